@@ -0,0 +1,969 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	// ErrInvalidConfig happens when a config is missing a required field or holds an unknown value.
+	ErrInvalidConfig = errors.New("invalid config")
+	// ErrUnknownConfigReference happens when a config refers to a resource, such as an app or a device, that
+	// neither exists nor is declared in the config.
+	ErrUnknownConfigReference = errors.New("config refers to an unknown resource")
+	// ErrConfigConflict happens when a resource differs from its config in a way that cannot be changed, such as
+	// the platform of a bundle ID.
+	ErrConfigConflict = errors.New("resource conflicts with config")
+)
+
+// Config is a declarative description of App Store Connect resources: bundle IDs and their capabilities,
+// provisioning profiles, beta groups and store metadata. It is read from YAML or JSON with ParseConfig, and
+// Client.Apply converges App Store Connect to match it. Resources that are not in the config are left alone.
+type Config struct {
+	BundleIDs  []BundleIDConfig  `json:"bundleIds,omitempty"`
+	Profiles   []ProfileConfig   `json:"profiles,omitempty"`
+	BetaGroups []BetaGroupConfig `json:"betaGroups,omitempty"`
+	Metadata   []MetadataConfig  `json:"metadata,omitempty"`
+}
+
+// BundleIDConfig is a bundle ID and the capabilities it has enabled.
+type BundleIDConfig struct {
+	Identifier   string             `json:"identifier"`
+	Name         string             `json:"name"`
+	Platform     BundleIDPlatform   `json:"platform"`
+	Capabilities []CapabilityConfig `json:"capabilities,omitempty"`
+	// PruneCapabilities disables the capabilities of the bundle ID that are not listed.
+	PruneCapabilities bool `json:"pruneCapabilities,omitempty"`
+}
+
+// CapabilityConfig is a capability enabled for a bundle ID. Its settings are only compared when some are set.
+type CapabilityConfig struct {
+	Type     CapabilityType      `json:"type"`
+	Settings []CapabilitySetting `json:"settings,omitempty"`
+}
+
+// ProfileConfig is a provisioning profile. As profiles cannot be modified, a profile that drifted from its
+// config is deleted and created again.
+type ProfileConfig struct {
+	Name        string `json:"name"`
+	ProfileType string `json:"profileType"`
+	// BundleID is the identifier of the bundle ID of the profile, such as com.example.app.
+	BundleID string `json:"bundleId"`
+	// Certificates are the IDs of the certificates included in the profile.
+	Certificates []string `json:"certificates"`
+	// Devices are the UDIDs of the devices the profile can be installed on.
+	Devices []string `json:"devices,omitempty"`
+	// AllDevices includes every enabled device of the platform of the bundle ID, in which case Devices is ignored.
+	AllDevices bool `json:"allDevices,omitempty"`
+}
+
+// BetaGroupConfig is a beta group of an app. Unset fields are unmanaged.
+type BetaGroupConfig struct {
+	// App is the bundle ID of the app, such as com.example.app.
+	App                    string `json:"app"`
+	Name                   string `json:"name"`
+	FeedbackEnabled        *bool  `json:"feedbackEnabled,omitempty"`
+	PublicLinkEnabled      *bool  `json:"publicLinkEnabled,omitempty"`
+	PublicLinkLimit        *int   `json:"publicLinkLimit,omitempty"`
+	PublicLinkLimitEnabled *bool  `json:"publicLinkLimitEnabled,omitempty"`
+}
+
+// MetadataConfig is the store metadata of an app and one of its App Store versions, applied like an AppMetadata
+// snapshot: unset fields and unlisted locales are unmanaged, and screenshots are not uploaded.
+type MetadataConfig struct {
+	// App is the bundle ID of the app, such as com.example.app.
+	App string `json:"app"`
+	// Version is the version string of the App Store version, such as 1.2.0.
+	Version string `json:"version"`
+	// Platform of the App Store version, needed when the app has versions with the same version string on
+	// several platforms.
+	Platform      Platform                            `json:"platform,omitempty"`
+	Categories    AppInfoCategories                   `json:"categories"`
+	AgeRating     *AgeRatingDeclarationAttributes     `json:"ageRating,omitempty"`
+	Localizations map[string]*AppMetadataLocalization `json:"localizations,omitempty"`
+}
+
+// ApplyResult lists the changes Client.Apply made. A resource that matches its config is not listed.
+type ApplyResult struct {
+	Changes []ConfigChange `json:"changes"`
+}
+
+// OutputKind implements Output.
+func (r ApplyResult) OutputKind() string {
+	return "applyResult"
+}
+
+// MarshalJSON encodes a result without changes with an empty list of changes rather than null.
+func (r ApplyResult) MarshalJSON() ([]byte, error) {
+	type result ApplyResult
+
+	if r.Changes == nil {
+		r.Changes = []ConfigChange{}
+	}
+
+	return json.Marshal(result(r))
+}
+
+func (r *ApplyResult) String() string {
+	if len(r.Changes) == 0 {
+		return "App Store Connect matches the config\n"
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%d changes\n", len(r.Changes))
+
+	for _, change := range r.Changes {
+		fmt.Fprintf(&b, "  %s\n", change)
+	}
+
+	return b.String()
+}
+
+// ParseConfig parses a config from YAML or JSON. Unknown fields are rejected, so that a misspelled field is not
+// silently ignored.
+func ParseConfig(b []byte) (*Config, error) {
+	var values interface{}
+	if err := yaml.Unmarshal(b, &values); err != nil {
+		return nil, err
+	}
+
+	b, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	config := new(Config)
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(config); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+	}
+
+	return config, config.Validate()
+}
+
+// ReadConfig reads a config from a YAML or JSON file.
+func ReadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := ParseConfig(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return config, nil
+}
+
+// Validate checks that every resource in the config has its required fields, known enum values, and is declared
+// only once.
+func (c *Config) Validate() error {
+	seen := make(map[string]bool)
+	declare := func(key string) error {
+		if seen[key] {
+			return fmt.Errorf("%w: %s is declared more than once", ErrInvalidConfig, key)
+		}
+
+		seen[key] = true
+
+		return nil
+	}
+
+	for _, bundleID := range c.BundleIDs {
+		if bundleID.Identifier == "" || bundleID.Name == "" {
+			return fmt.Errorf("%w: bundle IDs need an identifier and a name", ErrInvalidConfig)
+		}
+
+		if !bundleID.Platform.IsValid() {
+			return fmt.Errorf("%w: bundle ID %s has unknown platform %q", ErrInvalidConfig, bundleID.Identifier, bundleID.Platform)
+		}
+
+		if err := declare("bundle ID " + bundleID.Identifier); err != nil {
+			return err
+		}
+
+		for _, capability := range bundleID.Capabilities {
+			if !capability.Type.IsValid() {
+				return fmt.Errorf("%w: bundle ID %s has unknown capability %q", ErrInvalidConfig, bundleID.Identifier, capability.Type)
+			}
+
+			if err := declare("capability " + bundleID.Identifier + " " + string(capability.Type)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, profile := range c.Profiles {
+		if profile.Name == "" || profile.ProfileType == "" || profile.BundleID == "" {
+			return fmt.Errorf("%w: profiles need a name, a profile type and a bundle ID", ErrInvalidConfig)
+		}
+
+		if err := declare("profile " + profile.Name); err != nil {
+			return err
+		}
+	}
+
+	for _, group := range c.BetaGroups {
+		if group.App == "" || group.Name == "" {
+			return fmt.Errorf("%w: beta groups need an app and a name", ErrInvalidConfig)
+		}
+
+		if err := declare("beta group " + group.App + " " + group.Name); err != nil {
+			return err
+		}
+	}
+
+	for _, metadata := range c.Metadata {
+		if metadata.App == "" || metadata.Version == "" {
+			return fmt.Errorf("%w: metadata needs an app and a version", ErrInvalidConfig)
+		}
+
+		if err := declare("metadata " + metadata.App + " " + metadata.Version + " " + string(metadata.Platform)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ConfigPlan is the set of changes needed to converge App Store Connect to a config, computed by Client.Plan
+// without changing anything. Review it with String or Markdown before passing it to Client.ApplyPlan.
+type ConfigPlan struct {
+	Plan
+
+	// steps make the changes, one per change, and return the ID of the resource they created or changed.
+	steps []func(ctx context.Context) (string, error)
+}
+
+// Plan compares App Store Connect with a config and returns the changes Apply would make, without making them:
+// missing resources are created, and resources that drifted from their config are updated, or deleted and
+// created again when they cannot be modified. Bundle IDs come first, so that profiles can refer to bundle IDs
+// declared in the same config, then profiles, beta groups and metadata.
+func (c *Client) Plan(ctx context.Context, config *Config) (*ConfigPlan, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	p := &configPlanner{
+		client:    c,
+		plan:      &ConfigPlan{Plan: Plan{Changes: make([]ConfigChange, 0)}},
+		bundleIDs: make(map[string]*BundleID),
+		declared:  make(map[string]BundleIDPlatform),
+		apps:      make(map[string]string),
+	}
+
+	for _, bundleID := range config.BundleIDs {
+		if err := p.planBundleID(ctx, bundleID); err != nil {
+			return nil, fmt.Errorf("bundle ID %s: %w", bundleID.Identifier, err)
+		}
+	}
+
+	for _, profile := range config.Profiles {
+		if err := p.planProfile(ctx, profile); err != nil {
+			return nil, fmt.Errorf("profile %s: %w", profile.Name, err)
+		}
+	}
+
+	for _, group := range config.BetaGroups {
+		if err := p.planBetaGroup(ctx, group); err != nil {
+			return nil, fmt.Errorf("beta group %s: %w", group.Name, err)
+		}
+	}
+
+	for _, metadata := range config.Metadata {
+		if err := p.planMetadata(ctx, metadata); err != nil {
+			return nil, fmt.Errorf("metadata of %s %s: %w", metadata.App, metadata.Version, err)
+		}
+	}
+
+	return p.plan, nil
+}
+
+// ApplyPlan makes the changes of a plan returned by Plan, in order. It stops at the first failure and returns
+// the changes made until then. As the config is declarative, planning again after the cause is fixed picks up
+// the remaining changes.
+func (c *Client) ApplyPlan(ctx context.Context, plan *ConfigPlan) (*ApplyResult, error) {
+	if len(plan.steps) != len(plan.Changes) {
+		return nil, fmt.Errorf("%w: plan was not returned by Plan", ErrInvalidConfig)
+	}
+
+	result := &ApplyResult{Changes: make([]ConfigChange, 0, len(plan.Changes))}
+
+	for i, change := range plan.Changes {
+		id, err := plan.steps[i](ctx)
+		if err != nil {
+			return result, fmt.Errorf("%s: %w", change, err)
+		}
+
+		if id != "" {
+			change.ID = id
+		}
+
+		result.Changes = append(result.Changes, change)
+	}
+
+	return result, nil
+}
+
+// Apply converges App Store Connect to a config by planning the changes with Plan and making them with
+// ApplyPlan.
+func (c *Client) Apply(ctx context.Context, config *Config) (*ApplyResult, error) {
+	plan, err := c.Plan(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.ApplyPlan(ctx, plan)
+}
+
+// configPlanner holds the state of a single Plan, and of applying it: the bundle IDs and apps looked up by their
+// identifiers, to which the steps add the bundle IDs they create.
+type configPlanner struct {
+	client *Client
+	plan   *ConfigPlan
+	// bundleIDs are the bundle IDs by identifier.
+	bundleIDs map[string]*BundleID
+	// declared are the platforms of the bundle IDs the plan creates, by identifier.
+	declared map[string]BundleIDPlatform
+	// apps are the IDs of apps by bundle ID.
+	apps map[string]string
+	// devices are the enabled devices, listed the first time a profile needs them.
+	devices []Device
+}
+
+func (p *configPlanner) add(change ConfigChange, step func(ctx context.Context) (string, error)) {
+	p.plan.Changes = append(p.plan.Changes, change)
+	p.plan.steps = append(p.plan.steps, step)
+}
+
+// bundleIDID returns the ID of a bundle ID once the steps creating it have run.
+func (p *configPlanner) bundleIDID(identifier string) string {
+	if bundleID, ok := p.bundleIDs[identifier]; ok {
+		return bundleID.ID
+	}
+
+	return ""
+}
+
+func (p *configPlanner) planBundleID(ctx context.Context, config BundleIDConfig) error {
+	current, err := p.bundleID(ctx, config.Identifier)
+	if err != nil && !errors.Is(err, ErrUnknownConfigReference) {
+		return err
+	}
+
+	if current == nil {
+		p.declared[config.Identifier] = config.Platform
+		p.add(ConfigChange{Action: ConfigActionCreate, Resource: "bundleIds", Name: config.Identifier}, func(ctx context.Context) (string, error) {
+			res, _, err := p.client.Provisioning.CreateBundleID(ctx, BundleIDCreateRequestAttributes{
+				Identifier: config.Identifier,
+				Name:       config.Name,
+				Platform:   config.Platform,
+			})
+			if err != nil {
+				return "", err
+			}
+
+			p.bundleIDs[config.Identifier] = &res.Data
+
+			return res.Data.ID, nil
+		})
+
+		return p.planCapabilities(config, nil)
+	}
+
+	attributes := current.Attributes
+	if attributes == nil {
+		attributes = &BundleIDAttributes{}
+	}
+
+	if attributes.Platform != nil && *attributes.Platform != config.Platform {
+		return fmt.Errorf("%w: platform is %s, not %s", ErrConfigConflict, *attributes.Platform, config.Platform)
+	}
+
+	if name := stringValue(attributes.Name); name != config.Name {
+		p.add(ConfigChange{
+			Action:   ConfigActionUpdate,
+			Resource: "bundleIds",
+			Name:     config.Identifier,
+			ID:       current.ID,
+			Fields:   []ConfigFieldChange{{Field: "name", Current: name, Desired: config.Name}},
+		}, func(ctx context.Context) (string, error) {
+			_, _, err := p.client.Provisioning.UpdateBundleID(ctx, current.ID, String(config.Name))
+
+			return "", err
+		})
+	}
+
+	res, _, err := p.client.Provisioning.ListCapabilitiesForBundleID(ctx, current.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	return p.planCapabilities(config, res.Data)
+}
+
+func (p *configPlanner) planCapabilities(config BundleIDConfig, capabilities []BundleIDCapability) error {
+	existing := make(map[CapabilityType]BundleIDCapability, len(capabilities))
+
+	for _, capability := range capabilities {
+		if capability.Attributes != nil && capability.Attributes.CapabilityType != nil {
+			existing[*capability.Attributes.CapabilityType] = capability
+		}
+	}
+
+	listed := make(map[CapabilityType]bool, len(config.Capabilities))
+
+	for _, desired := range config.Capabilities {
+		desired := desired
+		listed[desired.Type] = true
+		name := config.Identifier + " " + string(desired.Type)
+
+		current, ok := existing[desired.Type]
+		if !ok {
+			p.add(ConfigChange{Action: ConfigActionCreate, Resource: "bundleIdCapabilities", Name: name}, func(ctx context.Context) (string, error) {
+				res, _, err := p.client.Provisioning.EnableCapability(ctx, desired.Type, desired.Settings, p.bundleIDID(config.Identifier))
+				if err != nil {
+					return "", err
+				}
+
+				return res.Data.ID, nil
+			})
+
+			continue
+		}
+
+		if len(desired.Settings) == 0 {
+			continue
+		}
+
+		currentSettings := formatCapabilitySettings(current.Attributes.Settings)
+		desiredSettings := formatCapabilitySettings(desired.Settings)
+
+		if currentSettings == desiredSettings {
+			continue
+		}
+
+		p.add(ConfigChange{
+			Action:   ConfigActionUpdate,
+			Resource: "bundleIdCapabilities",
+			Name:     name,
+			ID:       current.ID,
+			Fields:   []ConfigFieldChange{{Field: "settings", Current: currentSettings, Desired: desiredSettings}},
+		}, func(ctx context.Context) (string, error) {
+			_, _, err := p.client.Provisioning.UpdateCapability(ctx, current.ID, &desired.Type, desired.Settings)
+
+			return "", err
+		})
+	}
+
+	if !config.PruneCapabilities {
+		return nil
+	}
+
+	for _, capability := range capabilities {
+		if capability.Attributes == nil || capability.Attributes.CapabilityType == nil || listed[*capability.Attributes.CapabilityType] {
+			continue
+		}
+
+		id := capability.ID
+
+		p.add(ConfigChange{
+			Action:   ConfigActionDelete,
+			Resource: "bundleIdCapabilities",
+			Name:     config.Identifier + " " + string(*capability.Attributes.CapabilityType),
+			ID:       id,
+		}, func(ctx context.Context) (string, error) {
+			_, err := p.client.Provisioning.DisableCapability(ctx, id)
+
+			return "", err
+		})
+	}
+
+	return nil
+}
+
+// formatCapabilitySettings describes capability settings by the keys of the settings and of their enabled
+// options, such as "ICLOUD_VERSION=XCODE_6", ignoring the descriptions App Store Connect adds to them.
+func formatCapabilitySettings(settings []CapabilitySetting) string {
+	formatted := make([]string, 0, len(settings))
+
+	for _, setting := range settings {
+		options := make([]string, 0, len(setting.Options))
+
+		for _, option := range setting.Options {
+			if option.Enabled == nil || *option.Enabled {
+				options = append(options, stringValue(option.Key))
+			}
+		}
+
+		sort.Strings(options)
+		formatted = append(formatted, stringValue(setting.Key)+"="+strings.Join(options, ","))
+	}
+
+	sort.Strings(formatted)
+
+	return strings.Join(formatted, ";")
+}
+
+// bundleID returns the bundle ID registered with an identifier, or ErrUnknownConfigReference.
+func (p *configPlanner) bundleID(ctx context.Context, identifier string) (*BundleID, error) {
+	if bundleID, ok := p.bundleIDs[identifier]; ok {
+		return bundleID, nil
+	}
+
+	res, _, err := p.client.Provisioning.ListBundleIDs(ctx, &ListBundleIDsQuery{FilterIdentifier: []string{identifier}, Limit: MaxPageLimit})
+	if err != nil {
+		return nil, err
+	}
+
+	// The identifier filter also matches the identifiers the given one is a prefix of.
+	for i := range res.Data {
+		if attributes := res.Data[i].Attributes; attributes != nil && stringValue(attributes.IDentifier) == identifier {
+			p.bundleIDs[identifier] = &res.Data[i]
+
+			return &res.Data[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: bundle ID %s", ErrUnknownConfigReference, identifier)
+}
+
+// app returns the ID of the app with a bundle ID, or ErrUnknownConfigReference.
+func (p *configPlanner) app(ctx context.Context, bundleID string) (string, error) {
+	if id, ok := p.apps[bundleID]; ok {
+		return id, nil
+	}
+
+	res, _, err := p.client.Apps.ListApps(ctx, &ListAppsQuery{FilterBundleID: []string{bundleID}})
+	if err != nil {
+		return "", err
+	}
+
+	for _, app := range res.Data {
+		if app.Attributes != nil && stringValue(app.Attributes.BundleID) == bundleID {
+			p.apps[bundleID] = app.ID
+
+			return app.ID, nil
+		}
+	}
+
+	return "", fmt.Errorf("%w: app %s", ErrUnknownConfigReference, bundleID)
+}
+
+// profileState holds what is compared between a profile and its config.
+type profileState struct {
+	profileType  string
+	bundleID     string
+	certificates []string
+	devices      []string
+}
+
+func (s profileState) diff(desired profileState) []ConfigFieldChange {
+	fields := []struct {
+		name             string
+		current, desired string
+	}{
+		{"profileType", s.profileType, desired.profileType},
+		{"bundleId", s.bundleID, desired.bundleID},
+		{"certificates", strings.Join(s.certificates, ","), strings.Join(desired.certificates, ",")},
+		{"devices", strings.Join(s.devices, ","), strings.Join(desired.devices, ",")},
+	}
+
+	changes := make([]ConfigFieldChange, 0)
+
+	for _, field := range fields {
+		if field.current != field.desired {
+			changes = append(changes, ConfigFieldChange{Field: field.name, Current: field.current, Desired: field.desired})
+		}
+	}
+
+	return changes
+}
+
+func (p *configPlanner) planProfile(ctx context.Context, config ProfileConfig) error {
+	desired := profileState{
+		profileType:  config.ProfileType,
+		certificates: sortedStrings(config.Certificates),
+	}
+
+	var platform BundleIDPlatform
+
+	bundleID, err := p.bundleID(ctx, config.BundleID)
+
+	switch declared, ok := p.declared[config.BundleID]; {
+	case err == nil:
+		desired.bundleID = bundleID.ID
+
+		if bundleID.Attributes != nil && bundleID.Attributes.Platform != nil {
+			platform = *bundleID.Attributes.Platform
+		}
+	case ok && errors.Is(err, ErrUnknownConfigReference):
+		// The bundle ID is created by the plan, so it has no ID yet.
+		desired.bundleID = config.BundleID
+		platform = declared
+	default:
+		return err
+	}
+
+	if desired.devices, err = p.profileDevices(ctx, config, platform); err != nil {
+		return err
+	}
+
+	current, err := p.findProfile(ctx, config.Name)
+	if err != nil {
+		return err
+	}
+
+	change := ConfigChange{Action: ConfigActionCreate, Resource: "profiles", Name: config.Name}
+
+	if current != nil {
+		state, err := p.profileState(ctx, current)
+		if err != nil {
+			return err
+		}
+
+		change.Action = ConfigActionUpdate
+		change.ID = current.ID
+		change.Fields = state.diff(desired)
+
+		if profileState := stringValue(current.Attributes.ProfileState); profileState != "ACTIVE" {
+			change.Fields = append(change.Fields, ConfigFieldChange{Field: "profileState", Current: profileState, Desired: "ACTIVE"})
+		}
+
+		if len(change.Fields) == 0 {
+			return nil
+		}
+	}
+
+	p.add(change, func(ctx context.Context) (string, error) {
+		// Profiles cannot be modified, so a profile that drifted is deleted and created again.
+		if current != nil {
+			if _, err := p.client.Provisioning.DeleteProfile(ctx, current.ID); err != nil {
+				return "", err
+			}
+		}
+
+		res, _, err := p.client.Provisioning.CreateProfile(ctx, config.Name, config.ProfileType, p.bundleIDID(config.BundleID), desired.certificates, desired.devices)
+		if err != nil {
+			return "", err
+		}
+
+		return res.Data.ID, nil
+	})
+
+	return nil
+}
+
+// findProfile returns the profile with a name, or nil if there is none.
+func (p *configPlanner) findProfile(ctx context.Context, name string) (*Profile, error) {
+	res, _, err := p.client.Provisioning.ListProfiles(ctx, &ListProfilesQuery{FilterName: []string{name}, Limit: MaxPageLimit})
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range res.Data {
+		if attributes := res.Data[i].Attributes; attributes != nil && stringValue(attributes.Name) == name {
+			return &res.Data[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (p *configPlanner) profileState(ctx context.Context, profile *Profile) (profileState, error) {
+	state := profileState{profileType: stringValue(profile.Attributes.ProfileType)}
+
+	bundleID, _, err := p.client.Provisioning.GetBundleIDForProfile(ctx, profile.ID, nil)
+	if err != nil {
+		return state, err
+	}
+
+	state.bundleID = bundleID.Data.ID
+
+	err = ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		res, _, err := p.client.Provisioning.ListCertificatesInProfile(ctx, profile.ID, &ListCertificatesForProfileQuery{Limit: MaxPageLimit, Cursor: cursor})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, certificate := range res.Data {
+			state.certificates = append(state.certificates, certificate.ID)
+		}
+
+		return &res.Links, nil
+	})
+	if err != nil {
+		return state, err
+	}
+
+	err = ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		res, _, err := p.client.Provisioning.ListDevicesInProfile(ctx, profile.ID, &ListDevicesInProfileQuery{Limit: MaxPageLimit, Cursor: cursor})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, device := range res.Data {
+			state.devices = append(state.devices, device.ID)
+		}
+
+		return &res.Links, nil
+	})
+
+	state.certificates = sortedStrings(state.certificates)
+	state.devices = sortedStrings(state.devices)
+
+	return state, err
+}
+
+// profileDevices returns the sorted IDs of the devices a profile should include.
+func (p *configPlanner) profileDevices(ctx context.Context, config ProfileConfig, platform BundleIDPlatform) ([]string, error) {
+	if !config.AllDevices && len(config.Devices) == 0 {
+		return nil, nil
+	}
+
+	if p.devices == nil {
+		var devices []Device
+
+		err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+			res, _, err := p.client.Provisioning.ListDevices(ctx, &ListDevicesQuery{FilterStatus: []string{"ENABLED"}, Limit: MaxPageLimit, Cursor: cursor})
+			if err != nil {
+				return nil, err
+			}
+
+			devices = append(devices, res.Data...)
+
+			return &res.Links, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		p.devices = devices
+	}
+
+	ids := make([]string, 0)
+
+	if config.AllDevices {
+		for _, device := range p.devices {
+			if device.Attributes != nil && (platform == "" || device.Attributes.Platform == nil || *device.Attributes.Platform == platform) {
+				ids = append(ids, device.ID)
+			}
+		}
+
+		return sortedStrings(ids), nil
+	}
+
+	byUDID := make(map[string]string, len(p.devices))
+
+	for _, device := range p.devices {
+		if device.Attributes != nil {
+			byUDID[stringValue(device.Attributes.UDID)] = device.ID
+		}
+	}
+
+	for _, udid := range config.Devices {
+		id, ok := byUDID[udid]
+		if !ok {
+			return nil, fmt.Errorf("%w: no enabled device has the UDID %s", ErrUnknownConfigReference, udid)
+		}
+
+		ids = append(ids, id)
+	}
+
+	return sortedStrings(ids), nil
+}
+
+func (p *configPlanner) planBetaGroup(ctx context.Context, config BetaGroupConfig) error {
+	appID, err := p.app(ctx, config.App)
+	if err != nil {
+		return err
+	}
+
+	var current *BetaGroup
+
+	err = ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		res, _, err := p.client.TestFlight.ListBetaGroupsForApp(ctx, appID, &ListBetaGroupsForAppQuery{Limit: MaxPageLimit, Cursor: cursor})
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range res.Data {
+			if attributes := res.Data[i].Attributes; current == nil && attributes != nil && stringValue(attributes.Name) == config.Name {
+				current = &res.Data[i]
+			}
+		}
+
+		return &res.Links, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	name := config.App + " " + config.Name
+
+	if current == nil {
+		p.add(ConfigChange{Action: ConfigActionCreate, Resource: "betaGroups", Name: name}, func(ctx context.Context) (string, error) {
+			res, _, err := p.client.TestFlight.CreateBetaGroup(ctx, BetaGroupCreateRequestAttributes{
+				FeedbackEnabled:        config.FeedbackEnabled,
+				Name:                   config.Name,
+				PublicLinkEnabled:      config.PublicLinkEnabled,
+				PublicLinkLimit:        config.PublicLinkLimit,
+				PublicLinkLimitEnabled: config.PublicLinkLimitEnabled,
+			}, appID, nil, nil)
+			if err != nil {
+				return "", err
+			}
+
+			return res.Data.ID, nil
+		})
+
+		return nil
+	}
+
+	attributes := current.Attributes
+	update := &BetaGroupUpdateRequestAttributes{}
+	fields := make([]ConfigFieldChange, 0)
+
+	if config.FeedbackEnabled != nil && boolValue(attributes.FeedbackEnabled) != *config.FeedbackEnabled {
+		update.FeedbackEnabled = config.FeedbackEnabled
+		fields = append(fields, boolFieldChange("feedbackEnabled", boolValue(attributes.FeedbackEnabled), *config.FeedbackEnabled))
+	}
+
+	if config.PublicLinkEnabled != nil && boolValue(attributes.PublicLinkEnabled) != *config.PublicLinkEnabled {
+		update.PublicLinkEnabled = config.PublicLinkEnabled
+		fields = append(fields, boolFieldChange("publicLinkEnabled", boolValue(attributes.PublicLinkEnabled), *config.PublicLinkEnabled))
+	}
+
+	if config.PublicLinkLimitEnabled != nil && boolValue(attributes.PublicLinkLimitEnabled) != *config.PublicLinkLimitEnabled {
+		update.PublicLinkLimitEnabled = config.PublicLinkLimitEnabled
+		fields = append(fields, boolFieldChange("publicLinkLimitEnabled", boolValue(attributes.PublicLinkLimitEnabled), *config.PublicLinkLimitEnabled))
+	}
+
+	if config.PublicLinkLimit != nil && (attributes.PublicLinkLimit == nil || *attributes.PublicLinkLimit != *config.PublicLinkLimit) {
+		currentLimit := ""
+		if attributes.PublicLinkLimit != nil {
+			currentLimit = strconv.Itoa(*attributes.PublicLinkLimit)
+		}
+
+		update.PublicLinkLimit = config.PublicLinkLimit
+		fields = append(fields, ConfigFieldChange{Field: "publicLinkLimit", Current: currentLimit, Desired: strconv.Itoa(*config.PublicLinkLimit)})
+	}
+
+	if len(fields) == 0 {
+		return nil
+	}
+
+	p.add(ConfigChange{Action: ConfigActionUpdate, Resource: "betaGroups", Name: name, ID: current.ID, Fields: fields}, func(ctx context.Context) (string, error) {
+		_, _, err := p.client.TestFlight.UpdateBetaGroup(ctx, current.ID, update)
+
+		return "", err
+	})
+
+	return nil
+}
+
+func boolFieldChange(field string, current bool, desired bool) ConfigFieldChange {
+	return ConfigFieldChange{Field: field, Current: strconv.FormatBool(current), Desired: strconv.FormatBool(desired)}
+}
+
+func (p *configPlanner) planMetadata(ctx context.Context, config MetadataConfig) error {
+	appID, err := p.app(ctx, config.App)
+	if err != nil {
+		return err
+	}
+
+	query := &ListAppStoreVersionsQuery{FilterVersionString: []string{config.Version}}
+	if config.Platform != "" {
+		query.FilterPlatform = []string{string(config.Platform)}
+	}
+
+	versions, _, err := p.client.Apps.ListAppStoreVersionsForApp(ctx, appID, query)
+	if err != nil {
+		return err
+	}
+
+	if len(versions.Data) == 0 {
+		return ErrAppStoreVersionNotFound
+	}
+
+	current, _, err := p.client.Apps.ExportAppMetadata(ctx, appID, versions.Data[0].ID)
+	if err != nil {
+		return err
+	}
+
+	desired := &AppMetadata{
+		Categories:    config.Categories,
+		AgeRating:     config.AgeRating,
+		Localizations: config.Localizations,
+	}
+
+	applied := false
+
+	for _, change := range PlanAppMetadata(current, desired).Changes {
+		// Screenshots are not applied, since the config does not hold image files.
+		if change.Resource == "appScreenshotSets" {
+			continue
+		}
+
+		change.Name = config.App + " " + config.Version + " " + change.Name
+
+		// A single ApplyAppMetadata makes every change, so it runs with the first one.
+		p.add(change, func(ctx context.Context) (string, error) {
+			if applied {
+				return "", nil
+			}
+
+			if _, _, err := p.client.Apps.ApplyAppMetadata(ctx, current, desired); err != nil {
+				return "", err
+			}
+
+			applied = true
+
+			return "", nil
+		})
+	}
+
+	return nil
+}
+
+// sortedStrings returns a sorted copy of values.
+func sortedStrings(values []string) []string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+
+	return sorted
+}
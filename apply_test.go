@@ -0,0 +1,337 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testConfig = `
+bundleIds:
+  - identifier: com.example.app
+    name: Example
+    platform: IOS
+    pruneCapabilities: true
+    capabilities:
+      - type: PUSH_NOTIFICATIONS
+      - type: ICLOUD
+        settings:
+          - key: ICLOUD_VERSION
+            options:
+              - key: XCODE_6
+profiles:
+  - name: Example Development
+    profileType: IOS_APP_DEVELOPMENT
+    bundleId: com.example.app
+    certificates: [C2, C1]
+    devices: [UDID1]
+betaGroups:
+  - app: com.example.app
+    name: Internal
+    feedbackEnabled: true
+    publicLinkLimit: 100
+metadata:
+  - app: com.example.app
+    version: 1.2.0
+    platform: IOS
+    localizations:
+      en-US:
+        name: Example
+`
+
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+
+	config, err := ParseConfig([]byte(testConfig))
+	assert.NoError(t, err)
+	assert.Equal(t, "com.example.app", config.BundleIDs[0].Identifier)
+	assert.Equal(t, BundleIDPlatformiOS, config.BundleIDs[0].Platform)
+	assert.True(t, config.BundleIDs[0].PruneCapabilities)
+	assert.Equal(t, CapabilityTypeiCloud, config.BundleIDs[0].Capabilities[1].Type)
+	assert.Equal(t, String("XCODE_6"), config.BundleIDs[0].Capabilities[1].Settings[0].Options[0].Key)
+	assert.Equal(t, []string{"C2", "C1"}, config.Profiles[0].Certificates)
+	assert.Equal(t, Bool(true), config.BetaGroups[0].FeedbackEnabled)
+	assert.Equal(t, Int(100), config.BetaGroups[0].PublicLinkLimit)
+	assert.Equal(t, "1.2.0", config.Metadata[0].Version)
+	assert.Equal(t, String("Example"), config.Metadata[0].Localizations["en-US"].Name)
+
+	config, err = ParseConfig([]byte(`{"bundleIds":[{"identifier":"com.example.app","name":"Example","platform":"MAC_OS"}]}`))
+	assert.NoError(t, err)
+	assert.Equal(t, BundleIDPlatformMacOS, config.BundleIDs[0].Platform)
+}
+
+func TestParseConfigInvalid(t *testing.T) {
+	t.Parallel()
+
+	for name, doc := range map[string]string{
+		"unknown field":      "bundleIds:\n  - identifier: com.example.app\n    nmae: Example\n",
+		"missing name":       "bundleIds:\n  - identifier: com.example.app\n    platform: IOS\n",
+		"unknown platform":   "bundleIds:\n  - identifier: com.example.app\n    name: Example\n    platform: ANDROID\n",
+		"unknown capability": "bundleIds:\n  - identifier: com.example.app\n    name: Example\n    platform: IOS\n    capabilities: [{type: UNKNOWN}]\n",
+		"duplicate profile":  "profiles:\n  - {name: Dev, profileType: IOS_APP_DEVELOPMENT, bundleId: com.example.app}\n  - {name: Dev, profileType: IOS_APP_DEVELOPMENT, bundleId: com.example.app}\n",
+		"missing beta group": "betaGroups:\n  - app: com.example.app\n",
+		"missing version":    "metadata:\n  - app: com.example.app\n",
+	} {
+		_, err := ParseConfig([]byte(doc))
+		assert.ErrorIs(t, err, ErrInvalidConfig, name)
+	}
+
+	_, err := ParseConfig([]byte("bundleIds: ["))
+	assert.Error(t, err)
+}
+
+func TestReadConfig(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "asc.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(testConfig), 0o644))
+
+	config, err := ReadConfig(path)
+	assert.NoError(t, err)
+	assert.Len(t, config.BundleIDs, 1)
+
+	assert.NoError(t, os.WriteFile(path, []byte("profiles: [{name: Dev}]"), 0o644))
+
+	_, err = ReadConfig(path)
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+	assert.Contains(t, err.Error(), path)
+
+	_, err = ReadConfig(filepath.Join(t.TempDir(), "missing.yaml"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestApplyCreatesMissingResources(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /bundleIds":                        `{"data":[{"id":"9","type":"bundleIds","attributes":{"identifier":"com.example.app.widget"}}]}`,
+		"POST /bundleIds":                       `{"data":{"id":"1","type":"bundleIds","attributes":{"identifier":"com.example.app","platform":"IOS"}}}`,
+		"GET /bundleIds/1/bundleIdCapabilities": `{"data":[]}`,
+		"POST /bundleIdCapabilities":            `{"data":{"id":"2","type":"bundleIdCapabilities"}}`,
+		"GET /devices":                          `{"data":[{"id":"D1","type":"devices","attributes":{"udid":"UDID1","platform":"IOS","status":"ENABLED"}}]}`,
+		"GET /profiles":                         `{"data":[]}`,
+		"POST /profiles":                        `{"data":{"id":"3","type":"profiles"}}`,
+		"GET /apps":                             `{"data":[{"id":"A1","type":"apps","attributes":{"bundleId":"com.example.app"}}]}`,
+		"GET /apps/A1/betaGroups":               `{"data":[]}`,
+		"POST /betaGroups":                      `{"data":{"id":"4","type":"betaGroups"}}`,
+	})
+	defer server.Close()
+
+	config, err := ParseConfig([]byte(testConfig))
+	assert.NoError(t, err)
+
+	config.Metadata = nil
+
+	result, err := client.Apply(context.Background(), config)
+	assert.NoError(t, err)
+	assert.Equal(t, []ConfigChange{
+		{Action: ConfigActionCreate, Resource: "bundleIds", Name: "com.example.app", ID: "1"},
+		{Action: ConfigActionCreate, Resource: "bundleIdCapabilities", Name: "com.example.app PUSH_NOTIFICATIONS", ID: "2"},
+		{Action: ConfigActionCreate, Resource: "bundleIdCapabilities", Name: "com.example.app ICLOUD", ID: "2"},
+		{Action: ConfigActionCreate, Resource: "profiles", Name: "Example Development", ID: "3"},
+		{Action: ConfigActionCreate, Resource: "betaGroups", Name: "com.example.app Internal", ID: "4"},
+	}, result.Changes)
+	assert.Contains(t, *requests, "POST /profiles")
+	assert.Equal(t, "5 changes\n  CREATE bundleIds com.example.app\n", result.String()[:len("5 changes\n  CREATE bundleIds com.example.app\n")])
+}
+
+func TestPlanDoesNotMutate(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /bundleIds":          `{"data":[]}`,
+		"POST /bundleIds":         `{"data":{"id":"1","type":"bundleIds","attributes":{"identifier":"com.example.app","platform":"IOS"}}}`,
+		"GET /devices":            `{"data":[{"id":"D1","type":"devices","attributes":{"udid":"UDID1","platform":"IOS","status":"ENABLED"}}]}`,
+		"GET /profiles":           `{"data":[]}`,
+		"POST /profiles":          `{"data":{"id":"3","type":"profiles"}}`,
+		"GET /apps":               `{"data":[{"id":"A1","type":"apps","attributes":{"bundleId":"com.example.app"}}]}`,
+		"GET /apps/A1/betaGroups": `{"data":[{"id":"4","type":"betaGroups","attributes":{"name":"Internal","feedbackEnabled":true,"publicLinkLimit":100}}]}`,
+	})
+	defer server.Close()
+
+	config, err := ParseConfig([]byte(testConfig))
+	assert.NoError(t, err)
+
+	config.BundleIDs[0].Capabilities = nil
+	config.Metadata = nil
+
+	ctx := context.Background()
+
+	plan, err := client.Plan(ctx, config)
+	assert.NoError(t, err)
+	assert.Equal(t, "2 to create, 0 to update, 0 to delete", plan.Summary())
+	assert.Contains(t, plan.Markdown(), "+ CREATE profiles Example Development\n")
+
+	for _, request := range *requests {
+		assert.True(t, strings.HasPrefix(request, "GET "), request)
+	}
+
+	result, err := client.ApplyPlan(ctx, plan)
+	assert.NoError(t, err)
+	assert.Equal(t, []ConfigChange{
+		{Action: ConfigActionCreate, Resource: "bundleIds", Name: "com.example.app", ID: "1"},
+		{Action: ConfigActionCreate, Resource: "profiles", Name: "Example Development", ID: "3"},
+	}, result.Changes)
+	assert.Empty(t, plan.Changes[0].ID)
+
+	_, err = client.ApplyPlan(ctx, &ConfigPlan{Plan: plan.Plan})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
+
+func TestApplyDetectsDrift(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /bundleIds":     `{"data":[{"id":"1","type":"bundleIds","attributes":{"identifier":"com.example.app","name":"Old","platform":"IOS"}}]}`,
+		"PATCH /bundleIds/1": `{"data":{"id":"1","type":"bundleIds"}}`,
+		"GET /bundleIds/1/bundleIdCapabilities": `{"data":[
+			{"id":"2","type":"bundleIdCapabilities","attributes":{"capabilityType":"PUSH_NOTIFICATIONS"}},
+			{"id":"3","type":"bundleIdCapabilities","attributes":{"capabilityType":"ICLOUD","settings":[{"key":"ICLOUD_VERSION","name":"iCloud","options":[{"key":"XCODE_5","enabled":true},{"key":"XCODE_6","enabled":false}]}]}},
+			{"id":"4","type":"bundleIdCapabilities","attributes":{"capabilityType":"GAME_CENTER"}}
+		]}`,
+		"PATCH /bundleIdCapabilities/3":                         `{"data":{"id":"3","type":"bundleIdCapabilities"}}`,
+		"DELETE /bundleIdCapabilities/4":                        `{}`,
+		"GET /devices":                                          `{"data":[{"id":"D1","type":"devices","attributes":{"udid":"UDID1","platform":"IOS","status":"ENABLED"}}]}`,
+		"GET /profiles":                                         `{"data":[{"id":"5","type":"profiles","attributes":{"name":"Example Development","profileType":"IOS_APP_DEVELOPMENT","profileState":"ACTIVE"}}]}`,
+		"GET /profiles/5/bundleId":                              `{"data":{"id":"1","type":"bundleIds"}}`,
+		"GET /profiles/5/certificates":                          `{"data":[{"id":"C1","type":"certificates"}]}`,
+		"GET /profiles/5/devices":                               `{"data":[{"id":"D1","type":"devices"}]}`,
+		"DELETE /profiles/5":                                    `{}`,
+		"POST /profiles":                                        `{"data":{"id":"6","type":"profiles"}}`,
+		"GET /apps":                                             `{"data":[{"id":"A1","type":"apps","attributes":{"bundleId":"com.example.app"}}]}`,
+		"GET /apps/A1/betaGroups":                               `{"data":[{"id":"7","type":"betaGroups","attributes":{"name":"Internal","feedbackEnabled":false,"publicLinkLimit":100}}]}`,
+		"PATCH /betaGroups/7":                                   `{"data":{"id":"7","type":"betaGroups"}}`,
+		"GET /apps/A1/appStoreVersions":                         `{"data":[{"id":"10","type":"appStoreVersions"}]}`,
+		"GET /apps/A1/appInfos":                                 `{"data":[{"id":"8","type":"appInfos","attributes":{"appStoreState":"PREPARE_FOR_SUBMISSION"}}]}`,
+		"GET /appInfos/8/ageRatingDeclaration":                  `{"data":{"id":"9","type":"ageRatingDeclarations"}}`,
+		"GET /appInfos/8/appInfoLocalizations":                  `{"data":[{"id":"11","type":"appInfoLocalizations","attributes":{"locale":"en-US","name":"Old"}}]}`,
+		"GET /appStoreVersions/10/appStoreVersionLocalizations": `{"data":[]}`,
+		"PATCH /appInfoLocalizations/11":                        `{"data":{"id":"11","type":"appInfoLocalizations"}}`,
+	})
+	defer server.Close()
+
+	config, err := ParseConfig([]byte(testConfig))
+	assert.NoError(t, err)
+
+	result, err := client.Apply(context.Background(), config)
+	assert.NoError(t, err)
+	assert.Equal(t, []ConfigChange{
+		{Action: ConfigActionUpdate, Resource: "bundleIds", Name: "com.example.app", ID: "1", Fields: []ConfigFieldChange{{Field: "name", Current: "Old", Desired: "Example"}}},
+		{Action: ConfigActionUpdate, Resource: "bundleIdCapabilities", Name: "com.example.app ICLOUD", ID: "3", Fields: []ConfigFieldChange{{Field: "settings", Current: "ICLOUD_VERSION=XCODE_5", Desired: "ICLOUD_VERSION=XCODE_6"}}},
+		{Action: ConfigActionDelete, Resource: "bundleIdCapabilities", Name: "com.example.app GAME_CENTER", ID: "4"},
+		{Action: ConfigActionUpdate, Resource: "profiles", Name: "Example Development", ID: "6", Fields: []ConfigFieldChange{{Field: "certificates", Current: "C1", Desired: "C1,C2"}}},
+		{Action: ConfigActionUpdate, Resource: "betaGroups", Name: "com.example.app Internal", ID: "7", Fields: []ConfigFieldChange{{Field: "feedbackEnabled", Current: "false", Desired: "true"}}},
+		{Action: ConfigActionUpdate, Resource: "appInfoLocalizations", Name: "com.example.app 1.2.0 en-US", ID: "11", Fields: []ConfigFieldChange{{Field: "name", Current: "Old", Desired: "Example"}}},
+	}, result.Changes)
+	assert.Contains(t, *requests, "DELETE /profiles/5")
+	assert.NotContains(t, *requests, "DELETE /bundleIdCapabilities/2")
+	assert.Contains(t, result.String(), `UPDATE profiles Example Development: certificates: "C1" -> "C1,C2"`)
+}
+
+func TestApplyNoChanges(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /bundleIds":                        `{"data":[{"id":"1","type":"bundleIds","attributes":{"identifier":"com.example.app","name":"Example","platform":"IOS"}}]}`,
+		"GET /bundleIds/1/bundleIdCapabilities": `{"data":[{"id":"2","type":"bundleIdCapabilities","attributes":{"capabilityType":"PUSH_NOTIFICATIONS"}}]}`,
+		"GET /profiles":                         `{"data":[{"id":"5","type":"profiles","attributes":{"name":"Example Store","profileType":"IOS_APP_STORE","profileState":"ACTIVE"}}]}`,
+		"GET /profiles/5/bundleId":              `{"data":{"id":"1","type":"bundleIds"}}`,
+		"GET /profiles/5/certificates":          `{"data":[{"id":"C1","type":"certificates"}]}`,
+		"GET /profiles/5/devices":               `{"data":[]}`,
+	})
+	defer server.Close()
+
+	result, err := client.Apply(context.Background(), &Config{
+		BundleIDs: []BundleIDConfig{{Identifier: "com.example.app", Name: "Example", Platform: BundleIDPlatformiOS, Capabilities: []CapabilityConfig{{Type: CapabilityTypePushNotifications}}}},
+		Profiles:  []ProfileConfig{{Name: "Example Store", ProfileType: "IOS_APP_STORE", BundleID: "com.example.app", Certificates: []string{"C1"}}},
+	})
+	assert.NoError(t, err)
+	assert.Empty(t, result.Changes)
+	assert.Equal(t, "App Store Connect matches the config\n", result.String())
+}
+
+func TestApplyRecreatesInvalidProfile(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /bundleIds":               `{"data":[{"id":"1","type":"bundleIds","attributes":{"identifier":"com.example.app","platform":"IOS"}}]}`,
+		"GET /devices":                 `{"data":[{"id":"D1","type":"devices","attributes":{"platform":"IOS"}},{"id":"D2","type":"devices","attributes":{"platform":"MAC_OS"}}]}`,
+		"GET /profiles":                `{"data":[{"id":"5","type":"profiles","attributes":{"name":"Dev","profileType":"IOS_APP_DEVELOPMENT","profileState":"INVALID"}}]}`,
+		"GET /profiles/5/bundleId":     `{"data":{"id":"1","type":"bundleIds"}}`,
+		"GET /profiles/5/certificates": `{"data":[]}`,
+		"GET /profiles/5/devices":      `{"data":[{"id":"D1","type":"devices"}]}`,
+		"DELETE /profiles/5":           `{}`,
+		"POST /profiles":               `{"data":{"id":"6","type":"profiles"}}`,
+	})
+	defer server.Close()
+
+	result, err := client.Apply(context.Background(), &Config{
+		Profiles: []ProfileConfig{{Name: "Dev", ProfileType: "IOS_APP_DEVELOPMENT", BundleID: "com.example.app", AllDevices: true}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []ConfigChange{
+		{Action: ConfigActionUpdate, Resource: "profiles", Name: "Dev", ID: "6", Fields: []ConfigFieldChange{{Field: "profileState", Current: "INVALID", Desired: "ACTIVE"}}},
+	}, result.Changes)
+}
+
+func TestApplyErrors(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /bundleIds": `{"data":[{"id":"1","type":"bundleIds","attributes":{"identifier":"com.example.app","name":"Example","platform":"MAC_OS"}}]}`,
+		"GET /devices":   `{"data":[]}`,
+		"GET /apps":      `{"data":[]}`,
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+
+	_, err := client.Apply(ctx, &Config{BundleIDs: []BundleIDConfig{{Identifier: "com.example.app", Name: "Example", Platform: BundleIDPlatformiOS}}})
+	assert.ErrorIs(t, err, ErrConfigConflict)
+
+	_, err = client.Apply(ctx, &Config{Profiles: []ProfileConfig{{Name: "Dev", ProfileType: "MAC_APP_DEVELOPMENT", BundleID: "com.example.other"}}})
+	assert.ErrorIs(t, err, ErrUnknownConfigReference)
+
+	_, err = client.Apply(ctx, &Config{Profiles: []ProfileConfig{{Name: "Dev", ProfileType: "MAC_APP_DEVELOPMENT", BundleID: "com.example.app", Devices: []string{"UDID1"}}}})
+	assert.ErrorIs(t, err, ErrUnknownConfigReference)
+
+	_, err = client.Apply(ctx, &Config{BetaGroups: []BetaGroupConfig{{App: "com.example.app", Name: "Internal"}}})
+	assert.ErrorIs(t, err, ErrUnknownConfigReference)
+
+	_, err = client.Apply(ctx, &Config{Profiles: []ProfileConfig{{Name: "Dev"}}})
+	assert.ErrorIs(t, err, ErrInvalidConfig)
+}
+
+func TestFormatCapabilitySettings(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "", formatCapabilitySettings(nil))
+	assert.Equal(t, "A=X,Y;B=", formatCapabilitySettings([]CapabilitySetting{
+		{Key: String("B")},
+		{Key: String("A"), Options: []CapabilityOption{{Key: String("Y")}, {Key: String("Z"), Enabled: Bool(false)}, {Key: String("X"), Enabled: Bool(true)}}},
+	}))
+}
@@ -29,6 +29,7 @@ import (
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/apps
 // https://developer.apple.com/documentation/appstoreconnectapi/app_metadata
+// https://developer.apple.com/documentation/appstoreconnectapi/customer_reviews
 type AppsService service
 
 // Platform defines model for Platform.
@@ -43,8 +44,43 @@ const (
 	PlatformMACOS Platform = "MAC_OS"
 	// PlatformTVOS is for an app on tvOS.
 	PlatformTVOS Platform = "TV_OS"
+	// PlatformVISIONOS is for an app on visionOS.
+	PlatformVISIONOS Platform = "VISION_OS"
 )
 
+// ParsePlatform returns s as a Platform, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParsePlatform(s string) (Platform, error) {
+	if v := Platform(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "Platform", Value: s}
+}
+
+var platforms = []Platform{
+	PlatformIOS,
+	PlatformMACOS,
+	PlatformTVOS,
+	PlatformVISIONOS,
+}
+
+// Platforms returns every platform known to this package.
+func Platforms() []Platform {
+	return append([]Platform(nil), platforms...)
+}
+
+// IsValid reports whether the platform is known to this package. Unknown platforms can still be sent
+// to the API, so that platforms Apple adds later can be used before this package lists them.
+func (p Platform) IsValid() bool {
+	for _, platform := range platforms {
+		if p == platform {
+			return true
+		}
+	}
+
+	return false
+}
+
 // App defines model for App.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/app
@@ -73,20 +109,26 @@ type AppAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/app/relationships
 type AppRelationships struct {
-	AppInfos                  *PagedRelationship `json:"appInfos,omitempty"`
-	AppStoreVersions          *PagedRelationship `json:"appStoreVersions,omitempty"`
-	AvailableTerritories      *PagedRelationship `json:"availableTerritories,omitempty"`
-	BetaAppLocalizations      *PagedRelationship `json:"betaAppLocalizations,omitempty"`
-	BetaAppReviewDetail       *Relationship      `json:"betaAppReviewDetail,omitempty"`
-	BetaGroups                *PagedRelationship `json:"betaGroups,omitempty"`
-	BetaLicenseAgreement      *Relationship      `json:"betaLicenseAgreement,omitempty"`
-	Builds                    *PagedRelationship `json:"builds,omitempty"`
-	EndUserLicenseAgreement   *Relationship      `json:"endUserLicenseAgreement,omitempty"`
-	GameCenterEnabledVersions *PagedRelationship `json:"gameCenterEnabledVersions,omitempty"`
-	InAppPurchases            *PagedRelationship `json:"inAppPurchases,omitempty"`
-	PreOrder                  *Relationship      `json:"preOrder,omitempty"`
-	PreReleaseVersions        *PagedRelationship `json:"preReleaseVersions,omitempty"`
-	Prices                    *PagedRelationship `json:"prices,omitempty"`
+	AppClips                     *PagedRelationship `json:"appClips,omitempty"`
+	AppCustomProductPages        *PagedRelationship `json:"appCustomProductPages,omitempty"`
+	AppEvents                    *PagedRelationship `json:"appEvents,omitempty"`
+	AppInfos                     *PagedRelationship `json:"appInfos,omitempty"`
+	AppPriceSchedule             *Relationship      `json:"appPriceSchedule,omitempty"`
+	AppStoreVersionExperimentsV2 *PagedRelationship `json:"appStoreVersionExperimentsV2,omitempty"`
+	AppStoreVersions             *PagedRelationship `json:"appStoreVersions,omitempty"`
+	AvailableTerritories         *PagedRelationship `json:"availableTerritories,omitempty"`
+	BetaAppLocalizations         *PagedRelationship `json:"betaAppLocalizations,omitempty"`
+	BetaAppReviewDetail          *Relationship      `json:"betaAppReviewDetail,omitempty"`
+	BetaGroups                   *PagedRelationship `json:"betaGroups,omitempty"`
+	BetaLicenseAgreement         *Relationship      `json:"betaLicenseAgreement,omitempty"`
+	Builds                       *PagedRelationship `json:"builds,omitempty"`
+	EndUserLicenseAgreement      *Relationship      `json:"endUserLicenseAgreement,omitempty"`
+	GameCenterDetail             *Relationship      `json:"gameCenterDetail,omitempty"`
+	GameCenterEnabledVersions    *PagedRelationship `json:"gameCenterEnabledVersions,omitempty"`
+	InAppPurchases               *PagedRelationship `json:"inAppPurchases,omitempty"`
+	PreOrder                     *Relationship      `json:"preOrder,omitempty"`
+	PreReleaseVersions           *PagedRelationship `json:"preReleaseVersions,omitempty"`
+	Prices                       *PagedRelationship `json:"prices,omitempty"`
 }
 
 // AppUpdateRequest defines model for AppUpdateRequest.
@@ -289,21 +331,14 @@ type GetInAppPurchaseQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_apps
 func (s *AppsService) ListApps(ctx context.Context, params *ListAppsQuery) (*AppsResponse, *Response, error) {
-	res := new(AppsResponse)
-	resp, err := s.client.get(ctx, "apps", params, res)
-
-	return res, resp, err
+	return Get[AppsResponse](ctx, s.client, "apps", params)
 }
 
 // GetApp gets information about a specific app.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_information
 func (s *AppsService) GetApp(ctx context.Context, id string, params *GetAppQuery) (*AppResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s", id)
-	res := new(AppResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppResponse](ctx, s.client, fmt.Sprintf("apps/%s", id), params)
 }
 
 // NewAppPriceRelationship models the parameters for a new app price relationship
@@ -406,22 +441,14 @@ func (s *AppsService) RemoveBetaTestersFromApp(ctx context.Context, id string, b
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_in-app_purchases_for_an_app
 func (s *AppsService) ListInAppPurchasesForApp(ctx context.Context, id string, params *ListInAppPurchasesQuery) (*InAppPurchasesResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/inAppPurchases", id)
-	res := new(InAppPurchasesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[InAppPurchasesResponse](ctx, s.client, fmt.Sprintf("apps/%s/inAppPurchases", id), params)
 }
 
 // GetInAppPurchase gets information about an in-app purchase.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_purchase_information
 func (s *AppsService) GetInAppPurchase(ctx context.Context, id string, params *GetInAppPurchaseQuery) (*InAppPurchaseResponse, *Response, error) {
-	url := fmt.Sprintf("inAppPurchases/%s", id)
-	res := new(InAppPurchaseResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[InAppPurchaseResponse](ctx, s.client, fmt.Sprintf("inAppPurchases/%s", id), params)
 }
 
 // UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppResponseIncluded.
@@ -488,6 +515,11 @@ func (i *AppResponseIncluded) AppPrice() *AppPrice {
 	return extractIncludedAppPrice(i.inner)
 }
 
+// AppPriceSchedule returns the AppPriceSchedule stored within, if one is present.
+func (i *AppResponseIncluded) AppPriceSchedule() *AppPriceSchedule {
+	return extractIncludedAppPriceSchedule(i.inner)
+}
+
 // Territory returns the Territory stored within, if one is present.
 func (i *AppResponseIncluded) Territory() *Territory {
 	return extractIncludedTerritory(i.inner)
@@ -0,0 +1,967 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// AppClipAdvancedExperienceBusinessCategory defines model for AppClipAdvancedExperienceBusinessCategory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencebusinesscategory
+type AppClipAdvancedExperienceBusinessCategory string
+
+const (
+	// AppClipAdvancedExperienceBusinessCategoryAutomotive is for the automotive business category.
+	AppClipAdvancedExperienceBusinessCategoryAutomotive AppClipAdvancedExperienceBusinessCategory = "AUTOMOTIVE"
+	// AppClipAdvancedExperienceBusinessCategoryBeauty is for the beauty business category.
+	AppClipAdvancedExperienceBusinessCategoryBeauty AppClipAdvancedExperienceBusinessCategory = "BEAUTY"
+	// AppClipAdvancedExperienceBusinessCategoryBikes is for the bikes business category.
+	AppClipAdvancedExperienceBusinessCategoryBikes AppClipAdvancedExperienceBusinessCategory = "BIKES"
+	// AppClipAdvancedExperienceBusinessCategoryBooks is for the books business category.
+	AppClipAdvancedExperienceBusinessCategoryBooks AppClipAdvancedExperienceBusinessCategory = "BOOKS"
+	// AppClipAdvancedExperienceBusinessCategoryCasino is for the casino business category.
+	AppClipAdvancedExperienceBusinessCategoryCasino AppClipAdvancedExperienceBusinessCategory = "CASINO"
+	// AppClipAdvancedExperienceBusinessCategoryEducation is for the education business category.
+	AppClipAdvancedExperienceBusinessCategoryEducation AppClipAdvancedExperienceBusinessCategory = "EDUCATION"
+	// AppClipAdvancedExperienceBusinessCategoryEducationJapan is for the education japan business category.
+	AppClipAdvancedExperienceBusinessCategoryEducationJapan AppClipAdvancedExperienceBusinessCategory = "EDUCATION_JAPAN"
+	// AppClipAdvancedExperienceBusinessCategoryEntertainment is for the entertainment business category.
+	AppClipAdvancedExperienceBusinessCategoryEntertainment AppClipAdvancedExperienceBusinessCategory = "ENTERTAINMENT"
+	// AppClipAdvancedExperienceBusinessCategoryEVCharger is for the EV charger business category.
+	AppClipAdvancedExperienceBusinessCategoryEVCharger AppClipAdvancedExperienceBusinessCategory = "EV_CHARGER"
+	// AppClipAdvancedExperienceBusinessCategoryFinancialUSD is for the financial business category, in USD.
+	AppClipAdvancedExperienceBusinessCategoryFinancialUSD AppClipAdvancedExperienceBusinessCategory = "FINANCIAL_USD"
+	// AppClipAdvancedExperienceBusinessCategoryFinancialCNY is for the financial business category, in CNY.
+	AppClipAdvancedExperienceBusinessCategoryFinancialCNY AppClipAdvancedExperienceBusinessCategory = "FINANCIAL_CNY"
+	// AppClipAdvancedExperienceBusinessCategoryFinancialGBP is for the financial business category, in GBP.
+	AppClipAdvancedExperienceBusinessCategoryFinancialGBP AppClipAdvancedExperienceBusinessCategory = "FINANCIAL_GBP"
+	// AppClipAdvancedExperienceBusinessCategoryFinancialJPY is for the financial business category, in JPY.
+	AppClipAdvancedExperienceBusinessCategoryFinancialJPY AppClipAdvancedExperienceBusinessCategory = "FINANCIAL_JPY"
+	// AppClipAdvancedExperienceBusinessCategoryFinancialEUR is for the financial business category, in EUR.
+	AppClipAdvancedExperienceBusinessCategoryFinancialEUR AppClipAdvancedExperienceBusinessCategory = "FINANCIAL_EUR"
+	// AppClipAdvancedExperienceBusinessCategoryFitness is for the fitness business category.
+	AppClipAdvancedExperienceBusinessCategoryFitness AppClipAdvancedExperienceBusinessCategory = "FITNESS"
+	// AppClipAdvancedExperienceBusinessCategoryFoodAndDrink is for the food and drink business category.
+	AppClipAdvancedExperienceBusinessCategoryFoodAndDrink AppClipAdvancedExperienceBusinessCategory = "FOOD_AND_DRINK"
+	// AppClipAdvancedExperienceBusinessCategoryGas is for the gas business category.
+	AppClipAdvancedExperienceBusinessCategoryGas AppClipAdvancedExperienceBusinessCategory = "GAS"
+	// AppClipAdvancedExperienceBusinessCategoryGrocery is for the grocery business category.
+	AppClipAdvancedExperienceBusinessCategoryGrocery AppClipAdvancedExperienceBusinessCategory = "GROCERY"
+	// AppClipAdvancedExperienceBusinessCategoryHealthAndMedicine is for the health and medicine business category.
+	AppClipAdvancedExperienceBusinessCategoryHealthAndMedicine AppClipAdvancedExperienceBusinessCategory = "HEALTH_AND_MEDICINE"
+	// AppClipAdvancedExperienceBusinessCategoryHotelAndTravel is for the hotel and travel business category.
+	AppClipAdvancedExperienceBusinessCategoryHotelAndTravel AppClipAdvancedExperienceBusinessCategory = "HOTEL_AND_TRAVEL"
+	// AppClipAdvancedExperienceBusinessCategoryMusic is for the music business category.
+	AppClipAdvancedExperienceBusinessCategoryMusic AppClipAdvancedExperienceBusinessCategory = "MUSIC"
+	// AppClipAdvancedExperienceBusinessCategoryParking is for the parking business category.
+	AppClipAdvancedExperienceBusinessCategoryParking AppClipAdvancedExperienceBusinessCategory = "PARKING"
+	// AppClipAdvancedExperienceBusinessCategoryPetServices is for the pet services business category.
+	AppClipAdvancedExperienceBusinessCategoryPetServices AppClipAdvancedExperienceBusinessCategory = "PET_SERVICES"
+	// AppClipAdvancedExperienceBusinessCategoryProfessionalServices is for the professional services business category.
+	AppClipAdvancedExperienceBusinessCategoryProfessionalServices AppClipAdvancedExperienceBusinessCategory = "PROFESSIONAL_SERVICES"
+	// AppClipAdvancedExperienceBusinessCategoryShopping is for the shopping business category.
+	AppClipAdvancedExperienceBusinessCategoryShopping AppClipAdvancedExperienceBusinessCategory = "SHOPPING"
+	// AppClipAdvancedExperienceBusinessCategoryTicketing is for the ticketing business category.
+	AppClipAdvancedExperienceBusinessCategoryTicketing AppClipAdvancedExperienceBusinessCategory = "TICKETING"
+	// AppClipAdvancedExperienceBusinessCategoryTransit is for the transit business category.
+	AppClipAdvancedExperienceBusinessCategoryTransit AppClipAdvancedExperienceBusinessCategory = "TRANSIT"
+)
+
+var appClipAdvancedExperienceBusinessCategories = []AppClipAdvancedExperienceBusinessCategory{
+	AppClipAdvancedExperienceBusinessCategoryAutomotive,
+	AppClipAdvancedExperienceBusinessCategoryBeauty,
+	AppClipAdvancedExperienceBusinessCategoryBikes,
+	AppClipAdvancedExperienceBusinessCategoryBooks,
+	AppClipAdvancedExperienceBusinessCategoryCasino,
+	AppClipAdvancedExperienceBusinessCategoryEducation,
+	AppClipAdvancedExperienceBusinessCategoryEducationJapan,
+	AppClipAdvancedExperienceBusinessCategoryEntertainment,
+	AppClipAdvancedExperienceBusinessCategoryEVCharger,
+	AppClipAdvancedExperienceBusinessCategoryFinancialUSD,
+	AppClipAdvancedExperienceBusinessCategoryFinancialCNY,
+	AppClipAdvancedExperienceBusinessCategoryFinancialGBP,
+	AppClipAdvancedExperienceBusinessCategoryFinancialJPY,
+	AppClipAdvancedExperienceBusinessCategoryFinancialEUR,
+	AppClipAdvancedExperienceBusinessCategoryFitness,
+	AppClipAdvancedExperienceBusinessCategoryFoodAndDrink,
+	AppClipAdvancedExperienceBusinessCategoryGas,
+	AppClipAdvancedExperienceBusinessCategoryGrocery,
+	AppClipAdvancedExperienceBusinessCategoryHealthAndMedicine,
+	AppClipAdvancedExperienceBusinessCategoryHotelAndTravel,
+	AppClipAdvancedExperienceBusinessCategoryMusic,
+	AppClipAdvancedExperienceBusinessCategoryParking,
+	AppClipAdvancedExperienceBusinessCategoryPetServices,
+	AppClipAdvancedExperienceBusinessCategoryProfessionalServices,
+	AppClipAdvancedExperienceBusinessCategoryShopping,
+	AppClipAdvancedExperienceBusinessCategoryTicketing,
+	AppClipAdvancedExperienceBusinessCategoryTransit,
+}
+
+// IsValid reports whether the app clip advanced experience business category is one this package knows about.
+func (v AppClipAdvancedExperienceBusinessCategory) IsValid() bool {
+	for _, value := range appClipAdvancedExperienceBusinessCategories {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppClipAdvancedExperienceBusinessCategory returns s as an AppClipAdvancedExperienceBusinessCategory, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppClipAdvancedExperienceBusinessCategory(s string) (AppClipAdvancedExperienceBusinessCategory, error) {
+	if v := AppClipAdvancedExperienceBusinessCategory(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppClipAdvancedExperienceBusinessCategory", Value: s}
+}
+
+// AppClipAdvancedExperienceLanguage defines model for AppClipAdvancedExperienceLanguage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencelanguage
+type AppClipAdvancedExperienceLanguage string
+
+const (
+	// AppClipAdvancedExperienceLanguageAr is for Arabic.
+	AppClipAdvancedExperienceLanguageAr AppClipAdvancedExperienceLanguage = "AR"
+	// AppClipAdvancedExperienceLanguageCa is for Catalan.
+	AppClipAdvancedExperienceLanguageCa AppClipAdvancedExperienceLanguage = "CA"
+	// AppClipAdvancedExperienceLanguageCs is for Czech.
+	AppClipAdvancedExperienceLanguageCs AppClipAdvancedExperienceLanguage = "CS"
+	// AppClipAdvancedExperienceLanguageDa is for Danish.
+	AppClipAdvancedExperienceLanguageDa AppClipAdvancedExperienceLanguage = "DA"
+	// AppClipAdvancedExperienceLanguageDe is for German.
+	AppClipAdvancedExperienceLanguageDe AppClipAdvancedExperienceLanguage = "DE"
+	// AppClipAdvancedExperienceLanguageEl is for Greek.
+	AppClipAdvancedExperienceLanguageEl AppClipAdvancedExperienceLanguage = "EL"
+	// AppClipAdvancedExperienceLanguageEn is for English.
+	AppClipAdvancedExperienceLanguageEn AppClipAdvancedExperienceLanguage = "EN"
+	// AppClipAdvancedExperienceLanguageEs is for Spanish.
+	AppClipAdvancedExperienceLanguageEs AppClipAdvancedExperienceLanguage = "ES"
+	// AppClipAdvancedExperienceLanguageFi is for Finnish.
+	AppClipAdvancedExperienceLanguageFi AppClipAdvancedExperienceLanguage = "FI"
+	// AppClipAdvancedExperienceLanguageFr is for French.
+	AppClipAdvancedExperienceLanguageFr AppClipAdvancedExperienceLanguage = "FR"
+	// AppClipAdvancedExperienceLanguageHe is for Hebrew.
+	AppClipAdvancedExperienceLanguageHe AppClipAdvancedExperienceLanguage = "HE"
+	// AppClipAdvancedExperienceLanguageHi is for Hindi.
+	AppClipAdvancedExperienceLanguageHi AppClipAdvancedExperienceLanguage = "HI"
+	// AppClipAdvancedExperienceLanguageHr is for Croatian.
+	AppClipAdvancedExperienceLanguageHr AppClipAdvancedExperienceLanguage = "HR"
+	// AppClipAdvancedExperienceLanguageHu is for Hungarian.
+	AppClipAdvancedExperienceLanguageHu AppClipAdvancedExperienceLanguage = "HU"
+	// AppClipAdvancedExperienceLanguageId is for Indonesian.
+	AppClipAdvancedExperienceLanguageId AppClipAdvancedExperienceLanguage = "ID"
+	// AppClipAdvancedExperienceLanguageIt is for Italian.
+	AppClipAdvancedExperienceLanguageIt AppClipAdvancedExperienceLanguage = "IT"
+	// AppClipAdvancedExperienceLanguageJa is for Japanese.
+	AppClipAdvancedExperienceLanguageJa AppClipAdvancedExperienceLanguage = "JA"
+	// AppClipAdvancedExperienceLanguageKo is for Korean.
+	AppClipAdvancedExperienceLanguageKo AppClipAdvancedExperienceLanguage = "KO"
+	// AppClipAdvancedExperienceLanguageMs is for Malay.
+	AppClipAdvancedExperienceLanguageMs AppClipAdvancedExperienceLanguage = "MS"
+	// AppClipAdvancedExperienceLanguageNl is for Dutch.
+	AppClipAdvancedExperienceLanguageNl AppClipAdvancedExperienceLanguage = "NL"
+	// AppClipAdvancedExperienceLanguageNo is for Norwegian.
+	AppClipAdvancedExperienceLanguageNo AppClipAdvancedExperienceLanguage = "NO"
+	// AppClipAdvancedExperienceLanguagePl is for Polish.
+	AppClipAdvancedExperienceLanguagePl AppClipAdvancedExperienceLanguage = "PL"
+	// AppClipAdvancedExperienceLanguagePt is for Portuguese.
+	AppClipAdvancedExperienceLanguagePt AppClipAdvancedExperienceLanguage = "PT"
+	// AppClipAdvancedExperienceLanguageRo is for Romanian.
+	AppClipAdvancedExperienceLanguageRo AppClipAdvancedExperienceLanguage = "RO"
+	// AppClipAdvancedExperienceLanguageRu is for Russian.
+	AppClipAdvancedExperienceLanguageRu AppClipAdvancedExperienceLanguage = "RU"
+	// AppClipAdvancedExperienceLanguageSk is for Slovak.
+	AppClipAdvancedExperienceLanguageSk AppClipAdvancedExperienceLanguage = "SK"
+	// AppClipAdvancedExperienceLanguageSv is for Swedish.
+	AppClipAdvancedExperienceLanguageSv AppClipAdvancedExperienceLanguage = "SV"
+	// AppClipAdvancedExperienceLanguageTh is for Thai.
+	AppClipAdvancedExperienceLanguageTh AppClipAdvancedExperienceLanguage = "TH"
+	// AppClipAdvancedExperienceLanguageTr is for Turkish.
+	AppClipAdvancedExperienceLanguageTr AppClipAdvancedExperienceLanguage = "TR"
+	// AppClipAdvancedExperienceLanguageUk is for Ukrainian.
+	AppClipAdvancedExperienceLanguageUk AppClipAdvancedExperienceLanguage = "UK"
+	// AppClipAdvancedExperienceLanguageVi is for Vietnamese.
+	AppClipAdvancedExperienceLanguageVi AppClipAdvancedExperienceLanguage = "VI"
+	// AppClipAdvancedExperienceLanguageZh is for Chinese.
+	AppClipAdvancedExperienceLanguageZh AppClipAdvancedExperienceLanguage = "ZH"
+)
+
+var appClipAdvancedExperienceLanguages = []AppClipAdvancedExperienceLanguage{
+	AppClipAdvancedExperienceLanguageAr,
+	AppClipAdvancedExperienceLanguageCa,
+	AppClipAdvancedExperienceLanguageCs,
+	AppClipAdvancedExperienceLanguageDa,
+	AppClipAdvancedExperienceLanguageDe,
+	AppClipAdvancedExperienceLanguageEl,
+	AppClipAdvancedExperienceLanguageEn,
+	AppClipAdvancedExperienceLanguageEs,
+	AppClipAdvancedExperienceLanguageFi,
+	AppClipAdvancedExperienceLanguageFr,
+	AppClipAdvancedExperienceLanguageHe,
+	AppClipAdvancedExperienceLanguageHi,
+	AppClipAdvancedExperienceLanguageHr,
+	AppClipAdvancedExperienceLanguageHu,
+	AppClipAdvancedExperienceLanguageId,
+	AppClipAdvancedExperienceLanguageIt,
+	AppClipAdvancedExperienceLanguageJa,
+	AppClipAdvancedExperienceLanguageKo,
+	AppClipAdvancedExperienceLanguageMs,
+	AppClipAdvancedExperienceLanguageNl,
+	AppClipAdvancedExperienceLanguageNo,
+	AppClipAdvancedExperienceLanguagePl,
+	AppClipAdvancedExperienceLanguagePt,
+	AppClipAdvancedExperienceLanguageRo,
+	AppClipAdvancedExperienceLanguageRu,
+	AppClipAdvancedExperienceLanguageSk,
+	AppClipAdvancedExperienceLanguageSv,
+	AppClipAdvancedExperienceLanguageTh,
+	AppClipAdvancedExperienceLanguageTr,
+	AppClipAdvancedExperienceLanguageUk,
+	AppClipAdvancedExperienceLanguageVi,
+	AppClipAdvancedExperienceLanguageZh,
+}
+
+// IsValid reports whether the app clip advanced experience language is one this package knows about.
+func (v AppClipAdvancedExperienceLanguage) IsValid() bool {
+	for _, value := range appClipAdvancedExperienceLanguages {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppClipAdvancedExperienceLanguage returns s as an AppClipAdvancedExperienceLanguage, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppClipAdvancedExperienceLanguage(s string) (AppClipAdvancedExperienceLanguage, error) {
+	if v := AppClipAdvancedExperienceLanguage(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppClipAdvancedExperienceLanguage", Value: s}
+}
+
+// AppClipAdvancedExperienceStatus defines model for AppClipAdvancedExperienceStatus.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes
+type AppClipAdvancedExperienceStatus string
+
+const (
+	// AppClipAdvancedExperienceStatusReceived is for an experience that App Store Connect received.
+	AppClipAdvancedExperienceStatusReceived AppClipAdvancedExperienceStatus = "RECEIVED"
+	// AppClipAdvancedExperienceStatusDeactivated is for an experience that was removed.
+	AppClipAdvancedExperienceStatusDeactivated AppClipAdvancedExperienceStatus = "DEACTIVATED"
+	// AppClipAdvancedExperienceStatusAppTransferInProgress is for an experience whose app is being transferred.
+	AppClipAdvancedExperienceStatusAppTransferInProgress AppClipAdvancedExperienceStatus = "APP_TRANSFER_IN_PROGRESS"
+)
+
+var appClipAdvancedExperienceStatuses = []AppClipAdvancedExperienceStatus{
+	AppClipAdvancedExperienceStatusReceived,
+	AppClipAdvancedExperienceStatusDeactivated,
+	AppClipAdvancedExperienceStatusAppTransferInProgress,
+}
+
+// IsValid reports whether the app clip advanced experience status is one this package knows about.
+func (v AppClipAdvancedExperienceStatus) IsValid() bool {
+	for _, value := range appClipAdvancedExperienceStatuses {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppClipAdvancedExperienceStatus returns s as an AppClipAdvancedExperienceStatus, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppClipAdvancedExperienceStatus(s string) (AppClipAdvancedExperienceStatus, error) {
+	if v := AppClipAdvancedExperienceStatus(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppClipAdvancedExperienceStatus", Value: s}
+}
+
+// AppClipAdvancedExperiencePlaceStatus defines model for AppClipAdvancedExperiencePlaceStatus.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes
+type AppClipAdvancedExperiencePlaceStatus string
+
+const (
+	// AppClipAdvancedExperiencePlaceStatusPending is for a place that is still being matched.
+	AppClipAdvancedExperiencePlaceStatusPending AppClipAdvancedExperiencePlaceStatus = "PENDING"
+	// AppClipAdvancedExperiencePlaceStatusMatched is for a place that was matched to a place in Apple Maps.
+	AppClipAdvancedExperiencePlaceStatusMatched AppClipAdvancedExperiencePlaceStatus = "MATCHED"
+	// AppClipAdvancedExperiencePlaceStatusNoMatch is for a place that could not be matched to a place in Apple Maps.
+	AppClipAdvancedExperiencePlaceStatusNoMatch AppClipAdvancedExperiencePlaceStatus = "NO_MATCH"
+)
+
+var appClipAdvancedExperiencePlaceStatuses = []AppClipAdvancedExperiencePlaceStatus{
+	AppClipAdvancedExperiencePlaceStatusPending,
+	AppClipAdvancedExperiencePlaceStatusMatched,
+	AppClipAdvancedExperiencePlaceStatusNoMatch,
+}
+
+// IsValid reports whether the app clip advanced experience place status is one this package knows about.
+func (v AppClipAdvancedExperiencePlaceStatus) IsValid() bool {
+	for _, value := range appClipAdvancedExperiencePlaceStatuses {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppClipAdvancedExperiencePlaceStatus returns s as an AppClipAdvancedExperiencePlaceStatus, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppClipAdvancedExperiencePlaceStatus(s string) (AppClipAdvancedExperiencePlaceStatus, error) {
+	if v := AppClipAdvancedExperiencePlaceStatus(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppClipAdvancedExperiencePlaceStatus", Value: s}
+}
+
+// AppClipAdvancedExperiencePlaceRelationship defines model for AppClipAdvancedExperiencePlaceRelationship.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceplace
+type AppClipAdvancedExperiencePlaceRelationship string
+
+const (
+	// AppClipAdvancedExperiencePlaceRelationshipOwner is for a place that you own.
+	AppClipAdvancedExperiencePlaceRelationshipOwner AppClipAdvancedExperiencePlaceRelationship = "OWNER"
+	// AppClipAdvancedExperiencePlaceRelationshipAuthorized is for a place that you are authorized to act for.
+	AppClipAdvancedExperiencePlaceRelationshipAuthorized AppClipAdvancedExperiencePlaceRelationship = "AUTHORIZED"
+	// AppClipAdvancedExperiencePlaceRelationshipOther is for any other relationship to the place.
+	AppClipAdvancedExperiencePlaceRelationshipOther AppClipAdvancedExperiencePlaceRelationship = "OTHER"
+)
+
+var appClipAdvancedExperiencePlaceRelationships = []AppClipAdvancedExperiencePlaceRelationship{
+	AppClipAdvancedExperiencePlaceRelationshipOwner,
+	AppClipAdvancedExperiencePlaceRelationshipAuthorized,
+	AppClipAdvancedExperiencePlaceRelationshipOther,
+}
+
+// IsValid reports whether the app clip advanced experience place relationship is one this package knows about.
+func (v AppClipAdvancedExperiencePlaceRelationship) IsValid() bool {
+	for _, value := range appClipAdvancedExperiencePlaceRelationships {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppClipAdvancedExperiencePlaceRelationship returns s as an AppClipAdvancedExperiencePlaceRelationship, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppClipAdvancedExperiencePlaceRelationship(s string) (AppClipAdvancedExperiencePlaceRelationship, error) {
+	if v := AppClipAdvancedExperiencePlaceRelationship(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppClipAdvancedExperiencePlaceRelationship", Value: s}
+}
+
+// AppClipAdvancedExperiencePlaceMapAction defines model for AppClipAdvancedExperiencePlaceMapAction.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceplace
+type AppClipAdvancedExperiencePlaceMapAction string
+
+const (
+	// AppClipAdvancedExperiencePlaceMapActionBuyTickets is for the buy tickets action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionBuyTickets AppClipAdvancedExperiencePlaceMapAction = "BUY_TICKETS"
+	// AppClipAdvancedExperiencePlaceMapActionViewAvailability is for the view availability action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionViewAvailability AppClipAdvancedExperiencePlaceMapAction = "VIEW_AVAILABILITY"
+	// AppClipAdvancedExperiencePlaceMapActionViewPricing is for the view pricing action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionViewPricing AppClipAdvancedExperiencePlaceMapAction = "VIEW_PRICING"
+	// AppClipAdvancedExperiencePlaceMapActionHotelBookRoom is for the hotel book room action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionHotelBookRoom AppClipAdvancedExperiencePlaceMapAction = "HOTEL_BOOK_ROOM"
+	// AppClipAdvancedExperiencePlaceMapActionParkingReserveParking is for the parking reserve parking action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionParkingReserveParking AppClipAdvancedExperiencePlaceMapAction = "PARKING_RESERVE_PARKING"
+	// AppClipAdvancedExperiencePlaceMapActionRestaurantJoinWaitlist is for the restaurant join waitlist action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionRestaurantJoinWaitlist AppClipAdvancedExperiencePlaceMapAction = "RESTAURANT_JOIN_WAITLIST"
+	// AppClipAdvancedExperiencePlaceMapActionRestaurantOrderDelivery is for the restaurant order delivery action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionRestaurantOrderDelivery AppClipAdvancedExperiencePlaceMapAction = "RESTAURANT_ORDER_DELIVERY"
+	// AppClipAdvancedExperiencePlaceMapActionRestaurantOrderFood is for the restaurant order food action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionRestaurantOrderFood AppClipAdvancedExperiencePlaceMapAction = "RESTAURANT_ORDER_FOOD"
+	// AppClipAdvancedExperiencePlaceMapActionRestaurantOrderTakeout is for the restaurant order takeout action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionRestaurantOrderTakeout AppClipAdvancedExperiencePlaceMapAction = "RESTAURANT_ORDER_TAKEOUT"
+	// AppClipAdvancedExperiencePlaceMapActionRestaurantReservation is for the restaurant reservation action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionRestaurantReservation AppClipAdvancedExperiencePlaceMapAction = "RESTAURANT_RESERVATION"
+	// AppClipAdvancedExperiencePlaceMapActionScheduleAppointment is for the schedule appointment action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionScheduleAppointment AppClipAdvancedExperiencePlaceMapAction = "SCHEDULE_APPOINTMENT"
+	// AppClipAdvancedExperiencePlaceMapActionRestaurantViewMenu is for the restaurant view menu action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionRestaurantViewMenu AppClipAdvancedExperiencePlaceMapAction = "RESTAURANT_VIEW_MENU"
+	// AppClipAdvancedExperiencePlaceMapActionTheaterNowPlaying is for the theater now playing action on the place card.
+	AppClipAdvancedExperiencePlaceMapActionTheaterNowPlaying AppClipAdvancedExperiencePlaceMapAction = "THEATER_NOW_PLAYING"
+)
+
+var appClipAdvancedExperiencePlaceMapActions = []AppClipAdvancedExperiencePlaceMapAction{
+	AppClipAdvancedExperiencePlaceMapActionBuyTickets,
+	AppClipAdvancedExperiencePlaceMapActionViewAvailability,
+	AppClipAdvancedExperiencePlaceMapActionViewPricing,
+	AppClipAdvancedExperiencePlaceMapActionHotelBookRoom,
+	AppClipAdvancedExperiencePlaceMapActionParkingReserveParking,
+	AppClipAdvancedExperiencePlaceMapActionRestaurantJoinWaitlist,
+	AppClipAdvancedExperiencePlaceMapActionRestaurantOrderDelivery,
+	AppClipAdvancedExperiencePlaceMapActionRestaurantOrderFood,
+	AppClipAdvancedExperiencePlaceMapActionRestaurantOrderTakeout,
+	AppClipAdvancedExperiencePlaceMapActionRestaurantReservation,
+	AppClipAdvancedExperiencePlaceMapActionScheduleAppointment,
+	AppClipAdvancedExperiencePlaceMapActionRestaurantViewMenu,
+	AppClipAdvancedExperiencePlaceMapActionTheaterNowPlaying,
+}
+
+// IsValid reports whether the app clip advanced experience place map action is one this package knows about.
+func (v AppClipAdvancedExperiencePlaceMapAction) IsValid() bool {
+	for _, value := range appClipAdvancedExperiencePlaceMapActions {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppClipAdvancedExperiencePlaceMapAction returns s as an AppClipAdvancedExperiencePlaceMapAction, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppClipAdvancedExperiencePlaceMapAction(s string) (AppClipAdvancedExperiencePlaceMapAction, error) {
+	if v := AppClipAdvancedExperiencePlaceMapAction(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppClipAdvancedExperiencePlaceMapAction", Value: s}
+}
+
+// AppClipAdvancedExperience defines model for AppClipAdvancedExperience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience
+type AppClipAdvancedExperience struct {
+	Attributes    *AppClipAdvancedExperienceAttributes    `json:"attributes,omitempty"`
+	ID            string                                  `json:"id"`
+	Links         ResourceLinks                           `json:"links"`
+	Relationships *AppClipAdvancedExperienceRelationships `json:"relationships,omitempty"`
+	Type          string                                  `json:"type"`
+}
+
+// AppClipAdvancedExperienceAttributes defines model for AppClipAdvancedExperience.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/attributes
+type AppClipAdvancedExperienceAttributes struct {
+	Action           *AppClipAction                             `json:"action,omitempty"`
+	BusinessCategory *AppClipAdvancedExperienceBusinessCategory `json:"businessCategory,omitempty"`
+	DefaultLanguage  *AppClipAdvancedExperienceLanguage         `json:"defaultLanguage,omitempty"`
+	IsPoweredBy      *bool                                      `json:"isPoweredBy,omitempty"`
+	Link             *string                                    `json:"link,omitempty"`
+	Place            *AppClipAdvancedExperiencePlace            `json:"place,omitempty"`
+	PlaceStatus      *AppClipAdvancedExperiencePlaceStatus      `json:"placeStatus,omitempty"`
+	Status           *AppClipAdvancedExperienceStatus           `json:"status,omitempty"`
+	Version          *int                                       `json:"version,omitempty"`
+}
+
+// AppClipAdvancedExperienceRelationships defines model for AppClipAdvancedExperience.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperience/relationships
+type AppClipAdvancedExperienceRelationships struct {
+	AppClip       *Relationship      `json:"appClip,omitempty"`
+	HeaderImage   *Relationship      `json:"headerImage,omitempty"`
+	Localizations *PagedRelationship `json:"localizations,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlace defines model for AppClipAdvancedExperiencePlace.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceplace
+type AppClipAdvancedExperiencePlace struct {
+	Categories   []string                                    `json:"categories,omitempty"`
+	DisplayPoint *AppClipAdvancedExperiencePlaceDisplayPoint `json:"displayPoint,omitempty"`
+	HomePage     *string                                     `json:"homePage,omitempty"`
+	MainAddress  *AppClipAdvancedExperiencePlaceMainAddress  `json:"mainAddress,omitempty"`
+	MapAction    *AppClipAdvancedExperiencePlaceMapAction    `json:"mapAction,omitempty"`
+	Names        []string                                    `json:"names,omitempty"`
+	PhoneNumber  *AppClipAdvancedExperiencePlacePhoneNumber  `json:"phoneNumber,omitempty"`
+	PlaceID      *string                                     `json:"placeId,omitempty"`
+	Relationship *AppClipAdvancedExperiencePlaceRelationship `json:"relationship,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlaceDisplayPoint defines model for AppClipAdvancedExperiencePlace.DisplayPoint
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceplace/displaypoint
+type AppClipAdvancedExperiencePlaceDisplayPoint struct {
+	Coordinates *AppClipAdvancedExperiencePlaceCoordinates `json:"coordinates,omitempty"`
+	Source      *string                                    `json:"source,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlaceCoordinates defines model for AppClipAdvancedExperiencePlace.DisplayPoint.Coordinates
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceplace/displaypoint/coordinates
+type AppClipAdvancedExperiencePlaceCoordinates struct {
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlaceMainAddress defines model for AppClipAdvancedExperiencePlace.MainAddress
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceplace/mainaddress
+type AppClipAdvancedExperiencePlaceMainAddress struct {
+	FullAddress       *string                                          `json:"fullAddress,omitempty"`
+	StructuredAddress *AppClipAdvancedExperiencePlaceStructuredAddress `json:"structuredAddress,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlaceStructuredAddress defines model for AppClipAdvancedExperiencePlace.MainAddress.StructuredAddress
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceplace/mainaddress/structuredaddress
+type AppClipAdvancedExperiencePlaceStructuredAddress struct {
+	CountryCode   *string  `json:"countryCode,omitempty"`
+	Floor         *string  `json:"floor,omitempty"`
+	Locality      *string  `json:"locality,omitempty"`
+	Neighborhood  *string  `json:"neighborhood,omitempty"`
+	PostalCode    *string  `json:"postalCode,omitempty"`
+	StateProvince *string  `json:"stateProvince,omitempty"`
+	StreetAddress []string `json:"streetAddress,omitempty"`
+}
+
+// AppClipAdvancedExperiencePlacePhoneNumber defines model for AppClipAdvancedExperiencePlace.PhoneNumber
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceplace/phonenumber
+type AppClipAdvancedExperiencePlacePhoneNumber struct {
+	Intent *string `json:"intent,omitempty"`
+	Number *string `json:"number,omitempty"`
+	Type   *string `json:"type,omitempty"`
+}
+
+// AppClipAdvancedExperienceResponse defines model for AppClipAdvancedExperienceResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceresponse
+type AppClipAdvancedExperienceResponse struct {
+	Data     AppClipAdvancedExperience                   `json:"data"`
+	Included []AppClipAdvancedExperienceResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                               `json:"links"`
+}
+
+// AppClipAdvancedExperiencesResponse defines model for AppClipAdvancedExperiencesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencesresponse
+type AppClipAdvancedExperiencesResponse struct {
+	Data     []AppClipAdvancedExperience                 `json:"data"`
+	Included []AppClipAdvancedExperienceResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                          `json:"links"`
+	Meta     *PagingInformation                          `json:"meta,omitempty"`
+}
+
+// AppClipAdvancedExperienceResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppClipAdvancedExperienceResponse or AppClipAdvancedExperiencesResponse.
+type AppClipAdvancedExperienceResponseIncluded included
+
+// AppClipAdvancedExperienceLocalization defines model for AppClipAdvancedExperienceLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencelocalization
+type AppClipAdvancedExperienceLocalization struct {
+	Attributes *AppClipAdvancedExperienceLocalizationAttributes `json:"attributes,omitempty"`
+	ID         string                                           `json:"id"`
+	Links      ResourceLinks                                    `json:"links"`
+	Type       string                                           `json:"type"`
+}
+
+// AppClipAdvancedExperienceLocalizationAttributes defines model for AppClipAdvancedExperienceLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencelocalization/attributes
+type AppClipAdvancedExperienceLocalizationAttributes struct {
+	Language *AppClipAdvancedExperienceLanguage `json:"language,omitempty"`
+	Subtitle *string                            `json:"subtitle,omitempty"`
+	Title    *string                            `json:"title,omitempty"`
+}
+
+// NewAppClipAdvancedExperienceLocalization models the parameters for a localization created inline
+// with an advanced App Clip experience.
+type NewAppClipAdvancedExperienceLocalization struct {
+	Language AppClipAdvancedExperienceLanguage
+	Subtitle *string
+	Title    *string
+}
+
+type appClipAdvancedExperienceLocalizationInlineCreate struct {
+	Attributes appClipAdvancedExperienceLocalizationInlineCreateAttributes `json:"attributes"`
+	ID         string                                                      `json:"id"`
+	Type       string                                                      `json:"type"`
+}
+
+type appClipAdvancedExperienceLocalizationInlineCreateAttributes struct {
+	Language AppClipAdvancedExperienceLanguage `json:"language"`
+	Subtitle *string                           `json:"subtitle,omitempty"`
+	Title    *string                           `json:"title,omitempty"`
+}
+
+func (l NewAppClipAdvancedExperienceLocalization) inlineCreate(index int) appClipAdvancedExperienceLocalizationInlineCreate {
+	return appClipAdvancedExperienceLocalizationInlineCreate{
+		Attributes: appClipAdvancedExperienceLocalizationInlineCreateAttributes{
+			Language: l.Language,
+			Subtitle: l.Subtitle,
+			Title:    l.Title,
+		},
+		ID:   fmt.Sprintf("${new-localization-%d}", index),
+		Type: "appClipAdvancedExperienceLocalizations",
+	}
+}
+
+func newAppClipAdvancedExperienceLocalizations(localizations []NewAppClipAdvancedExperienceLocalization) ([]appClipAdvancedExperienceLocalizationInlineCreate, []string) {
+	inline := make([]appClipAdvancedExperienceLocalizationInlineCreate, len(localizations))
+	ids := make([]string, len(localizations))
+
+	for i, localization := range localizations {
+		localization := localization.inlineCreate(i)
+		inline[i] = localization
+		ids[i] = localization.ID
+	}
+
+	return inline, ids
+}
+
+// appClipAdvancedExperienceCreateRequest defines model for AppClipAdvancedExperienceCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencecreaterequest/data
+type appClipAdvancedExperienceCreateRequest struct {
+	Attributes    AppClipAdvancedExperienceCreateRequestAttributes    `json:"attributes"`
+	Relationships appClipAdvancedExperienceCreateRequestRelationships `json:"relationships"`
+	Type          string                                              `json:"type"`
+}
+
+// AppClipAdvancedExperienceCreateRequestAttributes are attributes for AppClipAdvancedExperienceCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencecreaterequest/data/attributes
+type AppClipAdvancedExperienceCreateRequestAttributes struct {
+	Action           *AppClipAction                             `json:"action,omitempty"`
+	BusinessCategory *AppClipAdvancedExperienceBusinessCategory `json:"businessCategory,omitempty"`
+	DefaultLanguage  AppClipAdvancedExperienceLanguage          `json:"defaultLanguage"`
+	IsPoweredBy      bool                                       `json:"isPoweredBy"`
+	Link             string                                     `json:"link"`
+	Place            *AppClipAdvancedExperiencePlace            `json:"place,omitempty"`
+}
+
+// appClipAdvancedExperienceCreateRequestRelationships are relationships for AppClipAdvancedExperienceCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperiencecreaterequest/data/relationships
+type appClipAdvancedExperienceCreateRequestRelationships struct {
+	AppClip       relationshipDeclaration      `json:"appClip"`
+	HeaderImage   relationshipDeclaration      `json:"headerImage"`
+	Localizations pagedRelationshipDeclaration `json:"localizations"`
+}
+
+// appClipAdvancedExperienceUpdateRequest defines model for AppClipAdvancedExperienceUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceupdaterequest/data
+type appClipAdvancedExperienceUpdateRequest struct {
+	Attributes    *AppClipAdvancedExperienceUpdateRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                                               `json:"id"`
+	Relationships *appClipAdvancedExperienceUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                               `json:"type"`
+}
+
+// AppClipAdvancedExperienceUpdateRequestAttributes are attributes for AppClipAdvancedExperienceUpdateRequest
+//
+// Set Removed to true to deactivate the experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceupdaterequest/data/attributes
+type AppClipAdvancedExperienceUpdateRequestAttributes struct {
+	Action           *AppClipAction                             `json:"action,omitempty"`
+	BusinessCategory *AppClipAdvancedExperienceBusinessCategory `json:"businessCategory,omitempty"`
+	DefaultLanguage  *AppClipAdvancedExperienceLanguage         `json:"defaultLanguage,omitempty"`
+	IsPoweredBy      *bool                                      `json:"isPoweredBy,omitempty"`
+	Place            *AppClipAdvancedExperiencePlace            `json:"place,omitempty"`
+	Removed          *bool                                      `json:"removed,omitempty"`
+}
+
+// appClipAdvancedExperienceUpdateRequestRelationships are relationships for AppClipAdvancedExperienceUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceupdaterequest/data/relationships
+type appClipAdvancedExperienceUpdateRequestRelationships struct {
+	HeaderImage   *relationshipDeclaration      `json:"headerImage,omitempty"`
+	Localizations *pagedRelationshipDeclaration `json:"localizations,omitempty"`
+}
+
+// AppClipAdvancedExperienceImage defines model for AppClipAdvancedExperienceImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimage
+type AppClipAdvancedExperienceImage struct {
+	Attributes *AppClipAdvancedExperienceImageAttributes `json:"attributes,omitempty"`
+	ID         string                                    `json:"id"`
+	Links      ResourceLinks                             `json:"links"`
+	Type       string                                    `json:"type"`
+}
+
+// AppClipAdvancedExperienceImageAttributes defines model for AppClipAdvancedExperienceImage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimage/attributes
+type AppClipAdvancedExperienceImageAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	SourceFileChecksum *string             `json:"sourceFileChecksum,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// AppClipAdvancedExperienceImageResponse defines model for AppClipAdvancedExperienceImageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimageresponse
+type AppClipAdvancedExperienceImageResponse struct {
+	Data  AppClipAdvancedExperienceImage `json:"data"`
+	Links DocumentLinks                  `json:"links"`
+}
+
+// appClipAdvancedExperienceImageCreateRequest defines model for AppClipAdvancedExperienceImageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimagecreaterequest/data
+type appClipAdvancedExperienceImageCreateRequest struct {
+	Attributes appClipAdvancedExperienceImageCreateRequestAttributes `json:"attributes"`
+	Type       string                                                `json:"type"`
+}
+
+// appClipAdvancedExperienceImageCreateRequestAttributes are attributes for AppClipAdvancedExperienceImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimagecreaterequest/data/attributes
+type appClipAdvancedExperienceImageCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// appClipAdvancedExperienceImageUpdateRequest defines model for AppClipAdvancedExperienceImageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimageupdaterequest/data
+type appClipAdvancedExperienceImageUpdateRequest struct {
+	Attributes *appClipAdvancedExperienceImageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                 `json:"id"`
+	Type       string                                                 `json:"type"`
+}
+
+// appClipAdvancedExperienceImageUpdateRequestAttributes are attributes for AppClipAdvancedExperienceImageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipadvancedexperienceimageupdaterequest/data/attributes
+type appClipAdvancedExperienceImageUpdateRequestAttributes struct {
+	SourceFileChecksum *string `json:"sourceFileChecksum,omitempty"`
+	Uploaded           *bool   `json:"uploaded,omitempty"`
+}
+
+// ListAdvancedExperiencesForAppClipQuery are query options for ListAdvancedExperiencesForAppClip
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_advanced_app_clip_experiences_for_an_app_clip
+type ListAdvancedExperiencesForAppClipQuery struct {
+	FieldsAppClipAdvancedExperiences []string `url:"fields[appClipAdvancedExperiences],omitempty"`
+	FilterAction                     []string `url:"filter[action],omitempty"`
+	FilterPlaceStatus                []string `url:"filter[placeStatus],omitempty"`
+	FilterStatus                     []string `url:"filter[status],omitempty"`
+	Include                          []string `url:"include,omitempty"`
+	Limit                            int      `url:"limit,omitempty"`
+	Cursor                           string   `url:"cursor,omitempty"`
+}
+
+// GetAppClipAdvancedExperienceQuery are query options for GetAppClipAdvancedExperience
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_advanced_app_clip_experience_information
+type GetAppClipAdvancedExperienceQuery struct {
+	FieldsAppClipAdvancedExperienceImages        []string `url:"fields[appClipAdvancedExperienceImages],omitempty"`
+	FieldsAppClipAdvancedExperienceLocalizations []string `url:"fields[appClipAdvancedExperienceLocalizations],omitempty"`
+	FieldsAppClipAdvancedExperiences             []string `url:"fields[appClipAdvancedExperiences],omitempty"`
+	Include                                      []string `url:"include,omitempty"`
+	LimitLocalizations                           int      `url:"limit[localizations],omitempty"`
+}
+
+// GetAppClipAdvancedExperienceImageQuery are query options for GetAppClipAdvancedExperienceImage
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_header_image_of_an_advanced_app_clip_experience
+type GetAppClipAdvancedExperienceImageQuery struct {
+	FieldsAppClipAdvancedExperienceImages []string `url:"fields[appClipAdvancedExperienceImages],omitempty"`
+}
+
+// ListAdvancedExperiencesForAppClip lists the advanced App Clip experiences of an App Clip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_advanced_app_clip_experiences_for_an_app_clip
+func (s *AppsService) ListAdvancedExperiencesForAppClip(ctx context.Context, id string, params *ListAdvancedExperiencesForAppClipQuery) (*AppClipAdvancedExperiencesResponse, *Response, error) {
+	return Get[AppClipAdvancedExperiencesResponse](ctx, s.client, fmt.Sprintf("appClips/%s/appClipAdvancedExperiences", id), params)
+}
+
+// GetAppClipAdvancedExperience gets information about an advanced App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_advanced_app_clip_experience_information
+func (s *AppsService) GetAppClipAdvancedExperience(ctx context.Context, id string, params *GetAppClipAdvancedExperienceQuery) (*AppClipAdvancedExperienceResponse, *Response, error) {
+	return Get[AppClipAdvancedExperienceResponse](ctx, s.client, fmt.Sprintf("appClipAdvancedExperiences/%s", id), params)
+}
+
+// CreateAppClipAdvancedExperience creates an advanced App Clip experience for an invocation URL, along with its localized card metadata.
+// The header image must be uploaded first, see UploadAppClipAdvancedExperienceImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_advanced_app_clip_experience
+func (s *AppsService) CreateAppClipAdvancedExperience(ctx context.Context, attributes AppClipAdvancedExperienceCreateRequestAttributes, appClipID string, headerImageID string, localizations []NewAppClipAdvancedExperienceLocalization) (*AppClipAdvancedExperienceResponse, *Response, error) {
+	newLocalizations, localizationIDs := newAppClipAdvancedExperienceLocalizations(localizations)
+	req := appClipAdvancedExperienceCreateRequest{
+		Attributes: attributes,
+		Relationships: appClipAdvancedExperienceCreateRequestRelationships{
+			AppClip:       *newRelationshipDeclaration(&appClipID, "appClips"),
+			HeaderImage:   *newRelationshipDeclaration(&headerImageID, "appClipAdvancedExperienceImages"),
+			Localizations: newPagedRelationshipDeclaration(localizationIDs, "appClipAdvancedExperienceLocalizations"),
+		},
+		Type: "appClipAdvancedExperiences",
+	}
+	res := new(AppClipAdvancedExperienceResponse)
+	resp, err := s.client.post(ctx, "appClipAdvancedExperiences", newRequestBodyWithIncluded(req, newLocalizations), res)
+
+	return res, resp, err
+}
+
+// UpdateAppClipAdvancedExperience updates an advanced App Clip experience. If localizations are provided, they replace
+// the existing localized card metadata.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_advanced_app_clip_experience
+func (s *AppsService) UpdateAppClipAdvancedExperience(ctx context.Context, id string, attributes *AppClipAdvancedExperienceUpdateRequestAttributes, headerImageID *string, localizations []NewAppClipAdvancedExperienceLocalization) (*AppClipAdvancedExperienceResponse, *Response, error) {
+	req := appClipAdvancedExperienceUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "appClipAdvancedExperiences",
+	}
+
+	newLocalizations, localizationIDs := newAppClipAdvancedExperienceLocalizations(localizations)
+
+	if headerImageID != nil || len(localizations) > 0 {
+		req.Relationships = &appClipAdvancedExperienceUpdateRequestRelationships{
+			HeaderImage: newRelationshipDeclaration(headerImageID, "appClipAdvancedExperienceImages"),
+		}
+
+		if len(localizations) > 0 {
+			relationships := newPagedRelationshipDeclaration(localizationIDs, "appClipAdvancedExperienceLocalizations")
+			req.Relationships.Localizations = &relationships
+		}
+	}
+
+	var included interface{}
+	if len(newLocalizations) > 0 {
+		included = newLocalizations
+	}
+
+	url := fmt.Sprintf("appClipAdvancedExperiences/%s", id)
+	res := new(AppClipAdvancedExperienceResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBodyWithIncluded(req, included), res)
+
+	return res, resp, err
+}
+
+// GetAppClipAdvancedExperienceImage gets information about the header image of an advanced App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_header_image_of_an_advanced_app_clip_experience
+func (s *AppsService) GetAppClipAdvancedExperienceImage(ctx context.Context, id string, params *GetAppClipAdvancedExperienceImageQuery) (*AppClipAdvancedExperienceImageResponse, *Response, error) {
+	return Get[AppClipAdvancedExperienceImageResponse](ctx, s.client, fmt.Sprintf("appClipAdvancedExperienceImages/%s", id), params)
+}
+
+// CreateAppClipAdvancedExperienceImage reserves a header image for an advanced App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_advanced_app_clip_experience_image
+func (s *AppsService) CreateAppClipAdvancedExperienceImage(ctx context.Context, fileName string, fileSize int64) (*AppClipAdvancedExperienceImageResponse, *Response, error) {
+	req := appClipAdvancedExperienceImageCreateRequest{
+		Attributes: appClipAdvancedExperienceImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Type: "appClipAdvancedExperienceImages",
+	}
+	res := new(AppClipAdvancedExperienceImageResponse)
+	resp, err := s.client.post(ctx, "appClipAdvancedExperienceImages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitAppClipAdvancedExperienceImage commits the header image of an advanced App Clip experience after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/commit_an_advanced_app_clip_experience_image
+func (s *AppsService) CommitAppClipAdvancedExperienceImage(ctx context.Context, id string, uploaded *bool, sourceFileChecksum *string) (*AppClipAdvancedExperienceImageResponse, *Response, error) {
+	req := appClipAdvancedExperienceImageUpdateRequest{
+		ID:   id,
+		Type: "appClipAdvancedExperienceImages",
+	}
+
+	if uploaded != nil || sourceFileChecksum != nil {
+		req.Attributes = &appClipAdvancedExperienceImageUpdateRequestAttributes{
+			Uploaded:           uploaded,
+			SourceFileChecksum: sourceFileChecksum,
+		}
+	}
+
+	url := fmt.Sprintf("appClipAdvancedExperienceImages/%s", id)
+	res := new(AppClipAdvancedExperienceImageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UploadAppClipAdvancedExperienceImage reserves, uploads and commits a header image for an advanced App Clip experience in one call.
+func (s *AppsService) UploadAppClipAdvancedExperienceImage(ctx context.Context, fileName string, file io.ReadSeeker) (*AppClipAdvancedExperienceImageResponse, *Response, error) {
+	fileSize, err := sizeOfFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation, resp, err := s.CreateAppClipAdvancedExperienceImage(ctx, fileName, fileSize)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var ops []UploadOperation
+	if reservation.Data.Attributes != nil {
+		ops = reservation.Data.Attributes.UploadOperations
+	}
+
+	checksum, err := s.client.UploadWithChecksum(ctx, ops, file)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	res, resp, err := s.CommitAppClipAdvancedExperienceImage(ctx, reservation.Data.ID, Bool(true), &checksum)
+	if err == nil && res.Data.Attributes != nil {
+		err = VerifyAssetChecksum(checksum, res.Data.Attributes.SourceFileChecksum, res.Data.Attributes.AssetDeliveryState)
+	}
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppClipAdvancedExperienceResponseIncluded.
+func (i *AppClipAdvancedExperienceResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppClip returns the AppClip stored within, if one is present.
+func (i *AppClipAdvancedExperienceResponseIncluded) AppClip() *AppClip {
+	return extractIncludedAppClip(i.inner)
+}
+
+// AppClipAdvancedExperienceImage returns the AppClipAdvancedExperienceImage stored within, if one is present.
+func (i *AppClipAdvancedExperienceResponseIncluded) AppClipAdvancedExperienceImage() *AppClipAdvancedExperienceImage {
+	return extractIncludedAppClipAdvancedExperienceImage(i.inner)
+}
+
+// AppClipAdvancedExperienceLocalization returns the AppClipAdvancedExperienceLocalization stored within, if one is present.
+func (i *AppClipAdvancedExperienceResponseIncluded) AppClipAdvancedExperienceLocalization() *AppClipAdvancedExperienceLocalization {
+	return extractIncludedAppClipAdvancedExperienceLocalization(i.inner)
+}
@@ -0,0 +1,219 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAdvancedExperiencesForAppClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperiencesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListAdvancedExperiencesForAppClip(ctx, "10", &ListAdvancedExperiencesForAppClipQuery{})
+	})
+}
+
+func TestGetAppClipAdvancedExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppClipAdvancedExperience(ctx, "10", &GetAppClipAdvancedExperienceQuery{})
+	})
+}
+
+func TestGetAppClipAdvancedExperienceIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appClips"},{"type":"appClipAdvancedExperienceImages"},{"type":"appClipAdvancedExperienceLocalizations"}]}`, func(ctx context.Context, client *Client) {
+		experience, _, err := client.Apps.GetAppClipAdvancedExperience(ctx, "10", &GetAppClipAdvancedExperienceQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, experience.Included)
+
+		assert.NotNil(t, experience.Included[0].AppClip())
+		assert.NotNil(t, experience.Included[1].AppClipAdvancedExperienceImage())
+		assert.NotNil(t, experience.Included[2].AppClipAdvancedExperienceLocalization())
+
+		assert.Nil(t, experience.Included[0].AppClipAdvancedExperienceImage())
+		assert.Nil(t, experience.Included[0].AppClipAdvancedExperienceLocalization())
+		assert.Nil(t, experience.Included[1].AppClip())
+	})
+}
+
+func TestCreateAppClipAdvancedExperience(t *testing.T) {
+	t.Parallel()
+
+	category := AppClipAdvancedExperienceBusinessCategoryFoodAndDrink
+	action := AppClipActionOpen
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppClipAdvancedExperience(ctx, AppClipAdvancedExperienceCreateRequestAttributes{
+			Action:           &action,
+			BusinessCategory: &category,
+			DefaultLanguage:  AppClipAdvancedExperienceLanguageEn,
+			Link:             "https://example.com/clip",
+			Place: &AppClipAdvancedExperiencePlace{
+				Names: []string{"Example Cafe"},
+			},
+		}, "10", "11", []NewAppClipAdvancedExperienceLocalization{
+			{Language: AppClipAdvancedExperienceLanguageEn, Title: String("Order ahead")},
+		})
+	})
+}
+
+func TestUpdateAppClipAdvancedExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAppClipAdvancedExperience(ctx, "10", &AppClipAdvancedExperienceUpdateRequestAttributes{
+			Removed: Bool(true),
+		}, String("11"), []NewAppClipAdvancedExperienceLocalization{
+			{Language: AppClipAdvancedExperienceLanguageFr, Subtitle: String("Commander")},
+		})
+	})
+}
+
+func TestUpdateAppClipAdvancedExperienceAttributesOnly(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAppClipAdvancedExperience(ctx, "10", &AppClipAdvancedExperienceUpdateRequestAttributes{
+			IsPoweredBy: Bool(true),
+		}, nil, nil)
+	})
+}
+
+func TestGetAppClipAdvancedExperienceImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppClipAdvancedExperienceImage(ctx, "10", &GetAppClipAdvancedExperienceImageQuery{})
+	})
+}
+
+func TestCreateAppClipAdvancedExperienceImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppClipAdvancedExperienceImage(ctx, "header.png", 20)
+	})
+}
+
+func TestCommitAppClipAdvancedExperienceImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipAdvancedExperienceImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CommitAppClipAdvancedExperienceImage(ctx, "10", Bool(true), String("10"))
+	})
+}
+
+func TestUploadAppClipAdvancedExperienceImage(t *testing.T) {
+	t.Parallel()
+
+	want := &AppClipAdvancedExperienceImageResponse{
+		Data: AppClipAdvancedExperienceImage{
+			ID:   "10",
+			Type: "appClipAdvancedExperienceImages",
+		},
+	}
+
+	testEndpointWithResponse(t, `{"data":{"id":"10","type":"appClipAdvancedExperienceImages"}}`, want, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppClipAdvancedExperienceImage(ctx, "header.png", bytes.NewReader([]byte("header")))
+	})
+}
+
+func TestUploadAppClipAdvancedExperienceImageError(t *testing.T) {
+	t.Parallel()
+
+	testEndpointExpectingError(t, `{"data":`, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppClipAdvancedExperienceImage(ctx, "header.png", bytes.NewReader([]byte("header")))
+	})
+}
+
+func TestParseAppClipAdvancedExperienceBusinessCategory(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppClipAdvancedExperienceBusinessCategory(string(AppClipAdvancedExperienceBusinessCategoryAutomotive))
+	assert.NoError(t, err)
+	assert.Equal(t, AppClipAdvancedExperienceBusinessCategoryAutomotive, got)
+
+	_, err = ParseAppClipAdvancedExperienceBusinessCategory("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppClipAdvancedExperienceBusinessCategory", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAppClipAdvancedExperienceLanguage(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppClipAdvancedExperienceLanguage(string(AppClipAdvancedExperienceLanguageAr))
+	assert.NoError(t, err)
+	assert.Equal(t, AppClipAdvancedExperienceLanguageAr, got)
+
+	_, err = ParseAppClipAdvancedExperienceLanguage("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppClipAdvancedExperienceLanguage", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAppClipAdvancedExperienceStatus(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppClipAdvancedExperienceStatus(string(AppClipAdvancedExperienceStatusReceived))
+	assert.NoError(t, err)
+	assert.Equal(t, AppClipAdvancedExperienceStatusReceived, got)
+
+	_, err = ParseAppClipAdvancedExperienceStatus("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppClipAdvancedExperienceStatus", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAppClipAdvancedExperiencePlaceStatus(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppClipAdvancedExperiencePlaceStatus(string(AppClipAdvancedExperiencePlaceStatusPending))
+	assert.NoError(t, err)
+	assert.Equal(t, AppClipAdvancedExperiencePlaceStatusPending, got)
+
+	_, err = ParseAppClipAdvancedExperiencePlaceStatus("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppClipAdvancedExperiencePlaceStatus", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAppClipAdvancedExperiencePlaceRelationship(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppClipAdvancedExperiencePlaceRelationship(string(AppClipAdvancedExperiencePlaceRelationshipOwner))
+	assert.NoError(t, err)
+	assert.Equal(t, AppClipAdvancedExperiencePlaceRelationshipOwner, got)
+
+	_, err = ParseAppClipAdvancedExperiencePlaceRelationship("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppClipAdvancedExperiencePlaceRelationship", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAppClipAdvancedExperiencePlaceMapAction(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppClipAdvancedExperiencePlaceMapAction(string(AppClipAdvancedExperiencePlaceMapActionBuyTickets))
+	assert.NoError(t, err)
+	assert.Equal(t, AppClipAdvancedExperiencePlaceMapActionBuyTickets, got)
+
+	_, err = ParseAppClipAdvancedExperiencePlaceMapAction("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppClipAdvancedExperiencePlaceMapAction", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,771 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// AppClipAction defines model for AppClipAction.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipaction
+type AppClipAction string
+
+const (
+	// AppClipActionOpen is an action for opening an App Clip.
+	AppClipActionOpen AppClipAction = "OPEN"
+	// AppClipActionPlay is an action for playing an App Clip.
+	AppClipActionPlay AppClipAction = "PLAY"
+	// AppClipActionView is an action for viewing an App Clip.
+	AppClipActionView AppClipAction = "VIEW"
+)
+
+var appClipActions = []AppClipAction{
+	AppClipActionOpen,
+	AppClipActionPlay,
+	AppClipActionView,
+}
+
+// IsValid reports whether the app clip action is one this package knows about.
+func (v AppClipAction) IsValid() bool {
+	for _, value := range appClipActions {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppClipAction returns s as an AppClipAction, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppClipAction(s string) (AppClipAction, error) {
+	if v := AppClipAction(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppClipAction", Value: s}
+}
+
+// AppClip defines model for AppClip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclip
+type AppClip struct {
+	Attributes    *AppClipAttributes    `json:"attributes,omitempty"`
+	ID            string                `json:"id"`
+	Links         ResourceLinks         `json:"links"`
+	Relationships *AppClipRelationships `json:"relationships,omitempty"`
+	Type          string                `json:"type"`
+}
+
+// AppClipAttributes defines model for AppClip.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclip/attributes
+type AppClipAttributes struct {
+	BundleID *string `json:"bundleId,omitempty"`
+}
+
+// AppClipRelationships defines model for AppClip.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclip/relationships
+type AppClipRelationships struct {
+	App                        *Relationship      `json:"app,omitempty"`
+	AppClipAdvancedExperiences *PagedRelationship `json:"appClipAdvancedExperiences,omitempty"`
+	AppClipDefaultExperiences  *PagedRelationship `json:"appClipDefaultExperiences,omitempty"`
+}
+
+// AppClipResponse defines model for AppClipResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipresponse
+type AppClipResponse struct {
+	Data     AppClip                   `json:"data"`
+	Included []AppClipResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks             `json:"links"`
+}
+
+// AppClipsResponse defines model for AppClipsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipsresponse
+type AppClipsResponse struct {
+	Data     []AppClip                 `json:"data"`
+	Included []AppClipResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks        `json:"links"`
+	Meta     *PagingInformation        `json:"meta,omitempty"`
+}
+
+// AppClipResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppClipResponse or AppClipsResponse.
+type AppClipResponseIncluded included
+
+// AppClipDefaultExperience defines model for AppClipDefaultExperience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperience
+type AppClipDefaultExperience struct {
+	Attributes    *AppClipDefaultExperienceAttributes    `json:"attributes,omitempty"`
+	ID            string                                 `json:"id"`
+	Links         ResourceLinks                          `json:"links"`
+	Relationships *AppClipDefaultExperienceRelationships `json:"relationships,omitempty"`
+	Type          string                                 `json:"type"`
+}
+
+// AppClipDefaultExperienceAttributes defines model for AppClipDefaultExperience.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperience/attributes
+type AppClipDefaultExperienceAttributes struct {
+	Action *AppClipAction `json:"action,omitempty"`
+}
+
+// AppClipDefaultExperienceRelationships defines model for AppClipDefaultExperience.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperience/relationships
+type AppClipDefaultExperienceRelationships struct {
+	AppClip                               *Relationship      `json:"appClip,omitempty"`
+	AppClipAppStoreReviewDetail           *Relationship      `json:"appClipAppStoreReviewDetail,omitempty"`
+	AppClipDefaultExperienceLocalizations *PagedRelationship `json:"appClipDefaultExperienceLocalizations,omitempty"`
+	ReleaseWithAppStoreVersion            *Relationship      `json:"releaseWithAppStoreVersion,omitempty"`
+}
+
+// AppClipDefaultExperienceResponse defines model for AppClipDefaultExperienceResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperienceresponse
+type AppClipDefaultExperienceResponse struct {
+	Data     AppClipDefaultExperience                   `json:"data"`
+	Included []AppClipDefaultExperienceResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                              `json:"links"`
+}
+
+// AppClipDefaultExperiencesResponse defines model for AppClipDefaultExperiencesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencesresponse
+type AppClipDefaultExperiencesResponse struct {
+	Data     []AppClipDefaultExperience                 `json:"data"`
+	Included []AppClipDefaultExperienceResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                         `json:"links"`
+	Meta     *PagingInformation                         `json:"meta,omitempty"`
+}
+
+// AppClipDefaultExperienceResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppClipDefaultExperienceResponse or AppClipDefaultExperiencesResponse.
+type AppClipDefaultExperienceResponseIncluded included
+
+// appClipDefaultExperienceCreateRequest defines model for AppClipDefaultExperienceCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencecreaterequest/data
+type appClipDefaultExperienceCreateRequest struct {
+	Attributes    *appClipDefaultExperienceCreateRequestAttributes   `json:"attributes,omitempty"`
+	Relationships appClipDefaultExperienceCreateRequestRelationships `json:"relationships"`
+	Type          string                                             `json:"type"`
+}
+
+// appClipDefaultExperienceCreateRequestAttributes are attributes for AppClipDefaultExperienceCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencecreaterequest/data/attributes
+type appClipDefaultExperienceCreateRequestAttributes struct {
+	Action *AppClipAction `json:"action,omitempty"`
+}
+
+// appClipDefaultExperienceCreateRequestRelationships are relationships for AppClipDefaultExperienceCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencecreaterequest/data/relationships
+type appClipDefaultExperienceCreateRequestRelationships struct {
+	AppClip                          relationshipDeclaration  `json:"appClip"`
+	AppClipDefaultExperienceTemplate *relationshipDeclaration `json:"appClipDefaultExperienceTemplate,omitempty"`
+	ReleaseWithAppStoreVersion       *relationshipDeclaration `json:"releaseWithAppStoreVersion,omitempty"`
+}
+
+// appClipDefaultExperienceUpdateRequest defines model for AppClipDefaultExperienceUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperienceupdaterequest/data
+type appClipDefaultExperienceUpdateRequest struct {
+	Attributes    *appClipDefaultExperienceCreateRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                                              `json:"id"`
+	Relationships *appClipDefaultExperienceUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                              `json:"type"`
+}
+
+// appClipDefaultExperienceUpdateRequestRelationships are relationships for AppClipDefaultExperienceUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperienceupdaterequest/data/relationships
+type appClipDefaultExperienceUpdateRequestRelationships struct {
+	ReleaseWithAppStoreVersion *relationshipDeclaration `json:"releaseWithAppStoreVersion,omitempty"`
+}
+
+// AppClipDefaultExperienceLocalization defines model for AppClipDefaultExperienceLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalization
+type AppClipDefaultExperienceLocalization struct {
+	Attributes    *AppClipDefaultExperienceLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                             `json:"id"`
+	Links         ResourceLinks                                      `json:"links"`
+	Relationships *AppClipDefaultExperienceLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                             `json:"type"`
+}
+
+// AppClipDefaultExperienceLocalizationAttributes defines model for AppClipDefaultExperienceLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalization/attributes
+type AppClipDefaultExperienceLocalizationAttributes struct {
+	Locale   *string `json:"locale,omitempty"`
+	Subtitle *string `json:"subtitle,omitempty"`
+}
+
+// AppClipDefaultExperienceLocalizationRelationships defines model for AppClipDefaultExperienceLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalization/relationships
+type AppClipDefaultExperienceLocalizationRelationships struct {
+	AppClipDefaultExperience *Relationship `json:"appClipDefaultExperience,omitempty"`
+	AppClipHeaderImage       *Relationship `json:"appClipHeaderImage,omitempty"`
+}
+
+// AppClipDefaultExperienceLocalizationResponse defines model for AppClipDefaultExperienceLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationresponse
+type AppClipDefaultExperienceLocalizationResponse struct {
+	Data     AppClipDefaultExperienceLocalization                   `json:"data"`
+	Included []AppClipDefaultExperienceLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                          `json:"links"`
+}
+
+// AppClipDefaultExperienceLocalizationsResponse defines model for AppClipDefaultExperienceLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationsresponse
+type AppClipDefaultExperienceLocalizationsResponse struct {
+	Data     []AppClipDefaultExperienceLocalization                 `json:"data"`
+	Included []AppClipDefaultExperienceLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                     `json:"links"`
+	Meta     *PagingInformation                                     `json:"meta,omitempty"`
+}
+
+// AppClipDefaultExperienceLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppClipDefaultExperienceLocalizationResponse or AppClipDefaultExperienceLocalizationsResponse.
+type AppClipDefaultExperienceLocalizationResponseIncluded included
+
+// appClipDefaultExperienceLocalizationCreateRequest defines model for AppClipDefaultExperienceLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationcreaterequest/data
+type appClipDefaultExperienceLocalizationCreateRequest struct {
+	Attributes    appClipDefaultExperienceLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships appClipDefaultExperienceLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                         `json:"type"`
+}
+
+// appClipDefaultExperienceLocalizationCreateRequestAttributes are attributes for AppClipDefaultExperienceLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationcreaterequest/data/attributes
+type appClipDefaultExperienceLocalizationCreateRequestAttributes struct {
+	Locale   string  `json:"locale"`
+	Subtitle *string `json:"subtitle,omitempty"`
+}
+
+// appClipDefaultExperienceLocalizationCreateRequestRelationships are relationships for AppClipDefaultExperienceLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationcreaterequest/data/relationships
+type appClipDefaultExperienceLocalizationCreateRequestRelationships struct {
+	AppClipDefaultExperience relationshipDeclaration `json:"appClipDefaultExperience"`
+}
+
+// appClipDefaultExperienceLocalizationUpdateRequest defines model for AppClipDefaultExperienceLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationupdaterequest/data
+type appClipDefaultExperienceLocalizationUpdateRequest struct {
+	Attributes *appClipDefaultExperienceLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                       `json:"id"`
+	Type       string                                                       `json:"type"`
+}
+
+// appClipDefaultExperienceLocalizationUpdateRequestAttributes are attributes for AppClipDefaultExperienceLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipdefaultexperiencelocalizationupdaterequest/data/attributes
+type appClipDefaultExperienceLocalizationUpdateRequestAttributes struct {
+	Subtitle *string `json:"subtitle,omitempty"`
+}
+
+// AppClipHeaderImage defines model for AppClipHeaderImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimage
+type AppClipHeaderImage struct {
+	Attributes    *AppClipHeaderImageAttributes    `json:"attributes,omitempty"`
+	ID            string                           `json:"id"`
+	Links         ResourceLinks                    `json:"links"`
+	Relationships *AppClipHeaderImageRelationships `json:"relationships,omitempty"`
+	Type          string                           `json:"type"`
+}
+
+// AppClipHeaderImageAttributes defines model for AppClipHeaderImage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimage/attributes
+type AppClipHeaderImageAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	SourceFileChecksum *string             `json:"sourceFileChecksum,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// AppClipHeaderImageRelationships defines model for AppClipHeaderImage.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimage/relationships
+type AppClipHeaderImageRelationships struct {
+	AppClipDefaultExperienceLocalization *Relationship `json:"appClipDefaultExperienceLocalization,omitempty"`
+}
+
+// AppClipHeaderImageResponse defines model for AppClipHeaderImageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimageresponse
+type AppClipHeaderImageResponse struct {
+	Data  AppClipHeaderImage `json:"data"`
+	Links DocumentLinks      `json:"links"`
+}
+
+// appClipHeaderImageCreateRequest defines model for AppClipHeaderImageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimagecreaterequest/data
+type appClipHeaderImageCreateRequest struct {
+	Attributes    appClipHeaderImageCreateRequestAttributes    `json:"attributes"`
+	Relationships appClipHeaderImageCreateRequestRelationships `json:"relationships"`
+	Type          string                                       `json:"type"`
+}
+
+// appClipHeaderImageCreateRequestAttributes are attributes for AppClipHeaderImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimagecreaterequest/data/attributes
+type appClipHeaderImageCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// appClipHeaderImageCreateRequestRelationships are relationships for AppClipHeaderImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimagecreaterequest/data/relationships
+type appClipHeaderImageCreateRequestRelationships struct {
+	AppClipDefaultExperienceLocalization relationshipDeclaration `json:"appClipDefaultExperienceLocalization"`
+}
+
+// appClipHeaderImageUpdateRequest defines model for AppClipHeaderImageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimageupdaterequest/data
+type appClipHeaderImageUpdateRequest struct {
+	Attributes *appClipHeaderImageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                     `json:"id"`
+	Type       string                                     `json:"type"`
+}
+
+// appClipHeaderImageUpdateRequestAttributes are attributes for AppClipHeaderImageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appclipheaderimageupdaterequest/data/attributes
+type appClipHeaderImageUpdateRequestAttributes struct {
+	SourceFileChecksum *string `json:"sourceFileChecksum,omitempty"`
+	Uploaded           *bool   `json:"uploaded,omitempty"`
+}
+
+// ListAppClipsForAppQuery are query options for ListAppClipsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_clips_for_an_app
+type ListAppClipsForAppQuery struct {
+	FieldsAppClips                  []string `url:"fields[appClips],omitempty"`
+	FieldsAppClipDefaultExperiences []string `url:"fields[appClipDefaultExperiences],omitempty"`
+	FilterBundleID                  []string `url:"filter[bundleId],omitempty"`
+	Include                         []string `url:"include,omitempty"`
+	Limit                           int      `url:"limit,omitempty"`
+	LimitAppClipDefaultExperiences  int      `url:"limit[appClipDefaultExperiences],omitempty"`
+	Cursor                          string   `url:"cursor,omitempty"`
+}
+
+// GetAppClipQuery are query options for GetAppClip
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_clip_information
+type GetAppClipQuery struct {
+	FieldsAppClips                  []string `url:"fields[appClips],omitempty"`
+	FieldsAppClipDefaultExperiences []string `url:"fields[appClipDefaultExperiences],omitempty"`
+	Include                         []string `url:"include,omitempty"`
+	LimitAppClipDefaultExperiences  int      `url:"limit[appClipDefaultExperiences],omitempty"`
+}
+
+// ListDefaultExperiencesForAppClipQuery are query options for ListDefaultExperiencesForAppClip
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_default_app_clip_experiences_for_an_app_clip
+type ListDefaultExperiencesForAppClipQuery struct {
+	ExistsReleaseWithAppStoreVersion            []string `url:"exists[releaseWithAppStoreVersion],omitempty"`
+	FieldsAppClipDefaultExperiences             []string `url:"fields[appClipDefaultExperiences],omitempty"`
+	FieldsAppClipDefaultExperienceLocalizations []string `url:"fields[appClipDefaultExperienceLocalizations],omitempty"`
+	FieldsAppStoreVersions                      []string `url:"fields[appStoreVersions],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+	Limit                                       int      `url:"limit,omitempty"`
+	LimitAppClipDefaultExperienceLocalizations  int      `url:"limit[appClipDefaultExperienceLocalizations],omitempty"`
+	Cursor                                      string   `url:"cursor,omitempty"`
+}
+
+// GetAppClipDefaultExperienceQuery are query options for GetAppClipDefaultExperience
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_default_app_clip_experience_information
+type GetAppClipDefaultExperienceQuery struct {
+	FieldsAppClipDefaultExperiences             []string `url:"fields[appClipDefaultExperiences],omitempty"`
+	FieldsAppClipDefaultExperienceLocalizations []string `url:"fields[appClipDefaultExperienceLocalizations],omitempty"`
+	FieldsAppClips                              []string `url:"fields[appClips],omitempty"`
+	FieldsAppStoreVersions                      []string `url:"fields[appStoreVersions],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+	LimitAppClipDefaultExperienceLocalizations  int      `url:"limit[appClipDefaultExperienceLocalizations],omitempty"`
+}
+
+// ListLocalizationsForAppClipDefaultExperienceQuery are query options for ListLocalizationsForAppClipDefaultExperience
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_default_app_clip_experience
+type ListLocalizationsForAppClipDefaultExperienceQuery struct {
+	FieldsAppClipDefaultExperienceLocalizations []string `url:"fields[appClipDefaultExperienceLocalizations],omitempty"`
+	FieldsAppClipHeaderImages                   []string `url:"fields[appClipHeaderImages],omitempty"`
+	FilterLocale                                []string `url:"filter[locale],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+	Limit                                       int      `url:"limit,omitempty"`
+	Cursor                                      string   `url:"cursor,omitempty"`
+}
+
+// GetAppClipDefaultExperienceLocalizationQuery are query options for GetAppClipDefaultExperienceLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_localized_default_app_clip_experience_information
+type GetAppClipDefaultExperienceLocalizationQuery struct {
+	FieldsAppClipDefaultExperienceLocalizations []string `url:"fields[appClipDefaultExperienceLocalizations],omitempty"`
+	FieldsAppClipHeaderImages                   []string `url:"fields[appClipHeaderImages],omitempty"`
+	Include                                     []string `url:"include,omitempty"`
+}
+
+// GetAppClipHeaderImageQuery are query options for GetAppClipHeaderImage and GetHeaderImageForAppClipDefaultExperienceLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_clip_header_image_information
+type GetAppClipHeaderImageQuery struct {
+	FieldsAppClipHeaderImages []string `url:"fields[appClipHeaderImages],omitempty"`
+	Include                   []string `url:"include,omitempty"`
+}
+
+// ListAppClipsForApp lists the App Clips of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_clips_for_an_app
+func (s *AppsService) ListAppClipsForApp(ctx context.Context, id string, params *ListAppClipsForAppQuery) (*AppClipsResponse, *Response, error) {
+	return Get[AppClipsResponse](ctx, s.client, fmt.Sprintf("apps/%s/appClips", id), params)
+}
+
+// GetAppClip gets information about an App Clip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_clip_information
+func (s *AppsService) GetAppClip(ctx context.Context, id string, params *GetAppClipQuery) (*AppClipResponse, *Response, error) {
+	return Get[AppClipResponse](ctx, s.client, fmt.Sprintf("appClips/%s", id), params)
+}
+
+// ListDefaultExperiencesForAppClip lists the default App Clip experiences of an App Clip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_default_app_clip_experiences_for_an_app_clip
+func (s *AppsService) ListDefaultExperiencesForAppClip(ctx context.Context, id string, params *ListDefaultExperiencesForAppClipQuery) (*AppClipDefaultExperiencesResponse, *Response, error) {
+	return Get[AppClipDefaultExperiencesResponse](ctx, s.client, fmt.Sprintf("appClips/%s/appClipDefaultExperiences", id), params)
+}
+
+// GetAppClipDefaultExperience gets information about a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_default_app_clip_experience_information
+func (s *AppsService) GetAppClipDefaultExperience(ctx context.Context, id string, params *GetAppClipDefaultExperienceQuery) (*AppClipDefaultExperienceResponse, *Response, error) {
+	return Get[AppClipDefaultExperienceResponse](ctx, s.client, fmt.Sprintf("appClipDefaultExperiences/%s", id), params)
+}
+
+// CreateAppClipDefaultExperience creates the default App Clip experience of an App Clip. Provide a templateID to
+// copy the metadata of an existing default experience, and an appStoreVersionID to release the experience with that version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_default_app_clip_experience
+func (s *AppsService) CreateAppClipDefaultExperience(ctx context.Context, appClipID string, action *AppClipAction, templateID *string, appStoreVersionID *string) (*AppClipDefaultExperienceResponse, *Response, error) {
+	req := appClipDefaultExperienceCreateRequest{
+		Relationships: appClipDefaultExperienceCreateRequestRelationships{
+			AppClip:                          *newRelationshipDeclaration(&appClipID, "appClips"),
+			AppClipDefaultExperienceTemplate: newRelationshipDeclaration(templateID, "appClipDefaultExperiences"),
+			ReleaseWithAppStoreVersion:       newRelationshipDeclaration(appStoreVersionID, "appStoreVersions"),
+		},
+		Type: "appClipDefaultExperiences",
+	}
+
+	if action != nil {
+		req.Attributes = &appClipDefaultExperienceCreateRequestAttributes{
+			Action: action,
+		}
+	}
+
+	res := new(AppClipDefaultExperienceResponse)
+	resp, err := s.client.post(ctx, "appClipDefaultExperiences", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppClipDefaultExperience updates the action of a default App Clip experience or the App Store version it is released with.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_default_app_clip_experience
+func (s *AppsService) UpdateAppClipDefaultExperience(ctx context.Context, id string, action *AppClipAction, appStoreVersionID *string) (*AppClipDefaultExperienceResponse, *Response, error) {
+	req := appClipDefaultExperienceUpdateRequest{
+		ID:   id,
+		Type: "appClipDefaultExperiences",
+	}
+
+	if action != nil {
+		req.Attributes = &appClipDefaultExperienceCreateRequestAttributes{
+			Action: action,
+		}
+	}
+
+	if appStoreVersionID != nil {
+		req.Relationships = &appClipDefaultExperienceUpdateRequestRelationships{
+			ReleaseWithAppStoreVersion: newRelationshipDeclaration(appStoreVersionID, "appStoreVersions"),
+		}
+	}
+
+	url := fmt.Sprintf("appClipDefaultExperiences/%s", id)
+	res := new(AppClipDefaultExperienceResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppClipDefaultExperience deletes a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_default_app_clip_experience
+func (s *AppsService) DeleteAppClipDefaultExperience(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appClipDefaultExperiences/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListLocalizationsForAppClipDefaultExperience lists the localized App Clip card metadata of a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_a_default_app_clip_experience
+func (s *AppsService) ListLocalizationsForAppClipDefaultExperience(ctx context.Context, id string, params *ListLocalizationsForAppClipDefaultExperienceQuery) (*AppClipDefaultExperienceLocalizationsResponse, *Response, error) {
+	return Get[AppClipDefaultExperienceLocalizationsResponse](ctx, s.client, fmt.Sprintf("appClipDefaultExperiences/%s/appClipDefaultExperienceLocalizations", id), params)
+}
+
+// GetAppClipDefaultExperienceLocalization gets the localized App Clip card metadata of a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_localized_default_app_clip_experience_information
+func (s *AppsService) GetAppClipDefaultExperienceLocalization(ctx context.Context, id string, params *GetAppClipDefaultExperienceLocalizationQuery) (*AppClipDefaultExperienceLocalizationResponse, *Response, error) {
+	return Get[AppClipDefaultExperienceLocalizationResponse](ctx, s.client, fmt.Sprintf("appClipDefaultExperienceLocalizations/%s", id), params)
+}
+
+// CreateAppClipDefaultExperienceLocalization adds localized App Clip card metadata to a default App Clip experience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_the_localized_metadata_for_a_default_app_clip_experience
+func (s *AppsService) CreateAppClipDefaultExperienceLocalization(ctx context.Context, locale string, subtitle *string, defaultExperienceID string) (*AppClipDefaultExperienceLocalizationResponse, *Response, error) {
+	req := appClipDefaultExperienceLocalizationCreateRequest{
+		Attributes: appClipDefaultExperienceLocalizationCreateRequestAttributes{
+			Locale:   locale,
+			Subtitle: subtitle,
+		},
+		Relationships: appClipDefaultExperienceLocalizationCreateRequestRelationships{
+			AppClipDefaultExperience: *newRelationshipDeclaration(&defaultExperienceID, "appClipDefaultExperiences"),
+		},
+		Type: "appClipDefaultExperienceLocalizations",
+	}
+	res := new(AppClipDefaultExperienceLocalizationResponse)
+	resp, err := s.client.post(ctx, "appClipDefaultExperienceLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppClipDefaultExperienceLocalization updates the localized subtitle of an App Clip card.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_the_localized_metadata_for_a_default_app_clip_experience
+func (s *AppsService) UpdateAppClipDefaultExperienceLocalization(ctx context.Context, id string, subtitle *string) (*AppClipDefaultExperienceLocalizationResponse, *Response, error) {
+	req := appClipDefaultExperienceLocalizationUpdateRequest{
+		ID:   id,
+		Type: "appClipDefaultExperienceLocalizations",
+	}
+
+	if subtitle != nil {
+		req.Attributes = &appClipDefaultExperienceLocalizationUpdateRequestAttributes{
+			Subtitle: subtitle,
+		}
+	}
+
+	url := fmt.Sprintf("appClipDefaultExperienceLocalizations/%s", id)
+	res := new(AppClipDefaultExperienceLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppClipDefaultExperienceLocalization deletes localized App Clip card metadata.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_the_localized_metadata_for_a_default_app_clip_experience
+func (s *AppsService) DeleteAppClipDefaultExperienceLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appClipDefaultExperienceLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GetHeaderImageForAppClipDefaultExperienceLocalization gets the header image of a localized App Clip card.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_header_image_for_a_default_app_clip_experience_localization
+func (s *AppsService) GetHeaderImageForAppClipDefaultExperienceLocalization(ctx context.Context, id string, params *GetAppClipHeaderImageQuery) (*AppClipHeaderImageResponse, *Response, error) {
+	return Get[AppClipHeaderImageResponse](ctx, s.client, fmt.Sprintf("appClipDefaultExperienceLocalizations/%s/appClipHeaderImage", id), params)
+}
+
+// GetAppClipHeaderImage gets information about an App Clip card header image and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_header_image_for_an_app_clip
+func (s *AppsService) GetAppClipHeaderImage(ctx context.Context, id string, params *GetAppClipHeaderImageQuery) (*AppClipHeaderImageResponse, *Response, error) {
+	return Get[AppClipHeaderImageResponse](ctx, s.client, fmt.Sprintf("appClipHeaderImages/%s", id), params)
+}
+
+// CreateAppClipHeaderImage reserves an App Clip card header image for a localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_clip_header_image
+func (s *AppsService) CreateAppClipHeaderImage(ctx context.Context, fileName string, fileSize int64, localizationID string) (*AppClipHeaderImageResponse, *Response, error) {
+	req := appClipHeaderImageCreateRequest{
+		Attributes: appClipHeaderImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Relationships: appClipHeaderImageCreateRequestRelationships{
+			AppClipDefaultExperienceLocalization: *newRelationshipDeclaration(&localizationID, "appClipDefaultExperienceLocalizations"),
+		},
+		Type: "appClipHeaderImages",
+	}
+	res := new(AppClipHeaderImageResponse)
+	resp, err := s.client.post(ctx, "appClipHeaderImages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitAppClipHeaderImage commits an App Clip card header image after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_clip_header_image
+func (s *AppsService) CommitAppClipHeaderImage(ctx context.Context, id string, uploaded *bool, sourceFileChecksum *string) (*AppClipHeaderImageResponse, *Response, error) {
+	req := appClipHeaderImageUpdateRequest{
+		ID:   id,
+		Type: "appClipHeaderImages",
+	}
+
+	if uploaded != nil || sourceFileChecksum != nil {
+		req.Attributes = &appClipHeaderImageUpdateRequestAttributes{
+			Uploaded:           uploaded,
+			SourceFileChecksum: sourceFileChecksum,
+		}
+	}
+
+	url := fmt.Sprintf("appClipHeaderImages/%s", id)
+	res := new(AppClipHeaderImageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppClipHeaderImage deletes the header image of an App Clip card.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_clip_header_image
+func (s *AppsService) DeleteAppClipHeaderImage(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appClipHeaderImages/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UploadAppClipHeaderImage reserves, uploads and commits a header image for a localized App Clip card in one call.
+func (s *AppsService) UploadAppClipHeaderImage(ctx context.Context, fileName string, file io.ReadSeeker, localizationID string) (*AppClipHeaderImageResponse, *Response, error) {
+	fileSize, err := sizeOfFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation, resp, err := s.CreateAppClipHeaderImage(ctx, fileName, fileSize, localizationID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var ops []UploadOperation
+	if reservation.Data.Attributes != nil {
+		ops = reservation.Data.Attributes.UploadOperations
+	}
+
+	checksum, err := s.client.UploadWithChecksum(ctx, ops, file)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	res, resp, err := s.CommitAppClipHeaderImage(ctx, reservation.Data.ID, Bool(true), &checksum)
+	if err == nil && res.Data.Attributes != nil {
+		err = VerifyAssetChecksum(checksum, res.Data.Attributes.SourceFileChecksum, res.Data.Attributes.AssetDeliveryState)
+	}
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppClipResponseIncluded.
+func (i *AppClipResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *AppClipResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// AppClipDefaultExperience returns the AppClipDefaultExperience stored within, if one is present.
+func (i *AppClipResponseIncluded) AppClipDefaultExperience() *AppClipDefaultExperience {
+	return extractIncludedAppClipDefaultExperience(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppClipDefaultExperienceResponseIncluded.
+func (i *AppClipDefaultExperienceResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppClip returns the AppClip stored within, if one is present.
+func (i *AppClipDefaultExperienceResponseIncluded) AppClip() *AppClip {
+	return extractIncludedAppClip(i.inner)
+}
+
+// AppClipDefaultExperienceLocalization returns the AppClipDefaultExperienceLocalization stored within, if one is present.
+func (i *AppClipDefaultExperienceResponseIncluded) AppClipDefaultExperienceLocalization() *AppClipDefaultExperienceLocalization {
+	return extractIncludedAppClipDefaultExperienceLocalization(i.inner)
+}
+
+// AppStoreVersion returns the AppStoreVersion stored within, if one is present.
+func (i *AppClipDefaultExperienceResponseIncluded) AppStoreVersion() *AppStoreVersion {
+	return extractIncludedAppStoreVersion(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppClipDefaultExperienceLocalizationResponseIncluded.
+func (i *AppClipDefaultExperienceLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppClipDefaultExperience returns the AppClipDefaultExperience stored within, if one is present.
+func (i *AppClipDefaultExperienceLocalizationResponseIncluded) AppClipDefaultExperience() *AppClipDefaultExperience {
+	return extractIncludedAppClipDefaultExperience(i.inner)
+}
+
+// AppClipHeaderImage returns the AppClipHeaderImage stored within, if one is present.
+func (i *AppClipDefaultExperienceLocalizationResponseIncluded) AppClipHeaderImage() *AppClipHeaderImage {
+	return extractIncludedAppClipHeaderImage(i.inner)
+}
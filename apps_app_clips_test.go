@@ -0,0 +1,254 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAppClipsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListAppClipsForApp(ctx, "10", &ListAppClipsForAppQuery{})
+	})
+}
+
+func TestGetAppClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppClip(ctx, "10", &GetAppClipQuery{})
+	})
+}
+
+func TestGetAppClipIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"appClipDefaultExperiences"}]}`, func(ctx context.Context, client *Client) {
+		clip, _, err := client.Apps.GetAppClip(ctx, "10", &GetAppClipQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, clip.Included)
+
+		assert.NotNil(t, clip.Included[0].App())
+		assert.NotNil(t, clip.Included[1].AppClipDefaultExperience())
+
+		assert.Nil(t, clip.Included[0].AppClipDefaultExperience())
+		assert.Nil(t, clip.Included[1].App())
+	})
+}
+
+func TestListDefaultExperiencesForAppClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperiencesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListDefaultExperiencesForAppClip(ctx, "10", &ListDefaultExperiencesForAppClipQuery{})
+	})
+}
+
+func TestGetAppClipDefaultExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppClipDefaultExperience(ctx, "10", &GetAppClipDefaultExperienceQuery{})
+	})
+}
+
+func TestGetAppClipDefaultExperienceIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appClips"},{"type":"appClipDefaultExperienceLocalizations"},{"type":"appStoreVersions"}]}`, func(ctx context.Context, client *Client) {
+		experience, _, err := client.Apps.GetAppClipDefaultExperience(ctx, "10", &GetAppClipDefaultExperienceQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, experience.Included)
+
+		assert.NotNil(t, experience.Included[0].AppClip())
+		assert.NotNil(t, experience.Included[1].AppClipDefaultExperienceLocalization())
+		assert.NotNil(t, experience.Included[2].AppStoreVersion())
+
+		assert.Nil(t, experience.Included[0].AppClipDefaultExperienceLocalization())
+		assert.Nil(t, experience.Included[0].AppStoreVersion())
+		assert.Nil(t, experience.Included[1].AppClip())
+	})
+}
+
+func TestCreateAppClipDefaultExperience(t *testing.T) {
+	t.Parallel()
+
+	action := AppClipActionOpen
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppClipDefaultExperience(ctx, "10", &action, String("11"), String("12"))
+	})
+}
+
+func TestUpdateAppClipDefaultExperience(t *testing.T) {
+	t.Parallel()
+
+	action := AppClipActionPlay
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAppClipDefaultExperience(ctx, "10", &action, String("12"))
+	})
+}
+
+func TestDeleteAppClipDefaultExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppClipDefaultExperience(ctx, "10")
+	})
+}
+
+func TestListLocalizationsForAppClipDefaultExperience(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListLocalizationsForAppClipDefaultExperience(ctx, "10", &ListLocalizationsForAppClipDefaultExperienceQuery{})
+	})
+}
+
+func TestGetAppClipDefaultExperienceLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppClipDefaultExperienceLocalization(ctx, "10", &GetAppClipDefaultExperienceLocalizationQuery{})
+	})
+}
+
+func TestGetAppClipDefaultExperienceLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appClipDefaultExperiences"},{"type":"appClipHeaderImages"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.Apps.GetAppClipDefaultExperienceLocalization(ctx, "10", &GetAppClipDefaultExperienceLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, localization.Included)
+
+		assert.NotNil(t, localization.Included[0].AppClipDefaultExperience())
+		assert.NotNil(t, localization.Included[1].AppClipHeaderImage())
+
+		assert.Nil(t, localization.Included[0].AppClipHeaderImage())
+		assert.Nil(t, localization.Included[1].AppClipDefaultExperience())
+	})
+}
+
+func TestCreateAppClipDefaultExperienceLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppClipDefaultExperienceLocalization(ctx, "en-US", String("Order ahead"), "10")
+	})
+}
+
+func TestUpdateAppClipDefaultExperienceLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipDefaultExperienceLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAppClipDefaultExperienceLocalization(ctx, "10", String("Order ahead"))
+	})
+}
+
+func TestDeleteAppClipDefaultExperienceLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppClipDefaultExperienceLocalization(ctx, "10")
+	})
+}
+
+func TestGetHeaderImageForAppClipDefaultExperienceLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipHeaderImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetHeaderImageForAppClipDefaultExperienceLocalization(ctx, "10", &GetAppClipHeaderImageQuery{})
+	})
+}
+
+func TestGetAppClipHeaderImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipHeaderImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppClipHeaderImage(ctx, "10", &GetAppClipHeaderImageQuery{})
+	})
+}
+
+func TestCreateAppClipHeaderImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipHeaderImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppClipHeaderImage(ctx, "header.png", 20, "10")
+	})
+}
+
+func TestCommitAppClipHeaderImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppClipHeaderImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CommitAppClipHeaderImage(ctx, "10", Bool(true), String("10"))
+	})
+}
+
+func TestDeleteAppClipHeaderImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppClipHeaderImage(ctx, "10")
+	})
+}
+
+func TestUploadAppClipHeaderImage(t *testing.T) {
+	t.Parallel()
+
+	want := &AppClipHeaderImageResponse{
+		Data: AppClipHeaderImage{
+			Attributes: &AppClipHeaderImageAttributes{UploadOperations: []UploadOperation{}},
+			ID:         "10",
+			Type:       "appClipHeaderImages",
+		},
+	}
+
+	testEndpointWithResponse(t, `{"data":{"id":"10","type":"appClipHeaderImages","attributes":{"uploadOperations":[]}}}`, want, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppClipHeaderImage(ctx, "header.png", bytes.NewReader([]byte("header")), "10")
+	})
+}
+
+func TestUploadAppClipHeaderImageError(t *testing.T) {
+	t.Parallel()
+
+	testEndpointExpectingError(t, `{"data":`, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppClipHeaderImage(ctx, "header.png", bytes.NewReader([]byte("header")), "10")
+	})
+}
+
+func TestParseAppClipAction(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppClipAction(string(AppClipActionOpen))
+	assert.NoError(t, err)
+	assert.Equal(t, AppClipActionOpen, got)
+
+	_, err = ParseAppClipAction("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppClipAction", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,1082 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// AppEventBadge defines model for AppEvent.Attributes.Badge
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes
+type AppEventBadge string
+
+const (
+	// AppEventBadgeLiveEvent is a badge for a live event.
+	AppEventBadgeLiveEvent AppEventBadge = "LIVE_EVENT"
+	// AppEventBadgePremiere is a badge for a premiere.
+	AppEventBadgePremiere AppEventBadge = "PREMIERE"
+	// AppEventBadgeChallenge is a badge for a challenge.
+	AppEventBadgeChallenge AppEventBadge = "CHALLENGE"
+	// AppEventBadgeCompetition is a badge for a competition.
+	AppEventBadgeCompetition AppEventBadge = "COMPETITION"
+	// AppEventBadgeNewSeason is a badge for a new season.
+	AppEventBadgeNewSeason AppEventBadge = "NEW_SEASON"
+	// AppEventBadgeMajorUpdate is a badge for a major update.
+	AppEventBadgeMajorUpdate AppEventBadge = "MAJOR_UPDATE"
+	// AppEventBadgeSpecialEvent is a badge for a special event.
+	AppEventBadgeSpecialEvent AppEventBadge = "SPECIAL_EVENT"
+)
+
+var appEventBadges = []AppEventBadge{
+	AppEventBadgeLiveEvent,
+	AppEventBadgePremiere,
+	AppEventBadgeChallenge,
+	AppEventBadgeCompetition,
+	AppEventBadgeNewSeason,
+	AppEventBadgeMajorUpdate,
+	AppEventBadgeSpecialEvent,
+}
+
+// IsValid reports whether the app event badge is one this package knows about.
+func (v AppEventBadge) IsValid() bool {
+	for _, value := range appEventBadges {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppEventBadge returns s as an AppEventBadge, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppEventBadge(s string) (AppEventBadge, error) {
+	if v := AppEventBadge(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppEventBadge", Value: s}
+}
+
+// AppEventState defines model for AppEvent.Attributes.EventState
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes
+type AppEventState string
+
+const (
+	// AppEventStateDraft is an event state for Draft.
+	AppEventStateDraft AppEventState = "DRAFT"
+	// AppEventStateReadyForReview is an event state for ReadyForReview.
+	AppEventStateReadyForReview AppEventState = "READY_FOR_REVIEW"
+	// AppEventStateWaitingForReview is an event state for WaitingForReview.
+	AppEventStateWaitingForReview AppEventState = "WAITING_FOR_REVIEW"
+	// AppEventStateInReview is an event state for InReview.
+	AppEventStateInReview AppEventState = "IN_REVIEW"
+	// AppEventStateRejected is an event state for Rejected.
+	AppEventStateRejected AppEventState = "REJECTED"
+	// AppEventStateAccepted is an event state for Accepted.
+	AppEventStateAccepted AppEventState = "ACCEPTED"
+	// AppEventStateApproved is an event state for Approved.
+	AppEventStateApproved AppEventState = "APPROVED"
+	// AppEventStatePublished is an event state for Published.
+	AppEventStatePublished AppEventState = "PUBLISHED"
+	// AppEventStatePast is an event state for Past.
+	AppEventStatePast AppEventState = "PAST"
+	// AppEventStateArchived is an event state for Archived.
+	AppEventStateArchived AppEventState = "ARCHIVED"
+)
+
+var appEventStates = []AppEventState{
+	AppEventStateDraft,
+	AppEventStateReadyForReview,
+	AppEventStateWaitingForReview,
+	AppEventStateInReview,
+	AppEventStateRejected,
+	AppEventStateAccepted,
+	AppEventStateApproved,
+	AppEventStatePublished,
+	AppEventStatePast,
+	AppEventStateArchived,
+}
+
+// IsValid reports whether the app event state is one this package knows about.
+func (v AppEventState) IsValid() bool {
+	for _, value := range appEventStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppEventState returns s as an AppEventState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppEventState(s string) (AppEventState, error) {
+	if v := AppEventState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppEventState", Value: s}
+}
+
+// AppEventPriority defines model for AppEvent.Attributes.Priority
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes
+type AppEventPriority string
+
+const (
+	// AppEventPriorityHigh is a priority for High.
+	AppEventPriorityHigh AppEventPriority = "HIGH"
+	// AppEventPriorityNormal is a priority for Normal.
+	AppEventPriorityNormal AppEventPriority = "NORMAL"
+)
+
+var appEventPriorities = []AppEventPriority{
+	AppEventPriorityHigh,
+	AppEventPriorityNormal,
+}
+
+// IsValid reports whether the app event priority is one this package knows about.
+func (v AppEventPriority) IsValid() bool {
+	for _, value := range appEventPriorities {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppEventPriority returns s as an AppEventPriority, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppEventPriority(s string) (AppEventPriority, error) {
+	if v := AppEventPriority(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppEventPriority", Value: s}
+}
+
+// AppEventPurpose defines model for AppEvent.Attributes.Purpose
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes
+type AppEventPurpose string
+
+const (
+	// AppEventPurposeAppropriateForAllUsers is a purpose for AppropriateForAllUsers.
+	AppEventPurposeAppropriateForAllUsers AppEventPurpose = "APPROPRIATE_FOR_ALL_USERS"
+	// AppEventPurposeAttractNewUsers is a purpose for AttractNewUsers.
+	AppEventPurposeAttractNewUsers AppEventPurpose = "ATTRACT_NEW_USERS"
+	// AppEventPurposeKeepActiveUsersInformed is a purpose for KeepActiveUsersInformed.
+	AppEventPurposeKeepActiveUsersInformed AppEventPurpose = "KEEP_ACTIVE_USERS_INFORMED"
+	// AppEventPurposeBringBackLapsedUsers is a purpose for BringBackLapsedUsers.
+	AppEventPurposeBringBackLapsedUsers AppEventPurpose = "BRING_BACK_LAPSED_USERS"
+)
+
+var appEventPurposes = []AppEventPurpose{
+	AppEventPurposeAppropriateForAllUsers,
+	AppEventPurposeAttractNewUsers,
+	AppEventPurposeKeepActiveUsersInformed,
+	AppEventPurposeBringBackLapsedUsers,
+}
+
+// IsValid reports whether the app event purpose is one this package knows about.
+func (v AppEventPurpose) IsValid() bool {
+	for _, value := range appEventPurposes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppEventPurpose returns s as an AppEventPurpose, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppEventPurpose(s string) (AppEventPurpose, error) {
+	if v := AppEventPurpose(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppEventPurpose", Value: s}
+}
+
+// AppEventAssetType defines model for AppEventAssetType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventassettype
+type AppEventAssetType string
+
+const (
+	// AppEventAssetTypeEventCard is an asset shown on the event card.
+	AppEventAssetTypeEventCard AppEventAssetType = "EVENT_CARD"
+	// AppEventAssetTypeEventDetailsPage is an asset shown on the event details page.
+	AppEventAssetTypeEventDetailsPage AppEventAssetType = "EVENT_DETAILS_PAGE"
+)
+
+var appEventAssetTypes = []AppEventAssetType{
+	AppEventAssetTypeEventCard,
+	AppEventAssetTypeEventDetailsPage,
+}
+
+// IsValid reports whether the app event asset type is one this package knows about.
+func (v AppEventAssetType) IsValid() bool {
+	for _, value := range appEventAssetTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppEventAssetType returns s as an AppEventAssetType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppEventAssetType(s string) (AppEventAssetType, error) {
+	if v := AppEventAssetType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppEventAssetType", Value: s}
+}
+
+// IsEditable reports whether an event in this state can still have its metadata and media changed.
+func (s AppEventState) IsEditable() bool {
+	switch s {
+	case AppEventStateDraft, AppEventStateReadyForReview, AppEventStateRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsLive reports whether an event in this state is visible on the App Store.
+func (s AppEventState) IsLive() bool {
+	return s == AppEventStatePublished
+}
+
+// AppEvent defines model for AppEvent.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent
+type AppEvent struct {
+	Attributes    *AppEventAttributes    `json:"attributes,omitempty"`
+	ID            string                 `json:"id"`
+	Links         ResourceLinks          `json:"links"`
+	Relationships *AppEventRelationships `json:"relationships,omitempty"`
+	Type          string                 `json:"type"`
+}
+
+// AppEventAttributes defines model for AppEvent.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes
+type AppEventAttributes struct {
+	ArchivedTerritorySchedules []AppEventTerritorySchedule `json:"archivedTerritorySchedules,omitempty"`
+	Badge                      *AppEventBadge              `json:"badge,omitempty"`
+	DeepLink                   *string                     `json:"deepLink,omitempty"`
+	EventState                 *AppEventState              `json:"eventState,omitempty"`
+	PrimaryLocale              *string                     `json:"primaryLocale,omitempty"`
+	Priority                   *AppEventPriority           `json:"priority,omitempty"`
+	PurchaseRequirement        *string                     `json:"purchaseRequirement,omitempty"`
+	Purpose                    *AppEventPurpose            `json:"purpose,omitempty"`
+	ReferenceName              *string                     `json:"referenceName,omitempty"`
+	TerritorySchedules         []AppEventTerritorySchedule `json:"territorySchedules,omitempty"`
+}
+
+// AppEventTerritorySchedule defines when an event is published, starts and ends in a set of territories.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/attributes/territoryschedules
+type AppEventTerritorySchedule struct {
+	EventEnd     *DateTime `json:"eventEnd,omitempty"`
+	EventStart   *DateTime `json:"eventStart,omitempty"`
+	PublishStart *DateTime `json:"publishStart,omitempty"`
+	Territories  []string  `json:"territories,omitempty"`
+}
+
+// AppEventRelationships defines model for AppEvent.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appevent/relationships
+type AppEventRelationships struct {
+	Localizations *PagedRelationship `json:"localizations,omitempty"`
+}
+
+// AppEventResponse defines model for AppEventResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventresponse
+type AppEventResponse struct {
+	Data     AppEvent               `json:"data"`
+	Included []AppEventLocalization `json:"included,omitempty"`
+	Links    DocumentLinks          `json:"links"`
+}
+
+// AppEventsResponse defines model for AppEventsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventsresponse
+type AppEventsResponse struct {
+	Data     []AppEvent             `json:"data"`
+	Included []AppEventLocalization `json:"included,omitempty"`
+	Links    PagedDocumentLinks     `json:"links"`
+	Meta     *PagingInformation     `json:"meta,omitempty"`
+}
+
+// appEventCreateRequest defines model for AppEventCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventcreaterequest/data
+type appEventCreateRequest struct {
+	Attributes    AppEventCreateRequestAttributes    `json:"attributes"`
+	Relationships appEventCreateRequestRelationships `json:"relationships"`
+	Type          string                             `json:"type"`
+}
+
+// AppEventCreateRequestAttributes are attributes for AppEventCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventcreaterequest/data/attributes
+type AppEventCreateRequestAttributes struct {
+	Badge               *AppEventBadge              `json:"badge,omitempty"`
+	DeepLink            *string                     `json:"deepLink,omitempty"`
+	PrimaryLocale       *string                     `json:"primaryLocale,omitempty"`
+	Priority            *AppEventPriority           `json:"priority,omitempty"`
+	PurchaseRequirement *string                     `json:"purchaseRequirement,omitempty"`
+	Purpose             *AppEventPurpose            `json:"purpose,omitempty"`
+	ReferenceName       string                      `json:"referenceName"`
+	TerritorySchedules  []AppEventTerritorySchedule `json:"territorySchedules,omitempty"`
+}
+
+// appEventCreateRequestRelationships are relationships for AppEventCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventcreaterequest/data/relationships
+type appEventCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// appEventUpdateRequest defines model for AppEventUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventupdaterequest/data
+type appEventUpdateRequest struct {
+	Attributes *AppEventUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                           `json:"id"`
+	Type       string                           `json:"type"`
+}
+
+// AppEventUpdateRequestAttributes are attributes for AppEventUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventupdaterequest/data/attributes
+type AppEventUpdateRequestAttributes struct {
+	Badge               *AppEventBadge              `json:"badge,omitempty"`
+	DeepLink            *string                     `json:"deepLink,omitempty"`
+	PrimaryLocale       *string                     `json:"primaryLocale,omitempty"`
+	Priority            *AppEventPriority           `json:"priority,omitempty"`
+	PurchaseRequirement *string                     `json:"purchaseRequirement,omitempty"`
+	Purpose             *AppEventPurpose            `json:"purpose,omitempty"`
+	ReferenceName       *string                     `json:"referenceName,omitempty"`
+	TerritorySchedules  []AppEventTerritorySchedule `json:"territorySchedules,omitempty"`
+}
+
+// AppEventLocalization defines model for AppEventLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalization
+type AppEventLocalization struct {
+	Attributes    *AppEventLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                             `json:"id"`
+	Links         ResourceLinks                      `json:"links"`
+	Relationships *AppEventLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                             `json:"type"`
+}
+
+// AppEventLocalizationAttributes defines model for AppEventLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalization/attributes
+type AppEventLocalizationAttributes struct {
+	Locale           *string `json:"locale,omitempty"`
+	LongDescription  *string `json:"longDescription,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	ShortDescription *string `json:"shortDescription,omitempty"`
+}
+
+// AppEventLocalizationRelationships defines model for AppEventLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalization/relationships
+type AppEventLocalizationRelationships struct {
+	AppEvent            *Relationship      `json:"appEvent,omitempty"`
+	AppEventScreenshots *PagedRelationship `json:"appEventScreenshots,omitempty"`
+	AppEventVideoClips  *PagedRelationship `json:"appEventVideoClips,omitempty"`
+}
+
+// AppEventLocalizationResponse defines model for AppEventLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationresponse
+type AppEventLocalizationResponse struct {
+	Data     AppEventLocalization                   `json:"data"`
+	Included []AppEventLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                          `json:"links"`
+}
+
+// AppEventLocalizationsResponse defines model for AppEventLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationsresponse
+type AppEventLocalizationsResponse struct {
+	Data     []AppEventLocalization                 `json:"data"`
+	Included []AppEventLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                     `json:"links"`
+	Meta     *PagingInformation                     `json:"meta,omitempty"`
+}
+
+// AppEventLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppEventLocalizationResponse or AppEventLocalizationsResponse.
+type AppEventLocalizationResponseIncluded included
+
+// appEventLocalizationCreateRequest defines model for AppEventLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationcreaterequest/data
+type appEventLocalizationCreateRequest struct {
+	Attributes    appEventLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships appEventLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                         `json:"type"`
+}
+
+// appEventLocalizationCreateRequestAttributes are attributes for AppEventLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationcreaterequest/data/attributes
+type appEventLocalizationCreateRequestAttributes struct {
+	Locale           string  `json:"locale"`
+	LongDescription  *string `json:"longDescription,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	ShortDescription *string `json:"shortDescription,omitempty"`
+}
+
+// appEventLocalizationCreateRequestRelationships are relationships for AppEventLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationcreaterequest/data/relationships
+type appEventLocalizationCreateRequestRelationships struct {
+	AppEvent relationshipDeclaration `json:"appEvent"`
+}
+
+// appEventLocalizationUpdateRequest defines model for AppEventLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationupdaterequest/data
+type appEventLocalizationUpdateRequest struct {
+	Attributes *AppEventLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                       `json:"id"`
+	Type       string                                       `json:"type"`
+}
+
+// AppEventLocalizationUpdateRequestAttributes are attributes for AppEventLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventlocalizationupdaterequest/data/attributes
+type AppEventLocalizationUpdateRequestAttributes struct {
+	LongDescription  *string `json:"longDescription,omitempty"`
+	Name             *string `json:"name,omitempty"`
+	ShortDescription *string `json:"shortDescription,omitempty"`
+}
+
+// AppEventScreenshot defines model for AppEventScreenshot.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshot
+type AppEventScreenshot struct {
+	Attributes    *AppEventScreenshotAttributes    `json:"attributes,omitempty"`
+	ID            string                           `json:"id"`
+	Links         ResourceLinks                    `json:"links"`
+	Relationships *AppEventScreenshotRelationships `json:"relationships,omitempty"`
+	Type          string                           `json:"type"`
+}
+
+// AppEventScreenshotAttributes defines model for AppEventScreenshot.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshot/attributes
+type AppEventScreenshotAttributes struct {
+	AppEventAssetType  *AppEventAssetType  `json:"appEventAssetType,omitempty"`
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	AssetToken         *string             `json:"assetToken,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// AppEventScreenshotRelationships defines model for AppEventScreenshot.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshot/relationships
+type AppEventScreenshotRelationships struct {
+	AppEventLocalization *Relationship `json:"appEventLocalization,omitempty"`
+}
+
+// AppEventScreenshotResponse defines model for AppEventScreenshotResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotresponse
+type AppEventScreenshotResponse struct {
+	Data  AppEventScreenshot `json:"data"`
+	Links DocumentLinks      `json:"links"`
+}
+
+// AppEventScreenshotsResponse defines model for AppEventScreenshotsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotsresponse
+type AppEventScreenshotsResponse struct {
+	Data  []AppEventScreenshot `json:"data"`
+	Links PagedDocumentLinks   `json:"links"`
+	Meta  *PagingInformation   `json:"meta,omitempty"`
+}
+
+// appEventScreenshotCreateRequest defines model for AppEventScreenshotCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotcreaterequest/data
+type appEventScreenshotCreateRequest struct {
+	Attributes    appEventScreenshotCreateRequestAttributes `json:"attributes"`
+	Relationships appEventMediaCreateRequestRelationships   `json:"relationships"`
+	Type          string                                    `json:"type"`
+}
+
+// appEventScreenshotCreateRequestAttributes are attributes for AppEventScreenshotCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotcreaterequest/data/attributes
+type appEventScreenshotCreateRequestAttributes struct {
+	AppEventAssetType AppEventAssetType `json:"appEventAssetType"`
+	FileName          string            `json:"fileName"`
+	FileSize          int64             `json:"fileSize"`
+}
+
+// appEventMediaCreateRequestRelationships are relationships for AppEventScreenshotCreateRequest and AppEventVideoClipCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotcreaterequest/data/relationships
+type appEventMediaCreateRequestRelationships struct {
+	AppEventLocalization relationshipDeclaration `json:"appEventLocalization"`
+}
+
+// appEventScreenshotUpdateRequest defines model for AppEventScreenshotUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotupdaterequest/data
+type appEventScreenshotUpdateRequest struct {
+	Attributes *appEventScreenshotUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                     `json:"id"`
+	Type       string                                     `json:"type"`
+}
+
+// appEventScreenshotUpdateRequestAttributes are attributes for AppEventScreenshotUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventscreenshotupdaterequest/data/attributes
+type appEventScreenshotUpdateRequestAttributes struct {
+	Uploaded *bool `json:"uploaded,omitempty"`
+}
+
+// AppEventVideoClip defines model for AppEventVideoClip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclip
+type AppEventVideoClip struct {
+	Attributes    *AppEventVideoClipAttributes    `json:"attributes,omitempty"`
+	ID            string                          `json:"id"`
+	Links         ResourceLinks                   `json:"links"`
+	Relationships *AppEventVideoClipRelationships `json:"relationships,omitempty"`
+	Type          string                          `json:"type"`
+}
+
+// AppEventVideoClipAttributes defines model for AppEventVideoClip.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclip/attributes
+type AppEventVideoClipAttributes struct {
+	AppEventAssetType    *AppEventAssetType  `json:"appEventAssetType,omitempty"`
+	AssetDeliveryState   *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName             *string             `json:"fileName,omitempty"`
+	FileSize             *int64              `json:"fileSize,omitempty"`
+	PreviewFrameTimeCode *string             `json:"previewFrameTimeCode,omitempty"`
+	PreviewImage         *ImageAsset         `json:"previewImage,omitempty"`
+	UploadOperations     []UploadOperation   `json:"uploadOperations,omitempty"`
+	VideoURL             *string             `json:"videoUrl,omitempty"`
+}
+
+// AppEventVideoClipRelationships defines model for AppEventVideoClip.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclip/relationships
+type AppEventVideoClipRelationships struct {
+	AppEventLocalization *Relationship `json:"appEventLocalization,omitempty"`
+}
+
+// AppEventVideoClipResponse defines model for AppEventVideoClipResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipresponse
+type AppEventVideoClipResponse struct {
+	Data  AppEventVideoClip `json:"data"`
+	Links DocumentLinks     `json:"links"`
+}
+
+// AppEventVideoClipsResponse defines model for AppEventVideoClipsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipsresponse
+type AppEventVideoClipsResponse struct {
+	Data  []AppEventVideoClip `json:"data"`
+	Links PagedDocumentLinks  `json:"links"`
+	Meta  *PagingInformation  `json:"meta,omitempty"`
+}
+
+// appEventVideoClipCreateRequest defines model for AppEventVideoClipCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipcreaterequest/data
+type appEventVideoClipCreateRequest struct {
+	Attributes    appEventVideoClipCreateRequestAttributes `json:"attributes"`
+	Relationships appEventMediaCreateRequestRelationships  `json:"relationships"`
+	Type          string                                   `json:"type"`
+}
+
+// appEventVideoClipCreateRequestAttributes are attributes for AppEventVideoClipCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipcreaterequest/data/attributes
+type appEventVideoClipCreateRequestAttributes struct {
+	AppEventAssetType    AppEventAssetType `json:"appEventAssetType"`
+	FileName             string            `json:"fileName"`
+	FileSize             int64             `json:"fileSize"`
+	PreviewFrameTimeCode *string           `json:"previewFrameTimeCode,omitempty"`
+}
+
+// appEventVideoClipUpdateRequest defines model for AppEventVideoClipUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipupdaterequest/data
+type appEventVideoClipUpdateRequest struct {
+	Attributes *appEventVideoClipUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                    `json:"id"`
+	Type       string                                    `json:"type"`
+}
+
+// appEventVideoClipUpdateRequestAttributes are attributes for AppEventVideoClipUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appeventvideoclipupdaterequest/data/attributes
+type appEventVideoClipUpdateRequestAttributes struct {
+	PreviewFrameTimeCode *string `json:"previewFrameTimeCode,omitempty"`
+	Uploaded             *bool   `json:"uploaded,omitempty"`
+}
+
+// ListAppEventsForAppQuery are query options for ListAppEventsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_in-app_events_for_an_app
+type ListAppEventsForAppQuery struct {
+	FieldsAppEvents             []string `url:"fields[appEvents],omitempty"`
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	FilterEventState            []string `url:"filter[eventState],omitempty"`
+	FilterID                    []string `url:"filter[id],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	LimitLocalizations          int      `url:"limit[localizations],omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// GetAppEventQuery are query options for GetAppEvent
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_event_information
+type GetAppEventQuery struct {
+	FieldsAppEvents             []string `url:"fields[appEvents],omitempty"`
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	LimitLocalizations          int      `url:"limit[localizations],omitempty"`
+}
+
+// ListLocalizationsForAppEventQuery are query options for ListLocalizationsForAppEvent
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_in-app_event
+type ListLocalizationsForAppEventQuery struct {
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	FieldsAppEventScreenshots   []string `url:"fields[appEventScreenshots],omitempty"`
+	FieldsAppEventVideoClips    []string `url:"fields[appEventVideoClips],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	LimitAppEventScreenshots    int      `url:"limit[appEventScreenshots],omitempty"`
+	LimitAppEventVideoClips     int      `url:"limit[appEventVideoClips],omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// GetAppEventLocalizationQuery are query options for GetAppEventLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_event_localization_information
+type GetAppEventLocalizationQuery struct {
+	FieldsAppEventLocalizations []string `url:"fields[appEventLocalizations],omitempty"`
+	FieldsAppEventScreenshots   []string `url:"fields[appEventScreenshots],omitempty"`
+	FieldsAppEventVideoClips    []string `url:"fields[appEventVideoClips],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	LimitAppEventScreenshots    int      `url:"limit[appEventScreenshots],omitempty"`
+	LimitAppEventVideoClips     int      `url:"limit[appEventVideoClips],omitempty"`
+}
+
+// ListScreenshotsForAppEventLocalizationQuery are query options for ListScreenshotsForAppEventLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_screenshots_for_an_in-app_event_localization
+type ListScreenshotsForAppEventLocalizationQuery struct {
+	FieldsAppEventScreenshots []string `url:"fields[appEventScreenshots],omitempty"`
+	Limit                     int      `url:"limit,omitempty"`
+	Cursor                    string   `url:"cursor,omitempty"`
+}
+
+// ListVideoClipsForAppEventLocalizationQuery are query options for ListVideoClipsForAppEventLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_video_clips_for_an_in-app_event_localization
+type ListVideoClipsForAppEventLocalizationQuery struct {
+	FieldsAppEventVideoClips []string `url:"fields[appEventVideoClips],omitempty"`
+	Limit                    int      `url:"limit,omitempty"`
+	Cursor                   string   `url:"cursor,omitempty"`
+}
+
+// GetAppEventScreenshotQuery are query options for GetAppEventScreenshot
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_event_screenshot_information
+type GetAppEventScreenshotQuery struct {
+	FieldsAppEventScreenshots []string `url:"fields[appEventScreenshots],omitempty"`
+}
+
+// GetAppEventVideoClipQuery are query options for GetAppEventVideoClip
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_event_video_clip_information
+type GetAppEventVideoClipQuery struct {
+	FieldsAppEventVideoClips []string `url:"fields[appEventVideoClips],omitempty"`
+}
+
+// ListAppEventsForApp lists the in-app events for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_in-app_events_for_an_app
+func (s *AppsService) ListAppEventsForApp(ctx context.Context, id string, params *ListAppEventsForAppQuery) (*AppEventsResponse, *Response, error) {
+	return Get[AppEventsResponse](ctx, s.client, fmt.Sprintf("apps/%s/appEvents", id), params)
+}
+
+// GetAppEvent gets an in-app event, including its schedule and publishing state.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_event_information
+func (s *AppsService) GetAppEvent(ctx context.Context, id string, params *GetAppEventQuery) (*AppEventResponse, *Response, error) {
+	return Get[AppEventResponse](ctx, s.client, fmt.Sprintf("appEvents/%s", id), params)
+}
+
+// CreateAppEvent creates a draft in-app event for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_in-app_event
+func (s *AppsService) CreateAppEvent(ctx context.Context, attributes AppEventCreateRequestAttributes, appID string) (*AppEventResponse, *Response, error) {
+	req := appEventCreateRequest{
+		Attributes: attributes,
+		Relationships: appEventCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "appEvents",
+	}
+	res := new(AppEventResponse)
+	resp, err := s.client.post(ctx, "appEvents", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppEvent updates the metadata or schedule of an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_in-app_event
+func (s *AppsService) UpdateAppEvent(ctx context.Context, id string, attributes *AppEventUpdateRequestAttributes) (*AppEventResponse, *Response, error) {
+	req := appEventUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "appEvents",
+	}
+	url := fmt.Sprintf("appEvents/%s", id)
+	res := new(AppEventResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppEvent deletes an in-app event that hasn't been published.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_in-app_event
+func (s *AppsService) DeleteAppEvent(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appEvents/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// SubmitAppEventForReview moves a draft in-app event to App Review by creating a review submission for the app,
+// adding the event to it, and submitting it. Once approved, the event is published according to its territory schedules.
+func (s *AppsService) SubmitAppEventForReview(ctx context.Context, appID string, eventID string) (*ReviewSubmissionResponse, *Response, error) {
+	submission, resp, err := s.client.Submission.CreateReviewSubmission(ctx, appID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	_, resp, err = s.client.Submission.CreateReviewSubmissionItem(ctx, submission.Data.ID, ReviewSubmissionItemTarget{
+		AppEventID: &eventID,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return s.client.Submission.SubmitReviewSubmission(ctx, submission.Data.ID)
+}
+
+// ListLocalizationsForAppEvent lists the localized metadata of an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_in-app_event
+func (s *AppsService) ListLocalizationsForAppEvent(ctx context.Context, id string, params *ListLocalizationsForAppEventQuery) (*AppEventLocalizationsResponse, *Response, error) {
+	return Get[AppEventLocalizationsResponse](ctx, s.client, fmt.Sprintf("appEvents/%s/localizations", id), params)
+}
+
+// GetAppEventLocalization gets the localized metadata of an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_event_localization_information
+func (s *AppsService) GetAppEventLocalization(ctx context.Context, id string, params *GetAppEventLocalizationQuery) (*AppEventLocalizationResponse, *Response, error) {
+	return Get[AppEventLocalizationResponse](ctx, s.client, fmt.Sprintf("appEventLocalizations/%s", id), params)
+}
+
+// CreateAppEventLocalization adds localized metadata to an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_in-app_event_localization
+func (s *AppsService) CreateAppEventLocalization(ctx context.Context, locale string, attributes *AppEventLocalizationUpdateRequestAttributes, appEventID string) (*AppEventLocalizationResponse, *Response, error) {
+	req := appEventLocalizationCreateRequest{
+		Attributes: appEventLocalizationCreateRequestAttributes{
+			Locale: locale,
+		},
+		Relationships: appEventLocalizationCreateRequestRelationships{
+			AppEvent: *newRelationshipDeclaration(&appEventID, "appEvents"),
+		},
+		Type: "appEventLocalizations",
+	}
+
+	if attributes != nil {
+		req.Attributes.LongDescription = attributes.LongDescription
+		req.Attributes.Name = attributes.Name
+		req.Attributes.ShortDescription = attributes.ShortDescription
+	}
+
+	res := new(AppEventLocalizationResponse)
+	resp, err := s.client.post(ctx, "appEventLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppEventLocalization updates the localized metadata of an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_in-app_event_localization
+func (s *AppsService) UpdateAppEventLocalization(ctx context.Context, id string, attributes *AppEventLocalizationUpdateRequestAttributes) (*AppEventLocalizationResponse, *Response, error) {
+	req := appEventLocalizationUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "appEventLocalizations",
+	}
+	url := fmt.Sprintf("appEventLocalizations/%s", id)
+	res := new(AppEventLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppEventLocalization deletes the localized metadata of an in-app event.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_in-app_event_localization
+func (s *AppsService) DeleteAppEventLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appEventLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListScreenshotsForAppEventLocalization lists the screenshots of an in-app event localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_screenshots_for_an_in-app_event_localization
+func (s *AppsService) ListScreenshotsForAppEventLocalization(ctx context.Context, id string, params *ListScreenshotsForAppEventLocalizationQuery) (*AppEventScreenshotsResponse, *Response, error) {
+	return Get[AppEventScreenshotsResponse](ctx, s.client, fmt.Sprintf("appEventLocalizations/%s/appEventScreenshots", id), params)
+}
+
+// ListVideoClipsForAppEventLocalization lists the video clips of an in-app event localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_video_clips_for_an_in-app_event_localization
+func (s *AppsService) ListVideoClipsForAppEventLocalization(ctx context.Context, id string, params *ListVideoClipsForAppEventLocalizationQuery) (*AppEventVideoClipsResponse, *Response, error) {
+	return Get[AppEventVideoClipsResponse](ctx, s.client, fmt.Sprintf("appEventLocalizations/%s/appEventVideoClips", id), params)
+}
+
+// GetAppEventScreenshot gets an in-app event screenshot and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_event_screenshot_information
+func (s *AppsService) GetAppEventScreenshot(ctx context.Context, id string, params *GetAppEventScreenshotQuery) (*AppEventScreenshotResponse, *Response, error) {
+	return Get[AppEventScreenshotResponse](ctx, s.client, fmt.Sprintf("appEventScreenshots/%s", id), params)
+}
+
+// CreateAppEventScreenshot reserves a screenshot for an in-app event localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_in-app_event_screenshot
+func (s *AppsService) CreateAppEventScreenshot(ctx context.Context, fileName string, fileSize int64, assetType AppEventAssetType, appEventLocalizationID string) (*AppEventScreenshotResponse, *Response, error) {
+	req := appEventScreenshotCreateRequest{
+		Attributes: appEventScreenshotCreateRequestAttributes{
+			AppEventAssetType: assetType,
+			FileName:          fileName,
+			FileSize:          fileSize,
+		},
+		Relationships: appEventMediaCreateRequestRelationships{
+			AppEventLocalization: *newRelationshipDeclaration(&appEventLocalizationID, "appEventLocalizations"),
+		},
+		Type: "appEventScreenshots",
+	}
+	res := new(AppEventScreenshotResponse)
+	resp, err := s.client.post(ctx, "appEventScreenshots", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitAppEventScreenshot commits an in-app event screenshot after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_in-app_event_screenshot
+func (s *AppsService) CommitAppEventScreenshot(ctx context.Context, id string, uploaded *bool) (*AppEventScreenshotResponse, *Response, error) {
+	req := appEventScreenshotUpdateRequest{
+		ID:   id,
+		Type: "appEventScreenshots",
+	}
+
+	if uploaded != nil {
+		req.Attributes = &appEventScreenshotUpdateRequestAttributes{
+			Uploaded: uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("appEventScreenshots/%s", id)
+	res := new(AppEventScreenshotResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppEventScreenshot deletes an in-app event screenshot.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_in-app_event_screenshot
+func (s *AppsService) DeleteAppEventScreenshot(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appEventScreenshots/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UploadAppEventScreenshot reserves, uploads and commits a screenshot for an in-app event localization.
+func (s *AppsService) UploadAppEventScreenshot(ctx context.Context, fileName string, file io.ReadSeeker, assetType AppEventAssetType, appEventLocalizationID string) (*AppEventScreenshotResponse, *Response, error) {
+	fileSize, _, err := fileChecksum(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation, resp, err := s.CreateAppEventScreenshot(ctx, fileName, fileSize, assetType, appEventLocalizationID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if reservation.Data.Attributes != nil {
+		err = s.client.Upload(ctx, reservation.Data.Attributes.UploadOperations, file)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return s.CommitAppEventScreenshot(ctx, reservation.Data.ID, Bool(true))
+}
+
+// GetAppEventVideoClip gets an in-app event video clip and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_in-app_event_video_clip_information
+func (s *AppsService) GetAppEventVideoClip(ctx context.Context, id string, params *GetAppEventVideoClipQuery) (*AppEventVideoClipResponse, *Response, error) {
+	return Get[AppEventVideoClipResponse](ctx, s.client, fmt.Sprintf("appEventVideoClips/%s", id), params)
+}
+
+// CreateAppEventVideoClip reserves a video clip for an in-app event localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_in-app_event_video_clip
+func (s *AppsService) CreateAppEventVideoClip(ctx context.Context, fileName string, fileSize int64, previewFrameTimeCode *string, assetType AppEventAssetType, appEventLocalizationID string) (*AppEventVideoClipResponse, *Response, error) {
+	req := appEventVideoClipCreateRequest{
+		Attributes: appEventVideoClipCreateRequestAttributes{
+			AppEventAssetType:    assetType,
+			FileName:             fileName,
+			FileSize:             fileSize,
+			PreviewFrameTimeCode: previewFrameTimeCode,
+		},
+		Relationships: appEventMediaCreateRequestRelationships{
+			AppEventLocalization: *newRelationshipDeclaration(&appEventLocalizationID, "appEventLocalizations"),
+		},
+		Type: "appEventVideoClips",
+	}
+	res := new(AppEventVideoClipResponse)
+	resp, err := s.client.post(ctx, "appEventVideoClips", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitAppEventVideoClip commits an in-app event video clip after uploading it, optionally changing its preview frame.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_in-app_event_video_clip
+func (s *AppsService) CommitAppEventVideoClip(ctx context.Context, id string, uploaded *bool, previewFrameTimeCode *string) (*AppEventVideoClipResponse, *Response, error) {
+	req := appEventVideoClipUpdateRequest{
+		ID:   id,
+		Type: "appEventVideoClips",
+	}
+
+	if uploaded != nil || previewFrameTimeCode != nil {
+		req.Attributes = &appEventVideoClipUpdateRequestAttributes{
+			PreviewFrameTimeCode: previewFrameTimeCode,
+			Uploaded:             uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("appEventVideoClips/%s", id)
+	res := new(AppEventVideoClipResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppEventVideoClip deletes an in-app event video clip.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_in-app_event_video_clip
+func (s *AppsService) DeleteAppEventVideoClip(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appEventVideoClips/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UploadAppEventVideoClip reserves, uploads and commits a video clip for an in-app event localization.
+func (s *AppsService) UploadAppEventVideoClip(ctx context.Context, fileName string, file io.ReadSeeker, previewFrameTimeCode *string, assetType AppEventAssetType, appEventLocalizationID string) (*AppEventVideoClipResponse, *Response, error) {
+	fileSize, _, err := fileChecksum(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation, resp, err := s.CreateAppEventVideoClip(ctx, fileName, fileSize, previewFrameTimeCode, assetType, appEventLocalizationID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if reservation.Data.Attributes != nil {
+		err = s.client.Upload(ctx, reservation.Data.Attributes.UploadOperations, file)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return s.CommitAppEventVideoClip(ctx, reservation.Data.ID, Bool(true), nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppEventLocalizationResponseIncluded.
+func (i *AppEventLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppEvent returns the AppEvent stored within, if one is present.
+func (i *AppEventLocalizationResponseIncluded) AppEvent() *AppEvent {
+	return extractIncludedAppEvent(i.inner)
+}
+
+// AppEventScreenshot returns the AppEventScreenshot stored within, if one is present.
+func (i *AppEventLocalizationResponseIncluded) AppEventScreenshot() *AppEventScreenshot {
+	return extractIncludedAppEventScreenshot(i.inner)
+}
+
+// AppEventVideoClip returns the AppEventVideoClip stored within, if one is present.
+func (i *AppEventLocalizationResponseIncluded) AppEventVideoClip() *AppEventVideoClip {
+	return extractIncludedAppEventVideoClip(i.inner)
+}
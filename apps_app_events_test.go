@@ -0,0 +1,364 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppEventStateIsEditable(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, AppEventStateDraft.IsEditable())
+	assert.True(t, AppEventStateRejected.IsEditable())
+	assert.False(t, AppEventStateInReview.IsEditable())
+	assert.False(t, AppEventStatePublished.IsEditable())
+}
+
+func TestAppEventStateIsLive(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, AppEventStatePublished.IsLive())
+	assert.False(t, AppEventStateApproved.IsLive())
+	assert.False(t, AppEventStatePast.IsLive())
+}
+
+func TestListAppEventsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListAppEventsForApp(ctx, "10", &ListAppEventsForAppQuery{})
+	})
+}
+
+func TestGetAppEvent(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppEvent(ctx, "10", &GetAppEventQuery{})
+	})
+}
+
+func TestCreateAppEvent(t *testing.T) {
+	t.Parallel()
+
+	badge := AppEventBadgeChallenge
+
+	testEndpointWithResponse(t, "{}", &AppEventResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppEvent(ctx, AppEventCreateRequestAttributes{
+			Badge:         &badge,
+			ReferenceName: "Summer Challenge",
+			TerritorySchedules: []AppEventTerritorySchedule{
+				{Territories: []string{"USA", "CAN"}},
+			},
+		}, "10")
+	})
+}
+
+func TestUpdateAppEvent(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAppEvent(ctx, "10", &AppEventUpdateRequestAttributes{
+			DeepLink: String("myapp://events/summer"),
+		})
+	})
+}
+
+func TestDeleteAppEvent(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppEvent(ctx, "10")
+	})
+}
+
+func TestSubmitAppEventForReview(t *testing.T) {
+	t.Parallel()
+
+	want := &ReviewSubmissionResponse{
+		Data: ReviewSubmission{
+			ID:   "10",
+			Type: "reviewSubmissions",
+		},
+	}
+
+	testEndpointWithResponse(t, `{"data":{"id":"10","type":"reviewSubmissions"}}`, want, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.SubmitAppEventForReview(ctx, "10", "11")
+	})
+}
+
+func TestSubmitAppEventForReviewError(t *testing.T) {
+	t.Parallel()
+
+	testEndpointExpectingError(t, `{"data":`, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.SubmitAppEventForReview(ctx, "10", "11")
+	})
+}
+
+func TestListLocalizationsForAppEvent(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListLocalizationsForAppEvent(ctx, "10", &ListLocalizationsForAppEventQuery{})
+	})
+}
+
+func TestGetAppEventLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppEventLocalization(ctx, "10", &GetAppEventLocalizationQuery{})
+	})
+}
+
+func TestGetAppEventLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appEvents"},{"type":"appEventScreenshots"},{"type":"appEventVideoClips"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.Apps.GetAppEventLocalization(ctx, "10", &GetAppEventLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, localization.Included)
+
+		assert.NotNil(t, localization.Included[0].AppEvent())
+		assert.NotNil(t, localization.Included[1].AppEventScreenshot())
+		assert.NotNil(t, localization.Included[2].AppEventVideoClip())
+
+		assert.Nil(t, localization.Included[0].AppEventScreenshot())
+		assert.Nil(t, localization.Included[0].AppEventVideoClip())
+		assert.Nil(t, localization.Included[1].AppEvent())
+	})
+}
+
+func TestCreateAppEventLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppEventLocalization(ctx, "en-US", &AppEventLocalizationUpdateRequestAttributes{
+			Name: String("Summer Challenge"),
+		}, "10")
+	})
+}
+
+func TestUpdateAppEventLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateAppEventLocalization(ctx, "10", &AppEventLocalizationUpdateRequestAttributes{
+			ShortDescription: String("Beat the heat"),
+		})
+	})
+}
+
+func TestDeleteAppEventLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppEventLocalization(ctx, "10")
+	})
+}
+
+func TestListScreenshotsForAppEventLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventScreenshotsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListScreenshotsForAppEventLocalization(ctx, "10", &ListScreenshotsForAppEventLocalizationQuery{})
+	})
+}
+
+func TestListVideoClipsForAppEventLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventVideoClipsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListVideoClipsForAppEventLocalization(ctx, "10", &ListVideoClipsForAppEventLocalizationQuery{})
+	})
+}
+
+func TestGetAppEventScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventScreenshotResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppEventScreenshot(ctx, "10", &GetAppEventScreenshotQuery{})
+	})
+}
+
+func TestCreateAppEventScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventScreenshotResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppEventScreenshot(ctx, "card.png", 20, AppEventAssetTypeEventCard, "10")
+	})
+}
+
+func TestCommitAppEventScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventScreenshotResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CommitAppEventScreenshot(ctx, "10", Bool(true))
+	})
+}
+
+func TestDeleteAppEventScreenshot(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppEventScreenshot(ctx, "10")
+	})
+}
+
+func TestUploadAppEventScreenshot(t *testing.T) {
+	t.Parallel()
+
+	want := &AppEventScreenshotResponse{
+		Data: AppEventScreenshot{
+			Attributes: &AppEventScreenshotAttributes{UploadOperations: []UploadOperation{}},
+			ID:         "10",
+			Type:       "appEventScreenshots",
+		},
+	}
+
+	testEndpointWithResponse(t, `{"data":{"id":"10","type":"appEventScreenshots","attributes":{"uploadOperations":[]}}}`, want, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppEventScreenshot(ctx, "card.png", bytes.NewReader([]byte("card")), AppEventAssetTypeEventCard, "10")
+	})
+}
+
+func TestUploadAppEventScreenshotError(t *testing.T) {
+	t.Parallel()
+
+	testEndpointExpectingError(t, `{"data":`, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppEventScreenshot(ctx, "card.png", bytes.NewReader([]byte("card")), AppEventAssetTypeEventCard, "10")
+	})
+}
+
+func TestGetAppEventVideoClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventVideoClipResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAppEventVideoClip(ctx, "10", &GetAppEventVideoClipQuery{})
+	})
+}
+
+func TestCreateAppEventVideoClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventVideoClipResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppEventVideoClip(ctx, "clip.mov", 20, String("00:00:05:00"), AppEventAssetTypeEventDetailsPage, "10")
+	})
+}
+
+func TestCommitAppEventVideoClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEventVideoClipResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CommitAppEventVideoClip(ctx, "10", Bool(true), String("00:00:05:00"))
+	})
+}
+
+func TestDeleteAppEventVideoClip(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteAppEventVideoClip(ctx, "10")
+	})
+}
+
+func TestUploadAppEventVideoClip(t *testing.T) {
+	t.Parallel()
+
+	want := &AppEventVideoClipResponse{
+		Data: AppEventVideoClip{
+			Attributes: &AppEventVideoClipAttributes{UploadOperations: []UploadOperation{}},
+			ID:         "10",
+			Type:       "appEventVideoClips",
+		},
+	}
+
+	testEndpointWithResponse(t, `{"data":{"id":"10","type":"appEventVideoClips","attributes":{"uploadOperations":[]}}}`, want, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppEventVideoClip(ctx, "clip.mov", bytes.NewReader([]byte("clip")), nil, AppEventAssetTypeEventCard, "10")
+	})
+}
+
+func TestUploadAppEventVideoClipError(t *testing.T) {
+	t.Parallel()
+
+	testEndpointExpectingError(t, `{"data":`, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppEventVideoClip(ctx, "clip.mov", bytes.NewReader([]byte("clip")), nil, AppEventAssetTypeEventCard, "10")
+	})
+}
+
+func TestParseAppEventBadge(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppEventBadge(string(AppEventBadgeLiveEvent))
+	assert.NoError(t, err)
+	assert.Equal(t, AppEventBadgeLiveEvent, got)
+
+	_, err = ParseAppEventBadge("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppEventBadge", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAppEventState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppEventState(string(AppEventStateDraft))
+	assert.NoError(t, err)
+	assert.Equal(t, AppEventStateDraft, got)
+
+	_, err = ParseAppEventState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppEventState", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAppEventPriority(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppEventPriority(string(AppEventPriorityHigh))
+	assert.NoError(t, err)
+	assert.Equal(t, AppEventPriorityHigh, got)
+
+	_, err = ParseAppEventPriority("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppEventPriority", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAppEventPurpose(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppEventPurpose(string(AppEventPurposeAppropriateForAllUsers))
+	assert.NoError(t, err)
+	assert.Equal(t, AppEventPurposeAppropriateForAllUsers, got)
+
+	_, err = ParseAppEventPurpose("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppEventPurpose", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAppEventAssetType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppEventAssetType(string(AppEventAssetTypeEventCard))
+	assert.NoError(t, err)
+	assert.Equal(t, AppEventAssetTypeEventCard, got)
+
+	_, err = ParseAppEventAssetType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppEventAssetType", Value: "UNKNOWN"}, err)
+}
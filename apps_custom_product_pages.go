@@ -0,0 +1,778 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppCustomProductPageVersionState defines model for AppCustomProductPageVersion.Attributes.State
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversion/attributes
+type AppCustomProductPageVersionState string
+
+const (
+	// AppCustomProductPageVersionStatePrepareForSubmission is a custom product page version state for PrepareForSubmission.
+	AppCustomProductPageVersionStatePrepareForSubmission AppCustomProductPageVersionState = "PREPARE_FOR_SUBMISSION"
+	// AppCustomProductPageVersionStateReadyForReview is a custom product page version state for ReadyForReview.
+	AppCustomProductPageVersionStateReadyForReview AppCustomProductPageVersionState = "READY_FOR_REVIEW"
+	// AppCustomProductPageVersionStateWaitingForReview is a custom product page version state for WaitingForReview.
+	AppCustomProductPageVersionStateWaitingForReview AppCustomProductPageVersionState = "WAITING_FOR_REVIEW"
+	// AppCustomProductPageVersionStateInReview is a custom product page version state for InReview.
+	AppCustomProductPageVersionStateInReview AppCustomProductPageVersionState = "IN_REVIEW"
+	// AppCustomProductPageVersionStateAccepted is a custom product page version state for Accepted.
+	AppCustomProductPageVersionStateAccepted AppCustomProductPageVersionState = "ACCEPTED"
+	// AppCustomProductPageVersionStateApproved is a custom product page version state for Approved.
+	AppCustomProductPageVersionStateApproved AppCustomProductPageVersionState = "APPROVED"
+	// AppCustomProductPageVersionStateReplacedWithNewVersion is a custom product page version state for ReplacedWithNewVersion.
+	AppCustomProductPageVersionStateReplacedWithNewVersion AppCustomProductPageVersionState = "REPLACED_WITH_NEW_VERSION"
+	// AppCustomProductPageVersionStateRejected is a custom product page version state for Rejected.
+	AppCustomProductPageVersionStateRejected AppCustomProductPageVersionState = "REJECTED"
+)
+
+var appCustomProductPageVersionStates = []AppCustomProductPageVersionState{
+	AppCustomProductPageVersionStatePrepareForSubmission,
+	AppCustomProductPageVersionStateReadyForReview,
+	AppCustomProductPageVersionStateWaitingForReview,
+	AppCustomProductPageVersionStateInReview,
+	AppCustomProductPageVersionStateAccepted,
+	AppCustomProductPageVersionStateApproved,
+	AppCustomProductPageVersionStateReplacedWithNewVersion,
+	AppCustomProductPageVersionStateRejected,
+}
+
+// IsValid reports whether the app custom product page version state is one this package knows about.
+func (v AppCustomProductPageVersionState) IsValid() bool {
+	for _, value := range appCustomProductPageVersionStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppCustomProductPageVersionState returns s as an AppCustomProductPageVersionState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppCustomProductPageVersionState(s string) (AppCustomProductPageVersionState, error) {
+	if v := AppCustomProductPageVersionState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppCustomProductPageVersionState", Value: s}
+}
+
+// AppCustomProductPage defines model for AppCustomProductPage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpage
+type AppCustomProductPage struct {
+	Attributes    *AppCustomProductPageAttributes    `json:"attributes,omitempty"`
+	ID            string                             `json:"id"`
+	Links         ResourceLinks                      `json:"links"`
+	Relationships *AppCustomProductPageRelationships `json:"relationships,omitempty"`
+	Type          string                             `json:"type"`
+}
+
+// AppCustomProductPageAttributes defines model for AppCustomProductPage.Attributes
+//
+// URL is the App Store link that opens the custom product page.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpage/attributes
+type AppCustomProductPageAttributes struct {
+	Name    *string `json:"name,omitempty"`
+	URL     *string `json:"url,omitempty"`
+	Visible *bool   `json:"visible,omitempty"`
+}
+
+// AppCustomProductPageRelationships defines model for AppCustomProductPage.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpage/relationships
+type AppCustomProductPageRelationships struct {
+	App                          *Relationship      `json:"app,omitempty"`
+	AppCustomProductPageVersions *PagedRelationship `json:"appCustomProductPageVersions,omitempty"`
+}
+
+// AppCustomProductPageResponse defines model for AppCustomProductPageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageresponse
+type AppCustomProductPageResponse struct {
+	Data     AppCustomProductPage                   `json:"data"`
+	Included []AppCustomProductPageResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                          `json:"links"`
+}
+
+// AppCustomProductPagesResponse defines model for AppCustomProductPagesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagesresponse
+type AppCustomProductPagesResponse struct {
+	Data     []AppCustomProductPage                 `json:"data"`
+	Included []AppCustomProductPageResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                     `json:"links"`
+	Meta     *PagingInformation                     `json:"meta,omitempty"`
+}
+
+// AppCustomProductPageResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppCustomProductPageResponse or AppCustomProductPagesResponse.
+type AppCustomProductPageResponseIncluded included
+
+// NewAppCustomProductPageLocalization models the parameters for a localization created inline
+// with the first version of a custom product page.
+type NewAppCustomProductPageLocalization struct {
+	Locale          string
+	PromotionalText *string
+}
+
+type appCustomProductPageLocalizationInlineCreate struct {
+	Attributes appCustomProductPageLocalizationInlineCreateAttributes `json:"attributes"`
+	ID         string                                                 `json:"id"`
+	Type       string                                                 `json:"type"`
+}
+
+type appCustomProductPageLocalizationInlineCreateAttributes struct {
+	Locale          string  `json:"locale"`
+	PromotionalText *string `json:"promotionalText,omitempty"`
+}
+
+func (l NewAppCustomProductPageLocalization) inlineCreate(index int) appCustomProductPageLocalizationInlineCreate {
+	return appCustomProductPageLocalizationInlineCreate{
+		Attributes: appCustomProductPageLocalizationInlineCreateAttributes{
+			Locale:          l.Locale,
+			PromotionalText: l.PromotionalText,
+		},
+		ID:   fmt.Sprintf("${new-localization-%d}", index),
+		Type: "appCustomProductPageLocalizations",
+	}
+}
+
+type appCustomProductPageVersionInlineCreate struct {
+	ID            string                                               `json:"id"`
+	Relationships appCustomProductPageVersionInlineCreateRelationships `json:"relationships"`
+	Type          string                                               `json:"type"`
+}
+
+type appCustomProductPageVersionInlineCreateRelationships struct {
+	AppCustomProductPageLocalizations pagedRelationshipDeclaration `json:"appCustomProductPageLocalizations"`
+}
+
+// appCustomProductPageCreateRequest defines model for AppCustomProductPageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagecreaterequest/data
+type appCustomProductPageCreateRequest struct {
+	Attributes    appCustomProductPageCreateRequestAttributes    `json:"attributes"`
+	Relationships appCustomProductPageCreateRequestRelationships `json:"relationships"`
+	Type          string                                         `json:"type"`
+}
+
+// appCustomProductPageCreateRequestAttributes are attributes for AppCustomProductPageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagecreaterequest/data/attributes
+type appCustomProductPageCreateRequestAttributes struct {
+	Name string `json:"name"`
+}
+
+// appCustomProductPageCreateRequestRelationships are relationships for AppCustomProductPageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagecreaterequest/data/relationships
+type appCustomProductPageCreateRequestRelationships struct {
+	App                          relationshipDeclaration       `json:"app"`
+	AppCustomProductPageVersions *pagedRelationshipDeclaration `json:"appCustomProductPageVersions,omitempty"`
+	AppStoreVersionTemplate      *relationshipDeclaration      `json:"appStoreVersionTemplate,omitempty"`
+	CustomProductPageTemplate    *relationshipDeclaration      `json:"customProductPageTemplate,omitempty"`
+}
+
+// appCustomProductPageUpdateRequest defines model for AppCustomProductPageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageupdaterequest/data
+type appCustomProductPageUpdateRequest struct {
+	Attributes *appCustomProductPageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                       `json:"id"`
+	Type       string                                       `json:"type"`
+}
+
+// appCustomProductPageUpdateRequestAttributes are attributes for AppCustomProductPageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageupdaterequest/data/attributes
+type appCustomProductPageUpdateRequestAttributes struct {
+	Name    *string `json:"name,omitempty"`
+	Visible *bool   `json:"visible,omitempty"`
+}
+
+// AppCustomProductPageVersion defines model for AppCustomProductPageVersion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversion
+type AppCustomProductPageVersion struct {
+	Attributes    *AppCustomProductPageVersionAttributes    `json:"attributes,omitempty"`
+	ID            string                                    `json:"id"`
+	Links         ResourceLinks                             `json:"links"`
+	Relationships *AppCustomProductPageVersionRelationships `json:"relationships,omitempty"`
+	Type          string                                    `json:"type"`
+}
+
+// AppCustomProductPageVersionAttributes defines model for AppCustomProductPageVersion.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversion/attributes
+type AppCustomProductPageVersionAttributes struct {
+	DeepLink *string                           `json:"deepLink,omitempty"`
+	State    *AppCustomProductPageVersionState `json:"state,omitempty"`
+	Version  *string                           `json:"version,omitempty"`
+}
+
+// AppCustomProductPageVersionRelationships defines model for AppCustomProductPageVersion.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversion/relationships
+type AppCustomProductPageVersionRelationships struct {
+	AppCustomProductPage              *Relationship      `json:"appCustomProductPage,omitempty"`
+	AppCustomProductPageLocalizations *PagedRelationship `json:"appCustomProductPageLocalizations,omitempty"`
+}
+
+// AppCustomProductPageVersionResponse defines model for AppCustomProductPageVersionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversionresponse
+type AppCustomProductPageVersionResponse struct {
+	Data     AppCustomProductPageVersion                   `json:"data"`
+	Included []AppCustomProductPageVersionResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                 `json:"links"`
+}
+
+// AppCustomProductPageVersionsResponse defines model for AppCustomProductPageVersionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversionsresponse
+type AppCustomProductPageVersionsResponse struct {
+	Data     []AppCustomProductPageVersion                 `json:"data"`
+	Included []AppCustomProductPageVersionResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                            `json:"links"`
+	Meta     *PagingInformation                            `json:"meta,omitempty"`
+}
+
+// AppCustomProductPageVersionResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppCustomProductPageVersionResponse or AppCustomProductPageVersionsResponse.
+type AppCustomProductPageVersionResponseIncluded included
+
+// appCustomProductPageVersionCreateRequest defines model for AppCustomProductPageVersionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversioncreaterequest/data
+type appCustomProductPageVersionCreateRequest struct {
+	Attributes    *appCustomProductPageVersionCreateRequestAttributes   `json:"attributes,omitempty"`
+	Relationships appCustomProductPageVersionCreateRequestRelationships `json:"relationships"`
+	Type          string                                                `json:"type"`
+}
+
+// appCustomProductPageVersionCreateRequestAttributes are attributes for AppCustomProductPageVersionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversioncreaterequest/data/attributes
+type appCustomProductPageVersionCreateRequestAttributes struct {
+	DeepLink *string `json:"deepLink,omitempty"`
+}
+
+// appCustomProductPageVersionCreateRequestRelationships are relationships for AppCustomProductPageVersionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversioncreaterequest/data/relationships
+type appCustomProductPageVersionCreateRequestRelationships struct {
+	AppCustomProductPage relationshipDeclaration `json:"appCustomProductPage"`
+}
+
+// appCustomProductPageVersionUpdateRequest defines model for AppCustomProductPageVersionUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpageversionupdaterequest/data
+type appCustomProductPageVersionUpdateRequest struct {
+	Attributes *appCustomProductPageVersionCreateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                              `json:"id"`
+	Type       string                                              `json:"type"`
+}
+
+// AppCustomProductPageLocalization defines model for AppCustomProductPageLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalization
+type AppCustomProductPageLocalization struct {
+	Attributes    *AppCustomProductPageLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                         `json:"id"`
+	Links         ResourceLinks                                  `json:"links"`
+	Relationships *AppCustomProductPageLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                         `json:"type"`
+}
+
+// AppCustomProductPageLocalizationAttributes defines model for AppCustomProductPageLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalization/attributes
+type AppCustomProductPageLocalizationAttributes struct {
+	Locale          *string `json:"locale,omitempty"`
+	PromotionalText *string `json:"promotionalText,omitempty"`
+}
+
+// AppCustomProductPageLocalizationRelationships defines model for AppCustomProductPageLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalization/relationships
+type AppCustomProductPageLocalizationRelationships struct {
+	AppCustomProductPageVersion *Relationship      `json:"appCustomProductPageVersion,omitempty"`
+	AppPreviewSets              *PagedRelationship `json:"appPreviewSets,omitempty"`
+	AppScreenshotSets           *PagedRelationship `json:"appScreenshotSets,omitempty"`
+}
+
+// AppCustomProductPageLocalizationResponse defines model for AppCustomProductPageLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationresponse
+type AppCustomProductPageLocalizationResponse struct {
+	Data     AppCustomProductPageLocalization                   `json:"data"`
+	Included []AppCustomProductPageLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                      `json:"links"`
+}
+
+// AppCustomProductPageLocalizationsResponse defines model for AppCustomProductPageLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationsresponse
+type AppCustomProductPageLocalizationsResponse struct {
+	Data     []AppCustomProductPageLocalization                 `json:"data"`
+	Included []AppCustomProductPageLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                 `json:"links"`
+	Meta     *PagingInformation                                 `json:"meta,omitempty"`
+}
+
+// AppCustomProductPageLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppCustomProductPageLocalizationResponse or AppCustomProductPageLocalizationsResponse.
+type AppCustomProductPageLocalizationResponseIncluded included
+
+// appCustomProductPageLocalizationCreateRequest defines model for AppCustomProductPageLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationcreaterequest/data
+type appCustomProductPageLocalizationCreateRequest struct {
+	Attributes    appCustomProductPageLocalizationInlineCreateAttributes     `json:"attributes"`
+	Relationships appCustomProductPageLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                     `json:"type"`
+}
+
+// appCustomProductPageLocalizationCreateRequestRelationships are relationships for AppCustomProductPageLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationcreaterequest/data/relationships
+type appCustomProductPageLocalizationCreateRequestRelationships struct {
+	AppCustomProductPageVersion relationshipDeclaration `json:"appCustomProductPageVersion"`
+}
+
+// appCustomProductPageLocalizationUpdateRequest defines model for AppCustomProductPageLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationupdaterequest/data
+type appCustomProductPageLocalizationUpdateRequest struct {
+	Attributes *appCustomProductPageLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                   `json:"id"`
+	Type       string                                                   `json:"type"`
+}
+
+// appCustomProductPageLocalizationUpdateRequestAttributes are attributes for AppCustomProductPageLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcustomproductpagelocalizationupdaterequest/data/attributes
+type appCustomProductPageLocalizationUpdateRequestAttributes struct {
+	PromotionalText *string `json:"promotionalText,omitempty"`
+}
+
+// ListAppCustomProductPagesForAppQuery are query options for ListAppCustomProductPagesForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_pages_for_an_app
+type ListAppCustomProductPagesForAppQuery struct {
+	FieldsAppCustomProductPages        []string `url:"fields[appCustomProductPages],omitempty"`
+	FieldsAppCustomProductPageVersions []string `url:"fields[appCustomProductPageVersions],omitempty"`
+	FilterVisible                      []string `url:"filter[visible],omitempty"`
+	Include                            []string `url:"include,omitempty"`
+	Limit                              int      `url:"limit,omitempty"`
+	LimitAppCustomProductPageVersions  int      `url:"limit[appCustomProductPageVersions],omitempty"`
+	Cursor                             string   `url:"cursor,omitempty"`
+}
+
+// GetAppCustomProductPageQuery are query options for GetAppCustomProductPage
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_information
+type GetAppCustomProductPageQuery struct {
+	FieldsAppCustomProductPages        []string `url:"fields[appCustomProductPages],omitempty"`
+	FieldsAppCustomProductPageVersions []string `url:"fields[appCustomProductPageVersions],omitempty"`
+	Include                            []string `url:"include,omitempty"`
+	LimitAppCustomProductPageVersions  int      `url:"limit[appCustomProductPageVersions],omitempty"`
+}
+
+// ListAppCustomProductPageVersionsQuery are query options for ListAppCustomProductPageVersions
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_page_versions_for_a_custom_product_page
+type ListAppCustomProductPageVersionsQuery struct {
+	FieldsAppCustomProductPageVersions      []string `url:"fields[appCustomProductPageVersions],omitempty"`
+	FieldsAppCustomProductPageLocalizations []string `url:"fields[appCustomProductPageLocalizations],omitempty"`
+	FilterState                             []string `url:"filter[state],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+	Limit                                   int      `url:"limit,omitempty"`
+	LimitAppCustomProductPageLocalizations  int      `url:"limit[appCustomProductPageLocalizations],omitempty"`
+	Cursor                                  string   `url:"cursor,omitempty"`
+}
+
+// GetAppCustomProductPageVersionQuery are query options for GetAppCustomProductPageVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_version_information
+type GetAppCustomProductPageVersionQuery struct {
+	FieldsAppCustomProductPageVersions      []string `url:"fields[appCustomProductPageVersions],omitempty"`
+	FieldsAppCustomProductPageLocalizations []string `url:"fields[appCustomProductPageLocalizations],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+	LimitAppCustomProductPageLocalizations  int      `url:"limit[appCustomProductPageLocalizations],omitempty"`
+}
+
+// ListAppCustomProductPageLocalizationsQuery are query options for ListAppCustomProductPageLocalizations
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_page_localizations_for_a_custom_product_page_version
+type ListAppCustomProductPageLocalizationsQuery struct {
+	FieldsAppCustomProductPageLocalizations []string `url:"fields[appCustomProductPageLocalizations],omitempty"`
+	FieldsAppPreviewSets                    []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppScreenshotSets                 []string `url:"fields[appScreenshotSets],omitempty"`
+	FilterLocale                            []string `url:"filter[locale],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+	Limit                                   int      `url:"limit,omitempty"`
+	LimitAppPreviewSets                     int      `url:"limit[appPreviewSets],omitempty"`
+	LimitAppScreenshotSets                  int      `url:"limit[appScreenshotSets],omitempty"`
+	Cursor                                  string   `url:"cursor,omitempty"`
+}
+
+// GetAppCustomProductPageLocalizationQuery are query options for GetAppCustomProductPageLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_localization_information
+type GetAppCustomProductPageLocalizationQuery struct {
+	FieldsAppCustomProductPageLocalizations []string `url:"fields[appCustomProductPageLocalizations],omitempty"`
+	FieldsAppPreviewSets                    []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppScreenshotSets                 []string `url:"fields[appScreenshotSets],omitempty"`
+	Include                                 []string `url:"include,omitempty"`
+	LimitAppPreviewSets                     int      `url:"limit[appPreviewSets],omitempty"`
+	LimitAppScreenshotSets                  int      `url:"limit[appScreenshotSets],omitempty"`
+}
+
+// ListAppScreenshotSetsForCustomProductPageLocalizationQuery are query options for ListAppScreenshotSetsForCustomProductPageLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshot_sets_for_a_custom_product_page_localization
+type ListAppScreenshotSetsForCustomProductPageLocalizationQuery struct {
+	FieldsAppScreenshotSets     []string `url:"fields[appScreenshotSets],omitempty"`
+	FieldsAppScreenshots        []string `url:"fields[appScreenshots],omitempty"`
+	FilterScreenshotDisplayType []string `url:"filter[screenshotDisplayType],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	LimitAppScreenshots         int      `url:"limit[appScreenshots],omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// ListAppPreviewSetsForCustomProductPageLocalizationQuery are query options for ListAppPreviewSetsForCustomProductPageLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_preview_sets_for_a_custom_product_page_localization
+type ListAppPreviewSetsForCustomProductPageLocalizationQuery struct {
+	FieldsAppPreviewSets []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppPreviews    []string `url:"fields[appPreviews],omitempty"`
+	FilterPreviewType    []string `url:"filter[previewType],omitempty"`
+	Include              []string `url:"include,omitempty"`
+	Limit                int      `url:"limit,omitempty"`
+	LimitAppPreviews     int      `url:"limit[appPreviews],omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+}
+
+// ListAppCustomProductPagesForApp lists the custom product pages for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_pages_for_an_app
+func (s *AppsService) ListAppCustomProductPagesForApp(ctx context.Context, id string, params *ListAppCustomProductPagesForAppQuery) (*AppCustomProductPagesResponse, *Response, error) {
+	return Get[AppCustomProductPagesResponse](ctx, s.client, fmt.Sprintf("apps/%s/appCustomProductPages", id), params)
+}
+
+// GetAppCustomProductPage gets a custom product page, including its name, visibility and App Store URL.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_information
+func (s *AppsService) GetAppCustomProductPage(ctx context.Context, id string, params *GetAppCustomProductPageQuery) (*AppCustomProductPageResponse, *Response, error) {
+	return Get[AppCustomProductPageResponse](ctx, s.client, fmt.Sprintf("appCustomProductPages/%s", id), params)
+}
+
+// CreateAppCustomProductPage creates a custom product page for an app.
+//
+// The page's metadata can be copied from an existing App Store version or custom product page by
+// providing appStoreVersionTemplateID or customProductPageTemplateID respectively. If any localizations
+// are provided, they're created inline with the first version of the page.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_custom_product_page
+func (s *AppsService) CreateAppCustomProductPage(ctx context.Context, name string, appID string, appStoreVersionTemplateID *string, customProductPageTemplateID *string, localizations []NewAppCustomProductPageLocalization) (*AppCustomProductPageResponse, *Response, error) {
+	req := appCustomProductPageCreateRequest{
+		Attributes: appCustomProductPageCreateRequestAttributes{
+			Name: name,
+		},
+		Relationships: appCustomProductPageCreateRequestRelationships{
+			App:                       *newRelationshipDeclaration(&appID, "apps"),
+			AppStoreVersionTemplate:   newRelationshipDeclaration(appStoreVersionTemplateID, "appStoreVersions"),
+			CustomProductPageTemplate: newRelationshipDeclaration(customProductPageTemplateID, "appCustomProductPages"),
+		},
+		Type: "appCustomProductPages",
+	}
+
+	body := newRequestBody(req)
+
+	if len(localizations) > 0 {
+		included := make([]interface{}, 0, len(localizations)+1)
+		localizationIDs := make([]string, len(localizations))
+
+		for i, localization := range localizations {
+			inline := localization.inlineCreate(i)
+			localizationIDs[i] = inline.ID
+			included = append(included, inline)
+		}
+
+		version := appCustomProductPageVersionInlineCreate{
+			ID: "${new-version-0}",
+			Relationships: appCustomProductPageVersionInlineCreateRelationships{
+				AppCustomProductPageLocalizations: newPagedRelationshipDeclaration(localizationIDs, "appCustomProductPageLocalizations"),
+			},
+			Type: "appCustomProductPageVersions",
+		}
+		versions := newPagedRelationshipDeclaration([]string{version.ID}, "appCustomProductPageVersions")
+		req.Relationships.AppCustomProductPageVersions = &versions
+		included = append(included, version)
+		body = newRequestBodyWithIncluded(req, included)
+	}
+
+	res := new(AppCustomProductPageResponse)
+	resp, err := s.client.post(ctx, "appCustomProductPages", body, res)
+
+	return res, resp, err
+}
+
+// UpdateAppCustomProductPage renames a custom product page or changes its visibility on the App Store.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_custom_product_page
+func (s *AppsService) UpdateAppCustomProductPage(ctx context.Context, id string, name *string, visible *bool) (*AppCustomProductPageResponse, *Response, error) {
+	req := appCustomProductPageUpdateRequest{
+		ID:   id,
+		Type: "appCustomProductPages",
+	}
+
+	if name != nil || visible != nil {
+		req.Attributes = &appCustomProductPageUpdateRequestAttributes{
+			Name:    name,
+			Visible: visible,
+		}
+	}
+
+	url := fmt.Sprintf("appCustomProductPages/%s", id)
+	res := new(AppCustomProductPageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppCustomProductPage deletes a custom product page.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_custom_product_page
+func (s *AppsService) DeleteAppCustomProductPage(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appCustomProductPages/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListAppCustomProductPageVersions lists the versions of a custom product page.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_page_versions_for_a_custom_product_page
+func (s *AppsService) ListAppCustomProductPageVersions(ctx context.Context, id string, params *ListAppCustomProductPageVersionsQuery) (*AppCustomProductPageVersionsResponse, *Response, error) {
+	return Get[AppCustomProductPageVersionsResponse](ctx, s.client, fmt.Sprintf("appCustomProductPages/%s/appCustomProductPageVersions", id), params)
+}
+
+// GetAppCustomProductPageVersion gets a custom product page version, including its review state and deep link.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_version_information
+func (s *AppsService) GetAppCustomProductPageVersion(ctx context.Context, id string, params *GetAppCustomProductPageVersionQuery) (*AppCustomProductPageVersionResponse, *Response, error) {
+	return Get[AppCustomProductPageVersionResponse](ctx, s.client, fmt.Sprintf("appCustomProductPageVersions/%s", id), params)
+}
+
+// CreateAppCustomProductPageVersion creates a new version of a custom product page.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_custom_product_page_version
+func (s *AppsService) CreateAppCustomProductPageVersion(ctx context.Context, customProductPageID string, deepLink *string) (*AppCustomProductPageVersionResponse, *Response, error) {
+	req := appCustomProductPageVersionCreateRequest{
+		Relationships: appCustomProductPageVersionCreateRequestRelationships{
+			AppCustomProductPage: *newRelationshipDeclaration(&customProductPageID, "appCustomProductPages"),
+		},
+		Type: "appCustomProductPageVersions",
+	}
+
+	if deepLink != nil {
+		req.Attributes = &appCustomProductPageVersionCreateRequestAttributes{
+			DeepLink: deepLink,
+		}
+	}
+
+	res := new(AppCustomProductPageVersionResponse)
+	resp, err := s.client.post(ctx, "appCustomProductPageVersions", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppCustomProductPageVersion changes the deep link that opens the app from a custom product page version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_custom_product_page_version
+func (s *AppsService) UpdateAppCustomProductPageVersion(ctx context.Context, id string, deepLink *string) (*AppCustomProductPageVersionResponse, *Response, error) {
+	req := appCustomProductPageVersionUpdateRequest{
+		ID:   id,
+		Type: "appCustomProductPageVersions",
+	}
+
+	if deepLink != nil {
+		req.Attributes = &appCustomProductPageVersionCreateRequestAttributes{
+			DeepLink: deepLink,
+		}
+	}
+
+	url := fmt.Sprintf("appCustomProductPageVersions/%s", id)
+	res := new(AppCustomProductPageVersionResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListAppCustomProductPageLocalizations lists the localizations of a custom product page version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_custom_product_page_localizations_for_a_custom_product_page_version
+func (s *AppsService) ListAppCustomProductPageLocalizations(ctx context.Context, id string, params *ListAppCustomProductPageLocalizationsQuery) (*AppCustomProductPageLocalizationsResponse, *Response, error) {
+	return Get[AppCustomProductPageLocalizationsResponse](ctx, s.client, fmt.Sprintf("appCustomProductPageVersions/%s/appCustomProductPageLocalizations", id), params)
+}
+
+// GetAppCustomProductPageLocalization gets a custom product page localization and its promotional text.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_custom_product_page_localization_information
+func (s *AppsService) GetAppCustomProductPageLocalization(ctx context.Context, id string, params *GetAppCustomProductPageLocalizationQuery) (*AppCustomProductPageLocalizationResponse, *Response, error) {
+	return Get[AppCustomProductPageLocalizationResponse](ctx, s.client, fmt.Sprintf("appCustomProductPageLocalizations/%s", id), params)
+}
+
+// CreateAppCustomProductPageLocalization adds a localization to a custom product page version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_custom_product_page_localization
+func (s *AppsService) CreateAppCustomProductPageLocalization(ctx context.Context, locale string, promotionalText *string, customProductPageVersionID string) (*AppCustomProductPageLocalizationResponse, *Response, error) {
+	req := appCustomProductPageLocalizationCreateRequest{
+		Attributes: appCustomProductPageLocalizationInlineCreateAttributes{
+			Locale:          locale,
+			PromotionalText: promotionalText,
+		},
+		Relationships: appCustomProductPageLocalizationCreateRequestRelationships{
+			AppCustomProductPageVersion: *newRelationshipDeclaration(&customProductPageVersionID, "appCustomProductPageVersions"),
+		},
+		Type: "appCustomProductPageLocalizations",
+	}
+	res := new(AppCustomProductPageLocalizationResponse)
+	resp, err := s.client.post(ctx, "appCustomProductPageLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppCustomProductPageLocalization changes the promotional text of a custom product page localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_custom_product_page_localization
+func (s *AppsService) UpdateAppCustomProductPageLocalization(ctx context.Context, id string, promotionalText *string) (*AppCustomProductPageLocalizationResponse, *Response, error) {
+	req := appCustomProductPageLocalizationUpdateRequest{
+		ID:   id,
+		Type: "appCustomProductPageLocalizations",
+	}
+
+	if promotionalText != nil {
+		req.Attributes = &appCustomProductPageLocalizationUpdateRequestAttributes{
+			PromotionalText: promotionalText,
+		}
+	}
+
+	url := fmt.Sprintf("appCustomProductPageLocalizations/%s", id)
+	res := new(AppCustomProductPageLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppCustomProductPageLocalization deletes a custom product page localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_custom_product_page_localization
+func (s *AppsService) DeleteAppCustomProductPageLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appCustomProductPageLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListAppScreenshotSetsForCustomProductPageLocalization lists the screenshot sets of a custom product page localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshot_sets_for_a_custom_product_page_localization
+func (s *AppsService) ListAppScreenshotSetsForCustomProductPageLocalization(ctx context.Context, id string, params *ListAppScreenshotSetsForCustomProductPageLocalizationQuery) (*AppScreenshotSetsResponse, *Response, error) {
+	return Get[AppScreenshotSetsResponse](ctx, s.client, fmt.Sprintf("appCustomProductPageLocalizations/%s/appScreenshotSets", id), params)
+}
+
+// ListAppPreviewSetsForCustomProductPageLocalization lists the preview sets of a custom product page localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_preview_sets_for_a_custom_product_page_localization
+func (s *AppsService) ListAppPreviewSetsForCustomProductPageLocalization(ctx context.Context, id string, params *ListAppPreviewSetsForCustomProductPageLocalizationQuery) (*AppPreviewSetsResponse, *Response, error) {
+	return Get[AppPreviewSetsResponse](ctx, s.client, fmt.Sprintf("appCustomProductPageLocalizations/%s/appPreviewSets", id), params)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppCustomProductPageResponseIncluded.
+func (i *AppCustomProductPageResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *AppCustomProductPageResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// AppCustomProductPageVersion returns the AppCustomProductPageVersion stored within, if one is present.
+func (i *AppCustomProductPageResponseIncluded) AppCustomProductPageVersion() *AppCustomProductPageVersion {
+	return extractIncludedAppCustomProductPageVersion(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppCustomProductPageVersionResponseIncluded.
+func (i *AppCustomProductPageVersionResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppCustomProductPage returns the AppCustomProductPage stored within, if one is present.
+func (i *AppCustomProductPageVersionResponseIncluded) AppCustomProductPage() *AppCustomProductPage {
+	return extractIncludedAppCustomProductPage(i.inner)
+}
+
+// AppCustomProductPageLocalization returns the AppCustomProductPageLocalization stored within, if one is present.
+func (i *AppCustomProductPageVersionResponseIncluded) AppCustomProductPageLocalization() *AppCustomProductPageLocalization {
+	return extractIncludedAppCustomProductPageLocalization(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppCustomProductPageLocalizationResponseIncluded.
+func (i *AppCustomProductPageLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppCustomProductPageVersion returns the AppCustomProductPageVersion stored within, if one is present.
+func (i *AppCustomProductPageLocalizationResponseIncluded) AppCustomProductPageVersion() *AppCustomProductPageVersion {
+	return extractIncludedAppCustomProductPageVersion(i.inner)
+}
+
+// AppPreviewSet returns the AppPreviewSet stored within, if one is present.
+func (i *AppCustomProductPageLocalizationResponseIncluded) AppPreviewSet() *AppPreviewSet {
+	return extractIncludedAppPreviewSet(i.inner)
+}
+
+// AppScreenshotSet returns the AppScreenshotSet stored within, if one is present.
+func (i *AppCustomProductPageLocalizationResponseIncluded) AppScreenshotSet() *AppScreenshotSet {
+	return extractIncludedAppScreenshotSet(i.inner)
+}
@@ -0,0 +1,199 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+)
+
+// CustomerReviewRow is a customer review flattened for export by ExportCustomerReviews. Its fields are tagged like
+// report rows, so it can be written by any ReportWriter.
+type CustomerReviewRow struct {
+	ID               string   `tsv:"Review ID"`
+	Territory        string   `tsv:"Territory"`
+	Rating           int      `tsv:"Rating"`
+	Title            string   `tsv:"Title"`
+	Body             string   `tsv:"Body"`
+	ReviewerNickname string   `tsv:"Reviewer Nickname"`
+	CreatedDate      DateTime `tsv:"Created Date"`
+	ResponseBody     string   `tsv:"Response Body"`
+	ResponseState    string   `tsv:"Response State"`
+}
+
+// CustomerReviewExportCursor marks the most recent reviews exported by ExportCustomerReviews, so the next export
+// only fetches reviews created since.
+type CustomerReviewExportCursor struct {
+	// CreatedDate is the creation date of the most recent review exported.
+	CreatedDate time.Time `json:"createdDate"`
+	// ReviewIDs are the IDs of the reviews exported that were created at CreatedDate.
+	ReviewIDs []string `json:"reviewIds"`
+}
+
+// ReadCustomerReviewExportCursor reads a cursor previously written by WriteCustomerReviewExportCursor. If there
+// is no file at path, as before the first export, a nil cursor is returned.
+func ReadCustomerReviewExportCursor(path string) (*CustomerReviewExportCursor, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	cursor := new(CustomerReviewExportCursor)
+	if err := json.Unmarshal(b, cursor); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return cursor, nil
+}
+
+// WriteCustomerReviewExportCursor writes cursor to a JSON file at path.
+func WriteCustomerReviewExportCursor(path string, cursor *CustomerReviewExportCursor) error {
+	b, err := json.MarshalIndent(cursor, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(b, '\n'), 0o644)
+}
+
+// ExportCustomerReviews writes the reviews of an app in every territory to w, newest first, and flushes it. If
+// cursor is not nil, only reviews created after it are fetched. The returned cursor marks the reviews exported
+// and should be passed to the next export; it is the given cursor if there were no new reviews. The number of
+// reviews written is returned along with it.
+func (s *AppsService) ExportCustomerReviews(ctx context.Context, appID string, cursor *CustomerReviewExportCursor, w ReportWriter) (*CustomerReviewExportCursor, int, error) {
+	params := &ListCustomerReviewsQuery{
+		Include: []string{"response"},
+		Limit:   MaxPageLimit,
+		Sort:    []string{"-createdDate"},
+	}
+
+	next := cursor
+	count := 0
+
+	err := ForEachPage(func(page string) (*PagedDocumentLinks, error) {
+		params.Cursor = page
+
+		res, _, err := s.ListCustomerReviewsForApp(ctx, appID, params)
+		if err != nil {
+			return nil, err
+		}
+
+		responses := make(map[string]CustomerReviewResponseV1, len(res.Included))
+		for _, response := range res.Included {
+			responses[response.ID] = response
+		}
+
+		for _, review := range res.Data {
+			row := newCustomerReviewRow(review, responses)
+
+			if cursor != nil && row.CreatedDate.Before(cursor.CreatedDate) {
+				return nil, nil
+			}
+
+			if cursor.contains(row) {
+				continue
+			}
+
+			if err := w.Write(row); err != nil {
+				return nil, err
+			}
+
+			next = next.advance(row)
+			count++
+		}
+
+		return &res.Links, nil
+	})
+	if err != nil {
+		return nil, count, err
+	}
+
+	return next, count, w.Flush()
+}
+
+func newCustomerReviewRow(review CustomerReview, responses map[string]CustomerReviewResponseV1) CustomerReviewRow {
+	row := CustomerReviewRow{ID: review.ID}
+
+	if attributes := review.Attributes; attributes != nil {
+		row.Title = stringValue(attributes.Title)
+		row.Body = stringValue(attributes.Body)
+		row.ReviewerNickname = stringValue(attributes.ReviewerNickname)
+
+		if attributes.Territory != nil {
+			row.Territory = string(*attributes.Territory)
+		}
+
+		if attributes.Rating != nil {
+			row.Rating = *attributes.Rating
+		}
+
+		if attributes.CreatedDate != nil {
+			row.CreatedDate = *attributes.CreatedDate
+		}
+	}
+
+	if review.Relationships == nil || review.Relationships.Response == nil || review.Relationships.Response.Data == nil {
+		return row
+	}
+
+	if response, ok := responses[review.Relationships.Response.Data.ID]; ok && response.Attributes != nil {
+		row.ResponseBody = stringValue(response.Attributes.ResponseBody)
+
+		if response.Attributes.State != nil {
+			row.ResponseState = string(*response.Attributes.State)
+		}
+	}
+
+	return row
+}
+
+// contains reports whether the review in row was exported before c was returned.
+func (c *CustomerReviewExportCursor) contains(row CustomerReviewRow) bool {
+	if c == nil || !row.CreatedDate.Equal(c.CreatedDate) {
+		return false
+	}
+
+	for _, id := range c.ReviewIDs {
+		if id == row.ID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// advance returns a cursor that also marks the review in row as exported.
+func (c *CustomerReviewExportCursor) advance(row CustomerReviewRow) *CustomerReviewExportCursor {
+	switch {
+	case c == nil || row.CreatedDate.After(c.CreatedDate):
+		return &CustomerReviewExportCursor{CreatedDate: row.CreatedDate.Time, ReviewIDs: []string{row.ID}}
+	case row.CreatedDate.Equal(c.CreatedDate):
+		return &CustomerReviewExportCursor{CreatedDate: c.CreatedDate, ReviewIDs: append(append([]string(nil), c.ReviewIDs...), row.ID)}
+	default:
+		return c
+	}
+}
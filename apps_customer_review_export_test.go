@@ -0,0 +1,177 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testCustomerReviewsPage1 = `{
+		"data": [
+			{"id": "r4", "type": "customerReviews", "attributes": {"rating": 5, "title": "Great", "body": "Love it", "reviewerNickname": "a", "territory": "USA", "createdDate": "2024-02-03T10:00:00Z"}, "relationships": {"response": {"data": {"id": "resp-4", "type": "customerReviewResponses"}}}},
+			{"id": "r3", "type": "customerReviews", "attributes": {"rating": 1, "title": "Bad", "territory": "FRA", "createdDate": "2024-02-02T10:00:00Z"}}
+		],
+		"included": [
+			{"id": "resp-4", "type": "customerReviewResponses", "attributes": {"responseBody": "Thanks!", "state": "PUBLISHED"}}
+		],
+		"links": {"self": "", "next": "%s/apps/10/customerReviews?cursor=page2"}
+	}`
+	testCustomerReviewsPage2 = `{
+		"data": [
+			{"id": "r2", "type": "customerReviews", "attributes": {"rating": 3, "territory": "DEU", "createdDate": "2024-02-02T10:00:00Z"}},
+			{"id": "r1", "type": "customerReviews", "attributes": {"rating": 4, "territory": "USA", "createdDate": "2024-02-01T10:00:00Z"}}
+		],
+		"links": {"self": ""}
+	}`
+)
+
+func newCustomerReviewsServer() (*Client, *httptest.Server, *[]string) {
+	var cursors []string
+
+	server := httptest.NewServer(nil)
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		if cursor == "page2" {
+			fmt.Fprintln(w, testCustomerReviewsPage2)
+		} else {
+			fmt.Fprintf(w, testCustomerReviewsPage1, server.URL)
+		}
+	})
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client, server, &cursors
+}
+
+func TestExportCustomerReviews(t *testing.T) {
+	t.Parallel()
+
+	client, server, cursors := newCustomerReviewsServer()
+	defer server.Close()
+
+	buf := new(bytes.Buffer)
+	cursor, count, err := client.Apps.ExportCustomerReviews(context.Background(), "10", nil, NewCSVReportWriter(buf))
+	assert.NoError(t, err)
+	assert.Equal(t, 4, count)
+	assert.Equal(t, []string{"", "page2"}, *cursors)
+	assert.Equal(t, &CustomerReviewExportCursor{
+		CreatedDate: time.Date(2024, 2, 3, 10, 0, 0, 0, time.UTC),
+		ReviewIDs:   []string{"r4"},
+	}, cursor)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 5)
+	assert.Equal(t, "review_id,territory,rating,title,body,reviewer_nickname,created_date,response_body,response_state", lines[0])
+	assert.Equal(t, "r4,USA,5,Great,Love it,a,2024-02-03T10:00:00Z,Thanks!,PUBLISHED", lines[1])
+	assert.Equal(t, "r1,USA,4,,,,2024-02-01T10:00:00Z,,", lines[4])
+}
+
+func TestExportCustomerReviewsResume(t *testing.T) {
+	t.Parallel()
+
+	client, server, cursors := newCustomerReviewsServer()
+	defer server.Close()
+
+	previous := &CustomerReviewExportCursor{
+		CreatedDate: time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC),
+		ReviewIDs:   []string{"r2"},
+	}
+
+	buf := new(bytes.Buffer)
+	cursor, count, err := client.Apps.ExportCustomerReviews(context.Background(), "10", previous, NewJSONLReportWriter(buf))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Equal(t, time.Date(2024, 2, 3, 10, 0, 0, 0, time.UTC), cursor.CreatedDate)
+	// r1 is older than the cursor, so paging stops there.
+	assert.Equal(t, []string{"", "page2"}, *cursors)
+	assert.Contains(t, buf.String(), `"review_id":"r4"`)
+	assert.Contains(t, buf.String(), `"review_id":"r3"`)
+	assert.NotContains(t, buf.String(), `"review_id":"r2"`)
+
+	// Nothing new: the cursor is unchanged.
+	cursor, count, err = client.Apps.ExportCustomerReviews(context.Background(), "10", cursor, NewJSONLReportWriter(new(bytes.Buffer)))
+	assert.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, []string{"r4"}, cursor.ReviewIDs)
+}
+
+func TestExportCustomerReviewsError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"status":"404"}]}`, http.StatusNotFound, false)
+	defer server.Close()
+
+	cursor, count, err := client.Apps.ExportCustomerReviews(context.Background(), "10", nil, NewCSVReportWriter(new(bytes.Buffer)))
+	assert.Error(t, err)
+	assert.Nil(t, cursor)
+	assert.Equal(t, 0, count)
+}
+
+func TestCustomerReviewExportCursorAdvance(t *testing.T) {
+	t.Parallel()
+
+	date := DateTime{time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC)}
+	cursor := (*CustomerReviewExportCursor)(nil).advance(CustomerReviewRow{ID: "a", CreatedDate: date})
+	cursor = cursor.advance(CustomerReviewRow{ID: "b", CreatedDate: date})
+	assert.Equal(t, []string{"a", "b"}, cursor.ReviewIDs)
+	assert.True(t, cursor.contains(CustomerReviewRow{ID: "b", CreatedDate: date}))
+	assert.False(t, cursor.contains(CustomerReviewRow{ID: "c", CreatedDate: date}))
+
+	older := cursor.advance(CustomerReviewRow{ID: "c", CreatedDate: DateTime{date.AddDate(0, 0, -1)}})
+	assert.Same(t, cursor, older)
+}
+
+func TestCustomerReviewExportCursorFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "cursor.json")
+
+	cursor, err := ReadCustomerReviewExportCursor(path)
+	assert.NoError(t, err)
+	assert.Nil(t, cursor)
+
+	want := &CustomerReviewExportCursor{CreatedDate: time.Date(2024, 2, 2, 10, 0, 0, 0, time.UTC), ReviewIDs: []string{"r2"}}
+	assert.NoError(t, WriteCustomerReviewExportCursor(path, want))
+
+	cursor, err = ReadCustomerReviewExportCursor(path)
+	assert.NoError(t, err)
+	assert.Equal(t, want, cursor)
+
+	assert.NoError(t, writeAppMetadataFile(path, "not a cursor"))
+
+	_, err = ReadCustomerReviewExportCursor(path)
+	assert.Error(t, err)
+}
@@ -0,0 +1,164 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// MaxCustomerReviewResponseLength is the maximum number of characters App Store Connect accepts in a response
+// to a customer review.
+const MaxCustomerReviewResponseLength = 5970
+
+// Merge fields filled in from a customer review by CustomerReviewFields.
+const (
+	// ReviewFieldCustomerName is the nickname the customer reviewed under.
+	ReviewFieldCustomerName = "customerName"
+	// ReviewFieldRating is the star rating of the review, from 1 to 5.
+	ReviewFieldRating = "rating"
+	// ReviewFieldTerritory is the territory code of the storefront the review was left in.
+	ReviewFieldTerritory = "territory"
+	// ReviewFieldTitle is the title of the review.
+	ReviewFieldTitle = "title"
+	// ReviewFieldAppVersion is the version of the app being discussed. It is not part of a customer review
+	// and must be supplied by the caller.
+	ReviewFieldAppVersion = "appVersion"
+)
+
+var reviewTemplateFieldPattern = regexp.MustCompile(`{{\s*(\w+)\s*}}`)
+
+// ErrMissingTemplateFields happens when a ReviewResponseTemplate refers to merge fields that were not provided.
+type ErrMissingTemplateFields struct {
+	Fields []string
+}
+
+func (e ErrMissingTemplateFields) Error() string {
+	return fmt.Sprintf("template refers to missing fields: %s", strings.Join(e.Fields, ", "))
+}
+
+// ErrReviewResponseTooLong happens when a rendered review response exceeds MaxCustomerReviewResponseLength.
+type ErrReviewResponseTooLong struct {
+	Length int
+}
+
+func (e ErrReviewResponseTooLong) Error() string {
+	return fmt.Sprintf("review response is %d characters long, the limit is %d", e.Length, MaxCustomerReviewResponseLength)
+}
+
+// ReviewResponseTemplate is the text of a customer review response with merge fields written as {{fieldName}},
+// such as "Thanks {{customerName}}, this is fixed in {{appVersion}}."
+type ReviewResponseTemplate string
+
+// Fields lists the distinct merge fields the template refers to, sorted by name.
+func (t ReviewResponseTemplate) Fields() []string {
+	seen := make(map[string]bool)
+	fields := make([]string, 0)
+
+	for _, match := range reviewTemplateFieldPattern.FindAllStringSubmatch(string(t), -1) {
+		if !seen[match[1]] {
+			seen[match[1]] = true
+			fields = append(fields, match[1])
+		}
+	}
+
+	sort.Strings(fields)
+
+	return fields
+}
+
+// Render substitutes the merge fields of the template with values. It fails with ErrMissingTemplateFields if the
+// template refers to a field that has no value, and with ErrReviewResponseTooLong if the result is longer than
+// App Store Connect accepts.
+func (t ReviewResponseTemplate) Render(values map[string]string) (string, error) {
+	missing := make([]string, 0)
+
+	for _, field := range t.Fields() {
+		if _, ok := values[field]; !ok {
+			missing = append(missing, field)
+		}
+	}
+
+	if len(missing) > 0 {
+		return "", ErrMissingTemplateFields{Fields: missing}
+	}
+
+	rendered := reviewTemplateFieldPattern.ReplaceAllStringFunc(string(t), func(match string) string {
+		return values[reviewTemplateFieldPattern.FindStringSubmatch(match)[1]]
+	})
+	rendered = strings.TrimSpace(rendered)
+
+	if length := utf8.RuneCountInString(rendered); length > MaxCustomerReviewResponseLength {
+		return "", ErrReviewResponseTooLong{Length: length}
+	}
+
+	return rendered, nil
+}
+
+// CustomerReviewFields returns the merge fields that can be filled in from a customer review. Attributes the
+// review does not carry are left out, so that templates referring to them fail to render.
+func CustomerReviewFields(review CustomerReview) map[string]string {
+	fields := make(map[string]string)
+
+	attrs := review.Attributes
+	if attrs == nil {
+		return fields
+	}
+
+	if attrs.ReviewerNickname != nil {
+		fields[ReviewFieldCustomerName] = *attrs.ReviewerNickname
+	}
+
+	if attrs.Rating != nil {
+		fields[ReviewFieldRating] = strconv.Itoa(*attrs.Rating)
+	}
+
+	if attrs.Territory != nil {
+		fields[ReviewFieldTerritory] = string(*attrs.Territory)
+	}
+
+	if attrs.Title != nil {
+		fields[ReviewFieldTitle] = *attrs.Title
+	}
+
+	return fields
+}
+
+// RespondToCustomerReview renders template with the fields of review, overridden by extra, and posts the result
+// as the developer response to the review. Nothing is sent if the template cannot be rendered.
+func (s *AppsService) RespondToCustomerReview(ctx context.Context, review CustomerReview, template ReviewResponseTemplate, extra map[string]string) (*CustomerReviewResponseV1Response, *Response, error) {
+	values := CustomerReviewFields(review)
+	for field, value := range extra {
+		values[field] = value
+	}
+
+	body, err := template.Render(values)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.CreateCustomerReviewResponse(ctx, body, review.ID)
+}
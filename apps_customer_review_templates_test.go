@@ -0,0 +1,111 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReviewResponseTemplateFields(t *testing.T) {
+	t.Parallel()
+
+	template := ReviewResponseTemplate("Hi {{customerName}}, {{ appVersion }} fixes this. Thanks {{customerName}}!")
+
+	assert.Equal(t, []string{"appVersion", "customerName"}, template.Fields())
+	assert.Empty(t, ReviewResponseTemplate("Thanks!").Fields())
+}
+
+func TestReviewResponseTemplateRender(t *testing.T) {
+	t.Parallel()
+
+	template := ReviewResponseTemplate("Hi {{customerName}}, {{ appVersion }} fixes this.\n")
+
+	got, err := template.Render(map[string]string{"customerName": "Kim", "appVersion": "2.1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "Hi Kim, 2.1 fixes this.", got)
+
+	_, err = template.Render(map[string]string{"customerName": "Kim"})
+	assert.Equal(t, ErrMissingTemplateFields{Fields: []string{"appVersion"}}, err)
+}
+
+func TestReviewResponseTemplateRenderTooLong(t *testing.T) {
+	t.Parallel()
+
+	template := ReviewResponseTemplate("{{body}}")
+
+	_, err := template.Render(map[string]string{"body": strings.Repeat("é", MaxCustomerReviewResponseLength)})
+	assert.NoError(t, err)
+
+	_, err = template.Render(map[string]string{"body": strings.Repeat("é", MaxCustomerReviewResponseLength+1)})
+	assert.Equal(t, ErrReviewResponseTooLong{Length: MaxCustomerReviewResponseLength + 1}, err)
+	assert.Contains(t, err.Error(), "5971")
+}
+
+func TestCustomerReviewFields(t *testing.T) {
+	t.Parallel()
+
+	territory := TerritoryCodeUSA
+	review := CustomerReview{
+		Attributes: &CustomerReviewAttributes{
+			Rating:           Int(4),
+			ReviewerNickname: String("Kim"),
+			Territory:        &territory,
+			Title:            String("Nice"),
+		},
+	}
+
+	assert.Equal(t, map[string]string{
+		ReviewFieldCustomerName: "Kim",
+		ReviewFieldRating:       "4",
+		ReviewFieldTerritory:    "USA",
+		ReviewFieldTitle:        "Nice",
+	}, CustomerReviewFields(review))
+	assert.Empty(t, CustomerReviewFields(CustomerReview{}))
+}
+
+func TestRespondToCustomerReview(t *testing.T) {
+	t.Parallel()
+
+	review := CustomerReview{
+		ID:         "10",
+		Attributes: &CustomerReviewAttributes{ReviewerNickname: String("Kim")},
+	}
+	template := ReviewResponseTemplate("Thanks {{customerName}}, see {{appVersion}}.")
+
+	testEndpointWithResponse(t, "{}", &CustomerReviewResponseV1Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.RespondToCustomerReview(ctx, review, template, map[string]string{ReviewFieldAppVersion: "2.1"})
+	})
+}
+
+func TestRespondToCustomerReviewMissingFields(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{})
+	defer server.Close()
+
+	_, _, err := client.Apps.RespondToCustomerReview(context.Background(), CustomerReview{ID: "10"}, "Thanks {{customerName}}", nil)
+	assert.Equal(t, ErrMissingTemplateFields{Fields: []string{ReviewFieldCustomerName}}, err)
+	assert.Empty(t, *requests)
+}
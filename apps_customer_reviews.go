@@ -0,0 +1,267 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CustomerReview defines model for CustomerReview.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreview
+type CustomerReview struct {
+	Attributes    *CustomerReviewAttributes    `json:"attributes,omitempty"`
+	ID            string                       `json:"id"`
+	Links         ResourceLinks                `json:"links"`
+	Relationships *CustomerReviewRelationships `json:"relationships,omitempty"`
+	Type          string                       `json:"type"`
+}
+
+// CustomerReviewAttributes defines model for CustomerReview.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreview/attributes
+type CustomerReviewAttributes struct {
+	Body             *string        `json:"body,omitempty"`
+	CreatedDate      *DateTime      `json:"createdDate,omitempty"`
+	Rating           *int           `json:"rating,omitempty"`
+	ReviewerNickname *string        `json:"reviewerNickname,omitempty"`
+	Territory        *TerritoryCode `json:"territory,omitempty"`
+	Title            *string        `json:"title,omitempty"`
+}
+
+// CustomerReviewRelationships defines model for CustomerReview.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreview/relationships
+type CustomerReviewRelationships struct {
+	Response *Relationship `json:"response,omitempty"`
+}
+
+// CustomerReviewResponse defines model for CustomerReviewResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreviewresponse
+type CustomerReviewResponse struct {
+	Data     CustomerReview             `json:"data"`
+	Included []CustomerReviewResponseV1 `json:"included,omitempty"`
+	Links    DocumentLinks              `json:"links"`
+}
+
+// CustomerReviewsResponse defines model for CustomerReviewsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreviewsresponse
+type CustomerReviewsResponse struct {
+	Data     []CustomerReview           `json:"data"`
+	Included []CustomerReviewResponseV1 `json:"included,omitempty"`
+	Links    PagedDocumentLinks         `json:"links"`
+	Meta     *PagingInformation         `json:"meta,omitempty"`
+}
+
+// CustomerReviewResponseState defines model for CustomerReviewResponseV1.Attributes.State
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreviewresponsev1/attributes
+type CustomerReviewResponseState string
+
+const (
+	// CustomerReviewResponseStatePendingPublish is a response that has been submitted but is not yet visible on the App Store.
+	CustomerReviewResponseStatePendingPublish CustomerReviewResponseState = "PENDING_PUBLISH"
+	// CustomerReviewResponseStatePublished is a response that is visible on the App Store.
+	CustomerReviewResponseStatePublished CustomerReviewResponseState = "PUBLISHED"
+)
+
+var customerReviewResponseStates = []CustomerReviewResponseState{
+	CustomerReviewResponseStatePendingPublish,
+	CustomerReviewResponseStatePublished,
+}
+
+// IsValid reports whether the customer review response state is one this package knows about.
+func (v CustomerReviewResponseState) IsValid() bool {
+	for _, value := range customerReviewResponseStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCustomerReviewResponseState returns s as a CustomerReviewResponseState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCustomerReviewResponseState(s string) (CustomerReviewResponseState, error) {
+	if v := CustomerReviewResponseState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CustomerReviewResponseState", Value: s}
+}
+
+// CustomerReviewResponseV1 defines model for CustomerReviewResponseV1.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreviewresponsev1
+type CustomerReviewResponseV1 struct {
+	Attributes    *CustomerReviewResponseV1Attributes    `json:"attributes,omitempty"`
+	ID            string                                 `json:"id"`
+	Links         ResourceLinks                          `json:"links"`
+	Relationships *CustomerReviewResponseV1Relationships `json:"relationships,omitempty"`
+	Type          string                                 `json:"type"`
+}
+
+// CustomerReviewResponseV1Attributes defines model for CustomerReviewResponseV1.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreviewresponsev1/attributes
+type CustomerReviewResponseV1Attributes struct {
+	LastModifiedDate *DateTime                    `json:"lastModifiedDate,omitempty"`
+	ResponseBody     *string                      `json:"responseBody,omitempty"`
+	State            *CustomerReviewResponseState `json:"state,omitempty"`
+}
+
+// CustomerReviewResponseV1Relationships defines model for CustomerReviewResponseV1.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreviewresponsev1/relationships
+type CustomerReviewResponseV1Relationships struct {
+	Review *Relationship `json:"review,omitempty"`
+}
+
+// CustomerReviewResponseV1Response defines model for CustomerReviewResponseV1Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreviewresponsev1response
+type CustomerReviewResponseV1Response struct {
+	Data     CustomerReviewResponseV1 `json:"data"`
+	Included []CustomerReview         `json:"included,omitempty"`
+	Links    DocumentLinks            `json:"links"`
+}
+
+// customerReviewResponseCreateRequest defines model for CustomerReviewResponseV1CreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreviewresponsev1createrequest/data
+type customerReviewResponseCreateRequest struct {
+	Attributes    customerReviewResponseCreateRequestAttributes    `json:"attributes"`
+	Relationships customerReviewResponseCreateRequestRelationships `json:"relationships"`
+	Type          string                                           `json:"type"`
+}
+
+// customerReviewResponseCreateRequestAttributes are attributes for CustomerReviewResponseV1CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreviewresponsev1createrequest/data/attributes
+type customerReviewResponseCreateRequestAttributes struct {
+	ResponseBody string `json:"responseBody"`
+}
+
+// customerReviewResponseCreateRequestRelationships are relationships for CustomerReviewResponseV1CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/customerreviewresponsev1createrequest/data/relationships
+type customerReviewResponseCreateRequestRelationships struct {
+	Review relationshipDeclaration `json:"review"`
+}
+
+// ListCustomerReviewsQuery are query options for ListCustomerReviewsForApp and ListCustomerReviewsForAppStoreVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_customer_reviews_for_an_app
+type ListCustomerReviewsQuery struct {
+	ExistsPublishedResponse       *bool    `url:"exists[publishedResponse],omitempty"`
+	FieldsCustomerReviews         []string `url:"fields[customerReviews],omitempty"`
+	FieldsCustomerReviewResponses []string `url:"fields[customerReviewResponses],omitempty"`
+	FilterRating                  []string `url:"filter[rating],omitempty"`
+	FilterTerritory               []string `url:"filter[territory],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Sort                          []string `url:"sort,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// GetCustomerReviewQuery are query options for GetCustomerReview
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_customerreviews_id
+type GetCustomerReviewQuery struct {
+	FieldsCustomerReviews         []string `url:"fields[customerReviews],omitempty"`
+	FieldsCustomerReviewResponses []string `url:"fields[customerReviewResponses],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+}
+
+// GetResponseForCustomerReviewQuery are query options for GetResponseForCustomerReview and GetCustomerReviewResponse
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_customerreviews_id_response
+type GetResponseForCustomerReviewQuery struct {
+	FieldsCustomerReviews         []string `url:"fields[customerReviews],omitempty"`
+	FieldsCustomerReviewResponses []string `url:"fields[customerReviewResponses],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+}
+
+// ListCustomerReviewsForApp lists the customer reviews left for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_customer_reviews_for_an_app
+func (s *AppsService) ListCustomerReviewsForApp(ctx context.Context, id string, params *ListCustomerReviewsQuery) (*CustomerReviewsResponse, *Response, error) {
+	return Get[CustomerReviewsResponse](ctx, s.client, fmt.Sprintf("apps/%s/customerReviews", id), params)
+}
+
+// ListCustomerReviewsForAppStoreVersion lists the customer reviews left for a specific App Store version of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_customer_reviews_for_an_app_store_version
+func (s *AppsService) ListCustomerReviewsForAppStoreVersion(ctx context.Context, id string, params *ListCustomerReviewsQuery) (*CustomerReviewsResponse, *Response, error) {
+	return Get[CustomerReviewsResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s/customerReviews", id), params)
+}
+
+// GetCustomerReview gets a single customer review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_customerreviews_id
+func (s *AppsService) GetCustomerReview(ctx context.Context, id string, params *GetCustomerReviewQuery) (*CustomerReviewResponse, *Response, error) {
+	return Get[CustomerReviewResponse](ctx, s.client, fmt.Sprintf("customerReviews/%s", id), params)
+}
+
+// GetResponseForCustomerReview gets the developer response to a customer review, if one exists.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_customerreviews_id_response
+func (s *AppsService) GetResponseForCustomerReview(ctx context.Context, id string, params *GetResponseForCustomerReviewQuery) (*CustomerReviewResponseV1Response, *Response, error) {
+	return Get[CustomerReviewResponseV1Response](ctx, s.client, fmt.Sprintf("customerReviews/%s/response", id), params)
+}
+
+// GetCustomerReviewResponse gets a developer response to a customer review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_customerreviewresponses_id
+func (s *AppsService) GetCustomerReviewResponse(ctx context.Context, id string, params *GetResponseForCustomerReviewQuery) (*CustomerReviewResponseV1Response, *Response, error) {
+	return Get[CustomerReviewResponseV1Response](ctx, s.client, fmt.Sprintf("customerReviewResponses/%s", id), params)
+}
+
+// CreateCustomerReviewResponse replies to a customer review, or replaces the existing reply if the review has one.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_customerreviewresponses
+func (s *AppsService) CreateCustomerReviewResponse(ctx context.Context, responseBody string, customerReviewID string) (*CustomerReviewResponseV1Response, *Response, error) {
+	req := customerReviewResponseCreateRequest{
+		Attributes: customerReviewResponseCreateRequestAttributes{
+			ResponseBody: responseBody,
+		},
+		Relationships: customerReviewResponseCreateRequestRelationships{
+			Review: *newRelationshipDeclaration(&customerReviewID, "customerReviews"),
+		},
+		Type: "customerReviewResponses",
+	}
+	res := new(CustomerReviewResponseV1Response)
+	resp, err := s.client.post(ctx, "customerReviewResponses", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteCustomerReviewResponse deletes a developer response to a customer review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_v1_customerreviewresponses_id
+func (s *AppsService) DeleteCustomerReviewResponse(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("customerReviewResponses/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
@@ -0,0 +1,95 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCustomerReviewsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CustomerReviewsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListCustomerReviewsForApp(ctx, "10", &ListCustomerReviewsQuery{ExistsPublishedResponse: Bool(false)})
+	})
+}
+
+func TestListCustomerReviewsForAppStoreVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CustomerReviewsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListCustomerReviewsForAppStoreVersion(ctx, "10", &ListCustomerReviewsQuery{})
+	})
+}
+
+func TestGetCustomerReview(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CustomerReviewResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetCustomerReview(ctx, "10", &GetCustomerReviewQuery{})
+	})
+}
+
+func TestGetResponseForCustomerReview(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CustomerReviewResponseV1Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetResponseForCustomerReview(ctx, "10", &GetResponseForCustomerReviewQuery{})
+	})
+}
+
+func TestGetCustomerReviewResponse(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CustomerReviewResponseV1Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetCustomerReviewResponse(ctx, "10", &GetResponseForCustomerReviewQuery{})
+	})
+}
+
+func TestCreateCustomerReviewResponse(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CustomerReviewResponseV1Response{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateCustomerReviewResponse(ctx, "Thanks!", "10")
+	})
+}
+
+func TestDeleteCustomerReviewResponse(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteCustomerReviewResponse(ctx, "10")
+	})
+}
+
+func TestParseCustomerReviewResponseState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCustomerReviewResponseState(string(CustomerReviewResponseStatePendingPublish))
+	assert.NoError(t, err)
+	assert.Equal(t, CustomerReviewResponseStatePendingPublish, got)
+
+	_, err = ParseCustomerReviewResponseState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CustomerReviewResponseState", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,69 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrFamilySharingIrreversible happens when an update would turn Family Sharing off for a subscription or
+// in-app purchase. App Store Connect only allows Family Sharing to be turned on, and once it is on it stays on.
+var ErrFamilySharingIrreversible = errors.New("family sharing cannot be turned off once it is enabled")
+
+// EnableFamilySharingForSubscription turns on Family Sharing for an auto-renewable subscription, unless it is on
+// already. This cannot be undone: once family members have access to a subscription, it can't be taken away.
+func (s *AppsService) EnableFamilySharingForSubscription(ctx context.Context, id string) (*SubscriptionResponse, *Response, error) {
+	current, resp, err := s.GetSubscription(ctx, id, &GetSubscriptionQuery{
+		FieldsSubscriptions: []string{"familySharable"},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if attrs := current.Data.Attributes; attrs != nil && attrs.FamilySharable != nil && *attrs.FamilySharable {
+		return current, resp, nil
+	}
+
+	return s.UpdateSubscription(ctx, id, &SubscriptionUpdateRequestAttributes{
+		FamilySharable: Bool(true),
+	})
+}
+
+// EnableFamilySharingForInAppPurchase turns on Family Sharing for a non-consumable in-app purchase or non-renewing
+// subscription, unless it is on already. This cannot be undone: once family members have access to a purchase,
+// it can't be taken away.
+func (s *AppsService) EnableFamilySharingForInAppPurchase(ctx context.Context, id string) (*InAppPurchaseV2Response, *Response, error) {
+	current, resp, err := s.GetInAppPurchaseV2(ctx, id, &GetInAppPurchaseV2Query{
+		FieldsInAppPurchases: []string{"familySharable"},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if attrs := current.Data.Attributes; attrs != nil && attrs.FamilySharable != nil && *attrs.FamilySharable {
+		return current, resp, nil
+	}
+
+	return s.UpdateInAppPurchaseV2(ctx, id, &InAppPurchaseV2UpdateRequestAttributes{
+		FamilySharable: Bool(true),
+	})
+}
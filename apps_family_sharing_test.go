@@ -0,0 +1,72 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnableFamilySharingForSubscription(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /subscriptions/10":   `{"data":{"type":"subscriptions","id":"10","attributes":{"familySharable":false}}}`,
+		"PATCH /subscriptions/10": `{"data":{"type":"subscriptions","id":"10","attributes":{"familySharable":true}}}`,
+		"GET /subscriptions/20":   `{"data":{"type":"subscriptions","id":"20","attributes":{"familySharable":true}}}`,
+	})
+	defer server.Close()
+
+	sub, _, err := client.Apps.EnableFamilySharingForSubscription(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Equal(t, Bool(true), sub.Data.Attributes.FamilySharable)
+
+	sub, _, err = client.Apps.EnableFamilySharingForSubscription(context.Background(), "20")
+	assert.NoError(t, err)
+	assert.Equal(t, "20", sub.Data.ID)
+
+	assert.Equal(t, []string{"GET /subscriptions/10", "PATCH /subscriptions/10", "GET /subscriptions/20"}, *requests)
+}
+
+func TestEnableFamilySharingForInAppPurchase(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /v2/inAppPurchases/10":   `{"data":{"type":"inAppPurchases","id":"10"}}`,
+		"PATCH /v2/inAppPurchases/10": `{"data":{"type":"inAppPurchases","id":"10","attributes":{"familySharable":true}}}`,
+		"GET /v2/inAppPurchases/20":   `{"data":{"type":"inAppPurchases","id":"20","attributes":{"familySharable":true}}}`,
+	})
+	defer server.Close()
+
+	iap, _, err := client.Apps.EnableFamilySharingForInAppPurchase(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Equal(t, Bool(true), iap.Data.Attributes.FamilySharable)
+
+	_, _, err = client.Apps.EnableFamilySharingForInAppPurchase(context.Background(), "20")
+	assert.NoError(t, err)
+
+	_, _, err = client.Apps.EnableFamilySharingForInAppPurchase(context.Background(), "30")
+	assert.Error(t, err)
+
+	assert.Equal(t, []string{"GET /v2/inAppPurchases/10", "PATCH /v2/inAppPurchases/10", "GET /v2/inAppPurchases/20", "GET /v2/inAppPurchases/30"}, *requests)
+}
@@ -0,0 +1,133 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// InAppPurchasePrice defines model for InAppPurchasePrice.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseprice
+type InAppPurchasePrice struct {
+	Attributes    *InAppPurchasePriceAttributes    `json:"attributes,omitempty"`
+	ID            string                           `json:"id"`
+	Relationships *InAppPurchasePriceRelationships `json:"relationships,omitempty"`
+	Type          string                           `json:"type"`
+}
+
+// InAppPurchasePriceAttributes defines model for InAppPurchasePrice.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseprice/attributes
+type InAppPurchasePriceAttributes struct {
+	EndDate   *Date `json:"endDate,omitempty"`
+	Manual    *bool `json:"manual,omitempty"`
+	StartDate *Date `json:"startDate,omitempty"`
+}
+
+// InAppPurchasePriceRelationships defines model for InAppPurchasePrice.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchaseprice/relationships
+type InAppPurchasePriceRelationships struct {
+	InAppPurchasePricePoint *Relationship `json:"inAppPurchasePricePoint,omitempty"`
+	Territory               *Relationship `json:"territory,omitempty"`
+}
+
+// InAppPurchasePricesResponse defines model for InAppPurchasePricesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepricesresponse
+type InAppPurchasePricesResponse struct {
+	Data     []InAppPurchasePrice                 `json:"data"`
+	Included []InAppPurchasePriceResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                   `json:"links"`
+	Meta     *PagingInformation                   `json:"meta,omitempty"`
+}
+
+// InAppPurchasePriceResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a InAppPurchasePricesResponse.
+type InAppPurchasePriceResponseIncluded included
+
+// InAppPurchasePricePoint defines model for InAppPurchasePricePoint.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepricepoint
+type InAppPurchasePricePoint struct {
+	Attributes    *InAppPurchasePricePointAttributes    `json:"attributes,omitempty"`
+	ID            string                                `json:"id"`
+	Links         ResourceLinks                         `json:"links"`
+	Relationships *InAppPurchasePricePointRelationships `json:"relationships,omitempty"`
+	Type          string                                `json:"type"`
+}
+
+// InAppPurchasePricePointAttributes defines model for InAppPurchasePricePoint.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepricepoint/attributes
+type InAppPurchasePricePointAttributes struct {
+	CustomerPrice *string `json:"customerPrice,omitempty"`
+	Proceeds      *string `json:"proceeds,omitempty"`
+}
+
+// InAppPurchasePricePointRelationships defines model for InAppPurchasePricePoint.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasepricepoint/relationships
+type InAppPurchasePricePointRelationships struct {
+	Territory *Relationship `json:"territory,omitempty"`
+}
+
+// ListManualPricesForInAppPurchaseQuery are query options for ListManualPricesForInAppPurchase
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_inapppurchasepriceschedules_id_manualprices
+type ListManualPricesForInAppPurchaseQuery struct {
+	FieldsInAppPurchasePrices      []string `url:"fields[inAppPurchasePrices],omitempty"`
+	FieldsInAppPurchasePricePoints []string `url:"fields[inAppPurchasePricePoints],omitempty"`
+	FieldsTerritories              []string `url:"fields[territories],omitempty"`
+	FilterTerritory                []string `url:"filter[territory],omitempty"`
+	Include                        []string `url:"include,omitempty"`
+	Limit                          int      `url:"limit,omitempty"`
+	Cursor                         string   `url:"cursor,omitempty"`
+}
+
+// ListManualPricesForInAppPurchase lists the prices set for an in-app purchase in each territory. The price schedule
+// of an in-app purchase shares its ID.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_inapppurchasepriceschedules_id_manualprices
+func (s *AppsService) ListManualPricesForInAppPurchase(ctx context.Context, id string, params *ListManualPricesForInAppPurchaseQuery) (*InAppPurchasePricesResponse, *Response, error) {
+	return Get[InAppPurchasePricesResponse](ctx, s.client, fmt.Sprintf("inAppPurchasePriceSchedules/%s/manualPrices", id), params)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in InAppPurchasePriceResponseIncluded.
+func (i *InAppPurchasePriceResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// InAppPurchasePricePoint returns the InAppPurchasePricePoint stored within, if one is present.
+func (i *InAppPurchasePriceResponseIncluded) InAppPurchasePricePoint() *InAppPurchasePricePoint {
+	return extractIncludedInAppPurchasePricePoint(i.inner)
+}
+
+// Territory returns the Territory stored within, if one is present.
+func (i *InAppPurchasePriceResponseIncluded) Territory() *Territory {
+	return extractIncludedTerritory(i.inner)
+}
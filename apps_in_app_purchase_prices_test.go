@@ -0,0 +1,52 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListManualPricesForInAppPurchase(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchasePricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListManualPricesForInAppPurchase(ctx, "10", &ListManualPricesForInAppPurchaseQuery{})
+	})
+}
+
+func TestListManualPricesForInAppPurchaseIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"inAppPurchasePricePoints"},{"type":"territories"}]}`, func(ctx context.Context, client *Client) {
+		prices, _, err := client.Apps.ListManualPricesForInAppPurchase(ctx, "10", &ListManualPricesForInAppPurchaseQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, prices.Included)
+
+		assert.NotNil(t, prices.Included[0].InAppPurchasePricePoint())
+		assert.NotNil(t, prices.Included[1].Territory())
+
+		assert.Nil(t, prices.Included[0].Territory())
+		assert.Nil(t, prices.Included[1].InAppPurchasePricePoint())
+	})
+}
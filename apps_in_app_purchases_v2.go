@@ -0,0 +1,153 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// InAppPurchaseV2 defines model for InAppPurchaseV2.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2
+type InAppPurchaseV2 struct {
+	Attributes    *InAppPurchaseV2Attributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *InAppPurchaseV2Relationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// InAppPurchaseV2Attributes defines model for InAppPurchaseV2.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2/attributes
+type InAppPurchaseV2Attributes struct {
+	ContentHosting    *bool              `json:"contentHosting,omitempty"`
+	FamilySharable    *bool              `json:"familySharable,omitempty"`
+	InAppPurchaseType *string            `json:"inAppPurchaseType,omitempty"`
+	Name              *string            `json:"name,omitempty"`
+	ProductID         *string            `json:"productId,omitempty"`
+	ReviewNote        *string            `json:"reviewNote,omitempty"`
+	State             *SubscriptionState `json:"state,omitempty"`
+}
+
+// InAppPurchaseV2Relationships defines model for InAppPurchaseV2.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2/relationships
+type InAppPurchaseV2Relationships struct {
+	AppStoreReviewScreenshot   *Relationship      `json:"appStoreReviewScreenshot,omitempty"`
+	InAppPurchaseLocalizations *PagedRelationship `json:"inAppPurchaseLocalizations,omitempty"`
+	IapPriceSchedule           *Relationship      `json:"iapPriceSchedule,omitempty"`
+	PromotedPurchase           *Relationship      `json:"promotedPurchase,omitempty"`
+}
+
+// InAppPurchaseV2Response defines model for InAppPurchaseV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2response
+type InAppPurchaseV2Response struct {
+	Data  InAppPurchaseV2 `json:"data"`
+	Links DocumentLinks   `json:"links"`
+}
+
+// InAppPurchasesV2Response defines model for InAppPurchasesV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesv2response
+type InAppPurchasesV2Response struct {
+	Data  []InAppPurchaseV2  `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// inAppPurchaseV2UpdateRequest defines model for InAppPurchaseV2UpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2updaterequest/data
+type inAppPurchaseV2UpdateRequest struct {
+	Attributes *InAppPurchaseV2UpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                  `json:"id"`
+	Type       string                                  `json:"type"`
+}
+
+// InAppPurchaseV2UpdateRequestAttributes are attributes for InAppPurchaseV2UpdateRequest
+//
+// FamilySharable can only be turned on. Once an in-app purchase is shared with family members it cannot be
+// turned off again, so setting it to false is rejected before a request is made.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasev2updaterequest/data/attributes
+type InAppPurchaseV2UpdateRequestAttributes struct {
+	FamilySharable *bool   `json:"familySharable,omitempty"`
+	Name           *string `json:"name,omitempty"`
+	ReviewNote     *string `json:"reviewNote,omitempty"`
+}
+
+// ListInAppPurchasesV2ForAppQuery are query options for ListInAppPurchasesV2ForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_inapppurchasesv2
+type ListInAppPurchasesV2ForAppQuery struct {
+	FieldsInAppPurchases    []string `url:"fields[inAppPurchases],omitempty"`
+	FilterInAppPurchaseType []string `url:"filter[inAppPurchaseType],omitempty"`
+	FilterName              []string `url:"filter[name],omitempty"`
+	FilterProductID         []string `url:"filter[productId],omitempty"`
+	FilterState             []string `url:"filter[state],omitempty"`
+	Limit                   int      `url:"limit,omitempty"`
+	Sort                    []string `url:"sort,omitempty"`
+	Cursor                  string   `url:"cursor,omitempty"`
+}
+
+// GetInAppPurchaseV2Query are query options for GetInAppPurchaseV2
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v2_inapppurchases_id
+type GetInAppPurchaseV2Query struct {
+	FieldsInAppPurchases []string `url:"fields[inAppPurchases],omitempty"`
+}
+
+// ListInAppPurchasesV2ForApp lists the in-app purchases of an app, with the attributes that can be managed through the API.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_inapppurchasesv2
+func (s *AppsService) ListInAppPurchasesV2ForApp(ctx context.Context, id string, params *ListInAppPurchasesV2ForAppQuery) (*InAppPurchasesV2Response, *Response, error) {
+	return Get[InAppPurchasesV2Response](ctx, s.client, fmt.Sprintf("apps/%s/inAppPurchasesV2", id), params)
+}
+
+// GetInAppPurchaseV2 reads the information about an in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v2_inapppurchases_id
+func (s *AppsService) GetInAppPurchaseV2(ctx context.Context, id string, params *GetInAppPurchaseV2Query) (*InAppPurchaseV2Response, *Response, error) {
+	return Get[InAppPurchaseV2Response](ctx, s.client, fmt.Sprintf("../v2/inAppPurchases/%s", id), params)
+}
+
+// UpdateInAppPurchaseV2 modifies the name, review note or Family Sharing of an in-app purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v2_inapppurchases_id
+func (s *AppsService) UpdateInAppPurchaseV2(ctx context.Context, id string, attributes *InAppPurchaseV2UpdateRequestAttributes) (*InAppPurchaseV2Response, *Response, error) {
+	if attributes != nil && attributes.FamilySharable != nil && !*attributes.FamilySharable {
+		return nil, nil, ErrFamilySharingIrreversible
+	}
+
+	req := inAppPurchaseV2UpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "inAppPurchases",
+	}
+	url := fmt.Sprintf("../v2/inAppPurchases/%s", id)
+	res := new(InAppPurchaseV2Response)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
@@ -23,6 +23,8 @@ package asc
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 )
 
 // AppStoreAgeRating defines model for AppStoreAgeRating.
@@ -41,6 +43,33 @@ const (
 	AppStoreAgeRatingTwelvePlus AppStoreAgeRating = "TWELVE_PLUS"
 )
 
+var appStoreAgeRatings = []AppStoreAgeRating{
+	AppStoreAgeRatingFourPlus,
+	AppStoreAgeRatingNinePlus,
+	AppStoreAgeRatingSeventeenPlus,
+	AppStoreAgeRatingTwelvePlus,
+}
+
+// IsValid reports whether the app store age rating is one this package knows about.
+func (v AppStoreAgeRating) IsValid() bool {
+	for _, value := range appStoreAgeRatings {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppStoreAgeRating returns s as an AppStoreAgeRating, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppStoreAgeRating(s string) (AppStoreAgeRating, error) {
+	if v := AppStoreAgeRating(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppStoreAgeRating", Value: s}
+}
+
 // BrazilAgeRating defines model for BrazilAgeRating.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/brazilagerating
@@ -61,6 +90,35 @@ const (
 	BrazilAgeRatingTwelve BrazilAgeRating = "TWELVE"
 )
 
+var brazilAgeRatings = []BrazilAgeRating{
+	BrazilAgeRatingEighteen,
+	BrazilAgeRatingFourteen,
+	BrazilAgeRatingL,
+	BrazilAgeRatingSixteen,
+	BrazilAgeRatingTen,
+	BrazilAgeRatingTwelve,
+}
+
+// IsValid reports whether the brazil age rating is one this package knows about.
+func (v BrazilAgeRating) IsValid() bool {
+	for _, value := range brazilAgeRatings {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseBrazilAgeRating returns s as a BrazilAgeRating, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseBrazilAgeRating(s string) (BrazilAgeRating, error) {
+	if v := BrazilAgeRating(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "BrazilAgeRating", Value: s}
+}
+
 // KidsAgeBand defines model for KidsAgeBand.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/kidsageband
@@ -75,6 +133,162 @@ const (
 	KidsAgeBandSixToEight KidsAgeBand = "SIX_TO_EIGHT"
 )
 
+var kidsAgeBands = []KidsAgeBand{
+	KidsAgeBandFiveAndUnder,
+	KidsAgeBandNineToEleven,
+	KidsAgeBandSixToEight,
+}
+
+// IsValid reports whether the kids age band is one this package knows about.
+func (v KidsAgeBand) IsValid() bool {
+	for _, value := range kidsAgeBands {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseKidsAgeBand returns s as a KidsAgeBand, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseKidsAgeBand(s string) (KidsAgeBand, error) {
+	if v := KidsAgeBand(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "KidsAgeBand", Value: s}
+}
+
+// AgeRatingContentLevel defines model for the frequency of a content descriptor in an AgeRatingDeclaration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ageratingdeclaration/attributes
+type AgeRatingContentLevel string
+
+const (
+	// AgeRatingContentLevelNone is for content that does not appear in the app.
+	AgeRatingContentLevelNone AgeRatingContentLevel = "NONE"
+	// AgeRatingContentLevelInfrequentOrMild is for content that appears infrequently or mildly.
+	AgeRatingContentLevelInfrequentOrMild AgeRatingContentLevel = "INFREQUENT_OR_MILD"
+	// AgeRatingContentLevelFrequentOrIntense is for content that appears frequently or intensely.
+	AgeRatingContentLevelFrequentOrIntense AgeRatingContentLevel = "FREQUENT_OR_INTENSE"
+)
+
+var ageRatingContentLevels = []AgeRatingContentLevel{
+	AgeRatingContentLevelNone,
+	AgeRatingContentLevelInfrequentOrMild,
+	AgeRatingContentLevelFrequentOrIntense,
+}
+
+// IsValid reports whether the age rating content level is one this package knows about.
+func (v AgeRatingContentLevel) IsValid() bool {
+	for _, value := range ageRatingContentLevels {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAgeRatingContentLevel returns s as an AgeRatingContentLevel, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAgeRatingContentLevel(s string) (AgeRatingContentLevel, error) {
+	if v := AgeRatingContentLevel(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AgeRatingContentLevel", Value: s}
+}
+
+// AgeRatingOverride defines model for AgeRatingOverride.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ageratingdeclaration/attributes
+type AgeRatingOverride string
+
+const (
+	// AgeRatingOverrideNone is for keeping the calculated age rating.
+	AgeRatingOverrideNone AgeRatingOverride = "NONE"
+	// AgeRatingOverrideSeventeenPlus is for raising the age rating to 17+.
+	AgeRatingOverrideSeventeenPlus AgeRatingOverride = "SEVENTEEN_PLUS"
+	// AgeRatingOverrideUnrated is for declaring the app as unrated.
+	AgeRatingOverrideUnrated AgeRatingOverride = "UNRATED"
+)
+
+var ageRatingOverrides = []AgeRatingOverride{
+	AgeRatingOverrideNone,
+	AgeRatingOverrideSeventeenPlus,
+	AgeRatingOverrideUnrated,
+}
+
+// IsValid reports whether the age rating override is one this package knows about.
+func (v AgeRatingOverride) IsValid() bool {
+	for _, value := range ageRatingOverrides {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAgeRatingOverride returns s as an AgeRatingOverride, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAgeRatingOverride(s string) (AgeRatingOverride, error) {
+	if v := AgeRatingOverride(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AgeRatingOverride", Value: s}
+}
+
+// KoreaAgeRatingOverride defines model for KoreaAgeRatingOverride.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ageratingdeclaration/attributes
+type KoreaAgeRatingOverride string
+
+const (
+	// KoreaAgeRatingOverrideNone is for keeping the calculated age rating in Korea.
+	KoreaAgeRatingOverrideNone KoreaAgeRatingOverride = "NONE"
+	// KoreaAgeRatingOverrideFifteenPlus is for raising the age rating in Korea to 15+.
+	KoreaAgeRatingOverrideFifteenPlus KoreaAgeRatingOverride = "FIFTEEN_PLUS"
+	// KoreaAgeRatingOverrideNineteenPlus is for raising the age rating in Korea to 19+.
+	KoreaAgeRatingOverrideNineteenPlus KoreaAgeRatingOverride = "NINETEEN_PLUS"
+)
+
+var koreaAgeRatingOverrides = []KoreaAgeRatingOverride{
+	KoreaAgeRatingOverrideNone,
+	KoreaAgeRatingOverrideFifteenPlus,
+	KoreaAgeRatingOverrideNineteenPlus,
+}
+
+// IsValid reports whether the korea age rating override is one this package knows about.
+func (v KoreaAgeRatingOverride) IsValid() bool {
+	for _, value := range koreaAgeRatingOverrides {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseKoreaAgeRatingOverride returns s as a KoreaAgeRatingOverride, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseKoreaAgeRatingOverride(s string) (KoreaAgeRatingOverride, error) {
+	if v := KoreaAgeRatingOverride(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "KoreaAgeRatingOverride", Value: s}
+}
+
+// ErrInvalidAgeRatingDeclaration happens when an age rating declaration contains a combination of answers
+// that App Store Connect will reject or that would make the app ineligible for the Kids category.
+type ErrInvalidAgeRatingDeclaration struct {
+	Problems []string
+}
+
+func (e ErrInvalidAgeRatingDeclaration) Error() string {
+	return fmt.Sprintf("age rating declaration is inconsistent: %s", strings.Join(e.Problems, "; "))
+}
+
 // ageRatingDeclarationUpdateRequest defines model for AgeRatingDeclarationUpdateRequest.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/ageratingdeclarationupdaterequest/data
@@ -88,22 +302,32 @@ type ageRatingDeclarationUpdateRequest struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/ageratingdeclarationupdaterequest/data/attributes
 type AgeRatingDeclarationUpdateRequestAttributes struct {
-	AlcoholTobaccoOrDrugUseOrReferences         *string      `json:"alcoholTobaccoOrDrugUseOrReferences,omitempty"`
-	Contests                                    *string      `json:"contests,omitempty"`
-	Gambling                                    *bool        `json:"gambling,omitempty"`
-	GamblingSimulated                           *string      `json:"gamblingSimulated,omitempty"`
-	HorrorOrFearThemes                          *string      `json:"horrorOrFearThemes,omitempty"`
-	KidsAgeBand                                 *KidsAgeBand `json:"kidsAgeBand,omitempty"`
-	MatureOrSuggestiveThemes                    *string      `json:"matureOrSuggestiveThemes,omitempty"`
-	MedicalOrTreatmentInformation               *string      `json:"medicalOrTreatmentInformation,omitempty"`
-	ProfanityOrCrudeHumor                       *string      `json:"profanityOrCrudeHumor,omitempty"`
-	SexualContentGraphicAndNudity               *string      `json:"sexualContentGraphicAndNudity,omitempty"`
-	SexualContentOrNudity                       *string      `json:"sexualContentOrNudity,omitempty"`
-	SeventeenPlus                               *bool        `json:"seventeenPlus,omitempty"`
-	UnrestrictedWebAccess                       *bool        `json:"unrestrictedWebAccess,omitempty"`
-	ViolenceCartoonOrFantasy                    *string      `json:"violenceCartoonOrFantasy,omitempty"`
-	ViolenceRealistic                           *string      `json:"violenceRealistic,omitempty"`
-	ViolenceRealisticProlongedGraphicOrSadistic *string      `json:"violenceRealisticProlongedGraphicOrSadistic,omitempty"`
+	AgeRatingOverride                           *AgeRatingOverride      `json:"ageRatingOverride,omitempty"`
+	AlcoholTobaccoOrDrugUseOrReferences         *AgeRatingContentLevel  `json:"alcoholTobaccoOrDrugUseOrReferences,omitempty"`
+	Contests                                    *AgeRatingContentLevel  `json:"contests,omitempty"`
+	Gambling                                    *bool                   `json:"gambling,omitempty"`
+	GamblingSimulated                           *AgeRatingContentLevel  `json:"gamblingSimulated,omitempty"`
+	HorrorOrFearThemes                          *AgeRatingContentLevel  `json:"horrorOrFearThemes,omitempty"`
+	KidsAgeBand                                 *KidsAgeBand            `json:"kidsAgeBand,omitempty"`
+	KoreaAgeRatingOverride                      *KoreaAgeRatingOverride `json:"koreaAgeRatingOverride,omitempty"`
+	LootBox                                     *bool                   `json:"lootBox,omitempty"`
+	MatureOrSuggestiveThemes                    *AgeRatingContentLevel  `json:"matureOrSuggestiveThemes,omitempty"`
+	MedicalOrTreatmentInformation               *AgeRatingContentLevel  `json:"medicalOrTreatmentInformation,omitempty"`
+	ProfanityOrCrudeHumor                       *AgeRatingContentLevel  `json:"profanityOrCrudeHumor,omitempty"`
+	SexualContentGraphicAndNudity               *AgeRatingContentLevel  `json:"sexualContentGraphicAndNudity,omitempty"`
+	SexualContentOrNudity                       *AgeRatingContentLevel  `json:"sexualContentOrNudity,omitempty"`
+	SeventeenPlus                               *bool                   `json:"seventeenPlus,omitempty"`
+	UnrestrictedWebAccess                       *bool                   `json:"unrestrictedWebAccess,omitempty"`
+	ViolenceCartoonOrFantasy                    *AgeRatingContentLevel  `json:"violenceCartoonOrFantasy,omitempty"`
+	ViolenceRealistic                           *AgeRatingContentLevel  `json:"violenceRealistic,omitempty"`
+	ViolenceRealisticProlongedGraphicOrSadistic *AgeRatingContentLevel  `json:"violenceRealisticProlongedGraphicOrSadistic,omitempty"`
+}
+
+// GetAgeRatingDeclarationForAppStoreVersionQuery are query options for GetAgeRatingDeclarationForAppStoreVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_age_rating_declaration_information_of_an_app_store_version
+type GetAgeRatingDeclarationForAppStoreVersionQuery struct {
+	FieldsAgeRatingDeclarations []string `url:"fields[ageRatingDeclarations],omitempty"`
 }
 
 // AgeRatingDeclarationResponse defines model for AgeRatingDeclarationResponse.
@@ -129,3 +353,106 @@ func (s *AppsService) UpdateAgeRatingDeclaration(ctx context.Context, id string,
 
 	return res, resp, err
 }
+
+// GetAgeRatingDeclarationForAppStoreVersion gets the age-related information declared for an App Store version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_age_rating_declaration_information_of_an_app_store_version
+func (s *AppsService) GetAgeRatingDeclarationForAppStoreVersion(ctx context.Context, id string, params *GetAgeRatingDeclarationForAppStoreVersionQuery) (*AgeRatingDeclarationResponse, *Response, error) {
+	return Get[AgeRatingDeclarationResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s/ageRatingDeclaration", id), params)
+}
+
+// Validate checks the declared answers for combinations that are inconsistent with each other, such as
+// intense content in an app made for kids, and returns an ErrInvalidAgeRatingDeclaration describing each one.
+func (a *AgeRatingDeclarationAttributes) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	attributes := AgeRatingDeclarationUpdateRequestAttributes(*a)
+
+	return attributes.Validate()
+}
+
+// Validate checks the answers to be submitted for combinations that are inconsistent with each other, such as
+// intense content in an app made for kids, and returns an ErrInvalidAgeRatingDeclaration describing each one.
+func (a *AgeRatingDeclarationUpdateRequestAttributes) Validate() error {
+	if a == nil {
+		return nil
+	}
+
+	var problems []string
+
+	madeForKids := a.KidsAgeBand != nil
+
+	if madeForKids {
+		levels := map[string]*AgeRatingContentLevel{
+			"alcoholTobaccoOrDrugUseOrReferences":         a.AlcoholTobaccoOrDrugUseOrReferences,
+			"contests":                                    a.Contests,
+			"gamblingSimulated":                           a.GamblingSimulated,
+			"horrorOrFearThemes":                          a.HorrorOrFearThemes,
+			"matureOrSuggestiveThemes":                    a.MatureOrSuggestiveThemes,
+			"medicalOrTreatmentInformation":               a.MedicalOrTreatmentInformation,
+			"profanityOrCrudeHumor":                       a.ProfanityOrCrudeHumor,
+			"sexualContentGraphicAndNudity":               a.SexualContentGraphicAndNudity,
+			"sexualContentOrNudity":                       a.SexualContentOrNudity,
+			"violenceCartoonOrFantasy":                    a.ViolenceCartoonOrFantasy,
+			"violenceRealistic":                           a.ViolenceRealistic,
+			"violenceRealisticProlongedGraphicOrSadistic": a.ViolenceRealisticProlongedGraphicOrSadistic,
+		}
+
+		for _, name := range sortedContentLevelNames(levels) {
+			if level := levels[name]; level != nil && *level == AgeRatingContentLevelFrequentOrIntense {
+				problems = append(problems, fmt.Sprintf("%s cannot be %s when kidsAgeBand is set", name, *level))
+			}
+		}
+
+		if a.Gambling != nil && *a.Gambling {
+			problems = append(problems, "gambling cannot be true when kidsAgeBand is set")
+		}
+
+		if a.UnrestrictedWebAccess != nil && *a.UnrestrictedWebAccess {
+			problems = append(problems, "unrestrictedWebAccess cannot be true when kidsAgeBand is set")
+		}
+
+		if a.SeventeenPlus != nil && *a.SeventeenPlus {
+			problems = append(problems, "seventeenPlus cannot be true when kidsAgeBand is set")
+		}
+
+		if a.AgeRatingOverride != nil && *a.AgeRatingOverride != AgeRatingOverrideNone {
+			problems = append(problems, fmt.Sprintf("ageRatingOverride cannot be %s when kidsAgeBand is set", *a.AgeRatingOverride))
+		}
+
+		if a.KoreaAgeRatingOverride != nil && *a.KoreaAgeRatingOverride != KoreaAgeRatingOverrideNone {
+			problems = append(problems, fmt.Sprintf("koreaAgeRatingOverride cannot be %s when kidsAgeBand is set", *a.KoreaAgeRatingOverride))
+		}
+	}
+
+	if isContentDeclared(a.SexualContentGraphicAndNudity) && a.SexualContentOrNudity != nil && *a.SexualContentOrNudity == AgeRatingContentLevelNone {
+		problems = append(problems, "sexualContentOrNudity cannot be NONE when sexualContentGraphicAndNudity is declared")
+	}
+
+	if isContentDeclared(a.ViolenceRealisticProlongedGraphicOrSadistic) && a.ViolenceRealistic != nil && *a.ViolenceRealistic == AgeRatingContentLevelNone {
+		problems = append(problems, "violenceRealistic cannot be NONE when violenceRealisticProlongedGraphicOrSadistic is declared")
+	}
+
+	if len(problems) > 0 {
+		return ErrInvalidAgeRatingDeclaration{Problems: problems}
+	}
+
+	return nil
+}
+
+func isContentDeclared(level *AgeRatingContentLevel) bool {
+	return level != nil && *level != AgeRatingContentLevelNone
+}
+
+func sortedContentLevelNames(m map[string]*AgeRatingContentLevel) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
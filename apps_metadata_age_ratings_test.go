@@ -22,7 +22,10 @@ package asc
 
 import (
 	"context"
+	"errors"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestUpdateAgeRatingDeclaration(t *testing.T) {
@@ -32,3 +35,127 @@ func TestUpdateAgeRatingDeclaration(t *testing.T) {
 		return client.Apps.UpdateAgeRatingDeclaration(ctx, "10", &AgeRatingDeclarationUpdateRequestAttributes{})
 	})
 }
+
+func TestGetAgeRatingDeclarationForAppStoreVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AgeRatingDeclarationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetAgeRatingDeclarationForAppStoreVersion(ctx, "10", &GetAgeRatingDeclarationForAppStoreVersionQuery{})
+	})
+}
+
+func TestValidateAgeRatingDeclaration(t *testing.T) {
+	t.Parallel()
+
+	none := AgeRatingContentLevelNone
+	mild := AgeRatingContentLevelInfrequentOrMild
+	intense := AgeRatingContentLevelFrequentOrIntense
+	band := KidsAgeBandSixToEight
+	override := AgeRatingOverrideSeventeenPlus
+	koreaOverride := KoreaAgeRatingOverrideNineteenPlus
+
+	var nilAttributes *AgeRatingDeclarationUpdateRequestAttributes
+
+	assert.NoError(t, nilAttributes.Validate())
+	assert.NoError(t, (&AgeRatingDeclarationUpdateRequestAttributes{}).Validate())
+	assert.NoError(t, (&AgeRatingDeclarationUpdateRequestAttributes{
+		KidsAgeBand:              &band,
+		ViolenceCartoonOrFantasy: &mild,
+		Gambling:                 Bool(false),
+	}).Validate())
+
+	err := (&AgeRatingDeclarationUpdateRequestAttributes{
+		KidsAgeBand:                   &band,
+		HorrorOrFearThemes:            &intense,
+		Gambling:                      Bool(true),
+		UnrestrictedWebAccess:         Bool(true),
+		SeventeenPlus:                 Bool(true),
+		AgeRatingOverride:             &override,
+		KoreaAgeRatingOverride:        &koreaOverride,
+		SexualContentGraphicAndNudity: &mild,
+		SexualContentOrNudity:         &none,
+	}).Validate()
+	assert.Error(t, err)
+	assert.NotEmpty(t, err.Error())
+
+	var invalid ErrInvalidAgeRatingDeclaration
+
+	assert.True(t, errors.As(err, &invalid))
+	assert.Len(t, invalid.Problems, 7)
+
+	err = (&AgeRatingDeclarationAttributes{
+		ViolenceRealisticProlongedGraphicOrSadistic: &mild,
+		ViolenceRealistic: &none,
+	}).Validate()
+	assert.Error(t, err)
+
+	var nilDeclaration *AgeRatingDeclarationAttributes
+
+	assert.NoError(t, nilDeclaration.Validate())
+}
+
+func TestParseAppStoreAgeRating(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppStoreAgeRating(string(AppStoreAgeRatingFourPlus))
+	assert.NoError(t, err)
+	assert.Equal(t, AppStoreAgeRatingFourPlus, got)
+
+	_, err = ParseAppStoreAgeRating("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppStoreAgeRating", Value: "UNKNOWN"}, err)
+}
+
+func TestParseBrazilAgeRating(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseBrazilAgeRating(string(BrazilAgeRatingEighteen))
+	assert.NoError(t, err)
+	assert.Equal(t, BrazilAgeRatingEighteen, got)
+
+	_, err = ParseBrazilAgeRating("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "BrazilAgeRating", Value: "UNKNOWN"}, err)
+}
+
+func TestParseKidsAgeBand(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseKidsAgeBand(string(KidsAgeBandFiveAndUnder))
+	assert.NoError(t, err)
+	assert.Equal(t, KidsAgeBandFiveAndUnder, got)
+
+	_, err = ParseKidsAgeBand("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "KidsAgeBand", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAgeRatingContentLevel(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAgeRatingContentLevel(string(AgeRatingContentLevelNone))
+	assert.NoError(t, err)
+	assert.Equal(t, AgeRatingContentLevelNone, got)
+
+	_, err = ParseAgeRatingContentLevel("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AgeRatingContentLevel", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAgeRatingOverride(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAgeRatingOverride(string(AgeRatingOverrideNone))
+	assert.NoError(t, err)
+	assert.Equal(t, AgeRatingOverrideNone, got)
+
+	_, err = ParseAgeRatingOverride("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AgeRatingOverride", Value: "UNKNOWN"}, err)
+}
+
+func TestParseKoreaAgeRatingOverride(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseKoreaAgeRatingOverride(string(KoreaAgeRatingOverrideNone))
+	assert.NoError(t, err)
+	assert.Equal(t, KoreaAgeRatingOverrideNone, got)
+
+	_, err = ParseKoreaAgeRatingOverride("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "KoreaAgeRatingOverride", Value: "UNKNOWN"}, err)
+}
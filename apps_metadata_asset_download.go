@@ -0,0 +1,283 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// StoreAssetKind distinguishes screenshots from app previews in a StoreAsset.
+type StoreAssetKind string
+
+const (
+	// StoreAssetKindScreenshot is an app screenshot.
+	StoreAssetKindScreenshot StoreAssetKind = "screenshots"
+	// StoreAssetKindPreview is an app preview video.
+	StoreAssetKindPreview StoreAssetKind = "previews"
+)
+
+var storeAssetKinds = []StoreAssetKind{
+	StoreAssetKindScreenshot,
+	StoreAssetKindPreview,
+}
+
+// IsValid reports whether the store asset kind is one this package knows about.
+func (v StoreAssetKind) IsValid() bool {
+	for _, value := range storeAssetKinds {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseStoreAssetKind returns s as a StoreAssetKind, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseStoreAssetKind(s string) (StoreAssetKind, error) {
+	if v := StoreAssetKind(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "StoreAssetKind", Value: s}
+}
+
+// StoreAsset describes a screenshot or app preview downloaded by DownloadStoreAssets.
+type StoreAsset struct {
+	Kind               StoreAssetKind
+	Locale             string
+	DisplayType        string
+	Position           int
+	FileName           string
+	SourceFileChecksum string
+	URL                string
+	Path               string
+}
+
+// URL resolves the template URL of an image asset at its original size. Format is the file extension
+// to render the image as, such as "png" or "jpg". An empty string is returned if the asset has no template URL.
+func (a ImageAsset) URL(format string) string {
+	if a.TemplateURL == nil {
+		return ""
+	}
+
+	var width, height string
+	if a.Width != nil {
+		width = strconv.Itoa(*a.Width)
+	}
+
+	if a.Height != nil {
+		height = strconv.Itoa(*a.Height)
+	}
+
+	return strings.NewReplacer("{w}", width, "{h}", height, "{f}", format).Replace(*a.TemplateURL)
+}
+
+// DownloadStoreAssets downloads the screenshots and app previews of every localization of an App Store version
+// into dir, laid out as <locale>/screenshots/<display type>/<position>_<file name> and
+// <locale>/previews/<preview type>/<position>_<file name>. Assets that are still processing and have no
+// delivered URL yet are skipped. The downloaded assets are returned in the order they were written.
+func (s *AppsService) DownloadStoreAssets(ctx context.Context, versionID string, dir string) ([]StoreAsset, *Response, error) {
+	localizations, resp, err := s.ListLocalizationsForAppStoreVersion(ctx, versionID, &ListLocalizationsForAppStoreVersionQuery{Limit: MaxPageLimit})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	assets := make([]StoreAsset, 0)
+
+	for _, loc := range localizations.Data {
+		if loc.Attributes == nil || loc.Attributes.Locale == nil {
+			continue
+		}
+
+		locale := *loc.Attributes.Locale
+
+		screenshots, resp, err := s.storeScreenshotAssets(ctx, loc.ID, locale)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		previews, resp, err := s.storePreviewAssets(ctx, loc.ID, locale)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, asset := range append(screenshots, previews...) {
+			asset.Path = filepath.Join(dir, asset.Locale, string(asset.Kind), asset.DisplayType, fmt.Sprintf("%02d_%s", asset.Position, asset.FileName))
+
+			resp, err = s.client.downloadFile(ctx, asset.URL, asset.Path)
+			if err != nil {
+				return nil, resp, fmt.Errorf("%s: %w", asset.Path, err)
+			}
+
+			assets = append(assets, asset)
+		}
+	}
+
+	return assets, resp, nil
+}
+
+func (s *AppsService) storeScreenshotAssets(ctx context.Context, versionLocalizationID string, locale string) ([]StoreAsset, *Response, error) {
+	sets, resp, err := s.ListAppScreenshotSetsForAppStoreVersionLocalization(ctx, versionLocalizationID, &ListAppScreenshotSetsForAppStoreVersionLocalizationQuery{
+		Include: []string{"appScreenshots"},
+		Limit:   50,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	screenshots := make(map[string]AppScreenshot, len(sets.Included))
+	for _, screenshot := range sets.Included {
+		screenshots[screenshot.ID] = screenshot
+	}
+
+	assets := make([]StoreAsset, 0)
+
+	for _, set := range sets.Data {
+		if set.Attributes == nil || set.Attributes.ScreenshotDisplayType == nil || set.Relationships == nil || set.Relationships.AppScreenshots == nil {
+			continue
+		}
+
+		for i, ref := range set.Relationships.AppScreenshots.Data {
+			screenshot, ok := screenshots[ref.ID]
+			if !ok || screenshot.Attributes == nil || screenshot.Attributes.ImageAsset == nil {
+				continue
+			}
+
+			fileName := storeAssetFileName(screenshot.Attributes.FileName, screenshot.ID, ".png")
+
+			url := screenshot.Attributes.ImageAsset.URL(strings.TrimPrefix(filepath.Ext(fileName), "."))
+			if url == "" {
+				continue
+			}
+
+			asset := StoreAsset{
+				Kind:        StoreAssetKindScreenshot,
+				Locale:      locale,
+				DisplayType: string(*set.Attributes.ScreenshotDisplayType),
+				Position:    i + 1,
+				FileName:    fileName,
+				URL:         url,
+			}
+			if screenshot.Attributes.SourceFileChecksum != nil {
+				asset.SourceFileChecksum = *screenshot.Attributes.SourceFileChecksum
+			}
+
+			assets = append(assets, asset)
+		}
+	}
+
+	return assets, resp, nil
+}
+
+func (s *AppsService) storePreviewAssets(ctx context.Context, versionLocalizationID string, locale string) ([]StoreAsset, *Response, error) {
+	sets, resp, err := s.ListAppPreviewSetsForAppStoreVersionLocalization(ctx, versionLocalizationID, &ListAppPreviewSetsForAppStoreVersionLocalizationQuery{
+		Include: []string{"appPreviews"},
+		Limit:   50,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	previews := make(map[string]AppPreview, len(sets.Included))
+	for _, preview := range sets.Included {
+		previews[preview.ID] = preview
+	}
+
+	assets := make([]StoreAsset, 0)
+
+	for _, set := range sets.Data {
+		if set.Attributes == nil || set.Attributes.PreviewType == nil || set.Relationships == nil || set.Relationships.AppPreviews == nil {
+			continue
+		}
+
+		for i, ref := range set.Relationships.AppPreviews.Data {
+			preview, ok := previews[ref.ID]
+			if !ok || preview.Attributes == nil || preview.Attributes.VideoURL == nil {
+				continue
+			}
+
+			asset := StoreAsset{
+				Kind:        StoreAssetKindPreview,
+				Locale:      locale,
+				DisplayType: string(*set.Attributes.PreviewType),
+				Position:    i + 1,
+				FileName:    storeAssetFileName(preview.Attributes.FileName, preview.ID, ".mp4"),
+				URL:         *preview.Attributes.VideoURL,
+			}
+			if preview.Attributes.SourceFileChecksum != nil {
+				asset.SourceFileChecksum = *preview.Attributes.SourceFileChecksum
+			}
+
+			assets = append(assets, asset)
+		}
+	}
+
+	return assets, resp, nil
+}
+
+// storeAssetFileName returns a file name that is safe to write locally for an asset, falling back to its ID
+// and the given extension when the uploaded file name is unknown.
+func storeAssetFileName(fileName *string, id string, ext string) string {
+	if fileName == nil || *fileName == "" {
+		return id + ext
+	}
+
+	name := filepath.Base(*fileName)
+	if filepath.Ext(name) == "" {
+		name += ext
+	}
+
+	return name
+}
+
+// downloadFile streams the contents of url into a new file at path, creating its parent directories.
+func (c *Client) downloadFile(ctx context.Context, url string, path string) (*Response, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return nil, err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, req, file)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(path)
+	}
+
+	return resp, err
+}
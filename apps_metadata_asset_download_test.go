@@ -0,0 +1,132 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageAssetURL(t *testing.T) {
+	t.Parallel()
+
+	asset := ImageAsset{
+		TemplateURL: String("https://example.com/image/{w}x{h}bb.{f}"),
+		Width:       Int(1242),
+		Height:      Int(2688),
+	}
+
+	assert.Equal(t, "https://example.com/image/1242x2688bb.png", asset.URL("png"))
+	assert.Equal(t, "", ImageAsset{}.URL("png"))
+}
+
+func TestDownloadStoreAssets(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /appStoreVersions/10/appStoreVersionLocalizations": `{"data":[{"id":"100","type":"appStoreVersionLocalizations","attributes":{"locale":"en-US"}}]}`,
+		"GET /appStoreVersionLocalizations/100/appScreenshotSets": `{"data":[{"id":"set1","type":"appScreenshotSets","attributes":{"screenshotDisplayType":"APP_IPHONE_65"},"relationships":{
+				"appScreenshots":{"data":[{"id":"s2","type":"appScreenshots"},{"id":"s1","type":"appScreenshots"},{"id":"s3","type":"appScreenshots"}]}
+			}}],"included":[
+				{"id":"s1","type":"appScreenshots","attributes":{"fileName":"first.png","sourceFileChecksum":"aa","imageAsset":{"templateUrl":"/cdn/s1/{w}x{h}.{f}","width":1242,"height":2688}}},
+				{"id":"s2","type":"appScreenshots","attributes":{"fileName":"../second.jpg","imageAsset":{"templateUrl":"/cdn/s2/{w}x{h}.{f}","width":1242,"height":2688}}},
+				{"id":"s3","type":"appScreenshots","attributes":{"fileName":"processing.png"}}
+			]}`,
+		"GET /appStoreVersionLocalizations/100/appPreviewSets": `{"data":[{"id":"pset1","type":"appPreviewSets","attributes":{"previewType":"IPHONE_65"},"relationships":{
+				"appPreviews":{"data":[{"id":"p1","type":"appPreviews"}]}
+			}}],"included":[{"id":"p1","type":"appPreviews","attributes":{"videoUrl":"/cdn/p1.mp4"}}]}`,
+		"GET /cdn/s1/1242x2688.png": "first",
+		"GET /cdn/s2/1242x2688.jpg": "second",
+		"GET /cdn/p1.mp4":           "video",
+	})
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	assets, _, err := client.Apps.DownloadStoreAssets(context.Background(), "10", dir)
+	assert.NoError(t, err)
+	assert.Len(t, assets, 3)
+	assert.Contains(t, *requests, "GET /cdn/s1/1242x2688.png")
+
+	want := map[string]string{
+		filepath.Join(dir, "en-US", "screenshots", "APP_IPHONE_65", "01_second.jpg"): "second\n",
+		filepath.Join(dir, "en-US", "screenshots", "APP_IPHONE_65", "02_first.png"):  "first\n",
+		filepath.Join(dir, "en-US", "previews", "IPHONE_65", "01_p1.mp4"):            "video\n",
+	}
+
+	for i, asset := range assets {
+		contents, ok := want[asset.Path]
+		assert.True(t, ok, "unexpected asset %d at %s", i, asset.Path)
+
+		b, err := os.ReadFile(asset.Path)
+		assert.NoError(t, err)
+		assert.Equal(t, contents, string(b))
+	}
+
+	assert.Equal(t, StoreAssetKindScreenshot, assets[1].Kind)
+	assert.Equal(t, "aa", assets[1].SourceFileChecksum)
+	assert.Equal(t, StoreAssetKindPreview, assets[2].Kind)
+}
+
+func TestDownloadStoreAssetsRemovesFailedDownloads(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /appStoreVersions/10/appStoreVersionLocalizations":   `{"data":[{"id":"100","type":"appStoreVersionLocalizations","attributes":{"locale":"en-US"}}]}`,
+		"GET /appStoreVersionLocalizations/100/appScreenshotSets": `{"data":[]}`,
+		"GET /appStoreVersionLocalizations/100/appPreviewSets": `{"data":[{"id":"pset1","type":"appPreviewSets","attributes":{"previewType":"IPHONE_65"},"relationships":{
+				"appPreviews":{"data":[{"id":"p1","type":"appPreviews"}]}
+			}}],"included":[{"id":"p1","type":"appPreviews","attributes":{"fileName":"missing.mov","videoUrl":"/cdn/missing.mov"}}]}`,
+	})
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	_, _, err := client.Apps.DownloadStoreAssets(context.Background(), "10", dir)
+	assert.Error(t, err)
+
+	_, statErr := os.Stat(filepath.Join(dir, "en-US", "previews", "IPHONE_65", "01_missing.mov"))
+	assert.True(t, os.IsNotExist(statErr))
+}
+
+func TestStoreAssetFileName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "id.png", storeAssetFileName(nil, "id", ".png"))
+	assert.Equal(t, "id.png", storeAssetFileName(String(""), "id", ".png"))
+	assert.Equal(t, "shot.png", storeAssetFileName(String("../../shot.png"), "id", ".png"))
+	assert.Equal(t, "shot.png", storeAssetFileName(String("shot"), "id", ".png"))
+}
+
+func TestParseStoreAssetKind(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseStoreAssetKind(string(StoreAssetKindScreenshot))
+	assert.NoError(t, err)
+	assert.Equal(t, StoreAssetKindScreenshot, got)
+
+	_, err = ParseStoreAssetKind("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "StoreAssetKind", Value: "UNKNOWN"}, err)
+}
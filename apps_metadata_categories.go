@@ -23,8 +23,237 @@ package asc
 import (
 	"context"
 	"fmt"
+	"strings"
 )
 
+// AppCategoryID is the identifier of an App Store category or subcategory, as used for the ID of an AppCategory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appcategory
+type AppCategoryID string
+
+const (
+	// AppCategoryIDBooks is for the Books category.
+	AppCategoryIDBooks AppCategoryID = "BOOKS"
+	// AppCategoryIDBusiness is for the Business category.
+	AppCategoryIDBusiness AppCategoryID = "BUSINESS"
+	// AppCategoryIDDeveloperTools is for the Developer Tools category.
+	AppCategoryIDDeveloperTools AppCategoryID = "DEVELOPER_TOOLS"
+	// AppCategoryIDEducation is for the Education category.
+	AppCategoryIDEducation AppCategoryID = "EDUCATION"
+	// AppCategoryIDEntertainment is for the Entertainment category.
+	AppCategoryIDEntertainment AppCategoryID = "ENTERTAINMENT"
+	// AppCategoryIDFinance is for the Finance category.
+	AppCategoryIDFinance AppCategoryID = "FINANCE"
+	// AppCategoryIDFoodAndDrink is for the Food & Drink category.
+	AppCategoryIDFoodAndDrink AppCategoryID = "FOOD_AND_DRINK"
+	// AppCategoryIDGames is for the Games category.
+	AppCategoryIDGames AppCategoryID = "GAMES"
+	// AppCategoryIDGraphicsAndDesign is for the Graphics & Design category.
+	AppCategoryIDGraphicsAndDesign AppCategoryID = "GRAPHICS_AND_DESIGN"
+	// AppCategoryIDHealthAndFitness is for the Health & Fitness category.
+	AppCategoryIDHealthAndFitness AppCategoryID = "HEALTH_AND_FITNESS"
+	// AppCategoryIDLifestyle is for the Lifestyle category.
+	AppCategoryIDLifestyle AppCategoryID = "LIFESTYLE"
+	// AppCategoryIDMagazinesAndNewspapers is for the Magazines & Newspapers category.
+	AppCategoryIDMagazinesAndNewspapers AppCategoryID = "MAGAZINES_AND_NEWSPAPERS"
+	// AppCategoryIDMedical is for the Medical category.
+	AppCategoryIDMedical AppCategoryID = "MEDICAL"
+	// AppCategoryIDMusic is for the Music category.
+	AppCategoryIDMusic AppCategoryID = "MUSIC"
+	// AppCategoryIDNavigation is for the Navigation category.
+	AppCategoryIDNavigation AppCategoryID = "NAVIGATION"
+	// AppCategoryIDNews is for the News category.
+	AppCategoryIDNews AppCategoryID = "NEWS"
+	// AppCategoryIDPhotoAndVideo is for the Photo & Video category.
+	AppCategoryIDPhotoAndVideo AppCategoryID = "PHOTO_AND_VIDEO"
+	// AppCategoryIDProductivity is for the Productivity category.
+	AppCategoryIDProductivity AppCategoryID = "PRODUCTIVITY"
+	// AppCategoryIDReference is for the Reference category.
+	AppCategoryIDReference AppCategoryID = "REFERENCE"
+	// AppCategoryIDShopping is for the Shopping category.
+	AppCategoryIDShopping AppCategoryID = "SHOPPING"
+	// AppCategoryIDSocialNetworking is for the Social Networking category.
+	AppCategoryIDSocialNetworking AppCategoryID = "SOCIAL_NETWORKING"
+	// AppCategoryIDSports is for the Sports category.
+	AppCategoryIDSports AppCategoryID = "SPORTS"
+	// AppCategoryIDStickers is for the Stickers category.
+	AppCategoryIDStickers AppCategoryID = "STICKERS"
+	// AppCategoryIDTravel is for the Travel category.
+	AppCategoryIDTravel AppCategoryID = "TRAVEL"
+	// AppCategoryIDUtilities is for the Utilities category.
+	AppCategoryIDUtilities AppCategoryID = "UTILITIES"
+	// AppCategoryIDWeather is for the Weather category.
+	AppCategoryIDWeather AppCategoryID = "WEATHER"
+)
+
+const (
+	// AppCategoryIDGamesAction is for the Action subcategory of Games.
+	AppCategoryIDGamesAction AppCategoryID = "GAMES_ACTION"
+	// AppCategoryIDGamesAdventure is for the Adventure subcategory of Games.
+	AppCategoryIDGamesAdventure AppCategoryID = "GAMES_ADVENTURE"
+	// AppCategoryIDGamesBoard is for the Board subcategory of Games.
+	AppCategoryIDGamesBoard AppCategoryID = "GAMES_BOARD"
+	// AppCategoryIDGamesCard is for the Card subcategory of Games.
+	AppCategoryIDGamesCard AppCategoryID = "GAMES_CARD"
+	// AppCategoryIDGamesCasino is for the Casino subcategory of Games.
+	AppCategoryIDGamesCasino AppCategoryID = "GAMES_CASINO"
+	// AppCategoryIDGamesCasual is for the Casual subcategory of Games.
+	AppCategoryIDGamesCasual AppCategoryID = "GAMES_CASUAL"
+	// AppCategoryIDGamesFamily is for the Family subcategory of Games.
+	AppCategoryIDGamesFamily AppCategoryID = "GAMES_FAMILY"
+	// AppCategoryIDGamesMusic is for the Music subcategory of Games.
+	AppCategoryIDGamesMusic AppCategoryID = "GAMES_MUSIC"
+	// AppCategoryIDGamesPuzzle is for the Puzzle subcategory of Games.
+	AppCategoryIDGamesPuzzle AppCategoryID = "GAMES_PUZZLE"
+	// AppCategoryIDGamesRacing is for the Racing subcategory of Games.
+	AppCategoryIDGamesRacing AppCategoryID = "GAMES_RACING"
+	// AppCategoryIDGamesRolePlaying is for the Role Playing subcategory of Games.
+	AppCategoryIDGamesRolePlaying AppCategoryID = "GAMES_ROLE_PLAYING"
+	// AppCategoryIDGamesSimulation is for the Simulation subcategory of Games.
+	AppCategoryIDGamesSimulation AppCategoryID = "GAMES_SIMULATION"
+	// AppCategoryIDGamesSports is for the Sports subcategory of Games.
+	AppCategoryIDGamesSports AppCategoryID = "GAMES_SPORTS"
+	// AppCategoryIDGamesStrategy is for the Strategy subcategory of Games.
+	AppCategoryIDGamesStrategy AppCategoryID = "GAMES_STRATEGY"
+	// AppCategoryIDGamesTrivia is for the Trivia subcategory of Games.
+	AppCategoryIDGamesTrivia AppCategoryID = "GAMES_TRIVIA"
+	// AppCategoryIDGamesWord is for the Word subcategory of Games.
+	AppCategoryIDGamesWord AppCategoryID = "GAMES_WORD"
+)
+
+const (
+	// AppCategoryIDStickersAnimals is for the Animals subcategory of Stickers.
+	AppCategoryIDStickersAnimals AppCategoryID = "STICKERS_ANIMALS"
+	// AppCategoryIDStickersArt is for the Art subcategory of Stickers.
+	AppCategoryIDStickersArt AppCategoryID = "STICKERS_ART"
+	// AppCategoryIDStickersCelebrations is for the Celebrations subcategory of Stickers.
+	AppCategoryIDStickersCelebrations AppCategoryID = "STICKERS_CELEBRATIONS"
+	// AppCategoryIDStickersCelebrities is for the Celebrities subcategory of Stickers.
+	AppCategoryIDStickersCelebrities AppCategoryID = "STICKERS_CELEBRITIES"
+	// AppCategoryIDStickersCharacters is for the Characters subcategory of Stickers.
+	AppCategoryIDStickersCharacters AppCategoryID = "STICKERS_CHARACTERS"
+	// AppCategoryIDStickersEatingAndDrinking is for the Eating & Drinking subcategory of Stickers.
+	AppCategoryIDStickersEatingAndDrinking AppCategoryID = "STICKERS_EATING_AND_DRINKING"
+	// AppCategoryIDStickersEmojiAndExpressions is for the Emoji & Expressions subcategory of Stickers.
+	AppCategoryIDStickersEmojiAndExpressions AppCategoryID = "STICKERS_EMOJI_AND_EXPRESSIONS"
+	// AppCategoryIDStickersFashion is for the Fashion subcategory of Stickers.
+	AppCategoryIDStickersFashion AppCategoryID = "STICKERS_FASHION"
+	// AppCategoryIDStickersGaming is for the Gaming subcategory of Stickers.
+	AppCategoryIDStickersGaming AppCategoryID = "STICKERS_GAMING"
+	// AppCategoryIDStickersKidsAndFamily is for the Kids & Family subcategory of Stickers.
+	AppCategoryIDStickersKidsAndFamily AppCategoryID = "STICKERS_KIDS_AND_FAMILY"
+	// AppCategoryIDStickersMoviesAndTV is for the Movies & TV subcategory of Stickers.
+	AppCategoryIDStickersMoviesAndTV AppCategoryID = "STICKERS_MOVIES_AND_TV"
+	// AppCategoryIDStickersMusic is for the Music subcategory of Stickers.
+	AppCategoryIDStickersMusic AppCategoryID = "STICKERS_MUSIC"
+	// AppCategoryIDStickersPeople is for the People subcategory of Stickers.
+	AppCategoryIDStickersPeople AppCategoryID = "STICKERS_PEOPLE"
+	// AppCategoryIDStickersPlacesAndObjects is for the Places & Objects subcategory of Stickers.
+	AppCategoryIDStickersPlacesAndObjects AppCategoryID = "STICKERS_PLACES_AND_OBJECTS"
+	// AppCategoryIDStickersSportsAndActivities is for the Sports & Activities subcategory of Stickers.
+	AppCategoryIDStickersSportsAndActivities AppCategoryID = "STICKERS_SPORTS_AND_ACTIVITIES"
+)
+
+var appCategoryIDs = []AppCategoryID{
+	AppCategoryIDBooks,
+	AppCategoryIDBusiness,
+	AppCategoryIDDeveloperTools,
+	AppCategoryIDEducation,
+	AppCategoryIDEntertainment,
+	AppCategoryIDFinance,
+	AppCategoryIDFoodAndDrink,
+	AppCategoryIDGames,
+	AppCategoryIDGraphicsAndDesign,
+	AppCategoryIDHealthAndFitness,
+	AppCategoryIDLifestyle,
+	AppCategoryIDMagazinesAndNewspapers,
+	AppCategoryIDMedical,
+	AppCategoryIDMusic,
+	AppCategoryIDNavigation,
+	AppCategoryIDNews,
+	AppCategoryIDPhotoAndVideo,
+	AppCategoryIDProductivity,
+	AppCategoryIDReference,
+	AppCategoryIDShopping,
+	AppCategoryIDSocialNetworking,
+	AppCategoryIDSports,
+	AppCategoryIDStickers,
+	AppCategoryIDTravel,
+	AppCategoryIDUtilities,
+	AppCategoryIDWeather,
+	AppCategoryIDGamesAction,
+	AppCategoryIDGamesAdventure,
+	AppCategoryIDGamesBoard,
+	AppCategoryIDGamesCard,
+	AppCategoryIDGamesCasino,
+	AppCategoryIDGamesCasual,
+	AppCategoryIDGamesFamily,
+	AppCategoryIDGamesMusic,
+	AppCategoryIDGamesPuzzle,
+	AppCategoryIDGamesRacing,
+	AppCategoryIDGamesRolePlaying,
+	AppCategoryIDGamesSimulation,
+	AppCategoryIDGamesSports,
+	AppCategoryIDGamesStrategy,
+	AppCategoryIDGamesTrivia,
+	AppCategoryIDGamesWord,
+	AppCategoryIDStickersAnimals,
+	AppCategoryIDStickersArt,
+	AppCategoryIDStickersCelebrations,
+	AppCategoryIDStickersCelebrities,
+	AppCategoryIDStickersCharacters,
+	AppCategoryIDStickersEatingAndDrinking,
+	AppCategoryIDStickersEmojiAndExpressions,
+	AppCategoryIDStickersFashion,
+	AppCategoryIDStickersGaming,
+	AppCategoryIDStickersKidsAndFamily,
+	AppCategoryIDStickersMoviesAndTV,
+	AppCategoryIDStickersMusic,
+	AppCategoryIDStickersPeople,
+	AppCategoryIDStickersPlacesAndObjects,
+	AppCategoryIDStickersSportsAndActivities,
+}
+
+// IsValid reports whether the app category ID is one this package knows about.
+func (v AppCategoryID) IsValid() bool {
+	for _, value := range appCategoryIDs {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppCategoryID returns s as an AppCategoryID, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppCategoryID(s string) (AppCategoryID, error) {
+	if v := AppCategoryID(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppCategoryID", Value: s}
+}
+
+// ErrInvalidAppCategories happens when a subcategory is assigned to an app info without the category it belongs to.
+type ErrInvalidAppCategories struct {
+	Subcategory AppCategoryID
+	Category    AppCategoryID
+}
+
+func (e ErrInvalidAppCategories) Error() string {
+	return fmt.Sprintf("subcategory %s does not belong to category %s", e.Subcategory, e.Category)
+}
+
+// AppInfoCategories describes the categories and subcategories to assign to an app info. Zero values are left unchanged.
+type AppInfoCategories struct {
+	Primary                 AppCategoryID `json:"primary,omitempty"`
+	PrimarySubcategoryOne   AppCategoryID `json:"primarySubcategoryOne,omitempty"`
+	PrimarySubcategoryTwo   AppCategoryID `json:"primarySubcategoryTwo,omitempty"`
+	Secondary               AppCategoryID `json:"secondary,omitempty"`
+	SecondarySubcategoryOne AppCategoryID `json:"secondarySubcategoryOne,omitempty"`
+	SecondarySubcategoryTwo AppCategoryID `json:"secondarySubcategoryTwo,omitempty"`
+}
+
 // AppCategory defines model for AppCategory.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appcategory
@@ -122,109 +351,131 @@ type GetAppCategoryForAppInfoQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_app_categories
 func (s *AppsService) ListAppCategories(ctx context.Context, params *ListAppCategoriesQuery) (*AppCategoriesResponse, *Response, error) {
-	res := new(AppCategoriesResponse)
-	resp, err := s.client.get(ctx, "appCategories", params, res)
-
-	return res, resp, err
+	return Get[AppCategoriesResponse](ctx, s.client, "appCategories", params)
 }
 
 // ListSubcategoriesForAppCategory lists all App Store subcategories that belong to a specific category.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_subcategories_for_an_app_category
 func (s *AppsService) ListSubcategoriesForAppCategory(ctx context.Context, id string, params *ListSubcategoriesForAppCategoryQuery) (*AppCategoriesResponse, *Response, error) {
-	url := fmt.Sprintf("appCategories/%s/subcategories", id)
-	res := new(AppCategoriesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppCategoriesResponse](ctx, s.client, fmt.Sprintf("appCategories/%s/subcategories", id), params)
 }
 
 // GetAppCategory gets a specific app category.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_category_information
 func (s *AppsService) GetAppCategory(ctx context.Context, id string, params *GetAppCategoryQuery) (*AppCategoryResponse, *Response, error) {
-	url := fmt.Sprintf("appCategories/%s", id)
-	res := new(AppCategoryResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppCategoryResponse](ctx, s.client, fmt.Sprintf("appCategories/%s", id), params)
 }
 
 // GetParentCategoryForAppCategory gets the App Store category to which a specific subcategory belongs.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_parent_information_of_an_app_category
 func (s *AppsService) GetParentCategoryForAppCategory(ctx context.Context, id string, params *GetAppCategoryForAppInfoQuery) (*AppCategoryResponse, *Response, error) {
-	url := fmt.Sprintf("appCategories/%s/parent", id)
-	res := new(AppCategoryResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppCategoryResponse](ctx, s.client, fmt.Sprintf("appCategories/%s/parent", id), params)
 }
 
 // GetPrimaryCategoryForAppInfo gets an app’s primary App Store category.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_primary_category_information_of_an_app_info
 func (s *AppsService) GetPrimaryCategoryForAppInfo(ctx context.Context, id string, params *GetAppCategoryForAppInfoQuery) (*AppCategoryResponse, *Response, error) {
-	url := fmt.Sprintf("appInfos/%s/primaryCategory", id)
-	res := new(AppCategoryResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppCategoryResponse](ctx, s.client, fmt.Sprintf("appInfos/%s/primaryCategory", id), params)
 }
 
 // GetSecondaryCategoryForAppInfo gets an app’s secondary App Store category.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_secondary_category_information_of_an_app_info
 func (s *AppsService) GetSecondaryCategoryForAppInfo(ctx context.Context, id string, params *GetAppCategoryForAppInfoQuery) (*AppCategoryResponse, *Response, error) {
-	url := fmt.Sprintf("appInfos/%s/secondaryCategory", id)
-	res := new(AppCategoryResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppCategoryResponse](ctx, s.client, fmt.Sprintf("appInfos/%s/secondaryCategory", id), params)
 }
 
 // GetPrimarySubcategoryOneForAppInfo gets the first App Store subcategory within an app’s primary category.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_primary_subcategory_one_information_of_an_app_info
 func (s *AppsService) GetPrimarySubcategoryOneForAppInfo(ctx context.Context, id string, params *GetAppCategoryForAppInfoQuery) (*AppCategoryResponse, *Response, error) {
-	url := fmt.Sprintf("appInfos/%s/primarySubcategoryOne", id)
-	res := new(AppCategoryResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppCategoryResponse](ctx, s.client, fmt.Sprintf("appInfos/%s/primarySubcategoryOne", id), params)
 }
 
 // GetPrimarySubcategoryTwoForAppInfo gets the second App Store subcategory within an app’s primary category.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_primary_subcategory_two_information_of_an_app_info
 func (s *AppsService) GetPrimarySubcategoryTwoForAppInfo(ctx context.Context, id string, params *GetAppCategoryForAppInfoQuery) (*AppCategoryResponse, *Response, error) {
-	url := fmt.Sprintf("appInfos/%s/primarySubcategoryTwo", id)
-	res := new(AppCategoryResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppCategoryResponse](ctx, s.client, fmt.Sprintf("appInfos/%s/primarySubcategoryTwo", id), params)
 }
 
 // GetSecondarySubcategoryOneForAppInfo gets the first App Store subcategory within an app’s secondary category.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_secondary_subcategory_one_information_of_an_app_info
 func (s *AppsService) GetSecondarySubcategoryOneForAppInfo(ctx context.Context, id string, params *GetAppCategoryForAppInfoQuery) (*AppCategoryResponse, *Response, error) {
-	url := fmt.Sprintf("appInfos/%s/secondarySubcategoryOne", id)
-	res := new(AppCategoryResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppCategoryResponse](ctx, s.client, fmt.Sprintf("appInfos/%s/secondarySubcategoryOne", id), params)
 }
 
 // GetSecondarySubcategoryTwoForAppInfo gets the second App Store subcategory within an app’s secondary category.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_secondary_subcategory_two_information_of_an_app_info
 func (s *AppsService) GetSecondarySubcategoryTwoForAppInfo(ctx context.Context, id string, params *GetAppCategoryForAppInfoQuery) (*AppCategoryResponse, *Response, error) {
-	url := fmt.Sprintf("appInfos/%s/secondarySubcategoryTwo", id)
-	res := new(AppCategoryResponse)
-	resp, err := s.client.get(ctx, url, params, res)
+	return Get[AppCategoryResponse](ctx, s.client, fmt.Sprintf("appInfos/%s/secondarySubcategoryTwo", id), params)
+}
+
+// Parent returns the category that a subcategory belongs to, or an empty AppCategoryID if the receiver
+// is a top-level category.
+func (c AppCategoryID) Parent() AppCategoryID {
+	for _, parent := range []AppCategoryID{AppCategoryIDGames, AppCategoryIDStickers} {
+		if strings.HasPrefix(string(c), string(parent)+"_") {
+			return parent
+		}
+	}
+
+	return ""
+}
+
+// Validate checks that every subcategory belongs to the category it is assigned under.
+func (c AppInfoCategories) Validate() error {
+	pairs := []struct {
+		category    AppCategoryID
+		subcategory AppCategoryID
+	}{
+		{c.Primary, c.PrimarySubcategoryOne},
+		{c.Primary, c.PrimarySubcategoryTwo},
+		{c.Secondary, c.SecondarySubcategoryOne},
+		{c.Secondary, c.SecondarySubcategoryTwo},
+	}
+
+	for _, pair := range pairs {
+		if pair.subcategory != "" && pair.subcategory.Parent() != pair.category {
+			return ErrInvalidAppCategories{Subcategory: pair.subcategory, Category: pair.category}
+		}
+	}
+
+	return nil
+}
+
+func (c AppInfoCategories) relationships() *AppInfoUpdateRequestRelationships {
+	id := func(c AppCategoryID) *string {
+		if c == "" {
+			return nil
+		}
+
+		return String(string(c))
+	}
+
+	return &AppInfoUpdateRequestRelationships{
+		PrimaryCategoryID:         id(c.Primary),
+		PrimarySubcategoryOneID:   id(c.PrimarySubcategoryOne),
+		PrimarySubcategoryTwoID:   id(c.PrimarySubcategoryTwo),
+		SecondaryCategoryID:       id(c.Secondary),
+		SecondarySubcategoryOneID: id(c.SecondarySubcategoryOne),
+		SecondarySubcategoryTwoID: id(c.SecondarySubcategoryTwo),
+	}
+}
+
+// SetAppInfoCategories validates and assigns the App Store categories and subcategories of an app info.
+func (s *AppsService) SetAppInfoCategories(ctx context.Context, id string, categories AppInfoCategories) (*AppInfoResponse, *Response, error) {
+	if err := categories.Validate(); err != nil {
+		return nil, nil, err
+	}
 
-	return res, resp, err
+	return s.UpdateAppInfo(ctx, id, categories.relationships())
 }
 
 // UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppCategoryResponseIncluded.
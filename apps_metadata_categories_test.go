@@ -119,3 +119,51 @@ func TestGetSecondarySubcategoryTwoForAppInfo(t *testing.T) {
 		return client.Apps.GetSecondarySubcategoryTwoForAppInfo(ctx, "10", &GetAppCategoryForAppInfoQuery{})
 	})
 }
+
+func TestAppCategoryIDParent(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, AppCategoryIDGames, AppCategoryIDGamesPuzzle.Parent())
+	assert.Equal(t, AppCategoryIDStickers, AppCategoryIDStickersArt.Parent())
+	assert.Equal(t, AppCategoryID(""), AppCategoryIDGames.Parent())
+	assert.Equal(t, AppCategoryID(""), AppCategoryIDProductivity.Parent())
+}
+
+func TestSetAppInfoCategories(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppInfoResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.SetAppInfoCategories(ctx, "10", AppInfoCategories{
+			Primary:               AppCategoryIDGames,
+			PrimarySubcategoryOne: AppCategoryIDGamesPuzzle,
+			PrimarySubcategoryTwo: AppCategoryIDGamesWord,
+			Secondary:             AppCategoryIDEducation,
+		})
+	})
+}
+
+func TestSetAppInfoCategoriesInvalid(t *testing.T) {
+	t.Parallel()
+
+	testEndpointExpectingError(t, "{}", func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.SetAppInfoCategories(ctx, "10", AppInfoCategories{
+			Primary:                 AppCategoryIDEducation,
+			SecondarySubcategoryOne: AppCategoryIDGamesPuzzle,
+		})
+	})
+
+	err := AppInfoCategories{Primary: AppCategoryIDBooks, PrimarySubcategoryOne: AppCategoryIDStickersArt}.Validate()
+	assert.Equal(t, ErrInvalidAppCategories{Subcategory: AppCategoryIDStickersArt, Category: AppCategoryIDBooks}, err)
+	assert.NotEmpty(t, err.Error())
+}
+
+func TestParseAppCategoryID(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppCategoryID(string(AppCategoryIDBooks))
+	assert.NoError(t, err)
+	assert.Equal(t, AppCategoryIDBooks, got)
+
+	_, err = ParseAppCategoryID("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppCategoryID", Value: "UNKNOWN"}, err)
+}
@@ -108,6 +108,15 @@ type EndUserLicenseAgreementResponse struct {
 	Links    DocumentLinks           `json:"links"`
 }
 
+// EndUserLicenseAgreementTerritoriesLinkagesResponse defines model for EndUserLicenseAgreementTerritoriesLinkagesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/enduserlicenseagreementterritorieslinkagesresponse
+type EndUserLicenseAgreementTerritoriesLinkagesResponse struct {
+	Data  []RelationshipData `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
 // GetEULAQuery are query options for GetEULA
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_end_user_license_agreement_information
@@ -125,6 +134,14 @@ type GetEULAForAppQuery struct {
 	FieldsEndUserLicenseAgreements []string `url:"fields[endUserLicenseAgreements],omitempty"`
 }
 
+// ListTerritoryIDsForEULAQuery are query options for ListTerritoryIDsForEULA
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_enduserlicenseagreements_id_relationships_territories
+type ListTerritoryIDsForEULAQuery struct {
+	Limit  int    `url:"limit,omitempty"`
+	Cursor string `url:"cursor,omitempty"`
+}
+
 // CreateEULA adds a custom end user license agreement (EULA) to an app and configure the territories to which it applies.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/create_an_end_user_license_agreement
@@ -186,20 +203,19 @@ func (s *AppsService) DeleteEULA(ctx context.Context, id string) (*Response, err
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_end_user_license_agreement_information
 func (s *AppsService) GetEULA(ctx context.Context, id string, params *GetEULAQuery) (*EndUserLicenseAgreementResponse, *Response, error) {
-	url := fmt.Sprintf("endUserLicenseAgreements/%s", id)
-	res := new(EndUserLicenseAgreementResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[EndUserLicenseAgreementResponse](ctx, s.client, fmt.Sprintf("endUserLicenseAgreements/%s", id), params)
 }
 
 // GetEULAForApp gets the custom end user license agreement (EULA) for a specific app and the territories where the agreement applies.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_end_user_license_agreement_information_of_an_app
 func (s *AppsService) GetEULAForApp(ctx context.Context, id string, params *GetEULAForAppQuery) (*EndUserLicenseAgreementResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/endUserLicenseAgreement", id)
-	res := new(EndUserLicenseAgreementResponse)
-	resp, err := s.client.get(ctx, url, params, res)
+	return Get[EndUserLicenseAgreementResponse](ctx, s.client, fmt.Sprintf("apps/%s/endUserLicenseAgreement", id), params)
+}
 
-	return res, resp, err
+// ListTerritoryIDsForEULA gets the IDs of the territories a custom end user license agreement applies to.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_enduserlicenseagreements_id_relationships_territories
+func (s *AppsService) ListTerritoryIDsForEULA(ctx context.Context, id string, params *ListTerritoryIDsForEULAQuery) (*EndUserLicenseAgreementTerritoriesLinkagesResponse, *Response, error) {
+	return Get[EndUserLicenseAgreementTerritoriesLinkagesResponse](ctx, s.client, fmt.Sprintf("endUserLicenseAgreements/%s/relationships/territories", id), params)
 }
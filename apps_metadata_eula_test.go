@@ -64,3 +64,11 @@ func TestGetEULAForApp(t *testing.T) {
 		return client.Apps.GetEULAForApp(ctx, "10", &GetEULAForAppQuery{})
 	})
 }
+
+func TestListTerritoryIDsForEULA(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &EndUserLicenseAgreementTerritoriesLinkagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListTerritoryIDsForEULA(ctx, "10", &ListTerritoryIDsForEULAQuery{})
+	})
+}
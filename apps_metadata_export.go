@@ -0,0 +1,925 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+var (
+	// ErrMissingAppInfo happens when an app has no app info to export metadata from.
+	ErrMissingAppInfo = errors.New("no app info found for app")
+	// ErrMissingVersionLocalization happens when screenshots are applied to a locale that the App Store version
+	// has no localization for.
+	ErrMissingVersionLocalization = errors.New("no App Store version localization found for locale")
+	// ErrMissingScreenshotFile happens when a screenshot is applied that was not read from a local file.
+	ErrMissingScreenshotFile = errors.New("no local file for screenshot")
+)
+
+const appMetadataFileName = "app.json"
+
+// AppMetadata is a declarative snapshot of the store metadata of an app and one of its App Store versions.
+// Nil and zero-valued fields are unmanaged, and are left unchanged when the snapshot is applied.
+type AppMetadata struct {
+	Categories    AppInfoCategories                   `json:"categories"`
+	AgeRating     *AgeRatingDeclarationAttributes     `json:"ageRating,omitempty"`
+	Localizations map[string]*AppMetadataLocalization `json:"-"`
+
+	appInfoID              string
+	ageRatingDeclarationID string
+	versionID              string
+}
+
+// AppMetadataLocalization is the localized app info and App Store version metadata of a single locale.
+type AppMetadataLocalization struct {
+	Name              *string                    `json:"name,omitempty"`
+	Subtitle          *string                    `json:"subtitle,omitempty"`
+	PrivacyPolicyText *string                    `json:"privacyPolicyText,omitempty"`
+	PrivacyPolicyURL  *string                    `json:"privacyPolicyUrl,omitempty"`
+	Description       *string                    `json:"description,omitempty"`
+	Keywords          *string                    `json:"keywords,omitempty"`
+	MarketingURL      *string                    `json:"marketingUrl,omitempty"`
+	PromotionalText   *string                    `json:"promotionalText,omitempty"`
+	SupportURL        *string                    `json:"supportUrl,omitempty"`
+	WhatsNew          *string                    `json:"whatsNew,omitempty"`
+	Screenshots       []AppMetadataScreenshotSet `json:"screenshots,omitempty"`
+
+	appInfoLocalizationID string
+	versionLocalizationID string
+}
+
+// AppMetadataScreenshotSet is a manifest of the screenshots uploaded for a single display type, in display order.
+type AppMetadataScreenshotSet struct {
+	DisplayType ScreenshotDisplayType   `json:"displayType"`
+	Screenshots []AppMetadataScreenshot `json:"screenshots"`
+}
+
+// AppMetadataScreenshot identifies an uploaded screenshot by its file name and checksum.
+type AppMetadataScreenshot struct {
+	FileName           string `json:"fileName"`
+	SourceFileChecksum string `json:"sourceFileChecksum,omitempty"`
+
+	// path is the local image file of a screenshot read by ReadFastlaneMetadata.
+	path string
+}
+
+// AppMetadataChange describes a single field that differs between two AppMetadata snapshots.
+type AppMetadataChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old"`
+	New   string `json:"new"`
+}
+
+func (c AppMetadataChange) String() string {
+	return fmt.Sprintf("%s: %q -> %q", c.Field, c.Old, c.New)
+}
+
+type appMetadataField struct {
+	name  string
+	value *string
+}
+
+func (l *AppMetadataLocalization) infoFields() []appMetadataField {
+	return []appMetadataField{
+		{"name", l.Name},
+		{"subtitle", l.Subtitle},
+		{"privacyPolicyText", l.PrivacyPolicyText},
+		{"privacyPolicyUrl", l.PrivacyPolicyURL},
+	}
+}
+
+func (l *AppMetadataLocalization) versionFields() []appMetadataField {
+	return []appMetadataField{
+		{"description", l.Description},
+		{"keywords", l.Keywords},
+		{"marketingUrl", l.MarketingURL},
+		{"promotionalText", l.PromotionalText},
+		{"supportUrl", l.SupportURL},
+		{"whatsNew", l.WhatsNew},
+	}
+}
+
+// ExportAppMetadata exports the app info, categories, age rating, localizations and screenshot manifest of an app
+// and one of its App Store versions. If the app has several app infos, the one that is not yet live is preferred.
+func (s *AppsService) ExportAppMetadata(ctx context.Context, appID string, versionID string) (*AppMetadata, *Response, error) {
+	infos, resp, err := s.ListAppInfosForApp(ctx, appID, &ListAppInfosForAppQuery{
+		Include: []string{
+			"primaryCategory",
+			"primarySubcategoryOne",
+			"primarySubcategoryTwo",
+			"secondaryCategory",
+			"secondarySubcategoryOne",
+			"secondarySubcategoryTwo",
+		},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	info := editableAppInfo(infos.Data)
+	if info == nil {
+		return nil, resp, ErrMissingAppInfo
+	}
+
+	metadata := &AppMetadata{
+		Categories:    appInfoCategoriesFromRelationships(info.Relationships),
+		Localizations: make(map[string]*AppMetadataLocalization),
+		appInfoID:     info.ID,
+		versionID:     versionID,
+	}
+
+	ageRating, resp, err := s.GetAgeRatingDeclarationForAppInfo(ctx, info.ID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	metadata.AgeRating = ageRating.Data.Attributes
+	metadata.ageRatingDeclarationID = ageRating.Data.ID
+
+	infoLocalizations, resp, err := s.ListAppInfoLocalizationsForAppInfo(ctx, info.ID, &ListAppInfoLocalizationsForAppInfoQuery{Limit: MaxPageLimit})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, loc := range infoLocalizations.Data {
+		if loc.Attributes == nil || loc.Attributes.Locale == nil {
+			continue
+		}
+
+		l := metadata.localization(*loc.Attributes.Locale)
+		l.appInfoLocalizationID = loc.ID
+		l.Name = loc.Attributes.Name
+		l.Subtitle = loc.Attributes.Subtitle
+		l.PrivacyPolicyText = loc.Attributes.PrivacyPolicyText
+		l.PrivacyPolicyURL = loc.Attributes.PrivacyPolicyURL
+	}
+
+	versionLocalizations, resp, err := s.ListLocalizationsForAppStoreVersion(ctx, versionID, &ListLocalizationsForAppStoreVersionQuery{Limit: MaxPageLimit})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, loc := range versionLocalizations.Data {
+		if loc.Attributes == nil || loc.Attributes.Locale == nil {
+			continue
+		}
+
+		l := metadata.localization(*loc.Attributes.Locale)
+		l.versionLocalizationID = loc.ID
+		l.Description = loc.Attributes.Description
+		l.Keywords = loc.Attributes.Keywords
+		l.MarketingURL = loc.Attributes.MarketingURL
+		l.PromotionalText = loc.Attributes.PromotionalText
+		l.SupportURL = loc.Attributes.SupportURL
+		l.WhatsNew = loc.Attributes.WhatsNew
+
+		l.Screenshots, resp, err = s.exportScreenshotManifest(ctx, loc.ID)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return metadata, resp, nil
+}
+
+func (s *AppsService) exportScreenshotManifest(ctx context.Context, versionLocalizationID string) ([]AppMetadataScreenshotSet, *Response, error) {
+	sets, resp, err := s.ListAppScreenshotSetsForAppStoreVersionLocalization(ctx, versionLocalizationID, &ListAppScreenshotSetsForAppStoreVersionLocalizationQuery{
+		Include: []string{"appScreenshots"},
+		Limit:   50,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	screenshots := make(map[string]AppScreenshot, len(sets.Included))
+	for _, screenshot := range sets.Included {
+		screenshots[screenshot.ID] = screenshot
+	}
+
+	manifest := make([]AppMetadataScreenshotSet, 0, len(sets.Data))
+
+	for _, set := range sets.Data {
+		if set.Attributes == nil || set.Attributes.ScreenshotDisplayType == nil {
+			continue
+		}
+
+		entry := AppMetadataScreenshotSet{
+			DisplayType: *set.Attributes.ScreenshotDisplayType,
+			Screenshots: []AppMetadataScreenshot{},
+		}
+
+		if set.Relationships != nil && set.Relationships.AppScreenshots != nil {
+			for _, ref := range set.Relationships.AppScreenshots.Data {
+				screenshot, ok := screenshots[ref.ID]
+				if !ok || screenshot.Attributes == nil {
+					continue
+				}
+
+				var item AppMetadataScreenshot
+				if screenshot.Attributes.FileName != nil {
+					item.FileName = *screenshot.Attributes.FileName
+				}
+
+				if screenshot.Attributes.SourceFileChecksum != nil {
+					item.SourceFileChecksum = *screenshot.Attributes.SourceFileChecksum
+				}
+
+				entry.Screenshots = append(entry.Screenshots, item)
+			}
+		}
+
+		manifest = append(manifest, entry)
+	}
+
+	sort.Slice(manifest, func(i, j int) bool {
+		return manifest[i].DisplayType < manifest[j].DisplayType
+	})
+
+	return manifest, resp, nil
+}
+
+func editableAppInfo(infos []AppInfo) *AppInfo {
+	if len(infos) == 0 {
+		return nil
+	}
+
+	for i := range infos {
+		attrs := infos[i].Attributes
+		if attrs == nil || attrs.AppStoreState == nil || *attrs.AppStoreState != AppStoreVersionStateReadyForSale {
+			return &infos[i]
+		}
+	}
+
+	return &infos[0]
+}
+
+func appInfoCategoriesFromRelationships(rels *AppInfoRelationships) AppInfoCategories {
+	var categories AppInfoCategories
+
+	if rels == nil {
+		return categories
+	}
+
+	id := func(rel *Relationship) AppCategoryID {
+		if rel == nil || rel.Data == nil {
+			return ""
+		}
+
+		return AppCategoryID(rel.Data.ID)
+	}
+
+	categories.Primary = id(rels.PrimaryCategory)
+	categories.PrimarySubcategoryOne = id(rels.PrimarySubcategoryOne)
+	categories.PrimarySubcategoryTwo = id(rels.PrimarySubcategoryTwo)
+	categories.Secondary = id(rels.SecondaryCategory)
+	categories.SecondarySubcategoryOne = id(rels.SecondarySubcategoryOne)
+	categories.SecondarySubcategoryTwo = id(rels.SecondarySubcategoryTwo)
+
+	return categories
+}
+
+func (m *AppMetadata) localization(locale string) *AppMetadataLocalization {
+	if m.Localizations == nil {
+		m.Localizations = make(map[string]*AppMetadataLocalization)
+	}
+
+	l, ok := m.Localizations[locale]
+	if !ok {
+		l = &AppMetadataLocalization{}
+		m.Localizations[locale] = l
+	}
+
+	return l
+}
+
+func (m *AppMetadata) locales() []string {
+	locales := make([]string, 0, len(m.Localizations))
+	for locale := range m.Localizations {
+		locales = append(locales, locale)
+	}
+
+	sort.Strings(locales)
+
+	return locales
+}
+
+// WriteAppMetadata writes a snapshot to dir as an app.json file holding the app-wide fields and one
+// <locale>.json file per localization, so that changes can be versioned and reviewed locale by locale.
+func WriteAppMetadata(dir string, metadata *AppMetadata) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	if err := writeAppMetadataFile(filepath.Join(dir, appMetadataFileName), metadata); err != nil {
+		return err
+	}
+
+	for _, locale := range metadata.locales() {
+		if err := writeAppMetadataFile(filepath.Join(dir, locale+".json"), metadata.Localizations[locale]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeAppMetadataFile(path string, v interface{}) error {
+	b, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(b, '\n'), 0o644)
+}
+
+// ReadAppMetadata reads a snapshot previously written by WriteAppMetadata from dir.
+func ReadAppMetadata(dir string) (*AppMetadata, error) {
+	metadata := &AppMetadata{
+		Localizations: make(map[string]*AppMetadataLocalization),
+	}
+
+	if err := readAppMetadataFile(filepath.Join(dir, appMetadataFileName), metadata); err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || name == appMetadataFileName || filepath.Ext(name) != ".json" {
+			continue
+		}
+
+		l := new(AppMetadataLocalization)
+		if err := readAppMetadataFile(filepath.Join(dir, name), l); err != nil {
+			return nil, err
+		}
+
+		metadata.Localizations[strings.TrimSuffix(name, ".json")] = l
+	}
+
+	return metadata, nil
+}
+
+func readAppMetadataFile(path string, v interface{}) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(b, v); err != nil {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+
+	return nil
+}
+
+// DiffAppMetadata lists the fields that would change if desired were applied over current. Fields that are
+// unset in desired and locales that are missing from it are not considered changes.
+func DiffAppMetadata(current *AppMetadata, desired *AppMetadata) []AppMetadataChange {
+	changes := diffAppInfoCategories(current.Categories, desired.Categories)
+	changes = append(changes, diffAgeRating(current.AgeRating, desired.AgeRating)...)
+
+	for _, locale := range desired.locales() {
+		info, version, screenshots := diffAppMetadataLocalization(locale, current.Localizations[locale], desired.Localizations[locale])
+		changes = append(changes, info...)
+		changes = append(changes, version...)
+		changes = append(changes, screenshots...)
+	}
+
+	return changes
+}
+
+// PlanAppMetadata returns the changes ApplyAppMetadata would make to bring current to desired as a structured
+// diff, grouped by the app info, age rating declaration and localizations they change. current must have been
+// produced by ExportAppMetadata. Screenshot sets that differ are listed too, although ApplyAppMetadata leaves
+// them to be uploaded separately.
+func PlanAppMetadata(current *AppMetadata, desired *AppMetadata) *Plan {
+	plan := &Plan{Changes: make([]ConfigChange, 0)}
+
+	if changes := diffAppInfoCategories(current.Categories, desired.Categories); len(changes) > 0 {
+		plan.Changes = append(plan.Changes, appMetadataChange(ConfigActionUpdate, "appInfos", "categories", current.appInfoID, "categories.", changes))
+	}
+
+	if changes := diffAgeRating(current.AgeRating, desired.AgeRating); len(changes) > 0 {
+		plan.Changes = append(plan.Changes, appMetadataChange(ConfigActionUpdate, "ageRatingDeclarations", "ageRating", current.ageRatingDeclarationID, "ageRating.", changes))
+	}
+
+	for _, locale := range desired.locales() {
+		cur := current.Localizations[locale]
+		if cur == nil {
+			cur = &AppMetadataLocalization{}
+		}
+
+		info, version, screenshots := diffAppMetadataLocalization(locale, cur, desired.Localizations[locale])
+		prefix := "localizations." + locale + "."
+
+		if len(info) > 0 {
+			action := ConfigActionUpdate
+			if cur.appInfoLocalizationID == "" {
+				action = ConfigActionCreate
+			}
+
+			plan.Changes = append(plan.Changes, appMetadataChange(action, "appInfoLocalizations", locale, cur.appInfoLocalizationID, prefix, info))
+		}
+
+		if len(version) > 0 {
+			action := ConfigActionUpdate
+			if cur.versionLocalizationID == "" {
+				action = ConfigActionCreate
+			}
+
+			plan.Changes = append(plan.Changes, appMetadataChange(action, "appStoreVersionLocalizations", locale, cur.versionLocalizationID, prefix, version))
+		}
+
+		for _, change := range screenshots {
+			displayType := strings.TrimPrefix(change.Field, prefix+"screenshots.")
+			change.Field = "screenshots"
+
+			plan.Changes = append(plan.Changes, appMetadataChange(ConfigActionUpdate, "appScreenshotSets", locale+" "+displayType, "", "", []AppMetadataChange{change}))
+		}
+	}
+
+	return plan
+}
+
+// appMetadataChange converts metadata changes to a single resource into a ConfigChange, trimming prefix from the
+// names of the fields.
+func appMetadataChange(action ConfigAction, resource string, name string, id string, prefix string, changes []AppMetadataChange) ConfigChange {
+	fields := make([]ConfigFieldChange, len(changes))
+	for i, change := range changes {
+		fields[i] = ConfigFieldChange{Field: strings.TrimPrefix(change.Field, prefix), Current: change.Old, Desired: change.New}
+	}
+
+	return ConfigChange{Action: action, Resource: resource, Name: name, ID: id, Fields: fields}
+}
+
+func diffAppInfoCategories(current AppInfoCategories, desired AppInfoCategories) []AppMetadataChange {
+	fields := []struct {
+		name    string
+		current AppCategoryID
+		desired AppCategoryID
+	}{
+		{"primary", current.Primary, desired.Primary},
+		{"primarySubcategoryOne", current.PrimarySubcategoryOne, desired.PrimarySubcategoryOne},
+		{"primarySubcategoryTwo", current.PrimarySubcategoryTwo, desired.PrimarySubcategoryTwo},
+		{"secondary", current.Secondary, desired.Secondary},
+		{"secondarySubcategoryOne", current.SecondarySubcategoryOne, desired.SecondarySubcategoryOne},
+		{"secondarySubcategoryTwo", current.SecondarySubcategoryTwo, desired.SecondarySubcategoryTwo},
+	}
+
+	var changes []AppMetadataChange
+
+	for _, field := range fields {
+		if field.desired != "" && field.desired != field.current {
+			changes = append(changes, AppMetadataChange{
+				Field: "categories." + field.name,
+				Old:   string(field.current),
+				New:   string(field.desired),
+			})
+		}
+	}
+
+	return changes
+}
+
+func diffAgeRating(current *AgeRatingDeclarationAttributes, desired *AgeRatingDeclarationAttributes) []AppMetadataChange {
+	if desired == nil {
+		return nil
+	}
+
+	currentFields := ageRatingFields(current)
+	desiredFields := ageRatingFields(desired)
+
+	names := make([]string, 0, len(desiredFields))
+	for name := range desiredFields {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var changes []AppMetadataChange
+
+	for _, name := range names {
+		if currentFields[name] != desiredFields[name] {
+			changes = append(changes, AppMetadataChange{
+				Field: "ageRating." + name,
+				Old:   currentFields[name],
+				New:   desiredFields[name],
+			})
+		}
+	}
+
+	return changes
+}
+
+func ageRatingFields(attrs *AgeRatingDeclarationAttributes) map[string]string {
+	fields := make(map[string]string)
+
+	if attrs == nil {
+		return fields
+	}
+
+	b, err := json.Marshal(attrs)
+	if err != nil {
+		return fields
+	}
+
+	var values map[string]interface{}
+	if err := json.Unmarshal(b, &values); err != nil {
+		return fields
+	}
+
+	for name, value := range values {
+		fields[name] = fmt.Sprint(value)
+	}
+
+	return fields
+}
+
+func diffAppMetadataLocalization(locale string, current *AppMetadataLocalization, desired *AppMetadataLocalization) (info, version, screenshots []AppMetadataChange) {
+	if desired == nil {
+		return nil, nil, nil
+	}
+
+	if current == nil {
+		current = &AppMetadataLocalization{}
+	}
+
+	prefix := "localizations." + locale + "."
+	info = diffAppMetadataFields(prefix, current.infoFields(), desired.infoFields())
+	version = diffAppMetadataFields(prefix, current.versionFields(), desired.versionFields())
+
+	if desired.Screenshots != nil {
+		currentSets := make(map[ScreenshotDisplayType][]AppMetadataScreenshot, len(current.Screenshots))
+		for _, set := range current.Screenshots {
+			currentSets[set.DisplayType] = set.Screenshots
+		}
+
+		for _, set := range desired.Screenshots {
+			old := currentSets[set.DisplayType]
+			if reflect.DeepEqual(normalizeScreenshots(old), normalizeScreenshots(set.Screenshots)) {
+				continue
+			}
+
+			screenshots = append(screenshots, AppMetadataChange{
+				Field: prefix + "screenshots." + string(set.DisplayType),
+				Old:   screenshotFileNames(old),
+				New:   screenshotFileNames(set.Screenshots),
+			})
+		}
+	}
+
+	return info, version, screenshots
+}
+
+func diffAppMetadataFields(prefix string, current []appMetadataField, desired []appMetadataField) []AppMetadataChange {
+	var changes []AppMetadataChange
+
+	for i, field := range desired {
+		if field.value == nil {
+			continue
+		}
+
+		old := current[i].value
+		if old != nil && *old == *field.value {
+			continue
+		}
+
+		change := AppMetadataChange{
+			Field: prefix + field.name,
+			New:   *field.value,
+		}
+
+		if old != nil {
+			change.Old = *old
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+func normalizeScreenshots(screenshots []AppMetadataScreenshot) []AppMetadataScreenshot {
+	if len(screenshots) == 0 {
+		return nil
+	}
+
+	normalized := make([]AppMetadataScreenshot, len(screenshots))
+	for i, screenshot := range screenshots {
+		normalized[i] = AppMetadataScreenshot{FileName: screenshot.FileName, SourceFileChecksum: screenshot.SourceFileChecksum}
+	}
+
+	return normalized
+}
+
+func screenshotFileNames(screenshots []AppMetadataScreenshot) string {
+	names := make([]string, len(screenshots))
+	for i, screenshot := range screenshots {
+		names[i] = screenshot.FileName
+	}
+
+	return strings.Join(names, ", ")
+}
+
+// ApplyAppMetadata updates App Store Connect so that current matches desired, and returns the changes that were
+// made, including those made before a failing update. current must have been produced by ExportAppMetadata.
+// Missing localizations are created, but nothing is deleted. Screenshot manifest changes are reported by
+// DiffAppMetadata but not applied, since the snapshot does not hold image files; see ApplyAppMetadataScreenshots.
+func (s *AppsService) ApplyAppMetadata(ctx context.Context, current *AppMetadata, desired *AppMetadata) ([]AppMetadataChange, *Response, error) {
+	var (
+		applied []AppMetadataChange
+		resp    *Response
+		err     error
+	)
+
+	if changes := diffAppInfoCategories(current.Categories, desired.Categories); len(changes) > 0 {
+		_, resp, err = s.SetAppInfoCategories(ctx, current.appInfoID, desired.Categories)
+		if err != nil {
+			return applied, resp, err
+		}
+
+		applied = append(applied, changes...)
+	}
+
+	if changes := diffAgeRating(current.AgeRating, desired.AgeRating); len(changes) > 0 {
+		attributes := AgeRatingDeclarationUpdateRequestAttributes(*desired.AgeRating)
+
+		_, resp, err = s.UpdateAgeRatingDeclaration(ctx, current.ageRatingDeclarationID, &attributes)
+		if err != nil {
+			return applied, resp, err
+		}
+
+		applied = append(applied, changes...)
+	}
+
+	for _, locale := range desired.locales() {
+		cur := current.Localizations[locale]
+		if cur == nil {
+			cur = &AppMetadataLocalization{}
+		}
+
+		des := desired.Localizations[locale]
+		info, version, _ := diffAppMetadataLocalization(locale, cur, des)
+
+		if len(info) > 0 {
+			resp, err = s.applyAppInfoLocalization(ctx, current.appInfoID, locale, cur.appInfoLocalizationID, des)
+			if err != nil {
+				return applied, resp, err
+			}
+
+			applied = append(applied, info...)
+		}
+
+		if len(version) > 0 {
+			resp, err = s.applyAppStoreVersionLocalization(ctx, current.versionID, locale, cur.versionLocalizationID, des)
+			if err != nil {
+				return applied, resp, err
+			}
+
+			applied = append(applied, version...)
+		}
+	}
+
+	return applied, resp, nil
+}
+
+func (s *AppsService) applyAppInfoLocalization(ctx context.Context, appInfoID string, locale string, id string, l *AppMetadataLocalization) (*Response, error) {
+	if id == "" {
+		_, resp, err := s.CreateAppInfoLocalization(ctx, AppInfoLocalizationCreateRequestAttributes{
+			Locale:            locale,
+			Name:              l.Name,
+			PrivacyPolicyText: l.PrivacyPolicyText,
+			PrivacyPolicyURL:  l.PrivacyPolicyURL,
+			Subtitle:          l.Subtitle,
+		}, appInfoID)
+
+		return resp, err
+	}
+
+	_, resp, err := s.UpdateAppInfoLocalization(ctx, id, &AppInfoLocalizationUpdateRequestAttributes{
+		Name:              l.Name,
+		PrivacyPolicyText: l.PrivacyPolicyText,
+		PrivacyPolicyURL:  l.PrivacyPolicyURL,
+		Subtitle:          l.Subtitle,
+	})
+
+	return resp, err
+}
+
+func (s *AppsService) applyAppStoreVersionLocalization(ctx context.Context, versionID string, locale string, id string, l *AppMetadataLocalization) (*Response, error) {
+	if id == "" {
+		_, resp, err := s.CreateAppStoreVersionLocalization(ctx, AppStoreVersionLocalizationCreateRequestAttributes{
+			Description:     l.Description,
+			Keywords:        l.Keywords,
+			Locale:          locale,
+			MarketingURL:    l.MarketingURL,
+			PromotionalText: l.PromotionalText,
+			SupportURL:      l.SupportURL,
+			WhatsNew:        l.WhatsNew,
+		}, versionID)
+
+		return resp, err
+	}
+
+	_, resp, err := s.UpdateAppStoreVersionLocalization(ctx, id, &AppStoreVersionLocalizationUpdateRequestAttributes{
+		Description:     l.Description,
+		Keywords:        l.Keywords,
+		MarketingURL:    l.MarketingURL,
+		PromotionalText: l.PromotionalText,
+		SupportURL:      l.SupportURL,
+		WhatsNew:        l.WhatsNew,
+	})
+
+	return resp, err
+}
+
+// ApplyAppMetadataScreenshots replaces the screenshots of each screenshot set whose manifest in desired differs
+// from current, and returns the sets that were changed, including those changed before a failing upload.
+// Screenshots are uploaded from the local files they were read from by ReadFastlaneMetadata, so a set with a
+// screenshot that has no local file fails with ErrMissingScreenshotFile. Missing screenshot sets are created,
+// but sets that are missing from desired are left unchanged. The App Store version localization of each locale
+// must already exist, for example by calling ApplyAppMetadata first.
+func (s *AppsService) ApplyAppMetadataScreenshots(ctx context.Context, current *AppMetadata, desired *AppMetadata) ([]AppMetadataChange, *Response, error) {
+	var (
+		applied       []AppMetadataChange
+		resp          *Response
+		localizations map[string]string
+	)
+
+	for _, locale := range desired.locales() {
+		cur := current.Localizations[locale]
+		if cur == nil {
+			cur = &AppMetadataLocalization{}
+		}
+
+		_, _, changes := diffAppMetadataLocalization(locale, cur, desired.Localizations[locale])
+		if len(changes) == 0 {
+			continue
+		}
+
+		localizationID := cur.versionLocalizationID
+		if localizationID == "" {
+			if localizations == nil {
+				res, r, err := s.ListLocalizationsForAppStoreVersion(ctx, current.versionID, &ListLocalizationsForAppStoreVersionQuery{Limit: MaxPageLimit})
+				if err != nil {
+					return applied, r, err
+				}
+
+				localizations = make(map[string]string, len(res.Data))
+
+				for _, loc := range res.Data {
+					if loc.Attributes != nil && loc.Attributes.Locale != nil {
+						localizations[*loc.Attributes.Locale] = loc.ID
+					}
+				}
+			}
+
+			if localizationID = localizations[locale]; localizationID == "" {
+				return applied, resp, fmt.Errorf("%w: %s", ErrMissingVersionLocalization, locale)
+			}
+		}
+
+		sets, r, err := s.ListAppScreenshotSetsForAppStoreVersionLocalization(ctx, localizationID, &ListAppScreenshotSetsForAppStoreVersionLocalizationQuery{Limit: 50})
+		if err != nil {
+			return applied, r, err
+		}
+
+		existing := make(map[ScreenshotDisplayType]string, len(sets.Data))
+
+		for _, set := range sets.Data {
+			if set.Attributes != nil && set.Attributes.ScreenshotDisplayType != nil {
+				existing[*set.Attributes.ScreenshotDisplayType] = set.ID
+			}
+		}
+
+		for _, set := range desired.Localizations[locale].Screenshots {
+			field := "localizations." + locale + ".screenshots." + string(set.DisplayType)
+
+			for _, change := range changes {
+				if change.Field != field {
+					continue
+				}
+
+				resp, err = s.replaceAppScreenshots(ctx, localizationID, existing[set.DisplayType], set)
+				if err != nil {
+					return applied, resp, fmt.Errorf("%s: %w", field, err)
+				}
+
+				applied = append(applied, change)
+			}
+		}
+	}
+
+	return applied, resp, nil
+}
+
+// replaceAppScreenshots replaces the screenshots of a screenshot set, or creates the set if setID is empty, with
+// the screenshots of a manifest. The new screenshots are uploaded before the old ones are deleted, so that a failed
+// upload leaves the set as it was, and the set is then put in the order of the manifest.
+func (s *AppsService) replaceAppScreenshots(ctx context.Context, localizationID string, setID string, set AppMetadataScreenshotSet) (*Response, error) {
+	for _, screenshot := range set.Screenshots {
+		if screenshot.path == "" {
+			return nil, fmt.Errorf("%w: %s", ErrMissingScreenshotFile, screenshot.FileName)
+		}
+	}
+
+	var (
+		resp   *Response
+		err    error
+		oldIDs []string
+	)
+
+	if setID == "" {
+		var created *AppScreenshotSetResponse
+
+		created, resp, err = s.CreateAppScreenshotSet(ctx, set.DisplayType, localizationID)
+		if err != nil {
+			return resp, err
+		}
+
+		setID = created.Data.ID
+	} else {
+		var ids *AppScreenshotSetAppScreenshotsLinkagesResponse
+
+		ids, resp, err = s.ListAppScreenshotIDsForSet(ctx, setID, &ListAppScreenshotIDsForSetQuery{Limit: 50})
+		if err != nil {
+			return resp, err
+		}
+
+		for _, id := range ids.Data {
+			oldIDs = append(oldIDs, id.ID)
+		}
+	}
+
+	newIDs := make([]string, 0, len(set.Screenshots))
+
+	for _, screenshot := range set.Screenshots {
+		var id string
+
+		id, resp, err = s.uploadAppScreenshotFile(ctx, screenshot, set.DisplayType, setID)
+		if err != nil {
+			// Remove the screenshots uploaded so far, so that the set keeps only its old screenshots.
+			for _, uploaded := range newIDs {
+				_, _ = s.DeleteAppScreenshot(ctx, uploaded)
+			}
+
+			return resp, err
+		}
+
+		newIDs = append(newIDs, id)
+	}
+
+	for _, id := range oldIDs {
+		if resp, err = s.DeleteAppScreenshot(ctx, id); err != nil {
+			return resp, err
+		}
+	}
+
+	return s.ReplaceAppScreenshotsForSet(ctx, setID, newIDs)
+}
+
+func (s *AppsService) uploadAppScreenshotFile(ctx context.Context, screenshot AppMetadataScreenshot, displayType ScreenshotDisplayType, setID string) (string, *Response, error) {
+	file, err := os.Open(screenshot.path)
+	if err != nil {
+		return "", nil, err
+	}
+	defer file.Close()
+
+	res, resp, err := s.UploadAppScreenshot(ctx, screenshot.FileName, file, displayType, setID)
+	if err != nil {
+		return "", resp, err
+	}
+
+	return res.Data.ID, resp, nil
+}
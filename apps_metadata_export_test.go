@@ -0,0 +1,397 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportAppMetadata(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /apps/1/appInfos": `{"data":[
+			{"id":"1","type":"appInfos","attributes":{"appStoreState":"READY_FOR_SALE"}},
+			{"id":"2","type":"appInfos","attributes":{"appStoreState":"PREPARE_FOR_SUBMISSION"},"relationships":{
+				"primaryCategory":{"data":{"id":"GAMES","type":"appCategories"}},
+				"primarySubcategoryOne":{"data":{"id":"GAMES_ACTION","type":"appCategories"}}
+			}}
+		]}`,
+		"GET /appInfos/2/ageRatingDeclaration":                  `{"data":{"id":"3","type":"ageRatingDeclarations","attributes":{"gambling":false}}}`,
+		"GET /appInfos/2/appInfoLocalizations":                  `{"data":[{"id":"4","type":"appInfoLocalizations","attributes":{"locale":"en-US","name":"App"}}]}`,
+		"GET /appStoreVersions/10/appStoreVersionLocalizations": `{"data":[{"id":"5","type":"appStoreVersionLocalizations","attributes":{"locale":"en-US","description":"Desc"}}]}`,
+		"GET /appStoreVersionLocalizations/5/appScreenshotSets": `{"data":[{"id":"6","type":"appScreenshotSets","attributes":{"screenshotDisplayType":"APP_IPHONE_65"},"relationships":{
+				"appScreenshots":{"data":[{"id":"8","type":"appScreenshots"},{"id":"7","type":"appScreenshots"}]}
+			}}],"included":[
+				{"id":"7","type":"appScreenshots","attributes":{"fileName":"b.png","sourceFileChecksum":"bb"}},
+				{"id":"8","type":"appScreenshots","attributes":{"fileName":"a.png","sourceFileChecksum":"aa"}}
+			]}`,
+	})
+	defer server.Close()
+
+	metadata, _, err := client.Apps.ExportAppMetadata(context.Background(), "1", "10")
+	assert.NoError(t, err)
+	assert.Equal(t, AppInfoCategories{Primary: AppCategoryIDGames, PrimarySubcategoryOne: "GAMES_ACTION"}, metadata.Categories)
+	assert.Equal(t, "3", metadata.ageRatingDeclarationID)
+	assert.Equal(t, Bool(false), metadata.AgeRating.Gambling)
+
+	l := metadata.Localizations["en-US"]
+	assert.Equal(t, "4", l.appInfoLocalizationID)
+	assert.Equal(t, "5", l.versionLocalizationID)
+	assert.Equal(t, String("App"), l.Name)
+	assert.Equal(t, String("Desc"), l.Description)
+	assert.Equal(t, []AppMetadataScreenshotSet{
+		{
+			DisplayType: "APP_IPHONE_65",
+			Screenshots: []AppMetadataScreenshot{
+				{FileName: "a.png", SourceFileChecksum: "aa"},
+				{FileName: "b.png", SourceFileChecksum: "bb"},
+			},
+		},
+	}, l.Screenshots)
+}
+
+func TestExportAppMetadataMissingAppInfo(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /apps/1/appInfos": `{"data":[]}`,
+	})
+	defer server.Close()
+
+	metadata, _, err := client.Apps.ExportAppMetadata(context.Background(), "1", "10")
+	assert.ErrorIs(t, err, ErrMissingAppInfo)
+	assert.Nil(t, metadata)
+}
+
+func TestWriteAndReadAppMetadata(t *testing.T) {
+	t.Parallel()
+
+	want := &AppMetadata{
+		Categories: AppInfoCategories{Primary: AppCategoryIDGames},
+		AgeRating:  &AgeRatingDeclarationAttributes{Gambling: Bool(false)},
+		Localizations: map[string]*AppMetadataLocalization{
+			"en-US": {
+				Name:        String("App"),
+				Description: String("Desc"),
+				Screenshots: []AppMetadataScreenshotSet{
+					{DisplayType: "APP_IPHONE_65", Screenshots: []AppMetadataScreenshot{{FileName: "a.png"}}},
+				},
+			},
+			"de-DE": {Name: String("Anwendung")},
+		},
+	}
+
+	dir := t.TempDir()
+	assert.NoError(t, WriteAppMetadata(dir, want))
+
+	got, err := ReadAppMetadata(dir)
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+}
+
+func TestReadAppMetadataMissingDirectory(t *testing.T) {
+	t.Parallel()
+
+	got, err := ReadAppMetadata(t.TempDir() + "/missing")
+	assert.Error(t, err)
+	assert.Nil(t, got)
+}
+
+func TestDiffAppMetadata(t *testing.T) {
+	t.Parallel()
+
+	current := &AppMetadata{
+		Categories: AppInfoCategories{Primary: AppCategoryIDGames},
+		AgeRating:  &AgeRatingDeclarationAttributes{Gambling: Bool(false), LootBox: Bool(false)},
+		Localizations: map[string]*AppMetadataLocalization{
+			"en-US": {
+				Name:        String("App"),
+				Description: String("Desc"),
+				Screenshots: []AppMetadataScreenshotSet{
+					{DisplayType: "APP_IPHONE_65", Screenshots: []AppMetadataScreenshot{{FileName: "a.png"}}},
+				},
+			},
+		},
+	}
+	desired := &AppMetadata{
+		Categories: AppInfoCategories{Primary: AppCategoryIDGames, Secondary: "UTILITIES"},
+		AgeRating:  &AgeRatingDeclarationAttributes{Gambling: Bool(false), LootBox: Bool(true)},
+		Localizations: map[string]*AppMetadataLocalization{
+			"en-US": {
+				Name:     String("App"),
+				Keywords: String("game"),
+				Screenshots: []AppMetadataScreenshotSet{
+					{DisplayType: "APP_IPHONE_65", Screenshots: []AppMetadataScreenshot{{FileName: "a.png"}, {FileName: "b.png"}}},
+				},
+			},
+			"de-DE": {Name: String("Anwendung")},
+		},
+	}
+
+	assert.Equal(t, []AppMetadataChange{
+		{Field: "categories.secondary", Old: "", New: "UTILITIES"},
+		{Field: "ageRating.lootBox", Old: "false", New: "true"},
+		{Field: "localizations.de-DE.name", Old: "", New: "Anwendung"},
+		{Field: "localizations.en-US.keywords", Old: "", New: "game"},
+		{Field: "localizations.en-US.screenshots.APP_IPHONE_65", Old: "a.png", New: "a.png, b.png"},
+	}, DiffAppMetadata(current, desired))
+	assert.Empty(t, DiffAppMetadata(current, current))
+	assert.Equal(t, `ageRating.lootBox: "false" -> "true"`, AppMetadataChange{Field: "ageRating.lootBox", Old: "false", New: "true"}.String())
+}
+
+func TestPlanAppMetadata(t *testing.T) {
+	t.Parallel()
+
+	current := &AppMetadata{
+		appInfoID:              "1",
+		ageRatingDeclarationID: "2",
+		AgeRating:              &AgeRatingDeclarationAttributes{LootBox: Bool(false)},
+		Localizations: map[string]*AppMetadataLocalization{
+			"en-US": {
+				appInfoLocalizationID: "3",
+				versionLocalizationID: "4",
+				Name:                  String("App"),
+				Screenshots: []AppMetadataScreenshotSet{
+					{DisplayType: "APP_IPHONE_65", Screenshots: []AppMetadataScreenshot{{FileName: "a.png"}}},
+				},
+			},
+		},
+	}
+	desired := &AppMetadata{
+		Categories: AppInfoCategories{Primary: AppCategoryIDGames},
+		AgeRating:  &AgeRatingDeclarationAttributes{LootBox: Bool(true)},
+		Localizations: map[string]*AppMetadataLocalization{
+			"en-US": {
+				Name:     String("App 2"),
+				Keywords: String("game"),
+				Screenshots: []AppMetadataScreenshotSet{
+					{DisplayType: "APP_IPHONE_65", Screenshots: []AppMetadataScreenshot{{FileName: "b.png"}}},
+				},
+			},
+			"de-DE": {Name: String("Anwendung")},
+		},
+	}
+
+	plan := PlanAppMetadata(current, desired)
+	assert.Equal(t, []ConfigChange{
+		{Action: ConfigActionUpdate, Resource: "appInfos", Name: "categories", ID: "1", Fields: []ConfigFieldChange{{Field: "primary", Desired: "GAMES"}}},
+		{Action: ConfigActionUpdate, Resource: "ageRatingDeclarations", Name: "ageRating", ID: "2", Fields: []ConfigFieldChange{{Field: "lootBox", Current: "false", Desired: "true"}}},
+		{Action: ConfigActionCreate, Resource: "appInfoLocalizations", Name: "de-DE", Fields: []ConfigFieldChange{{Field: "name", Desired: "Anwendung"}}},
+		{Action: ConfigActionUpdate, Resource: "appInfoLocalizations", Name: "en-US", ID: "3", Fields: []ConfigFieldChange{{Field: "name", Current: "App", Desired: "App 2"}}},
+		{Action: ConfigActionUpdate, Resource: "appStoreVersionLocalizations", Name: "en-US", ID: "4", Fields: []ConfigFieldChange{{Field: "keywords", Desired: "game"}}},
+		{Action: ConfigActionUpdate, Resource: "appScreenshotSets", Name: "en-US APP_IPHONE_65", Fields: []ConfigFieldChange{{Field: "screenshots", Current: "a.png", Desired: "b.png"}}},
+	}, plan.Changes)
+	assert.True(t, PlanAppMetadata(current, current).Empty())
+}
+
+func TestApplyAppMetadata(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"PATCH /appInfos/2":                     `{"data":{"id":"2","type":"appInfos"}}`,
+		"PATCH /ageRatingDeclarations/3":        `{"data":{"id":"3","type":"ageRatingDeclarations"}}`,
+		"PATCH /appStoreVersionLocalizations/5": `{"data":{"id":"5","type":"appStoreVersionLocalizations"}}`,
+		"POST /appInfoLocalizations":            `{"data":{"id":"9","type":"appInfoLocalizations"}}`,
+	})
+	defer server.Close()
+
+	current := &AppMetadata{
+		Categories: AppInfoCategories{Primary: AppCategoryIDGames},
+		AgeRating:  &AgeRatingDeclarationAttributes{LootBox: Bool(false)},
+		Localizations: map[string]*AppMetadataLocalization{
+			"en-US": {
+				Name:                  String("App"),
+				appInfoLocalizationID: "4",
+				versionLocalizationID: "5",
+			},
+		},
+		appInfoID:              "2",
+		ageRatingDeclarationID: "3",
+		versionID:              "10",
+	}
+	desired := &AppMetadata{
+		Categories: AppInfoCategories{Primary: AppCategoryIDGames, Secondary: "UTILITIES"},
+		AgeRating:  &AgeRatingDeclarationAttributes{LootBox: Bool(true)},
+		Localizations: map[string]*AppMetadataLocalization{
+			"en-US": {
+				Name:        String("App"),
+				Description: String("Desc"),
+				Screenshots: []AppMetadataScreenshotSet{
+					{DisplayType: "APP_IPHONE_65", Screenshots: []AppMetadataScreenshot{{FileName: "a.png"}}},
+				},
+			},
+			"de-DE": {Name: String("Anwendung")},
+		},
+	}
+
+	applied, _, err := client.Apps.ApplyAppMetadata(context.Background(), current, desired)
+	assert.NoError(t, err)
+	assert.Len(t, applied, 4)
+	assert.Equal(t, []string{
+		"PATCH /appInfos/2",
+		"PATCH /ageRatingDeclarations/3",
+		"POST /appInfoLocalizations",
+		"PATCH /appStoreVersionLocalizations/5",
+	}, *requests)
+}
+
+func TestApplyAppMetadataError(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{})
+	defer server.Close()
+
+	current := &AppMetadata{appInfoID: "2"}
+	desired := &AppMetadata{Categories: AppInfoCategories{Primary: AppCategoryIDGames}}
+
+	applied, _, err := client.Apps.ApplyAppMetadata(context.Background(), current, desired)
+	assert.Error(t, err)
+	assert.Empty(t, applied)
+}
+
+func TestApplyAppMetadataScreenshots(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /appStoreVersionLocalizations/4/appScreenshotSets":      `{"data":[{"id":"set1","type":"appScreenshotSets","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}]}`,
+		"GET /appScreenshotSets/set1/relationships/appScreenshots":   `{"data":[{"id":"s1","type":"appScreenshots"}]}`,
+		"DELETE /appScreenshots/s1":                                  `{}`,
+		"POST /appScreenshots":                                       `{"data":{"id":"s2","type":"appScreenshots","attributes":{"uploadOperations":[]}}}`,
+		"PATCH /appScreenshots/s2":                                   `{"data":{"id":"s2","type":"appScreenshots"}}`,
+		"GET /appStoreVersions/10/appStoreVersionLocalizations":      `{"data":[{"id":"5","type":"appStoreVersionLocalizations","attributes":{"locale":"de-DE"}}]}`,
+		"GET /appStoreVersionLocalizations/5/appScreenshotSets":      `{"data":[]}`,
+		"POST /appScreenshotSets":                                    `{"data":{"id":"set2","type":"appScreenshotSets"}}`,
+		"PATCH /appScreenshotSets/set1/relationships/appScreenshots": `{}`,
+		"PATCH /appScreenshotSets/set2/relationships/appScreenshots": `{}`,
+	})
+	defer server.Close()
+
+	screenshotsDir := t.TempDir()
+	writeFastlaneImage(t, screenshotsDir, "en-US/new.png", 1242, 2688)
+	writeFastlaneImage(t, screenshotsDir, "de-DE/neu.png", 1242, 2688)
+	unchanged := writeFastlaneImage(t, screenshotsDir, "fr-FR/same.png", 1242, 2688)
+
+	desired, err := ReadFastlaneMetadata(t.TempDir(), screenshotsDir)
+	assert.NoError(t, err)
+
+	current := &AppMetadata{
+		versionID: "10",
+		Localizations: map[string]*AppMetadataLocalization{
+			"en-US": {
+				versionLocalizationID: "4",
+				Screenshots:           []AppMetadataScreenshotSet{{DisplayType: ScreenshotDisplayTypeAppiPhone65, Screenshots: []AppMetadataScreenshot{{FileName: "old.png", SourceFileChecksum: "aa"}}}},
+			},
+			"fr-FR": {
+				versionLocalizationID: "6",
+				Screenshots:           []AppMetadataScreenshotSet{{DisplayType: ScreenshotDisplayTypeAppiPhone65, Screenshots: []AppMetadataScreenshot{{FileName: "same.png", SourceFileChecksum: unchanged}}}},
+			},
+		},
+	}
+
+	applied, _, err := client.Apps.ApplyAppMetadataScreenshots(context.Background(), current, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, []AppMetadataChange{
+		{Field: "localizations.de-DE.screenshots.APP_IPHONE_65", Old: "", New: "neu.png"},
+		{Field: "localizations.en-US.screenshots.APP_IPHONE_65", Old: "old.png", New: "new.png"},
+	}, applied)
+	assert.Contains(t, *requests, "POST /appScreenshotSets")
+
+	order := make([]string, 0)
+
+	for _, request := range *requests {
+		switch request {
+		case "POST /appScreenshots", "DELETE /appScreenshots/s1", "PATCH /appScreenshotSets/set1/relationships/appScreenshots":
+			order = append(order, request)
+		}
+	}
+
+	assert.Equal(t, []string{
+		"POST /appScreenshots",
+		"DELETE /appScreenshots/s1",
+		"PATCH /appScreenshotSets/set1/relationships/appScreenshots",
+	}, order[len(order)-3:], "the new screenshots are uploaded before the old ones are deleted")
+	assert.NotContains(t, *requests, "GET /appStoreVersionLocalizations/6/appScreenshotSets")
+}
+
+func TestApplyAppMetadataScreenshotsUploadError(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /appStoreVersionLocalizations/4/appScreenshotSets":    `{"data":[{"id":"set1","type":"appScreenshotSets","attributes":{"screenshotDisplayType":"APP_IPHONE_65"}}]}`,
+		"GET /appScreenshotSets/set1/relationships/appScreenshots": `{"data":[{"id":"s1","type":"appScreenshots"}]}`,
+		"DELETE /appScreenshots/s1":                                `{}`,
+	})
+	defer server.Close()
+
+	screenshotsDir := t.TempDir()
+	writeFastlaneImage(t, screenshotsDir, "en-US/new.png", 1242, 2688)
+
+	desired, err := ReadFastlaneMetadata(t.TempDir(), screenshotsDir)
+	assert.NoError(t, err)
+
+	current := &AppMetadata{
+		versionID: "10",
+		Localizations: map[string]*AppMetadataLocalization{
+			"en-US": {
+				versionLocalizationID: "4",
+				Screenshots:           []AppMetadataScreenshotSet{{DisplayType: ScreenshotDisplayTypeAppiPhone65, Screenshots: []AppMetadataScreenshot{{FileName: "old.png", SourceFileChecksum: "aa"}}}},
+			},
+		},
+	}
+
+	applied, _, err := client.Apps.ApplyAppMetadataScreenshots(context.Background(), current, desired)
+	assert.Error(t, err)
+	assert.Empty(t, applied)
+	assert.Contains(t, *requests, "POST /appScreenshots")
+	assert.NotContains(t, *requests, "DELETE /appScreenshots/s1", "the old screenshots are kept when an upload fails")
+	assert.NotContains(t, *requests, "PATCH /appScreenshotSets/set1/relationships/appScreenshots")
+}
+
+func TestApplyAppMetadataScreenshotsErrors(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /appStoreVersions/10/appStoreVersionLocalizations": `{"data":[]}`,
+		"GET /appStoreVersionLocalizations/4/appScreenshotSets": `{"data":[]}`,
+	})
+	defer server.Close()
+
+	ctx := context.Background()
+	current := &AppMetadata{versionID: "10", Localizations: map[string]*AppMetadataLocalization{"en-US": {versionLocalizationID: "4"}}}
+
+	desired := &AppMetadata{Localizations: map[string]*AppMetadataLocalization{
+		"en-US": {Screenshots: []AppMetadataScreenshotSet{{DisplayType: ScreenshotDisplayTypeAppiPhone65, Screenshots: []AppMetadataScreenshot{{FileName: "a.png"}}}}},
+	}}
+
+	_, _, err := client.Apps.ApplyAppMetadataScreenshots(ctx, current, desired)
+	assert.ErrorIs(t, err, ErrMissingScreenshotFile)
+	assert.NotContains(t, *requests, "POST /appScreenshotSets")
+
+	desired.Localizations["de-DE"] = desired.Localizations["en-US"]
+	delete(desired.Localizations, "en-US")
+
+	_, _, err = client.Apps.ApplyAppMetadataScreenshots(ctx, current, desired)
+	assert.ErrorIs(t, err, ErrMissingVersionLocalization)
+}
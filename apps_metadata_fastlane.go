@@ -0,0 +1,451 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrUnknownScreenshotSize happens when a screenshot in a fastlane screenshots directory has a pixel size that
+// no display type accepts.
+var ErrUnknownScreenshotSize = errors.New("no display type accepts screenshots of this size")
+
+const (
+	// fastlaneDefaultLocale holds values that apply to every locale that does not set them itself.
+	fastlaneDefaultLocale = "default"
+	// fastlaneIMessageDir holds the iMessage screenshots of a locale.
+	fastlaneIMessageDir = "iMessage"
+)
+
+// fastlaneIgnoredDirs are the directories of a deliver metadata directory that are not locales.
+var fastlaneIgnoredDirs = map[string]bool{
+	"review_information":                       true,
+	"trade_representative_contact_information": true,
+}
+
+// fastlaneAmbiguousDisplayTypes resolve pixel sizes that several display types accept, unless the file name
+// names the display type.
+var fastlaneAmbiguousDisplayTypes = []ScreenshotDisplayType{
+	ScreenshotDisplayTypeAppiPadPro3Gen129,
+	ScreenshotDisplayTypeiMessageAppIPadPro3Gen129,
+	ScreenshotDisplayTypeAppAppleTV,
+}
+
+type fastlaneFile struct {
+	name  string
+	value **string
+}
+
+type fastlaneCategoryFile struct {
+	name  string
+	value *AppCategoryID
+}
+
+func (c *AppInfoCategories) fastlaneFiles() []fastlaneCategoryFile {
+	return []fastlaneCategoryFile{
+		{"primary_category.txt", &c.Primary},
+		{"primary_first_sub_category.txt", &c.PrimarySubcategoryOne},
+		{"primary_second_sub_category.txt", &c.PrimarySubcategoryTwo},
+		{"secondary_category.txt", &c.Secondary},
+		{"secondary_first_sub_category.txt", &c.SecondarySubcategoryOne},
+		{"secondary_second_sub_category.txt", &c.SecondarySubcategoryTwo},
+	}
+}
+
+func (l *AppMetadataLocalization) fastlaneFiles() []fastlaneFile {
+	return []fastlaneFile{
+		{"name.txt", &l.Name},
+		{"subtitle.txt", &l.Subtitle},
+		{"privacy_url.txt", &l.PrivacyPolicyURL},
+		{"apple_tv_privacy_policy.txt", &l.PrivacyPolicyText},
+		{"description.txt", &l.Description},
+		{"keywords.txt", &l.Keywords},
+		{"marketing_url.txt", &l.MarketingURL},
+		{"promotional_text.txt", &l.PromotionalText},
+		{"support_url.txt", &l.SupportURL},
+		{"release_notes.txt", &l.WhatsNew},
+	}
+}
+
+// ReadFastlaneMetadata reads a metadata directory laid out for fastlane deliver, with category files such as
+// primary_category.txt at the top and a directory of text files such as name.txt and release_notes.txt for each
+// locale. Values in the default directory apply to every locale that does not have its own file. Surrounding
+// whitespace is trimmed, and missing files leave their fields unmanaged. Categories must be App Store Connect
+// category IDs, such as GAMES or GAMES_ACTION. The age rating, review information and copyright are not read.
+//
+// If screenshotsDir is not empty, the screenshots in each of its locale directories are read into the
+// screenshot manifest of the locale, so that ApplyAppMetadataScreenshots can upload them. As with deliver, the
+// display type of a screenshot is determined from its pixel size, screenshots of iMessage apps are kept in an
+// iMessage subdirectory, and only the *_framed screenshots of a directory are used if it has any. A pixel size
+// that several display types accept, such as 2048x2732, is resolved by a display type in the file name, such as
+// APP_IPAD_PRO_129, or otherwise to the newest display type.
+func ReadFastlaneMetadata(metadataDir string, screenshotsDir string) (*AppMetadata, error) {
+	metadata := &AppMetadata{
+		Localizations: make(map[string]*AppMetadataLocalization),
+	}
+
+	for _, file := range metadata.Categories.fastlaneFiles() {
+		value, err := readFastlaneFile(filepath.Join(metadataDir, file.name))
+		if err != nil {
+			return nil, err
+		}
+
+		if value != nil {
+			*file.value = AppCategoryID(*value)
+		}
+	}
+
+	entries, err := os.ReadDir(metadataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var defaults *AppMetadataLocalization
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() || fastlaneIgnoredDirs[name] || strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		l := new(AppMetadataLocalization)
+
+		for _, file := range l.fastlaneFiles() {
+			if *file.value, err = readFastlaneFile(filepath.Join(metadataDir, name, file.name)); err != nil {
+				return nil, err
+			}
+		}
+
+		if name == fastlaneDefaultLocale {
+			defaults = l
+		} else {
+			metadata.Localizations[name] = l
+		}
+	}
+
+	if defaults != nil {
+		for _, l := range metadata.Localizations {
+			values := defaults.fastlaneFiles()
+
+			for i, file := range l.fastlaneFiles() {
+				if *file.value == nil {
+					*file.value = *values[i].value
+				}
+			}
+		}
+	}
+
+	if screenshotsDir != "" {
+		if err := metadata.readFastlaneScreenshots(screenshotsDir); err != nil {
+			return nil, err
+		}
+	}
+
+	return metadata, nil
+}
+
+// readFastlaneFile returns the trimmed contents of a text file, or nil if it does not exist.
+func readFastlaneFile(path string) (*string, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	value := strings.TrimSpace(string(b))
+
+	return &value, nil
+}
+
+func (m *AppMetadata) readFastlaneScreenshots(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		locale := entry.Name()
+		if !entry.IsDir() || strings.HasPrefix(locale, ".") {
+			continue
+		}
+
+		sets, err := readFastlaneScreenshotDir(filepath.Join(dir, locale), false, nil)
+		if err != nil {
+			return err
+		}
+
+		sets, err = readFastlaneScreenshotDir(filepath.Join(dir, locale, fastlaneIMessageDir), true, sets)
+		if errors.Is(err, os.ErrNotExist) {
+			err = nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if len(sets) > 0 {
+			m.localization(locale).Screenshots = sets
+		}
+	}
+
+	return nil
+}
+
+// readFastlaneScreenshotDir adds the screenshots of a directory to sets, in file name order.
+func readFastlaneScreenshotDir(dir string, iMessage bool, sets []AppMetadataScreenshotSet) ([]AppMetadataScreenshotSet, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return sets, err
+	}
+
+	var names []string
+
+	framed := false
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || strings.HasPrefix(name, ".") {
+			continue
+		}
+
+		switch strings.ToLower(filepath.Ext(name)) {
+		case ".png", ".jpg", ".jpeg":
+		default:
+			continue
+		}
+
+		if strings.Contains(name, "_framed.") {
+			if !framed {
+				names = nil
+			}
+
+			framed = true
+		} else if framed {
+			continue
+		}
+
+		names = append(names, name)
+	}
+
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+
+		screenshot, displayType, err := readFastlaneScreenshot(path, iMessage)
+		if err != nil {
+			return sets, err
+		}
+
+		i := 0
+		for i < len(sets) && sets[i].DisplayType != displayType {
+			i++
+		}
+
+		if i == len(sets) {
+			sets = append(sets, AppMetadataScreenshotSet{DisplayType: displayType})
+		}
+
+		sets[i].Screenshots = append(sets[i].Screenshots, screenshot)
+	}
+
+	return sets, nil
+}
+
+func readFastlaneScreenshot(path string, iMessage bool) (AppMetadataScreenshot, ScreenshotDisplayType, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return AppMetadataScreenshot{}, "", err
+	}
+	defer file.Close()
+
+	config, _, err := image.DecodeConfig(file)
+	if err != nil {
+		return AppMetadataScreenshot{}, "", fmt.Errorf("%s: %w", path, err)
+	}
+
+	_, checksum, err := fileChecksum(file)
+	if err != nil {
+		return AppMetadataScreenshot{}, "", err
+	}
+
+	name := filepath.Base(path)
+	size := ScreenshotDimensions{Width: config.Width, Height: config.Height}
+
+	displayType, ok := fastlaneScreenshotDisplayType(name, iMessage, size)
+	if !ok {
+		return AppMetadataScreenshot{}, "", fmt.Errorf("%s is %s: %w", path, size, ErrUnknownScreenshotSize)
+	}
+
+	screenshot := AppMetadataScreenshot{
+		FileName:           trimFastlaneScreenshotPrefix(name, displayType),
+		SourceFileChecksum: checksum,
+		path:               path,
+	}
+
+	return screenshot, displayType, nil
+}
+
+// fastlaneScreenshotDisplayType determines the display type of a screenshot from its pixel size, using the file
+// name to choose between display types that accept the same size.
+func fastlaneScreenshotDisplayType(name string, iMessage bool, size ScreenshotDimensions) (ScreenshotDisplayType, bool) {
+	var candidates []ScreenshotDisplayType
+
+	for _, displayType := range screenshotDisplayTypes {
+		if strings.HasPrefix(string(displayType), "IMESSAGE_") != iMessage {
+			continue
+		}
+
+		for _, d := range displayType.Dimensions() {
+			if d == size {
+				candidates = append(candidates, displayType)
+
+				break
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	var named ScreenshotDisplayType
+
+	upper := strings.ToUpper(name)
+	for _, displayType := range candidates {
+		if strings.Contains(upper, string(displayType)) && len(displayType) > len(named) {
+			named = displayType
+		}
+	}
+
+	if named != "" {
+		return named, true
+	}
+
+	for _, preferred := range fastlaneAmbiguousDisplayTypes {
+		for _, displayType := range candidates {
+			if displayType == preferred {
+				return displayType, true
+			}
+		}
+	}
+
+	return candidates[0], true
+}
+
+// trimFastlaneScreenshotPrefix removes the <position>_<display type>_ prefix that DownloadFastlaneScreenshots
+// adds to file names, so that the manifest names the file that was originally uploaded.
+func trimFastlaneScreenshotPrefix(name string, displayType ScreenshotDisplayType) string {
+	i := strings.IndexByte(name, '_')
+	if i <= 0 || strings.Trim(name[:i], "0123456789") != "" {
+		return name
+	}
+
+	prefix := string(displayType) + "_"
+	if rest := name[i+1:]; strings.HasPrefix(rest, prefix) && len(rest) > len(prefix) {
+		return rest[len(prefix):]
+	}
+
+	return name
+}
+
+// WriteFastlaneMetadata writes the categories and localizations of a snapshot to dir in the layout read by
+// ReadFastlaneMetadata and fastlane deliver. Unmanaged fields are not written. Screenshots are downloaded
+// separately with DownloadFastlaneScreenshots.
+func WriteFastlaneMetadata(dir string, metadata *AppMetadata) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	for _, file := range metadata.Categories.fastlaneFiles() {
+		if *file.value == "" {
+			continue
+		}
+
+		if err := os.WriteFile(filepath.Join(dir, file.name), []byte(*file.value), 0o644); err != nil {
+			return err
+		}
+	}
+
+	for _, locale := range metadata.locales() {
+		if err := os.MkdirAll(filepath.Join(dir, locale), 0o755); err != nil {
+			return err
+		}
+
+		for _, file := range metadata.Localizations[locale].fastlaneFiles() {
+			if *file.value == nil {
+				continue
+			}
+
+			if err := os.WriteFile(filepath.Join(dir, locale, file.name), []byte(**file.value), 0o644); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// DownloadFastlaneScreenshots downloads the screenshots of every localization of an App Store version into dir
+// in the layout read by ReadFastlaneMetadata and fastlane deliver, as <locale>/<position>_<display type>_<file
+// name>, with iMessage screenshots in <locale>/iMessage. Screenshots that are still processing are skipped.
+func (s *AppsService) DownloadFastlaneScreenshots(ctx context.Context, versionID string, dir string) ([]StoreAsset, *Response, error) {
+	localizations, resp, err := s.ListLocalizationsForAppStoreVersion(ctx, versionID, &ListLocalizationsForAppStoreVersionQuery{Limit: MaxPageLimit})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	assets := make([]StoreAsset, 0)
+
+	for _, loc := range localizations.Data {
+		if loc.Attributes == nil || loc.Attributes.Locale == nil {
+			continue
+		}
+
+		screenshots, resp, err := s.storeScreenshotAssets(ctx, loc.ID, *loc.Attributes.Locale)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		for _, asset := range screenshots {
+			folder := filepath.Join(dir, asset.Locale)
+			if strings.HasPrefix(asset.DisplayType, "IMESSAGE_") {
+				folder = filepath.Join(folder, fastlaneIMessageDir)
+			}
+
+			asset.Path = filepath.Join(folder, fmt.Sprintf("%02d_%s_%s", asset.Position, asset.DisplayType, asset.FileName))
+
+			resp, err = s.client.downloadFile(ctx, asset.URL, asset.Path)
+			if err != nil {
+				return nil, resp, fmt.Errorf("%s: %w", asset.Path, err)
+			}
+
+			assets = append(assets, asset)
+		}
+	}
+
+	return assets, resp, nil
+}
@@ -0,0 +1,235 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"crypto/md5" // nolint: gosec
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeFastlaneFile writes a file under dir, creating its parent directories, and returns its contents.
+func writeFastlaneFile(t *testing.T, dir string, name string, contents []byte) []byte {
+	t.Helper()
+
+	path := filepath.Join(dir, name)
+	assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	assert.NoError(t, os.WriteFile(path, contents, 0o644))
+
+	return contents
+}
+
+// writeFastlaneImage writes a PNG of the given size under dir, and returns its MD5 checksum.
+func writeFastlaneImage(t *testing.T, dir string, name string, width, height int) string {
+	t.Helper()
+
+	img := encodedImage(t, "png", width, height)
+	b := make([]byte, img.Len())
+	_, err := img.Read(b)
+	assert.NoError(t, err)
+
+	sum := md5.Sum(writeFastlaneFile(t, dir, name, b)) // nolint: gosec
+
+	return hex.EncodeToString(sum[:])
+}
+
+func TestReadFastlaneMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadataDir := t.TempDir()
+	writeFastlaneFile(t, metadataDir, "primary_category.txt", []byte("GAMES\n"))
+	writeFastlaneFile(t, metadataDir, "primary_first_sub_category.txt", []byte("GAMES_PUZZLE"))
+	writeFastlaneFile(t, metadataDir, "copyright.txt", []byte("2020 Example"))
+	writeFastlaneFile(t, metadataDir, "default/support_url.txt", []byte("https://example.com/support"))
+	writeFastlaneFile(t, metadataDir, "default/keywords.txt", []byte("game"))
+	writeFastlaneFile(t, metadataDir, "en-US/name.txt", []byte("Example\n"))
+	writeFastlaneFile(t, metadataDir, "en-US/release_notes.txt", []byte("  Bug fixes.\n\n"))
+	writeFastlaneFile(t, metadataDir, "de-DE/name.txt", []byte("Beispiel"))
+	writeFastlaneFile(t, metadataDir, "de-DE/keywords.txt", []byte("spiel"))
+	writeFastlaneFile(t, metadataDir, "review_information/notes.txt", []byte("Sign in as demo"))
+
+	screenshotsDir := t.TempDir()
+	first := writeFastlaneImage(t, screenshotsDir, "en-US/1_first.png", 1242, 2688)
+	second := writeFastlaneImage(t, screenshotsDir, "en-US/2_second.png", 1242, 2688)
+	pro := writeFastlaneImage(t, screenshotsDir, "en-US/3_pro.png", 2048, 2732)
+	downloaded := writeFastlaneImage(t, screenshotsDir, "en-US/01_APP_IPAD_PRO_129_old.png", 2048, 2732)
+	sticker := writeFastlaneImage(t, screenshotsDir, "en-US/iMessage/sticker.png", 1242, 2688)
+	writeFastlaneImage(t, screenshotsDir, "fr-FR/plain.png", 1242, 2688)
+	framed := writeFastlaneImage(t, screenshotsDir, "fr-FR/plain_framed.png", 1242, 2688)
+	writeFastlaneFile(t, screenshotsDir, "fr-FR/screenshots.html", []byte("<html>"))
+
+	metadata, err := ReadFastlaneMetadata(metadataDir, screenshotsDir)
+	assert.NoError(t, err)
+	assert.Equal(t, AppInfoCategories{Primary: AppCategoryIDGames, PrimarySubcategoryOne: "GAMES_PUZZLE"}, metadata.Categories)
+	assert.Equal(t, []string{"de-DE", "en-US", "fr-FR"}, metadata.locales())
+
+	enUS := metadata.Localizations["en-US"]
+	assert.Equal(t, String("Example"), enUS.Name)
+	assert.Equal(t, String("Bug fixes."), enUS.WhatsNew)
+	assert.Equal(t, String("game"), enUS.Keywords)
+	assert.Equal(t, String("https://example.com/support"), enUS.SupportURL)
+	assert.Nil(t, enUS.Description)
+	assert.Equal(t, String("spiel"), metadata.Localizations["de-DE"].Keywords)
+
+	assert.Equal(t, []AppMetadataScreenshotSet{
+		{DisplayType: ScreenshotDisplayTypeAppiPadPro129, Screenshots: []AppMetadataScreenshot{{FileName: "old.png", SourceFileChecksum: downloaded}}},
+		{DisplayType: ScreenshotDisplayTypeAppiPhone65, Screenshots: []AppMetadataScreenshot{{FileName: "1_first.png", SourceFileChecksum: first}, {FileName: "2_second.png", SourceFileChecksum: second}}},
+		{DisplayType: ScreenshotDisplayTypeAppiPadPro3Gen129, Screenshots: []AppMetadataScreenshot{{FileName: "3_pro.png", SourceFileChecksum: pro}}},
+		{DisplayType: ScreenshotDisplayTypeiMessageAppIPhone65, Screenshots: []AppMetadataScreenshot{{FileName: "sticker.png", SourceFileChecksum: sticker}}},
+	}, normalizeScreenshotSets(enUS.Screenshots))
+	assert.Equal(t, filepath.Join(screenshotsDir, "en-US", "1_first.png"), enUS.Screenshots[1].Screenshots[0].path)
+
+	frFR := metadata.Localizations["fr-FR"]
+	assert.Nil(t, frFR.Name)
+	assert.Equal(t, []AppMetadataScreenshot{{FileName: "plain_framed.png", SourceFileChecksum: framed}}, normalizeScreenshots(frFR.Screenshots[0].Screenshots))
+}
+
+// normalizeScreenshotSets drops the local file paths from screenshot sets, for comparison in tests.
+func normalizeScreenshotSets(sets []AppMetadataScreenshotSet) []AppMetadataScreenshotSet {
+	normalized := make([]AppMetadataScreenshotSet, len(sets))
+	for i, set := range sets {
+		normalized[i] = AppMetadataScreenshotSet{DisplayType: set.DisplayType, Screenshots: normalizeScreenshots(set.Screenshots)}
+	}
+
+	return normalized
+}
+
+func TestReadFastlaneMetadataErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := ReadFastlaneMetadata(filepath.Join(t.TempDir(), "missing"), "")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	metadataDir := t.TempDir()
+
+	_, err = ReadFastlaneMetadata(metadataDir, filepath.Join(t.TempDir(), "missing"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	screenshotsDir := t.TempDir()
+	writeFastlaneImage(t, screenshotsDir, "en-US/tiny.png", 10, 10)
+
+	_, err = ReadFastlaneMetadata(metadataDir, screenshotsDir)
+	assert.ErrorIs(t, err, ErrUnknownScreenshotSize)
+	assert.Contains(t, err.Error(), "tiny.png is 10x10")
+
+	screenshotsDir = t.TempDir()
+	writeFastlaneFile(t, screenshotsDir, "en-US/broken.png", []byte("not an image"))
+
+	_, err = ReadFastlaneMetadata(metadataDir, screenshotsDir)
+	assert.Error(t, err)
+}
+
+func TestWriteFastlaneMetadata(t *testing.T) {
+	t.Parallel()
+
+	metadata := &AppMetadata{
+		Categories: AppInfoCategories{Primary: AppCategoryIDGames, Secondary: "UTILITIES"},
+		Localizations: map[string]*AppMetadataLocalization{
+			"en-US": {Name: String("Example"), PrivacyPolicyURL: String("https://example.com/privacy"), WhatsNew: String("Bug fixes.")},
+			"de-DE": {Description: String("Ein Beispiel.\n\nMit Absätzen.")},
+		},
+	}
+
+	dir := filepath.Join(t.TempDir(), "metadata")
+	assert.NoError(t, WriteFastlaneMetadata(dir, metadata))
+
+	b, err := os.ReadFile(filepath.Join(dir, "en-US", "release_notes.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Bug fixes.", string(b))
+
+	_, err = os.Stat(filepath.Join(dir, "en-US", "description.txt"))
+	assert.True(t, os.IsNotExist(err))
+
+	got, err := ReadFastlaneMetadata(dir, "")
+	assert.NoError(t, err)
+	assert.Equal(t, metadata.Categories, got.Categories)
+	assert.Equal(t, metadata.Localizations, got.Localizations)
+}
+
+func TestFastlaneScreenshotDisplayType(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		iMessage bool
+		size     ScreenshotDimensions
+		want     ScreenshotDisplayType
+	}{
+		{"shot.png", false, ScreenshotDimensions{1242, 2208}, ScreenshotDisplayTypeAppiPhone55},
+		{"shot.png", false, ScreenshotDimensions{2208, 1242}, ScreenshotDisplayTypeAppiPhone55},
+		{"shot.png", true, ScreenshotDimensions{1242, 2208}, ScreenshotDisplayTypeiMessageAppIPhone55},
+		{"shot.png", false, ScreenshotDimensions{2048, 2732}, ScreenshotDisplayTypeAppiPadPro3Gen129},
+		{"app_ipad_pro_129_shot.png", false, ScreenshotDimensions{2048, 2732}, ScreenshotDisplayTypeAppiPadPro129},
+		{"shot.png", false, ScreenshotDimensions{3840, 2160}, ScreenshotDisplayTypeAppAppleTV},
+		{"APP_APPLE_VISION_PRO.png", false, ScreenshotDimensions{3840, 2160}, ScreenshotDisplayTypeAppAppleVisionPro},
+	} {
+		got, ok := fastlaneScreenshotDisplayType(test.name, test.iMessage, test.size)
+		assert.True(t, ok, test.name)
+		assert.Equal(t, test.want, got, "%s %s", test.name, test.size)
+	}
+
+	_, ok := fastlaneScreenshotDisplayType("shot.png", true, ScreenshotDimensions{1920, 1080})
+	assert.False(t, ok)
+}
+
+func TestTrimFastlaneScreenshotPrefix(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "shot.png", trimFastlaneScreenshotPrefix("01_APP_IPHONE_65_shot.png", ScreenshotDisplayTypeAppiPhone65))
+	assert.Equal(t, "1_shot.png", trimFastlaneScreenshotPrefix("1_shot.png", ScreenshotDisplayTypeAppiPhone65))
+	assert.Equal(t, "01_APP_IPHONE_65_", trimFastlaneScreenshotPrefix("01_APP_IPHONE_65_", ScreenshotDisplayTypeAppiPhone65))
+	assert.Equal(t, "x1_APP_IPHONE_65_shot.png", trimFastlaneScreenshotPrefix("x1_APP_IPHONE_65_shot.png", ScreenshotDisplayTypeAppiPhone65))
+}
+
+func TestDownloadFastlaneScreenshots(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /appStoreVersions/10/appStoreVersionLocalizations": `{"data":[{"id":"100","type":"appStoreVersionLocalizations","attributes":{"locale":"en-US"}}]}`,
+		"GET /appStoreVersionLocalizations/100/appScreenshotSets": `{"data":[
+				{"id":"set1","type":"appScreenshotSets","attributes":{"screenshotDisplayType":"APP_IPHONE_65"},"relationships":{"appScreenshots":{"data":[{"id":"s1","type":"appScreenshots"}]}}},
+				{"id":"set2","type":"appScreenshotSets","attributes":{"screenshotDisplayType":"IMESSAGE_APP_IPHONE_65"},"relationships":{"appScreenshots":{"data":[{"id":"s2","type":"appScreenshots"}]}}}
+			],"included":[
+				{"id":"s1","type":"appScreenshots","attributes":{"fileName":"first.png","imageAsset":{"templateUrl":"/cdn/s1/{w}x{h}.{f}","width":1242,"height":2688}}},
+				{"id":"s2","type":"appScreenshots","attributes":{"fileName":"sticker.png","imageAsset":{"templateUrl":"/cdn/s2/{w}x{h}.{f}","width":1242,"height":2688}}}
+			]}`,
+		"GET /cdn/s1/1242x2688.png": "first",
+		"GET /cdn/s2/1242x2688.png": "sticker",
+	})
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	assets, _, err := client.Apps.DownloadFastlaneScreenshots(context.Background(), "10", dir)
+	assert.NoError(t, err)
+	assert.Len(t, assets, 2)
+	assert.Equal(t, filepath.Join(dir, "en-US", "01_APP_IPHONE_65_first.png"), assets[0].Path)
+	assert.Equal(t, filepath.Join(dir, "en-US", "iMessage", "01_IMESSAGE_APP_IPHONE_65_sticker.png"), assets[1].Path)
+
+	b, err := os.ReadFile(assets[1].Path)
+	assert.NoError(t, err)
+	assert.Equal(t, "sticker\n", string(b))
+}
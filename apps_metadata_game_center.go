@@ -113,33 +113,21 @@ type ListCompatibleVersionIDsForGameCenterEnabledVersionQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_game_center_enabled_versions_for_an_app
 func (s *AppsService) ListGameCenterEnabledVersionsForApp(ctx context.Context, id string, params *ListGameCenterEnabledVersionsForAppQuery) (*GameCenterEnabledVersionsResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/gameCenterEnabledVersions", id)
-	res := new(GameCenterEnabledVersionsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[GameCenterEnabledVersionsResponse](ctx, s.client, fmt.Sprintf("apps/%s/gameCenterEnabledVersions", id), params)
 }
 
 // ListCompatibleVersionsForGameCenterEnabledVersion lists the versions that are compatible with a given Game Center version
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_compatible_versions_for_a_game_center_enabled_version
 func (s *AppsService) ListCompatibleVersionsForGameCenterEnabledVersion(ctx context.Context, id string, params *ListCompatibleVersionsForGameCenterEnabledVersionQuery) (*GameCenterEnabledVersionsResponse, *Response, error) {
-	url := fmt.Sprintf("gameCenterEnabledVersions/%s/compatibleVersions", id)
-	res := new(GameCenterEnabledVersionsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[GameCenterEnabledVersionsResponse](ctx, s.client, fmt.Sprintf("gameCenterEnabledVersions/%s/compatibleVersions", id), params)
 }
 
 // ListCompatibleVersionIDsForGameCenterEnabledVersion lists the version IDs that are compatible with a given Game Center version
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_compatible_version_ids_for_a_game_center_enabled_version
 func (s *AppsService) ListCompatibleVersionIDsForGameCenterEnabledVersion(ctx context.Context, id string, params *ListCompatibleVersionIDsForGameCenterEnabledVersionQuery) (*GameCenterEnabledVersionCompatibleVersionsLinkagesResponse, *Response, error) {
-	url := fmt.Sprintf("gameCenterEnabledVersions/%s/relationships/compatibleVersions", id)
-	res := new(GameCenterEnabledVersionCompatibleVersionsLinkagesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[GameCenterEnabledVersionCompatibleVersionsLinkagesResponse](ctx, s.client, fmt.Sprintf("gameCenterEnabledVersions/%s/relationships/compatibleVersions", id), params)
 }
 
 // CreateCompatibleVersionsForGameCenterEnabledVersion adds a relationship between a given version and a Game Center enabled version
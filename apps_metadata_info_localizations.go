@@ -108,6 +108,34 @@ type AppInfoLocalizationUpdateRequestAttributes struct {
 	Subtitle          *string `json:"subtitle,omitempty"`
 }
 
+// WithName sets the name to update and returns the attributes for chaining.
+func (a *AppInfoLocalizationUpdateRequestAttributes) WithName(v string) *AppInfoLocalizationUpdateRequestAttributes {
+	a.Name = &v
+
+	return a
+}
+
+// WithPrivacyPolicyText sets the privacy policy text to update and returns the attributes for chaining.
+func (a *AppInfoLocalizationUpdateRequestAttributes) WithPrivacyPolicyText(v string) *AppInfoLocalizationUpdateRequestAttributes {
+	a.PrivacyPolicyText = &v
+
+	return a
+}
+
+// WithPrivacyPolicyURL sets the privacy policy URL to update and returns the attributes for chaining.
+func (a *AppInfoLocalizationUpdateRequestAttributes) WithPrivacyPolicyURL(v string) *AppInfoLocalizationUpdateRequestAttributes {
+	a.PrivacyPolicyURL = &v
+
+	return a
+}
+
+// WithSubtitle sets the subtitle to update and returns the attributes for chaining.
+func (a *AppInfoLocalizationUpdateRequestAttributes) WithSubtitle(v string) *AppInfoLocalizationUpdateRequestAttributes {
+	a.Subtitle = &v
+
+	return a
+}
+
 // AppInfoLocalizationsResponse defines model for AppInfoLocalizationsResponse.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appinfolocalizationsresponse
@@ -141,22 +169,14 @@ type GetAppInfoLocalizationQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_info_localizations_for_an_app_info
 func (s *AppsService) ListAppInfoLocalizationsForAppInfo(ctx context.Context, id string, params *ListAppInfoLocalizationsForAppInfoQuery) (*AppInfoLocalizationsResponse, *Response, error) {
-	url := fmt.Sprintf("appInfos/%s/appInfoLocalizations", id)
-	res := new(AppInfoLocalizationsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppInfoLocalizationsResponse](ctx, s.client, fmt.Sprintf("appInfos/%s/appInfoLocalizations", id), params)
 }
 
 // GetAppInfoLocalization reads localized app-level information.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_info_localization_information
 func (s *AppsService) GetAppInfoLocalization(ctx context.Context, id string, params *GetAppInfoLocalizationQuery) (*AppInfoLocalizationResponse, *Response, error) {
-	url := fmt.Sprintf("appInfoLocalizations/%s", id)
-	res := new(AppInfoLocalizationResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppInfoLocalizationResponse](ctx, s.client, fmt.Sprintf("appInfoLocalizations/%s", id), params)
 }
 
 // CreateAppInfoLocalization adds app-level localized information for a new locale.
@@ -23,6 +23,8 @@ package asc
 import (
 	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestListAppInfoLocalizationsForAppInfo(t *testing.T) {
@@ -64,3 +66,20 @@ func TestDeleteAppInfoLocalization(t *testing.T) {
 		return client.Apps.DeleteAppInfoLocalization(ctx, "10")
 	})
 }
+
+func TestAppInfoLocalizationUpdateRequestAttributesBuilder(t *testing.T) {
+	t.Parallel()
+
+	got := new(AppInfoLocalizationUpdateRequestAttributes).
+		WithName("TEST").
+		WithPrivacyPolicyText("TEST").
+		WithPrivacyPolicyURL("TEST").
+		WithSubtitle("TEST")
+
+	assert.Equal(t, &AppInfoLocalizationUpdateRequestAttributes{
+		Name:              String("TEST"),
+		PrivacyPolicyText: String("TEST"),
+		PrivacyPolicyURL:  String("TEST"),
+		Subtitle:          String("TEST"),
+	}, got)
+}
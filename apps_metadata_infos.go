@@ -152,22 +152,14 @@ type GetAgeRatingDeclarationForAppInfoQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_info_information
 func (s *AppsService) GetAppInfo(ctx context.Context, id string, params *GetAppInfoQuery) (*AppInfoResponse, *Response, error) {
-	url := fmt.Sprintf("appInfos/%s", id)
-	res := new(AppInfoResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppInfoResponse](ctx, s.client, fmt.Sprintf("appInfos/%s", id), params)
 }
 
 // ListAppInfosForApp gets information about an app that is currently live on App Store, or that goes live with the next version.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_infos_for_an_app
 func (s *AppsService) ListAppInfosForApp(ctx context.Context, id string, params *ListAppInfosForAppQuery) (*AppInfosResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/appInfos", id)
-	res := new(AppInfosResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppInfosResponse](ctx, s.client, fmt.Sprintf("apps/%s/appInfos", id), params)
 }
 
 // UpdateAppInfo updates the App Store categories and sub-categories for your app.
@@ -220,9 +212,5 @@ func (i *AppInfoResponseIncluded) AppCategory() *AppCategory {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_v1_appinfos_id_ageratingdeclaration
 func (s *AppsService) GetAgeRatingDeclarationForAppInfo(ctx context.Context, id string, params *GetAgeRatingDeclarationForAppInfoQuery) (*AgeRatingDeclarationResponse, *Response, error) {
-	url := fmt.Sprintf("appInfos/%s/ageRatingDeclaration", id)
-	res := new(AgeRatingDeclarationResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AgeRatingDeclarationResponse](ctx, s.client, fmt.Sprintf("appInfos/%s/ageRatingDeclaration", id), params)
 }
@@ -0,0 +1,485 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// LocalizationImportWarning describes a translated value that was shortened or skipped during an import.
+type LocalizationImportWarning struct {
+	Locale  string
+	Key     string
+	Message string
+}
+
+func (w LocalizationImportWarning) String() string {
+	return fmt.Sprintf("%s %s: %s", w.Locale, w.Key, w.Message)
+}
+
+type localizedValue struct {
+	key   string
+	value string
+}
+
+// ImportStrings reads a .strings file of translations for a locale into metadata. Keys name the localization
+// field they translate, such as "description", "keywords" or "whatsNew". UTF-8 and UTF-16 files are accepted.
+func ImportStrings(metadata *AppMetadata, locale string, r io.Reader) ([]LocalizationImportWarning, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := parseStrings(decodeUTF16(b))
+	if err != nil {
+		return nil, err
+	}
+
+	return metadata.importLocalizedValues(locale, values), nil
+}
+
+// ImportStringsDict reads a .stringsdict property list of translations for a locale into metadata. Entries whose
+// value is not a plain string, such as plural rules, are skipped with a warning.
+func ImportStringsDict(metadata *AppMetadata, locale string, r io.Reader) ([]LocalizationImportWarning, error) {
+	values, skipped, err := parseStringsDict(r)
+	if err != nil {
+		return nil, err
+	}
+
+	warnings := make([]LocalizationImportWarning, 0, len(skipped))
+	for _, key := range skipped {
+		warnings = append(warnings, LocalizationImportWarning{Locale: locale, Key: key, Message: "value is not a string and was skipped"})
+	}
+
+	return append(warnings, metadata.importLocalizedValues(locale, values)...), nil
+}
+
+// ImportXLIFF reads an XLIFF 1.2 or 2.0 document into metadata. Translations are imported into the target
+// language of each file in the document, and units are matched to localization fields by their ID.
+func ImportXLIFF(metadata *AppMetadata, r io.Reader) ([]LocalizationImportWarning, error) {
+	var doc xliffDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	var warnings []LocalizationImportWarning
+
+	for _, file := range doc.Files {
+		locale := file.TargetLanguage
+		if locale == "" {
+			locale = doc.TargetLanguage
+		}
+
+		if locale == "" {
+			return warnings, fmt.Errorf("xliff file %q has no target language", file.Original)
+		}
+
+		values := make([]localizedValue, 0, len(file.TransUnits)+len(file.Units))
+
+		for _, unit := range file.TransUnits {
+			if unit.Target != nil {
+				values = append(values, localizedValue{key: unit.ID, value: *unit.Target})
+			}
+		}
+
+		for _, unit := range file.Units {
+			var (
+				target   strings.Builder
+				complete bool
+			)
+
+			for _, segment := range unit.Segments {
+				if segment.Target != nil {
+					target.WriteString(*segment.Target)
+
+					complete = true
+				}
+			}
+
+			if complete {
+				values = append(values, localizedValue{key: unit.ID, value: target.String()})
+			}
+		}
+
+		warnings = append(warnings, metadata.importLocalizedValues(locale, values)...)
+	}
+
+	return warnings, nil
+}
+
+type xliffDocument struct {
+	TargetLanguage string      `xml:"trgLang,attr"`
+	Files          []xliffFile `xml:"file"`
+}
+
+type xliffFile struct {
+	Original       string           `xml:"original,attr"`
+	TargetLanguage string           `xml:"target-language,attr"`
+	TransUnits     []xliffTransUnit `xml:"body>trans-unit"`
+	Units          []xliffUnit      `xml:"unit"`
+}
+
+type xliffTransUnit struct {
+	ID     string  `xml:"id,attr"`
+	Target *string `xml:"target"`
+}
+
+type xliffUnit struct {
+	ID       string         `xml:"id,attr"`
+	Segments []xliffSegment `xml:"segment"`
+}
+
+type xliffSegment struct {
+	Target *string `xml:"target"`
+}
+
+func (m *AppMetadata) importLocalizedValues(locale string, values []localizedValue) []LocalizationImportWarning {
+	var warnings []LocalizationImportWarning
+
+	l := m.localization(locale)
+
+	fields := map[string]struct {
+		value **string
+		max   int
+	}{
+		"name":              {&l.Name, MaxAppNameLength},
+		"subtitle":          {&l.Subtitle, MaxSubtitleLength},
+		"privacypolicytext": {&l.PrivacyPolicyText, 0},
+		"privacypolicyurl":  {&l.PrivacyPolicyURL, 0},
+		"description":       {&l.Description, MaxDescriptionLength},
+		"keywords":          {&l.Keywords, MaxKeywordsLength},
+		"marketingurl":      {&l.MarketingURL, 0},
+		"promotionaltext":   {&l.PromotionalText, MaxPromotionalTextLength},
+		"supporturl":        {&l.SupportURL, 0},
+		"whatsnew":          {&l.WhatsNew, MaxWhatsNewLength},
+	}
+
+	for _, v := range values {
+		field, ok := fields[strings.ToLower(v.key)]
+		if !ok {
+			warnings = append(warnings, LocalizationImportWarning{Locale: locale, Key: v.key, Message: "does not match a localization field and was skipped"})
+
+			continue
+		}
+
+		value := v.value
+
+		if n := utf8.RuneCountInString(value); field.max > 0 && n > field.max {
+			value = string([]rune(value)[:field.max])
+			warnings = append(warnings, LocalizationImportWarning{
+				Locale:  locale,
+				Key:     v.key,
+				Message: fmt.Sprintf("is %d characters long and was truncated to %d", n, field.max),
+			})
+		}
+
+		*field.value = &value
+	}
+
+	return warnings
+}
+
+// decodeUTF16 converts UTF-16 text with a byte order mark to UTF-8, and returns any other text unchanged.
+func decodeUTF16(b []byte) string {
+	if len(b) < 2 {
+		return string(b)
+	}
+
+	var order func(b []byte) uint16
+
+	switch {
+	case b[0] == 0xFF && b[1] == 0xFE:
+		order = func(b []byte) uint16 { return uint16(b[0]) | uint16(b[1])<<8 }
+	case b[0] == 0xFE && b[1] == 0xFF:
+		order = func(b []byte) uint16 { return uint16(b[0])<<8 | uint16(b[1]) }
+	default:
+		return strings.TrimPrefix(string(b), "\ufeff")
+	}
+
+	units := make([]uint16, 0, len(b)/2)
+	for i := 2; i+1 < len(b); i += 2 {
+		units = append(units, order(b[i:i+2]))
+	}
+
+	return string(utf16.Decode(units))
+}
+
+type stringsParser struct {
+	src  []rune
+	pos  int
+	line int
+}
+
+// parseStrings parses the "key" = "value"; pairs of a .strings file, in order.
+func parseStrings(src string) ([]localizedValue, error) {
+	p := &stringsParser{src: []rune(src), line: 1}
+
+	var values []localizedValue
+
+	for {
+		if err := p.skipSpaceAndComments(); err != nil {
+			return nil, err
+		}
+
+		if p.pos >= len(p.src) {
+			return values, nil
+		}
+
+		key, err := p.token()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect('='); err != nil {
+			return nil, err
+		}
+
+		value, err := p.token()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(';'); err != nil {
+			return nil, err
+		}
+
+		values = append(values, localizedValue{key: key, value: value})
+	}
+}
+
+func (p *stringsParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("strings line %d: %s", p.line, fmt.Sprintf(format, args...))
+}
+
+func (p *stringsParser) next() rune {
+	r := p.src[p.pos]
+	p.pos++
+
+	if r == '\n' {
+		p.line++
+	}
+
+	return r
+}
+
+func (p *stringsParser) hasPrefix(prefix string) bool {
+	i := p.pos
+
+	for _, r := range prefix {
+		if i >= len(p.src) || p.src[i] != r {
+			return false
+		}
+
+		i++
+	}
+
+	return true
+}
+
+func (p *stringsParser) skipSpaceAndComments() error {
+	for p.pos < len(p.src) {
+		switch {
+		case unicode.IsSpace(p.src[p.pos]):
+			p.next()
+		case p.hasPrefix("//"):
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' {
+				p.next()
+			}
+		case p.hasPrefix("/*"):
+			p.pos += 2
+
+			for !p.hasPrefix("*/") {
+				if p.pos >= len(p.src) {
+					return p.errorf("unterminated comment")
+				}
+
+				p.next()
+			}
+
+			p.pos += 2
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (p *stringsParser) expect(r rune) error {
+	if err := p.skipSpaceAndComments(); err != nil {
+		return err
+	}
+
+	if p.pos >= len(p.src) || p.src[p.pos] != r {
+		return p.errorf("expected %q", r)
+	}
+
+	p.next()
+
+	return nil
+}
+
+func (p *stringsParser) token() (string, error) {
+	if err := p.skipSpaceAndComments(); err != nil {
+		return "", err
+	}
+
+	if p.pos >= len(p.src) {
+		return "", p.errorf("unexpected end of file")
+	}
+
+	if p.src[p.pos] != '"' {
+		start := p.pos
+		for p.pos < len(p.src) && (unicode.IsLetter(p.src[p.pos]) || unicode.IsDigit(p.src[p.pos]) || strings.ContainsRune("_.-", p.src[p.pos])) {
+			p.next()
+		}
+
+		if start == p.pos {
+			return "", p.errorf("unexpected %q", p.src[p.pos])
+		}
+
+		return string(p.src[start:p.pos]), nil
+	}
+
+	p.next()
+
+	var b strings.Builder
+
+	for p.pos < len(p.src) {
+		r := p.next()
+
+		switch r {
+		case '"':
+			return b.String(), nil
+		case '\\':
+			if p.pos >= len(p.src) {
+				return "", p.errorf("unterminated string")
+			}
+
+			escaped, err := p.escape(p.next())
+			if err != nil {
+				return "", err
+			}
+
+			b.WriteString(escaped)
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return "", p.errorf("unterminated string")
+}
+
+func (p *stringsParser) escape(r rune) (string, error) {
+	switch r {
+	case 'n':
+		return "\n", nil
+	case 't':
+		return "\t", nil
+	case 'r':
+		return "\r", nil
+	case 'u', 'U':
+		if p.pos+4 > len(p.src) {
+			return "", p.errorf("invalid unicode escape")
+		}
+
+		code, err := strconv.ParseUint(string(p.src[p.pos:p.pos+4]), 16, 16)
+		if err != nil {
+			return "", p.errorf("invalid unicode escape")
+		}
+
+		p.pos += 4
+
+		return string(rune(code)), nil
+	default:
+		return string(r), nil
+	}
+}
+
+// parseStringsDict parses the top-level string entries of a property list, in order, and returns the keys of
+// entries with other kinds of values separately.
+func parseStringsDict(r io.Reader) (values []localizedValue, skipped []string, err error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(b))
+	depth := 0
+	key := ""
+
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			return values, skipped, nil
+		} else if err != nil {
+			return nil, nil, err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			depth++
+
+			// The top-level dict is at depth 2, inside <plist>, so its entries are at depth 3.
+			if depth != 3 {
+				continue
+			}
+
+			var text string
+
+			switch t.Name.Local {
+			case "key":
+				if err := decoder.DecodeElement(&text, &t); err != nil {
+					return nil, nil, err
+				}
+
+				key = text
+				depth--
+			case "string":
+				if err := decoder.DecodeElement(&text, &t); err != nil {
+					return nil, nil, err
+				}
+
+				values = append(values, localizedValue{key: key, value: text})
+				depth--
+			default:
+				skipped = append(skipped, key)
+
+				if err := decoder.Skip(); err != nil {
+					return nil, nil, err
+				}
+
+				depth--
+			}
+		case xml.EndElement:
+			depth--
+		}
+	}
+}
@@ -0,0 +1,178 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImportStrings(t *testing.T) {
+	t.Parallel()
+
+	src := `/* App Store metadata */
+"name" = "Mon App";
+// The subtitle is too long on purpose.
+"subtitle" = "Un sous-titre beaucoup trop long pour l'App Store";
+whatsNew = "Corrections de bugs\nAméliorations \"mineures\" \U00e9";
+"unknown" = "ignored";
+`
+
+	metadata := &AppMetadata{}
+	warnings, err := ImportStrings(metadata, "fr-FR", strings.NewReader(src))
+	assert.NoError(t, err)
+
+	l := metadata.Localizations["fr-FR"]
+	assert.Equal(t, String("Mon App"), l.Name)
+	assert.Equal(t, String("Un sous-titre beaucoup trop lo"), l.Subtitle)
+	assert.Equal(t, String("Corrections de bugs\nAméliorations \"mineures\" é"), l.WhatsNew)
+	assert.Equal(t, []LocalizationImportWarning{
+		{Locale: "fr-FR", Key: "subtitle", Message: "is 49 characters long and was truncated to 30"},
+		{Locale: "fr-FR", Key: "unknown", Message: "does not match a localization field and was skipped"},
+	}, warnings)
+	assert.Equal(t, "fr-FR unknown: does not match a localization field and was skipped", warnings[1].String())
+}
+
+func TestImportStringsUTF16(t *testing.T) {
+	t.Parallel()
+
+	units := utf16.Encode([]rune(`"keywords" = "jeu,énigme";`))
+	b := []byte{0xFF, 0xFE}
+
+	for _, u := range units {
+		b = append(b, byte(u), byte(u>>8))
+	}
+
+	metadata := &AppMetadata{}
+	warnings, err := ImportStrings(metadata, "fr-FR", bytes.NewReader(b))
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, String("jeu,énigme"), metadata.Localizations["fr-FR"].Keywords)
+}
+
+func TestImportStringsInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, src := range []string{
+		`"name" = "App"`,
+		`"name" "App";`,
+		`"name" = "App;`,
+		`/* unterminated`,
+		`"name" = ;`,
+	} {
+		_, err := ImportStrings(&AppMetadata{}, "en-US", strings.NewReader(src))
+		assert.Error(t, err, src)
+	}
+}
+
+func TestImportStringsDict(t *testing.T) {
+	t.Parallel()
+
+	src := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>description</key>
+	<string>Une description</string>
+	<key>count</key>
+	<dict>
+		<key>NSStringLocalizedFormatKey</key>
+		<string>%#@items@</string>
+	</dict>
+	<key>promotionalText</key>
+	<string>Promo</string>
+</dict>
+</plist>`
+
+	metadata := &AppMetadata{}
+	warnings, err := ImportStringsDict(metadata, "fr-FR", strings.NewReader(src))
+	assert.NoError(t, err)
+	assert.Equal(t, []LocalizationImportWarning{
+		{Locale: "fr-FR", Key: "count", Message: "value is not a string and was skipped"},
+	}, warnings)
+	assert.Equal(t, String("Une description"), metadata.Localizations["fr-FR"].Description)
+	assert.Equal(t, String("Promo"), metadata.Localizations["fr-FR"].PromotionalText)
+}
+
+func TestImportXLIFF12(t *testing.T) {
+	t.Parallel()
+
+	src := `<?xml version="1.0" encoding="UTF-8"?>
+<xliff version="1.2" xmlns="urn:oasis:names:tc:xliff:document:1.2">
+	<file original="metadata" source-language="en" target-language="de-DE" datatype="plaintext">
+		<body>
+			<trans-unit id="name"><source>My App</source><target>Meine App</target></trans-unit>
+			<trans-unit id="supportUrl"><source>https://example.com</source><target>https://example.de</target></trans-unit>
+			<trans-unit id="keywords"><source>game</source></trans-unit>
+		</body>
+	</file>
+	<file original="metadata" source-language="en" target-language="ja" datatype="plaintext">
+		<body>
+			<trans-unit id="name"><source>My App</source><target>マイアプリ</target></trans-unit>
+		</body>
+	</file>
+</xliff>`
+
+	metadata := &AppMetadata{}
+	warnings, err := ImportXLIFF(metadata, strings.NewReader(src))
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, String("Meine App"), metadata.Localizations["de-DE"].Name)
+	assert.Equal(t, String("https://example.de"), metadata.Localizations["de-DE"].SupportURL)
+	assert.Nil(t, metadata.Localizations["de-DE"].Keywords)
+	assert.Equal(t, String("マイアプリ"), metadata.Localizations["ja"].Name)
+}
+
+func TestImportXLIFF20(t *testing.T) {
+	t.Parallel()
+
+	src := `<?xml version="1.0" encoding="UTF-8"?>
+<xliff version="2.0" xmlns="urn:oasis:names:tc:xliff:document:2.0" srcLang="en" trgLang="es-ES">
+	<file id="metadata">
+		<unit id="description">
+			<segment><source>First.</source><target>Primero.</target></segment>
+			<segment><source> Second.</source><target> Segundo.</target></segment>
+		</unit>
+	</file>
+</xliff>`
+
+	metadata := &AppMetadata{}
+	warnings, err := ImportXLIFF(metadata, strings.NewReader(src))
+	assert.NoError(t, err)
+	assert.Empty(t, warnings)
+	assert.Equal(t, String("Primero. Segundo."), metadata.Localizations["es-ES"].Description)
+}
+
+func TestImportXLIFFMissingTargetLanguage(t *testing.T) {
+	t.Parallel()
+
+	src := `<xliff version="1.2"><file original="metadata"><body></body></file></xliff>`
+
+	_, err := ImportXLIFF(&AppMetadata{}, strings.NewReader(src))
+	assert.EqualError(t, err, `xliff file "metadata" has no target language`)
+
+	_, err = ImportXLIFF(&AppMetadata{}, strings.NewReader(`<xliff`))
+	assert.Error(t, err)
+}
@@ -22,9 +22,17 @@ package asc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 )
 
+// ErrAppStoreVersionLocalizationNotFound happens when an App Store version has no localization for a locale.
+var ErrAppStoreVersionLocalizationNotFound = errors.New("app store version localization not found")
+
+// maxAppPreviewsPerSet is the number of previews App Store Connect allows in a single preview set.
+const maxAppPreviewsPerSet = 3
+
 // AppPreviewSet defines model for AppPreviewSet.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/apppreviewset
@@ -47,8 +55,10 @@ type AppPreviewSetAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/apppreviewset/relationships
 type AppPreviewSetRelationships struct {
-	AppPreviews                 *PagedRelationship `json:"appPreviews,omitempty"`
-	AppStoreVersionLocalization *Relationship      `json:"appStoreVersionLocalization,omitempty"`
+	AppCustomProductPageLocalization               *Relationship      `json:"appCustomProductPageLocalization,omitempty"`
+	AppPreviews                                    *PagedRelationship `json:"appPreviews,omitempty"`
+	AppStoreVersionExperimentTreatmentLocalization *Relationship      `json:"appStoreVersionExperimentTreatmentLocalization,omitempty"`
+	AppStoreVersionLocalization                    *Relationship      `json:"appStoreVersionLocalization,omitempty"`
 }
 
 // appPreviewSetCreateRequest defines model for AppPreviewSetCreateRequest.
@@ -71,7 +81,9 @@ type appPreviewSetCreateRequestAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/apppreviewsetcreaterequest/data/relationships
 type appPreviewSetCreateRequestRelationships struct {
-	AppStoreVersionLocalization relationshipDeclaration `json:"appStoreVersionLocalization"`
+	AppCustomProductPageLocalization               *relationshipDeclaration `json:"appCustomProductPageLocalization,omitempty"`
+	AppStoreVersionExperimentTreatmentLocalization *relationshipDeclaration `json:"appStoreVersionExperimentTreatmentLocalization,omitempty"`
+	AppStoreVersionLocalization                    *relationshipDeclaration `json:"appStoreVersionLocalization,omitempty"`
 }
 
 // AppPreviewSetResponse defines model for AppPreviewSetResponse.
@@ -135,28 +147,57 @@ type ListAppPreviewIDsForSetQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_preview_set_information
 func (s *AppsService) GetAppPreviewSet(ctx context.Context, id string, params *GetAppPreviewSetQuery) (*AppPreviewSetResponse, *Response, error) {
-	url := fmt.Sprintf("appPreviewSets/%s", id)
+	return Get[AppPreviewSetResponse](ctx, s.client, fmt.Sprintf("appPreviewSets/%s", id), params)
+}
+
+// CreateAppPreviewSet adds a new preview set to an App Store version localization for a specific preview type and display size.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_preview_set
+func (s *AppsService) CreateAppPreviewSet(ctx context.Context, previewType PreviewType, appStoreVersionLocalizationID string) (*AppPreviewSetResponse, *Response, error) {
+	req := appPreviewSetCreateRequest{
+		Attributes: appPreviewSetCreateRequestAttributes{
+			PreviewType: previewType,
+		},
+		Relationships: appPreviewSetCreateRequestRelationships{
+			AppStoreVersionLocalization: newRelationshipDeclaration(&appStoreVersionLocalizationID, "appStoreVersionLocalizations"),
+		},
+		Type: "appPreviewSets",
+	}
 	res := new(AppPreviewSetResponse)
-	resp, err := s.client.get(ctx, url, params, res)
+	resp, err := s.client.post(ctx, "appPreviewSets", newRequestBody(req), res)
 
 	return res, resp, err
 }
 
-// CreateAppPreviewSet adds a new preview set to an App Store version localization for a specific preview type and display size.
+// CreateAppPreviewSetForCustomProductPageLocalization adds a new preview set to a custom product page localization for a specific preview type.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_preview_set
-func (s *AppsService) CreateAppPreviewSet(ctx context.Context, previewType PreviewType, appStoreVersionLocalizationID string) (*AppPreviewSetResponse, *Response, error) {
+func (s *AppsService) CreateAppPreviewSetForCustomProductPageLocalization(ctx context.Context, previewType PreviewType, customProductPageLocalizationID string) (*AppPreviewSetResponse, *Response, error) {
 	req := appPreviewSetCreateRequest{
 		Attributes: appPreviewSetCreateRequestAttributes{
 			PreviewType: previewType,
 		},
 		Relationships: appPreviewSetCreateRequestRelationships{
-			AppStoreVersionLocalization: relationshipDeclaration{
-				Data: RelationshipData{
-					ID:   appStoreVersionLocalizationID,
-					Type: "appStoreVersionLocalizations",
-				},
-			},
+			AppCustomProductPageLocalization: newRelationshipDeclaration(&customProductPageLocalizationID, "appCustomProductPageLocalizations"),
+		},
+		Type: "appPreviewSets",
+	}
+	res := new(AppPreviewSetResponse)
+	resp, err := s.client.post(ctx, "appPreviewSets", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CreateAppPreviewSetForExperimentTreatmentLocalization adds a new preview set to an experiment treatment localization for a specific preview type.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_preview_set
+func (s *AppsService) CreateAppPreviewSetForExperimentTreatmentLocalization(ctx context.Context, previewType PreviewType, treatmentLocalizationID string) (*AppPreviewSetResponse, *Response, error) {
+	req := appPreviewSetCreateRequest{
+		Attributes: appPreviewSetCreateRequestAttributes{
+			PreviewType: previewType,
+		},
+		Relationships: appPreviewSetCreateRequestRelationships{
+			AppStoreVersionExperimentTreatmentLocalization: newRelationshipDeclaration(&treatmentLocalizationID, "appStoreVersionExperimentTreatmentLocalizations"),
 		},
 		Type: "appPreviewSets",
 	}
@@ -179,22 +220,14 @@ func (s *AppsService) DeleteAppPreviewSet(ctx context.Context, id string) (*Resp
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_previews_for_an_app_preview_set
 func (s *AppsService) ListAppPreviewsForSet(ctx context.Context, id string, params *ListAppPreviewsForSetQuery) (*AppPreviewsResponse, *Response, error) {
-	url := fmt.Sprintf("appPreviewSets/%s/appPreviews", id)
-	res := new(AppPreviewsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppPreviewsResponse](ctx, s.client, fmt.Sprintf("appPreviewSets/%s/appPreviews", id), params)
 }
 
 // ListAppPreviewIDsForSet gets the ordered preview IDs in a preview set.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_app_preview_ids_for_an_app_preview_set
 func (s *AppsService) ListAppPreviewIDsForSet(ctx context.Context, id string, params *ListAppPreviewIDsForSetQuery) (*AppPreviewSetAppPreviewsLinkagesResponse, *Response, error) {
-	url := fmt.Sprintf("appPreviewSets/%s/relationships/appPreviews", id)
-	res := new(AppPreviewSetAppPreviewsLinkagesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppPreviewSetAppPreviewsLinkagesResponse](ctx, s.client, fmt.Sprintf("appPreviewSets/%s/relationships/appPreviews", id), params)
 }
 
 // ReplaceAppPreviewsForSet changes the order of the previews in a preview set.
@@ -206,3 +239,107 @@ func (s *AppsService) ReplaceAppPreviewsForSet(ctx context.Context, id string, a
 
 	return s.client.patch(ctx, url, newRequestBody(linkages.Data), nil)
 }
+
+// AppPreviewFile is a video to upload into an app preview set by ReplaceAppPreviews.
+type AppPreviewFile struct {
+	FileName             string
+	File                 io.ReadSeeker
+	PreviewFrameTimeCode *string
+}
+
+// EnsureAppPreviewSet resolves the preview set of an App Store version for a locale and preview type,
+// creating the set if the localization does not have one yet.
+func (s *AppsService) EnsureAppPreviewSet(ctx context.Context, versionID string, locale string, previewType PreviewType) (*AppPreviewSetResponse, *Response, error) {
+	localization, resp, err := s.findAppStoreVersionLocalization(ctx, versionID, locale)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	sets, resp, err := s.ListAppPreviewSetsForAppStoreVersionLocalization(ctx, localization.ID, &ListAppPreviewSetsForAppStoreVersionLocalizationQuery{
+		FilterPreviewType: []string{string(previewType)},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for _, set := range sets.Data {
+		if set.Attributes != nil && set.Attributes.PreviewType != nil && *set.Attributes.PreviewType == previewType {
+			return &AppPreviewSetResponse{Data: set}, resp, nil
+		}
+	}
+
+	return s.CreateAppPreviewSet(ctx, previewType, localization.ID)
+}
+
+// ReplaceAppPreviews swaps the previews of an App Store version for a locale and preview type with previews,
+// in the given order. The new videos are uploaded before anything is removed, and are deleted again if any
+// of them fails to upload, so that the store page is never left with a partial set of previews.
+func (s *AppsService) ReplaceAppPreviews(ctx context.Context, versionID string, locale string, previewType PreviewType, previews []AppPreviewFile) (*AppPreviewSetResponse, *Response, error) {
+	if len(previews) > maxAppPreviewsPerSet {
+		return nil, nil, fmt.Errorf("%d previews given, a preview set holds at most %d", len(previews), maxAppPreviewsPerSet)
+	}
+
+	set, resp, err := s.EnsureAppPreviewSet(ctx, versionID, locale, previewType)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	setID := set.Data.ID
+
+	current, resp, err := s.ListAppPreviewIDsForSet(ctx, setID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	uploadedIDs := make([]string, 0, len(previews))
+
+	for _, preview := range previews {
+		uploaded, resp, err := s.UploadAppPreview(ctx, preview.FileName, preview.File, preview.PreviewFrameTimeCode, setID)
+		if err != nil {
+			s.deleteAppPreviews(ctx, uploadedIDs)
+
+			return nil, resp, fmt.Errorf("%s: %w", preview.FileName, err)
+		}
+
+		uploadedIDs = append(uploadedIDs, uploaded.Data.ID)
+	}
+
+	for _, ref := range current.Data {
+		resp, err = s.DeleteAppPreview(ctx, ref.ID)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	if len(uploadedIDs) > 0 {
+		resp, err = s.ReplaceAppPreviewsForSet(ctx, setID, uploadedIDs)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return s.GetAppPreviewSet(ctx, setID, &GetAppPreviewSetQuery{Include: []string{"appPreviews"}})
+}
+
+// deleteAppPreviews makes a best effort to remove previews uploaded by a failed ReplaceAppPreviews.
+func (s *AppsService) deleteAppPreviews(ctx context.Context, ids []string) {
+	for _, id := range ids {
+		_, _ = s.DeleteAppPreview(ctx, id)
+	}
+}
+
+func (s *AppsService) findAppStoreVersionLocalization(ctx context.Context, versionID string, locale string) (*AppStoreVersionLocalization, *Response, error) {
+	localizations, resp, err := s.ListLocalizationsForAppStoreVersion(ctx, versionID, &ListLocalizationsForAppStoreVersionQuery{Limit: MaxPageLimit})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	for i := range localizations.Data {
+		attrs := localizations.Data[i].Attributes
+		if attrs != nil && attrs.Locale != nil && *attrs.Locale == locale {
+			return &localizations.Data[i], resp, nil
+		}
+	}
+
+	return nil, resp, fmt.Errorf("%w: %s", ErrAppStoreVersionLocalizationNotFound, locale)
+}
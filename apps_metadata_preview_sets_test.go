@@ -21,8 +21,12 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 package asc // nolint: dupl
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGetAppPreviewSet(t *testing.T) {
@@ -41,6 +45,22 @@ func TestCreateAppPreviewSet(t *testing.T) {
 	})
 }
 
+func TestCreateAppPreviewSetForCustomProductPageLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPreviewSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppPreviewSetForCustomProductPageLocalization(ctx, PreviewTypeiPadPro129, "10")
+	})
+}
+
+func TestCreateAppPreviewSetForExperimentTreatmentLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPreviewSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppPreviewSetForExperimentTreatmentLocalization(ctx, PreviewTypeiPadPro129, "10")
+	})
+}
+
 func TestDeleteAppPreviewSet(t *testing.T) {
 	t.Parallel()
 
@@ -72,3 +92,112 @@ func TestReplaceAppPreviewsForSet(t *testing.T) {
 		return client.Apps.ReplaceAppPreviewsForSet(ctx, "10", []string{"10"})
 	})
 }
+
+type failingReadSeeker struct{}
+
+func (failingReadSeeker) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func (failingReadSeeker) Seek(int64, int) (int64, error) {
+	return 0, errors.New("seek failed")
+}
+
+func appPreviewSetRoutes(sets string) map[string]string {
+	return map[string]string{
+		"GET /appStoreVersions/10/appStoreVersionLocalizations": `{"data":[{"id":"100","type":"appStoreVersionLocalizations","attributes":{"locale":"en-US"}}]}`,
+		"GET /appStoreVersionLocalizations/100/appPreviewSets":  sets,
+		"POST /appPreviewSets":                                  `{"data":{"id":"set2","type":"appPreviewSets"}}`,
+		"GET /appPreviewSets/set1/relationships/appPreviews":    `{"data":[{"id":"old1","type":"appPreviews"}]}`,
+		"POST /appPreviews":                                     `{"data":{"id":"new1","type":"appPreviews","attributes":{"uploadOperations":[]}}}`,
+		"PATCH /appPreviews/new1":                               `{"data":{"id":"new1","type":"appPreviews"}}`,
+		"DELETE /appPreviews/old1":                              ``,
+		"DELETE /appPreviews/new1":                              ``,
+		"PATCH /appPreviewSets/set1/relationships/appPreviews":  ``,
+		"GET /appPreviewSets/set1":                              `{"data":{"id":"set1","type":"appPreviewSets"}}`,
+	}
+}
+
+func TestEnsureAppPreviewSetExisting(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(appPreviewSetRoutes(`{"data":[{"id":"set1","type":"appPreviewSets","attributes":{"previewType":"IPHONE_65"}}]}`))
+	defer server.Close()
+
+	set, _, err := client.Apps.EnsureAppPreviewSet(context.Background(), "10", "en-US", PreviewTypeiPhone65)
+	assert.NoError(t, err)
+	assert.Equal(t, "set1", set.Data.ID)
+	assert.NotContains(t, *requests, "POST /appPreviewSets")
+}
+
+func TestEnsureAppPreviewSetCreates(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(appPreviewSetRoutes(`{"data":[]}`))
+	defer server.Close()
+
+	set, _, err := client.Apps.EnsureAppPreviewSet(context.Background(), "10", "en-US", PreviewTypeiPhone65)
+	assert.NoError(t, err)
+	assert.Equal(t, "set2", set.Data.ID)
+	assert.Contains(t, *requests, "POST /appPreviewSets")
+}
+
+func TestEnsureAppPreviewSetMissingLocalization(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(appPreviewSetRoutes(`{"data":[]}`))
+	defer server.Close()
+
+	_, _, err := client.Apps.EnsureAppPreviewSet(context.Background(), "10", "fr-FR", PreviewTypeiPhone65)
+	assert.ErrorIs(t, err, ErrAppStoreVersionLocalizationNotFound)
+}
+
+func TestReplaceAppPreviews(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(appPreviewSetRoutes(`{"data":[{"id":"set1","type":"appPreviewSets","attributes":{"previewType":"IPHONE_65"}}]}`))
+	defer server.Close()
+
+	set, _, err := client.Apps.ReplaceAppPreviews(context.Background(), "10", "en-US", PreviewTypeiPhone65, []AppPreviewFile{
+		{FileName: "new.mov", File: bytes.NewReader([]byte("preview"))},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "set1", set.Data.ID)
+	assert.Equal(t, []string{
+		"GET /appStoreVersions/10/appStoreVersionLocalizations",
+		"GET /appStoreVersionLocalizations/100/appPreviewSets",
+		"GET /appPreviewSets/set1/relationships/appPreviews",
+		"POST /appPreviews",
+		"PATCH /appPreviews/new1",
+		"DELETE /appPreviews/old1",
+		"PATCH /appPreviewSets/set1/relationships/appPreviews",
+		"GET /appPreviewSets/set1",
+	}, *requests)
+}
+
+func TestReplaceAppPreviewsRollsBackFailedUpload(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(appPreviewSetRoutes(`{"data":[{"id":"set1","type":"appPreviewSets","attributes":{"previewType":"IPHONE_65"}}]}`))
+	defer server.Close()
+
+	_, _, err := client.Apps.ReplaceAppPreviews(context.Background(), "10", "en-US", PreviewTypeiPhone65, []AppPreviewFile{
+		{FileName: "new.mov", File: bytes.NewReader([]byte("preview"))},
+		{FileName: "broken.mov", File: failingReadSeeker{}},
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "broken.mov")
+	assert.Contains(t, *requests, "DELETE /appPreviews/new1")
+	assert.NotContains(t, *requests, "DELETE /appPreviews/old1")
+}
+
+func TestReplaceAppPreviewsTooMany(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(appPreviewSetRoutes(`{"data":[]}`))
+	defer server.Close()
+
+	_, _, err := client.Apps.ReplaceAppPreviews(context.Background(), "10", "en-US", PreviewTypeiPhone65, make([]AppPreviewFile, 4))
+	assert.Error(t, err)
+	assert.Empty(t, *requests)
+}
@@ -23,6 +23,7 @@ package asc
 import (
 	"context"
 	"fmt"
+	"io"
 )
 
 // PreviewType defines model for PreviewType.
@@ -33,6 +34,8 @@ type PreviewType string
 const (
 	// PreviewTypeAppleTV is a preview type for Apple TV.
 	PreviewTypeAppleTV PreviewType = "APPLE_TV"
+	// PreviewTypeAppleVisionPro is a preview type for Apple Vision Pro.
+	PreviewTypeAppleVisionPro PreviewType = "APPLE_VISION_PRO"
 	// PreviewTypeDesktop is a preview type for Desktop.
 	PreviewTypeDesktop PreviewType = "DESKTOP"
 	// PreviewTypeiPad105 is a preview type for iPad 10.5".
@@ -55,14 +58,65 @@ const (
 	PreviewTypeiPhone55 PreviewType = "IPHONE_55"
 	// PreviewTypeiPhone58 is a preview type for iPhone 5.8".
 	PreviewTypeiPhone58 PreviewType = "IPHONE_58"
+	// PreviewTypeiPhone61 is a preview type for iPhone 6.1".
+	PreviewTypeiPhone61 PreviewType = "IPHONE_61"
 	// PreviewTypeiPhone65 is a preview type for iPhone 6.5".
 	PreviewTypeiPhone65 PreviewType = "IPHONE_65"
+	// PreviewTypeiPhone67 is a preview type for iPhone 6.7".
+	PreviewTypeiPhone67 PreviewType = "IPHONE_67"
 	// PreviewTypeWatchSeries3 is a preview type for Apple Watch Series 3.
 	PreviewTypeWatchSeries3 PreviewType = "WATCH_SERIES_3"
 	// PreviewTypeWatchSeries4 is a preview type for Apple Watch Series 4.
 	PreviewTypeWatchSeries4 PreviewType = "WATCH_SERIES_4"
 )
 
+// ParsePreviewType returns s as a PreviewType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParsePreviewType(s string) (PreviewType, error) {
+	if v := PreviewType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "PreviewType", Value: s}
+}
+
+var previewTypes = []PreviewType{
+	PreviewTypeAppleTV,
+	PreviewTypeAppleVisionPro,
+	PreviewTypeDesktop,
+	PreviewTypeiPad105,
+	PreviewTypeiPad97,
+	PreviewTypeiPadPro129,
+	PreviewTypeiPadPro3Gen11,
+	PreviewTypeiPadPro3Gen129,
+	PreviewTypeiPhone35,
+	PreviewTypeiPhone40,
+	PreviewTypeiPhone47,
+	PreviewTypeiPhone55,
+	PreviewTypeiPhone58,
+	PreviewTypeiPhone61,
+	PreviewTypeiPhone65,
+	PreviewTypeiPhone67,
+	PreviewTypeWatchSeries3,
+	PreviewTypeWatchSeries4,
+}
+
+// PreviewTypes returns every preview type known to this package.
+func PreviewTypes() []PreviewType {
+	return append([]PreviewType(nil), previewTypes...)
+}
+
+// IsValid reports whether the preview type is known to this package. Unknown preview types can still be
+// sent to the API, so that device classes Apple adds later can be used before this package lists them.
+func (t PreviewType) IsValid() bool {
+	for _, previewType := range previewTypes {
+		if t == previewType {
+			return true
+		}
+	}
+
+	return false
+}
+
 // AppPreview defines model for AppPreview.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/apppreview
@@ -178,11 +232,7 @@ type GetAppPreviewQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_preview_information
 func (s *AppsService) GetAppPreview(ctx context.Context, id string, params *GetAppPreviewQuery) (*AppPreviewResponse, *Response, error) {
-	url := fmt.Sprintf("appPreviews/%s", id)
-	res := new(AppPreviewResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppPreviewResponse](ctx, s.client, fmt.Sprintf("appPreviews/%s", id), params)
 }
 
 // CreateAppPreview adds a new preview to a preview set.
@@ -242,3 +292,33 @@ func (s *AppsService) DeleteAppPreview(ctx context.Context, id string) (*Respons
 
 	return s.client.delete(ctx, url, nil)
 }
+
+// UploadAppPreview reserves, uploads and commits a video for an app preview set.
+func (s *AppsService) UploadAppPreview(ctx context.Context, fileName string, file io.ReadSeeker, previewFrameTimeCode *string, appPreviewSetID string) (*AppPreviewResponse, *Response, error) {
+	fileSize, err := sizeOfFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation, resp, err := s.CreateAppPreview(ctx, fileName, fileSize, appPreviewSetID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var ops []UploadOperation
+	if reservation.Data.Attributes != nil {
+		ops = reservation.Data.Attributes.UploadOperations
+	}
+
+	checksum, err := s.client.UploadWithChecksum(ctx, ops, file)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	res, resp, err := s.CommitAppPreview(ctx, reservation.Data.ID, Bool(true), &checksum, previewFrameTimeCode)
+	if err == nil && res.Data.Attributes != nil {
+		err = VerifyAssetChecksum(checksum, res.Data.Attributes.SourceFileChecksum, res.Data.Attributes.AssetDeliveryState)
+	}
+
+	return res, resp, err
+}
@@ -21,10 +21,23 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 package asc
 
 import (
+	"bytes"
 	"context"
+	"net/http"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
+func TestPreviewTypeIsValid(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, PreviewTypeAppleVisionPro.IsValid())
+	assert.True(t, PreviewTypeiPhone67.IsValid())
+	assert.False(t, PreviewType("IPHONE_99").IsValid())
+	assert.Len(t, PreviewTypes(), 18)
+}
+
 func TestGetAppPreview(t *testing.T) {
 	t.Parallel()
 
@@ -56,3 +69,52 @@ func TestDeleteAppPreview(t *testing.T) {
 		return client.Apps.DeleteAppPreview(ctx, "10")
 	})
 }
+
+func TestUploadAppPreview(t *testing.T) {
+	t.Parallel()
+
+	want := &AppPreviewResponse{
+		Data: AppPreview{
+			Attributes: &AppPreviewAttributes{UploadOperations: []UploadOperation{}},
+			ID:         "10",
+			Type:       "appPreviews",
+		},
+	}
+
+	testEndpointWithResponse(t, `{"data":{"id":"10","type":"appPreviews","attributes":{"uploadOperations":[]}}}`, want, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppPreview(ctx, "preview.mov", bytes.NewReader([]byte("preview")), String("00:00:05:00"), "10")
+	})
+}
+
+func TestUploadAppPreviewError(t *testing.T) {
+	t.Parallel()
+
+	testEndpointExpectingError(t, `{"data":`, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppPreview(ctx, "preview.mov", bytes.NewReader([]byte("preview")), nil, "10")
+	})
+}
+
+func TestUploadAppPreviewChecksumMismatch(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":{"id":"10","type":"appPreviews","attributes":{"sourceFileChecksum":"0000"}}}`, http.StatusOK, false)
+	defer server.Close()
+
+	_, checksum, err := fileChecksum(bytes.NewReader([]byte("preview")))
+	assert.NoError(t, err)
+
+	res, _, err := client.Apps.UploadAppPreview(context.Background(), "preview.mov", bytes.NewReader([]byte("preview")), nil, "10")
+	assert.Equal(t, ErrAssetChecksumMismatch{Expected: checksum, Reported: "0000"}, err)
+	assert.Equal(t, "10", res.Data.ID)
+}
+
+func TestParsePreviewType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParsePreviewType(string(PreviewTypeAppleTV))
+	assert.NoError(t, err)
+	assert.Equal(t, PreviewTypeAppleTV, got)
+
+	_, err = ParsePreviewType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "PreviewType", Value: "UNKNOWN"}, err)
+}
@@ -139,22 +139,14 @@ type GetRoutingAppCoverageQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_routing_app_coverage_information_of_an_app_store_version
 func (s *AppsService) GetRoutingAppCoverageForAppStoreVersion(ctx context.Context, id string, params *GetRoutingAppCoverageForVersionQuery) (*RoutingAppCoverageResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersions/%s/routingAppCoverage", id)
-	res := new(RoutingAppCoverageResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[RoutingAppCoverageResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s/routingAppCoverage", id), params)
 }
 
 // GetRoutingAppCoverage gets information about the routing app coverage file and its upload and processing status.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_routing_app_coverage_information
 func (s *AppsService) GetRoutingAppCoverage(ctx context.Context, id string, params *GetRoutingAppCoverageQuery) (*RoutingAppCoverageResponse, *Response, error) {
-	url := fmt.Sprintf("routingAppCoverages/%s", id)
-	res := new(RoutingAppCoverageResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[RoutingAppCoverageResponse](ctx, s.client, fmt.Sprintf("routingAppCoverages/%s", id), params)
 }
 
 // CreateRoutingAppCoverage attaches a routing app coverage file to an App Store version.
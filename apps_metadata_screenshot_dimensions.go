@@ -0,0 +1,200 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg" // register the JPEG format with image.DecodeConfig
+	_ "image/png"  // register the PNG format with image.DecodeConfig
+	"io"
+	"strings"
+)
+
+// ScreenshotDimensions is the pixel size of a screenshot.
+type ScreenshotDimensions struct {
+	Width  int
+	Height int
+}
+
+func (d ScreenshotDimensions) String() string {
+	return fmt.Sprintf("%dx%d", d.Width, d.Height)
+}
+
+// ErrInvalidScreenshot happens when a screenshot's format or pixel size is not accepted for its display type.
+type ErrInvalidScreenshot struct {
+	DisplayType ScreenshotDisplayType
+	Format      string
+	Got         ScreenshotDimensions
+	Expected    []ScreenshotDimensions
+}
+
+func (e ErrInvalidScreenshot) Error() string {
+	if e.Format != "png" && e.Format != "jpeg" {
+		return fmt.Sprintf("png or jpeg expected for %s, got %s", e.DisplayType, e.Format)
+	}
+
+	expected := make([]string, len(e.Expected))
+	for i, d := range e.Expected {
+		expected[i] = d.String()
+	}
+
+	return fmt.Sprintf("%s expected for %s, got %s", strings.Join(expected, " or "), e.DisplayType, e.Got)
+}
+
+// portraitAndLandscape returns the dimensions in both orientations, portrait first.
+func portraitAndLandscape(dimensions ...ScreenshotDimensions) []ScreenshotDimensions {
+	both := make([]ScreenshotDimensions, 0, len(dimensions)*2)
+	for _, d := range dimensions {
+		both = append(both, d, ScreenshotDimensions{Width: d.Height, Height: d.Width})
+	}
+
+	return both
+}
+
+var (
+	iPhone35Dimensions         = portraitAndLandscape(ScreenshotDimensions{640, 920}, ScreenshotDimensions{640, 960})
+	iPhone40Dimensions         = portraitAndLandscape(ScreenshotDimensions{640, 1096}, ScreenshotDimensions{640, 1136})
+	iPhone47Dimensions         = portraitAndLandscape(ScreenshotDimensions{750, 1334})
+	iPhone55Dimensions         = portraitAndLandscape(ScreenshotDimensions{1242, 2208})
+	iPhone58Dimensions         = portraitAndLandscape(ScreenshotDimensions{1125, 2436})
+	iPhone61Dimensions         = portraitAndLandscape(ScreenshotDimensions{1179, 2556}, ScreenshotDimensions{1170, 2532}, ScreenshotDimensions{1080, 2340})
+	iPhone65Dimensions         = portraitAndLandscape(ScreenshotDimensions{1242, 2688}, ScreenshotDimensions{1284, 2778})
+	iPhone67Dimensions         = portraitAndLandscape(ScreenshotDimensions{1290, 2796}, ScreenshotDimensions{1320, 2868}, ScreenshotDimensions{1260, 2736})
+	iPad97Dimensions           = portraitAndLandscape(ScreenshotDimensions{1536, 2048}, ScreenshotDimensions{1536, 2008}, ScreenshotDimensions{768, 1024}, ScreenshotDimensions{768, 1004})
+	iPad105Dimensions          = portraitAndLandscape(ScreenshotDimensions{1668, 2224})
+	iPadPro3Gen11Dimensions    = portraitAndLandscape(ScreenshotDimensions{1668, 2388}, ScreenshotDimensions{1640, 2360}, ScreenshotDimensions{1488, 2266})
+	iPadPro129Dimensions       = portraitAndLandscape(ScreenshotDimensions{2048, 2732})
+	iPadPro3Gen129Dimensions   = portraitAndLandscape(ScreenshotDimensions{2048, 2732}, ScreenshotDimensions{2064, 2752})
+	screenshotDimensionsByType = map[ScreenshotDisplayType][]ScreenshotDimensions{
+		ScreenshotDisplayTypeAppAppleTV:                {{1920, 1080}, {3840, 2160}},
+		ScreenshotDisplayTypeAppAppleVisionPro:         {{3840, 2160}},
+		ScreenshotDisplayTypeAppDesktop:                {{1280, 800}, {1440, 900}, {2560, 1600}, {2880, 1800}},
+		ScreenshotDisplayTypeAppiPad105:                iPad105Dimensions,
+		ScreenshotDisplayTypeAppiPad97:                 iPad97Dimensions,
+		ScreenshotDisplayTypeAppiPadPro129:             iPadPro129Dimensions,
+		ScreenshotDisplayTypeAppiPadPro3Gen11:          iPadPro3Gen11Dimensions,
+		ScreenshotDisplayTypeAppiPadPro3Gen129:         iPadPro3Gen129Dimensions,
+		ScreenshotDisplayTypeAppiPhone35:               iPhone35Dimensions,
+		ScreenshotDisplayTypeAppiPhone40:               iPhone40Dimensions,
+		ScreenshotDisplayTypeAppiPhone47:               iPhone47Dimensions,
+		ScreenshotDisplayTypeAppiPhone55:               iPhone55Dimensions,
+		ScreenshotDisplayTypeAppiPhone58:               iPhone58Dimensions,
+		ScreenshotDisplayTypeAppiPhone61:               iPhone61Dimensions,
+		ScreenshotDisplayTypeAppiPhone65:               iPhone65Dimensions,
+		ScreenshotDisplayTypeAppiPhone67:               iPhone67Dimensions,
+		ScreenshotDisplayTypeAppWatchSeries3:           {{312, 390}},
+		ScreenshotDisplayTypeAppWatchSeries4:           {{368, 448}},
+		ScreenshotDisplayTypeAppWatchSeries7:           {{396, 484}},
+		ScreenshotDisplayTypeAppWatchSeries10:          {{416, 496}},
+		ScreenshotDisplayTypeAppWatchUltra:             {{410, 502}, {422, 514}},
+		ScreenshotDisplayTypeiMessageAppIPad105:        iPad105Dimensions,
+		ScreenshotDisplayTypeiMessageAppIPad97:         iPad97Dimensions,
+		ScreenshotDisplayTypeiMessageAppIPadPro129:     iPadPro129Dimensions,
+		ScreenshotDisplayTypeiMessageAppIPadPro3Gen11:  iPadPro3Gen11Dimensions,
+		ScreenshotDisplayTypeiMessageAppIPadPro3Gen129: iPadPro3Gen129Dimensions,
+		ScreenshotDisplayTypeiMessageAppIPhone40:       iPhone40Dimensions,
+		ScreenshotDisplayTypeiMessageAppIPhone47:       iPhone47Dimensions,
+		ScreenshotDisplayTypeiMessageAppIPhone55:       iPhone55Dimensions,
+		ScreenshotDisplayTypeiMessageAppIPhone58:       iPhone58Dimensions,
+		ScreenshotDisplayTypeiMessageAppIPhone61:       iPhone61Dimensions,
+		ScreenshotDisplayTypeiMessageAppIPhone65:       iPhone65Dimensions,
+		ScreenshotDisplayTypeiMessageAppIPhone67:       iPhone67Dimensions,
+	}
+)
+
+// Dimensions returns the pixel sizes App Store Connect accepts for screenshots of this display type, or nil if
+// they are not known.
+func (t ScreenshotDisplayType) Dimensions() []ScreenshotDimensions {
+	return screenshotDimensionsByType[t]
+}
+
+// ValidateScreenshot checks that an image is a PNG or JPEG whose pixel size is accepted for the display type,
+// and returns an ErrInvalidScreenshot if it is not. Only the image header is read, and the file is rewound
+// afterwards. Display types with unknown dimensions only have their format checked.
+func ValidateScreenshot(displayType ScreenshotDisplayType, file io.ReadSeeker) error {
+	config, format, err := image.DecodeConfig(file)
+	if _, seekErr := file.Seek(0, io.SeekStart); seekErr != nil {
+		return seekErr
+	}
+
+	if errors.Is(err, image.ErrFormat) {
+		return ErrInvalidScreenshot{DisplayType: displayType, Format: "an unknown format"}
+	} else if err != nil {
+		return err
+	}
+
+	got := ScreenshotDimensions{Width: config.Width, Height: config.Height}
+	expected := displayType.Dimensions()
+
+	if format != "png" && format != "jpeg" {
+		return ErrInvalidScreenshot{DisplayType: displayType, Format: format, Got: got, Expected: expected}
+	}
+
+	if len(expected) == 0 {
+		return nil
+	}
+
+	for _, d := range expected {
+		if d == got {
+			return nil
+		}
+	}
+
+	return ErrInvalidScreenshot{DisplayType: displayType, Format: format, Got: got, Expected: expected}
+}
+
+// UploadAppScreenshot validates a screenshot against the display type of its screenshot set, and then reserves,
+// uploads and commits it. An invalid screenshot fails before anything is reserved in App Store Connect.
+func (s *AppsService) UploadAppScreenshot(ctx context.Context, fileName string, file io.ReadSeeker, displayType ScreenshotDisplayType, appScreenshotSetID string) (*AppScreenshotResponse, *Response, error) {
+	if err := ValidateScreenshot(displayType, file); err != nil {
+		return nil, nil, err
+	}
+
+	fileSize, err := sizeOfFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation, resp, err := s.CreateAppScreenshot(ctx, fileName, fileSize, appScreenshotSetID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var ops []UploadOperation
+	if reservation.Data.Attributes != nil {
+		ops = reservation.Data.Attributes.UploadOperations
+	}
+
+	checksum, err := s.client.UploadWithChecksum(ctx, ops, file)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	res, resp, err := s.CommitAppScreenshot(ctx, reservation.Data.ID, Bool(true), &checksum)
+	if err == nil && res.Data.Attributes != nil {
+		err = VerifyAssetChecksum(checksum, res.Data.Attributes.SourceFileChecksum, res.Data.Attributes.AssetDeliveryState)
+	}
+
+	return res, resp, err
+}
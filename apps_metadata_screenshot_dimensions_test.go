@@ -0,0 +1,136 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func encodedImage(t *testing.T, format string, width, height int) *bytes.Reader {
+	t.Helper()
+
+	var (
+		buf bytes.Buffer
+		err error
+	)
+
+	img := image.NewGray(image.Rect(0, 0, width, height))
+
+	switch format {
+	case "png":
+		err = png.Encode(&buf, img)
+	case "jpeg":
+		err = jpeg.Encode(&buf, img, nil)
+	case "gif":
+		err = gif.Encode(&buf, img, nil)
+	}
+
+	assert.NoError(t, err)
+
+	return bytes.NewReader(buf.Bytes())
+}
+
+func TestValidateScreenshot(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, ValidateScreenshot(ScreenshotDisplayTypeAppiPhone67, encodedImage(t, "png", 1290, 2796)))
+	assert.NoError(t, ValidateScreenshot(ScreenshotDisplayTypeAppiPhone67, encodedImage(t, "jpeg", 2796, 1290)))
+	assert.NoError(t, ValidateScreenshot(ScreenshotDisplayTypeAppDesktop, encodedImage(t, "png", 1280, 800)))
+	assert.NoError(t, ValidateScreenshot("APP_UNKNOWN", encodedImage(t, "png", 10, 10)))
+}
+
+func TestValidateScreenshotWrongDimensions(t *testing.T) {
+	t.Parallel()
+
+	err := ValidateScreenshot(ScreenshotDisplayTypeAppiPhone55, encodedImage(t, "png", 1290, 2796))
+	assert.Equal(t, ErrInvalidScreenshot{
+		DisplayType: ScreenshotDisplayTypeAppiPhone55,
+		Format:      "png",
+		Got:         ScreenshotDimensions{Width: 1290, Height: 2796},
+		Expected:    ScreenshotDisplayTypeAppiPhone55.Dimensions(),
+	}, err)
+	assert.EqualError(t, err, "1242x2208 or 2208x1242 expected for APP_IPHONE_55, got 1290x2796")
+
+	err = ValidateScreenshot(ScreenshotDisplayTypeAppDesktop, encodedImage(t, "png", 800, 1280))
+	assert.Error(t, err)
+}
+
+func TestValidateScreenshotWrongFormat(t *testing.T) {
+	t.Parallel()
+
+	err := ValidateScreenshot(ScreenshotDisplayTypeAppiPhone55, encodedImage(t, "gif", 1242, 2208))
+	assert.EqualError(t, err, "png or jpeg expected for APP_IPHONE_55, got gif")
+
+	err = ValidateScreenshot(ScreenshotDisplayTypeAppiPhone55, bytes.NewReader([]byte("not an image")))
+	assert.EqualError(t, err, "png or jpeg expected for APP_IPHONE_55, got an unknown format")
+}
+
+func TestValidateScreenshotRewindsFile(t *testing.T) {
+	t.Parallel()
+
+	file := encodedImage(t, "png", 1242, 2208)
+	assert.NoError(t, ValidateScreenshot(ScreenshotDisplayTypeAppiPhone55, file))
+	assert.Equal(t, int(file.Size()), file.Len())
+}
+
+func TestUploadAppScreenshot(t *testing.T) {
+	t.Parallel()
+
+	want := &AppScreenshotResponse{
+		Data: AppScreenshot{
+			Attributes: &AppScreenshotAttributes{UploadOperations: []UploadOperation{}},
+			ID:         "10",
+			Type:       "appScreenshots",
+		},
+	}
+
+	testEndpointWithResponse(t, `{"data":{"id":"10","type":"appScreenshots","attributes":{"uploadOperations":[]}}}`, want, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppScreenshot(ctx, "screenshot.png", encodedImage(t, "png", 1242, 2208), ScreenshotDisplayTypeAppiPhone55, "10")
+	})
+}
+
+func TestUploadAppScreenshotInvalid(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{})
+	defer server.Close()
+
+	screenshot, _, err := client.Apps.UploadAppScreenshot(context.Background(), "screenshot.png", encodedImage(t, "png", 10, 10), ScreenshotDisplayTypeAppiPhone55, "10")
+	assert.Error(t, err)
+	assert.Nil(t, screenshot)
+	assert.Empty(t, *requests)
+}
+
+func TestUploadAppScreenshotError(t *testing.T) {
+	t.Parallel()
+
+	testEndpointExpectingError(t, `{"data":`, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UploadAppScreenshot(ctx, "screenshot.png", encodedImage(t, "png", 1242, 2208), ScreenshotDisplayTypeAppiPhone55, "10")
+	})
+}
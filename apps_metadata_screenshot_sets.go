@@ -33,6 +33,8 @@ type ScreenshotDisplayType string
 const (
 	// ScreenshotDisplayTypeAppAppleTV is a screenshot display type for AppAppleTV.
 	ScreenshotDisplayTypeAppAppleTV ScreenshotDisplayType = "APP_APPLE_TV"
+	// ScreenshotDisplayTypeAppAppleVisionPro is a screenshot display type for AppAppleVisionPro.
+	ScreenshotDisplayTypeAppAppleVisionPro ScreenshotDisplayType = "APP_APPLE_VISION_PRO"
 	// ScreenshotDisplayTypeAppDesktop is a screenshot display type for AppDesktop.
 	ScreenshotDisplayTypeAppDesktop ScreenshotDisplayType = "APP_DESKTOP"
 	// ScreenshotDisplayTypeAppiPad105 is a screenshot display type for AppiPad105.
@@ -55,12 +57,22 @@ const (
 	ScreenshotDisplayTypeAppiPhone55 ScreenshotDisplayType = "APP_IPHONE_55"
 	// ScreenshotDisplayTypeAppiPhone58 is a screenshot display type for AppiPhone58.
 	ScreenshotDisplayTypeAppiPhone58 ScreenshotDisplayType = "APP_IPHONE_58"
+	// ScreenshotDisplayTypeAppiPhone61 is a screenshot display type for AppiPhone61.
+	ScreenshotDisplayTypeAppiPhone61 ScreenshotDisplayType = "APP_IPHONE_61"
 	// ScreenshotDisplayTypeAppiPhone65 is a screenshot display type for AppiPhone65.
 	ScreenshotDisplayTypeAppiPhone65 ScreenshotDisplayType = "APP_IPHONE_65"
+	// ScreenshotDisplayTypeAppiPhone67 is a screenshot display type for AppiPhone67.
+	ScreenshotDisplayTypeAppiPhone67 ScreenshotDisplayType = "APP_IPHONE_67"
 	// ScreenshotDisplayTypeAppWatchSeries3 is a screenshot display type for AppWatchSeries3.
 	ScreenshotDisplayTypeAppWatchSeries3 ScreenshotDisplayType = "APP_WATCH_SERIES_3"
 	// ScreenshotDisplayTypeAppWatchSeries4 is a screenshot display type for AppWatchSeries4.
 	ScreenshotDisplayTypeAppWatchSeries4 ScreenshotDisplayType = "APP_WATCH_SERIES_4"
+	// ScreenshotDisplayTypeAppWatchSeries7 is a screenshot display type for AppWatchSeries7.
+	ScreenshotDisplayTypeAppWatchSeries7 ScreenshotDisplayType = "APP_WATCH_SERIES_7"
+	// ScreenshotDisplayTypeAppWatchSeries10 is a screenshot display type for AppWatchSeries10.
+	ScreenshotDisplayTypeAppWatchSeries10 ScreenshotDisplayType = "APP_WATCH_SERIES_10"
+	// ScreenshotDisplayTypeAppWatchUltra is a screenshot display type for AppWatchUltra.
+	ScreenshotDisplayTypeAppWatchUltra ScreenshotDisplayType = "APP_WATCH_ULTRA"
 	// ScreenshotDisplayTypeiMessageAppIPad105 is a screenshot display type for iMessageAppIPad105.
 	ScreenshotDisplayTypeiMessageAppIPad105 ScreenshotDisplayType = "IMESSAGE_APP_IPAD_105"
 	// ScreenshotDisplayTypeiMessageAppIPad97 is a screenshot display type for iMessageAppIPad97.
@@ -79,10 +91,76 @@ const (
 	ScreenshotDisplayTypeiMessageAppIPhone55 ScreenshotDisplayType = "IMESSAGE_APP_IPHONE_55"
 	// ScreenshotDisplayTypeiMessageAppIPhone58 is a screenshot display type for iMessageAppIPhone58.
 	ScreenshotDisplayTypeiMessageAppIPhone58 ScreenshotDisplayType = "IMESSAGE_APP_IPHONE_58"
+	// ScreenshotDisplayTypeiMessageAppIPhone61 is a screenshot display type for iMessageAppIPhone61.
+	ScreenshotDisplayTypeiMessageAppIPhone61 ScreenshotDisplayType = "IMESSAGE_APP_IPHONE_61"
 	// ScreenshotDisplayTypeiMessageAppIPhone65 is a screenshot display type for iMessageAppIPhone65.
 	ScreenshotDisplayTypeiMessageAppIPhone65 ScreenshotDisplayType = "IMESSAGE_APP_IPHONE_65"
+	// ScreenshotDisplayTypeiMessageAppIPhone67 is a screenshot display type for iMessageAppIPhone67.
+	ScreenshotDisplayTypeiMessageAppIPhone67 ScreenshotDisplayType = "IMESSAGE_APP_IPHONE_67"
 )
 
+// ParseScreenshotDisplayType returns s as a ScreenshotDisplayType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseScreenshotDisplayType(s string) (ScreenshotDisplayType, error) {
+	if v := ScreenshotDisplayType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "ScreenshotDisplayType", Value: s}
+}
+
+var screenshotDisplayTypes = []ScreenshotDisplayType{
+	ScreenshotDisplayTypeAppAppleTV,
+	ScreenshotDisplayTypeAppAppleVisionPro,
+	ScreenshotDisplayTypeAppDesktop,
+	ScreenshotDisplayTypeAppiPad105,
+	ScreenshotDisplayTypeAppiPad97,
+	ScreenshotDisplayTypeAppiPadPro129,
+	ScreenshotDisplayTypeAppiPadPro3Gen11,
+	ScreenshotDisplayTypeAppiPadPro3Gen129,
+	ScreenshotDisplayTypeAppiPhone35,
+	ScreenshotDisplayTypeAppiPhone40,
+	ScreenshotDisplayTypeAppiPhone47,
+	ScreenshotDisplayTypeAppiPhone55,
+	ScreenshotDisplayTypeAppiPhone58,
+	ScreenshotDisplayTypeAppiPhone61,
+	ScreenshotDisplayTypeAppiPhone65,
+	ScreenshotDisplayTypeAppiPhone67,
+	ScreenshotDisplayTypeAppWatchSeries3,
+	ScreenshotDisplayTypeAppWatchSeries4,
+	ScreenshotDisplayTypeAppWatchSeries7,
+	ScreenshotDisplayTypeAppWatchSeries10,
+	ScreenshotDisplayTypeAppWatchUltra,
+	ScreenshotDisplayTypeiMessageAppIPad105,
+	ScreenshotDisplayTypeiMessageAppIPad97,
+	ScreenshotDisplayTypeiMessageAppIPadPro129,
+	ScreenshotDisplayTypeiMessageAppIPadPro3Gen11,
+	ScreenshotDisplayTypeiMessageAppIPadPro3Gen129,
+	ScreenshotDisplayTypeiMessageAppIPhone40,
+	ScreenshotDisplayTypeiMessageAppIPhone47,
+	ScreenshotDisplayTypeiMessageAppIPhone55,
+	ScreenshotDisplayTypeiMessageAppIPhone58,
+	ScreenshotDisplayTypeiMessageAppIPhone61,
+	ScreenshotDisplayTypeiMessageAppIPhone65,
+	ScreenshotDisplayTypeiMessageAppIPhone67,
+}
+
+// ScreenshotDisplayTypes returns every screenshot display type known to this package.
+func ScreenshotDisplayTypes() []ScreenshotDisplayType {
+	return append([]ScreenshotDisplayType(nil), screenshotDisplayTypes...)
+}
+
+// IsValid reports whether the display type is known to this package. Unknown display types can still be
+// sent to the API, so that device classes Apple adds later can be used before this package lists them.
+func (t ScreenshotDisplayType) IsValid() bool {
+	for _, displayType := range screenshotDisplayTypes {
+		if t == displayType {
+			return true
+		}
+	}
+
+	return false
+}
+
 // AppScreenshotSet defines model for AppScreenshotSet.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appscreenshotset
@@ -105,8 +183,10 @@ type AppScreenshotSetAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appscreenshotset/relationships
 type AppScreenshotSetRelationships struct {
-	AppScreenshots              *PagedRelationship `json:"appScreenshots,omitempty"`
-	AppStoreVersionLocalization *Relationship      `json:"appStoreVersionLocalization,omitempty"`
+	AppCustomProductPageLocalization               *Relationship      `json:"appCustomProductPageLocalization,omitempty"`
+	AppScreenshots                                 *PagedRelationship `json:"appScreenshots,omitempty"`
+	AppStoreVersionExperimentTreatmentLocalization *Relationship      `json:"appStoreVersionExperimentTreatmentLocalization,omitempty"`
+	AppStoreVersionLocalization                    *Relationship      `json:"appStoreVersionLocalization,omitempty"`
 }
 
 // appScreenshotSetCreateRequest defines model for AppScreenshotSetCreateRequest.
@@ -129,7 +209,9 @@ type appScreenshotSetCreateRequestAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appscreenshotsetcreaterequest/data/relationships
 type appScreenshotSetCreateRequestRelationships struct {
-	AppStoreVersionLocalization relationshipDeclaration `json:"appStoreVersionLocalization"`
+	AppCustomProductPageLocalization               *relationshipDeclaration `json:"appCustomProductPageLocalization,omitempty"`
+	AppStoreVersionExperimentTreatmentLocalization *relationshipDeclaration `json:"appStoreVersionExperimentTreatmentLocalization,omitempty"`
+	AppStoreVersionLocalization                    *relationshipDeclaration `json:"appStoreVersionLocalization,omitempty"`
 }
 
 // AppScreenshotSetResponse defines model for AppScreenshotSetResponse.
@@ -192,28 +274,57 @@ type ListAppScreenshotIDsForSetQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_screenshot_set_information
 func (s *AppsService) GetAppScreenshotSet(ctx context.Context, id string, params *GetAppScreenshotSetQuery) (*AppScreenshotSetResponse, *Response, error) {
-	url := fmt.Sprintf("appScreenshotSets/%s", id)
+	return Get[AppScreenshotSetResponse](ctx, s.client, fmt.Sprintf("appScreenshotSets/%s", id), params)
+}
+
+// CreateAppScreenshotSet adds a new screenshot set to an App Store version localization for a specific screenshot type and display size.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_screenshot_set
+func (s *AppsService) CreateAppScreenshotSet(ctx context.Context, screenshotDisplayType ScreenshotDisplayType, appStoreVersionLocalizationID string) (*AppScreenshotSetResponse, *Response, error) {
+	req := appScreenshotSetCreateRequest{
+		Attributes: appScreenshotSetCreateRequestAttributes{
+			ScreenshotDisplayType: screenshotDisplayType,
+		},
+		Relationships: appScreenshotSetCreateRequestRelationships{
+			AppStoreVersionLocalization: newRelationshipDeclaration(&appStoreVersionLocalizationID, "appStoreVersionLocalizations"),
+		},
+		Type: "appScreenshotSets",
+	}
 	res := new(AppScreenshotSetResponse)
-	resp, err := s.client.get(ctx, url, params, res)
+	resp, err := s.client.post(ctx, "appScreenshotSets", newRequestBody(req), res)
 
 	return res, resp, err
 }
 
-// CreateAppScreenshotSet adds a new screenshot set to an App Store version localization for a specific screenshot type and display size.
+// CreateAppScreenshotSetForCustomProductPageLocalization adds a new screenshot set to a custom product page localization for a specific screenshot type and display size.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_screenshot_set
-func (s *AppsService) CreateAppScreenshotSet(ctx context.Context, screenshotDisplayType ScreenshotDisplayType, appStoreVersionLocalizationID string) (*AppScreenshotSetResponse, *Response, error) {
+func (s *AppsService) CreateAppScreenshotSetForCustomProductPageLocalization(ctx context.Context, screenshotDisplayType ScreenshotDisplayType, customProductPageLocalizationID string) (*AppScreenshotSetResponse, *Response, error) {
 	req := appScreenshotSetCreateRequest{
 		Attributes: appScreenshotSetCreateRequestAttributes{
 			ScreenshotDisplayType: screenshotDisplayType,
 		},
 		Relationships: appScreenshotSetCreateRequestRelationships{
-			AppStoreVersionLocalization: relationshipDeclaration{
-				Data: RelationshipData{
-					ID:   appStoreVersionLocalizationID,
-					Type: "appStoreVersionLocalizations",
-				},
-			},
+			AppCustomProductPageLocalization: newRelationshipDeclaration(&customProductPageLocalizationID, "appCustomProductPageLocalizations"),
+		},
+		Type: "appScreenshotSets",
+	}
+	res := new(AppScreenshotSetResponse)
+	resp, err := s.client.post(ctx, "appScreenshotSets", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CreateAppScreenshotSetForExperimentTreatmentLocalization adds a new screenshot set to an experiment treatment localization for a specific screenshot type and display size.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_screenshot_set
+func (s *AppsService) CreateAppScreenshotSetForExperimentTreatmentLocalization(ctx context.Context, screenshotDisplayType ScreenshotDisplayType, treatmentLocalizationID string) (*AppScreenshotSetResponse, *Response, error) {
+	req := appScreenshotSetCreateRequest{
+		Attributes: appScreenshotSetCreateRequestAttributes{
+			ScreenshotDisplayType: screenshotDisplayType,
+		},
+		Relationships: appScreenshotSetCreateRequestRelationships{
+			AppStoreVersionExperimentTreatmentLocalization: newRelationshipDeclaration(&treatmentLocalizationID, "appStoreVersionExperimentTreatmentLocalizations"),
 		},
 		Type: "appScreenshotSets",
 	}
@@ -236,22 +347,14 @@ func (s *AppsService) DeleteAppScreenshotSet(ctx context.Context, id string) (*R
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshots_for_an_app_screenshot_set
 func (s *AppsService) ListAppScreenshotsForSet(ctx context.Context, id string, params *ListAppScreenshotsForSetQuery) (*AppScreenshotsResponse, *Response, error) {
-	url := fmt.Sprintf("appScreenshotSets/%s/appScreenshots", id)
-	res := new(AppScreenshotsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppScreenshotsResponse](ctx, s.client, fmt.Sprintf("appScreenshotSets/%s/appScreenshots", id), params)
 }
 
 // ListAppScreenshotIDsForSet gets the ordered screenshot IDs in a screenshot set.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_app_screenshot_ids_for_an_app_screenshot_set
 func (s *AppsService) ListAppScreenshotIDsForSet(ctx context.Context, id string, params *ListAppScreenshotIDsForSetQuery) (*AppScreenshotSetAppScreenshotsLinkagesResponse, *Response, error) {
-	url := fmt.Sprintf("appScreenshotSets/%s/relationships/appScreenshots", id)
-	res := new(AppScreenshotSetAppScreenshotsLinkagesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppScreenshotSetAppScreenshotsLinkagesResponse](ctx, s.client, fmt.Sprintf("appScreenshotSets/%s/relationships/appScreenshots", id), params)
 }
 
 // ReplaceAppScreenshotsForSet changes the order of the screenshots in a screenshot set.
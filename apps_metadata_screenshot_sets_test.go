@@ -23,8 +23,22 @@ package asc // nolint: dupl
 import (
 	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
+func TestScreenshotDisplayTypeIsValid(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, ScreenshotDisplayTypeAppAppleVisionPro.IsValid())
+	assert.True(t, ScreenshotDisplayTypeiMessageAppIPhone67.IsValid())
+	assert.False(t, ScreenshotDisplayType("APP_IPHONE_99").IsValid())
+
+	for _, displayType := range ScreenshotDisplayTypes() {
+		assert.NotEmpty(t, displayType.Dimensions(), displayType)
+	}
+}
+
 func TestGetAppScreenshotSet(t *testing.T) {
 	t.Parallel()
 
@@ -41,6 +55,22 @@ func TestCreateAppScreenshotSet(t *testing.T) {
 	})
 }
 
+func TestCreateAppScreenshotSetForCustomProductPageLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppScreenshotSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppScreenshotSetForCustomProductPageLocalization(ctx, ScreenshotDisplayTypeAppiPadPro129, "10")
+	})
+}
+
+func TestCreateAppScreenshotSetForExperimentTreatmentLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppScreenshotSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateAppScreenshotSetForExperimentTreatmentLocalization(ctx, ScreenshotDisplayTypeAppiPadPro129, "10")
+	})
+}
+
 func TestDeleteAppScreenshotSet(t *testing.T) {
 	t.Parallel()
 
@@ -72,3 +102,14 @@ func TestReplaceAppScreenshotsForSet(t *testing.T) {
 		return client.Apps.ReplaceAppScreenshotsForSet(ctx, "10", []string{"10"})
 	})
 }
+
+func TestParseScreenshotDisplayType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseScreenshotDisplayType(string(ScreenshotDisplayTypeAppAppleTV))
+	assert.NoError(t, err)
+	assert.Equal(t, ScreenshotDisplayTypeAppAppleTV, got)
+
+	_, err = ParseScreenshotDisplayType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "ScreenshotDisplayType", Value: "UNKNOWN"}, err)
+}
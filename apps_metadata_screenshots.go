@@ -127,11 +127,7 @@ type GetAppScreenshotQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_screenshot_information
 func (s *AppsService) GetAppScreenshot(ctx context.Context, id string, params *GetAppScreenshotQuery) (*AppScreenshotResponse, *Response, error) {
-	url := fmt.Sprintf("appScreenshots/%s", id)
-	res := new(AppScreenshotResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppScreenshotResponse](ctx, s.client, fmt.Sprintf("appScreenshots/%s", id), params)
 }
 
 // CreateAppScreenshot adds a new screenshot to a screenshot set.
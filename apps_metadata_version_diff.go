@@ -0,0 +1,330 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+)
+
+// VersionDiff is a structured comparison of two App Store versions. In every change, Old holds the value
+// of the first version and New the value of the second.
+//
+// Prices and availability are configured per app rather than per version, so they can only differ when
+// the two versions belong to different apps.
+type VersionDiff struct {
+	Localizations []AppMetadataChange `json:"localizations"`
+	Screenshots   []AppMetadataChange `json:"screenshots"`
+	Prices        []AppMetadataChange `json:"prices"`
+	Availability  []AppMetadataChange `json:"availability"`
+}
+
+// OutputKind implements Output.
+func (d VersionDiff) OutputKind() string {
+	return "versionDiff"
+}
+
+// MarshalJSON encodes a kind of difference the versions don't have as an empty list rather than null.
+func (d VersionDiff) MarshalJSON() ([]byte, error) {
+	type diff VersionDiff
+
+	for _, changes := range []*[]AppMetadataChange{&d.Localizations, &d.Screenshots, &d.Prices, &d.Availability} {
+		if *changes == nil {
+			*changes = []AppMetadataChange{}
+		}
+	}
+
+	return json.Marshal(diff(d))
+}
+
+// Empty reports whether the two compared versions have no differences.
+func (d *VersionDiff) Empty() bool {
+	return len(d.Localizations) == 0 && len(d.Screenshots) == 0 && len(d.Prices) == 0 && len(d.Availability) == 0
+}
+
+type versionSnapshot struct {
+	appID    string
+	metadata *AppMetadata
+	prices   map[string]string
+	// territories is the set of territory IDs the app is available in.
+	territories map[string]bool
+}
+
+// DiffVersions compares the localizations, screenshots, manual prices and territory availability of two
+// App Store versions. Screenshots are compared by their source file checksums, so re-uploading an identical
+// image is not reported as a change.
+func (s *AppsService) DiffVersions(ctx context.Context, versionA string, versionB string) (*VersionDiff, *Response, error) {
+	a, resp, err := s.snapshotVersion(ctx, versionA, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	b, resp, err := s.snapshotVersion(ctx, versionB, a)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	diff := &VersionDiff{
+		Prices:       diffStringMaps("prices.", a.prices, b.prices),
+		Availability: diffStringMaps("availability.", territoryAvailability(a.territories), territoryAvailability(b.territories)),
+	}
+
+	locales := a.metadata.locales()
+	for _, locale := range b.metadata.locales() {
+		if _, ok := a.metadata.Localizations[locale]; !ok {
+			locales = append(locales, locale)
+		}
+	}
+
+	sort.Strings(locales)
+
+	for _, locale := range locales {
+		localizations, screenshots := diffVersionLocalization(locale, a.metadata.Localizations[locale], b.metadata.Localizations[locale])
+		diff.Localizations = append(diff.Localizations, localizations...)
+		diff.Screenshots = append(diff.Screenshots, screenshots...)
+	}
+
+	return diff, resp, nil
+}
+
+// snapshotVersion gathers the metadata of a version. When the version belongs to the same app as other,
+// the app-wide prices and availability of other are reused instead of being fetched again.
+func (s *AppsService) snapshotVersion(ctx context.Context, versionID string, other *versionSnapshot) (*versionSnapshot, *Response, error) {
+	version, resp, err := s.GetAppStoreVersion(ctx, versionID, &GetAppStoreVersionQuery{
+		Include: []string{"app"},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	snapshot := &versionSnapshot{}
+	if rels := version.Data.Relationships; rels != nil && rels.App != nil && rels.App.Data != nil {
+		snapshot.appID = rels.App.Data.ID
+	}
+
+	snapshot.metadata, resp, err = s.ExportAppMetadata(ctx, snapshot.appID, versionID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if other != nil && other.appID == snapshot.appID {
+		snapshot.prices = other.prices
+		snapshot.territories = other.territories
+
+		return snapshot, resp, nil
+	}
+
+	snapshot.prices, resp, err = s.manualPricesForApp(ctx, snapshot.appID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	params := &ListTerritoriesQuery{Limit: MaxPageLimit}
+	territories := &TerritoriesResponse{}
+
+	err = ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		page, res, err := s.client.Pricing.ListTerritoriesForApp(ctx, snapshot.appID, params)
+		resp = res
+		if err != nil {
+			return nil, err
+		}
+
+		territories.Data = append(territories.Data, page.Data...)
+
+		return &page.Links, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	snapshot.territories = make(map[string]bool, len(territories.Data))
+	for _, territory := range territories.Data {
+		snapshot.territories[territory.ID] = true
+	}
+
+	return snapshot, resp, nil
+}
+
+// manualPricesForApp maps the territory IDs of the manual prices in the price schedule of an app to their
+// customer prices. Scheduled prices are suffixed with their start date.
+func (s *AppsService) manualPricesForApp(ctx context.Context, appID string) (map[string]string, *Response, error) {
+	schedule, resp, err := s.client.Pricing.GetPriceScheduleForApp(ctx, appID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	params := &ListPricesForAppPriceScheduleQuery{
+		Include: []string{"appPricePoint"},
+		Limit:   MaxPageLimit,
+	}
+	prices := &AppPricesResponse{}
+
+	err = ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		page, res, err := s.client.Pricing.ListManualPricesForAppPriceSchedule(ctx, schedule.Data.ID, params)
+		resp = res
+		if err != nil {
+			return nil, err
+		}
+
+		prices.Data = append(prices.Data, page.Data...)
+		prices.Included = append(prices.Included, page.Included...)
+
+		return &page.Links, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	customerPrices := make(map[string]string, len(prices.Included))
+
+	for _, included := range prices.Included {
+		if point := included.AppPricePoint(); point != nil && point.Attributes != nil && point.Attributes.CustomerPrice != nil {
+			customerPrices[point.ID] = *point.Attributes.CustomerPrice
+		}
+	}
+
+	byTerritory := make(map[string][]string)
+
+	for _, price := range prices.Data {
+		rels := price.Relationships
+		if rels == nil || rels.Territory == nil || rels.Territory.Data == nil {
+			continue
+		}
+
+		var value string
+		if rels.AppPricePoint != nil && rels.AppPricePoint.Data != nil {
+			value = customerPrices[rels.AppPricePoint.Data.ID]
+		}
+
+		if price.Attributes != nil && price.Attributes.StartDate != nil {
+			value += " from " + price.Attributes.StartDate.Format(dateFormat)
+		}
+
+		territory := rels.Territory.Data.ID
+		byTerritory[territory] = append(byTerritory[territory], value)
+	}
+
+	result := make(map[string]string, len(byTerritory))
+
+	for territory, values := range byTerritory {
+		sort.Strings(values)
+		result[territory] = strings.Join(values, ", ")
+	}
+
+	return result, resp, nil
+}
+
+func diffVersionLocalization(locale string, a *AppMetadataLocalization, b *AppMetadataLocalization) (localizations, screenshots []AppMetadataChange) {
+	if a == nil {
+		a = &AppMetadataLocalization{}
+	}
+
+	if b == nil {
+		b = &AppMetadataLocalization{}
+	}
+
+	prefix := "localizations." + locale + "."
+	fieldsA := append(a.infoFields(), a.versionFields()...)
+	fieldsB := append(b.infoFields(), b.versionFields()...)
+
+	for i, field := range fieldsA {
+		var valueA, valueB string
+		if field.value != nil {
+			valueA = *field.value
+		}
+
+		if fieldsB[i].value != nil {
+			valueB = *fieldsB[i].value
+		}
+
+		if valueA != valueB {
+			localizations = append(localizations, AppMetadataChange{Field: prefix + field.name, Old: valueA, New: valueB})
+		}
+	}
+
+	setsA := screenshotChecksumsByDisplayType(a.Screenshots)
+	setsB := screenshotChecksumsByDisplayType(b.Screenshots)
+	screenshots = diffStringMaps(prefix+"screenshots.", setsA, setsB)
+
+	return localizations, screenshots
+}
+
+func screenshotChecksumsByDisplayType(sets []AppMetadataScreenshotSet) map[string]string {
+	checksums := make(map[string]string, len(sets))
+
+	for _, set := range sets {
+		if len(set.Screenshots) == 0 {
+			continue
+		}
+
+		values := make([]string, len(set.Screenshots))
+		for i, screenshot := range set.Screenshots {
+			values[i] = screenshot.SourceFileChecksum
+		}
+
+		checksums[string(set.DisplayType)] = strings.Join(values, ", ")
+	}
+
+	return checksums
+}
+
+func territoryAvailability(territories map[string]bool) map[string]string {
+	availability := make(map[string]string, len(territories))
+	for territory := range territories {
+		availability[territory] = "available"
+	}
+
+	return availability
+}
+
+// diffStringMaps lists the keys whose values differ between a and b, in key order. A key that is missing
+// from one of the maps is compared as an empty value.
+func diffStringMaps(prefix string, a map[string]string, b map[string]string) []AppMetadataChange {
+	keys := make([]string, 0, len(a)+len(b))
+
+	for key := range a {
+		keys = append(keys, key)
+	}
+
+	for key := range b {
+		if _, ok := a[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+
+	sort.Strings(keys)
+
+	var changes []AppMetadataChange
+
+	for _, key := range keys {
+		if a[key] != b[key] {
+			changes = append(changes, AppMetadataChange{Field: prefix + key, Old: a[key], New: b[key]})
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,129 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func versionDiffRoutes(appID, versionID, localizationID, description, checksum string) map[string]string {
+	return map[string]string{
+		"GET /appStoreVersions/" + versionID:                                   `{"data":{"id":"` + versionID + `","type":"appStoreVersions","relationships":{"app":{"data":{"id":"` + appID + `","type":"apps"}}}}}`,
+		"GET /apps/" + appID + "/appInfos":                                     `{"data":[{"id":"info` + appID + `","type":"appInfos"}]}`,
+		"GET /appInfos/info" + appID + "/ageRatingDeclaration":                 `{"data":{"id":"age` + appID + `","type":"ageRatingDeclarations"}}`,
+		"GET /appInfos/info" + appID + "/appInfoLocalizations":                 `{"data":[{"id":"il` + appID + `","type":"appInfoLocalizations","attributes":{"locale":"en-US","name":"App"}}]}`,
+		"GET /appStoreVersions/" + versionID + "/appStoreVersionLocalizations": `{"data":[{"id":"` + localizationID + `","type":"appStoreVersionLocalizations","attributes":{"locale":"en-US","description":"` + description + `"}}]}`,
+		"GET /appStoreVersionLocalizations/" + localizationID + "/appScreenshotSets": `{"data":[{"id":"set` + localizationID + `","type":"appScreenshotSets","attributes":{"screenshotDisplayType":"APP_IPHONE_65"},"relationships":{
+				"appScreenshots":{"data":[{"id":"shot` + localizationID + `","type":"appScreenshots"}]}
+			}}],"included":[{"id":"shot` + localizationID + `","type":"appScreenshots","attributes":{"fileName":"a.png","sourceFileChecksum":"` + checksum + `"}}]}`,
+	}
+}
+
+func mergeRoutes(routes ...map[string]string) map[string]string {
+	merged := make(map[string]string)
+
+	for _, r := range routes {
+		for k, v := range r {
+			merged[k] = v
+		}
+	}
+
+	return merged
+}
+
+func TestDiffVersionsSameApp(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(mergeRoutes(
+		versionDiffRoutes("1", "10", "100", "Old", "aa"),
+		versionDiffRoutes("1", "20", "200", "New", "aa"),
+		map[string]string{
+			"GET /apps/1/appPriceSchedule":          `{"data":{"id":"1","type":"appPriceSchedules"}}`,
+			"GET /appPriceSchedules/1/manualPrices": `{"data":[]}`,
+			"GET /apps/1/availableTerritories":      `{"data":[{"id":"USA","type":"territories"}]}`,
+		},
+	))
+	defer server.Close()
+
+	diff, _, err := client.Apps.DiffVersions(context.Background(), "10", "20")
+	assert.NoError(t, err)
+	assert.Equal(t, []AppMetadataChange{{Field: "localizations.en-US.description", Old: "Old", New: "New"}}, diff.Localizations)
+	assert.Empty(t, diff.Screenshots)
+	assert.Empty(t, diff.Prices)
+	assert.Empty(t, diff.Availability)
+	assert.False(t, diff.Empty())
+
+	var scheduleRequests int
+
+	for _, r := range *requests {
+		if r == "GET /apps/1/appPriceSchedule" {
+			scheduleRequests++
+		}
+	}
+
+	assert.Equal(t, 1, scheduleRequests)
+}
+
+func TestDiffVersionsDifferentApps(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(mergeRoutes(
+		versionDiffRoutes("1", "10", "100", "Desc", "aa"),
+		versionDiffRoutes("2", "20", "200", "Desc", "bb"),
+		map[string]string{
+			"GET /apps/1/appPriceSchedule": `{"data":{"id":"1","type":"appPriceSchedules"}}`,
+			"GET /apps/2/appPriceSchedule": `{"data":{"id":"2","type":"appPriceSchedules"}}`,
+			"GET /appPriceSchedules/1/manualPrices": `{"data":[
+				{"id":"p1","type":"appPrices","relationships":{"appPricePoint":{"data":{"id":"pp1","type":"appPricePoints"}},"territory":{"data":{"id":"USA","type":"territories"}}}}
+			],"included":[{"id":"pp1","type":"appPricePoints","attributes":{"customerPrice":"0.99"}}]}`,
+			"GET /appPriceSchedules/2/manualPrices": `{"data":[
+				{"id":"p2","type":"appPrices","relationships":{"appPricePoint":{"data":{"id":"pp2","type":"appPricePoints"}},"territory":{"data":{"id":"USA","type":"territories"}}}},
+				{"id":"p3","type":"appPrices","attributes":{"startDate":"2030-01-01"},"relationships":{"appPricePoint":{"data":{"id":"pp3","type":"appPricePoints"}},"territory":{"data":{"id":"USA","type":"territories"}}}}
+			],"included":[
+				{"id":"pp2","type":"appPricePoints","attributes":{"customerPrice":"0.99"}},
+				{"id":"pp3","type":"appPricePoints","attributes":{"customerPrice":"1.99"}}
+			]}`,
+			"GET /apps/1/availableTerritories": `{"data":[{"id":"USA","type":"territories"},{"id":"CAN","type":"territories"}]}`,
+			"GET /apps/2/availableTerritories": `{"data":[{"id":"USA","type":"territories"}]}`,
+		},
+	))
+	defer server.Close()
+
+	diff, _, err := client.Apps.DiffVersions(context.Background(), "10", "20")
+	assert.NoError(t, err)
+	assert.Empty(t, diff.Localizations)
+	assert.Equal(t, []AppMetadataChange{{Field: "localizations.en-US.screenshots.APP_IPHONE_65", Old: "aa", New: "bb"}}, diff.Screenshots)
+	assert.Equal(t, []AppMetadataChange{{Field: "prices.USA", Old: "0.99", New: "0.99, 1.99 from 2030-01-01"}}, diff.Prices)
+	assert.Equal(t, []AppMetadataChange{{Field: "availability.CAN", Old: "available", New: ""}}, diff.Availability)
+}
+
+func TestDiffVersionsError(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{})
+	defer server.Close()
+
+	_, _, err := client.Apps.DiffVersions(context.Background(), "10", "20")
+	assert.Error(t, err)
+}
@@ -131,6 +131,48 @@ type AppStoreVersionLocalizationUpdateRequestAttributes struct {
 	WhatsNew        *string `json:"whatsNew,omitempty"`
 }
 
+// WithDescription sets the description to update and returns the attributes for chaining.
+func (a *AppStoreVersionLocalizationUpdateRequestAttributes) WithDescription(v string) *AppStoreVersionLocalizationUpdateRequestAttributes {
+	a.Description = &v
+
+	return a
+}
+
+// WithKeywords sets the keywords to update and returns the attributes for chaining.
+func (a *AppStoreVersionLocalizationUpdateRequestAttributes) WithKeywords(v string) *AppStoreVersionLocalizationUpdateRequestAttributes {
+	a.Keywords = &v
+
+	return a
+}
+
+// WithMarketingURL sets the marketing URL to update and returns the attributes for chaining.
+func (a *AppStoreVersionLocalizationUpdateRequestAttributes) WithMarketingURL(v string) *AppStoreVersionLocalizationUpdateRequestAttributes {
+	a.MarketingURL = &v
+
+	return a
+}
+
+// WithPromotionalText sets the promotional text to update and returns the attributes for chaining.
+func (a *AppStoreVersionLocalizationUpdateRequestAttributes) WithPromotionalText(v string) *AppStoreVersionLocalizationUpdateRequestAttributes {
+	a.PromotionalText = &v
+
+	return a
+}
+
+// WithSupportURL sets the support URL to update and returns the attributes for chaining.
+func (a *AppStoreVersionLocalizationUpdateRequestAttributes) WithSupportURL(v string) *AppStoreVersionLocalizationUpdateRequestAttributes {
+	a.SupportURL = &v
+
+	return a
+}
+
+// WithWhatsNew sets the whats new to update and returns the attributes for chaining.
+func (a *AppStoreVersionLocalizationUpdateRequestAttributes) WithWhatsNew(v string) *AppStoreVersionLocalizationUpdateRequestAttributes {
+	a.WhatsNew = &v
+
+	return a
+}
+
 // ListLocalizationsForAppStoreVersionQuery are query options for ListLocalizationsForAppStoreVersion
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_store_version_localizations_for_an_app_store_version
@@ -182,22 +224,14 @@ type ListAppPreviewSetsForAppStoreVersionLocalizationQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_store_version_localizations_for_an_app_store_version
 func (s *AppsService) ListLocalizationsForAppStoreVersion(ctx context.Context, id string, params *ListLocalizationsForAppStoreVersionQuery) (*AppStoreVersionLocalizationsResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersions/%s/appStoreVersionLocalizations", id)
-	res := new(AppStoreVersionLocalizationsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppStoreVersionLocalizationsResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s/appStoreVersionLocalizations", id), params)
 }
 
 // GetAppStoreVersionLocalization reads localized version-level information.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_localization_information
 func (s *AppsService) GetAppStoreVersionLocalization(ctx context.Context, id string, params *GetAppStoreVersionLocalizationQuery) (*AppStoreVersionLocalizationResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersionLocalizations/%s", id)
-	res := new(AppStoreVersionLocalizationResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppStoreVersionLocalizationResponse](ctx, s.client, fmt.Sprintf("appStoreVersionLocalizations/%s", id), params)
 }
 
 // CreateAppStoreVersionLocalization adds localized version-level information for a new locale.
@@ -251,22 +285,14 @@ func (s *AppsService) DeleteAppStoreVersionLocalization(ctx context.Context, id
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshot_sets_for_an_app_store_version_localization
 func (s *AppsService) ListAppScreenshotSetsForAppStoreVersionLocalization(ctx context.Context, id string, params *ListAppScreenshotSetsForAppStoreVersionLocalizationQuery) (*AppScreenshotSetsResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersionLocalizations/%s/appScreenshotSets", id)
-	res := new(AppScreenshotSetsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppScreenshotSetsResponse](ctx, s.client, fmt.Sprintf("appStoreVersionLocalizations/%s/appScreenshotSets", id), params)
 }
 
 // ListAppPreviewSetsForAppStoreVersionLocalization lists all app preview sets for a specific localization.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_preview_sets_for_an_app_store_version_localization
 func (s *AppsService) ListAppPreviewSetsForAppStoreVersionLocalization(ctx context.Context, id string, params *ListAppPreviewSetsForAppStoreVersionLocalizationQuery) (*AppPreviewSetsResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersionLocalizations/%s/appPreviewSets", id)
-	res := new(AppPreviewSetsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppPreviewSetsResponse](ctx, s.client, fmt.Sprintf("appStoreVersionLocalizations/%s/appPreviewSets", id), params)
 }
 
 // UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppStoreVersionLocalizationResponseIncluded.
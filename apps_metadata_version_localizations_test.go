@@ -98,3 +98,24 @@ func TestListAppPreviewSetsForAppStoreVersionLocalization(t *testing.T) {
 		return client.Apps.ListAppPreviewSetsForAppStoreVersionLocalization(ctx, "10", &ListAppPreviewSetsForAppStoreVersionLocalizationQuery{})
 	})
 }
+
+func TestAppStoreVersionLocalizationUpdateRequestAttributesBuilder(t *testing.T) {
+	t.Parallel()
+
+	got := new(AppStoreVersionLocalizationUpdateRequestAttributes).
+		WithDescription("TEST").
+		WithKeywords("TEST").
+		WithMarketingURL("TEST").
+		WithPromotionalText("TEST").
+		WithSupportURL("TEST").
+		WithWhatsNew("TEST")
+
+	assert.Equal(t, &AppStoreVersionLocalizationUpdateRequestAttributes{
+		Description:     String("TEST"),
+		Keywords:        String("TEST"),
+		MarketingURL:    String("TEST"),
+		PromotionalText: String("TEST"),
+		SupportURL:      String("TEST"),
+		WhatsNew:        String("TEST"),
+	}, got)
+}
@@ -0,0 +1,245 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// Character limits App Store Connect enforces on localized metadata.
+const (
+	MaxAppNameLength         = 30
+	MaxSubtitleLength        = 30
+	MaxDescriptionLength     = 4000
+	MaxKeywordsLength        = 100
+	MaxPromotionalTextLength = 170
+	MaxWhatsNewLength        = 4000
+	MaxScreenshotsPerSet     = 10
+)
+
+// RequiredScreenshotDisplayTypes lists the screenshot display types every localization of a version must provide
+// for each platform. Add ScreenshotDisplayTypeAppiPadPro3Gen129 to the iOS entry for apps that run on iPad.
+var RequiredScreenshotDisplayTypes = map[Platform][]ScreenshotDisplayType{
+	PlatformIOS:      {ScreenshotDisplayTypeAppiPhone65},
+	PlatformMACOS:    {ScreenshotDisplayTypeAppDesktop},
+	PlatformTVOS:     {ScreenshotDisplayTypeAppAppleTV},
+	PlatformVISIONOS: {ScreenshotDisplayTypeAppAppleVisionPro},
+}
+
+// VersionViolation describes a single App Store constraint that an App Store version does not satisfy.
+type VersionViolation struct {
+	Field   string
+	Message string
+}
+
+func (v VersionViolation) String() string {
+	return fmt.Sprintf("%s: %s", v.Field, v.Message)
+}
+
+// ValidateVersion checks an App Store version against the constraints App Review enforces, such as metadata
+// length limits, missing localizations and screenshots, an undeclared age rating, and a missing build, and returns
+// every violation it finds. An error is only returned if the version's metadata could not be fetched.
+func (s *AppsService) ValidateVersion(ctx context.Context, versionID string) ([]VersionViolation, *Response, error) {
+	version, resp, err := s.GetAppStoreVersion(ctx, versionID, &GetAppStoreVersionQuery{
+		Include: []string{"app", "build"},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var (
+		appID    string
+		platform Platform
+		hasBuild bool
+	)
+
+	if rels := version.Data.Relationships; rels != nil {
+		if rels.App != nil && rels.App.Data != nil {
+			appID = rels.App.Data.ID
+		}
+
+		hasBuild = rels.Build != nil && rels.Build.Data != nil
+	}
+
+	if attrs := version.Data.Attributes; attrs != nil && attrs.Platform != nil {
+		platform = *attrs.Platform
+	}
+
+	metadata, resp, err := s.ExportAppMetadata(ctx, appID, versionID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	violations := metadata.Lint(platform)
+
+	if !hasBuild {
+		violations = append(violations, VersionViolation{Field: "build", Message: "no build is selected"})
+	}
+
+	return violations, resp, nil
+}
+
+// Lint checks a metadata snapshot against the constraints App Review enforces for the given platform, without
+// making any requests. It can be used to check a snapshot read with ReadAppMetadata before applying it.
+func (m *AppMetadata) Lint(platform Platform) []VersionViolation {
+	var violations []VersionViolation
+
+	if m.Categories.Primary == "" {
+		violations = append(violations, VersionViolation{Field: "categories.primary", Message: "no primary category is set"})
+	}
+
+	if err := m.Categories.Validate(); err != nil {
+		violations = append(violations, VersionViolation{Field: "categories", Message: err.Error()})
+	}
+
+	violations = append(violations, lintAgeRating(m.AgeRating)...)
+
+	if len(m.Localizations) == 0 {
+		violations = append(violations, VersionViolation{Field: "localizations", Message: "no localizations are present"})
+	}
+
+	for _, locale := range m.locales() {
+		violations = append(violations, lintAppMetadataLocalization(locale, m.Localizations[locale], RequiredScreenshotDisplayTypes[platform])...)
+	}
+
+	return violations
+}
+
+func lintAgeRating(attrs *AgeRatingDeclarationAttributes) []VersionViolation {
+	if attrs == nil {
+		return []VersionViolation{{Field: "ageRating", Message: "no age rating is declared"}}
+	}
+
+	optional := map[string]bool{
+		"ageRatingOverride":      true,
+		"kidsAgeBand":            true,
+		"koreaAgeRatingOverride": true,
+	}
+
+	var violations []VersionViolation
+
+	v := reflect.ValueOf(*attrs)
+	for i := 0; i < v.NumField(); i++ {
+		name := strings.Split(v.Type().Field(i).Tag.Get("json"), ",")[0]
+		if v.Field(i).IsNil() && !optional[name] {
+			violations = append(violations, VersionViolation{Field: "ageRating." + name, Message: "is not answered"})
+		}
+	}
+
+	if err := attrs.Validate(); err != nil {
+		if invalid, ok := err.(ErrInvalidAgeRatingDeclaration); ok {
+			for _, problem := range invalid.Problems {
+				violations = append(violations, VersionViolation{Field: "ageRating", Message: problem})
+			}
+		}
+	}
+
+	return violations
+}
+
+func lintAppMetadataLocalization(locale string, l *AppMetadataLocalization, requiredDisplayTypes []ScreenshotDisplayType) []VersionViolation {
+	prefix := "localizations." + locale
+
+	if !hasAppMetadataValues(l.infoFields()) {
+		return []VersionViolation{{Field: prefix, Message: "no app info localization exists for this locale"}}
+	}
+
+	if !hasAppMetadataValues(l.versionFields()) {
+		return []VersionViolation{{Field: prefix, Message: "no App Store version localization exists for this locale"}}
+	}
+
+	var violations []VersionViolation
+
+	required := []appMetadataField{
+		{"name", l.Name},
+		{"description", l.Description},
+		{"keywords", l.Keywords},
+		{"supportUrl", l.SupportURL},
+	}
+
+	for _, field := range required {
+		if field.value == nil || strings.TrimSpace(*field.value) == "" {
+			violations = append(violations, VersionViolation{Field: prefix + "." + field.name, Message: "is required"})
+		}
+	}
+
+	limits := []struct {
+		field appMetadataField
+		max   int
+	}{
+		{appMetadataField{"name", l.Name}, MaxAppNameLength},
+		{appMetadataField{"subtitle", l.Subtitle}, MaxSubtitleLength},
+		{appMetadataField{"description", l.Description}, MaxDescriptionLength},
+		{appMetadataField{"keywords", l.Keywords}, MaxKeywordsLength},
+		{appMetadataField{"promotionalText", l.PromotionalText}, MaxPromotionalTextLength},
+		{appMetadataField{"whatsNew", l.WhatsNew}, MaxWhatsNewLength},
+	}
+
+	for _, limit := range limits {
+		if limit.field.value == nil {
+			continue
+		}
+
+		if n := utf8.RuneCountInString(*limit.field.value); n > limit.max {
+			violations = append(violations, VersionViolation{
+				Field:   prefix + "." + limit.field.name,
+				Message: fmt.Sprintf("is %d characters long, the limit is %d", n, limit.max),
+			})
+		}
+	}
+
+	counts := make(map[ScreenshotDisplayType]int, len(l.Screenshots))
+	for _, set := range l.Screenshots {
+		counts[set.DisplayType] = len(set.Screenshots)
+
+		if len(set.Screenshots) > MaxScreenshotsPerSet {
+			violations = append(violations, VersionViolation{
+				Field:   prefix + ".screenshots." + string(set.DisplayType),
+				Message: fmt.Sprintf("has %d screenshots, the limit is %d", len(set.Screenshots), MaxScreenshotsPerSet),
+			})
+		}
+	}
+
+	for _, displayType := range requiredDisplayTypes {
+		if counts[displayType] == 0 {
+			violations = append(violations, VersionViolation{
+				Field:   prefix + ".screenshots." + string(displayType),
+				Message: "at least one screenshot is required",
+			})
+		}
+	}
+
+	return violations
+}
+
+func hasAppMetadataValues(fields []appMetadataField) bool {
+	for _, field := range fields {
+		if field.value != nil {
+			return true
+		}
+	}
+
+	return false
+}
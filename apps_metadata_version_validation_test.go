@@ -0,0 +1,141 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validAppMetadata() *AppMetadata {
+	none := AgeRatingContentLevelNone
+
+	return &AppMetadata{
+		Categories: AppInfoCategories{Primary: AppCategoryIDGames},
+		AgeRating: &AgeRatingDeclarationAttributes{
+			AlcoholTobaccoOrDrugUseOrReferences:         &none,
+			Contests:                                    &none,
+			Gambling:                                    Bool(false),
+			GamblingSimulated:                           &none,
+			HorrorOrFearThemes:                          &none,
+			LootBox:                                     Bool(false),
+			MatureOrSuggestiveThemes:                    &none,
+			MedicalOrTreatmentInformation:               &none,
+			ProfanityOrCrudeHumor:                       &none,
+			SexualContentGraphicAndNudity:               &none,
+			SexualContentOrNudity:                       &none,
+			SeventeenPlus:                               Bool(false),
+			UnrestrictedWebAccess:                       Bool(false),
+			ViolenceCartoonOrFantasy:                    &none,
+			ViolenceRealistic:                           &none,
+			ViolenceRealisticProlongedGraphicOrSadistic: &none,
+		},
+		Localizations: map[string]*AppMetadataLocalization{
+			"en-US": {
+				Name:        String("App"),
+				Description: String("Desc"),
+				Keywords:    String("game"),
+				SupportURL:  String("https://example.com"),
+				Screenshots: []AppMetadataScreenshotSet{
+					{DisplayType: ScreenshotDisplayTypeAppiPhone65, Screenshots: []AppMetadataScreenshot{{FileName: "a.png"}}},
+				},
+			},
+		},
+	}
+}
+
+func TestLintAppMetadata(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, validAppMetadata().Lint(PlatformIOS))
+
+	metadata := validAppMetadata()
+	metadata.AgeRating.LootBox = nil
+	metadata.Localizations["en-US"].Keywords = String(strings.Repeat("k", MaxKeywordsLength+1))
+	metadata.Localizations["en-US"].SupportURL = nil
+	metadata.Localizations["de-DE"] = &AppMetadataLocalization{Name: String("Anwendung")}
+
+	assert.Equal(t, []VersionViolation{
+		{Field: "ageRating.lootBox", Message: "is not answered"},
+		{Field: "localizations.de-DE", Message: "no App Store version localization exists for this locale"},
+		{Field: "localizations.en-US.supportUrl", Message: "is required"},
+		{Field: "localizations.en-US.keywords", Message: "is 101 characters long, the limit is 100"},
+	}, metadata.Lint(PlatformIOS))
+
+	assert.Equal(t, []VersionViolation{
+		{Field: "localizations.en-US.screenshots.APP_DESKTOP", Message: "at least one screenshot is required"},
+	}, validAppMetadata().Lint(PlatformMACOS))
+}
+
+func TestLintAppMetadataEmpty(t *testing.T) {
+	t.Parallel()
+
+	violations := (&AppMetadata{}).Lint(PlatformIOS)
+	assert.Equal(t, []VersionViolation{
+		{Field: "categories.primary", Message: "no primary category is set"},
+		{Field: "ageRating", Message: "no age rating is declared"},
+		{Field: "localizations", Message: "no localizations are present"},
+	}, violations)
+	assert.Equal(t, "ageRating: no age rating is declared", violations[1].String())
+}
+
+func TestLintAppMetadataInconsistentAgeRating(t *testing.T) {
+	t.Parallel()
+
+	band := KidsAgeBandFiveAndUnder
+	metadata := validAppMetadata()
+	metadata.AgeRating.KidsAgeBand = &band
+	metadata.AgeRating.Gambling = Bool(true)
+
+	assert.Equal(t, []VersionViolation{
+		{Field: "ageRating", Message: "gambling cannot be true when kidsAgeBand is set"},
+	}, metadata.Lint(PlatformIOS))
+}
+
+func TestValidateVersion(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /appStoreVersions/10":                              `{"data":{"id":"10","type":"appStoreVersions","attributes":{"platform":"IOS"},"relationships":{"app":{"data":{"id":"1","type":"apps"}}}}}`,
+		"GET /apps/1/appInfos":                                  `{"data":[{"id":"2","type":"appInfos","relationships":{"primaryCategory":{"data":{"id":"GAMES","type":"appCategories"}}}}]}`,
+		"GET /appInfos/2/ageRatingDeclaration":                  `{"data":{"id":"3","type":"ageRatingDeclarations"}}`,
+		"GET /appInfos/2/appInfoLocalizations":                  `{"data":[]}`,
+		"GET /appStoreVersions/10/appStoreVersionLocalizations": `{"data":[]}`,
+	})
+	defer server.Close()
+
+	violations, _, err := client.Apps.ValidateVersion(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Contains(t, violations, VersionViolation{Field: "ageRating", Message: "no age rating is declared"})
+	assert.Contains(t, violations, VersionViolation{Field: "localizations", Message: "no localizations are present"})
+	assert.Equal(t, VersionViolation{Field: "build", Message: "no build is selected"}, violations[len(violations)-1])
+}
+
+func TestValidateVersionError(t *testing.T) {
+	t.Parallel()
+
+	testEndpointExpectingError(t, `{"data":`, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ValidateVersion(ctx, "10")
+	})
+}
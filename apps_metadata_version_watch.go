@@ -0,0 +1,118 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"time"
+)
+
+// IsRejected reports whether a version in this state was rejected, by App Review or by its developer.
+func (s AppStoreVersionState) IsRejected() bool {
+	switch s {
+	case AppStoreVersionStateRejected, AppStoreVersionStateMetadataRejected, AppStoreVersionStateInvalidBinary, AppStoreVersionStateDeveloperRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsReleased reports whether a version in this state is, or will be on its release date, live on the App Store.
+func (s AppStoreVersionState) IsReleased() bool {
+	return s == AppStoreVersionStateReadyForSale || s == AppStoreVersionStatePreorderReadyForSale
+}
+
+// VersionStateTransition is a change in the App Store state of a version observed by WatchVersionState.
+// From is empty for the first state observed. When a poll fails, Err is set and the states are empty.
+type VersionStateTransition struct {
+	From AppStoreVersionState
+	To   AppStoreVersionState
+	Time time.Time
+	Err  error
+}
+
+// WatchVersionState polls the App Store state of a version every interval, and sends the first state it observes
+// followed by every change of state. Failed polls are sent with Err set and polling carries on. The channel is
+// closed once the version is released or rejected, or when ctx is done.
+//
+// The App Store Connect API does not expose the messages App Review leaves in the Resolution Center, so a
+// rejection is only reported by its state.
+func (s *AppsService) WatchVersionState(ctx context.Context, versionID string, interval time.Duration) <-chan VersionStateTransition {
+	transitions := make(chan VersionStateTransition)
+
+	go func() {
+		defer close(transitions)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		var current AppStoreVersionState
+
+		for {
+			transition, changed := s.pollVersionState(ctx, versionID, current)
+			if changed {
+				select {
+				case transitions <- transition:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			if transition.Err == nil {
+				current = transition.To
+
+				if current.IsReleased() || current.IsRejected() {
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return transitions
+}
+
+func (s *AppsService) pollVersionState(ctx context.Context, versionID string, current AppStoreVersionState) (VersionStateTransition, bool) {
+	version, _, err := s.GetAppStoreVersion(ctx, versionID, &GetAppStoreVersionQuery{
+		FieldsAppStoreVersions: []string{"appStoreState"},
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return VersionStateTransition{}, false
+		}
+
+		return VersionStateTransition{Time: time.Now(), Err: err}, true
+	}
+
+	var state AppStoreVersionState
+	if version.Data.Attributes != nil && version.Data.Attributes.AppStoreState != nil {
+		state = *version.Data.Attributes.AppStoreState
+	}
+
+	transition := VersionStateTransition{From: current, To: state, Time: time.Now()}
+
+	return transition, state != current
+}
@@ -0,0 +1,145 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newVersionStateServer(states ...string) (*Client, *httptest.Server) {
+	var (
+		mu    sync.Mutex
+		polls int
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		state := states[len(states)-1]
+		if polls < len(states) {
+			state = states[polls]
+		}
+		polls++
+		mu.Unlock()
+
+		if state == "" {
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintln(w, `{"errors":[{"status":"500"}]}`)
+
+			return
+		}
+
+		fmt.Fprintf(w, `{"data":{"id":"10","type":"appStoreVersions","attributes":{"appStoreState":%q}}}`, state)
+	}))
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client, server
+}
+
+func collectTransitions(transitions <-chan VersionStateTransition) []VersionStateTransition {
+	var collected []VersionStateTransition
+	for transition := range transitions {
+		collected = append(collected, transition)
+	}
+
+	return collected
+}
+
+func TestAppStoreVersionStateIsRejected(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, AppStoreVersionStateRejected.IsRejected())
+	assert.True(t, AppStoreVersionStateMetadataRejected.IsRejected())
+	assert.False(t, AppStoreVersionStateInReview.IsRejected())
+}
+
+func TestAppStoreVersionStateIsReleased(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, AppStoreVersionStateReadyForSale.IsReleased())
+	assert.False(t, AppStoreVersionStatePendingDeveloperRelease.IsReleased())
+}
+
+func TestWatchVersionStateUntilReleased(t *testing.T) {
+	t.Parallel()
+
+	client, server := newVersionStateServer("WAITING_FOR_REVIEW", "WAITING_FOR_REVIEW", "IN_REVIEW", "", "PENDING_DEVELOPER_RELEASE", "READY_FOR_SALE")
+	defer server.Close()
+
+	transitions := collectTransitions(client.Apps.WatchVersionState(context.Background(), "10", time.Millisecond))
+	assert.Len(t, transitions, 5)
+
+	var states [][2]AppStoreVersionState
+
+	for _, transition := range transitions {
+		if transition.Err != nil {
+			continue
+		}
+
+		states = append(states, [2]AppStoreVersionState{transition.From, transition.To})
+	}
+
+	assert.Equal(t, [][2]AppStoreVersionState{
+		{"", AppStoreVersionStateWaitingForReview},
+		{AppStoreVersionStateWaitingForReview, AppStoreVersionStateInReview},
+		{AppStoreVersionStateInReview, AppStoreVersionStatePendingDeveloperRelease},
+		{AppStoreVersionStatePendingDeveloperRelease, AppStoreVersionStateReadyForSale},
+	}, states)
+	assert.Error(t, transitions[2].Err)
+}
+
+func TestWatchVersionStateUntilRejected(t *testing.T) {
+	t.Parallel()
+
+	client, server := newVersionStateServer("IN_REVIEW", "REJECTED")
+	defer server.Close()
+
+	transitions := collectTransitions(client.Apps.WatchVersionState(context.Background(), "10", time.Millisecond))
+	assert.Len(t, transitions, 2)
+	assert.Equal(t, AppStoreVersionStateRejected, transitions[1].To)
+}
+
+func TestWatchVersionStateCanceled(t *testing.T) {
+	t.Parallel()
+
+	client, server := newVersionStateServer("IN_REVIEW")
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	transitions := client.Apps.WatchVersionState(ctx, "10", time.Millisecond)
+
+	first := <-transitions
+	assert.Equal(t, AppStoreVersionStateInReview, first.To)
+
+	cancel()
+
+	assert.Empty(t, collectTransitions(transitions))
+}
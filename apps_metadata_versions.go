@@ -22,9 +22,19 @@ package asc
 
 import (
 	"context"
+	"errors"
 	"fmt"
 )
 
+// ErrNoProcessedBuild happens when SelectLatestBuild cannot find a processed build for the version's app and platform.
+var ErrNoProcessedBuild = errors.New("no processed build found for app store version")
+
+// ErrMissingVersionApp happens when SelectLatestBuild cannot determine which app an App Store version belongs to.
+var ErrMissingVersionApp = errors.New("app store version has no app relationship")
+
+// ErrBuildAppMismatch happens when SelectLatestBuild finds a build that does not belong to the version's app.
+var ErrBuildAppMismatch = errors.New("build does not belong to the app store version's app")
+
 // AppStoreVersionState defines model for AppStoreVersionState.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionstate
@@ -67,6 +77,46 @@ const (
 	AppStoreVersionStateWaitingForReview AppStoreVersionState = "WAITING_FOR_REVIEW"
 )
 
+var appStoreVersionStates = []AppStoreVersionState{
+	AppStoreVersionStateDeveloperRejected,
+	AppStoreVersionStateDeveloperRemovedFromSale,
+	AppStoreVersionStateInvalidBinary,
+	AppStoreVersionStateInReview,
+	AppStoreVersionStateMetadataRejected,
+	AppStoreVersionStatePendingAppleRelease,
+	AppStoreVersionStatePendingContract,
+	AppStoreVersionStatePendingDeveloperRelease,
+	AppStoreVersionStatePreorderReadyForSale,
+	AppStoreVersionStatePrepareForSubmission,
+	AppStoreVersionStateProcessingForAppStore,
+	AppStoreVersionStateReadyForSale,
+	AppStoreVersionStateRejected,
+	AppStoreVersionStateRemovedFromSale,
+	AppStoreVersionStateReplacedWithNewVersion,
+	AppStoreVersionStateWaitingForExportCompliance,
+	AppStoreVersionStateWaitingForReview,
+}
+
+// IsValid reports whether the app store version state is one this package knows about.
+func (v AppStoreVersionState) IsValid() bool {
+	for _, value := range appStoreVersionStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppStoreVersionState returns s as an AppStoreVersionState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppStoreVersionState(s string) (AppStoreVersionState, error) {
+	if v := AppStoreVersionState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppStoreVersionState", Value: s}
+}
+
 // AppStoreVersionUpdateRequest defines model for AppStoreVersionUpdateRequest.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionupdaterequest/data
@@ -89,6 +139,48 @@ type AppStoreVersionUpdateRequestAttributes struct {
 	VersionString       *string   `json:"versionString,omitempty"`
 }
 
+// WithCopyright sets the copyright to update and returns the attributes for chaining.
+func (a *AppStoreVersionUpdateRequestAttributes) WithCopyright(v string) *AppStoreVersionUpdateRequestAttributes {
+	a.Copyright = &v
+
+	return a
+}
+
+// WithDownloadable sets the downloadable to update and returns the attributes for chaining.
+func (a *AppStoreVersionUpdateRequestAttributes) WithDownloadable(v bool) *AppStoreVersionUpdateRequestAttributes {
+	a.Downloadable = &v
+
+	return a
+}
+
+// WithEarliestReleaseDate sets the earliest release date to update and returns the attributes for chaining.
+func (a *AppStoreVersionUpdateRequestAttributes) WithEarliestReleaseDate(v DateTime) *AppStoreVersionUpdateRequestAttributes {
+	a.EarliestReleaseDate = &v
+
+	return a
+}
+
+// WithReleaseType sets the release type to update and returns the attributes for chaining.
+func (a *AppStoreVersionUpdateRequestAttributes) WithReleaseType(v string) *AppStoreVersionUpdateRequestAttributes {
+	a.ReleaseType = &v
+
+	return a
+}
+
+// WithUsesIDFA sets the uses IDFA to update and returns the attributes for chaining.
+func (a *AppStoreVersionUpdateRequestAttributes) WithUsesIDFA(v bool) *AppStoreVersionUpdateRequestAttributes {
+	a.UsesIDFA = &v
+
+	return a
+}
+
+// WithVersionString sets the version string to update and returns the attributes for chaining.
+func (a *AppStoreVersionUpdateRequestAttributes) WithVersionString(v string) *AppStoreVersionUpdateRequestAttributes {
+	a.VersionString = &v
+
+	return a
+}
+
 // appStoreVersionUpdateRequestRelationships are relationships for AppStoreVersionUpdateRequest
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionupdaterequest/data/relationships
@@ -110,22 +202,25 @@ type AgeRatingDeclaration struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/ageratingdeclaration/attributes
 type AgeRatingDeclarationAttributes struct {
-	AlcoholTobaccoOrDrugUseOrReferences         *string      `json:"alcoholTobaccoOrDrugUseOrReferences,omitempty"`
-	Contests                                    *string      `json:"contests,omitempty"`
-	Gambling                                    *bool        `json:"gambling,omitempty"`
-	GamblingSimulated                           *string      `json:"gamblingSimulated,omitempty"`
-	HorrorOrFearThemes                          *string      `json:"horrorOrFearThemes,omitempty"`
-	KidsAgeBand                                 *KidsAgeBand `json:"kidsAgeBand,omitempty"`
-	MatureOrSuggestiveThemes                    *string      `json:"matureOrSuggestiveThemes,omitempty"`
-	MedicalOrTreatmentInformation               *string      `json:"medicalOrTreatmentInformation,omitempty"`
-	ProfanityOrCrudeHumor                       *string      `json:"profanityOrCrudeHumor,omitempty"`
-	SexualContentGraphicAndNudity               *string      `json:"sexualContentGraphicAndNudity,omitempty"`
-	SexualContentOrNudity                       *string      `json:"sexualContentOrNudity,omitempty"`
-	SeventeenPlus                               *bool        `json:"seventeenPlus,omitempty"`
-	UnrestrictedWebAccess                       *bool        `json:"unrestrictedWebAccess,omitempty"`
-	ViolenceCartoonOrFantasy                    *string      `json:"violenceCartoonOrFantasy,omitempty"`
-	ViolenceRealistic                           *string      `json:"violenceRealistic,omitempty"`
-	ViolenceRealisticProlongedGraphicOrSadistic *string      `json:"violenceRealisticProlongedGraphicOrSadistic,omitempty"`
+	AgeRatingOverride                           *AgeRatingOverride      `json:"ageRatingOverride,omitempty"`
+	AlcoholTobaccoOrDrugUseOrReferences         *AgeRatingContentLevel  `json:"alcoholTobaccoOrDrugUseOrReferences,omitempty"`
+	Contests                                    *AgeRatingContentLevel  `json:"contests,omitempty"`
+	Gambling                                    *bool                   `json:"gambling,omitempty"`
+	GamblingSimulated                           *AgeRatingContentLevel  `json:"gamblingSimulated,omitempty"`
+	HorrorOrFearThemes                          *AgeRatingContentLevel  `json:"horrorOrFearThemes,omitempty"`
+	KidsAgeBand                                 *KidsAgeBand            `json:"kidsAgeBand,omitempty"`
+	KoreaAgeRatingOverride                      *KoreaAgeRatingOverride `json:"koreaAgeRatingOverride,omitempty"`
+	LootBox                                     *bool                   `json:"lootBox,omitempty"`
+	MatureOrSuggestiveThemes                    *AgeRatingContentLevel  `json:"matureOrSuggestiveThemes,omitempty"`
+	MedicalOrTreatmentInformation               *AgeRatingContentLevel  `json:"medicalOrTreatmentInformation,omitempty"`
+	ProfanityOrCrudeHumor                       *AgeRatingContentLevel  `json:"profanityOrCrudeHumor,omitempty"`
+	SexualContentGraphicAndNudity               *AgeRatingContentLevel  `json:"sexualContentGraphicAndNudity,omitempty"`
+	SexualContentOrNudity                       *AgeRatingContentLevel  `json:"sexualContentOrNudity,omitempty"`
+	SeventeenPlus                               *bool                   `json:"seventeenPlus,omitempty"`
+	UnrestrictedWebAccess                       *bool                   `json:"unrestrictedWebAccess,omitempty"`
+	ViolenceCartoonOrFantasy                    *AgeRatingContentLevel  `json:"violenceCartoonOrFantasy,omitempty"`
+	ViolenceRealistic                           *AgeRatingContentLevel  `json:"violenceRealistic,omitempty"`
+	ViolenceRealisticProlongedGraphicOrSadistic *AgeRatingContentLevel  `json:"violenceRealisticProlongedGraphicOrSadistic,omitempty"`
 }
 
 // AppStoreVersion defines model for AppStoreVersion.
@@ -270,22 +365,14 @@ type GetAppStoreVersionQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_store_versions_for_an_app
 func (s *AppsService) ListAppStoreVersionsForApp(ctx context.Context, id string, params *ListAppStoreVersionsQuery) (*AppStoreVersionsResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/appStoreVersions", id)
-	res := new(AppStoreVersionsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppStoreVersionsResponse](ctx, s.client, fmt.Sprintf("apps/%s/appStoreVersions", id), params)
 }
 
 // GetAppStoreVersion gets information for a specific app store version.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_information
 func (s *AppsService) GetAppStoreVersion(ctx context.Context, id string, params *GetAppStoreVersionQuery) (*AppStoreVersionResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersions/%s", id)
-	res := new(AppStoreVersionResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppStoreVersionResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s", id), params)
 }
 
 // CreateAppStoreVersion adds a new App Store version or platform to an app.
@@ -346,11 +433,7 @@ func (s *AppsService) DeleteAppStoreVersion(ctx context.Context, id string) (*Re
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_the_build_id_for_an_app_store_version
 func (s *AppsService) GetBuildIDForAppStoreVersion(ctx context.Context, id string) (*AppStoreVersionBuildLinkageResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersions/%s/relationships/build", id)
-	res := new(AppStoreVersionBuildLinkageResponse)
-	resp, err := s.client.get(ctx, url, nil, res)
-
-	return res, resp, err
+	return Get[AppStoreVersionBuildLinkageResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s/relationships/build", id), nil)
 }
 
 // UpdateBuildForAppStoreVersion changes the build that is attached to a specific App Store version.
@@ -365,6 +448,58 @@ func (s *AppsService) UpdateBuildForAppStoreVersion(ctx context.Context, id stri
 	return res, resp, err
 }
 
+// SelectBuild attaches a build to an App Store version, replacing any build that was previously selected.
+func (s *AppsService) SelectBuild(ctx context.Context, versionID string, buildID string) (*AppStoreVersionBuildLinkageResponse, *Response, error) {
+	return s.UpdateBuildForAppStoreVersion(ctx, versionID, &buildID)
+}
+
+// SelectLatestBuild attaches the most recently uploaded, processed build of the version's app and platform
+// to an App Store version. ErrNoProcessedBuild is returned if no such build exists, and ErrMissingVersionApp
+// if the version's app cannot be determined.
+func (s *AppsService) SelectLatestBuild(ctx context.Context, versionID string) (*AppStoreVersionBuildLinkageResponse, *Response, error) {
+	version, resp, err := s.GetAppStoreVersion(ctx, versionID, &GetAppStoreVersionQuery{
+		Include: []string{"app"},
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	rels := version.Data.Relationships
+	if rels == nil || rels.App == nil || rels.App.Data == nil || rels.App.Data.ID == "" {
+		return nil, resp, fmt.Errorf("%w: %s", ErrMissingVersionApp, versionID)
+	}
+
+	appID := rels.App.Data.ID
+	query := &ListBuildsQuery{
+		FilterApp:             []string{appID},
+		FilterExpired:         []string{"false"},
+		FilterProcessingState: []string{"VALID"},
+		Include:               []string{"app"},
+		Sort:                  []string{"-uploadedDate"},
+		Limit:                 1,
+	}
+
+	if attrs := version.Data.Attributes; attrs != nil && attrs.Platform != nil {
+		query.FilterPreReleaseVersionPlatform = []string{string(*attrs.Platform)}
+	}
+
+	builds, resp, err := s.client.Builds.ListBuilds(ctx, query)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(builds.Data) == 0 {
+		return nil, resp, ErrNoProcessedBuild
+	}
+
+	build := builds.Data[0]
+	if build.Relationships == nil || build.Relationships.App == nil || build.Relationships.App.Data == nil || build.Relationships.App.Data.ID != appID {
+		return nil, resp, fmt.Errorf("%w: build %s, app %s", ErrBuildAppMismatch, build.ID, appID)
+	}
+
+	return s.SelectBuild(ctx, versionID, build.ID)
+}
+
 // UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppStoreVersionResponseIncluded.
 func (i *AppStoreVersionResponseIncluded) UnmarshalJSON(b []byte) error {
 	typeName, inner, err := unmarshalInclude(b)
@@ -22,7 +22,12 @@ package asc
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -115,3 +120,147 @@ func TestUpdateBuildForAppStoreVersion(t *testing.T) {
 		return client.Apps.UpdateBuildForAppStoreVersion(ctx, "10", String("10"))
 	})
 }
+
+func TestSelectBuild(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppStoreVersionBuildLinkageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.SelectBuild(ctx, "10", "11")
+	})
+}
+
+func TestSelectLatestBuild(t *testing.T) {
+	t.Parallel()
+
+	var buildsQuery url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/appStoreVersions/10":
+			fmt.Fprintln(w, `{"data":{"id":"10","type":"appStoreVersions","attributes":{"platform":"IOS"},"relationships":{"app":{"data":{"id":"1","type":"apps"}}}}}`)
+		case "/builds":
+			buildsQuery = r.URL.Query()
+			fmt.Fprintln(w, `{"data":[{"id":"12","type":"builds","relationships":{"app":{"data":{"id":"1","type":"apps"}}}}]}`)
+		case "/appStoreVersions/10/relationships/build":
+			fmt.Fprintln(w, `{"data":{"id":"12","type":"builds"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"errors":[{"status":"404"}]}`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	linkage, _, err := client.Apps.SelectLatestBuild(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Equal(t, "12", linkage.Data.ID)
+	assert.Equal(t, "1", buildsQuery.Get("filter[app]"))
+	assert.Equal(t, "IOS", buildsQuery.Get("filter[preReleaseVersion.platform]"))
+	assert.Equal(t, "VALID", buildsQuery.Get("filter[processingState]"))
+	assert.Equal(t, "-uploadedDate", buildsQuery.Get("sort"))
+	assert.Equal(t, "app", buildsQuery.Get("include"))
+}
+
+func TestSelectLatestBuildNoBuilds(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/builds" {
+			fmt.Fprintln(w, `{"data":[]}`)
+
+			return
+		}
+
+		fmt.Fprintln(w, `{"data":{"id":"10","type":"appStoreVersions","relationships":{"app":{"data":{"id":"1","type":"apps"}}}}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	linkage, _, err := client.Apps.SelectLatestBuild(context.Background(), "10")
+	assert.ErrorIs(t, err, ErrNoProcessedBuild)
+	assert.Nil(t, linkage)
+}
+
+func TestSelectLatestBuildMissingApp(t *testing.T) {
+	t.Parallel()
+
+	var paths []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		fmt.Fprintln(w, `{"data":{"id":"10","type":"appStoreVersions","attributes":{"platform":"IOS"}}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	linkage, _, err := client.Apps.SelectLatestBuild(context.Background(), "10")
+	assert.ErrorIs(t, err, ErrMissingVersionApp)
+	assert.Nil(t, linkage)
+	assert.Equal(t, []string{"/appStoreVersions/10"}, paths)
+}
+
+func TestSelectLatestBuildOtherApp(t *testing.T) {
+	t.Parallel()
+
+	var linked bool
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/appStoreVersions/10":
+			fmt.Fprintln(w, `{"data":{"id":"10","type":"appStoreVersions","relationships":{"app":{"data":{"id":"1","type":"apps"}}}}}`)
+		case "/builds":
+			fmt.Fprintln(w, `{"data":[{"id":"12","type":"builds","relationships":{"app":{"data":{"id":"2","type":"apps"}}}}]}`)
+		default:
+			linked = true
+
+			fmt.Fprintln(w, `{"data":{"id":"12","type":"builds"}}`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	linkage, _, err := client.Apps.SelectLatestBuild(context.Background(), "10")
+	assert.ErrorIs(t, err, ErrBuildAppMismatch)
+	assert.Nil(t, linkage)
+	assert.False(t, linked)
+}
+
+func TestAppStoreVersionUpdateRequestAttributesBuilder(t *testing.T) {
+	t.Parallel()
+
+	got := new(AppStoreVersionUpdateRequestAttributes).
+		WithCopyright("TEST").
+		WithDownloadable(true).
+		WithEarliestReleaseDate(DateTime{time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)}).
+		WithReleaseType("TEST").
+		WithUsesIDFA(true).
+		WithVersionString("TEST")
+
+	assert.Equal(t, &AppStoreVersionUpdateRequestAttributes{
+		Copyright:           String("TEST"),
+		Downloadable:        Bool(true),
+		EarliestReleaseDate: &DateTime{time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)},
+		ReleaseType:         String("TEST"),
+		UsesIDFA:            Bool(true),
+		VersionString:       String("TEST"),
+	}, got)
+}
+
+func TestParseAppStoreVersionState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppStoreVersionState(string(AppStoreVersionStateDeveloperRejected))
+	assert.NoError(t, err)
+	assert.Equal(t, AppStoreVersionStateDeveloperRejected, got)
+
+	_, err = ParseAppStoreVersionState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppStoreVersionState", Value: "UNKNOWN"}, err)
+}
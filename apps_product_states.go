@@ -0,0 +1,170 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+// ProductAction is something the developer can do with a subscription or in-app purchase in a given state.
+type ProductAction string
+
+const (
+	// ProductActionCompleteMetadata adds the localizations, prices and review screenshot the product is missing.
+	ProductActionCompleteMetadata ProductAction = "COMPLETE_METADATA"
+	// ProductActionSubmit submits the product for review.
+	ProductActionSubmit ProductAction = "SUBMIT"
+	// ProductActionEdit changes the metadata of the product.
+	ProductActionEdit ProductAction = "EDIT"
+	// ProductActionWaitForReview waits for App Review to finish reviewing the product.
+	ProductActionWaitForReview ProductAction = "WAIT_FOR_REVIEW"
+	// ProductActionSubmitAppVersion submits an app version, which the product is reviewed and released along with.
+	ProductActionSubmitAppVersion ProductAction = "SUBMIT_APP_VERSION"
+	// ProductActionRemoveFromSale removes the product from sale.
+	ProductActionRemoveFromSale ProductAction = "REMOVE_FROM_SALE"
+	// ProductActionRestoreToSale makes a product the developer removed from sale available again.
+	ProductActionRestoreToSale ProductAction = "RESTORE_TO_SALE"
+	// ProductActionContactApple asks Apple why the product was removed from sale.
+	ProductActionContactApple ProductAction = "CONTACT_APPLE"
+)
+
+var productActions = []ProductAction{
+	ProductActionCompleteMetadata,
+	ProductActionSubmit,
+	ProductActionEdit,
+	ProductActionWaitForReview,
+	ProductActionSubmitAppVersion,
+	ProductActionRemoveFromSale,
+	ProductActionRestoreToSale,
+	ProductActionContactApple,
+}
+
+// IsValid reports whether the product action is one this package knows about.
+func (v ProductAction) IsValid() bool {
+	for _, value := range productActions {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseProductAction returns s as a ProductAction, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseProductAction(s string) (ProductAction, error) {
+	if v := ProductAction(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "ProductAction", Value: s}
+}
+
+var subscriptionStateTransitions = map[SubscriptionState][]SubscriptionState{
+	SubscriptionStateMissingMetadata: {
+		SubscriptionStateReadyToSubmit,
+	},
+	SubscriptionStateReadyToSubmit: {
+		SubscriptionStateMissingMetadata,
+		SubscriptionStateWaitingForReview,
+	},
+	SubscriptionStateWaitingForReview: {
+		SubscriptionStateReadyToSubmit,
+		SubscriptionStateInReview,
+	},
+	SubscriptionStateInReview: {
+		SubscriptionStateApproved,
+		SubscriptionStatePendingBinaryApproval,
+		SubscriptionStateDeveloperActionNeeded,
+		SubscriptionStateRejected,
+	},
+	SubscriptionStatePendingBinaryApproval: {
+		SubscriptionStateApproved,
+		SubscriptionStateRejected,
+	},
+	SubscriptionStateDeveloperActionNeeded: {
+		SubscriptionStateMissingMetadata,
+		SubscriptionStateReadyToSubmit,
+		SubscriptionStateWaitingForReview,
+	},
+	SubscriptionStateRejected: {
+		SubscriptionStateMissingMetadata,
+		SubscriptionStateReadyToSubmit,
+		SubscriptionStateWaitingForReview,
+	},
+	SubscriptionStateApproved: {
+		SubscriptionStateWaitingForReview,
+		SubscriptionStateDeveloperRemovedFromSale,
+		SubscriptionStateRemovedFromSale,
+	},
+	SubscriptionStateDeveloperRemovedFromSale: {
+		SubscriptionStateApproved,
+		SubscriptionStateReadyToSubmit,
+	},
+	SubscriptionStateRemovedFromSale: {},
+}
+
+var subscriptionStateActions = map[SubscriptionState][]ProductAction{
+	SubscriptionStateMissingMetadata:          {ProductActionCompleteMetadata},
+	SubscriptionStateReadyToSubmit:            {ProductActionEdit, ProductActionSubmit},
+	SubscriptionStateWaitingForReview:         {ProductActionWaitForReview},
+	SubscriptionStateInReview:                 {ProductActionWaitForReview},
+	SubscriptionStatePendingBinaryApproval:    {ProductActionSubmitAppVersion},
+	SubscriptionStateDeveloperActionNeeded:    {ProductActionEdit, ProductActionSubmit},
+	SubscriptionStateRejected:                 {ProductActionEdit, ProductActionSubmit},
+	SubscriptionStateApproved:                 {ProductActionEdit, ProductActionRemoveFromSale},
+	SubscriptionStateDeveloperRemovedFromSale: {ProductActionRestoreToSale},
+	SubscriptionStateRemovedFromSale:          {ProductActionContactApple},
+}
+
+// IsValid reports whether the state is one App Store Connect documents.
+func (s SubscriptionState) IsValid() bool {
+	_, ok := subscriptionStateTransitions[s]
+
+	return ok
+}
+
+// CanSubmit reports whether a product in this state can be submitted for review.
+func (s SubscriptionState) CanSubmit() bool {
+	switch s {
+	case SubscriptionStateReadyToSubmit, SubscriptionStateDeveloperActionNeeded, SubscriptionStateRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsLive reports whether a product in this state is available for sale.
+func (s SubscriptionState) IsLive() bool {
+	return s == SubscriptionStateApproved
+}
+
+// CanTransitionTo reports whether App Store Connect moves a product from this state to next, either on its own or
+// in response to an action of the developer or App Review.
+func (s SubscriptionState) CanTransitionTo(next SubscriptionState) bool {
+	for _, candidate := range subscriptionStateTransitions[s] {
+		if candidate == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NextActions lists what the developer can do with a product in this state, in the order they are usually done.
+func (s SubscriptionState) NextActions() []ProductAction {
+	return append([]ProductAction(nil), subscriptionStateActions[s]...)
+}
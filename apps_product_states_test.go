@@ -0,0 +1,87 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptionStateIsValid(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, SubscriptionStateApproved.IsValid())
+	assert.True(t, SubscriptionStateRemovedFromSale.IsValid())
+	assert.False(t, SubscriptionState("PROCESSING").IsValid())
+}
+
+func TestSubscriptionStateCanSubmit(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, SubscriptionStateReadyToSubmit.CanSubmit())
+	assert.True(t, SubscriptionStateRejected.CanSubmit())
+	assert.False(t, SubscriptionStateMissingMetadata.CanSubmit())
+	assert.False(t, SubscriptionStateWaitingForReview.CanSubmit())
+	assert.False(t, SubscriptionStateApproved.CanSubmit())
+}
+
+func TestSubscriptionStateIsLive(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, SubscriptionStateApproved.IsLive())
+	assert.False(t, SubscriptionStateDeveloperRemovedFromSale.IsLive())
+}
+
+func TestSubscriptionStateCanTransitionTo(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, SubscriptionStateMissingMetadata.CanTransitionTo(SubscriptionStateReadyToSubmit))
+	assert.True(t, SubscriptionStateReadyToSubmit.CanTransitionTo(SubscriptionStateWaitingForReview))
+	assert.True(t, SubscriptionStateWaitingForReview.CanTransitionTo(SubscriptionStateInReview))
+	assert.True(t, SubscriptionStateInReview.CanTransitionTo(SubscriptionStateApproved))
+	assert.False(t, SubscriptionStateMissingMetadata.CanTransitionTo(SubscriptionStateApproved))
+	assert.False(t, SubscriptionStateRemovedFromSale.CanTransitionTo(SubscriptionStateApproved))
+	assert.False(t, SubscriptionState("PROCESSING").CanTransitionTo(SubscriptionStateApproved))
+}
+
+func TestSubscriptionStateNextActions(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []ProductAction{ProductActionCompleteMetadata}, SubscriptionStateMissingMetadata.NextActions())
+	assert.Equal(t, []ProductAction{ProductActionEdit, ProductActionSubmit}, SubscriptionStateReadyToSubmit.NextActions())
+	assert.Empty(t, SubscriptionState("PROCESSING").NextActions())
+
+	actions := SubscriptionStateApproved.NextActions()
+	actions[0] = ProductActionSubmit
+	assert.Equal(t, ProductActionEdit, SubscriptionStateApproved.NextActions()[0])
+}
+
+func TestParseProductAction(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseProductAction(string(ProductActionCompleteMetadata))
+	assert.NoError(t, err)
+	assert.Equal(t, ProductActionCompleteMetadata, got)
+
+	_, err = ParseProductAction("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "ProductAction", Value: "UNKNOWN"}, err)
+}
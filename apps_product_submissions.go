@@ -0,0 +1,247 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// ErrProductNotReady happens when a subscription or in-app purchase is not in a state that can be submitted for
+// review, or is missing metadata that review requires.
+type ErrProductNotReady struct {
+	ID      string
+	State   SubscriptionState
+	Missing []string
+}
+
+func (e ErrProductNotReady) Error() string {
+	if len(e.Missing) > 0 {
+		return fmt.Sprintf("product %s is missing %s", e.ID, strings.Join(e.Missing, ", "))
+	}
+
+	return fmt.Sprintf("product %s cannot be submitted in state %s", e.ID, e.State)
+}
+
+// SubscriptionSubmission defines model for SubscriptionSubmission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsubmission
+type SubscriptionSubmission struct {
+	ID            string                               `json:"id"`
+	Links         ResourceLinks                        `json:"links"`
+	Relationships *SubscriptionSubmissionRelationships `json:"relationships,omitempty"`
+	Type          string                               `json:"type"`
+}
+
+// SubscriptionSubmissionRelationships defines model for SubscriptionSubmission.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsubmission/relationships
+type SubscriptionSubmissionRelationships struct {
+	Subscription *Relationship `json:"subscription,omitempty"`
+}
+
+// SubscriptionSubmissionResponse defines model for SubscriptionSubmissionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsubmissionresponse
+type SubscriptionSubmissionResponse struct {
+	Data  SubscriptionSubmission `json:"data"`
+	Links DocumentLinks          `json:"links"`
+}
+
+// subscriptionSubmissionCreateRequest defines model for SubscriptionSubmissionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsubmissioncreaterequest/data
+type subscriptionSubmissionCreateRequest struct {
+	Relationships subscriptionSubmissionCreateRequestRelationships `json:"relationships"`
+	Type          string                                           `json:"type"`
+}
+
+// subscriptionSubmissionCreateRequestRelationships are relationships for SubscriptionSubmissionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsubmissioncreaterequest/data/relationships
+type subscriptionSubmissionCreateRequestRelationships struct {
+	Subscription relationshipDeclaration `json:"subscription"`
+}
+
+// InAppPurchaseSubmission defines model for InAppPurchaseSubmission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesubmission
+type InAppPurchaseSubmission struct {
+	ID            string                                `json:"id"`
+	Links         ResourceLinks                         `json:"links"`
+	Relationships *InAppPurchaseSubmissionRelationships `json:"relationships,omitempty"`
+	Type          string                                `json:"type"`
+}
+
+// InAppPurchaseSubmissionRelationships defines model for InAppPurchaseSubmission.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesubmission/relationships
+type InAppPurchaseSubmissionRelationships struct {
+	InAppPurchaseV2 *Relationship `json:"inAppPurchaseV2,omitempty"`
+}
+
+// InAppPurchaseSubmissionResponse defines model for InAppPurchaseSubmissionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesubmissionresponse
+type InAppPurchaseSubmissionResponse struct {
+	Data  InAppPurchaseSubmission `json:"data"`
+	Links DocumentLinks           `json:"links"`
+}
+
+// inAppPurchaseSubmissionCreateRequest defines model for InAppPurchaseSubmissionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesubmissioncreaterequest/data
+type inAppPurchaseSubmissionCreateRequest struct {
+	Relationships inAppPurchaseSubmissionCreateRequestRelationships `json:"relationships"`
+	Type          string                                            `json:"type"`
+}
+
+// inAppPurchaseSubmissionCreateRequestRelationships are relationships for InAppPurchaseSubmissionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/inapppurchasesubmissioncreaterequest/data/relationships
+type inAppPurchaseSubmissionCreateRequestRelationships struct {
+	InAppPurchaseV2 relationshipDeclaration `json:"inAppPurchaseV2"`
+}
+
+// CreateSubscriptionSubmission submits an auto-renewable subscription for review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_subscriptionsubmissions
+func (s *AppsService) CreateSubscriptionSubmission(ctx context.Context, subscriptionID string) (*SubscriptionSubmissionResponse, *Response, error) {
+	req := subscriptionSubmissionCreateRequest{
+		Relationships: subscriptionSubmissionCreateRequestRelationships{
+			Subscription: *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+		},
+		Type: "subscriptionSubmissions",
+	}
+	res := new(SubscriptionSubmissionResponse)
+	resp, err := s.client.post(ctx, "subscriptionSubmissions", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CreateInAppPurchaseSubmission submits an in-app purchase for review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_inapppurchasesubmissions
+func (s *AppsService) CreateInAppPurchaseSubmission(ctx context.Context, inAppPurchaseID string) (*InAppPurchaseSubmissionResponse, *Response, error) {
+	req := inAppPurchaseSubmissionCreateRequest{
+		Relationships: inAppPurchaseSubmissionCreateRequestRelationships{
+			InAppPurchaseV2: *newRelationshipDeclaration(&inAppPurchaseID, "inAppPurchases"),
+		},
+		Type: "inAppPurchaseSubmissions",
+	}
+	res := new(InAppPurchaseSubmissionResponse)
+	resp, err := s.client.post(ctx, "inAppPurchaseSubmissions", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// SubmitSubscriptionWhenReady checks that a subscription has a name, product ID, period and at least one price,
+// and that its state allows submission, before submitting it for review. If it is not ready, an
+// ErrProductNotReady describing what is missing is returned and nothing is submitted.
+func (s *AppsService) SubmitSubscriptionWhenReady(ctx context.Context, subscriptionID string) (*SubscriptionSubmissionResponse, *Response, error) {
+	sub, resp, err := s.GetSubscription(ctx, subscriptionID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	notReady := ErrProductNotReady{ID: subscriptionID}
+
+	if attrs := sub.Data.Attributes; attrs != nil {
+		if attrs.State != nil {
+			notReady.State = *attrs.State
+		}
+
+		notReady.Missing = missingProductMetadata(attrs.Name, attrs.ProductID)
+		if attrs.SubscriptionPeriod == nil {
+			notReady.Missing = append(notReady.Missing, "subscription period")
+		}
+	} else {
+		notReady.Missing = missingProductMetadata(nil, nil)
+		notReady.Missing = append(notReady.Missing, "subscription period")
+	}
+
+	prices, resp, err := s.ListPricesForSubscription(ctx, subscriptionID, &ListPricesForSubscriptionQuery{Limit: 1})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(prices.Data) == 0 {
+		notReady.Missing = append(notReady.Missing, "prices")
+	}
+
+	if len(notReady.Missing) > 0 || !notReady.State.CanSubmit() {
+		return nil, resp, notReady
+	}
+
+	return s.CreateSubscriptionSubmission(ctx, subscriptionID)
+}
+
+// SubmitInAppPurchaseWhenReady checks that an in-app purchase has a name, product ID and at least one price, and
+// that its state allows submission, before submitting it for review. If it is not ready, an ErrProductNotReady
+// describing what is missing is returned and nothing is submitted.
+func (s *AppsService) SubmitInAppPurchaseWhenReady(ctx context.Context, inAppPurchaseID string) (*InAppPurchaseSubmissionResponse, *Response, error) {
+	iap, resp, err := s.GetInAppPurchaseV2(ctx, inAppPurchaseID, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	notReady := ErrProductNotReady{ID: inAppPurchaseID}
+
+	if attrs := iap.Data.Attributes; attrs != nil {
+		if attrs.State != nil {
+			notReady.State = *attrs.State
+		}
+
+		notReady.Missing = missingProductMetadata(attrs.Name, attrs.ProductID)
+	} else {
+		notReady.Missing = missingProductMetadata(nil, nil)
+	}
+
+	prices, resp, err := s.ListManualPricesForInAppPurchase(ctx, inAppPurchaseID, &ListManualPricesForInAppPurchaseQuery{Limit: 1})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(prices.Data) == 0 {
+		notReady.Missing = append(notReady.Missing, "prices")
+	}
+
+	if len(notReady.Missing) > 0 || !notReady.State.CanSubmit() {
+		return nil, resp, notReady
+	}
+
+	return s.CreateInAppPurchaseSubmission(ctx, inAppPurchaseID)
+}
+
+func missingProductMetadata(name *string, productID *string) []string {
+	var missing []string
+
+	if name == nil || *name == "" {
+		missing = append(missing, "name")
+	}
+
+	if productID == nil || *productID == "" {
+		missing = append(missing, "product ID")
+	}
+
+	return missing
+}
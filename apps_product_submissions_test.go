@@ -0,0 +1,104 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSubscriptionSubmission(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionSubmissionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateSubscriptionSubmission(ctx, "10")
+	})
+}
+
+func TestCreateInAppPurchaseSubmission(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &InAppPurchaseSubmissionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateInAppPurchaseSubmission(ctx, "10")
+	})
+}
+
+func TestSubmitSubscriptionWhenReady(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /subscriptions/10":         `{"data":{"type":"subscriptions","id":"10","attributes":{"name":"Monthly","productId":"com.app.monthly","subscriptionPeriod":"ONE_MONTH","state":"READY_TO_SUBMIT"}}}`,
+		"GET /subscriptions/10/prices":  `{"data":[{"type":"subscriptionPrices","id":"1"}]}`,
+		"POST /subscriptionSubmissions": `{"data":{"type":"subscriptionSubmissions","id":"20"}}`,
+		"GET /subscriptions/11":         `{"data":{"type":"subscriptions","id":"11","attributes":{"productId":"com.app.yearly","state":"MISSING_METADATA"}}}`,
+		"GET /subscriptions/11/prices":  `{"data":[]}`,
+		"GET /subscriptions/12":         `{"data":{"type":"subscriptions","id":"12","attributes":{"name":"Weekly","productId":"com.app.weekly","subscriptionPeriod":"ONE_WEEK","state":"WAITING_FOR_REVIEW"}}}`,
+		"GET /subscriptions/12/prices":  `{"data":[{"type":"subscriptionPrices","id":"2"}]}`,
+	})
+	defer server.Close()
+
+	submission, _, err := client.Apps.SubmitSubscriptionWhenReady(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Equal(t, "20", submission.Data.ID)
+
+	_, _, err = client.Apps.SubmitSubscriptionWhenReady(context.Background(), "11")
+	assert.Equal(t, ErrProductNotReady{ID: "11", State: SubscriptionStateMissingMetadata, Missing: []string{"name", "subscription period", "prices"}}, err)
+	assert.EqualError(t, err, "product 11 is missing name, subscription period, prices")
+
+	_, _, err = client.Apps.SubmitSubscriptionWhenReady(context.Background(), "12")
+	assert.Equal(t, ErrProductNotReady{ID: "12", State: SubscriptionStateWaitingForReview}, err)
+	assert.EqualError(t, err, "product 12 cannot be submitted in state WAITING_FOR_REVIEW")
+
+	assert.Equal(t, []string{
+		"GET /subscriptions/10",
+		"GET /subscriptions/10/prices",
+		"POST /subscriptionSubmissions",
+		"GET /subscriptions/11",
+		"GET /subscriptions/11/prices",
+		"GET /subscriptions/12",
+		"GET /subscriptions/12/prices",
+	}, *requests)
+}
+
+func TestSubmitInAppPurchaseWhenReady(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /v2/inAppPurchases/10":                        `{"data":{"type":"inAppPurchases","id":"10","attributes":{"name":"Coins","productId":"com.app.coins","state":"READY_TO_SUBMIT"}}}`,
+		"GET /inAppPurchasePriceSchedules/10/manualPrices": `{"data":[{"type":"inAppPurchasePrices","id":"1"}]}`,
+		"POST /inAppPurchaseSubmissions":                   `{"data":{"type":"inAppPurchaseSubmissions","id":"20"}}`,
+		"GET /v2/inAppPurchases/11":                        `{"data":{"type":"inAppPurchases","id":"11"}}`,
+		"GET /inAppPurchasePriceSchedules/11/manualPrices": `{"data":[{"type":"inAppPurchasePrices","id":"2"}]}`,
+	})
+	defer server.Close()
+
+	submission, _, err := client.Apps.SubmitInAppPurchaseWhenReady(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Equal(t, "20", submission.Data.ID)
+
+	_, _, err = client.Apps.SubmitInAppPurchaseWhenReady(context.Background(), "11")
+	assert.Equal(t, ErrProductNotReady{ID: "11", Missing: []string{"name", "product ID"}}, err)
+
+	_, _, err = client.Apps.SubmitInAppPurchaseWhenReady(context.Background(), "12")
+	assert.Error(t, err)
+}
@@ -0,0 +1,354 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrPromotedPurchaseNotFound happens when a promoted purchase being moved is not among the app's promoted purchases.
+var ErrPromotedPurchaseNotFound = errors.New("promoted purchase not found for app")
+
+// PromotedPurchaseState defines model for PromotedPurchase.Attributes.State
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/promotedpurchase/attributes
+type PromotedPurchaseState string
+
+const (
+	// PromotedPurchaseStateApproved is a promoted purchase state for Approved.
+	PromotedPurchaseStateApproved PromotedPurchaseState = "APPROVED"
+	// PromotedPurchaseStateInReview is a promoted purchase state for InReview.
+	PromotedPurchaseStateInReview PromotedPurchaseState = "IN_REVIEW"
+	// PromotedPurchaseStatePrepareForSubmission is a promoted purchase state for PrepareForSubmission.
+	PromotedPurchaseStatePrepareForSubmission PromotedPurchaseState = "PREPARE_FOR_SUBMISSION"
+	// PromotedPurchaseStateRejected is a promoted purchase state for Rejected.
+	PromotedPurchaseStateRejected PromotedPurchaseState = "REJECTED"
+)
+
+var promotedPurchaseStates = []PromotedPurchaseState{
+	PromotedPurchaseStateApproved,
+	PromotedPurchaseStateInReview,
+	PromotedPurchaseStatePrepareForSubmission,
+	PromotedPurchaseStateRejected,
+}
+
+// IsValid reports whether the promoted purchase state is one this package knows about.
+func (v PromotedPurchaseState) IsValid() bool {
+	for _, value := range promotedPurchaseStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParsePromotedPurchaseState returns s as a PromotedPurchaseState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParsePromotedPurchaseState(s string) (PromotedPurchaseState, error) {
+	if v := PromotedPurchaseState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "PromotedPurchaseState", Value: s}
+}
+
+// PromotedPurchase defines model for PromotedPurchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/promotedpurchase
+type PromotedPurchase struct {
+	Attributes    *PromotedPurchaseAttributes    `json:"attributes,omitempty"`
+	ID            string                         `json:"id"`
+	Links         ResourceLinks                  `json:"links"`
+	Relationships *PromotedPurchaseRelationships `json:"relationships,omitempty"`
+	Type          string                         `json:"type"`
+}
+
+// PromotedPurchaseAttributes defines model for PromotedPurchase.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/promotedpurchase/attributes
+type PromotedPurchaseAttributes struct {
+	Enabled            *bool                  `json:"enabled,omitempty"`
+	State              *PromotedPurchaseState `json:"state,omitempty"`
+	VisibleForAllUsers *bool                  `json:"visibleForAllUsers,omitempty"`
+}
+
+// PromotedPurchaseRelationships defines model for PromotedPurchase.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/promotedpurchase/relationships
+type PromotedPurchaseRelationships struct {
+	InAppPurchaseV2 *Relationship `json:"inAppPurchaseV2,omitempty"`
+	Subscription    *Relationship `json:"subscription,omitempty"`
+}
+
+// PromotedPurchaseResponse defines model for PromotedPurchaseResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/promotedpurchaseresponse
+type PromotedPurchaseResponse struct {
+	Data     PromotedPurchase                   `json:"data"`
+	Included []PromotedPurchaseResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                      `json:"links"`
+}
+
+// PromotedPurchasesResponse defines model for PromotedPurchasesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/promotedpurchasesresponse
+type PromotedPurchasesResponse struct {
+	Data     []PromotedPurchase                 `json:"data"`
+	Included []PromotedPurchaseResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                 `json:"links"`
+	Meta     *PagingInformation                 `json:"meta,omitempty"`
+}
+
+// PromotedPurchaseResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a PromotedPurchaseResponse or PromotedPurchasesResponse.
+type PromotedPurchaseResponseIncluded included
+
+// AppPromotedPurchasesLinkagesResponse defines model for AppPromotedPurchasesLinkagesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppromotedpurchaseslinkagesresponse
+type AppPromotedPurchasesLinkagesResponse struct {
+	Data  []RelationshipData `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// promotedPurchaseCreateRequest defines model for PromotedPurchaseCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/promotedpurchasecreaterequest/data
+type promotedPurchaseCreateRequest struct {
+	Attributes    promotedPurchaseCreateRequestAttributes    `json:"attributes"`
+	Relationships promotedPurchaseCreateRequestRelationships `json:"relationships"`
+	Type          string                                     `json:"type"`
+}
+
+// promotedPurchaseCreateRequestAttributes are attributes for PromotedPurchaseCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/promotedpurchasecreaterequest/data/attributes
+type promotedPurchaseCreateRequestAttributes struct {
+	Enabled            *bool `json:"enabled,omitempty"`
+	VisibleForAllUsers bool  `json:"visibleForAllUsers"`
+}
+
+// promotedPurchaseCreateRequestRelationships are relationships for PromotedPurchaseCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/promotedpurchasecreaterequest/data/relationships
+type promotedPurchaseCreateRequestRelationships struct {
+	App             relationshipDeclaration  `json:"app"`
+	InAppPurchaseV2 *relationshipDeclaration `json:"inAppPurchaseV2,omitempty"`
+	Subscription    *relationshipDeclaration `json:"subscription,omitempty"`
+}
+
+// promotedPurchaseUpdateRequest defines model for PromotedPurchaseUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/promotedpurchaseupdaterequest/data
+type promotedPurchaseUpdateRequest struct {
+	Attributes *PromotedPurchaseUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                   `json:"id"`
+	Type       string                                   `json:"type"`
+}
+
+// PromotedPurchaseUpdateRequestAttributes are attributes for PromotedPurchaseUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/promotedpurchaseupdaterequest/data/attributes
+type PromotedPurchaseUpdateRequestAttributes struct {
+	Enabled            *bool `json:"enabled,omitempty"`
+	VisibleForAllUsers *bool `json:"visibleForAllUsers,omitempty"`
+}
+
+// ListPromotedPurchasesForAppQuery are query options for ListPromotedPurchasesForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_promotedpurchases
+type ListPromotedPurchasesForAppQuery struct {
+	FieldsPromotedPurchases []string `url:"fields[promotedPurchases],omitempty"`
+	FieldsSubscriptions     []string `url:"fields[subscriptions],omitempty"`
+	Include                 []string `url:"include,omitempty"`
+	Limit                   int      `url:"limit,omitempty"`
+	Cursor                  string   `url:"cursor,omitempty"`
+}
+
+// GetPromotedPurchaseQuery are query options for GetPromotedPurchase
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_promotedpurchases_id
+type GetPromotedPurchaseQuery struct {
+	FieldsPromotedPurchases []string `url:"fields[promotedPurchases],omitempty"`
+	FieldsSubscriptions     []string `url:"fields[subscriptions],omitempty"`
+	Include                 []string `url:"include,omitempty"`
+}
+
+// ListPromotedPurchaseIDsForAppQuery are query options for ListPromotedPurchaseIDsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_relationships_promotedpurchases
+type ListPromotedPurchaseIDsForAppQuery struct {
+	Limit  int    `url:"limit,omitempty"`
+	Cursor string `url:"cursor,omitempty"`
+}
+
+// ListPromotedPurchasesForApp lists the in-app purchases and subscriptions an app promotes on the App Store, in the
+// order they are shown.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_promotedpurchases
+func (s *AppsService) ListPromotedPurchasesForApp(ctx context.Context, id string, params *ListPromotedPurchasesForAppQuery) (*PromotedPurchasesResponse, *Response, error) {
+	return Get[PromotedPurchasesResponse](ctx, s.client, fmt.Sprintf("apps/%s/promotedPurchases", id), params)
+}
+
+// GetPromotedPurchase reads the information about a promoted purchase.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_promotedpurchases_id
+func (s *AppsService) GetPromotedPurchase(ctx context.Context, id string, params *GetPromotedPurchaseQuery) (*PromotedPurchaseResponse, *Response, error) {
+	return Get[PromotedPurchaseResponse](ctx, s.client, fmt.Sprintf("promotedPurchases/%s", id), params)
+}
+
+// CreatePromotedPurchase promotes an in-app purchase or a subscription of an app on the App Store. Exactly one of
+// inAppPurchaseID and subscriptionID should be set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_promotedpurchases
+func (s *AppsService) CreatePromotedPurchase(ctx context.Context, visibleForAllUsers bool, enabled *bool, appID string, inAppPurchaseID *string, subscriptionID *string) (*PromotedPurchaseResponse, *Response, error) {
+	req := promotedPurchaseCreateRequest{
+		Attributes: promotedPurchaseCreateRequestAttributes{
+			Enabled:            enabled,
+			VisibleForAllUsers: visibleForAllUsers,
+		},
+		Relationships: promotedPurchaseCreateRequestRelationships{
+			App:             *newRelationshipDeclaration(&appID, "apps"),
+			InAppPurchaseV2: newRelationshipDeclaration(inAppPurchaseID, "inAppPurchases"),
+			Subscription:    newRelationshipDeclaration(subscriptionID, "subscriptions"),
+		},
+		Type: "promotedPurchases",
+	}
+	res := new(PromotedPurchaseResponse)
+	resp, err := s.client.post(ctx, "promotedPurchases", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdatePromotedPurchase changes whether a promoted purchase is enabled, or visible to all users.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_promotedpurchases_id
+func (s *AppsService) UpdatePromotedPurchase(ctx context.Context, id string, attributes *PromotedPurchaseUpdateRequestAttributes) (*PromotedPurchaseResponse, *Response, error) {
+	req := promotedPurchaseUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "promotedPurchases",
+	}
+	url := fmt.Sprintf("promotedPurchases/%s", id)
+	res := new(PromotedPurchaseResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeletePromotedPurchase stops promoting an in-app purchase or subscription on the App Store.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_v1_promotedpurchases_id
+func (s *AppsService) DeletePromotedPurchase(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("promotedPurchases/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListPromotedPurchaseIDsForApp gets the ordered promoted purchase IDs of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_relationships_promotedpurchases
+func (s *AppsService) ListPromotedPurchaseIDsForApp(ctx context.Context, id string, params *ListPromotedPurchaseIDsForAppQuery) (*AppPromotedPurchasesLinkagesResponse, *Response, error) {
+	return Get[AppPromotedPurchasesLinkagesResponse](ctx, s.client, fmt.Sprintf("apps/%s/relationships/promotedPurchases", id), params)
+}
+
+// ReplacePromotedPurchasesForApp changes the order the promoted purchases of an app are shown in on the App Store.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_apps_id_relationships_promotedpurchases
+func (s *AppsService) ReplacePromotedPurchasesForApp(ctx context.Context, id string, promotedPurchaseIDs []string) (*Response, error) {
+	linkages := newPagedRelationshipDeclaration(promotedPurchaseIDs, "promotedPurchases")
+	url := fmt.Sprintf("apps/%s/relationships/promotedPurchases", id)
+
+	return s.client.patch(ctx, url, newRequestBody(linkages.Data), nil)
+}
+
+// MovePromotedPurchase moves one of an app's promoted purchases to the given position, keeping the others in their
+// current relative order. Positions past the end move the promoted purchase to the end.
+func (s *AppsService) MovePromotedPurchase(ctx context.Context, appID string, promotedPurchaseID string, position int) (*Response, error) {
+	params := &ListPromotedPurchaseIDsForAppQuery{Limit: MaxPageLimit}
+	ids := make([]string, 0)
+	found := false
+
+	var resp *Response
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		linkages, res, err := s.ListPromotedPurchaseIDsForApp(ctx, appID, params)
+		resp = res
+		if err != nil {
+			return nil, err
+		}
+
+		for _, linkage := range linkages.Data {
+			if linkage.ID == promotedPurchaseID {
+				found = true
+
+				continue
+			}
+
+			ids = append(ids, linkage.ID)
+		}
+
+		return &linkages.Links, nil
+	})
+	if err != nil {
+		return resp, err
+	}
+
+	if !found {
+		return resp, fmt.Errorf("%w: %s", ErrPromotedPurchaseNotFound, promotedPurchaseID)
+	}
+
+	if position < 0 {
+		position = 0
+	}
+
+	if position > len(ids) {
+		position = len(ids)
+	}
+
+	ids = append(ids[:position], append([]string{promotedPurchaseID}, ids[position:]...)...)
+
+	return s.ReplacePromotedPurchasesForApp(ctx, appID, ids)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in PromotedPurchaseResponseIncluded.
+func (i *PromotedPurchaseResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// Subscription returns the Subscription stored within, if one is present.
+func (i *PromotedPurchaseResponseIncluded) Subscription() *Subscription {
+	return extractIncludedSubscription(i.inner)
+}
+
+// InAppPurchase returns the InAppPurchase stored within, if one is present.
+func (i *PromotedPurchaseResponseIncluded) InAppPurchase() *InAppPurchase {
+	return extractIncludedInAppPurchase(i.inner)
+}
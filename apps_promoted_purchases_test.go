@@ -0,0 +1,195 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPromotedPurchasesForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &PromotedPurchasesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListPromotedPurchasesForApp(ctx, "10", &ListPromotedPurchasesForAppQuery{})
+	})
+}
+
+func TestGetPromotedPurchase(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &PromotedPurchaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetPromotedPurchase(ctx, "10", &GetPromotedPurchaseQuery{})
+	})
+}
+
+func TestGetPromotedPurchaseIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"subscriptions"},{"type":"inAppPurchases"}]}`, func(ctx context.Context, client *Client) {
+		promoted, _, err := client.Apps.GetPromotedPurchase(ctx, "10", &GetPromotedPurchaseQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, promoted.Included)
+
+		assert.NotNil(t, promoted.Included[0].Subscription())
+		assert.NotNil(t, promoted.Included[1].InAppPurchase())
+
+		assert.Nil(t, promoted.Included[0].InAppPurchase())
+		assert.Nil(t, promoted.Included[1].Subscription())
+	})
+}
+
+func TestCreatePromotedPurchase(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &PromotedPurchaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreatePromotedPurchase(ctx, true, Bool(true), "10", nil, String("20"))
+	})
+}
+
+func TestUpdatePromotedPurchase(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &PromotedPurchaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdatePromotedPurchase(ctx, "10", &PromotedPurchaseUpdateRequestAttributes{Enabled: Bool(false)})
+	})
+}
+
+func TestDeletePromotedPurchase(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeletePromotedPurchase(ctx, "10")
+	})
+}
+
+func TestListPromotedPurchaseIDsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPromotedPurchasesLinkagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListPromotedPurchaseIDsForApp(ctx, "10", &ListPromotedPurchaseIDsForAppQuery{})
+	})
+}
+
+func TestReplacePromotedPurchasesForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.ReplacePromotedPurchasesForApp(ctx, "10", []string{"20", "30"})
+	})
+}
+
+func TestMovePromotedPurchase(t *testing.T) {
+	t.Parallel()
+
+	var replaced []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			var body struct {
+				Data []RelationshipData `json:"data"`
+			}
+
+			_ = json.NewDecoder(r.Body).Decode(&body)
+
+			for _, linkage := range body.Data {
+				replaced = append(replaced, linkage.ID)
+			}
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":[{"type":"promotedPurchases","id":"1"},{"type":"promotedPurchases","id":"2"},{"type":"promotedPurchases","id":"3"}]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	_, err := client.Apps.MovePromotedPurchase(context.Background(), "10", "3", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"3", "1", "2"}, replaced)
+
+	replaced = nil
+	_, err = client.Apps.MovePromotedPurchase(context.Background(), "10", "1", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "3", "1"}, replaced)
+
+	_, err = client.Apps.MovePromotedPurchase(context.Background(), "10", "4", 0)
+	assert.True(t, errors.Is(err, ErrPromotedPurchaseNotFound))
+}
+
+func TestMovePromotedPurchasePaged(t *testing.T) {
+	t.Parallel()
+
+	var replaced []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			var body struct {
+				Data []RelationshipData `json:"data"`
+			}
+
+			_ = json.NewDecoder(r.Body).Decode(&body)
+
+			for _, linkage := range body.Data {
+				replaced = append(replaced, linkage.ID)
+			}
+
+			return
+		}
+
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprintf(w, `{"data":[{"type":"promotedPurchases","id":"1"}],"links":{"self":"","next":"http://%s/apps/10/relationships/promotedPurchases?cursor=a"}}`, r.Host)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data":[{"type":"promotedPurchases","id":"2"}],"links":{"self":""}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	_, err := client.Apps.MovePromotedPurchase(context.Background(), "10", "2", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2", "1"}, replaced)
+}
+
+func TestParsePromotedPurchaseState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParsePromotedPurchaseState(string(PromotedPurchaseStateApproved))
+	assert.NoError(t, err)
+	assert.Equal(t, PromotedPurchaseStateApproved, got)
+
+	_, err = ParsePromotedPurchaseState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "PromotedPurchaseState", Value: "UNKNOWN"}, err)
+}
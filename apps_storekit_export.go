@@ -0,0 +1,691 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// StoreKitProductType is the type of a product in a StoreKit configuration file.
+type StoreKitProductType string
+
+const (
+	// StoreKitProductTypeConsumable is a consumable in-app purchase.
+	StoreKitProductTypeConsumable StoreKitProductType = "Consumable"
+	// StoreKitProductTypeNonConsumable is a non-consumable in-app purchase.
+	StoreKitProductTypeNonConsumable StoreKitProductType = "NonConsumable"
+	// StoreKitProductTypeNonRenewingSubscription is a non-renewing subscription.
+	StoreKitProductTypeNonRenewingSubscription StoreKitProductType = "NonRenewingSubscription"
+	// StoreKitProductTypeRecurringSubscription is an auto-renewable subscription.
+	StoreKitProductTypeRecurringSubscription StoreKitProductType = "RecurringSubscription"
+)
+
+var knownStoreKitProductTypes = []StoreKitProductType{
+	StoreKitProductTypeConsumable,
+	StoreKitProductTypeNonConsumable,
+	StoreKitProductTypeNonRenewingSubscription,
+	StoreKitProductTypeRecurringSubscription,
+}
+
+// IsValid reports whether the store kit product type is one this package knows about.
+func (v StoreKitProductType) IsValid() bool {
+	for _, value := range knownStoreKitProductTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseStoreKitProductType returns s as a StoreKitProductType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseStoreKitProductType(s string) (StoreKitProductType, error) {
+	if v := StoreKitProductType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "StoreKitProductType", Value: s}
+}
+
+// StoreKitPaymentMode is the payment mode of a subscription offer in a StoreKit configuration file.
+type StoreKitPaymentMode string
+
+const (
+	// StoreKitPaymentModeFree is an offer that is free for its duration.
+	StoreKitPaymentModeFree StoreKitPaymentMode = "free"
+	// StoreKitPaymentModePayAsYouGo is an offer where the customer pays a discounted price each period.
+	StoreKitPaymentModePayAsYouGo StoreKitPaymentMode = "payAsYouGo"
+	// StoreKitPaymentModePayUpFront is an offer where the customer pays a discounted price once for its duration.
+	StoreKitPaymentModePayUpFront StoreKitPaymentMode = "payUpFront"
+)
+
+var knownStoreKitPaymentModes = []StoreKitPaymentMode{
+	StoreKitPaymentModeFree,
+	StoreKitPaymentModePayAsYouGo,
+	StoreKitPaymentModePayUpFront,
+}
+
+// IsValid reports whether the store kit payment mode is one this package knows about.
+func (v StoreKitPaymentMode) IsValid() bool {
+	for _, value := range knownStoreKitPaymentModes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseStoreKitPaymentMode returns s as a StoreKitPaymentMode, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseStoreKitPaymentMode(s string) (StoreKitPaymentMode, error) {
+	if v := StoreKitPaymentMode(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "StoreKitPaymentMode", Value: s}
+}
+
+// StoreKitExportOptions configures ExportStoreKitConfiguration.
+type StoreKitExportOptions struct {
+	// Territory is the territory whose prices are exported. Defaults to USA.
+	Territory string
+	// Locale is the locale the product names are exported under. Defaults to en_US.
+	Locale string
+}
+
+// StoreKitConfiguration is the contents of a StoreKit configuration (.storekit) file that Xcode uses to test
+// in-app purchases locally.
+type StoreKitConfiguration struct {
+	Identifier               string                      `json:"identifier"`
+	NonRenewingSubscriptions []StoreKitProduct           `json:"nonRenewingSubscriptions"`
+	Products                 []StoreKitProduct           `json:"products"`
+	Settings                 StoreKitSettings            `json:"settings"`
+	SubscriptionGroups       []StoreKitSubscriptionGroup `json:"subscriptionGroups"`
+	Version                  StoreKitVersion             `json:"version"`
+}
+
+// StoreKitSettings are the storefront settings of a StoreKit configuration file.
+type StoreKitSettings struct {
+	Locale     string `json:"_locale,omitempty"`
+	Storefront string `json:"_storefront,omitempty"`
+}
+
+// StoreKitVersion is the format version of a StoreKit configuration file.
+type StoreKitVersion struct {
+	Major int `json:"major"`
+	Minor int `json:"minor"`
+}
+
+// StoreKitLocalization is the localized name and description of a product or subscription group.
+type StoreKitLocalization struct {
+	Description string `json:"description"`
+	DisplayName string `json:"displayName"`
+	Locale      string `json:"locale"`
+}
+
+// StoreKitProduct is a consumable, non-consumable or non-renewing subscription product.
+type StoreKitProduct struct {
+	DisplayPrice    string                 `json:"displayPrice"`
+	FamilyShareable bool                   `json:"familyShareable"`
+	InternalID      string                 `json:"internalID"`
+	Localizations   []StoreKitLocalization `json:"localizations"`
+	ProductID       string                 `json:"productID"`
+	ReferenceName   string                 `json:"referenceName"`
+	Type            StoreKitProductType    `json:"type"`
+}
+
+// StoreKitSubscriptionGroup is a group of auto-renewable subscriptions.
+type StoreKitSubscriptionGroup struct {
+	ID            string                 `json:"id"`
+	Localizations []StoreKitLocalization `json:"localizations"`
+	Name          string                 `json:"name"`
+	Subscriptions []StoreKitSubscription `json:"subscriptions"`
+}
+
+// StoreKitSubscription is an auto-renewable subscription.
+type StoreKitSubscription struct {
+	AdHocOffers                 []StoreKitOffer        `json:"adHocOffers"`
+	CodeOffers                  []StoreKitOffer        `json:"codeOffers"`
+	DisplayPrice                string                 `json:"displayPrice"`
+	FamilyShareable             bool                   `json:"familyShareable"`
+	GroupNumber                 int                    `json:"groupNumber"`
+	InternalID                  string                 `json:"internalID"`
+	IntroductoryOffer           *StoreKitOffer         `json:"introductoryOffer"`
+	Localizations               []StoreKitLocalization `json:"localizations"`
+	ProductID                   string                 `json:"productID"`
+	RecurringSubscriptionPeriod string                 `json:"recurringSubscriptionPeriod"`
+	ReferenceName               string                 `json:"referenceName"`
+	SubscriptionGroupID         string                 `json:"subscriptionGroupID"`
+	Type                        StoreKitProductType    `json:"type"`
+}
+
+// StoreKitOffer is an introductory or promotional offer of an auto-renewable subscription.
+type StoreKitOffer struct {
+	DisplayPrice       string              `json:"displayPrice,omitempty"`
+	InternalID         string              `json:"internalID"`
+	NumberOfPeriods    int                 `json:"numberOfPeriods"`
+	OfferID            string              `json:"offerID,omitempty"`
+	PaymentMode        StoreKitPaymentMode `json:"paymentMode"`
+	ReferenceName      string              `json:"referenceName,omitempty"`
+	SubscriptionPeriod string              `json:"subscriptionPeriod"`
+}
+
+var storeKitPeriods = map[string]string{
+	"THREE_DAYS":   "P3D",
+	"ONE_WEEK":     "P1W",
+	"TWO_WEEKS":    "P2W",
+	"ONE_MONTH":    "P1M",
+	"TWO_MONTHS":   "P2M",
+	"THREE_MONTHS": "P3M",
+	"SIX_MONTHS":   "P6M",
+	"ONE_YEAR":     "P1Y",
+}
+
+var storeKitPaymentModes = map[SubscriptionOfferMode]StoreKitPaymentMode{
+	SubscriptionOfferModeFreeTrial:  StoreKitPaymentModeFree,
+	SubscriptionOfferModePayAsYouGo: StoreKitPaymentModePayAsYouGo,
+	SubscriptionOfferModePayUpFront: StoreKitPaymentModePayUpFront,
+}
+
+var storeKitProductTypes = map[string]StoreKitProductType{
+	"CONSUMABLE":                StoreKitProductTypeConsumable,
+	"NON_CONSUMABLE":            StoreKitProductTypeNonConsumable,
+	"NON_RENEWING_SUBSCRIPTION": StoreKitProductTypeNonRenewingSubscription,
+}
+
+// ExportStoreKitConfiguration reads the in-app purchases, subscription groups, subscriptions, introductory and
+// promotional offers of an app along with their prices in a single territory, and builds a StoreKit configuration
+// from them. App Store Connect resource IDs are used as internal IDs, so re-exporting an unchanged catalog produces
+// an identical file. Product names are exported under a single locale.
+func (s *AppsService) ExportStoreKitConfiguration(ctx context.Context, appID string, opts *StoreKitExportOptions) (*StoreKitConfiguration, *Response, error) {
+	options := StoreKitExportOptions{Territory: "USA", Locale: "en_US"}
+	if opts != nil {
+		if opts.Territory != "" {
+			options.Territory = opts.Territory
+		}
+
+		if opts.Locale != "" {
+			options.Locale = opts.Locale
+		}
+	}
+
+	config := &StoreKitConfiguration{
+		Identifier:               storeKitIdentifier(appID),
+		NonRenewingSubscriptions: []StoreKitProduct{},
+		Products:                 []StoreKitProduct{},
+		Settings:                 StoreKitSettings{Locale: options.Locale, Storefront: options.Territory},
+		SubscriptionGroups:       []StoreKitSubscriptionGroup{},
+		Version:                  StoreKitVersion{Major: 3, Minor: 0},
+	}
+
+	resp, err := s.exportStoreKitProducts(ctx, appID, options, config)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	resp, err = s.exportStoreKitSubscriptionGroups(ctx, appID, options, config)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return config, resp, nil
+}
+
+func (s *AppsService) exportStoreKitProducts(ctx context.Context, appID string, options StoreKitExportOptions, config *StoreKitConfiguration) (*Response, error) {
+	params := &ListInAppPurchasesQuery{
+		FilterInAppPurchaseType: []string{"CONSUMABLE", "NON_CONSUMABLE", "NON_RENEWING_SUBSCRIPTION"},
+		Limit:                   MaxPageLimit,
+	}
+
+	var resp *Response
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		iaps, res, err := s.ListInAppPurchasesForApp(ctx, appID, params)
+		resp = res
+		if err != nil {
+			return nil, err
+		}
+
+		for _, iap := range iaps.Data {
+			if iap.Attributes == nil || iap.Attributes.InAppPurchaseType == nil {
+				continue
+			}
+
+			productType, ok := storeKitProductTypes[*iap.Attributes.InAppPurchaseType]
+			if !ok {
+				continue
+			}
+
+			price, res, err := s.storeKitInAppPurchasePrice(ctx, iap.ID, options.Territory)
+			resp = res
+			if err != nil {
+				return nil, err
+			}
+
+			name := stringValue(iap.Attributes.ReferenceName)
+			product := StoreKitProduct{
+				DisplayPrice:  price,
+				InternalID:    iap.ID,
+				Localizations: []StoreKitLocalization{{DisplayName: name, Locale: options.Locale}},
+				ProductID:     stringValue(iap.Attributes.ProductID),
+				ReferenceName: name,
+				Type:          productType,
+			}
+
+			if productType == StoreKitProductTypeNonRenewingSubscription {
+				config.NonRenewingSubscriptions = append(config.NonRenewingSubscriptions, product)
+			} else {
+				config.Products = append(config.Products, product)
+			}
+		}
+
+		return &iaps.Links, nil
+	})
+
+	return resp, err
+}
+
+func (s *AppsService) exportStoreKitSubscriptionGroups(ctx context.Context, appID string, options StoreKitExportOptions, config *StoreKitConfiguration) (*Response, error) {
+	params := &ListSubscriptionGroupsForAppQuery{Limit: MaxPageLimit}
+
+	var resp *Response
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		groups, res, err := s.ListSubscriptionGroupsForApp(ctx, appID, params)
+		resp = res
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range groups.Data {
+			entry := StoreKitSubscriptionGroup{
+				ID:            group.ID,
+				Localizations: []StoreKitLocalization{},
+				Subscriptions: []StoreKitSubscription{},
+			}
+			if group.Attributes != nil {
+				entry.Name = stringValue(group.Attributes.ReferenceName)
+			}
+
+			entry.Subscriptions, resp, err = s.exportStoreKitSubscriptions(ctx, group.ID, options)
+			if err != nil {
+				return nil, err
+			}
+
+			config.SubscriptionGroups = append(config.SubscriptionGroups, entry)
+		}
+
+		return &groups.Links, nil
+	})
+
+	return resp, err
+}
+
+func (s *AppsService) exportStoreKitSubscriptions(ctx context.Context, groupID string, options StoreKitExportOptions) ([]StoreKitSubscription, *Response, error) {
+	subscriptions := []StoreKitSubscription{}
+	params := &ListSubscriptionsForGroupQuery{Limit: MaxPageLimit}
+
+	var resp *Response
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		subs, res, err := s.ListSubscriptionsForGroup(ctx, groupID, params)
+		resp = res
+		if err != nil {
+			return nil, err
+		}
+
+		for _, sub := range subs.Data {
+			entry := StoreKitSubscription{
+				AdHocOffers:         []StoreKitOffer{},
+				CodeOffers:          []StoreKitOffer{},
+				InternalID:          sub.ID,
+				SubscriptionGroupID: groupID,
+				Type:                StoreKitProductTypeRecurringSubscription,
+			}
+
+			if attrs := sub.Attributes; attrs != nil {
+				entry.FamilyShareable = attrs.FamilySharable != nil && *attrs.FamilySharable
+				entry.ProductID = stringValue(attrs.ProductID)
+				entry.ReferenceName = stringValue(attrs.Name)
+
+				if attrs.GroupLevel != nil {
+					entry.GroupNumber = *attrs.GroupLevel
+				}
+
+				if attrs.SubscriptionPeriod != nil {
+					entry.RecurringSubscriptionPeriod = storeKitPeriods[string(*attrs.SubscriptionPeriod)]
+				}
+			}
+
+			entry.Localizations = []StoreKitLocalization{{DisplayName: entry.ReferenceName, Locale: options.Locale}}
+
+			entry.DisplayPrice, resp, err = s.storeKitSubscriptionPrice(ctx, sub.ID, options.Territory)
+			if err != nil {
+				return nil, err
+			}
+
+			entry.IntroductoryOffer, resp, err = s.storeKitIntroductoryOffer(ctx, sub.ID, options.Territory)
+			if err != nil {
+				return nil, err
+			}
+
+			entry.AdHocOffers, resp, err = s.storeKitPromotionalOffers(ctx, sub.ID, options.Territory)
+			if err != nil {
+				return nil, err
+			}
+
+			subscriptions = append(subscriptions, entry)
+		}
+
+		return &subs.Links, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return subscriptions, resp, nil
+}
+
+func (s *AppsService) storeKitInAppPurchasePrice(ctx context.Context, iapID string, territory string) (string, *Response, error) {
+	params := &ListManualPricesForInAppPurchaseQuery{
+		FilterTerritory: []string{territory},
+		Include:         []string{"inAppPurchasePricePoint"},
+		Limit:           MaxPageLimit,
+	}
+	prices := &InAppPurchasePricesResponse{}
+
+	var resp *Response
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		page, res, err := s.ListManualPricesForInAppPurchase(ctx, iapID, params)
+		resp = res
+		if err != nil {
+			return nil, err
+		}
+
+		prices.Data = append(prices.Data, page.Data...)
+		prices.Included = append(prices.Included, page.Included...)
+
+		return &page.Links, nil
+	})
+	if err != nil {
+		return "", resp, err
+	}
+
+	points := make(map[string]string, len(prices.Included))
+
+	for _, included := range prices.Included {
+		if point := included.InAppPurchasePricePoint(); point != nil && point.Attributes != nil {
+			points[point.ID] = stringValue(point.Attributes.CustomerPrice)
+		}
+	}
+
+	now := time.Now()
+
+	var (
+		current string
+		start   time.Time
+	)
+
+	for _, price := range prices.Data {
+		if price.Attributes == nil || price.Relationships == nil || price.Relationships.InAppPurchasePricePoint == nil || price.Relationships.InAppPurchasePricePoint.Data == nil {
+			continue
+		}
+
+		from := dateValue(price.Attributes.StartDate)
+		if from.After(now) || (price.Attributes.EndDate != nil && !price.Attributes.EndDate.After(now)) || from.Before(start) {
+			continue
+		}
+
+		current, start = points[price.Relationships.InAppPurchasePricePoint.Data.ID], from
+	}
+
+	return current, resp, nil
+}
+
+func (s *AppsService) storeKitSubscriptionPrice(ctx context.Context, subscriptionID string, territory string) (string, *Response, error) {
+	params := &ListPricesForSubscriptionQuery{
+		FilterTerritory: []string{territory},
+		Include:         []string{"subscriptionPricePoint"},
+		Limit:           MaxPageLimit,
+	}
+	prices := &SubscriptionPricesResponse{}
+
+	var resp *Response
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		page, res, err := s.ListPricesForSubscription(ctx, subscriptionID, params)
+		resp = res
+		if err != nil {
+			return nil, err
+		}
+
+		prices.Data = append(prices.Data, page.Data...)
+		prices.Included = append(prices.Included, page.Included...)
+
+		return &page.Links, nil
+	})
+	if err != nil {
+		return "", resp, err
+	}
+
+	price := currentSubscriptionPrice(prices.Data, time.Now())
+	if price == nil || price.Relationships == nil || price.Relationships.SubscriptionPricePoint == nil || price.Relationships.SubscriptionPricePoint.Data == nil {
+		return "", resp, nil
+	}
+
+	return storeKitSubscriptionPricePoints(prices.Included)[price.Relationships.SubscriptionPricePoint.Data.ID], resp, nil
+}
+
+func (s *AppsService) storeKitIntroductoryOffer(ctx context.Context, subscriptionID string, territory string) (*StoreKitOffer, *Response, error) {
+	params := &ListIntroductoryOffersForSubscriptionQuery{
+		FilterTerritory: []string{territory},
+		Include:         []string{"subscriptionPricePoint"},
+		Limit:           MaxPageLimit,
+	}
+	offers := &SubscriptionIntroductoryOffersResponse{}
+
+	var resp *Response
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		page, res, err := s.ListIntroductoryOffersForSubscription(ctx, subscriptionID, params)
+		resp = res
+		if err != nil {
+			return nil, err
+		}
+
+		offers.Data = append(offers.Data, page.Data...)
+		offers.Included = append(offers.Included, page.Included...)
+
+		return &page.Links, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	points := make(map[string]string, len(offers.Included))
+
+	for _, included := range offers.Included {
+		if point := included.SubscriptionPricePoint(); point != nil && point.Attributes != nil {
+			points[point.ID] = stringValue(point.Attributes.CustomerPrice)
+		}
+	}
+
+	now := time.Now()
+
+	for _, offer := range offers.Data {
+		attrs := offer.Attributes
+		if attrs == nil || attrs.OfferMode == nil || attrs.Duration == nil {
+			continue
+		}
+
+		if (attrs.StartDate != nil && attrs.StartDate.After(now)) || (attrs.EndDate != nil && !attrs.EndDate.After(now)) {
+			continue
+		}
+
+		entry := newStoreKitOffer(offer.ID, *attrs.OfferMode, *attrs.Duration, attrs.NumberOfPeriods)
+		if rels := offer.Relationships; rels != nil && rels.SubscriptionPricePoint != nil && rels.SubscriptionPricePoint.Data != nil {
+			entry.DisplayPrice = points[rels.SubscriptionPricePoint.Data.ID]
+		}
+
+		return &entry, resp, nil
+	}
+
+	return nil, resp, nil
+}
+
+func (s *AppsService) storeKitPromotionalOffers(ctx context.Context, subscriptionID string, territory string) ([]StoreKitOffer, *Response, error) {
+	params := &ListPromotionalOffersForSubscriptionQuery{Limit: MaxPageLimit}
+	offers := &SubscriptionPromotionalOffersResponse{}
+
+	var resp *Response
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		page, res, err := s.ListPromotionalOffersForSubscription(ctx, subscriptionID, params)
+		resp = res
+		if err != nil {
+			return nil, err
+		}
+
+		offers.Data = append(offers.Data, page.Data...)
+
+		return &page.Links, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	entries := []StoreKitOffer{}
+
+	for _, offer := range offers.Data {
+		attrs := offer.Attributes
+		if attrs == nil || attrs.OfferMode == nil || attrs.Duration == nil {
+			continue
+		}
+
+		entry := newStoreKitOffer(offer.ID, *attrs.OfferMode, *attrs.Duration, attrs.NumberOfPeriods)
+		entry.OfferID = stringValue(attrs.OfferCode)
+		entry.ReferenceName = stringValue(attrs.Name)
+
+		if *attrs.OfferMode != SubscriptionOfferModeFreeTrial {
+			prices, resp, err := s.ListPricesForSubscriptionPromotionalOffer(ctx, offer.ID, &ListPricesForSubscriptionPromotionalOfferQuery{
+				FilterTerritory: []string{territory},
+				Include:         []string{"subscriptionPricePoint"},
+			})
+			if err != nil {
+				return nil, resp, err
+			}
+
+			points := storeKitSubscriptionPricePoints(prices.Included)
+			for _, price := range prices.Data {
+				if price.Relationships != nil && price.Relationships.SubscriptionPricePoint != nil && price.Relationships.SubscriptionPricePoint.Data != nil {
+					entry.DisplayPrice = points[price.Relationships.SubscriptionPricePoint.Data.ID]
+
+					break
+				}
+			}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, resp, nil
+}
+
+func newStoreKitOffer(id string, mode SubscriptionOfferMode, duration SubscriptionOfferDuration, numberOfPeriods *int) StoreKitOffer {
+	offer := StoreKitOffer{
+		InternalID:         id,
+		NumberOfPeriods:    1,
+		PaymentMode:        storeKitPaymentModes[mode],
+		SubscriptionPeriod: storeKitPeriods[string(duration)],
+	}
+	if numberOfPeriods != nil {
+		offer.NumberOfPeriods = *numberOfPeriods
+	}
+
+	return offer
+}
+
+func storeKitSubscriptionPricePoints(included []SubscriptionPriceResponseIncluded) map[string]string {
+	points := make(map[string]string, len(included))
+
+	for _, i := range included {
+		if point := i.SubscriptionPricePoint(); point != nil && point.Attributes != nil {
+			points[point.ID] = stringValue(point.Attributes.CustomerPrice)
+		}
+	}
+
+	return points
+}
+
+// storeKitIdentifier derives a stable configuration identifier from the app ID, so that re-exports of the same
+// app don't churn the file.
+func storeKitIdentifier(appID string) string {
+	sum := sha256.Sum256([]byte(appID))
+
+	return fmt.Sprintf("%X", sum[:4])
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
+
+func dateValue(d *Date) time.Time {
+	if d == nil {
+		return time.Time{}
+	}
+
+	return d.Time
+}
+
+// WriteStoreKitConfiguration writes a StoreKit configuration to path, conventionally a file with the .storekit
+// extension that is added to an Xcode scheme for local testing.
+func WriteStoreKitConfiguration(path string, config *StoreKitConfiguration) error {
+	b, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(b, '\n'), 0o644)
+}
@@ -0,0 +1,180 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportStoreKitConfiguration(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /apps/10/inAppPurchases": `{"data":[
+			{"id":"1","type":"inAppPurchases","attributes":{"inAppPurchaseType":"CONSUMABLE","productId":"com.app.coins","referenceName":"Coins"}},
+			{"id":"2","type":"inAppPurchases","attributes":{"inAppPurchaseType":"NON_RENEWING_SUBSCRIPTION","productId":"com.app.season","referenceName":"Season Pass"}}
+		]}`,
+		"GET /inAppPurchasePriceSchedules/1/manualPrices": `{"data":[
+			{"id":"p1","type":"inAppPurchasePrices","attributes":{"startDate":"2020-01-01"},"relationships":{"inAppPurchasePricePoint":{"data":{"id":"pp1","type":"inAppPurchasePricePoints"}}}},
+			{"id":"p2","type":"inAppPurchasePrices","attributes":{"startDate":"2999-01-01"},"relationships":{"inAppPurchasePricePoint":{"data":{"id":"pp2","type":"inAppPurchasePricePoints"}}}}
+		],"included":[
+			{"id":"pp1","type":"inAppPurchasePricePoints","attributes":{"customerPrice":"0.99"}},
+			{"id":"pp2","type":"inAppPurchasePricePoints","attributes":{"customerPrice":"1.99"}}
+		]}`,
+		"GET /inAppPurchasePriceSchedules/2/manualPrices": `{"data":[]}`,
+		"GET /apps/10/subscriptionGroups":                 `{"data":[{"id":"g1","type":"subscriptionGroups","attributes":{"referenceName":"Premium"}}]}`,
+		"GET /subscriptionGroups/g1/subscriptions": `{"data":[
+			{"id":"s1","type":"subscriptions","attributes":{"name":"Monthly","productId":"com.app.monthly","groupLevel":1,"familySharable":true,"subscriptionPeriod":"ONE_MONTH"}}
+		]}`,
+		"GET /subscriptions/s1/prices": `{"data":[
+			{"id":"sp1","type":"subscriptionPrices","relationships":{"subscriptionPricePoint":{"data":{"id":"spp1","type":"subscriptionPricePoints"}}}}
+		],"included":[{"id":"spp1","type":"subscriptionPricePoints","attributes":{"customerPrice":"4.99"}}]}`,
+		"GET /subscriptions/s1/introductoryOffers": `{"data":[
+			{"id":"i1","type":"subscriptionIntroductoryOffers","attributes":{"duration":"ONE_WEEK","numberOfPeriods":1,"offerMode":"FREE_TRIAL"}}
+		]}`,
+		"GET /subscriptions/s1/promotionalOffers": `{"data":[
+			{"id":"o1","type":"subscriptionPromotionalOffers","attributes":{"duration":"ONE_MONTH","name":"Winback","numberOfPeriods":3,"offerCode":"winback","offerMode":"PAY_AS_YOU_GO"}}
+		]}`,
+		"GET /subscriptionPromotionalOffers/o1/prices": `{"data":[
+			{"id":"op1","type":"subscriptionPromotionalOfferPrices","relationships":{"subscriptionPricePoint":{"data":{"id":"spp2","type":"subscriptionPricePoints"}}}}
+		],"included":[{"id":"spp2","type":"subscriptionPricePoints","attributes":{"customerPrice":"1.99"}}]}`,
+	})
+	defer server.Close()
+
+	config, _, err := client.Apps.ExportStoreKitConfiguration(context.Background(), "10", nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, storeKitIdentifier("10"), config.Identifier)
+	assert.Equal(t, StoreKitSettings{Locale: "en_US", Storefront: "USA"}, config.Settings)
+	assert.Equal(t, StoreKitVersion{Major: 3}, config.Version)
+
+	assert.Equal(t, []StoreKitProduct{{
+		DisplayPrice:  "0.99",
+		InternalID:    "1",
+		Localizations: []StoreKitLocalization{{DisplayName: "Coins", Locale: "en_US"}},
+		ProductID:     "com.app.coins",
+		ReferenceName: "Coins",
+		Type:          StoreKitProductTypeConsumable,
+	}}, config.Products)
+	assert.Len(t, config.NonRenewingSubscriptions, 1)
+	assert.Equal(t, StoreKitProductTypeNonRenewingSubscription, config.NonRenewingSubscriptions[0].Type)
+	assert.Empty(t, config.NonRenewingSubscriptions[0].DisplayPrice)
+
+	assert.Len(t, config.SubscriptionGroups, 1)
+	group := config.SubscriptionGroups[0]
+	assert.Equal(t, "g1", group.ID)
+	assert.Equal(t, "Premium", group.Name)
+	assert.Equal(t, []StoreKitSubscription{{
+		AdHocOffers: []StoreKitOffer{{
+			DisplayPrice:       "1.99",
+			InternalID:         "o1",
+			NumberOfPeriods:    3,
+			OfferID:            "winback",
+			PaymentMode:        StoreKitPaymentModePayAsYouGo,
+			ReferenceName:      "Winback",
+			SubscriptionPeriod: "P1M",
+		}},
+		CodeOffers:      []StoreKitOffer{},
+		DisplayPrice:    "4.99",
+		FamilyShareable: true,
+		GroupNumber:     1,
+		InternalID:      "s1",
+		IntroductoryOffer: &StoreKitOffer{
+			InternalID:         "i1",
+			NumberOfPeriods:    1,
+			PaymentMode:        StoreKitPaymentModeFree,
+			SubscriptionPeriod: "P1W",
+		},
+		Localizations:               []StoreKitLocalization{{DisplayName: "Monthly", Locale: "en_US"}},
+		ProductID:                   "com.app.monthly",
+		RecurringSubscriptionPeriod: "P1M",
+		ReferenceName:               "Monthly",
+		SubscriptionGroupID:         "g1",
+		Type:                        StoreKitProductTypeRecurringSubscription,
+	}}, group.Subscriptions)
+}
+
+func TestExportStoreKitConfigurationError(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /apps/10/inAppPurchases": `{"data":[]}`,
+	})
+	defer server.Close()
+
+	config, _, err := client.Apps.ExportStoreKitConfiguration(context.Background(), "10", &StoreKitExportOptions{Territory: "GBR", Locale: "en_GB"})
+	assert.Error(t, err)
+	assert.Nil(t, config)
+}
+
+func TestWriteStoreKitConfiguration(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "Products.storekit")
+	config := &StoreKitConfiguration{
+		Identifier:               "ABCD1234",
+		NonRenewingSubscriptions: []StoreKitProduct{},
+		Products:                 []StoreKitProduct{},
+		SubscriptionGroups:       []StoreKitSubscriptionGroup{},
+		Version:                  StoreKitVersion{Major: 3},
+	}
+
+	err := WriteStoreKitConfiguration(path, config)
+	assert.NoError(t, err)
+
+	b, err := os.ReadFile(path)
+	assert.NoError(t, err)
+
+	var got map[string]interface{}
+	err = json.Unmarshal(b, &got)
+	assert.NoError(t, err)
+	assert.Equal(t, "ABCD1234", got["identifier"])
+	assert.Equal(t, []interface{}{}, got["products"])
+	assert.Equal(t, map[string]interface{}{"major": float64(3), "minor": float64(0)}, got["version"])
+}
+
+func TestParseStoreKitProductType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseStoreKitProductType(string(StoreKitProductTypeConsumable))
+	assert.NoError(t, err)
+	assert.Equal(t, StoreKitProductTypeConsumable, got)
+
+	_, err = ParseStoreKitProductType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "StoreKitProductType", Value: "UNKNOWN"}, err)
+}
+
+func TestParseStoreKitPaymentMode(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseStoreKitPaymentMode(string(StoreKitPaymentModeFree))
+	assert.NoError(t, err)
+	assert.Equal(t, StoreKitPaymentModeFree, got)
+
+	_, err = ParseStoreKitPaymentMode("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "StoreKitPaymentMode", Value: "UNKNOWN"}, err)
+}
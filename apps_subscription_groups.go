@@ -0,0 +1,128 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionGroup defines model for SubscriptionGroup.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroup
+type SubscriptionGroup struct {
+	Attributes    *SubscriptionGroupAttributes    `json:"attributes,omitempty"`
+	ID            string                          `json:"id"`
+	Links         ResourceLinks                   `json:"links"`
+	Relationships *SubscriptionGroupRelationships `json:"relationships,omitempty"`
+	Type          string                          `json:"type"`
+}
+
+// SubscriptionGroupAttributes defines model for SubscriptionGroup.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroup/attributes
+type SubscriptionGroupAttributes struct {
+	ReferenceName *string `json:"referenceName,omitempty"`
+}
+
+// SubscriptionGroupRelationships defines model for SubscriptionGroup.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroup/relationships
+type SubscriptionGroupRelationships struct {
+	SubscriptionGroupLocalizations *PagedRelationship `json:"subscriptionGroupLocalizations,omitempty"`
+	Subscriptions                  *PagedRelationship `json:"subscriptions,omitempty"`
+}
+
+// SubscriptionGroupResponse defines model for SubscriptionGroupResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupresponse
+type SubscriptionGroupResponse struct {
+	Data     SubscriptionGroup `json:"data"`
+	Included []Subscription    `json:"included,omitempty"`
+	Links    DocumentLinks     `json:"links"`
+}
+
+// SubscriptionGroupsResponse defines model for SubscriptionGroupsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptiongroupsresponse
+type SubscriptionGroupsResponse struct {
+	Data     []SubscriptionGroup `json:"data"`
+	Included []Subscription      `json:"included,omitempty"`
+	Links    PagedDocumentLinks  `json:"links"`
+	Meta     *PagingInformation  `json:"meta,omitempty"`
+}
+
+// ListSubscriptionGroupsForAppQuery are query options for ListSubscriptionGroupsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_subscriptiongroups
+type ListSubscriptionGroupsForAppQuery struct {
+	FieldsSubscriptionGroups []string `url:"fields[subscriptionGroups],omitempty"`
+	FieldsSubscriptions      []string `url:"fields[subscriptions],omitempty"`
+	FilterReferenceName      []string `url:"filter[referenceName],omitempty"`
+	Include                  []string `url:"include,omitempty"`
+	Limit                    int      `url:"limit,omitempty"`
+	LimitSubscriptions       int      `url:"limit[subscriptions],omitempty"`
+	Sort                     []string `url:"sort,omitempty"`
+	Cursor                   string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionGroupQuery are query options for GetSubscriptionGroup
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptiongroups_id
+type GetSubscriptionGroupQuery struct {
+	FieldsSubscriptionGroups []string `url:"fields[subscriptionGroups],omitempty"`
+	FieldsSubscriptions      []string `url:"fields[subscriptions],omitempty"`
+	Include                  []string `url:"include,omitempty"`
+	LimitSubscriptions       int      `url:"limit[subscriptions],omitempty"`
+}
+
+// ListSubscriptionsForGroupQuery are query options for ListSubscriptionsForGroup
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptiongroups_id_subscriptions
+type ListSubscriptionsForGroupQuery struct {
+	FieldsSubscriptions []string `url:"fields[subscriptions],omitempty"`
+	FilterProductID     []string `url:"filter[productId],omitempty"`
+	FilterState         []string `url:"filter[state],omitempty"`
+	Limit               int      `url:"limit,omitempty"`
+	Sort                []string `url:"sort,omitempty"`
+	Cursor              string   `url:"cursor,omitempty"`
+}
+
+// ListSubscriptionGroupsForApp lists the auto-renewable subscription groups of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_subscriptiongroups
+func (s *AppsService) ListSubscriptionGroupsForApp(ctx context.Context, id string, params *ListSubscriptionGroupsForAppQuery) (*SubscriptionGroupsResponse, *Response, error) {
+	return Get[SubscriptionGroupsResponse](ctx, s.client, fmt.Sprintf("apps/%s/subscriptionGroups", id), params)
+}
+
+// GetSubscriptionGroup reads the information about a subscription group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptiongroups_id
+func (s *AppsService) GetSubscriptionGroup(ctx context.Context, id string, params *GetSubscriptionGroupQuery) (*SubscriptionGroupResponse, *Response, error) {
+	return Get[SubscriptionGroupResponse](ctx, s.client, fmt.Sprintf("subscriptionGroups/%s", id), params)
+}
+
+// ListSubscriptionsForGroup lists the auto-renewable subscriptions in a subscription group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptiongroups_id_subscriptions
+func (s *AppsService) ListSubscriptionsForGroup(ctx context.Context, id string, params *ListSubscriptionsForGroupQuery) (*SubscriptionsResponse, *Response, error) {
+	return Get[SubscriptionsResponse](ctx, s.client, fmt.Sprintf("subscriptionGroups/%s/subscriptions", id), params)
+}
@@ -0,0 +1,392 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionOfferDuration defines model for SubscriptionOfferDuration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionofferduration
+type SubscriptionOfferDuration string
+
+const (
+	// SubscriptionOfferDurationThreeDays is an offer period of three days.
+	SubscriptionOfferDurationThreeDays SubscriptionOfferDuration = "THREE_DAYS"
+	// SubscriptionOfferDurationOneWeek is an offer period of one week.
+	SubscriptionOfferDurationOneWeek SubscriptionOfferDuration = "ONE_WEEK"
+	// SubscriptionOfferDurationTwoWeeks is an offer period of two weeks.
+	SubscriptionOfferDurationTwoWeeks SubscriptionOfferDuration = "TWO_WEEKS"
+	// SubscriptionOfferDurationOneMonth is an offer period of one month.
+	SubscriptionOfferDurationOneMonth SubscriptionOfferDuration = "ONE_MONTH"
+	// SubscriptionOfferDurationTwoMonths is an offer period of two months.
+	SubscriptionOfferDurationTwoMonths SubscriptionOfferDuration = "TWO_MONTHS"
+	// SubscriptionOfferDurationThreeMonths is an offer period of three months.
+	SubscriptionOfferDurationThreeMonths SubscriptionOfferDuration = "THREE_MONTHS"
+	// SubscriptionOfferDurationSixMonths is an offer period of six months.
+	SubscriptionOfferDurationSixMonths SubscriptionOfferDuration = "SIX_MONTHS"
+	// SubscriptionOfferDurationOneYear is an offer period of one year.
+	SubscriptionOfferDurationOneYear SubscriptionOfferDuration = "ONE_YEAR"
+)
+
+var subscriptionOfferDurations = []SubscriptionOfferDuration{
+	SubscriptionOfferDurationThreeDays,
+	SubscriptionOfferDurationOneWeek,
+	SubscriptionOfferDurationTwoWeeks,
+	SubscriptionOfferDurationOneMonth,
+	SubscriptionOfferDurationTwoMonths,
+	SubscriptionOfferDurationThreeMonths,
+	SubscriptionOfferDurationSixMonths,
+	SubscriptionOfferDurationOneYear,
+}
+
+// IsValid reports whether the subscription offer duration is one this package knows about.
+func (v SubscriptionOfferDuration) IsValid() bool {
+	for _, value := range subscriptionOfferDurations {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSubscriptionOfferDuration returns s as a SubscriptionOfferDuration, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseSubscriptionOfferDuration(s string) (SubscriptionOfferDuration, error) {
+	if v := SubscriptionOfferDuration(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "SubscriptionOfferDuration", Value: s}
+}
+
+// SubscriptionOfferMode defines model for SubscriptionOfferMode.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffermode
+type SubscriptionOfferMode string
+
+const (
+	// SubscriptionOfferModeFreeTrial is an offer that is free for its duration.
+	SubscriptionOfferModeFreeTrial SubscriptionOfferMode = "FREE_TRIAL"
+	// SubscriptionOfferModePayAsYouGo is an offer where the customer pays a discounted price each period.
+	SubscriptionOfferModePayAsYouGo SubscriptionOfferMode = "PAY_AS_YOU_GO"
+	// SubscriptionOfferModePayUpFront is an offer where the customer pays a discounted price once for its duration.
+	SubscriptionOfferModePayUpFront SubscriptionOfferMode = "PAY_UP_FRONT"
+)
+
+var subscriptionOfferModes = []SubscriptionOfferMode{
+	SubscriptionOfferModeFreeTrial,
+	SubscriptionOfferModePayAsYouGo,
+	SubscriptionOfferModePayUpFront,
+}
+
+// IsValid reports whether the subscription offer mode is one this package knows about.
+func (v SubscriptionOfferMode) IsValid() bool {
+	for _, value := range subscriptionOfferModes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSubscriptionOfferMode returns s as a SubscriptionOfferMode, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseSubscriptionOfferMode(s string) (SubscriptionOfferMode, error) {
+	if v := SubscriptionOfferMode(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "SubscriptionOfferMode", Value: s}
+}
+
+// SubscriptionIntroductoryOffer defines model for SubscriptionIntroductoryOffer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffer
+type SubscriptionIntroductoryOffer struct {
+	Attributes    *SubscriptionIntroductoryOfferAttributes    `json:"attributes,omitempty"`
+	ID            string                                      `json:"id"`
+	Links         ResourceLinks                               `json:"links"`
+	Relationships *SubscriptionIntroductoryOfferRelationships `json:"relationships,omitempty"`
+	Type          string                                      `json:"type"`
+}
+
+// SubscriptionIntroductoryOfferAttributes defines model for SubscriptionIntroductoryOffer.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffer/attributes
+type SubscriptionIntroductoryOfferAttributes struct {
+	Duration        *SubscriptionOfferDuration `json:"duration,omitempty"`
+	EndDate         *Date                      `json:"endDate,omitempty"`
+	NumberOfPeriods *int                       `json:"numberOfPeriods,omitempty"`
+	OfferMode       *SubscriptionOfferMode     `json:"offerMode,omitempty"`
+	StartDate       *Date                      `json:"startDate,omitempty"`
+}
+
+// SubscriptionIntroductoryOfferRelationships defines model for SubscriptionIntroductoryOffer.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffer/relationships
+type SubscriptionIntroductoryOfferRelationships struct {
+	Subscription           *Relationship `json:"subscription,omitempty"`
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+	Territory              *Relationship `json:"territory,omitempty"`
+}
+
+// SubscriptionIntroductoryOfferResponse defines model for SubscriptionIntroductoryOfferResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryofferresponse
+type SubscriptionIntroductoryOfferResponse struct {
+	Data     SubscriptionIntroductoryOffer                   `json:"data"`
+	Included []SubscriptionIntroductoryOfferResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                   `json:"links"`
+}
+
+// SubscriptionIntroductoryOffersResponse defines model for SubscriptionIntroductoryOffersResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffersresponse
+type SubscriptionIntroductoryOffersResponse struct {
+	Data     []SubscriptionIntroductoryOffer                 `json:"data"`
+	Included []SubscriptionIntroductoryOfferResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                              `json:"links"`
+	Meta     *PagingInformation                              `json:"meta,omitempty"`
+}
+
+// SubscriptionIntroductoryOfferResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a SubscriptionIntroductoryOfferResponse or SubscriptionIntroductoryOffersResponse.
+type SubscriptionIntroductoryOfferResponseIncluded included
+
+// subscriptionIntroductoryOfferCreateRequest defines model for SubscriptionIntroductoryOfferCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffercreaterequest/data
+type subscriptionIntroductoryOfferCreateRequest struct {
+	Attributes    SubscriptionIntroductoryOfferCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionIntroductoryOfferCreateRequestRelationships `json:"relationships"`
+	Type          string                                                  `json:"type"`
+}
+
+// SubscriptionIntroductoryOfferCreateRequestAttributes are attributes for SubscriptionIntroductoryOfferCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffercreaterequest/data/attributes
+type SubscriptionIntroductoryOfferCreateRequestAttributes struct {
+	Duration        SubscriptionOfferDuration `json:"duration"`
+	EndDate         *Date                     `json:"endDate,omitempty"`
+	NumberOfPeriods int                       `json:"numberOfPeriods"`
+	OfferMode       SubscriptionOfferMode     `json:"offerMode"`
+	StartDate       *Date                     `json:"startDate,omitempty"`
+}
+
+// subscriptionIntroductoryOfferCreateRequestRelationships are relationships for SubscriptionIntroductoryOfferCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryoffercreaterequest/data/relationships
+type subscriptionIntroductoryOfferCreateRequestRelationships struct {
+	Subscription           relationshipDeclaration  `json:"subscription"`
+	SubscriptionPricePoint *relationshipDeclaration `json:"subscriptionPricePoint,omitempty"`
+	Territory              *relationshipDeclaration `json:"territory,omitempty"`
+}
+
+// subscriptionIntroductoryOfferUpdateRequest defines model for SubscriptionIntroductoryOfferUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryofferupdaterequest/data
+type subscriptionIntroductoryOfferUpdateRequest struct {
+	Attributes *subscriptionIntroductoryOfferUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                `json:"id"`
+	Type       string                                                `json:"type"`
+}
+
+// subscriptionIntroductoryOfferUpdateRequestAttributes are attributes for SubscriptionIntroductoryOfferUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionintroductoryofferupdaterequest/data/attributes
+type subscriptionIntroductoryOfferUpdateRequestAttributes struct {
+	EndDate *Date `json:"endDate,omitempty"`
+}
+
+// ListIntroductoryOffersForSubscriptionQuery are query options for ListIntroductoryOffersForSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id_introductoryoffers
+type ListIntroductoryOffersForSubscriptionQuery struct {
+	FieldsSubscriptionIntroductoryOffers []string `url:"fields[subscriptionIntroductoryOffers],omitempty"`
+	FieldsSubscriptionPricePoints        []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsSubscriptions                  []string `url:"fields[subscriptions],omitempty"`
+	FieldsTerritories                    []string `url:"fields[territories],omitempty"`
+	FilterTerritory                      []string `url:"filter[territory],omitempty"`
+	Include                              []string `url:"include,omitempty"`
+	Limit                                int      `url:"limit,omitempty"`
+	Cursor                               string   `url:"cursor,omitempty"`
+}
+
+// SubscriptionOfferPrice pairs a territory with the subscription price point an offer is sold at there.
+// PricePointID is left empty for free trials.
+type SubscriptionOfferPrice struct {
+	TerritoryID  string
+	PricePointID string
+}
+
+type subscriptionOfferPriceInlineCreate struct {
+	ID            string                                          `json:"id"`
+	Relationships subscriptionOfferPriceInlineCreateRelationships `json:"relationships"`
+	Type          string                                          `json:"type"`
+}
+
+type subscriptionOfferPriceInlineCreateRelationships struct {
+	SubscriptionPricePoint *relationshipDeclaration `json:"subscriptionPricePoint,omitempty"`
+	Territory              *relationshipDeclaration `json:"territory,omitempty"`
+}
+
+func (p SubscriptionOfferPrice) inlineCreate(index int, priceType string) subscriptionOfferPriceInlineCreate {
+	price := subscriptionOfferPriceInlineCreate{
+		ID:   fmt.Sprintf("${new-price-%d}", index),
+		Type: priceType,
+	}
+
+	if p.TerritoryID != "" {
+		price.Relationships.Territory = newRelationshipDeclaration(&p.TerritoryID, "territories")
+	}
+
+	if p.PricePointID != "" {
+		price.Relationships.SubscriptionPricePoint = newRelationshipDeclaration(&p.PricePointID, "subscriptionPricePoints")
+	}
+
+	return price
+}
+
+func newSubscriptionOfferPrices(prices []SubscriptionOfferPrice, priceType string) ([]subscriptionOfferPriceInlineCreate, []string) {
+	inline := make([]subscriptionOfferPriceInlineCreate, len(prices))
+	ids := make([]string, len(prices))
+
+	for i, price := range prices {
+		price := price.inlineCreate(i, priceType)
+		inline[i] = price
+		ids[i] = price.ID
+	}
+
+	return inline, ids
+}
+
+// ListIntroductoryOffersForSubscription lists the introductory offers of a subscription in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id_introductoryoffers
+func (s *AppsService) ListIntroductoryOffersForSubscription(ctx context.Context, id string, params *ListIntroductoryOffersForSubscriptionQuery) (*SubscriptionIntroductoryOffersResponse, *Response, error) {
+	return Get[SubscriptionIntroductoryOffersResponse](ctx, s.client, fmt.Sprintf("subscriptions/%s/introductoryOffers", id), params)
+}
+
+// CreateSubscriptionIntroductoryOffer creates an introductory offer for a subscription in a territory. The price
+// point is required for pay-as-you-go and pay-up-front offers and must be nil for free trials.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_subscriptionintroductoryoffers
+func (s *AppsService) CreateSubscriptionIntroductoryOffer(ctx context.Context, attributes SubscriptionIntroductoryOfferCreateRequestAttributes, subscriptionID string, territoryID *string, pricePointID *string) (*SubscriptionIntroductoryOfferResponse, *Response, error) {
+	req := subscriptionIntroductoryOfferCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionIntroductoryOfferCreateRequestRelationships{
+			Subscription:           *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+			SubscriptionPricePoint: newRelationshipDeclaration(pricePointID, "subscriptionPricePoints"),
+			Territory:              newRelationshipDeclaration(territoryID, "territories"),
+		},
+		Type: "subscriptionIntroductoryOffers",
+	}
+	res := new(SubscriptionIntroductoryOfferResponse)
+	resp, err := s.client.post(ctx, "subscriptionIntroductoryOffers", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionIntroductoryOffer changes the end date of an introductory offer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_subscriptionintroductoryoffers_id
+func (s *AppsService) UpdateSubscriptionIntroductoryOffer(ctx context.Context, id string, endDate *Date) (*SubscriptionIntroductoryOfferResponse, *Response, error) {
+	req := subscriptionIntroductoryOfferUpdateRequest{
+		ID:   id,
+		Type: "subscriptionIntroductoryOffers",
+	}
+
+	if endDate != nil {
+		req.Attributes = &subscriptionIntroductoryOfferUpdateRequestAttributes{
+			EndDate: endDate,
+		}
+	}
+
+	url := fmt.Sprintf("subscriptionIntroductoryOffers/%s", id)
+	res := new(SubscriptionIntroductoryOfferResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteSubscriptionIntroductoryOffer deletes an introductory offer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_v1_subscriptionintroductoryoffers_id
+func (s *AppsService) DeleteSubscriptionIntroductoryOffer(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("subscriptionIntroductoryOffers/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// CreateSubscriptionIntroductoryOffers creates the same introductory offer for a subscription in each of the given
+// territories, stopping at the first failure. The offers created before the failure are returned alongside the error.
+func (s *AppsService) CreateSubscriptionIntroductoryOffers(ctx context.Context, attributes SubscriptionIntroductoryOfferCreateRequestAttributes, subscriptionID string, prices []SubscriptionOfferPrice) ([]SubscriptionIntroductoryOffer, *Response, error) {
+	offers := make([]SubscriptionIntroductoryOffer, 0, len(prices))
+
+	var resp *Response
+
+	for _, price := range prices {
+		territoryID := price.TerritoryID
+
+		var pricePointID *string
+		if price.PricePointID != "" {
+			pricePointID = &price.PricePointID
+		}
+
+		offer, res, err := s.CreateSubscriptionIntroductoryOffer(ctx, attributes, subscriptionID, &territoryID, pricePointID)
+		resp = res
+
+		if err != nil {
+			return offers, resp, fmt.Errorf("%s: %w", territoryID, err)
+		}
+
+		offers = append(offers, offer.Data)
+	}
+
+	return offers, resp, nil
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in SubscriptionIntroductoryOfferResponseIncluded.
+func (i *SubscriptionIntroductoryOfferResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// Subscription returns the Subscription stored within, if one is present.
+func (i *SubscriptionIntroductoryOfferResponseIncluded) Subscription() *Subscription {
+	return extractIncludedSubscription(i.inner)
+}
+
+// SubscriptionPricePoint returns the SubscriptionPricePoint stored within, if one is present.
+func (i *SubscriptionIntroductoryOfferResponseIncluded) SubscriptionPricePoint() *SubscriptionPricePoint {
+	return extractIncludedSubscriptionPricePoint(i.inner)
+}
+
+// Territory returns the Territory stored within, if one is present.
+func (i *SubscriptionIntroductoryOfferResponseIncluded) Territory() *Territory {
+	return extractIncludedTerritory(i.inner)
+}
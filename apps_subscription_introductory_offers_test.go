@@ -0,0 +1,132 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListIntroductoryOffersForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionIntroductoryOffersResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListIntroductoryOffersForSubscription(ctx, "10", &ListIntroductoryOffersForSubscriptionQuery{})
+	})
+}
+
+func TestListIntroductoryOffersForSubscriptionIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"subscriptions"},{"type":"subscriptionPricePoints"},{"type":"territories"}]}`, func(ctx context.Context, client *Client) {
+		offers, _, err := client.Apps.ListIntroductoryOffersForSubscription(ctx, "10", &ListIntroductoryOffersForSubscriptionQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, offers.Included)
+
+		assert.NotNil(t, offers.Included[0].Subscription())
+		assert.NotNil(t, offers.Included[1].SubscriptionPricePoint())
+		assert.NotNil(t, offers.Included[2].Territory())
+
+		assert.Nil(t, offers.Included[0].Territory())
+		assert.Nil(t, offers.Included[1].Subscription())
+		assert.Nil(t, offers.Included[2].SubscriptionPricePoint())
+	})
+}
+
+func TestCreateSubscriptionIntroductoryOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionIntroductoryOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateSubscriptionIntroductoryOffer(ctx, SubscriptionIntroductoryOfferCreateRequestAttributes{
+			Duration:        SubscriptionOfferDurationOneWeek,
+			NumberOfPeriods: 1,
+			OfferMode:       SubscriptionOfferModeFreeTrial,
+		}, "10", String("USA"), nil)
+	})
+}
+
+func TestUpdateSubscriptionIntroductoryOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionIntroductoryOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateSubscriptionIntroductoryOffer(ctx, "10", &Date{})
+	})
+}
+
+func TestDeleteSubscriptionIntroductoryOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteSubscriptionIntroductoryOffer(ctx, "10")
+	})
+}
+
+func TestCreateSubscriptionIntroductoryOffers(t *testing.T) {
+	t.Parallel()
+
+	attributes := SubscriptionIntroductoryOfferCreateRequestAttributes{
+		Duration:        SubscriptionOfferDurationOneMonth,
+		NumberOfPeriods: 3,
+		OfferMode:       SubscriptionOfferModePayAsYouGo,
+	}
+
+	testEndpointCustomBehavior(`{"data":{"id":"1","type":"subscriptionIntroductoryOffers"}}`, func(ctx context.Context, client *Client) {
+		offers, _, err := client.Apps.CreateSubscriptionIntroductoryOffers(ctx, attributes, "10", []SubscriptionOfferPrice{
+			{TerritoryID: "USA", PricePointID: "20"},
+			{TerritoryID: "CAN", PricePointID: "30"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, offers, 2)
+	})
+
+	testEndpointCustomBehavior(`{"data":`, func(ctx context.Context, client *Client) {
+		offers, _, err := client.Apps.CreateSubscriptionIntroductoryOffers(ctx, attributes, "10", []SubscriptionOfferPrice{
+			{TerritoryID: "USA"},
+		})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "USA")
+		assert.Empty(t, offers)
+	})
+}
+
+func TestParseSubscriptionOfferDuration(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseSubscriptionOfferDuration(string(SubscriptionOfferDurationThreeDays))
+	assert.NoError(t, err)
+	assert.Equal(t, SubscriptionOfferDurationThreeDays, got)
+
+	_, err = ParseSubscriptionOfferDuration("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "SubscriptionOfferDuration", Value: "UNKNOWN"}, err)
+}
+
+func TestParseSubscriptionOfferMode(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseSubscriptionOfferMode(string(SubscriptionOfferModeFreeTrial))
+	assert.NoError(t, err)
+	assert.Equal(t, SubscriptionOfferModeFreeTrial, got)
+
+	_, err = ParseSubscriptionOfferMode("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "SubscriptionOfferMode", Value: "UNKNOWN"}, err)
+}
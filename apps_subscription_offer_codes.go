@@ -0,0 +1,659 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+)
+
+// SubscriptionCustomerEligibility defines model for SubscriptionCustomerEligibility.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptioncustomereligibility
+type SubscriptionCustomerEligibility string
+
+const (
+	// SubscriptionCustomerEligibilityNew is a customer who has never subscribed.
+	SubscriptionCustomerEligibilityNew SubscriptionCustomerEligibility = "NEW"
+	// SubscriptionCustomerEligibilityExisting is a customer with an active subscription.
+	SubscriptionCustomerEligibilityExisting SubscriptionCustomerEligibility = "EXISTING"
+	// SubscriptionCustomerEligibilityExpired is a customer whose subscription has expired.
+	SubscriptionCustomerEligibilityExpired SubscriptionCustomerEligibility = "EXPIRED"
+)
+
+var subscriptionCustomerEligibilities = []SubscriptionCustomerEligibility{
+	SubscriptionCustomerEligibilityNew,
+	SubscriptionCustomerEligibilityExisting,
+	SubscriptionCustomerEligibilityExpired,
+}
+
+// IsValid reports whether the subscription customer eligibility is one this package knows about.
+func (v SubscriptionCustomerEligibility) IsValid() bool {
+	for _, value := range subscriptionCustomerEligibilities {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSubscriptionCustomerEligibility returns s as a SubscriptionCustomerEligibility, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseSubscriptionCustomerEligibility(s string) (SubscriptionCustomerEligibility, error) {
+	if v := SubscriptionCustomerEligibility(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "SubscriptionCustomerEligibility", Value: s}
+}
+
+// SubscriptionOfferEligibility defines model for SubscriptionOfferEligibility.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffereligibility
+type SubscriptionOfferEligibility string
+
+const (
+	// SubscriptionOfferEligibilityStackWithIntroOffers lets the offer code be redeemed on top of an introductory offer.
+	SubscriptionOfferEligibilityStackWithIntroOffers SubscriptionOfferEligibility = "STACK_WITH_INTRO_OFFERS"
+	// SubscriptionOfferEligibilityReplaceIntroOffers lets the offer code be redeemed in place of an introductory offer.
+	SubscriptionOfferEligibilityReplaceIntroOffers SubscriptionOfferEligibility = "REPLACE_INTRO_OFFERS"
+)
+
+var subscriptionOfferEligibilities = []SubscriptionOfferEligibility{
+	SubscriptionOfferEligibilityStackWithIntroOffers,
+	SubscriptionOfferEligibilityReplaceIntroOffers,
+}
+
+// IsValid reports whether the subscription offer eligibility is one this package knows about.
+func (v SubscriptionOfferEligibility) IsValid() bool {
+	for _, value := range subscriptionOfferEligibilities {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSubscriptionOfferEligibility returns s as a SubscriptionOfferEligibility, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseSubscriptionOfferEligibility(s string) (SubscriptionOfferEligibility, error) {
+	if v := SubscriptionOfferEligibility(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "SubscriptionOfferEligibility", Value: s}
+}
+
+// SubscriptionOfferCode defines model for SubscriptionOfferCode.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercode
+type SubscriptionOfferCode struct {
+	Attributes    *SubscriptionOfferCodeAttributes    `json:"attributes,omitempty"`
+	ID            string                              `json:"id"`
+	Links         ResourceLinks                       `json:"links"`
+	Relationships *SubscriptionOfferCodeRelationships `json:"relationships,omitempty"`
+	Type          string                              `json:"type"`
+}
+
+// SubscriptionOfferCodeAttributes defines model for SubscriptionOfferCode.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercode/attributes
+type SubscriptionOfferCodeAttributes struct {
+	Active                *bool                             `json:"active,omitempty"`
+	CustomerEligibilities []SubscriptionCustomerEligibility `json:"customerEligibilities,omitempty"`
+	Duration              *SubscriptionOfferDuration        `json:"duration,omitempty"`
+	Name                  *string                           `json:"name,omitempty"`
+	NumberOfPeriods       *int                              `json:"numberOfPeriods,omitempty"`
+	OfferEligibility      *SubscriptionOfferEligibility     `json:"offerEligibility,omitempty"`
+	OfferMode             *SubscriptionOfferMode            `json:"offerMode,omitempty"`
+	TotalNumberOfCodes    *int                              `json:"totalNumberOfCodes,omitempty"`
+}
+
+// SubscriptionOfferCodeRelationships defines model for SubscriptionOfferCode.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercode/relationships
+type SubscriptionOfferCodeRelationships struct {
+	CustomCodes     *PagedRelationship `json:"customCodes,omitempty"`
+	OneTimeUseCodes *PagedRelationship `json:"oneTimeUseCodes,omitempty"`
+	Prices          *PagedRelationship `json:"prices,omitempty"`
+	Subscription    *Relationship      `json:"subscription,omitempty"`
+}
+
+// SubscriptionOfferCodeResponse defines model for SubscriptionOfferCodeResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercoderesponse
+type SubscriptionOfferCodeResponse struct {
+	Data     SubscriptionOfferCode                   `json:"data"`
+	Included []SubscriptionOfferCodeResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                           `json:"links"`
+}
+
+// SubscriptionOfferCodesResponse defines model for SubscriptionOfferCodesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodesresponse
+type SubscriptionOfferCodesResponse struct {
+	Data     []SubscriptionOfferCode                 `json:"data"`
+	Included []SubscriptionOfferCodeResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                      `json:"links"`
+	Meta     *PagingInformation                      `json:"meta,omitempty"`
+}
+
+// SubscriptionOfferCodeResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a SubscriptionOfferCodeResponse or SubscriptionOfferCodesResponse.
+type SubscriptionOfferCodeResponseIncluded included
+
+// SubscriptionOfferCodePrice defines model for SubscriptionOfferCodePrice.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeprice
+type SubscriptionOfferCodePrice struct {
+	ID            string                                   `json:"id"`
+	Links         ResourceLinks                            `json:"links"`
+	Relationships *SubscriptionOfferCodePriceRelationships `json:"relationships,omitempty"`
+	Type          string                                   `json:"type"`
+}
+
+// SubscriptionOfferCodePriceRelationships defines model for SubscriptionOfferCodePrice.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeprice/relationships
+type SubscriptionOfferCodePriceRelationships struct {
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+	Territory              *Relationship `json:"territory,omitempty"`
+}
+
+// SubscriptionOfferCodePricesResponse defines model for SubscriptionOfferCodePricesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodepricesresponse
+type SubscriptionOfferCodePricesResponse struct {
+	Data     []SubscriptionOfferCodePrice        `json:"data"`
+	Included []SubscriptionPriceResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// SubscriptionOfferCodeCustomCode defines model for SubscriptionOfferCodeCustomCode.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcode
+type SubscriptionOfferCodeCustomCode struct {
+	Attributes    *SubscriptionOfferCodeCustomCodeAttributes    `json:"attributes,omitempty"`
+	ID            string                                        `json:"id"`
+	Links         ResourceLinks                                 `json:"links"`
+	Relationships *SubscriptionOfferCodeCustomCodeRelationships `json:"relationships,omitempty"`
+	Type          string                                        `json:"type"`
+}
+
+// SubscriptionOfferCodeCustomCodeAttributes defines model for SubscriptionOfferCodeCustomCode.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcode/attributes
+type SubscriptionOfferCodeCustomCodeAttributes struct {
+	Active         *bool     `json:"active,omitempty"`
+	CreatedDate    *DateTime `json:"createdDate,omitempty"`
+	CustomCode     *string   `json:"customCode,omitempty"`
+	ExpirationDate *Date     `json:"expirationDate,omitempty"`
+	NumberOfCodes  *int      `json:"numberOfCodes,omitempty"`
+}
+
+// SubscriptionOfferCodeCustomCodeRelationships defines model for SubscriptionOfferCodeCustomCode.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcode/relationships
+type SubscriptionOfferCodeCustomCodeRelationships struct {
+	OfferCode *Relationship `json:"offerCode,omitempty"`
+}
+
+// SubscriptionOfferCodeCustomCodeResponse defines model for SubscriptionOfferCodeCustomCodeResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcoderesponse
+type SubscriptionOfferCodeCustomCodeResponse struct {
+	Data     SubscriptionOfferCodeCustomCode `json:"data"`
+	Included []SubscriptionOfferCode         `json:"included,omitempty"`
+	Links    DocumentLinks                   `json:"links"`
+}
+
+// SubscriptionOfferCodeCustomCodesResponse defines model for SubscriptionOfferCodeCustomCodesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcodesresponse
+type SubscriptionOfferCodeCustomCodesResponse struct {
+	Data     []SubscriptionOfferCodeCustomCode `json:"data"`
+	Included []SubscriptionOfferCode           `json:"included,omitempty"`
+	Links    PagedDocumentLinks                `json:"links"`
+	Meta     *PagingInformation                `json:"meta,omitempty"`
+}
+
+// SubscriptionOfferCodeOneTimeUseCode defines model for SubscriptionOfferCodeOneTimeUseCode.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecode
+type SubscriptionOfferCodeOneTimeUseCode struct {
+	Attributes    *SubscriptionOfferCodeOneTimeUseCodeAttributes    `json:"attributes,omitempty"`
+	ID            string                                            `json:"id"`
+	Links         ResourceLinks                                     `json:"links"`
+	Relationships *SubscriptionOfferCodeOneTimeUseCodeRelationships `json:"relationships,omitempty"`
+	Type          string                                            `json:"type"`
+}
+
+// SubscriptionOfferCodeOneTimeUseCodeAttributes defines model for SubscriptionOfferCodeOneTimeUseCode.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecode/attributes
+type SubscriptionOfferCodeOneTimeUseCodeAttributes struct {
+	Active         *bool     `json:"active,omitempty"`
+	CreatedDate    *DateTime `json:"createdDate,omitempty"`
+	ExpirationDate *Date     `json:"expirationDate,omitempty"`
+	NumberOfCodes  *int      `json:"numberOfCodes,omitempty"`
+}
+
+// SubscriptionOfferCodeOneTimeUseCodeRelationships defines model for SubscriptionOfferCodeOneTimeUseCode.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecode/relationships
+type SubscriptionOfferCodeOneTimeUseCodeRelationships struct {
+	OfferCode *Relationship `json:"offerCode,omitempty"`
+}
+
+// SubscriptionOfferCodeOneTimeUseCodeResponse defines model for SubscriptionOfferCodeOneTimeUseCodeResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecoderesponse
+type SubscriptionOfferCodeOneTimeUseCodeResponse struct {
+	Data     SubscriptionOfferCodeOneTimeUseCode `json:"data"`
+	Included []SubscriptionOfferCode             `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// SubscriptionOfferCodeOneTimeUseCodesResponse defines model for SubscriptionOfferCodeOneTimeUseCodesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecodesresponse
+type SubscriptionOfferCodeOneTimeUseCodesResponse struct {
+	Data     []SubscriptionOfferCodeOneTimeUseCode `json:"data"`
+	Included []SubscriptionOfferCode               `json:"included,omitempty"`
+	Links    PagedDocumentLinks                    `json:"links"`
+	Meta     *PagingInformation                    `json:"meta,omitempty"`
+}
+
+// subscriptionOfferCodeCreateRequest defines model for SubscriptionOfferCodeCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecreaterequest/data
+type subscriptionOfferCodeCreateRequest struct {
+	Attributes    SubscriptionOfferCodeCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionOfferCodeCreateRequestRelationships `json:"relationships"`
+	Type          string                                          `json:"type"`
+}
+
+// SubscriptionOfferCodeCreateRequestAttributes are attributes for SubscriptionOfferCodeCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecreaterequest/data/attributes
+type SubscriptionOfferCodeCreateRequestAttributes struct {
+	CustomerEligibilities []SubscriptionCustomerEligibility `json:"customerEligibilities"`
+	Duration              SubscriptionOfferDuration         `json:"duration"`
+	Name                  string                            `json:"name"`
+	NumberOfPeriods       int                               `json:"numberOfPeriods"`
+	OfferEligibility      SubscriptionOfferEligibility      `json:"offerEligibility"`
+	OfferMode             SubscriptionOfferMode             `json:"offerMode"`
+}
+
+// subscriptionOfferCodeCreateRequestRelationships are relationships for SubscriptionOfferCodeCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecreaterequest/data/relationships
+type subscriptionOfferCodeCreateRequestRelationships struct {
+	Prices       pagedRelationshipDeclaration `json:"prices"`
+	Subscription relationshipDeclaration      `json:"subscription"`
+}
+
+// subscriptionOfferCodeUpdateRequest defines model for SubscriptionOfferCodeUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeupdaterequest/data
+type subscriptionOfferCodeUpdateRequest struct {
+	Attributes *subscriptionOfferCodeActiveAttributes `json:"attributes,omitempty"`
+	ID         string                                 `json:"id"`
+	Type       string                                 `json:"type"`
+}
+
+// subscriptionOfferCodeActiveAttributes are the attributes for updating an offer code, a custom code, or a batch of
+// one-time use codes, all of which can only be activated or deactivated.
+type subscriptionOfferCodeActiveAttributes struct {
+	Active *bool `json:"active,omitempty"`
+}
+
+// subscriptionOfferCodeCustomCodeCreateRequest defines model for SubscriptionOfferCodeCustomCodeCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcodecreaterequest/data
+type subscriptionOfferCodeCustomCodeCreateRequest struct {
+	Attributes    SubscriptionOfferCodeCustomCodeCreateRequestAttributes `json:"attributes"`
+	Relationships subscriptionOfferCodeCodesCreateRequestRelationships   `json:"relationships"`
+	Type          string                                                 `json:"type"`
+}
+
+// SubscriptionOfferCodeCustomCodeCreateRequestAttributes are attributes for SubscriptionOfferCodeCustomCodeCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodecustomcodecreaterequest/data/attributes
+type SubscriptionOfferCodeCustomCodeCreateRequestAttributes struct {
+	CustomCode     string `json:"customCode"`
+	ExpirationDate *Date  `json:"expirationDate,omitempty"`
+	NumberOfCodes  int    `json:"numberOfCodes"`
+}
+
+// subscriptionOfferCodeCodesCreateRequestRelationships are relationships for SubscriptionOfferCodeCustomCodeCreateRequest
+// and SubscriptionOfferCodeOneTimeUseCodeCreateRequest
+type subscriptionOfferCodeCodesCreateRequestRelationships struct {
+	OfferCode relationshipDeclaration `json:"offerCode"`
+}
+
+// subscriptionOfferCodeOneTimeUseCodeCreateRequest defines model for SubscriptionOfferCodeOneTimeUseCodeCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecodecreaterequest/data
+type subscriptionOfferCodeOneTimeUseCodeCreateRequest struct {
+	Attributes    subscriptionOfferCodeOneTimeUseCodeCreateRequestAttributes `json:"attributes"`
+	Relationships subscriptionOfferCodeCodesCreateRequestRelationships       `json:"relationships"`
+	Type          string                                                     `json:"type"`
+}
+
+// subscriptionOfferCodeOneTimeUseCodeCreateRequestAttributes are attributes for SubscriptionOfferCodeOneTimeUseCodeCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionoffercodeonetimeusecodecreaterequest/data/attributes
+type subscriptionOfferCodeOneTimeUseCodeCreateRequestAttributes struct {
+	ExpirationDate Date `json:"expirationDate"`
+	NumberOfCodes  int  `json:"numberOfCodes"`
+}
+
+// ListOfferCodesForSubscriptionQuery are query options for ListOfferCodesForSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id_offercodes
+type ListOfferCodesForSubscriptionQuery struct {
+	FieldsSubscriptionOfferCodes               []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	FieldsSubscriptionOfferCodeCustomCodes     []string `url:"fields[subscriptionOfferCodeCustomCodes],omitempty"`
+	FieldsSubscriptionOfferCodeOneTimeUseCodes []string `url:"fields[subscriptionOfferCodeOneTimeUseCodes],omitempty"`
+	FieldsSubscriptionOfferCodePrices          []string `url:"fields[subscriptionOfferCodePrices],omitempty"`
+	FieldsSubscriptions                        []string `url:"fields[subscriptions],omitempty"`
+	FilterTerritory                            []string `url:"filter[territory],omitempty"`
+	Include                                    []string `url:"include,omitempty"`
+	Limit                                      int      `url:"limit,omitempty"`
+	LimitCustomCodes                           int      `url:"limit[customCodes],omitempty"`
+	LimitOneTimeUseCodes                       int      `url:"limit[oneTimeUseCodes],omitempty"`
+	LimitPrices                                int      `url:"limit[prices],omitempty"`
+	Cursor                                     string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionOfferCodeQuery are query options for GetSubscriptionOfferCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodes_id
+type GetSubscriptionOfferCodeQuery struct {
+	FieldsSubscriptionOfferCodes               []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	FieldsSubscriptionOfferCodeCustomCodes     []string `url:"fields[subscriptionOfferCodeCustomCodes],omitempty"`
+	FieldsSubscriptionOfferCodeOneTimeUseCodes []string `url:"fields[subscriptionOfferCodeOneTimeUseCodes],omitempty"`
+	FieldsSubscriptionOfferCodePrices          []string `url:"fields[subscriptionOfferCodePrices],omitempty"`
+	FieldsSubscriptions                        []string `url:"fields[subscriptions],omitempty"`
+	Include                                    []string `url:"include,omitempty"`
+	LimitCustomCodes                           int      `url:"limit[customCodes],omitempty"`
+	LimitOneTimeUseCodes                       int      `url:"limit[oneTimeUseCodes],omitempty"`
+	LimitPrices                                int      `url:"limit[prices],omitempty"`
+}
+
+// ListPricesForSubscriptionOfferCodeQuery are query options for ListPricesForSubscriptionOfferCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodes_id_prices
+type ListPricesForSubscriptionOfferCodeQuery struct {
+	FieldsSubscriptionOfferCodePrices []string `url:"fields[subscriptionOfferCodePrices],omitempty"`
+	FieldsSubscriptionPricePoints     []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories                 []string `url:"fields[territories],omitempty"`
+	FilterTerritory                   []string `url:"filter[territory],omitempty"`
+	Include                           []string `url:"include,omitempty"`
+	Limit                             int      `url:"limit,omitempty"`
+	Cursor                            string   `url:"cursor,omitempty"`
+}
+
+// ListCustomCodesForSubscriptionOfferCodeQuery are query options for ListCustomCodesForSubscriptionOfferCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodes_id_customcodes
+type ListCustomCodesForSubscriptionOfferCodeQuery struct {
+	FieldsSubscriptionOfferCodeCustomCodes []string `url:"fields[subscriptionOfferCodeCustomCodes],omitempty"`
+	FieldsSubscriptionOfferCodes           []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+	Limit                                  int      `url:"limit,omitempty"`
+	Cursor                                 string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionOfferCodeCustomCodeQuery are query options for GetSubscriptionOfferCodeCustomCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodecustomcodes_id
+type GetSubscriptionOfferCodeCustomCodeQuery struct {
+	FieldsSubscriptionOfferCodeCustomCodes []string `url:"fields[subscriptionOfferCodeCustomCodes],omitempty"`
+	FieldsSubscriptionOfferCodes           []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+}
+
+// ListOneTimeUseCodesForSubscriptionOfferCodeQuery are query options for ListOneTimeUseCodesForSubscriptionOfferCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodes_id_onetimeusecodes
+type ListOneTimeUseCodesForSubscriptionOfferCodeQuery struct {
+	FieldsSubscriptionOfferCodeOneTimeUseCodes []string `url:"fields[subscriptionOfferCodeOneTimeUseCodes],omitempty"`
+	FieldsSubscriptionOfferCodes               []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	Include                                    []string `url:"include,omitempty"`
+	Limit                                      int      `url:"limit,omitempty"`
+	Cursor                                     string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionOfferCodeOneTimeUseCodeQuery are query options for GetSubscriptionOfferCodeOneTimeUseCode
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodeonetimeusecodes_id
+type GetSubscriptionOfferCodeOneTimeUseCodeQuery struct {
+	FieldsSubscriptionOfferCodeOneTimeUseCodes []string `url:"fields[subscriptionOfferCodeOneTimeUseCodes],omitempty"`
+	FieldsSubscriptionOfferCodes               []string `url:"fields[subscriptionOfferCodes],omitempty"`
+	Include                                    []string `url:"include,omitempty"`
+}
+
+// ListOfferCodesForSubscription lists the offer codes of a subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id_offercodes
+func (s *AppsService) ListOfferCodesForSubscription(ctx context.Context, id string, params *ListOfferCodesForSubscriptionQuery) (*SubscriptionOfferCodesResponse, *Response, error) {
+	return Get[SubscriptionOfferCodesResponse](ctx, s.client, fmt.Sprintf("subscriptions/%s/offerCodes", id), params)
+}
+
+// GetSubscriptionOfferCode reads the information about an offer code.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodes_id
+func (s *AppsService) GetSubscriptionOfferCode(ctx context.Context, id string, params *GetSubscriptionOfferCodeQuery) (*SubscriptionOfferCodeResponse, *Response, error) {
+	return Get[SubscriptionOfferCodeResponse](ctx, s.client, fmt.Sprintf("subscriptionOfferCodes/%s", id), params)
+}
+
+// ListPricesForSubscriptionOfferCode lists the prices of an offer code in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodes_id_prices
+func (s *AppsService) ListPricesForSubscriptionOfferCode(ctx context.Context, id string, params *ListPricesForSubscriptionOfferCodeQuery) (*SubscriptionOfferCodePricesResponse, *Response, error) {
+	return Get[SubscriptionOfferCodePricesResponse](ctx, s.client, fmt.Sprintf("subscriptionOfferCodes/%s/prices", id), params)
+}
+
+// CreateSubscriptionOfferCode creates an offer code for a subscription with its price in each territory. Codes that
+// customers can redeem are added to it afterwards with CreateSubscriptionOfferCodeCustomCode or
+// CreateSubscriptionOfferCodeOneTimeUseCodes.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_subscriptionoffercodes
+func (s *AppsService) CreateSubscriptionOfferCode(ctx context.Context, attributes SubscriptionOfferCodeCreateRequestAttributes, subscriptionID string, prices []SubscriptionOfferPrice) (*SubscriptionOfferCodeResponse, *Response, error) {
+	newPrices, priceIDs := newSubscriptionOfferPrices(prices, "subscriptionOfferCodePrices")
+	req := subscriptionOfferCodeCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionOfferCodeCreateRequestRelationships{
+			Prices:       newPagedRelationshipDeclaration(priceIDs, "subscriptionOfferCodePrices"),
+			Subscription: *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+		},
+		Type: "subscriptionOfferCodes",
+	}
+	res := new(SubscriptionOfferCodeResponse)
+	resp, err := s.client.post(ctx, "subscriptionOfferCodes", newRequestBodyWithIncluded(req, newPrices), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionOfferCode activates or deactivates an offer code.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_subscriptionoffercodes_id
+func (s *AppsService) UpdateSubscriptionOfferCode(ctx context.Context, id string, active *bool) (*SubscriptionOfferCodeResponse, *Response, error) {
+	req := newSubscriptionOfferCodeActiveRequest(id, "subscriptionOfferCodes", active)
+	url := fmt.Sprintf("subscriptionOfferCodes/%s", id)
+	res := new(SubscriptionOfferCodeResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListCustomCodesForSubscriptionOfferCode lists the custom codes of an offer code.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodes_id_customcodes
+func (s *AppsService) ListCustomCodesForSubscriptionOfferCode(ctx context.Context, id string, params *ListCustomCodesForSubscriptionOfferCodeQuery) (*SubscriptionOfferCodeCustomCodesResponse, *Response, error) {
+	return Get[SubscriptionOfferCodeCustomCodesResponse](ctx, s.client, fmt.Sprintf("subscriptionOfferCodes/%s/customCodes", id), params)
+}
+
+// GetSubscriptionOfferCodeCustomCode reads the information about a custom code.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodecustomcodes_id
+func (s *AppsService) GetSubscriptionOfferCodeCustomCode(ctx context.Context, id string, params *GetSubscriptionOfferCodeCustomCodeQuery) (*SubscriptionOfferCodeCustomCodeResponse, *Response, error) {
+	return Get[SubscriptionOfferCodeCustomCodeResponse](ctx, s.client, fmt.Sprintf("subscriptionOfferCodeCustomCodes/%s", id), params)
+}
+
+// CreateSubscriptionOfferCodeCustomCode creates a custom code, such as a campaign keyword, that customers can redeem
+// for an offer code up to the given number of times.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_subscriptionoffercodecustomcodes
+func (s *AppsService) CreateSubscriptionOfferCodeCustomCode(ctx context.Context, attributes SubscriptionOfferCodeCustomCodeCreateRequestAttributes, offerCodeID string) (*SubscriptionOfferCodeCustomCodeResponse, *Response, error) {
+	req := subscriptionOfferCodeCustomCodeCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionOfferCodeCodesCreateRequestRelationships{
+			OfferCode: *newRelationshipDeclaration(&offerCodeID, "subscriptionOfferCodes"),
+		},
+		Type: "subscriptionOfferCodeCustomCodes",
+	}
+	res := new(SubscriptionOfferCodeCustomCodeResponse)
+	resp, err := s.client.post(ctx, "subscriptionOfferCodeCustomCodes", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionOfferCodeCustomCode activates or deactivates a custom code.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_subscriptionoffercodecustomcodes_id
+func (s *AppsService) UpdateSubscriptionOfferCodeCustomCode(ctx context.Context, id string, active *bool) (*SubscriptionOfferCodeCustomCodeResponse, *Response, error) {
+	req := newSubscriptionOfferCodeActiveRequest(id, "subscriptionOfferCodeCustomCodes", active)
+	url := fmt.Sprintf("subscriptionOfferCodeCustomCodes/%s", id)
+	res := new(SubscriptionOfferCodeCustomCodeResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListOneTimeUseCodesForSubscriptionOfferCode lists the batches of one-time use codes generated for an offer code.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodes_id_onetimeusecodes
+func (s *AppsService) ListOneTimeUseCodesForSubscriptionOfferCode(ctx context.Context, id string, params *ListOneTimeUseCodesForSubscriptionOfferCodeQuery) (*SubscriptionOfferCodeOneTimeUseCodesResponse, *Response, error) {
+	return Get[SubscriptionOfferCodeOneTimeUseCodesResponse](ctx, s.client, fmt.Sprintf("subscriptionOfferCodes/%s/oneTimeUseCodes", id), params)
+}
+
+// GetSubscriptionOfferCodeOneTimeUseCode reads the information about a batch of one-time use codes.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodeonetimeusecodes_id
+func (s *AppsService) GetSubscriptionOfferCodeOneTimeUseCode(ctx context.Context, id string, params *GetSubscriptionOfferCodeOneTimeUseCodeQuery) (*SubscriptionOfferCodeOneTimeUseCodeResponse, *Response, error) {
+	return Get[SubscriptionOfferCodeOneTimeUseCodeResponse](ctx, s.client, fmt.Sprintf("subscriptionOfferCodeOneTimeUseCodes/%s", id), params)
+}
+
+// CreateSubscriptionOfferCodeOneTimeUseCodes generates a batch of one-time use codes for an offer code that expire
+// on the given date. The codes themselves are generated asynchronously, and can be downloaded with
+// DownloadSubscriptionOfferCodeOneTimeUseCodeValues once they are ready.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_subscriptionoffercodeonetimeusecodes
+func (s *AppsService) CreateSubscriptionOfferCodeOneTimeUseCodes(ctx context.Context, numberOfCodes int, expirationDate Date, offerCodeID string) (*SubscriptionOfferCodeOneTimeUseCodeResponse, *Response, error) {
+	req := subscriptionOfferCodeOneTimeUseCodeCreateRequest{
+		Attributes: subscriptionOfferCodeOneTimeUseCodeCreateRequestAttributes{
+			ExpirationDate: expirationDate,
+			NumberOfCodes:  numberOfCodes,
+		},
+		Relationships: subscriptionOfferCodeCodesCreateRequestRelationships{
+			OfferCode: *newRelationshipDeclaration(&offerCodeID, "subscriptionOfferCodes"),
+		},
+		Type: "subscriptionOfferCodeOneTimeUseCodes",
+	}
+	res := new(SubscriptionOfferCodeOneTimeUseCodeResponse)
+	resp, err := s.client.post(ctx, "subscriptionOfferCodeOneTimeUseCodes", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionOfferCodeOneTimeUseCode activates or deactivates a batch of one-time use codes.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_subscriptionoffercodeonetimeusecodes_id
+func (s *AppsService) UpdateSubscriptionOfferCodeOneTimeUseCode(ctx context.Context, id string, active *bool) (*SubscriptionOfferCodeOneTimeUseCodeResponse, *Response, error) {
+	req := newSubscriptionOfferCodeActiveRequest(id, "subscriptionOfferCodeOneTimeUseCodes", active)
+	url := fmt.Sprintf("subscriptionOfferCodeOneTimeUseCodes/%s", id)
+	res := new(SubscriptionOfferCodeOneTimeUseCodeResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DownloadSubscriptionOfferCodeOneTimeUseCodeValues downloads the codes in a batch of one-time use codes as CSV,
+// ready to be handed out to customers.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionoffercodeonetimeusecodes_id_values
+func (s *AppsService) DownloadSubscriptionOfferCodeOneTimeUseCodeValues(ctx context.Context, id string) (io.Reader, *Response, error) {
+	url := fmt.Sprintf("subscriptionOfferCodeOneTimeUseCodes/%s/values", id)
+	buffer := new(bytes.Buffer)
+	resp, err := s.client.get(ctx, url, nil, buffer, withAccept("text/csv"))
+
+	return buffer, resp, err
+}
+
+func newSubscriptionOfferCodeActiveRequest(id string, typ string, active *bool) subscriptionOfferCodeUpdateRequest {
+	req := subscriptionOfferCodeUpdateRequest{
+		ID:   id,
+		Type: typ,
+	}
+
+	if active != nil {
+		req.Attributes = &subscriptionOfferCodeActiveAttributes{
+			Active: active,
+		}
+	}
+
+	return req
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in SubscriptionOfferCodeResponseIncluded.
+func (i *SubscriptionOfferCodeResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// Subscription returns the Subscription stored within, if one is present.
+func (i *SubscriptionOfferCodeResponseIncluded) Subscription() *Subscription {
+	return extractIncludedSubscription(i.inner)
+}
+
+// SubscriptionOfferCodeCustomCode returns the SubscriptionOfferCodeCustomCode stored within, if one is present.
+func (i *SubscriptionOfferCodeResponseIncluded) SubscriptionOfferCodeCustomCode() *SubscriptionOfferCodeCustomCode {
+	return extractIncludedSubscriptionOfferCodeCustomCode(i.inner)
+}
+
+// SubscriptionOfferCodeOneTimeUseCode returns the SubscriptionOfferCodeOneTimeUseCode stored within, if one is present.
+func (i *SubscriptionOfferCodeResponseIncluded) SubscriptionOfferCodeOneTimeUseCode() *SubscriptionOfferCodeOneTimeUseCode {
+	return extractIncludedSubscriptionOfferCodeOneTimeUseCode(i.inner)
+}
+
+// SubscriptionOfferCodePrice returns the SubscriptionOfferCodePrice stored within, if one is present.
+func (i *SubscriptionOfferCodeResponseIncluded) SubscriptionOfferCodePrice() *SubscriptionOfferCodePrice {
+	return extractIncludedSubscriptionOfferCodePrice(i.inner)
+}
@@ -0,0 +1,202 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListOfferCodesForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListOfferCodesForSubscription(ctx, "10", &ListOfferCodesForSubscriptionQuery{})
+	})
+}
+
+func TestGetSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetSubscriptionOfferCode(ctx, "10", &GetSubscriptionOfferCodeQuery{})
+	})
+}
+
+func TestGetSubscriptionOfferCodeIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"subscriptions"},{"type":"subscriptionOfferCodeCustomCodes"},{"type":"subscriptionOfferCodeOneTimeUseCodes"},{"type":"subscriptionOfferCodePrices"}]}`, func(ctx context.Context, client *Client) {
+		offerCode, _, err := client.Apps.GetSubscriptionOfferCode(ctx, "10", &GetSubscriptionOfferCodeQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, offerCode.Included)
+
+		assert.NotNil(t, offerCode.Included[0].Subscription())
+		assert.NotNil(t, offerCode.Included[1].SubscriptionOfferCodeCustomCode())
+		assert.NotNil(t, offerCode.Included[2].SubscriptionOfferCodeOneTimeUseCode())
+		assert.NotNil(t, offerCode.Included[3].SubscriptionOfferCodePrice())
+
+		assert.Nil(t, offerCode.Included[0].SubscriptionOfferCodePrice())
+		assert.Nil(t, offerCode.Included[1].Subscription())
+		assert.Nil(t, offerCode.Included[2].SubscriptionOfferCodeCustomCode())
+		assert.Nil(t, offerCode.Included[3].SubscriptionOfferCodeOneTimeUseCode())
+	})
+}
+
+func TestListPricesForSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodePricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListPricesForSubscriptionOfferCode(ctx, "10", &ListPricesForSubscriptionOfferCodeQuery{})
+	})
+}
+
+func TestCreateSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateSubscriptionOfferCode(ctx, SubscriptionOfferCodeCreateRequestAttributes{
+			CustomerEligibilities: []SubscriptionCustomerEligibility{SubscriptionCustomerEligibilityNew, SubscriptionCustomerEligibilityExpired},
+			Duration:              SubscriptionOfferDurationOneMonth,
+			Name:                  "Spring campaign",
+			NumberOfPeriods:       1,
+			OfferEligibility:      SubscriptionOfferEligibilityReplaceIntroOffers,
+			OfferMode:             SubscriptionOfferModeFreeTrial,
+		}, "10", []SubscriptionOfferPrice{
+			{TerritoryID: "USA"},
+		})
+	})
+}
+
+func TestUpdateSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateSubscriptionOfferCode(ctx, "10", Bool(false))
+	})
+}
+
+func TestListCustomCodesForSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeCustomCodesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListCustomCodesForSubscriptionOfferCode(ctx, "10", &ListCustomCodesForSubscriptionOfferCodeQuery{})
+	})
+}
+
+func TestGetSubscriptionOfferCodeCustomCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeCustomCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetSubscriptionOfferCodeCustomCode(ctx, "10", &GetSubscriptionOfferCodeCustomCodeQuery{})
+	})
+}
+
+func TestCreateSubscriptionOfferCodeCustomCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeCustomCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateSubscriptionOfferCodeCustomCode(ctx, SubscriptionOfferCodeCustomCodeCreateRequestAttributes{
+			CustomCode:    "SPRING",
+			NumberOfCodes: 500,
+		}, "10")
+	})
+}
+
+func TestUpdateSubscriptionOfferCodeCustomCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeCustomCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateSubscriptionOfferCodeCustomCode(ctx, "10", Bool(false))
+	})
+}
+
+func TestListOneTimeUseCodesForSubscriptionOfferCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeOneTimeUseCodesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListOneTimeUseCodesForSubscriptionOfferCode(ctx, "10", &ListOneTimeUseCodesForSubscriptionOfferCodeQuery{})
+	})
+}
+
+func TestGetSubscriptionOfferCodeOneTimeUseCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeOneTimeUseCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetSubscriptionOfferCodeOneTimeUseCode(ctx, "10", &GetSubscriptionOfferCodeOneTimeUseCodeQuery{})
+	})
+}
+
+func TestCreateSubscriptionOfferCodeOneTimeUseCodes(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeOneTimeUseCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateSubscriptionOfferCodeOneTimeUseCodes(ctx, 1000, Date{}, "10")
+	})
+}
+
+func TestUpdateSubscriptionOfferCodeOneTimeUseCode(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionOfferCodeOneTimeUseCodeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateSubscriptionOfferCodeOneTimeUseCode(ctx, "10", nil)
+	})
+}
+
+func TestDownloadSubscriptionOfferCodeOneTimeUseCodeValues(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior("CODE1\nCODE2", func(ctx context.Context, client *Client) {
+		values, resp, err := client.Apps.DownloadSubscriptionOfferCodeOneTimeUseCodeValues(ctx, "10")
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Equal(t, "text/csv", resp.Request.Header.Get("Accept"))
+
+		b, err := io.ReadAll(values)
+		assert.NoError(t, err)
+		assert.Equal(t, "CODE1\nCODE2\n", string(b))
+	})
+}
+
+func TestParseSubscriptionCustomerEligibility(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseSubscriptionCustomerEligibility(string(SubscriptionCustomerEligibilityNew))
+	assert.NoError(t, err)
+	assert.Equal(t, SubscriptionCustomerEligibilityNew, got)
+
+	_, err = ParseSubscriptionCustomerEligibility("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "SubscriptionCustomerEligibility", Value: "UNKNOWN"}, err)
+}
+
+func TestParseSubscriptionOfferEligibility(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseSubscriptionOfferEligibility(string(SubscriptionOfferEligibilityStackWithIntroOffers))
+	assert.NoError(t, err)
+	assert.Equal(t, SubscriptionOfferEligibilityStackWithIntroOffers, got)
+
+	_, err = ParseSubscriptionOfferEligibility("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "SubscriptionOfferEligibility", Value: "UNKNOWN"}, err)
+}
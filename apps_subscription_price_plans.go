@@ -0,0 +1,324 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SubscriptionPriceTarget is the price a subscription should have in a group of territories.
+type SubscriptionPriceTarget struct {
+	// Territories are the territories in the group, such as "USA" or "GBR".
+	Territories []string
+	// CustomerPrice is the price customers pay in each of the territories, such as "4.99".
+	CustomerPrice string
+}
+
+// SubscriptionPricePlanOptions configures PlanSubscriptionPrices.
+type SubscriptionPricePlanOptions struct {
+	// StartDate schedules the new prices. The changes take effect immediately if it is nil.
+	StartDate *Date
+	// PreserveCurrentPrice keeps existing subscribers on the price they currently pay when a price increases.
+	// Price decreases always apply to existing subscribers.
+	PreserveCurrentPrice bool
+}
+
+// SubscriptionPriceChange is a single price that a SubscriptionPricePlan schedules in a territory.
+type SubscriptionPriceChange struct {
+	Territory            string `json:"territory"`
+	CurrentPrice         string `json:"currentPrice,omitempty"`
+	NewPrice             string `json:"newPrice"`
+	PricePointID         string `json:"pricePointId"`
+	StartDate            *Date  `json:"startDate,omitempty"`
+	PreserveCurrentPrice bool   `json:"preserveCurrentPrice"`
+}
+
+// SubscriptionPricePlan is the set of price changes needed to bring a subscription to its target prices.
+// Review it with String before passing it to ApplySubscriptionPricePlan.
+type SubscriptionPricePlan struct {
+	SubscriptionID string                    `json:"subscriptionId"`
+	Changes        []SubscriptionPriceChange `json:"changes"`
+	// Scheduled are the target prices that are already scheduled to start on the planned date, such as by an
+	// earlier run of the same plan. Applying the plan leaves them as they are.
+	Scheduled []SubscriptionPriceChange `json:"scheduled,omitempty"`
+}
+
+// OutputKind implements Output.
+func (p SubscriptionPricePlan) OutputKind() string {
+	return "subscriptionPricePlan"
+}
+
+// MarshalJSON encodes a plan without changes with an empty list of changes rather than null.
+func (p SubscriptionPricePlan) MarshalJSON() ([]byte, error) {
+	type plan SubscriptionPricePlan
+
+	if p.Changes == nil {
+		p.Changes = []SubscriptionPriceChange{}
+	}
+
+	return json.Marshal(plan(p))
+}
+
+// Increase reports whether the change raises the price customers pay. A territory without a current price is
+// not considered an increase.
+func (c SubscriptionPriceChange) Increase() bool {
+	current, err := strconv.ParseFloat(c.CurrentPrice, 64)
+	if err != nil {
+		return false
+	}
+
+	next, err := strconv.ParseFloat(c.NewPrice, 64)
+	if err != nil {
+		return false
+	}
+
+	return next > current
+}
+
+func (c SubscriptionPriceChange) String() string {
+	current := c.CurrentPrice
+	if current == "" {
+		current = "none"
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s: %s -> %s", c.Territory, current, c.NewPrice)
+
+	if c.StartDate != nil {
+		fmt.Fprintf(&b, " starting %s", c.StartDate.Format(dateFormat))
+	} else {
+		b.WriteString(" immediately")
+	}
+
+	if c.PreserveCurrentPrice {
+		b.WriteString(", preserving the current price for existing subscribers")
+	}
+
+	return b.String()
+}
+
+func (p *SubscriptionPricePlan) String() string {
+	var b strings.Builder
+
+	if len(p.Changes) == 0 {
+		fmt.Fprintf(&b, "subscription %s: no price changes\n", p.SubscriptionID)
+	} else {
+		fmt.Fprintf(&b, "subscription %s: %d price changes\n", p.SubscriptionID, len(p.Changes))
+	}
+
+	for _, change := range p.Changes {
+		fmt.Fprintf(&b, "  %s\n", change)
+	}
+
+	for _, change := range p.Scheduled {
+		fmt.Fprintf(&b, "  %s (already scheduled)\n", change)
+	}
+
+	return b.String()
+}
+
+// PlanSubscriptionPrices compares the current prices of a subscription with the target prices of each group of
+// territories, resolves the matching price points, and returns the changes needed without applying them.
+// Territories that are already at their target price are left out of the plan, and territories whose target price
+// is already scheduled for the planned start date are listed in its Scheduled changes, so that planning again
+// after applying a plan has no changes.
+func (s *AppsService) PlanSubscriptionPrices(ctx context.Context, subscriptionID string, targets []SubscriptionPriceTarget, opts *SubscriptionPricePlanOptions) (*SubscriptionPricePlan, error) {
+	if opts == nil {
+		opts = &SubscriptionPricePlanOptions{}
+	}
+
+	prices, err := s.subscriptionPricesByTerritory(ctx, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &SubscriptionPricePlan{SubscriptionID: subscriptionID}
+
+	for _, target := range targets {
+		for _, territory := range target.Territories {
+			point, err := s.FindSubscriptionPricePoint(ctx, subscriptionID, territory, target.CustomerPrice)
+			if err != nil {
+				return nil, err
+			}
+
+			existing := prices[territory]
+			if existing.current.pricePointID == point.ID {
+				continue
+			}
+
+			change := SubscriptionPriceChange{
+				Territory:    territory,
+				CurrentPrice: existing.current.customerPrice,
+				NewPrice:     target.CustomerPrice,
+				PricePointID: point.ID,
+				StartDate:    opts.StartDate,
+			}
+			if point.Attributes != nil && point.Attributes.CustomerPrice != nil {
+				change.NewPrice = *point.Attributes.CustomerPrice
+			}
+
+			change.PreserveCurrentPrice = opts.PreserveCurrentPrice && change.Increase()
+
+			if scheduled := existing.scheduledAt(opts.StartDate); scheduled != nil && scheduled.pricePointID == point.ID {
+				change.PreserveCurrentPrice = scheduled.preserved
+				plan.Scheduled = append(plan.Scheduled, change)
+
+				continue
+			}
+
+			plan.Changes = append(plan.Changes, change)
+		}
+	}
+
+	return plan, nil
+}
+
+// ApplySubscriptionPricePlan schedules every change in a plan. It stops at the first failure and returns the
+// prices that were created before it, so that the plan can be resumed after the cause is fixed.
+func (s *AppsService) ApplySubscriptionPricePlan(ctx context.Context, plan *SubscriptionPricePlan) ([]SubscriptionPrice, error) {
+	prices := make([]SubscriptionPrice, 0, len(plan.Changes))
+
+	for _, change := range plan.Changes {
+		territory := change.Territory
+		attributes := &SubscriptionPriceCreateRequestAttributes{StartDate: change.StartDate}
+
+		if change.PreserveCurrentPrice {
+			attributes.PreserveCurrentPrice = Bool(true)
+		}
+
+		price, _, err := s.CreateSubscriptionPrice(ctx, attributes, plan.SubscriptionID, change.PricePointID, &territory)
+		if err != nil {
+			return prices, fmt.Errorf("%s: %w", territory, err)
+		}
+
+		prices = append(prices, price.Data)
+	}
+
+	return prices, nil
+}
+
+type subscriptionTerritoryPrice struct {
+	pricePointID  string
+	customerPrice string
+	startDate     *Date
+	preserved     bool
+}
+
+// subscriptionTerritoryPrices are the price a subscription has in a territory and the prices scheduled to
+// replace it.
+type subscriptionTerritoryPrices struct {
+	current   subscriptionTerritoryPrice
+	scheduled []subscriptionTerritoryPrice
+}
+
+// scheduledAt returns the price scheduled to start on date, or nil if there is none or date is nil.
+func (p subscriptionTerritoryPrices) scheduledAt(date *Date) *subscriptionTerritoryPrice {
+	if date == nil {
+		return nil
+	}
+
+	for i := range p.scheduled {
+		if p.scheduled[i].startDate.Format(dateFormat) == date.Format(dateFormat) {
+			return &p.scheduled[i]
+		}
+	}
+
+	return nil
+}
+
+func (s *AppsService) subscriptionPricesByTerritory(ctx context.Context, subscriptionID string) (map[string]subscriptionTerritoryPrices, error) {
+	byTerritory := make(map[string][]SubscriptionPrice)
+	points := make(map[string]string)
+	params := &ListPricesForSubscriptionQuery{
+		Include: []string{"subscriptionPricePoint", "territory"},
+		Limit:   MaxPageLimit,
+	}
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		prices, _, err := s.ListPricesForSubscription(ctx, subscriptionID, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, price := range prices.Data {
+			if price.Relationships == nil || price.Relationships.Territory == nil || price.Relationships.Territory.Data == nil {
+				continue
+			}
+
+			territory := price.Relationships.Territory.Data.ID
+			byTerritory[territory] = append(byTerritory[territory], price)
+		}
+
+		for _, included := range prices.Included {
+			if point := included.SubscriptionPricePoint(); point != nil && point.Attributes != nil && point.Attributes.CustomerPrice != nil {
+				points[point.ID] = *point.Attributes.CustomerPrice
+			}
+		}
+
+		return &prices.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	result := make(map[string]subscriptionTerritoryPrices, len(byTerritory))
+
+	for territory, prices := range byTerritory {
+		var territoryPrices subscriptionTerritoryPrices
+
+		current := currentSubscriptionPrice(prices, now)
+
+		for i := range prices {
+			price := &prices[i]
+			if price.Relationships.SubscriptionPricePoint == nil || price.Relationships.SubscriptionPricePoint.Data == nil {
+				continue
+			}
+
+			id := price.Relationships.SubscriptionPricePoint.Data.ID
+			territoryPrice := subscriptionTerritoryPrice{pricePointID: id, customerPrice: points[id]}
+
+			if price.Attributes != nil {
+				territoryPrice.startDate = price.Attributes.StartDate
+				territoryPrice.preserved = boolValue(price.Attributes.Preserved)
+			}
+
+			switch {
+			case price == current:
+				territoryPrices.current = territoryPrice
+			case territoryPrice.startDate != nil && territoryPrice.startDate.After(now):
+				territoryPrices.scheduled = append(territoryPrices.scheduled, territoryPrice)
+			}
+		}
+
+		result[territory] = territoryPrices
+	}
+
+	return result, nil
+}
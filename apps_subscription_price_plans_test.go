@@ -0,0 +1,221 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newSubscriptionPricePlanServer(t *testing.T, created *[]string) (*Client, *httptest.Server) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /subscriptions/10/prices":
+			// Prices created by the test are listed as well, as a scheduled price would be.
+			var scheduled strings.Builder
+
+			for i, b := range *created {
+				var body struct {
+					Data subscriptionPriceCreateRequest `json:"data"`
+				}
+
+				_ = json.Unmarshal([]byte(b), &body)
+				attributes, _ := json.Marshal(SubscriptionPriceAttributes{StartDate: body.Data.Attributes.StartDate, Preserved: body.Data.Attributes.PreserveCurrentPrice})
+				fmt.Fprintf(&scheduled, `{"type":"subscriptionPrices","id":"new-%d","attributes":%s,"relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"%s"}},"territory":{"data":{"type":"territories","id":"%s"}}}},`,
+					i, attributes, body.Data.Relationships.SubscriptionPricePoint.Data.ID, body.Data.Relationships.Territory.Data.ID)
+			}
+
+			fmt.Fprintln(w, `{"data":[`+scheduled.String()+`
+				{"type":"subscriptionPrices","id":"p1","attributes":{"startDate":"2020-01-01"},"relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"usa-399"}},"territory":{"data":{"type":"territories","id":"USA"}}}},
+				{"type":"subscriptionPrices","id":"p2","relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"gbr-499"}},"territory":{"data":{"type":"territories","id":"GBR"}}}},
+				{"type":"subscriptionPrices","id":"p3","relationships":{"subscriptionPricePoint":{"data":{"type":"subscriptionPricePoints","id":"can-499"}},"territory":{"data":{"type":"territories","id":"CAN"}}}}
+			],"included":[
+				{"type":"subscriptionPricePoints","id":"usa-399","attributes":{"customerPrice":"3.99"}},
+				{"type":"subscriptionPricePoints","id":"gbr-499","attributes":{"customerPrice":"4.99"}},
+				{"type":"subscriptionPricePoints","id":"can-499","attributes":{"customerPrice":"4.99"}}
+			]}`)
+		case "GET /subscriptions/10/pricePoints":
+			territory := r.URL.Query().Get("filter[territory]")
+			fmt.Fprintf(w, `{"data":[
+				{"type":"subscriptionPricePoints","id":"%[1]s-399","attributes":{"customerPrice":"3.99"}},
+				{"type":"subscriptionPricePoints","id":"%[1]s-499","attributes":{"customerPrice":"4.99"}}
+			]}`+"\n", map[string]string{"USA": "usa", "GBR": "gbr", "CAN": "can"}[territory])
+		case "POST /subscriptionPrices":
+			b, _ := io.ReadAll(r.Body)
+			*created = append(*created, string(b))
+			fmt.Fprintln(w, `{"data":{"type":"subscriptionPrices","id":"new"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"errors":[{"status":"404"}]}`)
+		}
+	}))
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client, server
+}
+
+func TestPlanSubscriptionPrices(t *testing.T) {
+	t.Parallel()
+
+	var created []string
+
+	client, server := newSubscriptionPricePlanServer(t, &created)
+	defer server.Close()
+
+	start := Date{time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC)}
+	plan, err := client.Apps.PlanSubscriptionPrices(context.Background(), "10", []SubscriptionPriceTarget{
+		{Territories: []string{"USA"}, CustomerPrice: "4.99"},
+		{Territories: []string{"GBR", "CAN"}, CustomerPrice: "3.99"},
+	}, &SubscriptionPricePlanOptions{StartDate: &start, PreserveCurrentPrice: true})
+	assert.NoError(t, err)
+	assert.Equal(t, []SubscriptionPriceChange{
+		{Territory: "USA", CurrentPrice: "3.99", NewPrice: "4.99", PricePointID: "usa-499", StartDate: &start, PreserveCurrentPrice: true},
+		{Territory: "GBR", CurrentPrice: "4.99", NewPrice: "3.99", PricePointID: "gbr-399", StartDate: &start},
+		{Territory: "CAN", CurrentPrice: "4.99", NewPrice: "3.99", PricePointID: "can-399", StartDate: &start},
+	}, plan.Changes)
+	assert.Equal(t, `subscription 10: 3 price changes
+  USA: 3.99 -> 4.99 starting 2030-03-01, preserving the current price for existing subscribers
+  GBR: 4.99 -> 3.99 starting 2030-03-01
+  CAN: 4.99 -> 3.99 starting 2030-03-01
+`, plan.String())
+
+	prices, err := client.Apps.ApplySubscriptionPricePlan(context.Background(), plan)
+	assert.NoError(t, err)
+	assert.Len(t, prices, 3)
+	assert.Len(t, created, 3)
+
+	var body struct {
+		Data subscriptionPriceCreateRequest `json:"data"`
+	}
+
+	err = json.Unmarshal([]byte(created[0]), &body)
+	assert.NoError(t, err)
+	assert.Equal(t, Bool(true), body.Data.Attributes.PreserveCurrentPrice)
+	assert.Equal(t, "usa-499", body.Data.Relationships.SubscriptionPricePoint.Data.ID)
+	assert.Equal(t, "USA", body.Data.Relationships.Territory.Data.ID)
+
+	var decrease struct {
+		Data subscriptionPriceCreateRequest `json:"data"`
+	}
+
+	err = json.Unmarshal([]byte(created[1]), &decrease)
+	assert.NoError(t, err)
+	assert.Nil(t, decrease.Data.Attributes.PreserveCurrentPrice)
+}
+
+func TestPlanSubscriptionPricesUnchanged(t *testing.T) {
+	t.Parallel()
+
+	var created []string
+
+	client, server := newSubscriptionPricePlanServer(t, &created)
+	defer server.Close()
+
+	plan, err := client.Apps.PlanSubscriptionPrices(context.Background(), "10", []SubscriptionPriceTarget{
+		{Territories: []string{"USA"}, CustomerPrice: "3.99"},
+	}, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, plan.Changes)
+	assert.Equal(t, "subscription 10: no price changes\n", plan.String())
+
+	_, err = client.Apps.PlanSubscriptionPrices(context.Background(), "10", []SubscriptionPriceTarget{
+		{Territories: []string{"USA"}, CustomerPrice: "9.99"},
+	}, nil)
+	assert.Equal(t, ErrPricePointNotFound{Territory: "USA", CustomerPrice: "9.99"}, err)
+}
+
+func TestPlanSubscriptionPricesAfterApply(t *testing.T) {
+	t.Parallel()
+
+	var created []string
+
+	client, server := newSubscriptionPricePlanServer(t, &created)
+	defer server.Close()
+
+	start := Date{time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC)}
+	targets := []SubscriptionPriceTarget{
+		{Territories: []string{"USA"}, CustomerPrice: "4.99"},
+		{Territories: []string{"GBR"}, CustomerPrice: "3.99"},
+	}
+	opts := &SubscriptionPricePlanOptions{StartDate: &start, PreserveCurrentPrice: true}
+
+	plan, err := client.Apps.PlanSubscriptionPrices(context.Background(), "10", targets, opts)
+	assert.NoError(t, err)
+	assert.Len(t, plan.Changes, 2)
+
+	_, err = client.Apps.ApplySubscriptionPricePlan(context.Background(), plan)
+	assert.NoError(t, err)
+
+	replan, err := client.Apps.PlanSubscriptionPrices(context.Background(), "10", targets, opts)
+	assert.NoError(t, err)
+	assert.Empty(t, replan.Changes)
+	assert.Equal(t, plan.Changes, replan.Scheduled)
+	assert.Equal(t, `subscription 10: no price changes
+  USA: 3.99 -> 4.99 starting 2030-03-01, preserving the current price for existing subscribers (already scheduled)
+  GBR: 4.99 -> 3.99 starting 2030-03-01 (already scheduled)
+`, replan.String())
+
+	_, err = client.Apps.ApplySubscriptionPricePlan(context.Background(), replan)
+	assert.NoError(t, err)
+	assert.Len(t, created, 2, "applying a plan without changes creates no prices")
+
+	later := Date{time.Date(2030, 4, 1, 0, 0, 0, 0, time.UTC)}
+	moved, err := client.Apps.PlanSubscriptionPrices(context.Background(), "10", targets, &SubscriptionPricePlanOptions{StartDate: &later})
+	assert.NoError(t, err)
+	assert.Len(t, moved.Changes, 2, "a price scheduled for another date is not a match")
+}
+
+func TestApplySubscriptionPricePlanError(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{})
+	defer server.Close()
+
+	prices, err := client.Apps.ApplySubscriptionPricePlan(context.Background(), &SubscriptionPricePlan{
+		SubscriptionID: "10",
+		Changes:        []SubscriptionPriceChange{{Territory: "USA", NewPrice: "4.99", PricePointID: "1"}},
+	})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "USA: ")
+	assert.Empty(t, prices)
+}
+
+func TestSubscriptionPriceChangeString(t *testing.T) {
+	t.Parallel()
+
+	change := SubscriptionPriceChange{Territory: "FRA", NewPrice: "4.99"}
+	assert.Equal(t, "FRA: none -> 4.99 immediately", change.String())
+	assert.False(t, change.Increase())
+}
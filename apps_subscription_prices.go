@@ -0,0 +1,364 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// SubscriptionPrice defines model for SubscriptionPrice.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionprice
+type SubscriptionPrice struct {
+	Attributes    *SubscriptionPriceAttributes    `json:"attributes,omitempty"`
+	ID            string                          `json:"id"`
+	Links         ResourceLinks                   `json:"links"`
+	Relationships *SubscriptionPriceRelationships `json:"relationships,omitempty"`
+	Type          string                          `json:"type"`
+}
+
+// SubscriptionPriceAttributes defines model for SubscriptionPrice.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionprice/attributes
+type SubscriptionPriceAttributes struct {
+	Preserved *bool `json:"preserved,omitempty"`
+	StartDate *Date `json:"startDate,omitempty"`
+}
+
+// SubscriptionPriceRelationships defines model for SubscriptionPrice.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionprice/relationships
+type SubscriptionPriceRelationships struct {
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+	Territory              *Relationship `json:"territory,omitempty"`
+}
+
+// SubscriptionPriceResponse defines model for SubscriptionPriceResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpriceresponse
+type SubscriptionPriceResponse struct {
+	Data     SubscriptionPrice                   `json:"data"`
+	Included []SubscriptionPriceResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// SubscriptionPricesResponse defines model for SubscriptionPricesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricesresponse
+type SubscriptionPricesResponse struct {
+	Data     []SubscriptionPrice                 `json:"data"`
+	Included []SubscriptionPriceResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// SubscriptionPriceResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a SubscriptionPriceResponse or SubscriptionPricesResponse.
+type SubscriptionPriceResponseIncluded included
+
+// SubscriptionPricePoint defines model for SubscriptionPricePoint.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricepoint
+type SubscriptionPricePoint struct {
+	Attributes    *SubscriptionPricePointAttributes    `json:"attributes,omitempty"`
+	ID            string                               `json:"id"`
+	Links         ResourceLinks                        `json:"links"`
+	Relationships *SubscriptionPricePointRelationships `json:"relationships,omitempty"`
+	Type          string                               `json:"type"`
+}
+
+// SubscriptionPricePointAttributes defines model for SubscriptionPricePoint.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricepoint/attributes
+type SubscriptionPricePointAttributes struct {
+	CustomerPrice *string `json:"customerPrice,omitempty"`
+	Proceeds      *string `json:"proceeds,omitempty"`
+	ProceedsYear2 *string `json:"proceedsYear2,omitempty"`
+}
+
+// SubscriptionPricePointRelationships defines model for SubscriptionPricePoint.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricepoint/relationships
+type SubscriptionPricePointRelationships struct {
+	Equalizations *PagedRelationship `json:"equalizations,omitempty"`
+	Territory     *Relationship      `json:"territory,omitempty"`
+}
+
+// SubscriptionPricePointResponse defines model for SubscriptionPricePointResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricepointresponse
+type SubscriptionPricePointResponse struct {
+	Data     SubscriptionPricePoint `json:"data"`
+	Included []Territory            `json:"included,omitempty"`
+	Links    DocumentLinks          `json:"links"`
+}
+
+// SubscriptionPricePointsResponse defines model for SubscriptionPricePointsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricepointsresponse
+type SubscriptionPricePointsResponse struct {
+	Data     []SubscriptionPricePoint `json:"data"`
+	Included []Territory              `json:"included,omitempty"`
+	Links    PagedDocumentLinks       `json:"links"`
+	Meta     *PagingInformation       `json:"meta,omitempty"`
+}
+
+// subscriptionPriceCreateRequest defines model for SubscriptionPriceCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricecreaterequest/data
+type subscriptionPriceCreateRequest struct {
+	Attributes    *SubscriptionPriceCreateRequestAttributes   `json:"attributes,omitempty"`
+	Relationships subscriptionPriceCreateRequestRelationships `json:"relationships"`
+	Type          string                                      `json:"type"`
+}
+
+// SubscriptionPriceCreateRequestAttributes are attributes for SubscriptionPriceCreateRequest
+//
+// PreserveCurrentPrice keeps existing subscribers on the price they currently pay when the new price takes
+// effect. StartDate schedules the price, and defaults to immediately.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricecreaterequest/data/attributes
+type SubscriptionPriceCreateRequestAttributes struct {
+	PreserveCurrentPrice *bool `json:"preserveCurrentPrice,omitempty"`
+	StartDate            *Date `json:"startDate,omitempty"`
+}
+
+// subscriptionPriceCreateRequestRelationships are relationships for SubscriptionPriceCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpricecreaterequest/data/relationships
+type subscriptionPriceCreateRequestRelationships struct {
+	Subscription           relationshipDeclaration  `json:"subscription"`
+	SubscriptionPricePoint relationshipDeclaration  `json:"subscriptionPricePoint"`
+	Territory              *relationshipDeclaration `json:"territory,omitempty"`
+}
+
+// ListPricesForSubscriptionQuery are query options for ListPricesForSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id_prices
+type ListPricesForSubscriptionQuery struct {
+	FieldsSubscriptionPrices      []string `url:"fields[subscriptionPrices],omitempty"`
+	FieldsSubscriptionPricePoints []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories             []string `url:"fields[territories],omitempty"`
+	FilterSubscriptionPricePoint  []string `url:"filter[subscriptionPricePoint],omitempty"`
+	FilterTerritory               []string `url:"filter[territory],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// ListPricePointsForSubscriptionQuery are query options for ListPricePointsForSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id_pricepoints
+type ListPricePointsForSubscriptionQuery struct {
+	FieldsSubscriptionPricePoints []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories             []string `url:"fields[territories],omitempty"`
+	FilterTerritory               []string `url:"filter[territory],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionPricePointQuery are query options for GetSubscriptionPricePoint
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionpricepoints_id
+type GetSubscriptionPricePointQuery struct {
+	FieldsSubscriptionPricePoints []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories             []string `url:"fields[territories],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+}
+
+// ListEqualizationsForSubscriptionPricePointQuery are query options for ListEqualizationsForSubscriptionPricePoint
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionpricepoints_id_equalizations
+type ListEqualizationsForSubscriptionPricePointQuery struct {
+	FieldsSubscriptionPricePoints []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsTerritories             []string `url:"fields[territories],omitempty"`
+	FilterTerritory               []string `url:"filter[territory],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// ListPricesForSubscription lists the current and scheduled prices of a subscription in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id_prices
+func (s *AppsService) ListPricesForSubscription(ctx context.Context, id string, params *ListPricesForSubscriptionQuery) (*SubscriptionPricesResponse, *Response, error) {
+	return Get[SubscriptionPricesResponse](ctx, s.client, fmt.Sprintf("subscriptions/%s/prices", id), params)
+}
+
+// ListPricePointsForSubscription lists the price points available to a subscription, including customer price and proceeds, in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id_pricepoints
+func (s *AppsService) ListPricePointsForSubscription(ctx context.Context, id string, params *ListPricePointsForSubscriptionQuery) (*SubscriptionPricePointsResponse, *Response, error) {
+	return Get[SubscriptionPricePointsResponse](ctx, s.client, fmt.Sprintf("subscriptions/%s/pricePoints", id), params)
+}
+
+// GetSubscriptionPricePoint reads the customer price and proceeds of a subscription price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionpricepoints_id
+func (s *AppsService) GetSubscriptionPricePoint(ctx context.Context, id string, params *GetSubscriptionPricePointQuery) (*SubscriptionPricePointResponse, *Response, error) {
+	return Get[SubscriptionPricePointResponse](ctx, s.client, fmt.Sprintf("subscriptionPricePoints/%s", id), params)
+}
+
+// ListEqualizationsForSubscriptionPricePoint lists the price points in every other territory that are equivalent to the given subscription price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionpricepoints_id_equalizations
+func (s *AppsService) ListEqualizationsForSubscriptionPricePoint(ctx context.Context, id string, params *ListEqualizationsForSubscriptionPricePointQuery) (*SubscriptionPricePointsResponse, *Response, error) {
+	return Get[SubscriptionPricePointsResponse](ctx, s.client, fmt.Sprintf("subscriptionPricePoints/%s/equalizations", id), params)
+}
+
+// CreateSubscriptionPrice schedules a new price for a subscription at the given price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_subscriptionprices
+func (s *AppsService) CreateSubscriptionPrice(ctx context.Context, attributes *SubscriptionPriceCreateRequestAttributes, subscriptionID string, pricePointID string, territoryID *string) (*SubscriptionPriceResponse, *Response, error) {
+	req := subscriptionPriceCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionPriceCreateRequestRelationships{
+			Subscription:           *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+			SubscriptionPricePoint: *newRelationshipDeclaration(&pricePointID, "subscriptionPricePoints"),
+			Territory:              newRelationshipDeclaration(territoryID, "territories"),
+		},
+		Type: "subscriptionPrices",
+	}
+	res := new(SubscriptionPriceResponse)
+	resp, err := s.client.post(ctx, "subscriptionPrices", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteSubscriptionPrice removes a scheduled price from a subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_v1_subscriptionprices_id
+func (s *AppsService) DeleteSubscriptionPrice(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("subscriptionPrices/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// FindSubscriptionPricePoint pages through the price points of a subscription in a territory and returns
+// the one whose customer price matches customerPrice, such as "4.99". If no price point matches, an
+// ErrPricePointNotFound is returned.
+func (s *AppsService) FindSubscriptionPricePoint(ctx context.Context, subscriptionID string, territory string, customerPrice string) (*SubscriptionPricePoint, error) {
+	want, err := strconv.ParseFloat(customerPrice, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ListPricePointsForSubscriptionQuery{
+		FieldsSubscriptionPricePoints: []string{"customerPrice", "proceeds", "proceedsYear2", "territory"},
+		FilterTerritory:               []string{territory},
+		Limit:                         MaxPageLimit,
+	}
+
+	var found *SubscriptionPricePoint
+
+	err = ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		points, _, err := s.ListPricePointsForSubscription(ctx, subscriptionID, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, point := range points.Data {
+			if point.Attributes == nil || point.Attributes.CustomerPrice == nil {
+				continue
+			}
+
+			got, err := strconv.ParseFloat(*point.Attributes.CustomerPrice, 64)
+			if err == nil && got == want {
+				point := point
+				found = &point
+
+				return nil, nil
+			}
+		}
+
+		return &points.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, ErrPricePointNotFound{Territory: territory, CustomerPrice: customerPrice}
+	}
+
+	return found, nil
+}
+
+// SetSubscriptionPrice resolves the price point of a subscription in a territory by its customer price and
+// schedules it as the new price of the subscription. Set PreserveCurrentPrice in attributes to grandfather
+// existing subscribers at the price they currently pay.
+func (s *AppsService) SetSubscriptionPrice(ctx context.Context, subscriptionID string, territory string, customerPrice string, attributes *SubscriptionPriceCreateRequestAttributes) (*SubscriptionPriceResponse, *Response, error) {
+	point, err := s.FindSubscriptionPricePoint(ctx, subscriptionID, territory, customerPrice)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.CreateSubscriptionPrice(ctx, attributes, subscriptionID, point.ID, &territory)
+}
+
+// currentSubscriptionPrice returns the price that is in effect at now, which is the one with the latest start
+// date that has already passed. Prices without a start date have been in effect since the subscription launched.
+func currentSubscriptionPrice(prices []SubscriptionPrice, now time.Time) *SubscriptionPrice {
+	var (
+		current *SubscriptionPrice
+		start   time.Time
+	)
+
+	for i := range prices {
+		var from time.Time
+		if prices[i].Attributes != nil && prices[i].Attributes.StartDate != nil {
+			from = prices[i].Attributes.StartDate.Time
+		}
+
+		if from.After(now) || (current != nil && from.Before(start)) {
+			continue
+		}
+
+		current, start = &prices[i], from
+	}
+
+	return current
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in SubscriptionPriceResponseIncluded.
+func (i *SubscriptionPriceResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// SubscriptionPricePoint returns the SubscriptionPricePoint stored within, if one is present.
+func (i *SubscriptionPriceResponseIncluded) SubscriptionPricePoint() *SubscriptionPricePoint {
+	return extractIncludedSubscriptionPricePoint(i.inner)
+}
+
+// Territory returns the Territory stored within, if one is present.
+func (i *SubscriptionPriceResponseIncluded) Territory() *Territory {
+	return extractIncludedTerritory(i.inner)
+}
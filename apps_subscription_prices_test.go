@@ -0,0 +1,159 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPricesForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListPricesForSubscription(ctx, "10", &ListPricesForSubscriptionQuery{})
+	})
+}
+
+func TestListPricesForSubscriptionIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"subscriptionPricePoints"},{"type":"territories"}]}`, func(ctx context.Context, client *Client) {
+		prices, _, err := client.Apps.ListPricesForSubscription(ctx, "10", &ListPricesForSubscriptionQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, prices.Included)
+
+		assert.NotNil(t, prices.Included[0].SubscriptionPricePoint())
+		assert.NotNil(t, prices.Included[1].Territory())
+
+		assert.Nil(t, prices.Included[0].Territory())
+		assert.Nil(t, prices.Included[1].SubscriptionPricePoint())
+	})
+}
+
+func TestListPricePointsForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPricePointsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListPricePointsForSubscription(ctx, "10", &ListPricePointsForSubscriptionQuery{})
+	})
+}
+
+func TestGetSubscriptionPricePoint(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPricePointResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetSubscriptionPricePoint(ctx, "10", &GetSubscriptionPricePointQuery{})
+	})
+}
+
+func TestListEqualizationsForSubscriptionPricePoint(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPricePointsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListEqualizationsForSubscriptionPricePoint(ctx, "10", &ListEqualizationsForSubscriptionPricePointQuery{})
+	})
+}
+
+func TestCreateSubscriptionPrice(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPriceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateSubscriptionPrice(ctx, &SubscriptionPriceCreateRequestAttributes{PreserveCurrentPrice: Bool(true)}, "10", "20", String("USA"))
+	})
+}
+
+func TestCreateSubscriptionPriceRequestBody(t *testing.T) {
+	t.Parallel()
+
+	req := subscriptionPriceCreateRequest{
+		Attributes: &SubscriptionPriceCreateRequestAttributes{PreserveCurrentPrice: Bool(true)},
+		Relationships: subscriptionPriceCreateRequestRelationships{
+			Subscription:           *newRelationshipDeclaration(String("10"), "subscriptions"),
+			SubscriptionPricePoint: *newRelationshipDeclaration(String("20"), "subscriptionPricePoints"),
+		},
+		Type: "subscriptionPrices",
+	}
+
+	b, err := json.Marshal(req)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"attributes":{"preserveCurrentPrice":true},
+		"relationships":{
+			"subscription":{"data":{"id":"10","type":"subscriptions"}},
+			"subscriptionPricePoint":{"data":{"id":"20","type":"subscriptionPricePoints"}}
+		},
+		"type":"subscriptionPrices"
+	}`, string(b))
+}
+
+func TestDeleteSubscriptionPrice(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteSubscriptionPrice(ctx, "10")
+	})
+}
+
+func TestFindSubscriptionPricePoint(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"data":[
+		{"type":"subscriptionPricePoints","id":"1","attributes":{"customerPrice":"0.99"}},
+		{"type":"subscriptionPricePoints","id":"2"},
+		{"type":"subscriptionPricePoints","id":"3","attributes":{"customerPrice":"4.99"}}
+		]}`, func(ctx context.Context, client *Client) {
+		point, err := client.Apps.FindSubscriptionPricePoint(ctx, "10", "USA", "4.990")
+		assert.NoError(t, err)
+		assert.Equal(t, "3", point.ID)
+
+		point, err = client.Apps.FindSubscriptionPricePoint(ctx, "10", "USA", "1.99")
+		assert.Equal(t, ErrPricePointNotFound{Territory: "USA", CustomerPrice: "1.99"}, err)
+		assert.Nil(t, point)
+
+		point, err = client.Apps.FindSubscriptionPricePoint(ctx, "10", "USA", "free")
+		assert.Error(t, err)
+		assert.Nil(t, point)
+	})
+}
+
+func TestSetSubscriptionPrice(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /subscriptions/10/pricePoints": `{"data":[{"type":"subscriptionPricePoints","id":"20","attributes":{"customerPrice":"4.99"}}]}`,
+		"POST /subscriptionPrices":          `{"data":{"type":"subscriptionPrices","id":"30"}}`,
+	})
+	defer server.Close()
+
+	price, _, err := client.Apps.SetSubscriptionPrice(context.Background(), "10", "USA", "4.99", &SubscriptionPriceCreateRequestAttributes{
+		PreserveCurrentPrice: Bool(true),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "30", price.Data.ID)
+	assert.Equal(t, []string{"GET /subscriptions/10/pricePoints", "POST /subscriptionPrices"}, *requests)
+
+	_, _, err = client.Apps.SetSubscriptionPrice(context.Background(), "10", "USA", "9.99", nil)
+	assert.Equal(t, ErrPricePointNotFound{Territory: "USA", CustomerPrice: "9.99"}, err)
+}
@@ -0,0 +1,288 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionPromotionalOffer defines model for SubscriptionPromotionalOffer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffer
+type SubscriptionPromotionalOffer struct {
+	Attributes    *SubscriptionPromotionalOfferAttributes    `json:"attributes,omitempty"`
+	ID            string                                     `json:"id"`
+	Links         ResourceLinks                              `json:"links"`
+	Relationships *SubscriptionPromotionalOfferRelationships `json:"relationships,omitempty"`
+	Type          string                                     `json:"type"`
+}
+
+// SubscriptionPromotionalOfferAttributes defines model for SubscriptionPromotionalOffer.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffer/attributes
+type SubscriptionPromotionalOfferAttributes struct {
+	Duration        *SubscriptionOfferDuration `json:"duration,omitempty"`
+	Name            *string                    `json:"name,omitempty"`
+	NumberOfPeriods *int                       `json:"numberOfPeriods,omitempty"`
+	OfferCode       *string                    `json:"offerCode,omitempty"`
+	OfferMode       *SubscriptionOfferMode     `json:"offerMode,omitempty"`
+}
+
+// SubscriptionPromotionalOfferRelationships defines model for SubscriptionPromotionalOffer.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffer/relationships
+type SubscriptionPromotionalOfferRelationships struct {
+	Prices       *PagedRelationship `json:"prices,omitempty"`
+	Subscription *Relationship      `json:"subscription,omitempty"`
+}
+
+// SubscriptionPromotionalOfferResponse defines model for SubscriptionPromotionalOfferResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferresponse
+type SubscriptionPromotionalOfferResponse struct {
+	Data     SubscriptionPromotionalOffer                   `json:"data"`
+	Included []SubscriptionPromotionalOfferResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                  `json:"links"`
+}
+
+// SubscriptionPromotionalOffersResponse defines model for SubscriptionPromotionalOffersResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffersresponse
+type SubscriptionPromotionalOffersResponse struct {
+	Data     []SubscriptionPromotionalOffer                 `json:"data"`
+	Included []SubscriptionPromotionalOfferResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                             `json:"links"`
+	Meta     *PagingInformation                             `json:"meta,omitempty"`
+}
+
+// SubscriptionPromotionalOfferResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a SubscriptionPromotionalOfferResponse or SubscriptionPromotionalOffersResponse.
+type SubscriptionPromotionalOfferResponseIncluded included
+
+// SubscriptionPromotionalOfferPrice defines model for SubscriptionPromotionalOfferPrice.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferprice
+type SubscriptionPromotionalOfferPrice struct {
+	ID            string                                          `json:"id"`
+	Links         ResourceLinks                                   `json:"links"`
+	Relationships *SubscriptionPromotionalOfferPriceRelationships `json:"relationships,omitempty"`
+	Type          string                                          `json:"type"`
+}
+
+// SubscriptionPromotionalOfferPriceRelationships defines model for SubscriptionPromotionalOfferPrice.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferprice/relationships
+type SubscriptionPromotionalOfferPriceRelationships struct {
+	SubscriptionPricePoint *Relationship `json:"subscriptionPricePoint,omitempty"`
+	Territory              *Relationship `json:"territory,omitempty"`
+}
+
+// SubscriptionPromotionalOfferPricesResponse defines model for SubscriptionPromotionalOfferPricesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferpricesresponse
+type SubscriptionPromotionalOfferPricesResponse struct {
+	Data     []SubscriptionPromotionalOfferPrice `json:"data"`
+	Included []SubscriptionPriceResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                  `json:"links"`
+	Meta     *PagingInformation                  `json:"meta,omitempty"`
+}
+
+// subscriptionPromotionalOfferCreateRequest defines model for SubscriptionPromotionalOfferCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffercreaterequest/data
+type subscriptionPromotionalOfferCreateRequest struct {
+	Attributes    SubscriptionPromotionalOfferCreateRequestAttributes    `json:"attributes"`
+	Relationships subscriptionPromotionalOfferCreateRequestRelationships `json:"relationships"`
+	Type          string                                                 `json:"type"`
+}
+
+// SubscriptionPromotionalOfferCreateRequestAttributes are attributes for SubscriptionPromotionalOfferCreateRequest
+//
+// OfferCode is the offer identifier that apps pass to StoreKit, and that promotional offer signatures are made for.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffercreaterequest/data/attributes
+type SubscriptionPromotionalOfferCreateRequestAttributes struct {
+	Duration        SubscriptionOfferDuration `json:"duration"`
+	Name            string                    `json:"name"`
+	NumberOfPeriods int                       `json:"numberOfPeriods"`
+	OfferCode       string                    `json:"offerCode"`
+	OfferMode       SubscriptionOfferMode     `json:"offerMode"`
+}
+
+// subscriptionPromotionalOfferCreateRequestRelationships are relationships for SubscriptionPromotionalOfferCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionaloffercreaterequest/data/relationships
+type subscriptionPromotionalOfferCreateRequestRelationships struct {
+	Prices       pagedRelationshipDeclaration `json:"prices"`
+	Subscription relationshipDeclaration      `json:"subscription"`
+}
+
+// subscriptionPromotionalOfferUpdateRequest defines model for SubscriptionPromotionalOfferUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferupdaterequest/data
+type subscriptionPromotionalOfferUpdateRequest struct {
+	ID            string                                                  `json:"id"`
+	Relationships *subscriptionPromotionalOfferUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                                  `json:"type"`
+}
+
+// subscriptionPromotionalOfferUpdateRequestRelationships are relationships for SubscriptionPromotionalOfferUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionpromotionalofferupdaterequest/data/relationships
+type subscriptionPromotionalOfferUpdateRequestRelationships struct {
+	Prices *pagedRelationshipDeclaration `json:"prices,omitempty"`
+}
+
+// ListPromotionalOffersForSubscriptionQuery are query options for ListPromotionalOffersForSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id_promotionaloffers
+type ListPromotionalOffersForSubscriptionQuery struct {
+	FieldsSubscriptionPromotionalOffers      []string `url:"fields[subscriptionPromotionalOffers],omitempty"`
+	FieldsSubscriptionPromotionalOfferPrices []string `url:"fields[subscriptionPromotionalOfferPrices],omitempty"`
+	FieldsSubscriptions                      []string `url:"fields[subscriptions],omitempty"`
+	FilterTerritory                          []string `url:"filter[territory],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	Limit                                    int      `url:"limit,omitempty"`
+	LimitPrices                              int      `url:"limit[prices],omitempty"`
+	Cursor                                   string   `url:"cursor,omitempty"`
+}
+
+// GetSubscriptionPromotionalOfferQuery are query options for GetSubscriptionPromotionalOffer
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionpromotionaloffers_id
+type GetSubscriptionPromotionalOfferQuery struct {
+	FieldsSubscriptionPromotionalOffers      []string `url:"fields[subscriptionPromotionalOffers],omitempty"`
+	FieldsSubscriptionPromotionalOfferPrices []string `url:"fields[subscriptionPromotionalOfferPrices],omitempty"`
+	FieldsSubscriptions                      []string `url:"fields[subscriptions],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	LimitPrices                              int      `url:"limit[prices],omitempty"`
+}
+
+// ListPricesForSubscriptionPromotionalOfferQuery are query options for ListPricesForSubscriptionPromotionalOffer
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionpromotionaloffers_id_prices
+type ListPricesForSubscriptionPromotionalOfferQuery struct {
+	FieldsSubscriptionPricePoints            []string `url:"fields[subscriptionPricePoints],omitempty"`
+	FieldsSubscriptionPromotionalOfferPrices []string `url:"fields[subscriptionPromotionalOfferPrices],omitempty"`
+	FieldsTerritories                        []string `url:"fields[territories],omitempty"`
+	FilterTerritory                          []string `url:"filter[territory],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	Limit                                    int      `url:"limit,omitempty"`
+	Cursor                                   string   `url:"cursor,omitempty"`
+}
+
+// ListPromotionalOffersForSubscription lists the promotional offers of a subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id_promotionaloffers
+func (s *AppsService) ListPromotionalOffersForSubscription(ctx context.Context, id string, params *ListPromotionalOffersForSubscriptionQuery) (*SubscriptionPromotionalOffersResponse, *Response, error) {
+	return Get[SubscriptionPromotionalOffersResponse](ctx, s.client, fmt.Sprintf("subscriptions/%s/promotionalOffers", id), params)
+}
+
+// GetSubscriptionPromotionalOffer reads the information about a promotional offer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionpromotionaloffers_id
+func (s *AppsService) GetSubscriptionPromotionalOffer(ctx context.Context, id string, params *GetSubscriptionPromotionalOfferQuery) (*SubscriptionPromotionalOfferResponse, *Response, error) {
+	return Get[SubscriptionPromotionalOfferResponse](ctx, s.client, fmt.Sprintf("subscriptionPromotionalOffers/%s", id), params)
+}
+
+// ListPricesForSubscriptionPromotionalOffer lists the prices of a promotional offer in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptionpromotionaloffers_id_prices
+func (s *AppsService) ListPricesForSubscriptionPromotionalOffer(ctx context.Context, id string, params *ListPricesForSubscriptionPromotionalOfferQuery) (*SubscriptionPromotionalOfferPricesResponse, *Response, error) {
+	return Get[SubscriptionPromotionalOfferPricesResponse](ctx, s.client, fmt.Sprintf("subscriptionPromotionalOffers/%s/prices", id), params)
+}
+
+// CreateSubscriptionPromotionalOffer creates a promotional offer for a subscription with its price in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_subscriptionpromotionaloffers
+func (s *AppsService) CreateSubscriptionPromotionalOffer(ctx context.Context, attributes SubscriptionPromotionalOfferCreateRequestAttributes, subscriptionID string, prices []SubscriptionOfferPrice) (*SubscriptionPromotionalOfferResponse, *Response, error) {
+	newPrices, priceIDs := newSubscriptionOfferPrices(prices, "subscriptionPromotionalOfferPrices")
+	req := subscriptionPromotionalOfferCreateRequest{
+		Attributes: attributes,
+		Relationships: subscriptionPromotionalOfferCreateRequestRelationships{
+			Prices:       newPagedRelationshipDeclaration(priceIDs, "subscriptionPromotionalOfferPrices"),
+			Subscription: *newRelationshipDeclaration(&subscriptionID, "subscriptions"),
+		},
+		Type: "subscriptionPromotionalOffers",
+	}
+	res := new(SubscriptionPromotionalOfferResponse)
+	resp, err := s.client.post(ctx, "subscriptionPromotionalOffers", newRequestBodyWithIncluded(req, newPrices), res)
+
+	return res, resp, err
+}
+
+// UpdateSubscriptionPromotionalOffer replaces the prices of a promotional offer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_subscriptionpromotionaloffers_id
+func (s *AppsService) UpdateSubscriptionPromotionalOffer(ctx context.Context, id string, prices []SubscriptionOfferPrice) (*SubscriptionPromotionalOfferResponse, *Response, error) {
+	req := subscriptionPromotionalOfferUpdateRequest{
+		ID:   id,
+		Type: "subscriptionPromotionalOffers",
+	}
+
+	var body *requestBody
+
+	if len(prices) > 0 {
+		newPrices, priceIDs := newSubscriptionOfferPrices(prices, "subscriptionPromotionalOfferPrices")
+		relationships := newPagedRelationshipDeclaration(priceIDs, "subscriptionPromotionalOfferPrices")
+		req.Relationships = &subscriptionPromotionalOfferUpdateRequestRelationships{
+			Prices: &relationships,
+		}
+		body = newRequestBodyWithIncluded(req, newPrices)
+	} else {
+		body = newRequestBody(req)
+	}
+
+	url := fmt.Sprintf("subscriptionPromotionalOffers/%s", id)
+	res := new(SubscriptionPromotionalOfferResponse)
+	resp, err := s.client.patch(ctx, url, body, res)
+
+	return res, resp, err
+}
+
+// DeleteSubscriptionPromotionalOffer deletes a promotional offer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_v1_subscriptionpromotionaloffers_id
+func (s *AppsService) DeleteSubscriptionPromotionalOffer(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("subscriptionPromotionalOffers/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in SubscriptionPromotionalOfferResponseIncluded.
+func (i *SubscriptionPromotionalOfferResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// Subscription returns the Subscription stored within, if one is present.
+func (i *SubscriptionPromotionalOfferResponseIncluded) Subscription() *Subscription {
+	return extractIncludedSubscription(i.inner)
+}
+
+// SubscriptionPromotionalOfferPrice returns the SubscriptionPromotionalOfferPrice stored within, if one is present.
+func (i *SubscriptionPromotionalOfferResponseIncluded) SubscriptionPromotionalOfferPrice() *SubscriptionPromotionalOfferPrice {
+	return extractIncludedSubscriptionPromotionalOfferPrice(i.inner)
+}
@@ -0,0 +1,121 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPromotionalOffersForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPromotionalOffersResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListPromotionalOffersForSubscription(ctx, "10", &ListPromotionalOffersForSubscriptionQuery{})
+	})
+}
+
+func TestGetSubscriptionPromotionalOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPromotionalOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetSubscriptionPromotionalOffer(ctx, "10", &GetSubscriptionPromotionalOfferQuery{})
+	})
+}
+
+func TestGetSubscriptionPromotionalOfferIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"subscriptions"},{"type":"subscriptionPromotionalOfferPrices"}]}`, func(ctx context.Context, client *Client) {
+		offer, _, err := client.Apps.GetSubscriptionPromotionalOffer(ctx, "10", &GetSubscriptionPromotionalOfferQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, offer.Included)
+
+		assert.NotNil(t, offer.Included[0].Subscription())
+		assert.NotNil(t, offer.Included[1].SubscriptionPromotionalOfferPrice())
+
+		assert.Nil(t, offer.Included[0].SubscriptionPromotionalOfferPrice())
+		assert.Nil(t, offer.Included[1].Subscription())
+	})
+}
+
+func TestListPricesForSubscriptionPromotionalOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPromotionalOfferPricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListPricesForSubscriptionPromotionalOffer(ctx, "10", &ListPricesForSubscriptionPromotionalOfferQuery{})
+	})
+}
+
+func TestCreateSubscriptionPromotionalOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPromotionalOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateSubscriptionPromotionalOffer(ctx, SubscriptionPromotionalOfferCreateRequestAttributes{
+			Duration:        SubscriptionOfferDurationOneMonth,
+			Name:            "Win them back",
+			NumberOfPeriods: 1,
+			OfferCode:       "WINBACK",
+			OfferMode:       SubscriptionOfferModePayUpFront,
+		}, "10", []SubscriptionOfferPrice{
+			{TerritoryID: "USA", PricePointID: "20"},
+		})
+	})
+}
+
+func TestUpdateSubscriptionPromotionalOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPromotionalOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateSubscriptionPromotionalOffer(ctx, "10", []SubscriptionOfferPrice{
+			{TerritoryID: "USA", PricePointID: "20"},
+		})
+	})
+
+	testEndpointWithResponse(t, "{}", &SubscriptionPromotionalOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateSubscriptionPromotionalOffer(ctx, "10", nil)
+	})
+}
+
+func TestDeleteSubscriptionPromotionalOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteSubscriptionPromotionalOffer(ctx, "10")
+	})
+}
+
+func TestNewSubscriptionOfferPrices(t *testing.T) {
+	t.Parallel()
+
+	inline, ids := newSubscriptionOfferPrices([]SubscriptionOfferPrice{
+		{TerritoryID: "USA", PricePointID: "20"},
+		{TerritoryID: "CAN"},
+	}, "subscriptionPromotionalOfferPrices")
+
+	assert.Equal(t, []string{"${new-price-0}", "${new-price-1}"}, ids)
+	assert.Len(t, inline, 2)
+	assert.Equal(t, "subscriptionPromotionalOfferPrices", inline[0].Type)
+	assert.NotNil(t, inline[0].Relationships.SubscriptionPricePoint)
+	assert.Nil(t, inline[1].Relationships.SubscriptionPricePoint)
+}
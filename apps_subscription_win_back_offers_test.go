@@ -0,0 +1,125 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListWinBackOffersForSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOffersResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListWinBackOffersForSubscription(ctx, "10", &ListWinBackOffersForSubscriptionQuery{})
+	})
+}
+
+func TestGetWinBackOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetWinBackOffer(ctx, "10", &GetWinBackOfferQuery{})
+	})
+}
+
+func TestListPricesForWinBackOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOfferPricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListPricesForWinBackOffer(ctx, "10", &ListPricesForWinBackOfferQuery{})
+	})
+}
+
+func TestCreateWinBackOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.CreateWinBackOffer(ctx, WinBackOfferCreateRequestAttributes{
+			CustomerEligibilityPaidSubscriptionDurationInMonths: 3,
+			CustomerEligibilityTimeSinceLastSubscribedInMonths:  IntegerRange{Minimum: Int(1), Maximum: Int(12)},
+			Duration:      SubscriptionOfferDurationOneMonth,
+			OfferID:       "COMEBACK",
+			OfferMode:     SubscriptionOfferModePayAsYouGo,
+			PeriodCount:   3,
+			Priority:      WinBackOfferPriorityNormal,
+			ReferenceName: "Come back",
+		}, "10", []SubscriptionOfferPrice{
+			{TerritoryID: "USA", PricePointID: "20"},
+		})
+	})
+}
+
+func TestUpdateWinBackOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateWinBackOffer(ctx, "10", &WinBackOfferUpdateRequestAttributes{EndDate: &Date{}})
+	})
+}
+
+func TestSetWinBackOfferPriority(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.SetWinBackOfferPriority(ctx, "10", WinBackOfferPriorityHigh)
+	})
+}
+
+func TestSetWinBackOfferPromotionIntent(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WinBackOfferResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.SetWinBackOfferPromotionIntent(ctx, "10", WinBackOfferPromotionIntentUseAutoGeneratedAssets)
+	})
+}
+
+func TestDeleteWinBackOffer(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Apps.DeleteWinBackOffer(ctx, "10")
+	})
+}
+
+func TestParseWinBackOfferPriority(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseWinBackOfferPriority(string(WinBackOfferPriorityHigh))
+	assert.NoError(t, err)
+	assert.Equal(t, WinBackOfferPriorityHigh, got)
+
+	_, err = ParseWinBackOfferPriority("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "WinBackOfferPriority", Value: "UNKNOWN"}, err)
+}
+
+func TestParseWinBackOfferPromotionIntent(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseWinBackOfferPromotionIntent(string(WinBackOfferPromotionIntentNotPromoted))
+	assert.NoError(t, err)
+	assert.Equal(t, WinBackOfferPromotionIntentNotPromoted, got)
+
+	_, err = ParseWinBackOfferPromotionIntent("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "WinBackOfferPromotionIntent", Value: "UNKNOWN"}, err)
+}
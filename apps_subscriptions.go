@@ -0,0 +1,226 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// SubscriptionState defines model for the states of subscriptions and in-app purchases.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscription/attributes
+type SubscriptionState string
+
+const (
+	// SubscriptionStateApproved is a product that has been approved by App Review.
+	SubscriptionStateApproved SubscriptionState = "APPROVED"
+	// SubscriptionStateDeveloperActionNeeded is a product App Review has asked the developer to change.
+	SubscriptionStateDeveloperActionNeeded SubscriptionState = "DEVELOPER_ACTION_NEEDED"
+	// SubscriptionStateDeveloperRemovedFromSale is a product the developer has removed from sale.
+	SubscriptionStateDeveloperRemovedFromSale SubscriptionState = "DEVELOPER_REMOVED_FROM_SALE"
+	// SubscriptionStateInReview is a product that is being reviewed.
+	SubscriptionStateInReview SubscriptionState = "IN_REVIEW"
+	// SubscriptionStateMissingMetadata is a product that lacks information required for submission.
+	SubscriptionStateMissingMetadata SubscriptionState = "MISSING_METADATA"
+	// SubscriptionStatePendingBinaryApproval is a product waiting for an app version to be approved.
+	SubscriptionStatePendingBinaryApproval SubscriptionState = "PENDING_BINARY_APPROVAL"
+	// SubscriptionStateReadyToSubmit is a product that can be submitted for review.
+	SubscriptionStateReadyToSubmit SubscriptionState = "READY_TO_SUBMIT"
+	// SubscriptionStateRejected is a product that was rejected by App Review.
+	SubscriptionStateRejected SubscriptionState = "REJECTED"
+	// SubscriptionStateRemovedFromSale is a product that Apple has removed from sale.
+	SubscriptionStateRemovedFromSale SubscriptionState = "REMOVED_FROM_SALE"
+	// SubscriptionStateWaitingForReview is a product that has been submitted and is waiting for review.
+	SubscriptionStateWaitingForReview SubscriptionState = "WAITING_FOR_REVIEW"
+)
+
+// ParseSubscriptionState returns s as a SubscriptionState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseSubscriptionState(s string) (SubscriptionState, error) {
+	if v := SubscriptionState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "SubscriptionState", Value: s}
+}
+
+// SubscriptionPeriod defines model for SubscriptionPeriod.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscription/attributes
+type SubscriptionPeriod string
+
+const (
+	// SubscriptionPeriodOneWeek is a subscription that renews every week.
+	SubscriptionPeriodOneWeek SubscriptionPeriod = "ONE_WEEK"
+	// SubscriptionPeriodOneMonth is a subscription that renews every month.
+	SubscriptionPeriodOneMonth SubscriptionPeriod = "ONE_MONTH"
+	// SubscriptionPeriodTwoMonths is a subscription that renews every two months.
+	SubscriptionPeriodTwoMonths SubscriptionPeriod = "TWO_MONTHS"
+	// SubscriptionPeriodThreeMonths is a subscription that renews every three months.
+	SubscriptionPeriodThreeMonths SubscriptionPeriod = "THREE_MONTHS"
+	// SubscriptionPeriodSixMonths is a subscription that renews every six months.
+	SubscriptionPeriodSixMonths SubscriptionPeriod = "SIX_MONTHS"
+	// SubscriptionPeriodOneYear is a subscription that renews every year.
+	SubscriptionPeriodOneYear SubscriptionPeriod = "ONE_YEAR"
+)
+
+var subscriptionPeriods = []SubscriptionPeriod{
+	SubscriptionPeriodOneWeek,
+	SubscriptionPeriodOneMonth,
+	SubscriptionPeriodTwoMonths,
+	SubscriptionPeriodThreeMonths,
+	SubscriptionPeriodSixMonths,
+	SubscriptionPeriodOneYear,
+}
+
+// IsValid reports whether the subscription period is one this package knows about.
+func (v SubscriptionPeriod) IsValid() bool {
+	for _, value := range subscriptionPeriods {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSubscriptionPeriod returns s as a SubscriptionPeriod, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseSubscriptionPeriod(s string) (SubscriptionPeriod, error) {
+	if v := SubscriptionPeriod(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "SubscriptionPeriod", Value: s}
+}
+
+// Subscription defines model for Subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscription
+type Subscription struct {
+	Attributes    *SubscriptionAttributes    `json:"attributes,omitempty"`
+	ID            string                     `json:"id"`
+	Links         ResourceLinks              `json:"links"`
+	Relationships *SubscriptionRelationships `json:"relationships,omitempty"`
+	Type          string                     `json:"type"`
+}
+
+// SubscriptionAttributes defines model for Subscription.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscription/attributes
+type SubscriptionAttributes struct {
+	FamilySharable     *bool               `json:"familySharable,omitempty"`
+	GroupLevel         *int                `json:"groupLevel,omitempty"`
+	Name               *string             `json:"name,omitempty"`
+	ProductID          *string             `json:"productId,omitempty"`
+	ReviewNote         *string             `json:"reviewNote,omitempty"`
+	State              *SubscriptionState  `json:"state,omitempty"`
+	SubscriptionPeriod *SubscriptionPeriod `json:"subscriptionPeriod,omitempty"`
+}
+
+// SubscriptionRelationships defines model for Subscription.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscription/relationships
+type SubscriptionRelationships struct {
+	AppStoreReviewScreenshot  *Relationship      `json:"appStoreReviewScreenshot,omitempty"`
+	Group                     *Relationship      `json:"group,omitempty"`
+	IntroductoryOffers        *PagedRelationship `json:"introductoryOffers,omitempty"`
+	OfferCodes                *PagedRelationship `json:"offerCodes,omitempty"`
+	Prices                    *PagedRelationship `json:"prices,omitempty"`
+	PromotedPurchase          *Relationship      `json:"promotedPurchase,omitempty"`
+	PromotionalOffers         *PagedRelationship `json:"promotionalOffers,omitempty"`
+	SubscriptionAvailability  *Relationship      `json:"subscriptionAvailability,omitempty"`
+	SubscriptionLocalizations *PagedRelationship `json:"subscriptionLocalizations,omitempty"`
+	WinBackOffers             *PagedRelationship `json:"winBackOffers,omitempty"`
+}
+
+// SubscriptionResponse defines model for SubscriptionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionresponse
+type SubscriptionResponse struct {
+	Data  Subscription  `json:"data"`
+	Links DocumentLinks `json:"links"`
+}
+
+// SubscriptionsResponse defines model for SubscriptionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionsresponse
+type SubscriptionsResponse struct {
+	Data  []Subscription     `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// subscriptionUpdateRequest defines model for SubscriptionUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionupdaterequest/data
+type subscriptionUpdateRequest struct {
+	Attributes *SubscriptionUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                               `json:"id"`
+	Type       string                               `json:"type"`
+}
+
+// SubscriptionUpdateRequestAttributes are attributes for SubscriptionUpdateRequest
+//
+// FamilySharable can only be turned on. Once a subscription is shared with family members it cannot be
+// turned off again, so setting it to false is rejected before a request is made.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/subscriptionupdaterequest/data/attributes
+type SubscriptionUpdateRequestAttributes struct {
+	FamilySharable     *bool               `json:"familySharable,omitempty"`
+	GroupLevel         *int                `json:"groupLevel,omitempty"`
+	Name               *string             `json:"name,omitempty"`
+	ReviewNote         *string             `json:"reviewNote,omitempty"`
+	SubscriptionPeriod *SubscriptionPeriod `json:"subscriptionPeriod,omitempty"`
+}
+
+// GetSubscriptionQuery are query options for GetSubscription
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id
+type GetSubscriptionQuery struct {
+	FieldsSubscriptions []string `url:"fields[subscriptions],omitempty"`
+}
+
+// GetSubscription reads the information about an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_subscriptions_id
+func (s *AppsService) GetSubscription(ctx context.Context, id string, params *GetSubscriptionQuery) (*SubscriptionResponse, *Response, error) {
+	return Get[SubscriptionResponse](ctx, s.client, fmt.Sprintf("subscriptions/%s", id), params)
+}
+
+// UpdateSubscription modifies the name, period, level, review note or Family Sharing of an auto-renewable subscription.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_subscriptions_id
+func (s *AppsService) UpdateSubscription(ctx context.Context, id string, attributes *SubscriptionUpdateRequestAttributes) (*SubscriptionResponse, *Response, error) {
+	if attributes != nil && attributes.FamilySharable != nil && !*attributes.FamilySharable {
+		return nil, nil, ErrFamilySharingIrreversible
+	}
+
+	req := subscriptionUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "subscriptions",
+	}
+	url := fmt.Sprintf("subscriptions/%s", id)
+	res := new(SubscriptionResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
@@ -0,0 +1,78 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.GetSubscription(ctx, "10", &GetSubscriptionQuery{})
+	})
+}
+
+func TestUpdateSubscription(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &SubscriptionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateSubscription(ctx, "10", &SubscriptionUpdateRequestAttributes{
+			FamilySharable: Bool(true),
+		})
+	})
+}
+
+func TestUpdateSubscriptionDisablingFamilySharing(t *testing.T) {
+	t.Parallel()
+
+	testEndpointExpectingError(t, "{}", func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.UpdateSubscription(ctx, "10", &SubscriptionUpdateRequestAttributes{
+			FamilySharable: Bool(false),
+		})
+	})
+}
+
+func TestParseSubscriptionState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseSubscriptionState(string(SubscriptionStateApproved))
+	assert.NoError(t, err)
+	assert.Equal(t, SubscriptionStateApproved, got)
+
+	_, err = ParseSubscriptionState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "SubscriptionState", Value: "UNKNOWN"}, err)
+}
+
+func TestParseSubscriptionPeriod(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseSubscriptionPeriod(string(SubscriptionPeriodOneWeek))
+	assert.NoError(t, err)
+	assert.Equal(t, SubscriptionPeriodOneWeek, got)
+
+	_, err = ParseSubscriptionPeriod("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "SubscriptionPeriod", Value: "UNKNOWN"}, err)
+}
@@ -27,6 +27,14 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestPlatformIsValid(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, PlatformVISIONOS.IsValid())
+	assert.False(t, Platform("WATCH_OS").IsValid())
+	assert.Contains(t, Platforms(), PlatformIOS)
+}
+
 func TestListApps(t *testing.T) {
 	t.Parallel()
 
@@ -51,7 +59,7 @@ func TestGetAppIncludeds(t *testing.T) {
 		{"type":"betaAppLocalizations"},{"type":"builds"},{"type":"betaLicenseAgreements"},
 		{"type":"betaAppReviewDetails"},{"type":"appInfos"},{"type":"endUserLicenseAgreements"},
 		{"type":"appPreOrders"},{"type":"appPrices"},{"type":"territories"},{"type":"inAppPurchases"},
-		{"type":"gameCenterEnabledVersions"},{"type":"perfPowerMetrics"}
+		{"type":"gameCenterEnabledVersions"},{"type":"perfPowerMetrics"},{"type":"appPriceSchedules"}
 		]}`, func(ctx context.Context, client *Client) {
 		app, _, err := client.Apps.GetApp(ctx, "10", &GetAppQuery{})
 		assert.NoError(t, err)
@@ -72,6 +80,7 @@ func TestGetAppIncludeds(t *testing.T) {
 		assert.NotNil(t, app.Included[12].InAppPurchase())
 		assert.NotNil(t, app.Included[13].GameCenterEnabledVersion())
 		assert.NotNil(t, app.Included[14].PerfPowerMetric())
+		assert.NotNil(t, app.Included[15].AppPriceSchedule())
 
 		assert.Nil(t, app.Included[0].AppStoreVersion())
 		assert.Nil(t, app.Included[0].PrereleaseVersion())
@@ -87,6 +96,7 @@ func TestGetAppIncludeds(t *testing.T) {
 		assert.Nil(t, app.Included[0].InAppPurchase())
 		assert.Nil(t, app.Included[0].GameCenterEnabledVersion())
 		assert.Nil(t, app.Included[0].PerfPowerMetric())
+		assert.Nil(t, app.Included[0].AppPriceSchedule())
 	})
 }
 
@@ -121,3 +131,14 @@ func TestGetInAppPurchase(t *testing.T) {
 		return client.Apps.GetInAppPurchase(ctx, "10", &GetInAppPurchaseQuery{})
 	})
 }
+
+func TestParsePlatform(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParsePlatform(string(PlatformIOS))
+	assert.NoError(t, err)
+	assert.Equal(t, PlatformIOS, got)
+
+	_, err = ParsePlatform("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "Platform", Value: "UNKNOWN"}, err)
+}
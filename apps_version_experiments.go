@@ -0,0 +1,720 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppStoreVersionExperimentState defines model for AppStoreVersionExperimentV2.Attributes.State
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2/attributes
+type AppStoreVersionExperimentState string
+
+const (
+	// AppStoreVersionExperimentStatePrepareForSubmission is an experiment state for PrepareForSubmission.
+	AppStoreVersionExperimentStatePrepareForSubmission AppStoreVersionExperimentState = "PREPARE_FOR_SUBMISSION"
+	// AppStoreVersionExperimentStateReadyForReview is an experiment state for ReadyForReview.
+	AppStoreVersionExperimentStateReadyForReview AppStoreVersionExperimentState = "READY_FOR_REVIEW"
+	// AppStoreVersionExperimentStateWaitingForReview is an experiment state for WaitingForReview.
+	AppStoreVersionExperimentStateWaitingForReview AppStoreVersionExperimentState = "WAITING_FOR_REVIEW"
+	// AppStoreVersionExperimentStateInReview is an experiment state for InReview.
+	AppStoreVersionExperimentStateInReview AppStoreVersionExperimentState = "IN_REVIEW"
+	// AppStoreVersionExperimentStateAccepted is an experiment state for Accepted.
+	AppStoreVersionExperimentStateAccepted AppStoreVersionExperimentState = "ACCEPTED"
+	// AppStoreVersionExperimentStateApproved is an experiment state for Approved.
+	AppStoreVersionExperimentStateApproved AppStoreVersionExperimentState = "APPROVED"
+	// AppStoreVersionExperimentStateRejected is an experiment state for Rejected.
+	AppStoreVersionExperimentStateRejected AppStoreVersionExperimentState = "REJECTED"
+	// AppStoreVersionExperimentStateCompleted is an experiment state for Completed.
+	AppStoreVersionExperimentStateCompleted AppStoreVersionExperimentState = "COMPLETED"
+	// AppStoreVersionExperimentStateStopped is an experiment state for Stopped.
+	AppStoreVersionExperimentStateStopped AppStoreVersionExperimentState = "STOPPED"
+)
+
+var appStoreVersionExperimentStates = []AppStoreVersionExperimentState{
+	AppStoreVersionExperimentStatePrepareForSubmission,
+	AppStoreVersionExperimentStateReadyForReview,
+	AppStoreVersionExperimentStateWaitingForReview,
+	AppStoreVersionExperimentStateInReview,
+	AppStoreVersionExperimentStateAccepted,
+	AppStoreVersionExperimentStateApproved,
+	AppStoreVersionExperimentStateRejected,
+	AppStoreVersionExperimentStateCompleted,
+	AppStoreVersionExperimentStateStopped,
+}
+
+// IsValid reports whether the app store version experiment state is one this package knows about.
+func (v AppStoreVersionExperimentState) IsValid() bool {
+	for _, value := range appStoreVersionExperimentStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppStoreVersionExperimentState returns s as an AppStoreVersionExperimentState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppStoreVersionExperimentState(s string) (AppStoreVersionExperimentState, error) {
+	if v := AppStoreVersionExperimentState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppStoreVersionExperimentState", Value: s}
+}
+
+// AppStoreVersionExperiment defines model for AppStoreVersionExperimentV2.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2
+type AppStoreVersionExperiment struct {
+	Attributes    *AppStoreVersionExperimentAttributes    `json:"attributes,omitempty"`
+	ID            string                                  `json:"id"`
+	Links         ResourceLinks                           `json:"links"`
+	Relationships *AppStoreVersionExperimentRelationships `json:"relationships,omitempty"`
+	Type          string                                  `json:"type"`
+}
+
+// AppStoreVersionExperimentAttributes defines model for AppStoreVersionExperimentV2.Attributes
+//
+// StartDate and EndDate report when the experiment actually ran, and State reports whether
+// it is still running, was stopped early, or completed.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2/attributes
+type AppStoreVersionExperimentAttributes struct {
+	EndDate           *DateTime                       `json:"endDate,omitempty"`
+	Name              *string                         `json:"name,omitempty"`
+	Platform          *Platform                       `json:"platform,omitempty"`
+	ReviewRequired    *bool                           `json:"reviewRequired,omitempty"`
+	StartDate         *DateTime                       `json:"startDate,omitempty"`
+	State             *AppStoreVersionExperimentState `json:"state,omitempty"`
+	TrafficProportion *int                            `json:"trafficProportion,omitempty"`
+}
+
+// AppStoreVersionExperimentRelationships defines model for AppStoreVersionExperimentV2.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2/relationships
+type AppStoreVersionExperimentRelationships struct {
+	App                                 *Relationship      `json:"app,omitempty"`
+	AppStoreVersionExperimentTreatments *PagedRelationship `json:"appStoreVersionExperimentTreatments,omitempty"`
+	ControlVersions                     *PagedRelationship `json:"controlVersions,omitempty"`
+	LatestControlVersion                *Relationship      `json:"latestControlVersion,omitempty"`
+}
+
+// AppStoreVersionExperimentResponse defines model for AppStoreVersionExperimentV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2response
+type AppStoreVersionExperimentResponse struct {
+	Data     AppStoreVersionExperiment                   `json:"data"`
+	Included []AppStoreVersionExperimentResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                               `json:"links"`
+}
+
+// AppStoreVersionExperimentsResponse defines model for AppStoreVersionExperimentsV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentsv2response
+type AppStoreVersionExperimentsResponse struct {
+	Data     []AppStoreVersionExperiment                 `json:"data"`
+	Included []AppStoreVersionExperimentResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                          `json:"links"`
+	Meta     *PagingInformation                          `json:"meta,omitempty"`
+}
+
+// AppStoreVersionExperimentResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppStoreVersionExperimentResponse or AppStoreVersionExperimentsResponse.
+type AppStoreVersionExperimentResponseIncluded included
+
+// appStoreVersionExperimentCreateRequest defines model for AppStoreVersionExperimentV2CreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2createrequest/data
+type appStoreVersionExperimentCreateRequest struct {
+	Attributes    appStoreVersionExperimentCreateRequestAttributes    `json:"attributes"`
+	Relationships appStoreVersionExperimentCreateRequestRelationships `json:"relationships"`
+	Type          string                                              `json:"type"`
+}
+
+// appStoreVersionExperimentCreateRequestAttributes are attributes for AppStoreVersionExperimentV2CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2createrequest/data/attributes
+type appStoreVersionExperimentCreateRequestAttributes struct {
+	Name              string   `json:"name"`
+	Platform          Platform `json:"platform"`
+	TrafficProportion int      `json:"trafficProportion"`
+}
+
+// appStoreVersionExperimentCreateRequestRelationships are relationships for AppStoreVersionExperimentV2CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2createrequest/data/relationships
+type appStoreVersionExperimentCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// appStoreVersionExperimentUpdateRequest defines model for AppStoreVersionExperimentV2UpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2updaterequest/data
+type appStoreVersionExperimentUpdateRequest struct {
+	Attributes *AppStoreVersionExperimentUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                            `json:"id"`
+	Type       string                                            `json:"type"`
+}
+
+// AppStoreVersionExperimentUpdateRequestAttributes are attributes for AppStoreVersionExperimentV2UpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimentv2updaterequest/data/attributes
+type AppStoreVersionExperimentUpdateRequestAttributes struct {
+	Name              *string `json:"name,omitempty"`
+	Started           *bool   `json:"started,omitempty"`
+	TrafficProportion *int    `json:"trafficProportion,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatment defines model for AppStoreVersionExperimentTreatment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatment
+type AppStoreVersionExperimentTreatment struct {
+	Attributes    *AppStoreVersionExperimentTreatmentAttributes    `json:"attributes,omitempty"`
+	ID            string                                           `json:"id"`
+	Links         ResourceLinks                                    `json:"links"`
+	Relationships *AppStoreVersionExperimentTreatmentRelationships `json:"relationships,omitempty"`
+	Type          string                                           `json:"type"`
+}
+
+// AppStoreVersionExperimentTreatmentAttributes defines model for AppStoreVersionExperimentTreatment.Attributes
+//
+// PromotedDate is set once the treatment has been applied to the original product page.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatment/attributes
+type AppStoreVersionExperimentTreatmentAttributes struct {
+	AppIcon      *ImageAsset `json:"appIcon,omitempty"`
+	AppIconName  *string     `json:"appIconName,omitempty"`
+	Name         *string     `json:"name,omitempty"`
+	PromotedDate *DateTime   `json:"promotedDate,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentRelationships defines model for AppStoreVersionExperimentTreatment.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatment/relationships
+type AppStoreVersionExperimentTreatmentRelationships struct {
+	AppStoreVersionExperimentTreatmentLocalizations *PagedRelationship `json:"appStoreVersionExperimentTreatmentLocalizations,omitempty"`
+	AppStoreVersionExperimentV2                     *Relationship      `json:"appStoreVersionExperimentV2,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentResponse defines model for AppStoreVersionExperimentTreatmentResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentresponse
+type AppStoreVersionExperimentTreatmentResponse struct {
+	Data     AppStoreVersionExperimentTreatment                   `json:"data"`
+	Included []AppStoreVersionExperimentTreatmentResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                        `json:"links"`
+}
+
+// AppStoreVersionExperimentTreatmentsResponse defines model for AppStoreVersionExperimentTreatmentsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentsresponse
+type AppStoreVersionExperimentTreatmentsResponse struct {
+	Data     []AppStoreVersionExperimentTreatment                 `json:"data"`
+	Included []AppStoreVersionExperimentTreatmentResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                   `json:"links"`
+	Meta     *PagingInformation                                   `json:"meta,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppStoreVersionExperimentTreatmentResponse or AppStoreVersionExperimentTreatmentsResponse.
+type AppStoreVersionExperimentTreatmentResponseIncluded included
+
+// appStoreVersionExperimentTreatmentCreateRequest defines model for AppStoreVersionExperimentTreatmentCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentcreaterequest/data
+type appStoreVersionExperimentTreatmentCreateRequest struct {
+	Attributes    appStoreVersionExperimentTreatmentCreateRequestAttributes    `json:"attributes"`
+	Relationships appStoreVersionExperimentTreatmentCreateRequestRelationships `json:"relationships"`
+	Type          string                                                       `json:"type"`
+}
+
+// appStoreVersionExperimentTreatmentCreateRequestAttributes are attributes for AppStoreVersionExperimentTreatmentCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentcreaterequest/data/attributes
+type appStoreVersionExperimentTreatmentCreateRequestAttributes struct {
+	AppIconName *string `json:"appIconName,omitempty"`
+	Name        string  `json:"name"`
+}
+
+// appStoreVersionExperimentTreatmentCreateRequestRelationships are relationships for AppStoreVersionExperimentTreatmentCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentcreaterequest/data/relationships
+type appStoreVersionExperimentTreatmentCreateRequestRelationships struct {
+	AppStoreVersionExperimentV2 relationshipDeclaration `json:"appStoreVersionExperimentV2"`
+}
+
+// appStoreVersionExperimentTreatmentUpdateRequest defines model for AppStoreVersionExperimentTreatmentUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentupdaterequest/data
+type appStoreVersionExperimentTreatmentUpdateRequest struct {
+	Attributes *appStoreVersionExperimentTreatmentUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                     `json:"id"`
+	Type       string                                                     `json:"type"`
+}
+
+// appStoreVersionExperimentTreatmentUpdateRequestAttributes are attributes for AppStoreVersionExperimentTreatmentUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentupdaterequest/data/attributes
+type appStoreVersionExperimentTreatmentUpdateRequestAttributes struct {
+	AppIconName *string `json:"appIconName,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentLocalization defines model for AppStoreVersionExperimentTreatmentLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalization
+type AppStoreVersionExperimentTreatmentLocalization struct {
+	Attributes    *AppStoreVersionExperimentTreatmentLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                                       `json:"id"`
+	Links         ResourceLinks                                                `json:"links"`
+	Relationships *AppStoreVersionExperimentTreatmentLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                                       `json:"type"`
+}
+
+// AppStoreVersionExperimentTreatmentLocalizationAttributes defines model for AppStoreVersionExperimentTreatmentLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalization/attributes
+type AppStoreVersionExperimentTreatmentLocalizationAttributes struct {
+	Locale *string `json:"locale,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentLocalizationRelationships defines model for AppStoreVersionExperimentTreatmentLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalization/relationships
+type AppStoreVersionExperimentTreatmentLocalizationRelationships struct {
+	AppPreviewSets                     *PagedRelationship `json:"appPreviewSets,omitempty"`
+	AppScreenshotSets                  *PagedRelationship `json:"appScreenshotSets,omitempty"`
+	AppStoreVersionExperimentTreatment *Relationship      `json:"appStoreVersionExperimentTreatment,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentLocalizationResponse defines model for AppStoreVersionExperimentTreatmentLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalizationresponse
+type AppStoreVersionExperimentTreatmentLocalizationResponse struct {
+	Data     AppStoreVersionExperimentTreatmentLocalization                   `json:"data"`
+	Included []AppStoreVersionExperimentTreatmentLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                                    `json:"links"`
+}
+
+// AppStoreVersionExperimentTreatmentLocalizationsResponse defines model for AppStoreVersionExperimentTreatmentLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalizationsresponse
+type AppStoreVersionExperimentTreatmentLocalizationsResponse struct {
+	Data     []AppStoreVersionExperimentTreatmentLocalization                 `json:"data"`
+	Included []AppStoreVersionExperimentTreatmentLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                               `json:"links"`
+	Meta     *PagingInformation                                               `json:"meta,omitempty"`
+}
+
+// AppStoreVersionExperimentTreatmentLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppStoreVersionExperimentTreatmentLocalizationResponse or AppStoreVersionExperimentTreatmentLocalizationsResponse.
+type AppStoreVersionExperimentTreatmentLocalizationResponseIncluded included
+
+// appStoreVersionExperimentTreatmentLocalizationCreateRequest defines model for AppStoreVersionExperimentTreatmentLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalizationcreaterequest/data
+type appStoreVersionExperimentTreatmentLocalizationCreateRequest struct {
+	Attributes    appStoreVersionExperimentTreatmentLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships appStoreVersionExperimentTreatmentLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                                   `json:"type"`
+}
+
+// appStoreVersionExperimentTreatmentLocalizationCreateRequestAttributes are attributes for AppStoreVersionExperimentTreatmentLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalizationcreaterequest/data/attributes
+type appStoreVersionExperimentTreatmentLocalizationCreateRequestAttributes struct {
+	Locale string `json:"locale"`
+}
+
+// appStoreVersionExperimentTreatmentLocalizationCreateRequestRelationships are relationships for AppStoreVersionExperimentTreatmentLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionexperimenttreatmentlocalizationcreaterequest/data/relationships
+type appStoreVersionExperimentTreatmentLocalizationCreateRequestRelationships struct {
+	AppStoreVersionExperimentTreatment relationshipDeclaration `json:"appStoreVersionExperimentTreatment"`
+}
+
+// ListAppStoreVersionExperimentsForAppQuery are query options for ListAppStoreVersionExperimentsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_store_version_experiments_for_an_app
+type ListAppStoreVersionExperimentsForAppQuery struct {
+	FieldsAppStoreVersionExperiments          []string `url:"fields[appStoreVersionExperiments],omitempty"`
+	FieldsAppStoreVersionExperimentTreatments []string `url:"fields[appStoreVersionExperimentTreatments],omitempty"`
+	FilterState                               []string `url:"filter[state],omitempty"`
+	Include                                   []string `url:"include,omitempty"`
+	Limit                                     int      `url:"limit,omitempty"`
+	LimitAppStoreVersionExperimentTreatments  int      `url:"limit[appStoreVersionExperimentTreatments],omitempty"`
+	Cursor                                    string   `url:"cursor,omitempty"`
+}
+
+// GetAppStoreVersionExperimentQuery are query options for GetAppStoreVersionExperiment
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_information
+type GetAppStoreVersionExperimentQuery struct {
+	FieldsAppStoreVersionExperiments          []string `url:"fields[appStoreVersionExperiments],omitempty"`
+	FieldsAppStoreVersionExperimentTreatments []string `url:"fields[appStoreVersionExperimentTreatments],omitempty"`
+	Include                                   []string `url:"include,omitempty"`
+	LimitAppStoreVersionExperimentTreatments  int      `url:"limit[appStoreVersionExperimentTreatments],omitempty"`
+}
+
+// ListTreatmentsForAppStoreVersionExperimentQuery are query options for ListTreatmentsForAppStoreVersionExperiment
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_treatments_for_an_app_store_version_experiment
+type ListTreatmentsForAppStoreVersionExperimentQuery struct {
+	FieldsAppStoreVersionExperimentTreatments             []string `url:"fields[appStoreVersionExperimentTreatments],omitempty"`
+	FieldsAppStoreVersionExperimentTreatmentLocalizations []string `url:"fields[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	Include                                               []string `url:"include,omitempty"`
+	Limit                                                 int      `url:"limit,omitempty"`
+	LimitAppStoreVersionExperimentTreatmentLocalizations  int      `url:"limit[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	Cursor                                                string   `url:"cursor,omitempty"`
+}
+
+// GetAppStoreVersionExperimentTreatmentQuery are query options for GetAppStoreVersionExperimentTreatment
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_treatment_information
+type GetAppStoreVersionExperimentTreatmentQuery struct {
+	FieldsAppStoreVersionExperimentTreatments             []string `url:"fields[appStoreVersionExperimentTreatments],omitempty"`
+	FieldsAppStoreVersionExperimentTreatmentLocalizations []string `url:"fields[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	Include                                               []string `url:"include,omitempty"`
+	LimitAppStoreVersionExperimentTreatmentLocalizations  int      `url:"limit[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+}
+
+// ListLocalizationsForAppStoreVersionExperimentTreatmentQuery are query options for ListLocalizationsForAppStoreVersionExperimentTreatment
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_app_store_version_experiment_treatment
+type ListLocalizationsForAppStoreVersionExperimentTreatmentQuery struct {
+	FieldsAppStoreVersionExperimentTreatmentLocalizations []string `url:"fields[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	FieldsAppPreviewSets                                  []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppScreenshotSets                               []string `url:"fields[appScreenshotSets],omitempty"`
+	FilterLocale                                          []string `url:"filter[locale],omitempty"`
+	Include                                               []string `url:"include,omitempty"`
+	Limit                                                 int      `url:"limit,omitempty"`
+	LimitAppPreviewSets                                   int      `url:"limit[appPreviewSets],omitempty"`
+	LimitAppScreenshotSets                                int      `url:"limit[appScreenshotSets],omitempty"`
+	Cursor                                                string   `url:"cursor,omitempty"`
+}
+
+// GetAppStoreVersionExperimentTreatmentLocalizationQuery are query options for GetAppStoreVersionExperimentTreatmentLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_treatment_localization_information
+type GetAppStoreVersionExperimentTreatmentLocalizationQuery struct {
+	FieldsAppStoreVersionExperimentTreatmentLocalizations []string `url:"fields[appStoreVersionExperimentTreatmentLocalizations],omitempty"`
+	FieldsAppPreviewSets                                  []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppScreenshotSets                               []string `url:"fields[appScreenshotSets],omitempty"`
+	Include                                               []string `url:"include,omitempty"`
+	LimitAppPreviewSets                                   int      `url:"limit[appPreviewSets],omitempty"`
+	LimitAppScreenshotSets                                int      `url:"limit[appScreenshotSets],omitempty"`
+}
+
+// ListAppScreenshotSetsForExperimentTreatmentLocalizationQuery are query options for ListAppScreenshotSetsForExperimentTreatmentLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshot_sets_for_an_app_store_version_experiment_treatment_localization
+type ListAppScreenshotSetsForExperimentTreatmentLocalizationQuery struct {
+	FieldsAppScreenshotSets     []string `url:"fields[appScreenshotSets],omitempty"`
+	FieldsAppScreenshots        []string `url:"fields[appScreenshots],omitempty"`
+	FilterScreenshotDisplayType []string `url:"filter[screenshotDisplayType],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	LimitAppScreenshots         int      `url:"limit[appScreenshots],omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// ListAppPreviewSetsForExperimentTreatmentLocalizationQuery are query options for ListAppPreviewSetsForExperimentTreatmentLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_preview_sets_for_an_app_store_version_experiment_treatment_localization
+type ListAppPreviewSetsForExperimentTreatmentLocalizationQuery struct {
+	FieldsAppPreviewSets []string `url:"fields[appPreviewSets],omitempty"`
+	FieldsAppPreviews    []string `url:"fields[appPreviews],omitempty"`
+	FilterPreviewType    []string `url:"filter[previewType],omitempty"`
+	Include              []string `url:"include,omitempty"`
+	Limit                int      `url:"limit,omitempty"`
+	LimitAppPreviews     int      `url:"limit[appPreviews],omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+}
+
+// ListAppStoreVersionExperimentsForApp lists the product page optimization experiments for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_store_version_experiments_for_an_app
+func (s *AppsService) ListAppStoreVersionExperimentsForApp(ctx context.Context, id string, params *ListAppStoreVersionExperimentsForAppQuery) (*AppStoreVersionExperimentsResponse, *Response, error) {
+	return Get[AppStoreVersionExperimentsResponse](ctx, s.client, fmt.Sprintf("apps/%s/appStoreVersionExperimentsV2", id), params)
+}
+
+// GetAppStoreVersionExperiment gets a product page optimization experiment, including its state and when it ran.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_information
+func (s *AppsService) GetAppStoreVersionExperiment(ctx context.Context, id string, params *GetAppStoreVersionExperimentQuery) (*AppStoreVersionExperimentResponse, *Response, error) {
+	return Get[AppStoreVersionExperimentResponse](ctx, s.client, fmt.Sprintf("../v2/appStoreVersionExperiments/%s", id), params)
+}
+
+// CreateAppStoreVersionExperiment creates a product page optimization experiment for an app on the given platform.
+//
+// trafficProportion is the percentage of App Store traffic that is shown one of the experiment's treatments.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_store_version_experiment
+func (s *AppsService) CreateAppStoreVersionExperiment(ctx context.Context, name string, platform Platform, trafficProportion int, appID string) (*AppStoreVersionExperimentResponse, *Response, error) {
+	req := appStoreVersionExperimentCreateRequest{
+		Attributes: appStoreVersionExperimentCreateRequestAttributes{
+			Name:              name,
+			Platform:          platform,
+			TrafficProportion: trafficProportion,
+		},
+		Relationships: appStoreVersionExperimentCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "appStoreVersionExperiments",
+	}
+	res := new(AppStoreVersionExperimentResponse)
+	resp, err := s.client.post(ctx, "../v2/appStoreVersionExperiments", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppStoreVersionExperiment updates the name or traffic proportion of an experiment, or starts or stops it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_store_version_experiment
+func (s *AppsService) UpdateAppStoreVersionExperiment(ctx context.Context, id string, attributes *AppStoreVersionExperimentUpdateRequestAttributes) (*AppStoreVersionExperimentResponse, *Response, error) {
+	req := appStoreVersionExperimentUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "appStoreVersionExperiments",
+	}
+	url := fmt.Sprintf("../v2/appStoreVersionExperiments/%s", id)
+	res := new(AppStoreVersionExperimentResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// StartAppStoreVersionExperiment starts an approved experiment so that it begins receiving traffic.
+func (s *AppsService) StartAppStoreVersionExperiment(ctx context.Context, id string) (*AppStoreVersionExperimentResponse, *Response, error) {
+	return s.UpdateAppStoreVersionExperiment(ctx, id, &AppStoreVersionExperimentUpdateRequestAttributes{
+		Started: Bool(true),
+	})
+}
+
+// StopAppStoreVersionExperiment stops a running experiment before its scheduled end.
+func (s *AppsService) StopAppStoreVersionExperiment(ctx context.Context, id string) (*AppStoreVersionExperimentResponse, *Response, error) {
+	return s.UpdateAppStoreVersionExperiment(ctx, id, &AppStoreVersionExperimentUpdateRequestAttributes{
+		Started: Bool(false),
+	})
+}
+
+// DeleteAppStoreVersionExperiment deletes an experiment that hasn't started.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_store_version_experiment
+func (s *AppsService) DeleteAppStoreVersionExperiment(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("../v2/appStoreVersionExperiments/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListTreatmentsForAppStoreVersionExperiment lists the treatments being tested in an experiment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_treatments_for_an_app_store_version_experiment
+func (s *AppsService) ListTreatmentsForAppStoreVersionExperiment(ctx context.Context, id string, params *ListTreatmentsForAppStoreVersionExperimentQuery) (*AppStoreVersionExperimentTreatmentsResponse, *Response, error) {
+	return Get[AppStoreVersionExperimentTreatmentsResponse](ctx, s.client, fmt.Sprintf("../v2/appStoreVersionExperiments/%s/appStoreVersionExperimentTreatments", id), params)
+}
+
+// GetAppStoreVersionExperimentTreatment gets a treatment, including its app icon and promotion date.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_treatment_information
+func (s *AppsService) GetAppStoreVersionExperimentTreatment(ctx context.Context, id string, params *GetAppStoreVersionExperimentTreatmentQuery) (*AppStoreVersionExperimentTreatmentResponse, *Response, error) {
+	return Get[AppStoreVersionExperimentTreatmentResponse](ctx, s.client, fmt.Sprintf("appStoreVersionExperimentTreatments/%s", id), params)
+}
+
+// CreateAppStoreVersionExperimentTreatment adds a treatment to an experiment.
+//
+// appIconName is the name of an alternate app icon included in the binary, for icon tests.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_store_version_experiment_treatment
+func (s *AppsService) CreateAppStoreVersionExperimentTreatment(ctx context.Context, name string, appIconName *string, experimentID string) (*AppStoreVersionExperimentTreatmentResponse, *Response, error) {
+	req := appStoreVersionExperimentTreatmentCreateRequest{
+		Attributes: appStoreVersionExperimentTreatmentCreateRequestAttributes{
+			AppIconName: appIconName,
+			Name:        name,
+		},
+		Relationships: appStoreVersionExperimentTreatmentCreateRequestRelationships{
+			AppStoreVersionExperimentV2: *newRelationshipDeclaration(&experimentID, "appStoreVersionExperiments"),
+		},
+		Type: "appStoreVersionExperimentTreatments",
+	}
+	res := new(AppStoreVersionExperimentTreatmentResponse)
+	resp, err := s.client.post(ctx, "appStoreVersionExperimentTreatments", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateAppStoreVersionExperimentTreatment changes the name or app icon of a treatment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_app_store_version_experiment_treatment
+func (s *AppsService) UpdateAppStoreVersionExperimentTreatment(ctx context.Context, id string, name *string, appIconName *string) (*AppStoreVersionExperimentTreatmentResponse, *Response, error) {
+	req := appStoreVersionExperimentTreatmentUpdateRequest{
+		ID:   id,
+		Type: "appStoreVersionExperimentTreatments",
+	}
+
+	if name != nil || appIconName != nil {
+		req.Attributes = &appStoreVersionExperimentTreatmentUpdateRequestAttributes{
+			AppIconName: appIconName,
+			Name:        name,
+		}
+	}
+
+	url := fmt.Sprintf("appStoreVersionExperimentTreatments/%s", id)
+	res := new(AppStoreVersionExperimentTreatmentResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppStoreVersionExperimentTreatment deletes a treatment from an experiment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_store_version_experiment_treatment
+func (s *AppsService) DeleteAppStoreVersionExperimentTreatment(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appStoreVersionExperimentTreatments/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListLocalizationsForAppStoreVersionExperimentTreatment lists the localizations of a treatment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_app_store_version_experiment_treatment
+func (s *AppsService) ListLocalizationsForAppStoreVersionExperimentTreatment(ctx context.Context, id string, params *ListLocalizationsForAppStoreVersionExperimentTreatmentQuery) (*AppStoreVersionExperimentTreatmentLocalizationsResponse, *Response, error) {
+	return Get[AppStoreVersionExperimentTreatmentLocalizationsResponse](ctx, s.client, fmt.Sprintf("appStoreVersionExperimentTreatments/%s/appStoreVersionExperimentTreatmentLocalizations", id), params)
+}
+
+// GetAppStoreVersionExperimentTreatmentLocalization gets a treatment localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_version_experiment_treatment_localization_information
+func (s *AppsService) GetAppStoreVersionExperimentTreatmentLocalization(ctx context.Context, id string, params *GetAppStoreVersionExperimentTreatmentLocalizationQuery) (*AppStoreVersionExperimentTreatmentLocalizationResponse, *Response, error) {
+	return Get[AppStoreVersionExperimentTreatmentLocalizationResponse](ctx, s.client, fmt.Sprintf("appStoreVersionExperimentTreatmentLocalizations/%s", id), params)
+}
+
+// CreateAppStoreVersionExperimentTreatmentLocalization adds a localization to a treatment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_store_version_experiment_treatment_localization
+func (s *AppsService) CreateAppStoreVersionExperimentTreatmentLocalization(ctx context.Context, locale string, treatmentID string) (*AppStoreVersionExperimentTreatmentLocalizationResponse, *Response, error) {
+	req := appStoreVersionExperimentTreatmentLocalizationCreateRequest{
+		Attributes: appStoreVersionExperimentTreatmentLocalizationCreateRequestAttributes{
+			Locale: locale,
+		},
+		Relationships: appStoreVersionExperimentTreatmentLocalizationCreateRequestRelationships{
+			AppStoreVersionExperimentTreatment: *newRelationshipDeclaration(&treatmentID, "appStoreVersionExperimentTreatments"),
+		},
+		Type: "appStoreVersionExperimentTreatmentLocalizations",
+	}
+	res := new(AppStoreVersionExperimentTreatmentLocalizationResponse)
+	resp, err := s.client.post(ctx, "appStoreVersionExperimentTreatmentLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteAppStoreVersionExperimentTreatmentLocalization deletes a treatment localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_app_store_version_experiment_treatment_localization
+func (s *AppsService) DeleteAppStoreVersionExperimentTreatmentLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("appStoreVersionExperimentTreatmentLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListAppScreenshotSetsForExperimentTreatmentLocalization lists the screenshot sets of a treatment localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_screenshot_sets_for_an_app_store_version_experiment_treatment_localization
+func (s *AppsService) ListAppScreenshotSetsForExperimentTreatmentLocalization(ctx context.Context, id string, params *ListAppScreenshotSetsForExperimentTreatmentLocalizationQuery) (*AppScreenshotSetsResponse, *Response, error) {
+	return Get[AppScreenshotSetsResponse](ctx, s.client, fmt.Sprintf("appStoreVersionExperimentTreatmentLocalizations/%s/appScreenshotSets", id), params)
+}
+
+// ListAppPreviewSetsForExperimentTreatmentLocalization lists the preview sets of a treatment localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_app_preview_sets_for_an_app_store_version_experiment_treatment_localization
+func (s *AppsService) ListAppPreviewSetsForExperimentTreatmentLocalization(ctx context.Context, id string, params *ListAppPreviewSetsForExperimentTreatmentLocalizationQuery) (*AppPreviewSetsResponse, *Response, error) {
+	return Get[AppPreviewSetsResponse](ctx, s.client, fmt.Sprintf("appStoreVersionExperimentTreatmentLocalizations/%s/appPreviewSets", id), params)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppStoreVersionExperimentResponseIncluded.
+func (i *AppStoreVersionExperimentResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *AppStoreVersionExperimentResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// AppStoreVersion returns the AppStoreVersion stored within, if one is present.
+func (i *AppStoreVersionExperimentResponseIncluded) AppStoreVersion() *AppStoreVersion {
+	return extractIncludedAppStoreVersion(i.inner)
+}
+
+// AppStoreVersionExperimentTreatment returns the AppStoreVersionExperimentTreatment stored within, if one is present.
+func (i *AppStoreVersionExperimentResponseIncluded) AppStoreVersionExperimentTreatment() *AppStoreVersionExperimentTreatment {
+	return extractIncludedAppStoreVersionExperimentTreatment(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppStoreVersionExperimentTreatmentResponseIncluded.
+func (i *AppStoreVersionExperimentTreatmentResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppStoreVersionExperiment returns the AppStoreVersionExperiment stored within, if one is present.
+func (i *AppStoreVersionExperimentTreatmentResponseIncluded) AppStoreVersionExperiment() *AppStoreVersionExperiment {
+	return extractIncludedAppStoreVersionExperiment(i.inner)
+}
+
+// AppStoreVersionExperimentTreatmentLocalization returns the AppStoreVersionExperimentTreatmentLocalization stored within, if one is present.
+func (i *AppStoreVersionExperimentTreatmentResponseIncluded) AppStoreVersionExperimentTreatmentLocalization() *AppStoreVersionExperimentTreatmentLocalization {
+	return extractIncludedAppStoreVersionExperimentTreatmentLocalization(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppStoreVersionExperimentTreatmentLocalizationResponseIncluded.
+func (i *AppStoreVersionExperimentTreatmentLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppStoreVersionExperimentTreatment returns the AppStoreVersionExperimentTreatment stored within, if one is present.
+func (i *AppStoreVersionExperimentTreatmentLocalizationResponseIncluded) AppStoreVersionExperimentTreatment() *AppStoreVersionExperimentTreatment {
+	return extractIncludedAppStoreVersionExperimentTreatment(i.inner)
+}
+
+// AppPreviewSet returns the AppPreviewSet stored within, if one is present.
+func (i *AppStoreVersionExperimentTreatmentLocalizationResponseIncluded) AppPreviewSet() *AppPreviewSet {
+	return extractIncludedAppPreviewSet(i.inner)
+}
+
+// AppScreenshotSet returns the AppScreenshotSet stored within, if one is present.
+func (i *AppStoreVersionExperimentTreatmentLocalizationResponseIncluded) AppScreenshotSet() *AppScreenshotSet {
+	return extractIncludedAppScreenshotSet(i.inner)
+}
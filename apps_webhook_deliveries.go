@@ -0,0 +1,229 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// WebhookDeliveryState defines model for WebhookDeliveryState.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhookdelivery/attributes-data.dictionary
+type WebhookDeliveryState string
+
+const (
+	// WebhookDeliveryStateFailed is a webhook delivery state for a notification the receiver didn't accept.
+	WebhookDeliveryStateFailed WebhookDeliveryState = "FAILED"
+	// WebhookDeliveryStatePending is a webhook delivery state for a notification that hasn't been sent yet.
+	WebhookDeliveryStatePending WebhookDeliveryState = "PENDING"
+	// WebhookDeliveryStateSucceeded is a webhook delivery state for a notification the receiver accepted.
+	WebhookDeliveryStateSucceeded WebhookDeliveryState = "SUCCEEDED"
+)
+
+var webhookDeliveryStates = []WebhookDeliveryState{
+	WebhookDeliveryStateFailed,
+	WebhookDeliveryStatePending,
+	WebhookDeliveryStateSucceeded,
+}
+
+// IsValid reports whether the webhook delivery state is one this package knows about.
+func (v WebhookDeliveryState) IsValid() bool {
+	for _, value := range webhookDeliveryStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseWebhookDeliveryState returns s as a WebhookDeliveryState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseWebhookDeliveryState(s string) (WebhookDeliveryState, error) {
+	if v := WebhookDeliveryState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "WebhookDeliveryState", Value: s}
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhookdelivery
+type WebhookDelivery struct {
+	Attributes    *WebhookDeliveryAttributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *WebhookDeliveryRelationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// WebhookDeliveryAttributes defines model for WebhookDelivery.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhookdelivery/attributes-data.dictionary
+type WebhookDeliveryAttributes struct {
+	CreatedDate   *DateTime                       `json:"createdDate,omitempty"`
+	DeliveryState *WebhookDeliveryState           `json:"deliveryState,omitempty"`
+	ErrorMessage  *string                         `json:"errorMessage,omitempty"`
+	Redelivered   *bool                           `json:"redelivered,omitempty"`
+	SentDate      *DateTime                       `json:"sentDate,omitempty"`
+	Request       *WebhookDeliveryRequestDetails  `json:"request,omitempty"`
+	Response      *WebhookDeliveryResponseDetails `json:"response,omitempty"`
+}
+
+// WebhookDeliveryRequestDetails describes the request App Store Connect sent to the receiver.
+type WebhookDeliveryRequestDetails struct {
+	URL *string `json:"url,omitempty"`
+}
+
+// WebhookDeliveryResponseDetails describes how the receiver responded to a delivery.
+type WebhookDeliveryResponseDetails struct {
+	Body           *string `json:"body,omitempty"`
+	HTTPStatusCode *int    `json:"httpStatusCode,omitempty"`
+}
+
+// WebhookDeliveryRelationships defines model for WebhookDelivery.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhookdelivery/relationships-data.dictionary
+type WebhookDeliveryRelationships struct {
+	Event *Relationship `json:"event,omitempty"`
+}
+
+// WebhookEvent defines model for WebhookEvent.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhookevent
+type WebhookEvent struct {
+	Attributes *WebhookEventAttributes `json:"attributes,omitempty"`
+	ID         string                  `json:"id"`
+	Links      ResourceLinks           `json:"links"`
+	Type       string                  `json:"type"`
+}
+
+// WebhookEventAttributes defines model for WebhookEvent.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhookevent/attributes-data.dictionary
+type WebhookEventAttributes struct {
+	CreatedDate *DateTime `json:"createdDate,omitempty"`
+	EventType   *string   `json:"eventType,omitempty"`
+	Payload     *string   `json:"payload,omitempty"`
+	Ping        *bool     `json:"ping,omitempty"`
+}
+
+// WebhookDeliveryResponse defines model for WebhookDeliveryResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhookdeliveryresponse
+type WebhookDeliveryResponse struct {
+	Data     WebhookDelivery `json:"data"`
+	Included []WebhookEvent  `json:"included,omitempty"`
+	Links    DocumentLinks   `json:"links"`
+}
+
+// WebhookDeliveriesResponse defines model for WebhookDeliveriesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhookdeliveriesresponse
+type WebhookDeliveriesResponse struct {
+	Data     []WebhookDelivery  `json:"data"`
+	Included []WebhookEvent     `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// webhookDeliveryCreateRequest defines model for WebhookDeliveryCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhookdeliverycreaterequest/data-data.dictionary
+type webhookDeliveryCreateRequest struct {
+	Relationships webhookDeliveryCreateRequestRelationships `json:"relationships"`
+	Type          string                                    `json:"type"`
+}
+
+// webhookDeliveryCreateRequestRelationships are relationships for WebhookDeliveryCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhookdeliverycreaterequest/data-data.dictionary/relationships-data.dictionary
+type webhookDeliveryCreateRequestRelationships struct {
+	Template relationshipDeclaration `json:"template"`
+}
+
+// ListDeliveriesForWebhookQuery are query options for ListDeliveriesForWebhook
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-webhooks-_id_-deliveries
+type ListDeliveriesForWebhookQuery struct {
+	FieldsWebhookDeliveries                   []string `url:"fields[webhookDeliveries],omitempty"`
+	FieldsWebhookEvents                       []string `url:"fields[webhookEvents],omitempty"`
+	FilterCreatedDateGreaterThanOrEqualToDate []string `url:"filter[createdDateGreaterThanOrEqualToDate],omitempty"`
+	FilterCreatedDateLessThanDate             []string `url:"filter[createdDateLessThanDate],omitempty"`
+	FilterDeliveryState                       []string `url:"filter[deliveryState],omitempty"`
+	Include                                   []string `url:"include,omitempty"`
+	Limit                                     int      `url:"limit,omitempty"`
+	Cursor                                    string   `url:"cursor,omitempty"`
+}
+
+// ListDeliveriesForWebhook lists the notifications App Store Connect sent, or tried to send, to a webhook along
+// with the status the receiver responded with.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-webhooks-_id_-deliveries
+func (s *AppsService) ListDeliveriesForWebhook(ctx context.Context, id string, params *ListDeliveriesForWebhookQuery) (*WebhookDeliveriesResponse, *Response, error) {
+	return Get[WebhookDeliveriesResponse](ctx, s.client, fmt.Sprintf("webhooks/%s/deliveries", id), params)
+}
+
+// RedeliverWebhookDelivery sends the notification of a past delivery to the webhook again.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-webhookdeliveries
+func (s *AppsService) RedeliverWebhookDelivery(ctx context.Context, deliveryID string) (*WebhookDeliveryResponse, *Response, error) {
+	req := webhookDeliveryCreateRequest{
+		Relationships: webhookDeliveryCreateRequestRelationships{
+			Template: *newRelationshipDeclaration(&deliveryID, "webhookDeliveries"),
+		},
+		Type: "webhookDeliveries",
+	}
+	res := new(WebhookDeliveryResponse)
+	resp, err := s.client.post(ctx, "webhookDeliveries", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// RedeliverFailedWebhookDeliveries sends again every notification that failed to reach a webhook since the given
+// date, such as the ones missed while the receiver was down. It returns the new deliveries.
+func (s *AppsService) RedeliverFailedWebhookDeliveries(ctx context.Context, id string, since time.Time) ([]WebhookDelivery, error) {
+	params := &ListDeliveriesForWebhookQuery{
+		FilterCreatedDateGreaterThanOrEqualToDate: []string{since.UTC().Format(time.RFC3339)},
+		FilterDeliveryState:                       []string{string(WebhookDeliveryStateFailed)},
+		Limit:                                     MaxPageLimit,
+	}
+
+	failed, _, err := List[WebhookDelivery](ctx, s.client, fmt.Sprintf("webhooks/%s/deliveries", id), params)
+	if err != nil {
+		return nil, err
+	}
+
+	redelivered := make([]WebhookDelivery, 0, len(failed))
+
+	for _, delivery := range failed {
+		res, _, err := s.RedeliverWebhookDelivery(ctx, delivery.ID)
+		if err != nil {
+			return redelivered, fmt.Errorf("failed to redeliver webhook delivery %s: %w", delivery.ID, err)
+		}
+
+		redelivered = append(redelivered, res.Data)
+	}
+
+	return redelivered, nil
+}
@@ -0,0 +1,106 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListDeliveriesForWebhook(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WebhookDeliveriesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.ListDeliveriesForWebhook(ctx, "10", &ListDeliveriesForWebhookQuery{})
+	})
+}
+
+func TestListDeliveriesForWebhookResponseStatus(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"data":[{"id":"d1","type":"webhookDeliveries","attributes":{"deliveryState":"FAILED","response":{"httpStatusCode":503,"body":"down"}}}]}`, func(ctx context.Context, client *Client) {
+		deliveries, _, err := client.Apps.ListDeliveriesForWebhook(ctx, "10", &ListDeliveriesForWebhookQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, deliveries.Data, 1)
+
+		attrs := deliveries.Data[0].Attributes
+		assert.Equal(t, WebhookDeliveryStateFailed, *attrs.DeliveryState)
+		assert.Equal(t, 503, *attrs.Response.HTTPStatusCode)
+		assert.Equal(t, "down", *attrs.Response.Body)
+	})
+}
+
+func TestRedeliverWebhookDelivery(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &WebhookDeliveryResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Apps.RedeliverWebhookDelivery(ctx, "10")
+	})
+}
+
+func TestRedeliverFailedWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /webhooks/10/deliveries": `{"data":[{"id":"d1","type":"webhookDeliveries"},{"id":"d2","type":"webhookDeliveries"}]}`,
+		"POST /webhookDeliveries":     `{"data":{"id":"d3","type":"webhookDeliveries"}}`,
+	})
+	defer server.Close()
+
+	redelivered, err := client.Apps.RedeliverFailedWebhookDeliveries(context.Background(), "10", time.Now().Add(-time.Hour))
+	assert.NoError(t, err)
+	assert.Len(t, redelivered, 2)
+	assert.Equal(t, []string{
+		"GET /webhooks/10/deliveries",
+		"POST /webhookDeliveries",
+		"POST /webhookDeliveries",
+	}, *requests)
+}
+
+func TestRedeliverFailedWebhookDeliveriesError(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /webhooks/10/deliveries": `{"data":[{"id":"d1","type":"webhookDeliveries"}]}`,
+	})
+	defer server.Close()
+
+	redelivered, err := client.Apps.RedeliverFailedWebhookDeliveries(context.Background(), "10", time.Now())
+	assert.Error(t, err)
+	assert.Empty(t, redelivered)
+
+	_, err = client.Apps.RedeliverFailedWebhookDeliveries(context.Background(), "11", time.Now())
+	assert.Error(t, err)
+}
+
+func TestParseWebhookDeliveryState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseWebhookDeliveryState(string(WebhookDeliveryStateFailed))
+	assert.NoError(t, err)
+	assert.Equal(t, WebhookDeliveryStateFailed, got)
+
+	_, err = ParseWebhookDeliveryState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "WebhookDeliveryState", Value: "UNKNOWN"}, err)
+}
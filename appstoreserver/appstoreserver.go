@@ -0,0 +1,207 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+/*
+Package appstoreserver is a Go client library for accessing Apple's App Store Server API, which servers use to
+look up the in-app purchases, subscriptions, and refunds of customers.
+
+Requests are authorized with an in-app purchase key, using a token that is scoped to a single app. Create an
+authorized http.Client with asc.NewBundleTokenConfig and pass it to NewClient:
+
+	auth, err := asc.NewBundleTokenConfig(keyID, issuerID, bundleID, 20*time.Minute, privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("client config failed: %s", err)
+	}
+
+	client := appstoreserver.NewClient(auth.Client(), appstoreserver.EnvironmentProduction)
+
+The transactions and renewal info the API returns, as well as App Store Server Notifications V2 sent to your
+server, are JWS-signed payloads. Verify and decode them with a Verifier that trusts the Apple Root CA - G3
+certificate:
+
+	verifier, err := appstoreserver.NewVerifier(appleRootCAG3)
+	if err != nil {
+		return nil, err
+	}
+
+	notification, err := verifier.VerifyNotification(body.SignedPayload)
+
+https://developer.apple.com/documentation/appstoreserverapi
+*/
+package appstoreserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"reflect"
+
+	"github.com/google/go-querystring/query"
+)
+
+const userAgent = "asc-go"
+
+// Environment is the server environment of the App Store, either production or the sandbox used for testing.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/environment
+type Environment string
+
+const (
+	// EnvironmentProduction is the App Store production environment.
+	EnvironmentProduction Environment = "Production"
+	// EnvironmentSandbox is the App Store sandbox environment.
+	EnvironmentSandbox Environment = "Sandbox"
+)
+
+// baseURL returns the App Store Server API URL for the environment.
+func (e Environment) baseURL() string {
+	if e == EnvironmentSandbox {
+		return "https://api.storekit-sandbox.itunes.apple.com/"
+	}
+
+	return "https://api.storekit.itunes.apple.com/"
+}
+
+// Client is the root instance of the App Store Server API.
+type Client struct {
+	client    *http.Client
+	baseURL   *url.URL
+	UserAgent string
+}
+
+// NewClient creates a new Client instance for the given environment. The http.Client should authorize its requests,
+// such as one created from asc.NewBundleTokenConfig.
+func NewClient(httpClient *http.Client, environment Environment) *Client {
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	baseURL, _ := url.Parse(environment.baseURL())
+
+	return &Client{
+		client:    httpClient,
+		baseURL:   baseURL,
+		UserAgent: userAgent,
+	}
+}
+
+// ErrorResponse is returned by the App Store Server API whenever a request is not successful.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/error_codes
+type ErrorResponse struct {
+	Response *http.Response `json:"-"`
+	// ErrorCode is a machine-readable code for the error, such as 4040010 for a transaction ID that was not found.
+	ErrorCode int64 `json:"errorCode"`
+	// ErrorMessage is a description of the error. Do not use this field for programmatic error handling.
+	ErrorMessage string `json:"errorMessage"`
+}
+
+func (e ErrorResponse) Error() string {
+	return fmt.Sprintf(
+		"%v %v: %d %d %s",
+		e.Response.Request.Method,
+		e.Response.Request.URL,
+		e.Response.StatusCode,
+		e.ErrorCode,
+		e.ErrorMessage,
+	)
+}
+
+// get sends a GET request to the API as configured.
+func (c *Client) get(ctx context.Context, path string, params interface{}, v interface{}) (*http.Response, error) {
+	if rv := reflect.ValueOf(params); params != nil && !(rv.Kind() == reflect.Ptr && rv.IsNil()) {
+		qs, err := query.Values(params)
+		if err != nil {
+			return nil, err
+		}
+
+		if encoded := qs.Encode(); encoded != "" {
+			path = fmt.Sprintf("%s?%s", path, encoded)
+		}
+	}
+
+	return c.do(ctx, http.MethodGet, path, nil, v)
+}
+
+// post sends a POST request with a JSON body to the API as configured.
+func (c *Client) post(ctx context.Context, path string, body interface{}, v interface{}) (*http.Response, error) {
+	return c.do(ctx, http.MethodPost, path, body, v)
+}
+
+// put sends a PUT request with a JSON body to the API as configured.
+func (c *Client) put(ctx context.Context, path string, body interface{}, v interface{}) (*http.Response, error) {
+	return c.do(ctx, http.MethodPut, path, body, v)
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, body interface{}, v interface{}) (*http.Response, error) {
+	rel, err := url.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf io.Reader
+
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+
+		buf = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL.ResolveReference(rel).String(), buf)
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		erro := &ErrorResponse{Response: resp}
+		if data, err := io.ReadAll(resp.Body); err == nil && len(bytes.TrimSpace(data)) > 0 {
+			_ = json.Unmarshal(data, erro)
+		}
+
+		return resp, erro
+	}
+
+	if v != nil {
+		err = json.NewDecoder(resp.Body).Decode(v)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,99 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package appstoreserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type recordedRequest struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Body   string
+}
+
+func newServer(status int, raw string) (*Client, *httptest.Server, *recordedRequest) {
+	recorded := new(recordedRequest)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		*recorded = recordedRequest{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Query:  r.URL.Query(),
+			Body:   string(body),
+		}
+
+		w.WriteHeader(status)
+		fmt.Fprintln(w, raw)
+	}))
+
+	client := NewClient(server.Client(), EnvironmentSandbox)
+	client.baseURL, _ = url.Parse(server.URL + "/")
+
+	return client, server, recorded
+}
+
+func TestNewClient(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "https://api.storekit.itunes.apple.com/", NewClient(nil, EnvironmentProduction).baseURL.String())
+	assert.Equal(t, "https://api.storekit-sandbox.itunes.apple.com/", NewClient(nil, EnvironmentSandbox).baseURL.String())
+}
+
+func TestErrorResponse(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newServer(http.StatusNotFound, `{"errorCode":4040010,"errorMessage":"Transaction id not found."}`)
+	defer server.Close()
+
+	_, resp, err := client.GetTransactionInfo(context.Background(), "10")
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	var erro *ErrorResponse
+
+	assert.True(t, errors.As(err, &erro))
+	assert.Equal(t, int64(4040010), erro.ErrorCode)
+	assert.Contains(t, err.Error(), "Transaction id not found.")
+}
+
+func TestErrorResponseWithoutBody(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newServer(http.StatusUnauthorized, "")
+	defer server.Close()
+
+	_, _, err := client.GetTransactionInfo(context.Background(), "10")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "401")
+}
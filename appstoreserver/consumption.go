@@ -0,0 +1,110 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package appstoreserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// ConsumptionStatus defines model for ConsumptionStatus.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/consumptionstatus
+type ConsumptionStatus int
+
+const (
+	// ConsumptionStatusUndeclared is a consumption status that is not provided.
+	ConsumptionStatusUndeclared ConsumptionStatus = 0
+	// ConsumptionStatusNotConsumed is an in-app purchase that is not consumed.
+	ConsumptionStatusNotConsumed ConsumptionStatus = 1
+	// ConsumptionStatusPartiallyConsumed is an in-app purchase that is partially consumed.
+	ConsumptionStatusPartiallyConsumed ConsumptionStatus = 2
+	// ConsumptionStatusFullyConsumed is an in-app purchase that is fully consumed.
+	ConsumptionStatusFullyConsumed ConsumptionStatus = 3
+)
+
+// DeliveryStatus defines model for DeliveryStatus.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/deliverystatus
+type DeliveryStatus int
+
+const (
+	// DeliveryStatusDelivered is an in-app purchase that was delivered and works properly.
+	DeliveryStatusDelivered DeliveryStatus = 0
+	// DeliveryStatusQualityIssue is an in-app purchase that wasn't delivered due to a quality issue.
+	DeliveryStatusQualityIssue DeliveryStatus = 1
+	// DeliveryStatusWrongItem is an in-app purchase where the app delivered the wrong item.
+	DeliveryStatusWrongItem DeliveryStatus = 2
+	// DeliveryStatusServerOutage is an in-app purchase that wasn't delivered due to a server outage.
+	DeliveryStatusServerOutage DeliveryStatus = 3
+	// DeliveryStatusCurrencyChange is an in-app purchase that wasn't delivered due to an in-game currency change.
+	DeliveryStatusCurrencyChange DeliveryStatus = 4
+	// DeliveryStatusOther is an in-app purchase that wasn't delivered for other reasons.
+	DeliveryStatusOther DeliveryStatus = 5
+)
+
+// RefundPreference defines model for RefundPreference.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/refundpreference
+type RefundPreference int
+
+const (
+	// RefundPreferenceUndeclared is a refund preference that is not provided.
+	RefundPreferenceUndeclared RefundPreference = 0
+	// RefundPreferencePreferGrant prefers that Apple grants the refund.
+	RefundPreferencePreferGrant RefundPreference = 1
+	// RefundPreferencePreferDecline prefers that Apple declines the refund.
+	RefundPreferencePreferDecline RefundPreference = 2
+	// RefundPreferenceNoPreference has no preference whether Apple grants or declines the refund.
+	RefundPreferenceNoPreference RefundPreference = 3
+)
+
+// ConsumptionRequest defines model for ConsumptionRequest.
+//
+// AccountTenure, LifetimeDollarsPurchased, LifetimeDollarsRefunded, Platform, PlayTime and UserStatus are the
+// bucketed values documented by Apple, with 0 meaning the value is undeclared.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/consumptionrequest
+type ConsumptionRequest struct {
+	AccountTenure            int               `json:"accountTenure"`
+	AppAccountToken          string            `json:"appAccountToken"`
+	ConsumptionStatus        ConsumptionStatus `json:"consumptionStatus"`
+	CustomerConsented        bool              `json:"customerConsented"`
+	DeliveryStatus           DeliveryStatus    `json:"deliveryStatus"`
+	LifetimeDollarsPurchased int               `json:"lifetimeDollarsPurchased"`
+	LifetimeDollarsRefunded  int               `json:"lifetimeDollarsRefunded"`
+	Platform                 int               `json:"platform"`
+	PlayTime                 int               `json:"playTime"`
+	RefundPreference         RefundPreference  `json:"refundPreference,omitempty"`
+	SampleContentProvided    bool              `json:"sampleContentProvided"`
+	UserStatus               int               `json:"userStatus"`
+}
+
+// SendConsumptionInformation sends consumption information about an in-app purchase to the App Store after a
+// customer requests a refund for it. Apple only uses the information if CustomerConsented is true.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/send_consumption_information
+func (c *Client) SendConsumptionInformation(ctx context.Context, transactionID string, req ConsumptionRequest) (*http.Response, error) {
+	url := fmt.Sprintf("inApps/v1/transactions/consumption/%s", transactionID)
+
+	return c.put(ctx, url, req, nil)
+}
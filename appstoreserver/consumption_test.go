@@ -0,0 +1,48 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package appstoreserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSendConsumptionInformation(t *testing.T) {
+	t.Parallel()
+
+	client, server, recorded := newServer(http.StatusAccepted, "")
+	defer server.Close()
+
+	resp, err := client.SendConsumptionInformation(context.Background(), "10", ConsumptionRequest{
+		ConsumptionStatus: ConsumptionStatusPartiallyConsumed,
+		CustomerConsented: true,
+		DeliveryStatus:    DeliveryStatusDelivered,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusAccepted, resp.StatusCode)
+	assert.Equal(t, http.MethodPut, recorded.Method)
+	assert.Equal(t, "/inApps/v1/transactions/consumption/10", recorded.Path)
+	assert.Contains(t, recorded.Body, `"consumptionStatus":2`)
+	assert.Contains(t, recorded.Body, `"customerConsented":true`)
+}
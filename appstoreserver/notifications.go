@@ -0,0 +1,337 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package appstoreserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// NotificationType defines model for NotificationType.
+//
+// https://developer.apple.com/documentation/appstoreservernotifications/notificationtype
+type NotificationType string
+
+const (
+	// NotificationTypeConsumptionRequest is sent when a customer requests a refund for a consumable.
+	NotificationTypeConsumptionRequest NotificationType = "CONSUMPTION_REQUEST"
+	// NotificationTypeDidChangeRenewalPref is sent when a customer changes their subscription plan.
+	NotificationTypeDidChangeRenewalPref NotificationType = "DID_CHANGE_RENEWAL_PREF"
+	// NotificationTypeDidChangeRenewalStatus is sent when a customer turns auto-renewal on or off.
+	NotificationTypeDidChangeRenewalStatus NotificationType = "DID_CHANGE_RENEWAL_STATUS"
+	// NotificationTypeDidFailToRenew is sent when a subscription fails to renew due to a billing issue.
+	NotificationTypeDidFailToRenew NotificationType = "DID_FAIL_TO_RENEW"
+	// NotificationTypeDidRenew is sent when a subscription successfully renews.
+	NotificationTypeDidRenew NotificationType = "DID_RENEW"
+	// NotificationTypeExpired is sent when a subscription expires.
+	NotificationTypeExpired NotificationType = "EXPIRED"
+	// NotificationTypeExternalPurchaseToken is sent for an external purchase token that has no reported transactions.
+	NotificationTypeExternalPurchaseToken NotificationType = "EXTERNAL_PURCHASE_TOKEN"
+	// NotificationTypeGracePeriodExpired is sent when the billing grace period of a subscription ends.
+	NotificationTypeGracePeriodExpired NotificationType = "GRACE_PERIOD_EXPIRED"
+	// NotificationTypeOfferRedeemed is sent when a customer redeems a promotional offer or offer code.
+	NotificationTypeOfferRedeemed NotificationType = "OFFER_REDEEMED"
+	// NotificationTypePriceIncrease is sent when the system informs a customer of a subscription price increase.
+	NotificationTypePriceIncrease NotificationType = "PRICE_INCREASE"
+	// NotificationTypeRefund is sent when the App Store refunds a transaction.
+	NotificationTypeRefund NotificationType = "REFUND"
+	// NotificationTypeRefundDeclined is sent when the App Store declines a refund request.
+	NotificationTypeRefundDeclined NotificationType = "REFUND_DECLINED"
+	// NotificationTypeRefundReversed is sent when the App Store reverses a previously granted refund.
+	NotificationTypeRefundReversed NotificationType = "REFUND_REVERSED"
+	// NotificationTypeRenewalExtended is sent when the App Store extends the renewal date of a subscription.
+	NotificationTypeRenewalExtended NotificationType = "RENEWAL_EXTENDED"
+	// NotificationTypeRenewalExtension is sent with the outcome of a request to extend the renewal date of many subscriptions.
+	NotificationTypeRenewalExtension NotificationType = "RENEWAL_EXTENSION"
+	// NotificationTypeRevoke is sent when a purchase shared through Family Sharing is no longer available.
+	NotificationTypeRevoke NotificationType = "REVOKE"
+	// NotificationTypeSubscribed is sent when a customer subscribes, or resubscribes.
+	NotificationTypeSubscribed NotificationType = "SUBSCRIBED"
+	// NotificationTypeTest is sent when a test notification is requested.
+	NotificationTypeTest NotificationType = "TEST"
+)
+
+// NotificationSubtype defines model for Subtype.
+//
+// https://developer.apple.com/documentation/appstoreservernotifications/subtype
+type NotificationSubtype string
+
+const (
+	// NotificationSubtypeAccepted is a price increase the customer accepted.
+	NotificationSubtypeAccepted NotificationSubtype = "ACCEPTED"
+	// NotificationSubtypeAutoRenewDisabled is a subscription whose auto-renewal was turned off.
+	NotificationSubtypeAutoRenewDisabled NotificationSubtype = "AUTO_RENEW_DISABLED"
+	// NotificationSubtypeAutoRenewEnabled is a subscription whose auto-renewal was turned on.
+	NotificationSubtypeAutoRenewEnabled NotificationSubtype = "AUTO_RENEW_ENABLED"
+	// NotificationSubtypeBillingRecovery is a subscription that renewed after previously failing to.
+	NotificationSubtypeBillingRecovery NotificationSubtype = "BILLING_RECOVERY"
+	// NotificationSubtypeBillingRetry is a subscription that expired after the billing retry period ended.
+	NotificationSubtypeBillingRetry NotificationSubtype = "BILLING_RETRY"
+	// NotificationSubtypeDowngrade is a subscription downgrade that takes effect at the next renewal.
+	NotificationSubtypeDowngrade NotificationSubtype = "DOWNGRADE"
+	// NotificationSubtypeFailure is a renewal date extension that failed for a subscription.
+	NotificationSubtypeFailure NotificationSubtype = "FAILURE"
+	// NotificationSubtypeGracePeriod is a subscription that failed to renew and entered its billing grace period.
+	NotificationSubtypeGracePeriod NotificationSubtype = "GRACE_PERIOD"
+	// NotificationSubtypeInitialBuy is a first purchase of a subscription.
+	NotificationSubtypeInitialBuy NotificationSubtype = "INITIAL_BUY"
+	// NotificationSubtypePending is a price increase the customer hasn't responded to yet.
+	NotificationSubtypePending NotificationSubtype = "PENDING"
+	// NotificationSubtypePriceIncrease is a subscription that expired because the customer didn't consent to a price increase.
+	NotificationSubtypePriceIncrease NotificationSubtype = "PRICE_INCREASE"
+	// NotificationSubtypeProductNotForSale is a subscription that expired because the product was not for sale at renewal.
+	NotificationSubtypeProductNotForSale NotificationSubtype = "PRODUCT_NOT_FOR_SALE"
+	// NotificationSubtypeResubscribe is a customer resubscribing to a subscription group.
+	NotificationSubtypeResubscribe NotificationSubtype = "RESUBSCRIBE"
+	// NotificationSubtypeSummary is the completion of a renewal date extension for many subscriptions.
+	NotificationSubtypeSummary NotificationSubtype = "SUMMARY"
+	// NotificationSubtypeUpgrade is a subscription upgrade that takes effect immediately.
+	NotificationSubtypeUpgrade NotificationSubtype = "UPGRADE"
+	// NotificationSubtypeUnreported is an external purchase token with no reported transactions.
+	NotificationSubtypeUnreported NotificationSubtype = "UNREPORTED"
+	// NotificationSubtypeVoluntary is a subscription that expired after the customer turned off auto-renewal.
+	NotificationSubtypeVoluntary NotificationSubtype = "VOLUNTARY"
+)
+
+// ResponseBodyV2 defines model for ResponseBodyV2, the body of the request the App Store sends to a notification URL.
+//
+// https://developer.apple.com/documentation/appstoreservernotifications/responsebodyv2
+type ResponseBodyV2 struct {
+	SignedPayload string `json:"signedPayload"`
+}
+
+// ResponseBodyV2DecodedPayload defines model for ResponseBodyV2DecodedPayload.
+//
+// https://developer.apple.com/documentation/appstoreservernotifications/responsebodyv2decodedpayload
+type ResponseBodyV2DecodedPayload struct {
+	Data             *NotificationData    `json:"data,omitempty"`
+	NotificationType NotificationType     `json:"notificationType"`
+	NotificationUUID string               `json:"notificationUUID"`
+	SignedDate       int64                `json:"signedDate"`
+	Subtype          NotificationSubtype  `json:"subtype,omitempty"`
+	Summary          *NotificationSummary `json:"summary,omitempty"`
+	Version          string               `json:"version"`
+}
+
+// NotificationData defines model for Data.
+//
+// SignedTransactionInfo and SignedRenewalInfo can be decoded with VerifyTransaction and VerifyRenewalInfo.
+//
+// https://developer.apple.com/documentation/appstoreservernotifications/data
+type NotificationData struct {
+	AppAppleID            int64              `json:"appAppleId,omitempty"`
+	BundleID              string             `json:"bundleId"`
+	BundleVersion         string             `json:"bundleVersion,omitempty"`
+	Environment           Environment        `json:"environment"`
+	SignedRenewalInfo     string             `json:"signedRenewalInfo,omitempty"`
+	SignedTransactionInfo string             `json:"signedTransactionInfo,omitempty"`
+	Status                SubscriptionStatus `json:"status,omitempty"`
+}
+
+// NotificationSummary defines model for Summary.
+//
+// https://developer.apple.com/documentation/appstoreservernotifications/summary
+type NotificationSummary struct {
+	AppAppleID             int64       `json:"appAppleId,omitempty"`
+	BundleID               string      `json:"bundleId"`
+	Environment            Environment `json:"environment"`
+	FailedCount            int64       `json:"failedCount"`
+	ProductID              string      `json:"productId"`
+	RequestIdentifier      string      `json:"requestIdentifier"`
+	StorefrontCountryCodes []string    `json:"storefrontCountryCodes,omitempty"`
+	SucceededCount         int64       `json:"succeededCount"`
+}
+
+// JWSTransactionDecodedPayload defines model for JWSTransactionDecodedPayload.
+//
+// Dates are in milliseconds since the Unix epoch, and Price is in milliunits of Currency.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/jwstransactiondecodedpayload
+type JWSTransactionDecodedPayload struct {
+	AppAccountToken             string      `json:"appAccountToken,omitempty"`
+	BundleID                    string      `json:"bundleId"`
+	Currency                    string      `json:"currency,omitempty"`
+	Environment                 Environment `json:"environment"`
+	ExpiresDate                 int64       `json:"expiresDate,omitempty"`
+	InAppOwnershipType          string      `json:"inAppOwnershipType"`
+	IsUpgraded                  bool        `json:"isUpgraded,omitempty"`
+	OfferDiscountType           string      `json:"offerDiscountType,omitempty"`
+	OfferIdentifier             string      `json:"offerIdentifier,omitempty"`
+	OfferType                   int         `json:"offerType,omitempty"`
+	OriginalPurchaseDate        int64       `json:"originalPurchaseDate"`
+	OriginalTransactionID       string      `json:"originalTransactionId"`
+	Price                       int64       `json:"price,omitempty"`
+	ProductID                   string      `json:"productId"`
+	PurchaseDate                int64       `json:"purchaseDate"`
+	Quantity                    int         `json:"quantity"`
+	RevocationDate              int64       `json:"revocationDate,omitempty"`
+	RevocationReason            *int        `json:"revocationReason,omitempty"`
+	SignedDate                  int64       `json:"signedDate"`
+	Storefront                  string      `json:"storefront"`
+	StorefrontID                string      `json:"storefrontId"`
+	SubscriptionGroupIdentifier string      `json:"subscriptionGroupIdentifier,omitempty"`
+	TransactionID               string      `json:"transactionId"`
+	TransactionReason           string      `json:"transactionReason,omitempty"`
+	Type                        string      `json:"type"`
+	WebOrderLineItemID          string      `json:"webOrderLineItemId,omitempty"`
+}
+
+// JWSRenewalInfoDecodedPayload defines model for JWSRenewalInfoDecodedPayload.
+//
+// Dates are in milliseconds since the Unix epoch.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/jwsrenewalinfodecodedpayload
+type JWSRenewalInfoDecodedPayload struct {
+	AutoRenewProductID          string      `json:"autoRenewProductId"`
+	AutoRenewStatus             int         `json:"autoRenewStatus"`
+	Environment                 Environment `json:"environment"`
+	ExpirationIntent            int         `json:"expirationIntent,omitempty"`
+	GracePeriodExpiresDate      int64       `json:"gracePeriodExpiresDate,omitempty"`
+	IsInBillingRetryPeriod      bool        `json:"isInBillingRetryPeriod,omitempty"`
+	OfferIdentifier             string      `json:"offerIdentifier,omitempty"`
+	OfferType                   int         `json:"offerType,omitempty"`
+	OriginalTransactionID       string      `json:"originalTransactionId"`
+	PriceIncreaseStatus         *int        `json:"priceIncreaseStatus,omitempty"`
+	ProductID                   string      `json:"productId"`
+	RecentSubscriptionStartDate int64       `json:"recentSubscriptionStartDate"`
+	RenewalDate                 int64       `json:"renewalDate,omitempty"`
+	SignedDate                  int64       `json:"signedDate"`
+}
+
+// SendAttemptResult defines model for SendAttemptResult.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/sendattemptresult
+type SendAttemptResult string
+
+const (
+	// SendAttemptResultSuccess is a notification the server responded to with a success status.
+	SendAttemptResultSuccess SendAttemptResult = "SUCCESS"
+	// SendAttemptResultTimedOut is a notification the server didn't respond to in time.
+	SendAttemptResultTimedOut SendAttemptResult = "TIMED_OUT"
+	// SendAttemptResultTLSIssue is a notification that failed because of the TLS configuration of the server.
+	SendAttemptResultTLSIssue SendAttemptResult = "TLS_ISSUE"
+	// SendAttemptResultCircularRedirect is a notification that failed because the server redirected in a loop.
+	SendAttemptResultCircularRedirect SendAttemptResult = "CIRCULAR_REDIRECT"
+	// SendAttemptResultNoResponse is a notification the server didn't respond to.
+	SendAttemptResultNoResponse SendAttemptResult = "NO_RESPONSE"
+	// SendAttemptResultSocketIssue is a notification that failed because of a network socket issue.
+	SendAttemptResultSocketIssue SendAttemptResult = "SOCKET_ISSUE"
+	// SendAttemptResultUnsupportedCharset is a notification the server responded to with an unsupported charset.
+	SendAttemptResultUnsupportedCharset SendAttemptResult = "UNSUPPORTED_CHARSET"
+	// SendAttemptResultInvalidResponse is a notification the server responded to with an invalid response.
+	SendAttemptResultInvalidResponse SendAttemptResult = "INVALID_RESPONSE"
+	// SendAttemptResultPrematureClose is a notification whose connection the server closed early.
+	SendAttemptResultPrematureClose SendAttemptResult = "PREMATURE_CLOSE"
+	// SendAttemptResultUnsuccessfulHTTPResponseCode is a notification the server responded to with a failure status.
+	SendAttemptResultUnsuccessfulHTTPResponseCode SendAttemptResult = "UNSUCCESSFUL_HTTP_RESPONSE_CODE"
+	// SendAttemptResultOther is a notification that failed for another reason.
+	SendAttemptResultOther SendAttemptResult = "OTHER"
+)
+
+// SendAttemptItem defines model for SendAttemptItem.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/sendattemptitem
+type SendAttemptItem struct {
+	AttemptDate       int64             `json:"attemptDate"`
+	SendAttemptResult SendAttemptResult `json:"sendAttemptResult"`
+}
+
+// SendTestNotificationResponse defines model for SendTestNotificationResponse.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/sendtestnotificationresponse
+type SendTestNotificationResponse struct {
+	TestNotificationToken string `json:"testNotificationToken"`
+}
+
+// CheckTestNotificationResponse defines model for CheckTestNotificationResponse.
+//
+// SignedPayload can be decoded with VerifyNotification.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/checktestnotificationresponse
+type CheckTestNotificationResponse struct {
+	SendAttempts  []SendAttemptItem `json:"sendAttempts,omitempty"`
+	SignedPayload string            `json:"signedPayload"`
+}
+
+// Delivered reports whether any attempt to send the test notification succeeded.
+func (r *CheckTestNotificationResponse) Delivered() bool {
+	for _, attempt := range r.SendAttempts {
+		if attempt.SendAttemptResult == SendAttemptResultSuccess {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RequestTestNotification asks the App Store to send a notification of type NotificationTypeTest to the
+// notification URL configured for the environment of the client.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/request_a_test_notification
+func (c *Client) RequestTestNotification(ctx context.Context) (*SendTestNotificationResponse, *http.Response, error) {
+	res := new(SendTestNotificationResponse)
+	resp, err := c.post(ctx, "inApps/v1/notifications/test", nil, res)
+
+	return res, resp, err
+}
+
+// GetTestNotificationStatus checks whether the test notification identified by token reached the server.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/get_test_notification_status
+func (c *Client) GetTestNotificationStatus(ctx context.Context, token string) (*CheckTestNotificationResponse, *http.Response, error) {
+	url := fmt.Sprintf("inApps/v1/notifications/test/%s", token)
+	res := new(CheckTestNotificationResponse)
+	resp, err := c.get(ctx, url, nil, res)
+
+	return res, resp, err
+}
+
+// SendTestNotification requests a test notification and checks its status every interval until the App Store
+// has attempted to send it or ctx is done, so that a health check can confirm the notification URL works end to
+// end. Use Delivered on the result to tell whether the server accepted the notification.
+func (c *Client) SendTestNotification(ctx context.Context, interval time.Duration) (*CheckTestNotificationResponse, error) {
+	sent, _, err := c.RequestTestNotification(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+
+		status, _, err := c.GetTestNotificationStatus(ctx, sent.TestNotificationToken)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(status.SendAttempts) > 0 {
+			return status, nil
+		}
+	}
+}
@@ -0,0 +1,169 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package appstoreserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVerifyNotification(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain(t, oidAppStoreReceiptSigning)
+	verifier, err := NewVerifier(chain.rootDER)
+	assert.NoError(t, err)
+
+	signedTransaction := chain.sign(t, JWSTransactionDecodedPayload{
+		BundleID:      "com.example.app",
+		ProductID:     "monthly",
+		TransactionID: "20",
+	})
+	signedRenewalInfo := chain.sign(t, JWSRenewalInfoDecodedPayload{
+		AutoRenewStatus:       1,
+		OriginalTransactionID: "10",
+	})
+
+	var body ResponseBodyV2
+
+	raw, err := json.Marshal(ResponseBodyV2{SignedPayload: chain.sign(t, ResponseBodyV2DecodedPayload{
+		NotificationType: NotificationTypeDidRenew,
+		Subtype:          NotificationSubtypeBillingRecovery,
+		Data: &NotificationData{
+			BundleID:              "com.example.app",
+			Environment:           EnvironmentSandbox,
+			SignedRenewalInfo:     signedRenewalInfo,
+			SignedTransactionInfo: signedTransaction,
+			Status:                SubscriptionStatusActive,
+		},
+	})})
+	assert.NoError(t, err)
+	assert.NoError(t, json.Unmarshal(raw, &body))
+
+	notification, err := verifier.VerifyNotification(body.SignedPayload)
+	assert.NoError(t, err)
+	assert.Equal(t, NotificationTypeDidRenew, notification.NotificationType)
+	assert.Equal(t, NotificationSubtypeBillingRecovery, notification.Subtype)
+	assert.Equal(t, EnvironmentSandbox, notification.Data.Environment)
+
+	transaction, err := verifier.VerifyTransaction(notification.Data.SignedTransactionInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "20", transaction.TransactionID)
+
+	renewal, err := verifier.VerifyRenewalInfo(notification.Data.SignedRenewalInfo)
+	assert.NoError(t, err)
+	assert.Equal(t, "10", renewal.OriginalTransactionID)
+}
+
+func TestRequestTestNotification(t *testing.T) {
+	t.Parallel()
+
+	client, server, recorded := newServer(http.StatusOK, `{"testNotificationToken":"TOKEN"}`)
+	defer server.Close()
+
+	res, _, err := client.RequestTestNotification(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "TOKEN", res.TestNotificationToken)
+	assert.Equal(t, http.MethodPost, recorded.Method)
+	assert.Equal(t, "/inApps/v1/notifications/test", recorded.Path)
+}
+
+func TestGetTestNotificationStatus(t *testing.T) {
+	t.Parallel()
+
+	client, server, recorded := newServer(http.StatusOK, `{"signedPayload":"PAYLOAD","sendAttempts":[{"attemptDate":1,"sendAttemptResult":"TIMED_OUT"}]}`)
+	defer server.Close()
+
+	res, _, err := client.GetTestNotificationStatus(context.Background(), "TOKEN")
+	assert.NoError(t, err)
+	assert.Equal(t, "/inApps/v1/notifications/test/TOKEN", recorded.Path)
+	assert.Equal(t, "PAYLOAD", res.SignedPayload)
+	assert.Equal(t, []SendAttemptItem{{AttemptDate: 1, SendAttemptResult: SendAttemptResultTimedOut}}, res.SendAttempts)
+	assert.False(t, res.Delivered())
+
+	res.SendAttempts = append(res.SendAttempts, SendAttemptItem{SendAttemptResult: SendAttemptResultSuccess})
+	assert.True(t, res.Delivered())
+}
+
+func TestSendTestNotification(t *testing.T) {
+	t.Parallel()
+
+	var checks int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			fmt.Fprintln(w, `{"testNotificationToken":"TOKEN"}`)
+
+			return
+		}
+
+		if atomic.AddInt32(&checks, 1) < 2 {
+			fmt.Fprintln(w, `{"signedPayload":"PAYLOAD"}`)
+
+			return
+		}
+
+		fmt.Fprintln(w, `{"signedPayload":"PAYLOAD","sendAttempts":[{"attemptDate":1,"sendAttemptResult":"SUCCESS"}]}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client(), EnvironmentSandbox)
+	client.baseURL, _ = url.Parse(server.URL + "/")
+
+	res, err := client.SendTestNotification(context.Background(), time.Millisecond)
+	assert.NoError(t, err)
+	assert.True(t, res.Delivered())
+	assert.Equal(t, int32(2), atomic.LoadInt32(&checks))
+}
+
+func TestSendTestNotificationTimeout(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newServer(http.StatusOK, `{"testNotificationToken":"TOKEN"}`)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	res, err := client.SendTestNotification(ctx, time.Millisecond)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, res)
+}
+
+func TestSendTestNotificationError(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newServer(http.StatusUnauthorized, "")
+	defer server.Close()
+
+	res, err := client.SendTestNotification(context.Background(), time.Millisecond)
+	assert.Error(t, err)
+	assert.Nil(t, res)
+}
@@ -0,0 +1,90 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package appstoreserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SubscriptionStatus defines model for Status.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/status
+type SubscriptionStatus int
+
+const (
+	// SubscriptionStatusActive is an active auto-renewable subscription.
+	SubscriptionStatusActive SubscriptionStatus = 1
+	// SubscriptionStatusExpired is an expired auto-renewable subscription.
+	SubscriptionStatusExpired SubscriptionStatus = 2
+	// SubscriptionStatusBillingRetry is an auto-renewable subscription the App Store is trying to renew.
+	SubscriptionStatusBillingRetry SubscriptionStatus = 3
+	// SubscriptionStatusBillingGracePeriod is an auto-renewable subscription in its billing grace period.
+	SubscriptionStatusBillingGracePeriod SubscriptionStatus = 4
+	// SubscriptionStatusRevoked is an auto-renewable subscription that the App Store revoked.
+	SubscriptionStatusRevoked SubscriptionStatus = 5
+)
+
+// StatusResponse defines model for StatusResponse.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/statusresponse
+type StatusResponse struct {
+	AppAppleID  int64                             `json:"appAppleId"`
+	BundleID    string                            `json:"bundleId"`
+	Data        []SubscriptionGroupIdentifierItem `json:"data"`
+	Environment Environment                       `json:"environment"`
+}
+
+// SubscriptionGroupIdentifierItem defines model for SubscriptionGroupIdentifierItem.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/subscriptiongroupidentifieritem
+type SubscriptionGroupIdentifierItem struct {
+	LastTransactions            []LastTransactionsItem `json:"lastTransactions"`
+	SubscriptionGroupIdentifier string                 `json:"subscriptionGroupIdentifier"`
+}
+
+// LastTransactionsItem defines model for LastTransactionsItem.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/lasttransactionsitem
+type LastTransactionsItem struct {
+	OriginalTransactionID string             `json:"originalTransactionId"`
+	SignedRenewalInfo     string             `json:"signedRenewalInfo"`
+	SignedTransactionInfo string             `json:"signedTransactionInfo"`
+	Status                SubscriptionStatus `json:"status"`
+}
+
+// subscriptionStatusesQuery are query options for GetAllSubscriptionStatuses
+type subscriptionStatusesQuery struct {
+	Status []SubscriptionStatus `url:"status,omitempty"`
+}
+
+// GetAllSubscriptionStatuses gets the statuses of a customer's auto-renewable subscriptions in the app, given any of
+// their transaction IDs. Pass statuses to only get subscriptions with those statuses.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/get_all_subscription_statuses
+func (c *Client) GetAllSubscriptionStatuses(ctx context.Context, transactionID string, statuses []SubscriptionStatus) (*StatusResponse, *http.Response, error) {
+	url := fmt.Sprintf("inApps/v1/subscriptions/%s", transactionID)
+	res := new(StatusResponse)
+	resp, err := c.get(ctx, url, &subscriptionStatusesQuery{Status: statuses}, res)
+
+	return res, resp, err
+}
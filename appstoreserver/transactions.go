@@ -0,0 +1,146 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package appstoreserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// TransactionHistoryQuery are query options for GetTransactionHistory
+//
+// StartDate and EndDate are in milliseconds since the Unix epoch.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/get_transaction_history
+type TransactionHistoryQuery struct {
+	EndDate                     int64    `url:"endDate,omitempty"`
+	InAppOwnershipType          string   `url:"inAppOwnershipType,omitempty"`
+	ProductID                   []string `url:"productId,omitempty"`
+	ProductType                 []string `url:"productType,omitempty"`
+	Revision                    string   `url:"revision,omitempty"`
+	Revoked                     *bool    `url:"revoked,omitempty"`
+	Sort                        string   `url:"sort,omitempty"`
+	StartDate                   int64    `url:"startDate,omitempty"`
+	SubscriptionGroupIdentifier []string `url:"subscriptionGroupIdentifier,omitempty"`
+}
+
+// HistoryResponse defines model for HistoryResponse.
+//
+// Each of SignedTransactions is a JWS-signed transaction, which can be decoded with Verifier.VerifyTransaction.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/historyresponse
+type HistoryResponse struct {
+	AppAppleID         int64       `json:"appAppleId"`
+	BundleID           string      `json:"bundleId"`
+	Environment        Environment `json:"environment"`
+	HasMore            bool        `json:"hasMore"`
+	Revision           string      `json:"revision"`
+	SignedTransactions []string    `json:"signedTransactions"`
+}
+
+// TransactionInfoResponse defines model for TransactionInfoResponse.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/transactioninforesponse
+type TransactionInfoResponse struct {
+	SignedTransactionInfo string `json:"signedTransactionInfo"`
+}
+
+// OrderLookupStatus defines model for OrderLookupStatus.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/orderlookupstatus
+type OrderLookupStatus int
+
+const (
+	// OrderLookupStatusValid is an order ID that contains at least one in-app purchase.
+	OrderLookupStatusValid OrderLookupStatus = 0
+	// OrderLookupStatusInvalid is an order ID that is not valid, or contains no in-app purchases.
+	OrderLookupStatusInvalid OrderLookupStatus = 1
+)
+
+// OrderLookupResponse defines model for OrderLookupResponse.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/orderlookupresponse
+type OrderLookupResponse struct {
+	SignedTransactions []string          `json:"signedTransactions"`
+	Status             OrderLookupStatus `json:"status"`
+}
+
+// RefundHistoryResponse defines model for RefundHistoryResponse.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/refundhistoryresponse
+type RefundHistoryResponse struct {
+	HasMore            bool     `json:"hasMore"`
+	Revision           string   `json:"revision"`
+	SignedTransactions []string `json:"signedTransactions"`
+}
+
+// GetTransactionHistory gets a customer's in-app purchase transaction history for the app, given any of their
+// transaction IDs. Pass the Revision of the previous response to get the next page.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/get_transaction_history
+func (c *Client) GetTransactionHistory(ctx context.Context, transactionID string, params *TransactionHistoryQuery) (*HistoryResponse, *http.Response, error) {
+	url := fmt.Sprintf("inApps/v2/history/%s", transactionID)
+	res := new(HistoryResponse)
+	resp, err := c.get(ctx, url, params, res)
+
+	return res, resp, err
+}
+
+// GetTransactionInfo gets information about a single transaction.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/get_transaction_info
+func (c *Client) GetTransactionInfo(ctx context.Context, transactionID string) (*TransactionInfoResponse, *http.Response, error) {
+	url := fmt.Sprintf("inApps/v1/transactions/%s", transactionID)
+	res := new(TransactionInfoResponse)
+	resp, err := c.get(ctx, url, nil, res)
+
+	return res, resp, err
+}
+
+// LookUpOrderID gets the in-app purchases of a customer's order, using the order ID from the receipt Apple emailed
+// them.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/look_up_order_id
+func (c *Client) LookUpOrderID(ctx context.Context, orderID string) (*OrderLookupResponse, *http.Response, error) {
+	url := fmt.Sprintf("inApps/v1/lookup/%s", orderID)
+	res := new(OrderLookupResponse)
+	resp, err := c.get(ctx, url, nil, res)
+
+	return res, resp, err
+}
+
+// refundHistoryQuery are query options for GetRefundHistory
+type refundHistoryQuery struct {
+	Revision string `url:"revision,omitempty"`
+}
+
+// GetRefundHistory gets the refunded in-app purchases of a customer for the app, given any of their transaction IDs.
+// Pass the Revision of the previous response to get the next page.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/get_refund_history
+func (c *Client) GetRefundHistory(ctx context.Context, transactionID string, revision string) (*RefundHistoryResponse, *http.Response, error) {
+	url := fmt.Sprintf("inApps/v2/refund/lookup/%s", transactionID)
+	res := new(RefundHistoryResponse)
+	resp, err := c.get(ctx, url, &refundHistoryQuery{Revision: revision}, res)
+
+	return res, resp, err
+}
@@ -0,0 +1,85 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package appstoreserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTransactionHistory(t *testing.T) {
+	t.Parallel()
+
+	client, server, recorded := newServer(http.StatusOK, `{"bundleId":"com.example.app","hasMore":true,"revision":"abc","signedTransactions":["a.b.c"]}`)
+	defer server.Close()
+
+	history, _, err := client.GetTransactionHistory(context.Background(), "10", &TransactionHistoryQuery{
+		ProductID: []string{"monthly", "yearly"},
+		Revision:  "xyz",
+		Sort:      "DESCENDING",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "/inApps/v2/history/10", recorded.Path)
+	assert.Equal(t, []string{"monthly", "yearly"}, recorded.Query["productId"])
+	assert.Equal(t, "xyz", recorded.Query.Get("revision"))
+	assert.True(t, history.HasMore)
+	assert.Equal(t, []string{"a.b.c"}, history.SignedTransactions)
+}
+
+func TestGetTransactionInfo(t *testing.T) {
+	t.Parallel()
+
+	client, server, recorded := newServer(http.StatusOK, `{"signedTransactionInfo":"a.b.c"}`)
+	defer server.Close()
+
+	info, _, err := client.GetTransactionInfo(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Equal(t, "/inApps/v1/transactions/10", recorded.Path)
+	assert.Equal(t, "a.b.c", info.SignedTransactionInfo)
+}
+
+func TestLookUpOrderID(t *testing.T) {
+	t.Parallel()
+
+	client, server, recorded := newServer(http.StatusOK, `{"status":1}`)
+	defer server.Close()
+
+	order, _, err := client.LookUpOrderID(context.Background(), "MTXYZ")
+	assert.NoError(t, err)
+	assert.Equal(t, "/inApps/v1/lookup/MTXYZ", recorded.Path)
+	assert.Equal(t, OrderLookupStatusInvalid, order.Status)
+}
+
+func TestGetRefundHistory(t *testing.T) {
+	t.Parallel()
+
+	client, server, recorded := newServer(http.StatusOK, `{"hasMore":false,"revision":"abc"}`)
+	defer server.Close()
+
+	refunds, _, err := client.GetRefundHistory(context.Background(), "10", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "/inApps/v2/refund/lookup/10", recorded.Path)
+	assert.Empty(t, recorded.Query)
+	assert.Equal(t, "abc", refunds.Revision)
+}
@@ -0,0 +1,240 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package appstoreserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+)
+
+// ErrInvalidJWS happens when a signed payload is not a well-formed ES256 JWS with a certificate chain.
+var ErrInvalidJWS = errors.New("invalid JWS")
+
+// ErrInvalidCertificateChain happens when the certificate chain of a signed payload is not issued by a trusted root
+// certificate, or is not an App Store signing chain.
+var ErrInvalidCertificateChain = errors.New("invalid certificate chain")
+
+// ErrInvalidSignature happens when the signature of a signed payload does not match its contents.
+var ErrInvalidSignature = errors.New("invalid signature")
+
+// ErrMissingRootCertificate happens when a Verifier is created without any root certificates.
+var ErrMissingRootCertificate = errors.New("no root certificates provided")
+
+var (
+	// oidAppStoreReceiptSigning marks the leaf certificate that signs App Store payloads.
+	oidAppStoreReceiptSigning = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 11, 1}
+	// oidAppleWWDRIntermediate marks the Apple Worldwide Developer Relations intermediate certificate.
+	oidAppleWWDRIntermediate = asn1.ObjectIdentifier{1, 2, 840, 113635, 100, 6, 2, 1}
+)
+
+// Verifier verifies and decodes the JWS-signed payloads of the App Store, such as App Store Server Notifications,
+// transactions, and renewal info.
+type Verifier struct {
+	roots *x509.CertPool
+
+	// CurrentTime returns the time certificate chains are checked at. It defaults to time.Now.
+	CurrentTime func() time.Time
+}
+
+// NewVerifier returns a new Verifier that trusts the given root certificates, in DER or PEM format. Pass the Apple Root
+// CA - G3 certificate, which can be downloaded from https://www.apple.com/certificateauthority/.
+func NewVerifier(rootCertificates ...[]byte) (*Verifier, error) {
+	if len(rootCertificates) == 0 {
+		return nil, ErrMissingRootCertificate
+	}
+
+	roots := x509.NewCertPool()
+
+	for _, data := range rootCertificates {
+		if block, _ := pem.Decode(data); block != nil {
+			data = block.Bytes
+		}
+
+		cert, err := x509.ParseCertificate(data)
+		if err != nil {
+			return nil, err
+		}
+
+		roots.AddCert(cert)
+	}
+
+	return &Verifier{
+		roots:       roots,
+		CurrentTime: time.Now,
+	}, nil
+}
+
+// VerifyNotification verifies and decodes the signedPayload of an App Store Server Notification.
+//
+// https://developer.apple.com/documentation/appstoreservernotifications/signedpayload
+func (v *Verifier) VerifyNotification(signedPayload string) (*ResponseBodyV2DecodedPayload, error) {
+	return verify[ResponseBodyV2DecodedPayload](v, signedPayload)
+}
+
+// VerifyTransaction verifies and decodes a JWS-signed transaction.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/jwstransaction
+func (v *Verifier) VerifyTransaction(signedTransaction string) (*JWSTransactionDecodedPayload, error) {
+	return verify[JWSTransactionDecodedPayload](v, signedTransaction)
+}
+
+// VerifyRenewalInfo verifies and decodes JWS-signed subscription renewal info.
+//
+// https://developer.apple.com/documentation/appstoreserverapi/jwsrenewalinfo
+func (v *Verifier) VerifyRenewalInfo(signedRenewalInfo string) (*JWSRenewalInfoDecodedPayload, error) {
+	return verify[JWSRenewalInfoDecodedPayload](v, signedRenewalInfo)
+}
+
+// verify verifies jws and decodes its payload into a new T. No payload is returned if verification fails.
+func verify[T any](v *Verifier, jws string) (*T, error) {
+	payload := new(T)
+	if err := v.Verify(jws, payload); err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+type jwsHeader struct {
+	Alg string   `json:"alg"`
+	X5C []string `json:"x5c"`
+}
+
+// Verify checks that a JWS is signed by a certificate chain issued by a trusted root, then decodes its payload into dst.
+func (v *Verifier) Verify(jws string, dst interface{}) error {
+	parts := strings.Split(jws, ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("%w: expected 3 parts, got %d", ErrInvalidJWS, len(parts))
+	}
+
+	var header jwsHeader
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return err
+	}
+
+	if header.Alg != "ES256" {
+		return fmt.Errorf("%w: unsupported algorithm %q", ErrInvalidJWS, header.Alg)
+	}
+
+	leaf, err := v.verifyChain(header.X5C)
+	if err != nil {
+		return err
+	}
+
+	key, ok := leaf.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return fmt.Errorf("%w: leaf certificate does not have an ECDSA key", ErrInvalidCertificateChain)
+	}
+
+	sig, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil || len(sig) != 64 {
+		return fmt.Errorf("%w: malformed signature", ErrInvalidSignature)
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+
+	if !ecdsa.Verify(key, digest[:], r, s) {
+		return ErrInvalidSignature
+	}
+
+	return decodeSegment(parts[1], dst)
+}
+
+func (v *Verifier) verifyChain(x5c []string) (*x509.Certificate, error) {
+	if len(x5c) != 3 {
+		return nil, fmt.Errorf("%w: expected 3 certificates, got %d", ErrInvalidCertificateChain, len(x5c))
+	}
+
+	certs := make([]*x509.Certificate, len(x5c))
+
+	for i, encoded := range x5c {
+		der, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCertificateChain, err)
+		}
+
+		certs[i], err = x509.ParseCertificate(der)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCertificateChain, err)
+		}
+	}
+
+	leaf, intermediate := certs[0], certs[1]
+
+	if !hasExtension(leaf, oidAppStoreReceiptSigning) || !hasExtension(intermediate, oidAppleWWDRIntermediate) {
+		return nil, fmt.Errorf("%w: not an App Store signing certificate", ErrInvalidCertificateChain)
+	}
+
+	intermediates := x509.NewCertPool()
+	intermediates.AddCert(intermediate)
+
+	now := time.Now
+	if v.CurrentTime != nil {
+		now = v.CurrentTime
+	}
+
+	_, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         v.roots,
+		Intermediates: intermediates,
+		CurrentTime:   now(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCertificateChain, err)
+	}
+
+	return leaf, nil
+}
+
+func hasExtension(cert *x509.Certificate, oid asn1.ObjectIdentifier) bool {
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oid) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func decodeSegment(segment string, dst interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segment, "="))
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidJWS, err)
+	}
+
+	if err := json.Unmarshal(data, dst); err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidJWS, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package appstoreserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// testChain is a certificate chain shaped like the one the App Store signs payloads with.
+type testChain struct {
+	rootDER []byte
+	x5c     []string
+	key     *ecdsa.PrivateKey
+}
+
+func newTestChain(t *testing.T, leafOID asn1.ObjectIdentifier) *testChain {
+	t.Helper()
+
+	newCert := func(serial int64, name string, isCA bool, oid asn1.ObjectIdentifier, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey, []byte) {
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		assert.NoError(t, err)
+
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(serial),
+			Subject:               pkix.Name{CommonName: name},
+			NotBefore:             time.Now().Add(-time.Hour),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  isCA,
+			BasicConstraintsValid: true,
+			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		}
+
+		if oid != nil {
+			template.ExtraExtensions = []pkix.Extension{{Id: oid, Value: []byte{0x05, 0x00}}}
+		}
+
+		if parent == nil {
+			parent, parentKey = template, key
+		}
+
+		der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+		assert.NoError(t, err)
+
+		cert, err := x509.ParseCertificate(der)
+		assert.NoError(t, err)
+
+		return cert, key, der
+	}
+
+	root, rootKey, rootDER := newCert(1, "Test Root", true, nil, nil, nil)
+	intermediate, intermediateKey, intermediateDER := newCert(2, "Test Intermediate", true, oidAppleWWDRIntermediate, root, rootKey)
+	_, leafKey, leafDER := newCert(3, "Test Leaf", false, leafOID, intermediate, intermediateKey)
+
+	return &testChain{
+		rootDER: rootDER,
+		x5c: []string{
+			base64.StdEncoding.EncodeToString(leafDER),
+			base64.StdEncoding.EncodeToString(intermediateDER),
+			base64.StdEncoding.EncodeToString(rootDER),
+		},
+		key: leafKey,
+	}
+}
+
+func (c *testChain) sign(t *testing.T, payload interface{}) string {
+	t.Helper()
+
+	header, err := json.Marshal(jwsHeader{Alg: "ES256", X5C: c.x5c})
+	assert.NoError(t, err)
+	body, err := json.Marshal(payload)
+	assert.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(body)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	r, s, err := ecdsa.Sign(rand.Reader, c.key, digest[:])
+	assert.NoError(t, err)
+
+	sig := make([]byte, 64)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:])
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(sig)
+}
+
+func TestNewVerifier(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain(t, oidAppStoreReceiptSigning)
+
+	_, err := NewVerifier()
+	assert.Equal(t, ErrMissingRootCertificate, err)
+
+	_, err = NewVerifier(chain.rootDER)
+	assert.NoError(t, err)
+
+	_, err = NewVerifier(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: chain.rootDER}))
+	assert.NoError(t, err)
+
+	_, err = NewVerifier([]byte("not a certificate"))
+	assert.Error(t, err)
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain(t, oidAppStoreReceiptSigning)
+	verifier, err := NewVerifier(chain.rootDER)
+	assert.NoError(t, err)
+
+	var got map[string]string
+
+	err = verifier.Verify(chain.sign(t, map[string]string{"hello": "world"}), &got)
+	assert.NoError(t, err)
+	assert.Equal(t, "world", got["hello"])
+}
+
+func TestVerifyMalformed(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain(t, oidAppStoreReceiptSigning)
+	verifier, err := NewVerifier(chain.rootDER)
+	assert.NoError(t, err)
+
+	var got map[string]string
+
+	assert.True(t, errors.Is(verifier.Verify("a.b", &got), ErrInvalidJWS))
+	assert.True(t, errors.Is(verifier.Verify("!!.b.c", &got), ErrInvalidJWS))
+}
+
+func TestVerifyTamperedPayload(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain(t, oidAppStoreReceiptSigning)
+	verifier, err := NewVerifier(chain.rootDER)
+	assert.NoError(t, err)
+
+	parts := strings.Split(chain.sign(t, map[string]string{"price": "100"}), ".")
+	parts[1] = base64.RawURLEncoding.EncodeToString([]byte(`{"price":"0"}`))
+
+	var got map[string]string
+
+	assert.True(t, errors.Is(verifier.Verify(strings.Join(parts, "."), &got), ErrInvalidSignature))
+}
+
+func TestVerifyUntrustedRoot(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain(t, oidAppStoreReceiptSigning)
+	other := newTestChain(t, oidAppStoreReceiptSigning)
+	verifier, err := NewVerifier(other.rootDER)
+	assert.NoError(t, err)
+
+	var got map[string]string
+
+	assert.True(t, errors.Is(verifier.Verify(chain.sign(t, map[string]string{}), &got), ErrInvalidCertificateChain))
+}
+
+func TestVerifyMissingSigningExtension(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain(t, nil)
+	verifier, err := NewVerifier(chain.rootDER)
+	assert.NoError(t, err)
+
+	var got map[string]string
+
+	assert.True(t, errors.Is(verifier.Verify(chain.sign(t, map[string]string{}), &got), ErrInvalidCertificateChain))
+}
+
+func TestVerifyExpiredChain(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain(t, oidAppStoreReceiptSigning)
+	verifier, err := NewVerifier(chain.rootDER)
+	assert.NoError(t, err)
+
+	verifier.CurrentTime = func() time.Time {
+		return time.Now().Add(24 * time.Hour)
+	}
+
+	var got map[string]string
+
+	assert.True(t, errors.Is(verifier.Verify(chain.sign(t, map[string]string{}), &got), ErrInvalidCertificateChain))
+}
+
+func TestVerifyTransaction(t *testing.T) {
+	t.Parallel()
+
+	chain := newTestChain(t, oidAppStoreReceiptSigning)
+	verifier, err := NewVerifier(chain.rootDER)
+	assert.NoError(t, err)
+
+	transaction, err := verifier.VerifyTransaction(chain.sign(t, map[string]string{"transactionId": "1"}))
+	assert.NoError(t, err)
+	assert.Equal(t, "1", transaction.TransactionID)
+
+	parts := strings.Split(chain.sign(t, map[string]string{"transactionId": "1"}), ".")
+	parts[1] = base64.RawURLEncoding.EncodeToString([]byte(`{"transactionId":"2"}`))
+
+	transaction, err = verifier.VerifyTransaction(strings.Join(parts, "."))
+	assert.True(t, errors.Is(err, ErrInvalidSignature))
+	assert.Nil(t, transaction, "no payload is returned when verification fails")
+
+	notification, err := verifier.VerifyNotification("a.b")
+	assert.True(t, errors.Is(err, ErrInvalidJWS))
+	assert.Nil(t, notification)
+
+	renewalInfo, err := verifier.VerifyRenewalInfo("a.b")
+	assert.True(t, errors.Is(err, ErrInvalidJWS))
+	assert.Nil(t, renewalInfo)
+}
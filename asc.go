@@ -33,6 +33,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
@@ -49,15 +50,17 @@ const (
 
 // Client is the root instance of the App Store Connect API.
 type Client struct {
-	client    *http.Client
-	baseURL   *url.URL
-	UserAgent string
-	httpDebug bool
+	client      *http.Client
+	baseURL     *url.URL
+	UserAgent   string
+	httpDebug   bool
+	strictEnums bool
 
 	common service
 
 	Apps         *AppsService
 	Builds       *BuildsService
+	GameCenter   *GameCenterService
 	Pricing      *PricingService
 	Provisioning *ProvisioningService
 	Publishing   *PublishingService
@@ -65,6 +68,7 @@ type Client struct {
 	Submission   *SubmissionService
 	TestFlight   *TestflightService
 	Users        *UsersService
+	XcodeCloud   *XcodeCloudService
 }
 
 // NewClient creates a new Client instance.
@@ -89,6 +93,7 @@ func NewClient(httpClient *http.Client) *Client {
 
 	c.Apps = (*AppsService)(&c.common)
 	c.Builds = (*BuildsService)(&c.common)
+	c.GameCenter = (*GameCenterService)(&c.common)
 	c.Pricing = (*PricingService)(&c.common)
 	c.Provisioning = (*ProvisioningService)(&c.common)
 	c.Publishing = (*PublishingService)(&c.common)
@@ -96,6 +101,7 @@ func NewClient(httpClient *http.Client) *Client {
 	c.Submission = (*SubmissionService)(&c.common)
 	c.TestFlight = (*TestflightService)(&c.common)
 	c.Users = (*UsersService)(&c.common)
+	c.XcodeCloud = (*XcodeCloudService)(&c.common)
 
 	return c
 }
@@ -105,12 +111,49 @@ func (c *Client) SetHTTPDebug(flag bool) {
 	c.httpDebug = flag
 }
 
+// SetBaseURL points the client at a server other than App Store Connect, such as the fake in package asctest.
+// Endpoint paths are resolved relative to baseURL, so a trailing slash is added if it is missing.
+func (c *Client) SetBaseURL(baseURL string) error {
+	u, err := url.Parse(baseURL)
+	if err != nil {
+		return err
+	}
+
+	if !strings.HasSuffix(u.Path, "/") {
+		u.Path += "/"
+	}
+
+	c.baseURL = u
+
+	return nil
+}
+
+// SetStrictEnums turns strict validation of enum values on or off. When on, requests whose body contains an
+// enum value this package doesn't know about are not sent, and responses containing one fail to decode, with
+// ErrUnknownEnumValue. It is off by default so that values Apple adds later don't break existing code.
+func (c *Client) SetStrictEnums(flag bool) {
+	c.strictEnums = flag
+}
+
 // Response is a App Store Connect API response. This wraps the standard http.Response
 // returned from Apple and provides convenient access to things like rate limit.
 type Response struct {
 	*http.Response
 
 	Rate Rate
+
+	// Meta is the paging information of a list response, or nil for responses that aren't paged.
+	Meta *PagingInformation
+}
+
+// TotalCount returns the total number of resources across all pages of a list response, or zero when the
+// response isn't paged.
+func (r *Response) TotalCount() int {
+	if r == nil {
+		return 0
+	}
+
+	return r.Meta.TotalCount()
 }
 
 // Rate represents the rate limit for the current client.
@@ -211,6 +254,12 @@ func withContentType(typ string) requestOption {
 	}
 }
 
+func withHeader(name string, value string) requestOption {
+	return func(req *http.Request) {
+		req.Header.Set(name, value)
+	}
+}
+
 // AddOptions adds the parameters in opt as URL query parameters to s.  opt
 // must be a struct whose fields may contain "url" tags.
 func appendingQueryOptions(s string, opt interface{}) (string, error) {
@@ -257,6 +306,90 @@ func (c *Client) get(ctx context.Context, url string, query interface{}, v inter
 	return resp, err
 }
 
+// Get sends a GET request for path, such as "apps/1", with the URL query parameters of query, and decodes the
+// response into a new T. The endpoints of the services are built on it, and it can also call endpoints this
+// package doesn't model yet. As with the endpoints, the response is returned even if the request fails.
+func Get[T any](ctx context.Context, c *Client, path string, query interface{}) (*T, *Response, error) {
+	res := new(T)
+	resp, err := c.get(ctx, path, query, res)
+
+	return res, resp, err
+}
+
+// listResponse is the shape shared by the responses of list endpoints, with the resources decoded as T.
+type listResponse[T any] struct {
+	Data  []T                `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// List sends GET requests for the list endpoint at path, such as "apps", and pages through it with
+// ForEachPage, returning the resources of every page decoded as T. query sets the URL query parameters, such
+// as filters and the page size, of every page. The returned Response is that of the last page read.
+func List[T any](ctx context.Context, c *Client, path string, query interface{}) ([]T, *Response, error) {
+	items := make([]T, 0)
+
+	var resp *Response
+
+	err := forEachListPage(path, query, func(url string) (*PagedDocumentLinks, error) {
+		page := new(listResponse[T])
+
+		var err error
+		if resp, err = c.get(ctx, url, nil, page); err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Data...)
+
+		return &page.Links, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return items, resp, nil
+}
+
+// forEachListPage calls fetch with the URL of each page of the list endpoint at path, with the URL query
+// parameters of query, until the last page.
+func forEachListPage(path string, query interface{}, fetch func(url string) (*PagedDocumentLinks, error)) error {
+	first := path
+
+	if query != nil {
+		var err error
+		if first, err = appendingQueryOptions(path, query); err != nil {
+			return err
+		}
+	}
+
+	return ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		url, err := withCursor(first, cursor)
+		if err != nil {
+			return nil, err
+		}
+
+		return fetch(url)
+	})
+}
+
+// withCursor returns path with its cursor query parameter set to cursor, or path itself for an empty cursor.
+func withCursor(path string, cursor string) (string, error) {
+	if cursor == "" {
+		return path, nil
+	}
+
+	u, err := url.Parse(path)
+	if err != nil {
+		return "", err
+	}
+
+	values := u.Query()
+	values.Set("cursor", cursor)
+	u.RawQuery = values.Encode()
+
+	return u.String(), nil
+}
+
 // post sends a POST request to the API as configured.
 func (c *Client) post(ctx context.Context, url string, body *requestBody, v interface{}) (*Response, error) {
 	req, err := c.newRequest(ctx, "POST", url, body, withContentType("application/json"))
@@ -312,6 +445,12 @@ func (c *Client) newRequest(ctx context.Context, method string, path string, bod
 
 	buf := new(bytes.Buffer)
 
+	if body != nil && c.strictEnums {
+		if err := validateEnums(body); err != nil {
+			return nil, err
+		}
+	}
+
 	if body != nil {
 		err := json.NewEncoder(buf).Encode(body)
 		if err != nil {
@@ -372,6 +511,10 @@ func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Res
 	}
 
 	err := backoff.RetryNotify(op, backoff.NewExponentialBackOff(), notify)
+	if err != nil {
+		// The request failed before a response was received, such as when ctx was canceled.
+		return nil, err
+	}
 
 	resp := <-respCh
 
@@ -379,25 +522,59 @@ func (c *Client) do(ctx context.Context, req *http.Request, v interface{}) (*Res
 
 	response := newResponse(resp)
 
-	if err != nil {
-		return response, err
-	}
-
 	if err := checkResponse(response); err != nil {
 		return response, err
 	}
 
 	if v != nil {
 		if w, ok := v.(io.Writer); ok {
-			_, err = io.Copy(w, resp.Body)
+			_, err = io.Copy(w, newProgressTracker(ctx, resp.ContentLength, 0).reader(resp.Body, 0))
 		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
+			if d, ok := v.(streamDecoder); ok {
+				err = d.decodeStream(resp.Body, c.strictEnums)
+			} else {
+				err = decodeJSON(resp.Body, v)
+			}
+
+			if err == nil && c.strictEnums {
+				err = validateEnums(v)
+			}
+
+			response.Meta = pagingInformationOf(v)
 		}
 	}
 
 	return response, err
 }
 
+// maxPooledDecodeBuffer is the largest buffer capacity returned to decodeBuffers.
+const maxPooledDecodeBuffer = 4 << 20
+
+// decodeBuffers holds the buffers response bodies are read into, so that decoding large list responses page
+// after page doesn't allocate and grow a new buffer each time.
+var decodeBuffers = sync.Pool{
+	New: func() interface{} { return new(bytes.Buffer) },
+}
+
+// decodeJSON decodes the JSON document read from r into v.
+func decodeJSON(r io.Reader, v interface{}) error {
+	buf, _ := decodeBuffers.Get().(*bytes.Buffer)
+	buf.Reset()
+
+	defer func() {
+		// Don't hold on to the buffer of an unusually large body, such as a full report.
+		if buf.Cap() <= maxPooledDecodeBuffer {
+			decodeBuffers.Put(buf)
+		}
+	}()
+
+	if _, err := buf.ReadFrom(r); err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buf.Bytes(), v)
+}
+
 func newResponse(r *http.Response) *Response {
 	response := &Response{Response: r}
 	response.Rate = parseRate(r)
@@ -413,7 +590,7 @@ func checkResponse(r *Response) error {
 	data, err := io.ReadAll(r.Body)
 	erro := new(ErrorResponse)
 
-	if err == nil && data != nil {
+	if err == nil && len(data) > 0 {
 		err := json.Unmarshal(data, erro)
 		if err != nil {
 			return err
@@ -21,6 +21,7 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 package asc
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -29,6 +30,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -58,6 +60,64 @@ func TestSetHTTPDebug(t *testing.T) {
 	assert.False(t, client.httpDebug)
 }
 
+func TestSetBaseURL(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(nil)
+
+	assert.NoError(t, client.SetBaseURL("http://localhost:8080/v1"))
+	assert.Equal(t, "http://localhost:8080/v1/", client.baseURL.String())
+
+	assert.NoError(t, client.SetBaseURL("http://localhost:8080/v1/"))
+	assert.Equal(t, "http://localhost:8080/v1/", client.baseURL.String())
+
+	assert.Error(t, client.SetBaseURL(":"))
+}
+
+func TestSetStrictEnums(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":{"attributes":{"roles":["ADMINISTRATOR"]}}}`, http.StatusOK, false)
+	defer server.Close()
+
+	var lenient UserResponse
+	_, err := client.get(context.Background(), "test", nil, &lenient)
+	assert.NoError(t, err)
+	assert.Equal(t, []UserRole{"ADMINISTRATOR"}, lenient.Data.Attributes.Roles)
+
+	client.SetStrictEnums(true)
+	assert.True(t, client.strictEnums)
+
+	var strict UserResponse
+	_, err = client.get(context.Background(), "test", nil, &strict)
+	assert.Equal(t, ErrUnknownEnumValue{Type: "UserRole", Value: "ADMINISTRATOR"}, err)
+
+	_, err = client.post(context.Background(), "test", newRequestBody(UserAttributes{Roles: []UserRole{"ADMINISTRATOR"}}), nil)
+	assert.Equal(t, ErrUnknownEnumValue{Type: "UserRole", Value: "ADMINISTRATOR"}, err)
+}
+
+func TestResponseTotalCount(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":[],"links":{"self":""},"meta":{"paging":{"total":1234,"limit":200}}}`, http.StatusOK, false)
+	defer server.Close()
+
+	resp, err := client.get(context.Background(), "test", nil, new(AppsResponse))
+	assert.NoError(t, err)
+	assert.Equal(t, 1234, resp.TotalCount())
+	assert.Equal(t, 200, resp.Meta.Paging.Limit)
+
+	client, server = newServer(`{"data":{"id":"1"},"links":{"self":""}}`, http.StatusOK, false)
+	defer server.Close()
+
+	resp, err = client.get(context.Background(), "test", nil, new(AppResponse))
+	assert.NoError(t, err)
+	assert.Nil(t, resp.Meta)
+	assert.Equal(t, 0, resp.TotalCount())
+
+	assert.Equal(t, 0, (*Response)(nil).TotalCount())
+}
+
 type mockPayload struct {
 	Value string `json:"value"`
 }
@@ -82,6 +142,21 @@ func TestNilContextReturnsError(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestCanceledContextReturnsError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer("", http.StatusOK, false)
+
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	resp, err := client.get(ctx, "test", nil, nil)
+	assert.Error(t, err)
+	assert.Nil(t, resp)
+}
+
 func TestGet(t *testing.T) {
 	t.Parallel()
 
@@ -146,6 +221,79 @@ func TestGetError(t *testing.T) {
 	assert.Equal(t, mockPayload{"TEST"}, unmarshaled)
 }
 
+func TestGenericGet(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(marshaledMockPayload, http.StatusOK, true)
+	defer server.Close()
+
+	got, resp, err := Get[mockPayload](context.Background(), client, "test", &mockParams{Field: "TEST"})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, &mockPayload{"TEST"}, got)
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	var queries []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprintf(w, `{"data":[{"value":"1"},{"value":"2"}],"links":{"self":"%[1]s/test","next":"%[1]s/test?cursor=NEXT"}}`, "https://api.example.com")
+
+			return
+		}
+
+		fmt.Fprintln(w, `{"data":[{"value":"3"}],"links":{"self":"https://api.example.com/test?cursor=NEXT"}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	got, resp, err := List[mockPayload](context.Background(), client, "test", &mockParams{Field: "TEST"})
+
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, []mockPayload{{"1"}, {"2"}, {"3"}}, got)
+	assert.Equal(t, []string{"field=TEST", "cursor=NEXT&field=TEST"}, queries)
+}
+
+func TestListError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"status":"500"}]}`, http.StatusInternalServerError, false)
+	defer server.Close()
+
+	got, resp, err := List[mockPayload](context.Background(), client, "test", nil)
+
+	assert.Error(t, err)
+	assert.NotNil(t, resp)
+	assert.Nil(t, got)
+
+	_, _, err = List[mockPayload](context.Background(), client, "test", []string{"horses"})
+	assert.Error(t, err)
+}
+
+func TestWithCursor(t *testing.T) {
+	t.Parallel()
+
+	got, err := withCursor("apps?limit=200", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "apps?limit=200", got)
+
+	got, err = withCursor("apps?cursor=OLD&limit=200", "NEW")
+	assert.NoError(t, err)
+	assert.Equal(t, "apps?cursor=NEW&limit=200", got)
+
+	_, err = withCursor(":", "NEW")
+	assert.Error(t, err)
+}
+
 func TestPost(t *testing.T) {
 	t.Parallel()
 
@@ -195,7 +343,7 @@ func TestDelete(t *testing.T) {
 func TestCheckGoodResponse(t *testing.T) {
 	t.Parallel()
 
-	resp := &Response{&http.Response{StatusCode: 200}, Rate{}}
+	resp := &Response{Response: &http.Response{StatusCode: 200}}
 	err := checkResponse(resp)
 	assert.NoError(t, err)
 }
@@ -287,6 +435,34 @@ func newServer(raw string, status int, addRateLimit bool) (*Client, *httptest.Se
 	return client, server
 }
 
+func newRoutedServer(routes map[string]string) (*Client, *httptest.Server, *[]string) {
+	var (
+		mu       sync.Mutex
+		requests []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		body, ok := routes[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"errors":[{"status":"404"}]}`)
+
+			return
+		}
+
+		fmt.Fprintln(w, body)
+	}))
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client, server, &requests
+}
+
 func testEndpointWithResponse(t *testing.T, marshalledGot string, want interface{}, endpoint func(ctx context.Context, client *Client) (interface{}, *Response, error)) {
 	t.Helper()
 
@@ -332,3 +508,90 @@ func testEndpointCustomBehavior(marshalledGot string, behavior func(ctx context.
 	defer server.Close()
 	behavior(context.Background(), client)
 }
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errors.New("read failed")
+}
+
+func TestDecodeJSON(t *testing.T) {
+	t.Parallel()
+
+	var got mockPayload
+	assert.NoError(t, decodeJSON(strings.NewReader(marshaledMockPayload+"\n"), &got))
+	assert.Equal(t, mockPayload{"TEST"}, got)
+
+	assert.Error(t, decodeJSON(strings.NewReader(`{"value":`), &got))
+	assert.Error(t, decodeJSON(errReader{}, &got))
+
+	large := `{"value":"` + strings.Repeat("A", maxPooledDecodeBuffer) + `"}`
+	assert.NoError(t, decodeJSON(strings.NewReader(large), &got))
+	assert.Len(t, got.Value, maxPooledDecodeBuffer)
+}
+
+func benchmarkListBody(n int, item func(i int) string) []byte {
+	items := make([]string, n)
+	for i := range items {
+		items[i] = item(i)
+	}
+
+	return []byte(`{"data":[` + strings.Join(items, ",") + `],"links":{` +
+		`"self":"https://api.appstoreconnect.apple.com/v1/test?limit=200",` +
+		`"next":"https://api.appstoreconnect.apple.com/v1/test?cursor=NEXT&limit=200"},` +
+		`"meta":{"paging":{"total":10000,"limit":200}}}`)
+}
+
+func benchmarkDecode(b *testing.B, body []byte, v func() interface{}) {
+	b.Helper()
+	b.ReportAllocs()
+	b.SetBytes(int64(len(body)))
+
+	for i := 0; i < b.N; i++ {
+		if err := decodeJSON(bytes.NewReader(body), v()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeDevices(b *testing.B) {
+	body := benchmarkListBody(MaxPageLimit, func(i int) string {
+		return fmt.Sprintf(`{"type":"devices","id":"%d","attributes":{"addedDate":"2020-06-01T12:00:00.000+0000",`+
+			`"deviceClass":"IPHONE","model":"iPhone 11","name":"Device %d","platform":"IOS","status":"ENABLED",`+
+			`"udid":"00008030-%012d"},"links":{"self":"https://api.appstoreconnect.apple.com/v1/devices/%d"}}`, i, i, i, i)
+	})
+
+	benchmarkDecode(b, body, func() interface{} { return new(DevicesResponse) })
+}
+
+func BenchmarkDecodePricePoints(b *testing.B) {
+	body := benchmarkListBody(MaxPageLimit, func(i int) string {
+		return fmt.Sprintf(`{"type":"appPricePoints","id":"%d","attributes":{"customerPrice":"%d.99","proceeds":"%d.70"},`+
+			`"relationships":{"territory":{"data":{"type":"territories","id":"USA"}}},`+
+			`"links":{"self":"https://api.appstoreconnect.apple.com/v1/appPricePoints/%d"}}`, i, i, i, i)
+	})
+
+	benchmarkDecode(b, body, func() interface{} { return new(AppPricePointsResponse) })
+}
+
+func BenchmarkDecodeCustomerReviews(b *testing.B) {
+	body := benchmarkListBody(MaxPageLimit, func(i int) string {
+		return fmt.Sprintf(`{"type":"customerReviews","id":"%d","attributes":{"body":"Great app, would use again.",`+
+			`"createdDate":"2020-06-01T12:00:00-07:00","rating":5,"reviewerNickname":"reviewer%d","title":"Review %d",`+
+			`"territory":"USA"},"links":{"self":"https://api.appstoreconnect.apple.com/v1/customerReviews/%d"}}`, i, i, i, i)
+	})
+
+	benchmarkDecode(b, body, func() interface{} { return new(CustomerReviewsResponse) })
+}
+
+func BenchmarkDecodeIncluded(b *testing.B) {
+	items := make([]string, MaxPageLimit)
+	for i := range items {
+		items[i] = fmt.Sprintf(`{"type":"territories","id":"T%d","attributes":{"currency":"USD"},`+
+			`"links":{"self":"https://api.appstoreconnect.apple.com/v1/territories/T%d"}}`, i, i)
+	}
+
+	body := []byte(`{"data":[],"included":[` + strings.Join(items, ",") + `],"links":{"self":""}}`)
+
+	benchmarkDecode(b, body, func() interface{} { return new(AppPricesResponse) })
+}
@@ -0,0 +1,89 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package ascmock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/lingjiawen/asc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func registerDevice(ctx context.Context, provisioning asc.ProvisioningAPI, name string, udid string) (string, error) {
+	res, _, err := provisioning.CreateDevice(ctx, name, udid, asc.BundleIDPlatformiOS)
+	if err != nil {
+		return "", err
+	}
+
+	return res.Data.ID, nil
+}
+
+func TestMock(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	provisioning := new(ProvisioningAPI)
+	provisioning.On("CreateDevice", ctx, "iPhone", "UDID", asc.BundleIDPlatformiOS).
+		Return(&asc.DeviceResponse{Data: asc.Device{ID: "10"}}, nil, nil).
+		Once()
+
+	id, err := registerDevice(ctx, provisioning, "iPhone", "UDID")
+	assert.NoError(t, err)
+	assert.Equal(t, "10", id)
+	provisioning.AssertExpectations(t)
+	provisioning.AssertNumberOfCalls(t, "CreateDevice", 1)
+}
+
+func TestMockError(t *testing.T) {
+	t.Parallel()
+
+	errDuplicate := errors.New("duplicate device")
+	provisioning := new(ProvisioningAPI)
+	provisioning.On("CreateDevice", mock.Anything, "iPhone", "UDID", asc.BundleIDPlatformiOS).Return(nil, nil, errDuplicate)
+
+	_, err := registerDevice(context.Background(), provisioning, "iPhone", "UDID")
+	assert.Equal(t, errDuplicate, err)
+	provisioning.AssertExpectations(t)
+}
+
+func TestMockUnexpectedCall(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() {
+		_, _ = registerDevice(context.Background(), new(ProvisioningAPI), "iPhone", "UDID")
+	})
+}
+
+func TestMockVariadic(t *testing.T) {
+	t.Parallel()
+
+	reporting := new(ReportingAPI)
+	reporting.On("DownloadDiagnosticLogsForBuild", mock.Anything, "10", []asc.DiagnosticType{asc.DiagnosticTypeHangs, asc.DiagnosticTypeDiskWrites}).
+		Return([]asc.DiagnosticSignatureLogs{}, nil)
+
+	logs, err := reporting.DownloadDiagnosticLogsForBuild(context.Background(), "10", asc.DiagnosticTypeHangs, asc.DiagnosticTypeDiskWrites)
+	assert.NoError(t, err)
+	assert.Empty(t, logs)
+	reporting.AssertExpectations(t)
+}
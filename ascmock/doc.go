@@ -0,0 +1,31 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package ascmock provides mocks of the service interfaces of package asc, so that code depending on them can be
+// unit tested without network access.
+//
+// Each mock embeds testify's mock.Mock, so calls are recorded: set the calls a test expects and their results with
+// On and Return, and check them with AssertExpectations. A call that wasn't set with On panics, which fails the
+// test that made the unexpected call.
+//
+// The interfaces and mocks are generated from the services of package asc by gen.go.
+package ascmock
+
+//go:generate go run gen.go
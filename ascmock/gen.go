@@ -0,0 +1,398 @@
+//go:build ignore
+// +build ignore
+
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// This program generates the service interfaces of package asc and the mocks of this package from the methods
+// of the services. Run it with go generate after adding or changing an endpoint.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// services maps the service types of package asc to the names of their interfaces.
+var services = []struct {
+	Service   string
+	Interface string
+}{
+	{"AppsService", "AppsAPI"},
+	{"BuildsService", "BuildsAPI"},
+	{"GameCenterService", "GameCenterAPI"},
+	{"PricingService", "PricingAPI"},
+	{"ProvisioningService", "ProvisioningAPI"},
+	{"PublishingService", "PublishingAPI"},
+	{"ReportingService", "ReportingAPI"},
+	{"SubmissionService", "SubmissionAPI"},
+	{"TestflightService", "TestFlightAPI"},
+	{"UsersService", "UsersAPI"},
+	{"XcodeCloudService", "XcodeCloudAPI"},
+}
+
+type method struct {
+	Name    string
+	Type    *ast.FuncType
+	Imports map[string]string
+}
+
+func main() {
+	header, err := ioutil.ReadFile("../asc.go")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	license := string(header[:bytes.Index(header, []byte("*/"))+2])
+
+	fset := token.NewFileSet()
+
+	pkgs, err := parser.ParseDir(fset, "..", func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != "interfaces.go"
+	}, parser.ParseComments)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	methods := make(map[string][]method)
+
+	for _, file := range pkgs["asc"].Files {
+		imports := make(map[string]string)
+
+		for _, spec := range file.Imports {
+			path, _ := strconv.Unquote(spec.Path.Value)
+			name := path[strings.LastIndex(path, "/")+1:]
+
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+
+			imports[name] = path
+		}
+
+		for _, decl := range file.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+				continue
+			}
+
+			star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+
+			recv := star.X.(*ast.Ident).Name
+			methods[recv] = append(methods[recv], method{Name: fn.Name.Name, Type: fn.Type, Imports: imports})
+		}
+	}
+
+	for _, list := range methods {
+		sort.Slice(list, func(i, j int) bool {
+			return list[i].Name < list[j].Name
+		})
+	}
+
+	write("../interfaces.go", license, interfaces(fset, methods))
+	write("ascmock.go", license, mocks(fset, methods))
+}
+
+func interfaces(fset *token.FileSet, methods map[string][]method) string {
+	var b strings.Builder
+
+	imports := make(map[string]bool)
+
+	for _, service := range services {
+		fmt.Fprintf(&b, "\n// %s is the interface implemented by %s, so that code using it can be tested with a mock.\n", service.Interface, service.Service)
+		fmt.Fprintf(&b, "type %s interface {\n", service.Interface)
+
+		for _, m := range methods[service.Service] {
+			collectImports(m, imports)
+			fmt.Fprintf(&b, "\t%s(%s)%s\n", m.Name, fields(fset, m.Type.Params, true), results(fset, m.Type))
+		}
+
+		b.WriteString("}\n")
+	}
+
+	b.WriteString("\nvar (\n")
+
+	for _, service := range services {
+		fmt.Fprintf(&b, "\t_ %s = (*%s)(nil)\n", service.Interface, service.Service)
+	}
+
+	b.WriteString(")\n")
+
+	return "package asc\n" + importBlock(imports) + b.String()
+}
+
+func mocks(fset *token.FileSet, methods map[string][]method) string {
+	var b strings.Builder
+
+	imports := map[string]bool{"github.com/lingjiawen/asc": true, "github.com/stretchr/testify/mock": true}
+
+	b.WriteString("\n// result returns the result of a mocked call at index i as a T, or the zero value of T if it is nil.\n")
+	b.WriteString("func result[T any](args mock.Arguments, i int) T {\n\tv, _ := args.Get(i).(T)\n\n\treturn v\n}\n")
+
+	for _, service := range services {
+		list := methods[service.Service]
+
+		fmt.Fprintf(&b, "\n// %s is a mock of asc.%s. Set the calls it expects with On, and check that they were made with\n", service.Interface, service.Interface)
+		b.WriteString("// AssertExpectations. An unexpected call fails the test that made it.\n")
+		fmt.Fprintf(&b, "type %s struct {\n\tmock.Mock\n}\n", service.Interface)
+
+		for _, m := range list {
+			collectImports(m, imports)
+
+			typ := qualify(m.Type)
+			params, args := signature(fset, typ)
+			// A variadic parameter is recorded as a single slice argument.
+			args = strings.ReplaceAll(args, "...", "")
+
+			fmt.Fprintf(&b, "\n// %s records the call and returns the results set for it with On.\n", m.Name)
+			fmt.Fprintf(&b, "func (m *%s) %s(%s)%s {\n", service.Interface, m.Name, params, results(fset, typ))
+
+			if typ.Results == nil || len(typ.Results.List) == 0 {
+				fmt.Fprintf(&b, "\tm.Called(%s)\n}\n", args)
+
+				continue
+			}
+
+			fmt.Fprintf(&b, "\targs := m.Called(%s)\n\n", args)
+
+			values := make([]string, 0)
+
+			for _, field := range typ.Results.List {
+				for i := 0; i < len(field.Names) || i == 0; i++ {
+					if ident, ok := field.Type.(*ast.Ident); ok && ident.Name == "error" {
+						values = append(values, fmt.Sprintf("args.Error(%d)", len(values)))
+					} else {
+						values = append(values, fmt.Sprintf("result[%s](args, %d)", node(fset, field.Type), len(values)))
+					}
+				}
+			}
+
+			fmt.Fprintf(&b, "\treturn %s\n}\n", strings.Join(values, ", "))
+		}
+	}
+
+	b.WriteString("\nvar (\n")
+
+	for _, service := range services {
+		fmt.Fprintf(&b, "\t_ asc.%s = (*%s)(nil)\n", service.Interface, service.Interface)
+	}
+
+	b.WriteString(")\n")
+
+	return "package ascmock\n" + importBlock(imports) + b.String()
+}
+
+// qualify returns a copy of typ where the exported identifiers of package asc are prefixed with the package name.
+func qualify(typ *ast.FuncType) *ast.FuncType {
+	var copyExpr func(expr ast.Expr) ast.Expr
+
+	copyFields := func(fields *ast.FieldList) *ast.FieldList {
+		if fields == nil {
+			return nil
+		}
+
+		list := &ast.FieldList{}
+
+		for _, field := range fields.List {
+			list.List = append(list.List, &ast.Field{Names: field.Names, Type: copyExpr(field.Type)})
+		}
+
+		return list
+	}
+
+	copyExpr = func(expr ast.Expr) ast.Expr {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			if e.IsExported() {
+				return &ast.SelectorExpr{X: ast.NewIdent("asc"), Sel: ast.NewIdent(e.Name)}
+			}
+
+			return e
+		case *ast.StarExpr:
+			return &ast.StarExpr{X: copyExpr(e.X)}
+		case *ast.ArrayType:
+			return &ast.ArrayType{Len: e.Len, Elt: copyExpr(e.Elt)}
+		case *ast.MapType:
+			return &ast.MapType{Key: copyExpr(e.Key), Value: copyExpr(e.Value)}
+		case *ast.Ellipsis:
+			return &ast.Ellipsis{Elt: copyExpr(e.Elt)}
+		case *ast.FuncType:
+			return &ast.FuncType{Params: copyFields(e.Params), Results: copyFields(e.Results)}
+		case *ast.ChanType:
+			return &ast.ChanType{Dir: e.Dir, Value: copyExpr(e.Value)}
+		default:
+			return e
+		}
+	}
+
+	return &ast.FuncType{Params: copyFields(typ.Params), Results: copyFields(typ.Results)}
+}
+
+// signature returns the parameters of typ, naming the unnamed ones, and the arguments to pass them on.
+func signature(fset *token.FileSet, typ *ast.FuncType) (string, string) {
+	params := make([]string, 0)
+	args := make([]string, 0)
+
+	for _, field := range typ.Params.List {
+		names := make([]string, 0)
+		for _, name := range field.Names {
+			names = append(names, name.Name)
+		}
+
+		if len(names) == 0 {
+			names = append(names, fmt.Sprintf("p%d", len(params)))
+		}
+
+		for _, name := range names {
+			params = append(params, name+" "+node(fset, field.Type))
+
+			if _, ok := field.Type.(*ast.Ellipsis); ok {
+				name += "..."
+			}
+
+			args = append(args, name)
+		}
+	}
+
+	return strings.Join(params, ", "), strings.Join(args, ", ")
+}
+
+// fields prints a parameter or result list on a single line.
+func fields(fset *token.FileSet, list *ast.FieldList, withNames bool) string {
+	if list == nil {
+		return ""
+	}
+
+	printed := make([]string, 0)
+
+	for _, field := range list.List {
+		typ := node(fset, field.Type)
+
+		if !withNames || len(field.Names) == 0 {
+			for i := 0; i < len(field.Names) || i == 0; i++ {
+				printed = append(printed, typ)
+			}
+
+			continue
+		}
+
+		for _, name := range field.Names {
+			printed = append(printed, name.Name+" "+typ)
+		}
+	}
+
+	return strings.Join(printed, ", ")
+}
+
+func results(fset *token.FileSet, typ *ast.FuncType) string {
+	if typ.Results == nil || len(typ.Results.List) == 0 {
+		return ""
+	}
+
+	printed := fields(fset, typ.Results, false)
+	if len(typ.Results.List) == 1 && len(typ.Results.List[0].Names) <= 1 {
+		return " " + printed
+	}
+
+	return " (" + printed + ")"
+}
+
+func collectImports(m method, imports map[string]bool) {
+	ast.Inspect(m.Type, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				imports[m.Imports[ident.Name]] = true
+			}
+		}
+
+		return true
+	})
+}
+
+func importBlock(imports map[string]bool) string {
+	paths := make([]string, 0, len(imports))
+	for path := range imports {
+		paths = append(paths, path)
+	}
+
+	thirdParty := func(path string) bool {
+		return strings.Contains(strings.Split(path, "/")[0], ".")
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		if thirdParty(paths[i]) != thirdParty(paths[j]) {
+			return thirdParty(paths[j])
+		}
+
+		return paths[i] < paths[j]
+	})
+
+	var b strings.Builder
+
+	b.WriteString("\nimport (\n")
+
+	for i, path := range paths {
+		if i > 0 && thirdParty(path) && !thirdParty(paths[i-1]) {
+			b.WriteString("\n")
+		}
+
+		fmt.Fprintf(&b, "\t%q\n", path)
+	}
+
+	b.WriteString(")\n")
+
+	return b.String()
+}
+
+func node(fset *token.FileSet, n ast.Node) string {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, n); err != nil {
+		log.Fatal(err)
+	}
+
+	return buf.String()
+}
+
+func write(path string, license string, source string) {
+	src := license + "\n\n// Code generated by ascmock/gen.go. DO NOT EDIT.\n\n" + source
+
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		log.Fatalf("%s: %v\n%s", path, err, src)
+	}
+
+	if err := ioutil.WriteFile(path, formatted, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
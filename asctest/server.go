@@ -0,0 +1,694 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package asctest provides an in-memory fake of the App Store Connect API for hermetic integration tests.
+//
+// The fake keeps the resources it is sent, such as bundle IDs, capabilities, devices, certificates, profiles,
+// beta groups and beta testers, and serves them back through the same endpoints App Store Connect does:
+//
+//	server := asctest.NewServer()
+//	defer server.Close()
+//
+//	client := server.Client()
+//	bundleID, _, err := client.Provisioning.CreateBundleID(ctx, asc.BundleIDCreateRequestAttributes{...})
+//	_, _, err = client.Provisioning.EnableCapability(ctx, asc.CapabilityTypeAppGroups, nil, bundleID.Data.ID)
+//	capabilities, _, err := client.Provisioning.ListCapabilitiesForBundleID(ctx, bundleID.Data.ID, nil)
+//
+// Resources App Store Connect creates by itself, such as apps and builds, can be seeded with Add. The fake
+// doesn't enforce the validation rules of App Store Connect, it only stores and relates resources.
+package asctest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/lingjiawen/asc"
+)
+
+// defaultLimit is the page size used when a request doesn't set one.
+const defaultLimit = 50
+
+// Resource is a resource stored by the fake.
+type Resource struct {
+	Type       string
+	ID         string
+	Attributes map[string]interface{}
+	// ToOne holds the to-one relationships of the resource by name.
+	ToOne map[string]asc.RelationshipData
+	// ToMany holds the to-many relationships of the resource by name.
+	ToMany map[string][]asc.RelationshipData
+}
+
+// Server is an in-memory fake of the App Store Connect API.
+type Server struct {
+	server *httptest.Server
+
+	mu        sync.Mutex
+	resources map[string]map[string]*Resource
+	nextID    int
+}
+
+// NewServer starts a fake App Store Connect API. Close it when done.
+func NewServer() *Server {
+	s := &Server{
+		resources: make(map[string]map[string]*Resource),
+	}
+	s.server = httptest.NewServer(http.StripPrefix("/v1", http.HandlerFunc(s.serveHTTP)))
+
+	return s
+}
+
+// Close shuts the server down.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// URL returns the base URL of the API served by the fake.
+func (s *Server) URL() string {
+	return s.server.URL + "/v1/"
+}
+
+// Client returns an asc.Client that sends its requests to the fake.
+func (s *Server) Client() *asc.Client {
+	client := asc.NewClient(s.server.Client())
+	_ = client.SetBaseURL(s.URL())
+
+	return client
+}
+
+// Add stores a resource of the given type, such as an app or a build App Store Connect would create by itself,
+// and returns its ID.
+func (s *Server) Add(typ string, attributes map[string]interface{}, toOne map[string]asc.RelationshipData) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.newResource(typ)
+	for k, v := range attributes {
+		r.Attributes[k] = v
+	}
+
+	for k, v := range toOne {
+		r.ToOne[k] = v
+	}
+
+	return r.ID
+}
+
+// Link adds related resources to a to-many relationship of a stored resource.
+func (s *Server) Link(typ string, id string, relationship string, related ...asc.RelationshipData) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.resources[typ][id]
+	if !ok {
+		return fmt.Errorf("asctest: no %s with id %s", typ, id)
+	}
+
+	r.ToMany[relationship] = appendLinkages(r.ToMany[relationship], related)
+
+	return nil
+}
+
+// Get returns a copy of a stored resource.
+func (s *Server) Get(typ string, id string) (Resource, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.resources[typ][id]
+	if !ok {
+		return Resource{}, false
+	}
+
+	return r.copy(), true
+}
+
+// List returns copies of the stored resources of a type, in the order they were created.
+func (s *Server) List(typ string) []Resource {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	list := make([]Resource, 0)
+	for _, r := range s.sorted(typ) {
+		list = append(list, r.copy())
+	}
+
+	return list
+}
+
+func (s *Server) newResource(typ string) *Resource {
+	s.nextID++
+
+	r := &Resource{
+		Type:       typ,
+		ID:         strconv.Itoa(s.nextID),
+		Attributes: make(map[string]interface{}),
+		ToOne:      make(map[string]asc.RelationshipData),
+		ToMany:     make(map[string][]asc.RelationshipData),
+	}
+
+	if s.resources[typ] == nil {
+		s.resources[typ] = make(map[string]*Resource)
+	}
+
+	s.resources[typ][r.ID] = r
+
+	return r
+}
+
+// sorted returns the stored resources of a type in the order they were created.
+func (s *Server) sorted(typ string) []*Resource {
+	list := make([]*Resource, 0, len(s.resources[typ]))
+	for _, r := range s.resources[typ] {
+		list = append(list, r)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		a, _ := strconv.Atoi(list[i].ID)
+		b, _ := strconv.Atoi(list[j].ID)
+
+		return a < b
+	})
+
+	return list
+}
+
+func (r *Resource) copy() Resource {
+	c := Resource{
+		Type:       r.Type,
+		ID:         r.ID,
+		Attributes: make(map[string]interface{}, len(r.Attributes)),
+		ToOne:      make(map[string]asc.RelationshipData, len(r.ToOne)),
+		ToMany:     make(map[string][]asc.RelationshipData, len(r.ToMany)),
+	}
+
+	for k, v := range r.Attributes {
+		c.Attributes[k] = v
+	}
+
+	for k, v := range r.ToOne {
+		c.ToOne[k] = v
+	}
+
+	for k, v := range r.ToMany {
+		c.ToMany[k] = append([]asc.RelationshipData(nil), v...)
+	}
+
+	return c
+}
+
+// relatesTo reports whether any relationship of the resource points to the given resource.
+func (r *Resource) relatesTo(typ string, id string) bool {
+	for _, data := range r.ToOne {
+		if data.Type == typ && data.ID == id {
+			return true
+		}
+	}
+
+	for _, list := range r.ToMany {
+		for _, data := range list {
+			if data.Type == typ && data.ID == id {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// matches reports whether the resource passes a filter[name] query parameter.
+func (r *Resource) matches(name string, values []string) bool {
+	candidates := make([]string, 0)
+
+	switch {
+	case name == "id":
+		candidates = append(candidates, r.ID)
+	case r.Attributes[name] != nil:
+		switch v := r.Attributes[name].(type) {
+		case []interface{}:
+			for _, item := range v {
+				candidates = append(candidates, fmt.Sprint(item))
+			}
+		default:
+			candidates = append(candidates, fmt.Sprint(v))
+		}
+	default:
+		if data, ok := r.ToOne[name]; ok {
+			candidates = append(candidates, data.ID)
+		}
+
+		for _, data := range r.ToMany[name] {
+			candidates = append(candidates, data.ID)
+		}
+	}
+
+	for _, candidate := range candidates {
+		for _, value := range values {
+			if candidate == value {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func appendLinkages(list []asc.RelationshipData, added []asc.RelationshipData) []asc.RelationshipData {
+	for _, data := range added {
+		found := false
+
+		for _, existing := range list {
+			if existing == data {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			list = append(list, data)
+		}
+	}
+
+	return list
+}
+
+func removeLinkages(list []asc.RelationshipData, removed []asc.RelationshipData) []asc.RelationshipData {
+	kept := make([]asc.RelationshipData, 0, len(list))
+
+	for _, existing := range list {
+		found := false
+
+		for _, data := range removed {
+			if existing == data {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			kept = append(kept, existing)
+		}
+	}
+
+	return kept
+}
+
+// serveHTTP routes a request by the segments of its path:
+//
+//	/{type}
+//	/{type}/{id}
+//	/{type}/{id}/{relationship}
+//	/{type}/{id}/relationships/{relationship}
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+
+	switch {
+	case len(segments) == 1 && r.Method == http.MethodGet:
+		s.list(w, r, s.sorted(segments[0]))
+	case len(segments) == 1 && r.Method == http.MethodPost:
+		s.create(w, r, segments[0])
+	case len(segments) == 2:
+		s.serveResource(w, r, segments[0], segments[1])
+	case len(segments) == 3 && r.Method == http.MethodGet:
+		s.related(w, r, segments[0], segments[1], segments[2])
+	case len(segments) == 4 && segments[2] == "relationships":
+		s.serveLinkages(w, r, segments[0], segments[1], segments[3])
+	default:
+		writeError(w, http.StatusNotFound, "NOT_FOUND", "The path provided does not match a defined resource type.")
+	}
+}
+
+func (s *Server) serveResource(w http.ResponseWriter, r *http.Request, typ string, id string) {
+	resource, ok := s.resources[typ][id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("There is no resource of type '%s' with id '%s'", typ, id))
+
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": s.render(resource), "links": links(r)})
+	case http.MethodPatch:
+		body, err := decodeBody(r)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", err.Error())
+
+			return
+		}
+
+		body.applyTo(resource)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": s.render(resource), "links": links(r)})
+	case http.MethodDelete:
+		delete(s.resources[typ], id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "The request method is not allowed for the resource.")
+	}
+}
+
+func (s *Server) create(w http.ResponseWriter, r *http.Request, typ string) {
+	body, err := decodeBody(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", err.Error())
+
+		return
+	}
+
+	if body.Type != typ {
+		writeError(w, http.StatusConflict, "ENTITY_ERROR.INCLUDED.INVALID_TYPE", fmt.Sprintf("The resource type '%s' does not match the endpoint '%s'", body.Type, typ))
+
+		return
+	}
+
+	resource := s.newResource(typ)
+	body.applyTo(resource)
+	writeJSON(w, http.StatusCreated, map[string]interface{}{"data": s.render(resource), "links": links(r)})
+}
+
+// related serves the resources related to a stored resource, either through a relationship of the resource
+// itself or through the relationships of other resources pointing back to it.
+func (s *Server) related(w http.ResponseWriter, r *http.Request, typ string, id string, relationship string) {
+	resource, ok := s.resources[typ][id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("There is no resource of type '%s' with id '%s'", typ, id))
+
+		return
+	}
+
+	if data, ok := resource.ToOne[relationship]; ok {
+		s.single(w, r, s.resources[data.Type][data.ID])
+
+		return
+	}
+
+	list := make([]*Resource, 0)
+
+	for _, data := range resource.ToMany[relationship] {
+		if related, ok := s.resources[data.Type][data.ID]; ok {
+			list = append(list, related)
+		}
+	}
+
+	relatedType := relationship
+	if !strings.HasSuffix(relationship, "s") {
+		relatedType += "s"
+	}
+
+	for _, candidate := range s.sorted(relatedType) {
+		if candidate.relatesTo(typ, id) && !contains(list, candidate) {
+			list = append(list, candidate)
+		}
+	}
+
+	if relatedType != relationship {
+		if len(list) == 0 {
+			s.single(w, r, nil)
+		} else {
+			s.single(w, r, list[0])
+		}
+
+		return
+	}
+
+	s.list(w, r, list)
+}
+
+func (s *Server) serveLinkages(w http.ResponseWriter, r *http.Request, typ string, id string, relationship string) {
+	resource, ok := s.resources[typ][id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "NOT_FOUND", fmt.Sprintf("There is no resource of type '%s' with id '%s'", typ, id))
+
+		return
+	}
+
+	if r.Method == http.MethodGet {
+		if data, ok := resource.ToOne[relationship]; ok {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"data": data, "links": links(r)})
+
+			return
+		}
+
+		linkages := append([]asc.RelationshipData{}, resource.ToMany[relationship]...)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": linkages, "links": links(r)})
+
+		return
+	}
+
+	var body struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", err.Error())
+
+		return
+	}
+
+	one, many, isMany, err := decodeLinkage(body.Data)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "PARAMETER_ERROR.INVALID", err.Error())
+
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodPatch && !isMany:
+		resource.ToOne[relationship] = *one
+	case r.Method == http.MethodPatch:
+		resource.ToMany[relationship] = many
+	case r.Method == http.MethodPost:
+		resource.ToMany[relationship] = appendLinkages(resource.ToMany[relationship], many)
+	case r.Method == http.MethodDelete:
+		resource.ToMany[relationship] = removeLinkages(resource.ToMany[relationship], many)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "METHOD_NOT_ALLOWED", "The request method is not allowed for the relationship.")
+
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) single(w http.ResponseWriter, r *http.Request, resource *Resource) {
+	if resource == nil {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"data": nil, "links": links(r)})
+
+		return
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"data": s.render(resource), "links": links(r)})
+}
+
+// list serves a page of resources, applying the filter, limit and cursor query parameters of the request.
+func (s *Server) list(w http.ResponseWriter, r *http.Request, resources []*Resource) {
+	query := r.URL.Query()
+	filtered := make([]*Resource, 0, len(resources))
+
+	for _, resource := range resources {
+		matches := true
+
+		for key, values := range query {
+			if strings.HasPrefix(key, "filter[") && strings.HasSuffix(key, "]") {
+				name := key[len("filter[") : len(key)-1]
+				if !resource.matches(name, strings.Split(strings.Join(values, ","), ",")) {
+					matches = false
+
+					break
+				}
+			}
+		}
+
+		if matches {
+			filtered = append(filtered, resource)
+		}
+	}
+
+	limit, err := strconv.Atoi(query.Get("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultLimit
+	}
+
+	offset, _ := strconv.Atoi(query.Get("cursor"))
+	if offset < 0 || offset > len(filtered) {
+		offset = len(filtered)
+	}
+
+	end := offset + limit
+	if end > len(filtered) {
+		end = len(filtered)
+	}
+
+	data := make([]interface{}, 0, end-offset)
+	for _, resource := range filtered[offset:end] {
+		data = append(data, s.render(resource))
+	}
+
+	pageLinks := links(r)
+
+	if end < len(filtered) {
+		next := *r.URL
+		q := next.Query()
+		q.Set("cursor", strconv.Itoa(end))
+		next.RawQuery = q.Encode()
+		pageLinks["next"] = s.server.URL + "/v1" + next.String()
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"data":  data,
+		"links": pageLinks,
+		"meta": map[string]interface{}{
+			"paging": map[string]int{"limit": limit, "total": len(filtered)},
+		},
+	})
+}
+
+func (s *Server) render(resource *Resource) map[string]interface{} {
+	self := fmt.Sprintf("%s/v1/%s/%s", s.server.URL, resource.Type, resource.ID)
+	relationships := make(map[string]interface{})
+
+	for name, data := range resource.ToOne {
+		relationships[name] = map[string]interface{}{"data": data}
+	}
+
+	for name, list := range resource.ToMany {
+		relationships[name] = map[string]interface{}{"data": list}
+	}
+
+	return map[string]interface{}{
+		"type":          resource.Type,
+		"id":            resource.ID,
+		"attributes":    resource.Attributes,
+		"relationships": relationships,
+		"links":         map[string]string{"self": self},
+	}
+}
+
+// requestData is the data of a create or update request body.
+type requestData struct {
+	Type          string                     `json:"type"`
+	Attributes    map[string]interface{}     `json:"attributes"`
+	Relationships map[string]json.RawMessage `json:"relationships"`
+}
+
+func decodeBody(r *http.Request) (*requestData, error) {
+	var body struct {
+		Data requestData `json:"data"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+
+	return &body.Data, nil
+}
+
+// applyTo merges the attributes and relationships of the request into a resource.
+func (d *requestData) applyTo(resource *Resource) {
+	for k, v := range d.Attributes {
+		if v == nil {
+			delete(resource.Attributes, k)
+		} else {
+			resource.Attributes[k] = v
+		}
+	}
+
+	for name, raw := range d.Relationships {
+		var relationship struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if json.Unmarshal(raw, &relationship) != nil {
+			continue
+		}
+
+		one, many, isMany, err := decodeLinkage(relationship.Data)
+
+		switch {
+		case err != nil:
+			continue
+		case isMany:
+			resource.ToMany[name] = many
+		case one == nil:
+			delete(resource.ToOne, name)
+		default:
+			resource.ToOne[name] = *one
+		}
+	}
+}
+
+// decodeLinkage decodes the data of a relationship, which is either a single linkage or a list of them.
+func decodeLinkage(raw json.RawMessage) (*asc.RelationshipData, []asc.RelationshipData, bool, error) {
+	trimmed := strings.TrimSpace(string(raw))
+
+	if strings.HasPrefix(trimmed, "[") {
+		many := make([]asc.RelationshipData, 0)
+		err := json.Unmarshal(raw, &many)
+
+		return nil, many, true, err
+	}
+
+	if trimmed == "" || trimmed == "null" {
+		return nil, nil, false, nil
+	}
+
+	one := new(asc.RelationshipData)
+	err := json.Unmarshal(raw, one)
+
+	return one, nil, false, err
+}
+
+func contains(list []*Resource, resource *Resource) bool {
+	for _, r := range list {
+		if r == resource {
+			return true
+		}
+	}
+
+	return false
+}
+
+func links(r *http.Request) map[string]interface{} {
+	return map[string]interface{}{"self": "http://" + r.Host + "/v1" + r.URL.String()}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, code string, detail string) {
+	writeJSON(w, status, map[string]interface{}{
+		"errors": []map[string]string{{
+			"status": strconv.Itoa(status),
+			"code":   code,
+			"title":  http.StatusText(status),
+			"detail": detail,
+		}},
+	})
+}
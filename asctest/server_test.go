@@ -0,0 +1,176 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asctest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/lingjiawen/asc"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProvisioning(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	bundleID, _, err := client.Provisioning.CreateBundleID(ctx, asc.BundleIDCreateRequestAttributes{
+		Identifier: "com.example.app",
+		Name:       "Example",
+		Platform:   asc.BundleIDPlatformiOS,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "com.example.app", *bundleID.Data.Attributes.IDentifier)
+
+	_, _, err = client.Provisioning.EnableCapability(ctx, asc.CapabilityTypeAppGroups, nil, bundleID.Data.ID)
+	assert.NoError(t, err)
+
+	capabilities, _, err := client.Provisioning.ListCapabilitiesForBundleID(ctx, bundleID.Data.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, capabilities.Data, 1)
+	assert.Equal(t, asc.CapabilityTypeAppGroups, *capabilities.Data[0].Attributes.CapabilityType)
+
+	bundleIDs, _, err := client.Provisioning.ListBundleIDs(ctx, &asc.ListBundleIDsQuery{FilterIdentifier: []string{"com.example.app"}})
+	assert.NoError(t, err)
+	assert.Len(t, bundleIDs.Data, 1)
+
+	bundleIDs, _, err = client.Provisioning.ListBundleIDs(ctx, &asc.ListBundleIDsQuery{FilterIdentifier: []string{"com.example.other"}})
+	assert.NoError(t, err)
+	assert.Empty(t, bundleIDs.Data)
+
+	_, err = client.Provisioning.DeleteBundleID(ctx, bundleID.Data.ID)
+	assert.NoError(t, err)
+
+	_, _, err = client.Provisioning.GetBundleID(ctx, bundleID.Data.ID, nil)
+
+	var errResponse *asc.ErrorResponse
+	assert.True(t, errors.As(err, &errResponse))
+	assert.Equal(t, http.StatusNotFound, errResponse.Response.StatusCode)
+}
+
+func TestTestFlight(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	ctx := context.Background()
+	client := server.Client()
+
+	appID := server.Add("apps", map[string]interface{}{"bundleId": "com.example.app", "name": "Example"}, nil)
+
+	group, _, err := client.TestFlight.CreateBetaGroup(ctx, asc.BetaGroupCreateRequestAttributes{Name: "Friends"}, appID, nil, nil)
+	assert.NoError(t, err)
+
+	tester, _, err := client.TestFlight.CreateBetaTester(ctx, asc.BetaTesterCreateRequestAttributes{Email: "tester@example.com"}, []string{group.Data.ID}, nil)
+	assert.NoError(t, err)
+
+	other, _, err := client.TestFlight.CreateBetaTester(ctx, asc.BetaTesterCreateRequestAttributes{Email: "other@example.com"}, nil, nil)
+	assert.NoError(t, err)
+
+	_, err = client.TestFlight.AddBetaTestersToBetaGroup(ctx, group.Data.ID, []string{other.Data.ID})
+	assert.NoError(t, err)
+
+	testers, _, err := client.TestFlight.ListBetaTestersForBetaGroup(ctx, group.Data.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, testers.Data, 2)
+
+	groups, _, err := client.TestFlight.ListBetaGroupsForApp(ctx, appID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, groups.Data, 1)
+
+	groups, _, err = client.TestFlight.ListBetaGroupsForBetaTester(ctx, tester.Data.ID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, groups.Data, 1)
+
+	_, err = client.TestFlight.RemoveBetaTestersFromBetaGroup(ctx, group.Data.ID, []string{other.Data.ID})
+	assert.NoError(t, err)
+
+	stored, ok := server.Get("betaGroups", group.Data.ID)
+	assert.True(t, ok)
+	assert.Empty(t, stored.ToMany["betaTesters"])
+	assert.Equal(t, asc.RelationshipData{ID: appID, Type: "apps"}, stored.ToOne["app"])
+}
+
+func TestPagination(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	for i := 0; i < 5; i++ {
+		server.Add("devices", map[string]interface{}{"platform": "IOS"}, nil)
+	}
+
+	ctx := context.Background()
+	client := server.Client()
+	params := &asc.ListDevicesQuery{Limit: 2}
+	pages := 0
+	ids := make([]string, 0)
+
+	for {
+		devices, _, err := client.Provisioning.ListDevices(ctx, params)
+		assert.NoError(t, err)
+
+		pages++
+
+		for _, device := range devices.Data {
+			ids = append(ids, device.ID)
+		}
+
+		if devices.Links.Next == nil || devices.Links.Next.Cursor() == "" {
+			break
+		}
+
+		params.Cursor = devices.Links.Next.Cursor()
+	}
+
+	assert.Equal(t, 3, pages)
+	assert.Equal(t, []string{"1", "2", "3", "4", "5"}, ids)
+	assert.Len(t, server.List("devices"), 5)
+}
+
+func TestLink(t *testing.T) {
+	t.Parallel()
+
+	server := NewServer()
+	defer server.Close()
+
+	profileID := server.Add("profiles", nil, nil)
+	deviceID := server.Add("devices", nil, nil)
+
+	assert.NoError(t, server.Link("profiles", profileID, "devices", asc.RelationshipData{ID: deviceID, Type: "devices"}))
+	assert.Error(t, server.Link("profiles", "0", "devices"))
+
+	devices, _, err := server.Client().Provisioning.ListDevicesInProfile(context.Background(), profileID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, devices.Data, 1)
+
+	_, ok := server.Get("profiles", "0")
+	assert.False(t, ok)
+}
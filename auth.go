@@ -21,11 +21,13 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 package asc
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/x509"
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -48,11 +50,17 @@ type AuthTransport struct {
 type jwtGenerator interface {
 	Token() (string, error)
 	IsValid() bool
+	// TokenContext is like Token, but uses ctx for anything it fetches to sign a new token.
+	TokenContext(ctx context.Context) (string, error)
+	// Invalidate discards the current token after App Store Connect rejected it, and reports whether the next
+	// token could be signed with a different key, which makes the rejected request worth retrying.
+	Invalidate() bool
 }
 
 type standardJWTGenerator struct {
 	keyID          string
 	issuerID       string
+	bundleID       string
 	expireDuration time.Duration
 	privateKey     *ecdsa.PrivateKey
 
@@ -62,7 +70,14 @@ type standardJWTGenerator struct {
 // NewTokenConfig returns a new AuthTransport instance that customizes the Authentication header of the request during transport.
 // It can be customized further by supplying a custom http.RoundTripper instance to the Transport field.
 func NewTokenConfig(keyID string, issuerID string, expireDuration time.Duration, privateKey []byte) (*AuthTransport, error) {
-	key, err := parsePrivateKey(privateKey)
+	return NewBundleTokenConfig(keyID, issuerID, "", expireDuration, privateKey)
+}
+
+// NewBundleTokenConfig returns a new AuthTransport instance like NewTokenConfig, whose tokens are also scoped to the
+// app with the given bundle ID. APIs that act on behalf of a single app, such as the App Store Server API, require
+// these tokens, and reject any that expire more than an hour after they were issued.
+func NewBundleTokenConfig(keyID string, issuerID string, bundleID string, expireDuration time.Duration, privateKey []byte) (*AuthTransport, error) {
+	key, err := ParsePrivateKey(privateKey)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +85,7 @@ func NewTokenConfig(keyID string, issuerID string, expireDuration time.Duration,
 	gen := &standardJWTGenerator{
 		keyID:          keyID,
 		issuerID:       issuerID,
+		bundleID:       bundleID,
 		privateKey:     key,
 		expireDuration: expireDuration,
 	}
@@ -81,7 +97,9 @@ func NewTokenConfig(keyID string, issuerID string, expireDuration time.Duration,
 	}, err
 }
 
-func parsePrivateKey(blob []byte) (*ecdsa.PrivateKey, error) {
+// ParsePrivateKey parses the PEM-encoded PKCS#8 private key downloaded from App Store Connect, such as an API key
+// or an in-app purchase key, into an ECDSA private key.
+func ParsePrivateKey(blob []byte) (*ecdsa.PrivateKey, error) {
 	block, _ := pem.Decode(blob)
 	if block == nil {
 		return nil, ErrMissingPEM
@@ -99,16 +117,59 @@ func parsePrivateKey(blob []byte) (*ecdsa.PrivateKey, error) {
 	return nil, ErrInvalidPrivateKey
 }
 
-// RoundTrip implements the http.RoundTripper interface to set the Authorization header.
+// RoundTrip implements the http.RoundTripper interface to set the Authorization header. When App Store Connect
+// rejects the token as unauthorized and the token can be signed with a different key, such as one rotated in the
+// secret store of a KeyProvider, the request is sent once more with a new token.
 func (t AuthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
-	token, err := t.jwtGenerator.Token()
+	token, err := t.jwtGenerator.TokenContext(req.Context())
 	if err != nil {
 		return nil, err
 	}
 
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 
-	return t.transport().RoundTrip(req)
+	resp, err := t.transport().RoundTrip(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || !t.jwtGenerator.Invalidate() {
+		return resp, err
+	}
+
+	retry := replayableRequest(req)
+	if retry == nil {
+		return resp, nil
+	}
+
+	_, _ = io.Copy(io.Discard, resp.Body)
+	resp.Body.Close()
+
+	token, err = t.jwtGenerator.TokenContext(req.Context())
+	if err != nil {
+		return nil, err
+	}
+
+	retry.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+
+	return t.transport().RoundTrip(retry)
+}
+
+// replayableRequest returns a copy of req that can be sent again, or nil if its body can't be read a second time.
+func replayableRequest(req *http.Request) *http.Request {
+	retry := req.Clone(req.Context())
+	if req.Body == nil || req.Body == http.NoBody {
+		return retry
+	}
+
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+
+	retry.Body = body
+
+	return retry
 }
 
 // Client returns a new http.Client instance for use with asc.Client.
@@ -142,6 +203,15 @@ func (g *standardJWTGenerator) Token() (string, error) {
 	return token, nil
 }
 
+func (g *standardJWTGenerator) TokenContext(ctx context.Context) (string, error) {
+	return g.Token()
+}
+
+// Invalidate keeps the token, as a token signed again with the same key would be rejected just the same.
+func (g *standardJWTGenerator) Invalidate() bool {
+	return false
+}
+
 func (g *standardJWTGenerator) IsValid() bool {
 	if g.token == "" {
 		return false
@@ -166,11 +236,31 @@ func (g *standardJWTGenerator) claims() jwt.Claims {
 	// 基于调整后的时间设置过期时间
 	expiry := adjustedTime.Add(g.expireDuration)
 
-	return jwt.StandardClaims{
+	claims := jwt.StandardClaims{
 		Audience:  jwt.ClaimStrings{"appstoreconnect-v1"},
 		Issuer:    g.issuerID,
 		ExpiresAt: jwt.At(expiry),
 	}
+
+	if g.bundleID == "" {
+		return claims
+	}
+
+	claims.IssuedAt = jwt.At(adjustedTime)
+
+	return bundleClaims{
+		StandardClaims: claims,
+		Audience:       "appstoreconnect-v1",
+		BundleID:       g.bundleID,
+	}
+}
+
+// bundleClaims are the claims of a token scoped to a single app, whose audience must be a single string rather
+// than the array jwt.StandardClaims encodes.
+type bundleClaims struct {
+	jwt.StandardClaims
+	Audience string `json:"aud"`
+	BundleID string `json:"bid"`
 }
 
 func newTransport() http.RoundTripper {
@@ -0,0 +1,138 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// APIKey is an App Store Connect API key, as fetched by a KeyProvider.
+type APIKey struct {
+	KeyID    string
+	IssuerID string
+	// PrivateKey is the PEM-encoded contents of the .p8 file.
+	PrivateKey []byte
+}
+
+// KeyProvider fetches the API key that tokens are signed with, such as from a secret store. Package keyprovider
+// has implementations for HashiCorp Vault, AWS Secrets Manager and 1Password Connect.
+type KeyProvider interface {
+	APIKey(ctx context.Context) (*APIKey, error)
+}
+
+// KeyProviderFunc adapts a function to a KeyProvider.
+type KeyProviderFunc func(ctx context.Context) (*APIKey, error)
+
+// APIKey calls f.
+func (f KeyProviderFunc) APIKey(ctx context.Context) (*APIKey, error) {
+	return f(ctx)
+}
+
+// NewKeyProviderTokenConfig returns an AuthTransport that fetches the API key from provider whenever it signs a
+// new token: when the previous token expires, and after App Store Connect rejects a token as unauthorized, in which
+// case the rejected request is sent once more with the new token. A key rotated in the secret store is therefore
+// used without restarting the process or failing the request. The first key is fetched before
+// returning, so that a misconfigured provider fails early.
+func NewKeyProviderTokenConfig(ctx context.Context, provider KeyProvider, expireDuration time.Duration) (*AuthTransport, error) {
+	gen := &keyProviderJWTGenerator{
+		provider:       provider,
+		expireDuration: expireDuration,
+	}
+
+	if _, err := gen.TokenContext(ctx); err != nil {
+		return nil, err
+	}
+
+	return &AuthTransport{
+		Transport:    newTransport(),
+		jwtGenerator: gen,
+	}, nil
+}
+
+// keyProviderJWTGenerator signs tokens with the key most recently fetched from a KeyProvider.
+type keyProviderJWTGenerator struct {
+	provider       KeyProvider
+	expireDuration time.Duration
+
+	mu      sync.Mutex
+	current *standardJWTGenerator
+}
+
+func (g *keyProviderJWTGenerator) Token() (string, error) {
+	return g.TokenContext(context.Background())
+}
+
+func (g *keyProviderJWTGenerator) IsValid() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return g.current != nil && g.current.IsValid()
+}
+
+// TokenContext returns the current token while it is valid, and otherwise fetches the key and signs a new one.
+func (g *keyProviderJWTGenerator) TokenContext(ctx context.Context) (string, error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.current != nil && g.current.IsValid() {
+		return g.current.token, nil
+	}
+
+	key, err := g.provider.APIKey(ctx)
+	if err != nil {
+		return "", fmt.Errorf("fetching API key: %w", err)
+	}
+
+	privateKey, err := ParsePrivateKey(key.PrivateKey)
+	if err != nil {
+		return "", fmt.Errorf("API key %s: %w", key.KeyID, err)
+	}
+
+	gen := &standardJWTGenerator{
+		keyID:          key.KeyID,
+		issuerID:       key.IssuerID,
+		privateKey:     privateKey,
+		expireDuration: g.expireDuration,
+	}
+
+	token, err := gen.Token()
+	if err != nil {
+		return "", err
+	}
+
+	g.current = gen
+
+	return token, nil
+}
+
+// Invalidate discards the current token, so that the key is fetched again for the next token, as the key may
+// have been rotated in the secret store.
+func (g *keyProviderJWTGenerator) Invalidate() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.current = nil
+
+	return true
+}
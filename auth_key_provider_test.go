@@ -0,0 +1,172 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// tokenKeyID returns the key ID in the header of a bearer token.
+func tokenKeyID(t *testing.T, authorization string) string {
+	t.Helper()
+
+	header, err := base64.RawURLEncoding.DecodeString(strings.Split(strings.TrimPrefix(authorization, "Bearer "), ".")[0])
+	assert.NoError(t, err)
+
+	var fields struct {
+		KeyID string `json:"kid"`
+	}
+	assert.NoError(t, json.Unmarshal(header, &fields))
+
+	return fields.KeyID
+}
+
+func TestNewKeyProviderTokenConfig(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		fetches int
+		keyIDs  []string
+	)
+
+	provider := KeyProviderFunc(func(ctx context.Context) (*APIKey, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		fetches++
+
+		return &APIKey{KeyID: []string{"OLD", "NEW"}[(fetches-1)%2], IssuerID: "ISSUER", PrivateKey: newTestPrivateKey(t)}, nil
+	})
+
+	var bodies []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		keyIDs = append(keyIDs, tokenKeyID(t, r.Header.Get("Authorization")))
+		if len(keyIDs) == 2 {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	auth, err := NewKeyProviderTokenConfig(context.Background(), provider, 20*time.Minute)
+	assert.NoError(t, err)
+	assert.True(t, auth.jwtGenerator.IsValid())
+
+	client := auth.Client()
+
+	for i := 0; i < 3; i++ {
+		resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{}`)) // nolint: noctx
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, "the rejected request is retried with the rotated key")
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, []string{"OLD", "OLD", "NEW", "NEW"}, keyIDs)
+	assert.Equal(t, []string{"{}", "{}", "{}", "{}"}, bodies)
+	assert.Equal(t, 2, fetches)
+}
+
+func TestNewKeyProviderTokenConfigRetriesOnce(t *testing.T) {
+	t.Parallel()
+
+	fetches := 0
+	provider := KeyProviderFunc(func(ctx context.Context) (*APIKey, error) {
+		fetches++
+
+		return &APIKey{KeyID: "KEY", IssuerID: "ISSUER", PrivateKey: newTestPrivateKey(t)}, nil
+	})
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	auth, err := NewKeyProviderTokenConfig(context.Background(), provider, 20*time.Minute)
+	assert.NoError(t, err)
+
+	resp, err := auth.Client().Get(server.URL) // nolint: noctx
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	resp.Body.Close()
+
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 2, fetches)
+}
+
+func TestNewKeyProviderTokenConfigErrors(t *testing.T) {
+	t.Parallel()
+
+	errUnavailable := errors.New("secret store unavailable")
+
+	_, err := NewKeyProviderTokenConfig(context.Background(), KeyProviderFunc(func(ctx context.Context) (*APIKey, error) {
+		return nil, errUnavailable
+	}), 20*time.Minute)
+	assert.ErrorIs(t, err, errUnavailable)
+
+	_, err = NewKeyProviderTokenConfig(context.Background(), KeyProviderFunc(func(ctx context.Context) (*APIKey, error) {
+		return &APIKey{KeyID: "KEY", PrivateKey: []byte("not a key")}, nil
+	}), 20*time.Minute)
+	assert.ErrorIs(t, err, ErrMissingPEM)
+	assert.Contains(t, err.Error(), "API key KEY")
+}
+
+func TestKeyProviderJWTGeneratorRefetchesExpiredTokens(t *testing.T) {
+	t.Parallel()
+
+	fetches := 0
+	gen := &keyProviderJWTGenerator{
+		provider: KeyProviderFunc(func(ctx context.Context) (*APIKey, error) {
+			fetches++
+
+			return &APIKey{KeyID: "KEY", IssuerID: "ISSUER", PrivateKey: newTestPrivateKey(t)}, nil
+		}),
+		expireDuration: time.Second,
+	}
+
+	_, err := gen.Token()
+	assert.NoError(t, err)
+	assert.False(t, gen.IsValid(), "tokens are backdated by a minute, so a one second lifetime has already expired")
+
+	_, err = gen.Token()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fetches)
+
+	assert.True(t, gen.Invalidate())
+	assert.False(t, gen.IsValid())
+}
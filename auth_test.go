@@ -21,8 +21,17 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 package asc
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 	"time"
@@ -66,6 +75,34 @@ PURJWPQa24fI+wNPDi4OzjkB2g6fa5BHqam1gRlZHe8BU3+IjuC3AUFz
 	assert.Equal(t, tok, tokCached)
 }
 
+func TestNewBundleTokenConfig(t *testing.T) {
+	t.Parallel()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+
+	token, err := NewBundleTokenConfig("TEST", "TEST", "com.example.app", 20*time.Minute, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	assert.NoError(t, err)
+
+	tok, err := token.jwtGenerator.Token()
+	assert.NoError(t, err)
+
+	components := strings.Split(tok, ".")
+	assert.Equal(t, 3, len(components))
+
+	payload, err := base64.RawURLEncoding.DecodeString(components[1])
+	assert.NoError(t, err)
+
+	var claims map[string]interface{}
+	assert.NoError(t, json.Unmarshal(payload, &claims))
+	assert.Equal(t, "com.example.app", claims["bid"])
+	assert.Equal(t, "appstoreconnect-v1", claims["aud"])
+	assert.NotNil(t, claims["iat"])
+	assert.True(t, token.jwtGenerator.IsValid())
+}
+
 func TestNewTokenConfigBadPEM(t *testing.T) {
 	t.Parallel()
 
@@ -120,6 +157,29 @@ func TestAuthTransportCustomTransport(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestAuthTransportDoesNotRetryWithSameKey(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	transport := AuthTransport{
+		jwtGenerator: &mockJWTGenerator{token: "TEST.TEST.TEST"},
+	}
+
+	resp, err := transport.Client().Get(server.URL) // nolint: noctx
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	resp.Body.Close()
+
+	assert.Equal(t, 1, requests)
+}
+
 type mockJWTGenerator struct {
 	token string
 }
@@ -128,6 +188,14 @@ func (g *mockJWTGenerator) Token() (string, error) {
 	return g.token, nil
 }
 
+func (g *mockJWTGenerator) TokenContext(ctx context.Context) (string, error) {
+	return g.token, nil
+}
+
+func (g *mockJWTGenerator) Invalidate() bool {
+	return false
+}
+
 func (g *mockJWTGenerator) IsValid() bool {
 	return true
 }
@@ -245,65 +245,42 @@ type GetAppEncryptionDeclarationForBuildQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_builds
 func (s *BuildsService) ListBuilds(ctx context.Context, params *ListBuildsQuery) (*BuildsResponse, *Response, error) {
-	res := new(BuildsResponse)
-	resp, err := s.client.get(ctx, "builds", params, res)
-
-	return res, resp, err
+	return Get[BuildsResponse](ctx, s.client, "builds", params)
 }
 
 // ListBuildsForApp gets a list of builds associated with a specific app.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_builds_of_an_app
 func (s *BuildsService) ListBuildsForApp(ctx context.Context, id string, params *ListBuildsForAppQuery) (*BuildsResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/builds", id)
-	res := new(BuildsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildsResponse](ctx, s.client, fmt.Sprintf("apps/%s/builds", id), params)
 }
 
 // GetBuild gets information about a specific build.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_build_information
 func (s *BuildsService) GetBuild(ctx context.Context, id string, params *GetBuildQuery) (*BuildResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s", id)
-	res := new(BuildResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildResponse](ctx, s.client, fmt.Sprintf("builds/%s", id), params)
 }
 
 // GetAppForBuild gets the app information for a specific build.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_build
 func (s *BuildsService) GetAppForBuild(ctx context.Context, id string, params *GetAppForBuildQuery) (*AppResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s/app", id)
-	res := new(AppResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppResponse](ctx, s.client, fmt.Sprintf("builds/%s/app", id), params)
 }
 
 // GetAppStoreVersionForBuild gets the App Store version of a specific build.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_store_version_information_of_a_build
 func (s *BuildsService) GetAppStoreVersionForBuild(ctx context.Context, id string, params *GetAppStoreVersionForBuildQuery) (*AppStoreVersionResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s/appStoreVersion", id)
-	res := new(AppStoreVersionResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppStoreVersionResponse](ctx, s.client, fmt.Sprintf("builds/%s/appStoreVersion", id), params)
 }
 
 // GetBuildForAppStoreVersion gets the build that is attached to a specific App Store version.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_build_information_of_an_app_store_version
 func (s *BuildsService) GetBuildForAppStoreVersion(ctx context.Context, id string, params *GetBuildForAppStoreVersionQuery) (*BuildResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersions/%s/build", id)
-	res := new(BuildResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s/build", id), params)
 }
 
 // UpdateBuild expires a build or changes its encryption exemption setting.
@@ -330,7 +307,7 @@ func (s *BuildsService) UpdateBuild(ctx context.Context, id string, expired *boo
 
 	url := fmt.Sprintf("builds/%s", id)
 	res := new(BuildResponse)
-	resp, err := s.client.post(ctx, url, newRequestBody(req), res)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
 
 	return res, resp, err
 }
@@ -389,33 +366,21 @@ func (s *BuildsService) RemoveAccessForIndividualTestersFromBuild(ctx context.Co
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_resource_ids_of_individual_testers_for_a_build
 func (s *BuildsService) ListResourceIDsForIndividualTestersForBuild(ctx context.Context, id string, params *ListResourceIDsForIndividualTestersForBuildQuery) (*BuildIndividualTestersLinkagesResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s/relationships/individualTesters", id)
-	res := new(BuildIndividualTestersLinkagesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildIndividualTestersLinkagesResponse](ctx, s.client, fmt.Sprintf("builds/%s/relationships/individualTesters", id), params)
 }
 
 // GetAppEncryptionDeclarationForBuild reads an app encryption declaration associated with a specific build.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_encryption_declaration_of_a_build
 func (s *BuildsService) GetAppEncryptionDeclarationForBuild(ctx context.Context, id string, params *GetAppEncryptionDeclarationForBuildQuery) (*AppEncryptionDeclarationResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s/appEncryptionDeclaration", id)
-	res := new(AppEncryptionDeclarationResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppEncryptionDeclarationResponse](ctx, s.client, fmt.Sprintf("builds/%s/appEncryptionDeclaration", id), params)
 }
 
 // GetAppEncryptionDeclarationIDForBuild gets the beta app encryption declaration resource ID associated with a build.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_the_app_encryption_declaration_id_for_a_build
 func (s *BuildsService) GetAppEncryptionDeclarationIDForBuild(ctx context.Context, id string) (*BuildAppEncryptionDeclarationLinkageResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s/relationships/appEncryptionDeclaration", id)
-	res := new(BuildAppEncryptionDeclarationLinkageResponse)
-	resp, err := s.client.get(ctx, url, nil, res)
-
-	return res, resp, err
+	return Get[BuildAppEncryptionDeclarationLinkageResponse](ctx, s.client, fmt.Sprintf("builds/%s/relationships/appEncryptionDeclaration", id), nil)
 }
 
 // UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in BuildResponseIncluded.
@@ -0,0 +1,194 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// AppEncryptionDeclarationDocument defines model for AppEncryptionDeclarationDocument.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationdocument
+type AppEncryptionDeclarationDocument struct {
+	Attributes *AppEncryptionDeclarationDocumentAttributes `json:"attributes,omitempty"`
+	ID         string                                      `json:"id"`
+	Links      ResourceLinks                               `json:"links"`
+	Type       string                                      `json:"type"`
+}
+
+// AppEncryptionDeclarationDocumentAttributes defines model for AppEncryptionDeclarationDocument.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationdocument/attributes
+type AppEncryptionDeclarationDocumentAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	AssetToken         *string             `json:"assetToken,omitempty"`
+	DownloadURL        *string             `json:"downloadUrl,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	SourceFileChecksum *string             `json:"sourceFileChecksum,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// AppEncryptionDeclarationDocumentResponse defines model for AppEncryptionDeclarationDocumentResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationdocumentresponse
+type AppEncryptionDeclarationDocumentResponse struct {
+	Data  AppEncryptionDeclarationDocument `json:"data"`
+	Links DocumentLinks                    `json:"links"`
+}
+
+// appEncryptionDeclarationDocumentCreateRequest defines model for AppEncryptionDeclarationDocumentCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationdocumentcreaterequest/data
+type appEncryptionDeclarationDocumentCreateRequest struct {
+	Attributes    appEncryptionDeclarationDocumentCreateRequestAttributes    `json:"attributes"`
+	Relationships appEncryptionDeclarationDocumentCreateRequestRelationships `json:"relationships"`
+	Type          string                                                     `json:"type"`
+}
+
+// appEncryptionDeclarationDocumentCreateRequestAttributes are attributes for AppEncryptionDeclarationDocumentCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationdocumentcreaterequest/data/attributes
+type appEncryptionDeclarationDocumentCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// appEncryptionDeclarationDocumentCreateRequestRelationships are relationships for AppEncryptionDeclarationDocumentCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationdocumentcreaterequest/data/relationships
+type appEncryptionDeclarationDocumentCreateRequestRelationships struct {
+	AppEncryptionDeclaration relationshipDeclaration `json:"appEncryptionDeclaration"`
+}
+
+// appEncryptionDeclarationDocumentUpdateRequest defines model for AppEncryptionDeclarationDocumentUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationdocumentupdaterequest/data
+type appEncryptionDeclarationDocumentUpdateRequest struct {
+	Attributes *appEncryptionDeclarationDocumentUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                   `json:"id"`
+	Type       string                                                   `json:"type"`
+}
+
+// appEncryptionDeclarationDocumentUpdateRequestAttributes are attributes for AppEncryptionDeclarationDocumentUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationdocumentupdaterequest/data/attributes
+type appEncryptionDeclarationDocumentUpdateRequestAttributes struct {
+	SourceFileChecksum *string `json:"sourceFileChecksum,omitempty"`
+	Uploaded           *bool   `json:"uploaded,omitempty"`
+}
+
+// GetAppEncryptionDeclarationDocumentQuery are query options for GetAppEncryptionDeclarationDocument
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_an_app_encryption_declaration_document
+type GetAppEncryptionDeclarationDocumentQuery struct {
+	FieldsAppEncryptionDeclarationDocuments []string `url:"fields[appEncryptionDeclarationDocuments],omitempty"`
+}
+
+// GetAppEncryptionDeclarationDocument gets an export compliance document and its upload status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_an_app_encryption_declaration_document
+func (s *BuildsService) GetAppEncryptionDeclarationDocument(ctx context.Context, id string, params *GetAppEncryptionDeclarationDocumentQuery) (*AppEncryptionDeclarationDocumentResponse, *Response, error) {
+	return Get[AppEncryptionDeclarationDocumentResponse](ctx, s.client, fmt.Sprintf("appEncryptionDeclarationDocuments/%s", id), params)
+}
+
+// GetDocumentForAppEncryptionDeclaration gets the export compliance document attached to an app encryption declaration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_appencryptiondeclarations_id_appencryptiondeclarationdocument
+func (s *BuildsService) GetDocumentForAppEncryptionDeclaration(ctx context.Context, id string, params *GetAppEncryptionDeclarationDocumentQuery) (*AppEncryptionDeclarationDocumentResponse, *Response, error) {
+	return Get[AppEncryptionDeclarationDocumentResponse](ctx, s.client, fmt.Sprintf("appEncryptionDeclarations/%s/appEncryptionDeclarationDocument", id), params)
+}
+
+// CreateAppEncryptionDeclarationDocument reserves an export compliance document for an app encryption declaration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_encryption_declaration_document
+func (s *BuildsService) CreateAppEncryptionDeclarationDocument(ctx context.Context, fileName string, fileSize int64, appEncryptionDeclarationID string) (*AppEncryptionDeclarationDocumentResponse, *Response, error) {
+	req := appEncryptionDeclarationDocumentCreateRequest{
+		Attributes: appEncryptionDeclarationDocumentCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Relationships: appEncryptionDeclarationDocumentCreateRequestRelationships{
+			AppEncryptionDeclaration: *newRelationshipDeclaration(&appEncryptionDeclarationID, "appEncryptionDeclarations"),
+		},
+		Type: "appEncryptionDeclarationDocuments",
+	}
+	res := new(AppEncryptionDeclarationDocumentResponse)
+	resp, err := s.client.post(ctx, "appEncryptionDeclarationDocuments", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitAppEncryptionDeclarationDocument commits an export compliance document after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/commit_an_app_encryption_declaration_document
+func (s *BuildsService) CommitAppEncryptionDeclarationDocument(ctx context.Context, id string, uploaded *bool, sourceFileChecksum *string) (*AppEncryptionDeclarationDocumentResponse, *Response, error) {
+	req := appEncryptionDeclarationDocumentUpdateRequest{
+		ID:   id,
+		Type: "appEncryptionDeclarationDocuments",
+	}
+
+	if uploaded != nil || sourceFileChecksum != nil {
+		req.Attributes = &appEncryptionDeclarationDocumentUpdateRequestAttributes{
+			SourceFileChecksum: sourceFileChecksum,
+			Uploaded:           uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("appEncryptionDeclarationDocuments/%s", id)
+	res := new(AppEncryptionDeclarationDocumentResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UploadAppEncryptionDeclarationDocument reserves, uploads and commits an export compliance document for an
+// app encryption declaration.
+func (s *BuildsService) UploadAppEncryptionDeclarationDocument(ctx context.Context, fileName string, file io.ReadSeeker, appEncryptionDeclarationID string) (*AppEncryptionDeclarationDocumentResponse, *Response, error) {
+	fileSize, err := sizeOfFile(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation, resp, err := s.CreateAppEncryptionDeclarationDocument(ctx, fileName, fileSize, appEncryptionDeclarationID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var ops []UploadOperation
+	if reservation.Data.Attributes != nil {
+		ops = reservation.Data.Attributes.UploadOperations
+	}
+
+	checksum, err := s.client.UploadWithChecksum(ctx, ops, file)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	res, resp, err := s.CommitAppEncryptionDeclarationDocument(ctx, reservation.Data.ID, Bool(true), &checksum)
+	if err == nil && res.Data.Attributes != nil {
+		err = VerifyAssetChecksum(checksum, res.Data.Attributes.SourceFileChecksum, res.Data.Attributes.AssetDeliveryState)
+	}
+
+	return res, resp, err
+}
@@ -43,6 +43,34 @@ const (
 	AppEncryptionDeclarationStateRejected AppEncryptionDeclarationState = "REJECTED"
 )
 
+var appEncryptionDeclarationStates = []AppEncryptionDeclarationState{
+	AppEncryptionDeclarationStateApproved,
+	AppEncryptionDeclarationStateExpired,
+	AppEncryptionDeclarationStateInvalid,
+	AppEncryptionDeclarationStateInReview,
+	AppEncryptionDeclarationStateRejected,
+}
+
+// IsValid reports whether the app encryption declaration state is one this package knows about.
+func (v AppEncryptionDeclarationState) IsValid() bool {
+	for _, value := range appEncryptionDeclarationStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAppEncryptionDeclarationState returns s as an AppEncryptionDeclarationState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAppEncryptionDeclarationState(s string) (AppEncryptionDeclarationState, error) {
+	if v := AppEncryptionDeclarationState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AppEncryptionDeclarationState", Value: s}
+}
+
 // AppEncryptionDeclaration defines model for AppEncryptionDeclaration.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclaration
@@ -58,6 +86,7 @@ type AppEncryptionDeclaration struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclaration/attributes
 type AppEncryptionDeclarationAttributes struct {
+	AppDescription                  *string                        `json:"appDescription,omitempty"`
 	AppEncryptionDeclarationState   *AppEncryptionDeclarationState `json:"appEncryptionDeclarationState,omitempty"`
 	AvailableOnFrenchStore          *bool                          `json:"availableOnFrenchStore,omitempty"`
 	CodeValue                       *string                        `json:"codeValue,omitempty"`
@@ -76,7 +105,35 @@ type AppEncryptionDeclarationAttributes struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclaration/relationships
 type AppEncryptionDeclarationRelationships struct {
-	App *Relationship `json:"app,omitempty"`
+	App                              *Relationship      `json:"app,omitempty"`
+	AppEncryptionDeclarationDocument *Relationship      `json:"appEncryptionDeclarationDocument,omitempty"`
+	Builds                           *PagedRelationship `json:"builds,omitempty"`
+}
+
+// appEncryptionDeclarationCreateRequest defines model for AppEncryptionDeclarationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationcreaterequest/data
+type appEncryptionDeclarationCreateRequest struct {
+	Attributes    AppEncryptionDeclarationCreateRequestAttributes    `json:"attributes"`
+	Relationships appEncryptionDeclarationCreateRequestRelationships `json:"relationships"`
+	Type          string                                             `json:"type"`
+}
+
+// AppEncryptionDeclarationCreateRequestAttributes are attributes for AppEncryptionDeclarationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationcreaterequest/data/attributes
+type AppEncryptionDeclarationCreateRequestAttributes struct {
+	AppDescription                  string `json:"appDescription"`
+	AvailableOnFrenchStore          bool   `json:"availableOnFrenchStore"`
+	ContainsProprietaryCryptography bool   `json:"containsProprietaryCryptography"`
+	ContainsThirdPartyCryptography  bool   `json:"containsThirdPartyCryptography"`
+}
+
+// appEncryptionDeclarationCreateRequestRelationships are relationships for AppEncryptionDeclarationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appencryptiondeclarationcreaterequest/data/relationships
+type appEncryptionDeclarationCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
 }
 
 // AppEncryptionDeclarationResponse defines model for AppEncryptionDeclarationResponse.
@@ -132,30 +189,38 @@ type GetAppForEncryptionDeclarationQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_app_encryption_declarations
 func (s *BuildsService) ListAppEncryptionDeclarations(ctx context.Context, params *ListAppEncryptionDeclarationsQuery) (*AppEncryptionDeclarationsResponse, *Response, error) {
-	res := new(AppEncryptionDeclarationsResponse)
-	resp, err := s.client.get(ctx, "appEncryptionDeclarations", params, res)
-
-	return res, resp, err
+	return Get[AppEncryptionDeclarationsResponse](ctx, s.client, "appEncryptionDeclarations", params)
 }
 
 // GetAppEncryptionDeclaration gets information about a specific app encryption declaration.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_encryption_declaration_information
 func (s *BuildsService) GetAppEncryptionDeclaration(ctx context.Context, id string, params *GetAppEncryptionDeclarationQuery) (*AppEncryptionDeclarationResponse, *Response, error) {
-	url := fmt.Sprintf("appEncryptionDeclarations/%s", id)
-	res := new(AppEncryptionDeclarationResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppEncryptionDeclarationResponse](ctx, s.client, fmt.Sprintf("appEncryptionDeclarations/%s", id), params)
 }
 
 // GetAppForAppEncryptionDeclaration gets the app information from a specific app encryption declaration.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_an_app_encryption_declaration
 func (s *BuildsService) GetAppForAppEncryptionDeclaration(ctx context.Context, id string, params *GetAppForEncryptionDeclarationQuery) (*AppResponse, *Response, error) {
-	url := fmt.Sprintf("appEncryptionDeclarations/%s/app", id)
-	res := new(AppResponse)
-	resp, err := s.client.get(ctx, url, params, res)
+	return Get[AppResponse](ctx, s.client, fmt.Sprintf("appEncryptionDeclarations/%s/app", id), params)
+}
+
+// CreateAppEncryptionDeclaration files a new export compliance declaration for an app. Supporting documentation
+// can then be attached with UploadAppEncryptionDeclarationDocument, and the declaration assigned to builds with
+// AssignBuildsToAppEncryptionDeclaration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_app_encryption_declaration
+func (s *BuildsService) CreateAppEncryptionDeclaration(ctx context.Context, attributes AppEncryptionDeclarationCreateRequestAttributes, appID string) (*AppEncryptionDeclarationResponse, *Response, error) {
+	req := appEncryptionDeclarationCreateRequest{
+		Attributes: attributes,
+		Relationships: appEncryptionDeclarationCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "appEncryptionDeclarations",
+	}
+	res := new(AppEncryptionDeclarationResponse)
+	resp, err := s.client.post(ctx, "appEncryptionDeclarations", newRequestBody(req), res)
 
 	return res, resp, err
 }
@@ -165,7 +230,7 @@ func (s *BuildsService) GetAppForAppEncryptionDeclaration(ctx context.Context, i
 // https://developer.apple.com/documentation/appstoreconnectapi/assign_builds_to_an_app_encryption_declaration
 func (s *BuildsService) AssignBuildsToAppEncryptionDeclaration(ctx context.Context, id string, buildIDs []string) (*Response, error) {
 	linkages := newPagedRelationshipDeclaration(buildIDs, "builds")
-	url := fmt.Sprintf("appStoreVersionSubmissions/%s", id)
+	url := fmt.Sprintf("appEncryptionDeclarations/%s/relationships/builds", id)
 
 	return s.client.post(ctx, url, newRequestBody(linkages.Data), nil)
 }
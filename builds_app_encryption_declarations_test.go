@@ -23,6 +23,8 @@ package asc
 import (
 	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestListAppEncryptionDeclarations(t *testing.T) {
@@ -56,3 +58,25 @@ func TestAssignBuildsToAppEncryptionDeclaration(t *testing.T) {
 		return client.Builds.AssignBuildsToAppEncryptionDeclaration(ctx, "10", []string{"10"})
 	})
 }
+
+func TestCreateAppEncryptionDeclaration(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppEncryptionDeclarationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Builds.CreateAppEncryptionDeclaration(ctx, AppEncryptionDeclarationCreateRequestAttributes{
+			AppDescription:                  "Uses HTTPS only",
+			ContainsProprietaryCryptography: false,
+		}, "10")
+	})
+}
+
+func TestParseAppEncryptionDeclarationState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAppEncryptionDeclarationState(string(AppEncryptionDeclarationStateApproved))
+	assert.NoError(t, err)
+	assert.Equal(t, AppEncryptionDeclarationStateApproved, got)
+
+	_, err = ParseAppEncryptionDeclarationState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppEncryptionDeclarationState", Value: "UNKNOWN"}, err)
+}
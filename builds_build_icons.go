@@ -43,6 +43,34 @@ const (
 	IconAssetTypeWatchAppStore IconAssetType = "WATCH_APP_STORE"
 )
 
+var iconAssetTypes = []IconAssetType{
+	IconAssetTypeAppStore,
+	IconAssetTypeMessagesAppStore,
+	IconAssetTypeTVOSHomeScreen,
+	IconAssetTypeTVOSTopShelf,
+	IconAssetTypeWatchAppStore,
+}
+
+// IsValid reports whether the icon asset type is one this package knows about.
+func (v IconAssetType) IsValid() bool {
+	for _, value := range iconAssetTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseIconAssetType returns s as an IconAssetType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseIconAssetType(s string) (IconAssetType, error) {
+	if v := IconAssetType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "IconAssetType", Value: s}
+}
+
 // BuildIcon defines model for BuildIcon.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/buildicon
@@ -83,9 +111,5 @@ type ListIconsQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_icons_for_a_build
 func (s *BuildsService) ListIconsForBuild(ctx context.Context, id string, params *ListIconsQuery) (*BuildIconsResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s/icons", id)
-	res := new(BuildIconsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildIconsResponse](ctx, s.client, fmt.Sprintf("builds/%s/icons", id), params)
 }
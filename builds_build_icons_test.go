@@ -23,6 +23,8 @@ package asc
 import (
 	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestListIconsForBuild(t *testing.T) {
@@ -32,3 +34,14 @@ func TestListIconsForBuild(t *testing.T) {
 		return client.Builds.ListIconsForBuild(ctx, "10", &ListIconsQuery{})
 	})
 }
+
+func TestParseIconAssetType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseIconAssetType(string(IconAssetTypeAppStore))
+	assert.NoError(t, err)
+	assert.Equal(t, IconAssetTypeAppStore, got)
+
+	_, err = ParseIconAssetType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "IconAssetType", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,225 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// ClientPoolOptions configures NewClientPool.
+type ClientPoolOptions struct {
+	// MaxConcurrentRequests caps the requests in flight across every client of the pool. Zero means no cap.
+	// A request is in flight until its response body is read to the end or closed, so a Stream callback that
+	// sends another request through the pool waits for a free slot, and never gets one if the cap is 1.
+	MaxConcurrentRequests int
+	// Transport sends the requests of every client whose credentials have no ProxyURL. It defaults to a new
+	// http.Transport, so that clients share its idle connections.
+	Transport http.RoundTripper
+}
+
+// ClientPool caches one client per set of credentials, for jobs that work across many teams. Clients share
+// a transport, or one per proxy, and the pool caps the requests in flight across all of them, so that a
+// nightly job over fifty teams doesn't open fifty times the connections. It is safe for concurrent use.
+type ClientPool struct {
+	mu         sync.Mutex
+	clients    map[string]*Client
+	transport  http.RoundTripper
+	transports map[string]http.RoundTripper
+	slots      chan struct{}
+}
+
+// NewClientPool returns an empty pool. opts may be nil.
+func NewClientPool(opts *ClientPoolOptions) *ClientPool {
+	if opts == nil {
+		opts = &ClientPoolOptions{}
+	}
+
+	transport := opts.Transport
+	if transport == nil {
+		transport = newTransport()
+	}
+
+	p := &ClientPool{
+		clients:    make(map[string]*Client),
+		transports: make(map[string]http.RoundTripper),
+	}
+
+	if opts.MaxConcurrentRequests > 0 {
+		p.slots = make(chan struct{}, opts.MaxConcurrentRequests)
+	}
+
+	p.transport = p.limit(transport)
+
+	return p
+}
+
+// Client returns the client of the credentials, creating it on first use. Clients are keyed by the issuer ID, key
+// ID, base URL and proxy URL of the credentials, so a team with several keys gets a client per key. Call Remove
+// after rotating a key to create the client again.
+func (p *ClientPool) Client(credentials *Credentials) (*Client, error) {
+	key := clientPoolKey(credentials)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if client, ok := p.clients[key]; ok {
+		return client, nil
+	}
+
+	auth, err := credentials.TokenConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	auth.Transport = p.transport
+
+	if credentials.ProxyURL != nil {
+		proxy := credentials.ProxyURL.String()
+		if _, ok := p.transports[proxy]; !ok {
+			p.transports[proxy] = p.limit(&http.Transport{
+				Proxy:           http.ProxyURL(credentials.ProxyURL),
+				IdleConnTimeout: defaultTimeout,
+			})
+		}
+
+		auth.Transport = p.transports[proxy]
+	}
+
+	client := NewClient(auth.Client())
+
+	if credentials.BaseURL != "" {
+		if err := client.SetBaseURL(credentials.BaseURL); err != nil {
+			return nil, err
+		}
+	}
+
+	p.clients[key] = client
+
+	return client, nil
+}
+
+// Remove drops the client of the credentials from the pool, if there is one.
+func (p *ClientPool) Remove(credentials *Credentials) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.clients, clientPoolKey(credentials))
+}
+
+// Len returns the number of clients in the pool.
+func (p *ClientPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.clients)
+}
+
+// CloseIdleConnections closes the idle connections of the transports shared by the clients, such as at the end
+// of a job.
+func (p *ClientPool) CloseIdleConnections() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	closeIdleConnections(p.transport)
+
+	for _, transport := range p.transports {
+		closeIdleConnections(transport)
+	}
+}
+
+func (p *ClientPool) limit(transport http.RoundTripper) http.RoundTripper {
+	if p.slots == nil {
+		return transport
+	}
+
+	return &limitedTransport{transport: transport, slots: p.slots}
+}
+
+func clientPoolKey(credentials *Credentials) string {
+	proxy := ""
+	if credentials.ProxyURL != nil {
+		proxy = credentials.ProxyURL.String()
+	}
+
+	return strings.Join([]string{credentials.IssuerID, credentials.KeyID, credentials.BaseURL, proxy}, "\x00")
+}
+
+func closeIdleConnections(transport http.RoundTripper) {
+	if limited, ok := transport.(*limitedTransport); ok {
+		transport = limited.transport
+	}
+
+	if closer, ok := transport.(interface{ CloseIdleConnections() }); ok {
+		closer.CloseIdleConnections()
+	}
+}
+
+// limitedTransport holds one of its slots from the time a request is sent until its response body is read to the
+// end or closed, waiting for a slot to free up when all are taken.
+type limitedTransport struct {
+	transport http.RoundTripper
+	slots     chan struct{}
+}
+
+func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	select {
+	case t.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		<-t.slots
+
+		return nil, err
+	}
+
+	resp.Body = &slotBody{ReadCloser: resp.Body, release: func() { <-t.slots }}
+
+	return resp, nil
+}
+
+// slotBody frees the slot of its request once it has been read to the end, or when it is closed.
+type slotBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *slotBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil {
+		b.once.Do(b.release)
+	}
+
+	return n, err
+}
+
+func (b *slotBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+
+	return err
+}
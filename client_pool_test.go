@@ -0,0 +1,180 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientPoolCachesClients(t *testing.T) {
+	t.Parallel()
+
+	key := newTestPrivateKey(t)
+	proxy, _ := url.Parse("http://proxy.example.com:3128")
+	pool := NewClientPool(nil)
+
+	a, err := pool.Client(&Credentials{KeyID: "KEY1", IssuerID: "TEAM1", PrivateKey: key})
+	assert.NoError(t, err)
+
+	again, err := pool.Client(&Credentials{KeyID: "KEY1", IssuerID: "TEAM1", PrivateKey: key})
+	assert.NoError(t, err)
+	assert.Same(t, a, again)
+
+	b, err := pool.Client(&Credentials{KeyID: "KEY1", IssuerID: "TEAM2", PrivateKey: key})
+	assert.NoError(t, err)
+	assert.NotSame(t, a, b)
+
+	c, err := pool.Client(&Credentials{KeyID: "KEY2", IssuerID: "TEAM2", PrivateKey: key, ProxyURL: proxy, BaseURL: "https://example.com/v1"})
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/v1/", c.baseURL.String())
+	assert.Equal(t, 3, pool.Len())
+
+	transport := func(client *Client) http.RoundTripper {
+		auth, _ := client.client.Transport.(*AuthTransport)
+
+		return auth.Transport
+	}
+	assert.Same(t, transport(a), transport(b))
+	assert.NotSame(t, transport(a), transport(c))
+
+	sandbox, err := pool.Client(&Credentials{KeyID: "KEY1", IssuerID: "TEAM1", PrivateKey: key, BaseURL: "https://sandbox.example.com/v1"})
+	assert.NoError(t, err)
+	assert.NotSame(t, a, sandbox, "clients of other base URLs are kept apart")
+
+	proxied, err := pool.Client(&Credentials{KeyID: "KEY1", IssuerID: "TEAM1", PrivateKey: key, ProxyURL: proxy})
+	assert.NoError(t, err)
+	assert.NotSame(t, a, proxied, "clients of other proxies are kept apart")
+	assert.Equal(t, 5, pool.Len())
+
+	pool.Remove(&Credentials{KeyID: "KEY1", IssuerID: "TEAM1", BaseURL: "https://sandbox.example.com/v1"})
+	pool.Remove(&Credentials{KeyID: "KEY1", IssuerID: "TEAM1", ProxyURL: proxy})
+
+	_, err = pool.Client(&Credentials{KeyID: "KEY3", IssuerID: "TEAM3"})
+	assert.ErrorIs(t, err, ErrMissingCredentials)
+	assert.Equal(t, 3, pool.Len())
+
+	pool.Remove(&Credentials{KeyID: "KEY1", IssuerID: "TEAM1"})
+	assert.Equal(t, 2, pool.Len())
+
+	replaced, err := pool.Client(&Credentials{KeyID: "KEY1", IssuerID: "TEAM1", PrivateKey: key})
+	assert.NoError(t, err)
+	assert.NotSame(t, a, replaced)
+
+	pool.CloseIdleConnections()
+}
+
+func TestClientPoolMaxConcurrentRequests(t *testing.T) {
+	t.Parallel()
+
+	var inFlight, maxInFlight int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+				break
+			}
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		fmt.Fprint(w, `{"data":[]}`)
+	}))
+	defer server.Close()
+
+	key := newTestPrivateKey(t)
+	pool := NewClientPool(&ClientPoolOptions{MaxConcurrentRequests: 2})
+
+	var wg sync.WaitGroup
+
+	for team := 0; team < 3; team++ {
+		client, err := pool.Client(&Credentials{KeyID: "KEY", IssuerID: fmt.Sprintf("TEAM%d", team), PrivateKey: key, BaseURL: server.URL})
+		assert.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, _, err := client.Apps.ListApps(context.Background(), nil)
+				assert.NoError(t, err)
+			}()
+		}
+	}
+
+	wg.Wait()
+	assert.EqualValues(t, 2, atomic.LoadInt32(&maxInFlight))
+}
+
+func TestLimitedTransportReleasesSlotAtEOF(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"data":[]}`)
+	}))
+	defer server.Close()
+
+	transport := &limitedTransport{transport: http.DefaultTransport, slots: make(chan struct{}, 1)}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	assert.NoError(t, err)
+
+	resp, err := transport.RoundTrip(req)
+	assert.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Len(t, transport.slots, 1)
+
+	_, err = io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Empty(t, transport.slots, "the slot is free once the body is read, before it is closed")
+}
+
+func TestLimitedTransportCanceled(t *testing.T) {
+	t.Parallel()
+
+	transport := &limitedTransport{transport: http.DefaultTransport, slots: make(chan struct{}, 1)}
+	transport.slots <- struct{}{}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://example.com", nil)
+	assert.NoError(t, err)
+
+	_, err = transport.RoundTrip(req) // nolint: bodyclose
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,147 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lingjiawen/asc"
+)
+
+const (
+	capabilityEnabled   = "enabled"
+	capabilityDisabled  = "disabled"
+	capabilityUnchanged = "unchanged"
+)
+
+// capabilityChange is what capability sync did to a capability of the bundle ID.
+type capabilityChange struct {
+	Capability asc.CapabilityType `json:"capability"`
+	Action     string             `json:"action"`
+	ID         string             `json:"id,omitempty"`
+}
+
+// runCapabilitySync enables the capabilities listed on the command line on a bundle ID, and with -prune
+// disables the capabilities it has that aren't listed.
+func runCapabilitySync(ctx context.Context, env *environment, args []string) error {
+	fs := newFlagSet(env, "capability sync")
+	identifier := fs.String("bundle-id", "", "identifier of the bundle ID, such as com.example.app")
+	prune := fs.Bool("prune", false, "disable the capabilities of the bundle ID that aren't listed")
+
+	var names stringList
+	fs.Var(&names, "capability", "type of a capability to enable, such as PUSH_NOTIFICATIONS, can be repeated")
+
+	if err := parseFlags(fs, args, "bundle-id"); err != nil {
+		return err
+	}
+
+	wanted := make([]asc.CapabilityType, 0, len(names))
+
+	for _, name := range names {
+		capabilityType, err := asc.ParseCapabilityType(name)
+		if err != nil {
+			return err
+		}
+
+		wanted = append(wanted, capabilityType)
+	}
+
+	bundleID, err := findBundleID(ctx, env.client, *identifier)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[asc.CapabilityType]string)
+
+	res, _, err := env.client.Provisioning.ListCapabilitiesForBundleID(ctx, bundleID.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	for _, capability := range res.Data {
+		if capability.Attributes != nil && capability.Attributes.CapabilityType != nil {
+			existing[*capability.Attributes.CapabilityType] = capability.ID
+		}
+	}
+
+	changes := make([]capabilityChange, 0, len(wanted))
+	listed := make(map[asc.CapabilityType]bool)
+
+	for _, capabilityType := range wanted {
+		if listed[capabilityType] {
+			continue
+		}
+
+		listed[capabilityType] = true
+
+		if id, ok := existing[capabilityType]; ok {
+			changes = append(changes, capabilityChange{Capability: capabilityType, Action: capabilityUnchanged, ID: id})
+
+			continue
+		}
+
+		created, _, err := env.client.Provisioning.EnableCapability(ctx, capabilityType, nil, bundleID.ID)
+		if err != nil {
+			return fmt.Errorf("enabling %s: %w", capabilityType, err)
+		}
+
+		changes = append(changes, capabilityChange{Capability: capabilityType, Action: capabilityEnabled, ID: created.Data.ID})
+	}
+
+	if *prune {
+		for _, capability := range res.Data {
+			if capability.Attributes == nil || capability.Attributes.CapabilityType == nil || listed[*capability.Attributes.CapabilityType] {
+				continue
+			}
+
+			if _, err := env.client.Provisioning.DisableCapability(ctx, capability.ID); err != nil {
+				return fmt.Errorf("disabling %s: %w", *capability.Attributes.CapabilityType, err)
+			}
+
+			changes = append(changes, capabilityChange{Capability: *capability.Attributes.CapabilityType, Action: capabilityDisabled, ID: capability.ID})
+		}
+	}
+
+	t := table{header: []string{"CAPABILITY", "ACTION", "ID"}}
+	for _, change := range changes {
+		t.rows = append(t.rows, []string{string(change.Capability), change.Action, change.ID})
+	}
+
+	return env.out.print(changes, t)
+}
+
+// findBundleID returns the bundle ID registered with the identifier. The identifier filter of App Store Connect
+// also matches identifiers the given one is a prefix of, so the results are checked again.
+func findBundleID(ctx context.Context, client *asc.Client, identifier string) (*asc.BundleID, error) {
+	res, _, err := client.Provisioning.ListBundleIDs(ctx, &asc.ListBundleIDsQuery{FilterIdentifier: []string{identifier}, Limit: asc.MaxPageLimit})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, bundleID := range res.Data {
+		if bundleID.Attributes != nil && value(bundleID.Attributes.IDentifier) == identifier {
+			return &res.Data[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("no bundle ID is registered with the identifier %s", identifier)
+}
@@ -0,0 +1,89 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lingjiawen/asc"
+	"github.com/lingjiawen/asc/asctest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCapabilitySync(t *testing.T) {
+	t.Parallel()
+
+	server := asctest.NewServer()
+	defer server.Close()
+
+	bundleID := server.Add("bundleIds", map[string]interface{}{"identifier": "com.example.app"}, nil)
+	server.Add("bundleIds", map[string]interface{}{"identifier": "com.example.app.widget"}, nil)
+	pushNotifications := server.Add("bundleIdCapabilities", map[string]interface{}{"capabilityType": "PUSH_NOTIFICATIONS"}, map[string]asc.RelationshipData{"bundleId": {ID: bundleID, Type: "bundleIds"}})
+	server.Add("bundleIdCapabilities", map[string]interface{}{"capabilityType": "GAME_CENTER"}, map[string]asc.RelationshipData{"bundleId": {ID: bundleID, Type: "bundleIds"}})
+
+	stdout, stderr, code := execute(server, "-output", "json", "capability", "sync", "-bundle-id", "com.example.app", "-capability", "PUSH_NOTIFICATIONS", "-capability", "APP_GROUPS", "-prune")
+	assert.Equal(t, 0, code, stderr)
+
+	var changes []capabilityChange
+	decodeOutput(t, stdout, "capabilitySync", &changes)
+	assert.Len(t, changes, 3)
+	assert.Equal(t, capabilityChange{Capability: asc.CapabilityTypePushNotifications, Action: capabilityUnchanged, ID: pushNotifications}, changes[0])
+	assert.Equal(t, asc.CapabilityTypeAppGroups, changes[1].Capability)
+	assert.Equal(t, capabilityEnabled, changes[1].Action)
+	assert.Equal(t, asc.CapabilityTypeGameCenter, changes[2].Capability)
+	assert.Equal(t, capabilityDisabled, changes[2].Action)
+
+	capabilities, _, err := server.Client().Provisioning.ListCapabilitiesForBundleID(context.Background(), bundleID, nil)
+	assert.NoError(t, err)
+	assert.Len(t, capabilities.Data, 2)
+}
+
+func TestCapabilitySyncWithoutPrune(t *testing.T) {
+	t.Parallel()
+
+	server := asctest.NewServer()
+	defer server.Close()
+
+	bundleID := server.Add("bundleIds", map[string]interface{}{"identifier": "com.example.app"}, nil)
+	server.Add("bundleIdCapabilities", map[string]interface{}{"capabilityType": "GAME_CENTER"}, map[string]asc.RelationshipData{"bundleId": {ID: bundleID, Type: "bundleIds"}})
+
+	stdout, stderr, code := execute(server, "capability", "sync", "-bundle-id", "com.example.app", "-capability", "APP_GROUPS")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "APP_GROUPS")
+	assert.NotContains(t, stdout, "GAME_CENTER")
+	assert.Len(t, server.List("bundleIdCapabilities"), 2)
+}
+
+func TestCapabilitySyncErrors(t *testing.T) {
+	t.Parallel()
+
+	server := asctest.NewServer()
+	defer server.Close()
+
+	_, stderr, code := execute(server, "capability", "sync", "-bundle-id", "com.example.app", "-capability", "UNKNOWN")
+	assert.Equal(t, 4, code)
+	assert.Contains(t, stderr, "UNKNOWN")
+
+	_, stderr, code = execute(server, "capability", "sync", "-bundle-id", "com.example.app", "-capability", "APP_GROUPS")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "no bundle ID is registered with the identifier com.example.app")
+}
@@ -0,0 +1,90 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"context"
+
+	"github.com/lingjiawen/asc"
+)
+
+// registeredDevice is the result of devices register.
+type registeredDevice struct {
+	Device  asc.Device `json:"device"`
+	Created bool       `json:"created"`
+}
+
+// runDevicesRegister registers a device for development, unless a device with its UDID is already registered,
+// so it can be run again safely.
+func runDevicesRegister(ctx context.Context, env *environment, args []string) error {
+	fs := newFlagSet(env, "devices register")
+	name := fs.String("name", "", "name of the device")
+	udid := fs.String("udid", "", "UDID of the device")
+	platformName := fs.String("platform", string(asc.BundleIDPlatformiOS), "platform of the device, IOS or MAC_OS")
+
+	if err := parseFlags(fs, args, "name", "udid"); err != nil {
+		return err
+	}
+
+	platform, err := asc.ParseBundleIDPlatform(*platformName)
+	if err != nil {
+		return err
+	}
+
+	existing, _, err := env.client.Provisioning.ListDevices(ctx, &asc.ListDevicesQuery{FilterUDID: []string{*udid}})
+	if err != nil {
+		return err
+	}
+
+	result := registeredDevice{}
+
+	if len(existing.Data) > 0 {
+		result.Device = existing.Data[0]
+	} else {
+		created, _, err := env.client.Provisioning.CreateDevice(ctx, *name, *udid, platform)
+		if err != nil {
+			return err
+		}
+
+		result.Device = created.Data
+		result.Created = true
+	}
+
+	attributes := result.Device.Attributes
+	if attributes == nil {
+		attributes = &asc.DeviceAttributes{}
+	}
+
+	status := "EXISTING"
+	if result.Created {
+		status = "CREATED"
+	}
+
+	devicePlatform := ""
+	if attributes.Platform != nil {
+		devicePlatform = string(*attributes.Platform)
+	}
+
+	return env.out.print(result, table{
+		header: []string{"ID", "NAME", "UDID", "PLATFORM", "STATUS", "RESULT"},
+		rows:   [][]string{{result.Device.ID, value(attributes.Name), value(attributes.UDID), devicePlatform, value(attributes.Status), status}},
+	})
+}
@@ -0,0 +1,49 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/lingjiawen/asc/asctest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDevicesRegister(t *testing.T) {
+	t.Parallel()
+
+	server := asctest.NewServer()
+	defer server.Close()
+
+	stdout, stderr, code := execute(server, "-output", "json", "devices", "register", "-name", "iPhone", "-udid", "00008030-0001")
+	assert.Equal(t, 0, code, stderr)
+
+	var result registeredDevice
+	decodeOutput(t, stdout, "devicesRegister", &result)
+	assert.True(t, result.Created)
+	assert.Equal(t, "00008030-0001", *result.Device.Attributes.UDID)
+	assert.Equal(t, "IOS", string(*result.Device.Attributes.Platform))
+
+	stdout, stderr, code = execute(server, "devices", "register", "-name", "iPhone", "-udid", "00008030-0001")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "EXISTING")
+	assert.Len(t, server.List("devices"), 1)
+}
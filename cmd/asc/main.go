@@ -0,0 +1,257 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Command asc is a command-line client for common App Store Connect workflows, built on package asc.
+//
+// Usage:
+//
+//	asc [-output table|json] <command> <subcommand> [flags]
+//
+// The commands are:
+//
+//	profiles regenerate   recreate a provisioning profile with its bundle ID, certificates and devices
+//	devices register      register a device unless its UDID is already registered
+//	testflight distribute add a build to one or more beta groups of an app
+//	capability sync       enable the listed capabilities of a bundle ID, and optionally disable the rest
+//	reports sales         download and print a Sales and Trends sales report
+//
+// Requests are authenticated with an App Store Connect API key read from the environment: ASC_KEY_ID,
+// ASC_ISSUER_ID and either ASC_PRIVATE_KEY, holding the PEM-encoded key, or ASC_PRIVATE_KEY_PATH, naming the
+// .p8 file it was downloaded as. ASC_BASE_URL points the client at another server, such as a mock. Setting
+// ASC_PROFILE instead reads the key of that profile from a credentials file; see asc.NewClientFromEnv.
+//
+// With -output json, each command writes a single JSON document holding the schemaVersion and kind of its
+// result, such as "devicesRegister", and the result itself as data; see asc.OutputDocument.
+//
+// The exit status tells scripts how a command failed, following asc.ErrorCategoryOf:
+//
+//	0  success
+//	1  any other failure
+//	2  malformed command line
+//	3  AUTH: the API key is missing, invalid or lacks a role
+//	4  VALIDATION: App Store Connect or asc rejected the input
+//	5  CONFLICT: the change conflicts with the state of a resource
+//	6  RATE_LIMIT: the hourly request limit was exceeded, retry later
+//	7  APPLE_OUTAGE: App Store Connect failed with a server error, retry later
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/lingjiawen/asc"
+)
+
+// errUsage is returned when the command line is malformed. The usage has already been printed.
+var errUsage = errors.New("usage")
+
+// exitStatuses are the exit statuses of failures by category. Other failures exit with 1.
+var exitStatuses = map[asc.ErrorCategory]int{
+	asc.ErrorCategoryAuth:        3,
+	asc.ErrorCategoryValidation:  4,
+	asc.ErrorCategoryConflict:    5,
+	asc.ErrorCategoryRateLimit:   6,
+	asc.ErrorCategoryAppleOutage: 7,
+}
+
+// command is a subcommand of asc, such as "profiles regenerate".
+type command struct {
+	name    string
+	summary string
+	run     func(ctx context.Context, env *environment, args []string) error
+}
+
+// environment is what a command runs against.
+type environment struct {
+	client *asc.Client
+	out    *printer
+	stderr io.Writer
+}
+
+var commands = []command{
+	{name: "profiles regenerate", summary: "recreate a provisioning profile with its bundle ID, certificates and devices", run: runProfilesRegenerate},
+	{name: "devices register", summary: "register a device unless its UDID is already registered", run: runDevicesRegister},
+	{name: "testflight distribute", summary: "add a build to one or more beta groups of an app", run: runTestflightDistribute},
+	{name: "capability sync", summary: "enable the listed capabilities of a bundle ID, and optionally disable the rest", run: runCapabilitySync},
+	{name: "reports sales", summary: "download and print a Sales and Trends sales report", run: runReportsSales},
+}
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := run(ctx, os.Args[1:], os.Stdout, os.Stderr, asc.NewClientFromEnv)
+
+	stop()
+	os.Exit(code)
+}
+
+// run executes the command line args and returns the exit status: 0 on success, 2 if the command line is
+// malformed, and the status of the category of the error if the command failed.
+func run(ctx context.Context, args []string, stdout io.Writer, stderr io.Writer, newClient func() (*asc.Client, error)) int {
+	fs := flag.NewFlagSet("asc", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	output := fs.String("output", formatTable, "output format, table or json")
+	fs.Usage = func() { printUsage(stderr, fs) }
+
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return 0
+		}
+
+		return 2
+	}
+
+	out, err := newPrinter(stdout, *output)
+	if err != nil {
+		fmt.Fprintf(stderr, "asc: %v\n", err)
+
+		return 2
+	}
+
+	cmd, rest, ok := findCommand(fs.Args())
+	if !ok {
+		fs.Usage()
+
+		return 2
+	}
+
+	client, err := newClient()
+	if err != nil {
+		fmt.Fprintf(stderr, "asc: %v\n", err)
+
+		return exitStatus(err)
+	}
+
+	out.kind = outputKind(cmd.name)
+	err = cmd.run(ctx, &environment{client: client, out: out, stderr: stderr}, rest)
+
+	switch {
+	case err == nil:
+		return 0
+	case errors.Is(err, flag.ErrHelp):
+		return 0
+	case errors.Is(err, errUsage):
+		return 2
+	default:
+		fmt.Fprintf(stderr, "asc %s: %v\n", cmd.name, err)
+
+		return exitStatus(err)
+	}
+}
+
+// exitStatus returns the exit status of a command that failed with err.
+func exitStatus(err error) int {
+	if status, ok := exitStatuses[asc.ErrorCategoryOf(err)]; ok {
+		return status
+	}
+
+	return 1
+}
+
+// findCommand looks up the command named by the first two arguments and returns it with the remaining arguments.
+func findCommand(args []string) (command, []string, bool) {
+	if len(args) < 2 {
+		return command{}, nil, false
+	}
+
+	name := args[0] + " " + args[1]
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, args[2:], true
+		}
+	}
+
+	return command{}, nil, false
+}
+
+func printUsage(w io.Writer, fs *flag.FlagSet) {
+	fmt.Fprintf(w, "Usage: asc [-output table|json] <command> <subcommand> [flags]\n\nCommands:\n")
+
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-22s %s\n", cmd.name, cmd.summary)
+	}
+
+	fmt.Fprintf(w, "\nFlags:\n")
+	fs.PrintDefaults()
+}
+
+// newFlagSet returns the flag set of a command, which prints its errors and usage to env.
+func newFlagSet(env *environment, name string) *flag.FlagSet {
+	fs := flag.NewFlagSet("asc "+name, flag.ContinueOnError)
+	fs.SetOutput(env.stderr)
+
+	return fs
+}
+
+// parseFlags parses the arguments of a command and checks that the required flags were set.
+func parseFlags(fs *flag.FlagSet, args []string, required ...string) error {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+
+		return errUsage
+	}
+
+	if fs.NArg() > 0 {
+		fmt.Fprintf(fs.Output(), "unexpected arguments: %s\n", strings.Join(fs.Args(), " "))
+		fs.Usage()
+
+		return errUsage
+	}
+
+	for _, name := range required {
+		if f := fs.Lookup(name); f != nil && f.Value.String() == "" {
+			fmt.Fprintf(fs.Output(), "flag -%s is required\n", name)
+			fs.Usage()
+
+			return errUsage
+		}
+	}
+
+	return nil
+}
+
+// stringList is a flag that can be repeated, collecting every value it is given.
+type stringList []string
+
+func (l *stringList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *stringList) Set(value string) error {
+	*l = append(*l, value)
+
+	return nil
+}
+
+// value returns the string pointed to by s, or an empty string if s is nil.
+func value(s *string) string {
+	if s == nil {
+		return ""
+	}
+
+	return *s
+}
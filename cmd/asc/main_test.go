@@ -0,0 +1,144 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/lingjiawen/asc"
+	"github.com/lingjiawen/asc/asctest"
+	"github.com/stretchr/testify/assert"
+)
+
+// execute runs the command line args against the fake server and returns what was written to stdout and stderr,
+// and the exit status.
+func execute(server *asctest.Server, args ...string) (string, string, int) {
+	var stdout, stderr bytes.Buffer
+
+	code := run(context.Background(), args, &stdout, &stderr, func() (*asc.Client, error) {
+		return server.Client(), nil
+	})
+
+	return stdout.String(), stderr.String(), code
+}
+
+// decodeOutput checks that stdout is a JSON document of the given kind and decodes its data into v.
+func decodeOutput(t *testing.T, stdout string, kind string, v interface{}) {
+	t.Helper()
+
+	var doc asc.OutputDocument
+	assert.NoError(t, json.Unmarshal([]byte(stdout), &doc))
+	assert.Equal(t, asc.OutputSchemaVersion, doc.SchemaVersion)
+	assert.Equal(t, kind, doc.Kind)
+	assert.NoError(t, json.Unmarshal(doc.Data, v))
+}
+
+func TestRunUsage(t *testing.T) {
+	t.Parallel()
+
+	server := asctest.NewServer()
+	defer server.Close()
+
+	_, stderr, code := execute(server)
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "profiles regenerate")
+	assert.Contains(t, stderr, "reports sales")
+
+	_, _, code = execute(server, "profiles", "unknown")
+	assert.Equal(t, 2, code)
+
+	_, _, code = execute(server, "-h")
+	assert.Equal(t, 0, code)
+
+	_, stderr, code = execute(server, "-output", "yaml", "devices", "register")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, `unknown output format "yaml"`)
+
+	_, stderr, code = execute(server, "devices", "register", "-name", "iPhone")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "flag -udid is required")
+
+	_, stderr, code = execute(server, "devices", "register", "-name", "iPhone", "-udid", "1", "extra")
+	assert.Equal(t, 2, code)
+	assert.Contains(t, stderr, "unexpected arguments: extra")
+}
+
+func TestRunClientError(t *testing.T) {
+	t.Parallel()
+
+	var stdout, stderr bytes.Buffer
+
+	code := run(context.Background(), []string{"devices", "register", "-name", "iPhone", "-udid", "1"}, &stdout, &stderr, func() (*asc.Client, error) {
+		return nil, errors.New("no key")
+	})
+	assert.Equal(t, 1, code)
+	assert.Equal(t, "asc: no key\n", stderr.String())
+
+	code = run(context.Background(), []string{"devices", "register", "-name", "iPhone", "-udid", "1"}, &stdout, &stderr, func() (*asc.Client, error) {
+		return nil, fmt.Errorf("%w: ASC_KEY_ID is not set", asc.ErrMissingCredentials)
+	})
+	assert.Equal(t, 3, code)
+}
+
+func TestExitStatus(t *testing.T) {
+	t.Parallel()
+
+	for status, want := range map[int]int{
+		http.StatusUnauthorized:       3,
+		http.StatusNotFound:           4,
+		http.StatusConflict:           5,
+		http.StatusTooManyRequests:    6,
+		http.StatusServiceUnavailable: 7,
+	} {
+		err := fmt.Errorf("registering device: %w", &asc.ErrorResponse{Response: &http.Response{StatusCode: status}})
+		assert.Equal(t, want, exitStatus(err), status)
+	}
+
+	assert.Equal(t, 1, exitStatus(errors.New("connection reset")))
+}
+
+func TestRunCommandError(t *testing.T) {
+	t.Parallel()
+
+	server := asctest.NewServer()
+	defer server.Close()
+
+	_, stderr, code := execute(server, "devices", "register", "-name", "iPhone", "-udid", "1", "-platform", "ANDROID")
+	assert.Equal(t, 4, code)
+	assert.Contains(t, stderr, "asc devices register: ")
+}
+
+func TestStringList(t *testing.T) {
+	t.Parallel()
+
+	var l stringList
+
+	assert.NoError(t, l.Set("a"))
+	assert.NoError(t, l.Set("b"))
+	assert.Equal(t, stringList{"a", "b"}, l)
+	assert.Equal(t, "a,b", l.String())
+}
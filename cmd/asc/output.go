@@ -0,0 +1,97 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/lingjiawen/asc"
+)
+
+const (
+	formatTable = "table"
+	formatJSON  = "json"
+)
+
+// table is the tabular form of a command's result.
+type table struct {
+	header []string
+	rows   [][]string
+}
+
+// printer writes the results of commands in the output format chosen on the command line.
+type printer struct {
+	w      io.Writer
+	format string
+	// kind names the result in JSON documents, such as "devicesRegister".
+	kind string
+}
+
+func newPrinter(w io.Writer, format string) (*printer, error) {
+	switch format {
+	case formatTable, formatJSON:
+		return &printer{w: w, format: format}, nil
+	default:
+		return nil, fmt.Errorf("unknown output format %q, expected %s or %s", format, formatTable, formatJSON)
+	}
+}
+
+// print writes v as an indented JSON document in the envelope of asc.WriteOutput, or t as columns aligned
+// with tabs.
+func (p *printer) print(v interface{}, t table) error {
+	if p.format == formatJSON {
+		data, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(asc.OutputDocument{SchemaVersion: asc.OutputSchemaVersion, Kind: p.kind, Data: data})
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 4, 2, ' ', 0)
+
+	if len(t.header) > 0 {
+		fmt.Fprintln(tw, strings.Join(t.header, "\t"))
+	}
+
+	for _, row := range t.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+// outputKind returns the kind of the results of a command, such as "devicesRegister" for "devices register".
+func outputKind(name string) string {
+	words := strings.Fields(name)
+	for i := 1; i < len(words); i++ {
+		words[i] = strings.ToUpper(words[i][:1]) + words[i][1:]
+	}
+
+	return strings.Join(words, "")
+}
@@ -0,0 +1,70 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrinterTable(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	p, err := newPrinter(&buf, formatTable)
+	assert.NoError(t, err)
+
+	err = p.print(nil, table{header: []string{"ID", "NAME"}, rows: [][]string{{"1", "iPhone"}, {"10", "iPad"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "ID  NAME\n1   iPhone\n10  iPad\n", buf.String())
+}
+
+func TestPrinterJSON(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	p, err := newPrinter(&buf, formatJSON)
+	assert.NoError(t, err)
+
+	p.kind = "devicesList"
+
+	err = p.print(map[string]string{"id": "1"}, table{header: []string{"ID"}, rows: [][]string{{"1"}}})
+	assert.NoError(t, err)
+	assert.Equal(t, "{\n  \"schemaVersion\": 1,\n  \"kind\": \"devicesList\",\n  \"data\": {\n    \"id\": \"1\"\n  }\n}\n", buf.String())
+}
+
+func TestOutputKind(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "devicesRegister", outputKind("devices register"))
+	assert.Equal(t, "testflightDistribute", outputKind("testflight distribute"))
+}
+
+func TestNewPrinterUnknownFormat(t *testing.T) {
+	t.Parallel()
+
+	_, err := newPrinter(nil, "yaml")
+	assert.EqualError(t, err, `unknown output format "yaml", expected table or json`)
+}
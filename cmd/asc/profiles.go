@@ -0,0 +1,221 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lingjiawen/asc"
+)
+
+// regeneratedProfile is the result of profiles regenerate.
+type regeneratedProfile struct {
+	Profile        asc.Profile `json:"profile"`
+	PreviousID     string      `json:"previousId"`
+	BundleID       string      `json:"bundleId"`
+	CertificateIDs []string    `json:"certificateIds"`
+	DeviceIDs      []string    `json:"deviceIds"`
+}
+
+// runProfilesRegenerate deletes a provisioning profile and creates it again with the same name, type, bundle
+// ID, certificates and devices, for instance after a certificate expired or a device was registered.
+func runProfilesRegenerate(ctx context.Context, env *environment, args []string) error {
+	fs := newFlagSet(env, "profiles regenerate")
+	name := fs.String("name", "", "name of the profile to regenerate")
+	allDevices := fs.Bool("all-devices", false, "include every enabled device of the profile's platform, not only the devices it already had")
+
+	if err := parseFlags(fs, args, "name"); err != nil {
+		return err
+	}
+
+	profile, err := findProfile(ctx, env.client, *name)
+	if err != nil {
+		return err
+	}
+
+	bundleID, _, err := env.client.Provisioning.GetBundleIDForProfile(ctx, profile.ID, nil)
+	if err != nil {
+		return err
+	}
+
+	certificateIDs, err := listProfileCertificateIDs(ctx, env.client, profile.ID)
+	if err != nil {
+		return err
+	}
+
+	var deviceIDs []string
+	if *allDevices && profileTakesDevices(profile) {
+		deviceIDs, err = listEnabledDeviceIDs(ctx, env.client, profile.Attributes.Platform)
+	} else {
+		deviceIDs, err = listProfileDeviceIDs(ctx, env.client, profile.ID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := env.client.Provisioning.DeleteProfile(ctx, profile.ID); err != nil {
+		return err
+	}
+
+	created, _, err := env.client.Provisioning.CreateProfile(ctx, *name, value(profile.Attributes.ProfileType), bundleID.Data.ID, certificateIDs, deviceIDs)
+	if err != nil {
+		return fmt.Errorf("profile %s was deleted but could not be created again: %w", profile.ID, err)
+	}
+
+	result := regeneratedProfile{
+		Profile:        created.Data,
+		PreviousID:     profile.ID,
+		BundleID:       bundleID.Data.ID,
+		CertificateIDs: certificateIDs,
+		DeviceIDs:      deviceIDs,
+	}
+
+	attributes := created.Data.Attributes
+	if attributes == nil {
+		attributes = &asc.ProfileAttributes{}
+	}
+
+	expires := ""
+	if attributes.ExpirationDate != nil {
+		expires = attributes.ExpirationDate.Format("2006-01-02")
+	}
+
+	return env.out.print(result, table{
+		header: []string{"ID", "NAME", "TYPE", "STATE", "EXPIRES", "CERTIFICATES", "DEVICES"},
+		rows: [][]string{{
+			created.Data.ID,
+			value(attributes.Name),
+			value(attributes.ProfileType),
+			value(attributes.ProfileState),
+			expires,
+			strconv.Itoa(len(certificateIDs)),
+			strconv.Itoa(len(deviceIDs)),
+		}},
+	})
+}
+
+// findProfile returns the only profile named name.
+func findProfile(ctx context.Context, client *asc.Client, name string) (*asc.Profile, error) {
+	var matches []asc.Profile
+
+	err := asc.ForEachPage(func(cursor string) (*asc.PagedDocumentLinks, error) {
+		res, _, err := client.Provisioning.ListProfiles(ctx, &asc.ListProfilesQuery{FilterName: []string{name}, Limit: asc.MaxPageLimit, Cursor: cursor})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, profile := range res.Data {
+			if profile.Attributes != nil && value(profile.Attributes.Name) == name {
+				matches = append(matches, profile)
+			}
+		}
+
+		return &res.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no profile is named %q", name)
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, fmt.Errorf("%d profiles are named %q", len(matches), name)
+	}
+}
+
+// profileTakesDevices reports whether the type of the profile, such as IOS_APP_DEVELOPMENT or
+// IOS_APP_ADHOC, lists the devices it may be installed on.
+func profileTakesDevices(profile *asc.Profile) bool {
+	profileType := value(profile.Attributes.ProfileType)
+
+	return strings.HasSuffix(profileType, "_DEVELOPMENT") || strings.HasSuffix(profileType, "_ADHOC")
+}
+
+func listProfileCertificateIDs(ctx context.Context, client *asc.Client, id string) ([]string, error) {
+	var ids []string
+
+	err := asc.ForEachPage(func(cursor string) (*asc.PagedDocumentLinks, error) {
+		res, _, err := client.Provisioning.ListCertificatesInProfile(ctx, id, &asc.ListCertificatesForProfileQuery{Limit: asc.MaxPageLimit, Cursor: cursor})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, certificate := range res.Data {
+			ids = append(ids, certificate.ID)
+		}
+
+		return &res.Links, nil
+	})
+
+	return ids, err
+}
+
+func listProfileDeviceIDs(ctx context.Context, client *asc.Client, id string) ([]string, error) {
+	var ids []string
+
+	err := asc.ForEachPage(func(cursor string) (*asc.PagedDocumentLinks, error) {
+		res, _, err := client.Provisioning.ListDevicesInProfile(ctx, id, &asc.ListDevicesInProfileQuery{Limit: asc.MaxPageLimit, Cursor: cursor})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, device := range res.Data {
+			ids = append(ids, device.ID)
+		}
+
+		return &res.Links, nil
+	})
+
+	return ids, err
+}
+
+func listEnabledDeviceIDs(ctx context.Context, client *asc.Client, platform *asc.BundleIDPlatform) ([]string, error) {
+	query := &asc.ListDevicesQuery{FilterStatus: []string{"ENABLED"}, Limit: asc.MaxPageLimit}
+	if platform != nil {
+		query.FilterPlatform = []string{string(*platform)}
+	}
+
+	var ids []string
+
+	err := asc.ForEachPage(func(cursor string) (*asc.PagedDocumentLinks, error) {
+		query.Cursor = cursor
+
+		res, _, err := client.Provisioning.ListDevices(ctx, query)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, device := range res.Data {
+			ids = append(ids, device.ID)
+		}
+
+		return &res.Links, nil
+	})
+
+	return ids, err
+}
@@ -0,0 +1,110 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/lingjiawen/asc"
+	"github.com/lingjiawen/asc/asctest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProfilesRegenerate(t *testing.T) {
+	t.Parallel()
+
+	server := asctest.NewServer()
+	defer server.Close()
+
+	bundleID := server.Add("bundleIds", map[string]interface{}{"identifier": "com.example.app"}, nil)
+	certificate := server.Add("certificates", map[string]interface{}{"name": "Development"}, nil)
+	device := server.Add("devices", map[string]interface{}{"name": "iPhone", "platform": "IOS", "status": "ENABLED"}, nil)
+	server.Add("devices", map[string]interface{}{"name": "iPad", "platform": "IOS", "status": "ENABLED"}, nil)
+	profile := server.Add("profiles", map[string]interface{}{"name": "App Development", "profileType": "IOS_APP_DEVELOPMENT", "platform": "IOS"}, map[string]asc.RelationshipData{
+		"bundleId": {ID: bundleID, Type: "bundleIds"},
+	})
+	server.Add("profiles", map[string]interface{}{"name": "App Store", "profileType": "IOS_APP_STORE"}, nil)
+	assert.NoError(t, server.Link("profiles", profile, "certificates", asc.RelationshipData{ID: certificate, Type: "certificates"}))
+	assert.NoError(t, server.Link("profiles", profile, "devices", asc.RelationshipData{ID: device, Type: "devices"}))
+
+	stdout, stderr, code := execute(server, "-output", "json", "profiles", "regenerate", "-name", "App Development")
+	assert.Equal(t, 0, code, stderr)
+
+	var result regeneratedProfile
+	decodeOutput(t, stdout, "profilesRegenerate", &result)
+	assert.Equal(t, profile, result.PreviousID)
+	assert.Equal(t, bundleID, result.BundleID)
+	assert.Equal(t, []string{certificate}, result.CertificateIDs)
+	assert.Equal(t, []string{device}, result.DeviceIDs)
+
+	_, ok := server.Get("profiles", profile)
+	assert.False(t, ok)
+
+	created, ok := server.Get("profiles", result.Profile.ID)
+	assert.True(t, ok)
+	assert.Equal(t, "App Development", created.Attributes["name"])
+	assert.Equal(t, "IOS_APP_DEVELOPMENT", created.Attributes["profileType"])
+	assert.Equal(t, bundleID, created.ToOne["bundleId"].ID)
+	assert.Len(t, created.ToMany["devices"], 1)
+
+	stdout, stderr, code = execute(server, "-output", "json", "profiles", "regenerate", "-name", "App Development", "-all-devices")
+	assert.Equal(t, 0, code, stderr)
+	decodeOutput(t, stdout, "profilesRegenerate", &result)
+	assert.Len(t, result.DeviceIDs, 2)
+
+	stdout, stderr, code = execute(server, "profiles", "regenerate", "-name", "App Development")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "IOS_APP_DEVELOPMENT")
+}
+
+func TestProfilesRegenerateNotFound(t *testing.T) {
+	t.Parallel()
+
+	server := asctest.NewServer()
+	defer server.Close()
+
+	server.Add("profiles", map[string]interface{}{"name": "App Development Old"}, nil)
+
+	_, stderr, code := execute(server, "profiles", "regenerate", "-name", "App Development")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `no profile is named "App Development"`)
+
+	server.Add("profiles", map[string]interface{}{"name": "App Development"}, nil)
+	server.Add("profiles", map[string]interface{}{"name": "App Development"}, nil)
+
+	_, stderr, code = execute(server, "profiles", "regenerate", "-name", "App Development")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, `2 profiles are named "App Development"`)
+}
+
+func TestProfileTakesDevices(t *testing.T) {
+	t.Parallel()
+
+	for profileType, expected := range map[string]bool{
+		"IOS_APP_DEVELOPMENT": true,
+		"IOS_APP_ADHOC":       true,
+		"IOS_APP_STORE":       false,
+		"MAC_APP_DIRECT":      false,
+	} {
+		profile := &asc.Profile{Attributes: &asc.ProfileAttributes{ProfileType: asc.String(profileType)}}
+		assert.Equal(t, expected, profileTakesDevices(profile), profileType)
+	}
+}
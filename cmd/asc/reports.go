@@ -0,0 +1,72 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/lingjiawen/asc"
+)
+
+// runReportsSales downloads a sales report from Sales and Trends and prints its rows.
+func runReportsSales(ctx context.Context, env *environment, args []string) error {
+	fs := newFlagSet(env, "reports sales")
+	vendor := fs.String("vendor", "", "vendor number of the account")
+	date := fs.String("date", "", "date of the report, such as 2024-01-31 for a daily report or 2024-01 for a monthly one; the latest report if empty")
+	frequency := fs.String("frequency", string(asc.SalesReportFrequencyDaily), "frequency of the report, DAILY, WEEKLY, MONTHLY or YEARLY")
+	subType := fs.String("subtype", string(asc.SalesReportSubTypeSummary), "subtype of the report, such as SUMMARY or SUMMARY_TERRITORY")
+
+	if err := parseFlags(fs, args, "vendor"); err != nil {
+		return err
+	}
+
+	query, err := asc.NewSalesReportQuery(*vendor, asc.SalesReportTypeSales, asc.SalesReportSubType(*subType), asc.SalesReportFrequency(*frequency), *date)
+	if err != nil {
+		return err
+	}
+
+	rows, _, err := env.client.Reporting.GetSalesReport(ctx, query)
+	if err != nil {
+		return err
+	}
+
+	if rows == nil {
+		rows = []asc.SalesReportRow{}
+	}
+
+	t := table{header: []string{"BEGIN", "END", "SKU", "TITLE", "COUNTRY", "UNITS", "PROCEEDS", "CURRENCY"}}
+
+	for _, row := range rows {
+		t.rows = append(t.rows, []string{
+			row.BeginDate.Format("2006-01-02"),
+			row.EndDate.Format("2006-01-02"),
+			row.SKU,
+			row.Title,
+			row.CountryCode,
+			strconv.FormatFloat(row.Units, 'f', -1, 64),
+			strconv.FormatFloat(row.DeveloperProceeds, 'f', 2, 64),
+			row.CurrencyOfProceeds,
+		})
+	}
+
+	return env.out.print(rows, t)
+}
@@ -0,0 +1,101 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/lingjiawen/asc"
+	"github.com/stretchr/testify/assert"
+)
+
+const testSalesReport = "Provider\tProvider Country\tSKU\tDeveloper\tTitle\tVersion\tProduct Type Identifier\tUnits\tDeveloper Proceeds\tBegin Date\tEnd Date\tCustomer Currency\tCountry Code\tCurrency of Proceeds\tApple Identifier\n" +
+	"APPLE\tUS\tcom.example.app\tExample\tExample App\t1.0\t1F\t3\t1.4\t01/31/2024\t01/31/2024\tEUR\tDE\tEUR\t1\n"
+
+func executeReports(t *testing.T, args ...string) (string, string, int, *http.Request) {
+	t.Helper()
+
+	var request *http.Request
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request = r
+
+		w.Header().Set("Content-Type", "application/a-gzip")
+
+		gz := gzip.NewWriter(w)
+		_, _ = gz.Write([]byte(testSalesReport))
+		_ = gz.Close()
+	}))
+	defer server.Close()
+
+	var stdout, stderr bytes.Buffer
+
+	code := run(context.Background(), args, &stdout, &stderr, func() (*asc.Client, error) {
+		client := asc.NewClient(server.Client())
+
+		return client, client.SetBaseURL(server.URL + "/v1")
+	})
+
+	return stdout.String(), stderr.String(), code, request
+}
+
+func TestReportsSales(t *testing.T) {
+	t.Parallel()
+
+	stdout, stderr, code, request := executeReports(t, "reports", "sales", "-vendor", "12345678", "-date", "2024-01-31")
+	assert.Equal(t, 0, code, stderr)
+	assert.Equal(t, "/v1/salesReports", request.URL.Path)
+	assert.Equal(t, "DAILY", request.URL.Query().Get("filter[frequency]"))
+	assert.Equal(t, "SALES", request.URL.Query().Get("filter[reportType]"))
+	assert.Equal(t, "SUMMARY", request.URL.Query().Get("filter[reportSubType]"))
+	assert.Equal(t, "1_0", request.URL.Query().Get("filter[version]"))
+	assert.Equal(t, "12345678", request.URL.Query().Get("filter[vendorNumber]"))
+	assert.Equal(t, "2024-01-31", request.URL.Query().Get("filter[reportDate]"))
+	assert.Equal(t, "BEGIN       END         SKU              TITLE        COUNTRY  UNITS  PROCEEDS  CURRENCY\n"+
+		"2024-01-31  2024-01-31  com.example.app  Example App  DE       3      1.40      EUR\n", stdout)
+}
+
+func TestReportsSalesJSON(t *testing.T) {
+	t.Parallel()
+
+	stdout, stderr, code, _ := executeReports(t, "-output", "json", "reports", "sales", "-vendor", "12345678", "-frequency", "WEEKLY", "-subtype", "SUMMARY_TERRITORY")
+	assert.Equal(t, 0, code, stderr)
+
+	var rows []asc.SalesReportRow
+	decodeOutput(t, stdout, "reportsSales", &rows)
+	assert.Len(t, rows, 1)
+	assert.Equal(t, "com.example.app", rows[0].SKU)
+	assert.Equal(t, 3.0, rows[0].Units)
+}
+
+func TestReportsSalesInvalid(t *testing.T) {
+	t.Parallel()
+
+	_, stderr, code, request := executeReports(t, "reports", "sales", "-vendor", "12345678", "-subtype", "OPT_IN")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "OPT_IN reports are published WEEKLY, not DAILY")
+	assert.Nil(t, request)
+}
@@ -0,0 +1,99 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/lingjiawen/asc"
+)
+
+// distributedGroup is a beta group a build was added to by testflight distribute.
+type distributedGroup struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	BuildID string `json:"buildId"`
+}
+
+// runTestflightDistribute adds a build to beta groups of its app, found by name. No group is changed unless
+// every group named on the command line exists.
+func runTestflightDistribute(ctx context.Context, env *environment, args []string) error {
+	fs := newFlagSet(env, "testflight distribute")
+	appID := fs.String("app", "", "ID of the app")
+	buildID := fs.String("build", "", "ID of the build to distribute")
+
+	var groups stringList
+	fs.Var(&groups, "group", "name of a beta group to add the build to, can be repeated")
+
+	if err := parseFlags(fs, args, "app", "build", "group"); err != nil {
+		return err
+	}
+
+	byName := make(map[string]asc.BetaGroup)
+
+	err := asc.ForEachPage(func(cursor string) (*asc.PagedDocumentLinks, error) {
+		res, _, err := env.client.TestFlight.ListBetaGroupsForApp(ctx, *appID, &asc.ListBetaGroupsForAppQuery{Limit: asc.MaxPageLimit, Cursor: cursor})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range res.Data {
+			if group.Attributes != nil {
+				byName[value(group.Attributes.Name)] = group
+			}
+		}
+
+		return &res.Links, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var missing []string
+
+	for _, name := range groups {
+		if _, ok := byName[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("app %s has no beta group named %s", *appID, strings.Join(missing, ", "))
+	}
+
+	result := make([]distributedGroup, 0, len(groups))
+	t := table{header: []string{"GROUP", "ID", "BUILD"}}
+
+	for _, name := range groups {
+		group := byName[name]
+
+		if _, err := env.client.TestFlight.AddBuildsToBetaGroup(ctx, group.ID, []string{*buildID}); err != nil {
+			return fmt.Errorf("adding build %s to %s: %w", *buildID, name, err)
+		}
+
+		result = append(result, distributedGroup{ID: group.ID, Name: name, BuildID: *buildID})
+		t.rows = append(t.rows, []string{name, group.ID, *buildID})
+	}
+
+	return env.out.print(result, t)
+}
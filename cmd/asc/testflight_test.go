@@ -0,0 +1,69 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package main
+
+import (
+	"testing"
+
+	"github.com/lingjiawen/asc"
+	"github.com/lingjiawen/asc/asctest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTestflightDistribute(t *testing.T) {
+	t.Parallel()
+
+	server := asctest.NewServer()
+	defer server.Close()
+
+	app := server.Add("apps", nil, nil)
+	build := server.Add("builds", nil, map[string]asc.RelationshipData{"app": {ID: app, Type: "apps"}})
+	internal := server.Add("betaGroups", map[string]interface{}{"name": "Internal"}, map[string]asc.RelationshipData{"app": {ID: app, Type: "apps"}})
+	external := server.Add("betaGroups", map[string]interface{}{"name": "External"}, map[string]asc.RelationshipData{"app": {ID: app, Type: "apps"}})
+	server.Add("betaGroups", map[string]interface{}{"name": "Other"}, map[string]asc.RelationshipData{"app": {ID: app, Type: "apps"}})
+
+	stdout, stderr, code := execute(server, "testflight", "distribute", "-app", app, "-build", build, "-group", "Internal", "-group", "External")
+	assert.Equal(t, 0, code, stderr)
+	assert.Contains(t, stdout, "Internal")
+	assert.Contains(t, stdout, "External")
+
+	for _, id := range []string{internal, external} {
+		group, _ := server.Get("betaGroups", id)
+		assert.Equal(t, []asc.RelationshipData{{ID: build, Type: "builds"}}, group.ToMany["builds"])
+	}
+}
+
+func TestTestflightDistributeMissingGroup(t *testing.T) {
+	t.Parallel()
+
+	server := asctest.NewServer()
+	defer server.Close()
+
+	app := server.Add("apps", nil, nil)
+	internal := server.Add("betaGroups", map[string]interface{}{"name": "Internal"}, map[string]asc.RelationshipData{"app": {ID: app, Type: "apps"}})
+
+	_, stderr, code := execute(server, "testflight", "distribute", "-app", app, "-build", "1", "-group", "Internal", "-group", "External")
+	assert.Equal(t, 1, code)
+	assert.Contains(t, stderr, "has no beta group named External")
+
+	group, _ := server.Get("betaGroups", internal)
+	assert.Empty(t, group.ToMany["builds"])
+}
@@ -0,0 +1,341 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxTokenLifetime is the longest App Store Connect accepts a token to stay valid for.
+const MaxTokenLifetime = 20 * time.Minute
+
+// DefaultProfile is the profile of a credentials file used when no profile is selected.
+const DefaultProfile = "default"
+
+var (
+	// ErrMissingCredentials happens when the key ID, issuer ID or private key of an API key is not configured.
+	ErrMissingCredentials = errors.New("missing API key credentials")
+	// ErrInvalidCredentials happens when credentials or a credentials file cannot be parsed.
+	ErrInvalidCredentials = errors.New("invalid API key credentials")
+	// ErrUnknownProfile happens when a credentials file has no profile with the selected name.
+	ErrUnknownProfile = errors.New("unknown credentials profile")
+)
+
+// Credentials describe an App Store Connect API key, and how clients authenticated with it connect.
+type Credentials struct {
+	KeyID    string
+	IssuerID string
+	// PrivateKey is the PEM-encoded contents of the .p8 file. If it is empty, the key is read from PrivateKeyPath.
+	PrivateKey     []byte
+	PrivateKeyPath string
+	// TokenLifetime is how long each signed token stays valid. It defaults to, and may not exceed,
+	// MaxTokenLifetime.
+	TokenLifetime time.Duration
+	// ProxyURL is the HTTP proxy requests are sent through. If it is nil, no proxy is used.
+	ProxyURL *url.URL
+	// BaseURL overrides the URL of the API, such as for a mock server.
+	BaseURL string
+}
+
+// credentialsFile is the format of a credentials file, with one set of credentials per named profile.
+type credentialsFile struct {
+	Profiles map[string]credentialsProfile `json:"profiles"`
+}
+
+type credentialsProfile struct {
+	KeyID          string `json:"keyId"`
+	IssuerID       string `json:"issuerId"`
+	PrivateKey     string `json:"privateKey,omitempty"`
+	PrivateKeyPath string `json:"privateKeyPath,omitempty"`
+	TokenLifetime  string `json:"tokenLifetime,omitempty"`
+	Proxy          string `json:"proxy,omitempty"`
+	BaseURL        string `json:"baseUrl,omitempty"`
+}
+
+// NewClientFromEnv returns a client authenticated with the API key described by the environment:
+//
+//	ASC_KEY_ID            the key ID
+//	ASC_ISSUER_ID         the issuer ID
+//	ASC_PRIVATE_KEY       the contents of the .p8 file, or
+//	ASC_PRIVATE_KEY_PATH  the path of the .p8 file
+//	ASC_TOKEN_LIFETIME    how long each token stays valid, such as 10m (optional)
+//	ASC_PROXY_URL         the URL of an HTTP proxy (optional)
+//	ASC_BASE_URL          the URL of the API (optional)
+//
+// If ASC_PROFILE is set, the variables are ignored, and the credentials of that profile are read from the
+// credentials file at ASC_CREDENTIALS_FILE, or DefaultCredentialsFile if it is not set.
+func NewClientFromEnv() (*Client, error) {
+	credentials, err := credentialsFromEnv(os.Getenv)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewClient()
+}
+
+// NewClientFromCredentialsFile returns a client authenticated with the credentials of a profile in a
+// credentials file. See ReadCredentialsFile for its format.
+func NewClientFromCredentialsFile(path string, profile string) (*Client, error) {
+	credentials, err := ReadCredentialsFile(path, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewClient()
+}
+
+func credentialsFromEnv(getenv func(string) string) (*Credentials, error) {
+	if profile := getenv("ASC_PROFILE"); profile != "" {
+		path := getenv("ASC_CREDENTIALS_FILE")
+		if path == "" {
+			var err error
+			if path, err = DefaultCredentialsFile(); err != nil {
+				return nil, err
+			}
+		}
+
+		return ReadCredentialsFile(path, profile)
+	}
+
+	profile := credentialsProfile{
+		KeyID:          getenv("ASC_KEY_ID"),
+		IssuerID:       getenv("ASC_ISSUER_ID"),
+		PrivateKey:     getenv("ASC_PRIVATE_KEY"),
+		PrivateKeyPath: getenv("ASC_PRIVATE_KEY_PATH"),
+		TokenLifetime:  getenv("ASC_TOKEN_LIFETIME"),
+		Proxy:          getenv("ASC_PROXY_URL"),
+		BaseURL:        getenv("ASC_BASE_URL"),
+	}
+
+	if profile.KeyID == "" || profile.IssuerID == "" {
+		return nil, fmt.Errorf("%w: ASC_KEY_ID and ASC_ISSUER_ID must be set", ErrMissingCredentials)
+	}
+
+	if profile.PrivateKey == "" && profile.PrivateKeyPath == "" {
+		return nil, fmt.Errorf("%w: ASC_PRIVATE_KEY or ASC_PRIVATE_KEY_PATH must be set", ErrMissingCredentials)
+	}
+
+	return profile.credentials("")
+}
+
+// DefaultCredentialsFile returns the path of the credentials file in the home directory, ~/.asc/credentials.yaml.
+func DefaultCredentialsFile() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(home, ".asc", "credentials.yaml"), nil
+}
+
+// ReadCredentialsFile reads the credentials of a profile from a YAML or JSON credentials file, which holds
+// credentials for several API keys, such as those of different teams, by name:
+//
+//	profiles:
+//	  default:
+//	    keyId: ABCD1234
+//	    issuerId: 69a6de70-0000-47e3-e053-5b8c7c11a4d1
+//	    privateKeyPath: AuthKey_ABCD1234.p8
+//	  agency:
+//	    keyId: EFGH5678
+//	    issuerId: 69a6de70-1111-47e3-e053-5b8c7c11a4d1
+//	    privateKeyPath: ~/keys/AuthKey_EFGH5678.p8
+//	    tokenLifetime: 10m
+//	    proxy: http://proxy.example.com:3128
+//
+// Each profile also accepts privateKey, holding the contents of the .p8 file, and baseUrl. A relative
+// privateKeyPath is relative to the directory of the credentials file. An empty profile selects DefaultProfile.
+func ReadCredentialsFile(path string, profile string) (*Credentials, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := parseCredentialsFile(b)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if profile == "" {
+		profile = DefaultProfile
+	}
+
+	p, ok := file.Profiles[profile]
+	if !ok {
+		names := make([]string, 0, len(file.Profiles))
+		for name := range file.Profiles {
+			names = append(names, name)
+		}
+
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("%s: %w %q, expected one of %s", path, ErrUnknownProfile, profile, strings.Join(names, ", "))
+	}
+
+	if p.KeyID == "" || p.IssuerID == "" || (p.PrivateKey == "" && p.PrivateKeyPath == "") {
+		return nil, fmt.Errorf("%s: %w: profile %q needs keyId, issuerId, and privateKey or privateKeyPath", path, ErrMissingCredentials, profile)
+	}
+
+	credentials, err := p.credentials(filepath.Dir(path))
+	if err != nil {
+		return nil, fmt.Errorf("%s: profile %q: %w", path, profile, err)
+	}
+
+	return credentials, nil
+}
+
+func parseCredentialsFile(b []byte) (*credentialsFile, error) {
+	var values interface{}
+	if err := yaml.Unmarshal(b, &values); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	b, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	file := new(credentialsFile)
+
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.DisallowUnknownFields()
+
+	if err := dec.Decode(file); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	return file, nil
+}
+
+// credentials converts a profile to Credentials, resolving a relative private key path against dir.
+func (p credentialsProfile) credentials(dir string) (*Credentials, error) {
+	credentials := &Credentials{
+		KeyID:          p.KeyID,
+		IssuerID:       p.IssuerID,
+		PrivateKeyPath: p.PrivateKeyPath,
+		BaseURL:        p.BaseURL,
+	}
+
+	if p.PrivateKey != "" {
+		credentials.PrivateKey = []byte(p.PrivateKey)
+	}
+
+	if strings.HasPrefix(credentials.PrivateKeyPath, "~/") {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, err
+		}
+
+		credentials.PrivateKeyPath = filepath.Join(home, credentials.PrivateKeyPath[2:])
+	} else if credentials.PrivateKeyPath != "" && dir != "" && !filepath.IsAbs(credentials.PrivateKeyPath) {
+		credentials.PrivateKeyPath = filepath.Join(dir, credentials.PrivateKeyPath)
+	}
+
+	if p.TokenLifetime != "" {
+		lifetime, err := time.ParseDuration(p.TokenLifetime)
+		if err != nil {
+			return nil, fmt.Errorf("%w: token lifetime: %v", ErrInvalidCredentials, err)
+		}
+
+		credentials.TokenLifetime = lifetime
+	}
+
+	if p.Proxy != "" {
+		proxy, err := url.Parse(p.Proxy)
+		if err != nil {
+			return nil, fmt.Errorf("%w: proxy: %v", ErrInvalidCredentials, err)
+		}
+
+		credentials.ProxyURL = proxy
+	}
+
+	return credentials, nil
+}
+
+// NewClient returns a client authenticated with the credentials, reading the private key from PrivateKeyPath
+// if PrivateKey is empty.
+func (c *Credentials) NewClient() (*Client, error) {
+	auth, err := c.TokenConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	client := NewClient(auth.Client())
+
+	if c.BaseURL != "" {
+		if err := client.SetBaseURL(c.BaseURL); err != nil {
+			return nil, err
+		}
+	}
+
+	return client, nil
+}
+
+// TokenConfig returns an AuthTransport signing tokens with the credentials, which sends requests through
+// ProxyURL if it is set.
+func (c *Credentials) TokenConfig() (*AuthTransport, error) {
+	lifetime := c.TokenLifetime
+	if lifetime == 0 {
+		lifetime = MaxTokenLifetime
+	}
+
+	if lifetime < 0 || lifetime > MaxTokenLifetime {
+		return nil, fmt.Errorf("%w: token lifetime %s is not between 0 and %s", ErrInvalidCredentials, lifetime, MaxTokenLifetime)
+	}
+
+	key := c.PrivateKey
+	if len(key) == 0 {
+		if c.PrivateKeyPath == "" {
+			return nil, fmt.Errorf("%w: no private key", ErrMissingCredentials)
+		}
+
+		var err error
+		if key, err = os.ReadFile(c.PrivateKeyPath); err != nil {
+			return nil, err
+		}
+	}
+
+	auth, err := NewTokenConfig(c.KeyID, c.IssuerID, lifetime, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.ProxyURL != nil {
+		auth.Transport = &http.Transport{
+			Proxy:           http.ProxyURL(c.ProxyURL),
+			IdleConnTimeout: defaultTimeout,
+		}
+	}
+
+	return auth, nil
+}
@@ -0,0 +1,192 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestPrivateKey(t *testing.T) []byte {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func testEnv(vars map[string]string) func(string) string {
+	return func(name string) string { return vars[name] }
+}
+
+func TestCredentialsFromEnv(t *testing.T) {
+	t.Parallel()
+
+	key := newTestPrivateKey(t)
+
+	_, err := credentialsFromEnv(testEnv(nil))
+	assert.ErrorIs(t, err, ErrMissingCredentials)
+	assert.EqualError(t, err, "missing API key credentials: ASC_KEY_ID and ASC_ISSUER_ID must be set")
+
+	_, err = credentialsFromEnv(testEnv(map[string]string{"ASC_KEY_ID": "TEST", "ASC_ISSUER_ID": "TEST"}))
+	assert.ErrorIs(t, err, ErrMissingCredentials)
+
+	_, err = credentialsFromEnv(testEnv(map[string]string{"ASC_KEY_ID": "TEST", "ASC_ISSUER_ID": "TEST", "ASC_PRIVATE_KEY": string(key), "ASC_TOKEN_LIFETIME": "soon"}))
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	credentials, err := credentialsFromEnv(testEnv(map[string]string{
+		"ASC_KEY_ID":         "KEY",
+		"ASC_ISSUER_ID":      "ISSUER",
+		"ASC_PRIVATE_KEY":    string(key),
+		"ASC_TOKEN_LIFETIME": "10m",
+		"ASC_PROXY_URL":      "http://proxy.example.com:3128",
+		"ASC_BASE_URL":       "http://localhost/v1",
+	}))
+	assert.NoError(t, err)
+	assert.Equal(t, "KEY", credentials.KeyID)
+	assert.Equal(t, "ISSUER", credentials.IssuerID)
+	assert.Equal(t, key, credentials.PrivateKey)
+	assert.Equal(t, 10*time.Minute, credentials.TokenLifetime)
+	assert.Equal(t, "proxy.example.com:3128", credentials.ProxyURL.Host)
+
+	client, err := credentials.NewClient()
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost/v1/", client.baseURL.String())
+}
+
+func TestCredentialsFromEnvProfile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "credentials.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte("profiles:\n  agency:\n    keyId: KEY\n    issuerId: ISSUER\n    privateKeyPath: AuthKey_KEY.p8\n"), 0o644))
+
+	credentials, err := credentialsFromEnv(testEnv(map[string]string{"ASC_PROFILE": "agency", "ASC_CREDENTIALS_FILE": path, "ASC_KEY_ID": "IGNORED"}))
+	assert.NoError(t, err)
+	assert.Equal(t, "KEY", credentials.KeyID)
+	assert.Equal(t, filepath.Join(dir, "AuthKey_KEY.p8"), credentials.PrivateKeyPath)
+}
+
+func TestReadCredentialsFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	key := newTestPrivateKey(t)
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "AuthKey_A.p8"), key, 0o600))
+
+	path := filepath.Join(dir, "credentials.yaml")
+	assert.NoError(t, os.WriteFile(path, []byte(`
+profiles:
+  default:
+    keyId: A
+    issuerId: ISSUER
+    privateKeyPath: AuthKey_A.p8
+  absolute:
+    keyId: B
+    issuerId: ISSUER
+    privateKeyPath: /keys/AuthKey_B.p8
+    tokenLifetime: 5m
+    proxy: http://proxy.example.com:3128
+  incomplete:
+    keyId: C
+`), 0o644))
+
+	credentials, err := ReadCredentialsFile(path, "")
+	assert.NoError(t, err)
+	assert.Equal(t, &Credentials{KeyID: "A", IssuerID: "ISSUER", PrivateKeyPath: filepath.Join(dir, "AuthKey_A.p8")}, credentials)
+
+	client, err := NewClientFromCredentialsFile(path, DefaultProfile)
+	assert.NoError(t, err)
+	assert.NotNil(t, client)
+
+	credentials, err = ReadCredentialsFile(path, "absolute")
+	assert.NoError(t, err)
+	assert.Equal(t, "/keys/AuthKey_B.p8", credentials.PrivateKeyPath)
+	assert.Equal(t, 5*time.Minute, credentials.TokenLifetime)
+
+	_, err = ReadCredentialsFile(path, "missing")
+	assert.ErrorIs(t, err, ErrUnknownProfile)
+	assert.Contains(t, err.Error(), "expected one of absolute, default, incomplete")
+
+	_, err = ReadCredentialsFile(path, "incomplete")
+	assert.ErrorIs(t, err, ErrMissingCredentials)
+
+	_, err = NewClientFromCredentialsFile(path, "absolute")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = ReadCredentialsFile(filepath.Join(dir, "missing.yaml"), "")
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	assert.NoError(t, os.WriteFile(path, []byte("profiles:\n  default:\n    keyName: A\n"), 0o644))
+
+	_, err = ReadCredentialsFile(path, "")
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+}
+
+func TestCredentialsTokenConfig(t *testing.T) {
+	t.Parallel()
+
+	key := newTestPrivateKey(t)
+	proxy, err := url.Parse("http://proxy.example.com:3128")
+	assert.NoError(t, err)
+
+	auth, err := (&Credentials{KeyID: "A", IssuerID: "ISSUER", PrivateKey: key, ProxyURL: proxy}).TokenConfig()
+	assert.NoError(t, err)
+
+	transport, ok := auth.Transport.(*http.Transport)
+	assert.True(t, ok)
+
+	got, err := transport.Proxy(&http.Request{})
+	assert.NoError(t, err)
+	assert.Equal(t, proxy, got)
+
+	_, err = (&Credentials{KeyID: "A", IssuerID: "ISSUER", PrivateKey: key, TokenLifetime: time.Hour}).TokenConfig()
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+
+	_, err = (&Credentials{KeyID: "A", IssuerID: "ISSUER"}).TokenConfig()
+	assert.ErrorIs(t, err, ErrMissingCredentials)
+
+	_, err = (&Credentials{KeyID: "A", IssuerID: "ISSUER", PrivateKey: []byte("not a key")}).TokenConfig()
+	assert.ErrorIs(t, err, ErrMissingPEM)
+}
+
+func TestDefaultCredentialsFile(t *testing.T) {
+	t.Parallel()
+
+	path, err := DefaultCredentialsFile()
+	assert.NoError(t, err)
+	assert.Equal(t, filepath.Join(".asc", "credentials.yaml"), filepath.Join(filepath.Base(filepath.Dir(path)), filepath.Base(path)))
+}
@@ -87,6 +87,16 @@ so you can't use this API to make queries against the entire App Store. For more
 creating the necessary credentials for the App Store Connect API, see the documentation at
 https://developer.apple.com/documentation/appstoreconnectapi/creating_api_keys_for_app_store_connect_api.
 
+NewClientFromEnv creates the same client from the ASC_KEY_ID, ASC_ISSUER_ID and ASC_PRIVATE_KEY_PATH
+environment variables instead. Keys of several teams can be kept as named profiles of a credentials file, and
+selected with NewClientFromCredentialsFile or the ASC_PROFILE environment variable.
+Jobs that work across many teams can get their clients from a ClientPool, which keeps one client per key,
+shares connections between them, and caps the requests in flight across all teams.
+
+Keys kept in a secret store can be fetched at runtime with NewKeyProviderTokenConfig. The keyprovider
+package implements KeyProvider for HashiCorp Vault, AWS Secrets Manager and 1Password Connect, and the
+key is fetched again when Apple rejects the current one.
+
 # Rate Limiting
 
 Apple imposes a rate limit on all API clients. The returned Response.Rate value contains the rate
@@ -95,37 +105,116 @@ identifiable as an ErrorResponse with an error code of 429.
 
 Learn more about rate limiting at https://developer.apple.com/documentation/appstoreconnectapi/identifying_rate_limits.
 
+# Errors
+
+ErrorCategoryOf classifies an error as AUTH, VALIDATION, CONFLICT, RATE_LIMIT or APPLE_OUTAGE, so that
+automation can decide whether to retry, alert or fail a build. An ErrorResponse also matches the typed error
+of its category with errors.As:
+
+	var rateLimited *asc.RateLimitError
+	if errors.As(err, &rateLimited) {
+		time.Sleep(rateLimited.RetryAfter)
+	}
+
 # Pagination
 
 All requests for resource collections (apps, builds, beta groups, etc.) support pagination.
 Responses for paginated resources will contain a Links property of type PagedDocumentLinks,
 with Reference URLs for first, next, and self. A Reference can have its cursor extracted
 with the Cursor() method, and that can be passed to a query param using its Cursor field.
+ForEachPage follows those cursors until the last page.
 You can also find more information about the per-page limit and total count of resources in
-the response's Meta field of type PagingInformation.
+the response's Meta field of type PagingInformation. The same information is available on the
+returned *Response, whose TotalCount method works the same for every list endpoint.
 
 	auth, _ = asc.NewTokenConfig(keyID, issuerID, expiryDuration, privateKey)
 	client := asc.NewClient(auth.Client())
 
 	opt := &asc.ListAppsQuery{
 		FilterBundleID: []string{"com.sky.MyApp"},
+		Limit:          asc.MaxPageLimit,
 	}
 
 	var allApps []asc.App
-	for {
-		apps, _, err := apps, _, err := client.Apps.ListApps(opt)
+	err := asc.ForEachPage(func(cursor string) (*asc.PagedDocumentLinks, error) {
+		opt.Cursor = cursor
+		apps, _, err := client.Apps.ListApps(ctx, opt)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		allApps = append(allApps, apps.Data...)
-		if apps.Links.Next == nil {
-			break
-		}
-		cursor := apps.Links.Next.Cursor()
-		if cursor == "" {
-			break
-		}
-		opt.Cursor = cursor
+		return &apps.Links, nil
+	})
+
+List does the same for any list endpoint, decoding the resources of every page into a slice, and Get sends a
+single GET request. Both take the endpoint's path, so they also work for endpoints this package doesn't model yet:
+
+	apps, _, err := asc.List[asc.App](ctx, client, "apps", opt)
+
+For very large exports, Stream pages through a list endpoint like List, but decodes each resource as it is
+read and passes it to a callback instead of collecting them:
+
+	_, err := asc.Stream(ctx, client, "devices", nil, func(device *asc.Device) error {
+		return enc.Encode(device)
+	})
+
+# Declarative Configuration
+
+Client.Apply converges bundle IDs, capabilities, profiles, beta groups and store metadata to a Config, which is
+usually kept in version control as YAML:
+
+	bundleIds:
+	  - identifier: com.sky.MyApp
+	    name: My App
+	    platform: IOS
+	    capabilities:
+	      - type: PUSH_NOTIFICATIONS
+	profiles:
+	  - name: My App Development
+	    profileType: IOS_APP_DEVELOPMENT
+	    bundleId: com.sky.MyApp
+	    certificates: [ABCD1234]
+	    allDevices: true
+
+	config, err := asc.ReadConfig("asc.yaml")
+	if err != nil {
+		return err
+	}
+	result, err := client.Apply(ctx, config)
+	fmt.Print(result)
+
+The result lists every resource that was created, deleted, or updated because it drifted from the config.
+
+To review changes before making them, call Client.Plan, which only reads from App Store Connect, and pass the
+plan to Client.ApplyPlan once it has been approved. Plan.Markdown renders the diff for a pull request comment:
+
+	plan, err := client.Plan(ctx, config)
+	if err != nil {
+		return err
 	}
+	fmt.Print(plan.Markdown())
+
+RosterPlan.Diff and PlanAppMetadata produce the same kind of diff for a team roster and exported metadata.
+
+Plans, apply results, access audits, version diffs and sync results implement Output, and WriteOutput writes
+them as a JSON document with a schemaVersion and kind, for CI systems and tools written in other languages.
+The asc command writes the same documents with -output json.
+
+# Testing
+
+Each service implements an interface, such as ProvisioningAPI for ProvisioningService, so that code can depend on
+the interface and be tested without network access. Package ascmock provides mocks of every interface:
+
+	func registerDevice(ctx context.Context, provisioning asc.ProvisioningAPI, name string, udid string) error
+
+	provisioning := new(ascmock.ProvisioningAPI)
+	provisioning.On("CreateDevice", mock.Anything, "iPhone", "UDID", asc.BundleIDPlatformiOS).
+		Return(&asc.DeviceResponse{}, nil, nil)
+
+	err := registerDevice(ctx, provisioning, "iPhone", "UDID")
+	provisioning.AssertExpectations(t)
+
+For end-to-end tests, package asctest provides an in-memory fake of the API that stores the resources it is sent,
+and whose Client method returns a Client that talks to it.
 */
 package asc
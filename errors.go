@@ -0,0 +1,276 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrorCategory is a stable classification of an error, so that automation can decide whether to retry a
+// failed operation, alert someone, or fail a build, without parsing error messages.
+type ErrorCategory string
+
+const (
+	// ErrorCategoryAuth means the API key is missing, invalid, revoked, or lacks the role the request needs.
+	// Retrying won't help until the key is fixed.
+	ErrorCategoryAuth ErrorCategory = "AUTH"
+	// ErrorCategoryValidation means the request or the input it was built from is invalid, such as an
+	// attribute App Store Connect rejects, an unknown resource, or a malformed config.
+	ErrorCategoryValidation ErrorCategory = "VALIDATION"
+	// ErrorCategoryConflict means the request conflicts with the current state of a resource, such as
+	// submitting a version that is already in review.
+	ErrorCategoryConflict ErrorCategory = "CONFLICT"
+	// ErrorCategoryRateLimit means the hourly request limit of the API key was exceeded. Retry later.
+	ErrorCategoryRateLimit ErrorCategory = "RATE_LIMIT"
+	// ErrorCategoryAppleOutage means App Store Connect failed with a server error. Retry later.
+	ErrorCategoryAppleOutage ErrorCategory = "APPLE_OUTAGE"
+	// ErrorCategoryUnknown is any other error, such as a network failure.
+	ErrorCategoryUnknown ErrorCategory = "UNKNOWN"
+)
+
+var errorCategories = []ErrorCategory{
+	ErrorCategoryAuth,
+	ErrorCategoryValidation,
+	ErrorCategoryConflict,
+	ErrorCategoryRateLimit,
+	ErrorCategoryAppleOutage,
+	ErrorCategoryUnknown,
+}
+
+// IsValid reports whether the error category is one this package knows about.
+func (v ErrorCategory) IsValid() bool {
+	for _, value := range errorCategories {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseErrorCategory returns s as an ErrorCategory, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseErrorCategory(s string) (ErrorCategory, error) {
+	if v := ErrorCategory(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "ErrorCategory", Value: s}
+}
+
+// Retryable reports whether an operation that failed with an error of the category may succeed if tried again
+// later without changes.
+func (v ErrorCategory) Retryable() bool {
+	return v == ErrorCategoryRateLimit || v == ErrorCategoryAppleOutage
+}
+
+// AuthError is an ErrorResponse in ErrorCategoryAuth.
+type AuthError struct {
+	*ErrorResponse
+}
+
+func (e *AuthError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// ValidationError is an ErrorResponse in ErrorCategoryValidation.
+type ValidationError struct {
+	*ErrorResponse
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// ConflictError is an ErrorResponse in ErrorCategoryConflict.
+type ConflictError struct {
+	*ErrorResponse
+}
+
+func (e *ConflictError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// RateLimitError is an ErrorResponse in ErrorCategoryRateLimit.
+type RateLimitError struct {
+	*ErrorResponse
+	// RetryAfter is how long the response asked to wait before retrying, or zero if it didn't say.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// AppleOutageError is an ErrorResponse in ErrorCategoryAppleOutage.
+type AppleOutageError struct {
+	*ErrorResponse
+}
+
+func (e *AppleOutageError) Unwrap() error {
+	return e.ErrorResponse
+}
+
+// Category classifies the error by its status code. App Store Connect responds to invalid attributes with
+// 409 Conflict and ENTITY_ERROR codes, which are classified as validation errors rather than conflicts.
+func (e *ErrorResponse) Category() ErrorCategory {
+	if e.Response == nil {
+		return ErrorCategoryUnknown
+	}
+
+	switch code := e.Response.StatusCode; {
+	case code == http.StatusUnauthorized, code == http.StatusForbidden:
+		return ErrorCategoryAuth
+	case code == http.StatusConflict && e.entityErrors():
+		return ErrorCategoryValidation
+	case code == http.StatusConflict:
+		return ErrorCategoryConflict
+	case code == http.StatusTooManyRequests:
+		return ErrorCategoryRateLimit
+	case code >= http.StatusInternalServerError:
+		return ErrorCategoryAppleOutage
+	case code >= http.StatusBadRequest:
+		return ErrorCategoryValidation
+	default:
+		return ErrorCategoryUnknown
+	}
+}
+
+// entityErrors reports whether the response lists errors and all of them are about the request entity.
+func (e *ErrorResponse) entityErrors() bool {
+	for _, err := range e.Errors {
+		if !strings.HasPrefix(err.Code, "ENTITY_ERROR") {
+			return false
+		}
+	}
+
+	return len(e.Errors) > 0
+}
+
+// As lets errors.As match an ErrorResponse against the typed error of its category, such as *RateLimitError.
+func (e *ErrorResponse) As(target interface{}) bool {
+	category := e.Category()
+
+	switch t := target.(type) {
+	case **AuthError:
+		if category == ErrorCategoryAuth {
+			*t = &AuthError{e}
+
+			return true
+		}
+	case **ValidationError:
+		if category == ErrorCategoryValidation {
+			*t = &ValidationError{e}
+
+			return true
+		}
+	case **ConflictError:
+		if category == ErrorCategoryConflict {
+			*t = &ConflictError{e}
+
+			return true
+		}
+	case **RateLimitError:
+		if category == ErrorCategoryRateLimit {
+			*t = &RateLimitError{ErrorResponse: e, RetryAfter: retryAfter(e.Response)}
+
+			return true
+		}
+	case **AppleOutageError:
+		if category == ErrorCategoryAppleOutage {
+			*t = &AppleOutageError{e}
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// retryAfter parses the Retry-After header of a response given in seconds.
+func retryAfter(r *http.Response) time.Duration {
+	seconds, err := strconv.Atoi(r.Header.Get("Retry-After"))
+	if err != nil || seconds < 0 {
+		return 0
+	}
+
+	return time.Duration(seconds) * time.Second
+}
+
+// localErrorCategories classify the errors this package returns without making a request.
+var localErrorCategories = []struct {
+	err      error
+	category ErrorCategory
+}{
+	{ErrMissingCredentials, ErrorCategoryAuth},
+	{ErrInvalidCredentials, ErrorCategoryAuth},
+	{ErrUnknownProfile, ErrorCategoryAuth},
+	{ErrMissingPEM, ErrorCategoryAuth},
+	{ErrInvalidPrivateKey, ErrorCategoryAuth},
+	{ErrInvalidConfig, ErrorCategoryValidation},
+	{ErrUnknownConfigReference, ErrorCategoryValidation},
+	{ErrUnknownRosterApp, ErrorCategoryValidation},
+	{ErrEmptyRoster, ErrorCategoryValidation},
+	{ErrIncompleteCiWorkflowDocument, ErrorCategoryValidation},
+	{ErrConflictingCiBuildRunSource, ErrorCategoryValidation},
+	{ErrMissingInvitationEmail, ErrorCategoryValidation},
+	{ErrFamilySharingIrreversible, ErrorCategoryValidation},
+	{ErrConfigConflict, ErrorCategoryConflict},
+}
+
+// ErrorCategoryOf classifies err, which may wrap an ErrorResponse or an error this package returns without
+// making a request, such as ErrMissingCredentials or ErrInvalidConfig. It returns an empty category for a nil
+// error and ErrorCategoryUnknown for an error it cannot classify.
+func ErrorCategoryOf(err error) ErrorCategory {
+	if err == nil {
+		return ""
+	}
+
+	var errResponse *ErrorResponse
+	if errors.As(err, &errResponse) {
+		return errResponse.Category()
+	}
+
+	for _, local := range localErrorCategories {
+		if errors.Is(err, local.err) {
+			return local.category
+		}
+	}
+
+	var (
+		errEnum         ErrUnknownEnumValue
+		errAvailability ErrInvalidAvailabilityPlan
+		errInReview     ErrSubmissionInReview
+	)
+
+	if errors.As(err, &errEnum) || errors.As(err, &errAvailability) {
+		return ErrorCategoryValidation
+	}
+
+	if errors.As(err, &errInReview) {
+		return ErrorCategoryConflict
+	}
+
+	return ErrorCategoryUnknown
+}
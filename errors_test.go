@@ -0,0 +1,134 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestErrorResponse(status int, codes ...string) *ErrorResponse {
+	errs := make([]ErrorResponseError, len(codes))
+	for i, code := range codes {
+		errs[i] = ErrorResponseError{Code: code}
+	}
+
+	return &ErrorResponse{Response: &http.Response{StatusCode: status, Header: http.Header{}}, Errors: errs}
+}
+
+func TestErrorResponseCategory(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		err  *ErrorResponse
+		want ErrorCategory
+	}{
+		{newTestErrorResponse(http.StatusUnauthorized, "NOT_AUTHORIZED"), ErrorCategoryAuth},
+		{newTestErrorResponse(http.StatusForbidden, "FORBIDDEN_ERROR"), ErrorCategoryAuth},
+		{newTestErrorResponse(http.StatusBadRequest, "PARAMETER_ERROR.INVALID"), ErrorCategoryValidation},
+		{newTestErrorResponse(http.StatusNotFound, "NOT_FOUND"), ErrorCategoryValidation},
+		{newTestErrorResponse(http.StatusConflict, "ENTITY_ERROR.ATTRIBUTE.INVALID"), ErrorCategoryValidation},
+		{newTestErrorResponse(http.StatusConflict, "STATE_ERROR"), ErrorCategoryConflict},
+		{newTestErrorResponse(http.StatusConflict, "ENTITY_ERROR.ATTRIBUTE.INVALID", "STATE_ERROR"), ErrorCategoryConflict},
+		{newTestErrorResponse(http.StatusConflict), ErrorCategoryConflict},
+		{newTestErrorResponse(http.StatusTooManyRequests, "RATE_LIMIT_EXCEEDED"), ErrorCategoryRateLimit},
+		{newTestErrorResponse(http.StatusInternalServerError, "UNEXPECTED_ERROR"), ErrorCategoryAppleOutage},
+		{newTestErrorResponse(http.StatusServiceUnavailable), ErrorCategoryAppleOutage},
+		{newTestErrorResponse(http.StatusFound), ErrorCategoryUnknown},
+		{&ErrorResponse{}, ErrorCategoryUnknown},
+	} {
+		assert.Equal(t, tc.want, tc.err.Category(), tc.err.Response)
+	}
+}
+
+func TestErrorResponseAs(t *testing.T) {
+	t.Parallel()
+
+	err := fmt.Errorf("listing apps: %w", newTestErrorResponse(http.StatusUnauthorized))
+
+	var authErr *AuthError
+	assert.True(t, errors.As(err, &authErr))
+	assert.Equal(t, http.StatusUnauthorized, authErr.Response.StatusCode)
+
+	var errResponse *ErrorResponse
+	assert.True(t, errors.As(authErr, &errResponse))
+
+	var validationErr *ValidationError
+	assert.False(t, errors.As(err, &validationErr))
+	assert.True(t, errors.As(newTestErrorResponse(http.StatusUnprocessableEntity), &validationErr))
+
+	var conflictErr *ConflictError
+	assert.True(t, errors.As(newTestErrorResponse(http.StatusConflict, "STATE_ERROR"), &conflictErr))
+
+	var outageErr *AppleOutageError
+	assert.True(t, errors.As(newTestErrorResponse(http.StatusBadGateway), &outageErr))
+
+	rateLimited := newTestErrorResponse(http.StatusTooManyRequests)
+	rateLimited.Response.Header.Set("Retry-After", "120")
+
+	var rateLimitErr *RateLimitError
+	assert.True(t, errors.As(rateLimited, &rateLimitErr))
+	assert.Equal(t, 2*time.Minute, rateLimitErr.RetryAfter)
+
+	assert.True(t, errors.As(newTestErrorResponse(http.StatusTooManyRequests), &rateLimitErr))
+	assert.Zero(t, rateLimitErr.RetryAfter)
+}
+
+func TestErrorCategoryOf(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, ErrorCategory(""), ErrorCategoryOf(nil))
+	assert.Equal(t, ErrorCategoryRateLimit, ErrorCategoryOf(fmt.Errorf("uploading: %w", newTestErrorResponse(http.StatusTooManyRequests))))
+	assert.Equal(t, ErrorCategoryAuth, ErrorCategoryOf(fmt.Errorf("%w: ASC_KEY_ID is not set", ErrMissingCredentials)))
+	assert.Equal(t, ErrorCategoryValidation, ErrorCategoryOf(fmt.Errorf("%w: profiles[0]: name is required", ErrInvalidConfig)))
+	assert.Equal(t, ErrorCategoryValidation, ErrorCategoryOf(ErrUnknownEnumValue{Type: "Platform", Value: "ANDROID"}))
+	assert.Equal(t, ErrorCategoryValidation, ErrorCategoryOf(ErrInvalidAvailabilityPlan{Problems: []string{"unknown territory XXX"}}))
+	assert.Equal(t, ErrorCategoryConflict, ErrorCategoryOf(ErrConfigConflict))
+	assert.Equal(t, ErrorCategoryConflict, ErrorCategoryOf(ErrSubmissionInReview{SubmissionID: "20", State: ReviewSubmissionStateInReview}))
+	assert.Equal(t, ErrorCategoryUnknown, ErrorCategoryOf(errors.New("connection reset")))
+}
+
+func TestErrorCategoryRetryable(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, ErrorCategoryRateLimit.Retryable())
+	assert.True(t, ErrorCategoryAppleOutage.Retryable())
+	assert.False(t, ErrorCategoryAuth.Retryable())
+	assert.False(t, ErrorCategoryValidation.Retryable())
+	assert.False(t, ErrorCategoryConflict.Retryable())
+	assert.False(t, ErrorCategoryUnknown.Retryable())
+}
+
+func TestParseErrorCategory(t *testing.T) {
+	t.Parallel()
+
+	category, err := ParseErrorCategory("RATE_LIMIT")
+	assert.NoError(t, err)
+	assert.Equal(t, ErrorCategoryRateLimit, category)
+
+	_, err = ParseErrorCategory("UNKNOWN_CATEGORY")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "ErrorCategory", Value: "UNKNOWN_CATEGORY"}, err)
+}
@@ -0,0 +1,304 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// GameCenterAchievementRow is a row of a tab-separated achievement catalog, as read by
+// ReadGameCenterAchievementCatalog. An achievement spans one row per locale, identified by its vendor identifier;
+// its points and flags are read from its first row.
+type GameCenterAchievementRow struct {
+	VendorIdentifier        string `tsv:"Vendor Identifier"`
+	ReferenceName           string `tsv:"Reference Name"`
+	Points                  int    `tsv:"Points"`
+	ShowBeforeEarned        bool   `tsv:"Show Before Earned"`
+	Repeatable              bool   `tsv:"Repeatable"`
+	Locale                  string `tsv:"Locale"`
+	Name                    string `tsv:"Name"`
+	BeforeEarnedDescription string `tsv:"Before Earned Description"`
+	AfterEarnedDescription  string `tsv:"After Earned Description"`
+	// Image is the path of the image of the localization, relative to GameCenterAchievementSyncOptions.ImageDir.
+	Image string `tsv:"Image"`
+}
+
+// GameCenterAchievementSyncOptions configures SyncGameCenterAchievements.
+type GameCenterAchievementSyncOptions struct {
+	// ImageDir is the directory the image paths of the catalog are relative to.
+	ImageDir string
+}
+
+// GameCenterAchievementSyncResult summarizes the changes SyncGameCenterAchievements made, by vendor identifier.
+type GameCenterAchievementSyncResult struct {
+	Created   []string `json:"created"`
+	Updated   []string `json:"updated"`
+	Unchanged []string `json:"unchanged"`
+	// Localizations is the number of localizations created or updated.
+	Localizations int `json:"localizations"`
+	// Images is the number of localization images uploaded.
+	Images int `json:"images"`
+}
+
+// OutputKind implements Output.
+func (r GameCenterAchievementSyncResult) OutputKind() string {
+	return "gameCenterAchievementSync"
+}
+
+// MarshalJSON encodes a kind of change the sync didn't make as an empty list rather than null.
+func (r GameCenterAchievementSyncResult) MarshalJSON() ([]byte, error) {
+	type result GameCenterAchievementSyncResult
+
+	for _, ids := range []*[]string{&r.Created, &r.Updated, &r.Unchanged} {
+		if *ids == nil {
+			*ids = []string{}
+		}
+	}
+
+	return json.Marshal(result(r))
+}
+
+// ReadGameCenterAchievementCatalog reads a tab-separated achievement catalog, such as one exported from a
+// spreadsheet, whose header names the columns of GameCenterAchievementRow.
+func ReadGameCenterAchievementCatalog(r io.Reader) ([]GameCenterAchievementRow, error) {
+	var rows []GameCenterAchievementRow
+
+	err := ParseReport(r, &rows)
+
+	return rows, err
+}
+
+// SyncGameCenterAchievements creates or updates the achievements of an app, their localizations and localization
+// images to match a catalog. Achievements are matched by vendor identifier and localizations by locale. Images
+// are only uploaded to localizations that have none, so running it again after a failure resumes where it
+// stopped. Achievements and localizations missing from the catalog are left alone.
+func (s *GameCenterService) SyncGameCenterAchievements(ctx context.Context, gameCenterDetailID string, rows []GameCenterAchievementRow, opts *GameCenterAchievementSyncOptions) (*GameCenterAchievementSyncResult, error) {
+	if opts == nil {
+		opts = &GameCenterAchievementSyncOptions{}
+	}
+
+	existing, err := s.listAllGameCenterAchievements(ctx, gameCenterDetailID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &GameCenterAchievementSyncResult{}
+
+	order, grouped := groupGameCenterAchievementRows(rows)
+
+	for _, vendorIdentifier := range order {
+		group := grouped[vendorIdentifier]
+
+		achievementID, err := s.syncGameCenterAchievement(ctx, gameCenterDetailID, existing[vendorIdentifier], group[0], result)
+		if err != nil {
+			return result, fmt.Errorf("failed to sync achievement %s: %w", vendorIdentifier, err)
+		}
+
+		if err := s.syncGameCenterAchievementLocalizations(ctx, achievementID, group, opts, result); err != nil {
+			return result, fmt.Errorf("failed to sync localizations of achievement %s: %w", vendorIdentifier, err)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *GameCenterService) listAllGameCenterAchievements(ctx context.Context, gameCenterDetailID string) (map[string]*GameCenterAchievement, error) {
+	url := fmt.Sprintf("gameCenterDetails/%s/gameCenterAchievements", gameCenterDetailID)
+
+	data, _, err := List[GameCenterAchievement](ctx, s.client, url, &ListGameCenterAchievementsQuery{Limit: MaxPageLimit})
+	if err != nil {
+		return nil, err
+	}
+
+	achievements := make(map[string]*GameCenterAchievement)
+
+	for i := range data {
+		achievement := &data[i]
+		if achievement.Attributes != nil && achievement.Attributes.VendorIdentifier != nil {
+			achievements[*achievement.Attributes.VendorIdentifier] = achievement
+		}
+	}
+
+	return achievements, nil
+}
+
+func (s *GameCenterService) syncGameCenterAchievement(ctx context.Context, gameCenterDetailID string, current *GameCenterAchievement, row GameCenterAchievementRow, result *GameCenterAchievementSyncResult) (string, error) {
+	if current == nil {
+		res, _, err := s.CreateGameCenterAchievement(ctx, GameCenterAchievementCreateRequestAttributes{
+			Points:           row.Points,
+			ReferenceName:    row.ReferenceName,
+			Repeatable:       row.Repeatable,
+			ShowBeforeEarned: row.ShowBeforeEarned,
+			VendorIdentifier: row.VendorIdentifier,
+		}, gameCenterDetailID)
+		if err != nil {
+			return "", err
+		}
+
+		result.Created = append(result.Created, row.VendorIdentifier)
+
+		return res.Data.ID, nil
+	}
+
+	attributes := current.Attributes
+	if attributes == nil {
+		attributes = &GameCenterAchievementAttributes{}
+	}
+
+	if stringValue(attributes.ReferenceName) == row.ReferenceName &&
+		attributes.Points != nil && *attributes.Points == row.Points &&
+		boolValue(attributes.ShowBeforeEarned) == row.ShowBeforeEarned &&
+		boolValue(attributes.Repeatable) == row.Repeatable {
+		result.Unchanged = append(result.Unchanged, row.VendorIdentifier)
+
+		return current.ID, nil
+	}
+
+	_, _, err := s.UpdateGameCenterAchievement(ctx, current.ID, &GameCenterAchievementUpdateRequestAttributes{
+		Points:           &row.Points,
+		ReferenceName:    &row.ReferenceName,
+		Repeatable:       &row.Repeatable,
+		ShowBeforeEarned: &row.ShowBeforeEarned,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	result.Updated = append(result.Updated, row.VendorIdentifier)
+
+	return current.ID, nil
+}
+
+func (s *GameCenterService) syncGameCenterAchievementLocalizations(ctx context.Context, achievementID string, rows []GameCenterAchievementRow, opts *GameCenterAchievementSyncOptions, result *GameCenterAchievementSyncResult) error {
+	url := fmt.Sprintf("gameCenterAchievements/%s/localizations", achievementID)
+	params := &ListGameCenterAchievementLocalizationsQuery{Limit: MaxPageLimit, Include: []string{"gameCenterAchievementImage"}}
+
+	data, _, err := List[GameCenterAchievementLocalization](ctx, s.client, url, params)
+	if err != nil {
+		return err
+	}
+
+	existing := make(map[string]*GameCenterAchievementLocalization)
+
+	for i := range data {
+		localization := &data[i]
+		if localization.Attributes != nil && localization.Attributes.Locale != nil {
+			existing[*localization.Attributes.Locale] = localization
+		}
+	}
+
+	for _, row := range rows {
+		if row.Locale == "" {
+			continue
+		}
+
+		localizationID, hasImage, err := s.syncGameCenterAchievementLocalization(ctx, achievementID, existing[row.Locale], row, result)
+		if err != nil {
+			return fmt.Errorf("%s: %w", row.Locale, err)
+		}
+
+		if row.Image == "" || hasImage {
+			continue
+		}
+
+		if err := s.uploadGameCenterAchievementImageFile(ctx, filepath.Join(opts.ImageDir, row.Image), localizationID); err != nil {
+			return fmt.Errorf("%s: %w", row.Locale, err)
+		}
+
+		result.Images++
+	}
+
+	return nil
+}
+
+func (s *GameCenterService) syncGameCenterAchievementLocalization(ctx context.Context, achievementID string, current *GameCenterAchievementLocalization, row GameCenterAchievementRow, result *GameCenterAchievementSyncResult) (string, bool, error) {
+	if current == nil {
+		res, _, err := s.CreateGameCenterAchievementLocalization(ctx, row.Locale, row.Name, row.BeforeEarnedDescription, row.AfterEarnedDescription, achievementID)
+		if err != nil {
+			return "", false, err
+		}
+
+		result.Localizations++
+
+		return res.Data.ID, false, nil
+	}
+
+	hasImage := current.Relationships != nil &&
+		current.Relationships.GameCenterAchievementImage != nil &&
+		current.Relationships.GameCenterAchievementImage.Data != nil
+
+	attributes := current.Attributes
+	if stringValue(attributes.Name) == row.Name &&
+		stringValue(attributes.BeforeEarnedDescription) == row.BeforeEarnedDescription &&
+		stringValue(attributes.AfterEarnedDescription) == row.AfterEarnedDescription {
+		return current.ID, hasImage, nil
+	}
+
+	_, _, err := s.UpdateGameCenterAchievementLocalization(ctx, current.ID, &GameCenterAchievementLocalizationUpdateRequestAttributes{
+		AfterEarnedDescription:  &row.AfterEarnedDescription,
+		BeforeEarnedDescription: &row.BeforeEarnedDescription,
+		Name:                    &row.Name,
+	})
+	if err != nil {
+		return "", false, err
+	}
+
+	result.Localizations++
+
+	return current.ID, hasImage, nil
+}
+
+func (s *GameCenterService) uploadGameCenterAchievementImageFile(ctx context.Context, path string, localizationID string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, _, err = s.UploadGameCenterAchievementImage(ctx, filepath.Base(path), file, localizationID)
+
+	return err
+}
+
+// groupGameCenterAchievementRows groups rows by vendor identifier, in the order each achievement first appears.
+func groupGameCenterAchievementRows(rows []GameCenterAchievementRow) ([]string, map[string][]GameCenterAchievementRow) {
+	order := make([]string, 0)
+	grouped := make(map[string][]GameCenterAchievementRow)
+
+	for _, row := range rows {
+		if row.VendorIdentifier == "" {
+			continue
+		}
+
+		if _, ok := grouped[row.VendorIdentifier]; !ok {
+			order = append(order, row.VendorIdentifier)
+		}
+
+		grouped[row.VendorIdentifier] = append(grouped[row.VendorIdentifier], row)
+	}
+
+	return order, grouped
+}
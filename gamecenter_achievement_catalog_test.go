@@ -0,0 +1,126 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testGameCenterAchievementCatalog = "Vendor Identifier\tReference Name\tPoints\tShow Before Earned\tRepeatable\tLocale\tName\tBefore Earned Description\tAfter Earned Description\tImage\n" +
+	"first_win\tFirst Win\t10\tYes\tNo\ten-US\tFirst Win\tWin a match\tYou won a match\tfirst_win.png\n" +
+	"first_win\tFirst Win\t10\tYes\tNo\tfr-FR\tPremière victoire\tGagnez un match\tVous avez gagné un match\t\n" +
+	"veteran\tVeteran\t50\tNo\tNo\ten-US\tVeteran\tPlay 100 matches\tYou played 100 matches\t\n" +
+	"streak\tStreak\t20\tNo\tYes\t\t\t\t\t\n"
+
+func TestReadGameCenterAchievementCatalog(t *testing.T) {
+	t.Parallel()
+
+	rows, err := ReadGameCenterAchievementCatalog(strings.NewReader(testGameCenterAchievementCatalog))
+	assert.NoError(t, err)
+	assert.Len(t, rows, 4)
+	assert.Equal(t, GameCenterAchievementRow{
+		VendorIdentifier:        "first_win",
+		ReferenceName:           "First Win",
+		Points:                  10,
+		ShowBeforeEarned:        true,
+		Locale:                  "en-US",
+		Name:                    "First Win",
+		BeforeEarnedDescription: "Win a match",
+		AfterEarnedDescription:  "You won a match",
+		Image:                   "first_win.png",
+	}, rows[0])
+	assert.True(t, rows[3].Repeatable)
+}
+
+func TestSyncGameCenterAchievements(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "first_win.png"), []byte("png"), 0o600))
+
+	rows, err := ReadGameCenterAchievementCatalog(strings.NewReader(testGameCenterAchievementCatalog))
+	assert.NoError(t, err)
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /gameCenterDetails/10/gameCenterAchievements": `{"data":[
+			{"id":"1","type":"gameCenterAchievements","attributes":{"vendorIdentifier":"first_win","referenceName":"First Win","points":10,"showBeforeEarned":true,"repeatable":false}},
+			{"id":"2","type":"gameCenterAchievements","attributes":{"vendorIdentifier":"veteran","referenceName":"Veteran","points":25}}
+		],"links":{"self":""}}`,
+		"GET /gameCenterAchievements/1/localizations": `{"data":[
+			{"id":"11","type":"gameCenterAchievementLocalizations","attributes":{"locale":"en-US","name":"First Win","beforeEarnedDescription":"Win a match","afterEarnedDescription":"You won a match"}}
+		],"links":{"self":""}}`,
+		"GET /gameCenterAchievements/2/localizations": `{"data":[],"links":{"self":""}}`,
+		"GET /gameCenterAchievements/3/localizations": `{"data":[],"links":{"self":""}}`,
+		"POST /gameCenterAchievements":                `{"data":{"id":"3","type":"gameCenterAchievements"}}`,
+		"PATCH /gameCenterAchievements/2":             `{"data":{"id":"2","type":"gameCenterAchievements"}}`,
+		"POST /gameCenterAchievementLocalizations":    `{"data":{"id":"12","type":"gameCenterAchievementLocalizations"}}`,
+		"POST /gameCenterAchievementImages":           `{"data":{"id":"13","type":"gameCenterAchievementImages","attributes":{"uploadOperations":[]}}}`,
+		"PATCH /gameCenterAchievementImages/13":       `{"data":{"id":"13","type":"gameCenterAchievementImages"}}`,
+	})
+	defer server.Close()
+
+	result, err := client.GameCenter.SyncGameCenterAchievements(context.Background(), "10", rows, &GameCenterAchievementSyncOptions{ImageDir: dir})
+	assert.NoError(t, err)
+	assert.Equal(t, &GameCenterAchievementSyncResult{
+		Created:       []string{"streak"},
+		Updated:       []string{"veteran"},
+		Unchanged:     []string{"first_win"},
+		Localizations: 2,
+		Images:        1,
+	}, result)
+	assert.Equal(t, []string{
+		"GET /gameCenterDetails/10/gameCenterAchievements",
+		"GET /gameCenterAchievements/1/localizations",
+		"POST /gameCenterAchievementImages",
+		"PATCH /gameCenterAchievementImages/13",
+		"POST /gameCenterAchievementLocalizations",
+		"PATCH /gameCenterAchievements/2",
+		"GET /gameCenterAchievements/2/localizations",
+		"POST /gameCenterAchievementLocalizations",
+		"POST /gameCenterAchievements",
+		"GET /gameCenterAchievements/3/localizations",
+	}, *requests)
+}
+
+func TestSyncGameCenterAchievementsMissingImage(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /gameCenterDetails/10/gameCenterAchievements": `{"data":[],"links":{"self":""}}`,
+		"POST /gameCenterAchievements":                     `{"data":{"id":"3","type":"gameCenterAchievements"}}`,
+		"GET /gameCenterAchievements/3/localizations":      `{"data":[],"links":{"self":""}}`,
+		"POST /gameCenterAchievementLocalizations":         `{"data":{"id":"12","type":"gameCenterAchievementLocalizations"}}`,
+	})
+	defer server.Close()
+
+	rows := []GameCenterAchievementRow{{VendorIdentifier: "first_win", Locale: "en-US", Image: "missing.png"}}
+
+	result, err := client.GameCenter.SyncGameCenterAchievements(context.Background(), "10", rows, &GameCenterAchievementSyncOptions{ImageDir: t.TempDir()})
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "first_win")
+	assert.Equal(t, []string{"first_win"}, result.Created)
+}
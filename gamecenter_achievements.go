@@ -0,0 +1,614 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// GameCenterAchievement defines model for GameCenterAchievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievement
+type GameCenterAchievement struct {
+	Attributes    *GameCenterAchievementAttributes    `json:"attributes,omitempty"`
+	ID            string                              `json:"id"`
+	Links         ResourceLinks                       `json:"links"`
+	Relationships *GameCenterAchievementRelationships `json:"relationships,omitempty"`
+	Type          string                              `json:"type"`
+}
+
+// GameCenterAchievementAttributes defines model for GameCenterAchievement.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievement/attributes
+type GameCenterAchievementAttributes struct {
+	ActivityProperties map[string]string `json:"activityProperties,omitempty"`
+	Archived           *bool             `json:"archived,omitempty"`
+	Points             *int              `json:"points,omitempty"`
+	ReferenceName      *string           `json:"referenceName,omitempty"`
+	Repeatable         *bool             `json:"repeatable,omitempty"`
+	ShowBeforeEarned   *bool             `json:"showBeforeEarned,omitempty"`
+	VendorIdentifier   *string           `json:"vendorIdentifier,omitempty"`
+}
+
+// GameCenterAchievementRelationships defines model for GameCenterAchievement.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievement/relationships
+type GameCenterAchievementRelationships struct {
+	GameCenterDetail *Relationship      `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup  *Relationship      `json:"gameCenterGroup,omitempty"`
+	GroupAchievement *Relationship      `json:"groupAchievement,omitempty"`
+	Localizations    *PagedRelationship `json:"localizations,omitempty"`
+	Releases         *PagedRelationship `json:"releases,omitempty"`
+}
+
+// gameCenterAchievementCreateRequest defines model for GameCenterAchievementCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementcreaterequest/data
+type gameCenterAchievementCreateRequest struct {
+	Attributes    GameCenterAchievementCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterAchievementCreateRequestRelationships `json:"relationships"`
+	Type          string                                          `json:"type"`
+}
+
+// GameCenterAchievementCreateRequestAttributes are attributes for GameCenterAchievementCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementcreaterequest/data/attributes
+type GameCenterAchievementCreateRequestAttributes struct {
+	ActivityProperties map[string]string `json:"activityProperties,omitempty"`
+	Points             int               `json:"points"`
+	ReferenceName      string            `json:"referenceName"`
+	Repeatable         bool              `json:"repeatable"`
+	ShowBeforeEarned   bool              `json:"showBeforeEarned"`
+	VendorIdentifier   string            `json:"vendorIdentifier"`
+}
+
+// gameCenterAchievementCreateRequestRelationships are relationships for GameCenterAchievementCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementcreaterequest/data/relationships
+type gameCenterAchievementCreateRequestRelationships struct {
+	GameCenterDetail *relationshipDeclaration `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup  *relationshipDeclaration `json:"gameCenterGroup,omitempty"`
+}
+
+// gameCenterAchievementUpdateRequest defines model for GameCenterAchievementUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementupdaterequest/data
+type gameCenterAchievementUpdateRequest struct {
+	Attributes *GameCenterAchievementUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                        `json:"id"`
+	Type       string                                        `json:"type"`
+}
+
+// GameCenterAchievementUpdateRequestAttributes are attributes for GameCenterAchievementUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementupdaterequest/data/attributes
+type GameCenterAchievementUpdateRequestAttributes struct {
+	ActivityProperties map[string]string `json:"activityProperties,omitempty"`
+	Archived           *bool             `json:"archived,omitempty"`
+	Points             *int              `json:"points,omitempty"`
+	ReferenceName      *string           `json:"referenceName,omitempty"`
+	Repeatable         *bool             `json:"repeatable,omitempty"`
+	ShowBeforeEarned   *bool             `json:"showBeforeEarned,omitempty"`
+}
+
+// GameCenterAchievementResponse defines model for GameCenterAchievementResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementresponse
+type GameCenterAchievementResponse struct {
+	Data     GameCenterAchievement                   `json:"data"`
+	Included []GameCenterAchievementResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                           `json:"links"`
+}
+
+// GameCenterAchievementsResponse defines model for GameCenterAchievementsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementsresponse
+type GameCenterAchievementsResponse struct {
+	Data     []GameCenterAchievement                 `json:"data"`
+	Included []GameCenterAchievementResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                      `json:"links"`
+	Meta     *PagingInformation                      `json:"meta,omitempty"`
+}
+
+// GameCenterAchievementResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterAchievementResponse or GameCenterAchievementsResponse.
+type GameCenterAchievementResponseIncluded included
+
+// GameCenterAchievementLocalization defines model for GameCenterAchievementLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalization
+type GameCenterAchievementLocalization struct {
+	Attributes    *GameCenterAchievementLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                          `json:"id"`
+	Links         ResourceLinks                                   `json:"links"`
+	Relationships *GameCenterAchievementLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                          `json:"type"`
+}
+
+// GameCenterAchievementLocalizationAttributes defines model for GameCenterAchievementLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalization/attributes
+type GameCenterAchievementLocalizationAttributes struct {
+	AfterEarnedDescription  *string `json:"afterEarnedDescription,omitempty"`
+	BeforeEarnedDescription *string `json:"beforeEarnedDescription,omitempty"`
+	Locale                  *string `json:"locale,omitempty"`
+	Name                    *string `json:"name,omitempty"`
+}
+
+// GameCenterAchievementLocalizationRelationships defines model for GameCenterAchievementLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalization/relationships
+type GameCenterAchievementLocalizationRelationships struct {
+	GameCenterAchievement      *Relationship `json:"gameCenterAchievement,omitempty"`
+	GameCenterAchievementImage *Relationship `json:"gameCenterAchievementImage,omitempty"`
+}
+
+// gameCenterAchievementLocalizationCreateRequest defines model for GameCenterAchievementLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationcreaterequest/data
+type gameCenterAchievementLocalizationCreateRequest struct {
+	Attributes    gameCenterAchievementLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterAchievementLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                      `json:"type"`
+}
+
+// gameCenterAchievementLocalizationCreateRequestAttributes are attributes for GameCenterAchievementLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationcreaterequest/data/attributes
+type gameCenterAchievementLocalizationCreateRequestAttributes struct {
+	AfterEarnedDescription  string `json:"afterEarnedDescription"`
+	BeforeEarnedDescription string `json:"beforeEarnedDescription"`
+	Locale                  string `json:"locale"`
+	Name                    string `json:"name"`
+}
+
+// gameCenterAchievementLocalizationCreateRequestRelationships are relationships for GameCenterAchievementLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationcreaterequest/data/relationships
+type gameCenterAchievementLocalizationCreateRequestRelationships struct {
+	GameCenterAchievement relationshipDeclaration `json:"gameCenterAchievement"`
+}
+
+// gameCenterAchievementLocalizationUpdateRequest defines model for GameCenterAchievementLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationupdaterequest/data
+type gameCenterAchievementLocalizationUpdateRequest struct {
+	Attributes *GameCenterAchievementLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                    `json:"id"`
+	Type       string                                                    `json:"type"`
+}
+
+// GameCenterAchievementLocalizationUpdateRequestAttributes are attributes for GameCenterAchievementLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationupdaterequest/data/attributes
+type GameCenterAchievementLocalizationUpdateRequestAttributes struct {
+	AfterEarnedDescription  *string `json:"afterEarnedDescription,omitempty"`
+	BeforeEarnedDescription *string `json:"beforeEarnedDescription,omitempty"`
+	Name                    *string `json:"name,omitempty"`
+}
+
+// GameCenterAchievementLocalizationResponse defines model for GameCenterAchievementLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationresponse
+type GameCenterAchievementLocalizationResponse struct {
+	Data     GameCenterAchievementLocalization                   `json:"data"`
+	Included []GameCenterAchievementLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                       `json:"links"`
+}
+
+// GameCenterAchievementLocalizationsResponse defines model for GameCenterAchievementLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementlocalizationsresponse
+type GameCenterAchievementLocalizationsResponse struct {
+	Data     []GameCenterAchievementLocalization                 `json:"data"`
+	Included []GameCenterAchievementLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                  `json:"links"`
+	Meta     *PagingInformation                                  `json:"meta,omitempty"`
+}
+
+// GameCenterAchievementLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be
+// returned in a GameCenterAchievementLocalizationResponse or GameCenterAchievementLocalizationsResponse.
+type GameCenterAchievementLocalizationResponseIncluded included
+
+// GameCenterAchievementImage defines model for GameCenterAchievementImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimage
+type GameCenterAchievementImage struct {
+	Attributes    *GameCenterAchievementImageAttributes    `json:"attributes,omitempty"`
+	ID            string                                   `json:"id"`
+	Links         ResourceLinks                            `json:"links"`
+	Relationships *GameCenterAchievementImageRelationships `json:"relationships,omitempty"`
+	Type          string                                   `json:"type"`
+}
+
+// GameCenterAchievementImageAttributes defines model for GameCenterAchievementImage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimage/attributes
+type GameCenterAchievementImageAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// GameCenterAchievementImageRelationships defines model for GameCenterAchievementImage.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimage/relationships
+type GameCenterAchievementImageRelationships struct {
+	GameCenterAchievementLocalization *Relationship `json:"gameCenterAchievementLocalization,omitempty"`
+}
+
+// gameCenterAchievementImageCreateRequest defines model for GameCenterAchievementImageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimagecreaterequest/data
+type gameCenterAchievementImageCreateRequest struct {
+	Attributes    gameCenterAchievementImageCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterAchievementImageCreateRequestRelationships `json:"relationships"`
+	Type          string                                               `json:"type"`
+}
+
+// gameCenterAchievementImageCreateRequestAttributes are attributes for GameCenterAchievementImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimagecreaterequest/data/attributes
+type gameCenterAchievementImageCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// gameCenterAchievementImageCreateRequestRelationships are relationships for GameCenterAchievementImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimagecreaterequest/data/relationships
+type gameCenterAchievementImageCreateRequestRelationships struct {
+	GameCenterAchievementLocalization relationshipDeclaration `json:"gameCenterAchievementLocalization"`
+}
+
+// gameCenterAchievementImageUpdateRequest defines model for GameCenterAchievementImageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimageupdaterequest/data
+type gameCenterAchievementImageUpdateRequest struct {
+	Attributes *gameCenterAchievementImageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                             `json:"id"`
+	Type       string                                             `json:"type"`
+}
+
+// gameCenterAchievementImageUpdateRequestAttributes are attributes for GameCenterAchievementImageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimageupdaterequest/data/attributes
+type gameCenterAchievementImageUpdateRequestAttributes struct {
+	Uploaded *bool `json:"uploaded,omitempty"`
+}
+
+// GameCenterAchievementImageResponse defines model for GameCenterAchievementImageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementimageresponse
+type GameCenterAchievementImageResponse struct {
+	Data     GameCenterAchievementImage          `json:"data"`
+	Included []GameCenterAchievementLocalization `json:"included,omitempty"`
+	Links    DocumentLinks                       `json:"links"`
+}
+
+// ListGameCenterAchievementsQuery are query options for ListGameCenterAchievementsForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_achievements_for_a_game_center_detail
+type ListGameCenterAchievementsQuery struct {
+	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	FieldsGameCenterAchievements             []string `url:"fields[gameCenterAchievements],omitempty"`
+	FilterArchived                           []string `url:"filter[archived],omitempty"`
+	FilterID                                 []string `url:"filter[id],omitempty"`
+	FilterReferenceName                      []string `url:"filter[referenceName],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	Limit                                    int      `url:"limit,omitempty"`
+	LimitLocalizations                       int      `url:"limit[localizations],omitempty"`
+	Cursor                                   string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterAchievementQuery are query options for GetGameCenterAchievement
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_information
+type GetGameCenterAchievementQuery struct {
+	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	FieldsGameCenterAchievements             []string `url:"fields[gameCenterAchievements],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	LimitLocalizations                       int      `url:"limit[localizations],omitempty"`
+}
+
+// ListGameCenterAchievementLocalizationsQuery are query options for ListLocalizationsForGameCenterAchievement
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_achievement
+type ListGameCenterAchievementLocalizationsQuery struct {
+	FieldsGameCenterAchievementImages        []string `url:"fields[gameCenterAchievementImages],omitempty"`
+	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+	Limit                                    int      `url:"limit,omitempty"`
+	Cursor                                   string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterAchievementLocalizationQuery are query options for GetGameCenterAchievementLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_localization_information
+type GetGameCenterAchievementLocalizationQuery struct {
+	FieldsGameCenterAchievementImages        []string `url:"fields[gameCenterAchievementImages],omitempty"`
+	FieldsGameCenterAchievementLocalizations []string `url:"fields[gameCenterAchievementLocalizations],omitempty"`
+	Include                                  []string `url:"include,omitempty"`
+}
+
+// GetGameCenterAchievementImageQuery are query options for GetGameCenterAchievementImage
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_image_information
+type GetGameCenterAchievementImageQuery struct {
+	FieldsGameCenterAchievementImages []string `url:"fields[gameCenterAchievementImages],omitempty"`
+	Include                           []string `url:"include,omitempty"`
+}
+
+// ListGameCenterAchievementsForGameCenterDetail lists the achievements of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_achievements_for_a_game_center_detail
+func (s *GameCenterService) ListGameCenterAchievementsForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterAchievementsQuery) (*GameCenterAchievementsResponse, *Response, error) {
+	return Get[GameCenterAchievementsResponse](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/gameCenterAchievements", id), params)
+}
+
+// GetGameCenterAchievement gets information about an achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_information
+func (s *GameCenterService) GetGameCenterAchievement(ctx context.Context, id string, params *GetGameCenterAchievementQuery) (*GameCenterAchievementResponse, *Response, error) {
+	return Get[GameCenterAchievementResponse](ctx, s.client, fmt.Sprintf("gameCenterAchievements/%s", id), params)
+}
+
+// CreateGameCenterAchievement creates an achievement for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_achievement
+func (s *GameCenterService) CreateGameCenterAchievement(ctx context.Context, attributes GameCenterAchievementCreateRequestAttributes, gameCenterDetailID string) (*GameCenterAchievementResponse, *Response, error) {
+	req := gameCenterAchievementCreateRequest{
+		Attributes: attributes,
+		Relationships: gameCenterAchievementCreateRequestRelationships{
+			GameCenterDetail: newRelationshipDeclaration(&gameCenterDetailID, "gameCenterDetails"),
+		},
+		Type: "gameCenterAchievements",
+	}
+	res := new(GameCenterAchievementResponse)
+	resp, err := s.client.post(ctx, "gameCenterAchievements", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterAchievement modifies an achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_achievement
+func (s *GameCenterService) UpdateGameCenterAchievement(ctx context.Context, id string, attributes *GameCenterAchievementUpdateRequestAttributes) (*GameCenterAchievementResponse, *Response, error) {
+	req := gameCenterAchievementUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "gameCenterAchievements",
+	}
+	url := fmt.Sprintf("gameCenterAchievements/%s", id)
+	res := new(GameCenterAchievementResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ArchiveGameCenterAchievement archives a live achievement, hiding it from players who have not earned it.
+func (s *GameCenterService) ArchiveGameCenterAchievement(ctx context.Context, id string) (*GameCenterAchievementResponse, *Response, error) {
+	return s.UpdateGameCenterAchievement(ctx, id, &GameCenterAchievementUpdateRequestAttributes{Archived: Bool(true)})
+}
+
+// UnarchiveGameCenterAchievement makes an archived achievement available to players again.
+func (s *GameCenterService) UnarchiveGameCenterAchievement(ctx context.Context, id string) (*GameCenterAchievementResponse, *Response, error) {
+	return s.UpdateGameCenterAchievement(ctx, id, &GameCenterAchievementUpdateRequestAttributes{Archived: Bool(false)})
+}
+
+// DeleteGameCenterAchievement deletes an achievement that has never been live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_achievement
+func (s *GameCenterService) DeleteGameCenterAchievement(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterAchievements/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListLocalizationsForGameCenterAchievement lists the localized names and descriptions of an achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_localizations_for_an_achievement
+func (s *GameCenterService) ListLocalizationsForGameCenterAchievement(ctx context.Context, id string, params *ListGameCenterAchievementLocalizationsQuery) (*GameCenterAchievementLocalizationsResponse, *Response, error) {
+	return Get[GameCenterAchievementLocalizationsResponse](ctx, s.client, fmt.Sprintf("gameCenterAchievements/%s/localizations", id), params)
+}
+
+// GetGameCenterAchievementLocalization gets the localized name and descriptions of an achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_localization_information
+func (s *GameCenterService) GetGameCenterAchievementLocalization(ctx context.Context, id string, params *GetGameCenterAchievementLocalizationQuery) (*GameCenterAchievementLocalizationResponse, *Response, error) {
+	return Get[GameCenterAchievementLocalizationResponse](ctx, s.client, fmt.Sprintf("gameCenterAchievementLocalizations/%s", id), params)
+}
+
+// CreateGameCenterAchievementLocalization adds a localized name and descriptions to an achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_achievement_localization
+func (s *GameCenterService) CreateGameCenterAchievementLocalization(ctx context.Context, locale string, name string, beforeEarnedDescription string, afterEarnedDescription string, gameCenterAchievementID string) (*GameCenterAchievementLocalizationResponse, *Response, error) {
+	req := gameCenterAchievementLocalizationCreateRequest{
+		Attributes: gameCenterAchievementLocalizationCreateRequestAttributes{
+			AfterEarnedDescription:  afterEarnedDescription,
+			BeforeEarnedDescription: beforeEarnedDescription,
+			Locale:                  locale,
+			Name:                    name,
+		},
+		Relationships: gameCenterAchievementLocalizationCreateRequestRelationships{
+			GameCenterAchievement: *newRelationshipDeclaration(&gameCenterAchievementID, "gameCenterAchievements"),
+		},
+		Type: "gameCenterAchievementLocalizations",
+	}
+	res := new(GameCenterAchievementLocalizationResponse)
+	resp, err := s.client.post(ctx, "gameCenterAchievementLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterAchievementLocalization modifies the localized name or descriptions of an achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_achievement_localization
+func (s *GameCenterService) UpdateGameCenterAchievementLocalization(ctx context.Context, id string, attributes *GameCenterAchievementLocalizationUpdateRequestAttributes) (*GameCenterAchievementLocalizationResponse, *Response, error) {
+	req := gameCenterAchievementLocalizationUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "gameCenterAchievementLocalizations",
+	}
+	url := fmt.Sprintf("gameCenterAchievementLocalizations/%s", id)
+	res := new(GameCenterAchievementLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterAchievementLocalization deletes a localization of an achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_achievement_localization
+func (s *GameCenterService) DeleteGameCenterAchievementLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterAchievementLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GetGameCenterAchievementImage gets an achievement image and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_image_information
+func (s *GameCenterService) GetGameCenterAchievementImage(ctx context.Context, id string, params *GetGameCenterAchievementImageQuery) (*GameCenterAchievementImageResponse, *Response, error) {
+	return Get[GameCenterAchievementImageResponse](ctx, s.client, fmt.Sprintf("gameCenterAchievementImages/%s", id), params)
+}
+
+// GetImageForGameCenterAchievementLocalization gets the image of an achievement localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_image_information_of_an_achievement_localization
+func (s *GameCenterService) GetImageForGameCenterAchievementLocalization(ctx context.Context, id string, params *GetGameCenterAchievementImageQuery) (*GameCenterAchievementImageResponse, *Response, error) {
+	return Get[GameCenterAchievementImageResponse](ctx, s.client, fmt.Sprintf("gameCenterAchievementLocalizations/%s/gameCenterAchievementImage", id), params)
+}
+
+// CreateGameCenterAchievementImage reserves an image for an achievement localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_achievement_image
+func (s *GameCenterService) CreateGameCenterAchievementImage(ctx context.Context, fileName string, fileSize int64, gameCenterAchievementLocalizationID string) (*GameCenterAchievementImageResponse, *Response, error) {
+	req := gameCenterAchievementImageCreateRequest{
+		Attributes: gameCenterAchievementImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Relationships: gameCenterAchievementImageCreateRequestRelationships{
+			GameCenterAchievementLocalization: *newRelationshipDeclaration(&gameCenterAchievementLocalizationID, "gameCenterAchievementLocalizations"),
+		},
+		Type: "gameCenterAchievementImages",
+	}
+	res := new(GameCenterAchievementImageResponse)
+	resp, err := s.client.post(ctx, "gameCenterAchievementImages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitGameCenterAchievementImage commits an achievement image after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_an_achievement_image
+func (s *GameCenterService) CommitGameCenterAchievementImage(ctx context.Context, id string, uploaded *bool) (*GameCenterAchievementImageResponse, *Response, error) {
+	req := gameCenterAchievementImageUpdateRequest{
+		ID:   id,
+		Type: "gameCenterAchievementImages",
+	}
+
+	if uploaded != nil {
+		req.Attributes = &gameCenterAchievementImageUpdateRequestAttributes{
+			Uploaded: uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterAchievementImages/%s", id)
+	res := new(GameCenterAchievementImageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterAchievementImage deletes the image of an achievement localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_achievement_image
+func (s *GameCenterService) DeleteGameCenterAchievementImage(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterAchievementImages/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UploadGameCenterAchievementImage reserves, uploads and commits an image for an achievement localization.
+func (s *GameCenterService) UploadGameCenterAchievementImage(ctx context.Context, fileName string, file io.ReadSeeker, gameCenterAchievementLocalizationID string) (*GameCenterAchievementImageResponse, *Response, error) {
+	fileSize, _, err := fileChecksum(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation, resp, err := s.CreateGameCenterAchievementImage(ctx, fileName, fileSize, gameCenterAchievementLocalizationID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if reservation.Data.Attributes != nil {
+		err = s.client.Upload(ctx, reservation.Data.Attributes.UploadOperations, file)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return s.CommitGameCenterAchievementImage(ctx, reservation.Data.ID, Bool(true))
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterAchievementResponseIncluded.
+func (i *GameCenterAchievementResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterAchievementLocalization returns the GameCenterAchievementLocalization stored within, if one is present.
+func (i *GameCenterAchievementResponseIncluded) GameCenterAchievementLocalization() *GameCenterAchievementLocalization {
+	return extractIncludedGameCenterAchievementLocalization(i.inner)
+}
+
+// GameCenterDetail returns the GameCenterDetail stored within, if one is present.
+func (i *GameCenterAchievementResponseIncluded) GameCenterDetail() *GameCenterDetail {
+	return extractIncludedGameCenterDetail(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterAchievementLocalizationResponseIncluded.
+func (i *GameCenterAchievementLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterAchievement returns the GameCenterAchievement stored within, if one is present.
+func (i *GameCenterAchievementLocalizationResponseIncluded) GameCenterAchievement() *GameCenterAchievement {
+	return extractIncludedGameCenterAchievement(i.inner)
+}
+
+// GameCenterAchievementImage returns the GameCenterAchievementImage stored within, if one is present.
+func (i *GameCenterAchievementLocalizationResponseIncluded) GameCenterAchievementImage() *GameCenterAchievementImage {
+	return extractIncludedGameCenterAchievementImage(i.inner)
+}
@@ -0,0 +1,227 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGameCenterAchievementsForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterAchievementsForGameCenterDetail(ctx, "10", &ListGameCenterAchievementsQuery{})
+	})
+}
+
+func TestGetGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterAchievement(ctx, "10", &GetGameCenterAchievementQuery{})
+	})
+}
+
+func TestGetGameCenterAchievementIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterAchievementLocalizations"},{"type":"gameCenterDetails"}]}`, func(ctx context.Context, client *Client) {
+		achievement, _, err := client.GameCenter.GetGameCenterAchievement(ctx, "10", &GetGameCenterAchievementQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, achievement.Included, 2)
+
+		assert.NotNil(t, achievement.Included[0].GameCenterAchievementLocalization())
+		assert.NotNil(t, achievement.Included[1].GameCenterDetail())
+
+		assert.Nil(t, achievement.Included[0].GameCenterDetail())
+		assert.Nil(t, achievement.Included[1].GameCenterAchievementLocalization())
+	})
+}
+
+func TestCreateGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterAchievement(ctx, GameCenterAchievementCreateRequestAttributes{
+			Points:           10,
+			ReferenceName:    "First Blood",
+			VendorIdentifier: "first_blood",
+		}, "10")
+	})
+}
+
+func TestUpdateGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		points := 20
+
+		return client.GameCenter.UpdateGameCenterAchievement(ctx, "10", &GameCenterAchievementUpdateRequestAttributes{Points: &points})
+	})
+}
+
+func TestArchiveGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ArchiveGameCenterAchievement(ctx, "10")
+	})
+}
+
+func TestUnarchiveGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UnarchiveGameCenterAchievement(ctx, "10")
+	})
+}
+
+func TestDeleteGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterAchievement(ctx, "10")
+	})
+}
+
+func TestListLocalizationsForGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListLocalizationsForGameCenterAchievement(ctx, "10", &ListGameCenterAchievementLocalizationsQuery{})
+	})
+}
+
+func TestGetGameCenterAchievementLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterAchievementLocalization(ctx, "10", &GetGameCenterAchievementLocalizationQuery{})
+	})
+}
+
+func TestGetGameCenterAchievementLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterAchievements"},{"type":"gameCenterAchievementImages"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.GameCenter.GetGameCenterAchievementLocalization(ctx, "10", &GetGameCenterAchievementLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, localization.Included, 2)
+
+		assert.NotNil(t, localization.Included[0].GameCenterAchievement())
+		assert.NotNil(t, localization.Included[1].GameCenterAchievementImage())
+
+		assert.Nil(t, localization.Included[0].GameCenterAchievementImage())
+		assert.Nil(t, localization.Included[1].GameCenterAchievement())
+	})
+}
+
+func TestCreateGameCenterAchievementLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterAchievementLocalization(ctx, "en-US", "First Blood", "Win a match", "You won a match", "10")
+	})
+}
+
+func TestUpdateGameCenterAchievementLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterAchievementLocalization(ctx, "10", &GameCenterAchievementLocalizationUpdateRequestAttributes{Name: String("First Win")})
+	})
+}
+
+func TestDeleteGameCenterAchievementLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterAchievementLocalization(ctx, "10")
+	})
+}
+
+func TestGetGameCenterAchievementImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterAchievementImage(ctx, "10", &GetGameCenterAchievementImageQuery{})
+	})
+}
+
+func TestGetImageForGameCenterAchievementLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetImageForGameCenterAchievementLocalization(ctx, "10", &GetGameCenterAchievementImageQuery{})
+	})
+}
+
+func TestCreateGameCenterAchievementImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterAchievementImage(ctx, "badge.png", 20, "10")
+	})
+}
+
+func TestCommitGameCenterAchievementImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CommitGameCenterAchievementImage(ctx, "10", Bool(true))
+	})
+}
+
+func TestDeleteGameCenterAchievementImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterAchievementImage(ctx, "10")
+	})
+}
+
+func TestUploadGameCenterAchievementImage(t *testing.T) {
+	t.Parallel()
+
+	want := &GameCenterAchievementImageResponse{
+		Data: GameCenterAchievementImage{
+			Attributes: &GameCenterAchievementImageAttributes{UploadOperations: []UploadOperation{}},
+			ID:         "10",
+			Type:       "gameCenterAchievementImages",
+		},
+	}
+
+	testEndpointWithResponse(t, `{"data":{"id":"10","type":"gameCenterAchievementImages","attributes":{"uploadOperations":[]}}}`, want, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UploadGameCenterAchievementImage(ctx, "badge.png", bytes.NewReader([]byte("badge")), "10")
+	})
+}
+
+func TestUploadGameCenterAchievementImageError(t *testing.T) {
+	t.Parallel()
+
+	testEndpointExpectingError(t, `{"data":`, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UploadGameCenterAchievementImage(ctx, "badge.png", bytes.NewReader([]byte("badge")), "10")
+	})
+}
@@ -0,0 +1,972 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// GameCenterVersionState defines model for the state of a GameCenterActivityVersion or GameCenterChallengeVersion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterversionstate
+type GameCenterVersionState string
+
+const (
+	// GameCenterVersionStatePrepareForSubmission is a version that can still be edited.
+	GameCenterVersionStatePrepareForSubmission GameCenterVersionState = "PREPARE_FOR_SUBMISSION"
+	// GameCenterVersionStateReadyForReview is a version that is ready to be reviewed with the next app version.
+	GameCenterVersionStateReadyForReview GameCenterVersionState = "READY_FOR_REVIEW"
+	// GameCenterVersionStateWaitingForReview is a version that was submitted for review.
+	GameCenterVersionStateWaitingForReview GameCenterVersionState = "WAITING_FOR_REVIEW"
+	// GameCenterVersionStateInReview is a version that is being reviewed.
+	GameCenterVersionStateInReview GameCenterVersionState = "IN_REVIEW"
+	// GameCenterVersionStateDeveloperRejected is a version that was withdrawn from review.
+	GameCenterVersionStateDeveloperRejected GameCenterVersionState = "DEVELOPER_REJECTED"
+	// GameCenterVersionStateRejected is a version that did not pass review.
+	GameCenterVersionStateRejected GameCenterVersionState = "REJECTED"
+	// GameCenterVersionStateAccepted is a version that passed review and can be released.
+	GameCenterVersionStateAccepted GameCenterVersionState = "ACCEPTED"
+	// GameCenterVersionStateLive is the version players currently see.
+	GameCenterVersionStateLive GameCenterVersionState = "LIVE"
+	// GameCenterVersionStateReplacedWithNewVersion is a previously live version.
+	GameCenterVersionStateReplacedWithNewVersion GameCenterVersionState = "REPLACED_WITH_NEW_VERSION"
+)
+
+var gameCenterVersionStates = []GameCenterVersionState{
+	GameCenterVersionStatePrepareForSubmission,
+	GameCenterVersionStateReadyForReview,
+	GameCenterVersionStateWaitingForReview,
+	GameCenterVersionStateInReview,
+	GameCenterVersionStateDeveloperRejected,
+	GameCenterVersionStateRejected,
+	GameCenterVersionStateAccepted,
+	GameCenterVersionStateLive,
+	GameCenterVersionStateReplacedWithNewVersion,
+}
+
+// IsValid reports whether the game center version state is one this package knows about.
+func (v GameCenterVersionState) IsValid() bool {
+	for _, value := range gameCenterVersionStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseGameCenterVersionState returns s as a GameCenterVersionState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseGameCenterVersionState(s string) (GameCenterVersionState, error) {
+	if v := GameCenterVersionState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "GameCenterVersionState", Value: s}
+}
+
+// GameCenterActivityPlayStyle defines model for GameCenterActivity.Attributes.PlayStyle
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivity/attributes
+type GameCenterActivityPlayStyle string
+
+const (
+	// GameCenterActivityPlayStyleAsynchronous is for activities players take turns in.
+	GameCenterActivityPlayStyleAsynchronous GameCenterActivityPlayStyle = "ASYNCHRONOUS"
+	// GameCenterActivityPlayStyleSynchronous is for activities players play at the same time.
+	GameCenterActivityPlayStyleSynchronous GameCenterActivityPlayStyle = "SYNCHRONOUS"
+)
+
+var gameCenterActivityPlayStyles = []GameCenterActivityPlayStyle{
+	GameCenterActivityPlayStyleAsynchronous,
+	GameCenterActivityPlayStyleSynchronous,
+}
+
+// IsValid reports whether the game center activity play style is one this package knows about.
+func (v GameCenterActivityPlayStyle) IsValid() bool {
+	for _, value := range gameCenterActivityPlayStyles {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseGameCenterActivityPlayStyle returns s as a GameCenterActivityPlayStyle, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseGameCenterActivityPlayStyle(s string) (GameCenterActivityPlayStyle, error) {
+	if v := GameCenterActivityPlayStyle(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "GameCenterActivityPlayStyle", Value: s}
+}
+
+// GameCenterActivity defines model for GameCenterActivity.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivity
+type GameCenterActivity struct {
+	Attributes    *GameCenterActivityAttributes    `json:"attributes,omitempty"`
+	ID            string                           `json:"id"`
+	Links         ResourceLinks                    `json:"links"`
+	Relationships *GameCenterActivityRelationships `json:"relationships,omitempty"`
+	Type          string                           `json:"type"`
+}
+
+// GameCenterActivityAttributes defines model for GameCenterActivity.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivity/attributes
+type GameCenterActivityAttributes struct {
+	Archived            *bool                        `json:"archived,omitempty"`
+	MaximumPlayersCount *int                         `json:"maximumPlayersCount,omitempty"`
+	MinimumPlayersCount *int                         `json:"minimumPlayersCount,omitempty"`
+	PlayStyle           *GameCenterActivityPlayStyle `json:"playStyle,omitempty"`
+	Properties          map[string]string            `json:"properties,omitempty"`
+	ReferenceName       *string                      `json:"referenceName,omitempty"`
+	SupportsPartyCode   *bool                        `json:"supportsPartyCode,omitempty"`
+	VendorIdentifier    *string                      `json:"vendorIdentifier,omitempty"`
+}
+
+// GameCenterActivityRelationships defines model for GameCenterActivity.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivity/relationships
+type GameCenterActivityRelationships struct {
+	Achievements     *PagedRelationship `json:"achievements,omitempty"`
+	GameCenterDetail *Relationship      `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup  *Relationship      `json:"gameCenterGroup,omitempty"`
+	Leaderboards     *PagedRelationship `json:"leaderboards,omitempty"`
+	Versions         *PagedRelationship `json:"versions,omitempty"`
+}
+
+// gameCenterActivityCreateRequest defines model for GameCenterActivityCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitycreaterequest/data
+type gameCenterActivityCreateRequest struct {
+	Attributes    GameCenterActivityCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterActivityCreateRequestRelationships `json:"relationships"`
+	Type          string                                       `json:"type"`
+}
+
+// GameCenterActivityCreateRequestAttributes are attributes for GameCenterActivityCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitycreaterequest/data/attributes
+type GameCenterActivityCreateRequestAttributes struct {
+	MaximumPlayersCount *int                         `json:"maximumPlayersCount,omitempty"`
+	MinimumPlayersCount *int                         `json:"minimumPlayersCount,omitempty"`
+	PlayStyle           *GameCenterActivityPlayStyle `json:"playStyle,omitempty"`
+	Properties          map[string]string            `json:"properties,omitempty"`
+	ReferenceName       string                       `json:"referenceName"`
+	SupportsPartyCode   *bool                        `json:"supportsPartyCode,omitempty"`
+	VendorIdentifier    string                       `json:"vendorIdentifier"`
+}
+
+// gameCenterActivityCreateRequestRelationships are relationships for GameCenterActivityCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitycreaterequest/data/relationships
+type gameCenterActivityCreateRequestRelationships struct {
+	GameCenterDetail *relationshipDeclaration `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup  *relationshipDeclaration `json:"gameCenterGroup,omitempty"`
+}
+
+// gameCenterActivityUpdateRequest defines model for GameCenterActivityUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityupdaterequest/data
+type gameCenterActivityUpdateRequest struct {
+	Attributes *GameCenterActivityUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                     `json:"id"`
+	Type       string                                     `json:"type"`
+}
+
+// GameCenterActivityUpdateRequestAttributes are attributes for GameCenterActivityUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityupdaterequest/data/attributes
+type GameCenterActivityUpdateRequestAttributes struct {
+	Archived            *bool                        `json:"archived,omitempty"`
+	MaximumPlayersCount *int                         `json:"maximumPlayersCount,omitempty"`
+	MinimumPlayersCount *int                         `json:"minimumPlayersCount,omitempty"`
+	PlayStyle           *GameCenterActivityPlayStyle `json:"playStyle,omitempty"`
+	Properties          map[string]string            `json:"properties,omitempty"`
+	ReferenceName       *string                      `json:"referenceName,omitempty"`
+	SupportsPartyCode   *bool                        `json:"supportsPartyCode,omitempty"`
+}
+
+// GameCenterActivityResponse defines model for GameCenterActivityResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityresponse
+type GameCenterActivityResponse struct {
+	Data     GameCenterActivity                   `json:"data"`
+	Included []GameCenterActivityResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                        `json:"links"`
+}
+
+// GameCenterActivitiesResponse defines model for GameCenterActivitiesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitiesresponse
+type GameCenterActivitiesResponse struct {
+	Data     []GameCenterActivity                 `json:"data"`
+	Included []GameCenterActivityResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                   `json:"links"`
+	Meta     *PagingInformation                   `json:"meta,omitempty"`
+}
+
+// GameCenterActivityResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterActivityResponse or GameCenterActivitiesResponse.
+type GameCenterActivityResponseIncluded included
+
+// ListGameCenterActivitiesQuery are query options for ListGameCenterActivitiesForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterdetails-_id_-gamecenteractivities
+type ListGameCenterActivitiesQuery struct {
+	FieldsGameCenterActivities       []string `url:"fields[gameCenterActivities],omitempty"`
+	FieldsGameCenterActivityVersions []string `url:"fields[gameCenterActivityVersions],omitempty"`
+	FilterArchived                   []string `url:"filter[archived],omitempty"`
+	FilterID                         []string `url:"filter[id],omitempty"`
+	Include                          []string `url:"include,omitempty"`
+	Limit                            int      `url:"limit,omitempty"`
+	LimitVersions                    int      `url:"limit[versions],omitempty"`
+	Cursor                           string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterActivityQuery are query options for GetGameCenterActivity
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivities-_id_
+type GetGameCenterActivityQuery struct {
+	FieldsGameCenterActivities       []string `url:"fields[gameCenterActivities],omitempty"`
+	FieldsGameCenterActivityVersions []string `url:"fields[gameCenterActivityVersions],omitempty"`
+	Include                          []string `url:"include,omitempty"`
+	LimitVersions                    int      `url:"limit[versions],omitempty"`
+}
+
+// GameCenterActivityVersion defines model for GameCenterActivityVersion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversion
+type GameCenterActivityVersion struct {
+	Attributes    *GameCenterActivityVersionAttributes    `json:"attributes,omitempty"`
+	ID            string                                  `json:"id"`
+	Links         ResourceLinks                           `json:"links"`
+	Relationships *GameCenterActivityVersionRelationships `json:"relationships,omitempty"`
+	Type          string                                  `json:"type"`
+}
+
+// GameCenterActivityVersionAttributes defines model for GameCenterActivityVersion.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversion/attributes
+type GameCenterActivityVersionAttributes struct {
+	FallbackURL *string                 `json:"fallbackUrl,omitempty"`
+	State       *GameCenterVersionState `json:"state,omitempty"`
+	Version     *int                    `json:"version,omitempty"`
+}
+
+// GameCenterActivityVersionRelationships defines model for GameCenterActivityVersion.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversion/relationships
+type GameCenterActivityVersionRelationships struct {
+	Activity      *Relationship      `json:"activity,omitempty"`
+	DefaultImage  *Relationship      `json:"defaultImage,omitempty"`
+	Localizations *PagedRelationship `json:"localizations,omitempty"`
+	Releases      *PagedRelationship `json:"releases,omitempty"`
+}
+
+// gameCenterActivityVersionCreateRequest defines model for GameCenterActivityVersionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversioncreaterequest/data
+type gameCenterActivityVersionCreateRequest struct {
+	Relationships gameCenterActivityVersionCreateRequestRelationships `json:"relationships"`
+	Type          string                                              `json:"type"`
+}
+
+// gameCenterActivityVersionCreateRequestRelationships are relationships for GameCenterActivityVersionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversioncreaterequest/data/relationships
+type gameCenterActivityVersionCreateRequestRelationships struct {
+	Activity relationshipDeclaration `json:"activity"`
+}
+
+// gameCenterActivityVersionUpdateRequest defines model for GameCenterActivityVersionUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversionupdaterequest/data
+type gameCenterActivityVersionUpdateRequest struct {
+	Attributes *gameCenterActivityVersionUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                            `json:"id"`
+	Type       string                                            `json:"type"`
+}
+
+// gameCenterActivityVersionUpdateRequestAttributes are attributes for GameCenterActivityVersionUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversionupdaterequest/data/attributes
+type gameCenterActivityVersionUpdateRequestAttributes struct {
+	FallbackURL *string `json:"fallbackUrl,omitempty"`
+}
+
+// GameCenterActivityVersionResponse defines model for GameCenterActivityVersionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversionresponse
+type GameCenterActivityVersionResponse struct {
+	Data     GameCenterActivityVersion                   `json:"data"`
+	Included []GameCenterActivityVersionResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                               `json:"links"`
+}
+
+// GameCenterActivityVersionsResponse defines model for GameCenterActivityVersionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversionsresponse
+type GameCenterActivityVersionsResponse struct {
+	Data     []GameCenterActivityVersion                 `json:"data"`
+	Included []GameCenterActivityVersionResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                          `json:"links"`
+	Meta     *PagingInformation                          `json:"meta,omitempty"`
+}
+
+// GameCenterActivityVersionResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterActivityVersionResponse or GameCenterActivityVersionsResponse.
+type GameCenterActivityVersionResponseIncluded included
+
+// GameCenterActivityLocalization defines model for GameCenterActivityLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitylocalization
+type GameCenterActivityLocalization struct {
+	Attributes    *GameCenterActivityLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                       `json:"id"`
+	Links         ResourceLinks                                `json:"links"`
+	Relationships *GameCenterActivityLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                       `json:"type"`
+}
+
+// GameCenterActivityLocalizationAttributes defines model for GameCenterActivityLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitylocalization/attributes
+type GameCenterActivityLocalizationAttributes struct {
+	Description *string `json:"description,omitempty"`
+	Locale      *string `json:"locale,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// GameCenterActivityLocalizationRelationships defines model for GameCenterActivityLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitylocalization/relationships
+type GameCenterActivityLocalizationRelationships struct {
+	Image   *Relationship `json:"image,omitempty"`
+	Version *Relationship `json:"version,omitempty"`
+}
+
+// gameCenterActivityLocalizationCreateRequest defines model for GameCenterActivityLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitylocalizationcreaterequest/data
+type gameCenterActivityLocalizationCreateRequest struct {
+	Attributes    gameCenterActivityLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterActivityLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                   `json:"type"`
+}
+
+// gameCenterActivityLocalizationCreateRequestAttributes are attributes for GameCenterActivityLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitylocalizationcreaterequest/data/attributes
+type gameCenterActivityLocalizationCreateRequestAttributes struct {
+	Description string `json:"description"`
+	Locale      string `json:"locale"`
+	Name        string `json:"name"`
+}
+
+// gameCenterActivityLocalizationCreateRequestRelationships are relationships for GameCenterActivityLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitylocalizationcreaterequest/data/relationships
+type gameCenterActivityLocalizationCreateRequestRelationships struct {
+	Version relationshipDeclaration `json:"version"`
+}
+
+// gameCenterActivityLocalizationUpdateRequest defines model for GameCenterActivityLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitylocalizationupdaterequest/data
+type gameCenterActivityLocalizationUpdateRequest struct {
+	Attributes *gameCenterActivityLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                 `json:"id"`
+	Type       string                                                 `json:"type"`
+}
+
+// gameCenterActivityLocalizationUpdateRequestAttributes are attributes for GameCenterActivityLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitylocalizationupdaterequest/data/attributes
+type gameCenterActivityLocalizationUpdateRequestAttributes struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// GameCenterActivityLocalizationResponse defines model for GameCenterActivityLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitylocalizationresponse
+type GameCenterActivityLocalizationResponse struct {
+	Data     GameCenterActivityLocalization                   `json:"data"`
+	Included []GameCenterActivityLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                    `json:"links"`
+}
+
+// GameCenterActivityLocalizationsResponse defines model for GameCenterActivityLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivitylocalizationsresponse
+type GameCenterActivityLocalizationsResponse struct {
+	Data     []GameCenterActivityLocalization                 `json:"data"`
+	Included []GameCenterActivityLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                               `json:"links"`
+	Meta     *PagingInformation                               `json:"meta,omitempty"`
+}
+
+// GameCenterActivityLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterActivityLocalizationResponse or GameCenterActivityLocalizationsResponse.
+type GameCenterActivityLocalizationResponseIncluded included
+
+// GameCenterActivityImage defines model for GameCenterActivityImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityimage
+type GameCenterActivityImage struct {
+	Attributes *GameCenterActivityImageAttributes `json:"attributes,omitempty"`
+	ID         string                             `json:"id"`
+	Links      ResourceLinks                      `json:"links"`
+	Type       string                             `json:"type"`
+}
+
+// GameCenterActivityImageAttributes defines model for GameCenterActivityImage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityimage/attributes
+type GameCenterActivityImageAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// gameCenterActivityImageCreateRequest defines model for GameCenterActivityImageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityimagecreaterequest/data
+type gameCenterActivityImageCreateRequest struct {
+	Attributes    gameCenterActivityImageCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterActivityImageCreateRequestRelationships `json:"relationships"`
+	Type          string                                            `json:"type"`
+}
+
+// gameCenterActivityImageCreateRequestAttributes are attributes for GameCenterActivityImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityimagecreaterequest/data/attributes
+type gameCenterActivityImageCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// gameCenterActivityImageCreateRequestRelationships are relationships for GameCenterActivityImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityimagecreaterequest/data/relationships
+type gameCenterActivityImageCreateRequestRelationships struct {
+	Localization relationshipDeclaration `json:"localization"`
+}
+
+// gameCenterActivityImageUpdateRequest defines model for GameCenterActivityImageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityimageupdaterequest/data
+type gameCenterActivityImageUpdateRequest struct {
+	Attributes *gameCenterActivityImageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                          `json:"id"`
+	Type       string                                          `json:"type"`
+}
+
+// gameCenterActivityImageUpdateRequestAttributes are attributes for GameCenterActivityImageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityimageupdaterequest/data/attributes
+type gameCenterActivityImageUpdateRequestAttributes struct {
+	Uploaded *bool `json:"uploaded,omitempty"`
+}
+
+// GameCenterActivityImageResponse defines model for GameCenterActivityImageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityimageresponse
+type GameCenterActivityImageResponse struct {
+	Data  GameCenterActivityImage `json:"data"`
+	Links DocumentLinks           `json:"links"`
+}
+
+// GameCenterActivityVersionRelease defines model for GameCenterActivityVersionRelease.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversionrelease
+type GameCenterActivityVersionRelease struct {
+	ID            string                                         `json:"id"`
+	Links         ResourceLinks                                  `json:"links"`
+	Relationships *GameCenterActivityVersionReleaseRelationships `json:"relationships,omitempty"`
+	Type          string                                         `json:"type"`
+}
+
+// GameCenterActivityVersionReleaseRelationships defines model for GameCenterActivityVersionRelease.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversionrelease/relationships
+type GameCenterActivityVersionReleaseRelationships struct {
+	Version *Relationship `json:"version,omitempty"`
+}
+
+// gameCenterActivityVersionReleaseCreateRequest defines model for GameCenterActivityVersionReleaseCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversionreleasecreaterequest/data
+type gameCenterActivityVersionReleaseCreateRequest struct {
+	Relationships gameCenterActivityVersionReleaseCreateRequestRelationships `json:"relationships"`
+	Type          string                                                     `json:"type"`
+}
+
+// gameCenterActivityVersionReleaseCreateRequestRelationships are relationships for GameCenterActivityVersionReleaseCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversionreleasecreaterequest/data/relationships
+type gameCenterActivityVersionReleaseCreateRequestRelationships struct {
+	Version relationshipDeclaration `json:"version"`
+}
+
+// GameCenterActivityVersionReleaseResponse defines model for GameCenterActivityVersionReleaseResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenteractivityversionreleaseresponse
+type GameCenterActivityVersionReleaseResponse struct {
+	Data  GameCenterActivityVersionRelease `json:"data"`
+	Links DocumentLinks                    `json:"links"`
+}
+
+// ListGameCenterActivityVersionsQuery are query options for ListVersionsForGameCenterActivity
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivities-_id_-versions
+type ListGameCenterActivityVersionsQuery struct {
+	FieldsGameCenterActivityVersions []string `url:"fields[gameCenterActivityVersions],omitempty"`
+	Include                          []string `url:"include,omitempty"`
+	Limit                            int      `url:"limit,omitempty"`
+	Cursor                           string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterActivityVersionQuery are query options for GetGameCenterActivityVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivityversions-_id_
+type GetGameCenterActivityVersionQuery struct {
+	FieldsGameCenterActivityImages        []string `url:"fields[gameCenterActivityImages],omitempty"`
+	FieldsGameCenterActivityLocalizations []string `url:"fields[gameCenterActivityLocalizations],omitempty"`
+	FieldsGameCenterActivityVersions      []string `url:"fields[gameCenterActivityVersions],omitempty"`
+	Include                               []string `url:"include,omitempty"`
+	LimitLocalizations                    int      `url:"limit[localizations],omitempty"`
+}
+
+// ListGameCenterActivityLocalizationsQuery are query options for ListLocalizationsForGameCenterActivityVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivityversions-_id_-localizations
+type ListGameCenterActivityLocalizationsQuery struct {
+	FieldsGameCenterActivityImages        []string `url:"fields[gameCenterActivityImages],omitempty"`
+	FieldsGameCenterActivityLocalizations []string `url:"fields[gameCenterActivityLocalizations],omitempty"`
+	Include                               []string `url:"include,omitempty"`
+	Limit                                 int      `url:"limit,omitempty"`
+	Cursor                                string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterActivityLocalizationQuery are query options for GetGameCenterActivityLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivitylocalizations-_id_
+type GetGameCenterActivityLocalizationQuery struct {
+	FieldsGameCenterActivityImages        []string `url:"fields[gameCenterActivityImages],omitempty"`
+	FieldsGameCenterActivityLocalizations []string `url:"fields[gameCenterActivityLocalizations],omitempty"`
+	Include                               []string `url:"include,omitempty"`
+}
+
+// GetGameCenterActivityImageQuery are query options for GetGameCenterActivityImage
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivityimages-_id_
+type GetGameCenterActivityImageQuery struct {
+	FieldsGameCenterActivityImages []string `url:"fields[gameCenterActivityImages],omitempty"`
+}
+
+// GameCenterActivityVersion returns the GameCenterActivityVersion stored within, if one is present.
+func (i *GameCenterActivityResponseIncluded) GameCenterActivityVersion() *GameCenterActivityVersion {
+	return extractIncludedGameCenterActivityVersion(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterActivityResponseIncluded.
+func (i *GameCenterActivityResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// ListGameCenterActivitiesForGameCenterDetail lists the activities of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterdetails-_id_-gamecenteractivities
+func (s *GameCenterService) ListGameCenterActivitiesForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterActivitiesQuery) (*GameCenterActivitiesResponse, *Response, error) {
+	return Get[GameCenterActivitiesResponse](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/gameCenterActivities", id), params)
+}
+
+// GetGameCenterActivity gets information about an activity.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivities-_id_
+func (s *GameCenterService) GetGameCenterActivity(ctx context.Context, id string, params *GetGameCenterActivityQuery) (*GameCenterActivityResponse, *Response, error) {
+	return Get[GameCenterActivityResponse](ctx, s.client, fmt.Sprintf("gameCenterActivities/%s", id), params)
+}
+
+// CreateGameCenterActivity creates an activity for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenteractivities
+func (s *GameCenterService) CreateGameCenterActivity(ctx context.Context, attributes GameCenterActivityCreateRequestAttributes, gameCenterDetailID string) (*GameCenterActivityResponse, *Response, error) {
+	req := gameCenterActivityCreateRequest{
+		Attributes: attributes,
+		Relationships: gameCenterActivityCreateRequestRelationships{
+			GameCenterDetail: newRelationshipDeclaration(&gameCenterDetailID, "gameCenterDetails"),
+		},
+		Type: "gameCenterActivities",
+	}
+	res := new(GameCenterActivityResponse)
+	resp, err := s.client.post(ctx, "gameCenterActivities", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterActivity changes the configuration of an activity.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-gamecenteractivities-_id_
+func (s *GameCenterService) UpdateGameCenterActivity(ctx context.Context, id string, attributes *GameCenterActivityUpdateRequestAttributes) (*GameCenterActivityResponse, *Response, error) {
+	req := gameCenterActivityUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "gameCenterActivities",
+	}
+	url := fmt.Sprintf("gameCenterActivities/%s", id)
+	res := new(GameCenterActivityResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterActivity deletes an activity that has never been live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecenteractivities-_id_
+func (s *GameCenterService) DeleteGameCenterActivity(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterActivities/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// AddAchievementsToGameCenterActivity associates achievements with an activity.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenteractivities-_id_-relationships-achievements
+func (s *GameCenterService) AddAchievementsToGameCenterActivity(ctx context.Context, id string, gameCenterAchievementIDs []string) (*Response, error) {
+	linkages := newPagedRelationshipDeclaration(gameCenterAchievementIDs, "gameCenterAchievements")
+	url := fmt.Sprintf("gameCenterActivities/%s/relationships/achievements", id)
+
+	return s.client.post(ctx, url, newRequestBody(linkages.Data), nil)
+}
+
+// RemoveAchievementsFromGameCenterActivity dissociates achievements from an activity.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecenteractivities-_id_-relationships-achievements
+func (s *GameCenterService) RemoveAchievementsFromGameCenterActivity(ctx context.Context, id string, gameCenterAchievementIDs []string) (*Response, error) {
+	linkages := newPagedRelationshipDeclaration(gameCenterAchievementIDs, "gameCenterAchievements")
+	url := fmt.Sprintf("gameCenterActivities/%s/relationships/achievements", id)
+
+	return s.client.delete(ctx, url, newRequestBody(linkages.Data))
+}
+
+// AddLeaderboardsToGameCenterActivity associates leaderboards with an activity.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenteractivities-_id_-relationships-leaderboards
+func (s *GameCenterService) AddLeaderboardsToGameCenterActivity(ctx context.Context, id string, gameCenterLeaderboardIDs []string) (*Response, error) {
+	linkages := newPagedRelationshipDeclaration(gameCenterLeaderboardIDs, "gameCenterLeaderboards")
+	url := fmt.Sprintf("gameCenterActivities/%s/relationships/leaderboards", id)
+
+	return s.client.post(ctx, url, newRequestBody(linkages.Data), nil)
+}
+
+// RemoveLeaderboardsFromGameCenterActivity dissociates leaderboards from an activity.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecenteractivities-_id_-relationships-leaderboards
+func (s *GameCenterService) RemoveLeaderboardsFromGameCenterActivity(ctx context.Context, id string, gameCenterLeaderboardIDs []string) (*Response, error) {
+	linkages := newPagedRelationshipDeclaration(gameCenterLeaderboardIDs, "gameCenterLeaderboards")
+	url := fmt.Sprintf("gameCenterActivities/%s/relationships/leaderboards", id)
+
+	return s.client.delete(ctx, url, newRequestBody(linkages.Data))
+}
+
+// GameCenterActivity returns the GameCenterActivity stored within, if one is present.
+func (i *GameCenterActivityVersionResponseIncluded) GameCenterActivity() *GameCenterActivity {
+	return extractIncludedGameCenterActivity(i.inner)
+}
+
+// GameCenterActivityImage returns the GameCenterActivityImage stored within, if one is present.
+func (i *GameCenterActivityVersionResponseIncluded) GameCenterActivityImage() *GameCenterActivityImage {
+	return extractIncludedGameCenterActivityImage(i.inner)
+}
+
+// GameCenterActivityLocalization returns the GameCenterActivityLocalization stored within, if one is present.
+func (i *GameCenterActivityVersionResponseIncluded) GameCenterActivityLocalization() *GameCenterActivityLocalization {
+	return extractIncludedGameCenterActivityLocalization(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterActivityVersionResponseIncluded.
+func (i *GameCenterActivityVersionResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterActivityImage returns the GameCenterActivityImage stored within, if one is present.
+func (i *GameCenterActivityLocalizationResponseIncluded) GameCenterActivityImage() *GameCenterActivityImage {
+	return extractIncludedGameCenterActivityImage(i.inner)
+}
+
+// GameCenterActivityVersion returns the GameCenterActivityVersion stored within, if one is present.
+func (i *GameCenterActivityLocalizationResponseIncluded) GameCenterActivityVersion() *GameCenterActivityVersion {
+	return extractIncludedGameCenterActivityVersion(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterActivityLocalizationResponseIncluded.
+func (i *GameCenterActivityLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// ListVersionsForGameCenterActivity lists the versions of an activity.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivities-_id_-versions
+func (s *GameCenterService) ListVersionsForGameCenterActivity(ctx context.Context, id string, params *ListGameCenterActivityVersionsQuery) (*GameCenterActivityVersionsResponse, *Response, error) {
+	return Get[GameCenterActivityVersionsResponse](ctx, s.client, fmt.Sprintf("gameCenterActivities/%s/versions", id), params)
+}
+
+// GetGameCenterActivityVersion gets information about an activity version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivityversions-_id_
+func (s *GameCenterService) GetGameCenterActivityVersion(ctx context.Context, id string, params *GetGameCenterActivityVersionQuery) (*GameCenterActivityVersionResponse, *Response, error) {
+	return Get[GameCenterActivityVersionResponse](ctx, s.client, fmt.Sprintf("gameCenterActivityVersions/%s", id), params)
+}
+
+// CreateGameCenterActivityVersion creates a new editable version of an activity.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenteractivityversions
+func (s *GameCenterService) CreateGameCenterActivityVersion(ctx context.Context, gameCenterActivityID string) (*GameCenterActivityVersionResponse, *Response, error) {
+	req := gameCenterActivityVersionCreateRequest{
+		Relationships: gameCenterActivityVersionCreateRequestRelationships{
+			Activity: *newRelationshipDeclaration(&gameCenterActivityID, "gameCenterActivities"),
+		},
+		Type: "gameCenterActivityVersions",
+	}
+	res := new(GameCenterActivityVersionResponse)
+	resp, err := s.client.post(ctx, "gameCenterActivityVersions", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterActivityVersion changes the fallback URL of an activity version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-gamecenteractivityversions-_id_
+func (s *GameCenterService) UpdateGameCenterActivityVersion(ctx context.Context, id string, fallbackURL *string) (*GameCenterActivityVersionResponse, *Response, error) {
+	req := gameCenterActivityVersionUpdateRequest{
+		ID:   id,
+		Type: "gameCenterActivityVersions",
+	}
+	if fallbackURL != nil {
+		req.Attributes = &gameCenterActivityVersionUpdateRequestAttributes{
+			FallbackURL: fallbackURL,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterActivityVersions/%s", id)
+	res := new(GameCenterActivityVersionResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListLocalizationsForGameCenterActivityVersion lists the localizations of an activity version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivityversions-_id_-localizations
+func (s *GameCenterService) ListLocalizationsForGameCenterActivityVersion(ctx context.Context, id string, params *ListGameCenterActivityLocalizationsQuery) (*GameCenterActivityLocalizationsResponse, *Response, error) {
+	return Get[GameCenterActivityLocalizationsResponse](ctx, s.client, fmt.Sprintf("gameCenterActivityVersions/%s/localizations", id), params)
+}
+
+// GetGameCenterActivityLocalization gets information about an activity localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivitylocalizations-_id_
+func (s *GameCenterService) GetGameCenterActivityLocalization(ctx context.Context, id string, params *GetGameCenterActivityLocalizationQuery) (*GameCenterActivityLocalizationResponse, *Response, error) {
+	return Get[GameCenterActivityLocalizationResponse](ctx, s.client, fmt.Sprintf("gameCenterActivityLocalizations/%s", id), params)
+}
+
+// CreateGameCenterActivityLocalization adds a localized name and description to an activity version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenteractivitylocalizations
+func (s *GameCenterService) CreateGameCenterActivityLocalization(ctx context.Context, locale string, name string, description string, gameCenterActivityVersionID string) (*GameCenterActivityLocalizationResponse, *Response, error) {
+	req := gameCenterActivityLocalizationCreateRequest{
+		Attributes: gameCenterActivityLocalizationCreateRequestAttributes{
+			Description: description,
+			Locale:      locale,
+			Name:        name,
+		},
+		Relationships: gameCenterActivityLocalizationCreateRequestRelationships{
+			Version: *newRelationshipDeclaration(&gameCenterActivityVersionID, "gameCenterActivityVersions"),
+		},
+		Type: "gameCenterActivityLocalizations",
+	}
+	res := new(GameCenterActivityLocalizationResponse)
+	resp, err := s.client.post(ctx, "gameCenterActivityLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterActivityLocalization changes the localized name or description of an activity version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-gamecenteractivitylocalizations-_id_
+func (s *GameCenterService) UpdateGameCenterActivityLocalization(ctx context.Context, id string, name *string, description *string) (*GameCenterActivityLocalizationResponse, *Response, error) {
+	req := gameCenterActivityLocalizationUpdateRequest{
+		ID:   id,
+		Type: "gameCenterActivityLocalizations",
+	}
+	if name != nil || description != nil {
+		req.Attributes = &gameCenterActivityLocalizationUpdateRequestAttributes{
+			Description: description,
+			Name:        name,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterActivityLocalizations/%s", id)
+	res := new(GameCenterActivityLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterActivityLocalization deletes an activity localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecenteractivitylocalizations-_id_
+func (s *GameCenterService) DeleteGameCenterActivityLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterActivityLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GetGameCenterActivityImage gets an activity image and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivityimages-_id_
+func (s *GameCenterService) GetGameCenterActivityImage(ctx context.Context, id string, params *GetGameCenterActivityImageQuery) (*GameCenterActivityImageResponse, *Response, error) {
+	return Get[GameCenterActivityImageResponse](ctx, s.client, fmt.Sprintf("gameCenterActivityImages/%s", id), params)
+}
+
+// CreateGameCenterActivityImage reserves an image for an activity localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenteractivityimages
+func (s *GameCenterService) CreateGameCenterActivityImage(ctx context.Context, fileName string, fileSize int64, gameCenterActivityLocalizationID string) (*GameCenterActivityImageResponse, *Response, error) {
+	req := gameCenterActivityImageCreateRequest{
+		Attributes: gameCenterActivityImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Relationships: gameCenterActivityImageCreateRequestRelationships{
+			Localization: *newRelationshipDeclaration(&gameCenterActivityLocalizationID, "gameCenterActivityLocalizations"),
+		},
+		Type: "gameCenterActivityImages",
+	}
+	res := new(GameCenterActivityImageResponse)
+	resp, err := s.client.post(ctx, "gameCenterActivityImages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitGameCenterActivityImage commits an activity image after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-gamecenteractivityimages-_id_
+func (s *GameCenterService) CommitGameCenterActivityImage(ctx context.Context, id string, uploaded *bool) (*GameCenterActivityImageResponse, *Response, error) {
+	req := gameCenterActivityImageUpdateRequest{
+		ID:   id,
+		Type: "gameCenterActivityImages",
+	}
+	if uploaded != nil {
+		req.Attributes = &gameCenterActivityImageUpdateRequestAttributes{
+			Uploaded: uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterActivityImages/%s", id)
+	res := new(GameCenterActivityImageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterActivityImage deletes the image of an activity localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecenteractivityimages-_id_
+func (s *GameCenterService) DeleteGameCenterActivityImage(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterActivityImages/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UploadGameCenterActivityImage reserves, uploads and commits an image for an activity localization.
+func (s *GameCenterService) UploadGameCenterActivityImage(ctx context.Context, fileName string, file io.ReadSeeker, gameCenterActivityLocalizationID string) (*GameCenterActivityImageResponse, *Response, error) {
+	fileSize, _, err := fileChecksum(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation, resp, err := s.CreateGameCenterActivityImage(ctx, fileName, fileSize, gameCenterActivityLocalizationID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if reservation.Data.Attributes != nil {
+		err = s.client.Upload(ctx, reservation.Data.Attributes.UploadOperations, file)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return s.CommitGameCenterActivityImage(ctx, reservation.Data.ID, Bool(true))
+}
+
+// CreateGameCenterActivityVersionRelease releases an activity version, making it live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenteractivityversionreleases
+func (s *GameCenterService) CreateGameCenterActivityVersionRelease(ctx context.Context, gameCenterActivityVersionID string) (*GameCenterActivityVersionReleaseResponse, *Response, error) {
+	req := gameCenterActivityVersionReleaseCreateRequest{
+		Relationships: gameCenterActivityVersionReleaseCreateRequestRelationships{
+			Version: *newRelationshipDeclaration(&gameCenterActivityVersionID, "gameCenterActivityVersions"),
+		},
+		Type: "gameCenterActivityVersionReleases",
+	}
+	res := new(GameCenterActivityVersionReleaseResponse)
+	resp, err := s.client.post(ctx, "gameCenterActivityVersionReleases", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// GetGameCenterActivityVersionRelease gets information about an activity version release.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenteractivityversionreleases-_id_
+func (s *GameCenterService) GetGameCenterActivityVersionRelease(ctx context.Context, id string) (*GameCenterActivityVersionReleaseResponse, *Response, error) {
+	return Get[GameCenterActivityVersionReleaseResponse](ctx, s.client, fmt.Sprintf("gameCenterActivityVersionReleases/%s", id), nil)
+}
+
+// DeleteGameCenterActivityVersionRelease deletes an activity version release that is not live yet.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecenteractivityversionreleases-_id_
+func (s *GameCenterService) DeleteGameCenterActivityVersionRelease(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterActivityVersionReleases/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
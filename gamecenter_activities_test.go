@@ -0,0 +1,311 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGameCenterActivitiesForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivitiesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterActivitiesForGameCenterDetail(ctx, "10", &ListGameCenterActivitiesQuery{})
+	})
+}
+
+func TestGetGameCenterActivity(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterActivity(ctx, "10", &GetGameCenterActivityQuery{})
+	})
+}
+
+func TestGetGameCenterActivityIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterActivityVersions"}]}`, func(ctx context.Context, client *Client) {
+		activity, _, err := client.GameCenter.GetGameCenterActivity(ctx, "10", &GetGameCenterActivityQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, activity.Included, 1)
+
+		assert.NotNil(t, activity.Included[0].GameCenterActivityVersion())
+	})
+}
+
+func TestCreateGameCenterActivity(t *testing.T) {
+	t.Parallel()
+
+	playStyle := GameCenterActivityPlayStyleSynchronous
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterActivity(ctx, GameCenterActivityCreateRequestAttributes{
+			PlayStyle:        &playStyle,
+			ReferenceName:    "Boss Rush",
+			VendorIdentifier: "boss_rush",
+		}, "10")
+	})
+}
+
+func TestUpdateGameCenterActivity(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterActivity(ctx, "10", &GameCenterActivityUpdateRequestAttributes{SupportsPartyCode: Bool(true)})
+	})
+}
+
+func TestDeleteGameCenterActivity(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterActivity(ctx, "10")
+	})
+}
+
+func TestAddAchievementsToGameCenterActivity(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.AddAchievementsToGameCenterActivity(ctx, "10", []string{"20"})
+	})
+}
+
+func TestRemoveAchievementsFromGameCenterActivity(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.RemoveAchievementsFromGameCenterActivity(ctx, "10", []string{"20"})
+	})
+}
+
+func TestAddLeaderboardsToGameCenterActivity(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.AddLeaderboardsToGameCenterActivity(ctx, "10", []string{"20"})
+	})
+}
+
+func TestRemoveLeaderboardsFromGameCenterActivity(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.RemoveLeaderboardsFromGameCenterActivity(ctx, "10", []string{"20"})
+	})
+}
+
+func TestListVersionsForGameCenterActivity(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListVersionsForGameCenterActivity(ctx, "10", &ListGameCenterActivityVersionsQuery{})
+	})
+}
+
+func TestGetGameCenterActivityVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterActivityVersion(ctx, "10", &GetGameCenterActivityVersionQuery{})
+	})
+}
+
+func TestGetGameCenterActivityVersionIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterActivities"},{"type":"gameCenterActivityImages"},{"type":"gameCenterActivityLocalizations"}]}`, func(ctx context.Context, client *Client) {
+		version, _, err := client.GameCenter.GetGameCenterActivityVersion(ctx, "10", &GetGameCenterActivityVersionQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, version.Included, 3)
+
+		assert.NotNil(t, version.Included[0].GameCenterActivity())
+		assert.NotNil(t, version.Included[1].GameCenterActivityImage())
+		assert.NotNil(t, version.Included[2].GameCenterActivityLocalization())
+
+		assert.Nil(t, version.Included[0].GameCenterActivityLocalization())
+		assert.Nil(t, version.Included[1].GameCenterActivity())
+		assert.Nil(t, version.Included[2].GameCenterActivityImage())
+	})
+}
+
+func TestCreateGameCenterActivityVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterActivityVersion(ctx, "10")
+	})
+}
+
+func TestUpdateGameCenterActivityVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterActivityVersion(ctx, "10", String("https://example.com/activity"))
+	})
+}
+
+func TestListLocalizationsForGameCenterActivityVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListLocalizationsForGameCenterActivityVersion(ctx, "10", &ListGameCenterActivityLocalizationsQuery{})
+	})
+}
+
+func TestGetGameCenterActivityLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterActivityLocalization(ctx, "10", &GetGameCenterActivityLocalizationQuery{})
+	})
+}
+
+func TestGetGameCenterActivityLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterActivityImages"},{"type":"gameCenterActivityVersions"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.GameCenter.GetGameCenterActivityLocalization(ctx, "10", &GetGameCenterActivityLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, localization.Included, 2)
+
+		assert.NotNil(t, localization.Included[0].GameCenterActivityImage())
+		assert.NotNil(t, localization.Included[1].GameCenterActivityVersion())
+
+		assert.Nil(t, localization.Included[0].GameCenterActivityVersion())
+		assert.Nil(t, localization.Included[1].GameCenterActivityImage())
+	})
+}
+
+func TestCreateGameCenterActivityLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterActivityLocalization(ctx, "en-US", "Boss Rush", "Defeat every boss", "10")
+	})
+}
+
+func TestUpdateGameCenterActivityLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterActivityLocalization(ctx, "10", String("Boss Rush II"), nil)
+	})
+}
+
+func TestDeleteGameCenterActivityLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterActivityLocalization(ctx, "10")
+	})
+}
+
+func TestGetGameCenterActivityImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterActivityImage(ctx, "10", &GetGameCenterActivityImageQuery{})
+	})
+}
+
+func TestCreateGameCenterActivityImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterActivityImage(ctx, "image.png", 20, "10")
+	})
+}
+
+func TestCommitGameCenterActivityImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CommitGameCenterActivityImage(ctx, "10", Bool(true))
+	})
+}
+
+func TestDeleteGameCenterActivityImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterActivityImage(ctx, "10")
+	})
+}
+
+func TestUploadGameCenterActivityImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UploadGameCenterActivityImage(ctx, "image.png", bytes.NewReader([]byte("image")), "10")
+	})
+}
+
+func TestCreateGameCenterActivityVersionRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityVersionReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterActivityVersionRelease(ctx, "10")
+	})
+}
+
+func TestGetGameCenterActivityVersionRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterActivityVersionReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterActivityVersionRelease(ctx, "10")
+	})
+}
+
+func TestDeleteGameCenterActivityVersionRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterActivityVersionRelease(ctx, "10")
+	})
+}
+
+func TestParseGameCenterVersionState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseGameCenterVersionState(string(GameCenterVersionStatePrepareForSubmission))
+	assert.NoError(t, err)
+	assert.Equal(t, GameCenterVersionStatePrepareForSubmission, got)
+
+	_, err = ParseGameCenterVersionState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "GameCenterVersionState", Value: "UNKNOWN"}, err)
+}
+
+func TestParseGameCenterActivityPlayStyle(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseGameCenterActivityPlayStyle(string(GameCenterActivityPlayStyleAsynchronous))
+	assert.NoError(t, err)
+	assert.Equal(t, GameCenterActivityPlayStyleAsynchronous, got)
+
+	_, err = ParseGameCenterActivityPlayStyle("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "GameCenterActivityPlayStyle", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,926 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// GameCenterChallengeType defines model for GameCenterChallenge.Attributes.ChallengeType
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallenge/attributes
+type GameCenterChallengeType string
+
+const (
+	// GameCenterChallengeTypeLeaderboard is for challenges scored with a leaderboard.
+	GameCenterChallengeTypeLeaderboard GameCenterChallengeType = "LEADERBOARD"
+)
+
+var gameCenterChallengeTypes = []GameCenterChallengeType{
+	GameCenterChallengeTypeLeaderboard,
+}
+
+// IsValid reports whether the game center challenge type is one this package knows about.
+func (v GameCenterChallengeType) IsValid() bool {
+	for _, value := range gameCenterChallengeTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseGameCenterChallengeType returns s as a GameCenterChallengeType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseGameCenterChallengeType(s string) (GameCenterChallengeType, error) {
+	if v := GameCenterChallengeType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "GameCenterChallengeType", Value: s}
+}
+
+// GameCenterChallengeDuration defines model for the durations in GameCenterChallenge.Attributes.AllowedDurations
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallenge/attributes
+type GameCenterChallengeDuration string
+
+const (
+	// GameCenterChallengeDurationOneDay is for challenges that last a day.
+	GameCenterChallengeDurationOneDay GameCenterChallengeDuration = "ONE_DAY"
+	// GameCenterChallengeDurationThreeDays is for challenges that last three days.
+	GameCenterChallengeDurationThreeDays GameCenterChallengeDuration = "THREE_DAYS"
+	// GameCenterChallengeDurationOneWeek is for challenges that last a week.
+	GameCenterChallengeDurationOneWeek GameCenterChallengeDuration = "ONE_WEEK"
+)
+
+var gameCenterChallengeDurations = []GameCenterChallengeDuration{
+	GameCenterChallengeDurationOneDay,
+	GameCenterChallengeDurationThreeDays,
+	GameCenterChallengeDurationOneWeek,
+}
+
+// IsValid reports whether the game center challenge duration is one this package knows about.
+func (v GameCenterChallengeDuration) IsValid() bool {
+	for _, value := range gameCenterChallengeDurations {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseGameCenterChallengeDuration returns s as a GameCenterChallengeDuration, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseGameCenterChallengeDuration(s string) (GameCenterChallengeDuration, error) {
+	if v := GameCenterChallengeDuration(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "GameCenterChallengeDuration", Value: s}
+}
+
+// GameCenterChallenge defines model for GameCenterChallenge.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallenge
+type GameCenterChallenge struct {
+	Attributes    *GameCenterChallengeAttributes    `json:"attributes,omitempty"`
+	ID            string                            `json:"id"`
+	Links         ResourceLinks                     `json:"links"`
+	Relationships *GameCenterChallengeRelationships `json:"relationships,omitempty"`
+	Type          string                            `json:"type"`
+}
+
+// GameCenterChallengeAttributes defines model for GameCenterChallenge.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallenge/attributes
+type GameCenterChallengeAttributes struct {
+	AllowedDurations []GameCenterChallengeDuration `json:"allowedDurations,omitempty"`
+	Archived         *bool                         `json:"archived,omitempty"`
+	ChallengeType    *GameCenterChallengeType      `json:"challengeType,omitempty"`
+	ReferenceName    *string                       `json:"referenceName,omitempty"`
+	Repeatable       *bool                         `json:"repeatable,omitempty"`
+	VendorIdentifier *string                       `json:"vendorIdentifier,omitempty"`
+}
+
+// GameCenterChallengeRelationships defines model for GameCenterChallenge.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallenge/relationships
+type GameCenterChallengeRelationships struct {
+	GameCenterDetail *Relationship      `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup  *Relationship      `json:"gameCenterGroup,omitempty"`
+	Leaderboard      *Relationship      `json:"leaderboard,omitempty"`
+	Versions         *PagedRelationship `json:"versions,omitempty"`
+}
+
+// gameCenterChallengeCreateRequest defines model for GameCenterChallengeCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengecreaterequest/data
+type gameCenterChallengeCreateRequest struct {
+	Attributes    GameCenterChallengeCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterChallengeCreateRequestRelationships `json:"relationships"`
+	Type          string                                        `json:"type"`
+}
+
+// GameCenterChallengeCreateRequestAttributes are attributes for GameCenterChallengeCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengecreaterequest/data/attributes
+type GameCenterChallengeCreateRequestAttributes struct {
+	AllowedDurations []GameCenterChallengeDuration `json:"allowedDurations,omitempty"`
+	ChallengeType    GameCenterChallengeType       `json:"challengeType"`
+	ReferenceName    string                        `json:"referenceName"`
+	Repeatable       *bool                         `json:"repeatable,omitempty"`
+	VendorIdentifier string                        `json:"vendorIdentifier"`
+}
+
+// gameCenterChallengeCreateRequestRelationships are relationships for GameCenterChallengeCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengecreaterequest/data/relationships
+type gameCenterChallengeCreateRequestRelationships struct {
+	GameCenterDetail *relationshipDeclaration `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup  *relationshipDeclaration `json:"gameCenterGroup,omitempty"`
+	Leaderboard      *relationshipDeclaration `json:"leaderboard,omitempty"`
+}
+
+// gameCenterChallengeUpdateRequest defines model for GameCenterChallengeUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeupdaterequest/data
+type gameCenterChallengeUpdateRequest struct {
+	Attributes    *GameCenterChallengeUpdateRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                                         `json:"id"`
+	Relationships *gameCenterChallengeUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                         `json:"type"`
+}
+
+// GameCenterChallengeUpdateRequestAttributes are attributes for GameCenterChallengeUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeupdaterequest/data/attributes
+type GameCenterChallengeUpdateRequestAttributes struct {
+	AllowedDurations []GameCenterChallengeDuration `json:"allowedDurations,omitempty"`
+	Archived         *bool                         `json:"archived,omitempty"`
+	ReferenceName    *string                       `json:"referenceName,omitempty"`
+	Repeatable       *bool                         `json:"repeatable,omitempty"`
+}
+
+// gameCenterChallengeUpdateRequestRelationships are relationships for GameCenterChallengeUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeupdaterequest/data/relationships
+type gameCenterChallengeUpdateRequestRelationships struct {
+	Leaderboard *relationshipDeclaration `json:"leaderboard,omitempty"`
+}
+
+// GameCenterChallengeResponse defines model for GameCenterChallengeResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeresponse
+type GameCenterChallengeResponse struct {
+	Data     GameCenterChallenge                   `json:"data"`
+	Included []GameCenterChallengeResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                         `json:"links"`
+}
+
+// GameCenterChallengesResponse defines model for GameCenterChallengesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengesresponse
+type GameCenterChallengesResponse struct {
+	Data     []GameCenterChallenge                 `json:"data"`
+	Included []GameCenterChallengeResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                    `json:"links"`
+	Meta     *PagingInformation                    `json:"meta,omitempty"`
+}
+
+// GameCenterChallengeResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterChallengeResponse or GameCenterChallengesResponse.
+type GameCenterChallengeResponseIncluded included
+
+// ListGameCenterChallengesQuery are query options for ListGameCenterChallengesForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterdetails-_id_-gamecenterchallenges
+type ListGameCenterChallengesQuery struct {
+	FieldsGameCenterChallengeVersions []string `url:"fields[gameCenterChallengeVersions],omitempty"`
+	FieldsGameCenterChallenges        []string `url:"fields[gameCenterChallenges],omitempty"`
+	FieldsGameCenterLeaderboards      []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	FilterArchived                    []string `url:"filter[archived],omitempty"`
+	FilterID                          []string `url:"filter[id],omitempty"`
+	Include                           []string `url:"include,omitempty"`
+	Limit                             int      `url:"limit,omitempty"`
+	LimitVersions                     int      `url:"limit[versions],omitempty"`
+	Cursor                            string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterChallengeQuery are query options for GetGameCenterChallenge
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallenges-_id_
+type GetGameCenterChallengeQuery struct {
+	FieldsGameCenterChallengeVersions []string `url:"fields[gameCenterChallengeVersions],omitempty"`
+	FieldsGameCenterChallenges        []string `url:"fields[gameCenterChallenges],omitempty"`
+	FieldsGameCenterLeaderboards      []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                           []string `url:"include,omitempty"`
+	LimitVersions                     int      `url:"limit[versions],omitempty"`
+}
+
+// GameCenterChallengeVersion defines model for GameCenterChallengeVersion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversion
+type GameCenterChallengeVersion struct {
+	Attributes    *GameCenterChallengeVersionAttributes    `json:"attributes,omitempty"`
+	ID            string                                   `json:"id"`
+	Links         ResourceLinks                            `json:"links"`
+	Relationships *GameCenterChallengeVersionRelationships `json:"relationships,omitempty"`
+	Type          string                                   `json:"type"`
+}
+
+// GameCenterChallengeVersionAttributes defines model for GameCenterChallengeVersion.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversion/attributes
+type GameCenterChallengeVersionAttributes struct {
+	FallbackURL *string                 `json:"fallbackUrl,omitempty"`
+	State       *GameCenterVersionState `json:"state,omitempty"`
+	Version     *int                    `json:"version,omitempty"`
+}
+
+// GameCenterChallengeVersionRelationships defines model for GameCenterChallengeVersion.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversion/relationships
+type GameCenterChallengeVersionRelationships struct {
+	Challenge     *Relationship      `json:"challenge,omitempty"`
+	DefaultImage  *Relationship      `json:"defaultImage,omitempty"`
+	Localizations *PagedRelationship `json:"localizations,omitempty"`
+	Releases      *PagedRelationship `json:"releases,omitempty"`
+}
+
+// gameCenterChallengeVersionCreateRequest defines model for GameCenterChallengeVersionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversioncreaterequest/data
+type gameCenterChallengeVersionCreateRequest struct {
+	Relationships gameCenterChallengeVersionCreateRequestRelationships `json:"relationships"`
+	Type          string                                               `json:"type"`
+}
+
+// gameCenterChallengeVersionCreateRequestRelationships are relationships for GameCenterChallengeVersionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversioncreaterequest/data/relationships
+type gameCenterChallengeVersionCreateRequestRelationships struct {
+	Challenge relationshipDeclaration `json:"challenge"`
+}
+
+// gameCenterChallengeVersionUpdateRequest defines model for GameCenterChallengeVersionUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversionupdaterequest/data
+type gameCenterChallengeVersionUpdateRequest struct {
+	Attributes *gameCenterChallengeVersionUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                             `json:"id"`
+	Type       string                                             `json:"type"`
+}
+
+// gameCenterChallengeVersionUpdateRequestAttributes are attributes for GameCenterChallengeVersionUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversionupdaterequest/data/attributes
+type gameCenterChallengeVersionUpdateRequestAttributes struct {
+	FallbackURL *string `json:"fallbackUrl,omitempty"`
+}
+
+// GameCenterChallengeVersionResponse defines model for GameCenterChallengeVersionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversionresponse
+type GameCenterChallengeVersionResponse struct {
+	Data     GameCenterChallengeVersion                   `json:"data"`
+	Included []GameCenterChallengeVersionResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                `json:"links"`
+}
+
+// GameCenterChallengeVersionsResponse defines model for GameCenterChallengeVersionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversionsresponse
+type GameCenterChallengeVersionsResponse struct {
+	Data     []GameCenterChallengeVersion                 `json:"data"`
+	Included []GameCenterChallengeVersionResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                           `json:"links"`
+	Meta     *PagingInformation                           `json:"meta,omitempty"`
+}
+
+// GameCenterChallengeVersionResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterChallengeVersionResponse or GameCenterChallengeVersionsResponse.
+type GameCenterChallengeVersionResponseIncluded included
+
+// GameCenterChallengeLocalization defines model for GameCenterChallengeLocalization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengelocalization
+type GameCenterChallengeLocalization struct {
+	Attributes    *GameCenterChallengeLocalizationAttributes    `json:"attributes,omitempty"`
+	ID            string                                        `json:"id"`
+	Links         ResourceLinks                                 `json:"links"`
+	Relationships *GameCenterChallengeLocalizationRelationships `json:"relationships,omitempty"`
+	Type          string                                        `json:"type"`
+}
+
+// GameCenterChallengeLocalizationAttributes defines model for GameCenterChallengeLocalization.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengelocalization/attributes
+type GameCenterChallengeLocalizationAttributes struct {
+	Description *string `json:"description,omitempty"`
+	Locale      *string `json:"locale,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// GameCenterChallengeLocalizationRelationships defines model for GameCenterChallengeLocalization.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengelocalization/relationships
+type GameCenterChallengeLocalizationRelationships struct {
+	Image   *Relationship `json:"image,omitempty"`
+	Version *Relationship `json:"version,omitempty"`
+}
+
+// gameCenterChallengeLocalizationCreateRequest defines model for GameCenterChallengeLocalizationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengelocalizationcreaterequest/data
+type gameCenterChallengeLocalizationCreateRequest struct {
+	Attributes    gameCenterChallengeLocalizationCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterChallengeLocalizationCreateRequestRelationships `json:"relationships"`
+	Type          string                                                    `json:"type"`
+}
+
+// gameCenterChallengeLocalizationCreateRequestAttributes are attributes for GameCenterChallengeLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengelocalizationcreaterequest/data/attributes
+type gameCenterChallengeLocalizationCreateRequestAttributes struct {
+	Description string `json:"description"`
+	Locale      string `json:"locale"`
+	Name        string `json:"name"`
+}
+
+// gameCenterChallengeLocalizationCreateRequestRelationships are relationships for GameCenterChallengeLocalizationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengelocalizationcreaterequest/data/relationships
+type gameCenterChallengeLocalizationCreateRequestRelationships struct {
+	Version relationshipDeclaration `json:"version"`
+}
+
+// gameCenterChallengeLocalizationUpdateRequest defines model for GameCenterChallengeLocalizationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengelocalizationupdaterequest/data
+type gameCenterChallengeLocalizationUpdateRequest struct {
+	Attributes *gameCenterChallengeLocalizationUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                                  `json:"id"`
+	Type       string                                                  `json:"type"`
+}
+
+// gameCenterChallengeLocalizationUpdateRequestAttributes are attributes for GameCenterChallengeLocalizationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengelocalizationupdaterequest/data/attributes
+type gameCenterChallengeLocalizationUpdateRequestAttributes struct {
+	Description *string `json:"description,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+// GameCenterChallengeLocalizationResponse defines model for GameCenterChallengeLocalizationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengelocalizationresponse
+type GameCenterChallengeLocalizationResponse struct {
+	Data     GameCenterChallengeLocalization                   `json:"data"`
+	Included []GameCenterChallengeLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                     `json:"links"`
+}
+
+// GameCenterChallengeLocalizationsResponse defines model for GameCenterChallengeLocalizationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengelocalizationsresponse
+type GameCenterChallengeLocalizationsResponse struct {
+	Data     []GameCenterChallengeLocalization                 `json:"data"`
+	Included []GameCenterChallengeLocalizationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                `json:"links"`
+	Meta     *PagingInformation                                `json:"meta,omitempty"`
+}
+
+// GameCenterChallengeLocalizationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterChallengeLocalizationResponse or GameCenterChallengeLocalizationsResponse.
+type GameCenterChallengeLocalizationResponseIncluded included
+
+// GameCenterChallengeImage defines model for GameCenterChallengeImage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeimage
+type GameCenterChallengeImage struct {
+	Attributes *GameCenterChallengeImageAttributes `json:"attributes,omitempty"`
+	ID         string                              `json:"id"`
+	Links      ResourceLinks                       `json:"links"`
+	Type       string                              `json:"type"`
+}
+
+// GameCenterChallengeImageAttributes defines model for GameCenterChallengeImage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeimage/attributes
+type GameCenterChallengeImageAttributes struct {
+	AssetDeliveryState *AppMediaAssetState `json:"assetDeliveryState,omitempty"`
+	FileName           *string             `json:"fileName,omitempty"`
+	FileSize           *int64              `json:"fileSize,omitempty"`
+	ImageAsset         *ImageAsset         `json:"imageAsset,omitempty"`
+	UploadOperations   []UploadOperation   `json:"uploadOperations,omitempty"`
+}
+
+// gameCenterChallengeImageCreateRequest defines model for GameCenterChallengeImageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeimagecreaterequest/data
+type gameCenterChallengeImageCreateRequest struct {
+	Attributes    gameCenterChallengeImageCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterChallengeImageCreateRequestRelationships `json:"relationships"`
+	Type          string                                             `json:"type"`
+}
+
+// gameCenterChallengeImageCreateRequestAttributes are attributes for GameCenterChallengeImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeimagecreaterequest/data/attributes
+type gameCenterChallengeImageCreateRequestAttributes struct {
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// gameCenterChallengeImageCreateRequestRelationships are relationships for GameCenterChallengeImageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeimagecreaterequest/data/relationships
+type gameCenterChallengeImageCreateRequestRelationships struct {
+	Localization relationshipDeclaration `json:"localization"`
+}
+
+// gameCenterChallengeImageUpdateRequest defines model for GameCenterChallengeImageUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeimageupdaterequest/data
+type gameCenterChallengeImageUpdateRequest struct {
+	Attributes *gameCenterChallengeImageUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                           `json:"id"`
+	Type       string                                           `json:"type"`
+}
+
+// gameCenterChallengeImageUpdateRequestAttributes are attributes for GameCenterChallengeImageUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeimageupdaterequest/data/attributes
+type gameCenterChallengeImageUpdateRequestAttributes struct {
+	Uploaded *bool `json:"uploaded,omitempty"`
+}
+
+// GameCenterChallengeImageResponse defines model for GameCenterChallengeImageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeimageresponse
+type GameCenterChallengeImageResponse struct {
+	Data  GameCenterChallengeImage `json:"data"`
+	Links DocumentLinks            `json:"links"`
+}
+
+// GameCenterChallengeVersionRelease defines model for GameCenterChallengeVersionRelease.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversionrelease
+type GameCenterChallengeVersionRelease struct {
+	ID            string                                          `json:"id"`
+	Links         ResourceLinks                                   `json:"links"`
+	Relationships *GameCenterChallengeVersionReleaseRelationships `json:"relationships,omitempty"`
+	Type          string                                          `json:"type"`
+}
+
+// GameCenterChallengeVersionReleaseRelationships defines model for GameCenterChallengeVersionRelease.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversionrelease/relationships
+type GameCenterChallengeVersionReleaseRelationships struct {
+	Version *Relationship `json:"version,omitempty"`
+}
+
+// gameCenterChallengeVersionReleaseCreateRequest defines model for GameCenterChallengeVersionReleaseCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversionreleasecreaterequest/data
+type gameCenterChallengeVersionReleaseCreateRequest struct {
+	Relationships gameCenterChallengeVersionReleaseCreateRequestRelationships `json:"relationships"`
+	Type          string                                                      `json:"type"`
+}
+
+// gameCenterChallengeVersionReleaseCreateRequestRelationships are relationships for GameCenterChallengeVersionReleaseCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversionreleasecreaterequest/data/relationships
+type gameCenterChallengeVersionReleaseCreateRequestRelationships struct {
+	Version relationshipDeclaration `json:"version"`
+}
+
+// GameCenterChallengeVersionReleaseResponse defines model for GameCenterChallengeVersionReleaseResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterchallengeversionreleaseresponse
+type GameCenterChallengeVersionReleaseResponse struct {
+	Data  GameCenterChallengeVersionRelease `json:"data"`
+	Links DocumentLinks                     `json:"links"`
+}
+
+// ListGameCenterChallengeVersionsQuery are query options for ListVersionsForGameCenterChallenge
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallenges-_id_-versions
+type ListGameCenterChallengeVersionsQuery struct {
+	FieldsGameCenterChallengeVersions []string `url:"fields[gameCenterChallengeVersions],omitempty"`
+	Include                           []string `url:"include,omitempty"`
+	Limit                             int      `url:"limit,omitempty"`
+	Cursor                            string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterChallengeVersionQuery are query options for GetGameCenterChallengeVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallengeversions-_id_
+type GetGameCenterChallengeVersionQuery struct {
+	FieldsGameCenterChallengeImages        []string `url:"fields[gameCenterChallengeImages],omitempty"`
+	FieldsGameCenterChallengeLocalizations []string `url:"fields[gameCenterChallengeLocalizations],omitempty"`
+	FieldsGameCenterChallengeVersions      []string `url:"fields[gameCenterChallengeVersions],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+	LimitLocalizations                     int      `url:"limit[localizations],omitempty"`
+}
+
+// ListGameCenterChallengeLocalizationsQuery are query options for ListLocalizationsForGameCenterChallengeVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallengeversions-_id_-localizations
+type ListGameCenterChallengeLocalizationsQuery struct {
+	FieldsGameCenterChallengeImages        []string `url:"fields[gameCenterChallengeImages],omitempty"`
+	FieldsGameCenterChallengeLocalizations []string `url:"fields[gameCenterChallengeLocalizations],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+	Limit                                  int      `url:"limit,omitempty"`
+	Cursor                                 string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterChallengeLocalizationQuery are query options for GetGameCenterChallengeLocalization
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallengelocalizations-_id_
+type GetGameCenterChallengeLocalizationQuery struct {
+	FieldsGameCenterChallengeImages        []string `url:"fields[gameCenterChallengeImages],omitempty"`
+	FieldsGameCenterChallengeLocalizations []string `url:"fields[gameCenterChallengeLocalizations],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+}
+
+// GetGameCenterChallengeImageQuery are query options for GetGameCenterChallengeImage
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallengeimages-_id_
+type GetGameCenterChallengeImageQuery struct {
+	FieldsGameCenterChallengeImages []string `url:"fields[gameCenterChallengeImages],omitempty"`
+}
+
+// GameCenterChallengeVersion returns the GameCenterChallengeVersion stored within, if one is present.
+func (i *GameCenterChallengeResponseIncluded) GameCenterChallengeVersion() *GameCenterChallengeVersion {
+	return extractIncludedGameCenterChallengeVersion(i.inner)
+}
+
+// GameCenterLeaderboard returns the GameCenterLeaderboard stored within, if one is present.
+func (i *GameCenterChallengeResponseIncluded) GameCenterLeaderboard() *GameCenterLeaderboard {
+	return extractIncludedGameCenterLeaderboard(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterChallengeResponseIncluded.
+func (i *GameCenterChallengeResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// ListGameCenterChallengesForGameCenterDetail lists the challenges of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterdetails-_id_-gamecenterchallenges
+func (s *GameCenterService) ListGameCenterChallengesForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterChallengesQuery) (*GameCenterChallengesResponse, *Response, error) {
+	return Get[GameCenterChallengesResponse](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/gameCenterChallenges", id), params)
+}
+
+// GetGameCenterChallenge gets information about a challenge.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallenges-_id_
+func (s *GameCenterService) GetGameCenterChallenge(ctx context.Context, id string, params *GetGameCenterChallengeQuery) (*GameCenterChallengeResponse, *Response, error) {
+	return Get[GameCenterChallengeResponse](ctx, s.client, fmt.Sprintf("gameCenterChallenges/%s", id), params)
+}
+
+// CreateGameCenterChallenge creates a challenge for an app, scored with the given leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenterchallenges
+func (s *GameCenterService) CreateGameCenterChallenge(ctx context.Context, attributes GameCenterChallengeCreateRequestAttributes, gameCenterDetailID string, gameCenterLeaderboardID *string) (*GameCenterChallengeResponse, *Response, error) {
+	req := gameCenterChallengeCreateRequest{
+		Attributes: attributes,
+		Relationships: gameCenterChallengeCreateRequestRelationships{
+			GameCenterDetail: newRelationshipDeclaration(&gameCenterDetailID, "gameCenterDetails"),
+			Leaderboard:      newRelationshipDeclaration(gameCenterLeaderboardID, "gameCenterLeaderboards"),
+		},
+		Type: "gameCenterChallenges",
+	}
+	res := new(GameCenterChallengeResponse)
+	resp, err := s.client.post(ctx, "gameCenterChallenges", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterChallenge changes the configuration of a challenge, or the leaderboard it is scored with.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-gamecenterchallenges-_id_
+func (s *GameCenterService) UpdateGameCenterChallenge(ctx context.Context, id string, attributes *GameCenterChallengeUpdateRequestAttributes, gameCenterLeaderboardID *string) (*GameCenterChallengeResponse, *Response, error) {
+	req := gameCenterChallengeUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "gameCenterChallenges",
+	}
+	if gameCenterLeaderboardID != nil {
+		req.Relationships = &gameCenterChallengeUpdateRequestRelationships{
+			Leaderboard: newRelationshipDeclaration(gameCenterLeaderboardID, "gameCenterLeaderboards"),
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterChallenges/%s", id)
+	res := new(GameCenterChallengeResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterChallenge deletes a challenge that has never been live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecenterchallenges-_id_
+func (s *GameCenterService) DeleteGameCenterChallenge(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterChallenges/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GameCenterChallenge returns the GameCenterChallenge stored within, if one is present.
+func (i *GameCenterChallengeVersionResponseIncluded) GameCenterChallenge() *GameCenterChallenge {
+	return extractIncludedGameCenterChallenge(i.inner)
+}
+
+// GameCenterChallengeImage returns the GameCenterChallengeImage stored within, if one is present.
+func (i *GameCenterChallengeVersionResponseIncluded) GameCenterChallengeImage() *GameCenterChallengeImage {
+	return extractIncludedGameCenterChallengeImage(i.inner)
+}
+
+// GameCenterChallengeLocalization returns the GameCenterChallengeLocalization stored within, if one is present.
+func (i *GameCenterChallengeVersionResponseIncluded) GameCenterChallengeLocalization() *GameCenterChallengeLocalization {
+	return extractIncludedGameCenterChallengeLocalization(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterChallengeVersionResponseIncluded.
+func (i *GameCenterChallengeVersionResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// GameCenterChallengeImage returns the GameCenterChallengeImage stored within, if one is present.
+func (i *GameCenterChallengeLocalizationResponseIncluded) GameCenterChallengeImage() *GameCenterChallengeImage {
+	return extractIncludedGameCenterChallengeImage(i.inner)
+}
+
+// GameCenterChallengeVersion returns the GameCenterChallengeVersion stored within, if one is present.
+func (i *GameCenterChallengeLocalizationResponseIncluded) GameCenterChallengeVersion() *GameCenterChallengeVersion {
+	return extractIncludedGameCenterChallengeVersion(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterChallengeLocalizationResponseIncluded.
+func (i *GameCenterChallengeLocalizationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// ListVersionsForGameCenterChallenge lists the versions of a challenge.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallenges-_id_-versions
+func (s *GameCenterService) ListVersionsForGameCenterChallenge(ctx context.Context, id string, params *ListGameCenterChallengeVersionsQuery) (*GameCenterChallengeVersionsResponse, *Response, error) {
+	return Get[GameCenterChallengeVersionsResponse](ctx, s.client, fmt.Sprintf("gameCenterChallenges/%s/versions", id), params)
+}
+
+// GetGameCenterChallengeVersion gets information about a challenge version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallengeversions-_id_
+func (s *GameCenterService) GetGameCenterChallengeVersion(ctx context.Context, id string, params *GetGameCenterChallengeVersionQuery) (*GameCenterChallengeVersionResponse, *Response, error) {
+	return Get[GameCenterChallengeVersionResponse](ctx, s.client, fmt.Sprintf("gameCenterChallengeVersions/%s", id), params)
+}
+
+// CreateGameCenterChallengeVersion creates a new editable version of a challenge.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenterchallengeversions
+func (s *GameCenterService) CreateGameCenterChallengeVersion(ctx context.Context, gameCenterChallengeID string) (*GameCenterChallengeVersionResponse, *Response, error) {
+	req := gameCenterChallengeVersionCreateRequest{
+		Relationships: gameCenterChallengeVersionCreateRequestRelationships{
+			Challenge: *newRelationshipDeclaration(&gameCenterChallengeID, "gameCenterChallenges"),
+		},
+		Type: "gameCenterChallengeVersions",
+	}
+	res := new(GameCenterChallengeVersionResponse)
+	resp, err := s.client.post(ctx, "gameCenterChallengeVersions", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterChallengeVersion changes the fallback URL of a challenge version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-gamecenterchallengeversions-_id_
+func (s *GameCenterService) UpdateGameCenterChallengeVersion(ctx context.Context, id string, fallbackURL *string) (*GameCenterChallengeVersionResponse, *Response, error) {
+	req := gameCenterChallengeVersionUpdateRequest{
+		ID:   id,
+		Type: "gameCenterChallengeVersions",
+	}
+	if fallbackURL != nil {
+		req.Attributes = &gameCenterChallengeVersionUpdateRequestAttributes{
+			FallbackURL: fallbackURL,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterChallengeVersions/%s", id)
+	res := new(GameCenterChallengeVersionResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListLocalizationsForGameCenterChallengeVersion lists the localizations of a challenge version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallengeversions-_id_-localizations
+func (s *GameCenterService) ListLocalizationsForGameCenterChallengeVersion(ctx context.Context, id string, params *ListGameCenterChallengeLocalizationsQuery) (*GameCenterChallengeLocalizationsResponse, *Response, error) {
+	return Get[GameCenterChallengeLocalizationsResponse](ctx, s.client, fmt.Sprintf("gameCenterChallengeVersions/%s/localizations", id), params)
+}
+
+// GetGameCenterChallengeLocalization gets information about a challenge localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallengelocalizations-_id_
+func (s *GameCenterService) GetGameCenterChallengeLocalization(ctx context.Context, id string, params *GetGameCenterChallengeLocalizationQuery) (*GameCenterChallengeLocalizationResponse, *Response, error) {
+	return Get[GameCenterChallengeLocalizationResponse](ctx, s.client, fmt.Sprintf("gameCenterChallengeLocalizations/%s", id), params)
+}
+
+// CreateGameCenterChallengeLocalization adds a localized name and description to a challenge version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenterchallengelocalizations
+func (s *GameCenterService) CreateGameCenterChallengeLocalization(ctx context.Context, locale string, name string, description string, gameCenterChallengeVersionID string) (*GameCenterChallengeLocalizationResponse, *Response, error) {
+	req := gameCenterChallengeLocalizationCreateRequest{
+		Attributes: gameCenterChallengeLocalizationCreateRequestAttributes{
+			Description: description,
+			Locale:      locale,
+			Name:        name,
+		},
+		Relationships: gameCenterChallengeLocalizationCreateRequestRelationships{
+			Version: *newRelationshipDeclaration(&gameCenterChallengeVersionID, "gameCenterChallengeVersions"),
+		},
+		Type: "gameCenterChallengeLocalizations",
+	}
+	res := new(GameCenterChallengeLocalizationResponse)
+	resp, err := s.client.post(ctx, "gameCenterChallengeLocalizations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterChallengeLocalization changes the localized name or description of a challenge version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-gamecenterchallengelocalizations-_id_
+func (s *GameCenterService) UpdateGameCenterChallengeLocalization(ctx context.Context, id string, name *string, description *string) (*GameCenterChallengeLocalizationResponse, *Response, error) {
+	req := gameCenterChallengeLocalizationUpdateRequest{
+		ID:   id,
+		Type: "gameCenterChallengeLocalizations",
+	}
+	if name != nil || description != nil {
+		req.Attributes = &gameCenterChallengeLocalizationUpdateRequestAttributes{
+			Description: description,
+			Name:        name,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterChallengeLocalizations/%s", id)
+	res := new(GameCenterChallengeLocalizationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterChallengeLocalization deletes a challenge localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecenterchallengelocalizations-_id_
+func (s *GameCenterService) DeleteGameCenterChallengeLocalization(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterChallengeLocalizations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// GetGameCenterChallengeImage gets a challenge image and its upload and processing status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallengeimages-_id_
+func (s *GameCenterService) GetGameCenterChallengeImage(ctx context.Context, id string, params *GetGameCenterChallengeImageQuery) (*GameCenterChallengeImageResponse, *Response, error) {
+	return Get[GameCenterChallengeImageResponse](ctx, s.client, fmt.Sprintf("gameCenterChallengeImages/%s", id), params)
+}
+
+// CreateGameCenterChallengeImage reserves an image for a challenge localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenterchallengeimages
+func (s *GameCenterService) CreateGameCenterChallengeImage(ctx context.Context, fileName string, fileSize int64, gameCenterChallengeLocalizationID string) (*GameCenterChallengeImageResponse, *Response, error) {
+	req := gameCenterChallengeImageCreateRequest{
+		Attributes: gameCenterChallengeImageCreateRequestAttributes{
+			FileName: fileName,
+			FileSize: fileSize,
+		},
+		Relationships: gameCenterChallengeImageCreateRequestRelationships{
+			Localization: *newRelationshipDeclaration(&gameCenterChallengeLocalizationID, "gameCenterChallengeLocalizations"),
+		},
+		Type: "gameCenterChallengeImages",
+	}
+	res := new(GameCenterChallengeImageResponse)
+	resp, err := s.client.post(ctx, "gameCenterChallengeImages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// CommitGameCenterChallengeImage commits a challenge image after uploading it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch-v1-gamecenterchallengeimages-_id_
+func (s *GameCenterService) CommitGameCenterChallengeImage(ctx context.Context, id string, uploaded *bool) (*GameCenterChallengeImageResponse, *Response, error) {
+	req := gameCenterChallengeImageUpdateRequest{
+		ID:   id,
+		Type: "gameCenterChallengeImages",
+	}
+	if uploaded != nil {
+		req.Attributes = &gameCenterChallengeImageUpdateRequestAttributes{
+			Uploaded: uploaded,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterChallengeImages/%s", id)
+	res := new(GameCenterChallengeImageResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterChallengeImage deletes the image of a challenge localization.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecenterchallengeimages-_id_
+func (s *GameCenterService) DeleteGameCenterChallengeImage(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterChallengeImages/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UploadGameCenterChallengeImage reserves, uploads and commits an image for a challenge localization.
+func (s *GameCenterService) UploadGameCenterChallengeImage(ctx context.Context, fileName string, file io.ReadSeeker, gameCenterChallengeLocalizationID string) (*GameCenterChallengeImageResponse, *Response, error) {
+	fileSize, _, err := fileChecksum(file)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	reservation, resp, err := s.CreateGameCenterChallengeImage(ctx, fileName, fileSize, gameCenterChallengeLocalizationID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if reservation.Data.Attributes != nil {
+		err = s.client.Upload(ctx, reservation.Data.Attributes.UploadOperations, file)
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	return s.CommitGameCenterChallengeImage(ctx, reservation.Data.ID, Bool(true))
+}
+
+// CreateGameCenterChallengeVersionRelease releases a challenge version, making it live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post-v1-gamecenterchallengeversionreleases
+func (s *GameCenterService) CreateGameCenterChallengeVersionRelease(ctx context.Context, gameCenterChallengeVersionID string) (*GameCenterChallengeVersionReleaseResponse, *Response, error) {
+	req := gameCenterChallengeVersionReleaseCreateRequest{
+		Relationships: gameCenterChallengeVersionReleaseCreateRequestRelationships{
+			Version: *newRelationshipDeclaration(&gameCenterChallengeVersionID, "gameCenterChallengeVersions"),
+		},
+		Type: "gameCenterChallengeVersionReleases",
+	}
+	res := new(GameCenterChallengeVersionReleaseResponse)
+	resp, err := s.client.post(ctx, "gameCenterChallengeVersionReleases", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// GetGameCenterChallengeVersionRelease gets information about a challenge version release.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get-v1-gamecenterchallengeversionreleases-_id_
+func (s *GameCenterService) GetGameCenterChallengeVersionRelease(ctx context.Context, id string) (*GameCenterChallengeVersionReleaseResponse, *Response, error) {
+	return Get[GameCenterChallengeVersionReleaseResponse](ctx, s.client, fmt.Sprintf("gameCenterChallengeVersionReleases/%s", id), nil)
+}
+
+// DeleteGameCenterChallengeVersionRelease deletes a challenge version release that is not live yet.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete-v1-gamecenterchallengeversionreleases-_id_
+func (s *GameCenterService) DeleteGameCenterChallengeVersionRelease(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterChallengeVersionReleases/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
@@ -0,0 +1,282 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGameCenterChallengesForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterChallengesForGameCenterDetail(ctx, "10", &ListGameCenterChallengesQuery{})
+	})
+}
+
+func TestGetGameCenterChallenge(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterChallenge(ctx, "10", &GetGameCenterChallengeQuery{})
+	})
+}
+
+func TestGetGameCenterChallengeIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterChallengeVersions"},{"type":"gameCenterLeaderboards"}]}`, func(ctx context.Context, client *Client) {
+		challenge, _, err := client.GameCenter.GetGameCenterChallenge(ctx, "10", &GetGameCenterChallengeQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, challenge.Included, 2)
+
+		assert.NotNil(t, challenge.Included[0].GameCenterChallengeVersion())
+		assert.NotNil(t, challenge.Included[1].GameCenterLeaderboard())
+
+		assert.Nil(t, challenge.Included[0].GameCenterLeaderboard())
+		assert.Nil(t, challenge.Included[1].GameCenterChallengeVersion())
+	})
+}
+
+func TestCreateGameCenterChallenge(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterChallenge(ctx, GameCenterChallengeCreateRequestAttributes{
+			AllowedDurations: []GameCenterChallengeDuration{GameCenterChallengeDurationOneDay},
+			ChallengeType:    GameCenterChallengeTypeLeaderboard,
+			ReferenceName:    "Speedrun",
+			VendorIdentifier: "speedrun",
+		}, "10", String("20"))
+	})
+}
+
+func TestUpdateGameCenterChallenge(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterChallenge(ctx, "10", &GameCenterChallengeUpdateRequestAttributes{Repeatable: Bool(true)}, String("20"))
+	})
+}
+
+func TestDeleteGameCenterChallenge(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterChallenge(ctx, "10")
+	})
+}
+
+func TestListVersionsForGameCenterChallenge(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListVersionsForGameCenterChallenge(ctx, "10", &ListGameCenterChallengeVersionsQuery{})
+	})
+}
+
+func TestGetGameCenterChallengeVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterChallengeVersion(ctx, "10", &GetGameCenterChallengeVersionQuery{})
+	})
+}
+
+func TestGetGameCenterChallengeVersionIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterChallenges"},{"type":"gameCenterChallengeImages"},{"type":"gameCenterChallengeLocalizations"}]}`, func(ctx context.Context, client *Client) {
+		version, _, err := client.GameCenter.GetGameCenterChallengeVersion(ctx, "10", &GetGameCenterChallengeVersionQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, version.Included, 3)
+
+		assert.NotNil(t, version.Included[0].GameCenterChallenge())
+		assert.NotNil(t, version.Included[1].GameCenterChallengeImage())
+		assert.NotNil(t, version.Included[2].GameCenterChallengeLocalization())
+
+		assert.Nil(t, version.Included[0].GameCenterChallengeLocalization())
+		assert.Nil(t, version.Included[1].GameCenterChallenge())
+		assert.Nil(t, version.Included[2].GameCenterChallengeImage())
+	})
+}
+
+func TestCreateGameCenterChallengeVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterChallengeVersion(ctx, "10")
+	})
+}
+
+func TestUpdateGameCenterChallengeVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterChallengeVersion(ctx, "10", String("https://example.com/challenge"))
+	})
+}
+
+func TestListLocalizationsForGameCenterChallengeVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeLocalizationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListLocalizationsForGameCenterChallengeVersion(ctx, "10", &ListGameCenterChallengeLocalizationsQuery{})
+	})
+}
+
+func TestGetGameCenterChallengeLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterChallengeLocalization(ctx, "10", &GetGameCenterChallengeLocalizationQuery{})
+	})
+}
+
+func TestGetGameCenterChallengeLocalizationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterChallengeImages"},{"type":"gameCenterChallengeVersions"}]}`, func(ctx context.Context, client *Client) {
+		localization, _, err := client.GameCenter.GetGameCenterChallengeLocalization(ctx, "10", &GetGameCenterChallengeLocalizationQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, localization.Included, 2)
+
+		assert.NotNil(t, localization.Included[0].GameCenterChallengeImage())
+		assert.NotNil(t, localization.Included[1].GameCenterChallengeVersion())
+
+		assert.Nil(t, localization.Included[0].GameCenterChallengeVersion())
+		assert.Nil(t, localization.Included[1].GameCenterChallengeImage())
+	})
+}
+
+func TestCreateGameCenterChallengeLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterChallengeLocalization(ctx, "en-US", "Boss Rush", "Defeat every boss", "10")
+	})
+}
+
+func TestUpdateGameCenterChallengeLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeLocalizationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterChallengeLocalization(ctx, "10", String("Boss Rush II"), nil)
+	})
+}
+
+func TestDeleteGameCenterChallengeLocalization(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterChallengeLocalization(ctx, "10")
+	})
+}
+
+func TestGetGameCenterChallengeImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterChallengeImage(ctx, "10", &GetGameCenterChallengeImageQuery{})
+	})
+}
+
+func TestCreateGameCenterChallengeImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterChallengeImage(ctx, "image.png", 20, "10")
+	})
+}
+
+func TestCommitGameCenterChallengeImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CommitGameCenterChallengeImage(ctx, "10", Bool(true))
+	})
+}
+
+func TestDeleteGameCenterChallengeImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterChallengeImage(ctx, "10")
+	})
+}
+
+func TestUploadGameCenterChallengeImage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeImageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UploadGameCenterChallengeImage(ctx, "image.png", bytes.NewReader([]byte("image")), "10")
+	})
+}
+
+func TestCreateGameCenterChallengeVersionRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeVersionReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterChallengeVersionRelease(ctx, "10")
+	})
+}
+
+func TestGetGameCenterChallengeVersionRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterChallengeVersionReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterChallengeVersionRelease(ctx, "10")
+	})
+}
+
+func TestDeleteGameCenterChallengeVersionRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterChallengeVersionRelease(ctx, "10")
+	})
+}
+
+func TestParseGameCenterChallengeType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseGameCenterChallengeType(string(GameCenterChallengeTypeLeaderboard))
+	assert.NoError(t, err)
+	assert.Equal(t, GameCenterChallengeTypeLeaderboard, got)
+
+	_, err = ParseGameCenterChallengeType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "GameCenterChallengeType", Value: "UNKNOWN"}, err)
+}
+
+func TestParseGameCenterChallengeDuration(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseGameCenterChallengeDuration(string(GameCenterChallengeDurationOneDay))
+	assert.NoError(t, err)
+	assert.Equal(t, GameCenterChallengeDurationOneDay, got)
+
+	_, err = ParseGameCenterChallengeDuration("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "GameCenterChallengeDuration", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,477 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// GameCenterDetail defines model for GameCenterDetail.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetail
+type GameCenterDetail struct {
+	Attributes    *GameCenterDetailAttributes    `json:"attributes,omitempty"`
+	ID            string                         `json:"id"`
+	Links         ResourceLinks                  `json:"links"`
+	Relationships *GameCenterDetailRelationships `json:"relationships,omitempty"`
+	Type          string                         `json:"type"`
+}
+
+// GameCenterDetailAttributes defines model for GameCenterDetail.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetail/attributes
+type GameCenterDetailAttributes struct {
+	ArcadeEnabled    *bool `json:"arcadeEnabled,omitempty"`
+	ChallengeEnabled *bool `json:"challengeEnabled,omitempty"`
+}
+
+// GameCenterDetailRelationships defines model for GameCenterDetail.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetail/relationships
+type GameCenterDetailRelationships struct {
+	AchievementReleases       *PagedRelationship `json:"achievementReleases,omitempty"`
+	ActivityReleases          *PagedRelationship `json:"activityReleases,omitempty"`
+	App                       *Relationship      `json:"app,omitempty"`
+	ChallengeReleases         *PagedRelationship `json:"challengeReleases,omitempty"`
+	DefaultGroupLeaderboard   *Relationship      `json:"defaultGroupLeaderboard,omitempty"`
+	DefaultLeaderboard        *Relationship      `json:"defaultLeaderboard,omitempty"`
+	GameCenterAchievements    *PagedRelationship `json:"gameCenterAchievements,omitempty"`
+	GameCenterActivities      *PagedRelationship `json:"gameCenterActivities,omitempty"`
+	GameCenterAppVersions     *PagedRelationship `json:"gameCenterAppVersions,omitempty"`
+	GameCenterChallenges      *PagedRelationship `json:"gameCenterChallenges,omitempty"`
+	GameCenterGroup           *Relationship      `json:"gameCenterGroup,omitempty"`
+	GameCenterLeaderboards    *PagedRelationship `json:"gameCenterLeaderboards,omitempty"`
+	GameCenterLeaderboardSets *PagedRelationship `json:"gameCenterLeaderboardSets,omitempty"`
+	LeaderboardReleases       *PagedRelationship `json:"leaderboardReleases,omitempty"`
+	LeaderboardSetReleases    *PagedRelationship `json:"leaderboardSetReleases,omitempty"`
+}
+
+// gameCenterDetailCreateRequest defines model for GameCenterDetailCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailcreaterequest/data
+type gameCenterDetailCreateRequest struct {
+	Attributes    *gameCenterDetailCreateRequestAttributes   `json:"attributes,omitempty"`
+	Relationships gameCenterDetailCreateRequestRelationships `json:"relationships"`
+	Type          string                                     `json:"type"`
+}
+
+// gameCenterDetailCreateRequestAttributes are attributes for GameCenterDetailCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailcreaterequest/data/attributes
+type gameCenterDetailCreateRequestAttributes struct {
+	ChallengeEnabled *bool `json:"challengeEnabled,omitempty"`
+}
+
+// gameCenterDetailCreateRequestRelationships are relationships for GameCenterDetailCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailcreaterequest/data/relationships
+type gameCenterDetailCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// gameCenterDetailUpdateRequest defines model for GameCenterDetailUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailupdaterequest/data
+type gameCenterDetailUpdateRequest struct {
+	Attributes    *GameCenterDetailUpdateRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                                      `json:"id"`
+	Relationships *gameCenterDetailUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                      `json:"type"`
+}
+
+// GameCenterDetailUpdateRequestAttributes are attributes for GameCenterDetailUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailupdaterequest/data/attributes
+type GameCenterDetailUpdateRequestAttributes struct {
+	ChallengeEnabled *bool `json:"challengeEnabled,omitempty"`
+}
+
+// gameCenterDetailUpdateRequestRelationships are relationships for GameCenterDetailUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailupdaterequest/data/relationships
+type gameCenterDetailUpdateRequestRelationships struct {
+	DefaultGroupLeaderboard *relationshipDeclaration `json:"defaultGroupLeaderboard,omitempty"`
+	DefaultLeaderboard      *relationshipDeclaration `json:"defaultLeaderboard,omitempty"`
+	GameCenterGroup         *relationshipDeclaration `json:"gameCenterGroup,omitempty"`
+}
+
+// GameCenterDetailResponse defines model for GameCenterDetailResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailresponse
+type GameCenterDetailResponse struct {
+	Data     GameCenterDetail                   `json:"data"`
+	Included []GameCenterDetailResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                      `json:"links"`
+}
+
+// GameCenterDetailsResponse defines model for GameCenterDetailsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailsresponse
+type GameCenterDetailsResponse struct {
+	Data     []GameCenterDetail                 `json:"data"`
+	Included []GameCenterDetailResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                 `json:"links"`
+	Meta     *PagingInformation                 `json:"meta,omitempty"`
+}
+
+// GameCenterDetailResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterDetailResponse or GameCenterDetailsResponse.
+type GameCenterDetailResponseIncluded included
+
+// GameCenterDetailLinkagesResponse defines model for the GameCenterDetail linkages responses of its achievements,
+// leaderboards and leaderboard sets.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterdetailgamecenterachievementslinkagesresponse
+type GameCenterDetailLinkagesResponse struct {
+	Data  []RelationshipData `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// GameCenterAppVersion defines model for GameCenterAppVersion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterappversion
+type GameCenterAppVersion struct {
+	Attributes    *GameCenterAppVersionAttributes    `json:"attributes,omitempty"`
+	ID            string                             `json:"id"`
+	Links         ResourceLinks                      `json:"links"`
+	Relationships *GameCenterAppVersionRelationships `json:"relationships,omitempty"`
+	Type          string                             `json:"type"`
+}
+
+// GameCenterAppVersionAttributes defines model for GameCenterAppVersion.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterappversion/attributes
+type GameCenterAppVersionAttributes struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// GameCenterAppVersionRelationships defines model for GameCenterAppVersion.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterappversion/relationships
+type GameCenterAppVersionRelationships struct {
+	AppStoreVersion       *Relationship      `json:"appStoreVersion,omitempty"`
+	CompatibilityVersions *PagedRelationship `json:"compatibilityVersions,omitempty"`
+}
+
+// gameCenterAppVersionCreateRequest defines model for GameCenterAppVersionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterappversioncreaterequest/data
+type gameCenterAppVersionCreateRequest struct {
+	Relationships gameCenterAppVersionCreateRequestRelationships `json:"relationships"`
+	Type          string                                         `json:"type"`
+}
+
+// gameCenterAppVersionCreateRequestRelationships are relationships for GameCenterAppVersionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterappversioncreaterequest/data/relationships
+type gameCenterAppVersionCreateRequestRelationships struct {
+	AppStoreVersion relationshipDeclaration `json:"appStoreVersion"`
+}
+
+// gameCenterAppVersionUpdateRequest defines model for GameCenterAppVersionUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterappversionupdaterequest/data
+type gameCenterAppVersionUpdateRequest struct {
+	Attributes *gameCenterAppVersionUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                       `json:"id"`
+	Type       string                                       `json:"type"`
+}
+
+// gameCenterAppVersionUpdateRequestAttributes are attributes for GameCenterAppVersionUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterappversionupdaterequest/data/attributes
+type gameCenterAppVersionUpdateRequestAttributes struct {
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+// GameCenterAppVersionResponse defines model for GameCenterAppVersionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterappversionresponse
+type GameCenterAppVersionResponse struct {
+	Data  GameCenterAppVersion `json:"data"`
+	Links DocumentLinks        `json:"links"`
+}
+
+// GameCenterAppVersionsResponse defines model for GameCenterAppVersionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterappversionsresponse
+type GameCenterAppVersionsResponse struct {
+	Data  []GameCenterAppVersion `json:"data"`
+	Links PagedDocumentLinks     `json:"links"`
+	Meta  *PagingInformation     `json:"meta,omitempty"`
+}
+
+// GetGameCenterDetailQuery are query options for GetGameCenterDetail and GetGameCenterDetailForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_detail_information
+type GetGameCenterDetailQuery struct {
+	FieldsGameCenterAppVersions []string `url:"fields[gameCenterAppVersions],omitempty"`
+	FieldsGameCenterDetails     []string `url:"fields[gameCenterDetails],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	LimitGameCenterAppVersions  int      `url:"limit[gameCenterAppVersions],omitempty"`
+}
+
+// ListGameCenterAppVersionsForGameCenterDetailQuery are query options for ListGameCenterAppVersionsForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_game_center_app_versions_for_a_game_center_detail
+type ListGameCenterAppVersionsForGameCenterDetailQuery struct {
+	FieldsGameCenterAppVersions []string `url:"fields[gameCenterAppVersions],omitempty"`
+	FilterEnabled               []string `url:"filter[enabled],omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterAppVersionQuery are query options for GetGameCenterAppVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_app_version_information
+type GetGameCenterAppVersionQuery struct {
+	FieldsGameCenterAppVersions []string `url:"fields[gameCenterAppVersions],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+}
+
+// ListGameCenterDetailLinkagesQuery are query options for the linkages of the achievements, leaderboards and
+// leaderboard sets of a Game Center detail.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_gamecenterdetails_id_relationships_gamecenterachievements
+type ListGameCenterDetailLinkagesQuery struct {
+	Limit  int    `url:"limit,omitempty"`
+	Cursor string `url:"cursor,omitempty"`
+}
+
+// GetGameCenterDetail gets the Game Center configuration of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_detail_information
+func (s *GameCenterService) GetGameCenterDetail(ctx context.Context, id string, params *GetGameCenterDetailQuery) (*GameCenterDetailResponse, *Response, error) {
+	return Get[GameCenterDetailResponse](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s", id), params)
+}
+
+// GetGameCenterDetailForApp gets the Game Center configuration of an app by the app's ID.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_game_center_detail_information_of_an_app
+func (s *GameCenterService) GetGameCenterDetailForApp(ctx context.Context, id string, params *GetGameCenterDetailQuery) (*GameCenterDetailResponse, *Response, error) {
+	return Get[GameCenterDetailResponse](ctx, s.client, fmt.Sprintf("apps/%s/gameCenterDetail", id), params)
+}
+
+// CreateGameCenterDetail enables Game Center for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_detail
+func (s *GameCenterService) CreateGameCenterDetail(ctx context.Context, appID string, challengeEnabled *bool) (*GameCenterDetailResponse, *Response, error) {
+	req := gameCenterDetailCreateRequest{
+		Relationships: gameCenterDetailCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "gameCenterDetails",
+	}
+
+	if challengeEnabled != nil {
+		req.Attributes = &gameCenterDetailCreateRequestAttributes{
+			ChallengeEnabled: challengeEnabled,
+		}
+	}
+
+	res := new(GameCenterDetailResponse)
+	resp, err := s.client.post(ctx, "gameCenterDetails", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterDetail turns challenges on or off for an app, or changes its default leaderboard. The default
+// leaderboard is left unchanged when its ID is nil.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_detail
+func (s *GameCenterService) UpdateGameCenterDetail(ctx context.Context, id string, attributes *GameCenterDetailUpdateRequestAttributes, defaultLeaderboardID *string) (*GameCenterDetailResponse, *Response, error) {
+	req := gameCenterDetailUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "gameCenterDetails",
+	}
+
+	if defaultLeaderboardID != nil {
+		req.Relationships = &gameCenterDetailUpdateRequestRelationships{
+			DefaultLeaderboard: newRelationshipDeclaration(defaultLeaderboardID, "gameCenterLeaderboards"),
+		}
+	}
+
+	return s.updateGameCenterDetail(ctx, req)
+}
+
+// EnableGameCenterForApp returns the Game Center configuration of an app, creating it first if Game Center is not
+// enabled for the app yet.
+func (s *GameCenterService) EnableGameCenterForApp(ctx context.Context, appID string) (*GameCenterDetailResponse, *Response, error) {
+	res, resp, err := s.GetGameCenterDetailForApp(ctx, appID, nil)
+	if err == nil {
+		return res, resp, nil
+	}
+
+	var erro *ErrorResponse
+	if !errors.As(err, &erro) || erro.Response == nil || erro.Response.StatusCode != http.StatusNotFound {
+		return nil, resp, err
+	}
+
+	return s.CreateGameCenterDetail(ctx, appID, nil)
+}
+
+// ListGameCenterAppVersionsForGameCenterDetail lists the app versions Game Center is configured for.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_game_center_app_versions_for_a_game_center_detail
+func (s *GameCenterService) ListGameCenterAppVersionsForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterAppVersionsForGameCenterDetailQuery) (*GameCenterAppVersionsResponse, *Response, error) {
+	return Get[GameCenterAppVersionsResponse](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/gameCenterAppVersions", id), params)
+}
+
+// GetGameCenterAppVersion gets the Game Center configuration of an app version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_app_version_information
+func (s *GameCenterService) GetGameCenterAppVersion(ctx context.Context, id string, params *GetGameCenterAppVersionQuery) (*GameCenterAppVersionResponse, *Response, error) {
+	return Get[GameCenterAppVersionResponse](ctx, s.client, fmt.Sprintf("gameCenterAppVersions/%s", id), params)
+}
+
+// CreateGameCenterAppVersion adds Game Center to an App Store version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_app_version
+func (s *GameCenterService) CreateGameCenterAppVersion(ctx context.Context, appStoreVersionID string) (*GameCenterAppVersionResponse, *Response, error) {
+	req := gameCenterAppVersionCreateRequest{
+		Relationships: gameCenterAppVersionCreateRequestRelationships{
+			AppStoreVersion: *newRelationshipDeclaration(&appStoreVersionID, "appStoreVersions"),
+		},
+		Type: "gameCenterAppVersions",
+	}
+	res := new(GameCenterAppVersionResponse)
+	resp, err := s.client.post(ctx, "gameCenterAppVersions", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterAppVersion enables or disables Game Center for an App Store version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_app_version
+func (s *GameCenterService) UpdateGameCenterAppVersion(ctx context.Context, id string, enabled *bool) (*GameCenterAppVersionResponse, *Response, error) {
+	req := gameCenterAppVersionUpdateRequest{
+		ID:   id,
+		Type: "gameCenterAppVersions",
+	}
+
+	if enabled != nil {
+		req.Attributes = &gameCenterAppVersionUpdateRequestAttributes{
+			Enabled: enabled,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterAppVersions/%s", id)
+	res := new(GameCenterAppVersionResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListAchievementIDsForGameCenterDetail lists the IDs of the achievements of an app, in the order players see them.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_gamecenterdetails_id_relationships_gamecenterachievements
+func (s *GameCenterService) ListAchievementIDsForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterDetailLinkagesQuery) (*GameCenterDetailLinkagesResponse, *Response, error) {
+	return s.listGameCenterDetailLinkages(ctx, id, "gameCenterAchievements", params)
+}
+
+// ReorderAchievementsForGameCenterDetail sets the order in which players see the achievements of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_gamecenterdetails_id_relationships_gamecenterachievements
+func (s *GameCenterService) ReorderAchievementsForGameCenterDetail(ctx context.Context, id string, gameCenterAchievementIDs []string) (*Response, error) {
+	return s.replaceGameCenterDetailLinkages(ctx, id, "gameCenterAchievements", gameCenterAchievementIDs)
+}
+
+// ListLeaderboardIDsForGameCenterDetail lists the IDs of the leaderboards of an app, in the order players see them.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_gamecenterdetails_id_relationships_gamecenterleaderboards
+func (s *GameCenterService) ListLeaderboardIDsForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterDetailLinkagesQuery) (*GameCenterDetailLinkagesResponse, *Response, error) {
+	return s.listGameCenterDetailLinkages(ctx, id, "gameCenterLeaderboards", params)
+}
+
+// ReorderLeaderboardsForGameCenterDetail sets the order in which players see the leaderboards of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_gamecenterdetails_id_relationships_gamecenterleaderboards
+func (s *GameCenterService) ReorderLeaderboardsForGameCenterDetail(ctx context.Context, id string, gameCenterLeaderboardIDs []string) (*Response, error) {
+	return s.replaceGameCenterDetailLinkages(ctx, id, "gameCenterLeaderboards", gameCenterLeaderboardIDs)
+}
+
+// ListLeaderboardSetIDsForGameCenterDetail lists the IDs of the leaderboard sets of an app, in the order players
+// see them.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_gamecenterdetails_id_relationships_gamecenterleaderboardsets
+func (s *GameCenterService) ListLeaderboardSetIDsForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterDetailLinkagesQuery) (*GameCenterDetailLinkagesResponse, *Response, error) {
+	return s.listGameCenterDetailLinkages(ctx, id, "gameCenterLeaderboardSets", params)
+}
+
+// ReorderLeaderboardSetsForGameCenterDetail sets the order in which players see the leaderboard sets of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_gamecenterdetails_id_relationships_gamecenterleaderboardsets
+func (s *GameCenterService) ReorderLeaderboardSetsForGameCenterDetail(ctx context.Context, id string, gameCenterLeaderboardSetIDs []string) (*Response, error) {
+	return s.replaceGameCenterDetailLinkages(ctx, id, "gameCenterLeaderboardSets", gameCenterLeaderboardSetIDs)
+}
+
+func (s *GameCenterService) updateGameCenterDetail(ctx context.Context, req gameCenterDetailUpdateRequest) (*GameCenterDetailResponse, *Response, error) {
+	url := fmt.Sprintf("gameCenterDetails/%s", req.ID)
+	res := new(GameCenterDetailResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+func (s *GameCenterService) listGameCenterDetailLinkages(ctx context.Context, id string, relationship string, params *ListGameCenterDetailLinkagesQuery) (*GameCenterDetailLinkagesResponse, *Response, error) {
+	return Get[GameCenterDetailLinkagesResponse](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/relationships/%s", id, relationship), params)
+}
+
+func (s *GameCenterService) replaceGameCenterDetailLinkages(ctx context.Context, id string, relationship string, ids []string) (*Response, error) {
+	linkages := newPagedRelationshipDeclaration(ids, relationship)
+	url := fmt.Sprintf("gameCenterDetails/%s/relationships/%s", id, relationship)
+
+	return s.client.patch(ctx, url, newRequestBody(linkages.Data), nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterDetailResponseIncluded.
+func (i *GameCenterDetailResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// GameCenterAchievement returns the GameCenterAchievement stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) GameCenterAchievement() *GameCenterAchievement {
+	return extractIncludedGameCenterAchievement(i.inner)
+}
+
+// GameCenterAppVersion returns the GameCenterAppVersion stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) GameCenterAppVersion() *GameCenterAppVersion {
+	return extractIncludedGameCenterAppVersion(i.inner)
+}
+
+// GameCenterGroup returns the GameCenterGroup stored within, if one is present.
+func (i *GameCenterDetailResponseIncluded) GameCenterGroup() *GameCenterGroup {
+	return extractIncludedGameCenterGroup(i.inner)
+}
@@ -0,0 +1,186 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterDetail(ctx, "10", &GetGameCenterDetailQuery{})
+	})
+}
+
+func TestGetGameCenterDetailIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"gameCenterAppVersions"},{"type":"gameCenterGroups"}]}`, func(ctx context.Context, client *Client) {
+		detail, _, err := client.GameCenter.GetGameCenterDetail(ctx, "10", &GetGameCenterDetailQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, detail.Included, 3)
+
+		assert.NotNil(t, detail.Included[0].App())
+		assert.NotNil(t, detail.Included[1].GameCenterAppVersion())
+		assert.NotNil(t, detail.Included[2].GameCenterGroup())
+
+		assert.Nil(t, detail.Included[0].GameCenterAppVersion())
+		assert.Nil(t, detail.Included[1].App())
+		assert.Nil(t, detail.Included[2].App())
+	})
+}
+
+func TestGetGameCenterDetailForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterDetailForApp(ctx, "10", &GetGameCenterDetailQuery{})
+	})
+}
+
+func TestCreateGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterDetail(ctx, "10", Bool(true))
+	})
+}
+
+func TestUpdateGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterDetail(ctx, "10", &GameCenterDetailUpdateRequestAttributes{ChallengeEnabled: Bool(false)}, String("20"))
+	})
+}
+
+func TestEnableGameCenterForApp(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"POST /gameCenterDetails": `{"data":{"id":"20","type":"gameCenterDetails"}}`,
+	})
+	defer server.Close()
+
+	detail, _, err := client.GameCenter.EnableGameCenterForApp(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Equal(t, "20", detail.Data.ID)
+	assert.Equal(t, []string{"GET /apps/10/gameCenterDetail", "POST /gameCenterDetails"}, *requests)
+}
+
+func TestEnableGameCenterForAppAlreadyEnabled(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /apps/10/gameCenterDetail": `{"data":{"id":"20","type":"gameCenterDetails"}}`,
+	})
+	defer server.Close()
+
+	detail, _, err := client.GameCenter.EnableGameCenterForApp(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Equal(t, "20", detail.Data.ID)
+	assert.Len(t, *requests, 1)
+}
+
+func TestListGameCenterAppVersionsForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAppVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterAppVersionsForGameCenterDetail(ctx, "10", &ListGameCenterAppVersionsForGameCenterDetailQuery{})
+	})
+}
+
+func TestGetGameCenterAppVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAppVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterAppVersion(ctx, "10", &GetGameCenterAppVersionQuery{})
+	})
+}
+
+func TestCreateGameCenterAppVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAppVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterAppVersion(ctx, "10")
+	})
+}
+
+func TestUpdateGameCenterAppVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAppVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterAppVersion(ctx, "10", Bool(false))
+	})
+}
+
+func TestListAchievementIDsForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailLinkagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListAchievementIDsForGameCenterDetail(ctx, "10", &ListGameCenterDetailLinkagesQuery{})
+	})
+}
+
+func TestReorderAchievementsForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.ReorderAchievementsForGameCenterDetail(ctx, "10", []string{"20", "30"})
+	})
+}
+
+func TestListLeaderboardIDsForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailLinkagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListLeaderboardIDsForGameCenterDetail(ctx, "10", &ListGameCenterDetailLinkagesQuery{})
+	})
+}
+
+func TestReorderLeaderboardsForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.ReorderLeaderboardsForGameCenterDetail(ctx, "10", []string{"20", "30"})
+	})
+}
+
+func TestListLeaderboardSetIDsForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailLinkagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListLeaderboardSetIDsForGameCenterDetail(ctx, "10", &ListGameCenterDetailLinkagesQuery{})
+	})
+}
+
+func TestReorderLeaderboardSetsForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.ReorderLeaderboardSetsForGameCenterDetail(ctx, "10", []string{"20", "30"})
+	})
+}
@@ -0,0 +1,337 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterGroup defines model for GameCenterGroup.
+//
+// A group shares achievements and leaderboards across the apps it contains, so that several SKUs of a game can
+// share the same Game Center data.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroup
+type GameCenterGroup struct {
+	Attributes    *GameCenterGroupAttributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *GameCenterGroupRelationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// GameCenterGroupAttributes defines model for GameCenterGroup.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroup/attributes
+type GameCenterGroupAttributes struct {
+	ReferenceName *string `json:"referenceName,omitempty"`
+}
+
+// GameCenterGroupRelationships defines model for GameCenterGroup.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroup/relationships
+type GameCenterGroupRelationships struct {
+	GameCenterAchievements    *PagedRelationship `json:"gameCenterAchievements,omitempty"`
+	GameCenterActivities      *PagedRelationship `json:"gameCenterActivities,omitempty"`
+	GameCenterChallenges      *PagedRelationship `json:"gameCenterChallenges,omitempty"`
+	GameCenterDetails         *PagedRelationship `json:"gameCenterDetails,omitempty"`
+	GameCenterLeaderboards    *PagedRelationship `json:"gameCenterLeaderboards,omitempty"`
+	GameCenterLeaderboardSets *PagedRelationship `json:"gameCenterLeaderboardSets,omitempty"`
+}
+
+// gameCenterGroupCreateRequest defines model for GameCenterGroupCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupcreaterequest/data
+type gameCenterGroupCreateRequest struct {
+	Attributes *gameCenterGroupCreateRequestAttributes `json:"attributes,omitempty"`
+	Type       string                                  `json:"type"`
+}
+
+// gameCenterGroupCreateRequestAttributes are attributes for GameCenterGroupCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupcreaterequest/data/attributes
+type gameCenterGroupCreateRequestAttributes struct {
+	ReferenceName *string `json:"referenceName,omitempty"`
+}
+
+// gameCenterGroupUpdateRequest defines model for GameCenterGroupUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupupdaterequest/data
+type gameCenterGroupUpdateRequest struct {
+	Attributes *gameCenterGroupCreateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                  `json:"id"`
+	Type       string                                  `json:"type"`
+}
+
+// GameCenterGroupResponse defines model for GameCenterGroupResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupresponse
+type GameCenterGroupResponse struct {
+	Data     GameCenterGroup                   `json:"data"`
+	Included []GameCenterGroupResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                     `json:"links"`
+}
+
+// GameCenterGroupsResponse defines model for GameCenterGroupsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentergroupsresponse
+type GameCenterGroupsResponse struct {
+	Data     []GameCenterGroup                 `json:"data"`
+	Included []GameCenterGroupResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                `json:"links"`
+	Meta     *PagingInformation                `json:"meta,omitempty"`
+}
+
+// GameCenterGroupResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterGroupResponse or GameCenterGroupsResponse.
+type GameCenterGroupResponseIncluded included
+
+// GameCenterGroupMapping maps the IDs of the app-scoped achievements and leaderboards of an app to the IDs of their
+// group-scoped counterparts, once the app has been moved into a group. Items that have no group counterpart are
+// left out.
+type GameCenterGroupMapping struct {
+	Achievements map[string]string
+	Leaderboards map[string]string
+}
+
+// ListGameCenterGroupsQuery are query options for ListGameCenterGroups
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_game_center_groups
+type ListGameCenterGroupsQuery struct {
+	FieldsGameCenterDetails []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups  []string `url:"fields[gameCenterGroups],omitempty"`
+	FilterGameCenterDetails []string `url:"filter[gameCenterDetails],omitempty"`
+	Include                 []string `url:"include,omitempty"`
+	Limit                   int      `url:"limit,omitempty"`
+	LimitGameCenterDetails  int      `url:"limit[gameCenterDetails],omitempty"`
+	Cursor                  string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterGroupQuery are query options for GetGameCenterGroup
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_group_information
+type GetGameCenterGroupQuery struct {
+	FieldsGameCenterDetails []string `url:"fields[gameCenterDetails],omitempty"`
+	FieldsGameCenterGroups  []string `url:"fields[gameCenterGroups],omitempty"`
+	Include                 []string `url:"include,omitempty"`
+	LimitGameCenterDetails  int      `url:"limit[gameCenterDetails],omitempty"`
+}
+
+// ListGameCenterDetailsForGameCenterGroupQuery are query options for ListGameCenterDetailsForGameCenterGroup
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_game_center_details_for_a_game_center_group
+type ListGameCenterDetailsForGameCenterGroupQuery struct {
+	FieldsGameCenterDetails []string `url:"fields[gameCenterDetails],omitempty"`
+	Limit                   int      `url:"limit,omitempty"`
+	Cursor                  string   `url:"cursor,omitempty"`
+}
+
+// GameCenterDetail returns the GameCenterDetail stored within, if one is present.
+func (i *GameCenterGroupResponseIncluded) GameCenterDetail() *GameCenterDetail {
+	return extractIncludedGameCenterDetail(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterGroupResponseIncluded.
+func (i *GameCenterGroupResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// ListGameCenterGroups lists the Game Center groups of your team.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_game_center_groups
+func (s *GameCenterService) ListGameCenterGroups(ctx context.Context, params *ListGameCenterGroupsQuery) (*GameCenterGroupsResponse, *Response, error) {
+	return Get[GameCenterGroupsResponse](ctx, s.client, "gameCenterGroups", params)
+}
+
+// GetGameCenterGroup gets information about a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_game_center_group_information
+func (s *GameCenterService) GetGameCenterGroup(ctx context.Context, id string, params *GetGameCenterGroupQuery) (*GameCenterGroupResponse, *Response, error) {
+	return Get[GameCenterGroupResponse](ctx, s.client, fmt.Sprintf("gameCenterGroups/%s", id), params)
+}
+
+// GetGameCenterGroupForGameCenterDetail gets the Game Center group an app belongs to.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_game_center_group_information_of_a_game_center_detail
+func (s *GameCenterService) GetGameCenterGroupForGameCenterDetail(ctx context.Context, id string, params *GetGameCenterGroupQuery) (*GameCenterGroupResponse, *Response, error) {
+	return Get[GameCenterGroupResponse](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/gameCenterGroup", id), params)
+}
+
+// CreateGameCenterGroup creates a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_game_center_group
+func (s *GameCenterService) CreateGameCenterGroup(ctx context.Context, referenceName *string) (*GameCenterGroupResponse, *Response, error) {
+	req := gameCenterGroupCreateRequest{
+		Type: "gameCenterGroups",
+	}
+	if referenceName != nil {
+		req.Attributes = &gameCenterGroupCreateRequestAttributes{
+			ReferenceName: referenceName,
+		}
+	}
+
+	res := new(GameCenterGroupResponse)
+	resp, err := s.client.post(ctx, "gameCenterGroups", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterGroup renames a Game Center group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_group
+func (s *GameCenterService) UpdateGameCenterGroup(ctx context.Context, id string, referenceName *string) (*GameCenterGroupResponse, *Response, error) {
+	req := gameCenterGroupUpdateRequest{
+		ID:   id,
+		Type: "gameCenterGroups",
+	}
+	if referenceName != nil {
+		req.Attributes = &gameCenterGroupCreateRequestAttributes{
+			ReferenceName: referenceName,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterGroups/%s", id)
+	res := new(GameCenterGroupResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterGroup deletes a Game Center group that contains no apps.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_game_center_group
+func (s *GameCenterService) DeleteGameCenterGroup(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterGroups/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListGameCenterDetailsForGameCenterGroup lists the Game Center details of the apps in a group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_game_center_details_for_a_game_center_group
+func (s *GameCenterService) ListGameCenterDetailsForGameCenterGroup(ctx context.Context, id string, params *ListGameCenterDetailsForGameCenterGroupQuery) (*GameCenterDetailsResponse, *Response, error) {
+	return Get[GameCenterDetailsResponse](ctx, s.client, fmt.Sprintf("gameCenterGroups/%s/gameCenterDetails", id), params)
+}
+
+// ListGameCenterAchievementsForGameCenterGroup lists the group-scoped achievements of a group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_achievements_for_a_game_center_group
+func (s *GameCenterService) ListGameCenterAchievementsForGameCenterGroup(ctx context.Context, id string, params *ListGameCenterAchievementsQuery) (*GameCenterAchievementsResponse, *Response, error) {
+	return Get[GameCenterAchievementsResponse](ctx, s.client, fmt.Sprintf("gameCenterGroups/%s/gameCenterAchievements", id), params)
+}
+
+// ListGameCenterLeaderboardsForGameCenterGroup lists the group-scoped leaderboards of a group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboards_for_a_game_center_group
+func (s *GameCenterService) ListGameCenterLeaderboardsForGameCenterGroup(ctx context.Context, id string, params *ListGameCenterLeaderboardsQuery) (*GameCenterLeaderboardsResponse, *Response, error) {
+	return Get[GameCenterLeaderboardsResponse](ctx, s.client, fmt.Sprintf("gameCenterGroups/%s/gameCenterLeaderboards", id), params)
+}
+
+// CreateGameCenterGroupAchievement creates a group-scoped achievement shared by every app in a group.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_achievement
+func (s *GameCenterService) CreateGameCenterGroupAchievement(ctx context.Context, attributes GameCenterAchievementCreateRequestAttributes, gameCenterGroupID string) (*GameCenterAchievementResponse, *Response, error) {
+	req := gameCenterAchievementCreateRequest{
+		Attributes: attributes,
+		Relationships: gameCenterAchievementCreateRequestRelationships{
+			GameCenterGroup: newRelationshipDeclaration(&gameCenterGroupID, "gameCenterGroups"),
+		},
+		Type: "gameCenterAchievements",
+	}
+	res := new(GameCenterAchievementResponse)
+	resp, err := s.client.post(ctx, "gameCenterAchievements", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// AddGameCenterDetailToGroup moves an app into a Game Center group. App Store Connect copies the app-scoped
+// achievements and leaderboards of the app into the group, and links each of them to its group-scoped copy.
+// An app can't leave a group once it has joined it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_game_center_detail
+func (s *GameCenterService) AddGameCenterDetailToGroup(ctx context.Context, gameCenterDetailID string, gameCenterGroupID string) (*GameCenterDetailResponse, *Response, error) {
+	req := gameCenterDetailUpdateRequest{
+		ID: gameCenterDetailID,
+		Relationships: &gameCenterDetailUpdateRequestRelationships{
+			GameCenterGroup: newRelationshipDeclaration(&gameCenterGroupID, "gameCenterGroups"),
+		},
+		Type: "gameCenterDetails",
+	}
+
+	return s.updateGameCenterDetail(ctx, req)
+}
+
+// GetGroupAchievementForGameCenterAchievement gets the group-scoped copy of an app-scoped achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_group_achievement_information_of_an_achievement
+func (s *GameCenterService) GetGroupAchievementForGameCenterAchievement(ctx context.Context, id string, params *GetGameCenterAchievementQuery) (*GameCenterAchievementResponse, *Response, error) {
+	return Get[GameCenterAchievementResponse](ctx, s.client, fmt.Sprintf("gameCenterAchievements/%s/groupAchievement", id), params)
+}
+
+// GetGroupLeaderboardForGameCenterLeaderboard gets the group-scoped copy of an app-scoped leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_group_leaderboard_information_of_a_leaderboard
+func (s *GameCenterService) GetGroupLeaderboardForGameCenterLeaderboard(ctx context.Context, id string, params *GetGameCenterLeaderboardQuery) (*GameCenterLeaderboardResponse, *Response, error) {
+	return Get[GameCenterLeaderboardResponse](ctx, s.client, fmt.Sprintf("gameCenterLeaderboards/%s/groupLeaderboard", id), params)
+}
+
+// MapGameCenterDetailToGroup pages through the achievements and leaderboards of an app and maps each of them
+// to its group-scoped copy. It is meant to be called after AddGameCenterDetailToGroup, so that scripts which
+// stored app-scoped IDs can be migrated to the group-scoped ones.
+func (s *GameCenterService) MapGameCenterDetailToGroup(ctx context.Context, gameCenterDetailID string) (*GameCenterGroupMapping, error) {
+	mapping := GameCenterGroupMapping{
+		Achievements: make(map[string]string),
+		Leaderboards: make(map[string]string),
+	}
+
+	achievementParams := &ListGameCenterAchievementsQuery{Include: []string{"groupAchievement"}, Limit: MaxPageLimit}
+
+	achievements, _, err := List[GameCenterAchievement](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/gameCenterAchievements", gameCenterDetailID), achievementParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list achievements of %s: %w", gameCenterDetailID, err)
+	}
+
+	for _, achievement := range achievements {
+		if achievement.Relationships != nil && achievement.Relationships.GroupAchievement != nil && achievement.Relationships.GroupAchievement.Data != nil {
+			mapping.Achievements[achievement.ID] = achievement.Relationships.GroupAchievement.Data.ID
+		}
+	}
+
+	leaderboardParams := &ListGameCenterLeaderboardsQuery{Include: []string{"groupLeaderboard"}, Limit: MaxPageLimit}
+
+	leaderboards, _, err := List[GameCenterLeaderboard](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/gameCenterLeaderboards", gameCenterDetailID), leaderboardParams)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list leaderboards of %s: %w", gameCenterDetailID, err)
+	}
+
+	for _, leaderboard := range leaderboards {
+		if leaderboard.Relationships != nil && leaderboard.Relationships.GroupLeaderboard != nil && leaderboard.Relationships.GroupLeaderboard.Data != nil {
+			mapping.Leaderboards[leaderboard.ID] = leaderboard.Relationships.GroupLeaderboard.Data.ID
+		}
+	}
+
+	return &mapping, nil
+}
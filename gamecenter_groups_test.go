@@ -0,0 +1,181 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGameCenterGroups(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterGroupsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterGroups(ctx, &ListGameCenterGroupsQuery{})
+	})
+}
+
+func TestGetGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterGroup(ctx, "10", &GetGameCenterGroupQuery{})
+	})
+}
+
+func TestGetGameCenterGroupIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterDetails"}]}`, func(ctx context.Context, client *Client) {
+		group, _, err := client.GameCenter.GetGameCenterGroup(ctx, "10", &GetGameCenterGroupQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, group.Included, 1)
+
+		assert.NotNil(t, group.Included[0].GameCenterDetail())
+	})
+}
+
+func TestGetGameCenterGroupForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterGroupForGameCenterDetail(ctx, "10", &GetGameCenterGroupQuery{})
+	})
+}
+
+func TestCreateGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterGroup(ctx, String("Shared Saga"))
+	})
+}
+
+func TestUpdateGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterGroupResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterGroup(ctx, "10", String("Shared Saga II"))
+	})
+}
+
+func TestDeleteGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterGroup(ctx, "10")
+	})
+}
+
+func TestListGameCenterDetailsForGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterDetailsForGameCenterGroup(ctx, "10", &ListGameCenterDetailsForGameCenterGroupQuery{})
+	})
+}
+
+func TestListGameCenterAchievementsForGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterAchievementsForGameCenterGroup(ctx, "10", &ListGameCenterAchievementsQuery{})
+	})
+}
+
+func TestListGameCenterLeaderboardsForGameCenterGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterLeaderboardsForGameCenterGroup(ctx, "10", &ListGameCenterLeaderboardsQuery{})
+	})
+}
+
+func TestCreateGameCenterGroupAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterGroupAchievement(ctx, GameCenterAchievementCreateRequestAttributes{
+			Points:           10,
+			ReferenceName:    "First Blood",
+			VendorIdentifier: "grp.first_blood",
+		}, "10")
+	})
+}
+
+func TestAddGameCenterDetailToGroup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterDetailResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.AddGameCenterDetailToGroup(ctx, "10", "20")
+	})
+}
+
+func TestGetGroupAchievementForGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGroupAchievementForGameCenterAchievement(ctx, "10", &GetGameCenterAchievementQuery{})
+	})
+}
+
+func TestGetGroupLeaderboardForGameCenterLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGroupLeaderboardForGameCenterLeaderboard(ctx, "10", &GetGameCenterLeaderboardQuery{})
+	})
+}
+
+func TestMapGameCenterDetailToGroup(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /gameCenterDetails/10/gameCenterAchievements": `{"data":[
+			{"id":"a1","type":"gameCenterAchievements","relationships":{"groupAchievement":{"data":{"id":"ga1","type":"gameCenterAchievements"}}}},
+			{"id":"a2","type":"gameCenterAchievements","relationships":{"groupAchievement":{}}}
+		]}`,
+		"GET /gameCenterDetails/10/gameCenterLeaderboards": `{"data":[
+			{"id":"l1","type":"gameCenterLeaderboards","relationships":{"groupLeaderboard":{"data":{"id":"gl1","type":"gameCenterLeaderboards"}}}}
+		]}`,
+	})
+	defer server.Close()
+
+	mapping, err := client.GameCenter.MapGameCenterDetailToGroup(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"a1": "ga1"}, mapping.Achievements)
+	assert.Equal(t, map[string]string{"l1": "gl1"}, mapping.Leaderboards)
+}
+
+func TestMapGameCenterDetailToGroupError(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /gameCenterDetails/10/gameCenterAchievements": `{"data":[]}`,
+	})
+	defer server.Close()
+
+	mapping, err := client.GameCenter.MapGameCenterDetailToGroup(context.Background(), "10")
+	assert.Error(t, err)
+	assert.Nil(t, mapping)
+}
@@ -0,0 +1,182 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterLeaderboard defines model for GameCenterLeaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboard
+type GameCenterLeaderboard struct {
+	Attributes    *GameCenterLeaderboardAttributes    `json:"attributes,omitempty"`
+	ID            string                              `json:"id"`
+	Links         ResourceLinks                       `json:"links"`
+	Relationships *GameCenterLeaderboardRelationships `json:"relationships,omitempty"`
+	Type          string                              `json:"type"`
+}
+
+// GameCenterLeaderboardAttributes defines model for GameCenterLeaderboard.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboard/attributes
+type GameCenterLeaderboardAttributes struct {
+	ActivityProperties  map[string]string `json:"activityProperties,omitempty"`
+	Archived            *bool             `json:"archived,omitempty"`
+	DefaultFormatter    *string           `json:"defaultFormatter,omitempty"`
+	RecurrenceDuration  *string           `json:"recurrenceDuration,omitempty"`
+	RecurrenceRule      *string           `json:"recurrenceRule,omitempty"`
+	RecurrenceStartDate *DateTime         `json:"recurrenceStartDate,omitempty"`
+	ReferenceName       *string           `json:"referenceName,omitempty"`
+	ScoreRangeEnd       *string           `json:"scoreRangeEnd,omitempty"`
+	ScoreRangeStart     *string           `json:"scoreRangeStart,omitempty"`
+	ScoreSortType       *string           `json:"scoreSortType,omitempty"`
+	SubmissionType      *string           `json:"submissionType,omitempty"`
+	VendorIdentifier    *string           `json:"vendorIdentifier,omitempty"`
+	Visibility          *string           `json:"visibility,omitempty"`
+}
+
+// GameCenterLeaderboardRelationships defines model for GameCenterLeaderboard.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboard/relationships
+type GameCenterLeaderboardRelationships struct {
+	GameCenterDetail          *Relationship      `json:"gameCenterDetail,omitempty"`
+	GameCenterGroup           *Relationship      `json:"gameCenterGroup,omitempty"`
+	GameCenterLeaderboardSets *PagedRelationship `json:"gameCenterLeaderboardSets,omitempty"`
+	GroupLeaderboard          *Relationship      `json:"groupLeaderboard,omitempty"`
+	Localizations             *PagedRelationship `json:"localizations,omitempty"`
+	Releases                  *PagedRelationship `json:"releases,omitempty"`
+}
+
+// gameCenterLeaderboardUpdateRequest defines model for GameCenterLeaderboardUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardupdaterequest/data
+type gameCenterLeaderboardUpdateRequest struct {
+	Attributes *GameCenterLeaderboardUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                        `json:"id"`
+	Type       string                                        `json:"type"`
+}
+
+// GameCenterLeaderboardUpdateRequestAttributes are attributes for GameCenterLeaderboardUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardupdaterequest/data/attributes
+type GameCenterLeaderboardUpdateRequestAttributes struct {
+	ActivityProperties  map[string]string `json:"activityProperties,omitempty"`
+	Archived            *bool             `json:"archived,omitempty"`
+	DefaultFormatter    *string           `json:"defaultFormatter,omitempty"`
+	RecurrenceDuration  *string           `json:"recurrenceDuration,omitempty"`
+	RecurrenceRule      *string           `json:"recurrenceRule,omitempty"`
+	RecurrenceStartDate *DateTime         `json:"recurrenceStartDate,omitempty"`
+	ReferenceName       *string           `json:"referenceName,omitempty"`
+	ScoreRangeEnd       *string           `json:"scoreRangeEnd,omitempty"`
+	ScoreRangeStart     *string           `json:"scoreRangeStart,omitempty"`
+	ScoreSortType       *string           `json:"scoreSortType,omitempty"`
+	SubmissionType      *string           `json:"submissionType,omitempty"`
+	Visibility          *string           `json:"visibility,omitempty"`
+}
+
+// GameCenterLeaderboardResponse defines model for GameCenterLeaderboardResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardresponse
+type GameCenterLeaderboardResponse struct {
+	Data  GameCenterLeaderboard `json:"data"`
+	Links DocumentLinks         `json:"links"`
+}
+
+// GameCenterLeaderboardsResponse defines model for GameCenterLeaderboardsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsresponse
+type GameCenterLeaderboardsResponse struct {
+	Data  []GameCenterLeaderboard `json:"data"`
+	Links PagedDocumentLinks      `json:"links"`
+	Meta  *PagingInformation      `json:"meta,omitempty"`
+}
+
+// ListGameCenterLeaderboardsQuery are query options for ListGameCenterLeaderboardsForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboards_for_a_game_center_detail
+type ListGameCenterLeaderboardsQuery struct {
+	FieldsGameCenterLeaderboards []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	FilterArchived               []string `url:"filter[archived],omitempty"`
+	FilterID                     []string `url:"filter[id],omitempty"`
+	FilterReferenceName          []string `url:"filter[referenceName],omitempty"`
+	Include                      []string `url:"include,omitempty"`
+	Limit                        int      `url:"limit,omitempty"`
+	Cursor                       string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterLeaderboardQuery are query options for GetGameCenterLeaderboard
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_leaderboard_information
+type GetGameCenterLeaderboardQuery struct {
+	FieldsGameCenterLeaderboards []string `url:"fields[gameCenterLeaderboards],omitempty"`
+	Include                      []string `url:"include,omitempty"`
+}
+
+// ListGameCenterLeaderboardsForGameCenterDetail lists the leaderboards of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboards_for_a_game_center_detail
+func (s *GameCenterService) ListGameCenterLeaderboardsForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterLeaderboardsQuery) (*GameCenterLeaderboardsResponse, *Response, error) {
+	return Get[GameCenterLeaderboardsResponse](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/gameCenterLeaderboards", id), params)
+}
+
+// GetGameCenterLeaderboard gets information about a leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_leaderboard_information
+func (s *GameCenterService) GetGameCenterLeaderboard(ctx context.Context, id string, params *GetGameCenterLeaderboardQuery) (*GameCenterLeaderboardResponse, *Response, error) {
+	return Get[GameCenterLeaderboardResponse](ctx, s.client, fmt.Sprintf("gameCenterLeaderboards/%s", id), params)
+}
+
+// UpdateGameCenterLeaderboard modifies a leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_leaderboard
+func (s *GameCenterService) UpdateGameCenterLeaderboard(ctx context.Context, id string, attributes *GameCenterLeaderboardUpdateRequestAttributes) (*GameCenterLeaderboardResponse, *Response, error) {
+	req := gameCenterLeaderboardUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "gameCenterLeaderboards",
+	}
+	url := fmt.Sprintf("gameCenterLeaderboards/%s", id)
+	res := new(GameCenterLeaderboardResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ArchiveGameCenterLeaderboard archives a live leaderboard, so that it no longer accepts scores.
+func (s *GameCenterService) ArchiveGameCenterLeaderboard(ctx context.Context, id string) (*GameCenterLeaderboardResponse, *Response, error) {
+	return s.UpdateGameCenterLeaderboard(ctx, id, &GameCenterLeaderboardUpdateRequestAttributes{Archived: Bool(true)})
+}
+
+// UnarchiveGameCenterLeaderboard makes an archived leaderboard accept scores again.
+func (s *GameCenterService) UnarchiveGameCenterLeaderboard(ctx context.Context, id string) (*GameCenterLeaderboardResponse, *Response, error) {
+	return s.UpdateGameCenterLeaderboard(ctx, id, &GameCenterLeaderboardUpdateRequestAttributes{Archived: Bool(false)})
+}
+
+// DeleteGameCenterLeaderboard deletes a leaderboard that has never been live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_leaderboard
+func (s *GameCenterService) DeleteGameCenterLeaderboard(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboards/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
@@ -0,0 +1,74 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+)
+
+func TestListGameCenterLeaderboardsForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterLeaderboardsForGameCenterDetail(ctx, "10", &ListGameCenterLeaderboardsQuery{})
+	})
+}
+
+func TestGetGameCenterLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboard(ctx, "10", &GetGameCenterLeaderboardQuery{})
+	})
+}
+
+func TestUpdateGameCenterLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterLeaderboard(ctx, "10", &GameCenterLeaderboardUpdateRequestAttributes{ReferenceName: String("Season 2")})
+	})
+}
+
+func TestArchiveGameCenterLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ArchiveGameCenterLeaderboard(ctx, "10")
+	})
+}
+
+func TestUnarchiveGameCenterLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UnarchiveGameCenterLeaderboard(ctx, "10")
+	})
+}
+
+func TestDeleteGameCenterLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterLeaderboard(ctx, "10")
+	})
+}
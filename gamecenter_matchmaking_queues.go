@@ -0,0 +1,238 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterMatchmakingQueue defines model for GameCenterMatchmakingQueue.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingqueue
+type GameCenterMatchmakingQueue struct {
+	Attributes    *GameCenterMatchmakingQueueAttributes    `json:"attributes,omitempty"`
+	ID            string                                   `json:"id"`
+	Links         ResourceLinks                            `json:"links"`
+	Relationships *GameCenterMatchmakingQueueRelationships `json:"relationships,omitempty"`
+	Type          string                                   `json:"type"`
+}
+
+// GameCenterMatchmakingQueueAttributes defines model for GameCenterMatchmakingQueue.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingqueue/attributes
+type GameCenterMatchmakingQueueAttributes struct {
+	ClassicMatchmakingBundleIDs []string `json:"classicMatchmakingBundleIds,omitempty"`
+	ReferenceName               *string  `json:"referenceName,omitempty"`
+}
+
+// GameCenterMatchmakingQueueRelationships defines model for GameCenterMatchmakingQueue.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingqueue/relationships
+type GameCenterMatchmakingQueueRelationships struct {
+	ExperimentRuleSet *Relationship `json:"experimentRuleSet,omitempty"`
+	RuleSet           *Relationship `json:"ruleSet,omitempty"`
+}
+
+// gameCenterMatchmakingQueueCreateRequest defines model for GameCenterMatchmakingQueueCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingqueuecreaterequest/data
+type gameCenterMatchmakingQueueCreateRequest struct {
+	Attributes    gameCenterMatchmakingQueueCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterMatchmakingQueueCreateRequestRelationships `json:"relationships"`
+	Type          string                                               `json:"type"`
+}
+
+// gameCenterMatchmakingQueueCreateRequestAttributes are attributes for GameCenterMatchmakingQueueCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingqueuecreaterequest/data/attributes
+type gameCenterMatchmakingQueueCreateRequestAttributes struct {
+	ClassicMatchmakingBundleIDs []string `json:"classicMatchmakingBundleIds,omitempty"`
+	ReferenceName               string   `json:"referenceName"`
+}
+
+// gameCenterMatchmakingQueueCreateRequestRelationships are relationships for GameCenterMatchmakingQueueCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingqueuecreaterequest/data/relationships
+type gameCenterMatchmakingQueueCreateRequestRelationships struct {
+	ExperimentRuleSet *relationshipDeclaration `json:"experimentRuleSet,omitempty"`
+	RuleSet           relationshipDeclaration  `json:"ruleSet"`
+}
+
+// gameCenterMatchmakingQueueUpdateRequest defines model for GameCenterMatchmakingQueueUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingqueueupdaterequest/data
+type gameCenterMatchmakingQueueUpdateRequest struct {
+	Attributes    *gameCenterMatchmakingQueueUpdateRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                                                `json:"id"`
+	Relationships *gameCenterMatchmakingQueueUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                                `json:"type"`
+}
+
+// gameCenterMatchmakingQueueUpdateRequestAttributes are attributes for GameCenterMatchmakingQueueUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingqueueupdaterequest/data/attributes
+type gameCenterMatchmakingQueueUpdateRequestAttributes struct {
+	ClassicMatchmakingBundleIDs []string `json:"classicMatchmakingBundleIds,omitempty"`
+}
+
+// gameCenterMatchmakingQueueUpdateRequestRelationships are relationships for GameCenterMatchmakingQueueUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingqueueupdaterequest/data/relationships
+type gameCenterMatchmakingQueueUpdateRequestRelationships struct {
+	ExperimentRuleSet *relationshipDeclaration `json:"experimentRuleSet,omitempty"`
+	RuleSet           *relationshipDeclaration `json:"ruleSet,omitempty"`
+}
+
+// GameCenterMatchmakingQueueResponse defines model for GameCenterMatchmakingQueueResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingqueueresponse
+type GameCenterMatchmakingQueueResponse struct {
+	Data     GameCenterMatchmakingQueue                   `json:"data"`
+	Included []GameCenterMatchmakingQueueResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                `json:"links"`
+}
+
+// GameCenterMatchmakingQueuesResponse defines model for GameCenterMatchmakingQueuesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingqueuesresponse
+type GameCenterMatchmakingQueuesResponse struct {
+	Data     []GameCenterMatchmakingQueue                 `json:"data"`
+	Included []GameCenterMatchmakingQueueResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                           `json:"links"`
+	Meta     *PagingInformation                           `json:"meta,omitempty"`
+}
+
+// GameCenterMatchmakingQueueResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterMatchmakingQueueResponse or GameCenterMatchmakingQueuesResponse.
+type GameCenterMatchmakingQueueResponseIncluded included
+
+// ListGameCenterMatchmakingQueuesQuery are query options for ListGameCenterMatchmakingQueues
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_matchmaking_queues
+type ListGameCenterMatchmakingQueuesQuery struct {
+	FieldsGameCenterMatchmakingQueues   []string `url:"fields[gameCenterMatchmakingQueues],omitempty"`
+	FieldsGameCenterMatchmakingRuleSets []string `url:"fields[gameCenterMatchmakingRuleSets],omitempty"`
+	Include                             []string `url:"include,omitempty"`
+	Limit                               int      `url:"limit,omitempty"`
+	Cursor                              string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterMatchmakingQueueQuery are query options for GetGameCenterMatchmakingQueue
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_matchmaking_queue_information
+type GetGameCenterMatchmakingQueueQuery struct {
+	FieldsGameCenterMatchmakingQueues   []string `url:"fields[gameCenterMatchmakingQueues],omitempty"`
+	FieldsGameCenterMatchmakingRuleSets []string `url:"fields[gameCenterMatchmakingRuleSets],omitempty"`
+	Include                             []string `url:"include,omitempty"`
+}
+
+// GameCenterMatchmakingRuleSet returns the GameCenterMatchmakingRuleSet stored within, if one is present.
+func (i *GameCenterMatchmakingQueueResponseIncluded) GameCenterMatchmakingRuleSet() *GameCenterMatchmakingRuleSet {
+	return extractIncludedGameCenterMatchmakingRuleSet(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterMatchmakingQueueResponseIncluded.
+func (i *GameCenterMatchmakingQueueResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	if err != nil {
+		return err
+	}
+
+	i.Type = typeName
+	i.inner = inner
+
+	return nil
+}
+
+// ListGameCenterMatchmakingQueues lists the matchmaking queues of your team.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_matchmaking_queues
+func (s *GameCenterService) ListGameCenterMatchmakingQueues(ctx context.Context, params *ListGameCenterMatchmakingQueuesQuery) (*GameCenterMatchmakingQueuesResponse, *Response, error) {
+	return Get[GameCenterMatchmakingQueuesResponse](ctx, s.client, "gameCenterMatchmakingQueues", params)
+}
+
+// GetGameCenterMatchmakingQueue gets information about a matchmaking queue.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_matchmaking_queue_information
+func (s *GameCenterService) GetGameCenterMatchmakingQueue(ctx context.Context, id string, params *GetGameCenterMatchmakingQueueQuery) (*GameCenterMatchmakingQueueResponse, *Response, error) {
+	return Get[GameCenterMatchmakingQueueResponse](ctx, s.client, fmt.Sprintf("gameCenterMatchmakingQueues/%s", id), params)
+}
+
+// CreateGameCenterMatchmakingQueue creates a matchmaking queue that matches players with a rule set, optionally
+// trialling an experimental rule set alongside it.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_matchmaking_queue
+func (s *GameCenterService) CreateGameCenterMatchmakingQueue(ctx context.Context, referenceName string, classicMatchmakingBundleIDs []string, ruleSetID string, experimentRuleSetID *string) (*GameCenterMatchmakingQueueResponse, *Response, error) {
+	req := gameCenterMatchmakingQueueCreateRequest{
+		Attributes: gameCenterMatchmakingQueueCreateRequestAttributes{
+			ClassicMatchmakingBundleIDs: classicMatchmakingBundleIDs,
+			ReferenceName:               referenceName,
+		},
+		Relationships: gameCenterMatchmakingQueueCreateRequestRelationships{
+			ExperimentRuleSet: newRelationshipDeclaration(experimentRuleSetID, "gameCenterMatchmakingRuleSets"),
+			RuleSet:           *newRelationshipDeclaration(&ruleSetID, "gameCenterMatchmakingRuleSets"),
+		},
+		Type: "gameCenterMatchmakingQueues",
+	}
+	res := new(GameCenterMatchmakingQueueResponse)
+	resp, err := s.client.post(ctx, "gameCenterMatchmakingQueues", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterMatchmakingQueue changes the classic matchmaking bundle IDs or the rule sets of a matchmaking
+// queue. Pointing a queue at a new rule set is how a tested rule set is deployed.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_matchmaking_queue
+func (s *GameCenterService) UpdateGameCenterMatchmakingQueue(ctx context.Context, id string, classicMatchmakingBundleIDs []string, ruleSetID *string, experimentRuleSetID *string) (*GameCenterMatchmakingQueueResponse, *Response, error) {
+	req := gameCenterMatchmakingQueueUpdateRequest{
+		ID:   id,
+		Type: "gameCenterMatchmakingQueues",
+	}
+	if classicMatchmakingBundleIDs != nil {
+		req.Attributes = &gameCenterMatchmakingQueueUpdateRequestAttributes{
+			ClassicMatchmakingBundleIDs: classicMatchmakingBundleIDs,
+		}
+	}
+
+	if ruleSetID != nil || experimentRuleSetID != nil {
+		req.Relationships = &gameCenterMatchmakingQueueUpdateRequestRelationships{
+			ExperimentRuleSet: newRelationshipDeclaration(experimentRuleSetID, "gameCenterMatchmakingRuleSets"),
+			RuleSet:           newRelationshipDeclaration(ruleSetID, "gameCenterMatchmakingRuleSets"),
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterMatchmakingQueues/%s", id)
+	res := new(GameCenterMatchmakingQueueResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterMatchmakingQueue deletes a matchmaking queue.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_matchmaking_queue
+func (s *GameCenterService) DeleteGameCenterMatchmakingQueue(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterMatchmakingQueues/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
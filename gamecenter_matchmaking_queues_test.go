@@ -0,0 +1,80 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGameCenterMatchmakingQueues(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingQueuesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterMatchmakingQueues(ctx, &ListGameCenterMatchmakingQueuesQuery{})
+	})
+}
+
+func TestGetGameCenterMatchmakingQueue(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingQueueResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterMatchmakingQueue(ctx, "10", &GetGameCenterMatchmakingQueueQuery{})
+	})
+}
+
+func TestGetGameCenterMatchmakingQueueIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterMatchmakingRuleSets"}]}`, func(ctx context.Context, client *Client) {
+		queue, _, err := client.GameCenter.GetGameCenterMatchmakingQueue(ctx, "10", &GetGameCenterMatchmakingQueueQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, queue.Included, 1)
+
+		assert.NotNil(t, queue.Included[0].GameCenterMatchmakingRuleSet())
+	})
+}
+
+func TestCreateGameCenterMatchmakingQueue(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingQueueResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterMatchmakingQueue(ctx, "Ranked", []string{"com.example.game"}, "10", String("11"))
+	})
+}
+
+func TestUpdateGameCenterMatchmakingQueue(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingQueueResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterMatchmakingQueue(ctx, "10", []string{"com.example.game"}, String("11"), nil)
+	})
+}
+
+func TestDeleteGameCenterMatchmakingQueue(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterMatchmakingQueue(ctx, "10")
+	})
+}
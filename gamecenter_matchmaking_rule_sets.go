@@ -0,0 +1,765 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterMatchmakingRuleType defines model for GameCenterMatchmakingRule.Attributes.Type
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrule/attributes
+type GameCenterMatchmakingRuleType string
+
+const (
+	// GameCenterMatchmakingRuleTypeCompatible is for a rule that evaluates whether two requests can be matched.
+	GameCenterMatchmakingRuleTypeCompatible GameCenterMatchmakingRuleType = "COMPATIBLE"
+	// GameCenterMatchmakingRuleTypeDistance is for a rule that computes a distance between two requests.
+	GameCenterMatchmakingRuleTypeDistance GameCenterMatchmakingRuleType = "DISTANCE"
+	// GameCenterMatchmakingRuleTypeMatch is for a rule that evaluates whether a candidate match is acceptable.
+	GameCenterMatchmakingRuleTypeMatch GameCenterMatchmakingRuleType = "MATCH"
+	// GameCenterMatchmakingRuleTypeTeam is for a rule that evaluates team assignments.
+	GameCenterMatchmakingRuleTypeTeam GameCenterMatchmakingRuleType = "TEAM"
+)
+
+var gameCenterMatchmakingRuleTypes = []GameCenterMatchmakingRuleType{
+	GameCenterMatchmakingRuleTypeCompatible,
+	GameCenterMatchmakingRuleTypeDistance,
+	GameCenterMatchmakingRuleTypeMatch,
+	GameCenterMatchmakingRuleTypeTeam,
+}
+
+// IsValid reports whether the game center matchmaking rule type is one this package knows about.
+func (v GameCenterMatchmakingRuleType) IsValid() bool {
+	for _, value := range gameCenterMatchmakingRuleTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseGameCenterMatchmakingRuleType returns s as a GameCenterMatchmakingRuleType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseGameCenterMatchmakingRuleType(s string) (GameCenterMatchmakingRuleType, error) {
+	if v := GameCenterMatchmakingRuleType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "GameCenterMatchmakingRuleType", Value: s}
+}
+
+// GameCenterMatchmakingRuleSet defines model for GameCenterMatchmakingRuleSet.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingruleset
+type GameCenterMatchmakingRuleSet struct {
+	Attributes    *GameCenterMatchmakingRuleSetAttributes    `json:"attributes,omitempty"`
+	ID            string                                     `json:"id"`
+	Links         ResourceLinks                              `json:"links"`
+	Relationships *GameCenterMatchmakingRuleSetRelationships `json:"relationships,omitempty"`
+	Type          string                                     `json:"type"`
+}
+
+// GameCenterMatchmakingRuleSetAttributes defines model for GameCenterMatchmakingRuleSet.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingruleset/attributes
+type GameCenterMatchmakingRuleSetAttributes struct {
+	MaxPlayers          *int    `json:"maxPlayers,omitempty"`
+	MinPlayers          *int    `json:"minPlayers,omitempty"`
+	ReferenceName       *string `json:"referenceName,omitempty"`
+	RuleLanguageVersion *int    `json:"ruleLanguageVersion,omitempty"`
+}
+
+// GameCenterMatchmakingRuleSetRelationships defines model for GameCenterMatchmakingRuleSet.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingruleset/relationships
+type GameCenterMatchmakingRuleSetRelationships struct {
+	MatchmakingQueues *PagedRelationship `json:"matchmakingQueues,omitempty"`
+	Rules             *PagedRelationship `json:"rules,omitempty"`
+	Teams             *PagedRelationship `json:"teams,omitempty"`
+}
+
+// gameCenterMatchmakingRuleSetCreateRequest defines model for GameCenterMatchmakingRuleSetCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesetcreaterequest/data
+type gameCenterMatchmakingRuleSetCreateRequest struct {
+	Attributes GameCenterMatchmakingRuleSetCreateRequestAttributes `json:"attributes"`
+	Type       string                                              `json:"type"`
+}
+
+// GameCenterMatchmakingRuleSetCreateRequestAttributes are attributes for GameCenterMatchmakingRuleSetCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesetcreaterequest/data/attributes
+type GameCenterMatchmakingRuleSetCreateRequestAttributes struct {
+	MaxPlayers          int    `json:"maxPlayers"`
+	MinPlayers          int    `json:"minPlayers"`
+	ReferenceName       string `json:"referenceName"`
+	RuleLanguageVersion int    `json:"ruleLanguageVersion"`
+}
+
+// gameCenterMatchmakingRuleSetUpdateRequest defines model for GameCenterMatchmakingRuleSetUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesetupdaterequest/data
+type gameCenterMatchmakingRuleSetUpdateRequest struct {
+	Attributes *gameCenterMatchmakingRuleSetUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                               `json:"id"`
+	Type       string                                               `json:"type"`
+}
+
+// gameCenterMatchmakingRuleSetUpdateRequestAttributes are attributes for GameCenterMatchmakingRuleSetUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesetupdaterequest/data/attributes
+type gameCenterMatchmakingRuleSetUpdateRequestAttributes struct {
+	MaxPlayers *int `json:"maxPlayers,omitempty"`
+	MinPlayers *int `json:"minPlayers,omitempty"`
+}
+
+// GameCenterMatchmakingRuleSetResponse defines model for GameCenterMatchmakingRuleSetResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesetresponse
+type GameCenterMatchmakingRuleSetResponse struct {
+	Data     GameCenterMatchmakingRuleSet                   `json:"data"`
+	Included []GameCenterMatchmakingRuleSetResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                  `json:"links"`
+}
+
+// GameCenterMatchmakingRuleSetsResponse defines model for GameCenterMatchmakingRuleSetsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesetsresponse
+type GameCenterMatchmakingRuleSetsResponse struct {
+	Data     []GameCenterMatchmakingRuleSet                 `json:"data"`
+	Included []GameCenterMatchmakingRuleSetResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                             `json:"links"`
+	Meta     *PagingInformation                             `json:"meta,omitempty"`
+}
+
+// GameCenterMatchmakingRuleSetResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a GameCenterMatchmakingRuleSetResponse or GameCenterMatchmakingRuleSetsResponse.
+type GameCenterMatchmakingRuleSetResponseIncluded included
+
+// GameCenterMatchmakingRule defines model for GameCenterMatchmakingRule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrule
+type GameCenterMatchmakingRule struct {
+	Attributes *GameCenterMatchmakingRuleAttributes `json:"attributes,omitempty"`
+	ID         string                               `json:"id"`
+	Links      ResourceLinks                        `json:"links"`
+	Type       string                               `json:"type"`
+}
+
+// GameCenterMatchmakingRuleAttributes defines model for GameCenterMatchmakingRule.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrule/attributes
+type GameCenterMatchmakingRuleAttributes struct {
+	Description   *string                        `json:"description,omitempty"`
+	Expression    *string                        `json:"expression,omitempty"`
+	ReferenceName *string                        `json:"referenceName,omitempty"`
+	Type          *GameCenterMatchmakingRuleType `json:"type,omitempty"`
+	Weight        *float64                       `json:"weight,omitempty"`
+}
+
+// gameCenterMatchmakingRuleCreateRequest defines model for GameCenterMatchmakingRuleCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulecreaterequest/data
+type gameCenterMatchmakingRuleCreateRequest struct {
+	Attributes    GameCenterMatchmakingRuleCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterMatchmakingRuleCreateRequestRelationships `json:"relationships"`
+	Type          string                                              `json:"type"`
+}
+
+// GameCenterMatchmakingRuleCreateRequestAttributes are attributes for GameCenterMatchmakingRuleCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulecreaterequest/data/attributes
+type GameCenterMatchmakingRuleCreateRequestAttributes struct {
+	Description   string                        `json:"description"`
+	Expression    string                        `json:"expression"`
+	ReferenceName string                        `json:"referenceName"`
+	Type          GameCenterMatchmakingRuleType `json:"type"`
+	Weight        *float64                      `json:"weight,omitempty"`
+}
+
+// gameCenterMatchmakingRuleCreateRequestRelationships are relationships for GameCenterMatchmakingRuleCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulecreaterequest/data/relationships
+type gameCenterMatchmakingRuleCreateRequestRelationships struct {
+	RuleSet relationshipDeclaration `json:"ruleSet"`
+}
+
+// gameCenterMatchmakingRuleUpdateRequest defines model for GameCenterMatchmakingRuleUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingruleupdaterequest/data
+type gameCenterMatchmakingRuleUpdateRequest struct {
+	Attributes *GameCenterMatchmakingRuleUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                            `json:"id"`
+	Type       string                                            `json:"type"`
+}
+
+// GameCenterMatchmakingRuleUpdateRequestAttributes are attributes for GameCenterMatchmakingRuleUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingruleupdaterequest/data/attributes
+type GameCenterMatchmakingRuleUpdateRequestAttributes struct {
+	Description *string  `json:"description,omitempty"`
+	Expression  *string  `json:"expression,omitempty"`
+	Weight      *float64 `json:"weight,omitempty"`
+}
+
+// GameCenterMatchmakingRuleResponse defines model for GameCenterMatchmakingRuleResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingruleresponse
+type GameCenterMatchmakingRuleResponse struct {
+	Data  GameCenterMatchmakingRule `json:"data"`
+	Links DocumentLinks             `json:"links"`
+}
+
+// GameCenterMatchmakingRulesResponse defines model for GameCenterMatchmakingRulesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesresponse
+type GameCenterMatchmakingRulesResponse struct {
+	Data  []GameCenterMatchmakingRule `json:"data"`
+	Links PagedDocumentLinks          `json:"links"`
+	Meta  *PagingInformation          `json:"meta,omitempty"`
+}
+
+// GameCenterMatchmakingTeam defines model for GameCenterMatchmakingTeam.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingteam
+type GameCenterMatchmakingTeam struct {
+	Attributes *GameCenterMatchmakingTeamAttributes `json:"attributes,omitempty"`
+	ID         string                               `json:"id"`
+	Links      ResourceLinks                        `json:"links"`
+	Type       string                               `json:"type"`
+}
+
+// GameCenterMatchmakingTeamAttributes defines model for GameCenterMatchmakingTeam.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingteam/attributes
+type GameCenterMatchmakingTeamAttributes struct {
+	MaxPlayers    *int    `json:"maxPlayers,omitempty"`
+	MinPlayers    *int    `json:"minPlayers,omitempty"`
+	ReferenceName *string `json:"referenceName,omitempty"`
+}
+
+// gameCenterMatchmakingTeamCreateRequest defines model for GameCenterMatchmakingTeamCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingteamcreaterequest/data
+type gameCenterMatchmakingTeamCreateRequest struct {
+	Attributes    gameCenterMatchmakingTeamCreateRequestAttributes    `json:"attributes"`
+	Relationships gameCenterMatchmakingTeamCreateRequestRelationships `json:"relationships"`
+	Type          string                                              `json:"type"`
+}
+
+// gameCenterMatchmakingTeamCreateRequestAttributes are attributes for GameCenterMatchmakingTeamCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingteamcreaterequest/data/attributes
+type gameCenterMatchmakingTeamCreateRequestAttributes struct {
+	MaxPlayers    int    `json:"maxPlayers"`
+	MinPlayers    int    `json:"minPlayers"`
+	ReferenceName string `json:"referenceName"`
+}
+
+// gameCenterMatchmakingTeamCreateRequestRelationships are relationships for GameCenterMatchmakingTeamCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingteamcreaterequest/data/relationships
+type gameCenterMatchmakingTeamCreateRequestRelationships struct {
+	RuleSet relationshipDeclaration `json:"ruleSet"`
+}
+
+// gameCenterMatchmakingTeamUpdateRequest defines model for GameCenterMatchmakingTeamUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingteamupdaterequest/data
+type gameCenterMatchmakingTeamUpdateRequest struct {
+	Attributes *gameCenterMatchmakingTeamUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                            `json:"id"`
+	Type       string                                            `json:"type"`
+}
+
+// gameCenterMatchmakingTeamUpdateRequestAttributes are attributes for GameCenterMatchmakingTeamUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingteamupdaterequest/data/attributes
+type gameCenterMatchmakingTeamUpdateRequestAttributes struct {
+	MaxPlayers *int `json:"maxPlayers,omitempty"`
+	MinPlayers *int `json:"minPlayers,omitempty"`
+}
+
+// GameCenterMatchmakingTeamResponse defines model for GameCenterMatchmakingTeamResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingteamresponse
+type GameCenterMatchmakingTeamResponse struct {
+	Data  GameCenterMatchmakingTeam `json:"data"`
+	Links DocumentLinks             `json:"links"`
+}
+
+// GameCenterMatchmakingTeamsResponse defines model for GameCenterMatchmakingTeamsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingteamsresponse
+type GameCenterMatchmakingTeamsResponse struct {
+	Data  []GameCenterMatchmakingTeam `json:"data"`
+	Links PagedDocumentLinks          `json:"links"`
+	Meta  *PagingInformation          `json:"meta,omitempty"`
+}
+
+// GameCenterMatchmakingRuleSetTest defines model for GameCenterMatchmakingRuleSetTest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesettest
+type GameCenterMatchmakingRuleSetTest struct {
+	Attributes *GameCenterMatchmakingRuleSetTestAttributes `json:"attributes,omitempty"`
+	ID         string                                      `json:"id"`
+	Links      ResourceLinks                               `json:"links"`
+	Type       string                                      `json:"type"`
+}
+
+// GameCenterMatchmakingRuleSetTestAttributes defines model for GameCenterMatchmakingRuleSetTest.Attributes
+//
+// Each element of MatchmakingResults is one match the rule set would make, listing the test requests it groups.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesettest/attributes
+type GameCenterMatchmakingRuleSetTestAttributes struct {
+	MatchmakingResults [][]GameCenterMatchmakingTestResult `json:"matchmakingResults,omitempty"`
+}
+
+// GameCenterMatchmakingTestResult defines model for a matched request in GameCenterMatchmakingRuleSetTest.Attributes.MatchmakingResults
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesettest/attributes
+type GameCenterMatchmakingTestResult struct {
+	RequestName     *string                               `json:"requestName,omitempty"`
+	Team            *string                               `json:"team,omitempty"`
+	TeamAssignments []GameCenterMatchmakingTeamAssignment `json:"teamAssignments,omitempty"`
+}
+
+// GameCenterMatchmakingTeamAssignment defines model for GameCenterMatchmakingTeamAssignment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingteamassignment
+type GameCenterMatchmakingTeamAssignment struct {
+	PlayerID *string `json:"playerId,omitempty"`
+	Team     *string `json:"team,omitempty"`
+}
+
+// GameCenterMatchmakingRuleSetTestResponse defines model for GameCenterMatchmakingRuleSetTestResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesettestresponse
+type GameCenterMatchmakingRuleSetTestResponse struct {
+	Data  GameCenterMatchmakingRuleSetTest `json:"data"`
+	Links DocumentLinks                    `json:"links"`
+}
+
+// GameCenterMatchmakingProperty defines model for GameCenterMatchmakingProperty.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/property
+type GameCenterMatchmakingProperty struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// GameCenterMatchmakingTestLocation defines model for GameCenterMatchmakingTestRequestInlineCreate.Attributes.Location
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/location
+type GameCenterMatchmakingTestLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// NewGameCenterMatchmakingTestRequest models the parameters for a simulated matchmaking request created inline
+// with a rule set test.
+type NewGameCenterMatchmakingTestRequest struct {
+	BundleID       string
+	Locale         *string
+	Location       *GameCenterMatchmakingTestLocation
+	MaxPlayers     *int
+	MinPlayers     *int
+	Platform       Platform
+	PlayerCount    *int
+	Players        []NewGameCenterMatchmakingTestPlayer
+	RequestName    string
+	SecondsInQueue int
+}
+
+// NewGameCenterMatchmakingTestPlayer models the properties of a player in a NewGameCenterMatchmakingTestRequest.
+type NewGameCenterMatchmakingTestPlayer struct {
+	PlayerID   string
+	Properties []GameCenterMatchmakingProperty
+}
+
+// gameCenterMatchmakingRuleSetTestCreateRequest defines model for GameCenterMatchmakingRuleSetTestCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesettestcreaterequest/data
+type gameCenterMatchmakingRuleSetTestCreateRequest struct {
+	Relationships gameCenterMatchmakingRuleSetTestCreateRequestRelationships `json:"relationships"`
+	Type          string                                                     `json:"type"`
+}
+
+// gameCenterMatchmakingRuleSetTestCreateRequestRelationships are relationships for GameCenterMatchmakingRuleSetTestCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecentermatchmakingrulesettestcreaterequest/data/relationships
+type gameCenterMatchmakingRuleSetTestCreateRequestRelationships struct {
+	MatchmakingRequests pagedRelationshipDeclaration `json:"matchmakingRequests"`
+	MatchmakingRuleSet  relationshipDeclaration      `json:"matchmakingRuleSet"`
+}
+
+type gameCenterMatchmakingTestRequestInlineCreate struct {
+	Attributes    gameCenterMatchmakingTestRequestInlineCreateAttributes     `json:"attributes"`
+	ID            string                                                     `json:"id"`
+	Relationships *gameCenterMatchmakingTestRequestInlineCreateRelationships `json:"relationships,omitempty"`
+	Type          string                                                     `json:"type"`
+}
+
+type gameCenterMatchmakingTestRequestInlineCreateAttributes struct {
+	BundleID       string                             `json:"bundleId"`
+	Locale         *string                            `json:"locale,omitempty"`
+	Location       *GameCenterMatchmakingTestLocation `json:"location,omitempty"`
+	MaxPlayers     *int                               `json:"maxPlayers,omitempty"`
+	MinPlayers     *int                               `json:"minPlayers,omitempty"`
+	Platform       Platform                           `json:"platform"`
+	PlayerCount    *int                               `json:"playerCount,omitempty"`
+	RequestName    string                             `json:"requestName"`
+	SecondsInQueue int                                `json:"secondsInQueue"`
+}
+
+type gameCenterMatchmakingTestRequestInlineCreateRelationships struct {
+	MatchmakingPlayerProperties pagedRelationshipDeclaration `json:"matchmakingPlayerProperties"`
+}
+
+type gameCenterMatchmakingTestPlayerPropertyInlineCreate struct {
+	Attributes gameCenterMatchmakingTestPlayerPropertyInlineCreateAttributes `json:"attributes"`
+	ID         string                                                        `json:"id"`
+	Type       string                                                        `json:"type"`
+}
+
+type gameCenterMatchmakingTestPlayerPropertyInlineCreateAttributes struct {
+	PlayerID   string                          `json:"playerId"`
+	Properties []GameCenterMatchmakingProperty `json:"properties"`
+}
+
+// ListGameCenterMatchmakingRuleSetsQuery are query options for ListGameCenterMatchmakingRuleSets
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_matchmaking_rule_sets
+type ListGameCenterMatchmakingRuleSetsQuery struct {
+	FieldsGameCenterMatchmakingQueues   []string `url:"fields[gameCenterMatchmakingQueues],omitempty"`
+	FieldsGameCenterMatchmakingRuleSets []string `url:"fields[gameCenterMatchmakingRuleSets],omitempty"`
+	FieldsGameCenterMatchmakingRules    []string `url:"fields[gameCenterMatchmakingRules],omitempty"`
+	FieldsGameCenterMatchmakingTeams    []string `url:"fields[gameCenterMatchmakingTeams],omitempty"`
+	Include                             []string `url:"include,omitempty"`
+	Limit                               int      `url:"limit,omitempty"`
+	LimitMatchmakingQueues              int      `url:"limit[matchmakingQueues],omitempty"`
+	LimitRules                          int      `url:"limit[rules],omitempty"`
+	LimitTeams                          int      `url:"limit[teams],omitempty"`
+	Cursor                              string   `url:"cursor,omitempty"`
+}
+
+// GetGameCenterMatchmakingRuleSetQuery are query options for GetGameCenterMatchmakingRuleSet
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_matchmaking_rule_set_information
+type GetGameCenterMatchmakingRuleSetQuery struct {
+	FieldsGameCenterMatchmakingQueues   []string `url:"fields[gameCenterMatchmakingQueues],omitempty"`
+	FieldsGameCenterMatchmakingRuleSets []string `url:"fields[gameCenterMatchmakingRuleSets],omitempty"`
+	FieldsGameCenterMatchmakingRules    []string `url:"fields[gameCenterMatchmakingRules],omitempty"`
+	FieldsGameCenterMatchmakingTeams    []string `url:"fields[gameCenterMatchmakingTeams],omitempty"`
+	Include                             []string `url:"include,omitempty"`
+	LimitMatchmakingQueues              int      `url:"limit[matchmakingQueues],omitempty"`
+	LimitRules                          int      `url:"limit[rules],omitempty"`
+	LimitTeams                          int      `url:"limit[teams],omitempty"`
+}
+
+// ListRulesForGameCenterMatchmakingRuleSetQuery are query options for ListRulesForGameCenterMatchmakingRuleSet
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_rules_for_a_matchmaking_rule_set
+type ListRulesForGameCenterMatchmakingRuleSetQuery struct {
+	FieldsGameCenterMatchmakingRules []string `url:"fields[gameCenterMatchmakingRules],omitempty"`
+	Limit                            int      `url:"limit,omitempty"`
+	Cursor                           string   `url:"cursor,omitempty"`
+}
+
+// ListTeamsForGameCenterMatchmakingRuleSetQuery are query options for ListTeamsForGameCenterMatchmakingRuleSet
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_teams_for_a_matchmaking_rule_set
+type ListTeamsForGameCenterMatchmakingRuleSetQuery struct {
+	FieldsGameCenterMatchmakingTeams []string `url:"fields[gameCenterMatchmakingTeams],omitempty"`
+	Limit                            int      `url:"limit,omitempty"`
+	Cursor                           string   `url:"cursor,omitempty"`
+}
+
+// GameCenterMatchmakingQueue returns the GameCenterMatchmakingQueue stored within, if one is present.
+func (i *GameCenterMatchmakingRuleSetResponseIncluded) GameCenterMatchmakingQueue() *GameCenterMatchmakingQueue {
+	return extractIncludedGameCenterMatchmakingQueue(i.inner)
+}
+
+// GameCenterMatchmakingRule returns the GameCenterMatchmakingRule stored within, if one is present.
+func (i *GameCenterMatchmakingRuleSetResponseIncluded) GameCenterMatchmakingRule() *GameCenterMatchmakingRule {
+	return extractIncludedGameCenterMatchmakingRule(i.inner)
+}
+
+// GameCenterMatchmakingTeam returns the GameCenterMatchmakingTeam stored within, if one is present.
+func (i *GameCenterMatchmakingRuleSetResponseIncluded) GameCenterMatchmakingTeam() *GameCenterMatchmakingTeam {
+	return extractIncludedGameCenterMatchmakingTeam(i.inner)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in GameCenterMatchmakingRuleSetResponseIncluded.
+func (i *GameCenterMatchmakingRuleSetResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	if err != nil {
+		return err
+	}
+
+	i.Type = typeName
+	i.inner = inner
+
+	return nil
+}
+
+// ListGameCenterMatchmakingRuleSets lists the matchmaking rule sets of your team.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_matchmaking_rule_sets
+func (s *GameCenterService) ListGameCenterMatchmakingRuleSets(ctx context.Context, params *ListGameCenterMatchmakingRuleSetsQuery) (*GameCenterMatchmakingRuleSetsResponse, *Response, error) {
+	return Get[GameCenterMatchmakingRuleSetsResponse](ctx, s.client, "gameCenterMatchmakingRuleSets", params)
+}
+
+// GetGameCenterMatchmakingRuleSet gets information about a matchmaking rule set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_matchmaking_rule_set_information
+func (s *GameCenterService) GetGameCenterMatchmakingRuleSet(ctx context.Context, id string, params *GetGameCenterMatchmakingRuleSetQuery) (*GameCenterMatchmakingRuleSetResponse, *Response, error) {
+	return Get[GameCenterMatchmakingRuleSetResponse](ctx, s.client, fmt.Sprintf("gameCenterMatchmakingRuleSets/%s", id), params)
+}
+
+// CreateGameCenterMatchmakingRuleSet creates a matchmaking rule set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_matchmaking_rule_set
+func (s *GameCenterService) CreateGameCenterMatchmakingRuleSet(ctx context.Context, attributes GameCenterMatchmakingRuleSetCreateRequestAttributes) (*GameCenterMatchmakingRuleSetResponse, *Response, error) {
+	req := gameCenterMatchmakingRuleSetCreateRequest{
+		Attributes: attributes,
+		Type:       "gameCenterMatchmakingRuleSets",
+	}
+	res := new(GameCenterMatchmakingRuleSetResponse)
+	resp, err := s.client.post(ctx, "gameCenterMatchmakingRuleSets", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterMatchmakingRuleSet changes the player limits of a matchmaking rule set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_matchmaking_rule_set
+func (s *GameCenterService) UpdateGameCenterMatchmakingRuleSet(ctx context.Context, id string, minPlayers *int, maxPlayers *int) (*GameCenterMatchmakingRuleSetResponse, *Response, error) {
+	req := gameCenterMatchmakingRuleSetUpdateRequest{
+		ID:   id,
+		Type: "gameCenterMatchmakingRuleSets",
+	}
+	if minPlayers != nil || maxPlayers != nil {
+		req.Attributes = &gameCenterMatchmakingRuleSetUpdateRequestAttributes{
+			MaxPlayers: maxPlayers,
+			MinPlayers: minPlayers,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterMatchmakingRuleSets/%s", id)
+	res := new(GameCenterMatchmakingRuleSetResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterMatchmakingRuleSet deletes a matchmaking rule set that no queue uses.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_matchmaking_rule_set
+func (s *GameCenterService) DeleteGameCenterMatchmakingRuleSet(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterMatchmakingRuleSets/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListMatchmakingQueuesForGameCenterMatchmakingRuleSet lists the matchmaking queues that use a rule set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_matchmaking_queues_for_a_matchmaking_rule_set
+func (s *GameCenterService) ListMatchmakingQueuesForGameCenterMatchmakingRuleSet(ctx context.Context, id string, params *ListGameCenterMatchmakingQueuesQuery) (*GameCenterMatchmakingQueuesResponse, *Response, error) {
+	return Get[GameCenterMatchmakingQueuesResponse](ctx, s.client, fmt.Sprintf("gameCenterMatchmakingRuleSets/%s/matchmakingQueues", id), params)
+}
+
+// ListRulesForGameCenterMatchmakingRuleSet lists the rules of a matchmaking rule set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_rules_for_a_matchmaking_rule_set
+func (s *GameCenterService) ListRulesForGameCenterMatchmakingRuleSet(ctx context.Context, id string, params *ListRulesForGameCenterMatchmakingRuleSetQuery) (*GameCenterMatchmakingRulesResponse, *Response, error) {
+	return Get[GameCenterMatchmakingRulesResponse](ctx, s.client, fmt.Sprintf("gameCenterMatchmakingRuleSets/%s/rules", id), params)
+}
+
+// ListTeamsForGameCenterMatchmakingRuleSet lists the teams of a matchmaking rule set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_teams_for_a_matchmaking_rule_set
+func (s *GameCenterService) ListTeamsForGameCenterMatchmakingRuleSet(ctx context.Context, id string, params *ListTeamsForGameCenterMatchmakingRuleSetQuery) (*GameCenterMatchmakingTeamsResponse, *Response, error) {
+	return Get[GameCenterMatchmakingTeamsResponse](ctx, s.client, fmt.Sprintf("gameCenterMatchmakingRuleSets/%s/teams", id), params)
+}
+
+// CreateGameCenterMatchmakingRule adds a rule to a matchmaking rule set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_matchmaking_rule
+func (s *GameCenterService) CreateGameCenterMatchmakingRule(ctx context.Context, attributes GameCenterMatchmakingRuleCreateRequestAttributes, ruleSetID string) (*GameCenterMatchmakingRuleResponse, *Response, error) {
+	req := gameCenterMatchmakingRuleCreateRequest{
+		Attributes: attributes,
+		Relationships: gameCenterMatchmakingRuleCreateRequestRelationships{
+			RuleSet: *newRelationshipDeclaration(&ruleSetID, "gameCenterMatchmakingRuleSets"),
+		},
+		Type: "gameCenterMatchmakingRules",
+	}
+	res := new(GameCenterMatchmakingRuleResponse)
+	resp, err := s.client.post(ctx, "gameCenterMatchmakingRules", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterMatchmakingRule changes the expression, description or weight of a matchmaking rule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_matchmaking_rule
+func (s *GameCenterService) UpdateGameCenterMatchmakingRule(ctx context.Context, id string, attributes *GameCenterMatchmakingRuleUpdateRequestAttributes) (*GameCenterMatchmakingRuleResponse, *Response, error) {
+	req := gameCenterMatchmakingRuleUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "gameCenterMatchmakingRules",
+	}
+	url := fmt.Sprintf("gameCenterMatchmakingRules/%s", id)
+	res := new(GameCenterMatchmakingRuleResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterMatchmakingRule removes a rule from its matchmaking rule set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_matchmaking_rule
+func (s *GameCenterService) DeleteGameCenterMatchmakingRule(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterMatchmakingRules/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// CreateGameCenterMatchmakingTeam adds a team to a matchmaking rule set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_matchmaking_team
+func (s *GameCenterService) CreateGameCenterMatchmakingTeam(ctx context.Context, referenceName string, minPlayers int, maxPlayers int, ruleSetID string) (*GameCenterMatchmakingTeamResponse, *Response, error) {
+	req := gameCenterMatchmakingTeamCreateRequest{
+		Attributes: gameCenterMatchmakingTeamCreateRequestAttributes{
+			MaxPlayers:    maxPlayers,
+			MinPlayers:    minPlayers,
+			ReferenceName: referenceName,
+		},
+		Relationships: gameCenterMatchmakingTeamCreateRequestRelationships{
+			RuleSet: *newRelationshipDeclaration(&ruleSetID, "gameCenterMatchmakingRuleSets"),
+		},
+		Type: "gameCenterMatchmakingTeams",
+	}
+	res := new(GameCenterMatchmakingTeamResponse)
+	resp, err := s.client.post(ctx, "gameCenterMatchmakingTeams", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateGameCenterMatchmakingTeam changes the player limits of a matchmaking team.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_matchmaking_team
+func (s *GameCenterService) UpdateGameCenterMatchmakingTeam(ctx context.Context, id string, minPlayers *int, maxPlayers *int) (*GameCenterMatchmakingTeamResponse, *Response, error) {
+	req := gameCenterMatchmakingTeamUpdateRequest{
+		ID:   id,
+		Type: "gameCenterMatchmakingTeams",
+	}
+	if minPlayers != nil || maxPlayers != nil {
+		req.Attributes = &gameCenterMatchmakingTeamUpdateRequestAttributes{
+			MaxPlayers: maxPlayers,
+			MinPlayers: minPlayers,
+		}
+	}
+
+	url := fmt.Sprintf("gameCenterMatchmakingTeams/%s", id)
+	res := new(GameCenterMatchmakingTeamResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteGameCenterMatchmakingTeam removes a team from its matchmaking rule set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_matchmaking_team
+func (s *GameCenterService) DeleteGameCenterMatchmakingTeam(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterMatchmakingTeams/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// CreateGameCenterMatchmakingRuleSetTest runs a set of simulated matchmaking requests against a rule set and
+// returns the matches it would make, without affecting live matchmaking. This allows a rule set to be validated
+// before it is attached to a queue.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_matchmaking_rule_set_test
+func (s *GameCenterService) CreateGameCenterMatchmakingRuleSetTest(ctx context.Context, ruleSetID string, requests []NewGameCenterMatchmakingTestRequest) (*GameCenterMatchmakingRuleSetTestResponse, *Response, error) {
+	included := make([]interface{}, 0, len(requests))
+	requestIDs := make([]string, len(requests))
+	playerCount := 0
+
+	for i, request := range requests {
+		inline := gameCenterMatchmakingTestRequestInlineCreate{
+			Attributes: gameCenterMatchmakingTestRequestInlineCreateAttributes{
+				BundleID:       request.BundleID,
+				Locale:         request.Locale,
+				Location:       request.Location,
+				MaxPlayers:     request.MaxPlayers,
+				MinPlayers:     request.MinPlayers,
+				Platform:       request.Platform,
+				PlayerCount:    request.PlayerCount,
+				RequestName:    request.RequestName,
+				SecondsInQueue: request.SecondsInQueue,
+			},
+			ID:   fmt.Sprintf("${new-request-%d}", i),
+			Type: "gameCenterMatchmakingTestRequests",
+		}
+
+		if len(request.Players) > 0 {
+			playerIDs := make([]string, len(request.Players))
+
+			for j, player := range request.Players {
+				property := gameCenterMatchmakingTestPlayerPropertyInlineCreate{
+					Attributes: gameCenterMatchmakingTestPlayerPropertyInlineCreateAttributes{
+						PlayerID:   player.PlayerID,
+						Properties: player.Properties,
+					},
+					ID:   fmt.Sprintf("${new-player-property-%d}", playerCount),
+					Type: "gameCenterMatchmakingTestPlayerProperties",
+				}
+				playerCount++
+				playerIDs[j] = property.ID
+				included = append(included, property)
+			}
+
+			inline.Relationships = &gameCenterMatchmakingTestRequestInlineCreateRelationships{
+				MatchmakingPlayerProperties: newPagedRelationshipDeclaration(playerIDs, "gameCenterMatchmakingTestPlayerProperties"),
+			}
+		}
+
+		requestIDs[i] = inline.ID
+		included = append(included, inline)
+	}
+
+	req := gameCenterMatchmakingRuleSetTestCreateRequest{
+		Relationships: gameCenterMatchmakingRuleSetTestCreateRequestRelationships{
+			MatchmakingRequests: newPagedRelationshipDeclaration(requestIDs, "gameCenterMatchmakingTestRequests"),
+			MatchmakingRuleSet:  *newRelationshipDeclaration(&ruleSetID, "gameCenterMatchmakingRuleSets"),
+		},
+		Type: "gameCenterMatchmakingRuleSetTests",
+	}
+	res := new(GameCenterMatchmakingRuleSetTestResponse)
+	resp, err := s.client.post(ctx, "gameCenterMatchmakingRuleSetTests", newRequestBodyWithIncluded(req, included), res)
+
+	return res, resp, err
+}
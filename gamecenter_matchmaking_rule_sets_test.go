@@ -0,0 +1,253 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListGameCenterMatchmakingRuleSets(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingRuleSetsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListGameCenterMatchmakingRuleSets(ctx, &ListGameCenterMatchmakingRuleSetsQuery{})
+	})
+}
+
+func TestGetGameCenterMatchmakingRuleSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingRuleSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterMatchmakingRuleSet(ctx, "10", &GetGameCenterMatchmakingRuleSetQuery{})
+	})
+}
+
+func TestGetGameCenterMatchmakingRuleSetIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"gameCenterMatchmakingQueues"},{"type":"gameCenterMatchmakingRules"},{"type":"gameCenterMatchmakingTeams"}]}`, func(ctx context.Context, client *Client) {
+		ruleSet, _, err := client.GameCenter.GetGameCenterMatchmakingRuleSet(ctx, "10", &GetGameCenterMatchmakingRuleSetQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, ruleSet.Included, 3)
+
+		assert.NotNil(t, ruleSet.Included[0].GameCenterMatchmakingQueue())
+		assert.NotNil(t, ruleSet.Included[1].GameCenterMatchmakingRule())
+		assert.NotNil(t, ruleSet.Included[2].GameCenterMatchmakingTeam())
+
+		assert.Nil(t, ruleSet.Included[0].GameCenterMatchmakingTeam())
+		assert.Nil(t, ruleSet.Included[1].GameCenterMatchmakingQueue())
+		assert.Nil(t, ruleSet.Included[2].GameCenterMatchmakingRule())
+	})
+}
+
+func TestCreateGameCenterMatchmakingRuleSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingRuleSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterMatchmakingRuleSet(ctx, GameCenterMatchmakingRuleSetCreateRequestAttributes{
+			MaxPlayers:          4,
+			MinPlayers:          2,
+			ReferenceName:       "Ranked",
+			RuleLanguageVersion: 1,
+		})
+	})
+}
+
+func TestUpdateGameCenterMatchmakingRuleSet(t *testing.T) {
+	t.Parallel()
+
+	maxPlayers := 8
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingRuleSetResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterMatchmakingRuleSet(ctx, "10", nil, &maxPlayers)
+	})
+}
+
+func TestDeleteGameCenterMatchmakingRuleSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterMatchmakingRuleSet(ctx, "10")
+	})
+}
+
+func TestListMatchmakingQueuesForGameCenterMatchmakingRuleSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingQueuesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListMatchmakingQueuesForGameCenterMatchmakingRuleSet(ctx, "10", &ListGameCenterMatchmakingQueuesQuery{})
+	})
+}
+
+func TestListRulesForGameCenterMatchmakingRuleSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingRulesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListRulesForGameCenterMatchmakingRuleSet(ctx, "10", &ListRulesForGameCenterMatchmakingRuleSetQuery{})
+	})
+}
+
+func TestListTeamsForGameCenterMatchmakingRuleSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingTeamsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListTeamsForGameCenterMatchmakingRuleSet(ctx, "10", &ListTeamsForGameCenterMatchmakingRuleSetQuery{})
+	})
+}
+
+func TestCreateGameCenterMatchmakingRule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingRuleResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterMatchmakingRule(ctx, GameCenterMatchmakingRuleCreateRequestAttributes{
+			Description:   "Players must share a locale",
+			Expression:    "requests[0].locale == requests[1].locale",
+			ReferenceName: "SameLocale",
+			Type:          GameCenterMatchmakingRuleTypeCompatible,
+		}, "10")
+	})
+}
+
+func TestUpdateGameCenterMatchmakingRule(t *testing.T) {
+	t.Parallel()
+
+	weight := 0.5
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingRuleResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterMatchmakingRule(ctx, "10", &GameCenterMatchmakingRuleUpdateRequestAttributes{Weight: &weight})
+	})
+}
+
+func TestDeleteGameCenterMatchmakingRule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterMatchmakingRule(ctx, "10")
+	})
+}
+
+func TestCreateGameCenterMatchmakingTeam(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingTeamResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterMatchmakingTeam(ctx, "Red", 1, 2, "10")
+	})
+}
+
+func TestUpdateGameCenterMatchmakingTeam(t *testing.T) {
+	t.Parallel()
+
+	minPlayers := 2
+
+	testEndpointWithResponse(t, "{}", &GameCenterMatchmakingTeamResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.UpdateGameCenterMatchmakingTeam(ctx, "10", &minPlayers, nil)
+	})
+}
+
+func TestDeleteGameCenterMatchmakingTeam(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterMatchmakingTeam(ctx, "10")
+	})
+}
+
+func TestCreateGameCenterMatchmakingRuleSetTest(t *testing.T) {
+	t.Parallel()
+
+	var body struct {
+		Data struct {
+			Relationships struct {
+				MatchmakingRequests struct {
+					Data []RelationshipData `json:"data"`
+				} `json:"matchmakingRequests"`
+			} `json:"relationships"`
+		} `json:"data"`
+		Included []struct {
+			ID            string `json:"id"`
+			Type          string `json:"type"`
+			Relationships *struct {
+				MatchmakingPlayerProperties struct {
+					Data []RelationshipData `json:"data"`
+				} `json:"matchmakingPlayerProperties"`
+			} `json:"relationships"`
+		} `json:"included"`
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&body)
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"data":{"id":"1","type":"gameCenterMatchmakingRuleSetTests","attributes":{"matchmakingResults":[[{"requestName":"alice"},{"requestName":"bob"}]]}}}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	res, _, err := client.GameCenter.CreateGameCenterMatchmakingRuleSetTest(context.Background(), "10", []NewGameCenterMatchmakingTestRequest{
+		{
+			BundleID:       "com.example.game",
+			Platform:       PlatformIOS,
+			Players:        []NewGameCenterMatchmakingTestPlayer{{PlayerID: "p1", Properties: []GameCenterMatchmakingProperty{{Key: "skill", Value: "10"}}}},
+			RequestName:    "alice",
+			SecondsInQueue: 5,
+		},
+		{
+			BundleID:       "com.example.game",
+			Platform:       PlatformIOS,
+			RequestName:    "bob",
+			SecondsInQueue: 10,
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res.Data.Attributes.MatchmakingResults, 1)
+	assert.Len(t, res.Data.Attributes.MatchmakingResults[0], 2)
+
+	assert.Equal(t, []RelationshipData{
+		{ID: "${new-request-0}", Type: "gameCenterMatchmakingTestRequests"},
+		{ID: "${new-request-1}", Type: "gameCenterMatchmakingTestRequests"},
+	}, body.Data.Relationships.MatchmakingRequests.Data)
+	assert.Len(t, body.Included, 3)
+	assert.Equal(t, "${new-player-property-0}", body.Included[0].ID)
+	assert.Equal(t, "gameCenterMatchmakingTestPlayerProperties", body.Included[0].Type)
+	assert.Equal(t, "${new-request-0}", body.Included[1].ID)
+	assert.Equal(t, []RelationshipData{{ID: "${new-player-property-0}", Type: "gameCenterMatchmakingTestPlayerProperties"}}, body.Included[1].Relationships.MatchmakingPlayerProperties.Data)
+	assert.Nil(t, body.Included[2].Relationships)
+}
+
+func TestParseGameCenterMatchmakingRuleType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseGameCenterMatchmakingRuleType(string(GameCenterMatchmakingRuleTypeCompatible))
+	assert.NoError(t, err)
+	assert.Equal(t, GameCenterMatchmakingRuleTypeCompatible, got)
+
+	_, err = ParseGameCenterMatchmakingRuleType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "GameCenterMatchmakingRuleType", Value: "UNKNOWN"}, err)
+}
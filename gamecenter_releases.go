@@ -0,0 +1,346 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// GameCenterAchievementRelease defines model for GameCenterAchievementRelease.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementrelease
+type GameCenterAchievementRelease struct {
+	Attributes    *GameCenterReleaseAttributes               `json:"attributes,omitempty"`
+	ID            string                                     `json:"id"`
+	Links         ResourceLinks                              `json:"links"`
+	Relationships *GameCenterAchievementReleaseRelationships `json:"relationships,omitempty"`
+	Type          string                                     `json:"type"`
+}
+
+// GameCenterReleaseAttributes defines model for the attributes of GameCenterAchievementRelease,
+// GameCenterLeaderboardRelease and GameCenterLeaderboardSetRelease.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementrelease/attributes
+type GameCenterReleaseAttributes struct {
+	Live *bool `json:"live,omitempty"`
+}
+
+// GameCenterAchievementReleaseRelationships defines model for GameCenterAchievementRelease.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementrelease/relationships
+type GameCenterAchievementReleaseRelationships struct {
+	GameCenterAchievement *Relationship `json:"gameCenterAchievement,omitempty"`
+	GameCenterDetail      *Relationship `json:"gameCenterDetail,omitempty"`
+}
+
+// GameCenterAchievementReleaseResponse defines model for GameCenterAchievementReleaseResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementreleaseresponse
+type GameCenterAchievementReleaseResponse struct {
+	Data  GameCenterAchievementRelease `json:"data"`
+	Links DocumentLinks                `json:"links"`
+}
+
+// GameCenterAchievementReleasesResponse defines model for GameCenterAchievementReleasesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementreleasesresponse
+type GameCenterAchievementReleasesResponse struct {
+	Data  []GameCenterAchievementRelease `json:"data"`
+	Links PagedDocumentLinks             `json:"links"`
+	Meta  *PagingInformation             `json:"meta,omitempty"`
+}
+
+// GameCenterLeaderboardRelease defines model for GameCenterLeaderboardRelease.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardrelease
+type GameCenterLeaderboardRelease struct {
+	Attributes    *GameCenterReleaseAttributes               `json:"attributes,omitempty"`
+	ID            string                                     `json:"id"`
+	Links         ResourceLinks                              `json:"links"`
+	Relationships *GameCenterLeaderboardReleaseRelationships `json:"relationships,omitempty"`
+	Type          string                                     `json:"type"`
+}
+
+// GameCenterLeaderboardReleaseRelationships defines model for GameCenterLeaderboardRelease.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardrelease/relationships
+type GameCenterLeaderboardReleaseRelationships struct {
+	GameCenterDetail      *Relationship `json:"gameCenterDetail,omitempty"`
+	GameCenterLeaderboard *Relationship `json:"gameCenterLeaderboard,omitempty"`
+}
+
+// GameCenterLeaderboardReleaseResponse defines model for GameCenterLeaderboardReleaseResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardreleaseresponse
+type GameCenterLeaderboardReleaseResponse struct {
+	Data  GameCenterLeaderboardRelease `json:"data"`
+	Links DocumentLinks                `json:"links"`
+}
+
+// GameCenterLeaderboardReleasesResponse defines model for GameCenterLeaderboardReleasesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardreleasesresponse
+type GameCenterLeaderboardReleasesResponse struct {
+	Data  []GameCenterLeaderboardRelease `json:"data"`
+	Links PagedDocumentLinks             `json:"links"`
+	Meta  *PagingInformation             `json:"meta,omitempty"`
+}
+
+// GameCenterLeaderboardSetRelease defines model for GameCenterLeaderboardSetRelease.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetrelease
+type GameCenterLeaderboardSetRelease struct {
+	Attributes    *GameCenterReleaseAttributes                  `json:"attributes,omitempty"`
+	ID            string                                        `json:"id"`
+	Links         ResourceLinks                                 `json:"links"`
+	Relationships *GameCenterLeaderboardSetReleaseRelationships `json:"relationships,omitempty"`
+	Type          string                                        `json:"type"`
+}
+
+// GameCenterLeaderboardSetReleaseRelationships defines model for GameCenterLeaderboardSetRelease.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetrelease/relationships
+type GameCenterLeaderboardSetReleaseRelationships struct {
+	GameCenterDetail         *Relationship `json:"gameCenterDetail,omitempty"`
+	GameCenterLeaderboardSet *Relationship `json:"gameCenterLeaderboardSet,omitempty"`
+}
+
+// GameCenterLeaderboardSetReleaseResponse defines model for GameCenterLeaderboardSetReleaseResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetreleaseresponse
+type GameCenterLeaderboardSetReleaseResponse struct {
+	Data  GameCenterLeaderboardSetRelease `json:"data"`
+	Links DocumentLinks                   `json:"links"`
+}
+
+// GameCenterLeaderboardSetReleasesResponse defines model for GameCenterLeaderboardSetReleasesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterleaderboardsetreleasesresponse
+type GameCenterLeaderboardSetReleasesResponse struct {
+	Data  []GameCenterLeaderboardSetRelease `json:"data"`
+	Links PagedDocumentLinks                `json:"links"`
+	Meta  *PagingInformation                `json:"meta,omitempty"`
+}
+
+// gameCenterReleaseCreateRequest defines model for GameCenterAchievementReleaseCreateRequest,
+// GameCenterLeaderboardReleaseCreateRequest and GameCenterLeaderboardSetReleaseCreateRequest, which only differ
+// in the relationship to the released resource.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/gamecenterachievementreleasecreaterequest/data
+type gameCenterReleaseCreateRequest struct {
+	Relationships map[string]relationshipDeclaration `json:"relationships"`
+	Type          string                             `json:"type"`
+}
+
+// GetGameCenterReleaseQuery are query options for getting a Game Center release.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_release_information
+type GetGameCenterReleaseQuery struct {
+	FieldsGameCenterAchievementReleases    []string `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FieldsGameCenterLeaderboardReleases    []string `url:"fields[gameCenterLeaderboardReleases],omitempty"`
+	FieldsGameCenterLeaderboardSetReleases []string `url:"fields[gameCenterLeaderboardSetReleases],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+}
+
+// ListGameCenterAchievementReleasesQuery are query options for ListAchievementReleasesForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_achievement_releases_for_a_game_center_detail
+type ListGameCenterAchievementReleasesQuery struct {
+	FieldsGameCenterAchievementReleases []string `url:"fields[gameCenterAchievementReleases],omitempty"`
+	FilterGameCenterAchievement         []string `url:"filter[gameCenterAchievement],omitempty"`
+	FilterLive                          []string `url:"filter[live],omitempty"`
+	Include                             []string `url:"include,omitempty"`
+	Limit                               int      `url:"limit,omitempty"`
+	Cursor                              string   `url:"cursor,omitempty"`
+}
+
+// ListGameCenterLeaderboardReleasesQuery are query options for ListLeaderboardReleasesForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboard_releases_for_a_game_center_detail
+type ListGameCenterLeaderboardReleasesQuery struct {
+	FieldsGameCenterLeaderboardReleases []string `url:"fields[gameCenterLeaderboardReleases],omitempty"`
+	FilterGameCenterLeaderboard         []string `url:"filter[gameCenterLeaderboard],omitempty"`
+	FilterLive                          []string `url:"filter[live],omitempty"`
+	Include                             []string `url:"include,omitempty"`
+	Limit                               int      `url:"limit,omitempty"`
+	Cursor                              string   `url:"cursor,omitempty"`
+}
+
+// ListGameCenterLeaderboardSetReleasesQuery are query options for ListLeaderboardSetReleasesForGameCenterDetail
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboard_set_releases_for_a_game_center_detail
+type ListGameCenterLeaderboardSetReleasesQuery struct {
+	FieldsGameCenterLeaderboardSetReleases []string `url:"fields[gameCenterLeaderboardSetReleases],omitempty"`
+	FilterGameCenterLeaderboardSet         []string `url:"filter[gameCenterLeaderboardSet],omitempty"`
+	FilterLive                             []string `url:"filter[live],omitempty"`
+	Include                                []string `url:"include,omitempty"`
+	Limit                                  int      `url:"limit,omitempty"`
+	Cursor                                 string   `url:"cursor,omitempty"`
+}
+
+// CreateGameCenterAchievementRelease releases an achievement of an app, making it live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_an_achievement_release
+func (s *GameCenterService) CreateGameCenterAchievementRelease(ctx context.Context, gameCenterDetailID string, gameCenterAchievementID string) (*GameCenterAchievementReleaseResponse, *Response, error) {
+	req := newGameCenterReleaseCreateRequest("gameCenterAchievementReleases", gameCenterDetailID, "gameCenterAchievement", gameCenterAchievementID, "gameCenterAchievements")
+	res := new(GameCenterAchievementReleaseResponse)
+	resp, err := s.client.post(ctx, "gameCenterAchievementReleases", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// GetGameCenterAchievementRelease gets information about an achievement release.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_achievement_release_information
+func (s *GameCenterService) GetGameCenterAchievementRelease(ctx context.Context, id string, params *GetGameCenterReleaseQuery) (*GameCenterAchievementReleaseResponse, *Response, error) {
+	return Get[GameCenterAchievementReleaseResponse](ctx, s.client, fmt.Sprintf("gameCenterAchievementReleases/%s", id), params)
+}
+
+// DeleteGameCenterAchievementRelease deletes an achievement release that is not live yet.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_achievement_release
+func (s *GameCenterService) DeleteGameCenterAchievementRelease(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterAchievementReleases/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListAchievementReleasesForGameCenterDetail lists the achievement releases of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_achievement_releases_for_a_game_center_detail
+func (s *GameCenterService) ListAchievementReleasesForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterAchievementReleasesQuery) (*GameCenterAchievementReleasesResponse, *Response, error) {
+	return Get[GameCenterAchievementReleasesResponse](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/achievementReleases", id), params)
+}
+
+// ListReleasesForGameCenterAchievement lists the releases of an achievement.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_releases_for_an_achievement
+func (s *GameCenterService) ListReleasesForGameCenterAchievement(ctx context.Context, id string, params *ListGameCenterAchievementReleasesQuery) (*GameCenterAchievementReleasesResponse, *Response, error) {
+	return Get[GameCenterAchievementReleasesResponse](ctx, s.client, fmt.Sprintf("gameCenterAchievements/%s/releases", id), params)
+}
+
+// CreateGameCenterLeaderboardRelease releases a leaderboard of an app, making it live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_leaderboard_release
+func (s *GameCenterService) CreateGameCenterLeaderboardRelease(ctx context.Context, gameCenterDetailID string, gameCenterLeaderboardID string) (*GameCenterLeaderboardReleaseResponse, *Response, error) {
+	req := newGameCenterReleaseCreateRequest("gameCenterLeaderboardReleases", gameCenterDetailID, "gameCenterLeaderboard", gameCenterLeaderboardID, "gameCenterLeaderboards")
+	res := new(GameCenterLeaderboardReleaseResponse)
+	resp, err := s.client.post(ctx, "gameCenterLeaderboardReleases", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// GetGameCenterLeaderboardRelease gets information about a leaderboard release.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_leaderboard_release_information
+func (s *GameCenterService) GetGameCenterLeaderboardRelease(ctx context.Context, id string, params *GetGameCenterReleaseQuery) (*GameCenterLeaderboardReleaseResponse, *Response, error) {
+	return Get[GameCenterLeaderboardReleaseResponse](ctx, s.client, fmt.Sprintf("gameCenterLeaderboardReleases/%s", id), params)
+}
+
+// DeleteGameCenterLeaderboardRelease deletes a leaderboard release that is not live yet.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_leaderboard_release
+func (s *GameCenterService) DeleteGameCenterLeaderboardRelease(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardReleases/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListLeaderboardReleasesForGameCenterDetail lists the leaderboard releases of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboard_releases_for_a_game_center_detail
+func (s *GameCenterService) ListLeaderboardReleasesForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterLeaderboardReleasesQuery) (*GameCenterLeaderboardReleasesResponse, *Response, error) {
+	return Get[GameCenterLeaderboardReleasesResponse](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/leaderboardReleases", id), params)
+}
+
+// ListReleasesForGameCenterLeaderboard lists the releases of a leaderboard.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_releases_for_a_leaderboard
+func (s *GameCenterService) ListReleasesForGameCenterLeaderboard(ctx context.Context, id string, params *ListGameCenterLeaderboardReleasesQuery) (*GameCenterLeaderboardReleasesResponse, *Response, error) {
+	return Get[GameCenterLeaderboardReleasesResponse](ctx, s.client, fmt.Sprintf("gameCenterLeaderboards/%s/releases", id), params)
+}
+
+// CreateGameCenterLeaderboardSetRelease releases a leaderboard set of an app, making it live.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_leaderboard_set_release
+func (s *GameCenterService) CreateGameCenterLeaderboardSetRelease(ctx context.Context, gameCenterDetailID string, gameCenterLeaderboardSetID string) (*GameCenterLeaderboardSetReleaseResponse, *Response, error) {
+	req := newGameCenterReleaseCreateRequest("gameCenterLeaderboardSetReleases", gameCenterDetailID, "gameCenterLeaderboardSet", gameCenterLeaderboardSetID, "gameCenterLeaderboardSets")
+	res := new(GameCenterLeaderboardSetReleaseResponse)
+	resp, err := s.client.post(ctx, "gameCenterLeaderboardSetReleases", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// GetGameCenterLeaderboardSetRelease gets information about a leaderboard set release.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_leaderboard_set_release_information
+func (s *GameCenterService) GetGameCenterLeaderboardSetRelease(ctx context.Context, id string, params *GetGameCenterReleaseQuery) (*GameCenterLeaderboardSetReleaseResponse, *Response, error) {
+	return Get[GameCenterLeaderboardSetReleaseResponse](ctx, s.client, fmt.Sprintf("gameCenterLeaderboardSetReleases/%s", id), params)
+}
+
+// DeleteGameCenterLeaderboardSetRelease deletes a leaderboard set release that is not live yet.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_leaderboard_set_release
+func (s *GameCenterService) DeleteGameCenterLeaderboardSetRelease(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("gameCenterLeaderboardSetReleases/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListLeaderboardSetReleasesForGameCenterDetail lists the leaderboard set releases of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_leaderboard_set_releases_for_a_game_center_detail
+func (s *GameCenterService) ListLeaderboardSetReleasesForGameCenterDetail(ctx context.Context, id string, params *ListGameCenterLeaderboardSetReleasesQuery) (*GameCenterLeaderboardSetReleasesResponse, *Response, error) {
+	return Get[GameCenterLeaderboardSetReleasesResponse](ctx, s.client, fmt.Sprintf("gameCenterDetails/%s/leaderboardSetReleases", id), params)
+}
+
+// ListReleasesForGameCenterLeaderboardSet lists the releases of a leaderboard set.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_releases_for_a_leaderboard_set
+func (s *GameCenterService) ListReleasesForGameCenterLeaderboardSet(ctx context.Context, id string, params *ListGameCenterLeaderboardSetReleasesQuery) (*GameCenterLeaderboardSetReleasesResponse, *Response, error) {
+	return Get[GameCenterLeaderboardSetReleasesResponse](ctx, s.client, fmt.Sprintf("gameCenterLeaderboardSets/%s/releases", id), params)
+}
+
+// RotateGameCenterLeaderboard performs a seasonal reset: it releases the leaderboard replacing a retiring one,
+// then archives the retiring leaderboard. The replacement is released first so that players always have a live
+// leaderboard to submit scores to.
+func (s *GameCenterService) RotateGameCenterLeaderboard(ctx context.Context, gameCenterDetailID string, retiringLeaderboardID string, replacementLeaderboardID string) error {
+	if _, _, err := s.CreateGameCenterLeaderboardRelease(ctx, gameCenterDetailID, replacementLeaderboardID); err != nil {
+		return fmt.Errorf("failed to release leaderboard %s: %w", replacementLeaderboardID, err)
+	}
+
+	if _, _, err := s.ArchiveGameCenterLeaderboard(ctx, retiringLeaderboardID); err != nil {
+		return fmt.Errorf("failed to archive leaderboard %s: %w", retiringLeaderboardID, err)
+	}
+
+	return nil
+}
+
+func newGameCenterReleaseCreateRequest(releaseType string, gameCenterDetailID string, relationship string, id string, relationshipType string) gameCenterReleaseCreateRequest {
+	return gameCenterReleaseCreateRequest{
+		Relationships: map[string]relationshipDeclaration{
+			"gameCenterDetail": *newRelationshipDeclaration(&gameCenterDetailID, "gameCenterDetails"),
+			relationship:       *newRelationshipDeclaration(&id, relationshipType),
+		},
+		Type: releaseType,
+	}
+}
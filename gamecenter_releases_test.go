@@ -0,0 +1,178 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateGameCenterAchievementRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterAchievementRelease(ctx, "10", "20")
+	})
+}
+
+func TestGetGameCenterAchievementRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterAchievementRelease(ctx, "10", &GetGameCenterReleaseQuery{})
+	})
+}
+
+func TestDeleteGameCenterAchievementRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterAchievementRelease(ctx, "10")
+	})
+}
+
+func TestListAchievementReleasesForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementReleasesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListAchievementReleasesForGameCenterDetail(ctx, "10", &ListGameCenterAchievementReleasesQuery{})
+	})
+}
+
+func TestListReleasesForGameCenterAchievement(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterAchievementReleasesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListReleasesForGameCenterAchievement(ctx, "10", &ListGameCenterAchievementReleasesQuery{})
+	})
+}
+
+func TestCreateGameCenterLeaderboardRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterLeaderboardRelease(ctx, "10", "20")
+	})
+}
+
+func TestGetGameCenterLeaderboardRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboardRelease(ctx, "10", &GetGameCenterReleaseQuery{})
+	})
+}
+
+func TestDeleteGameCenterLeaderboardRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterLeaderboardRelease(ctx, "10")
+	})
+}
+
+func TestListLeaderboardReleasesForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardReleasesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListLeaderboardReleasesForGameCenterDetail(ctx, "10", &ListGameCenterLeaderboardReleasesQuery{})
+	})
+}
+
+func TestListReleasesForGameCenterLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardReleasesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListReleasesForGameCenterLeaderboard(ctx, "10", &ListGameCenterLeaderboardReleasesQuery{})
+	})
+}
+
+func TestCreateGameCenterLeaderboardSetRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.CreateGameCenterLeaderboardSetRelease(ctx, "10", "20")
+	})
+}
+
+func TestGetGameCenterLeaderboardSetRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetReleaseResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.GetGameCenterLeaderboardSetRelease(ctx, "10", &GetGameCenterReleaseQuery{})
+	})
+}
+
+func TestDeleteGameCenterLeaderboardSetRelease(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.GameCenter.DeleteGameCenterLeaderboardSetRelease(ctx, "10")
+	})
+}
+
+func TestListLeaderboardSetReleasesForGameCenterDetail(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetReleasesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListLeaderboardSetReleasesForGameCenterDetail(ctx, "10", &ListGameCenterLeaderboardSetReleasesQuery{})
+	})
+}
+
+func TestListReleasesForGameCenterLeaderboardSet(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &GameCenterLeaderboardSetReleasesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.GameCenter.ListReleasesForGameCenterLeaderboardSet(ctx, "10", &ListGameCenterLeaderboardSetReleasesQuery{})
+	})
+}
+
+func TestRotateGameCenterLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"POST /gameCenterLeaderboardReleases": `{"data":{"id":"r1","type":"gameCenterLeaderboardReleases"}}`,
+		"PATCH /gameCenterLeaderboards/old":   `{"data":{"id":"old","type":"gameCenterLeaderboards","attributes":{"archived":true}}}`,
+	})
+	defer server.Close()
+
+	err := client.GameCenter.RotateGameCenterLeaderboard(context.Background(), "10", "old", "new")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"POST /gameCenterLeaderboardReleases",
+		"PATCH /gameCenterLeaderboards/old",
+	}, *requests)
+}
+
+func TestRotateGameCenterLeaderboardReleaseFails(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"PATCH /gameCenterLeaderboards/old": `{}`,
+	})
+	defer server.Close()
+
+	err := client.GameCenter.RotateGameCenterLeaderboard(context.Background(), "10", "old", "new")
+	assert.Error(t, err)
+	assert.Equal(t, []string{"POST /gameCenterLeaderboardReleases"}, *requests)
+}
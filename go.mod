@@ -1,10 +1,17 @@
 module github.com/lingjiawen/asc
 
-go 1.16
+go 1.18
 
 require (
 	github.com/cenkalti/backoff/v4 v4.1.1
 	github.com/dgrijalva/jwt-go/v4 v4.0.0-preview1
 	github.com/google/go-querystring v1.1.0
 	github.com/stretchr/testify v1.7.0
+	gopkg.in/yaml.v3 v3.0.1
+)
+
+require (
+	github.com/davecgh/go-spew v1.1.0 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	github.com/stretchr/objx v0.1.0 // indirect
 )
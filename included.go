@@ -49,6 +49,30 @@ func extractIncludedAgeRatingDeclaration(i interface{}) *AgeRatingDeclaration {
 	return nil
 }
 
+func extractIncludedAlternativeDistributionPackage(i interface{}) *AlternativeDistributionPackage {
+	if v, ok := i.(AlternativeDistributionPackage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAlternativeDistributionPackageDelta(i interface{}) *AlternativeDistributionPackageDelta {
+	if v, ok := i.(AlternativeDistributionPackageDelta); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAlternativeDistributionPackageVariant(i interface{}) *AlternativeDistributionPackageVariant {
+	if v, ok := i.(AlternativeDistributionPackageVariant); ok {
+		return &v
+	}
+
+	return nil
+}
+
 func extractIncludedApp(i interface{}) *App {
 	if v, ok := i.(App); ok {
 		return &v
@@ -65,6 +89,86 @@ func extractIncludedAppCategory(i interface{}) *AppCategory {
 	return nil
 }
 
+func extractIncludedAppClip(i interface{}) *AppClip {
+	if v, ok := i.(AppClip); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipAdvancedExperience(i interface{}) *AppClipAdvancedExperience {
+	if v, ok := i.(AppClipAdvancedExperience); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipAdvancedExperienceImage(i interface{}) *AppClipAdvancedExperienceImage {
+	if v, ok := i.(AppClipAdvancedExperienceImage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipAdvancedExperienceLocalization(i interface{}) *AppClipAdvancedExperienceLocalization {
+	if v, ok := i.(AppClipAdvancedExperienceLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipDefaultExperience(i interface{}) *AppClipDefaultExperience {
+	if v, ok := i.(AppClipDefaultExperience); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipDefaultExperienceLocalization(i interface{}) *AppClipDefaultExperienceLocalization {
+	if v, ok := i.(AppClipDefaultExperienceLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppClipHeaderImage(i interface{}) *AppClipHeaderImage {
+	if v, ok := i.(AppClipHeaderImage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppCustomProductPage(i interface{}) *AppCustomProductPage {
+	if v, ok := i.(AppCustomProductPage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppCustomProductPageLocalization(i interface{}) *AppCustomProductPageLocalization {
+	if v, ok := i.(AppCustomProductPageLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppCustomProductPageVersion(i interface{}) *AppCustomProductPageVersion {
+	if v, ok := i.(AppCustomProductPageVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
 func extractIncludedAppEncryptionDeclaration(i interface{}) *AppEncryptionDeclaration {
 	if v, ok := i.(AppEncryptionDeclaration); ok {
 		return &v
@@ -73,6 +177,30 @@ func extractIncludedAppEncryptionDeclaration(i interface{}) *AppEncryptionDeclar
 	return nil
 }
 
+func extractIncludedAppEvent(i interface{}) *AppEvent {
+	if v, ok := i.(AppEvent); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppEventScreenshot(i interface{}) *AppEventScreenshot {
+	if v, ok := i.(AppEventScreenshot); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppEventVideoClip(i interface{}) *AppEventVideoClip {
+	if v, ok := i.(AppEventVideoClip); ok {
+		return &v
+	}
+
+	return nil
+}
+
 func extractIncludedAppInfo(i interface{}) *AppInfo {
 	if v, ok := i.(AppInfo); ok {
 		return &v
@@ -113,6 +241,22 @@ func extractIncludedAppPrice(i interface{}) *AppPrice {
 	return nil
 }
 
+func extractIncludedAppPricePoint(i interface{}) *AppPricePoint {
+	if v, ok := i.(AppPricePoint); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppPriceSchedule(i interface{}) *AppPriceSchedule {
+	if v, ok := i.(AppPriceSchedule); ok {
+		return &v
+	}
+
+	return nil
+}
+
 func extractIncludedAppScreenshotSet(i interface{}) *AppScreenshotSet {
 	if v, ok := i.(AppScreenshotSet); ok {
 		return &v
@@ -137,6 +281,30 @@ func extractIncludedAppStoreVersion(i interface{}) *AppStoreVersion {
 	return nil
 }
 
+func extractIncludedAppStoreVersionExperiment(i interface{}) *AppStoreVersionExperiment {
+	if v, ok := i.(AppStoreVersionExperiment); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppStoreVersionExperimentTreatment(i interface{}) *AppStoreVersionExperimentTreatment {
+	if v, ok := i.(AppStoreVersionExperimentTreatment); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedAppStoreVersionExperimentTreatmentLocalization(i interface{}) *AppStoreVersionExperimentTreatmentLocalization {
+	if v, ok := i.(AppStoreVersionExperimentTreatmentLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
 func extractIncludedAppStoreVersionLocalization(i interface{}) *AppStoreVersionLocalization {
 	if v, ok := i.(AppStoreVersionLocalization); ok {
 		return &v
@@ -265,80 +433,368 @@ func extractIncludedCertificate(i interface{}) *Certificate {
 	return nil
 }
 
-func extractIncludedDevice(i interface{}) *Device {
-	if v, ok := i.(Device); ok {
+func extractIncludedCiProduct(i interface{}) *CiProduct {
+	if v, ok := i.(CiProduct); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedCiBuildRun(i interface{}) *CiBuildRun {
+	if v, ok := i.(CiBuildRun); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedCiWorkflow(i interface{}) *CiWorkflow {
+	if v, ok := i.(CiWorkflow); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedCiXcodeVersion(i interface{}) *CiXcodeVersion {
+	if v, ok := i.(CiXcodeVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedCiMacOsVersion(i interface{}) *CiMacOsVersion {
+	if v, ok := i.(CiMacOsVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedDevice(i interface{}) *Device {
+	if v, ok := i.(Device); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedDiagnosticSignature(i interface{}) *DiagnosticSignature {
+	if v, ok := i.(DiagnosticSignature); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedEndUserLicenseAgreement(i interface{}) *EndUserLicenseAgreement {
+	if v, ok := i.(EndUserLicenseAgreement); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterActivity(i interface{}) *GameCenterActivity {
+	if v, ok := i.(GameCenterActivity); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterActivityVersion(i interface{}) *GameCenterActivityVersion {
+	if v, ok := i.(GameCenterActivityVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterActivityLocalization(i interface{}) *GameCenterActivityLocalization {
+	if v, ok := i.(GameCenterActivityLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterActivityImage(i interface{}) *GameCenterActivityImage {
+	if v, ok := i.(GameCenterActivityImage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterChallenge(i interface{}) *GameCenterChallenge {
+	if v, ok := i.(GameCenterChallenge); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterChallengeVersion(i interface{}) *GameCenterChallengeVersion {
+	if v, ok := i.(GameCenterChallengeVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterChallengeLocalization(i interface{}) *GameCenterChallengeLocalization {
+	if v, ok := i.(GameCenterChallengeLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterChallengeImage(i interface{}) *GameCenterChallengeImage {
+	if v, ok := i.(GameCenterChallengeImage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterAchievement(i interface{}) *GameCenterAchievement {
+	if v, ok := i.(GameCenterAchievement); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterAchievementLocalization(i interface{}) *GameCenterAchievementLocalization {
+	if v, ok := i.(GameCenterAchievementLocalization); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterAchievementImage(i interface{}) *GameCenterAchievementImage {
+	if v, ok := i.(GameCenterAchievementImage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterAppVersion(i interface{}) *GameCenterAppVersion {
+	if v, ok := i.(GameCenterAppVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterDetail(i interface{}) *GameCenterDetail {
+	if v, ok := i.(GameCenterDetail); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterMatchmakingQueue(i interface{}) *GameCenterMatchmakingQueue {
+	if v, ok := i.(GameCenterMatchmakingQueue); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterMatchmakingRuleSet(i interface{}) *GameCenterMatchmakingRuleSet {
+	if v, ok := i.(GameCenterMatchmakingRuleSet); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterMatchmakingRule(i interface{}) *GameCenterMatchmakingRule {
+	if v, ok := i.(GameCenterMatchmakingRule); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterMatchmakingTeam(i interface{}) *GameCenterMatchmakingTeam {
+	if v, ok := i.(GameCenterMatchmakingTeam); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterGroup(i interface{}) *GameCenterGroup {
+	if v, ok := i.(GameCenterGroup); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterLeaderboard(i interface{}) *GameCenterLeaderboard {
+	if v, ok := i.(GameCenterLeaderboard); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedGameCenterEnabledVersion(i interface{}) *GameCenterEnabledVersion {
+	if v, ok := i.(GameCenterEnabledVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedIDFADeclaration(i interface{}) *IDFADeclaration {
+	if v, ok := i.(IDFADeclaration); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedInAppPurchasePricePoint(i interface{}) *InAppPurchasePricePoint {
+	if v, ok := i.(InAppPurchasePricePoint); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedInAppPurchase(i interface{}) *InAppPurchase {
+	if v, ok := i.(InAppPurchase); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedPerfPowerMetric(i interface{}) *PerfPowerMetric {
+	if v, ok := i.(PerfPowerMetric); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedPrereleaseVersion(i interface{}) *PrereleaseVersion {
+	if v, ok := i.(PrereleaseVersion); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedProfile(i interface{}) *Profile {
+	if v, ok := i.(Profile); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedReviewSubmissionItem(i interface{}) *ReviewSubmissionItem {
+	if v, ok := i.(ReviewSubmissionItem); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedRoutingAppCoverage(i interface{}) *RoutingAppCoverage {
+	if v, ok := i.(RoutingAppCoverage); ok {
+		return &v
+	}
+
+	return nil
+}
+
+func extractIncludedScmRepository(i interface{}) *ScmRepository {
+	if v, ok := i.(ScmRepository); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedDiagnosticSignature(i interface{}) *DiagnosticSignature {
-	if v, ok := i.(DiagnosticSignature); ok {
+func extractIncludedScmProvider(i interface{}) *ScmProvider {
+	if v, ok := i.(ScmProvider); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedEndUserLicenseAgreement(i interface{}) *EndUserLicenseAgreement {
-	if v, ok := i.(EndUserLicenseAgreement); ok {
+func extractIncludedScmGitReference(i interface{}) *ScmGitReference {
+	if v, ok := i.(ScmGitReference); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedGameCenterEnabledVersion(i interface{}) *GameCenterEnabledVersion {
-	if v, ok := i.(GameCenterEnabledVersion); ok {
+func extractIncludedScmPullRequest(i interface{}) *ScmPullRequest {
+	if v, ok := i.(ScmPullRequest); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedIDFADeclaration(i interface{}) *IDFADeclaration {
-	if v, ok := i.(IDFADeclaration); ok {
+func extractIncludedSubscription(i interface{}) *Subscription {
+	if v, ok := i.(Subscription); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedInAppPurchase(i interface{}) *InAppPurchase {
-	if v, ok := i.(InAppPurchase); ok {
+func extractIncludedSubscriptionOfferCodeCustomCode(i interface{}) *SubscriptionOfferCodeCustomCode {
+	if v, ok := i.(SubscriptionOfferCodeCustomCode); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedPerfPowerMetric(i interface{}) *PerfPowerMetric {
-	if v, ok := i.(PerfPowerMetric); ok {
+func extractIncludedSubscriptionOfferCodeOneTimeUseCode(i interface{}) *SubscriptionOfferCodeOneTimeUseCode {
+	if v, ok := i.(SubscriptionOfferCodeOneTimeUseCode); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedPrereleaseVersion(i interface{}) *PrereleaseVersion {
-	if v, ok := i.(PrereleaseVersion); ok {
+func extractIncludedSubscriptionOfferCodePrice(i interface{}) *SubscriptionOfferCodePrice {
+	if v, ok := i.(SubscriptionOfferCodePrice); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedProfile(i interface{}) *Profile {
-	if v, ok := i.(Profile); ok {
+func extractIncludedSubscriptionPricePoint(i interface{}) *SubscriptionPricePoint {
+	if v, ok := i.(SubscriptionPricePoint); ok {
 		return &v
 	}
 
 	return nil
 }
 
-func extractIncludedRoutingAppCoverage(i interface{}) *RoutingAppCoverage {
-	if v, ok := i.(RoutingAppCoverage); ok {
+func extractIncludedSubscriptionPromotionalOfferPrice(i interface{}) *SubscriptionPromotionalOfferPrice {
+	if v, ok := i.(SubscriptionPromotionalOfferPrice); ok {
 		return &v
 	}
 
@@ -365,9 +821,12 @@ func unmarshalInclude(b []byte) (string, interface{}, error) {
 
 	typeName := typeRef.Type
 
-	return supportedIncludeTypes()(typeName, b)
+	return includeTypes(typeName, b)
 }
 
+// includeTypes is built once, rather than for every included resource that is decoded.
+var includeTypes = supportedIncludeTypes()
+
 type includeTypeUnmarshallers map[string]func([]byte) (string, interface{}, error)
 
 func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
@@ -378,6 +837,108 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"alternativeDistributionPackages": func(b []byte) (string, interface{}, error) {
+			var v AlternativeDistributionPackage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"alternativeDistributionPackageDeltas": func(b []byte) (string, interface{}, error) {
+			var v AlternativeDistributionPackageDelta
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"alternativeDistributionPackageVariants": func(b []byte) (string, interface{}, error) {
+			var v AlternativeDistributionPackageVariant
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"alternativeDistributionPackageVersions": func(b []byte) (string, interface{}, error) {
+			var v AlternativeDistributionPackageVersion
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipAdvancedExperienceImages": func(b []byte) (string, interface{}, error) {
+			var v AppClipAdvancedExperienceImage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipAdvancedExperienceLocalizations": func(b []byte) (string, interface{}, error) {
+			var v AppClipAdvancedExperienceLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipAdvancedExperiences": func(b []byte) (string, interface{}, error) {
+			var v AppClipAdvancedExperience
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipDefaultExperienceLocalizations": func(b []byte) (string, interface{}, error) {
+			var v AppClipDefaultExperienceLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipDefaultExperiences": func(b []byte) (string, interface{}, error) {
+			var v AppClipDefaultExperience
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClipHeaderImages": func(b []byte) (string, interface{}, error) {
+			var v AppClipHeaderImage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appClips": func(b []byte) (string, interface{}, error) {
+			var v AppClip
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appCustomProductPageLocalizations": func(b []byte) (string, interface{}, error) {
+			var v AppCustomProductPageLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appCustomProductPages": func(b []byte) (string, interface{}, error) {
+			var v AppCustomProductPage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appCustomProductPageVersions": func(b []byte) (string, interface{}, error) {
+			var v AppCustomProductPageVersion
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appEvents": func(b []byte) (string, interface{}, error) {
+			var v AppEvent
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appEventScreenshots": func(b []byte) (string, interface{}, error) {
+			var v AppEventScreenshot
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appEventVideoClips": func(b []byte) (string, interface{}, error) {
+			var v AppEventVideoClip
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"apps": func(b []byte) (string, interface{}, error) {
 			var v App
 			err := json.Unmarshal(b, &v)
@@ -426,6 +987,18 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"appPricePoints": func(b []byte) (string, interface{}, error) {
+			var v AppPricePoint
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appPriceSchedules": func(b []byte) (string, interface{}, error) {
+			var v AppPriceSchedule
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"appScreenshotSets": func(b []byte) (string, interface{}, error) {
 			var v AppScreenshotSet
 			err := json.Unmarshal(b, &v)
@@ -438,6 +1011,24 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"appStoreVersionExperiments": func(b []byte) (string, interface{}, error) {
+			var v AppStoreVersionExperiment
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appStoreVersionExperimentTreatmentLocalizations": func(b []byte) (string, interface{}, error) {
+			var v AppStoreVersionExperimentTreatmentLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"appStoreVersionExperimentTreatments": func(b []byte) (string, interface{}, error) {
+			var v AppStoreVersionExperimentTreatment
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"appStoreVersions": func(b []byte) (string, interface{}, error) {
 			var v AppStoreVersion
 			err := json.Unmarshal(b, &v)
@@ -540,6 +1131,36 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"ciProducts": func(b []byte) (string, interface{}, error) {
+			var v CiProduct
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"ciBuildRuns": func(b []byte) (string, interface{}, error) {
+			var v CiBuildRun
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"ciWorkflows": func(b []byte) (string, interface{}, error) {
+			var v CiWorkflow
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"ciXcodeVersions": func(b []byte) (string, interface{}, error) {
+			var v CiXcodeVersion
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"ciMacOsVersions": func(b []byte) (string, interface{}, error) {
+			var v CiMacOsVersion
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"devices": func(b []byte) (string, interface{}, error) {
 			var v Device
 			err := json.Unmarshal(b, &v)
@@ -558,6 +1179,120 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"gameCenterActivities": func(b []byte) (string, interface{}, error) {
+			var v GameCenterActivity
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterActivityVersions": func(b []byte) (string, interface{}, error) {
+			var v GameCenterActivityVersion
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterActivityLocalizations": func(b []byte) (string, interface{}, error) {
+			var v GameCenterActivityLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterActivityImages": func(b []byte) (string, interface{}, error) {
+			var v GameCenterActivityImage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterChallenges": func(b []byte) (string, interface{}, error) {
+			var v GameCenterChallenge
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterChallengeVersions": func(b []byte) (string, interface{}, error) {
+			var v GameCenterChallengeVersion
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterChallengeLocalizations": func(b []byte) (string, interface{}, error) {
+			var v GameCenterChallengeLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterChallengeImages": func(b []byte) (string, interface{}, error) {
+			var v GameCenterChallengeImage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterAchievements": func(b []byte) (string, interface{}, error) {
+			var v GameCenterAchievement
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterAchievementLocalizations": func(b []byte) (string, interface{}, error) {
+			var v GameCenterAchievementLocalization
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterAchievementImages": func(b []byte) (string, interface{}, error) {
+			var v GameCenterAchievementImage
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterAppVersions": func(b []byte) (string, interface{}, error) {
+			var v GameCenterAppVersion
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterDetails": func(b []byte) (string, interface{}, error) {
+			var v GameCenterDetail
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterMatchmakingQueues": func(b []byte) (string, interface{}, error) {
+			var v GameCenterMatchmakingQueue
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterMatchmakingRuleSets": func(b []byte) (string, interface{}, error) {
+			var v GameCenterMatchmakingRuleSet
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterMatchmakingRules": func(b []byte) (string, interface{}, error) {
+			var v GameCenterMatchmakingRule
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterMatchmakingTeams": func(b []byte) (string, interface{}, error) {
+			var v GameCenterMatchmakingTeam
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterGroups": func(b []byte) (string, interface{}, error) {
+			var v GameCenterGroup
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"gameCenterLeaderboards": func(b []byte) (string, interface{}, error) {
+			var v GameCenterLeaderboard
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"gameCenterEnabledVersions": func(b []byte) (string, interface{}, error) {
 			var v GameCenterEnabledVersion
 			err := json.Unmarshal(b, &v)
@@ -576,6 +1311,12 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"inAppPurchasePricePoints": func(b []byte) (string, interface{}, error) {
+			var v InAppPurchasePricePoint
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"perfPowerMetrics": func(b []byte) (string, interface{}, error) {
 			var v PerfPowerMetric
 			err := json.Unmarshal(b, &v)
@@ -594,12 +1335,78 @@ func supportedIncludeTypes() func(string, []byte) (string, interface{}, error) {
 
 			return v.Type, v, err
 		},
+		"reviewSubmissionItems": func(b []byte) (string, interface{}, error) {
+			var v ReviewSubmissionItem
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"routingAppCoverages": func(b []byte) (string, interface{}, error) {
 			var v RoutingAppCoverage
 			err := json.Unmarshal(b, &v)
 
 			return v.Type, v, err
 		},
+		"scmRepositories": func(b []byte) (string, interface{}, error) {
+			var v ScmRepository
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"scmProviders": func(b []byte) (string, interface{}, error) {
+			var v ScmProvider
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"scmGitReferences": func(b []byte) (string, interface{}, error) {
+			var v ScmGitReference
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"scmPullRequests": func(b []byte) (string, interface{}, error) {
+			var v ScmPullRequest
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptions": func(b []byte) (string, interface{}, error) {
+			var v Subscription
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionOfferCodeCustomCodes": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionOfferCodeCustomCode
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionOfferCodeOneTimeUseCodes": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionOfferCodeOneTimeUseCode
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionOfferCodePrices": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionOfferCodePrice
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionPricePoints": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionPricePoint
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
+		"subscriptionPromotionalOfferPrices": func(b []byte) (string, interface{}, error) {
+			var v SubscriptionPromotionalOfferPrice
+			err := json.Unmarshal(b, &v)
+
+			return v.Type, v, err
+		},
 		"territories": func(b []byte) (string, interface{}, error) {
 			var v Territory
 			err := json.Unmarshal(b, &v)
@@ -74,13 +74,23 @@ func TestKnownIncludeTypes(t *testing.T) {
 	t.Parallel()
 
 	knownTypes := []string{"ageRatingDeclarations", "apps", "appCategories", "appEncryptionDeclarations",
-		"appInfos", "appInfoLocalizations", "appPreOrders", "appPreviewSets", "appPrices", "appScreenshotSets",
+		"appInfos", "appInfoLocalizations", "appPreOrders", "appPreviewSets", "appPrices", "appPricePoints",
+		"appPriceSchedules", "appScreenshotSets",
 		"appStoreReviewDetails", "appStoreVersions", "appStoreVersionLocalizations", "appStoreVersionPhasedReleases",
 		"appStoreVersionSubmissions", "betaAppLocalizations", "betaAppReviewDetails", "betaAppReviewSubmissions",
 		"betaBuildLocalizations", "betaGroups", "betaLicenseAgreements", "betaTesters", "builds", "buildBetaDetails",
 		"buildIcons", "bundleIds", "bundleIdCapabilities", "certificates", "devices", "diagnosticSignatures",
-		"endUserLicenseAgreements", "gameCenterEnabledVersions", "idfaDeclarations", "inAppPurchases", "perfPowerMetrics",
-		"preReleaseVersions", "profiles", "routingAppCoverages", "territories"}
+		"endUserLicenseAgreements", "gameCenterEnabledVersions", "idfaDeclarations", "inAppPurchases", "inAppPurchasePricePoints", "perfPowerMetrics",
+		"preReleaseVersions", "profiles", "routingAppCoverages", "territories", "appClips",
+		"appClipDefaultExperiences", "appClipDefaultExperienceLocalizations", "appClipHeaderImages",
+		"appClipAdvancedExperiences", "appClipAdvancedExperienceImages", "appClipAdvancedExperienceLocalizations",
+		"appCustomProductPages", "appCustomProductPageVersions", "appCustomProductPageLocalizations",
+		"appStoreVersionExperiments", "appStoreVersionExperimentTreatments",
+		"appStoreVersionExperimentTreatmentLocalizations", "reviewSubmissionItems", "appEvents", "appEventScreenshots",
+		"appEventVideoClips", "subscriptions", "subscriptionOfferCodeCustomCodes",
+		"subscriptionOfferCodeOneTimeUseCodes", "subscriptionOfferCodePrices", "subscriptionPricePoints",
+		"subscriptionPromotionalOfferPrices",
+		"ciProducts", "scmRepositories", "ciBuildRuns", "ciWorkflows", "scmProviders", "scmGitReferences", "scmPullRequests", "ciXcodeVersions", "ciMacOsVersions", "gameCenterAppVersions", "gameCenterDetails", "gameCenterAchievements", "gameCenterAchievementLocalizations", "gameCenterAchievementImages", "gameCenterLeaderboards", "gameCenterMatchmakingQueues", "gameCenterMatchmakingRuleSets", "gameCenterMatchmakingRules", "gameCenterMatchmakingTeams", "gameCenterActivities", "gameCenterActivityVersions", "gameCenterActivityLocalizations", "gameCenterActivityImages", "gameCenterChallenges", "gameCenterChallengeVersions", "gameCenterChallengeLocalizations", "gameCenterChallengeImages", "gameCenterGroups"}
 
 	var payload *mockPayloadIncluded
 
@@ -0,0 +1,52 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func listDeviceIDs(ctx context.Context, provisioning ProvisioningAPI) ([]string, error) {
+	res, _, err := provisioning.ListDevices(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(res.Data))
+	for _, device := range res.Data {
+		ids = append(ids, device.ID)
+	}
+
+	return ids, nil
+}
+
+func TestServiceInterfaces(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"data":[{"id":"10","type":"devices"}]}`, func(ctx context.Context, client *Client) {
+		ids, err := listDeviceIDs(ctx, client.Provisioning)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"10"}, ids)
+	})
+}
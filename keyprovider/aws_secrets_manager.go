@@ -0,0 +1,254 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package keyprovider
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/lingjiawen/asc"
+)
+
+// AWSSecretsManager fetches an API key from a secret in AWS Secrets Manager, whose value is a JSON object.
+// Requests are signed with Signature Version 4.
+//
+// https://docs.aws.amazon.com/secretsmanager/latest/apireference/API_GetSecretValue.html
+type AWSSecretsManager struct {
+	// Region is the AWS region of the secret, such as us-east-1.
+	Region string
+	// SecretID is the name or ARN of the secret.
+	SecretID string
+	// VersionStage is the staging label of the version to read. It defaults to AWSCURRENT.
+	VersionStage    string
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is required with temporary credentials, such as those of an assumed role.
+	SessionToken string
+	// Endpoint overrides the URL of the Secrets Manager API, such as for a VPC endpoint.
+	Endpoint string
+	Fields   Fields
+	// HTTPClient sends the requests to AWS. It defaults to http.DefaultClient.
+	HTTPClient *http.Client
+
+	now func() time.Time
+}
+
+// NewAWSSecretsManagerFromEnv returns an AWSSecretsManager provider for a secret, using the region and
+// credentials in the AWS_REGION (or AWS_DEFAULT_REGION), AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// AWS_SESSION_TOKEN environment variables, as the AWS command line does.
+func NewAWSSecretsManagerFromEnv(secretID string) *AWSSecretsManager {
+	region := os.Getenv("AWS_REGION")
+	if region == "" {
+		region = os.Getenv("AWS_DEFAULT_REGION")
+	}
+
+	return &AWSSecretsManager{
+		Region:          region,
+		SecretID:        secretID,
+		AccessKeyID:     os.Getenv("AWS_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("AWS_SECRET_ACCESS_KEY"),
+		SessionToken:    os.Getenv("AWS_SESSION_TOKEN"),
+	}
+}
+
+// APIKey reads the secret.
+func (m *AWSSecretsManager) APIKey(ctx context.Context) (*asc.APIKey, error) {
+	input := map[string]string{"SecretId": m.SecretID}
+	if m.VersionStage != "" {
+		input["VersionStage"] = m.VersionStage
+	}
+
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint := m.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://secretsmanager.%s.amazonaws.com/", m.Region)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", "secretsmanager.GetSecretValue")
+
+	now := time.Now
+	if m.now != nil {
+		now = m.now
+	}
+
+	signer := sigV4Signer{
+		accessKeyID:     m.AccessKeyID,
+		secretAccessKey: m.SecretAccessKey,
+		sessionToken:    m.SessionToken,
+		region:          m.Region,
+		service:         "secretsmanager",
+	}
+	signer.sign(req, body, now())
+
+	resp, err := httpClient(m.HTTPClient).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse("secrets manager", resp); err != nil {
+		return nil, err
+	}
+
+	var output struct {
+		SecretString *string `json:"SecretString"`
+		SecretBinary []byte  `json:"SecretBinary"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&output); err != nil {
+		return nil, err
+	}
+
+	secret := output.SecretBinary
+	if output.SecretString != nil {
+		secret = []byte(*output.SecretString)
+	}
+
+	values, err := stringValues(secret)
+	if err != nil {
+		return nil, fmt.Errorf("secret %s is not a JSON object: %w", m.SecretID, err)
+	}
+
+	return m.Fields.apiKey(values)
+}
+
+// sigV4Signer signs requests to AWS with Signature Version 4.
+//
+// https://docs.aws.amazon.com/IAM/latest/UserGuide/create-signed-request.html
+type sigV4Signer struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	region          string
+	service         string
+}
+
+// sign adds the X-Amz-Date, X-Amz-Security-Token and Authorization headers to a request with the given body,
+// signing its host, content type and X-Amz headers.
+func (s sigV4Signer) sign(req *http.Request, body []byte, now time.Time) {
+	amzDate := now.UTC().Format("20060102T150405Z")
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	if s.sessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", s.sessionToken)
+	}
+
+	headers := map[string]string{"host": req.URL.Host}
+
+	for name, values := range req.Header {
+		name = strings.ToLower(name)
+		if name == "content-type" || strings.HasPrefix(name, "x-amz-") {
+			headers[name] = strings.TrimSpace(strings.Join(values, ","))
+		}
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders.String(),
+		signedHeaders,
+		hexSHA256(body),
+	}, "\n")
+
+	scope := strings.Join([]string{amzDate[:8], s.region, s.service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, hexSHA256([]byte(canonicalRequest))}, "\n")
+
+	key := []byte("AWS4" + s.secretAccessKey)
+	for _, part := range []string{amzDate[:8], s.region, s.service, "aws4_request"} {
+		key = hmacSHA256(key, part)
+	}
+
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s", s.accessKeyID, scope, signedHeaders, signature))
+}
+
+// canonicalQuery encodes query parameters sorted by name and value, with spaces encoded as %20.
+func canonicalQuery(query url.Values) string {
+	pairs := make([]string, 0, len(query))
+
+	for name, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, awsEscape(name)+"="+awsEscape(value))
+		}
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, "&")
+}
+
+func awsEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func hexSHA256(b []byte) string {
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,139 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package keyprovider
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAWSSecretsManager(t *testing.T) {
+	t.Parallel()
+
+	var requests []*http.Request
+
+	secret := `{"keyId":"KEY","issuerId":"ISSUER","privateKey":"PEM"}`
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r)
+
+		switch string(body) {
+		case `{"SecretId":"asc/ci"}`:
+			_, _ = w.Write([]byte(`{"Name":"asc/ci","SecretString":` + jsonString(secret) + `}`))
+		case `{"SecretId":"asc/binary","VersionStage":"AWSPREVIOUS"}`:
+			_, _ = w.Write([]byte(`{"Name":"asc/binary","SecretBinary":"` + base64.StdEncoding.EncodeToString([]byte(secret)) + `"}`))
+		case `{"SecretId":"asc/plain"}`:
+			_, _ = w.Write([]byte(`{"Name":"asc/plain","SecretString":"PEM"}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"__type":"ResourceNotFoundException"}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	provider := &AWSSecretsManager{
+		Region:          "us-east-1",
+		SecretID:        "asc/ci",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "SECRET",
+		SessionToken:    "SESSION",
+		Endpoint:        server.URL,
+		now:             func() time.Time { return time.Date(2020, 8, 30, 12, 36, 0, 0, time.UTC) },
+	}
+
+	key, err := provider.APIKey(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "KEY", key.KeyID)
+	assert.Equal(t, "secretsmanager.GetSecretValue", requests[0].Header.Get("X-Amz-Target"))
+	assert.Equal(t, "20200830T123600Z", requests[0].Header.Get("X-Amz-Date"))
+	assert.Equal(t, "SESSION", requests[0].Header.Get("X-Amz-Security-Token"))
+	assert.Regexp(t, `^AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20200830/us-east-1/secretsmanager/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-amz-security-token;x-amz-target, Signature=[0-9a-f]{64}$`, requests[0].Header.Get("Authorization"))
+
+	provider.SecretID = "asc/binary"
+	provider.VersionStage = "AWSPREVIOUS"
+
+	key, err = provider.APIKey(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "ISSUER", key.IssuerID)
+
+	provider.SecretID = "asc/plain"
+	provider.VersionStage = ""
+
+	_, err = provider.APIKey(ctx)
+	assert.EqualError(t, err, "secret asc/plain is not a JSON object: invalid character 'P' looking for beginning of value")
+
+	provider.SecretID = "missing"
+
+	_, err = provider.APIKey(ctx)
+	assert.EqualError(t, err, `secrets manager responded with 400 Bad Request: {"__type":"ResourceNotFoundException"}`)
+}
+
+func jsonString(s string) string {
+	b, _ := json.Marshal(s)
+
+	return string(b)
+}
+
+// TestSigV4Signer checks the signer against the example request in the AWS documentation.
+func TestSigV4Signer(t *testing.T) {
+	t.Parallel()
+
+	req, err := http.NewRequest(http.MethodGet, "https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil) // nolint: noctx
+	assert.NoError(t, err)
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	signer := sigV4Signer{
+		accessKeyID:     "AKIDEXAMPLE",
+		secretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		region:          "us-east-1",
+		service:         "iam",
+	}
+	signer.sign(req, nil, time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC))
+
+	assert.Equal(t, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7", req.Header.Get("Authorization"))
+}
+
+func TestCanonicalQuery(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "a=1&a=2&b=x%20y&c=%2A~", canonicalQuery(map[string][]string{"b": {"x y"}, "a": {"2", "1"}, "c": {"*~"}}))
+}
+
+func TestNewAWSSecretsManagerFromEnv(t *testing.T) {
+	t.Setenv("AWS_REGION", "")
+	t.Setenv("AWS_DEFAULT_REGION", "eu-west-1")
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKID")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "SECRET")
+	t.Setenv("AWS_SESSION_TOKEN", "")
+
+	assert.Equal(t, &AWSSecretsManager{Region: "eu-west-1", SecretID: "asc/ci", AccessKeyID: "AKID", SecretAccessKey: "SECRET"}, NewAWSSecretsManagerFromEnv("asc/ci"))
+}
@@ -0,0 +1,123 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package keyprovider fetches App Store Connect API keys from secret stores, for use with
+// asc.NewKeyProviderTokenConfig. The key is fetched again each time a token is signed, so that keys rotated in
+// the store are picked up without restarting the process.
+//
+// Each provider reads a secret holding the key ID, the issuer ID and the PEM-encoded contents of the .p8 file, in
+// fields named keyId, issuerId and privateKey unless Fields names them otherwise:
+//
+//	auth, err := asc.NewKeyProviderTokenConfig(ctx, &keyprovider.Vault{
+//		Address: "https://vault.example.com:8200",
+//		Token:   os.Getenv("VAULT_TOKEN"),
+//		Path:    "app-store-connect/ci",
+//	}, 20*time.Minute)
+//	if err != nil {
+//		return err
+//	}
+//	client := asc.NewClient(auth.Client())
+package keyprovider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/lingjiawen/asc"
+)
+
+// ErrMissingField happens when a secret does not have one of the fields of an API key.
+var ErrMissingField = errors.New("secret has no field")
+
+// maxErrorBodySize is how much of an unsuccessful response is included in its error.
+const maxErrorBodySize = 1024
+
+// Fields names the fields of a secret that hold the parts of an API key. Empty names default to keyId, issuerId
+// and privateKey.
+type Fields struct {
+	KeyID      string
+	IssuerID   string
+	PrivateKey string
+}
+
+// apiKey reads an API key from the fields of a secret.
+func (f Fields) apiKey(values map[string]string) (*asc.APIKey, error) {
+	names := [3]string{f.KeyID, f.IssuerID, f.PrivateKey}
+	defaults := [3]string{"keyId", "issuerId", "privateKey"}
+
+	var parts [3]string
+
+	for i, name := range names {
+		if name == "" {
+			name = defaults[i]
+		}
+
+		value, ok := values[name]
+		if !ok || value == "" {
+			return nil, fmt.Errorf("%w %q", ErrMissingField, name)
+		}
+
+		parts[i] = value
+	}
+
+	return &asc.APIKey{KeyID: parts[0], IssuerID: parts[1], PrivateKey: []byte(parts[2])}, nil
+}
+
+// stringValues decodes a JSON object, keeping only its string values.
+func stringValues(data []byte) (map[string]string, error) {
+	var object map[string]interface{}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(object))
+
+	for name, value := range object {
+		if s, ok := value.(string); ok {
+			values[name] = s
+		}
+	}
+
+	return values, nil
+}
+
+// httpClient returns client, or http.DefaultClient if it is nil.
+func httpClient(client *http.Client) *http.Client {
+	if client == nil {
+		return http.DefaultClient
+	}
+
+	return client
+}
+
+// checkResponse returns an error describing an unsuccessful response from a secret store.
+func checkResponse(store string, resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	return fmt.Errorf("%s responded with %s: %s", store, resp.Status, body)
+}
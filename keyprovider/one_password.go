@@ -0,0 +1,101 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package keyprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/lingjiawen/asc"
+)
+
+// OnePassword fetches an API key from an item in a 1Password vault, through a 1Password Connect server. Fields
+// are matched by their label.
+//
+// https://developer.1password.com/docs/connect/connect-api-reference
+type OnePassword struct {
+	// Host is the URL of the 1Password Connect server, such as http://localhost:8080.
+	Host string
+	// Token is the access token of the Connect server.
+	Token string
+	// VaultID is the ID of the vault holding the item.
+	VaultID string
+	// ItemID is the ID of the item.
+	ItemID string
+	Fields Fields
+	// HTTPClient sends the requests to the Connect server. It defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// NewOnePasswordFromEnv returns a OnePassword provider for an item, connecting to the server in the
+// OP_CONNECT_HOST environment variable with the token in OP_CONNECT_TOKEN, as the 1Password SDKs do.
+func NewOnePasswordFromEnv(vaultID string, itemID string) *OnePassword {
+	return &OnePassword{
+		Host:    os.Getenv("OP_CONNECT_HOST"),
+		Token:   os.Getenv("OP_CONNECT_TOKEN"),
+		VaultID: vaultID,
+		ItemID:  itemID,
+	}
+}
+
+// APIKey reads the item.
+func (p *OnePassword) APIKey(ctx context.Context) (*asc.APIKey, error) {
+	endpoint := fmt.Sprintf("%s/v1/vaults/%s/items/%s", strings.TrimSuffix(p.Host, "/"), url.PathEscape(p.VaultID), url.PathEscape(p.ItemID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+p.Token)
+
+	resp, err := httpClient(p.HTTPClient).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse("1password connect", resp); err != nil {
+		return nil, err
+	}
+
+	var item struct {
+		Fields []struct {
+			Label string `json:"label"`
+			Value string `json:"value"`
+		} `json:"fields"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&item); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]string, len(item.Fields))
+	for _, field := range item.Fields {
+		values[field.Label] = field.Value
+	}
+
+	return p.Fields.apiKey(values)
+}
@@ -0,0 +1,74 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package keyprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnePassword(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/vaults/VAULT/items/ITEM" || r.Header.Get("Authorization") != "Bearer TOKEN" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"id":"ITEM","fields":[
+			{"id":"1","label":"Key ID","value":"KEY"},
+			{"id":"2","label":"issuerId","value":"ISSUER"},
+			{"id":"3","label":"privateKey","value":"PEM"}
+		]}`))
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+	provider := &OnePassword{Host: server.URL, Token: "TOKEN", VaultID: "VAULT", ItemID: "ITEM", Fields: Fields{KeyID: "Key ID"}}
+
+	key, err := provider.APIKey(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "KEY", key.KeyID)
+	assert.Equal(t, []byte("PEM"), key.PrivateKey)
+
+	provider.Fields = Fields{}
+
+	_, err = provider.APIKey(ctx)
+	assert.ErrorIs(t, err, ErrMissingField)
+
+	provider.Token = "EXPIRED"
+
+	_, err = provider.APIKey(ctx)
+	assert.EqualError(t, err, "1password connect responded with 401 Unauthorized: ")
+}
+
+func TestNewOnePasswordFromEnv(t *testing.T) {
+	t.Setenv("OP_CONNECT_HOST", "http://localhost:8080")
+	t.Setenv("OP_CONNECT_TOKEN", "TOKEN")
+
+	assert.Equal(t, &OnePassword{Host: "http://localhost:8080", Token: "TOKEN", VaultID: "VAULT", ItemID: "ITEM"}, NewOnePasswordFromEnv("VAULT", "ITEM"))
+}
@@ -0,0 +1,127 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package keyprovider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/lingjiawen/asc"
+)
+
+// Vault fetches an API key from a key/value secret in HashiCorp Vault.
+//
+// https://developer.hashicorp.com/vault/api-docs/secret/kv
+type Vault struct {
+	// Address is the URL of the Vault server, such as https://vault.example.com:8200.
+	Address string
+	// Token authenticates the requests to Vault.
+	Token string
+	// Namespace is the Vault Enterprise namespace of the secret, if any.
+	Namespace string
+	// Mount is the path the key/value secrets engine is mounted at. It defaults to secret.
+	Mount string
+	// Path is the path of the secret within the mount.
+	Path string
+	// KVVersion is the version of the key/value secrets engine, 1 or 2. It defaults to 2.
+	KVVersion int
+	Fields    Fields
+	// HTTPClient sends the requests to Vault. It defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+// NewVaultFromEnv returns a Vault provider for the secret at path in the secret mount, connecting to the server
+// in the VAULT_ADDR environment variable with the token in VAULT_TOKEN and the namespace in VAULT_NAMESPACE, as
+// the Vault command line does.
+func NewVaultFromEnv(path string) *Vault {
+	return &Vault{
+		Address:   os.Getenv("VAULT_ADDR"),
+		Token:     os.Getenv("VAULT_TOKEN"),
+		Namespace: os.Getenv("VAULT_NAMESPACE"),
+		Path:      path,
+	}
+}
+
+// APIKey reads the current version of the secret.
+func (v *Vault) APIKey(ctx context.Context) (*asc.APIKey, error) {
+	mount := strings.Trim(v.Mount, "/")
+	if mount == "" {
+		mount = "secret"
+	}
+
+	url := strings.TrimSuffix(v.Address, "/") + "/v1/" + mount + "/"
+	if v.KVVersion != 1 {
+		url += "data/"
+	}
+
+	url += strings.TrimPrefix(v.Path, "/")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-Vault-Token", v.Token)
+
+	if v.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.Namespace)
+	}
+
+	resp, err := httpClient(v.HTTPClient).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if err := checkResponse("vault", resp); err != nil {
+		return nil, err
+	}
+
+	var secret struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&secret); err != nil {
+		return nil, err
+	}
+
+	data := secret.Data
+
+	if v.KVVersion != 1 {
+		var versioned struct {
+			Data json.RawMessage `json:"data"`
+		}
+		if err := json.Unmarshal(data, &versioned); err != nil {
+			return nil, err
+		}
+
+		data = versioned.Data
+	}
+
+	values, err := stringValues(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.Fields.apiKey(values)
+}
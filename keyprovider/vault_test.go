@@ -0,0 +1,79 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package keyprovider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVault(t *testing.T) {
+	t.Parallel()
+
+	var paths, tokens, namespaces []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		tokens = append(tokens, r.Header.Get("X-Vault-Token"))
+		namespaces = append(namespaces, r.Header.Get("X-Vault-Namespace"))
+
+		switch r.URL.Path {
+		case "/v1/secret/data/asc/ci":
+			_, _ = w.Write([]byte(`{"data":{"data":{"keyId":"KEY","issuerId":"ISSUER","privateKey":"PEM"},"metadata":{"version":3}}}`))
+		case "/v1/kv/asc/ci":
+			_, _ = w.Write([]byte(`{"data":{"keyId":"KEY1","issuerId":"ISSUER","privateKey":"PEM"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errors":[]}`))
+		}
+	}))
+	defer server.Close()
+
+	ctx := context.Background()
+
+	key, err := (&Vault{Address: server.URL + "/", Token: "TOKEN", Path: "/asc/ci"}).APIKey(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "KEY", key.KeyID)
+	assert.Equal(t, []byte("PEM"), key.PrivateKey)
+
+	key, err = (&Vault{Address: server.URL, Token: "TOKEN", Namespace: "team", Mount: "kv", Path: "asc/ci", KVVersion: 1}).APIKey(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "KEY1", key.KeyID)
+
+	_, err = (&Vault{Address: server.URL, Token: "TOKEN", Path: "missing"}).APIKey(ctx)
+	assert.EqualError(t, err, `vault responded with 404 Not Found: {"errors":[]}`)
+
+	assert.Equal(t, []string{"/v1/secret/data/asc/ci", "/v1/kv/asc/ci", "/v1/secret/data/missing"}, paths)
+	assert.Equal(t, []string{"TOKEN", "TOKEN", "TOKEN"}, tokens)
+	assert.Equal(t, []string{"", "team", ""}, namespaces)
+}
+
+func TestNewVaultFromEnv(t *testing.T) {
+	t.Setenv("VAULT_ADDR", "https://vault.example.com:8200")
+	t.Setenv("VAULT_TOKEN", "TOKEN")
+	t.Setenv("VAULT_NAMESPACE", "team")
+
+	assert.Equal(t, &Vault{Address: "https://vault.example.com:8200", Token: "TOKEN", Namespace: "team", Path: "asc/ci"}, NewVaultFromEnv("asc/ci"))
+}
@@ -0,0 +1,99 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// OutputSchemaVersion is the version of the documents written by WriteOutput. It changes when a field is removed
+// or changes meaning, but not when a field is added, so consumers should ignore fields they don't know.
+const OutputSchemaVersion = 1
+
+// ErrUnsupportedOutput happens when a document read by ReadOutput has another schema version or kind than
+// the one requested.
+var ErrUnsupportedOutput = errors.New("unsupported output document")
+
+// Output is the result of a higher-level helper, such as a plan, an access audit or a sync, that has a stable
+// JSON form for CI systems and tools written in other languages.
+type Output interface {
+	// OutputKind names the result in its document, such as "plan" or "accessAudit".
+	OutputKind() string
+}
+
+// OutputDocument is the envelope WriteOutput puts around a result, so that consumers can tell what they are
+// reading and which schema it follows:
+//
+//	{
+//	  "schemaVersion": 1,
+//	  "kind": "plan",
+//	  "data": {"changes": [...]}
+//	}
+type OutputDocument struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	Kind          string          `json:"kind"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// NewOutputDocument returns v in its envelope.
+func NewOutputDocument(v Output) (*OutputDocument, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OutputDocument{SchemaVersion: OutputSchemaVersion, Kind: v.OutputKind(), Data: data}, nil
+}
+
+// WriteOutput writes v to w as an indented JSON document.
+func WriteOutput(w io.Writer, v Output) error {
+	doc, err := NewOutputDocument(v)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(doc)
+}
+
+// ReadOutput reads a document written by WriteOutput into v, checking that it has the schema version of this
+// package and the kind of v.
+func ReadOutput(r io.Reader, v Output) error {
+	var doc OutputDocument
+	if err := json.NewDecoder(r).Decode(&doc); err != nil {
+		return err
+	}
+
+	if doc.SchemaVersion != OutputSchemaVersion {
+		return fmt.Errorf("%w: schema version %d, expected %d", ErrUnsupportedOutput, doc.SchemaVersion, OutputSchemaVersion)
+	}
+
+	if doc.Kind != v.OutputKind() {
+		return fmt.Errorf("%w: kind %q, expected %q", ErrUnsupportedOutput, doc.Kind, v.OutputKind())
+	}
+
+	return json.Unmarshal(doc.Data, v)
+}
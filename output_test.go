@@ -0,0 +1,95 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteOutput(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	plan := &Plan{Changes: []ConfigChange{
+		{Action: ConfigActionUpdate, Resource: "profiles", Name: "Development", ID: "P1", Fields: []ConfigFieldChange{{Field: "certificates", Current: "C1", Desired: "C1,C2"}}},
+		{Action: ConfigActionCreate, Resource: "bundleIds", Name: "com.example.app"},
+	}}
+
+	err := WriteOutput(&buf, plan)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"schemaVersion": 1,
+		"kind": "plan",
+		"data": {"changes": [
+			{"action": "UPDATE", "resource": "profiles", "name": "Development", "id": "P1", "fields": [{"field": "certificates", "current": "C1", "desired": "C1,C2"}]},
+			{"action": "CREATE", "resource": "bundleIds", "name": "com.example.app"}
+		]}
+	}`, buf.String())
+
+	var got Plan
+	assert.NoError(t, ReadOutput(&buf, &got))
+	assert.Equal(t, plan, &got)
+}
+
+func TestWriteOutputEmptyLists(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		output Output
+		want   string
+	}{
+		{&Plan{}, `{"changes":[]}`},
+		{&ConfigPlan{}, `{"changes":[]}`},
+		{&ApplyResult{}, `{"changes":[]}`},
+		{&RosterPlan{}, `{"actions":[]}`},
+		{&SubscriptionPricePlan{SubscriptionID: "S1"}, `{"subscriptionId":"S1","changes":[]}`},
+		{&CiWorkflowPlan{WorkflowID: "W1"}, `{"workflowId":"W1","document":null,"changes":[]}`},
+		{&VersionDiff{}, `{"localizations":[],"screenshots":[],"prices":[],"availability":[]}`},
+		{&GameCenterAchievementSyncResult{}, `{"created":[],"updated":[],"unchanged":[],"localizations":0,"images":0}`},
+	} {
+		doc, err := NewOutputDocument(tc.output)
+		assert.NoError(t, err)
+		assert.Equal(t, OutputSchemaVersion, doc.SchemaVersion)
+		assert.Equal(t, tc.output.OutputKind(), doc.Kind)
+		assert.JSONEq(t, tc.want, string(doc.Data), doc.Kind)
+	}
+}
+
+func TestReadOutputUnsupported(t *testing.T) {
+	t.Parallel()
+
+	var plan Plan
+
+	err := ReadOutput(strings.NewReader(`{"schemaVersion":2,"kind":"plan","data":{"changes":[]}}`), &plan)
+	assert.ErrorIs(t, err, ErrUnsupportedOutput)
+	assert.EqualError(t, err, "unsupported output document: schema version 2, expected 1")
+
+	err = ReadOutput(strings.NewReader(`{"schemaVersion":1,"kind":"rosterPlan","data":{"actions":[]}}`), &plan)
+	assert.EqualError(t, err, `unsupported output document: kind "rosterPlan", expected "plan"`)
+
+	err = ReadOutput(strings.NewReader(`{`), &plan)
+	assert.Error(t, err)
+}
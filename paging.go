@@ -23,8 +23,13 @@ package asc
 import (
 	"encoding/json"
 	"net/url"
+	"reflect"
 )
 
+// MaxPageLimit is the largest page size, set with the Limit field of list queries, that App Store Connect accepts
+// for most list endpoints.
+const MaxPageLimit = 200
+
 // Reference is a wrapper type for a URL that contains a cursor parameter.
 type Reference struct {
 	url.URL
@@ -42,8 +47,8 @@ func (r Reference) MarshalJSON() ([]byte, error) {
 
 // UnmarshalJSON unmarshals the JSON fragment into a Reference.
 func (r *Reference) UnmarshalJSON(b []byte) error {
-	var s string
-	if err := json.Unmarshal(b, &s); err != nil {
+	s, err := unquoteJSONString(b)
+	if err != nil {
 		return err
 	}
 
@@ -66,6 +71,64 @@ type PagedDocumentLinks struct {
 	Self  Reference  `json:"self"`
 }
 
+// NextCursor returns the cursor of the next page, or an empty string if this is the last page.
+func (l PagedDocumentLinks) NextCursor() string {
+	if l.Next == nil {
+		return ""
+	}
+
+	return l.Next.Cursor()
+}
+
+// SelfCursor returns the cursor of this page, or an empty string for the first page. Storing it lets an
+// interrupted export resume from the page it was processing.
+func (l PagedDocumentLinks) SelfCursor() string {
+	return l.Self.Cursor()
+}
+
+// ParseCursor returns the cursor of next, which is either a bare cursor or a full next link as returned in
+// PagedDocumentLinks, such as one persisted by an earlier run.
+func ParseCursor(next string) string {
+	u, err := url.Parse(next)
+	if err != nil || u.RawQuery == "" {
+		return next
+	}
+
+	return u.Query().Get("cursor")
+}
+
+// ForEachPage pages through a list endpoint. It calls fetch with an empty cursor for the first page, then with
+// the cursor of the next page until fetch returns the links of the last page or an error.
+//
+//	params := &asc.ListAppsQuery{Limit: asc.MaxPageLimit}
+//	err := asc.ForEachPage(func(cursor string) (*asc.PagedDocumentLinks, error) {
+//		params.Cursor = cursor
+//		apps, _, err := client.Apps.ListApps(ctx, params)
+//		if err != nil {
+//			return nil, err
+//		}
+//		all = append(all, apps.Data...)
+//		return &apps.Links, nil
+//	})
+func ForEachPage(fetch func(cursor string) (*PagedDocumentLinks, error)) error {
+	return ForEachPageFrom("", fetch)
+}
+
+// ForEachPageFrom is like ForEachPage, but starts at the page of cursor, such as the NextCursor or SelfCursor of
+// a page saved before an export was interrupted.
+func ForEachPageFrom(cursor string, fetch func(cursor string) (*PagedDocumentLinks, error)) error {
+	for {
+		links, err := fetch(cursor)
+		if err != nil || links == nil {
+			return err
+		}
+
+		if cursor = links.NextCursor(); cursor == "" {
+			return nil
+		}
+	}
+}
+
 // PagingInformation defines model for PagingInformation.
 type PagingInformation struct {
 	Paging struct {
@@ -74,6 +137,32 @@ type PagingInformation struct {
 	} `json:"paging"`
 }
 
+// TotalCount returns the total number of resources across all pages, or zero if p is nil.
+func (p *PagingInformation) TotalCount() int {
+	if p == nil {
+		return 0
+	}
+
+	return p.Paging.Total
+}
+
+// pagingInformationOf returns the Meta field of a decoded list response, or nil if v has none.
+func pagingInformationOf(v interface{}) *PagingInformation {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil
+	}
+
+	meta := rv.FieldByName("Meta")
+	if !meta.IsValid() || !meta.CanInterface() {
+		return nil
+	}
+
+	p, _ := meta.Interface().(*PagingInformation)
+
+	return p
+}
+
 // ResourceLinks defines model for ResourceLinks.
 type ResourceLinks struct {
 	Self Reference `json:"self"`
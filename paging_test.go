@@ -22,6 +22,7 @@ package asc
 
 import (
 	"encoding/json"
+	"errors"
 	"net/url"
 	"testing"
 
@@ -64,6 +65,84 @@ func TestReferenceBadUnmarshal(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestForEachPage(t *testing.T) {
+	t.Parallel()
+
+	next, _ := url.Parse("https://api.appstoreconnect.apple.com/v1/apps?cursor=NEXT")
+	pages := []PagedDocumentLinks{{Next: &Reference{*next}}, {}}
+	cursors := make([]string, 0)
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		cursors = append(cursors, cursor)
+		page := pages[len(cursors)-1]
+
+		return &page, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "NEXT"}, cursors)
+
+	err = ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		return nil, errors.New("")
+	})
+	assert.Error(t, err)
+
+	assert.NoError(t, ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		return nil, nil
+	}))
+}
+
+func TestForEachPageFrom(t *testing.T) {
+	t.Parallel()
+
+	cursors := make([]string, 0)
+
+	err := ForEachPageFrom("SAVED", func(cursor string) (*PagedDocumentLinks, error) {
+		cursors = append(cursors, cursor)
+
+		return &PagedDocumentLinks{}, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"SAVED"}, cursors)
+}
+
+func TestPagedDocumentLinksCursors(t *testing.T) {
+	t.Parallel()
+
+	var links PagedDocumentLinks
+	err := json.Unmarshal([]byte(`{
+		"self":"https://api.appstoreconnect.apple.com/v1/apps?cursor=SELF&limit=200",
+		"next":"https://api.appstoreconnect.apple.com/v1/apps?cursor=NEXT&limit=200"
+	}`), &links)
+	assert.NoError(t, err)
+	assert.Equal(t, "SELF", links.SelfCursor())
+	assert.Equal(t, "NEXT", links.NextCursor())
+	assert.Equal(t, "", PagedDocumentLinks{}.NextCursor())
+}
+
+func TestPagingInformationOf(t *testing.T) {
+	t.Parallel()
+
+	meta := &PagingInformation{}
+	meta.Paging.Total = 3
+
+	assert.Same(t, meta, pagingInformationOf(&BuildsResponse{Meta: meta}))
+	assert.Nil(t, pagingInformationOf(&BuildsResponse{}))
+	assert.Nil(t, pagingInformationOf(&BuildResponse{}))
+	assert.Nil(t, pagingInformationOf(&mockPayload{}))
+	assert.Nil(t, pagingInformationOf([]string{}))
+	assert.Equal(t, 3, meta.TotalCount())
+	assert.Equal(t, 0, (*PagingInformation)(nil).TotalCount())
+}
+
+func TestParseCursor(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "NEXT", ParseCursor("https://api.appstoreconnect.apple.com/v1/apps?cursor=NEXT&limit=200"))
+	assert.Equal(t, "NEXT", ParseCursor("NEXT"))
+	assert.Equal(t, "eyJvZmZzZXQiOiIyMDAifQ", ParseCursor("eyJvZmZzZXQiOiIyMDAifQ"))
+	assert.Equal(t, "", ParseCursor("https://api.appstoreconnect.apple.com/v1/apps?limit=200"))
+}
+
 func TestNewRelationships(t *testing.T) {
 	t.Parallel()
 
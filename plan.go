@@ -0,0 +1,220 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ConfigAction is the kind of change made to a resource to converge it to its config.
+type ConfigAction string
+
+const (
+	// ConfigActionCreate creates a resource that is missing.
+	ConfigActionCreate ConfigAction = "CREATE"
+	// ConfigActionUpdate changes a resource that drifted from its config.
+	ConfigActionUpdate ConfigAction = "UPDATE"
+	// ConfigActionDelete deletes a resource the config prunes, such as an unlisted capability.
+	ConfigActionDelete ConfigAction = "DELETE"
+)
+
+var configActions = []ConfigAction{
+	ConfigActionCreate,
+	ConfigActionUpdate,
+	ConfigActionDelete,
+}
+
+// IsValid reports whether the config action is one this package knows about.
+func (v ConfigAction) IsValid() bool {
+	for _, value := range configActions {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseConfigAction returns s as a ConfigAction, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseConfigAction(s string) (ConfigAction, error) {
+	if v := ConfigAction(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "ConfigAction", Value: s}
+}
+
+// ConfigFieldChange is a field of a resource that drifted from its config.
+type ConfigFieldChange struct {
+	Field   string `json:"field"`
+	Current string `json:"current"`
+	Desired string `json:"desired"`
+}
+
+func (c ConfigFieldChange) String() string {
+	return fmt.Sprintf("%s: %q -> %q", c.Field, c.Current, c.Desired)
+}
+
+// ConfigChange is a change made to a single resource to converge it to its desired state.
+type ConfigChange struct {
+	Action ConfigAction `json:"action"`
+	// Resource is the type of the resource, such as "bundleIds" or "profiles".
+	Resource string `json:"resource"`
+	// Name identifies the resource, such as the identifier of a bundle ID, the name of a profile or the email
+	// address of a user.
+	Name string `json:"name"`
+	// ID is the ID of the resource. It is empty in a plan for resources to be created, and is the new ID in the
+	// result of applying a plan for created and recreated resources.
+	ID string `json:"id,omitempty"`
+	// Fields are the fields that drifted, for updates.
+	Fields []ConfigFieldChange `json:"fields,omitempty"`
+}
+
+func (c ConfigChange) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s %s %s", c.Action, c.Resource, c.Name)
+
+	for i, field := range c.Fields {
+		if i == 0 {
+			b.WriteString(": ")
+		} else {
+			b.WriteString(", ")
+		}
+
+		b.WriteString(field.String())
+	}
+
+	return b.String()
+}
+
+// Plan is a structured diff of the resources a declarative change creates, updates and deletes, computed
+// without changing anything. String prints it for a terminal, Markdown for a pull request comment, and
+// WriteOutput for other tools.
+type Plan struct {
+	Changes []ConfigChange `json:"changes"`
+}
+
+// OutputKind implements Output.
+func (p Plan) OutputKind() string {
+	return "plan"
+}
+
+// MarshalJSON encodes a plan without changes with an empty list of changes rather than null.
+func (p Plan) MarshalJSON() ([]byte, error) {
+	type plan Plan
+
+	if p.Changes == nil {
+		p.Changes = []ConfigChange{}
+	}
+
+	return json.Marshal(plan(p))
+}
+
+// Empty reports whether the plan changes nothing.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// Count returns the number of changes of a kind in the plan.
+func (p *Plan) Count(action ConfigAction) int {
+	count := 0
+
+	for _, change := range p.Changes {
+		if change.Action == action {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Summary counts the changes of the plan, such as "1 to create, 2 to update, 0 to delete".
+func (p *Plan) Summary() string {
+	if p.Empty() {
+		return "no changes"
+	}
+
+	return fmt.Sprintf("%d to create, %d to update, %d to delete", p.Count(ConfigActionCreate), p.Count(ConfigActionUpdate), p.Count(ConfigActionDelete))
+}
+
+func (p *Plan) String() string {
+	var b strings.Builder
+
+	b.WriteString(p.Summary() + "\n")
+
+	for _, change := range p.Changes {
+		fmt.Fprintf(&b, "  %s\n", change)
+	}
+
+	return b.String()
+}
+
+// Markdown formats the plan as a heading and a diff block, which renders created resources in green and deleted
+// ones in red, with the previous and new values of each updated field on their own lines:
+//
+//	### Plan: 0 to create, 1 to update, 0 to delete
+//
+//	```diff
+//	! UPDATE profiles Development
+//	-     certificates: "C1"
+//	+     certificates: "C1,C2"
+//	```
+func (p *Plan) Markdown() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "### Plan: %s\n", p.Summary())
+
+	if p.Empty() {
+		return b.String()
+	}
+
+	b.WriteString("\n```diff\n")
+
+	for _, change := range p.Changes {
+		marker := "!"
+
+		switch change.Action {
+		case ConfigActionCreate:
+			marker = "+"
+		case ConfigActionDelete:
+			marker = "-"
+		}
+
+		fmt.Fprintf(&b, "%s %s %s %s\n", marker, change.Action, change.Resource, change.Name)
+
+		for _, field := range change.Fields {
+			if change.Action != ConfigActionCreate {
+				fmt.Fprintf(&b, "-     %s: %q\n", field.Field, field.Current)
+			}
+
+			if change.Action != ConfigActionDelete {
+				fmt.Fprintf(&b, "+     %s: %q\n", field.Field, field.Desired)
+			}
+		}
+	}
+
+	b.WriteString("```\n")
+
+	return b.String()
+}
@@ -0,0 +1,86 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseConfigAction(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range configActions {
+		got, err := ParseConfigAction(string(value))
+		assert.NoError(t, err)
+		assert.Equal(t, value, got)
+	}
+
+	_, err := ParseConfigAction("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "ConfigAction", Value: "UNKNOWN"}, err)
+}
+
+var testPlan = &Plan{Changes: []ConfigChange{
+	{Action: ConfigActionCreate, Resource: "bundleIds", Name: "com.example.app"},
+	{Action: ConfigActionUpdate, Resource: "profiles", Name: "Development", ID: "1", Fields: []ConfigFieldChange{{Field: "certificates", Current: "C1", Desired: "C1,C2"}}},
+	{Action: ConfigActionDelete, Resource: "bundleIdCapabilities", Name: "com.example.app GAME_CENTER", ID: "2"},
+	{Action: ConfigActionCreate, Resource: "userInvitations", Name: "new@example.com", Fields: []ConfigFieldChange{{Field: "roles", Current: "none", Desired: "DEVELOPER"}}},
+}}
+
+func TestPlanSummary(t *testing.T) {
+	t.Parallel()
+
+	assert.False(t, testPlan.Empty())
+	assert.Equal(t, 2, testPlan.Count(ConfigActionCreate))
+	assert.Equal(t, "2 to create, 1 to update, 1 to delete", testPlan.Summary())
+
+	empty := &Plan{}
+	assert.True(t, empty.Empty())
+	assert.Equal(t, "no changes", empty.Summary())
+	assert.Equal(t, "no changes\n", empty.String())
+	assert.Equal(t, "### Plan: no changes\n", empty.Markdown())
+}
+
+func TestPlanString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, `2 to create, 1 to update, 1 to delete
+  CREATE bundleIds com.example.app
+  UPDATE profiles Development: certificates: "C1" -> "C1,C2"
+  DELETE bundleIdCapabilities com.example.app GAME_CENTER
+  CREATE userInvitations new@example.com: roles: "none" -> "DEVELOPER"
+`, testPlan.String())
+}
+
+func TestPlanMarkdown(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "### Plan: 2 to create, 1 to update, 1 to delete\n\n```diff\n"+
+		"+ CREATE bundleIds com.example.app\n"+
+		"! UPDATE profiles Development\n"+
+		"-     certificates: \"C1\"\n"+
+		"+     certificates: \"C1,C2\"\n"+
+		"- DELETE bundleIdCapabilities com.example.app GAME_CENTER\n"+
+		"+ CREATE userInvitations new@example.com\n"+
+		"+     roles: \"DEVELOPER\"\n"+
+		"```\n", testPlan.Markdown())
+}
@@ -36,18 +36,33 @@ type PricingService service
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appprice
 type AppPrice struct {
+	Attributes    *AppPriceAttributes    `json:"attributes,omitempty"`
 	ID            string                 `json:"id"`
 	Links         ResourceLinks          `json:"links"`
 	Relationships *AppPriceRelationships `json:"relationships,omitempty"`
 	Type          string                 `json:"type"`
 }
 
+// AppPriceAttributes defines model for AppPrice.Attributes
+//
+// These attributes are only populated for prices that belong to an app price schedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricev2/attributes
+type AppPriceAttributes struct {
+	EndDate   *Date `json:"endDate,omitempty"`
+	Manual    *bool `json:"manual,omitempty"`
+	StartDate *Date `json:"startDate,omitempty"`
+}
+
 // AppPriceRelationships defines model for AppPrice.Relationships
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appprice/relationships
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricev2/relationships
 type AppPriceRelationships struct {
-	App       *Relationship `json:"app,omitempty"`
-	PriceTier *Relationship `json:"priceTier,omitempty"`
+	App           *Relationship `json:"app,omitempty"`
+	AppPricePoint *Relationship `json:"appPricePoint,omitempty"`
+	PriceTier     *Relationship `json:"priceTier,omitempty"`
+	Territory     *Relationship `json:"territory,omitempty"`
 }
 
 // AppPriceResponse defines model for AppPriceResponse.
@@ -62,11 +77,16 @@ type AppPriceResponse struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/apppricesresponse
 type AppPricesResponse struct {
-	Data  []AppPrice         `json:"data"`
-	Links PagedDocumentLinks `json:"links"`
-	Meta  *PagingInformation `json:"meta,omitempty"`
+	Data     []AppPrice                  `json:"data"`
+	Included []AppPricesResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks          `json:"links"`
+	Meta     *PagingInformation          `json:"meta,omitempty"`
 }
 
+// AppPricesResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppPricesResponse.
+type AppPricesResponseIncluded included
+
 // ListPricesQuery are query options for ListPrices
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_prices_for_an_app
@@ -91,20 +111,31 @@ type GetPriceQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_prices_for_an_app
 func (s *PricingService) ListPricesForApp(ctx context.Context, id string, params *ListPricesQuery) (*AppPricesResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/prices", id)
-	res := new(AppPricesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppPricesResponse](ctx, s.client, fmt.Sprintf("apps/%s/prices", id), params)
 }
 
 // GetPrice reads current price and scheduled price changes for an app, including price tier and start date.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_price_information
 func (s *PricingService) GetPrice(ctx context.Context, id string, params *GetPriceQuery) (*AppPriceResponse, *Response, error) {
-	url := fmt.Sprintf("appPrices/%s", id)
-	res := new(AppPriceResponse)
-	resp, err := s.client.get(ctx, url, params, res)
+	return Get[AppPriceResponse](ctx, s.client, fmt.Sprintf("appPrices/%s", id), params)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppPricesResponseIncluded.
+func (i *AppPricesResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AppPricePoint returns the AppPricePoint stored within, if one is present.
+func (i *AppPricesResponseIncluded) AppPricePoint() *AppPricePoint {
+	return extractIncludedAppPricePoint(i.inner)
+}
 
-	return res, resp, err
+// Territory returns the Territory stored within, if one is present.
+func (i *AppPricesResponseIncluded) Territory() *Territory {
+	return extractIncludedTerritory(i.inner)
 }
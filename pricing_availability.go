@@ -0,0 +1,451 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrTerritoryAvailabilityNotFound happens when an app's availability has no entry for a territory.
+var ErrTerritoryAvailabilityNotFound = errors.New("no territory availability found")
+
+// ErrInvalidAvailabilityPlan happens when an AvailabilityPlan names unknown territories or contradicts itself.
+type ErrInvalidAvailabilityPlan struct {
+	Problems []string
+}
+
+func (e ErrInvalidAvailabilityPlan) Error() string {
+	return fmt.Sprintf("availability plan is invalid: %s", strings.Join(e.Problems, "; "))
+}
+
+// AppAvailability defines model for AppAvailabilityV2.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2
+type AppAvailability struct {
+	Attributes    *AppAvailabilityAttributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *AppAvailabilityRelationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// AppAvailabilityAttributes defines model for AppAvailabilityV2.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2/attributes
+type AppAvailabilityAttributes struct {
+	AvailableInNewTerritories *bool `json:"availableInNewTerritories,omitempty"`
+}
+
+// AppAvailabilityRelationships defines model for AppAvailabilityV2.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2/relationships
+type AppAvailabilityRelationships struct {
+	App                     *Relationship      `json:"app,omitempty"`
+	TerritoryAvailabilities *PagedRelationship `json:"territoryAvailabilities,omitempty"`
+}
+
+// AppAvailabilityResponse defines model for AppAvailabilityV2Response.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2response
+type AppAvailabilityResponse struct {
+	Data     AppAvailability         `json:"data"`
+	Included []TerritoryAvailability `json:"included,omitempty"`
+	Links    DocumentLinks           `json:"links"`
+}
+
+// TerritoryAvailability defines model for TerritoryAvailability.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailability
+type TerritoryAvailability struct {
+	Attributes    *TerritoryAvailabilityAttributes    `json:"attributes,omitempty"`
+	ID            string                              `json:"id"`
+	Links         ResourceLinks                       `json:"links"`
+	Relationships *TerritoryAvailabilityRelationships `json:"relationships,omitempty"`
+	Type          string                              `json:"type"`
+}
+
+// TerritoryAvailabilityAttributes defines model for TerritoryAvailability.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailability/attributes
+type TerritoryAvailabilityAttributes struct {
+	Available           *bool    `json:"available,omitempty"`
+	ContentStatuses     []string `json:"contentStatuses,omitempty"`
+	PreOrderEnabled     *bool    `json:"preOrderEnabled,omitempty"`
+	PreOrderPublishDate *Date    `json:"preOrderPublishDate,omitempty"`
+	ReleaseDate         *Date    `json:"releaseDate,omitempty"`
+}
+
+// TerritoryAvailabilityRelationships defines model for TerritoryAvailability.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailability/relationships
+type TerritoryAvailabilityRelationships struct {
+	Territory *Relationship `json:"territory,omitempty"`
+}
+
+// TerritoryAvailabilityResponse defines model for TerritoryAvailabilityResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailabilityresponse
+type TerritoryAvailabilityResponse struct {
+	Data     TerritoryAvailability `json:"data"`
+	Included []Territory           `json:"included,omitempty"`
+	Links    DocumentLinks         `json:"links"`
+}
+
+// TerritoryAvailabilitiesResponse defines model for TerritoryAvailabilitiesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailabilitiesresponse
+type TerritoryAvailabilitiesResponse struct {
+	Data     []TerritoryAvailability `json:"data"`
+	Included []Territory             `json:"included,omitempty"`
+	Links    PagedDocumentLinks      `json:"links"`
+	Meta     *PagingInformation      `json:"meta,omitempty"`
+}
+
+// appAvailabilityCreateRequest defines model for AppAvailabilityV2CreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2createrequest/data
+type appAvailabilityCreateRequest struct {
+	Attributes    appAvailabilityCreateRequestAttributes    `json:"attributes"`
+	Relationships appAvailabilityCreateRequestRelationships `json:"relationships"`
+	Type          string                                    `json:"type"`
+}
+
+// appAvailabilityCreateRequestAttributes are attributes for AppAvailabilityV2CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2createrequest/data/attributes
+type appAvailabilityCreateRequestAttributes struct {
+	AvailableInNewTerritories bool `json:"availableInNewTerritories"`
+}
+
+// appAvailabilityCreateRequestRelationships are relationships for AppAvailabilityV2CreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/appavailabilityv2createrequest/data/relationships
+type appAvailabilityCreateRequestRelationships struct {
+	App                     relationshipDeclaration      `json:"app"`
+	TerritoryAvailabilities pagedRelationshipDeclaration `json:"territoryAvailabilities"`
+}
+
+// NewTerritoryAvailability models the availability of an app in a single territory when its availability is created.
+// Set ReleaseDate to nil for the app to be released as soon as it is approved.
+type NewTerritoryAvailability struct {
+	Available       bool
+	PreOrderEnabled bool
+	ReleaseDate     *Date
+	Territory       TerritoryCode
+}
+
+type territoryAvailabilityInlineCreate struct {
+	Attributes    territoryAvailabilityInlineCreateAttributes    `json:"attributes"`
+	ID            string                                         `json:"id"`
+	Relationships territoryAvailabilityInlineCreateRelationships `json:"relationships"`
+	Type          string                                         `json:"type"`
+}
+
+type territoryAvailabilityInlineCreateAttributes struct {
+	Available       bool  `json:"available"`
+	PreOrderEnabled bool  `json:"preOrderEnabled"`
+	ReleaseDate     *Date `json:"releaseDate,omitempty"`
+}
+
+type territoryAvailabilityInlineCreateRelationships struct {
+	Territory relationshipDeclaration `json:"territory"`
+}
+
+func (a NewTerritoryAvailability) inlineCreate() territoryAvailabilityInlineCreate {
+	territory := string(a.Territory)
+
+	return territoryAvailabilityInlineCreate{
+		Attributes: territoryAvailabilityInlineCreateAttributes{
+			Available:       a.Available,
+			PreOrderEnabled: a.PreOrderEnabled,
+			ReleaseDate:     a.ReleaseDate,
+		},
+		ID: fmt.Sprintf("${new-territory-availability-%s}", territory),
+		Relationships: territoryAvailabilityInlineCreateRelationships{
+			Territory: *newRelationshipDeclaration(&territory, "territories"),
+		},
+		Type: "territoryAvailabilities",
+	}
+}
+
+// territoryAvailabilityUpdateRequest defines model for TerritoryAvailabilityUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailabilityupdaterequest/data
+type territoryAvailabilityUpdateRequest struct {
+	Attributes *TerritoryAvailabilityUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                        `json:"id"`
+	Type       string                                        `json:"type"`
+}
+
+// TerritoryAvailabilityUpdateRequestAttributes are attributes for TerritoryAvailabilityUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territoryavailabilityupdaterequest/data/attributes
+type TerritoryAvailabilityUpdateRequestAttributes struct {
+	Available       *bool `json:"available,omitempty"`
+	PreOrderEnabled *bool `json:"preOrderEnabled,omitempty"`
+	ReleaseDate     *Date `json:"releaseDate,omitempty"`
+}
+
+// GetAppAvailabilityQuery are query options for GetAppAvailability and GetAppAvailabilityForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v2_appavailabilities_id
+type GetAppAvailabilityQuery struct {
+	FieldsAppAvailabilities       []string `url:"fields[appAvailabilities],omitempty"`
+	FieldsTerritoryAvailabilities []string `url:"fields[territoryAvailabilities],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	LimitTerritoryAvailabilities  int      `url:"limit[territoryAvailabilities],omitempty"`
+}
+
+// ListTerritoryAvailabilitiesQuery are query options for ListTerritoryAvailabilitiesForAppAvailability
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v2_appavailabilities_id_territoryavailabilities
+type ListTerritoryAvailabilitiesQuery struct {
+	FieldsTerritories             []string `url:"fields[territories],omitempty"`
+	FieldsTerritoryAvailabilities []string `url:"fields[territoryAvailabilities],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// AvailabilityPlan declares a change to the territories an app is available in.
+type AvailabilityPlan struct {
+	// Add lists the territories to make the app available in.
+	Add []TerritoryCode
+	// ReleaseDate is the date the app becomes available in the territories of Add. Nil releases
+	// the app as soon as it is approved.
+	ReleaseDate *Date
+	// Remove lists the territories to remove the app from.
+	Remove []TerritoryCode
+	// AvailableInNewTerritories controls whether the app is made available in territories Apple adds
+	// in the future. It is only used when the app has no availability yet.
+	AvailableInNewTerritories bool
+}
+
+// Validate checks that every territory of the plan is known, and that no territory is both added and removed.
+func (p AvailabilityPlan) Validate() error {
+	var problems []string
+
+	added := make(map[TerritoryCode]bool, len(p.Add))
+
+	for _, code := range p.Add {
+		if !code.IsValid() {
+			problems = append(problems, fmt.Sprintf("%s is not a known territory", code))
+		}
+
+		added[code] = true
+	}
+
+	for _, code := range p.Remove {
+		if !code.IsValid() {
+			problems = append(problems, fmt.Sprintf("%s is not a known territory", code))
+		}
+
+		if added[code] {
+			problems = append(problems, fmt.Sprintf("%s is both added and removed", code))
+		}
+	}
+
+	if len(problems) > 0 {
+		return ErrInvalidAvailabilityPlan{Problems: problems}
+	}
+
+	return nil
+}
+
+// GetAppAvailabilityForApp reads the availability of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_appavailabilityv2
+func (s *PricingService) GetAppAvailabilityForApp(ctx context.Context, id string, params *GetAppAvailabilityQuery) (*AppAvailabilityResponse, *Response, error) {
+	return Get[AppAvailabilityResponse](ctx, s.client, fmt.Sprintf("apps/%s/appAvailabilityV2", id), params)
+}
+
+// GetAppAvailability reads an app availability.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v2_appavailabilities_id
+func (s *PricingService) GetAppAvailability(ctx context.Context, id string, params *GetAppAvailabilityQuery) (*AppAvailabilityResponse, *Response, error) {
+	return Get[AppAvailabilityResponse](ctx, s.client, fmt.Sprintf("../v2/appAvailabilities/%s", id), params)
+}
+
+// ListTerritoryAvailabilitiesForAppAvailability lists the availability of an app in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v2_appavailabilities_id_territoryavailabilities
+func (s *PricingService) ListTerritoryAvailabilitiesForAppAvailability(ctx context.Context, id string, params *ListTerritoryAvailabilitiesQuery) (*TerritoryAvailabilitiesResponse, *Response, error) {
+	return Get[TerritoryAvailabilitiesResponse](ctx, s.client, fmt.Sprintf("../v2/appAvailabilities/%s/territoryAvailabilities", id), params)
+}
+
+// CreateAppAvailability sets the territories an app is available in for the first time.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v2_appavailabilities
+func (s *PricingService) CreateAppAvailability(ctx context.Context, appID string, availableInNewTerritories bool, territories []NewTerritoryAvailability) (*AppAvailabilityResponse, *Response, error) {
+	newAvailabilities := make([]territoryAvailabilityInlineCreate, len(territories))
+	availabilityIDs := make([]string, len(territories))
+
+	for i, territory := range territories {
+		availability := territory.inlineCreate()
+		newAvailabilities[i] = availability
+		availabilityIDs[i] = availability.ID
+	}
+
+	req := appAvailabilityCreateRequest{
+		Attributes: appAvailabilityCreateRequestAttributes{
+			AvailableInNewTerritories: availableInNewTerritories,
+		},
+		Relationships: appAvailabilityCreateRequestRelationships{
+			App:                     *newRelationshipDeclaration(&appID, "apps"),
+			TerritoryAvailabilities: newPagedRelationshipDeclaration(availabilityIDs, "territoryAvailabilities"),
+		},
+		Type: "appAvailabilities",
+	}
+
+	body := newRequestBody(req)
+	if len(newAvailabilities) > 0 {
+		body = newRequestBodyWithIncluded(req, newAvailabilities)
+	}
+
+	res := new(AppAvailabilityResponse)
+	resp, err := s.client.post(ctx, "../v2/appAvailabilities", body, res)
+
+	return res, resp, err
+}
+
+// UpdateTerritoryAvailability changes whether and when an app is available in a single territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_territoryavailabilities_id
+func (s *PricingService) UpdateTerritoryAvailability(ctx context.Context, id string, attributes *TerritoryAvailabilityUpdateRequestAttributes) (*TerritoryAvailabilityResponse, *Response, error) {
+	req := territoryAvailabilityUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "territoryAvailabilities",
+	}
+	url := fmt.Sprintf("territoryAvailabilities/%s", id)
+	res := new(TerritoryAvailabilityResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ScheduleAvailability applies an AvailabilityPlan to an app in one call, and returns the territory
+// availabilities it changed. If the app has no availability yet, one is created with only the added
+// territories; otherwise each affected territory is updated in turn.
+func (s *PricingService) ScheduleAvailability(ctx context.Context, appID string, plan AvailabilityPlan) ([]TerritoryAvailability, *Response, error) {
+	if err := plan.Validate(); err != nil {
+		return nil, nil, err
+	}
+
+	existing, resp, err := s.GetAppAvailabilityForApp(ctx, appID, nil)
+	if err != nil {
+		var erro *ErrorResponse
+		if !errors.As(err, &erro) || erro.Response == nil || erro.Response.StatusCode != http.StatusNotFound {
+			return nil, resp, err
+		}
+
+		return s.createAvailabilityFromPlan(ctx, appID, plan)
+	}
+
+	params := &ListTerritoryAvailabilitiesQuery{
+		Include: []string{"territory"},
+		Limit:   MaxPageLimit,
+	}
+	territories := &TerritoryAvailabilitiesResponse{}
+
+	err = ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		page, res, err := s.ListTerritoryAvailabilitiesForAppAvailability(ctx, existing.Data.ID, params)
+		resp = res
+		if err != nil {
+			return nil, err
+		}
+
+		territories.Data = append(territories.Data, page.Data...)
+		territories.Included = append(territories.Included, page.Included...)
+
+		return &page.Links, nil
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	ids := make(map[TerritoryCode]string, len(territories.Data))
+
+	for _, availability := range territories.Data {
+		if rels := availability.Relationships; rels != nil && rels.Territory != nil && rels.Territory.Data != nil {
+			ids[TerritoryCode(rels.Territory.Data.ID)] = availability.ID
+		}
+	}
+
+	changes := make([]territoryAvailabilityChange, 0, len(plan.Add)+len(plan.Remove))
+
+	for _, code := range plan.Add {
+		changes = append(changes, territoryAvailabilityChange{code, TerritoryAvailabilityUpdateRequestAttributes{Available: Bool(true), ReleaseDate: plan.ReleaseDate}})
+	}
+
+	for _, code := range plan.Remove {
+		changes = append(changes, territoryAvailabilityChange{code, TerritoryAvailabilityUpdateRequestAttributes{Available: Bool(false)}})
+	}
+
+	updated := make([]TerritoryAvailability, 0, len(changes))
+
+	for _, change := range changes {
+		id, ok := ids[change.code]
+		if !ok {
+			return updated, resp, fmt.Errorf("%w: %s", ErrTerritoryAvailabilityNotFound, change.code)
+		}
+
+		attributes := change.attributes
+
+		res, resp, err := s.UpdateTerritoryAvailability(ctx, id, &attributes)
+		if err != nil {
+			return updated, resp, err
+		}
+
+		updated = append(updated, res.Data)
+	}
+
+	return updated, resp, nil
+}
+
+type territoryAvailabilityChange struct {
+	code       TerritoryCode
+	attributes TerritoryAvailabilityUpdateRequestAttributes
+}
+
+func (s *PricingService) createAvailabilityFromPlan(ctx context.Context, appID string, plan AvailabilityPlan) ([]TerritoryAvailability, *Response, error) {
+	territories := make([]NewTerritoryAvailability, len(plan.Add))
+	for i, code := range plan.Add {
+		territories[i] = NewTerritoryAvailability{
+			Available:   true,
+			ReleaseDate: plan.ReleaseDate,
+			Territory:   code,
+		}
+	}
+
+	res, resp, err := s.CreateAppAvailability(ctx, appID, plan.AvailableInNewTerritories, territories)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return res.Included, resp, nil
+}
@@ -0,0 +1,161 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAppAvailabilityForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppAvailabilityResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetAppAvailabilityForApp(ctx, "10", &GetAppAvailabilityQuery{})
+	})
+}
+
+func TestGetAppAvailability(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppAvailabilityResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetAppAvailability(ctx, "10", &GetAppAvailabilityQuery{})
+	})
+}
+
+func TestListTerritoryAvailabilitiesForAppAvailability(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &TerritoryAvailabilitiesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.ListTerritoryAvailabilitiesForAppAvailability(ctx, "10", &ListTerritoryAvailabilitiesQuery{})
+	})
+}
+
+func TestCreateAppAvailability(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppAvailabilityResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.CreateAppAvailability(ctx, "10", true, []NewTerritoryAvailability{
+			{Available: true, Territory: TerritoryCodeUSA},
+		})
+	})
+}
+
+func TestUpdateTerritoryAvailability(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &TerritoryAvailabilityResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.UpdateTerritoryAvailability(ctx, "10", &TerritoryAvailabilityUpdateRequestAttributes{
+			Available: Bool(false),
+		})
+	})
+}
+
+func TestAvailabilityPlanValidate(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, AvailabilityPlan{Add: []TerritoryCode{TerritoryCodeUSA}, Remove: []TerritoryCode{TerritoryCodeCAN}}.Validate())
+
+	err := AvailabilityPlan{Add: []TerritoryCode{"USA", "XYZ"}, Remove: []TerritoryCode{"USA"}}.Validate()
+	assert.Equal(t, ErrInvalidAvailabilityPlan{Problems: []string{
+		"XYZ is not a known territory",
+		"USA is both added and removed",
+	}}, err)
+}
+
+func TestScheduleAvailabilityUpdatesTerritories(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /apps/1/appAvailabilityV2": `{"data":{"id":"2","type":"appAvailabilities"}}`,
+		"GET /v2/appAvailabilities/2/territoryAvailabilities": `{"data":[
+			{"id":"ta-usa","type":"territoryAvailabilities","relationships":{"territory":{"data":{"id":"USA","type":"territories"}}}},
+			{"id":"ta-can","type":"territoryAvailabilities","relationships":{"territory":{"data":{"id":"CAN","type":"territories"}}}}
+		]}`,
+		"PATCH /territoryAvailabilities/ta-usa": `{"data":{"id":"ta-usa","type":"territoryAvailabilities"}}`,
+		"PATCH /territoryAvailabilities/ta-can": `{"data":{"id":"ta-can","type":"territoryAvailabilities"}}`,
+	})
+	defer server.Close()
+
+	updated, _, err := client.Pricing.ScheduleAvailability(context.Background(), "1", AvailabilityPlan{
+		Add:         []TerritoryCode{TerritoryCodeUSA},
+		ReleaseDate: &Date{time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+		Remove:      []TerritoryCode{TerritoryCodeCAN},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, updated, 2)
+	assert.Equal(t, []string{
+		"GET /apps/1/appAvailabilityV2",
+		"GET /v2/appAvailabilities/2/territoryAvailabilities",
+		"PATCH /territoryAvailabilities/ta-usa",
+		"PATCH /territoryAvailabilities/ta-can",
+	}, *requests)
+}
+
+func TestScheduleAvailabilityMissingTerritory(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /apps/1/appAvailabilityV2":                       `{"data":{"id":"2","type":"appAvailabilities"}}`,
+		"GET /v2/appAvailabilities/2/territoryAvailabilities": `{"data":[]}`,
+	})
+	defer server.Close()
+
+	_, _, err := client.Pricing.ScheduleAvailability(context.Background(), "1", AvailabilityPlan{
+		Add: []TerritoryCode{TerritoryCodeUSA},
+	})
+	assert.ErrorIs(t, err, ErrTerritoryAvailabilityNotFound)
+}
+
+func TestScheduleAvailabilityCreatesAvailability(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"POST /v2/appAvailabilities": `{"data":{"id":"2","type":"appAvailabilities"},"included":[
+			{"id":"ta-usa","type":"territoryAvailabilities","attributes":{"available":true}}
+		]}`,
+	})
+	defer server.Close()
+
+	created, _, err := client.Pricing.ScheduleAvailability(context.Background(), "1", AvailabilityPlan{
+		Add: []TerritoryCode{TerritoryCodeUSA},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, created, 1)
+	assert.Equal(t, []string{
+		"GET /apps/1/appAvailabilityV2",
+		"POST /v2/appAvailabilities",
+	}, *requests)
+}
+
+func TestScheduleAvailabilityInvalidPlan(t *testing.T) {
+	t.Parallel()
+
+	client := NewClient(nil)
+
+	_, _, err := client.Pricing.ScheduleAvailability(context.Background(), "1", AvailabilityPlan{
+		Add: []TerritoryCode{"XYZ"},
+	})
+	assert.IsType(t, ErrInvalidAvailabilityPlan{}, err)
+}
@@ -0,0 +1,144 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+)
+
+// ErrPricePointNotFound happens when no app or subscription price point in a territory matches the requested customer price.
+type ErrPricePointNotFound struct {
+	Territory     string
+	CustomerPrice string
+}
+
+func (e ErrPricePointNotFound) Error() string {
+	return fmt.Sprintf("no price point with customer price %s found in territory %s", e.CustomerPrice, e.Territory)
+}
+
+// ListPricePointsForAppQuery are query options for ListPricePointsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_apppricepoints
+type ListPricePointsForAppQuery struct {
+	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
+	FieldsTerritories    []string `url:"fields[territories],omitempty"`
+	FilterTerritory      []string `url:"filter[territory],omitempty"`
+	Include              []string `url:"include,omitempty"`
+	Limit                int      `url:"limit,omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+}
+
+// GetAppPricePointV3Query are query options for GetAppPricePointV3
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v3_apppricepoints_id
+type GetAppPricePointV3Query struct {
+	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
+	FieldsTerritories    []string `url:"fields[territories],omitempty"`
+	Include              []string `url:"include,omitempty"`
+}
+
+// ListEqualizationsForAppPricePointQuery are query options for ListEqualizationsForAppPricePoint
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v3_apppricepoints_id_equalizations
+type ListEqualizationsForAppPricePointQuery struct {
+	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
+	FieldsApps           []string `url:"fields[apps],omitempty"`
+	FieldsTerritories    []string `url:"fields[territories],omitempty"`
+	FilterTerritory      []string `url:"filter[territory],omitempty"`
+	Include              []string `url:"include,omitempty"`
+	Limit                int      `url:"limit,omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+}
+
+// ListPricePointsForApp lists the price points available to an app, including customer price and proceeds, in each territory.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_apppricepoints
+func (s *PricingService) ListPricePointsForApp(ctx context.Context, id string, params *ListPricePointsForAppQuery) (*AppPricePointsResponse, *Response, error) {
+	return Get[AppPricePointsResponse](ctx, s.client, fmt.Sprintf("apps/%s/appPricePoints", id), params)
+}
+
+// GetAppPricePointV3 reads the customer price and proceeds of an app price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v3_apppricepoints_id
+func (s *PricingService) GetAppPricePointV3(ctx context.Context, id string, params *GetAppPricePointV3Query) (*AppPricePointResponse, *Response, error) {
+	return Get[AppPricePointResponse](ctx, s.client, fmt.Sprintf("../v3/appPricePoints/%s", id), params)
+}
+
+// ListEqualizationsForAppPricePoint lists the price points in every other territory that are equivalent to the given app price point.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v3_apppricepoints_id_equalizations
+func (s *PricingService) ListEqualizationsForAppPricePoint(ctx context.Context, id string, params *ListEqualizationsForAppPricePointQuery) (*AppPricePointsResponse, *Response, error) {
+	return Get[AppPricePointsResponse](ctx, s.client, fmt.Sprintf("../v3/appPricePoints/%s/equalizations", id), params)
+}
+
+// FindPricePoint pages through the price points of an app in a territory and returns the one whose
+// customer price matches customerPrice, such as "0.99". If no price point matches, an
+// ErrPricePointNotFound is returned.
+func (s *PricingService) FindPricePoint(ctx context.Context, appID string, territory string, customerPrice string) (*AppPricePoint, error) {
+	want, err := strconv.ParseFloat(customerPrice, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &ListPricePointsForAppQuery{
+		FieldsAppPricePoints: []string{"customerPrice", "proceeds", "territory"},
+		FilterTerritory:      []string{territory},
+		Limit:                MaxPageLimit,
+	}
+
+	var found *AppPricePoint
+
+	err = ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		points, _, err := s.ListPricePointsForApp(ctx, appID, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, point := range points.Data {
+			if point.Attributes == nil || point.Attributes.CustomerPrice == nil {
+				continue
+			}
+
+			got, err := strconv.ParseFloat(*point.Attributes.CustomerPrice, 64)
+			if err == nil && got == want {
+				point := point
+				found = &point
+
+				return nil, nil
+			}
+		}
+
+		return &points.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, ErrPricePointNotFound{Territory: territory, CustomerPrice: customerPrice}
+	}
+
+	return found, nil
+}
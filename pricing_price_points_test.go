@@ -0,0 +1,85 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListPricePointsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPricePointsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.ListPricePointsForApp(ctx, "10", &ListPricePointsForAppQuery{})
+	})
+}
+
+func TestGetAppPricePointV3(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPricePointResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetAppPricePointV3(ctx, "10", &GetAppPricePointV3Query{})
+	})
+}
+
+func TestListEqualizationsForAppPricePoint(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPricePointsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.ListEqualizationsForAppPricePoint(ctx, "10", &ListEqualizationsForAppPricePointQuery{})
+	})
+}
+
+func TestFindPricePoint(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"data":[
+		{"type":"appPricePoints","id":"1","attributes":{"customerPrice":"0.0"}},
+		{"type":"appPricePoints","id":"2"},
+		{"type":"appPricePoints","id":"3","attributes":{"customerPrice":"0.99"}}
+		]}`, func(ctx context.Context, client *Client) {
+		point, err := client.Pricing.FindPricePoint(ctx, "10", "USA", "0.990")
+		assert.NoError(t, err)
+		assert.Equal(t, "3", point.ID)
+
+		point, err = client.Pricing.FindPricePoint(ctx, "10", "USA", "1.99")
+		assert.Equal(t, ErrPricePointNotFound{Territory: "USA", CustomerPrice: "1.99"}, err)
+		assert.NotEmpty(t, err.Error())
+		assert.Nil(t, point)
+
+		point, err = client.Pricing.FindPricePoint(ctx, "10", "USA", "free")
+		assert.Error(t, err)
+		assert.Nil(t, point)
+	})
+}
+
+func TestFindPricePointError(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"data":`, func(ctx context.Context, client *Client) {
+		point, err := client.Pricing.FindPricePoint(ctx, "10", "USA", "0.99")
+		assert.Error(t, err)
+		assert.Nil(t, point)
+	})
+}
@@ -0,0 +1,241 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// AppPriceSchedule defines model for AppPriceSchedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppriceschedule
+type AppPriceSchedule struct {
+	ID            string                         `json:"id"`
+	Links         ResourceLinks                  `json:"links"`
+	Relationships *AppPriceScheduleRelationships `json:"relationships,omitempty"`
+	Type          string                         `json:"type"`
+}
+
+// AppPriceScheduleRelationships defines model for AppPriceSchedule.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppriceschedule/relationships
+type AppPriceScheduleRelationships struct {
+	App             *Relationship      `json:"app,omitempty"`
+	AutomaticPrices *PagedRelationship `json:"automaticPrices,omitempty"`
+	BaseTerritory   *Relationship      `json:"baseTerritory,omitempty"`
+	ManualPrices    *PagedRelationship `json:"manualPrices,omitempty"`
+}
+
+// AppPriceScheduleResponse defines model for AppPriceScheduleResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricescheduleresponse
+type AppPriceScheduleResponse struct {
+	Data     AppPriceSchedule                   `json:"data"`
+	Included []AppPriceScheduleResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                      `json:"links"`
+}
+
+// AppPriceScheduleResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AppPriceScheduleResponse.
+type AppPriceScheduleResponseIncluded included
+
+// appPriceScheduleCreateRequest defines model for AppPriceScheduleCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppriceschedulecreaterequest/data
+type appPriceScheduleCreateRequest struct {
+	Relationships appPriceScheduleCreateRequestRelationships `json:"relationships"`
+	Type          string                                     `json:"type"`
+}
+
+// appPriceScheduleCreateRequestRelationships are relationships for AppPriceScheduleCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/apppriceschedulecreaterequest/data/relationships
+type appPriceScheduleCreateRequestRelationships struct {
+	App           relationshipDeclaration      `json:"app"`
+	BaseTerritory relationshipDeclaration      `json:"baseTerritory"`
+	ManualPrices  pagedRelationshipDeclaration `json:"manualPrices"`
+}
+
+// NewManualAppPrice models the parameters for a manual price in a new app price schedule.
+//
+// Set StartDate to nil for the price to take effect immediately, and EndDate to nil
+// for the price to remain in effect indefinitely. Use the AppPricePoint methods on
+// *PricingService to populate the AppPricePointID value.
+type NewManualAppPrice struct {
+	AppPricePointID string
+	EndDate         *Date
+	StartDate       *Date
+}
+
+type appPriceInlineCreate struct {
+	Attributes    appPriceInlineCreateAttributes    `json:"attributes"`
+	ID            string                            `json:"id"`
+	Relationships appPriceInlineCreateRelationships `json:"relationships"`
+	Type          string                            `json:"type"`
+}
+
+type appPriceInlineCreateAttributes struct {
+	EndDate   *Date `json:"endDate"`
+	StartDate *Date `json:"startDate"`
+}
+
+type appPriceInlineCreateRelationships struct {
+	AppPricePoint relationshipDeclaration `json:"appPricePoint"`
+}
+
+func (p NewManualAppPrice) inlineCreate(index int) appPriceInlineCreate {
+	return appPriceInlineCreate{
+		Attributes: appPriceInlineCreateAttributes{
+			EndDate:   p.EndDate,
+			StartDate: p.StartDate,
+		},
+		ID: fmt.Sprintf("${new-manual-price-%d}", index),
+		Relationships: appPriceInlineCreateRelationships{
+			AppPricePoint: *newRelationshipDeclaration(&p.AppPricePointID, "appPricePoints"),
+		},
+		Type: "appPrices",
+	}
+}
+
+// GetAppPriceScheduleQuery are query options for GetAppPriceSchedule and GetPriceScheduleForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apppriceschedules_id
+type GetAppPriceScheduleQuery struct {
+	FieldsAppPrices         []string `url:"fields[appPrices],omitempty"`
+	FieldsAppPriceSchedules []string `url:"fields[appPriceSchedules],omitempty"`
+	FieldsTerritories       []string `url:"fields[territories],omitempty"`
+	Include                 []string `url:"include,omitempty"`
+	LimitAutomaticPrices    int      `url:"limit[automaticPrices],omitempty"`
+	LimitManualPrices       int      `url:"limit[manualPrices],omitempty"`
+}
+
+// GetBaseTerritoryForAppPriceScheduleQuery are query options for GetBaseTerritoryForAppPriceSchedule
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apppriceschedules_id_baseterritory
+type GetBaseTerritoryForAppPriceScheduleQuery struct {
+	FieldsTerritories []string `url:"fields[territories],omitempty"`
+}
+
+// ListPricesForAppPriceScheduleQuery are query options for ListManualPricesForAppPriceSchedule
+// and ListAutomaticPricesForAppPriceSchedule
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apppriceschedules_id_manualprices
+type ListPricesForAppPriceScheduleQuery struct {
+	FieldsAppPrices      []string `url:"fields[appPrices],omitempty"`
+	FieldsAppPricePoints []string `url:"fields[appPricePoints],omitempty"`
+	FieldsTerritories    []string `url:"fields[territories],omitempty"`
+	FilterEndDate        []string `url:"filter[endDate],omitempty"`
+	FilterStartDate      []string `url:"filter[startDate],omitempty"`
+	FilterTerritory      []string `url:"filter[territory],omitempty"`
+	Include              []string `url:"include,omitempty"`
+	Limit                int      `url:"limit,omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+}
+
+// GetPriceScheduleForApp reads the price schedule of an app, including its base territory and manual and automatic prices.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_apppriceschedule
+func (s *PricingService) GetPriceScheduleForApp(ctx context.Context, id string, params *GetAppPriceScheduleQuery) (*AppPriceScheduleResponse, *Response, error) {
+	return Get[AppPriceScheduleResponse](ctx, s.client, fmt.Sprintf("apps/%s/appPriceSchedule", id), params)
+}
+
+// GetAppPriceSchedule reads an app price schedule by its ID.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apppriceschedules_id
+func (s *PricingService) GetAppPriceSchedule(ctx context.Context, id string, params *GetAppPriceScheduleQuery) (*AppPriceScheduleResponse, *Response, error) {
+	return Get[AppPriceScheduleResponse](ctx, s.client, fmt.Sprintf("appPriceSchedules/%s", id), params)
+}
+
+// GetBaseTerritoryForAppPriceSchedule reads the territory that automatic prices in an app price schedule are equalized from.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apppriceschedules_id_baseterritory
+func (s *PricingService) GetBaseTerritoryForAppPriceSchedule(ctx context.Context, id string, params *GetBaseTerritoryForAppPriceScheduleQuery) (*TerritoryResponse, *Response, error) {
+	return Get[TerritoryResponse](ctx, s.client, fmt.Sprintf("appPriceSchedules/%s/baseTerritory", id), params)
+}
+
+// ListManualPricesForAppPriceSchedule lists the prices that were set explicitly in an app price schedule, including future-dated changes.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apppriceschedules_id_manualprices
+func (s *PricingService) ListManualPricesForAppPriceSchedule(ctx context.Context, id string, params *ListPricesForAppPriceScheduleQuery) (*AppPricesResponse, *Response, error) {
+	return Get[AppPricesResponse](ctx, s.client, fmt.Sprintf("appPriceSchedules/%s/manualPrices", id), params)
+}
+
+// ListAutomaticPricesForAppPriceSchedule lists the prices that App Store Connect equalized from the base territory of an app price schedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apppriceschedules_id_automaticprices
+func (s *PricingService) ListAutomaticPricesForAppPriceSchedule(ctx context.Context, id string, params *ListPricesForAppPriceScheduleQuery) (*AppPricesResponse, *Response, error) {
+	return Get[AppPricesResponse](ctx, s.client, fmt.Sprintf("appPriceSchedules/%s/automaticPrices", id), params)
+}
+
+// CreateAppPriceSchedule replaces the price schedule of an app. Prices in territories other than the base territory
+// that are not set manually are equalized automatically from the base territory price.
+//
+// Note: the new schedule replaces every existing manual price, including scheduled future changes.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_apppriceschedules
+func (s *PricingService) CreateAppPriceSchedule(ctx context.Context, appID string, baseTerritoryID string, manualPrices []NewManualAppPrice) (*AppPriceScheduleResponse, *Response, error) {
+	newPrices := make([]appPriceInlineCreate, len(manualPrices))
+	priceIDs := make([]string, len(manualPrices))
+
+	for i, price := range manualPrices {
+		price := price.inlineCreate(i)
+		newPrices[i] = price
+		priceIDs[i] = price.ID
+	}
+
+	req := appPriceScheduleCreateRequest{
+		Relationships: appPriceScheduleCreateRequestRelationships{
+			App:           *newRelationshipDeclaration(&appID, "apps"),
+			BaseTerritory: *newRelationshipDeclaration(&baseTerritoryID, "territories"),
+			ManualPrices:  newPagedRelationshipDeclaration(priceIDs, "appPrices"),
+		},
+		Type: "appPriceSchedules",
+	}
+	res := new(AppPriceScheduleResponse)
+	resp, err := s.client.post(ctx, "appPriceSchedules", newRequestBodyWithIncluded(req, newPrices), res)
+
+	return res, resp, err
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AppPriceScheduleResponseIncluded.
+func (i *AppPriceScheduleResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *AppPriceScheduleResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// AppPrice returns the AppPrice stored within, if one is present.
+func (i *AppPriceScheduleResponseIncluded) AppPrice() *AppPrice {
+	return extractIncludedAppPrice(i.inner)
+}
+
+// Territory returns the Territory stored within, if one is present.
+func (i *AppPriceScheduleResponseIncluded) Territory() *Territory {
+	return extractIncludedTerritory(i.inner)
+}
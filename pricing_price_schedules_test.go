@@ -0,0 +1,111 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPriceScheduleForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPriceScheduleResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetPriceScheduleForApp(ctx, "10", &GetAppPriceScheduleQuery{})
+	})
+}
+
+func TestGetAppPriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPriceScheduleResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetAppPriceSchedule(ctx, "10", &GetAppPriceScheduleQuery{})
+	})
+}
+
+func TestGetAppPriceScheduleIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"appPrices"},{"type":"territories"}]}`, func(ctx context.Context, client *Client) {
+		schedule, _, err := client.Pricing.GetAppPriceSchedule(ctx, "10", &GetAppPriceScheduleQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, schedule.Included)
+
+		assert.NotNil(t, schedule.Included[0].App())
+		assert.NotNil(t, schedule.Included[1].AppPrice())
+		assert.NotNil(t, schedule.Included[2].Territory())
+
+		assert.Nil(t, schedule.Included[0].AppPrice())
+		assert.Nil(t, schedule.Included[0].Territory())
+	})
+}
+
+func TestGetBaseTerritoryForAppPriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &TerritoryResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.GetBaseTerritoryForAppPriceSchedule(ctx, "10", &GetBaseTerritoryForAppPriceScheduleQuery{})
+	})
+}
+
+func TestListManualPricesForAppPriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.ListManualPricesForAppPriceSchedule(ctx, "10", &ListPricesForAppPriceScheduleQuery{})
+	})
+}
+
+func TestListAutomaticPricesForAppPriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPricesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.ListAutomaticPricesForAppPriceSchedule(ctx, "10", &ListPricesForAppPriceScheduleQuery{})
+	})
+}
+
+func TestListPricesForAppPriceScheduleIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"appPricePoints"},{"type":"territories"}]}`, func(ctx context.Context, client *Client) {
+		prices, _, err := client.Pricing.ListManualPricesForAppPriceSchedule(ctx, "10", &ListPricesForAppPriceScheduleQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, prices.Included)
+
+		assert.NotNil(t, prices.Included[0].AppPricePoint())
+		assert.NotNil(t, prices.Included[1].Territory())
+
+		assert.Nil(t, prices.Included[0].Territory())
+	})
+}
+
+func TestCreateAppPriceSchedule(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppPriceScheduleResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Pricing.CreateAppPriceSchedule(ctx, "10", "USA", []NewManualAppPrice{
+			{AppPricePointID: "10"},
+			{AppPricePointID: "11", StartDate: &Date{}},
+		})
+	})
+}
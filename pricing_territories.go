@@ -75,41 +75,26 @@ type ListTerritoriesQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_territories
 func (s *PricingService) ListTerritories(ctx context.Context, params *ListTerritoriesQuery) (*TerritoriesResponse, *Response, error) {
-	res := new(TerritoriesResponse)
-	resp, err := s.client.get(ctx, "territories", params, res)
-
-	return res, resp, err
+	return Get[TerritoriesResponse](ctx, s.client, "territories", params)
 }
 
 // ListTerritoriesForApp gets a list of App Store territories where an app is or will be available.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_available_territories_for_an_app
 func (s *PricingService) ListTerritoriesForApp(ctx context.Context, id string, params *ListTerritoriesQuery) (*TerritoriesResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/availableTerritories", id)
-	res := new(TerritoriesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[TerritoriesResponse](ctx, s.client, fmt.Sprintf("apps/%s/availableTerritories", id), params)
 }
 
 // ListTerritoriesForEULA lists all the App Store territories to which a specific custom app license agreement applies.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_territories_for_an_end_user_license_agreement
 func (s *PricingService) ListTerritoriesForEULA(ctx context.Context, id string, params *ListTerritoriesQuery) (*TerritoriesResponse, *Response, error) {
-	url := fmt.Sprintf("endUserLicenseAgreements/%s/territories", id)
-	res := new(TerritoriesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[TerritoriesResponse](ctx, s.client, fmt.Sprintf("endUserLicenseAgreements/%s/territories", id), params)
 }
 
 // GetTerritoryForAppPrice gets the territory in which a specific price point applies.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_territory_information_of_an_app_price_point
 func (s *PricingService) GetTerritoryForAppPrice(ctx context.Context, id string, params *ListTerritoriesQuery) (*TerritoryResponse, *Response, error) {
-	url := fmt.Sprintf("appPricePoints/%s/territory", id)
-	res := new(TerritoryResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[TerritoryResponse](ctx, s.client, fmt.Sprintf("appPricePoints/%s/territory", id), params)
 }
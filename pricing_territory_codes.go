@@ -0,0 +1,415 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import "sort"
+
+// TerritoryCode is the ID of a territory where the App Store operates, in ISO 3166-1 alpha-3 form.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/territorycode
+type TerritoryCode string
+
+// Territories where the App Store operates.
+const (
+	TerritoryCodeAFG TerritoryCode = "AFG" // Afghanistan
+	TerritoryCodeAGO TerritoryCode = "AGO" // Angola
+	TerritoryCodeAIA TerritoryCode = "AIA" // Anguilla
+	TerritoryCodeALB TerritoryCode = "ALB" // Albania
+	TerritoryCodeARE TerritoryCode = "ARE" // United Arab Emirates
+	TerritoryCodeARG TerritoryCode = "ARG" // Argentina
+	TerritoryCodeARM TerritoryCode = "ARM" // Armenia
+	TerritoryCodeATG TerritoryCode = "ATG" // Antigua and Barbuda
+	TerritoryCodeAUS TerritoryCode = "AUS" // Australia
+	TerritoryCodeAUT TerritoryCode = "AUT" // Austria
+	TerritoryCodeAZE TerritoryCode = "AZE" // Azerbaijan
+	TerritoryCodeBEL TerritoryCode = "BEL" // Belgium
+	TerritoryCodeBEN TerritoryCode = "BEN" // Benin
+	TerritoryCodeBFA TerritoryCode = "BFA" // Burkina Faso
+	TerritoryCodeBGR TerritoryCode = "BGR" // Bulgaria
+	TerritoryCodeBHR TerritoryCode = "BHR" // Bahrain
+	TerritoryCodeBHS TerritoryCode = "BHS" // Bahamas
+	TerritoryCodeBIH TerritoryCode = "BIH" // Bosnia and Herzegovina
+	TerritoryCodeBLR TerritoryCode = "BLR" // Belarus
+	TerritoryCodeBLZ TerritoryCode = "BLZ" // Belize
+	TerritoryCodeBMU TerritoryCode = "BMU" // Bermuda
+	TerritoryCodeBOL TerritoryCode = "BOL" // Bolivia
+	TerritoryCodeBRA TerritoryCode = "BRA" // Brazil
+	TerritoryCodeBRB TerritoryCode = "BRB" // Barbados
+	TerritoryCodeBRN TerritoryCode = "BRN" // Brunei
+	TerritoryCodeBTN TerritoryCode = "BTN" // Bhutan
+	TerritoryCodeBWA TerritoryCode = "BWA" // Botswana
+	TerritoryCodeCAN TerritoryCode = "CAN" // Canada
+	TerritoryCodeCHE TerritoryCode = "CHE" // Switzerland
+	TerritoryCodeCHL TerritoryCode = "CHL" // Chile
+	TerritoryCodeCHN TerritoryCode = "CHN" // China mainland
+	TerritoryCodeCIV TerritoryCode = "CIV" // Cote d'Ivoire
+	TerritoryCodeCMR TerritoryCode = "CMR" // Cameroon
+	TerritoryCodeCOD TerritoryCode = "COD" // Congo, Democratic Republic of the
+	TerritoryCodeCOG TerritoryCode = "COG" // Congo, Republic of the
+	TerritoryCodeCOL TerritoryCode = "COL" // Colombia
+	TerritoryCodeCPV TerritoryCode = "CPV" // Cape Verde
+	TerritoryCodeCRI TerritoryCode = "CRI" // Costa Rica
+	TerritoryCodeCYM TerritoryCode = "CYM" // Cayman Islands
+	TerritoryCodeCYP TerritoryCode = "CYP" // Cyprus
+	TerritoryCodeCZE TerritoryCode = "CZE" // Czech Republic
+	TerritoryCodeDEU TerritoryCode = "DEU" // Germany
+	TerritoryCodeDMA TerritoryCode = "DMA" // Dominica
+	TerritoryCodeDNK TerritoryCode = "DNK" // Denmark
+	TerritoryCodeDOM TerritoryCode = "DOM" // Dominican Republic
+	TerritoryCodeDZA TerritoryCode = "DZA" // Algeria
+	TerritoryCodeECU TerritoryCode = "ECU" // Ecuador
+	TerritoryCodeEGY TerritoryCode = "EGY" // Egypt
+	TerritoryCodeESP TerritoryCode = "ESP" // Spain
+	TerritoryCodeEST TerritoryCode = "EST" // Estonia
+	TerritoryCodeFIN TerritoryCode = "FIN" // Finland
+	TerritoryCodeFJI TerritoryCode = "FJI" // Fiji
+	TerritoryCodeFRA TerritoryCode = "FRA" // France
+	TerritoryCodeFSM TerritoryCode = "FSM" // Micronesia
+	TerritoryCodeGAB TerritoryCode = "GAB" // Gabon
+	TerritoryCodeGBR TerritoryCode = "GBR" // United Kingdom
+	TerritoryCodeGEO TerritoryCode = "GEO" // Georgia
+	TerritoryCodeGHA TerritoryCode = "GHA" // Ghana
+	TerritoryCodeGMB TerritoryCode = "GMB" // Gambia
+	TerritoryCodeGNB TerritoryCode = "GNB" // Guinea-Bissau
+	TerritoryCodeGRC TerritoryCode = "GRC" // Greece
+	TerritoryCodeGRD TerritoryCode = "GRD" // Grenada
+	TerritoryCodeGTM TerritoryCode = "GTM" // Guatemala
+	TerritoryCodeGUY TerritoryCode = "GUY" // Guyana
+	TerritoryCodeHKG TerritoryCode = "HKG" // Hong Kong
+	TerritoryCodeHND TerritoryCode = "HND" // Honduras
+	TerritoryCodeHRV TerritoryCode = "HRV" // Croatia
+	TerritoryCodeHUN TerritoryCode = "HUN" // Hungary
+	TerritoryCodeIDN TerritoryCode = "IDN" // Indonesia
+	TerritoryCodeIND TerritoryCode = "IND" // India
+	TerritoryCodeIRL TerritoryCode = "IRL" // Ireland
+	TerritoryCodeIRQ TerritoryCode = "IRQ" // Iraq
+	TerritoryCodeISL TerritoryCode = "ISL" // Iceland
+	TerritoryCodeISR TerritoryCode = "ISR" // Israel
+	TerritoryCodeITA TerritoryCode = "ITA" // Italy
+	TerritoryCodeJAM TerritoryCode = "JAM" // Jamaica
+	TerritoryCodeJOR TerritoryCode = "JOR" // Jordan
+	TerritoryCodeJPN TerritoryCode = "JPN" // Japan
+	TerritoryCodeKAZ TerritoryCode = "KAZ" // Kazakhstan
+	TerritoryCodeKEN TerritoryCode = "KEN" // Kenya
+	TerritoryCodeKGZ TerritoryCode = "KGZ" // Kyrgyzstan
+	TerritoryCodeKHM TerritoryCode = "KHM" // Cambodia
+	TerritoryCodeKNA TerritoryCode = "KNA" // St. Kitts and Nevis
+	TerritoryCodeKOR TerritoryCode = "KOR" // Korea, Republic of
+	TerritoryCodeKWT TerritoryCode = "KWT" // Kuwait
+	TerritoryCodeLAO TerritoryCode = "LAO" // Laos
+	TerritoryCodeLBN TerritoryCode = "LBN" // Lebanon
+	TerritoryCodeLBR TerritoryCode = "LBR" // Liberia
+	TerritoryCodeLBY TerritoryCode = "LBY" // Libya
+	TerritoryCodeLCA TerritoryCode = "LCA" // St. Lucia
+	TerritoryCodeLKA TerritoryCode = "LKA" // Sri Lanka
+	TerritoryCodeLTU TerritoryCode = "LTU" // Lithuania
+	TerritoryCodeLUX TerritoryCode = "LUX" // Luxembourg
+	TerritoryCodeLVA TerritoryCode = "LVA" // Latvia
+	TerritoryCodeMAC TerritoryCode = "MAC" // Macau
+	TerritoryCodeMAR TerritoryCode = "MAR" // Morocco
+	TerritoryCodeMDA TerritoryCode = "MDA" // Moldova
+	TerritoryCodeMDG TerritoryCode = "MDG" // Madagascar
+	TerritoryCodeMDV TerritoryCode = "MDV" // Maldives
+	TerritoryCodeMEX TerritoryCode = "MEX" // Mexico
+	TerritoryCodeMKD TerritoryCode = "MKD" // North Macedonia
+	TerritoryCodeMLI TerritoryCode = "MLI" // Mali
+	TerritoryCodeMLT TerritoryCode = "MLT" // Malta
+	TerritoryCodeMMR TerritoryCode = "MMR" // Myanmar
+	TerritoryCodeMNE TerritoryCode = "MNE" // Montenegro
+	TerritoryCodeMNG TerritoryCode = "MNG" // Mongolia
+	TerritoryCodeMOZ TerritoryCode = "MOZ" // Mozambique
+	TerritoryCodeMRT TerritoryCode = "MRT" // Mauritania
+	TerritoryCodeMSR TerritoryCode = "MSR" // Montserrat
+	TerritoryCodeMUS TerritoryCode = "MUS" // Mauritius
+	TerritoryCodeMWI TerritoryCode = "MWI" // Malawi
+	TerritoryCodeMYS TerritoryCode = "MYS" // Malaysia
+	TerritoryCodeNAM TerritoryCode = "NAM" // Namibia
+	TerritoryCodeNER TerritoryCode = "NER" // Niger
+	TerritoryCodeNGA TerritoryCode = "NGA" // Nigeria
+	TerritoryCodeNIC TerritoryCode = "NIC" // Nicaragua
+	TerritoryCodeNLD TerritoryCode = "NLD" // Netherlands
+	TerritoryCodeNOR TerritoryCode = "NOR" // Norway
+	TerritoryCodeNPL TerritoryCode = "NPL" // Nepal
+	TerritoryCodeNRU TerritoryCode = "NRU" // Nauru
+	TerritoryCodeNZL TerritoryCode = "NZL" // New Zealand
+	TerritoryCodeOMN TerritoryCode = "OMN" // Oman
+	TerritoryCodePAK TerritoryCode = "PAK" // Pakistan
+	TerritoryCodePAN TerritoryCode = "PAN" // Panama
+	TerritoryCodePER TerritoryCode = "PER" // Peru
+	TerritoryCodePHL TerritoryCode = "PHL" // Philippines
+	TerritoryCodePLW TerritoryCode = "PLW" // Palau
+	TerritoryCodePNG TerritoryCode = "PNG" // Papua New Guinea
+	TerritoryCodePOL TerritoryCode = "POL" // Poland
+	TerritoryCodePRT TerritoryCode = "PRT" // Portugal
+	TerritoryCodePRY TerritoryCode = "PRY" // Paraguay
+	TerritoryCodeQAT TerritoryCode = "QAT" // Qatar
+	TerritoryCodeROU TerritoryCode = "ROU" // Romania
+	TerritoryCodeRUS TerritoryCode = "RUS" // Russia
+	TerritoryCodeRWA TerritoryCode = "RWA" // Rwanda
+	TerritoryCodeSAU TerritoryCode = "SAU" // Saudi Arabia
+	TerritoryCodeSEN TerritoryCode = "SEN" // Senegal
+	TerritoryCodeSGP TerritoryCode = "SGP" // Singapore
+	TerritoryCodeSLB TerritoryCode = "SLB" // Solomon Islands
+	TerritoryCodeSLE TerritoryCode = "SLE" // Sierra Leone
+	TerritoryCodeSLV TerritoryCode = "SLV" // El Salvador
+	TerritoryCodeSRB TerritoryCode = "SRB" // Serbia
+	TerritoryCodeSTP TerritoryCode = "STP" // Sao Tome and Principe
+	TerritoryCodeSUR TerritoryCode = "SUR" // Suriname
+	TerritoryCodeSVK TerritoryCode = "SVK" // Slovakia
+	TerritoryCodeSVN TerritoryCode = "SVN" // Slovenia
+	TerritoryCodeSWE TerritoryCode = "SWE" // Sweden
+	TerritoryCodeSWZ TerritoryCode = "SWZ" // Eswatini
+	TerritoryCodeSYC TerritoryCode = "SYC" // Seychelles
+	TerritoryCodeTCA TerritoryCode = "TCA" // Turks and Caicos Islands
+	TerritoryCodeTCD TerritoryCode = "TCD" // Chad
+	TerritoryCodeTHA TerritoryCode = "THA" // Thailand
+	TerritoryCodeTJK TerritoryCode = "TJK" // Tajikistan
+	TerritoryCodeTKM TerritoryCode = "TKM" // Turkmenistan
+	TerritoryCodeTON TerritoryCode = "TON" // Tonga
+	TerritoryCodeTTO TerritoryCode = "TTO" // Trinidad and Tobago
+	TerritoryCodeTUN TerritoryCode = "TUN" // Tunisia
+	TerritoryCodeTUR TerritoryCode = "TUR" // Turkey
+	TerritoryCodeTWN TerritoryCode = "TWN" // Taiwan
+	TerritoryCodeTZA TerritoryCode = "TZA" // Tanzania
+	TerritoryCodeUGA TerritoryCode = "UGA" // Uganda
+	TerritoryCodeUKR TerritoryCode = "UKR" // Ukraine
+	TerritoryCodeURY TerritoryCode = "URY" // Uruguay
+	TerritoryCodeUSA TerritoryCode = "USA" // United States
+	TerritoryCodeUZB TerritoryCode = "UZB" // Uzbekistan
+	TerritoryCodeVCT TerritoryCode = "VCT" // St. Vincent and the Grenadines
+	TerritoryCodeVEN TerritoryCode = "VEN" // Venezuela
+	TerritoryCodeVGB TerritoryCode = "VGB" // British Virgin Islands
+	TerritoryCodeVNM TerritoryCode = "VNM" // Vietnam
+	TerritoryCodeVUT TerritoryCode = "VUT" // Vanuatu
+	TerritoryCodeWSM TerritoryCode = "WSM" // Samoa
+	TerritoryCodeXKS TerritoryCode = "XKS" // Kosovo
+	TerritoryCodeYEM TerritoryCode = "YEM" // Yemen
+	TerritoryCodeZAF TerritoryCode = "ZAF" // South Africa
+	TerritoryCodeZMB TerritoryCode = "ZMB" // Zambia
+	TerritoryCodeZWE TerritoryCode = "ZWE" // Zimbabwe
+)
+
+// ParseTerritoryCode returns s as a TerritoryCode, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseTerritoryCode(s string) (TerritoryCode, error) {
+	if v := TerritoryCode(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "TerritoryCode", Value: s}
+}
+
+var territoryCodes = map[TerritoryCode]bool{
+	TerritoryCodeAFG: true,
+	TerritoryCodeAGO: true,
+	TerritoryCodeAIA: true,
+	TerritoryCodeALB: true,
+	TerritoryCodeARE: true,
+	TerritoryCodeARG: true,
+	TerritoryCodeARM: true,
+	TerritoryCodeATG: true,
+	TerritoryCodeAUS: true,
+	TerritoryCodeAUT: true,
+	TerritoryCodeAZE: true,
+	TerritoryCodeBEL: true,
+	TerritoryCodeBEN: true,
+	TerritoryCodeBFA: true,
+	TerritoryCodeBGR: true,
+	TerritoryCodeBHR: true,
+	TerritoryCodeBHS: true,
+	TerritoryCodeBIH: true,
+	TerritoryCodeBLR: true,
+	TerritoryCodeBLZ: true,
+	TerritoryCodeBMU: true,
+	TerritoryCodeBOL: true,
+	TerritoryCodeBRA: true,
+	TerritoryCodeBRB: true,
+	TerritoryCodeBRN: true,
+	TerritoryCodeBTN: true,
+	TerritoryCodeBWA: true,
+	TerritoryCodeCAN: true,
+	TerritoryCodeCHE: true,
+	TerritoryCodeCHL: true,
+	TerritoryCodeCHN: true,
+	TerritoryCodeCIV: true,
+	TerritoryCodeCMR: true,
+	TerritoryCodeCOD: true,
+	TerritoryCodeCOG: true,
+	TerritoryCodeCOL: true,
+	TerritoryCodeCPV: true,
+	TerritoryCodeCRI: true,
+	TerritoryCodeCYM: true,
+	TerritoryCodeCYP: true,
+	TerritoryCodeCZE: true,
+	TerritoryCodeDEU: true,
+	TerritoryCodeDMA: true,
+	TerritoryCodeDNK: true,
+	TerritoryCodeDOM: true,
+	TerritoryCodeDZA: true,
+	TerritoryCodeECU: true,
+	TerritoryCodeEGY: true,
+	TerritoryCodeESP: true,
+	TerritoryCodeEST: true,
+	TerritoryCodeFIN: true,
+	TerritoryCodeFJI: true,
+	TerritoryCodeFRA: true,
+	TerritoryCodeFSM: true,
+	TerritoryCodeGAB: true,
+	TerritoryCodeGBR: true,
+	TerritoryCodeGEO: true,
+	TerritoryCodeGHA: true,
+	TerritoryCodeGMB: true,
+	TerritoryCodeGNB: true,
+	TerritoryCodeGRC: true,
+	TerritoryCodeGRD: true,
+	TerritoryCodeGTM: true,
+	TerritoryCodeGUY: true,
+	TerritoryCodeHKG: true,
+	TerritoryCodeHND: true,
+	TerritoryCodeHRV: true,
+	TerritoryCodeHUN: true,
+	TerritoryCodeIDN: true,
+	TerritoryCodeIND: true,
+	TerritoryCodeIRL: true,
+	TerritoryCodeIRQ: true,
+	TerritoryCodeISL: true,
+	TerritoryCodeISR: true,
+	TerritoryCodeITA: true,
+	TerritoryCodeJAM: true,
+	TerritoryCodeJOR: true,
+	TerritoryCodeJPN: true,
+	TerritoryCodeKAZ: true,
+	TerritoryCodeKEN: true,
+	TerritoryCodeKGZ: true,
+	TerritoryCodeKHM: true,
+	TerritoryCodeKNA: true,
+	TerritoryCodeKOR: true,
+	TerritoryCodeKWT: true,
+	TerritoryCodeLAO: true,
+	TerritoryCodeLBN: true,
+	TerritoryCodeLBR: true,
+	TerritoryCodeLBY: true,
+	TerritoryCodeLCA: true,
+	TerritoryCodeLKA: true,
+	TerritoryCodeLTU: true,
+	TerritoryCodeLUX: true,
+	TerritoryCodeLVA: true,
+	TerritoryCodeMAC: true,
+	TerritoryCodeMAR: true,
+	TerritoryCodeMDA: true,
+	TerritoryCodeMDG: true,
+	TerritoryCodeMDV: true,
+	TerritoryCodeMEX: true,
+	TerritoryCodeMKD: true,
+	TerritoryCodeMLI: true,
+	TerritoryCodeMLT: true,
+	TerritoryCodeMMR: true,
+	TerritoryCodeMNE: true,
+	TerritoryCodeMNG: true,
+	TerritoryCodeMOZ: true,
+	TerritoryCodeMRT: true,
+	TerritoryCodeMSR: true,
+	TerritoryCodeMUS: true,
+	TerritoryCodeMWI: true,
+	TerritoryCodeMYS: true,
+	TerritoryCodeNAM: true,
+	TerritoryCodeNER: true,
+	TerritoryCodeNGA: true,
+	TerritoryCodeNIC: true,
+	TerritoryCodeNLD: true,
+	TerritoryCodeNOR: true,
+	TerritoryCodeNPL: true,
+	TerritoryCodeNRU: true,
+	TerritoryCodeNZL: true,
+	TerritoryCodeOMN: true,
+	TerritoryCodePAK: true,
+	TerritoryCodePAN: true,
+	TerritoryCodePER: true,
+	TerritoryCodePHL: true,
+	TerritoryCodePLW: true,
+	TerritoryCodePNG: true,
+	TerritoryCodePOL: true,
+	TerritoryCodePRT: true,
+	TerritoryCodePRY: true,
+	TerritoryCodeQAT: true,
+	TerritoryCodeROU: true,
+	TerritoryCodeRUS: true,
+	TerritoryCodeRWA: true,
+	TerritoryCodeSAU: true,
+	TerritoryCodeSEN: true,
+	TerritoryCodeSGP: true,
+	TerritoryCodeSLB: true,
+	TerritoryCodeSLE: true,
+	TerritoryCodeSLV: true,
+	TerritoryCodeSRB: true,
+	TerritoryCodeSTP: true,
+	TerritoryCodeSUR: true,
+	TerritoryCodeSVK: true,
+	TerritoryCodeSVN: true,
+	TerritoryCodeSWE: true,
+	TerritoryCodeSWZ: true,
+	TerritoryCodeSYC: true,
+	TerritoryCodeTCA: true,
+	TerritoryCodeTCD: true,
+	TerritoryCodeTHA: true,
+	TerritoryCodeTJK: true,
+	TerritoryCodeTKM: true,
+	TerritoryCodeTON: true,
+	TerritoryCodeTTO: true,
+	TerritoryCodeTUN: true,
+	TerritoryCodeTUR: true,
+	TerritoryCodeTWN: true,
+	TerritoryCodeTZA: true,
+	TerritoryCodeUGA: true,
+	TerritoryCodeUKR: true,
+	TerritoryCodeURY: true,
+	TerritoryCodeUSA: true,
+	TerritoryCodeUZB: true,
+	TerritoryCodeVCT: true,
+	TerritoryCodeVEN: true,
+	TerritoryCodeVGB: true,
+	TerritoryCodeVNM: true,
+	TerritoryCodeVUT: true,
+	TerritoryCodeWSM: true,
+	TerritoryCodeXKS: true,
+	TerritoryCodeYEM: true,
+	TerritoryCodeZAF: true,
+	TerritoryCodeZMB: true,
+	TerritoryCodeZWE: true,
+}
+
+// IsValid reports whether the code is one of the territories where the App Store operates.
+func (c TerritoryCode) IsValid() bool {
+	return territoryCodes[c]
+}
+
+// TerritoryCodes returns every territory where the App Store operates, in alphabetical order of their codes.
+func TerritoryCodes() []TerritoryCode {
+	codes := make([]TerritoryCode, 0, len(territoryCodes))
+	for code := range territoryCodes {
+		codes = append(codes, code)
+	}
+
+	sort.Slice(codes, func(i, j int) bool {
+		return codes[i] < codes[j]
+	})
+
+	return codes
+}
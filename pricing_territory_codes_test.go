@@ -0,0 +1,58 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTerritoryCodeIsValid(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, TerritoryCodeUSA.IsValid())
+	assert.True(t, TerritoryCode("XKS").IsValid())
+	assert.False(t, TerritoryCode("US").IsValid())
+	assert.False(t, TerritoryCode("usa").IsValid())
+}
+
+func TestTerritoryCodes(t *testing.T) {
+	t.Parallel()
+
+	codes := TerritoryCodes()
+	assert.Len(t, codes, 176)
+	assert.True(t, sort.SliceIsSorted(codes, func(i, j int) bool {
+		return codes[i] < codes[j]
+	}))
+}
+
+func TestParseTerritoryCode(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseTerritoryCode(string(TerritoryCodeAFG))
+	assert.NoError(t, err)
+	assert.Equal(t, TerritoryCodeAFG, got)
+
+	_, err = ParseTerritoryCode("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "TerritoryCode", Value: "UNKNOWN"}, err)
+}
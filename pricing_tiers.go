@@ -83,9 +83,12 @@ type AppPricePointAttributes struct {
 // AppPricePointRelationships defines model for AppPricePoint.Relationships
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/apppricepoint/relationships
+// https://developer.apple.com/documentation/appstoreconnectapi/apppricepointv3/relationships
 type AppPricePointRelationships struct {
-	PriceTier *Relationship `json:"priceTier,omitempty"`
-	Territory *Relationship `json:"territory,omitempty"`
+	App           *Relationship      `json:"app,omitempty"`
+	Equalizations *PagedRelationship `json:"equalizations,omitempty"`
+	PriceTier     *Relationship      `json:"priceTier,omitempty"`
+	Territory     *Relationship      `json:"territory,omitempty"`
 }
 
 // AppPricePointResponse defines model for AppPricePointResponse.
@@ -174,62 +177,40 @@ type GetAppPricePointQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_app_price_tiers
 func (s *PricingService) ListAppPriceTiers(ctx context.Context, params *ListAppPriceTiersQuery) (*AppPriceTiersResponse, *Response, error) {
-	res := new(AppPriceTiersResponse)
-	resp, err := s.client.get(ctx, "appPriceTiers", params, res)
-
-	return res, resp, err
+	return Get[AppPriceTiersResponse](ctx, s.client, "appPriceTiers", params)
 }
 
 // GetAppPriceTier reads available app price tiers.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_price_tier_information
 func (s *PricingService) GetAppPriceTier(ctx context.Context, id string, params *GetAppPriceTierQuery) (*AppPriceTierResponse, *Response, error) {
-	url := fmt.Sprintf("appPriceTiers/%s", id)
-	res := new(AppPriceTierResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppPriceTierResponse](ctx, s.client, fmt.Sprintf("appPriceTiers/%s", id), params)
 }
 
 // ListPricePointsForAppPriceTier lists price points across all App Store territories for a specific price tier.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_price_points_for_an_app_price_tier
 func (s *PricingService) ListPricePointsForAppPriceTier(ctx context.Context, id string, params *ListPricePointsForAppPriceTierQuery) (*AppPricePointsResponse, *Response, error) {
-	url := fmt.Sprintf("appPriceTiers/%s/pricePoints", id)
-	res := new(AppPricePointsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppPricePointsResponse](ctx, s.client, fmt.Sprintf("appPriceTiers/%s/pricePoints", id), params)
 }
 
 // ListAppPricePoints lists all app price points available in App Store Connect, including related price tier, developer proceeds, and territory.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_app_price_points
 func (s *PricingService) ListAppPricePoints(ctx context.Context, params *ListAppPricePointsQuery) (*AppPricePointsResponse, *Response, error) {
-	res := new(AppPricePointsResponse)
-	resp, err := s.client.get(ctx, "appPricePoints", params, res)
-
-	return res, resp, err
+	return Get[AppPricePointsResponse](ctx, s.client, "appPricePoints", params)
 }
 
 // GetTerritoryForAppPricePoint gets the territory in which a specific price point applies.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_territory_information_of_an_app_price_point
 func (s *PricingService) GetTerritoryForAppPricePoint(ctx context.Context, id string, params *GetTerritoryForAppPricePointQuery) (*TerritoryResponse, *Response, error) {
-	url := fmt.Sprintf("appPricePoints/%s/territory", id)
-	res := new(TerritoryResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[TerritoryResponse](ctx, s.client, fmt.Sprintf("appPricePoints/%s/territory", id), params)
 }
 
 // GetAppPricePoint reads the customer prices and your proceeds for a price tier.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_price_point_information
 func (s *PricingService) GetAppPricePoint(ctx context.Context, id string, params *GetAppPricePointQuery) (*AppPricePointResponse, *Response, error) {
-	url := fmt.Sprintf("appPricePoints/%s", id)
-	res := new(AppPricePointResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppPricePointResponse](ctx, s.client, fmt.Sprintf("appPricePoints/%s", id), params)
 }
@@ -0,0 +1,138 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package promotionaloffer generates the signatures that apps pass to StoreKit when a customer redeems a
+// subscription promotional offer.
+//
+// Signatures are created with an in-app purchase key downloaded from App Store Connect, and should be
+// generated on a server the app trusts rather than in the app itself.
+//
+// https://developer.apple.com/documentation/storekit/in-app_purchase/original_api_for_in-app_purchase/subscriptions_and_offers/generating_a_signature_for_promotional_offers
+package promotionaloffer
+
+import (
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lingjiawen/asc"
+)
+
+// separator is the invisible separator (U+2063) Apple requires between the fields of the signed payload.
+const separator = "\u2063"
+
+// Signer creates promotional offer signatures with an in-app purchase key.
+type Signer struct {
+	keyID      string
+	privateKey *ecdsa.PrivateKey
+}
+
+// Params are the details of the offer being redeemed that are covered by the signature.
+type Params struct {
+	// AppBundleID is the bundle identifier of the app.
+	AppBundleID string
+	// ProductID is the product identifier of the subscription.
+	ProductID string
+	// OfferID is the offer code of the promotional offer, as set in App Store Connect.
+	OfferID string
+	// ApplicationUsername is the app account token or username the app passes to StoreKit, if any.
+	ApplicationUsername string
+}
+
+// Signature is the set of values an app passes to StoreKit along with the offer identifier.
+type Signature struct {
+	KeyIdentifier string
+	Nonce         string
+	// Timestamp is the time of signing in milliseconds since the Unix epoch.
+	Timestamp int64
+	// Signature is the base64-encoded ES256 signature.
+	Signature string
+}
+
+// NewSigner returns a new Signer for the in-app purchase key with the given key identifier
+// and PEM-encoded private key.
+func NewSigner(keyID string, privateKey []byte) (*Signer, error) {
+	key, err := asc.ParsePrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		keyID:      keyID,
+		privateKey: key,
+	}, nil
+}
+
+// Sign creates a signature for the offer with a new random nonce and the current time.
+func (s *Signer) Sign(p Params) (*Signature, error) {
+	nonce, err := newNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	return s.SignWithNonce(p, nonce, time.Now())
+}
+
+// SignWithNonce creates a signature for the offer with the given nonce and timestamp. The nonce must be
+// a UUID, and should not be reused.
+func (s *Signer) SignWithNonce(p Params, nonce string, timestamp time.Time) (*Signature, error) {
+	millis := timestamp.UnixNano() / int64(time.Millisecond)
+	payload := strings.Join([]string{
+		p.AppBundleID,
+		s.keyID,
+		p.ProductID,
+		p.OfferID,
+		strings.ToLower(p.ApplicationUsername),
+		strings.ToLower(nonce),
+		strconv.FormatInt(millis, 10),
+	}, separator)
+
+	digest := sha256.Sum256([]byte(payload))
+
+	sig, err := ecdsa.SignASN1(rand.Reader, s.privateKey, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signature{
+		KeyIdentifier: s.keyID,
+		Nonce:         nonce,
+		Timestamp:     millis,
+		Signature:     base64.StdEncoding.EncodeToString(sig),
+	}, nil
+}
+
+// newNonce returns a random version 4 UUID in its lowercase string form.
+func newNonce() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16]), nil
+}
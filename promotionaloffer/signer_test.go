@@ -0,0 +1,112 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package promotionaloffer
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestKey(t *testing.T) (*ecdsa.PrivateKey, []byte) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.NoError(t, err)
+
+	return key, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func TestNewSigner(t *testing.T) {
+	t.Parallel()
+
+	_, blob := newTestKey(t)
+
+	signer, err := NewSigner("TEST", blob)
+	assert.NoError(t, err)
+	assert.NotNil(t, signer)
+
+	_, err = NewSigner("TEST", []byte("not a key"))
+	assert.Error(t, err)
+}
+
+func TestSignWithNonce(t *testing.T) {
+	t.Parallel()
+
+	key, blob := newTestKey(t)
+
+	signer, err := NewSigner("KEY123", blob)
+	assert.NoError(t, err)
+
+	params := Params{
+		AppBundleID:         "com.example.app",
+		ProductID:           "com.example.app.monthly",
+		OfferID:             "WINBACK",
+		ApplicationUsername: "9F5E5B48-9C3A-4A5B-8D8A-1C4E2E0B7A11",
+	}
+	timestamp := time.Unix(1600000000, 123000000)
+
+	sig, err := signer.SignWithNonce(params, "A1B2C3D4-0000-4000-8000-000000000000", timestamp)
+	assert.NoError(t, err)
+	assert.Equal(t, "KEY123", sig.KeyIdentifier)
+	assert.Equal(t, "A1B2C3D4-0000-4000-8000-000000000000", sig.Nonce)
+	assert.Equal(t, int64(1600000000123), sig.Timestamp)
+
+	raw, err := base64.StdEncoding.DecodeString(sig.Signature)
+	assert.NoError(t, err)
+
+	payload := "com.example.app\u2063KEY123\u2063com.example.app.monthly\u2063WINBACK\u2063" +
+		"9f5e5b48-9c3a-4a5b-8d8a-1c4e2e0b7a11\u2063a1b2c3d4-0000-4000-8000-000000000000\u20631600000000123"
+	digest := sha256.Sum256([]byte(payload))
+	assert.True(t, ecdsa.VerifyASN1(&key.PublicKey, digest[:], raw))
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	_, blob := newTestKey(t)
+
+	signer, err := NewSigner("KEY123", blob)
+	assert.NoError(t, err)
+
+	first, err := signer.Sign(Params{AppBundleID: "com.example.app", ProductID: "monthly", OfferID: "OFFER"})
+	assert.NoError(t, err)
+
+	second, err := signer.Sign(Params{AppBundleID: "com.example.app", ProductID: "monthly", OfferID: "OFFER"})
+	assert.NoError(t, err)
+
+	uuid := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+	assert.Regexp(t, uuid, first.Nonce)
+	assert.NotEqual(t, first.Nonce, second.Nonce)
+	assert.NotZero(t, first.Timestamp)
+}
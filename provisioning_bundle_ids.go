@@ -37,6 +37,31 @@ const (
 	BundleIDPlatformMacOS BundleIDPlatform = "MAC_OS"
 )
 
+var bundleIDPlatforms = []BundleIDPlatform{
+	BundleIDPlatformiOS,
+	BundleIDPlatformMacOS,
+}
+
+// IsValid reports whether the bundle ID platform is one this package knows about.
+func (v BundleIDPlatform) IsValid() bool {
+	for _, value := range bundleIDPlatforms {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseBundleIDPlatform returns s as a BundleIDPlatform, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseBundleIDPlatform(s string) (BundleIDPlatform, error) {
+	if v := BundleIDPlatform(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "BundleIDPlatform", Value: s}
+}
+
 // BundleID defines model for BundleId.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/bundleid
@@ -232,54 +257,35 @@ func (s *ProvisioningService) DeleteBundleID(ctx context.Context, id string) (*R
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_bundle_ids
 func (s *ProvisioningService) ListBundleIDs(ctx context.Context, params *ListBundleIDsQuery) (*BundleIDsResponse, *Response, error) {
-	res := new(BundleIDsResponse)
-	resp, err := s.client.get(ctx, "bundleIds", params, res)
-
-	return res, resp, err
+	return Get[BundleIDsResponse](ctx, s.client, "bundleIds", params)
 }
 
 // GetBundleID gets information about a specific bundle ID.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_bundle_id_information
 func (s *ProvisioningService) GetBundleID(ctx context.Context, id string, params *GetBundleIDQuery) (*BundleIDResponse, *Response, error) {
-	url := fmt.Sprintf("bundleIds/%s", id)
-	res := new(BundleIDResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BundleIDResponse](ctx, s.client, fmt.Sprintf("bundleIds/%s", id), params)
 }
 
 // GetAppForBundleID gets app information for a specific bundle identifier.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_bundle_id
 func (s *ProvisioningService) GetAppForBundleID(ctx context.Context, id string, params *GetAppForBundleIDQuery) (*AppResponse, *Response, error) {
-	url := fmt.Sprintf("bundleIds/%s/app", id)
-	res := new(AppResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppResponse](ctx, s.client, fmt.Sprintf("bundleIds/%s/app", id), params)
 }
 
 // ListProfilesForBundleID gets a list of all profiles for a specific bundle ID.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_profiles_for_a_bundle_id
 func (s *ProvisioningService) ListProfilesForBundleID(ctx context.Context, id string, params *ListProfilesForBundleIDQuery) (*ProfilesResponse, *Response, error) {
-	url := fmt.Sprintf("bundleIds/%s/profiles", id)
-	res := new(ProfilesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[ProfilesResponse](ctx, s.client, fmt.Sprintf("bundleIds/%s/profiles", id), params)
 }
 
 // ListCapabilitiesForBundleID gets a list of all capabilities for a specific bundle ID.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_capabilities_for_a_bundle_id
 func (s *ProvisioningService) ListCapabilitiesForBundleID(ctx context.Context, id string, params *ListCapabilitiesForBundleIDQuery) (*BundleIDCapabilitiesResponse, *Response, error) {
-	url := fmt.Sprintf("bundleIds/%s/bundleIdCapabilities", id)
-	res := new(BundleIDCapabilitiesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BundleIDCapabilitiesResponse](ctx, s.client, fmt.Sprintf("bundleIds/%s/bundleIdCapabilities", id), params)
 }
 
 // UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in BundleIDResponseIncluded.
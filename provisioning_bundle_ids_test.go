@@ -108,3 +108,14 @@ func TestListCapabilitiesForBundleID(t *testing.T) {
 		return client.Provisioning.ListCapabilitiesForBundleID(ctx, "10", &ListCapabilitiesForBundleIDQuery{})
 	})
 }
+
+func TestParseBundleIDPlatform(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseBundleIDPlatform(string(BundleIDPlatformiOS))
+	assert.NoError(t, err)
+	assert.Equal(t, BundleIDPlatformiOS, got)
+
+	_, err = ParseBundleIDPlatform("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "BundleIDPlatform", Value: "UNKNOWN"}, err)
+}
@@ -104,6 +104,26 @@ const (
 	CapabilityTypeHealthKitBackgroundDelivery CapabilityType = "HEALTHKIT_BACKGROUND_DELIVERY" // [新增]
 )
 
+// IsValid reports whether the capability type is one this package knows about.
+func (v CapabilityType) IsValid() bool {
+	for _, value := range AllCapabilityTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCapabilityType returns s as a CapabilityType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCapabilityType(s string) (CapabilityType, error) {
+	if v := CapabilityType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CapabilityType", Value: s}
+}
+
 var AllCapabilityTypes = []CapabilityType{
 	CapabilityTypeAccessWifiInformation,
 	CapabilityTypeAppleIDAuth,
@@ -23,6 +23,8 @@ package asc
 import (
 	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestEnableCapability(t *testing.T) {
@@ -50,3 +52,14 @@ func TestUpdateCapability(t *testing.T) {
 		return client.Provisioning.UpdateCapability(ctx, "10", &capability, []CapabilitySetting{})
 	})
 }
+
+func TestParseCapabilityType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCapabilityType(string(CapabilityTypeAccessWifiInformation))
+	assert.NoError(t, err)
+	assert.Equal(t, CapabilityTypeAccessWifiInformation, got)
+
+	_, err = ParseCapabilityType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CapabilityType", Value: "UNKNOWN"}, err)
+}
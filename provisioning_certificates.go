@@ -57,6 +57,38 @@ const (
 	CertificateTypeMacInstallerDistribution CertificateType = "MAC_INSTALLER_DISTRIBUTION"
 )
 
+var certificateTypes = []CertificateType{
+	CertificateTypeDeveloperIDApplication,
+	CertificateTypeDeveloperIDKext,
+	CertificateTypeDevelopment,
+	CertificateTypeDistribution,
+	CertificateTypeiOSDevelopment,
+	CertificateTypeiOSDistribution,
+	CertificateTypeMacAppDevelopment,
+	CertificateTypeMacAppDistribution,
+	CertificateTypeMacInstallerDistribution,
+}
+
+// IsValid reports whether the certificate type is one this package knows about.
+func (v CertificateType) IsValid() bool {
+	for _, value := range certificateTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCertificateType returns s as a CertificateType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCertificateType(s string) (CertificateType, error) {
+	if v := CertificateType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CertificateType", Value: s}
+}
+
 // Certificate defines model for Certificate.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/certificate
@@ -165,21 +197,14 @@ func (s *ProvisioningService) CreateCertificate(ctx context.Context, certificate
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_and_download_certificates
 func (s *ProvisioningService) ListCertificates(ctx context.Context, params *ListCertificatesQuery) (*CertificatesResponse, *Response, error) {
-	res := new(CertificatesResponse)
-	resp, err := s.client.get(ctx, "certificates", params, res)
-
-	return res, resp, err
+	return Get[CertificatesResponse](ctx, s.client, "certificates", params)
 }
 
 // GetCertificate gets information about a certificate and download the certificate data.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_and_download_certificate_information
 func (s *ProvisioningService) GetCertificate(ctx context.Context, id string, params *GetCertificateQuery) (*CertificateResponse, *Response, error) {
-	url := fmt.Sprintf("certificates/%s", id)
-	res := new(CertificateResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[CertificateResponse](ctx, s.client, fmt.Sprintf("certificates/%s", id), params)
 }
 
 // RevokeCertificate revokes a lost, stolen, compromised, or expiring signing certificate.
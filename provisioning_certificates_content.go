@@ -0,0 +1,134 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"crypto"
+	"crypto/sha1" // nolint: gosec
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// ErrMissingCertificateContent happens when a certificate is returned without its certificateContent, such as
+// when it was excluded with a sparse fieldset.
+var ErrMissingCertificateContent = errors.New("certificate has no content")
+
+// ErrCertificateKeyMismatch happens when exporting a certificate with a private key that it wasn't created for.
+var ErrCertificateKeyMismatch = errors.New("private key does not match the certificate")
+
+// SigningCertificate is a certificate resource along with its decoded X.509 certificate.
+type SigningCertificate struct {
+	Resource    Certificate
+	Certificate *x509.Certificate
+}
+
+// ParseCertificateContent decodes the base64-encoded, DER-encoded certificateContent of a certificate.
+func ParseCertificateContent(content string) (*x509.Certificate, error) {
+	der, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return nil, err
+	}
+
+	return x509.ParseCertificate(der)
+}
+
+// DownloadCertificate gets a certificate and decodes its content, so that its details can be read and it can
+// be exported to a .cer or .p12 file.
+func (s *ProvisioningService) DownloadCertificate(ctx context.Context, id string) (*SigningCertificate, *Response, error) {
+	res, resp, err := s.GetCertificate(ctx, id, nil)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if res.Data.Attributes == nil || res.Data.Attributes.CertificateContent == nil {
+		return nil, resp, fmt.Errorf("%w: %s", ErrMissingCertificateContent, id)
+	}
+
+	cert, err := ParseCertificateContent(*res.Data.Attributes.CertificateContent)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &SigningCertificate{Resource: res.Data, Certificate: cert}, resp, nil
+}
+
+// Expiry returns the time after which the certificate is no longer valid.
+func (c *SigningCertificate) Expiry() time.Time {
+	return c.Certificate.NotAfter
+}
+
+// SerialNumber returns the serial number of the certificate in upper-case hexadecimal, as App Store Connect
+// and Keychain Access show it.
+func (c *SigningCertificate) SerialNumber() string {
+	return strings.ToUpper(c.Certificate.SerialNumber.Text(16))
+}
+
+// SHA1Fingerprint returns the SHA-1 fingerprint of the certificate in upper-case hexadecimal, as used by
+// codesign and security to identify signing identities.
+func (c *SigningCertificate) SHA1Fingerprint() string {
+	sum := sha1.Sum(c.Certificate.Raw) // nolint: gosec
+
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+// CER returns the DER-encoded certificate, the contents of a .cer file.
+func (c *SigningCertificate) CER() []byte {
+	return c.Certificate.Raw
+}
+
+// P12 returns a PKCS #12 archive of the certificate and key, the private key of the certificate signing
+// request it was created from, protected with password. The archive can be imported into a keychain.
+func (c *SigningCertificate) P12(key crypto.PrivateKey, password string) ([]byte, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T", ErrCertificateKeyMismatch, key)
+	}
+
+	public, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !public.Equal(c.Certificate.PublicKey) {
+		return nil, ErrCertificateKeyMismatch
+	}
+
+	return encodePKCS12(c.Certificate, key, password)
+}
+
+// WriteCER writes the certificate to a .cer file at path.
+func (c *SigningCertificate) WriteCER(path string) error {
+	return os.WriteFile(path, c.CER(), 0o644)
+}
+
+// WriteP12 writes a PKCS #12 archive of the certificate and key, protected with password, to a .p12 file at
+// path. As the file holds a private key, it is only readable by its owner.
+func (c *SigningCertificate) WriteP12(path string, key crypto.PrivateKey, password string) error {
+	b, err := c.P12(key, password)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0o600)
+}
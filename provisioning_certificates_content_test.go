@@ -0,0 +1,133 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestSigningCertificate(t *testing.T) (*x509.Certificate, crypto.Signer) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(0x5A3F01),
+		Subject:      pkix.Name{CommonName: "Apple Distribution: Example (TEAMID)"},
+		NotBefore:    time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		NotAfter:     time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	assert.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+
+	return cert, key
+}
+
+func TestDownloadCertificate(t *testing.T) {
+	t.Parallel()
+
+	cert, key := newTestSigningCertificate(t)
+	content := base64.StdEncoding.EncodeToString(cert.Raw)
+
+	client, server := newServer(fmt.Sprintf(`{"data":{"type":"certificates","id":"10","attributes":{"certificateContent":"%s"}}}`, content), http.StatusOK, false)
+	defer server.Close()
+
+	got, _, err := client.Provisioning.DownloadCertificate(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Equal(t, "10", got.Resource.ID)
+	assert.Equal(t, cert.NotAfter, got.Expiry())
+	assert.Equal(t, "5A3F01", got.SerialNumber())
+	assert.Len(t, got.SHA1Fingerprint(), 40)
+	assert.Equal(t, cert.Raw, got.CER())
+
+	dir := t.TempDir()
+
+	assert.NoError(t, got.WriteCER(filepath.Join(dir, "cert.cer")))
+	written, err := os.ReadFile(filepath.Join(dir, "cert.cer"))
+	assert.NoError(t, err)
+	assert.Equal(t, cert.Raw, written)
+
+	assert.NoError(t, got.WriteP12(filepath.Join(dir, "cert.p12"), key, "secret"))
+	info, err := os.Stat(filepath.Join(dir, "cert.p12"))
+	assert.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	assert.ErrorIs(t, got.WriteP12(filepath.Join(dir, "other.p12"), otherKey, "secret"), ErrCertificateKeyMismatch)
+
+	_, err = got.P12("not a key", "secret")
+	assert.ErrorIs(t, err, ErrCertificateKeyMismatch)
+}
+
+func TestDownloadCertificateMissingContent(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":{"type":"certificates","id":"10","attributes":{}}}`, http.StatusOK, false)
+	defer server.Close()
+
+	_, _, err := client.Provisioning.DownloadCertificate(context.Background(), "10")
+	assert.ErrorIs(t, err, ErrMissingCertificateContent)
+}
+
+func TestDownloadCertificateError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":{"type":"certificates","id":"10","attributes":{"certificateContent":"bm90IGEgY2VydA=="}}}`, http.StatusOK, false)
+	defer server.Close()
+
+	_, _, err := client.Provisioning.DownloadCertificate(context.Background(), "10")
+	assert.Error(t, err)
+
+	client, server = newServer(`{"data":`, http.StatusOK, false)
+	defer server.Close()
+
+	_, _, err = client.Provisioning.DownloadCertificate(context.Background(), "10")
+	assert.Error(t, err)
+}
+
+func TestParseCertificateContent(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseCertificateContent("!")
+	assert.Error(t, err)
+}
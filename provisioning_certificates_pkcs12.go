@@ -0,0 +1,321 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"crypto/cipher"
+	"crypto/des" // nolint: gosec
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" // nolint: gosec
+	"crypto/x509"
+	"encoding/asn1"
+	"unicode/utf16"
+)
+
+// The PKCS #12 archive written by encodePKCS12 uses the algorithms Keychain Access and OpenSSL both read:
+// the private key is encrypted with pbeWithSHAAnd3-KeyTripleDES-CBC, and the archive is authenticated with
+// HMAC-SHA1.
+//
+// https://datatracker.ietf.org/doc/html/rfc7292
+const pkcs12Iterations = 2048
+
+// Purposes of the key material derived by pkcs12KDF.
+const (
+	pkcs12KeyID byte = 1
+	pkcs12IVID  byte = 2
+	pkcs12MACID byte = 3
+)
+
+var (
+	oidData                  = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidPBEWithSHAAnd3KeyTDES = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 1, 3}
+	oidPKCS8ShroudedKeyBag   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 2}
+	oidCertBag               = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 12, 10, 1, 3}
+	oidX509Certificate       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 22, 1}
+	oidLocalKeyID            = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 21}
+	oidSHA1                  = asn1.ObjectIdentifier{1, 3, 14, 3, 2, 26}
+)
+
+type pfxPdu struct {
+	Version  int
+	AuthSafe contentInfo
+	MacData  macData
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"optional"`
+}
+
+type macData struct {
+	Mac        digestInfo
+	MacSalt    []byte
+	Iterations int `asn1:"optional,default:1"`
+}
+
+type digestInfo struct {
+	Algorithm algorithmIdentifier
+	Digest    []byte
+}
+
+type algorithmIdentifier struct {
+	Algorithm  asn1.ObjectIdentifier
+	Parameters asn1.RawValue `asn1:"optional"`
+}
+
+type pbeParams struct {
+	Salt       []byte
+	Iterations int
+}
+
+type encryptedPrivateKeyInfo struct {
+	Algorithm     algorithmIdentifier
+	EncryptedData []byte
+}
+
+type safeBag struct {
+	ID         asn1.ObjectIdentifier
+	Value      asn1.RawValue
+	Attributes []pkcs12Attribute `asn1:"set,optional"`
+}
+
+type pkcs12Attribute struct {
+	ID    asn1.ObjectIdentifier
+	Value asn1.RawValue
+}
+
+type certBag struct {
+	ID   asn1.ObjectIdentifier
+	Data []byte `asn1:"tag:0,explicit"`
+}
+
+// encodePKCS12 returns a password-protected PKCS #12 archive of cert and the private key that signed it.
+func encodePKCS12(cert *x509.Certificate, key interface{}, password string) ([]byte, error) {
+	pkcs8, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := bmpPassword(password)
+
+	keyBag, err := encryptedKeyBag(pkcs8, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := sha1.Sum(cert.Raw) // nolint: gosec
+
+	localKeyID, err := localKeyIDAttribute(fingerprint[:])
+	if err != nil {
+		return nil, err
+	}
+
+	keyBag.Attributes = localKeyID
+
+	certValue, err := asn1.Marshal(certBag{ID: oidX509Certificate, Data: cert.Raw})
+	if err != nil {
+		return nil, err
+	}
+
+	bags := []safeBag{
+		{ID: oidCertBag, Value: explicitContent(certValue), Attributes: localKeyID},
+		keyBag,
+	}
+
+	// Each bag is stored in its own unencrypted SafeContents; the key is protected by its shrouded bag.
+	safes := make([]contentInfo, len(bags))
+
+	for i, bag := range bags {
+		contents, err := asn1.Marshal([]safeBag{bag})
+		if err != nil {
+			return nil, err
+		}
+
+		if safes[i], err = dataContentInfo(contents); err != nil {
+			return nil, err
+		}
+	}
+
+	authenticatedSafe, err := asn1.Marshal(safes)
+	if err != nil {
+		return nil, err
+	}
+
+	authSafe, err := dataContentInfo(authenticatedSafe)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, 8)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha1.New, pkcs12KDF(pkcs12MACID, secret, salt, pkcs12Iterations, sha1.Size))
+	mac.Write(authenticatedSafe)
+
+	return asn1.Marshal(pfxPdu{
+		Version:  3,
+		AuthSafe: authSafe,
+		MacData: macData{
+			Mac: digestInfo{
+				Algorithm: algorithmIdentifier{Algorithm: oidSHA1, Parameters: asn1.NullRawValue},
+				Digest:    mac.Sum(nil),
+			},
+			MacSalt:    salt,
+			Iterations: pkcs12Iterations,
+		},
+	})
+}
+
+func encryptedKeyBag(pkcs8 []byte, secret []byte) (safeBag, error) {
+	salt := make([]byte, 8)
+	if _, err := rand.Read(salt); err != nil {
+		return safeBag{}, err
+	}
+
+	block, err := des.NewTripleDESCipher(pkcs12KDF(pkcs12KeyID, secret, salt, pkcs12Iterations, 24)) // nolint: gosec
+	if err != nil {
+		return safeBag{}, err
+	}
+
+	iv := pkcs12KDF(pkcs12IVID, secret, salt, pkcs12Iterations, block.BlockSize())
+
+	padding := block.BlockSize() - len(pkcs8)%block.BlockSize()
+	encrypted := make([]byte, len(pkcs8)+padding)
+	copy(encrypted, pkcs8)
+
+	for i := len(pkcs8); i < len(encrypted); i++ {
+		encrypted[i] = byte(padding)
+	}
+
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	params, err := asn1.Marshal(pbeParams{Salt: salt, Iterations: pkcs12Iterations})
+	if err != nil {
+		return safeBag{}, err
+	}
+
+	value, err := asn1.Marshal(encryptedPrivateKeyInfo{
+		Algorithm:     algorithmIdentifier{Algorithm: oidPBEWithSHAAnd3KeyTDES, Parameters: asn1.RawValue{FullBytes: params}},
+		EncryptedData: encrypted,
+	})
+	if err != nil {
+		return safeBag{}, err
+	}
+
+	return safeBag{ID: oidPKCS8ShroudedKeyBag, Value: explicitContent(value)}, nil
+}
+
+func localKeyIDAttribute(id []byte) ([]pkcs12Attribute, error) {
+	value, err := asn1.Marshal(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return []pkcs12Attribute{{ID: oidLocalKeyID, Value: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: value}}}, nil
+}
+
+func dataContentInfo(data []byte) (contentInfo, error) {
+	content, err := asn1.Marshal(data)
+	if err != nil {
+		return contentInfo{}, err
+	}
+
+	return contentInfo{ContentType: oidData, Content: explicitContent(content)}, nil
+}
+
+// explicitContent wraps the DER encoding of a value in the [0] EXPLICIT tag used for the contents of content
+// infos and safe bags. Tags in struct fields don't apply to asn1.RawValue, so it is built by hand.
+func explicitContent(der []byte) asn1.RawValue {
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: der}
+}
+
+// bmpPassword encodes password as a null-terminated, big-endian UTF-16 string, as PKCS #12 expects.
+func bmpPassword(password string) []byte {
+	units := utf16.Encode([]rune(password))
+	secret := make([]byte, 0, 2*len(units)+2)
+
+	for _, u := range units {
+		secret = append(secret, byte(u>>8), byte(u))
+	}
+
+	return append(secret, 0, 0)
+}
+
+// pkcs12KDF derives size bytes of key material for the purpose id from password and salt, with SHA-1 as the
+// hash function.
+//
+// https://datatracker.ietf.org/doc/html/rfc7292#appendix-B.2
+func pkcs12KDF(id byte, password, salt []byte, iterations, size int) []byte {
+	const u, v = sha1.Size, 64
+
+	fill := func(b []byte) []byte {
+		if len(b) == 0 {
+			return nil
+		}
+
+		out := make([]byte, v*((len(b)+v-1)/v))
+		for i := range out {
+			out[i] = b[i%len(b)]
+		}
+
+		return out
+	}
+
+	d := make([]byte, v)
+	for i := range d {
+		d[i] = id
+	}
+
+	input := append(fill(salt), fill(password)...)
+	out := make([]byte, 0, size+u)
+
+	for len(out) < size {
+		h := sha1.New() // nolint: gosec
+		h.Write(d)
+		h.Write(input)
+		a := h.Sum(nil)
+
+		for i := 1; i < iterations; i++ {
+			sum := sha1.Sum(a) // nolint: gosec
+			a = sum[:]
+		}
+
+		out = append(out, a...)
+
+		// Each v-byte block of the input is incremented by B + 1, where B is A repeated to v bytes.
+		b := fill(a)
+
+		for j := 0; j < len(input); j += v {
+			carry := 1
+
+			for k := v - 1; k >= 0; k-- {
+				carry += int(input[j+k]) + int(b[k])
+				input[j+k] = byte(carry)
+				carry >>= 8
+			}
+		}
+	}
+
+	return out[:size]
+}
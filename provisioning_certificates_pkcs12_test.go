@@ -0,0 +1,151 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"crypto/cipher"
+	"crypto/des" // nolint: gosec
+	"crypto/hmac"
+	"crypto/sha1" // nolint: gosec
+	"crypto/x509"
+	"encoding/asn1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// decodeTestPKCS12 checks the MAC of a PKCS #12 archive written by encodePKCS12 and returns its certificate and
+// decrypted private key.
+func decodeTestPKCS12(t *testing.T, der []byte, password string) (*x509.Certificate, interface{}) {
+	t.Helper()
+
+	secret := bmpPassword(password)
+
+	var pfx pfxPdu
+
+	_, err := asn1.Unmarshal(der, &pfx)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, pfx.Version)
+
+	var authenticatedSafe []byte
+
+	_, err = asn1.Unmarshal(pfx.AuthSafe.Content.Bytes, &authenticatedSafe)
+	assert.NoError(t, err)
+
+	mac := hmac.New(sha1.New, pkcs12KDF(pkcs12MACID, secret, pfx.MacData.MacSalt, pfx.MacData.Iterations, sha1.Size))
+	mac.Write(authenticatedSafe)
+	assert.Equal(t, mac.Sum(nil), pfx.MacData.Mac.Digest, "MAC doesn't match")
+
+	var safes []contentInfo
+
+	_, err = asn1.Unmarshal(authenticatedSafe, &safes)
+	assert.NoError(t, err)
+
+	var (
+		cert *x509.Certificate
+		key  interface{}
+	)
+
+	for _, safe := range safes {
+		var contents []byte
+
+		_, err = asn1.Unmarshal(safe.Content.Bytes, &contents)
+		assert.NoError(t, err)
+
+		var bags []safeBag
+
+		_, err = asn1.Unmarshal(contents, &bags)
+		assert.NoError(t, err)
+
+		for _, bag := range bags {
+			switch {
+			case bag.ID.Equal(oidCertBag):
+				var value certBag
+
+				_, err = asn1.Unmarshal(bag.Value.Bytes, &value)
+				assert.NoError(t, err)
+
+				cert, err = x509.ParseCertificate(value.Data)
+				assert.NoError(t, err)
+			case bag.ID.Equal(oidPKCS8ShroudedKeyBag):
+				var value encryptedPrivateKeyInfo
+
+				_, err = asn1.Unmarshal(bag.Value.Bytes, &value)
+				assert.NoError(t, err)
+				assert.True(t, value.Algorithm.Algorithm.Equal(oidPBEWithSHAAnd3KeyTDES))
+
+				var params pbeParams
+
+				_, err = asn1.Unmarshal(value.Algorithm.Parameters.FullBytes, &params)
+				assert.NoError(t, err)
+
+				block, err := des.NewTripleDESCipher(pkcs12KDF(pkcs12KeyID, secret, params.Salt, params.Iterations, 24)) // nolint: gosec
+				assert.NoError(t, err)
+
+				iv := pkcs12KDF(pkcs12IVID, secret, params.Salt, params.Iterations, block.BlockSize())
+				decrypted := make([]byte, len(value.EncryptedData))
+				cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, value.EncryptedData)
+				decrypted = decrypted[:len(decrypted)-int(decrypted[len(decrypted)-1])]
+
+				key, err = x509.ParsePKCS8PrivateKey(decrypted)
+				assert.NoError(t, err)
+			}
+		}
+	}
+
+	return cert, key
+}
+
+func TestEncodePKCS12(t *testing.T) {
+	t.Parallel()
+
+	cert, key := newTestSigningCertificate(t)
+
+	der, err := encodePKCS12(cert, key, "pässwörd")
+	assert.NoError(t, err)
+
+	gotCert, gotKey := decodeTestPKCS12(t, der, "pässwörd")
+	assert.Equal(t, cert.Raw, gotCert.Raw)
+	assert.Equal(t, key, gotKey)
+
+	_, err = encodePKCS12(cert, "not a key", "")
+	assert.Error(t, err)
+}
+
+func TestBMPPassword(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []byte{0, 0}, bmpPassword(""))
+	assert.Equal(t, []byte{0, 'a', 0, 0xe4, 0, 0}, bmpPassword("aä"))
+}
+
+func TestPKCS12KDFLength(t *testing.T) {
+	t.Parallel()
+
+	salt := []byte("saltsalt")
+	key := pkcs12KDF(pkcs12KeyID, bmpPassword("secret"), salt, 1, 24)
+	iv := pkcs12KDF(pkcs12IVID, bmpPassword("secret"), salt, 1, 8)
+
+	assert.Len(t, key, 24)
+	assert.Len(t, iv, 8)
+	assert.NotEqual(t, key[:8], iv)
+	assert.Equal(t, key, pkcs12KDF(pkcs12KeyID, bmpPassword("secret"), salt, 1, 24))
+}
@@ -24,6 +24,8 @@ import (
 	"bytes"
 	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCreateCertificate(t *testing.T) {
@@ -60,3 +62,14 @@ func TestRevokeCertificate(t *testing.T) {
 		return client.Provisioning.RevokeCertificate(ctx, "10")
 	})
 }
+
+func TestParseCertificateType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCertificateType(string(CertificateTypeDeveloperIDApplication))
+	assert.NoError(t, err)
+	assert.Equal(t, CertificateTypeDeveloperIDApplication, got)
+
+	_, err = ParseCertificateType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CertificateType", Value: "UNKNOWN"}, err)
+}
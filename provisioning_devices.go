@@ -143,21 +143,14 @@ func (s *ProvisioningService) CreateDevice(ctx context.Context, name string, udi
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_devices
 func (s *ProvisioningService) ListDevices(ctx context.Context, params *ListDevicesQuery) (*DevicesResponse, *Response, error) {
-	res := new(DevicesResponse)
-	resp, err := s.client.get(ctx, "devices", params, res)
-
-	return res, resp, err
+	return Get[DevicesResponse](ctx, s.client, "devices", params)
 }
 
 // GetDevice gets information for a specific device registered to your team.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_device_information
 func (s *ProvisioningService) GetDevice(ctx context.Context, id string, params *GetDeviceQuery) (*DeviceResponse, *Response, error) {
-	url := fmt.Sprintf("devices/%s", id)
-	res := new(DeviceResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[DeviceResponse](ctx, s.client, fmt.Sprintf("devices/%s", id), params)
 }
 
 // UpdateDevice updates the name or status of a specific device.
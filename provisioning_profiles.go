@@ -206,54 +206,35 @@ func (s *ProvisioningService) DeleteProfile(ctx context.Context, id string) (*Re
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_and_download_profiles
 func (s *ProvisioningService) ListProfiles(ctx context.Context, params *ListProfilesQuery) (*ProfilesResponse, *Response, error) {
-	res := new(ProfilesResponse)
-	resp, err := s.client.get(ctx, "profiles", params, res)
-
-	return res, resp, err
+	return Get[ProfilesResponse](ctx, s.client, "profiles", params)
 }
 
 // GetProfile gets information for a specific provisioning profile and download its data.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_and_download_profile_information
 func (s *ProvisioningService) GetProfile(ctx context.Context, id string, params *GetProfileQuery) (*ProfileResponse, *Response, error) {
-	url := fmt.Sprintf("profiles/%s", id)
-	res := new(ProfileResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[ProfileResponse](ctx, s.client, fmt.Sprintf("profiles/%s", id), params)
 }
 
 // GetBundleIDForProfile gets the bundle ID information for a specific provisioning profile.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_bundle_id_in_a_profile
 func (s *ProvisioningService) GetBundleIDForProfile(ctx context.Context, id string, params *GetBundleIDForProfileQuery) (*BundleIDResponse, *Response, error) {
-	url := fmt.Sprintf("profiles/%s/bundleId", id)
-	res := new(BundleIDResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BundleIDResponse](ctx, s.client, fmt.Sprintf("profiles/%s/bundleId", id), params)
 }
 
 // ListCertificatesInProfile gets a list of all certificates and their data for a specific provisioning profile.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_certificates_in_a_profile
 func (s *ProvisioningService) ListCertificatesInProfile(ctx context.Context, id string, params *ListCertificatesForProfileQuery) (*CertificatesResponse, *Response, error) {
-	url := fmt.Sprintf("profiles/%s/certificates", id)
-	res := new(CertificatesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[CertificatesResponse](ctx, s.client, fmt.Sprintf("profiles/%s/certificates", id), params)
 }
 
 // ListDevicesInProfile gets a list of all devices for a specific provisioning profile.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_devices_in_a_profile
 func (s *ProvisioningService) ListDevicesInProfile(ctx context.Context, id string, params *ListDevicesInProfileQuery) (*DevicesResponse, *Response, error) {
-	url := fmt.Sprintf("profiles/%s/devices", id)
-	res := new(DevicesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[DevicesResponse](ctx, s.client, fmt.Sprintf("profiles/%s/devices", id), params)
 }
 
 // UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in ProfileResponseIncluded.
@@ -24,4 +24,6 @@ package asc
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/app_store_version_phased_releases
 // https://developer.apple.com/documentation/appstoreconnectapi/app_pre-orders
+// https://developer.apple.com/documentation/appstoreconnectapi/featuring_nominations
+// https://developer.apple.com/documentation/appstoreconnectapi/alternative_distribution
 type PublishingService service
@@ -0,0 +1,461 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// ChecksumAlgorithm defines model for ChecksumAlgorithm.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/checksumalgorithm
+type ChecksumAlgorithm string
+
+const (
+	// ChecksumAlgorithmMD5 is a checksum computed with MD5.
+	ChecksumAlgorithmMD5 ChecksumAlgorithm = "MD5"
+	// ChecksumAlgorithmSHA256 is a checksum computed with SHA-256.
+	ChecksumAlgorithmSHA256 ChecksumAlgorithm = "SHA_256"
+)
+
+var checksumAlgorithms = []ChecksumAlgorithm{
+	ChecksumAlgorithmMD5,
+	ChecksumAlgorithmSHA256,
+}
+
+// IsValid reports whether the checksum algorithm is one this package knows about.
+func (v ChecksumAlgorithm) IsValid() bool {
+	for _, value := range checksumAlgorithms {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseChecksumAlgorithm returns s as a ChecksumAlgorithm, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseChecksumAlgorithm(s string) (ChecksumAlgorithm, error) {
+	if v := ChecksumAlgorithm(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "ChecksumAlgorithm", Value: s}
+}
+
+// Checksum defines model for Checksum.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/checksum
+type Checksum struct {
+	Algorithm *ChecksumAlgorithm `json:"algorithm,omitempty"`
+	Hash      *string            `json:"hash,omitempty"`
+}
+
+// Checksums defines model for Checksums.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/checksums
+type Checksums struct {
+	Composite *Checksum `json:"composite,omitempty"`
+	File      *Checksum `json:"file,omitempty"`
+}
+
+// AlternativeDistributionPackageVersionState defines model for AlternativeDistributionPackageVersion.Attributes.State
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackageversion/attributes
+type AlternativeDistributionPackageVersionState string
+
+const (
+	// AlternativeDistributionPackageVersionStateCompleted is a package version that is ready to be distributed.
+	AlternativeDistributionPackageVersionStateCompleted AlternativeDistributionPackageVersionState = "COMPLETED"
+	// AlternativeDistributionPackageVersionStateReplaced is a package version that a newer version has replaced.
+	AlternativeDistributionPackageVersionStateReplaced AlternativeDistributionPackageVersionState = "REPLACED"
+)
+
+var alternativeDistributionPackageVersionStates = []AlternativeDistributionPackageVersionState{
+	AlternativeDistributionPackageVersionStateCompleted,
+	AlternativeDistributionPackageVersionStateReplaced,
+}
+
+// IsValid reports whether the alternative distribution package version state is one this package knows about.
+func (v AlternativeDistributionPackageVersionState) IsValid() bool {
+	for _, value := range alternativeDistributionPackageVersionStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAlternativeDistributionPackageVersionState returns s as an AlternativeDistributionPackageVersionState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAlternativeDistributionPackageVersionState(s string) (AlternativeDistributionPackageVersionState, error) {
+	if v := AlternativeDistributionPackageVersionState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AlternativeDistributionPackageVersionState", Value: s}
+}
+
+// AlternativeDistributionPackage defines model for AlternativeDistributionPackage.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackage
+type AlternativeDistributionPackage struct {
+	Attributes    *AlternativeDistributionPackageAttributes    `json:"attributes,omitempty"`
+	ID            string                                       `json:"id"`
+	Links         ResourceLinks                                `json:"links"`
+	Relationships *AlternativeDistributionPackageRelationships `json:"relationships,omitempty"`
+	Type          string                                       `json:"type"`
+}
+
+// AlternativeDistributionPackageAttributes defines model for AlternativeDistributionPackage.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackage/attributes
+type AlternativeDistributionPackageAttributes struct {
+	SourceFileChecksum *Checksums `json:"sourceFileChecksum,omitempty"`
+}
+
+// AlternativeDistributionPackageRelationships defines model for AlternativeDistributionPackage.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackage/relationships
+type AlternativeDistributionPackageRelationships struct {
+	Versions *PagedRelationship `json:"versions,omitempty"`
+}
+
+// AlternativeDistributionPackageResponse defines model for AlternativeDistributionPackageResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackageresponse
+type AlternativeDistributionPackageResponse struct {
+	Data     AlternativeDistributionPackage          `json:"data"`
+	Included []AlternativeDistributionPackageVersion `json:"included,omitempty"`
+	Links    DocumentLinks                           `json:"links"`
+}
+
+// alternativeDistributionPackageCreateRequest defines model for AlternativeDistributionPackageCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackagecreaterequest/data
+type alternativeDistributionPackageCreateRequest struct {
+	Relationships alternativeDistributionPackageCreateRequestRelationships `json:"relationships"`
+	Type          string                                                   `json:"type"`
+}
+
+// alternativeDistributionPackageCreateRequestRelationships are relationships for AlternativeDistributionPackageCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackagecreaterequest/data/relationships
+type alternativeDistributionPackageCreateRequestRelationships struct {
+	AppStoreVersion relationshipDeclaration `json:"appStoreVersion"`
+}
+
+// AlternativeDistributionPackageVersion defines model for AlternativeDistributionPackageVersion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackageversion
+type AlternativeDistributionPackageVersion struct {
+	Attributes    *AlternativeDistributionPackageVersionAttributes    `json:"attributes,omitempty"`
+	ID            string                                              `json:"id"`
+	Links         ResourceLinks                                       `json:"links"`
+	Relationships *AlternativeDistributionPackageVersionRelationships `json:"relationships,omitempty"`
+	Type          string                                              `json:"type"`
+}
+
+// AlternativeDistributionPackageVersionAttributes defines model for AlternativeDistributionPackageVersion.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackageversion/attributes
+type AlternativeDistributionPackageVersionAttributes struct {
+	FileChecksum      *string                                     `json:"fileChecksum,omitempty"`
+	State             *AlternativeDistributionPackageVersionState `json:"state,omitempty"`
+	URL               *string                                     `json:"url,omitempty"`
+	URLExpirationDate *DateTime                                   `json:"urlExpirationDate,omitempty"`
+	Version           *string                                     `json:"version,omitempty"`
+}
+
+// AlternativeDistributionPackageVersionRelationships defines model for AlternativeDistributionPackageVersion.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackageversion/relationships
+type AlternativeDistributionPackageVersionRelationships struct {
+	AlternativeDistributionPackage *Relationship      `json:"alternativeDistributionPackage,omitempty"`
+	Deltas                         *PagedRelationship `json:"deltas,omitempty"`
+	Variants                       *PagedRelationship `json:"variants,omitempty"`
+}
+
+// AlternativeDistributionPackageVersionResponse defines model for AlternativeDistributionPackageVersionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackageversionresponse
+type AlternativeDistributionPackageVersionResponse struct {
+	Data     AlternativeDistributionPackageVersion                   `json:"data"`
+	Included []AlternativeDistributionPackageVersionResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                                           `json:"links"`
+}
+
+// AlternativeDistributionPackageVersionsResponse defines model for AlternativeDistributionPackageVersionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackageversionsresponse
+type AlternativeDistributionPackageVersionsResponse struct {
+	Data     []AlternativeDistributionPackageVersion                 `json:"data"`
+	Included []AlternativeDistributionPackageVersionResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                                      `json:"links"`
+	Meta     *PagingInformation                                      `json:"meta,omitempty"`
+}
+
+// AlternativeDistributionPackageVersionResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a AlternativeDistributionPackageVersionResponse or AlternativeDistributionPackageVersionsResponse.
+type AlternativeDistributionPackageVersionResponseIncluded included
+
+// AlternativeDistributionPackageVariant defines model for AlternativeDistributionPackageVariant.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackagevariant
+type AlternativeDistributionPackageVariant struct {
+	Attributes *AlternativeDistributionPackageVariantAttributes `json:"attributes,omitempty"`
+	ID         string                                           `json:"id"`
+	Links      ResourceLinks                                    `json:"links"`
+	Type       string                                           `json:"type"`
+}
+
+// AlternativeDistributionPackageVariantAttributes defines model for AlternativeDistributionPackageVariant.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackagevariant/attributes
+type AlternativeDistributionPackageVariantAttributes struct {
+	AlternativeDistributionKeyBlob *string   `json:"alternativeDistributionKeyBlob,omitempty"`
+	FileChecksum                   *string   `json:"fileChecksum,omitempty"`
+	URL                            *string   `json:"url,omitempty"`
+	URLExpirationDate              *DateTime `json:"urlExpirationDate,omitempty"`
+}
+
+// AlternativeDistributionPackageVariantResponse defines model for AlternativeDistributionPackageVariantResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackagevariantresponse
+type AlternativeDistributionPackageVariantResponse struct {
+	Data  AlternativeDistributionPackageVariant `json:"data"`
+	Links DocumentLinks                         `json:"links"`
+}
+
+// AlternativeDistributionPackageVariantsResponse defines model for AlternativeDistributionPackageVariantsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackagevariantsresponse
+type AlternativeDistributionPackageVariantsResponse struct {
+	Data  []AlternativeDistributionPackageVariant `json:"data"`
+	Links PagedDocumentLinks                      `json:"links"`
+	Meta  *PagingInformation                      `json:"meta,omitempty"`
+}
+
+// AlternativeDistributionPackageDelta defines model for AlternativeDistributionPackageDelta.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackagedelta
+type AlternativeDistributionPackageDelta struct {
+	Attributes *AlternativeDistributionPackageDeltaAttributes `json:"attributes,omitempty"`
+	ID         string                                         `json:"id"`
+	Links      ResourceLinks                                  `json:"links"`
+	Type       string                                         `json:"type"`
+}
+
+// AlternativeDistributionPackageDeltaAttributes defines model for AlternativeDistributionPackageDelta.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackagedelta/attributes
+type AlternativeDistributionPackageDeltaAttributes struct {
+	AlternativeDistributionKeyBlob *string   `json:"alternativeDistributionKeyBlob,omitempty"`
+	FileChecksum                   *string   `json:"fileChecksum,omitempty"`
+	URL                            *string   `json:"url,omitempty"`
+	URLExpirationDate              *DateTime `json:"urlExpirationDate,omitempty"`
+}
+
+// AlternativeDistributionPackageDeltaResponse defines model for AlternativeDistributionPackageDeltaResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackagedeltaresponse
+type AlternativeDistributionPackageDeltaResponse struct {
+	Data  AlternativeDistributionPackageDelta `json:"data"`
+	Links DocumentLinks                       `json:"links"`
+}
+
+// AlternativeDistributionPackageDeltasResponse defines model for AlternativeDistributionPackageDeltasResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/alternativedistributionpackagedeltasresponse
+type AlternativeDistributionPackageDeltasResponse struct {
+	Data  []AlternativeDistributionPackageDelta `json:"data"`
+	Links PagedDocumentLinks                    `json:"links"`
+	Meta  *PagingInformation                    `json:"meta,omitempty"`
+}
+
+// GetAlternativeDistributionPackageQuery are query options for GetAlternativeDistributionPackage and
+// GetAlternativeDistributionPackageForAppStoreVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackages_id
+type GetAlternativeDistributionPackageQuery struct {
+	FieldsAlternativeDistributionPackages        []string `url:"fields[alternativeDistributionPackages],omitempty"`
+	FieldsAlternativeDistributionPackageVersions []string `url:"fields[alternativeDistributionPackageVersions],omitempty"`
+	Include                                      []string `url:"include,omitempty"`
+	LimitVersions                                int      `url:"limit[versions],omitempty"`
+}
+
+// ListVersionsForAlternativeDistributionPackageQuery are query options for ListVersionsForAlternativeDistributionPackage
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackages_id_versions
+type ListVersionsForAlternativeDistributionPackageQuery struct {
+	FieldsAlternativeDistributionPackageDeltas   []string `url:"fields[alternativeDistributionPackageDeltas],omitempty"`
+	FieldsAlternativeDistributionPackageVariants []string `url:"fields[alternativeDistributionPackageVariants],omitempty"`
+	FieldsAlternativeDistributionPackageVersions []string `url:"fields[alternativeDistributionPackageVersions],omitempty"`
+	FilterState                                  []string `url:"filter[state],omitempty"`
+	Include                                      []string `url:"include,omitempty"`
+	Limit                                        int      `url:"limit,omitempty"`
+	LimitDeltas                                  int      `url:"limit[deltas],omitempty"`
+	LimitVariants                                int      `url:"limit[variants],omitempty"`
+	Cursor                                       string   `url:"cursor,omitempty"`
+}
+
+// GetAlternativeDistributionPackageVersionQuery are query options for GetAlternativeDistributionPackageVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackageversions_id
+type GetAlternativeDistributionPackageVersionQuery struct {
+	FieldsAlternativeDistributionPackageDeltas   []string `url:"fields[alternativeDistributionPackageDeltas],omitempty"`
+	FieldsAlternativeDistributionPackageVariants []string `url:"fields[alternativeDistributionPackageVariants],omitempty"`
+	FieldsAlternativeDistributionPackageVersions []string `url:"fields[alternativeDistributionPackageVersions],omitempty"`
+	Include                                      []string `url:"include,omitempty"`
+	LimitDeltas                                  int      `url:"limit[deltas],omitempty"`
+	LimitVariants                                int      `url:"limit[variants],omitempty"`
+}
+
+// ListVariantsForAlternativeDistributionPackageVersionQuery are query options for ListVariantsForAlternativeDistributionPackageVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackageversions_id_variants
+type ListVariantsForAlternativeDistributionPackageVersionQuery struct {
+	FieldsAlternativeDistributionPackageVariants []string `url:"fields[alternativeDistributionPackageVariants],omitempty"`
+	Limit                                        int      `url:"limit,omitempty"`
+	Cursor                                       string   `url:"cursor,omitempty"`
+}
+
+// ListDeltasForAlternativeDistributionPackageVersionQuery are query options for ListDeltasForAlternativeDistributionPackageVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackageversions_id_deltas
+type ListDeltasForAlternativeDistributionPackageVersionQuery struct {
+	FieldsAlternativeDistributionPackageDeltas []string `url:"fields[alternativeDistributionPackageDeltas],omitempty"`
+	Limit                                      int      `url:"limit,omitempty"`
+	Cursor                                     string   `url:"cursor,omitempty"`
+}
+
+// GetAlternativeDistributionPackageVariantQuery are query options for GetAlternativeDistributionPackageVariant
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackagevariants_id
+type GetAlternativeDistributionPackageVariantQuery struct {
+	FieldsAlternativeDistributionPackageVariants []string `url:"fields[alternativeDistributionPackageVariants],omitempty"`
+}
+
+// GetAlternativeDistributionPackageDeltaQuery are query options for GetAlternativeDistributionPackageDelta
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackagedeltas_id
+type GetAlternativeDistributionPackageDeltaQuery struct {
+	FieldsAlternativeDistributionPackageDeltas []string `url:"fields[alternativeDistributionPackageDeltas],omitempty"`
+}
+
+// CreateAlternativeDistributionPackage requests an alternative distribution package for an App Store version
+// that is approved for distribution outside the App Store.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_alternativedistributionpackages
+func (s *PublishingService) CreateAlternativeDistributionPackage(ctx context.Context, appStoreVersionID string) (*AlternativeDistributionPackageResponse, *Response, error) {
+	req := alternativeDistributionPackageCreateRequest{
+		Relationships: alternativeDistributionPackageCreateRequestRelationships{
+			AppStoreVersion: *newRelationshipDeclaration(&appStoreVersionID, "appStoreVersions"),
+		},
+		Type: "alternativeDistributionPackages",
+	}
+	res := new(AlternativeDistributionPackageResponse)
+	resp, err := s.client.post(ctx, "alternativeDistributionPackages", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// GetAlternativeDistributionPackage reads an alternative distribution package.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackages_id
+func (s *PublishingService) GetAlternativeDistributionPackage(ctx context.Context, id string, params *GetAlternativeDistributionPackageQuery) (*AlternativeDistributionPackageResponse, *Response, error) {
+	return Get[AlternativeDistributionPackageResponse](ctx, s.client, fmt.Sprintf("alternativeDistributionPackages/%s", id), params)
+}
+
+// GetAlternativeDistributionPackageForAppStoreVersion reads the alternative distribution package of an App Store version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_appstoreversions_id_alternativedistributionpackage
+func (s *PublishingService) GetAlternativeDistributionPackageForAppStoreVersion(ctx context.Context, id string, params *GetAlternativeDistributionPackageQuery) (*AlternativeDistributionPackageResponse, *Response, error) {
+	return Get[AlternativeDistributionPackageResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s/alternativeDistributionPackage", id), params)
+}
+
+// ListVersionsForAlternativeDistributionPackage lists the versions of an alternative distribution package.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackages_id_versions
+func (s *PublishingService) ListVersionsForAlternativeDistributionPackage(ctx context.Context, id string, params *ListVersionsForAlternativeDistributionPackageQuery) (*AlternativeDistributionPackageVersionsResponse, *Response, error) {
+	return Get[AlternativeDistributionPackageVersionsResponse](ctx, s.client, fmt.Sprintf("alternativeDistributionPackages/%s/versions", id), params)
+}
+
+// GetAlternativeDistributionPackageVersion reads a version of an alternative distribution package, including
+// the URL its archive can be downloaded from until the URL expires.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackageversions_id
+func (s *PublishingService) GetAlternativeDistributionPackageVersion(ctx context.Context, id string, params *GetAlternativeDistributionPackageVersionQuery) (*AlternativeDistributionPackageVersionResponse, *Response, error) {
+	return Get[AlternativeDistributionPackageVersionResponse](ctx, s.client, fmt.Sprintf("alternativeDistributionPackageVersions/%s", id), params)
+}
+
+// ListVariantsForAlternativeDistributionPackageVersion lists the device-specific variants of an alternative distribution package version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackageversions_id_variants
+func (s *PublishingService) ListVariantsForAlternativeDistributionPackageVersion(ctx context.Context, id string, params *ListVariantsForAlternativeDistributionPackageVersionQuery) (*AlternativeDistributionPackageVariantsResponse, *Response, error) {
+	return Get[AlternativeDistributionPackageVariantsResponse](ctx, s.client, fmt.Sprintf("alternativeDistributionPackageVersions/%s/variants", id), params)
+}
+
+// ListDeltasForAlternativeDistributionPackageVersion lists the deltas that update earlier versions to an alternative distribution package version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackageversions_id_deltas
+func (s *PublishingService) ListDeltasForAlternativeDistributionPackageVersion(ctx context.Context, id string, params *ListDeltasForAlternativeDistributionPackageVersionQuery) (*AlternativeDistributionPackageDeltasResponse, *Response, error) {
+	return Get[AlternativeDistributionPackageDeltasResponse](ctx, s.client, fmt.Sprintf("alternativeDistributionPackageVersions/%s/deltas", id), params)
+}
+
+// GetAlternativeDistributionPackageVariant reads a variant of an alternative distribution package version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackagevariants_id
+func (s *PublishingService) GetAlternativeDistributionPackageVariant(ctx context.Context, id string, params *GetAlternativeDistributionPackageVariantQuery) (*AlternativeDistributionPackageVariantResponse, *Response, error) {
+	return Get[AlternativeDistributionPackageVariantResponse](ctx, s.client, fmt.Sprintf("alternativeDistributionPackageVariants/%s", id), params)
+}
+
+// GetAlternativeDistributionPackageDelta reads a delta of an alternative distribution package version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_alternativedistributionpackagedeltas_id
+func (s *PublishingService) GetAlternativeDistributionPackageDelta(ctx context.Context, id string, params *GetAlternativeDistributionPackageDeltaQuery) (*AlternativeDistributionPackageDeltaResponse, *Response, error) {
+	return Get[AlternativeDistributionPackageDeltaResponse](ctx, s.client, fmt.Sprintf("alternativeDistributionPackageDeltas/%s", id), params)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in AlternativeDistributionPackageVersionResponseIncluded.
+func (i *AlternativeDistributionPackageVersionResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// AlternativeDistributionPackage returns the AlternativeDistributionPackage stored within, if one is present.
+func (i *AlternativeDistributionPackageVersionResponseIncluded) AlternativeDistributionPackage() *AlternativeDistributionPackage {
+	return extractIncludedAlternativeDistributionPackage(i.inner)
+}
+
+// AlternativeDistributionPackageDelta returns the AlternativeDistributionPackageDelta stored within, if one is present.
+func (i *AlternativeDistributionPackageVersionResponseIncluded) AlternativeDistributionPackageDelta() *AlternativeDistributionPackageDelta {
+	return extractIncludedAlternativeDistributionPackageDelta(i.inner)
+}
+
+// AlternativeDistributionPackageVariant returns the AlternativeDistributionPackageVariant stored within, if one is present.
+func (i *AlternativeDistributionPackageVersionResponseIncluded) AlternativeDistributionPackageVariant() *AlternativeDistributionPackageVariant {
+	return extractIncludedAlternativeDistributionPackageVariant(i.inner)
+}
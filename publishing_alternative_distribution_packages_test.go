@@ -0,0 +1,142 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAlternativeDistributionPackage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AlternativeDistributionPackageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.CreateAlternativeDistributionPackage(ctx, "10")
+	})
+}
+
+func TestGetAlternativeDistributionPackage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AlternativeDistributionPackageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.GetAlternativeDistributionPackage(ctx, "10", &GetAlternativeDistributionPackageQuery{})
+	})
+}
+
+func TestGetAlternativeDistributionPackageForAppStoreVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AlternativeDistributionPackageResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.GetAlternativeDistributionPackageForAppStoreVersion(ctx, "10", &GetAlternativeDistributionPackageQuery{})
+	})
+}
+
+func TestListVersionsForAlternativeDistributionPackage(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AlternativeDistributionPackageVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.ListVersionsForAlternativeDistributionPackage(ctx, "10", &ListVersionsForAlternativeDistributionPackageQuery{
+			FilterState: []string{string(AlternativeDistributionPackageVersionStateCompleted)},
+		})
+	})
+}
+
+func TestGetAlternativeDistributionPackageVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AlternativeDistributionPackageVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.GetAlternativeDistributionPackageVersion(ctx, "10", &GetAlternativeDistributionPackageVersionQuery{})
+	})
+}
+
+func TestGetAlternativeDistributionPackageVersionIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"alternativeDistributionPackages"},{"type":"alternativeDistributionPackageDeltas"},{"type":"alternativeDistributionPackageVariants"}]}`, func(ctx context.Context, client *Client) {
+		version, _, err := client.Publishing.GetAlternativeDistributionPackageVersion(ctx, "10", &GetAlternativeDistributionPackageVersionQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, version.Included)
+
+		assert.NotNil(t, version.Included[0].AlternativeDistributionPackage())
+		assert.NotNil(t, version.Included[1].AlternativeDistributionPackageDelta())
+		assert.NotNil(t, version.Included[2].AlternativeDistributionPackageVariant())
+
+		assert.Nil(t, version.Included[0].AlternativeDistributionPackageDelta())
+		assert.Nil(t, version.Included[1].AlternativeDistributionPackageVariant())
+		assert.Nil(t, version.Included[2].AlternativeDistributionPackage())
+	})
+}
+
+func TestListVariantsForAlternativeDistributionPackageVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AlternativeDistributionPackageVariantsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.ListVariantsForAlternativeDistributionPackageVersion(ctx, "10", &ListVariantsForAlternativeDistributionPackageVersionQuery{})
+	})
+}
+
+func TestListDeltasForAlternativeDistributionPackageVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AlternativeDistributionPackageDeltasResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.ListDeltasForAlternativeDistributionPackageVersion(ctx, "10", &ListDeltasForAlternativeDistributionPackageVersionQuery{})
+	})
+}
+
+func TestGetAlternativeDistributionPackageVariant(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AlternativeDistributionPackageVariantResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.GetAlternativeDistributionPackageVariant(ctx, "10", &GetAlternativeDistributionPackageVariantQuery{})
+	})
+}
+
+func TestGetAlternativeDistributionPackageDelta(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AlternativeDistributionPackageDeltaResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.GetAlternativeDistributionPackageDelta(ctx, "10", &GetAlternativeDistributionPackageDeltaQuery{})
+	})
+}
+
+func TestParseChecksumAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseChecksumAlgorithm(string(ChecksumAlgorithmMD5))
+	assert.NoError(t, err)
+	assert.Equal(t, ChecksumAlgorithmMD5, got)
+
+	_, err = ParseChecksumAlgorithm("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "ChecksumAlgorithm", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAlternativeDistributionPackageVersionState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAlternativeDistributionPackageVersionState(string(AlternativeDistributionPackageVersionStateCompleted))
+	assert.NoError(t, err)
+	assert.Equal(t, AlternativeDistributionPackageVersionStateCompleted, got)
+
+	_, err = ParseAlternativeDistributionPackageVersionState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AlternativeDistributionPackageVersionState", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,458 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// NominationType defines model for Nomination.Attributes.Type
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nomination/attributes
+type NominationType string
+
+const (
+	// NominationTypeAppLaunch is a nomination for the launch of a new app.
+	NominationTypeAppLaunch NominationType = "APP_LAUNCH"
+	// NominationTypeAppEnhancements is a nomination for new features in an existing app.
+	NominationTypeAppEnhancements NominationType = "APP_ENHANCEMENTS"
+	// NominationTypeNewContent is a nomination for new content in an existing app.
+	NominationTypeNewContent NominationType = "NEW_CONTENT"
+)
+
+var nominationTypes = []NominationType{
+	NominationTypeAppLaunch,
+	NominationTypeAppEnhancements,
+	NominationTypeNewContent,
+}
+
+// IsValid reports whether the nomination type is one this package knows about.
+func (v NominationType) IsValid() bool {
+	for _, value := range nominationTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseNominationType returns s as a NominationType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseNominationType(s string) (NominationType, error) {
+	if v := NominationType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "NominationType", Value: s}
+}
+
+// NominationState defines model for Nomination.Attributes.State
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nomination/attributes
+type NominationState string
+
+const (
+	// NominationStateDraft is a nomination state for Draft.
+	NominationStateDraft NominationState = "DRAFT"
+	// NominationStateSubmitted is a nomination state for Submitted.
+	NominationStateSubmitted NominationState = "SUBMITTED"
+	// NominationStateArchived is a nomination state for Archived.
+	NominationStateArchived NominationState = "ARCHIVED"
+)
+
+var nominationStates = []NominationState{
+	NominationStateDraft,
+	NominationStateSubmitted,
+	NominationStateArchived,
+}
+
+// IsValid reports whether the nomination state is one this package knows about.
+func (v NominationState) IsValid() bool {
+	for _, value := range nominationStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseNominationState returns s as a NominationState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseNominationState(s string) (NominationState, error) {
+	if v := NominationState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "NominationState", Value: s}
+}
+
+// DeviceFamily defines model for DeviceFamily.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/devicefamily
+type DeviceFamily string
+
+const (
+	// DeviceFamilyIPhone is a device family for iPhone.
+	DeviceFamilyIPhone DeviceFamily = "IPHONE"
+	// DeviceFamilyIPad is a device family for iPad.
+	DeviceFamilyIPad DeviceFamily = "IPAD"
+	// DeviceFamilyAppleTV is a device family for Apple TV.
+	DeviceFamilyAppleTV DeviceFamily = "APPLE_TV"
+	// DeviceFamilyAppleWatch is a device family for Apple Watch.
+	DeviceFamilyAppleWatch DeviceFamily = "APPLE_WATCH"
+	// DeviceFamilyMac is a device family for Mac.
+	DeviceFamilyMac DeviceFamily = "MAC"
+	// DeviceFamilyVision is a device family for Apple Vision.
+	DeviceFamilyVision DeviceFamily = "VISION"
+)
+
+var deviceFamilies = []DeviceFamily{
+	DeviceFamilyIPhone,
+	DeviceFamilyIPad,
+	DeviceFamilyAppleTV,
+	DeviceFamilyAppleWatch,
+	DeviceFamilyMac,
+	DeviceFamilyVision,
+}
+
+// IsValid reports whether the device family is one this package knows about.
+func (v DeviceFamily) IsValid() bool {
+	for _, value := range deviceFamilies {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseDeviceFamily returns s as a DeviceFamily, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseDeviceFamily(s string) (DeviceFamily, error) {
+	if v := DeviceFamily(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "DeviceFamily", Value: s}
+}
+
+// Nomination defines model for Nomination.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nomination
+type Nomination struct {
+	Attributes    *NominationAttributes    `json:"attributes,omitempty"`
+	ID            string                   `json:"id"`
+	Links         ResourceLinks            `json:"links"`
+	Relationships *NominationRelationships `json:"relationships,omitempty"`
+	Type          string                   `json:"type"`
+}
+
+// NominationAttributes defines model for Nomination.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nomination/attributes
+type NominationAttributes struct {
+	CreatedDate                *DateTime        `json:"createdDate,omitempty"`
+	Description                *string          `json:"description,omitempty"`
+	DeviceFamilies             []DeviceFamily   `json:"deviceFamilies,omitempty"`
+	HasInAppEvents             *bool            `json:"hasInAppEvents,omitempty"`
+	LastModifiedDate           *DateTime        `json:"lastModifiedDate,omitempty"`
+	LaunchInSelectMarketsFirst *bool            `json:"launchInSelectMarketsFirst,omitempty"`
+	Locales                    []string         `json:"locales,omitempty"`
+	Name                       *string          `json:"name,omitempty"`
+	Notes                      *string          `json:"notes,omitempty"`
+	PreOrderEnabled            *bool            `json:"preOrderEnabled,omitempty"`
+	PublishEndDate             *DateTime        `json:"publishEndDate,omitempty"`
+	PublishStartDate           *DateTime        `json:"publishStartDate,omitempty"`
+	State                      *NominationState `json:"state,omitempty"`
+	SubmittedDate              *DateTime        `json:"submittedDate,omitempty"`
+	SupplementalMaterialsURIs  []string         `json:"supplementalMaterialsUris,omitempty"`
+	Type                       *NominationType  `json:"type,omitempty"`
+}
+
+// NominationRelationships defines model for Nomination.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nomination/relationships
+type NominationRelationships struct {
+	CreatedByActor       *Relationship      `json:"createdByActor,omitempty"`
+	InAppEvents          *PagedRelationship `json:"inAppEvents,omitempty"`
+	LastModifiedByActor  *Relationship      `json:"lastModifiedByActor,omitempty"`
+	RelatedApps          *PagedRelationship `json:"relatedApps,omitempty"`
+	SubmittedByActor     *Relationship      `json:"submittedByActor,omitempty"`
+	SupportedTerritories *PagedRelationship `json:"supportedTerritories,omitempty"`
+}
+
+// NominationResponse defines model for NominationResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nominationresponse
+type NominationResponse struct {
+	Data     Nomination                   `json:"data"`
+	Included []NominationResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                `json:"links"`
+}
+
+// NominationsResponse defines model for NominationsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nominationsresponse
+type NominationsResponse struct {
+	Data     []Nomination                 `json:"data"`
+	Included []NominationResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks           `json:"links"`
+	Meta     *PagingInformation           `json:"meta,omitempty"`
+}
+
+// NominationResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a NominationResponse or NominationsResponse.
+type NominationResponseIncluded included
+
+// nominationCreateRequest defines model for NominationCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nominationcreaterequest/data
+type nominationCreateRequest struct {
+	Attributes    NominationCreateRequestAttributes    `json:"attributes"`
+	Relationships nominationCreateRequestRelationships `json:"relationships"`
+	Type          string                               `json:"type"`
+}
+
+// NominationCreateRequestAttributes are attributes for NominationCreateRequest. Set Submitted to
+// create the nomination and submit it to Apple in a single request.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nominationcreaterequest/data/attributes
+type NominationCreateRequestAttributes struct {
+	Description                string         `json:"description"`
+	DeviceFamilies             []DeviceFamily `json:"deviceFamilies,omitempty"`
+	HasInAppEvents             *bool          `json:"hasInAppEvents,omitempty"`
+	LaunchInSelectMarketsFirst *bool          `json:"launchInSelectMarketsFirst,omitempty"`
+	Locales                    []string       `json:"locales,omitempty"`
+	Name                       string         `json:"name"`
+	Notes                      *string        `json:"notes,omitempty"`
+	PreOrderEnabled            *bool          `json:"preOrderEnabled,omitempty"`
+	PublishEndDate             *DateTime      `json:"publishEndDate,omitempty"`
+	PublishStartDate           DateTime       `json:"publishStartDate"`
+	Submitted                  bool           `json:"submitted"`
+	SupplementalMaterialsURIs  []string       `json:"supplementalMaterialsUris,omitempty"`
+	Type                       NominationType `json:"type"`
+}
+
+// nominationCreateRequestRelationships are relationships for NominationCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nominationcreaterequest/data/relationships
+type nominationCreateRequestRelationships struct {
+	InAppEvents          *pagedRelationshipDeclaration `json:"inAppEvents,omitempty"`
+	RelatedApps          pagedRelationshipDeclaration  `json:"relatedApps"`
+	SupportedTerritories *pagedRelationshipDeclaration `json:"supportedTerritories,omitempty"`
+}
+
+// NominationRelationshipIDs are the IDs of the resources related to a nomination. Leave a list empty
+// to omit it from a request; an update replaces every list that is set.
+type NominationRelationshipIDs struct {
+	AppIDs       []string
+	AppEventIDs  []string
+	TerritoryIDs []string
+}
+
+// nominationUpdateRequest defines model for NominationUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nominationupdaterequest/data
+type nominationUpdateRequest struct {
+	Attributes    *NominationUpdateRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                                `json:"id"`
+	Relationships *nominationUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                `json:"type"`
+}
+
+// NominationUpdateRequestAttributes are attributes for NominationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nominationupdaterequest/data/attributes
+type NominationUpdateRequestAttributes struct {
+	Archived                   *bool           `json:"archived,omitempty"`
+	Description                *string         `json:"description,omitempty"`
+	DeviceFamilies             []DeviceFamily  `json:"deviceFamilies,omitempty"`
+	HasInAppEvents             *bool           `json:"hasInAppEvents,omitempty"`
+	LaunchInSelectMarketsFirst *bool           `json:"launchInSelectMarketsFirst,omitempty"`
+	Locales                    []string        `json:"locales,omitempty"`
+	Name                       *string         `json:"name,omitempty"`
+	Notes                      *string         `json:"notes,omitempty"`
+	PreOrderEnabled            *bool           `json:"preOrderEnabled,omitempty"`
+	PublishEndDate             *DateTime       `json:"publishEndDate,omitempty"`
+	PublishStartDate           *DateTime       `json:"publishStartDate,omitempty"`
+	Submitted                  *bool           `json:"submitted,omitempty"`
+	SupplementalMaterialsURIs  []string        `json:"supplementalMaterialsUris,omitempty"`
+	Type                       *NominationType `json:"type,omitempty"`
+}
+
+// nominationUpdateRequestRelationships are relationships for NominationUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/nominationupdaterequest/data/relationships
+type nominationUpdateRequestRelationships struct {
+	InAppEvents          *pagedRelationshipDeclaration `json:"inAppEvents,omitempty"`
+	RelatedApps          *pagedRelationshipDeclaration `json:"relatedApps,omitempty"`
+	SupportedTerritories *pagedRelationshipDeclaration `json:"supportedTerritories,omitempty"`
+}
+
+// ListNominationsQuery are query options for ListNominations. FilterType and FilterState are required by the API.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_nominations
+type ListNominationsQuery struct {
+	FieldsNominations         []string `url:"fields[nominations],omitempty"`
+	FilterRelatedApps         []string `url:"filter[relatedApps],omitempty"`
+	FilterState               []string `url:"filter[state],omitempty"`
+	FilterType                []string `url:"filter[type],omitempty"`
+	Include                   []string `url:"include,omitempty"`
+	Limit                     int      `url:"limit,omitempty"`
+	LimitInAppEvents          int      `url:"limit[inAppEvents],omitempty"`
+	LimitRelatedApps          int      `url:"limit[relatedApps],omitempty"`
+	LimitSupportedTerritories int      `url:"limit[supportedTerritories],omitempty"`
+	Sort                      []string `url:"sort,omitempty"`
+	Cursor                    string   `url:"cursor,omitempty"`
+}
+
+// GetNominationQuery are query options for GetNomination
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_nominations_id
+type GetNominationQuery struct {
+	FieldsNominations         []string `url:"fields[nominations],omitempty"`
+	Include                   []string `url:"include,omitempty"`
+	LimitInAppEvents          int      `url:"limit[inAppEvents],omitempty"`
+	LimitRelatedApps          int      `url:"limit[relatedApps],omitempty"`
+	LimitSupportedTerritories int      `url:"limit[supportedTerritories],omitempty"`
+}
+
+// ListNominations lists the featuring nominations of your team.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_nominations
+func (s *PublishingService) ListNominations(ctx context.Context, params *ListNominationsQuery) (*NominationsResponse, *Response, error) {
+	return Get[NominationsResponse](ctx, s.client, "nominations", params)
+}
+
+// GetNomination reads a featuring nomination.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_nominations_id
+func (s *PublishingService) GetNomination(ctx context.Context, id string, params *GetNominationQuery) (*NominationResponse, *Response, error) {
+	return Get[NominationResponse](ctx, s.client, fmt.Sprintf("nominations/%s", id), params)
+}
+
+// CreateNomination creates a featuring nomination for one or more apps. At least one app ID is required.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_nominations
+func (s *PublishingService) CreateNomination(ctx context.Context, attributes NominationCreateRequestAttributes, relationships NominationRelationshipIDs) (*NominationResponse, *Response, error) {
+	req := nominationCreateRequest{
+		Attributes: attributes,
+		Relationships: nominationCreateRequestRelationships{
+			RelatedApps: newPagedRelationshipDeclaration(relationships.AppIDs, "apps"),
+		},
+		Type: "nominations",
+	}
+
+	if len(relationships.AppEventIDs) > 0 {
+		appEvents := newPagedRelationshipDeclaration(relationships.AppEventIDs, "appEvents")
+		req.Relationships.InAppEvents = &appEvents
+	}
+
+	if len(relationships.TerritoryIDs) > 0 {
+		territories := newPagedRelationshipDeclaration(relationships.TerritoryIDs, "territories")
+		req.Relationships.SupportedTerritories = &territories
+	}
+
+	res := new(NominationResponse)
+	resp, err := s.client.post(ctx, "nominations", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateNomination updates a featuring nomination. Related apps, in-app events and territories are only
+// replaced when relationships is not nil and the corresponding list is not empty.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_nominations_id
+func (s *PublishingService) UpdateNomination(ctx context.Context, id string, attributes *NominationUpdateRequestAttributes, relationships *NominationRelationshipIDs) (*NominationResponse, *Response, error) {
+	req := nominationUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "nominations",
+	}
+
+	if relationships != nil {
+		req.Relationships = &nominationUpdateRequestRelationships{}
+
+		if len(relationships.AppIDs) > 0 {
+			apps := newPagedRelationshipDeclaration(relationships.AppIDs, "apps")
+			req.Relationships.RelatedApps = &apps
+		}
+
+		if len(relationships.AppEventIDs) > 0 {
+			appEvents := newPagedRelationshipDeclaration(relationships.AppEventIDs, "appEvents")
+			req.Relationships.InAppEvents = &appEvents
+		}
+
+		if len(relationships.TerritoryIDs) > 0 {
+			territories := newPagedRelationshipDeclaration(relationships.TerritoryIDs, "territories")
+			req.Relationships.SupportedTerritories = &territories
+		}
+	}
+
+	url := fmt.Sprintf("nominations/%s", id)
+	res := new(NominationResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// SubmitNomination submits a draft featuring nomination to Apple.
+func (s *PublishingService) SubmitNomination(ctx context.Context, id string) (*NominationResponse, *Response, error) {
+	return s.UpdateNomination(ctx, id, &NominationUpdateRequestAttributes{Submitted: Bool(true)}, nil)
+}
+
+// ArchiveNomination archives a featuring nomination.
+func (s *PublishingService) ArchiveNomination(ctx context.Context, id string) (*NominationResponse, *Response, error) {
+	return s.UpdateNomination(ctx, id, &NominationUpdateRequestAttributes{Archived: Bool(true)}, nil)
+}
+
+// DeleteNomination deletes a featuring nomination that has not been submitted.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_v1_nominations_id
+func (s *PublishingService) DeleteNomination(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("nominations/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in NominationResponseIncluded.
+func (i *NominationResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *NominationResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// AppEvent returns the AppEvent stored within, if one is present.
+func (i *NominationResponseIncluded) AppEvent() *AppEvent {
+	return extractIncludedAppEvent(i.inner)
+}
+
+// Territory returns the Territory stored within, if one is present.
+func (i *NominationResponseIncluded) Territory() *Territory {
+	return extractIncludedTerritory(i.inner)
+}
@@ -0,0 +1,163 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListNominations(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &NominationsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.ListNominations(ctx, &ListNominationsQuery{
+			FilterState: []string{string(NominationStateDraft)},
+			FilterType:  []string{string(NominationTypeAppLaunch)},
+		})
+	})
+}
+
+func TestGetNomination(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &NominationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.GetNomination(ctx, "10", &GetNominationQuery{})
+	})
+}
+
+func TestGetNominationIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"appEvents"},{"type":"territories"}]}`, func(ctx context.Context, client *Client) {
+		nomination, _, err := client.Publishing.GetNomination(ctx, "10", &GetNominationQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, nomination.Included)
+
+		assert.NotNil(t, nomination.Included[0].App())
+		assert.NotNil(t, nomination.Included[1].AppEvent())
+		assert.NotNil(t, nomination.Included[2].Territory())
+
+		assert.Nil(t, nomination.Included[0].AppEvent())
+		assert.Nil(t, nomination.Included[1].Territory())
+		assert.Nil(t, nomination.Included[2].App())
+	})
+}
+
+func TestCreateNomination(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &NominationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.CreateNomination(ctx, NominationCreateRequestAttributes{
+			Description:      "A brand new puzzle game",
+			DeviceFamilies:   []DeviceFamily{DeviceFamilyIPhone, DeviceFamilyIPad},
+			Name:             "Launch",
+			PublishStartDate: DateTime{time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)},
+			Type:             NominationTypeAppLaunch,
+		}, NominationRelationshipIDs{
+			AppIDs:       []string{"1"},
+			TerritoryIDs: []string{"USA"},
+		})
+	})
+}
+
+func TestNominationCreateRequestOmitsEmptyRelationships(t *testing.T) {
+	t.Parallel()
+
+	b, err := json.Marshal(nominationCreateRequestRelationships{
+		RelatedApps: newPagedRelationshipDeclaration([]string{"1"}, "apps"),
+	})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"relatedApps":{"data":[{"id":"1","type":"apps"}]}}`, string(b))
+}
+
+func TestUpdateNomination(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &NominationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.UpdateNomination(ctx, "10", &NominationUpdateRequestAttributes{
+			Notes: String("Now with in-app events"),
+		}, &NominationRelationshipIDs{
+			AppEventIDs: []string{"20"},
+		})
+	})
+}
+
+func TestSubmitNomination(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &NominationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.SubmitNomination(ctx, "10")
+	})
+}
+
+func TestArchiveNomination(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &NominationResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.ArchiveNomination(ctx, "10")
+	})
+}
+
+func TestDeleteNomination(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Publishing.DeleteNomination(ctx, "10")
+	})
+}
+
+func TestParseNominationType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseNominationType(string(NominationTypeAppLaunch))
+	assert.NoError(t, err)
+	assert.Equal(t, NominationTypeAppLaunch, got)
+
+	_, err = ParseNominationType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "NominationType", Value: "UNKNOWN"}, err)
+}
+
+func TestParseNominationState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseNominationState(string(NominationStateDraft))
+	assert.NoError(t, err)
+	assert.Equal(t, NominationStateDraft, got)
+
+	_, err = ParseNominationState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "NominationState", Value: "UNKNOWN"}, err)
+}
+
+func TestParseDeviceFamily(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseDeviceFamily(string(DeviceFamilyIPhone))
+	assert.NoError(t, err)
+	assert.Equal(t, DeviceFamilyIPhone, got)
+
+	_, err = ParseDeviceFamily("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "DeviceFamily", Value: "UNKNOWN"}, err)
+}
@@ -41,6 +41,33 @@ const (
 	PhasedReleaseStateComplete PhasedReleaseState = "COMPLETE"
 )
 
+var phasedReleaseStates = []PhasedReleaseState{
+	PhasedReleaseStateInactive,
+	PhasedReleaseStateActive,
+	PhasedReleaseStatePaused,
+	PhasedReleaseStateComplete,
+}
+
+// IsValid reports whether the phased release state is one this package knows about.
+func (v PhasedReleaseState) IsValid() bool {
+	for _, value := range phasedReleaseStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParsePhasedReleaseState returns s as a PhasedReleaseState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParsePhasedReleaseState(s string) (PhasedReleaseState, error) {
+	if v := PhasedReleaseState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "PhasedReleaseState", Value: s}
+}
+
 // AppStoreVersionPhasedRelease defines model for AppStoreVersionPhasedRelease.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appstoreversionphasedrelease
@@ -178,9 +205,5 @@ func (s *PublishingService) DeletePhasedRelease(ctx context.Context, id string)
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_store_version_phased_release_information_of_an_app_store_version
 func (s *PublishingService) GetAppStoreVersionPhasedReleaseForAppStoreVersion(ctx context.Context, id string, params *GetAppStoreVersionPhasedReleaseForAppStoreVersionQuery) (*AppStoreVersionPhasedReleaseResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersions/%s/appStoreVersionPhasedRelease", id)
-	res := new(AppStoreVersionPhasedReleaseResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppStoreVersionPhasedReleaseResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s/appStoreVersionPhasedRelease", id), params)
 }
@@ -23,6 +23,8 @@ package asc
 import (
 	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCreatePhasedRelease(t *testing.T) {
@@ -60,3 +62,14 @@ func TestGetAppStoreVersionPhasedReleaseForAppStoreVersion(t *testing.T) {
 		return client.Publishing.GetAppStoreVersionPhasedReleaseForAppStoreVersion(ctx, "10", &GetAppStoreVersionPhasedReleaseForAppStoreVersionQuery{})
 	})
 }
+
+func TestParsePhasedReleaseState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParsePhasedReleaseState(string(PhasedReleaseStateInactive))
+	assert.NoError(t, err)
+	assert.Equal(t, PhasedReleaseStateInactive, got)
+
+	_, err = ParsePhasedReleaseState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "PhasedReleaseState", Value: "UNKNOWN"}, err)
+}
@@ -22,7 +22,9 @@ package asc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 )
 
 // AppPreOrder defines model for AppPreOrder.
@@ -115,22 +117,14 @@ type GetPreOrderForAppQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_pre-order_information
 func (s *PublishingService) GetPreOrder(ctx context.Context, id string, params *GetPreOrderQuery) (*AppPreOrderResponse, *Response, error) {
-	url := fmt.Sprintf("appPreOrders/%s", id)
-	res := new(AppPreOrderResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppPreOrderResponse](ctx, s.client, fmt.Sprintf("appPreOrders/%s", id), params)
 }
 
 // GetPreOrderForApp gets available date and release date of an app that is available for pre-order.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_pre-order_information_of_an_app
 func (s *PublishingService) GetPreOrderForApp(ctx context.Context, id string, params *GetPreOrderForAppQuery) (*AppPreOrderResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/preOrder", id)
-	res := new(AppPreOrderResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppPreOrderResponse](ctx, s.client, fmt.Sprintf("apps/%s/preOrder", id), params)
 }
 
 // CreatePreOrder turns on pre-order and set the expected app release date.
@@ -191,3 +185,21 @@ func (s *PublishingService) DeletePreOrder(ctx context.Context, id string) (*Res
 
 	return s.client.delete(ctx, url, nil)
 }
+
+// SchedulePreOrder sets the expected release date of an app that is available for pre-order. If the app
+// does not have a pre-order yet, one is created, otherwise the existing pre-order is updated.
+//
+// To control whether the app is made available in new territories when pre-order begins, see UpdateApp.
+func (s *PublishingService) SchedulePreOrder(ctx context.Context, appID string, appReleaseDate *Date) (*AppPreOrderResponse, *Response, error) {
+	existing, resp, err := s.GetPreOrderForApp(ctx, appID, nil)
+	if err != nil {
+		var erro *ErrorResponse
+		if !errors.As(err, &erro) || erro.Response == nil || erro.Response.StatusCode != http.StatusNotFound {
+			return nil, resp, err
+		}
+
+		return s.CreatePreOrder(ctx, appReleaseDate, appID)
+	}
+
+	return s.UpdatePreOrder(ctx, existing.Data.ID, appReleaseDate)
+}
@@ -22,8 +22,14 @@ package asc
 
 import (
 	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestGetPreOrder(t *testing.T) {
@@ -65,3 +71,51 @@ func TestDeletePreOrder(t *testing.T) {
 		return client.Publishing.DeletePreOrder(ctx, "10")
 	})
 }
+
+func TestSchedulePreOrder(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, `{"data":{"id":"10","type":"appPreOrders"}}`, &AppPreOrderResponse{Data: AppPreOrder{ID: "10", Type: "appPreOrders"}}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Publishing.SchedulePreOrder(ctx, "10", &Date{time.Now()})
+	})
+}
+
+func TestSchedulePreOrderCreatesMissing(t *testing.T) {
+	t.Parallel()
+
+	var methods []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"errors":[{"status":"404"}]}`)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintln(w, `{"data":{"id":"11","type":"appPreOrders"}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	preOrder, _, err := client.Publishing.SchedulePreOrder(context.Background(), "10", &Date{time.Now()})
+	assert.NoError(t, err)
+	assert.Equal(t, "11", preOrder.Data.ID)
+	assert.Equal(t, []string{http.MethodGet, http.MethodPost}, methods)
+}
+
+func TestSchedulePreOrderError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"status":"500"}]}`, http.StatusInternalServerError, false)
+	defer server.Close()
+
+	preOrder, _, err := client.Publishing.SchedulePreOrder(context.Background(), "10", &Date{time.Now()})
+	assert.Error(t, err)
+	assert.Nil(t, preOrder)
+}
@@ -0,0 +1,301 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// ReferenceCache keeps reference data that rarely changes, such as territories and price points, in memory, so
+// that lookups are instant once each dataset has been fetched. Call Run, or Refresh on your own schedule, to keep
+// the data fresh: refreshes of a dataset that fits in a single page send a conditional GET with the ETag or
+// Last-Modified of the previous response, so an unchanged dataset costs a single request. The validators of a page
+// only cover that page, so datasets of several pages, and those whose responses carry neither validator, are
+// fetched in full. It is safe for concurrent use.
+type ReferenceCache struct {
+	client *Client
+
+	mu      sync.Mutex
+	entries map[string]*referenceEntry
+}
+
+// referenceFetch fetches every page of a dataset, sending the conditions with the request for the first page,
+// and returns the dataset and the response whose validators cover it, which is nil for a dataset of several pages.
+type referenceFetch func(ctx context.Context, conditions []requestOption) (interface{}, *Response, error)
+
+// referenceEntry is a cached dataset. Its mutex is held while it is fetched, so concurrent lookups of a dataset
+// that is not cached yet wait for a single fetch.
+type referenceEntry struct {
+	mu           sync.Mutex
+	fetch        referenceFetch
+	value        interface{}
+	etag         string
+	lastModified string
+}
+
+// NewReferenceCache returns an empty cache of reference data fetched with the client.
+func (c *Client) NewReferenceCache() *ReferenceCache {
+	return &ReferenceCache{client: c, entries: make(map[string]*referenceEntry)}
+}
+
+// Territories returns every territory where the App Store operates, fetching them on first use. The returned
+// slice is shared and must not be modified.
+func (r *ReferenceCache) Territories(ctx context.Context) ([]Territory, error) {
+	value, err := r.lookup(ctx, "territories", func(ctx context.Context, conditions []requestOption) (interface{}, *Response, error) {
+		territories := make([]Territory, 0)
+		params := &ListTerritoriesQuery{Limit: MaxPageLimit}
+
+		resp, err := r.fetchPages(ctx, conditions, func(ctx context.Context, cursor string, options []requestOption) (*PagedDocumentLinks, *Response, error) {
+			params.Cursor = cursor
+			res := new(TerritoriesResponse)
+			resp, err := r.client.get(ctx, "territories", params, res, options...)
+			territories = append(territories, res.Data...)
+
+			return &res.Links, resp, err
+		})
+
+		return territories, resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	territories, _ := value.([]Territory)
+
+	return territories, nil
+}
+
+// PricePoints returns the price points of an app in a territory, such as "USA", fetching them on first use. The
+// returned slice is shared and must not be modified.
+func (r *ReferenceCache) PricePoints(ctx context.Context, appID string, territory string) ([]AppPricePoint, error) {
+	key := fmt.Sprintf("pricePoints/%s/%s", appID, territory)
+
+	value, err := r.lookup(ctx, key, func(ctx context.Context, conditions []requestOption) (interface{}, *Response, error) {
+		points := make([]AppPricePoint, 0)
+		url := fmt.Sprintf("apps/%s/appPricePoints", appID)
+		params := &ListPricePointsForAppQuery{
+			FieldsAppPricePoints: []string{"customerPrice", "proceeds", "territory"},
+			FilterTerritory:      []string{territory},
+			Limit:                MaxPageLimit,
+		}
+
+		resp, err := r.fetchPages(ctx, conditions, func(ctx context.Context, cursor string, options []requestOption) (*PagedDocumentLinks, *Response, error) {
+			params.Cursor = cursor
+			res := new(AppPricePointsResponse)
+			resp, err := r.client.get(ctx, url, params, res, options...)
+			points = append(points, res.Data...)
+
+			return &res.Links, resp, err
+		})
+
+		return points, resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	points, _ := value.([]AppPricePoint)
+
+	return points, nil
+}
+
+// CapabilitySettings returns the settings of the capabilities enabled for a bundle ID, by capability type,
+// fetching them on first use. App Store Connect doesn't publish a schema of capability options, but the settings
+// of a capability list the keys, options and allowed instances it accepts, so the capabilities of a reference
+// bundle ID serve as one. The returned map is shared and must not be modified.
+func (r *ReferenceCache) CapabilitySettings(ctx context.Context, bundleIDID string) (map[CapabilityType][]CapabilitySetting, error) {
+	key := fmt.Sprintf("capabilities/%s", bundleIDID)
+
+	value, err := r.lookup(ctx, key, func(ctx context.Context, conditions []requestOption) (interface{}, *Response, error) {
+		settings := make(map[CapabilityType][]CapabilitySetting)
+		url := fmt.Sprintf("bundleIds/%s/bundleIdCapabilities", bundleIDID)
+		params := &ListCapabilitiesForBundleIDQuery{Limit: MaxPageLimit}
+
+		resp, err := r.fetchPages(ctx, conditions, func(ctx context.Context, cursor string, options []requestOption) (*PagedDocumentLinks, *Response, error) {
+			params.Cursor = cursor
+			res := new(BundleIDCapabilitiesResponse)
+			resp, err := r.client.get(ctx, url, params, res, options...)
+
+			for _, capability := range res.Data {
+				if capability.Attributes != nil && capability.Attributes.CapabilityType != nil {
+					settings[*capability.Attributes.CapabilityType] = capability.Attributes.Settings
+				}
+			}
+
+			return &res.Links, resp, err
+		})
+
+		return settings, resp, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	settings, _ := value.(map[CapabilityType][]CapabilitySetting)
+
+	return settings, nil
+}
+
+// Refresh revalidates every cached dataset with a conditional GET, and fetches those that changed again. A
+// dataset that fails to refresh keeps its previous data. The first error is returned after trying every dataset.
+func (r *ReferenceCache) Refresh(ctx context.Context) error {
+	r.mu.Lock()
+	entries := make([]*referenceEntry, 0, len(r.entries))
+
+	for _, entry := range r.entries {
+		entries = append(entries, entry)
+	}
+	r.mu.Unlock()
+
+	var firstErr error
+
+	for _, entry := range entries {
+		entry.mu.Lock()
+		err := r.refresh(ctx, entry)
+		entry.mu.Unlock()
+
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// Run calls Refresh every interval until ctx is done, passing its errors to onError if it isn't nil, and returns
+// the error of ctx.
+func (r *ReferenceCache) Run(ctx context.Context, interval time.Duration, onError func(error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if err := r.Refresh(ctx); err != nil && onError != nil && ctx.Err() == nil {
+				onError(err)
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// lookup returns the cached value of key, fetching it first if it isn't cached.
+func (r *ReferenceCache) lookup(ctx context.Context, key string, fetch referenceFetch) (interface{}, error) {
+	r.mu.Lock()
+
+	entry, ok := r.entries[key]
+	if !ok {
+		entry = &referenceEntry{fetch: fetch}
+		r.entries[key] = entry
+	}
+	r.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.value == nil {
+		if err := r.refresh(ctx, entry); err != nil {
+			return nil, err
+		}
+	}
+
+	return entry.value, nil
+}
+
+// refresh fetches the dataset of entry, conditionally if it has been fetched before. The caller holds entry.mu.
+func (r *ReferenceCache) refresh(ctx context.Context, entry *referenceEntry) error {
+	var conditions []requestOption
+
+	if entry.value != nil && entry.etag != "" {
+		conditions = append(conditions, withHeader("If-None-Match", entry.etag))
+	}
+
+	if entry.value != nil && entry.lastModified != "" {
+		conditions = append(conditions, withHeader("If-Modified-Since", entry.lastModified))
+	}
+
+	value, resp, err := entry.fetch(ctx, conditions)
+	if isNotModified(err) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	entry.value = value
+	entry.etag, entry.lastModified = "", ""
+
+	if resp != nil {
+		entry.etag = resp.Header.Get("ETag")
+		entry.lastModified = resp.Header.Get("Last-Modified")
+	}
+
+	return nil
+}
+
+// fetchPages calls fetch for each page of a list, with the conditions for the first page only. It returns the
+// response of the first page if the list has no other pages, as its validators then cover the whole list, and
+// nil otherwise.
+func (r *ReferenceCache) fetchPages(ctx context.Context, conditions []requestOption, fetch func(ctx context.Context, cursor string, options []requestOption) (*PagedDocumentLinks, *Response, error)) (*Response, error) {
+	var (
+		first *Response
+		pages int
+	)
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		options := conditions
+		if cursor != "" {
+			options = nil
+		}
+
+		links, resp, err := fetch(ctx, cursor, options)
+		if cursor == "" {
+			first = resp
+		}
+
+		pages++
+
+		if err != nil {
+			return nil, err
+		}
+
+		return links, nil
+	})
+	if err != nil || pages > 1 {
+		return nil, err
+	}
+
+	return first, nil
+}
+
+// isNotModified reports whether err is the 304 Not Modified response to a conditional GET.
+func isNotModified(err error) bool {
+	var errResponse *ErrorResponse
+
+	return errors.As(err, &errResponse) && errResponse.Response != nil && errResponse.Response.StatusCode == http.StatusNotModified
+}
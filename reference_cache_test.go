@@ -0,0 +1,234 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// referenceServer serves two pages of territories with an ETag of the first page, which only the second page
+// changes with the version, and a single page of price points with a Last-Modified date, recording the requests
+// it receives.
+type referenceServer struct {
+	mu       sync.Mutex
+	version  int
+	fail     bool
+	requests []string
+}
+
+func (s *referenceServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, fmt.Sprintf("%s?cursor=%s if-none-match=%s if-modified-since=%s", r.URL.Path, r.URL.Query().Get("cursor"), r.Header.Get("If-None-Match"), r.Header.Get("If-Modified-Since")))
+
+	if s.fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+
+		return
+	}
+
+	switch r.URL.Path {
+	case "/territories":
+		etag := `"v0"`
+		if r.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		if r.URL.Query().Get("cursor") == "" {
+			w.Header().Set("ETag", etag)
+			fmt.Fprintf(w, `{"data":[{"type":"territories","id":"USA"}],"links":{"self":"","next":"http://%s/territories?cursor=2"}}`, r.Host)
+
+			return
+		}
+
+		if s.version > 0 {
+			fmt.Fprint(w, `{"data":[{"type":"territories","id":"GBR"},{"type":"territories","id":"FRA"}],"links":{"self":""}}`)
+		} else {
+			fmt.Fprint(w, `{"data":[{"type":"territories","id":"GBR"}],"links":{"self":""}}`)
+		}
+	case "/apps/APP/appPricePoints":
+		if r.Header.Get("If-Modified-Since") != "" {
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		w.Header().Set("Last-Modified", "Wed, 14 Oct 2026 07:28:00 GMT")
+		fmt.Fprint(w, `{"data":[{"type":"appPricePoints","id":"P1","attributes":{"customerPrice":"0.99"}}],"links":{"self":""}}`)
+	case "/bundleIds/B1/bundleIdCapabilities":
+		fmt.Fprint(w, `{"data":[{"type":"bundleIdCapabilities","id":"C1","attributes":{"capabilityType":"ICLOUD","settings":[{"key":"ICLOUD_VERSION","options":[{"key":"XCODE_6"}]}]}}],"links":{"self":""}}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (s *referenceServer) requested() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	requests := s.requests
+	s.requests = nil
+
+	return requests
+}
+
+func newReferenceServer(t *testing.T) (*ReferenceCache, *referenceServer) {
+	t.Helper()
+
+	reference := &referenceServer{}
+	server := httptest.NewServer(reference)
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client.NewReferenceCache(), reference
+}
+
+func territoryIDs(territories []Territory) []string {
+	ids := make([]string, len(territories))
+	for i, territory := range territories {
+		ids[i] = territory.ID
+	}
+
+	return ids
+}
+
+func TestReferenceCacheTerritories(t *testing.T) {
+	t.Parallel()
+
+	cache, server := newReferenceServer(t)
+	ctx := context.Background()
+
+	territories, err := cache.Territories(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"USA", "GBR"}, territoryIDs(territories))
+	assert.Equal(t, []string{
+		"/territories?cursor= if-none-match= if-modified-since=",
+		"/territories?cursor=2 if-none-match= if-modified-since=",
+	}, server.requested())
+
+	territories, err = cache.Territories(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, territories, 2)
+	assert.Empty(t, server.requested(), "lookups after warm-up are served from memory")
+
+	server.mu.Lock()
+	server.version = 1
+	server.mu.Unlock()
+
+	assert.NoError(t, cache.Refresh(ctx))
+	assert.Equal(t, []string{
+		"/territories?cursor= if-none-match= if-modified-since=",
+		"/territories?cursor=2 if-none-match= if-modified-since=",
+	}, server.requested(), "the ETag of the first page doesn't cover the others, so they are fetched in full")
+
+	territories, err = cache.Territories(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"USA", "GBR", "FRA"}, territoryIDs(territories))
+
+	server.mu.Lock()
+	server.fail = true
+	server.mu.Unlock()
+
+	err = cache.Refresh(ctx)
+
+	var errResponse *ErrorResponse
+	assert.True(t, errors.As(err, &errResponse))
+
+	territories, err = cache.Territories(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, territories, 3, "a failed refresh keeps the previous data")
+}
+
+func TestReferenceCachePricePoints(t *testing.T) {
+	t.Parallel()
+
+	cache, server := newReferenceServer(t)
+	ctx := context.Background()
+
+	points, err := cache.PricePoints(ctx, "APP", "USA")
+	assert.NoError(t, err)
+	assert.Len(t, points, 1)
+	assert.Equal(t, "0.99", *points[0].Attributes.CustomerPrice)
+	assert.Equal(t, []string{"/apps/APP/appPricePoints?cursor= if-none-match= if-modified-since="}, server.requested())
+
+	assert.NoError(t, cache.Refresh(ctx))
+	assert.Equal(t, []string{"/apps/APP/appPricePoints?cursor= if-none-match= if-modified-since=Wed, 14 Oct 2026 07:28:00 GMT"}, server.requested())
+
+	points, err = cache.PricePoints(ctx, "APP", "USA")
+	assert.NoError(t, err)
+	assert.Len(t, points, 1)
+
+	_, err = cache.PricePoints(ctx, "OTHER", "USA")
+	assert.Error(t, err)
+}
+
+func TestReferenceCacheCapabilitySettings(t *testing.T) {
+	t.Parallel()
+
+	cache, server := newReferenceServer(t)
+
+	settings, err := cache.CapabilitySettings(context.Background(), "B1")
+	assert.NoError(t, err)
+	assert.Len(t, settings[CapabilityTypeiCloud], 1)
+	assert.Equal(t, "XCODE_6", *settings[CapabilityTypeiCloud][0].Options[0].Key)
+
+	assert.NoError(t, cache.Refresh(context.Background()))
+	assert.Len(t, server.requested(), 2, "datasets without validators are fetched in full")
+}
+
+func TestReferenceCacheRun(t *testing.T) {
+	t.Parallel()
+
+	cache, server := newReferenceServer(t)
+
+	_, err := cache.Territories(context.Background())
+	assert.NoError(t, err)
+
+	server.mu.Lock()
+	server.fail = true
+	server.mu.Unlock()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error, 1)
+
+	go func() {
+		errs <- cache.Run(ctx, time.Millisecond, func(err error) {
+			cancel()
+		})
+	}()
+
+	assert.ErrorIs(t, <-errs, context.Canceled)
+}
@@ -0,0 +1,266 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"reflect"
+)
+
+// AnalyticsReportName is the name of an analytics report, as returned in AnalyticsReport.Attributes.Name.
+type AnalyticsReportName string
+
+const (
+	// AnalyticsReportNameAppStoreDiscoveryAndEngagementStandard counts impressions and page views of the App Store
+	// page of an app.
+	AnalyticsReportNameAppStoreDiscoveryAndEngagementStandard AnalyticsReportName = "App Store Discovery and Engagement Standard"
+	// AnalyticsReportNameAppStoreDiscoveryAndEngagementDetailed counts impressions and page views of the App Store
+	// page of an app, with the source of each.
+	AnalyticsReportNameAppStoreDiscoveryAndEngagementDetailed AnalyticsReportName = "App Store Discovery and Engagement Detailed"
+	// AnalyticsReportNameAppDownloadsStandard counts first-time downloads, redownloads and updates of an app.
+	AnalyticsReportNameAppDownloadsStandard AnalyticsReportName = "App Downloads Standard"
+	// AnalyticsReportNameAppDownloadsDetailed counts first-time downloads, redownloads and updates of an app, with
+	// the source of each.
+	AnalyticsReportNameAppDownloadsDetailed AnalyticsReportName = "App Downloads Detailed"
+	// AnalyticsReportNameAppStorePurchasesStandard counts purchases and proceeds of an app and its in-app purchases.
+	AnalyticsReportNameAppStorePurchasesStandard AnalyticsReportName = "App Store Purchases Standard"
+	// AnalyticsReportNameAppStorePurchasesDetailed counts purchases and proceeds of an app and its in-app
+	// purchases, with the source of each.
+	AnalyticsReportNameAppStorePurchasesDetailed AnalyticsReportName = "App Store Purchases Detailed"
+	// AnalyticsReportNameAppSessionsStandard counts sessions of an app and their duration.
+	AnalyticsReportNameAppSessionsStandard AnalyticsReportName = "App Sessions Standard"
+	// AnalyticsReportNameAppSessionsDetailed counts sessions of an app and their duration, with the source of the
+	// download of the app.
+	AnalyticsReportNameAppSessionsDetailed AnalyticsReportName = "App Sessions Detailed"
+	// AnalyticsReportNameAppInstallationAndDeletionStandard counts installations and deletions of an app.
+	AnalyticsReportNameAppInstallationAndDeletionStandard AnalyticsReportName = "App Store Installation and Deletion Standard"
+	// AnalyticsReportNameAppInstallationAndDeletionDetailed counts installations and deletions of an app, with the
+	// source of the download of the app.
+	AnalyticsReportNameAppInstallationAndDeletionDetailed AnalyticsReportName = "App Store Installation and Deletion Detailed"
+	// AnalyticsReportNameAppCrashes counts crashes of an app by version and device.
+	AnalyticsReportNameAppCrashes AnalyticsReportName = "App Crashes"
+)
+
+var analyticsReportNames = []AnalyticsReportName{
+	AnalyticsReportNameAppStoreDiscoveryAndEngagementStandard,
+	AnalyticsReportNameAppStoreDiscoveryAndEngagementDetailed,
+	AnalyticsReportNameAppDownloadsStandard,
+	AnalyticsReportNameAppDownloadsDetailed,
+	AnalyticsReportNameAppStorePurchasesStandard,
+	AnalyticsReportNameAppStorePurchasesDetailed,
+	AnalyticsReportNameAppSessionsStandard,
+	AnalyticsReportNameAppSessionsDetailed,
+	AnalyticsReportNameAppInstallationAndDeletionStandard,
+	AnalyticsReportNameAppInstallationAndDeletionDetailed,
+	AnalyticsReportNameAppCrashes,
+}
+
+// IsValid reports whether the analytics report name is one this package knows about.
+func (v AnalyticsReportName) IsValid() bool {
+	for _, value := range analyticsReportNames {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAnalyticsReportName returns s as an AnalyticsReportName, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAnalyticsReportName(s string) (AnalyticsReportName, error) {
+	if v := AnalyticsReportName(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AnalyticsReportName", Value: s}
+}
+
+// AnalyticsReportSpec describes an analytics report: its category and the struct its segments are decoded into.
+type AnalyticsReportSpec struct {
+	Name     AnalyticsReportName
+	Category AnalyticsReportCategory
+	// Row is the zero value of the struct a row of the report's segments is decoded into, such as
+	// AppDownloadsReportRow{}. It can be passed to NewReportStream.
+	Row interface{}
+}
+
+// NewRows returns a pointer to an empty slice of the report's row type, ready for ParseReport.
+func (s AnalyticsReportSpec) NewRows() interface{} {
+	return reflect.New(reflect.SliceOf(reflect.TypeOf(s.Row))).Interface()
+}
+
+// AnalyticsReportSpecs lists the analytics reports that have a typed row.
+//
+// https://developer.apple.com/documentation/analytics-reports
+var AnalyticsReportSpecs = []AnalyticsReportSpec{
+	{AnalyticsReportNameAppStoreDiscoveryAndEngagementStandard, AnalyticsReportCategoryAppStoreEngagement, AppStoreDiscoveryAndEngagementReportRow{}},
+	{AnalyticsReportNameAppStoreDiscoveryAndEngagementDetailed, AnalyticsReportCategoryAppStoreEngagement, AppStoreDiscoveryAndEngagementReportRow{}},
+	{AnalyticsReportNameAppDownloadsStandard, AnalyticsReportCategoryAppStoreCommerce, AppDownloadsReportRow{}},
+	{AnalyticsReportNameAppDownloadsDetailed, AnalyticsReportCategoryAppStoreCommerce, AppDownloadsReportRow{}},
+	{AnalyticsReportNameAppStorePurchasesStandard, AnalyticsReportCategoryAppStoreCommerce, AppStorePurchasesReportRow{}},
+	{AnalyticsReportNameAppStorePurchasesDetailed, AnalyticsReportCategoryAppStoreCommerce, AppStorePurchasesReportRow{}},
+	{AnalyticsReportNameAppSessionsStandard, AnalyticsReportCategoryAppUsage, AppSessionsReportRow{}},
+	{AnalyticsReportNameAppSessionsDetailed, AnalyticsReportCategoryAppUsage, AppSessionsReportRow{}},
+	{AnalyticsReportNameAppInstallationAndDeletionStandard, AnalyticsReportCategoryAppUsage, AppInstallationAndDeletionReportRow{}},
+	{AnalyticsReportNameAppInstallationAndDeletionDetailed, AnalyticsReportCategoryAppUsage, AppInstallationAndDeletionReportRow{}},
+	{AnalyticsReportNameAppCrashes, AnalyticsReportCategoryAppUsage, AppCrashesReportRow{}},
+}
+
+// LookupAnalyticsReportSpec returns the spec of the analytics report named name, or false if it has no typed row.
+// Segments of such reports can still be decoded into a struct tagged with their columns.
+func LookupAnalyticsReportSpec(name AnalyticsReportName) (AnalyticsReportSpec, bool) {
+	for _, spec := range AnalyticsReportSpecs {
+		if spec.Name == name {
+			return spec, true
+		}
+	}
+
+	return AnalyticsReportSpec{}, false
+}
+
+// Spec returns the spec of the report, or false if it has no typed row.
+func (r *AnalyticsReport) Spec() (AnalyticsReportSpec, bool) {
+	if r.Attributes == nil || r.Attributes.Name == nil {
+		return AnalyticsReportSpec{}, false
+	}
+
+	return LookupAnalyticsReportSpec(AnalyticsReportName(*r.Attributes.Name))
+}
+
+// AppStoreDiscoveryAndEngagementReportRow is a row of an App Store Discovery and Engagement report. Columns
+// describing the source of the engagement, such as SourceInfo, are only filled in detailed reports.
+type AppStoreDiscoveryAndEngagementReportRow struct {
+	Date            Date   `tsv:"Date"`
+	AppName         string `tsv:"App Name"`
+	AppAppleID      string `tsv:"App Apple Identifier"`
+	Event           string `tsv:"Event"`
+	PageType        string `tsv:"Page Type"`
+	SourceType      string `tsv:"Source Type"`
+	EngagementType  string `tsv:"Engagement Type"`
+	Device          string `tsv:"Device"`
+	PlatformVersion string `tsv:"Platform Version"`
+	Territory       string `tsv:"Territory"`
+	SourceInfo      string `tsv:"Source Info"`
+	Campaign        string `tsv:"Campaign"`
+	PageTitle       string `tsv:"Page Title"`
+	Counts          int    `tsv:"Counts"`
+	UniqueCounts    int    `tsv:"Unique Counts"`
+}
+
+// AppDownloadsReportRow is a row of an App Downloads report. Columns describing the source of the download, such as
+// SourceInfo, are only filled in detailed reports.
+type AppDownloadsReportRow struct {
+	Date            Date   `tsv:"Date"`
+	AppName         string `tsv:"App Name"`
+	AppAppleID      string `tsv:"App Apple Identifier"`
+	DownloadType    string `tsv:"Download Type"`
+	AppVersion      string `tsv:"App Version"`
+	Device          string `tsv:"Device"`
+	PlatformVersion string `tsv:"Platform Version"`
+	SourceType      string `tsv:"Source Type"`
+	SourceInfo      string `tsv:"Source Info"`
+	Campaign        string `tsv:"Campaign"`
+	PageType        string `tsv:"Page Type"`
+	PageTitle       string `tsv:"Page Title"`
+	PreOrder        string `tsv:"Pre-Order"`
+	Territory       string `tsv:"Territory"`
+	Counts          int    `tsv:"Counts"`
+}
+
+// AppStorePurchasesReportRow is a row of an App Store Purchases report. Amounts are in US dollars.
+type AppStorePurchasesReportRow struct {
+	Date                   Date    `tsv:"Date"`
+	AppName                string  `tsv:"App Name"`
+	AppAppleID             string  `tsv:"App Apple Identifier"`
+	PurchaseType           string  `tsv:"Purchase Type"`
+	ContentName            string  `tsv:"Content Name"`
+	ContentAppleIdentifier string  `tsv:"Content Apple Identifier"`
+	PaymentMethod          string  `tsv:"Payment Method"`
+	Device                 string  `tsv:"Device"`
+	PlatformVersion        string  `tsv:"Platform Version"`
+	SourceType             string  `tsv:"Source Type"`
+	SourceInfo             string  `tsv:"Source Info"`
+	Campaign               string  `tsv:"Campaign"`
+	PageType               string  `tsv:"Page Type"`
+	PageTitle              string  `tsv:"Page Title"`
+	PreOrder               string  `tsv:"Pre-Order"`
+	Territory              string  `tsv:"Territory"`
+	Purchases              int     `tsv:"Purchases"`
+	ProceedsInUSD          float64 `tsv:"Proceeds In USD"`
+	SalesInUSD             float64 `tsv:"Sales In USD"`
+	PayingUsers            int     `tsv:"Paying Users"`
+}
+
+// AppSessionsReportRow is a row of an App Sessions report. Columns describing the source of the download of the
+// app, such as SourceInfo, are only filled in detailed reports.
+type AppSessionsReportRow struct {
+	Date                 Date    `tsv:"Date"`
+	AppName              string  `tsv:"App Name"`
+	AppAppleID           string  `tsv:"App Apple Identifier"`
+	AppVersion           string  `tsv:"App Version"`
+	Device               string  `tsv:"Device"`
+	PlatformVersion      string  `tsv:"Platform Version"`
+	SourceType           string  `tsv:"Source Type"`
+	SourceInfo           string  `tsv:"Source Info"`
+	Campaign             string  `tsv:"Campaign"`
+	PageType             string  `tsv:"Page Type"`
+	PageTitle            string  `tsv:"Page Title"`
+	AppDownloadDate      Date    `tsv:"App Download Date"`
+	Territory            string  `tsv:"Territory"`
+	Sessions             int     `tsv:"Sessions"`
+	TotalSessionDuration float64 `tsv:"Total Session Duration"`
+	UniqueDevices        int     `tsv:"Unique Devices"`
+}
+
+// AppInstallationAndDeletionReportRow is a row of an App Store Installation and Deletion report. Columns
+// describing the source of the download of the app, such as SourceInfo, are only filled in detailed reports.
+type AppInstallationAndDeletionReportRow struct {
+	Date            Date   `tsv:"Date"`
+	AppName         string `tsv:"App Name"`
+	AppAppleID      string `tsv:"App Apple Identifier"`
+	Event           string `tsv:"Event"`
+	DownloadType    string `tsv:"Download Type"`
+	AppVersion      string `tsv:"App Version"`
+	Device          string `tsv:"Device"`
+	PlatformVersion string `tsv:"Platform Version"`
+	SourceType      string `tsv:"Source Type"`
+	SourceInfo      string `tsv:"Source Info"`
+	Campaign        string `tsv:"Campaign"`
+	PageType        string `tsv:"Page Type"`
+	PageTitle       string `tsv:"Page Title"`
+	AppDownloadDate Date   `tsv:"App Download Date"`
+	Territory       string `tsv:"Territory"`
+	Counts          int    `tsv:"Counts"`
+	UniqueDevices   int    `tsv:"Unique Devices"`
+}
+
+// AppCrashesReportRow is a row of an App Crashes report.
+type AppCrashesReportRow struct {
+	Date            Date   `tsv:"Date"`
+	AppName         string `tsv:"App Name"`
+	AppAppleID      string `tsv:"App Apple Identifier"`
+	AppVersion      string `tsv:"App Version"`
+	Build           string `tsv:"Build"`
+	Device          string `tsv:"Device"`
+	Platform        string `tsv:"Platform"`
+	PlatformVersion string `tsv:"Platform Version"`
+	Crashes         int    `tsv:"Crashes"`
+	UniqueDevices   int    `tsv:"Unique Devices"`
+}
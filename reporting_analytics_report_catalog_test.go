@@ -0,0 +1,91 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupAnalyticsReportSpec(t *testing.T) {
+	t.Parallel()
+
+	spec, ok := LookupAnalyticsReportSpec(AnalyticsReportNameAppDownloadsDetailed)
+	assert.True(t, ok)
+	assert.Equal(t, AnalyticsReportCategoryAppStoreCommerce, spec.Category)
+	assert.IsType(t, AppDownloadsReportRow{}, spec.Row)
+	assert.IsType(t, &[]AppDownloadsReportRow{}, spec.NewRows())
+
+	_, ok = LookupAnalyticsReportSpec("Unknown")
+	assert.False(t, ok)
+
+	for _, spec := range AnalyticsReportSpecs {
+		columns, err := ReportColumns(spec.Row)
+		assert.NoError(t, err, spec.Name)
+		assert.NotEmpty(t, columns, spec.Name)
+	}
+}
+
+func TestAnalyticsReportSpec(t *testing.T) {
+	t.Parallel()
+
+	report := AnalyticsReport{Attributes: &AnalyticsReportAttributes{Name: String("App Crashes")}}
+	spec, ok := report.Spec()
+	assert.True(t, ok)
+	assert.Equal(t, AnalyticsReportNameAppCrashes, spec.Name)
+
+	_, ok = (&AnalyticsReport{}).Spec()
+	assert.False(t, ok)
+}
+
+func TestParseAnalyticsReportSegment(t *testing.T) {
+	t.Parallel()
+
+	segment := "Date\tApp Name\tApp Apple Identifier\tPurchase Type\tContent Name\tDevice\tTerritory\tPurchases\tProceeds In USD\tSales In USD\tPaying Users\n" +
+		"2024-01-31\tApp\t1234567890\tIn-App Purchase\tCoins\tiPhone\tUS\t12\t8.4\t11.88\t10\n"
+
+	spec, _ := LookupAnalyticsReportSpec(AnalyticsReportNameAppStorePurchasesStandard)
+	rows := spec.NewRows()
+
+	err := ParseReport(strings.NewReader(segment), rows)
+	assert.NoError(t, err)
+
+	purchases := *rows.(*[]AppStorePurchasesReportRow)
+	assert.Len(t, purchases, 1)
+	assert.Equal(t, Date{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)}, purchases[0].Date)
+	assert.Equal(t, 12, purchases[0].Purchases)
+	assert.Equal(t, 8.4, purchases[0].ProceedsInUSD)
+	assert.Equal(t, 10, purchases[0].PayingUsers)
+}
+
+func TestParseAnalyticsReportName(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAnalyticsReportName(string(AnalyticsReportNameAppStoreDiscoveryAndEngagementStandard))
+	assert.NoError(t, err)
+	assert.Equal(t, AnalyticsReportNameAppStoreDiscoveryAndEngagementStandard, got)
+
+	_, err = ParseAnalyticsReportName("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AnalyticsReportName", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,537 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5" // nolint: gosec
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// ErrMissingSegmentURL happens when an analytics report segment has no URL to download it from.
+var ErrMissingSegmentURL = errors.New("analytics report segment has no download url")
+
+// ErrAnalyticsReportSegmentChecksum happens when a downloaded analytics report segment doesn't match its checksum.
+var ErrAnalyticsReportSegmentChecksum = errors.New("analytics report segment checksum mismatch")
+
+// AnalyticsReportAccessType defines model for AnalyticsReportRequest.Attributes.AccessType
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequest/attributes
+type AnalyticsReportAccessType string
+
+const (
+	// AnalyticsReportAccessTypeOngoing generates reports every day, starting from the day it is requested.
+	AnalyticsReportAccessTypeOngoing AnalyticsReportAccessType = "ONGOING"
+	// AnalyticsReportAccessTypeOneTimeSnapshot generates a single set of reports covering all historical data.
+	AnalyticsReportAccessTypeOneTimeSnapshot AnalyticsReportAccessType = "ONE_TIME_SNAPSHOT"
+)
+
+var analyticsReportAccessTypes = []AnalyticsReportAccessType{
+	AnalyticsReportAccessTypeOngoing,
+	AnalyticsReportAccessTypeOneTimeSnapshot,
+}
+
+// IsValid reports whether the analytics report access type is one this package knows about.
+func (v AnalyticsReportAccessType) IsValid() bool {
+	for _, value := range analyticsReportAccessTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAnalyticsReportAccessType returns s as an AnalyticsReportAccessType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAnalyticsReportAccessType(s string) (AnalyticsReportAccessType, error) {
+	if v := AnalyticsReportAccessType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AnalyticsReportAccessType", Value: s}
+}
+
+// AnalyticsReportCategory defines model for AnalyticsReport.Attributes.Category
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreport/attributes
+type AnalyticsReportCategory string
+
+const (
+	// AnalyticsReportCategoryAppStoreEngagement are reports of how people find and interact with the App Store page of an app.
+	AnalyticsReportCategoryAppStoreEngagement AnalyticsReportCategory = "APP_STORE_ENGAGEMENT"
+	// AnalyticsReportCategoryAppStoreCommerce are reports of downloads, sales and proceeds.
+	AnalyticsReportCategoryAppStoreCommerce AnalyticsReportCategory = "APP_STORE_COMMERCE"
+	// AnalyticsReportCategoryAppUsage are reports of installs, sessions and crashes.
+	AnalyticsReportCategoryAppUsage AnalyticsReportCategory = "APP_USAGE"
+	// AnalyticsReportCategoryFrameworkUsage are reports of the use of system frameworks by an app.
+	AnalyticsReportCategoryFrameworkUsage AnalyticsReportCategory = "FRAMEWORK_USAGE"
+	// AnalyticsReportCategoryPerformance are reports of the performance of an app.
+	AnalyticsReportCategoryPerformance AnalyticsReportCategory = "PERFORMANCE"
+)
+
+var analyticsReportCategories = []AnalyticsReportCategory{
+	AnalyticsReportCategoryAppStoreEngagement,
+	AnalyticsReportCategoryAppStoreCommerce,
+	AnalyticsReportCategoryAppUsage,
+	AnalyticsReportCategoryFrameworkUsage,
+	AnalyticsReportCategoryPerformance,
+}
+
+// IsValid reports whether the analytics report category is one this package knows about.
+func (v AnalyticsReportCategory) IsValid() bool {
+	for _, value := range analyticsReportCategories {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAnalyticsReportCategory returns s as an AnalyticsReportCategory, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAnalyticsReportCategory(s string) (AnalyticsReportCategory, error) {
+	if v := AnalyticsReportCategory(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AnalyticsReportCategory", Value: s}
+}
+
+// AnalyticsReportGranularity defines model for AnalyticsReportInstance.Attributes.Granularity
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstance/attributes
+type AnalyticsReportGranularity string
+
+const (
+	// AnalyticsReportGranularityDaily is an instance covering a single day.
+	AnalyticsReportGranularityDaily AnalyticsReportGranularity = "DAILY"
+	// AnalyticsReportGranularityWeekly is an instance covering a week.
+	AnalyticsReportGranularityWeekly AnalyticsReportGranularity = "WEEKLY"
+	// AnalyticsReportGranularityMonthly is an instance covering a month.
+	AnalyticsReportGranularityMonthly AnalyticsReportGranularity = "MONTHLY"
+)
+
+var analyticsReportGranularities = []AnalyticsReportGranularity{
+	AnalyticsReportGranularityDaily,
+	AnalyticsReportGranularityWeekly,
+	AnalyticsReportGranularityMonthly,
+}
+
+// IsValid reports whether the analytics report granularity is one this package knows about.
+func (v AnalyticsReportGranularity) IsValid() bool {
+	for _, value := range analyticsReportGranularities {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAnalyticsReportGranularity returns s as an AnalyticsReportGranularity, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAnalyticsReportGranularity(s string) (AnalyticsReportGranularity, error) {
+	if v := AnalyticsReportGranularity(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AnalyticsReportGranularity", Value: s}
+}
+
+// AnalyticsReportRequest defines model for AnalyticsReportRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequest
+type AnalyticsReportRequest struct {
+	Attributes    *AnalyticsReportRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                               `json:"id"`
+	Links         ResourceLinks                        `json:"links"`
+	Relationships *AnalyticsReportRequestRelationships `json:"relationships,omitempty"`
+	Type          string                               `json:"type"`
+}
+
+// AnalyticsReportRequestAttributes defines model for AnalyticsReportRequest.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequest/attributes
+type AnalyticsReportRequestAttributes struct {
+	AccessType             *AnalyticsReportAccessType `json:"accessType,omitempty"`
+	StoppedDueToInactivity *bool                      `json:"stoppedDueToInactivity,omitempty"`
+}
+
+// AnalyticsReportRequestRelationships defines model for AnalyticsReportRequest.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequest/relationships
+type AnalyticsReportRequestRelationships struct {
+	Reports *PagedRelationship `json:"reports,omitempty"`
+}
+
+// AnalyticsReportRequestResponse defines model for AnalyticsReportRequestResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequestresponse
+type AnalyticsReportRequestResponse struct {
+	Data     AnalyticsReportRequest `json:"data"`
+	Included []AnalyticsReport      `json:"included,omitempty"`
+	Links    DocumentLinks          `json:"links"`
+}
+
+// AnalyticsReportRequestsResponse defines model for AnalyticsReportRequestsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequestsresponse
+type AnalyticsReportRequestsResponse struct {
+	Data     []AnalyticsReportRequest `json:"data"`
+	Included []AnalyticsReport        `json:"included,omitempty"`
+	Links    PagedDocumentLinks       `json:"links"`
+	Meta     *PagingInformation       `json:"meta,omitempty"`
+}
+
+// analyticsReportRequestCreateRequest defines model for AnalyticsReportRequestCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequestcreaterequest/data
+type analyticsReportRequestCreateRequest struct {
+	Attributes    analyticsReportRequestCreateRequestAttributes    `json:"attributes"`
+	Relationships analyticsReportRequestCreateRequestRelationships `json:"relationships"`
+	Type          string                                           `json:"type"`
+}
+
+// analyticsReportRequestCreateRequestAttributes are attributes for AnalyticsReportRequestCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequestcreaterequest/data/attributes
+type analyticsReportRequestCreateRequestAttributes struct {
+	AccessType AnalyticsReportAccessType `json:"accessType"`
+}
+
+// analyticsReportRequestCreateRequestRelationships are relationships for AnalyticsReportRequestCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportrequestcreaterequest/data/relationships
+type analyticsReportRequestCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// AnalyticsReport defines model for AnalyticsReport.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreport
+type AnalyticsReport struct {
+	Attributes    *AnalyticsReportAttributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *AnalyticsReportRelationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// AnalyticsReportAttributes defines model for AnalyticsReport.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreport/attributes
+type AnalyticsReportAttributes struct {
+	Category *AnalyticsReportCategory `json:"category,omitempty"`
+	Name     *string                  `json:"name,omitempty"`
+}
+
+// AnalyticsReportRelationships defines model for AnalyticsReport.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreport/relationships
+type AnalyticsReportRelationships struct {
+	Instances *PagedRelationship `json:"instances,omitempty"`
+}
+
+// AnalyticsReportResponse defines model for AnalyticsReportResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportresponse
+type AnalyticsReportResponse struct {
+	Data  AnalyticsReport `json:"data"`
+	Links DocumentLinks   `json:"links"`
+}
+
+// AnalyticsReportsResponse defines model for AnalyticsReportsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportsresponse
+type AnalyticsReportsResponse struct {
+	Data  []AnalyticsReport  `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// AnalyticsReportInstance defines model for AnalyticsReportInstance.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstance
+type AnalyticsReportInstance struct {
+	Attributes    *AnalyticsReportInstanceAttributes    `json:"attributes,omitempty"`
+	ID            string                                `json:"id"`
+	Links         ResourceLinks                         `json:"links"`
+	Relationships *AnalyticsReportInstanceRelationships `json:"relationships,omitempty"`
+	Type          string                                `json:"type"`
+}
+
+// AnalyticsReportInstanceAttributes defines model for AnalyticsReportInstance.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstance/attributes
+type AnalyticsReportInstanceAttributes struct {
+	Granularity    *AnalyticsReportGranularity `json:"granularity,omitempty"`
+	ProcessingDate *Date                       `json:"processingDate,omitempty"`
+}
+
+// AnalyticsReportInstanceRelationships defines model for AnalyticsReportInstance.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstance/relationships
+type AnalyticsReportInstanceRelationships struct {
+	Segments *PagedRelationship `json:"segments,omitempty"`
+}
+
+// AnalyticsReportInstanceResponse defines model for AnalyticsReportInstanceResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstanceresponse
+type AnalyticsReportInstanceResponse struct {
+	Data  AnalyticsReportInstance `json:"data"`
+	Links DocumentLinks           `json:"links"`
+}
+
+// AnalyticsReportInstancesResponse defines model for AnalyticsReportInstancesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportinstancesresponse
+type AnalyticsReportInstancesResponse struct {
+	Data  []AnalyticsReportInstance `json:"data"`
+	Links PagedDocumentLinks        `json:"links"`
+	Meta  *PagingInformation        `json:"meta,omitempty"`
+}
+
+// AnalyticsReportSegment defines model for AnalyticsReportSegment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportsegment
+type AnalyticsReportSegment struct {
+	Attributes *AnalyticsReportSegmentAttributes `json:"attributes,omitempty"`
+	ID         string                            `json:"id"`
+	Links      ResourceLinks                     `json:"links"`
+	Type       string                            `json:"type"`
+}
+
+// AnalyticsReportSegmentAttributes defines model for AnalyticsReportSegment.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportsegment/attributes
+type AnalyticsReportSegmentAttributes struct {
+	Checksum    *string `json:"checksum,omitempty"`
+	SizeInBytes *int64  `json:"sizeInBytes,omitempty"`
+	URL         *string `json:"url,omitempty"`
+}
+
+// AnalyticsReportSegmentResponse defines model for AnalyticsReportSegmentResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportsegmentresponse
+type AnalyticsReportSegmentResponse struct {
+	Data  AnalyticsReportSegment `json:"data"`
+	Links DocumentLinks          `json:"links"`
+}
+
+// AnalyticsReportSegmentsResponse defines model for AnalyticsReportSegmentsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/analyticsreportsegmentsresponse
+type AnalyticsReportSegmentsResponse struct {
+	Data  []AnalyticsReportSegment `json:"data"`
+	Links PagedDocumentLinks       `json:"links"`
+	Meta  *PagingInformation       `json:"meta,omitempty"`
+}
+
+// GetAnalyticsReportRequestQuery are query options for GetAnalyticsReportRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreportrequests_id
+type GetAnalyticsReportRequestQuery struct {
+	FieldsAnalyticsReportRequests []string `url:"fields[analyticsReportRequests],omitempty"`
+	FieldsAnalyticsReports        []string `url:"fields[analyticsReports],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	LimitReports                  int      `url:"limit[reports],omitempty"`
+}
+
+// ListAnalyticsReportRequestsForAppQuery are query options for ListAnalyticsReportRequestsForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_analyticsreportrequests
+type ListAnalyticsReportRequestsForAppQuery struct {
+	FieldsAnalyticsReportRequests []string `url:"fields[analyticsReportRequests],omitempty"`
+	FieldsAnalyticsReports        []string `url:"fields[analyticsReports],omitempty"`
+	FilterAccessType              []string `url:"filter[accessType],omitempty"`
+	Include                       []string `url:"include,omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	LimitReports                  int      `url:"limit[reports],omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// ListReportsForAnalyticsReportRequestQuery are query options for ListReportsForAnalyticsReportRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreportrequests_id_reports
+type ListReportsForAnalyticsReportRequestQuery struct {
+	FieldsAnalyticsReports []string `url:"fields[analyticsReports],omitempty"`
+	FilterCategory         []string `url:"filter[category],omitempty"`
+	FilterName             []string `url:"filter[name],omitempty"`
+	Limit                  int      `url:"limit,omitempty"`
+	Cursor                 string   `url:"cursor,omitempty"`
+}
+
+// GetAnalyticsReportQuery are query options for GetAnalyticsReport
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreports_id
+type GetAnalyticsReportQuery struct {
+	FieldsAnalyticsReports []string `url:"fields[analyticsReports],omitempty"`
+}
+
+// ListInstancesForAnalyticsReportQuery are query options for ListInstancesForAnalyticsReport
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreports_id_instances
+type ListInstancesForAnalyticsReportQuery struct {
+	FieldsAnalyticsReportInstances []string `url:"fields[analyticsReportInstances],omitempty"`
+	FilterGranularity              []string `url:"filter[granularity],omitempty"`
+	FilterProcessingDate           []string `url:"filter[processingDate],omitempty"`
+	Limit                          int      `url:"limit,omitempty"`
+	Cursor                         string   `url:"cursor,omitempty"`
+}
+
+// GetAnalyticsReportInstanceQuery are query options for GetAnalyticsReportInstance
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreportinstances_id
+type GetAnalyticsReportInstanceQuery struct {
+	FieldsAnalyticsReportInstances []string `url:"fields[analyticsReportInstances],omitempty"`
+}
+
+// ListSegmentsForAnalyticsReportInstanceQuery are query options for ListSegmentsForAnalyticsReportInstance
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreportinstances_id_segments
+type ListSegmentsForAnalyticsReportInstanceQuery struct {
+	FieldsAnalyticsReportSegments []string `url:"fields[analyticsReportSegments],omitempty"`
+	Limit                         int      `url:"limit,omitempty"`
+	Cursor                        string   `url:"cursor,omitempty"`
+}
+
+// GetAnalyticsReportSegmentQuery are query options for GetAnalyticsReportSegment
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreportsegments_id
+type GetAnalyticsReportSegmentQuery struct {
+	FieldsAnalyticsReportSegments []string `url:"fields[analyticsReportSegments],omitempty"`
+}
+
+// CreateAnalyticsReportRequest requests the generation of analytics reports for an app. Reports for ongoing
+// requests are generated every day, and an app can only have one ongoing request at a time.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_analyticsreportrequests
+func (s *ReportingService) CreateAnalyticsReportRequest(ctx context.Context, accessType AnalyticsReportAccessType, appID string) (*AnalyticsReportRequestResponse, *Response, error) {
+	req := analyticsReportRequestCreateRequest{
+		Attributes: analyticsReportRequestCreateRequestAttributes{
+			AccessType: accessType,
+		},
+		Relationships: analyticsReportRequestCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "analyticsReportRequests",
+	}
+	res := new(AnalyticsReportRequestResponse)
+	resp, err := s.client.post(ctx, "analyticsReportRequests", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// GetAnalyticsReportRequest reads the information about an analytics report request.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreportrequests_id
+func (s *ReportingService) GetAnalyticsReportRequest(ctx context.Context, id string, params *GetAnalyticsReportRequestQuery) (*AnalyticsReportRequestResponse, *Response, error) {
+	return Get[AnalyticsReportRequestResponse](ctx, s.client, fmt.Sprintf("analyticsReportRequests/%s", id), params)
+}
+
+// ListAnalyticsReportRequestsForApp lists the analytics report requests of an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_apps_id_analyticsreportrequests
+func (s *ReportingService) ListAnalyticsReportRequestsForApp(ctx context.Context, id string, params *ListAnalyticsReportRequestsForAppQuery) (*AnalyticsReportRequestsResponse, *Response, error) {
+	return Get[AnalyticsReportRequestsResponse](ctx, s.client, fmt.Sprintf("apps/%s/analyticsReportRequests", id), params)
+}
+
+// DeleteAnalyticsReportRequest stops the generation of reports for an analytics report request.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_v1_analyticsreportrequests_id
+func (s *ReportingService) DeleteAnalyticsReportRequest(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("analyticsReportRequests/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// ListReportsForAnalyticsReportRequest lists the reports generated for an analytics report request.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreportrequests_id_reports
+func (s *ReportingService) ListReportsForAnalyticsReportRequest(ctx context.Context, id string, params *ListReportsForAnalyticsReportRequestQuery) (*AnalyticsReportsResponse, *Response, error) {
+	return Get[AnalyticsReportsResponse](ctx, s.client, fmt.Sprintf("analyticsReportRequests/%s/reports", id), params)
+}
+
+// GetAnalyticsReport reads the name and category of an analytics report.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreports_id
+func (s *ReportingService) GetAnalyticsReport(ctx context.Context, id string, params *GetAnalyticsReportQuery) (*AnalyticsReportResponse, *Response, error) {
+	return Get[AnalyticsReportResponse](ctx, s.client, fmt.Sprintf("analyticsReports/%s", id), params)
+}
+
+// ListInstancesForAnalyticsReport lists the instances of an analytics report, one per processing date and granularity.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreports_id_instances
+func (s *ReportingService) ListInstancesForAnalyticsReport(ctx context.Context, id string, params *ListInstancesForAnalyticsReportQuery) (*AnalyticsReportInstancesResponse, *Response, error) {
+	return Get[AnalyticsReportInstancesResponse](ctx, s.client, fmt.Sprintf("analyticsReports/%s/instances", id), params)
+}
+
+// GetAnalyticsReportInstance reads the granularity and processing date of an analytics report instance.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreportinstances_id
+func (s *ReportingService) GetAnalyticsReportInstance(ctx context.Context, id string, params *GetAnalyticsReportInstanceQuery) (*AnalyticsReportInstanceResponse, *Response, error) {
+	return Get[AnalyticsReportInstanceResponse](ctx, s.client, fmt.Sprintf("analyticsReportInstances/%s", id), params)
+}
+
+// ListSegmentsForAnalyticsReportInstance lists the downloadable files an analytics report instance is split into.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreportinstances_id_segments
+func (s *ReportingService) ListSegmentsForAnalyticsReportInstance(ctx context.Context, id string, params *ListSegmentsForAnalyticsReportInstanceQuery) (*AnalyticsReportSegmentsResponse, *Response, error) {
+	return Get[AnalyticsReportSegmentsResponse](ctx, s.client, fmt.Sprintf("analyticsReportInstances/%s/segments", id), params)
+}
+
+// GetAnalyticsReportSegment reads the download URL, size and checksum of an analytics report segment.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_analyticsreportsegments_id
+func (s *ReportingService) GetAnalyticsReportSegment(ctx context.Context, id string, params *GetAnalyticsReportSegmentQuery) (*AnalyticsReportSegmentResponse, *Response, error) {
+	return Get[AnalyticsReportSegmentResponse](ctx, s.client, fmt.Sprintf("analyticsReportSegments/%s", id), params)
+}
+
+// DownloadAnalyticsReportSegment downloads the file of an analytics report segment, verifies it against the
+// segment's checksum, and returns its decompressed, tab-separated contents. The contents can be decoded with
+// ParseReport.
+func (s *ReportingService) DownloadAnalyticsReportSegment(ctx context.Context, segment *AnalyticsReportSegment) (io.Reader, *Response, error) {
+	if segment.Attributes == nil || segment.Attributes.URL == nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrMissingSegmentURL, segment.ID)
+	}
+
+	buffer := new(bytes.Buffer)
+
+	resp, err := s.client.get(ctx, *segment.Attributes.URL, nil, buffer)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if checksum := segment.Attributes.Checksum; checksum != nil && *checksum != "" {
+		sum := md5.Sum(buffer.Bytes()) // nolint: gosec
+		if got := hex.EncodeToString(sum[:]); got != *checksum {
+			return nil, resp, fmt.Errorf("%w: segment %s: expected %s, got %s", ErrAnalyticsReportSegmentChecksum, segment.ID, *checksum, got)
+		}
+	}
+
+	report, err := decompressReport(buffer)
+
+	return report, resp, err
+}
@@ -0,0 +1,186 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"crypto/md5" // nolint: gosec
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAnalyticsReportRequest(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportRequestResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.CreateAnalyticsReportRequest(ctx, AnalyticsReportAccessTypeOngoing, "10")
+	})
+}
+
+func TestGetAnalyticsReportRequest(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportRequestResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.GetAnalyticsReportRequest(ctx, "10", &GetAnalyticsReportRequestQuery{})
+	})
+}
+
+func TestListAnalyticsReportRequestsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportRequestsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.ListAnalyticsReportRequestsForApp(ctx, "10", &ListAnalyticsReportRequestsForAppQuery{})
+	})
+}
+
+func TestDeleteAnalyticsReportRequest(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.Reporting.DeleteAnalyticsReportRequest(ctx, "10")
+	})
+}
+
+func TestListReportsForAnalyticsReportRequest(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.ListReportsForAnalyticsReportRequest(ctx, "10", &ListReportsForAnalyticsReportRequestQuery{})
+	})
+}
+
+func TestGetAnalyticsReport(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.GetAnalyticsReport(ctx, "10", &GetAnalyticsReportQuery{})
+	})
+}
+
+func TestListInstancesForAnalyticsReport(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportInstancesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.ListInstancesForAnalyticsReport(ctx, "10", &ListInstancesForAnalyticsReportQuery{})
+	})
+}
+
+func TestGetAnalyticsReportInstance(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportInstanceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.GetAnalyticsReportInstance(ctx, "10", &GetAnalyticsReportInstanceQuery{})
+	})
+}
+
+func TestListSegmentsForAnalyticsReportInstance(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportSegmentsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.ListSegmentsForAnalyticsReportInstance(ctx, "10", &ListSegmentsForAnalyticsReportInstanceQuery{})
+	})
+}
+
+func TestGetAnalyticsReportSegment(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AnalyticsReportSegmentResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.GetAnalyticsReportSegment(ctx, "10", &GetAnalyticsReportSegmentQuery{})
+	})
+}
+
+func TestDownloadAnalyticsReportSegment(t *testing.T) {
+	t.Parallel()
+
+	report := "Date\tApp Name\tCounts\n2024-01-31\tApp\t12\n"
+	compressed := gzipped(t, report).Bytes()
+	sum := md5.Sum(compressed) // nolint: gosec
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(compressed)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	segment := &AnalyticsReportSegment{
+		ID: "1",
+		Attributes: &AnalyticsReportSegmentAttributes{
+			Checksum: String(hex.EncodeToString(sum[:])),
+			URL:      String(server.URL + "/segment.csv.gz"),
+		},
+	}
+
+	got, _, err := client.Reporting.DownloadAnalyticsReportSegment(context.Background(), segment)
+	assert.NoError(t, err)
+
+	b, err := io.ReadAll(got)
+	assert.NoError(t, err)
+	assert.Equal(t, report, string(b))
+
+	segment.Attributes.Checksum = String("00")
+	_, _, err = client.Reporting.DownloadAnalyticsReportSegment(context.Background(), segment)
+	assert.ErrorIs(t, err, ErrAnalyticsReportSegmentChecksum)
+
+	_, _, err = client.Reporting.DownloadAnalyticsReportSegment(context.Background(), &AnalyticsReportSegment{ID: "2"})
+	assert.ErrorIs(t, err, ErrMissingSegmentURL)
+
+	segment.Attributes.URL = String("http://127.0.0.1:0/missing")
+	_, _, err = client.Reporting.DownloadAnalyticsReportSegment(context.Background(), segment)
+	assert.Error(t, err)
+}
+
+func TestParseAnalyticsReportAccessType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAnalyticsReportAccessType(string(AnalyticsReportAccessTypeOngoing))
+	assert.NoError(t, err)
+	assert.Equal(t, AnalyticsReportAccessTypeOngoing, got)
+
+	_, err = ParseAnalyticsReportAccessType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AnalyticsReportAccessType", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAnalyticsReportCategory(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAnalyticsReportCategory(string(AnalyticsReportCategoryAppStoreEngagement))
+	assert.NoError(t, err)
+	assert.Equal(t, AnalyticsReportCategoryAppStoreEngagement, got)
+
+	_, err = ParseAnalyticsReportCategory("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AnalyticsReportCategory", Value: "UNKNOWN"}, err)
+}
+
+func TestParseAnalyticsReportGranularity(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAnalyticsReportGranularity(string(AnalyticsReportGranularityDaily))
+	assert.NoError(t, err)
+	assert.Equal(t, AnalyticsReportGranularityDaily, got)
+
+	_, err = ParseAnalyticsReportGranularity("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AnalyticsReportGranularity", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,205 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// DiagnosticType is the kind of issue a DiagnosticSignature aggregates.
+type DiagnosticType string
+
+const (
+	// DiagnosticTypeDiskWrites are excessive logical disk writes.
+	DiagnosticTypeDiskWrites DiagnosticType = "DISK_WRITES"
+	// DiagnosticTypeHangs are periods where the main thread was unresponsive.
+	DiagnosticTypeHangs DiagnosticType = "HANGS"
+	// DiagnosticTypeLaunches are slow app launches.
+	DiagnosticTypeLaunches DiagnosticType = "LAUNCHES"
+)
+
+var diagnosticTypes = []DiagnosticType{
+	DiagnosticTypeDiskWrites,
+	DiagnosticTypeHangs,
+	DiagnosticTypeLaunches,
+}
+
+// IsValid reports whether the diagnostic type is one this package knows about.
+func (v DiagnosticType) IsValid() bool {
+	for _, value := range diagnosticTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseDiagnosticType returns s as a DiagnosticType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseDiagnosticType(s string) (DiagnosticType, error) {
+	if v := DiagnosticType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "DiagnosticType", Value: s}
+}
+
+// DiagnosticLogs is the vendor-specific payload of the logs for a diagnostic signature.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogs
+type DiagnosticLogs struct {
+	ProductData []DiagnosticLogsProductData `json:"productData,omitempty"`
+	Version     string                      `json:"version,omitempty"`
+}
+
+// DiagnosticLogsProductData are the logs collected for a single diagnostic signature.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogs/productdata
+type DiagnosticLogsProductData struct {
+	DiagnosticInsights []DiagnosticInsight   `json:"diagnosticInsights,omitempty"`
+	DiagnosticLogs     []DiagnosticLogReport `json:"diagnosticLogs,omitempty"`
+	SignatureID        string                `json:"signatureId,omitempty"`
+}
+
+// DiagnosticInsight is a suggestion from Apple on how to address a diagnostic signature.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticinsight
+type DiagnosticInsight struct {
+	InsightsCategory string `json:"insightsCategory,omitempty"`
+	InsightsString   string `json:"insightsString,omitempty"`
+	InsightsURL      string `json:"insightsURL,omitempty"`
+}
+
+// DiagnosticLogReport is a single log, made of the call stacks captured when the issue occurred and
+// information about the device it occurred on.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogs/productdata/diagnosticlogs
+type DiagnosticLogReport struct {
+	CallStackTree      []DiagnosticLogCallStackTree `json:"callStackTree,omitempty"`
+	DiagnosticMetaData *DiagnosticLogMetaData       `json:"diagnosticMetaData,omitempty"`
+}
+
+// DiagnosticLogCallStackTree is the set of call stacks captured in a log.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogcallstacktree
+type DiagnosticLogCallStackTree struct {
+	CallStackPerThread bool                     `json:"callStackPerThread,omitempty"`
+	CallStacks         []DiagnosticLogCallStack `json:"callStacks,omitempty"`
+}
+
+// DiagnosticLogCallStack is the call stack of a single thread, or of all threads aggregated together.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogcallstack
+type DiagnosticLogCallStack struct {
+	CallStackRootFrames []DiagnosticLogCallStackNode `json:"callStackRootFrames,omitempty"`
+}
+
+// DiagnosticLogCallStackNode is a frame of a call stack, along with the frames it called.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogcallstacknode
+type DiagnosticLogCallStackNode struct {
+	Address                     string                       `json:"address,omitempty"`
+	BinaryName                  string                       `json:"binaryName,omitempty"`
+	BinaryUUID                  string                       `json:"binaryUUID,omitempty"`
+	FileName                    string                       `json:"fileName,omitempty"`
+	InsightsCategory            string                       `json:"insightsCategory,omitempty"`
+	IsBlameFrame                bool                         `json:"isBlameFrame,omitempty"`
+	LineNumber                  string                       `json:"lineNumber,omitempty"`
+	OffsetIntoBinaryTextSegment string                       `json:"offsetIntoBinaryTextSegment,omitempty"`
+	OffsetIntoSymbol            string                       `json:"offsetIntoSymbol,omitempty"`
+	RawFrame                    string                       `json:"rawFrame,omitempty"`
+	SampleCount                 int                          `json:"sampleCount,omitempty"`
+	SubFrames                   []DiagnosticLogCallStackNode `json:"subFrames,omitempty"`
+	SymbolName                  string                       `json:"symbolName,omitempty"`
+}
+
+// DiagnosticLogMetaData describes the app and the device a log was captured on.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/diagnosticlogs/productdata/diagnosticlogs/diagnosticmetadata
+type DiagnosticLogMetaData struct {
+	AppVersion           string `json:"appVersion,omitempty"`
+	BuildVersion         string `json:"buildVersion,omitempty"`
+	BundleID             string `json:"bundleId,omitempty"`
+	DeviceType           string `json:"deviceType,omitempty"`
+	Event                string `json:"event,omitempty"`
+	EventDetail          string `json:"eventDetail,omitempty"`
+	OSVersion            string `json:"osVersion,omitempty"`
+	PlatformArchitecture string `json:"platformArchitecture,omitempty"`
+	WritesCaused         string `json:"writesCaused,omitempty"`
+}
+
+// DiagnosticSignatureLogs pairs a diagnostic signature with its downloaded logs.
+type DiagnosticSignatureLogs struct {
+	Signature DiagnosticSignature
+	Logs      *DiagnosticLogs
+}
+
+// DownloadDiagnosticLogs downloads the anonymized backtrace logs of a diagnostic signature, decoded from the
+// vendor-specific payload.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/download_logs_for_a_diagnostic_signature
+func (s *ReportingService) DownloadDiagnosticLogs(ctx context.Context, signatureID string, params *GetLogsForDiagnosticSignatureQuery) (*DiagnosticLogs, *Response, error) {
+	url := fmt.Sprintf("diagnosticSignatures/%s/logs", signatureID)
+	res := new(DiagnosticLogs)
+	resp, err := s.client.get(ctx, url, params, res, withAccept("application/vnd.apple.diagnostic-logs+json"))
+
+	return res, resp, err
+}
+
+// DownloadDiagnosticLogsForBuild lists every diagnostic signature of a build, optionally only those of the given
+// types, and downloads the logs of each.
+func (s *ReportingService) DownloadDiagnosticLogsForBuild(ctx context.Context, buildID string, types ...DiagnosticType) ([]DiagnosticSignatureLogs, error) {
+	params := &ListDiagnosticsSignaturesQuery{}
+	for _, t := range types {
+		params.FilterDiagnosticType = append(params.FilterDiagnosticType, string(t))
+	}
+
+	var results []DiagnosticSignatureLogs
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		res, _, err := s.ListDiagnosticSignaturesForBuild(ctx, buildID, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, signature := range res.Data {
+			logs, _, err := s.DownloadDiagnosticLogs(ctx, signature.ID, nil)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", signature.ID, err)
+			}
+
+			results = append(results, DiagnosticSignatureLogs{
+				Signature: signature,
+				Logs:      logs,
+			})
+		}
+
+		return &res.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
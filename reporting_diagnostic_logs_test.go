@@ -0,0 +1,111 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDiagnosticLogs = `{
+	"version": "1.0",
+	"productData": [{
+		"signatureId": "sig-1",
+		"diagnosticInsights": [{"insightsCategory": "HANGS", "insightsString": "Avoid I/O on the main thread"}],
+		"diagnosticLogs": [{
+			"diagnosticMetaData": {"bundleId": "com.example.app", "appVersion": "1.1", "buildVersion": "42", "osVersion": "iOS 17.0", "deviceType": "iPhone15,2", "event": "hang"},
+			"callStackTree": [{
+				"callStackPerThread": true,
+				"callStacks": [{
+					"callStackRootFrames": [{
+						"binaryName": "Example",
+						"symbolName": "main",
+						"sampleCount": 3,
+						"subFrames": [{"binaryName": "Foundation", "symbolName": "-[NSData writeToFile:]", "isBlameFrame": true, "sampleCount": 3}]
+					}]
+				}]
+			}]
+		}]
+	}]
+}`
+
+func TestDownloadDiagnosticLogs(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(testDiagnosticLogs, func(ctx context.Context, client *Client) {
+		logs, _, err := client.Reporting.DownloadDiagnosticLogs(ctx, "sig-1", nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0", logs.Version)
+		assert.Len(t, logs.ProductData, 1)
+
+		data := logs.ProductData[0]
+		assert.Equal(t, "sig-1", data.SignatureID)
+		assert.Equal(t, "HANGS", data.DiagnosticInsights[0].InsightsCategory)
+		assert.Equal(t, "com.example.app", data.DiagnosticLogs[0].DiagnosticMetaData.BundleID)
+
+		root := data.DiagnosticLogs[0].CallStackTree[0].CallStacks[0].CallStackRootFrames[0]
+		assert.Equal(t, "main", root.SymbolName)
+		assert.True(t, root.SubFrames[0].IsBlameFrame)
+	})
+}
+
+func TestDownloadDiagnosticLogsForBuild(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /builds/10/diagnosticSignatures":  `{"data":[{"id":"sig-1","type":"diagnosticSignatures","attributes":{"diagnosticType":"HANGS"}}],"links":{}}`,
+		"GET /diagnosticSignatures/sig-1/logs": testDiagnosticLogs,
+	})
+	defer server.Close()
+
+	results, err := client.Reporting.DownloadDiagnosticLogsForBuild(context.Background(), "10", DiagnosticTypeHangs)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "sig-1", results[0].Signature.ID)
+	assert.Equal(t, "sig-1", results[0].Logs.ProductData[0].SignatureID)
+	assert.Equal(t, []string{"GET /builds/10/diagnosticSignatures", "GET /diagnosticSignatures/sig-1/logs"}, *requests)
+}
+
+func TestDownloadDiagnosticLogsForBuild_Err(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /builds/10/diagnosticSignatures": `{"data":[{"id":"sig-1","type":"diagnosticSignatures"}],"links":{}}`,
+	})
+	defer server.Close()
+
+	results, err := client.Reporting.DownloadDiagnosticLogsForBuild(context.Background(), "10")
+	assert.Error(t, err)
+	assert.Nil(t, results)
+}
+
+func TestParseDiagnosticType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseDiagnosticType(string(DiagnosticTypeDiskWrites))
+	assert.NoError(t, err)
+	assert.Equal(t, DiagnosticTypeDiskWrites, got)
+
+	_, err = ParseDiagnosticType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "DiagnosticType", Value: "UNKNOWN"}, err)
+}
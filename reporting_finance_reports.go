@@ -0,0 +1,284 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"time"
+)
+
+// FinanceReportType is the kind of data in a finance report.
+type FinanceReportType string
+
+const (
+	// FinanceReportTypeFinancial is a report of the earnings of a region, used to reconcile payments.
+	FinanceReportTypeFinancial FinanceReportType = "FINANCIAL"
+	// FinanceReportTypeFinanceDetail is a report of the earnings of every region, along with their input tax and
+	// adjustments. It must be requested with FinanceRegionCodeAllRegions.
+	FinanceReportTypeFinanceDetail FinanceReportType = "FINANCE_DETAIL"
+)
+
+var financeReportTypes = []FinanceReportType{
+	FinanceReportTypeFinancial,
+	FinanceReportTypeFinanceDetail,
+}
+
+// IsValid reports whether the finance report type is one this package knows about.
+func (v FinanceReportType) IsValid() bool {
+	for _, value := range financeReportTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseFinanceReportType returns s as a FinanceReportType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseFinanceReportType(s string) (FinanceReportType, error) {
+	if v := FinanceReportType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "FinanceReportType", Value: s}
+}
+
+// FinanceRegionCode is the code of a region that finance reports are generated for, each paid in a single currency.
+type FinanceRegionCode string
+
+// FinanceRegion is a region that finance reports are generated for.
+type FinanceRegion struct {
+	Code FinanceRegionCode
+	Name string
+	// Currency is the ISO 4217 code of the currency the region's earnings are reported in.
+	Currency string
+}
+
+const (
+	// FinanceRegionCodeAllRegions requests the reports of every region, for FinanceReportTypeFinanceDetail.
+	FinanceRegionCodeAllRegions FinanceRegionCode = "Z1"
+	// FinanceRegionCodeConsolidated requests a consolidated report of every region, for FinanceReportTypeFinancial.
+	FinanceRegionCodeConsolidated FinanceRegionCode = "ZZ"
+)
+
+var financeRegionCodes = []FinanceRegionCode{
+	FinanceRegionCodeAllRegions,
+	FinanceRegionCodeConsolidated,
+}
+
+// IsValid reports whether the finance region code is one this package knows about.
+func (v FinanceRegionCode) IsValid() bool {
+	for _, value := range financeRegionCodes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseFinanceRegionCode returns s as a FinanceRegionCode, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseFinanceRegionCode(s string) (FinanceRegionCode, error) {
+	if v := FinanceRegionCode(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "FinanceRegionCode", Value: s}
+}
+
+// FinanceRegions lists the regions that finance reports are generated for.
+//
+// https://help.apple.com/app-store-connect/#/dev3a16f3fe0
+var FinanceRegions = []FinanceRegion{
+	{"AE", "United Arab Emirates", "AED"},
+	{"AU", "Australia", "AUD"},
+	{"BG", "Bulgaria", "BGN"},
+	{"BR", "Brazil", "BRL"},
+	{"CA", "Canada", "CAD"},
+	{"CH", "Switzerland", "CHF"},
+	{"CL", "Chile", "CLP"},
+	{"CN", "China mainland", "CNY"},
+	{"CO", "Colombia", "COP"},
+	{"CZ", "Czech Republic", "CZK"},
+	{"DK", "Denmark", "DKK"},
+	{"EG", "Egypt", "EGP"},
+	{"EU", "Euro-Zone", "EUR"},
+	{"GB", "United Kingdom", "GBP"},
+	{"HK", "Hong Kong", "HKD"},
+	{"HU", "Hungary", "HUF"},
+	{"ID", "Indonesia", "IDR"},
+	{"IL", "Israel", "ILS"},
+	{"IN", "India", "INR"},
+	{"JP", "Japan", "JPY"},
+	{"KR", "Republic of Korea", "KRW"},
+	{"KZ", "Kazakhstan", "KZT"},
+	{"LL", "Latin America and the Caribbean", "USD"},
+	{"MX", "Mexico", "MXN"},
+	{"MY", "Malaysia", "MYR"},
+	{"NG", "Nigeria", "NGN"},
+	{"NO", "Norway", "NOK"},
+	{"NZ", "New Zealand", "NZD"},
+	{"PE", "Peru", "PEN"},
+	{"PH", "Philippines", "PHP"},
+	{"PK", "Pakistan", "PKR"},
+	{"PL", "Poland", "PLN"},
+	{"QA", "Qatar", "QAR"},
+	{"RO", "Romania", "RON"},
+	{"RU", "Russia", "RUB"},
+	{"SA", "Saudi Arabia", "SAR"},
+	{"SE", "Sweden", "SEK"},
+	{"SG", "Singapore", "SGD"},
+	{"TH", "Thailand", "THB"},
+	{"TR", "Turkey", "TRY"},
+	{"TW", "Taiwan", "TWD"},
+	{"TZ", "Tanzania", "TZS"},
+	{"US", "Americas", "USD"},
+	{"VN", "Vietnam", "VND"},
+	{"WW", "Rest of World", "USD"},
+	{"ZA", "South Africa", "ZAR"},
+}
+
+// LookupFinanceRegion returns the region with code, or false if there is none.
+func LookupFinanceRegion(code FinanceRegionCode) (FinanceRegion, bool) {
+	for _, region := range FinanceRegions {
+		if region.Code == code {
+			return region, true
+		}
+	}
+
+	return FinanceRegion{}, false
+}
+
+// FiscalPeriod is a period of Apple's fiscal calendar, which finance reports and payments follow. A fiscal year
+// starts on the Sunday after the last Saturday of September and is split into twelve periods named after calendar
+// months, lasting five, four and four weeks in each quarter. Years of 53 weeks add a week to their first period.
+type FiscalPeriod struct {
+	// Year is the fiscal year, which is the calendar year it ends in.
+	Year int
+	// Period is the number of the period within the year, from 1 for October to 12 for September.
+	Period int
+	// Start is the first day of the period.
+	Start time.Time
+	// End is the last day of the period.
+	End time.Time
+}
+
+// Month returns the calendar month the period is named after.
+func (p FiscalPeriod) Month() time.Month {
+	return time.Month((p.Period+8)%12 + 1)
+}
+
+// Contains reports whether date falls within the period.
+func (p FiscalPeriod) Contains(date time.Time) bool {
+	day := fiscalDay(date)
+
+	return !day.Before(p.Start) && !day.After(p.End)
+}
+
+// ReportDate formats the period as expected by DownloadFinanceReportsQuery.FilterReportDate, such as "2023-10"
+// for the first period of fiscal year 2024.
+func (p FiscalPeriod) ReportDate() string {
+	year := p.Year
+	if p.Month() >= time.October {
+		year--
+	}
+
+	return time.Date(year, p.Month(), 1, 0, 0, 0, 0, time.UTC).Format("2006-01")
+}
+
+// FiscalYearStart returns the first day of a fiscal year.
+func FiscalYearStart(year int) time.Time {
+	return lastSaturdayOfSeptember(year-1).AddDate(0, 0, 1)
+}
+
+// FiscalYearEnd returns the last day of a fiscal year.
+func FiscalYearEnd(year int) time.Time {
+	return lastSaturdayOfSeptember(year)
+}
+
+// FiscalPeriods returns the twelve periods of a fiscal year.
+func FiscalPeriods(year int) []FiscalPeriod {
+	start := FiscalYearStart(year)
+	weeks := int(FiscalYearEnd(year).Sub(start).Hours()/24+1) / 7
+
+	periods := make([]FiscalPeriod, 12)
+
+	for i := range periods {
+		length := 4
+		if i%3 == 0 {
+			length = 5
+		}
+
+		if i == 0 && weeks == 53 {
+			length++
+		}
+
+		end := start.AddDate(0, 0, length*7)
+		periods[i] = FiscalPeriod{
+			Year:   year,
+			Period: i + 1,
+			Start:  start,
+			End:    end.AddDate(0, 0, -1),
+		}
+		start = end
+	}
+
+	return periods
+}
+
+// FiscalPeriodForDate returns the fiscal period that date falls within.
+func FiscalPeriodForDate(date time.Time) FiscalPeriod {
+	day := fiscalDay(date)
+
+	year := day.Year()
+	if day.After(FiscalYearEnd(year)) {
+		year++
+	}
+
+	for _, period := range FiscalPeriods(year) {
+		if period.Contains(day) {
+			return period
+		}
+	}
+
+	// Unreachable, as the periods of a year cover all of its days.
+	return FiscalPeriod{}
+}
+
+// NewFinanceReportQuery returns the query options to download the finance report of a region for a fiscal period.
+func NewFinanceReportQuery(vendorNumber string, reportType FinanceReportType, regionCode FinanceRegionCode, period FiscalPeriod) *DownloadFinanceReportsQuery {
+	return &DownloadFinanceReportsQuery{
+		FilterRegionCode:   []string{string(regionCode)},
+		FilterReportDate:   []string{period.ReportDate()},
+		FilterReportType:   []string{string(reportType)},
+		FilterVendorNumber: []string{vendorNumber},
+	}
+}
+
+func lastSaturdayOfSeptember(year int) time.Time {
+	day := time.Date(year, time.September, 30, 0, 0, 0, 0, time.UTC)
+
+	return day.AddDate(0, 0, -int((day.Weekday()+1)%7))
+}
+
+// fiscalDay returns the calendar day of date, as a UTC midnight.
+func fiscalDay(date time.Time) time.Time {
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+}
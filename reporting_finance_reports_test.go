@@ -0,0 +1,136 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testDay(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestLookupFinanceRegion(t *testing.T) {
+	t.Parallel()
+
+	region, ok := LookupFinanceRegion("EU")
+	assert.True(t, ok)
+	assert.Equal(t, "EUR", region.Currency)
+
+	_, ok = LookupFinanceRegion("XX")
+	assert.False(t, ok)
+
+	seen := map[FinanceRegionCode]bool{}
+	for _, region := range FinanceRegions {
+		assert.False(t, seen[region.Code], region.Code)
+		assert.Len(t, region.Currency, 3, region.Code)
+		seen[region.Code] = true
+	}
+}
+
+func TestFiscalYearBounds(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, testDay(2023, time.October, 1), FiscalYearStart(2024))
+	assert.Equal(t, testDay(2024, time.September, 28), FiscalYearEnd(2024))
+	assert.Equal(t, testDay(2022, time.September, 25), FiscalYearStart(2023))
+	assert.Equal(t, testDay(2023, time.September, 30), FiscalYearEnd(2023))
+}
+
+func TestFiscalPeriods(t *testing.T) {
+	t.Parallel()
+
+	periods := FiscalPeriods(2024)
+	assert.Len(t, periods, 12)
+	assert.Equal(t, FiscalPeriod{Year: 2024, Period: 1, Start: testDay(2023, time.October, 1), End: testDay(2023, time.November, 4)}, periods[0])
+	assert.Equal(t, testDay(2023, time.December, 31), periods[3].Start)
+	assert.Equal(t, testDay(2024, time.February, 3), periods[3].End)
+	assert.Equal(t, testDay(2024, time.September, 28), periods[11].End)
+
+	for i := 1; i < len(periods); i++ {
+		assert.Equal(t, periods[i-1].End.AddDate(0, 0, 1), periods[i].Start)
+	}
+
+	// A 53-week year adds a week to its first period.
+	periods = FiscalPeriods(2023)
+	assert.Equal(t, testDay(2022, time.November, 5), periods[0].End)
+	assert.Equal(t, testDay(2023, time.September, 30), periods[11].End)
+}
+
+func TestFiscalPeriodForDate(t *testing.T) {
+	t.Parallel()
+
+	period := FiscalPeriodForDate(time.Date(2024, time.January, 15, 18, 30, 0, 0, time.UTC))
+	assert.Equal(t, 2024, period.Year)
+	assert.Equal(t, 4, period.Period)
+	assert.Equal(t, time.January, period.Month())
+	assert.Equal(t, "2024-01", period.ReportDate())
+	assert.True(t, period.Contains(testDay(2024, time.February, 3)))
+	assert.False(t, period.Contains(testDay(2024, time.February, 4)))
+
+	period = FiscalPeriodForDate(testDay(2024, time.September, 29))
+	assert.Equal(t, 2025, period.Year)
+	assert.Equal(t, 1, period.Period)
+	assert.Equal(t, time.October, period.Month())
+	assert.Equal(t, "2024-10", period.ReportDate())
+
+	period = FiscalPeriodForDate(testDay(2023, time.September, 30))
+	assert.Equal(t, 2023, period.Year)
+	assert.Equal(t, 12, period.Period)
+	assert.Equal(t, "2023-09", period.ReportDate())
+}
+
+func TestNewFinanceReportQuery(t *testing.T) {
+	t.Parallel()
+
+	query := NewFinanceReportQuery("85012345", FinanceReportTypeFinancial, "US", FiscalPeriods(2024)[0])
+	assert.Equal(t, &DownloadFinanceReportsQuery{
+		FilterRegionCode:   []string{"US"},
+		FilterReportDate:   []string{"2023-10"},
+		FilterReportType:   []string{"FINANCIAL"},
+		FilterVendorNumber: []string{"85012345"},
+	}, query)
+}
+
+func TestParseFinanceReportType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseFinanceReportType(string(FinanceReportTypeFinancial))
+	assert.NoError(t, err)
+	assert.Equal(t, FinanceReportTypeFinancial, got)
+
+	_, err = ParseFinanceReportType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "FinanceReportType", Value: "UNKNOWN"}, err)
+}
+
+func TestParseFinanceRegionCode(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseFinanceRegionCode(string(FinanceRegionCodeAllRegions))
+	assert.NoError(t, err)
+	assert.Equal(t, FinanceRegionCodeAllRegions, got)
+
+	_, err = ParseFinanceRegionCode("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "FinanceRegionCode", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,166 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// ErrReportNotAvailable happens when App Store Connect has not generated a report for a date yet.
+var ErrReportNotAvailable = errors.New("report is not available yet")
+
+// defaultReportPollInterval is how often WaitForSalesAndTrendsReport and WaitForFinanceReport poll by default.
+const defaultReportPollInterval = 15 * time.Minute
+
+// IsReportNotReady reports whether err means that a Sales and Trends or finance report has not been generated
+// yet, as opposed to not existing, such as for a date without sales. Requesting the report later should succeed.
+func IsReportNotReady(err error) bool {
+	if errors.Is(err, ErrReportNotAvailable) {
+		return true
+	}
+
+	var errResponse *ErrorResponse
+	if !errors.As(err, &errResponse) || !isReportNotFound(err) {
+		return false
+	}
+
+	for _, e := range errResponse.Errors {
+		if strings.Contains(strings.ToLower(e.Detail), "not available yet") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isReportNotFound(err error) bool {
+	var errResponse *ErrorResponse
+
+	return errors.As(err, &errResponse) && errResponse.Response != nil && errResponse.Response.StatusCode == http.StatusNotFound
+}
+
+// ReportAvailableAt returns when App Store Connect usually publishes the Sales and Trends report of frequency for
+// date: at 5 am Pacific Time, the day after a daily report or the week of a weekly report, on the fifth day after
+// the end of a month, and on the sixth of January for a yearly report. Reports for other regions can be published
+// earlier, and publication is occasionally delayed.
+func ReportAvailableAt(frequency SalesReportFrequency, date time.Time) time.Time {
+	var day time.Time
+
+	switch frequency {
+	case SalesReportFrequencyMonthly:
+		day = time.Date(date.Year(), date.Month()+1, 5, 0, 0, 0, 0, time.UTC)
+	case SalesReportFrequencyYearly:
+		day = time.Date(date.Year()+1, time.January, 6, 0, 0, 0, 0, time.UTC)
+	default:
+		day = time.Date(date.Year(), date.Month(), date.Day()+1, 0, 0, 0, 0, time.UTC)
+	}
+
+	return time.Date(day.Year(), day.Month(), day.Day(), 5, 0, 0, 0, pacificTime())
+}
+
+// WaitForSalesAndTrendsReport downloads a Sales and Trends report, waiting for it to be published if it isn't yet.
+// If params request a report date, it first sleeps until the report is expected to be published, as given by
+// ReportAvailableAt. It then polls every interval, or every 15 minutes if interval is zero, until the report is
+// available, another error happens, or ctx is done.
+func (s *ReportingService) WaitForSalesAndTrendsReport(ctx context.Context, params *DownloadSalesAndTrendsReportsQuery, interval time.Duration) (io.Reader, *Response, error) {
+	var availableAt time.Time
+
+	if params != nil && len(params.FilterReportDate) > 0 && len(params.FilterFrequency) > 0 {
+		if date, ok := parseReportDate(params.FilterReportDate[0]); ok {
+			availableAt = ReportAvailableAt(SalesReportFrequency(params.FilterFrequency[0]), date)
+		}
+	}
+
+	return waitForReport(ctx, availableAt, interval, func() (io.Reader, *Response, error) {
+		return s.DownloadSalesAndTrendsReports(ctx, params)
+	})
+}
+
+// WaitForFinanceReport downloads a finance report, polling every interval, or every 15 minutes if interval is zero,
+// until it is published, another error happens, or ctx is done.
+func (s *ReportingService) WaitForFinanceReport(ctx context.Context, params *DownloadFinanceReportsQuery, interval time.Duration) (io.Reader, *Response, error) {
+	return waitForReport(ctx, time.Time{}, interval, func() (io.Reader, *Response, error) {
+		return s.DownloadFinanceReports(ctx, params)
+	})
+}
+
+func waitForReport(ctx context.Context, availableAt time.Time, interval time.Duration, download func() (io.Reader, *Response, error)) (io.Reader, *Response, error) {
+	if interval <= 0 {
+		interval = defaultReportPollInterval
+	}
+
+	if err := sleepContext(ctx, time.Until(availableAt)); err != nil {
+		return nil, nil, err
+	}
+
+	for {
+		report, resp, err := download()
+		if !IsReportNotReady(err) {
+			return report, resp, err
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return nil, resp, err
+		}
+	}
+}
+
+// sleepContext waits for d, or returns the error of ctx if it is done first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func parseReportDate(value string) (time.Time, bool) {
+	for _, layout := range []string{dateFormat, "2006-01", "2006"} {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+// pacificTime returns the time zone of App Store Connect's publication schedule, falling back to Pacific Standard
+// Time if the time zone database is unavailable.
+func pacificTime() *time.Location {
+	if location, err := time.LoadLocation("America/Los_Angeles"); err == nil {
+		return location
+	}
+
+	return time.FixedZone("PST", -8*60*60)
+}
@@ -0,0 +1,169 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testReportNotReady = `{"errors":[{"status":"404","code":"NOT_FOUND","detail":"Report is not available yet. Daily reports for the Americas are available by 5 am Pacific Time."}]}`
+	testReportNoSales  = `{"errors":[{"status":"404","code":"NOT_FOUND","detail":"There were no sales for the date specified."}]}`
+)
+
+// newNotReadyServer fails with testReportNotReady the first notReady times it is requested, then serves report.
+func newNotReadyServer(notReady int, report string) (*Client, *httptest.Server, *int) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if requests <= notReady {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, testReportNotReady)
+
+			return
+		}
+
+		fmt.Fprint(w, report)
+	}))
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client, server, &requests
+}
+
+func TestIsReportNotReady(t *testing.T) {
+	t.Parallel()
+
+	notFound := &http.Response{StatusCode: http.StatusNotFound}
+
+	assert.True(t, IsReportNotReady(ErrReportNotAvailable))
+	assert.True(t, IsReportNotReady(fmt.Errorf("wrapped: %w", &ErrorResponse{
+		Response: notFound,
+		Errors:   []ErrorResponseError{{Detail: "Report is not available yet."}},
+	})))
+	assert.False(t, IsReportNotReady(&ErrorResponse{
+		Response: notFound,
+		Errors:   []ErrorResponseError{{Detail: "There were no sales for the date specified."}},
+	}))
+	assert.False(t, IsReportNotReady(&ErrorResponse{
+		Response: &http.Response{StatusCode: http.StatusBadRequest},
+		Errors:   []ErrorResponseError{{Detail: "Report is not available yet."}},
+	}))
+	assert.False(t, IsReportNotReady(errors.New("not available yet")))
+	assert.False(t, IsReportNotReady(nil))
+}
+
+func TestReportAvailableAt(t *testing.T) {
+	t.Parallel()
+
+	pacific := pacificTime()
+
+	assert.Equal(t, time.Date(2024, 2, 1, 5, 0, 0, 0, pacific), ReportAvailableAt(SalesReportFrequencyDaily, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 2, 5, 5, 0, 0, 0, pacific), ReportAvailableAt(SalesReportFrequencyWeekly, time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2024, 2, 5, 5, 0, 0, 0, pacific), ReportAvailableAt(SalesReportFrequencyMonthly, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, time.Date(2025, 1, 6, 5, 0, 0, 0, pacific), ReportAvailableAt(SalesReportFrequencyYearly, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+}
+
+func TestWaitForSalesAndTrendsReport(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newNotReadyServer(2, "report")
+	defer server.Close()
+
+	params := &DownloadSalesAndTrendsReportsQuery{
+		FilterFrequency:  []string{"DAILY"},
+		FilterReportDate: []string{"2024-01-31"},
+	}
+
+	report, _, err := client.Reporting.WaitForSalesAndTrendsReport(context.Background(), params, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, *requests)
+
+	b, _ := io.ReadAll(report)
+	assert.Equal(t, "report", string(b))
+}
+
+func TestWaitForSalesAndTrendsReportCanceled(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newNotReadyServer(0, "report")
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// Today's report is not expected before tomorrow, so the wait outlasts ctx.
+	params := &DownloadSalesAndTrendsReportsQuery{
+		FilterFrequency:  []string{"DAILY"},
+		FilterReportDate: []string{time.Now().Format(dateFormat)},
+	}
+
+	report, _, err := client.Reporting.WaitForSalesAndTrendsReport(ctx, params, 0)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	assert.Nil(t, report)
+	assert.Equal(t, 0, *requests)
+}
+
+func TestWaitForFinanceReport(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newNotReadyServer(1, "report")
+	defer server.Close()
+
+	_, _, err := client.Reporting.WaitForFinanceReport(context.Background(), &DownloadFinanceReportsQuery{}, time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, *requests)
+}
+
+func TestWaitForFinanceReportError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(testReportNoSales, http.StatusNotFound, false)
+	defer server.Close()
+
+	_, _, err := client.Reporting.WaitForFinanceReport(context.Background(), &DownloadFinanceReportsQuery{}, time.Millisecond)
+	assert.Error(t, err)
+	assert.False(t, IsReportNotReady(err))
+}
+
+func TestParseReportDate(t *testing.T) {
+	t.Parallel()
+
+	date, ok := parseReportDate("2024-01")
+	assert.True(t, ok)
+	assert.Equal(t, time.January, date.Month())
+
+	_, ok = parseReportDate("January")
+	assert.False(t, ok)
+}
@@ -0,0 +1,145 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"sync"
+	"time"
+)
+
+// ReportChecksumStore persists the checksum of the last report processed under an ID, such as a report key and
+// date, for a ReportDeduplicator.
+type ReportChecksumStore interface {
+	// Checksum returns the checksum recorded for id, or false if there is none.
+	Checksum(id string) (string, bool, error)
+	// SetChecksum records the checksum of the report processed for id.
+	SetChecksum(id string, checksum string) error
+}
+
+// MemoryReportChecksumStore is a ReportChecksumStore kept in memory. It is safe for concurrent use.
+type MemoryReportChecksumStore struct {
+	mu        sync.Mutex
+	checksums map[string]string
+}
+
+// Checksum returns the checksum recorded for id, or false if there is none.
+func (m *MemoryReportChecksumStore) Checksum(id string) (string, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	checksum, ok := m.checksums[id]
+
+	return checksum, ok, nil
+}
+
+// SetChecksum records the checksum of the report processed for id.
+func (m *MemoryReportChecksumStore) SetChecksum(id string, checksum string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.checksums == nil {
+		m.checksums = make(map[string]string)
+	}
+
+	m.checksums[id] = checksum
+
+	return nil
+}
+
+// ReportDeduplicator skips processing a report again when its content has not changed since it was last processed,
+// such as when App Store Connect regenerates a report identically. Reports are compared by the SHA-256 checksum of
+// their uncompressed content, so that recompressing a report does not make it look different.
+type ReportDeduplicator struct {
+	Store ReportChecksumStore
+}
+
+// NewReportDeduplicator creates a ReportDeduplicator that records checksums in store. If store is nil, checksums are
+// kept in memory.
+func NewReportDeduplicator(store ReportChecksumStore) *ReportDeduplicator {
+	if store == nil {
+		store = &MemoryReportChecksumStore{}
+	}
+
+	return &ReportDeduplicator{Store: store}
+}
+
+// Process reads report and calls process with its content, unless a report with the same content was already
+// processed for id. The checksum is only recorded once process succeeds. It returns whether process was called.
+func (d *ReportDeduplicator) Process(id string, report io.Reader, process func(report io.Reader) error) (bool, error) {
+	content, err := io.ReadAll(report)
+	if err != nil {
+		return false, err
+	}
+
+	checksum, err := reportChecksum(content)
+	if err != nil {
+		return false, err
+	}
+
+	previous, ok, err := d.Store.Checksum(id)
+	if err != nil {
+		return false, err
+	}
+
+	if ok && previous == checksum {
+		return false, nil
+	}
+
+	if err := process(bytes.NewReader(content)); err != nil {
+		return true, err
+	}
+
+	return true, d.Store.SetChecksum(id, checksum)
+}
+
+// Handler wraps a ReportHandler, such as the one of a ReportSyncer, so that it skips reports it already processed.
+// Reports are identified by their key and date.
+func (d *ReportDeduplicator) Handler(handler ReportHandler) ReportHandler {
+	return func(ctx context.Context, key ReportKey, date time.Time, report io.Reader) error {
+		id := key.String() + "@" + date.Format(dateFormat)
+
+		_, err := d.Process(id, report, func(report io.Reader) error {
+			return handler(ctx, key, date, report)
+		})
+
+		return err
+	}
+}
+
+// reportChecksum returns the hex-encoded SHA-256 checksum of the uncompressed content of a report.
+func reportChecksum(content []byte) (string, error) {
+	r, err := decompressReport(bytes.NewReader(content))
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, r); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
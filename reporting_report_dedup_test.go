@@ -0,0 +1,117 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportDeduplicatorProcess(t *testing.T) {
+	t.Parallel()
+
+	dedup := NewReportDeduplicator(nil)
+	calls := 0
+	process := func(report io.Reader) error {
+		b, err := io.ReadAll(report)
+		assert.NotEmpty(t, b)
+
+		calls++
+
+		return err
+	}
+
+	processed, err := dedup.Process("a", gzipped(t, testSalesReport), process)
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	// The same content, compressed or not, is skipped.
+	processed, err = dedup.Process("a", strings.NewReader(testSalesReport), process)
+	assert.NoError(t, err)
+	assert.False(t, processed)
+
+	// Changed content, or another ID, is processed.
+	processed, err = dedup.Process("a", strings.NewReader(testSalesReport+"\n"), process)
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	processed, err = dedup.Process("b", strings.NewReader(testSalesReport), process)
+	assert.NoError(t, err)
+	assert.True(t, processed)
+
+	assert.Equal(t, 3, calls)
+}
+
+func TestReportDeduplicatorProcessError(t *testing.T) {
+	t.Parallel()
+
+	dedup := NewReportDeduplicator(&MemoryReportChecksumStore{})
+	errProcess := errors.New("process failed")
+
+	processed, err := dedup.Process("a", strings.NewReader(testSalesReport), func(report io.Reader) error {
+		return errProcess
+	})
+	assert.True(t, processed)
+	assert.True(t, errors.Is(err, errProcess))
+
+	// A failed report is not recorded, so it is processed again.
+	processed, err = dedup.Process("a", strings.NewReader(testSalesReport), func(report io.Reader) error {
+		return nil
+	})
+	assert.True(t, processed)
+	assert.NoError(t, err)
+
+	processed, err = dedup.Process("a", strings.NewReader("\x1f\x8bnot gzip"), func(report io.Reader) error {
+		return nil
+	})
+	assert.False(t, processed)
+	assert.Error(t, err)
+}
+
+func TestReportDeduplicatorHandler(t *testing.T) {
+	t.Parallel()
+
+	dedup := NewReportDeduplicator(nil)
+	calls := 0
+	handler := dedup.Handler(func(ctx context.Context, key ReportKey, date time.Time, report io.Reader) error {
+		calls++
+
+		return nil
+	})
+
+	date := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, handler(context.Background(), testReportKey, date, strings.NewReader("a")))
+	assert.NoError(t, handler(context.Background(), testReportKey, date, strings.NewReader("a")))
+	assert.NoError(t, handler(context.Background(), testReportKey, date.AddDate(0, 0, 1), strings.NewReader("a")))
+	assert.Equal(t, 2, calls)
+
+	checksum, ok, err := dedup.Store.Checksum("85012345/SALES/SUMMARY/DAILY@2024-02-01")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Len(t, checksum, 64)
+}
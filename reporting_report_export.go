@@ -0,0 +1,301 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ReportWriter writes parsed report rows, such as SalesReportRow, to a file format that data warehouses can load.
+// CSVReportWriter and JSONLReportWriter are provided; other formats, such as Parquet, can be supported by
+// implementing this interface on top of a library for that format.
+type ReportWriter interface {
+	// Write writes a single row, which is a struct tagged like SalesReportRow or a pointer to one. Every row
+	// written to a ReportWriter must be of the same type.
+	Write(row interface{}) error
+	// Flush writes any buffered data to the underlying writer.
+	Flush() error
+}
+
+// ReportColumn describes a column of an exported report.
+type ReportColumn struct {
+	// Name is the normalized name of the column, such as "developer_proceeds".
+	Name string
+	// Header is the name of the column in the original report, such as "Developer Proceeds".
+	Header string
+
+	index int
+}
+
+// ReportColumns returns the columns a row type is exported with, in the order of its fields. row is a struct tagged
+// like SalesReportRow, or a pointer to one.
+func ReportColumns(row interface{}) ([]ReportColumn, error) {
+	rowType, err := reportRowType(row)
+	if err != nil {
+		return nil, err
+	}
+
+	return reportExportColumns(rowType), nil
+}
+
+// CSVReportWriter writes report rows as comma-separated values, preceded by a header of normalized column names.
+type CSVReportWriter struct {
+	writer  *csv.Writer
+	rowType reflect.Type
+	columns []ReportColumn
+	record  []string
+}
+
+// NewCSVReportWriter creates a CSVReportWriter that writes to w.
+func NewCSVReportWriter(w io.Writer) *CSVReportWriter {
+	return &CSVReportWriter{writer: csv.NewWriter(w)}
+}
+
+// Write writes a row, preceded by the header if it is the first one.
+func (w *CSVReportWriter) Write(row interface{}) error {
+	v, err := reportRowValue(row, w.rowType)
+	if err != nil {
+		return err
+	}
+
+	if w.rowType == nil {
+		if err := w.writeHeader(v.Type()); err != nil {
+			return err
+		}
+	}
+
+	for i, column := range w.columns {
+		w.record[i] = formatReportValue(v.Field(column.index))
+	}
+
+	return w.writer.Write(w.record)
+}
+
+func (w *CSVReportWriter) writeHeader(rowType reflect.Type) error {
+	w.rowType = rowType
+	w.columns = reportExportColumns(rowType)
+	w.record = make([]string, len(w.columns))
+
+	for i, column := range w.columns {
+		w.record[i] = column.Name
+	}
+
+	return w.writer.Write(w.record)
+}
+
+// Flush writes any buffered rows to the underlying writer.
+func (w *CSVReportWriter) Flush() error {
+	w.writer.Flush()
+
+	return w.writer.Error()
+}
+
+// JSONLReportWriter writes report rows as JSON Lines, one object per row keyed by normalized column names. Numbers
+// are written as JSON numbers, dates as strings formatted like "2006-01-02", and date-times as RFC 3339 strings.
+type JSONLReportWriter struct {
+	writer  io.Writer
+	rowType reflect.Type
+	columns []ReportColumn
+	buf     []byte
+}
+
+// NewJSONLReportWriter creates a JSONLReportWriter that writes to w.
+func NewJSONLReportWriter(w io.Writer) *JSONLReportWriter {
+	return &JSONLReportWriter{writer: w}
+}
+
+// Write writes a row as a single line.
+func (w *JSONLReportWriter) Write(row interface{}) error {
+	v, err := reportRowValue(row, w.rowType)
+	if err != nil {
+		return err
+	}
+
+	if w.rowType == nil {
+		w.rowType = v.Type()
+		w.columns = reportExportColumns(w.rowType)
+	}
+
+	buf := append(w.buf[:0], '{')
+
+	for i, column := range w.columns {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+
+		key, _ := json.Marshal(column.Name)
+		buf = append(buf, key...)
+		buf = append(buf, ':')
+
+		value, err := marshalReportValue(v.Field(column.index))
+		if err != nil {
+			return err
+		}
+
+		buf = append(buf, value...)
+	}
+
+	buf = append(buf, '}', '\n')
+	w.buf = buf
+
+	_, err = w.writer.Write(buf)
+
+	return err
+}
+
+// Flush is a no-op, as rows are written as soon as they are received.
+func (w *JSONLReportWriter) Flush() error {
+	return nil
+}
+
+// ExportReport writes every row of a report stream to w, flushes it, and returns the number of rows written.
+func ExportReport(stream *ReportStream, w ReportWriter) (int, error) {
+	row := reflect.New(stream.rowType)
+	count := 0
+
+	for {
+		err := stream.Scan(row.Interface())
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return count, err
+		}
+
+		if err := w.Write(row.Interface()); err != nil {
+			return count, err
+		}
+
+		count++
+	}
+
+	return count, w.Flush()
+}
+
+// reportRowValue returns the struct held by row, checking that it is of rowType unless rowType is nil.
+func reportRowValue(row interface{}, rowType reflect.Type) (reflect.Value, error) {
+	v := reflect.Indirect(reflect.ValueOf(row))
+	if !v.IsValid() || v.Kind() != reflect.Struct || (rowType != nil && v.Type() != rowType) {
+		return reflect.Value{}, fmt.Errorf("%w: unexpected row of type %T", ErrReportColumnType, row)
+	}
+
+	return v, nil
+}
+
+func reportExportColumns(rowType reflect.Type) []ReportColumn {
+	columns := make([]ReportColumn, 0, rowType.NumField())
+
+	for i := 0; i < rowType.NumField(); i++ {
+		tag := rowType.Field(i).Tag.Get("tsv")
+		if tag == "" || tag == "-" {
+			continue
+		}
+
+		columns = append(columns, ReportColumn{
+			Name:   normalizeReportColumn(tag),
+			Header: tag,
+			index:  i,
+		})
+	}
+
+	return columns
+}
+
+// normalizeReportColumn turns a report header, such as "Customer Price" or "Proceeds Reason", into a snake_case
+// column name that data warehouses accept.
+func normalizeReportColumn(header string) string {
+	var b strings.Builder
+
+	underscore := false
+
+	for _, r := range strings.TrimSpace(header) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if underscore && b.Len() > 0 {
+				b.WriteByte('_')
+			}
+
+			b.WriteRune(unicode.ToLower(r))
+
+			underscore = false
+		} else {
+			underscore = true
+		}
+	}
+
+	return b.String()
+}
+
+func formatReportValue(field reflect.Value) string {
+	if date, ok := field.Interface().(Date); ok {
+		if date.IsZero() {
+			return ""
+		}
+
+		return date.Format(dateFormat)
+	}
+
+	if dateTime, ok := field.Interface().(DateTime); ok {
+		if dateTime.IsZero() {
+			return ""
+		}
+
+		return dateTime.Format(time.RFC3339)
+	}
+
+	switch field.Kind() {
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(field.Int(), 10)
+	case reflect.Float64:
+		return strconv.FormatFloat(field.Float(), 'f', -1, 64)
+	case reflect.Bool:
+		return strconv.FormatBool(field.Bool())
+	default:
+		return field.String()
+	}
+}
+
+func marshalReportValue(field reflect.Value) ([]byte, error) {
+	if date, ok := field.Interface().(Date); ok {
+		if date.IsZero() {
+			return []byte("null"), nil
+		}
+
+		return json.Marshal(date.Format(dateFormat))
+	}
+
+	if dateTime, ok := field.Interface().(DateTime); ok {
+		if dateTime.IsZero() {
+			return []byte("null"), nil
+		}
+
+		return json.Marshal(dateTime.Format(time.RFC3339))
+	}
+
+	return json.Marshal(field.Interface())
+}
@@ -0,0 +1,128 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testExportRow struct {
+	Name      string  `tsv:"Product Name"`
+	Units     int     `tsv:"Units"`
+	Proceeds  float64 `tsv:"Developer Proceeds"`
+	BeginDate Date    `tsv:"Begin Date"`
+	Ignored   string
+}
+
+var testExportRows = []testExportRow{
+	{Name: "App, \"Pro\"", Units: 2, Proceeds: 1.4, BeginDate: Date{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)}, Ignored: "x"},
+	{Name: "Coins", Units: -1, Proceeds: -0.7},
+}
+
+func TestReportColumns(t *testing.T) {
+	t.Parallel()
+
+	columns, err := ReportColumns(&testExportRow{})
+	assert.NoError(t, err)
+	assert.Len(t, columns, 4)
+	assert.Equal(t, "product_name", columns[0].Name)
+	assert.Equal(t, "Product Name", columns[0].Header)
+	assert.Equal(t, "developer_proceeds", columns[2].Name)
+
+	_, err = ReportColumns(1)
+	assert.True(t, errors.Is(err, ErrReportColumnType))
+}
+
+func TestNormalizeReportColumn(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "customer_price", normalizeReportColumn("Customer Price"))
+	assert.Equal(t, "apple_id", normalizeReportColumn(" Apple ID "))
+	assert.Equal(t, "proceeds_usd", normalizeReportColumn("Proceeds (USD)"))
+	assert.Equal(t, "sku", normalizeReportColumn("SKU"))
+}
+
+func TestCSVReportWriter(t *testing.T) {
+	t.Parallel()
+
+	buf := new(bytes.Buffer)
+	w := NewCSVReportWriter(buf)
+
+	assert.NoError(t, w.Write(testExportRows[0]))
+	assert.NoError(t, w.Write(&testExportRows[1]))
+	assert.NoError(t, w.Flush())
+	assert.Equal(t, "product_name,units,developer_proceeds,begin_date\n"+
+		"\"App, \"\"Pro\"\"\",2,1.4,2024-01-31\n"+
+		"Coins,-1,-0.7,\n", buf.String())
+
+	assert.True(t, errors.Is(w.Write(SalesReportRow{}), ErrReportColumnType))
+	assert.True(t, errors.Is(w.Write(nil), ErrReportColumnType))
+}
+
+func TestJSONLReportWriter(t *testing.T) {
+	t.Parallel()
+
+	buf := new(bytes.Buffer)
+	w := NewJSONLReportWriter(buf)
+
+	for _, row := range testExportRows {
+		assert.NoError(t, w.Write(row))
+	}
+
+	assert.NoError(t, w.Flush())
+	assert.Equal(t, `{"product_name":"App, \"Pro\"","units":2,"developer_proceeds":1.4,"begin_date":"2024-01-31"}`+"\n"+
+		`{"product_name":"Coins","units":-1,"developer_proceeds":-0.7,"begin_date":null}`+"\n", buf.String())
+
+	assert.True(t, errors.Is(w.Write(&SalesReportRow{}), ErrReportColumnType))
+}
+
+func TestExportReport(t *testing.T) {
+	t.Parallel()
+
+	stream, err := NewReportStream(gzipped(t, testSalesReport), SalesReportRow{})
+	assert.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+	count, err := ExportReport(stream, NewCSVReportWriter(buf))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Len(t, lines, 3)
+	assert.True(t, strings.HasPrefix(lines[0], "provider,provider_country,sku,"))
+}
+
+func TestExportReportError(t *testing.T) {
+	t.Parallel()
+
+	stream, err := NewReportStream(strings.NewReader("Units\nnope\n"), SalesReportRow{})
+	assert.NoError(t, err)
+
+	count, err := ExportReport(stream, NewJSONLReportWriter(new(bytes.Buffer)))
+	assert.True(t, errors.Is(err, ErrReportColumnType))
+	assert.Equal(t, 0, count)
+}
@@ -0,0 +1,155 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// ReportStream decodes the rows of a tab-separated report one at a time, so that reports too large to hold in
+// memory, such as daily Subscription Event reports, can be processed with bounded memory.
+type ReportStream struct {
+	closer  io.Closer
+	columns []int
+	header  []string
+	reader  *csv.Reader
+	rowType reflect.Type
+}
+
+// NewReportStream reads the header of a tab-separated report and returns a stream of its rows. row is the
+// struct, or a pointer to the struct, that each row is decoded into, like SalesReportRow. As with ParseReport,
+// gzipped reports are decompressed transparently.
+func NewReportStream(r io.Reader, row interface{}) (*ReportStream, error) {
+	rowType, err := reportRowType(row)
+	if err != nil {
+		return nil, err
+	}
+
+	stream := &ReportStream{rowType: rowType}
+	if closer, ok := r.(io.Closer); ok {
+		stream.closer = closer
+	}
+
+	r, err = decompressReport(r)
+	if err != nil {
+		return nil, err
+	}
+
+	stream.reader = newReportReader(r)
+
+	header, err := stream.reader.Read()
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	// The reader reuses its record, so the header is copied to outlive the next read.
+	stream.header = append([]string(nil), header...)
+	stream.columns = reportColumns(rowType, stream.header)
+
+	return stream, nil
+}
+
+// StreamSalesAndTrendsReport downloads a Sales and Trends report and returns a stream of its rows, decoded into
+// the type of row. Unlike DownloadSalesAndTrendsReports, the report is decoded while it is being downloaded rather
+// than buffered in memory. The stream must be closed to release the connection.
+func (s *ReportingService) StreamSalesAndTrendsReport(ctx context.Context, params *DownloadSalesAndTrendsReportsQuery, row interface{}) (*ReportStream, error) {
+	pr, pw := io.Pipe()
+
+	go func() {
+		_, err := s.client.get(ctx, "salesReports", params, pw, withAccept("application/a-gzip"))
+		pw.CloseWithError(err)
+	}()
+
+	stream, err := NewReportStream(pr, row)
+	if err != nil {
+		pr.Close()
+
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// Header returns the column names of the report.
+func (s *ReportStream) Header() []string {
+	return s.header
+}
+
+// Next decodes the next row of the report and returns a pointer to it, such as a *SalesReportRow. io.EOF is
+// returned once every row has been read.
+func (s *ReportStream) Next() (interface{}, error) {
+	row := reflect.New(s.rowType)
+	if err := s.next(row.Elem()); err != nil {
+		return nil, err
+	}
+
+	return row.Interface(), nil
+}
+
+// Scan decodes the next row of the report into row, which must be a pointer to the type the stream was created
+// with. Unlike Next, it lets a caller reuse a single row. io.EOF is returned once every row has been read.
+func (s *ReportStream) Scan(row interface{}) error {
+	v := reflect.ValueOf(row)
+	if v.Kind() != reflect.Ptr || v.Elem().Type() != s.rowType {
+		return fmt.Errorf("%w: expected *%s, got %T", ErrReportColumnType, s.rowType, row)
+	}
+
+	v.Elem().Set(reflect.Zero(s.rowType))
+
+	return s.next(v.Elem())
+}
+
+func (s *ReportStream) next(row reflect.Value) error {
+	record, err := s.reader.Read()
+	if err != nil {
+		return err
+	}
+
+	return decodeReportRecord(row, s.columns, s.header, record)
+}
+
+// Close releases the underlying reader, if it can be closed. Closing a stream returned by
+// StreamSalesAndTrendsReport stops the download.
+func (s *ReportStream) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+
+	return s.closer.Close()
+}
+
+func reportRowType(row interface{}) (reflect.Type, error) {
+	rowType := reflect.TypeOf(row)
+	if rowType != nil && rowType.Kind() == reflect.Ptr {
+		rowType = rowType.Elem()
+	}
+
+	if rowType == nil || rowType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: expected a struct, got %T", ErrReportColumnType, row)
+	}
+
+	return rowType, nil
+}
@@ -0,0 +1,133 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReportStreamNext(t *testing.T) {
+	t.Parallel()
+
+	stream, err := NewReportStream(gzipped(t, testSalesReport), SalesReportRow{})
+	assert.NoError(t, err)
+	assert.Equal(t, "Provider", stream.Header()[0])
+
+	row, err := stream.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "com.app", row.(*SalesReportRow).SKU)
+
+	row, err = stream.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, -0.99, row.(*SalesReportRow).CustomerPrice)
+
+	row, err = stream.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+	assert.Nil(t, row)
+	assert.NoError(t, stream.Close())
+}
+
+func TestReportStreamScan(t *testing.T) {
+	t.Parallel()
+
+	stream, err := NewReportStream(strings.NewReader(testSalesReport), &SalesReportRow{})
+	assert.NoError(t, err)
+
+	var row SalesReportRow
+
+	assert.NoError(t, stream.Scan(&row))
+	assert.Equal(t, "1.0", row.Version)
+	assert.NoError(t, stream.Scan(&row))
+	assert.Equal(t, "", row.Version)
+	assert.True(t, errors.Is(stream.Scan(&row), io.EOF))
+
+	var wrong SubscriberReportRow
+
+	assert.True(t, errors.Is(stream.Scan(&wrong), ErrReportColumnType))
+}
+
+func TestReportStreamEmpty(t *testing.T) {
+	t.Parallel()
+
+	stream, err := NewReportStream(strings.NewReader(""), SalesReportRow{})
+	assert.NoError(t, err)
+
+	_, err = stream.Next()
+	assert.True(t, errors.Is(err, io.EOF))
+}
+
+func TestNewReportStreamErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewReportStream(strings.NewReader(testSalesReport), "")
+	assert.True(t, errors.Is(err, ErrReportColumnType))
+
+	_, err = NewReportStream(strings.NewReader(testSalesReport), nil)
+	assert.True(t, errors.Is(err, ErrReportColumnType))
+
+	_, err = NewReportStream(strings.NewReader("\x1f\x8bnot gzip"), SalesReportRow{})
+	assert.Error(t, err)
+}
+
+func TestStreamSalesAndTrendsReport(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(testSalesReport, http.StatusOK, false)
+	defer server.Close()
+
+	stream, err := client.Reporting.StreamSalesAndTrendsReport(context.Background(), &DownloadSalesAndTrendsReportsQuery{}, SalesReportRow{})
+	assert.NoError(t, err)
+
+	defer stream.Close()
+
+	count := 0
+
+	for {
+		_, err := stream.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		assert.NoError(t, err)
+
+		count++
+	}
+
+	assert.Equal(t, 2, count)
+}
+
+func TestStreamSalesAndTrendsReportError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"status":"404","code":"NOT_FOUND"}]}`, http.StatusNotFound, false)
+	defer server.Close()
+
+	stream, err := client.Reporting.StreamSalesAndTrendsReport(context.Background(), &DownloadSalesAndTrendsReportsQuery{}, SalesReportRow{})
+	assert.Error(t, err)
+	assert.Nil(t, stream)
+}
@@ -0,0 +1,298 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+// ReportKey identifies a series of Sales and Trends reports synced by a ReportSyncer.
+type ReportKey struct {
+	VendorNumber  string
+	ReportType    SalesReportType
+	ReportSubType SalesReportSubType
+	Frequency     SalesReportFrequency
+	// Version is the version of the report format, or empty for the one listed in SalesReportSpecs.
+	Version string
+}
+
+// String returns the key as a path, such as "85012345/SALES/SUMMARY/DAILY".
+func (k ReportKey) String() string {
+	s := fmt.Sprintf("%s/%s/%s/%s", k.VendorNumber, k.ReportType, k.ReportSubType, k.Frequency)
+	if k.Version != "" {
+		s += "/" + k.Version
+	}
+
+	return s
+}
+
+// ReportSyncState stores the date of the last report a ReportSyncer handled successfully for each ReportKey, so that
+// a sync can resume where the previous one stopped.
+type ReportSyncState interface {
+	// LastSynced returns the date of the last report synced for key, or false if none was.
+	LastSynced(key ReportKey) (time.Time, bool, error)
+	// SetLastSynced records that the report of date was synced for key.
+	SetLastSynced(key ReportKey, date time.Time) error
+}
+
+// MemoryReportSyncState is a ReportSyncState kept in memory. It is safe for concurrent use.
+type MemoryReportSyncState struct {
+	mu    sync.Mutex
+	dates map[ReportKey]time.Time
+}
+
+// LastSynced returns the date of the last report synced for key, or false if none was.
+func (m *MemoryReportSyncState) LastSynced(key ReportKey) (time.Time, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	date, ok := m.dates[key]
+
+	return date, ok, nil
+}
+
+// SetLastSynced records that the report of date was synced for key.
+func (m *MemoryReportSyncState) SetLastSynced(key ReportKey, date time.Time) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.dates == nil {
+		m.dates = make(map[ReportKey]time.Time)
+	}
+
+	m.dates[key] = date
+
+	return nil
+}
+
+// ReportHandler processes a downloaded report. report holds the gzipped report, ready for ParseReport or
+// NewReportStream. If a dated report had no sales, report is empty.
+type ReportHandler func(ctx context.Context, key ReportKey, date time.Time, report io.Reader) error
+
+// ReportSyncer downloads the Sales and Trends reports published since the last sync, one date at a time, and hands
+// each to Handler.
+type ReportSyncer struct {
+	// State tracks the last report synced for each key. It defaults to a MemoryReportSyncState.
+	State ReportSyncState
+	// Handler is called with every report, in date order. If it fails, the sync of the key stops and the report
+	// is requested again by the next sync.
+	Handler ReportHandler
+	// Since is the date of the first report to sync for keys without state. If zero, only the latest report is synced.
+	Since time.Time
+	// BackOff returns the policy used to retry a report that is not available yet. It defaults to
+	// backoff.NewExponentialBackOff. When retries run out, the sync of the key stops without an error.
+	BackOff func() backoff.BackOff
+	// Now returns the current time. It defaults to time.Now.
+	Now func() time.Time
+
+	service *ReportingService
+}
+
+// NewReportSyncer creates a ReportSyncer that hands each report to handler.
+func (s *ReportingService) NewReportSyncer(handler ReportHandler) *ReportSyncer {
+	return &ReportSyncer{
+		State:   &MemoryReportSyncState{},
+		Handler: handler,
+		BackOff: func() backoff.BackOff { return backoff.NewExponentialBackOff() },
+		Now:     time.Now,
+		service: s,
+	}
+}
+
+// Sync downloads and handles the missing reports of every key, in order.
+func (s *ReportSyncer) Sync(ctx context.Context, keys ...ReportKey) error {
+	for _, key := range keys {
+		if err := s.syncKey(ctx, key); err != nil {
+			return fmt.Errorf("%s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+// MissingDates returns the dates of the reports of key published since the last sync, oldest first.
+func (s *ReportSyncer) MissingDates(key ReportKey) ([]time.Time, error) {
+	latest := latestReportDate(key.Frequency, s.Now())
+
+	last, ok, err := s.State.LastSynced(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var first time.Time
+
+	switch {
+	case ok:
+		first = nextReportDate(key.Frequency, last)
+	case !s.Since.IsZero():
+		first = alignReportDate(key.Frequency, s.Since)
+	default:
+		first = latest
+	}
+
+	var dates []time.Time
+	for date := first; !date.After(latest); date = nextReportDate(key.Frequency, date) {
+		dates = append(dates, date)
+	}
+
+	return dates, nil
+}
+
+func (s *ReportSyncer) syncKey(ctx context.Context, key ReportKey) error {
+	dates, err := s.MissingDates(key)
+	if err != nil {
+		return err
+	}
+
+	for _, date := range dates {
+		report, err := s.download(ctx, key, date)
+		if errors.Is(err, ErrReportNotAvailable) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		if err := s.Handler(ctx, key, date, report); err != nil {
+			return err
+		}
+
+		if err := s.State.SetLastSynced(key, date); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// download fetches the report of key for date, retrying while it is not available yet. A report that does not
+// exist because there were no sales is returned empty.
+func (s *ReportSyncer) download(ctx context.Context, key ReportKey, date time.Time) (io.Reader, error) {
+	params := &DownloadSalesAndTrendsReportsQuery{
+		FilterFrequency:     []string{string(key.Frequency)},
+		FilterReportDate:    []string{formatReportDate(key.Frequency, date)},
+		FilterReportSubType: []string{string(key.ReportSubType)},
+		FilterReportType:    []string{string(key.ReportType)},
+		FilterVendorNumber:  []string{key.VendorNumber},
+	}
+	if key.Version != "" {
+		params.FilterVersion = []string{key.Version}
+	} else if spec, ok := LookupSalesReportSpec(key.ReportType, key.ReportSubType); ok {
+		params.FilterVersion = []string{string(spec.Version)}
+	}
+
+	var report io.Reader
+
+	op := func() error {
+		var err error
+
+		report, _, err = s.service.DownloadSalesAndTrendsReports(ctx, params)
+
+		switch {
+		case err == nil:
+			return nil
+		case IsReportNotReady(err):
+			return ErrReportNotAvailable
+		case isReportNotFound(err):
+			report = strings.NewReader("")
+
+			return nil
+		default:
+			return backoff.Permanent(err)
+		}
+	}
+
+	if err := backoff.Retry(op, backoff.WithContext(s.BackOff(), ctx)); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// latestReportDate returns the date of the most recent report of frequency that can be published at now: the
+// previous day, the previous Sunday, the previous month or the previous year.
+func latestReportDate(frequency SalesReportFrequency, now time.Time) time.Time {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch frequency {
+	case SalesReportFrequencyWeekly:
+		days := int(today.Weekday())
+		if days == 0 {
+			days = 7
+		}
+
+		return today.AddDate(0, 0, -days)
+	case SalesReportFrequencyMonthly:
+		return time.Date(now.Year(), now.Month()-1, 1, 0, 0, 0, 0, time.UTC)
+	case SalesReportFrequencyYearly:
+		return time.Date(now.Year()-1, 1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return today.AddDate(0, 0, -1)
+	}
+}
+
+// alignReportDate returns the date of the first report of frequency that covers date.
+func alignReportDate(frequency SalesReportFrequency, date time.Time) time.Time {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.UTC)
+
+	switch frequency {
+	case SalesReportFrequencyWeekly:
+		return day.AddDate(0, 0, (7-int(day.Weekday()))%7)
+	case SalesReportFrequencyMonthly:
+		return time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.UTC)
+	case SalesReportFrequencyYearly:
+		return time.Date(date.Year(), 1, 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+func nextReportDate(frequency SalesReportFrequency, date time.Time) time.Time {
+	switch frequency {
+	case SalesReportFrequencyWeekly:
+		return date.AddDate(0, 0, 7)
+	case SalesReportFrequencyMonthly:
+		return date.AddDate(0, 1, 0)
+	case SalesReportFrequencyYearly:
+		return date.AddDate(1, 0, 0)
+	default:
+		return date.AddDate(0, 0, 1)
+	}
+}
+
+// formatReportDate formats date as expected by the filter[reportDate] parameter for frequency.
+func formatReportDate(frequency SalesReportFrequency, date time.Time) string {
+	switch frequency {
+	case SalesReportFrequencyMonthly:
+		return date.Format("2006-01")
+	case SalesReportFrequencyYearly:
+		return date.Format("2006")
+	default:
+		return date.Format(dateFormat)
+	}
+}
@@ -0,0 +1,228 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+var testReportKey = ReportKey{
+	VendorNumber:  "85012345",
+	ReportType:    SalesReportTypeSales,
+	ReportSubType: SalesReportSubTypeSummary,
+	Frequency:     SalesReportFrequencyDaily,
+}
+
+// newReportServer serves reports keyed by their filter[reportDate]. Dates mapped to an empty string are not available
+// yet, and dates missing from reports had no sales.
+func newReportServer(reports map[string]string) (*Client, *httptest.Server, *[]string) {
+	var (
+		mu    sync.Mutex
+		dates []string
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		date := r.URL.Query().Get("filter[reportDate]")
+
+		mu.Lock()
+		dates = append(dates, date)
+		mu.Unlock()
+
+		report, ok := reports[date]
+
+		switch {
+		case !ok:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"errors":[{"status":"404","code":"NOT_FOUND","detail":"There were no sales for the date specified."}]}`)
+		case report == "":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"errors":[{"status":"404","code":"NOT_FOUND","detail":"Report is not available yet. Daily reports for the Americas are available by 5 am Pacific Time."}]}`)
+		default:
+			fmt.Fprint(w, report)
+		}
+	}))
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client, server, &dates
+}
+
+func newTestReportSyncer(client *Client, handler ReportHandler) *ReportSyncer {
+	syncer := client.Reporting.NewReportSyncer(handler)
+	syncer.Now = func() time.Time { return time.Date(2024, 2, 4, 12, 0, 0, 0, time.UTC) }
+	syncer.BackOff = func() backoff.BackOff {
+		return backoff.WithMaxRetries(backoff.NewConstantBackOff(time.Millisecond), 2)
+	}
+
+	return syncer
+}
+
+func TestReportSyncerSync(t *testing.T) {
+	t.Parallel()
+
+	client, server, requested := newReportServer(map[string]string{
+		"2024-02-01": "a",
+		"2024-02-03": "c",
+	})
+	defer server.Close()
+
+	handled := map[string]string{}
+	syncer := newTestReportSyncer(client, func(ctx context.Context, key ReportKey, date time.Time, report io.Reader) error {
+		b, err := io.ReadAll(report)
+		handled[date.Format(dateFormat)] = string(b)
+
+		return err
+	})
+	syncer.Since = time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	err := syncer.Sync(context.Background(), testReportKey)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{"2024-02-01": "a", "2024-02-02": "", "2024-02-03": "c"}, handled)
+	assert.Equal(t, []string{"2024-02-01", "2024-02-02", "2024-02-03"}, *requested)
+
+	last, ok, err := syncer.State.LastSynced(testReportKey)
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC), last)
+
+	// Nothing new has been published since.
+	err = syncer.Sync(context.Background(), testReportKey)
+	assert.NoError(t, err)
+	assert.Len(t, *requested, 3)
+}
+
+func TestReportSyncerSyncNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	client, server, requested := newReportServer(map[string]string{
+		"2024-02-02": "b",
+		"2024-02-03": "",
+	})
+	defer server.Close()
+
+	syncer := newTestReportSyncer(client, func(ctx context.Context, key ReportKey, date time.Time, report io.Reader) error {
+		return nil
+	})
+	assert.NoError(t, syncer.State.SetLastSynced(testReportKey, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)))
+
+	err := syncer.Sync(context.Background(), testReportKey)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"2024-02-02", "2024-02-03", "2024-02-03", "2024-02-03"}, *requested)
+
+	last, _, _ := syncer.State.LastSynced(testReportKey)
+	assert.Equal(t, time.Date(2024, 2, 2, 0, 0, 0, 0, time.UTC), last)
+}
+
+func TestReportSyncerSyncHandlerError(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newReportServer(map[string]string{"2024-02-03": "c"})
+	defer server.Close()
+
+	errHandler := errors.New("handler failed")
+	syncer := newTestReportSyncer(client, func(ctx context.Context, key ReportKey, date time.Time, report io.Reader) error {
+		return errHandler
+	})
+
+	err := syncer.Sync(context.Background(), testReportKey)
+	assert.True(t, errors.Is(err, errHandler))
+
+	_, ok, _ := syncer.State.LastSynced(testReportKey)
+	assert.False(t, ok)
+}
+
+func TestReportSyncerSyncServerError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"status":"403","code":"FORBIDDEN_ERROR"}]}`, http.StatusForbidden, false)
+	defer server.Close()
+
+	syncer := newTestReportSyncer(client, func(ctx context.Context, key ReportKey, date time.Time, report io.Reader) error {
+		return nil
+	})
+
+	err := syncer.Sync(context.Background(), testReportKey)
+	assert.Error(t, err)
+}
+
+func TestReportSyncerMissingDates(t *testing.T) {
+	t.Parallel()
+
+	// Sunday, February 4, 2024.
+	syncer := NewClient(nil).Reporting.NewReportSyncer(nil)
+	syncer.Now = func() time.Time { return time.Date(2024, 2, 4, 12, 0, 0, 0, time.UTC) }
+	syncer.Since = time.Date(2023, 12, 15, 0, 0, 0, 0, time.UTC)
+
+	date := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+
+	weekly, err := syncer.MissingDates(ReportKey{Frequency: SalesReportFrequencyWeekly})
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{date(2023, 12, 17), date(2023, 12, 24), date(2023, 12, 31), date(2024, 1, 7), date(2024, 1, 14), date(2024, 1, 21), date(2024, 1, 28)}, weekly)
+
+	monthly, err := syncer.MissingDates(ReportKey{Frequency: SalesReportFrequencyMonthly})
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{date(2023, 12, 1), date(2024, 1, 1)}, monthly)
+
+	yearly, err := syncer.MissingDates(ReportKey{Frequency: SalesReportFrequencyYearly})
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{date(2023, 1, 1)}, yearly)
+
+	syncer.Since = time.Time{}
+
+	daily, err := syncer.MissingDates(ReportKey{Frequency: SalesReportFrequencyDaily})
+	assert.NoError(t, err)
+	assert.Equal(t, []time.Time{date(2024, 2, 3)}, daily)
+}
+
+func TestFormatReportDate(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, "2024-01-07", formatReportDate(SalesReportFrequencyDaily, date))
+	assert.Equal(t, "2024-01-07", formatReportDate(SalesReportFrequencyWeekly, date))
+	assert.Equal(t, "2024-01", formatReportDate(SalesReportFrequencyMonthly, date))
+	assert.Equal(t, "2024", formatReportDate(SalesReportFrequencyYearly, date))
+}
+
+func TestReportKeyString(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "85012345/SALES/SUMMARY/DAILY", testReportKey.String())
+
+	key := testReportKey
+	key.Version = "1_0"
+	assert.Equal(t, "85012345/SALES/SUMMARY/DAILY/1_0", key.String())
+}
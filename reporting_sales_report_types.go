@@ -0,0 +1,184 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidSalesReport happens when a combination of report type, subtype, frequency and version is not one that
+// App Store Connect publishes.
+var ErrInvalidSalesReport = errors.New("invalid sales report")
+
+// SalesReportVersion is the version of the format of a Sales and Trends report.
+type SalesReportVersion string
+
+const (
+	// SalesReportVersion1_0 is version 1_0 of a report format.
+	SalesReportVersion1_0 SalesReportVersion = "1_0"
+	// SalesReportVersion1_1 is version 1_1 of a report format.
+	SalesReportVersion1_1 SalesReportVersion = "1_1"
+	// SalesReportVersion1_3 is version 1_3 of a report format.
+	SalesReportVersion1_3 SalesReportVersion = "1_3"
+)
+
+var salesReportVersions = []SalesReportVersion{
+	SalesReportVersion1_0,
+	SalesReportVersion1_1,
+	SalesReportVersion1_3,
+}
+
+// IsValid reports whether the sales report version is one this package knows about.
+func (v SalesReportVersion) IsValid() bool {
+	for _, value := range salesReportVersions {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSalesReportVersion returns s as a SalesReportVersion, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseSalesReportVersion(s string) (SalesReportVersion, error) {
+	if v := SalesReportVersion(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "SalesReportVersion", Value: s}
+}
+
+// SalesReportSpec is a kind of Sales and Trends report that App Store Connect publishes, along with the
+// frequencies it is published at and the version of its format.
+type SalesReportSpec struct {
+	ReportType    SalesReportType
+	ReportSubType SalesReportSubType
+	Frequencies   []SalesReportFrequency
+	Version       SalesReportVersion
+}
+
+var (
+	allSalesReportFrequencies = []SalesReportFrequency{SalesReportFrequencyDaily, SalesReportFrequencyWeekly, SalesReportFrequencyMonthly, SalesReportFrequencyYearly}
+	dailySalesReportFrequency = []SalesReportFrequency{SalesReportFrequencyDaily}
+)
+
+// SalesReportSpecs lists every kind of Sales and Trends report.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/download_sales_and_trends_reports
+var SalesReportSpecs = []SalesReportSpec{
+	{SalesReportTypeSales, SalesReportSubTypeSummary, allSalesReportFrequencies, SalesReportVersion1_0},
+	{SalesReportTypeSales, SalesReportSubTypeSummaryInstallType, allSalesReportFrequencies, SalesReportVersion1_1},
+	{SalesReportTypeSales, SalesReportSubTypeSummaryTerritory, allSalesReportFrequencies, SalesReportVersion1_1},
+	{SalesReportTypeSales, SalesReportSubTypeSummaryChannel, allSalesReportFrequencies, SalesReportVersion1_1},
+	{SalesReportTypeSales, SalesReportSubTypeOptIn, []SalesReportFrequency{SalesReportFrequencyWeekly}, SalesReportVersion1_0},
+	{SalesReportTypePreOrder, SalesReportSubTypeSummary, allSalesReportFrequencies, SalesReportVersion1_0},
+	{SalesReportTypeNewsstand, SalesReportSubTypeDetailed, []SalesReportFrequency{SalesReportFrequencyDaily, SalesReportFrequencyWeekly}, SalesReportVersion1_0},
+	{SalesReportTypeSubscription, SalesReportSubTypeSummary, dailySalesReportFrequency, SalesReportVersion1_3},
+	{SalesReportTypeSubscriptionEvent, SalesReportSubTypeSummary, dailySalesReportFrequency, SalesReportVersion1_3},
+	{SalesReportTypeSubscriber, SalesReportSubTypeDetailed, dailySalesReportFrequency, SalesReportVersion1_3},
+	{SalesReportTypeSubscriptionOfferCodeRedemption, SalesReportSubTypeSummary, dailySalesReportFrequency, SalesReportVersion1_0},
+	{SalesReportTypeWinBackEligibility, SalesReportSubTypeSummary, dailySalesReportFrequency, SalesReportVersion1_0},
+}
+
+// LookupSalesReportSpec returns the spec of the reports of a type and subtype, or false if App Store Connect does
+// not publish such reports.
+func LookupSalesReportSpec(reportType SalesReportType, reportSubType SalesReportSubType) (SalesReportSpec, bool) {
+	for _, spec := range SalesReportSpecs {
+		if spec.ReportType == reportType && spec.ReportSubType == reportSubType {
+			return spec, true
+		}
+	}
+
+	return SalesReportSpec{}, false
+}
+
+// SupportsFrequency reports whether reports of this spec are published at frequency.
+func (s SalesReportSpec) SupportsFrequency(frequency SalesReportFrequency) bool {
+	for _, f := range s.Frequencies {
+		if f == frequency {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateSalesReport checks that a combination of report type, subtype, frequency and version can be downloaded,
+// and returns an error describing the valid values otherwise. An empty version is valid.
+func ValidateSalesReport(reportType SalesReportType, reportSubType SalesReportSubType, frequency SalesReportFrequency, version SalesReportVersion) error {
+	spec, ok := LookupSalesReportSpec(reportType, reportSubType)
+	if !ok {
+		var subTypes []string
+
+		for _, s := range SalesReportSpecs {
+			if s.ReportType == reportType {
+				subTypes = append(subTypes, string(s.ReportSubType))
+			}
+		}
+
+		if len(subTypes) == 0 {
+			return fmt.Errorf("%w: unknown report type %s", ErrInvalidSalesReport, reportType)
+		}
+
+		return fmt.Errorf("%w: %s reports have subtypes %s, not %s", ErrInvalidSalesReport, reportType, strings.Join(subTypes, ", "), reportSubType)
+	}
+
+	if !spec.SupportsFrequency(frequency) {
+		frequencies := make([]string, len(spec.Frequencies))
+		for i, f := range spec.Frequencies {
+			frequencies[i] = string(f)
+		}
+
+		return fmt.Errorf("%w: %s %s reports are published %s, not %s", ErrInvalidSalesReport, reportType, reportSubType, strings.Join(frequencies, ", "), frequency)
+	}
+
+	if version != "" && version != spec.Version {
+		return fmt.Errorf("%w: %s %s reports are version %s, not %s", ErrInvalidSalesReport, reportType, reportSubType, spec.Version, version)
+	}
+
+	return nil
+}
+
+// NewSalesReportQuery returns the query options to download a report, filling in the version of its format. An
+// error is returned if the combination is invalid. reportDate is formatted as expected for frequency, such as
+// "2024-01-31" for a daily report or "2024-01" for a monthly one; it can be empty for the latest report.
+func NewSalesReportQuery(vendorNumber string, reportType SalesReportType, reportSubType SalesReportSubType, frequency SalesReportFrequency, reportDate string) (*DownloadSalesAndTrendsReportsQuery, error) {
+	if err := ValidateSalesReport(reportType, reportSubType, frequency, ""); err != nil {
+		return nil, err
+	}
+
+	spec, _ := LookupSalesReportSpec(reportType, reportSubType)
+
+	query := &DownloadSalesAndTrendsReportsQuery{
+		FilterFrequency:     []string{string(frequency)},
+		FilterReportSubType: []string{string(reportSubType)},
+		FilterReportType:    []string{string(reportType)},
+		FilterVendorNumber:  []string{vendorNumber},
+		FilterVersion:       []string{string(spec.Version)},
+	}
+	if reportDate != "" {
+		query.FilterReportDate = []string{reportDate}
+	}
+
+	return query, nil
+}
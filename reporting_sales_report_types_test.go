@@ -0,0 +1,115 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupSalesReportSpec(t *testing.T) {
+	t.Parallel()
+
+	spec, ok := LookupSalesReportSpec(SalesReportTypeSubscriptionEvent, SalesReportSubTypeSummary)
+	assert.True(t, ok)
+	assert.Equal(t, SalesReportVersion1_3, spec.Version)
+	assert.True(t, spec.SupportsFrequency(SalesReportFrequencyDaily))
+	assert.False(t, spec.SupportsFrequency(SalesReportFrequencyWeekly))
+
+	_, ok = LookupSalesReportSpec(SalesReportTypeSubscriptionEvent, SalesReportSubTypeDetailed)
+	assert.False(t, ok)
+}
+
+func TestSalesReportSpecsAreUnique(t *testing.T) {
+	t.Parallel()
+
+	seen := map[string]bool{}
+
+	for _, spec := range SalesReportSpecs {
+		key := string(spec.ReportType) + "/" + string(spec.ReportSubType)
+		assert.False(t, seen[key], key)
+		assert.NotEmpty(t, spec.Frequencies, key)
+		assert.NotEmpty(t, spec.Version, key)
+		seen[key] = true
+	}
+}
+
+func TestValidateSalesReport(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, ValidateSalesReport(SalesReportTypeSales, SalesReportSubTypeSummary, SalesReportFrequencyMonthly, SalesReportVersion1_0))
+	assert.NoError(t, ValidateSalesReport(SalesReportTypeSubscriber, SalesReportSubTypeDetailed, SalesReportFrequencyDaily, ""))
+
+	tests := []struct {
+		reportType    SalesReportType
+		reportSubType SalesReportSubType
+		frequency     SalesReportFrequency
+		version       SalesReportVersion
+		message       string
+	}{
+		{"INSTALLS", SalesReportSubTypeSummary, SalesReportFrequencyDaily, "", "invalid sales report: unknown report type INSTALLS"},
+		{SalesReportTypeSubscriptionEvent, SalesReportSubTypeDetailed, SalesReportFrequencyDaily, "", "invalid sales report: SUBSCRIPTION_EVENT reports have subtypes SUMMARY, not DETAILED"},
+		{SalesReportTypeSubscriptionEvent, SalesReportSubTypeSummary, SalesReportFrequencyWeekly, "", "invalid sales report: SUBSCRIPTION_EVENT SUMMARY reports are published DAILY, not WEEKLY"},
+		{SalesReportTypeSubscriptionEvent, SalesReportSubTypeSummary, SalesReportFrequencyDaily, SalesReportVersion1_0, "invalid sales report: SUBSCRIPTION_EVENT SUMMARY reports are version 1_3, not 1_0"},
+	}
+
+	for _, test := range tests {
+		err := ValidateSalesReport(test.reportType, test.reportSubType, test.frequency, test.version)
+		assert.True(t, errors.Is(err, ErrInvalidSalesReport))
+		assert.EqualError(t, err, test.message)
+	}
+}
+
+func TestNewSalesReportQuery(t *testing.T) {
+	t.Parallel()
+
+	query, err := NewSalesReportQuery("85012345", SalesReportTypeSubscriptionEvent, SalesReportSubTypeSummary, SalesReportFrequencyDaily, "2024-01-31")
+	assert.NoError(t, err)
+	assert.Equal(t, &DownloadSalesAndTrendsReportsQuery{
+		FilterFrequency:     []string{"DAILY"},
+		FilterReportDate:    []string{"2024-01-31"},
+		FilterReportSubType: []string{"SUMMARY"},
+		FilterReportType:    []string{"SUBSCRIPTION_EVENT"},
+		FilterVendorNumber:  []string{"85012345"},
+		FilterVersion:       []string{"1_3"},
+	}, query)
+
+	query, err = NewSalesReportQuery("85012345", SalesReportTypeSales, SalesReportSubTypeSummary, SalesReportFrequencyDaily, "")
+	assert.NoError(t, err)
+	assert.Nil(t, query.FilterReportDate)
+
+	query, err = NewSalesReportQuery("85012345", SalesReportTypeSales, SalesReportSubTypeDetailed, SalesReportFrequencyDaily, "")
+	assert.True(t, errors.Is(err, ErrInvalidSalesReport))
+	assert.Nil(t, query)
+}
+
+func TestParseSalesReportVersion(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseSalesReportVersion(string(SalesReportVersion1_0))
+	assert.NoError(t, err)
+	assert.Equal(t, SalesReportVersion1_0, got)
+
+	_, err = ParseSalesReportVersion("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "SalesReportVersion", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,615 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// SalesReportType is the kind of data in a Sales and Trends report.
+type SalesReportType string
+
+const (
+	// SalesReportTypeSales is a report of app, in-app purchase and subscription sales.
+	SalesReportTypeSales SalesReportType = "SALES"
+	// SalesReportTypePreOrder is a report of pre-orders.
+	SalesReportTypePreOrder SalesReportType = "PRE_ORDER"
+	// SalesReportTypeNewsstand is a report of Newsstand sales.
+	SalesReportTypeNewsstand SalesReportType = "NEWSSTAND"
+	// SalesReportTypeSubscription is a report of active subscriptions.
+	SalesReportTypeSubscription SalesReportType = "SUBSCRIPTION"
+	// SalesReportTypeSubscriptionEvent is a report of subscription events, such as upgrades and cancellations.
+	SalesReportTypeSubscriptionEvent SalesReportType = "SUBSCRIPTION_EVENT"
+	// SalesReportTypeSubscriber is a report of transactions by anonymized subscriber.
+	SalesReportTypeSubscriber SalesReportType = "SUBSCRIBER"
+	// SalesReportTypeSubscriptionOfferCodeRedemption is a report of subscription offer code redemptions.
+	SalesReportTypeSubscriptionOfferCodeRedemption SalesReportType = "SUBSCRIPTION_OFFER_CODE_REDEMPTION"
+	// SalesReportTypeWinBackEligibility is a report of subscribers eligible for win-back offers.
+	SalesReportTypeWinBackEligibility SalesReportType = "WIN_BACK_ELIGIBILITY"
+)
+
+var salesReportTypes = []SalesReportType{
+	SalesReportTypeSales,
+	SalesReportTypePreOrder,
+	SalesReportTypeNewsstand,
+	SalesReportTypeSubscription,
+	SalesReportTypeSubscriptionEvent,
+	SalesReportTypeSubscriber,
+	SalesReportTypeSubscriptionOfferCodeRedemption,
+	SalesReportTypeWinBackEligibility,
+}
+
+// IsValid reports whether the sales report type is one this package knows about.
+func (v SalesReportType) IsValid() bool {
+	for _, value := range salesReportTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSalesReportType returns s as a SalesReportType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseSalesReportType(s string) (SalesReportType, error) {
+	if v := SalesReportType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "SalesReportType", Value: s}
+}
+
+// SalesReportSubType is the level of detail of a Sales and Trends report.
+type SalesReportSubType string
+
+const (
+	// SalesReportSubTypeSummary aggregates rows.
+	SalesReportSubTypeSummary SalesReportSubType = "SUMMARY"
+	// SalesReportSubTypeDetailed lists individual rows.
+	SalesReportSubTypeDetailed SalesReportSubType = "DETAILED"
+	// SalesReportSubTypeOptIn lists subscribers that opted in to share their contact information.
+	SalesReportSubTypeOptIn SalesReportSubType = "OPT_IN"
+	// SalesReportSubTypeSummaryInstallType aggregates rows by install type, such as manual or automatic updates.
+	SalesReportSubTypeSummaryInstallType SalesReportSubType = "SUMMARY_INSTALL_TYPE"
+	// SalesReportSubTypeSummaryTerritory aggregates rows by territory.
+	SalesReportSubTypeSummaryTerritory SalesReportSubType = "SUMMARY_TERRITORY"
+	// SalesReportSubTypeSummaryChannel aggregates rows by distribution channel, such as the App Store or a web browser.
+	SalesReportSubTypeSummaryChannel SalesReportSubType = "SUMMARY_CHANNEL"
+)
+
+var salesReportSubTypes = []SalesReportSubType{
+	SalesReportSubTypeSummary,
+	SalesReportSubTypeDetailed,
+	SalesReportSubTypeOptIn,
+	SalesReportSubTypeSummaryInstallType,
+	SalesReportSubTypeSummaryTerritory,
+	SalesReportSubTypeSummaryChannel,
+}
+
+// IsValid reports whether the sales report sub type is one this package knows about.
+func (v SalesReportSubType) IsValid() bool {
+	for _, value := range salesReportSubTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSalesReportSubType returns s as a SalesReportSubType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseSalesReportSubType(s string) (SalesReportSubType, error) {
+	if v := SalesReportSubType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "SalesReportSubType", Value: s}
+}
+
+// SalesReportFrequency is the period covered by a Sales and Trends report.
+type SalesReportFrequency string
+
+const (
+	// SalesReportFrequencyDaily is a report covering a single day.
+	SalesReportFrequencyDaily SalesReportFrequency = "DAILY"
+	// SalesReportFrequencyWeekly is a report covering a week ending on a Sunday.
+	SalesReportFrequencyWeekly SalesReportFrequency = "WEEKLY"
+	// SalesReportFrequencyMonthly is a report covering a calendar month.
+	SalesReportFrequencyMonthly SalesReportFrequency = "MONTHLY"
+	// SalesReportFrequencyYearly is a report covering a calendar year.
+	SalesReportFrequencyYearly SalesReportFrequency = "YEARLY"
+)
+
+var salesReportFrequencies = []SalesReportFrequency{
+	SalesReportFrequencyDaily,
+	SalesReportFrequencyWeekly,
+	SalesReportFrequencyMonthly,
+	SalesReportFrequencyYearly,
+}
+
+// IsValid reports whether the sales report frequency is one this package knows about.
+func (v SalesReportFrequency) IsValid() bool {
+	for _, value := range salesReportFrequencies {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSalesReportFrequency returns s as a SalesReportFrequency, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseSalesReportFrequency(s string) (SalesReportFrequency, error) {
+	if v := SalesReportFrequency(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "SalesReportFrequency", Value: s}
+}
+
+// ErrReportColumnType happens when a value in a report can't be parsed into the type of its column.
+var ErrReportColumnType = errors.New("invalid report value")
+
+// SalesReportRow is a row of a Sales report.
+//
+// https://help.apple.com/app-store-connect/#/dev15f9508ca
+type SalesReportRow struct {
+	Provider              string  `tsv:"Provider"`
+	ProviderCountry       string  `tsv:"Provider Country"`
+	SKU                   string  `tsv:"SKU"`
+	Developer             string  `tsv:"Developer"`
+	Title                 string  `tsv:"Title"`
+	Version               string  `tsv:"Version"`
+	ProductTypeIdentifier string  `tsv:"Product Type Identifier"`
+	Units                 float64 `tsv:"Units"`
+	DeveloperProceeds     float64 `tsv:"Developer Proceeds"`
+	BeginDate             Date    `tsv:"Begin Date"`
+	EndDate               Date    `tsv:"End Date"`
+	CustomerCurrency      string  `tsv:"Customer Currency"`
+	CountryCode           string  `tsv:"Country Code"`
+	CurrencyOfProceeds    string  `tsv:"Currency of Proceeds"`
+	AppleIdentifier       string  `tsv:"Apple Identifier"`
+	CustomerPrice         float64 `tsv:"Customer Price"`
+	PromoCode             string  `tsv:"Promo Code"`
+	ParentIdentifier      string  `tsv:"Parent Identifier"`
+	Subscription          string  `tsv:"Subscription"`
+	Period                string  `tsv:"Period"`
+	Category              string  `tsv:"Category"`
+	CMB                   string  `tsv:"CMB"`
+	Device                string  `tsv:"Device"`
+	SupportedPlatforms    string  `tsv:"Supported Platforms"`
+	ProceedsReason        string  `tsv:"Proceeds Reason"`
+	PreservedPricing      string  `tsv:"Preserved Pricing"`
+	Client                string  `tsv:"Client"`
+	OrderType             string  `tsv:"Order Type"`
+}
+
+// PreOrderReportRow is a row of a Pre-Order report, counting the pre-orders placed and canceled for an app in a
+// territory during the period of the report.
+//
+// https://help.apple.com/app-store-connect/#/dev63c95e436
+type PreOrderReportRow struct {
+	Provider           string `tsv:"Provider"`
+	ProviderCountry    string `tsv:"Provider Country"`
+	Title              string `tsv:"Title"`
+	SKU                string `tsv:"SKU"`
+	Developer          string `tsv:"Developer"`
+	PreOrderStartDate  Date   `tsv:"Pre-Order Start Date"`
+	PreOrderEndDate    Date   `tsv:"Pre-Order End Date"`
+	Ordered            int    `tsv:"Ordered"`
+	Canceled           int    `tsv:"Canceled"`
+	CumulativeOrdered  int    `tsv:"Cumulative Ordered"`
+	CumulativeCanceled int    `tsv:"Cumulative Canceled"`
+	BeginDate          Date   `tsv:"Start Date"`
+	EndDate            Date   `tsv:"End Date"`
+	CountryCode        string `tsv:"Country Code"`
+	AppleIdentifier    string `tsv:"Apple Identifier"`
+	Device             string `tsv:"Device"`
+	SupportedPlatforms string `tsv:"Supported Platforms"`
+	Category           string `tsv:"Category"`
+	Client             string `tsv:"Client"`
+}
+
+// NetOrdered returns the number of pre-orders placed and not canceled during the period of the report.
+func (r PreOrderReportRow) NetOrdered() int {
+	return r.Ordered - r.Canceled
+}
+
+// CumulativeNetOrdered returns the number of pre-orders placed and not canceled since pre-orders opened.
+func (r PreOrderReportRow) CumulativeNetOrdered() int {
+	return r.CumulativeOrdered - r.CumulativeCanceled
+}
+
+// SubscriberReportRow is a row of a Subscriber report.
+//
+// https://help.apple.com/app-store-connect/#/dev4c0a7c2fe
+type SubscriberReportRow struct {
+	EventDate                    Date    `tsv:"Event Date"`
+	AppName                      string  `tsv:"App Name"`
+	AppAppleID                   string  `tsv:"App Apple ID"`
+	SubscriptionName             string  `tsv:"Subscription Name"`
+	SubscriptionAppleID          string  `tsv:"Subscription Apple ID"`
+	SubscriptionGroupID          string  `tsv:"Subscription Group ID"`
+	StandardSubscriptionDuration string  `tsv:"Standard Subscription Duration"`
+	SubscriptionOfferName        string  `tsv:"Subscription Offer Name"`
+	PromotionalOfferID           string  `tsv:"Promotional Offer ID"`
+	SubscriptionOfferType        string  `tsv:"Subscription Offer Type"`
+	SubscriptionOfferDuration    string  `tsv:"Subscription Offer Duration"`
+	MarketingOptInDuration       string  `tsv:"Marketing Opt-In Duration"`
+	CustomerPrice                float64 `tsv:"Customer Price"`
+	CustomerCurrency             string  `tsv:"Customer Currency"`
+	DeveloperProceeds            float64 `tsv:"Developer Proceeds"`
+	ProceedsCurrency             string  `tsv:"Proceeds Currency"`
+	PreservedPricing             bool    `tsv:"Preserved Pricing"`
+	ProceedsReason               string  `tsv:"Proceeds Reason"`
+	Client                       string  `tsv:"Client"`
+	Country                      string  `tsv:"Country"`
+	SubscriberID                 string  `tsv:"Subscriber ID"`
+	SubscriberIDReset            bool    `tsv:"Subscriber ID Reset"`
+	Refund                       bool    `tsv:"Refund"`
+	PurchaseDate                 Date    `tsv:"Purchase Date"`
+	Units                        float64 `tsv:"Units"`
+}
+
+// SubscriptionReportRow is a row of a Subscription report, counting the subscriptions active on the day of the
+// report for a price, offer and territory.
+//
+// https://help.apple.com/app-store-connect/#/itc5dcdf6693
+type SubscriptionReportRow struct {
+	AppName                                 string  `tsv:"App Name"`
+	AppAppleID                              string  `tsv:"App Apple ID"`
+	SubscriptionName                        string  `tsv:"Subscription Name"`
+	SubscriptionAppleID                     string  `tsv:"Subscription Apple ID"`
+	SubscriptionGroupID                     string  `tsv:"Subscription Group ID"`
+	StandardSubscriptionDuration            string  `tsv:"Standard Subscription Duration"`
+	SubscriptionOfferName                   string  `tsv:"Subscription Offer Name"`
+	PromotionalOfferID                      string  `tsv:"Promotional Offer ID"`
+	CustomerPrice                           float64 `tsv:"Customer Price"`
+	CustomerCurrency                        string  `tsv:"Customer Currency"`
+	DeveloperProceeds                       float64 `tsv:"Developer Proceeds"`
+	ProceedsCurrency                        string  `tsv:"Proceeds Currency"`
+	PreservedPricing                        bool    `tsv:"Preserved Pricing"`
+	ProceedsReason                          string  `tsv:"Proceeds Reason"`
+	Client                                  string  `tsv:"Client"`
+	Device                                  string  `tsv:"Device"`
+	State                                   string  `tsv:"State"`
+	Country                                 string  `tsv:"Country"`
+	ActiveStandardPriceSubscriptions        int     `tsv:"Active Standard Price Subscriptions"`
+	ActiveFreeTrialIntroductoryOffers       int     `tsv:"Active Free Trial Introductory Offer Subscriptions"`
+	ActivePayUpFrontIntroductoryOffers      int     `tsv:"Active Pay Up Front Introductory Offer Subscriptions"`
+	ActivePayAsYouGoIntroductoryOffers      int     `tsv:"Active Pay As You Go Introductory Offer Subscriptions"`
+	FreeTrialPromotionalOfferSubscriptions  int     `tsv:"Free Trial Promotional Offer Subscriptions"`
+	PayUpFrontPromotionalOfferSubscriptions int     `tsv:"Pay Up Front Promotional Offer Subscriptions"`
+	PayAsYouGoPromotionalOfferSubscriptions int     `tsv:"Pay As You Go Promotional Offer Subscriptions"`
+	FreeTrialOfferCodeSubscriptions         int     `tsv:"Free Trial Offer Code Subscriptions"`
+	PayUpFrontOfferCodeSubscriptions        int     `tsv:"Pay Up Front Offer Code Subscriptions"`
+	PayAsYouGoOfferCodeSubscriptions        int     `tsv:"Pay As You Go Offer Code Subscriptions"`
+	MarketingOptIns                         int     `tsv:"Marketing Opt-Ins"`
+	BillingRetry                            int     `tsv:"Billing Retry"`
+	GracePeriod                             int     `tsv:"Grace Period"`
+	Subscribers                             int     `tsv:"Subscribers"`
+}
+
+// ReportAmount is an amount of money in a report, along with the ISO 4217 code of its currency.
+type ReportAmount struct {
+	Value    float64
+	Currency string
+}
+
+// String formats the amount like "9.99 USD".
+func (a ReportAmount) String() string {
+	return strconv.FormatFloat(a.Value, 'f', -1, 64) + " " + a.Currency
+}
+
+// CustomerPriceAmount returns the price the customer paid, in their currency.
+func (r SubscriptionReportRow) CustomerPriceAmount() ReportAmount {
+	return ReportAmount{r.CustomerPrice, r.CustomerCurrency}
+}
+
+// DeveloperProceedsAmount returns the proceeds of the subscription, in the currency they are paid in.
+func (r SubscriptionReportRow) DeveloperProceedsAmount() ReportAmount {
+	return ReportAmount{r.DeveloperProceeds, r.ProceedsCurrency}
+}
+
+// IsIntroductoryOffer reports whether the row counts subscriptions to an introductory offer rather than at the
+// standard price.
+func (r SubscriptionReportRow) IsIntroductoryOffer() bool {
+	return r.ActiveFreeTrialIntroductoryOffers+r.ActivePayUpFrontIntroductoryOffers+r.ActivePayAsYouGoIntroductoryOffers > 0
+}
+
+// CustomerPriceAmount returns the price the customer paid, in their currency.
+func (r SubscriberReportRow) CustomerPriceAmount() ReportAmount {
+	return ReportAmount{r.CustomerPrice, r.CustomerCurrency}
+}
+
+// DeveloperProceedsAmount returns the proceeds of the transaction, in the currency they are paid in.
+func (r SubscriberReportRow) DeveloperProceedsAmount() ReportAmount {
+	return ReportAmount{r.DeveloperProceeds, r.ProceedsCurrency}
+}
+
+// CustomerPriceAmount returns the price the customer paid, in their currency.
+func (r SalesReportRow) CustomerPriceAmount() ReportAmount {
+	return ReportAmount{r.CustomerPrice, r.CustomerCurrency}
+}
+
+// DeveloperProceedsAmount returns the proceeds of a single unit, in the currency they are paid in.
+func (r SalesReportRow) DeveloperProceedsAmount() ReportAmount {
+	return ReportAmount{r.DeveloperProceeds, r.CurrencyOfProceeds}
+}
+
+// SubscriptionEventReportRow is a row of a Subscription Event report.
+//
+// https://help.apple.com/app-store-connect/#/dev1c2c5e5c7
+type SubscriptionEventReportRow struct {
+	EventDate                    Date   `tsv:"Event Date"`
+	Event                        string `tsv:"Event"`
+	AppName                      string `tsv:"App Name"`
+	AppAppleID                   string `tsv:"App Apple ID"`
+	SubscriptionName             string `tsv:"Subscription Name"`
+	SubscriptionAppleID          string `tsv:"Subscription Apple ID"`
+	SubscriptionGroupID          string `tsv:"Subscription Group ID"`
+	StandardSubscriptionDuration string `tsv:"Standard Subscription Duration"`
+	SubscriptionOfferType        string `tsv:"Subscription Offer Type"`
+	SubscriptionOfferDuration    string `tsv:"Subscription Offer Duration"`
+	MarketingOptIn               bool   `tsv:"Marketing Opt-In"`
+	MarketingOptInDuration       string `tsv:"Marketing Opt-In Duration"`
+	PreservedPricing             bool   `tsv:"Preserved Pricing"`
+	ProceedsReason               string `tsv:"Proceeds Reason"`
+	PromotionalOfferName         string `tsv:"Promotional Offer Name"`
+	PromotionalOfferID           string `tsv:"Promotional Offer ID"`
+	ConsecutivePaidPeriods       int    `tsv:"Consecutive Paid Periods"`
+	OriginalStartDate            Date   `tsv:"Original Start Date"`
+	Device                       string `tsv:"Device"`
+	Client                       string `tsv:"Client"`
+	State                        string `tsv:"State"`
+	Country                      string `tsv:"Country"`
+	PreviousSubscriptionName     string `tsv:"Previous Subscription Name"`
+	PreviousSubscriptionAppleID  string `tsv:"Previous Subscription Apple ID"`
+	DaysBeforeCanceling          int    `tsv:"Days Before Canceling"`
+	CancellationReason           string `tsv:"Cancellation Reason"`
+	DaysCanceled                 int    `tsv:"Days Canceled"`
+	Quantity                     int    `tsv:"Quantity"`
+}
+
+// GetSalesReport downloads a Sales report and parses its rows.
+func (s *ReportingService) GetSalesReport(ctx context.Context, params *DownloadSalesAndTrendsReportsQuery) ([]SalesReportRow, *Response, error) {
+	var rows []SalesReportRow
+
+	resp, err := s.getSalesAndTrendsReport(ctx, params, &rows)
+
+	return rows, resp, err
+}
+
+// GetPreOrderReport downloads a Pre-Order report and parses its rows.
+func (s *ReportingService) GetPreOrderReport(ctx context.Context, params *DownloadSalesAndTrendsReportsQuery) ([]PreOrderReportRow, *Response, error) {
+	var rows []PreOrderReportRow
+
+	resp, err := s.getSalesAndTrendsReport(ctx, params, &rows)
+
+	return rows, resp, err
+}
+
+// GetSubscriptionReport downloads a Subscription report and parses its rows.
+func (s *ReportingService) GetSubscriptionReport(ctx context.Context, params *DownloadSalesAndTrendsReportsQuery) ([]SubscriptionReportRow, *Response, error) {
+	var rows []SubscriptionReportRow
+
+	resp, err := s.getSalesAndTrendsReport(ctx, params, &rows)
+
+	return rows, resp, err
+}
+
+// GetSubscriberReport downloads a Subscriber report and parses its rows.
+func (s *ReportingService) GetSubscriberReport(ctx context.Context, params *DownloadSalesAndTrendsReportsQuery) ([]SubscriberReportRow, *Response, error) {
+	var rows []SubscriberReportRow
+
+	resp, err := s.getSalesAndTrendsReport(ctx, params, &rows)
+
+	return rows, resp, err
+}
+
+// GetSubscriptionEventReport downloads a Subscription Event report and parses its rows.
+func (s *ReportingService) GetSubscriptionEventReport(ctx context.Context, params *DownloadSalesAndTrendsReportsQuery) ([]SubscriptionEventReportRow, *Response, error) {
+	var rows []SubscriptionEventReportRow
+
+	resp, err := s.getSalesAndTrendsReport(ctx, params, &rows)
+
+	return rows, resp, err
+}
+
+func (s *ReportingService) getSalesAndTrendsReport(ctx context.Context, params *DownloadSalesAndTrendsReportsQuery, rows interface{}) (*Response, error) {
+	report, resp, err := s.DownloadSalesAndTrendsReports(ctx, params)
+	if err != nil {
+		return resp, err
+	}
+
+	return resp, ParseReport(report, rows)
+}
+
+// ParseReport decodes a tab-separated report into rows, which must be a pointer to a slice of structs whose
+// fields are tagged with the report column they hold, like those of SalesReportRow. Gzipped reports, as
+// downloaded from App Store Connect, are decompressed transparently. Columns without a matching field are
+// ignored, and fields without a matching column are left at their zero value. Fields can be strings, ints,
+// float64s, bools, parsed from "Yes" or "No", and Dates.
+func ParseReport(r io.Reader, rows interface{}) error {
+	v := reflect.ValueOf(rows)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Slice || v.Elem().Type().Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("%w: expected a pointer to a slice of structs, got %T", ErrReportColumnType, rows)
+	}
+
+	slice := v.Elem()
+
+	stream, err := NewReportStream(r, reflect.Zero(slice.Type().Elem()).Interface())
+	if err != nil {
+		return err
+	}
+
+	for {
+		row := reflect.New(slice.Type().Elem()).Elem()
+		if err := stream.next(row); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		slice.Set(reflect.Append(slice, row))
+	}
+}
+
+// decompressReport returns a reader of the uncompressed contents of r, which may or may not be gzipped.
+func decompressReport(r io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(r)
+
+	magic, err := buffered.Peek(2)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		return gzip.NewReader(buffered)
+	}
+
+	return buffered, nil
+}
+
+func newReportReader(r io.Reader) *csv.Reader {
+	reader := csv.NewReader(r)
+	reader.Comma = '\t'
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+	reader.ReuseRecord = true
+
+	return reader
+}
+
+var reportFieldCache sync.Map
+
+// reportColumns maps each column of header to the index of the field of rowType that holds it, or -1.
+func reportColumns(rowType reflect.Type, header []string) []int {
+	var fields map[string]int
+
+	if cached, ok := reportFieldCache.Load(rowType); ok {
+		fields = cached.(map[string]int)
+	} else {
+		fields = make(map[string]int, rowType.NumField())
+
+		for i := 0; i < rowType.NumField(); i++ {
+			if tag := rowType.Field(i).Tag.Get("tsv"); tag != "" && tag != "-" {
+				fields[tag] = i
+			}
+		}
+
+		reportFieldCache.Store(rowType, fields)
+	}
+
+	columns := make([]int, len(header))
+
+	for i, name := range header {
+		index, ok := fields[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))]
+		if !ok {
+			index = -1
+		}
+
+		columns[i] = index
+	}
+
+	return columns
+}
+
+var reportDateLayouts = []string{"01/02/2006", dateFormat}
+
+func decodeReportRecord(row reflect.Value, columns []int, header []string, record []string) error {
+	for i, value := range record {
+		if i >= len(columns) || columns[i] < 0 {
+			continue
+		}
+
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		field := row.Field(columns[i])
+
+		if err := decodeReportValue(field, value); err != nil {
+			return fmt.Errorf("%w: column %q: %q", ErrReportColumnType, header[i], value)
+		}
+	}
+
+	return nil
+}
+
+func decodeReportValue(field reflect.Value, value string) error {
+	switch field.Interface().(type) {
+	case Date:
+		for _, layout := range reportDateLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				field.Set(reflect.ValueOf(Date{t}))
+
+				return nil
+			}
+		}
+
+		return ErrReportColumnType
+	}
+
+	switch field.Kind() {
+	case reflect.String:
+		field.SetString(value)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(strings.ReplaceAll(value, ",", ""), 10, 64)
+		if err != nil {
+			return err
+		}
+
+		field.SetInt(n)
+	case reflect.Float64:
+		n, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+		if err != nil {
+			return err
+		}
+
+		field.SetFloat(n)
+	case reflect.Bool:
+		switch strings.ToLower(value) {
+		case "yes", "true", "1":
+			field.SetBool(true)
+		case "no", "false", "0":
+			field.SetBool(false)
+		default:
+			return ErrReportColumnType
+		}
+	default:
+		return ErrReportColumnType
+	}
+
+	return nil
+}
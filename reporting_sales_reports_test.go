@@ -0,0 +1,261 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSalesReport = "Provider\tProvider Country\tSKU\tDeveloper\tTitle\tVersion\tProduct Type Identifier\tUnits\tDeveloper Proceeds\tBegin Date\tEnd Date\tCustomer Currency\tCountry Code\tCurrency of Proceeds\tApple Identifier\tCustomer Price\tPromo Code\tParent Identifier\tSubscription\tPeriod\tCategory\tCMB\tDevice\tSupported Platforms\tProceeds Reason\tPreserved Pricing\tClient\tOrder Type\n" +
+	"APPLE\tUS\tcom.app\tDev\tApp\t1.0\t1F\t12\t0\t01/31/2024\t01/31/2024\tUSD\tUS\tUSD\t1234567890\t0\t\t\t\t\tGames\t\tiPhone\tiOS\t\t\t\t\n" +
+	"APPLE\tUS\tcom.app.coins\tDev\tCoins\t\tIA1\t-1\t-0.70\t01/31/2024\t01/31/2024\tUSD\tUS\tUSD\t1234567891\t-0.99\t\tcom.app\t\t\tGames\t\tiPhone\tiOS\t\t\t\t\n"
+
+func gzipped(t *testing.T, s string) *bytes.Buffer {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	w := gzip.NewWriter(buf)
+	_, err := w.Write([]byte(s))
+	assert.NoError(t, err)
+	assert.NoError(t, w.Close())
+
+	return buf
+}
+
+func TestParseReportSales(t *testing.T) {
+	t.Parallel()
+
+	var rows []SalesReportRow
+
+	err := ParseReport(gzipped(t, testSalesReport), &rows)
+	assert.NoError(t, err)
+	assert.Len(t, rows, 2)
+
+	assert.Equal(t, "com.app", rows[0].SKU)
+	assert.Equal(t, "1F", rows[0].ProductTypeIdentifier)
+	assert.Equal(t, float64(12), rows[0].Units)
+	assert.Equal(t, Date{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)}, rows[0].BeginDate)
+	assert.Equal(t, "1234567890", rows[0].AppleIdentifier)
+
+	assert.Equal(t, float64(-1), rows[1].Units)
+	assert.Equal(t, -0.70, rows[1].DeveloperProceeds)
+	assert.Equal(t, -0.99, rows[1].CustomerPrice)
+	assert.Equal(t, "com.app", rows[1].ParentIdentifier)
+}
+
+func TestParseReportSubscriptionEvent(t *testing.T) {
+	t.Parallel()
+
+	report := "Event Date\tEvent\tApp Name\tSubscription Name\tConsecutive Paid Periods\tOriginal Start Date\tCountry\tQuantity\tUnknown Column\n" +
+		"2024-01-31\tRenew\tApp\tMonthly\t3\t2023-10-31\tUS\t2\tignored\n"
+
+	var rows []SubscriptionEventReportRow
+
+	err := ParseReport(strings.NewReader(report), &rows)
+	assert.NoError(t, err)
+	assert.Equal(t, []SubscriptionEventReportRow{{
+		EventDate:              Date{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		Event:                  "Renew",
+		AppName:                "App",
+		SubscriptionName:       "Monthly",
+		ConsecutivePaidPeriods: 3,
+		OriginalStartDate:      Date{time.Date(2023, 10, 31, 0, 0, 0, 0, time.UTC)},
+		Country:                "US",
+		Quantity:               2,
+	}}, rows)
+}
+
+func TestParseReportErrors(t *testing.T) {
+	t.Parallel()
+
+	var rows []SubscriberReportRow
+
+	err := ParseReport(strings.NewReader(""), &rows)
+	assert.NoError(t, err)
+	assert.Empty(t, rows)
+
+	err = ParseReport(strings.NewReader("Units\nmany\n"), &rows)
+	assert.ErrorIs(t, err, ErrReportColumnType)
+
+	err = ParseReport(strings.NewReader("Event Date\n31.01.2024\n"), &rows)
+	assert.ErrorIs(t, err, ErrReportColumnType)
+
+	err = ParseReport(strings.NewReader(testSalesReport), rows)
+	assert.ErrorIs(t, err, ErrReportColumnType)
+
+	err = ParseReport(bytes.NewReader([]byte{0x1f, 0x8b, 0x00}), &rows)
+	assert.Error(t, err)
+}
+
+func TestGetSalesReport(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(testSalesReport, func(ctx context.Context, client *Client) {
+		rows, resp, err := client.Reporting.GetSalesReport(ctx, &DownloadSalesAndTrendsReportsQuery{})
+		assert.NoError(t, err)
+		assert.NotNil(t, resp)
+		assert.Len(t, rows, 2)
+	})
+}
+
+func TestGetSubscriberReport(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior("Event Date\tSubscriber ID\tUnits\n2024-01-31\t42\t1\n", func(ctx context.Context, client *Client) {
+		rows, _, err := client.Reporting.GetSubscriberReport(ctx, &DownloadSalesAndTrendsReportsQuery{})
+		assert.NoError(t, err)
+		assert.Equal(t, []SubscriberReportRow{{
+			EventDate:    Date{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+			SubscriberID: "42",
+			Units:        1,
+		}}, rows)
+	})
+}
+
+func TestGetSubscriptionEventReport(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior("Event\nRenew\n", func(ctx context.Context, client *Client) {
+		rows, _, err := client.Reporting.GetSubscriptionEventReport(ctx, &DownloadSalesAndTrendsReportsQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, rows, 1)
+	})
+}
+
+func TestGetSalesReportError(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{})
+	defer server.Close()
+
+	rows, _, err := client.Reporting.GetSalesReport(context.Background(), &DownloadSalesAndTrendsReportsQuery{})
+	assert.Error(t, err)
+	assert.Nil(t, rows)
+}
+
+func TestGetSubscriptionReport(t *testing.T) {
+	t.Parallel()
+
+	report := "App Name\tSubscription Name\tCustomer Price\tCustomer Currency\tDeveloper Proceeds\tProceeds Currency\tPreserved Pricing\tCountry\tActive Standard Price Subscriptions\tActive Free Trial Introductory Offer Subscriptions\tSubscribers\n" +
+		"App\tMonthly\t9.99\tUSD\t6.99\tUSD\tYes\tUS\t1,200\t0\t1200\n" +
+		"App\tMonthly\t0\tEUR\t0\tEUR\t\tFR\t0\t35\t35\n"
+
+	testEndpointCustomBehavior(report, func(ctx context.Context, client *Client) {
+		rows, _, err := client.Reporting.GetSubscriptionReport(ctx, &DownloadSalesAndTrendsReportsQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, rows, 2)
+
+		assert.True(t, rows[0].PreservedPricing)
+		assert.Equal(t, 1200, rows[0].ActiveStandardPriceSubscriptions)
+		assert.Equal(t, ReportAmount{9.99, "USD"}, rows[0].CustomerPriceAmount())
+		assert.Equal(t, "6.99 USD", rows[0].DeveloperProceedsAmount().String())
+		assert.False(t, rows[0].IsIntroductoryOffer())
+
+		assert.False(t, rows[1].PreservedPricing)
+		assert.True(t, rows[1].IsIntroductoryOffer())
+	})
+}
+
+func TestParseReportBools(t *testing.T) {
+	t.Parallel()
+
+	var rows []SubscriberReportRow
+
+	err := ParseReport(strings.NewReader("Refund\tSubscriber ID Reset\tPreserved Pricing\nYes\tNo\t\n"), &rows)
+	assert.NoError(t, err)
+	assert.True(t, rows[0].Refund)
+	assert.False(t, rows[0].SubscriberIDReset)
+	assert.False(t, rows[0].PreservedPricing)
+
+	err = ParseReport(strings.NewReader("Refund\nmaybe\n"), &rows)
+	assert.ErrorIs(t, err, ErrReportColumnType)
+}
+
+func TestReportRowAmounts(t *testing.T) {
+	t.Parallel()
+
+	sales := SalesReportRow{CustomerPrice: 0.99, CustomerCurrency: "EUR", DeveloperProceeds: 0.7, CurrencyOfProceeds: "EUR"}
+	assert.Equal(t, "0.99 EUR", sales.CustomerPriceAmount().String())
+	assert.Equal(t, ReportAmount{0.7, "EUR"}, sales.DeveloperProceedsAmount())
+
+	subscriber := SubscriberReportRow{CustomerPrice: 4.99, CustomerCurrency: "GBP", DeveloperProceeds: 3.49, ProceedsCurrency: "GBP"}
+	assert.Equal(t, ReportAmount{4.99, "GBP"}, subscriber.CustomerPriceAmount())
+	assert.Equal(t, ReportAmount{3.49, "GBP"}, subscriber.DeveloperProceedsAmount())
+}
+
+func TestGetPreOrderReport(t *testing.T) {
+	t.Parallel()
+
+	report := "Provider\tProvider Country\tTitle\tSKU\tDeveloper\tPre-Order Start Date\tPre-Order End Date\tOrdered\tCanceled\tCumulative Ordered\tCumulative Canceled\tStart Date\tEnd Date\tCountry Code\tApple Identifier\tDevice\tSupported Platforms\tCategory\tClient\n" +
+		"APPLE\tUS\tGame\tcom.game\tDev\t01/01/2024\t03/01/2024\t120\t7\t500\t20\t01/31/2024\t01/31/2024\tUS\t1234567890\tiPhone\tiOS\tGames\t\n"
+
+	testEndpointCustomBehavior(report, func(ctx context.Context, client *Client) {
+		rows, _, err := client.Reporting.GetPreOrderReport(ctx, &DownloadSalesAndTrendsReportsQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, rows, 1)
+
+		assert.Equal(t, "com.game", rows[0].SKU)
+		assert.Equal(t, Date{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)}, rows[0].PreOrderEndDate)
+		assert.Equal(t, Date{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)}, rows[0].BeginDate)
+		assert.Equal(t, 113, rows[0].NetOrdered())
+		assert.Equal(t, 480, rows[0].CumulativeNetOrdered())
+	})
+}
+
+func TestParseSalesReportType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseSalesReportType(string(SalesReportTypeSales))
+	assert.NoError(t, err)
+	assert.Equal(t, SalesReportTypeSales, got)
+
+	_, err = ParseSalesReportType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "SalesReportType", Value: "UNKNOWN"}, err)
+}
+
+func TestParseSalesReportSubType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseSalesReportSubType(string(SalesReportSubTypeSummary))
+	assert.NoError(t, err)
+	assert.Equal(t, SalesReportSubTypeSummary, got)
+
+	_, err = ParseSalesReportSubType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "SalesReportSubType", Value: "UNKNOWN"}, err)
+}
+
+func TestParseSalesReportFrequency(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseSalesReportFrequency(string(SalesReportFrequencyDaily))
+	assert.NoError(t, err)
+	assert.Equal(t, SalesReportFrequencyDaily, got)
+
+	_, err = ParseSalesReportFrequency("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "SalesReportFrequency", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,151 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"fmt"
+	"sort"
+)
+
+// ErrMissingExchangeRate happens when sales are summarized in a currency and no exchange rate was provided for
+// the currency of some proceeds.
+type ErrMissingExchangeRate struct {
+	Currency string
+}
+
+func (e ErrMissingExchangeRate) Error() string {
+	return fmt.Sprintf("no exchange rate for %s", e.Currency)
+}
+
+// SalesGrouping is a set of fields that SummarizeSales groups rows by. Fields can be combined, such as
+// GroupBySKU | GroupByDate.
+type SalesGrouping int
+
+const (
+	// GroupBySKU groups rows by the SKU of the product sold.
+	GroupBySKU SalesGrouping = 1 << iota
+	// GroupByTerritory groups rows by the country code of the customer.
+	GroupByTerritory
+	// GroupByDate groups rows by the first day of the period they cover.
+	GroupByDate
+)
+
+// SalesSummaryOptions are options for SummarizeSales.
+type SalesSummaryOptions struct {
+	// GroupBy is the set of fields rows are grouped by. If zero, all rows are summarized together.
+	GroupBy SalesGrouping
+	// Currency is the ISO 4217 code of the currency proceeds are converted to. If empty, proceeds are not converted,
+	// and rows are also grouped by the currency of their proceeds.
+	Currency string
+	// ExchangeRates maps the code of each currency proceeds are paid in to the amount of Currency that one unit of
+	// it is worth. The rate of Currency itself is always 1.
+	ExchangeRates map[string]float64
+}
+
+// SalesSummaryKey identifies a group of rows summarized by SummarizeSales. Fields that rows were not grouped by are
+// left empty.
+type SalesSummaryKey struct {
+	SKU       string
+	Territory string
+	Date      Date
+	Currency  string
+}
+
+// SalesSummary is the total of a group of sales report rows.
+type SalesSummary struct {
+	SalesSummaryKey
+	// Units is the number of units sold, net of returns.
+	Units float64
+	// Proceeds is the total of developer proceeds, in Currency.
+	Proceeds float64
+}
+
+// SummarizeSales totals the units and developer proceeds of sales report rows, grouped as described by opts.
+// Summaries are sorted by date, SKU, territory and currency.
+func SummarizeSales(rows []SalesReportRow, opts SalesSummaryOptions) ([]SalesSummary, error) {
+	summaries := make(map[SalesSummaryKey]*SalesSummary)
+
+	for _, row := range rows {
+		rate := 1.0
+		if opts.Currency != "" && row.CurrencyOfProceeds != opts.Currency {
+			r, ok := opts.ExchangeRates[row.CurrencyOfProceeds]
+			if !ok {
+				return nil, ErrMissingExchangeRate{Currency: row.CurrencyOfProceeds}
+			}
+
+			rate = r
+		}
+
+		key := salesSummaryKey(row, opts)
+
+		summary, ok := summaries[key]
+		if !ok {
+			summary = &SalesSummary{SalesSummaryKey: key}
+			summaries[key] = summary
+		}
+
+		summary.Units += row.Units
+		summary.Proceeds += row.Units * row.DeveloperProceeds * rate
+	}
+
+	result := make([]SalesSummary, 0, len(summaries))
+	for _, summary := range summaries {
+		result = append(result, *summary)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		a, b := result[i], result[j]
+
+		switch {
+		case !a.Date.Equal(b.Date.Time):
+			return a.Date.Before(b.Date.Time)
+		case a.SKU != b.SKU:
+			return a.SKU < b.SKU
+		case a.Territory != b.Territory:
+			return a.Territory < b.Territory
+		default:
+			return a.Currency < b.Currency
+		}
+	})
+
+	return result, nil
+}
+
+func salesSummaryKey(row SalesReportRow, opts SalesSummaryOptions) SalesSummaryKey {
+	key := SalesSummaryKey{Currency: opts.Currency}
+	if key.Currency == "" {
+		key.Currency = row.CurrencyOfProceeds
+	}
+
+	if opts.GroupBy&GroupBySKU != 0 {
+		key.SKU = row.SKU
+	}
+
+	if opts.GroupBy&GroupByTerritory != 0 {
+		key.Territory = row.CountryCode
+	}
+
+	if opts.GroupBy&GroupByDate != 0 {
+		key.Date = row.BeginDate
+	}
+
+	return key
+}
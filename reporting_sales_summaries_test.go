@@ -0,0 +1,102 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testSalesRows() []SalesReportRow {
+	jan30 := Date{time.Date(2024, 1, 30, 0, 0, 0, 0, time.UTC)}
+	jan31 := Date{time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)}
+
+	return []SalesReportRow{
+		{SKU: "coins", CountryCode: "US", BeginDate: jan31, Units: 3, DeveloperProceeds: 0.7, CurrencyOfProceeds: "USD"},
+		{SKU: "coins", CountryCode: "FR", BeginDate: jan31, Units: 2, DeveloperProceeds: 0.6, CurrencyOfProceeds: "EUR"},
+		{SKU: "coins", CountryCode: "US", BeginDate: jan30, Units: -1, DeveloperProceeds: 0.7, CurrencyOfProceeds: "USD"},
+		{SKU: "app", CountryCode: "US", BeginDate: jan30, Units: 10, DeveloperProceeds: 0, CurrencyOfProceeds: "USD"},
+	}
+}
+
+func TestSummarizeSalesConverted(t *testing.T) {
+	t.Parallel()
+
+	summaries, err := SummarizeSales(testSalesRows(), SalesSummaryOptions{
+		GroupBy:       GroupBySKU,
+		Currency:      "USD",
+		ExchangeRates: map[string]float64{"EUR": 1.1},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, summaries, 2)
+
+	assert.Equal(t, SalesSummaryKey{SKU: "app", Currency: "USD"}, summaries[0].SalesSummaryKey)
+	assert.Equal(t, float64(10), summaries[0].Units)
+	assert.Equal(t, float64(0), summaries[0].Proceeds)
+
+	assert.Equal(t, SalesSummaryKey{SKU: "coins", Currency: "USD"}, summaries[1].SalesSummaryKey)
+	assert.Equal(t, float64(4), summaries[1].Units)
+	assert.InDelta(t, 3*0.7+2*0.6*1.1-0.7, summaries[1].Proceeds, 1e-9)
+}
+
+func TestSummarizeSalesByCurrency(t *testing.T) {
+	t.Parallel()
+
+	summaries, err := SummarizeSales(testSalesRows(), SalesSummaryOptions{GroupBy: GroupByDate | GroupByTerritory})
+	assert.NoError(t, err)
+	assert.Len(t, summaries, 3)
+
+	assert.Equal(t, "US", summaries[0].Territory)
+	assert.Equal(t, 2024, summaries[0].Date.Year())
+	assert.Equal(t, 30, summaries[0].Date.Day())
+	assert.Equal(t, float64(9), summaries[0].Units)
+
+	assert.Equal(t, "FR", summaries[1].Territory)
+	assert.Equal(t, "EUR", summaries[1].Currency)
+	assert.InDelta(t, 1.2, summaries[1].Proceeds, 1e-9)
+
+	assert.Equal(t, "US", summaries[2].Territory)
+	assert.InDelta(t, 2.1, summaries[2].Proceeds, 1e-9)
+}
+
+func TestSummarizeSalesMissingRate(t *testing.T) {
+	t.Parallel()
+
+	summaries, err := SummarizeSales(testSalesRows(), SalesSummaryOptions{Currency: "USD"})
+	assert.Nil(t, summaries)
+
+	var errRate ErrMissingExchangeRate
+
+	assert.True(t, errors.As(err, &errRate))
+	assert.Equal(t, "EUR", errRate.Currency)
+	assert.EqualError(t, err, "no exchange rate for EUR")
+}
+
+func TestSummarizeSalesEmpty(t *testing.T) {
+	t.Parallel()
+
+	summaries, err := SummarizeSales(nil, SalesSummaryOptions{})
+	assert.NoError(t, err)
+	assert.Empty(t, summaries)
+}
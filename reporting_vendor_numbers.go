@@ -0,0 +1,78 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// VerifyVendorNumber reports whether the API key has access to the Sales and Trends reports of a vendor number.
+//
+// The App Store Connect API has no endpoint that lists the vendor numbers available to a key, so vendor numbers
+// can't be discovered outright. Instead, this requests the daily Sales report of a recent day: it is accessible if
+// the report is returned, or if App Store Connect answers that there were no sales or that the report is not
+// available yet, and it is not if the request is rejected as invalid or forbidden.
+func (s *ReportingService) VerifyVendorNumber(ctx context.Context, vendorNumber string) (bool, error) {
+	date := time.Now().UTC().AddDate(0, 0, -2)
+
+	params, err := NewSalesReportQuery(vendorNumber, SalesReportTypeSales, SalesReportSubTypeSummary, SalesReportFrequencyDaily, date.Format(dateFormat))
+	if err != nil {
+		return false, err
+	}
+
+	_, _, err = s.DownloadSalesAndTrendsReports(ctx, params)
+	if err == nil || isReportNotFound(err) {
+		return true, nil
+	}
+
+	var errResponse *ErrorResponse
+	if errors.As(err, &errResponse) && errResponse.Response != nil {
+		switch errResponse.Response.StatusCode {
+		case http.StatusBadRequest, http.StatusForbidden:
+			return false, nil
+		}
+	}
+
+	return false, err
+}
+
+// FilterVendorNumbers returns the candidate vendor numbers that the API key has access to, in order, as checked
+// by VerifyVendorNumber. It lets an agency managing many accounts keep a single list of vendor numbers and find
+// those each key can use.
+func (s *ReportingService) FilterVendorNumbers(ctx context.Context, candidates []string) ([]string, error) {
+	var accessible []string
+
+	for _, vendorNumber := range candidates {
+		ok, err := s.VerifyVendorNumber(ctx, vendorNumber)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			accessible = append(accessible, vendorNumber)
+		}
+	}
+
+	return accessible, nil
+}
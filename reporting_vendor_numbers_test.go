@@ -0,0 +1,90 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newVendorNumbersServer() (*Client, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("filter[vendorNumber]") {
+		case "1":
+			fmt.Fprint(w, "report")
+		case "2":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, testReportNoSales)
+		case "3":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintln(w, `{"errors":[{"status":"403","code":"FORBIDDEN_ERROR"}]}`)
+		case "4":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, `{"errors":[{"status":"400","code":"PARAMETER_ERROR.INVALID","source":{"parameter":"filter[vendorNumber]"}}]}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintln(w, `{"errors":[{"status":"500"}]}`)
+		}
+	}))
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client, server
+}
+
+func TestVerifyVendorNumber(t *testing.T) {
+	t.Parallel()
+
+	client, server := newVendorNumbersServer()
+	defer server.Close()
+
+	for vendorNumber, want := range map[string]bool{"1": true, "2": true, "3": false, "4": false} {
+		ok, err := client.Reporting.VerifyVendorNumber(context.Background(), vendorNumber)
+		assert.NoError(t, err, vendorNumber)
+		assert.Equal(t, want, ok, vendorNumber)
+	}
+
+	ok, err := client.Reporting.VerifyVendorNumber(context.Background(), "5")
+	assert.Error(t, err)
+	assert.False(t, ok)
+}
+
+func TestFilterVendorNumbers(t *testing.T) {
+	t.Parallel()
+
+	client, server := newVendorNumbersServer()
+	defer server.Close()
+
+	accessible, err := client.Reporting.FilterVendorNumbers(context.Background(), []string{"4", "1", "3", "2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, accessible)
+
+	accessible, err = client.Reporting.FilterVendorNumbers(context.Background(), []string{"1", "5"})
+	assert.Error(t, err)
+	assert.Nil(t, accessible)
+}
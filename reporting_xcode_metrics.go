@@ -0,0 +1,274 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// PerfPowerMetricType is a category of power and performance metrics, used to filter GetPerfPowerMetricsQuery.
+type PerfPowerMetricType string
+
+const (
+	// PerfPowerMetricTypeAnimation are scroll hitch rate metrics.
+	PerfPowerMetricTypeAnimation PerfPowerMetricType = "ANIMATION"
+	// PerfPowerMetricTypeBattery are energy usage metrics.
+	PerfPowerMetricTypeBattery PerfPowerMetricType = "BATTERY"
+	// PerfPowerMetricTypeDisk are logical disk write metrics.
+	PerfPowerMetricTypeDisk PerfPowerMetricType = "DISK"
+	// PerfPowerMetricTypeHang are hang rate metrics.
+	PerfPowerMetricTypeHang PerfPowerMetricType = "HANG"
+	// PerfPowerMetricTypeLaunch are launch time metrics.
+	PerfPowerMetricTypeLaunch PerfPowerMetricType = "LAUNCH"
+	// PerfPowerMetricTypeMemory are peak and suspended memory metrics.
+	PerfPowerMetricTypeMemory PerfPowerMetricType = "MEMORY"
+	// PerfPowerMetricTypeTermination are background and foreground termination metrics.
+	PerfPowerMetricTypeTermination PerfPowerMetricType = "TERMINATION"
+)
+
+var perfPowerMetricTypes = []PerfPowerMetricType{
+	PerfPowerMetricTypeAnimation,
+	PerfPowerMetricTypeBattery,
+	PerfPowerMetricTypeDisk,
+	PerfPowerMetricTypeHang,
+	PerfPowerMetricTypeLaunch,
+	PerfPowerMetricTypeMemory,
+	PerfPowerMetricTypeTermination,
+}
+
+// IsValid reports whether the perf power metric type is one this package knows about.
+func (v PerfPowerMetricType) IsValid() bool {
+	for _, value := range perfPowerMetricTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParsePerfPowerMetricType returns s as a PerfPowerMetricType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParsePerfPowerMetricType(s string) (PerfPowerMetricType, error) {
+	if v := PerfPowerMetricType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "PerfPowerMetricType", Value: s}
+}
+
+// XcodeMetrics is the vendor-specific payload of power and performance metrics, as shown in the Xcode Organizer.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics
+type XcodeMetrics struct {
+	Insights    *XcodeMetricsInsights     `json:"insights,omitempty"`
+	ProductData []XcodeMetricsProductData `json:"productData,omitempty"`
+	Version     string                    `json:"version,omitempty"`
+}
+
+// XcodeMetricsInsights are the metrics whose values changed notably in the latest version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/insights
+type XcodeMetricsInsights struct {
+	Regressions []XcodeMetricsInsight `json:"regressions,omitempty"`
+	TrendingUp  []XcodeMetricsInsight `json:"trendingUp,omitempty"`
+}
+
+// XcodeMetricsInsight describes how a metric changed in the latest version compared to earlier versions.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/metricsinsight
+type XcodeMetricsInsight struct {
+	HighImpact            bool                            `json:"highImpact,omitempty"`
+	LatestVersion         string                          `json:"latestVersion,omitempty"`
+	MaxLatestVersionValue float64                         `json:"maxLatestVersionValue,omitempty"`
+	Metric                string                          `json:"metric,omitempty"`
+	MetricCategory        PerfPowerMetricType             `json:"metricCategory,omitempty"`
+	Populations           []XcodeMetricsInsightPopulation `json:"populations,omitempty"`
+	ReferenceVersions     string                          `json:"referenceVersions,omitempty"`
+	SubSystemLabel        string                          `json:"subSystemLabel,omitempty"`
+	SummaryString         string                          `json:"summaryString,omitempty"`
+}
+
+// XcodeMetricsInsightPopulation is the change of a metric on a single device and percentile.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/metricsinsight/populations
+type XcodeMetricsInsightPopulation struct {
+	DeltaPercentage       float64 `json:"deltaPercentage,omitempty"`
+	Device                string  `json:"device,omitempty"`
+	LatestVersionValue    float64 `json:"latestVersionValue,omitempty"`
+	Percentile            string  `json:"percentile,omitempty"`
+	ReferenceAverageValue float64 `json:"referenceAverageValue,omitempty"`
+	SummaryString         string  `json:"summaryString,omitempty"`
+}
+
+// XcodeMetricsProductData are the metrics of a single platform.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata
+type XcodeMetricsProductData struct {
+	MetricCategories []XcodeMetricsCategory `json:"metricCategories,omitempty"`
+	Platform         string                 `json:"platform,omitempty"`
+}
+
+// XcodeMetricsCategory groups the metrics of a PerfPowerMetricType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories
+type XcodeMetricsCategory struct {
+	Identifier PerfPowerMetricType `json:"identifier,omitempty"`
+	Metrics    []XcodeMetric       `json:"metrics,omitempty"`
+}
+
+// XcodeMetric is a single metric, such as launchTime or hangRate, broken down into datasets by device and percentile.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories/metrics
+type XcodeMetric struct {
+	Datasets   []XcodeMetricDataset `json:"datasets,omitempty"`
+	GoalKeys   []XcodeMetricGoalKey `json:"goalKeys,omitempty"`
+	Identifier string               `json:"identifier,omitempty"`
+	Unit       *XcodeMetricUnit     `json:"unit,omitempty"`
+}
+
+// XcodeMetricGoalKey is a range of values that Apple considers a goal for a metric.
+type XcodeMetricGoalKey struct {
+	GoalKey    string   `json:"goalKey,omitempty"`
+	LowerBound *float64 `json:"lowerBound,omitempty"`
+	UpperBound *float64 `json:"upperBound,omitempty"`
+}
+
+// XcodeMetricUnit is the unit the values of a metric are measured in.
+type XcodeMetricUnit struct {
+	DisplayName string `json:"displayName,omitempty"`
+	Identifier  string `json:"identifier,omitempty"`
+}
+
+// XcodeMetricDataset is the series of values of a metric for one device and percentile, one point per app version.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/xcodemetrics/productdata/metriccategories/metrics/datasets
+type XcodeMetricDataset struct {
+	FilterCriteria        *XcodeMetricFilterCriteria `json:"filterCriteria,omitempty"`
+	Points                []XcodeMetricPoint         `json:"points,omitempty"`
+	RecommendedMetricGoal *XcodeMetricGoal           `json:"recommendedMetricGoal,omitempty"`
+}
+
+// XcodeMetricFilterCriteria identifies the devices and percentile a dataset covers.
+type XcodeMetricFilterCriteria struct {
+	Device              string `json:"device,omitempty"`
+	DeviceMarketingName string `json:"deviceMarketingName,omitempty"`
+	Percentile          string `json:"percentile,omitempty"`
+}
+
+// XcodeMetricGoal is the value Apple recommends a metric to stay under.
+type XcodeMetricGoal struct {
+	Detail string  `json:"detail,omitempty"`
+	Value  float64 `json:"value,omitempty"`
+}
+
+// XcodeMetricPoint is the value of a metric in a single app version.
+type XcodeMetricPoint struct {
+	ErrorMargin         *float64                    `json:"errorMargin,omitempty"`
+	Goal                string                      `json:"goal,omitempty"`
+	PercentageBreakdown *XcodeMetricPercentageBreak `json:"percentageBreakdown,omitempty"`
+	Value               float64                     `json:"value"`
+	Version             string                      `json:"version,omitempty"`
+}
+
+// XcodeMetricPercentageBreak is the share of a value attributed to a subsystem, such as a kind of termination.
+type XcodeMetricPercentageBreak struct {
+	SubSystemLabel string  `json:"subSystemLabel,omitempty"`
+	Value          float64 `json:"value"`
+}
+
+// GetXcodeMetricsForApp gets the power and performance metrics of the most recent versions of an app, decoded
+// from the vendor-specific payload.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_power_and_performance_metrics_for_an_app
+func (s *ReportingService) GetXcodeMetricsForApp(ctx context.Context, id string, params *GetPerfPowerMetricsQuery) (*XcodeMetrics, *Response, error) {
+	url := fmt.Sprintf("apps/%s/perfPowerMetrics", id)
+	res := new(XcodeMetrics)
+	resp, err := s.client.get(ctx, url, params, res, withAccept("application/vnd.apple.xcode-metrics+json"))
+
+	return res, resp, err
+}
+
+// GetXcodeMetricsForBuild gets the power and performance metrics of a build, decoded from the vendor-specific payload.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_power_and_performance_metrics_for_a_build
+func (s *ReportingService) GetXcodeMetricsForBuild(ctx context.Context, id string, params *GetPerfPowerMetricsQuery) (*XcodeMetrics, *Response, error) {
+	url := fmt.Sprintf("builds/%s/perfPowerMetrics", id)
+	res := new(XcodeMetrics)
+	resp, err := s.client.get(ctx, url, params, res, withAccept("application/vnd.apple.xcode-metrics+json"))
+
+	return res, resp, err
+}
+
+// Metric finds a metric by its identifier, such as "launchTime" or "hangRate", on a platform, such as "iOS".
+// Nil is returned if the payload has no such metric.
+func (m *XcodeMetrics) Metric(platform string, identifier string) *XcodeMetric {
+	for i := range m.ProductData {
+		if m.ProductData[i].Platform != platform {
+			continue
+		}
+
+		for j := range m.ProductData[i].MetricCategories {
+			category := &m.ProductData[i].MetricCategories[j]
+			for k := range category.Metrics {
+				if category.Metrics[k].Identifier == identifier {
+					return &category.Metrics[k]
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Dataset finds the dataset of a metric for a device, such as "all_iPhones", and a percentile, such as
+// "percentile.ninety". Nil is returned if the metric has no such dataset.
+func (m *XcodeMetric) Dataset(device string, percentile string) *XcodeMetricDataset {
+	for i := range m.Datasets {
+		criteria := m.Datasets[i].FilterCriteria
+		if criteria != nil && criteria.Device == device && criteria.Percentile == percentile {
+			return &m.Datasets[i]
+		}
+	}
+
+	return nil
+}
+
+// Version returns the point of a dataset for an app version, or nil if the version has no data.
+func (d *XcodeMetricDataset) Version(version string) *XcodeMetricPoint {
+	for i := range d.Points {
+		if d.Points[i].Version == version {
+			return &d.Points[i]
+		}
+	}
+
+	return nil
+}
+
+// Latest returns the point of the most recent version in a dataset, or nil if it is empty. Points are listed
+// from the oldest to the most recent version.
+func (d *XcodeMetricDataset) Latest() *XcodeMetricPoint {
+	if len(d.Points) == 0 {
+		return nil
+	}
+
+	return &d.Points[len(d.Points)-1]
+}
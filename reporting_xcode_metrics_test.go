@@ -0,0 +1,120 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testXcodeMetrics = `{
+	"version": "1.0",
+	"insights": {
+		"regressions": [{
+			"metricCategory": "LAUNCH",
+			"metric": "launchTime",
+			"latestVersion": "1.1",
+			"highImpact": true,
+			"populations": [{"device": "all_iPhones", "percentile": "percentile.ninety", "deltaPercentage": 25.5, "latestVersionValue": 2.5, "referenceAverageValue": 2}]
+		}]
+	},
+	"productData": [{
+		"platform": "iOS",
+		"metricCategories": [{
+			"identifier": "LAUNCH",
+			"metrics": [{
+				"identifier": "launchTime",
+				"unit": {"identifier": "s", "displayName": "s"},
+				"datasets": [
+					{
+						"filterCriteria": {"device": "all_iPhones", "deviceMarketingName": "All iPhones", "percentile": "percentile.fifty"},
+						"points": [{"version": "1.0", "value": 1.2}, {"version": "1.1", "value": 1.4, "errorMargin": 0.1}]
+					},
+					{
+						"filterCriteria": {"device": "all_iPhones", "deviceMarketingName": "All iPhones", "percentile": "percentile.ninety"},
+						"points": [{"version": "1.0", "value": 2}, {"version": "1.1", "value": 2.5}]
+					}
+				]
+			}]
+		}]
+	}]
+}`
+
+func TestGetXcodeMetricsForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(testXcodeMetrics, func(ctx context.Context, client *Client) {
+		metrics, _, err := client.Reporting.GetXcodeMetricsForApp(ctx, "10", &GetPerfPowerMetricsQuery{
+			FilterMetricType: []string{string(PerfPowerMetricTypeLaunch)},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "1.0", metrics.Version)
+		assert.Len(t, metrics.Insights.Regressions, 1)
+		assert.Equal(t, PerfPowerMetricTypeLaunch, metrics.Insights.Regressions[0].MetricCategory)
+		assert.Equal(t, 25.5, metrics.Insights.Regressions[0].Populations[0].DeltaPercentage)
+	})
+}
+
+func TestGetXcodeMetricsForBuild(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &XcodeMetrics{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Reporting.GetXcodeMetricsForBuild(ctx, "10", &GetPerfPowerMetricsQuery{})
+	})
+}
+
+func TestXcodeMetricsLookup(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(testXcodeMetrics, func(ctx context.Context, client *Client) {
+		metrics, _, err := client.Reporting.GetXcodeMetricsForApp(ctx, "10", nil)
+		assert.NoError(t, err)
+
+		metric := metrics.Metric("iOS", "launchTime")
+		assert.NotNil(t, metric)
+		assert.Equal(t, "s", metric.Unit.Identifier)
+		assert.Nil(t, metrics.Metric("iOS", "hangRate"))
+		assert.Nil(t, metrics.Metric("macOS", "launchTime"))
+
+		dataset := metric.Dataset("all_iPhones", "percentile.fifty")
+		assert.NotNil(t, dataset)
+		assert.Nil(t, metric.Dataset("all_iPads", "percentile.fifty"))
+
+		assert.Equal(t, 1.4, dataset.Latest().Value)
+		assert.Equal(t, 0.1, *dataset.Latest().ErrorMargin)
+		assert.Equal(t, 1.2, dataset.Version("1.0").Value)
+		assert.Nil(t, dataset.Version("2.0"))
+		assert.Nil(t, (&XcodeMetricDataset{}).Latest())
+	})
+}
+
+func TestParsePerfPowerMetricType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParsePerfPowerMetricType(string(PerfPowerMetricTypeAnimation))
+	assert.NoError(t, err)
+	assert.Equal(t, PerfPowerMetricTypeAnimation, got)
+
+	_, err = ParsePerfPowerMetricType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "PerfPowerMetricType", Value: "UNKNOWN"}, err)
+}
@@ -21,8 +21,10 @@ along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
 package asc
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"reflect"
 	"regexp"
 	"time"
 )
@@ -47,6 +49,95 @@ func (e ErrInvalidEmail) Error() string {
 	return fmt.Sprintf("email: %s failed to pass regex validation", e.Value)
 }
 
+// ErrUnknownEnumValue occurs when a value is not one of the values this package knows about for an enum type,
+// such as when parsing it or when a Client validates enums strictly.
+type ErrUnknownEnumValue struct {
+	Type  string
+	Value string
+}
+
+func (e ErrUnknownEnumValue) Error() string {
+	return fmt.Sprintf("%q is not a known %s", e.Value, e.Type)
+}
+
+// enum is implemented by the string types whose values this package knows about.
+type enum interface {
+	IsValid() bool
+}
+
+var (
+	enumType  = reflect.TypeOf((*enum)(nil)).Elem()
+	emailType = reflect.TypeOf(Email(""))
+)
+
+// validateEnums walks v and returns ErrUnknownEnumValue for the first non-empty enum value it finds that this
+// package doesn't know about.
+func validateEnums(v interface{}) error {
+	return validateEnumValue(reflect.ValueOf(v))
+}
+
+func validateEnumValue(v reflect.Value) error {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		return validateEnumValue(v.Elem())
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if err := validateEnumValue(v.Field(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if err := validateEnumValue(v.Index(i)); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			if err := validateEnumValue(iter.Key()); err != nil {
+				return err
+			}
+
+			if err := validateEnumValue(iter.Value()); err != nil {
+				return err
+			}
+		}
+	case reflect.String:
+		// Emails validate themselves when they are marshaled or unmarshaled.
+		if v.Len() == 0 || v.Type() == emailType || !v.Type().Implements(enumType) {
+			return nil
+		}
+
+		// Copy the value so that enums held in unexported fields can be checked too.
+		value := reflect.New(v.Type()).Elem()
+		value.SetString(v.String())
+
+		if !value.Interface().(enum).IsValid() {
+			return ErrUnknownEnumValue{Type: v.Type().Name(), Value: v.String()}
+		}
+	}
+
+	return nil
+}
+
+// unquoteJSONString returns the JSON string data as a Go string. Strings without escapes, such as dates and
+// links, are sliced directly rather than going through the reflection-based decoder.
+func unquoteJSONString(data []byte) (string, error) {
+	if n := len(data); n >= 2 && data[0] == '"' && data[n-1] == '"' && bytes.IndexByte(data, '\\') < 0 {
+		return string(data[1 : n-1]), nil
+	}
+
+	var s string
+	err := json.Unmarshal(data, &s)
+
+	return s, err
+}
+
 // Date represents a date with no time component.
 type Date struct {
 	time.Time
@@ -57,18 +148,27 @@ func (d Date) MarshalJSON() ([]byte, error) {
 	return json.Marshal(d.Time.Format(dateFormat))
 }
 
-// UnmarshalJSON is a custom unmarshaller for time-less dates.
+// UnmarshalJSON is a custom unmarshaller for time-less dates. A null value leaves the date unchanged, and a full
+// date-time value is truncated to its calendar date.
 func (d *Date) UnmarshalJSON(data []byte) error {
-	var dateStr string
+	if string(data) == "null" {
+		return nil
+	}
 
-	err := json.Unmarshal(data, &dateStr)
+	dateStr, err := unquoteJSONString(data)
 	if err != nil {
 		return err
 	}
 
 	parsed, err := time.Parse(dateFormat, dateStr)
 	if err != nil {
-		return err
+		var dateTime DateTime
+		if dateTime.parse(dateStr) != nil {
+			return err
+		}
+
+		year, month, day := dateTime.Date()
+		parsed = time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
 	}
 
 	d.Time = parsed
@@ -76,6 +176,13 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// NewDate returns the calendar date of t, in the location of t, as a Date.
+func NewDate(t time.Time) *Date {
+	year, month, day := t.Date()
+
+	return &Date{time.Date(year, month, day, 0, 0, 0, 0, time.UTC)}
+}
+
 // DateTime represents a date with an ISO8601-like date-time.
 type DateTime struct {
 	time.Time
@@ -86,15 +193,22 @@ func (d DateTime) MarshalJSON() ([]byte, error) {
 	return json.Marshal(d.Time.Format(customISO8601Format))
 }
 
-// UnmarshalJSON is a custom unmarshaller for date-times.
+// UnmarshalJSON is a custom unmarshaller for date-times. A null value leaves the date-time unchanged.
 func (d *DateTime) UnmarshalJSON(data []byte) error {
-	var dateTimeStr string
+	if string(data) == "null" {
+		return nil
+	}
 
-	err := json.Unmarshal(data, &dateTimeStr)
+	dateTimeStr, err := unquoteJSONString(data)
 	if err != nil {
 		return err
 	}
 
+	return d.parse(dateTimeStr)
+}
+
+// parse sets the date-time from an RFC 3339 or ISO 8601 string, keeping its UTC offset.
+func (d *DateTime) parse(dateTimeStr string) error {
 	parsed, err := time.Parse(time.RFC3339, dateTimeStr)
 	if err != nil {
 		parsed, err = time.Parse(customISO8601Format, dateTimeStr)
@@ -108,7 +222,25 @@ func (d *DateTime) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-// Email is a validated email address string.
+// NewDateTime returns t as a DateTime. It is marshaled with the UTC offset of the location of t.
+func NewDateTime(t time.Time) *DateTime {
+	return &DateTime{t}
+}
+
+// NewEarliestReleaseDate returns t rounded up to the next hour, in the location of t, as App Store Connect
+// only accepts earliest release dates of app versions on the hour.
+func NewEarliestReleaseDate(t time.Time) *DateTime {
+	rounded := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	if rounded.Before(t) {
+		rounded = rounded.Add(time.Hour)
+	}
+
+	return &DateTime{rounded}
+}
+
+// Email is an email address string. It is validated when it is marshaled, so addresses can be sent
+// to App Store Connect only if they are valid, but any string, including an empty one, is accepted
+// when decoding responses.
 type Email string
 
 // MarshalJSON is a custom marshaler for email addresses.
@@ -121,17 +253,19 @@ func (e Email) MarshalJSON() ([]byte, error) {
 	return json.Marshal(string(e))
 }
 
-// UnmarshalJSON is a custom unmarshaller for email addresses.
+// IsValid reports whether the email address passes the validation applied when it is marshaled.
+func (e Email) IsValid() bool {
+	return emailRegex.MatchString(string(e))
+}
+
+// UnmarshalJSON is a custom unmarshaller for email addresses. The address is not validated, as App Store
+// Connect returns empty and unvalidated addresses for fields such as review contact emails.
 func (e *Email) UnmarshalJSON(data []byte) error {
 	var s string
 	if err := json.Unmarshal(data, &s); err != nil {
 		return err
 	}
 
-	if !emailRegex.MatchString(s) {
-		return ErrInvalidEmail{Value: s}
-	}
-
 	*e = Email(s)
 
 	return nil
@@ -149,6 +283,12 @@ func Int(v int) *int {
 	return &v
 }
 
+// Int64 is a helper routine that allocates a new int64 value
+// to store v and returns a pointer to it.
+func Int64(v int64) *int64 {
+	return &v
+}
+
 // Float is a helper routine that allocates a new float64 value
 // to store v and returns a pointer to it.
 func Float(v float64) *float64 {
@@ -160,3 +300,9 @@ func Float(v float64) *float64 {
 func String(v string) *string {
 	return &v
 }
+
+// Ptr is a helper routine that allocates a new value of any type, such as an enum
+// or a Date, to store v and returns a pointer to it.
+func Ptr[T any](v T) *T {
+	return &v
+}
@@ -29,6 +29,26 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func TestValidateEnums(t *testing.T) {
+	t.Parallel()
+
+	valid := &UserResponse{Data: User{Attributes: &UserAttributes{Roles: []UserRole{UserRoleAdmin}}}}
+	assert.NoError(t, validateEnums(valid))
+	assert.NoError(t, validateEnums(&UserResponse{}))
+
+	invalid := &UserResponse{Data: User{Attributes: &UserAttributes{Roles: []UserRole{UserRoleAdmin, "ADMINISTRATOR"}}}}
+	assert.Equal(t, ErrUnknownEnumValue{Type: "UserRole", Value: "ADMINISTRATOR"}, validateEnums(invalid))
+
+	platforms := map[Platform]string{"IPHONE": "iPhone"}
+	assert.Equal(t, ErrUnknownEnumValue{Type: "Platform", Value: "IPHONE"}, validateEnums(platforms))
+
+	body := newRequestBody(struct {
+		email Email
+		state AppStoreVersionState
+	}{"not an email", "LIVE"})
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AppStoreVersionState", Value: "LIVE"}, validateEnums(body))
+}
+
 type dateContainer struct {
 	Field Date `json:"date"`
 }
@@ -45,6 +65,24 @@ func dateContainerJSON(date string) string {
 	return fmt.Sprintf(`{"date":"%s"}`, date)
 }
 
+func TestUnquoteJSONString(t *testing.T) {
+	t.Parallel()
+
+	got, err := unquoteJSONString([]byte(`"2020-04-01"`))
+	assert.NoError(t, err)
+	assert.Equal(t, "2020-04-01", got)
+
+	got, err = unquoteJSONString([]byte(`"https://example.com/?a=\u0026"`))
+	assert.NoError(t, err)
+	assert.Equal(t, "https://example.com/?a=&", got)
+
+	_, err = unquoteJSONString([]byte(`20200401`))
+	assert.Error(t, err)
+
+	_, err = unquoteJSONString([]byte(`"`))
+	assert.Error(t, err)
+}
+
 func TestDateMarshal(t *testing.T) {
 	t.Parallel()
 
@@ -87,6 +125,38 @@ func TestDateUnmarshalInvalidDate(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestDateUnmarshalNull(t *testing.T) {
+	t.Parallel()
+
+	var b dateContainer
+	err := json.Unmarshal([]byte(`{"date":null}`), &b)
+	assert.NoError(t, err)
+	assert.True(t, b.Field.IsZero())
+}
+
+func TestDateUnmarshalDateTime(t *testing.T) {
+	t.Parallel()
+
+	want := time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+	jsonStr := dateContainerJSON("2020-04-01T23:16:48-07:00")
+
+	var b dateContainer
+	err := json.Unmarshal([]byte(jsonStr), &b)
+	assert.NoError(t, err)
+	assert.Equal(t, want, b.Field.Time)
+}
+
+func TestNewDate(t *testing.T) {
+	t.Parallel()
+
+	pacific := time.FixedZone("PDT", -7*60*60)
+	date := NewDate(time.Date(2020, 4, 1, 23, 30, 0, 0, pacific))
+
+	got, err := json.Marshal(date)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2020-04-01"`, string(got))
+}
+
 type dateTimeContainer struct {
 	Field DateTime `json:"time"`
 }
@@ -145,6 +215,48 @@ func TestDateTimeUnmarshalInvalidDate(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestDateTimeUnmarshalNull(t *testing.T) {
+	t.Parallel()
+
+	var b dateTimeContainer
+	err := json.Unmarshal([]byte(`{"time":null}`), &b)
+	assert.NoError(t, err)
+	assert.True(t, b.Field.IsZero())
+}
+
+func TestDateTimeUnmarshalOffset(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"2020-04-01T05:16:48-07:00", "2020-04-01T05:16:48-0700"} {
+		var b dateTimeContainer
+		err := json.Unmarshal([]byte(dateTimeContainerJSON(value)), &b)
+		assert.NoError(t, err)
+
+		_, offset := b.Field.Zone()
+		assert.Equal(t, -7*60*60, offset)
+		assert.Equal(t, time.Date(2020, 4, 1, 12, 16, 48, 0, time.UTC), b.Field.UTC())
+	}
+}
+
+func TestNewDateTime(t *testing.T) {
+	t.Parallel()
+
+	pacific := time.FixedZone("PDT", -7*60*60)
+
+	got, err := json.Marshal(NewDateTime(time.Date(2020, 4, 1, 5, 0, 0, 0, pacific)))
+	assert.NoError(t, err)
+	assert.Equal(t, `"2020-04-01T05:00:00-0700"`, string(got))
+}
+
+func TestNewEarliestReleaseDate(t *testing.T) {
+	t.Parallel()
+
+	india := time.FixedZone("IST", 5*60*60+30*60)
+
+	assert.Equal(t, time.Date(2020, 4, 1, 6, 0, 0, 0, india), NewEarliestReleaseDate(time.Date(2020, 4, 1, 5, 16, 48, 0, india)).Time)
+	assert.Equal(t, time.Date(2020, 4, 1, 5, 0, 0, 0, india), NewEarliestReleaseDate(time.Date(2020, 4, 1, 5, 0, 0, 0, india)).Time)
+}
+
 type emailContainer struct {
 	Field Email `json:"email"`
 }
@@ -207,7 +319,36 @@ func TestEmailUnmarshalInvalidEmail(t *testing.T) {
 
 	var b emailContainer
 	err := json.Unmarshal([]byte(jsonStr), &b)
-	assert.Error(t, err)
+	assert.NoError(t, err)
+	assert.Equal(t, Email("TEST"), b.Field)
+	assert.False(t, b.Field.IsValid())
+}
+
+func TestEmailUnmarshalEmpty(t *testing.T) {
+	t.Parallel()
+
+	var attrs AppStoreReviewDetailAttributes
+	err := json.Unmarshal([]byte(`{"contactEmail":""}`), &attrs)
+	assert.NoError(t, err)
+	assert.Equal(t, Email(""), *attrs.ContactEmail)
+	assert.False(t, attrs.ContactEmail.IsValid())
+
+	var detail BetaAppReviewDetailAttributes
+	err = json.Unmarshal([]byte(`{"contactEmail":""}`), &detail)
+	assert.NoError(t, err)
+	assert.Equal(t, Email(""), *detail.ContactEmail)
+
+	var localization BetaAppLocalizationAttributes
+	err = json.Unmarshal([]byte(`{"feedbackEmail":""}`), &localization)
+	assert.NoError(t, err)
+	assert.Equal(t, Email(""), *localization.FeedbackEmail)
+}
+
+func TestEmailIsValid(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, Email("my@email.com").IsValid())
+	assert.False(t, Email("email.com").IsValid())
 }
 
 func TestBool(t *testing.T) {
@@ -226,6 +367,14 @@ func TestInt(t *testing.T) {
 	assert.Equal(t, want, &got, "Int returned same *int, but they should differ")
 }
 
+func TestInt64(t *testing.T) {
+	t.Parallel()
+
+	got := int64(100)
+	want := Int64(got)
+	assert.Equal(t, want, &got, "Int64 returned same *int64, but they should differ")
+}
+
 func TestFloat(t *testing.T) {
 	t.Parallel()
 
@@ -241,3 +390,11 @@ func TestString(t *testing.T) {
 	want := String(got)
 	assert.Equal(t, want, &got, "String returned same *string, but they should differ")
 }
+
+func TestPtr(t *testing.T) {
+	t.Parallel()
+
+	got := PlatformIOS
+	want := Ptr(got)
+	assert.Equal(t, want, &got, "Ptr returned same *Platform, but they should differ")
+}
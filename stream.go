@@ -0,0 +1,157 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Stream pages through the list endpoint at path, such as "devices", like List, but decodes the resources of the
+// data array one at a time as they are read and passes each to fn rather than collecting them, so that very large
+// exports hold only one resource in memory at a time. query sets the URL query parameters of every page.
+//
+// If fn returns an error, Stream stops and returns that error. To consume items from another goroutine, fn can
+// send them on a channel. The returned Response is that of the last page read.
+func Stream[T any](ctx context.Context, c *Client, path string, query interface{}, fn func(item *T) error) (*Response, error) {
+	var resp *Response
+
+	err := forEachListPage(path, query, func(url string) (*PagedDocumentLinks, error) {
+		page := &streamedPage[T]{fn: fn}
+
+		var err error
+		if resp, err = c.get(ctx, url, nil, page); err != nil {
+			return nil, err
+		}
+
+		return &page.Links, nil
+	})
+
+	return resp, err
+}
+
+// streamDecoder is implemented by responses that decode themselves from the body as it is read.
+type streamDecoder interface {
+	decodeStream(r io.Reader, strictEnums bool) error
+}
+
+// streamedPage is a page of a list response whose resources are passed to fn instead of being kept.
+type streamedPage[T any] struct {
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+
+	fn func(item *T) error
+}
+
+func (p *streamedPage[T]) decodeStream(r io.Reader, strictEnums bool) error {
+	return decodeStreamedData(r, p, strictEnums, p.fn)
+}
+
+// decodeStreamedData decodes the JSON object read from r into v, except for the elements of its data array,
+// which are decoded into new values of T and passed to fn as they are read.
+func decodeStreamedData[T any](r io.Reader, v interface{}, strictEnums bool, fn func(item *T) error) error {
+	dec := json.NewDecoder(r)
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	// Members other than data are small, so they are collected and decoded into v together at the end.
+	var rest bytes.Buffer
+
+	rest.WriteByte('{')
+
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		key, _ := token.(string)
+
+		if key != "data" {
+			var raw json.RawMessage
+			if err := dec.Decode(&raw); err != nil {
+				return err
+			}
+
+			if rest.Len() > 1 {
+				rest.WriteByte(',')
+			}
+
+			name, _ := json.Marshal(key)
+			rest.Write(name)
+			rest.WriteByte(':')
+			rest.Write(raw)
+
+			continue
+		}
+
+		if err := decodeStreamedItems(dec, strictEnums, fn); err != nil {
+			return err
+		}
+	}
+
+	rest.WriteByte('}')
+
+	return json.Unmarshal(rest.Bytes(), v)
+}
+
+func decodeStreamedItems[T any](dec *json.Decoder, strictEnums bool, fn func(item *T) error) error {
+	if err := expectDelim(dec, '['); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		item := new(T)
+		if err := dec.Decode(item); err != nil {
+			return err
+		}
+
+		if strictEnums {
+			if err := validateEnums(item); err != nil {
+				return err
+			}
+		}
+
+		if err := fn(item); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+
+	if token != delim {
+		return fmt.Errorf("expected %v, got %v", delim, token)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const streamedDevicesBody = `{
+	"data":[
+		{"type":"devices","id":"1","attributes":{"platform":"IOS"}},
+		{"type":"devices","id":"2","attributes":{"platform":"MAC_OS"}}
+	],
+	"links":{"self":"https://api.appstoreconnect.apple.com/v1/devices"},
+	"meta":{"paging":{"total":2,"limit":200}}
+}`
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	var cursors []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cursor := r.URL.Query().Get("cursor")
+		cursors = append(cursors, cursor)
+
+		if cursor == "" {
+			fmt.Fprintln(w, `{"data":[{"type":"devices","id":"1"}],"links":{"self":"","next":"https://api.appstoreconnect.apple.com/v1/devices?cursor=NEXT"}}`)
+
+			return
+		}
+
+		fmt.Fprintln(w, streamedDevicesBody)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	ids := make([]string, 0)
+	resp, err := Stream(context.Background(), client, "devices", &ListDevicesQuery{Limit: MaxPageLimit}, func(device *Device) error {
+		ids = append(ids, device.ID)
+
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "1", "2"}, ids)
+	assert.Equal(t, []string{"", "NEXT"}, cursors)
+	assert.Equal(t, 2, resp.TotalCount())
+}
+
+func TestStreamCallbackError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(streamedDevicesBody, http.StatusOK, false)
+	defer server.Close()
+
+	errStop := errors.New("stop")
+	count := 0
+
+	_, err := Stream(context.Background(), client, "devices", nil, func(device *Device) error {
+		count++
+
+		return errStop
+	})
+	assert.Equal(t, errStop, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestStreamStrictEnums(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":[{"type":"devices","id":"1","attributes":{"platform":"DOS"}}]}`, http.StatusOK, false)
+	defer server.Close()
+
+	client.SetStrictEnums(true)
+
+	_, err := Stream(context.Background(), client, "devices", nil, func(device *Device) error {
+		t.Fatal("invalid item was streamed")
+
+		return nil
+	})
+	assert.Equal(t, ErrUnknownEnumValue{Type: "BundleIDPlatform", Value: "DOS"}, err)
+}
+
+func TestStreamMalformed(t *testing.T) {
+	t.Parallel()
+
+	for _, body := range []string{`[]`, `{"data":{}}`, `{"data":[{"id":1}]}`, `{"data":[`, `{"links":`} {
+		client, server := newServer(body, http.StatusOK, false)
+
+		_, err := Stream(context.Background(), client, "devices", nil, func(device *Device) error {
+			return nil
+		})
+		assert.Error(t, err, body)
+
+		server.Close()
+	}
+}
+
+func TestStreamDoesNotAffectOtherCalls(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(streamedDevicesBody, http.StatusOK, false)
+	defer server.Close()
+
+	res, _, err := client.Provisioning.ListDevices(context.Background(), nil)
+	assert.NoError(t, err)
+	assert.Len(t, res.Data, 2)
+}
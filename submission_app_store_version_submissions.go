@@ -108,9 +108,5 @@ func (s *SubmissionService) DeleteSubmission(ctx context.Context, id string) (*R
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_store_version_submission_information_of_an_app_store_version
 func (s *SubmissionService) GetAppStoreVersionSubmissionForAppStoreVersion(ctx context.Context, id string, params *GetAppStoreVersionSubmissionForAppStoreVersionQuery) (*AppStoreVersionSubmissionResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersions/%s/appStoreVersionSubmission", id)
-	res := new(AppStoreVersionSubmissionResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppStoreVersionSubmissionResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s/appStoreVersionSubmission", id), params)
 }
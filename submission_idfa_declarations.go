@@ -164,9 +164,5 @@ func (s *SubmissionService) DeleteIDFADeclaration(ctx context.Context, id string
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_idfa_declaration_information_of_an_app_store_version
 func (s *SubmissionService) GetIDFADeclarationForAppStoreVersion(ctx context.Context, id string, params *GetIDFADeclarationForAppStoreVersionQuery) (*IDFADeclarationResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersions/%s/idfaDeclaration", id)
-	res := new(IDFADeclarationResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[IDFADeclarationResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s/idfaDeclaration", id), params)
 }
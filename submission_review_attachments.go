@@ -135,22 +135,14 @@ type ListAttachmentQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_review_attachment_information
 func (s *SubmissionService) GetAttachment(ctx context.Context, id string, params *GetAttachmentQuery) (*AppStoreReviewAttachmentResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreReviewAttachments/%s", id)
-	res := new(AppStoreReviewAttachmentResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppStoreReviewAttachmentResponse](ctx, s.client, fmt.Sprintf("appStoreReviewAttachments/%s", id), params)
 }
 
 // ListAttachmentsForReviewDetail lists all the App Store review attachments you include with a version when you submit it for App Review.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_review_attachments_for_an_app_store_review_detail
 func (s *SubmissionService) ListAttachmentsForReviewDetail(ctx context.Context, id string, params *ListAttachmentQuery) (*AppStoreReviewAttachmentsResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreReviewDetails/%s/appStoreReviewAttachments", id)
-	res := new(AppStoreReviewAttachmentsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppStoreReviewAttachmentsResponse](ctx, s.client, fmt.Sprintf("appStoreReviewDetails/%s/appStoreReviewAttachments", id), params)
 }
 
 // CreateAttachment attaches a document for App Review to an App Store version.
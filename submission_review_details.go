@@ -40,7 +40,7 @@ type AppStoreReviewDetail struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appstorereviewdetail/attributes
 type AppStoreReviewDetailAttributes struct {
-	ContactEmail        *string `json:"contactEmail,omitempty"`
+	ContactEmail        *Email  `json:"contactEmail,omitempty"`
 	ContactFirstName    *string `json:"contactFirstName,omitempty"`
 	ContactLastName     *string `json:"contactLastName,omitempty"`
 	ContactPhone        *string `json:"contactPhone,omitempty"`
@@ -71,7 +71,7 @@ type appStoreReviewDetailCreateRequest struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appstorereviewdetailcreaterequest/data/attributes
 type AppStoreReviewDetailCreateRequestAttributes struct {
-	ContactEmail        *string `json:"contactEmail,omitempty"`
+	ContactEmail        *Email  `json:"contactEmail,omitempty"`
 	ContactFirstName    *string `json:"contactFirstName,omitempty"`
 	ContactLastName     *string `json:"contactLastName,omitempty"`
 	ContactPhone        *string `json:"contactPhone,omitempty"`
@@ -101,7 +101,7 @@ type appStoreReviewDetailUpdateRequest struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/appstorereviewdetailupdaterequest/data/attributes
 type AppStoreReviewDetailUpdateRequestAttributes struct {
-	ContactEmail        *string `json:"contactEmail,omitempty"`
+	ContactEmail        *Email  `json:"contactEmail,omitempty"`
 	ContactFirstName    *string `json:"contactFirstName,omitempty"`
 	ContactLastName     *string `json:"contactLastName,omitempty"`
 	ContactPhone        *string `json:"contactPhone,omitempty"`
@@ -166,22 +166,14 @@ func (s *SubmissionService) CreateReviewDetail(ctx context.Context, attributes *
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_app_store_review_detail_information
 func (s *SubmissionService) GetReviewDetail(ctx context.Context, id string, params *GetReviewDetailQuery) (*AppStoreReviewDetailResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreReviewDetails/%s", id)
-	res := new(AppStoreReviewDetailResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppStoreReviewDetailResponse](ctx, s.client, fmt.Sprintf("appStoreReviewDetails/%s", id), params)
 }
 
 // GetReviewDetailsForAppStoreVersion gets the details you provide to App Review so they can test your app.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_store_review_details_resource_information_of_an_app_store_version
 func (s *SubmissionService) GetReviewDetailsForAppStoreVersion(ctx context.Context, id string, params *GetAppStoreReviewDetailsForAppStoreVersionQuery) (*AppStoreReviewDetailResponse, *Response, error) {
-	url := fmt.Sprintf("appStoreVersions/%s/appStoreReviewDetail", id)
-	res := new(AppStoreReviewDetailResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppStoreReviewDetailResponse](ctx, s.client, fmt.Sprintf("appStoreVersions/%s/appStoreReviewDetail", id), params)
 }
 
 // UpdateReviewDetail update the app store review details, including the contact information, demo account, and notes.
@@ -0,0 +1,454 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// ReviewSubmissionState defines model for ReviewSubmission.Attributes.State
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmission/attributes
+type ReviewSubmissionState string
+
+const (
+	// ReviewSubmissionStateReadyForReview is a review submission state for ReadyForReview.
+	ReviewSubmissionStateReadyForReview ReviewSubmissionState = "READY_FOR_REVIEW"
+	// ReviewSubmissionStateWaitingForReview is a review submission state for WaitingForReview.
+	ReviewSubmissionStateWaitingForReview ReviewSubmissionState = "WAITING_FOR_REVIEW"
+	// ReviewSubmissionStateInReview is a review submission state for InReview.
+	ReviewSubmissionStateInReview ReviewSubmissionState = "IN_REVIEW"
+	// ReviewSubmissionStateUnresolvedIssues is a review submission state for UnresolvedIssues.
+	ReviewSubmissionStateUnresolvedIssues ReviewSubmissionState = "UNRESOLVED_ISSUES"
+	// ReviewSubmissionStateCanceling is a review submission state for Canceling.
+	ReviewSubmissionStateCanceling ReviewSubmissionState = "CANCELING"
+	// ReviewSubmissionStateCompleting is a review submission state for Completing.
+	ReviewSubmissionStateCompleting ReviewSubmissionState = "COMPLETING"
+	// ReviewSubmissionStateComplete is a review submission state for Complete.
+	ReviewSubmissionStateComplete ReviewSubmissionState = "COMPLETE"
+)
+
+var reviewSubmissionStates = []ReviewSubmissionState{
+	ReviewSubmissionStateReadyForReview,
+	ReviewSubmissionStateWaitingForReview,
+	ReviewSubmissionStateInReview,
+	ReviewSubmissionStateUnresolvedIssues,
+	ReviewSubmissionStateCanceling,
+	ReviewSubmissionStateCompleting,
+	ReviewSubmissionStateComplete,
+}
+
+// IsValid reports whether the review submission state is one this package knows about.
+func (v ReviewSubmissionState) IsValid() bool {
+	for _, value := range reviewSubmissionStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseReviewSubmissionState returns s as a ReviewSubmissionState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseReviewSubmissionState(s string) (ReviewSubmissionState, error) {
+	if v := ReviewSubmissionState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "ReviewSubmissionState", Value: s}
+}
+
+// ReviewSubmissionItemState defines model for ReviewSubmissionItem.Attributes.State
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitem/attributes
+type ReviewSubmissionItemState string
+
+const (
+	// ReviewSubmissionItemStateReadyForReview is a review submission item state for ReadyForReview.
+	ReviewSubmissionItemStateReadyForReview ReviewSubmissionItemState = "READY_FOR_REVIEW"
+	// ReviewSubmissionItemStateAccepted is a review submission item state for Accepted.
+	ReviewSubmissionItemStateAccepted ReviewSubmissionItemState = "ACCEPTED"
+	// ReviewSubmissionItemStateApproved is a review submission item state for Approved.
+	ReviewSubmissionItemStateApproved ReviewSubmissionItemState = "APPROVED"
+	// ReviewSubmissionItemStateRejected is a review submission item state for Rejected.
+	ReviewSubmissionItemStateRejected ReviewSubmissionItemState = "REJECTED"
+	// ReviewSubmissionItemStateRemoved is a review submission item state for Removed.
+	ReviewSubmissionItemStateRemoved ReviewSubmissionItemState = "REMOVED"
+)
+
+var reviewSubmissionItemStates = []ReviewSubmissionItemState{
+	ReviewSubmissionItemStateReadyForReview,
+	ReviewSubmissionItemStateAccepted,
+	ReviewSubmissionItemStateApproved,
+	ReviewSubmissionItemStateRejected,
+	ReviewSubmissionItemStateRemoved,
+}
+
+// IsValid reports whether the review submission item state is one this package knows about.
+func (v ReviewSubmissionItemState) IsValid() bool {
+	for _, value := range reviewSubmissionItemStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseReviewSubmissionItemState returns s as a ReviewSubmissionItemState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseReviewSubmissionItemState(s string) (ReviewSubmissionItemState, error) {
+	if v := ReviewSubmissionItemState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "ReviewSubmissionItemState", Value: s}
+}
+
+// ReviewSubmission defines model for ReviewSubmission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmission
+type ReviewSubmission struct {
+	Attributes    *ReviewSubmissionAttributes    `json:"attributes,omitempty"`
+	ID            string                         `json:"id"`
+	Links         ResourceLinks                  `json:"links"`
+	Relationships *ReviewSubmissionRelationships `json:"relationships,omitempty"`
+	Type          string                         `json:"type"`
+}
+
+// ReviewSubmissionAttributes defines model for ReviewSubmission.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmission/attributes
+type ReviewSubmissionAttributes struct {
+	Platform      *Platform              `json:"platform,omitempty"`
+	State         *ReviewSubmissionState `json:"state,omitempty"`
+	SubmittedDate *DateTime              `json:"submittedDate,omitempty"`
+}
+
+// ReviewSubmissionRelationships defines model for ReviewSubmission.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmission/relationships
+type ReviewSubmissionRelationships struct {
+	App                      *Relationship      `json:"app,omitempty"`
+	AppStoreVersionForReview *Relationship      `json:"appStoreVersionForReview,omitempty"`
+	Items                    *PagedRelationship `json:"items,omitempty"`
+}
+
+// ReviewSubmissionResponse defines model for ReviewSubmissionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionresponse
+type ReviewSubmissionResponse struct {
+	Data     ReviewSubmission                   `json:"data"`
+	Included []ReviewSubmissionResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                      `json:"links"`
+}
+
+// ReviewSubmissionsResponse defines model for ReviewSubmissionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionsresponse
+type ReviewSubmissionsResponse struct {
+	Data     []ReviewSubmission                 `json:"data"`
+	Included []ReviewSubmissionResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks                 `json:"links"`
+	Meta     *PagingInformation                 `json:"meta,omitempty"`
+}
+
+// ReviewSubmissionResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a ReviewSubmissionResponse or ReviewSubmissionsResponse.
+type ReviewSubmissionResponseIncluded included
+
+// reviewSubmissionCreateRequest defines model for ReviewSubmissionCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissioncreaterequest/data
+type reviewSubmissionCreateRequest struct {
+	Attributes    *reviewSubmissionCreateRequestAttributes   `json:"attributes,omitempty"`
+	Relationships reviewSubmissionCreateRequestRelationships `json:"relationships"`
+	Type          string                                     `json:"type"`
+}
+
+// reviewSubmissionCreateRequestAttributes are attributes for ReviewSubmissionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissioncreaterequest/data/attributes
+type reviewSubmissionCreateRequestAttributes struct {
+	Platform *Platform `json:"platform,omitempty"`
+}
+
+// reviewSubmissionCreateRequestRelationships are relationships for ReviewSubmissionCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissioncreaterequest/data/relationships
+type reviewSubmissionCreateRequestRelationships struct {
+	App relationshipDeclaration `json:"app"`
+}
+
+// reviewSubmissionUpdateRequest defines model for ReviewSubmissionUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionupdaterequest/data
+type reviewSubmissionUpdateRequest struct {
+	Attributes *reviewSubmissionUpdateRequestAttributes `json:"attributes,omitempty"`
+	ID         string                                   `json:"id"`
+	Type       string                                   `json:"type"`
+}
+
+// reviewSubmissionUpdateRequestAttributes are attributes for ReviewSubmissionUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionupdaterequest/data/attributes
+type reviewSubmissionUpdateRequestAttributes struct {
+	Canceled  *bool `json:"canceled,omitempty"`
+	Submitted *bool `json:"submitted,omitempty"`
+}
+
+// ReviewSubmissionItem defines model for ReviewSubmissionItem.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitem
+type ReviewSubmissionItem struct {
+	Attributes    *ReviewSubmissionItemAttributes    `json:"attributes,omitempty"`
+	ID            string                             `json:"id"`
+	Links         ResourceLinks                      `json:"links"`
+	Relationships *ReviewSubmissionItemRelationships `json:"relationships,omitempty"`
+	Type          string                             `json:"type"`
+}
+
+// ReviewSubmissionItemAttributes defines model for ReviewSubmissionItem.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitem/attributes
+type ReviewSubmissionItemAttributes struct {
+	State *ReviewSubmissionItemState `json:"state,omitempty"`
+}
+
+// ReviewSubmissionItemRelationships defines model for ReviewSubmissionItem.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitem/relationships
+type ReviewSubmissionItemRelationships struct {
+	AppCustomProductPageVersion *Relationship `json:"appCustomProductPageVersion,omitempty"`
+	AppEvent                    *Relationship `json:"appEvent,omitempty"`
+	AppStoreVersion             *Relationship `json:"appStoreVersion,omitempty"`
+	AppStoreVersionExperimentV2 *Relationship `json:"appStoreVersionExperimentV2,omitempty"`
+	ReviewSubmission            *Relationship `json:"reviewSubmission,omitempty"`
+}
+
+// ReviewSubmissionItemResponse defines model for ReviewSubmissionItemResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitemresponse
+type ReviewSubmissionItemResponse struct {
+	Data  ReviewSubmissionItem `json:"data"`
+	Links DocumentLinks        `json:"links"`
+}
+
+// ReviewSubmissionItemsResponse defines model for ReviewSubmissionItemsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitemsresponse
+type ReviewSubmissionItemsResponse struct {
+	Data  []ReviewSubmissionItem `json:"data"`
+	Links PagedDocumentLinks     `json:"links"`
+	Meta  *PagingInformation     `json:"meta,omitempty"`
+}
+
+// reviewSubmissionItemCreateRequest defines model for ReviewSubmissionItemCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitemcreaterequest/data
+type reviewSubmissionItemCreateRequest struct {
+	Relationships reviewSubmissionItemCreateRequestRelationships `json:"relationships"`
+	Type          string                                         `json:"type"`
+}
+
+// reviewSubmissionItemCreateRequestRelationships are relationships for ReviewSubmissionItemCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/reviewsubmissionitemcreaterequest/data/relationships
+type reviewSubmissionItemCreateRequestRelationships struct {
+	AppCustomProductPageVersion *relationshipDeclaration `json:"appCustomProductPageVersion,omitempty"`
+	AppEvent                    *relationshipDeclaration `json:"appEvent,omitempty"`
+	AppStoreVersion             *relationshipDeclaration `json:"appStoreVersion,omitempty"`
+	AppStoreVersionExperimentV2 *relationshipDeclaration `json:"appStoreVersionExperimentV2,omitempty"`
+	ReviewSubmission            relationshipDeclaration  `json:"reviewSubmission"`
+}
+
+// ReviewSubmissionItemTarget is a public-facing options object identifying the resource a ReviewSubmissionItem
+// adds to a review submission. Exactly one of the IDs should be set.
+type ReviewSubmissionItemTarget struct {
+	AppCustomProductPageVersionID *string
+	AppEventID                    *string
+	AppStoreVersionID             *string
+	AppStoreVersionExperimentID   *string
+}
+
+// ListReviewSubmissionsQuery are query options for ListReviewSubmissions
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_reviewsubmissions
+type ListReviewSubmissionsQuery struct {
+	FieldsReviewSubmissions     []string `url:"fields[reviewSubmissions],omitempty"`
+	FieldsReviewSubmissionItems []string `url:"fields[reviewSubmissionItems],omitempty"`
+	FilterApp                   []string `url:"filter[app],omitempty"`
+	FilterPlatform              []string `url:"filter[platform],omitempty"`
+	FilterState                 []string `url:"filter[state],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	LimitItems                  int      `url:"limit[items],omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// GetReviewSubmissionQuery are query options for GetReviewSubmission
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_reviewsubmissions_id
+type GetReviewSubmissionQuery struct {
+	FieldsReviewSubmissions     []string `url:"fields[reviewSubmissions],omitempty"`
+	FieldsReviewSubmissionItems []string `url:"fields[reviewSubmissionItems],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	LimitItems                  int      `url:"limit[items],omitempty"`
+}
+
+// ListItemsForReviewSubmissionQuery are query options for ListItemsForReviewSubmission
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_reviewsubmissions_id_items
+type ListItemsForReviewSubmissionQuery struct {
+	FieldsReviewSubmissionItems []string `url:"fields[reviewSubmissionItems],omitempty"`
+	Include                     []string `url:"include,omitempty"`
+	Limit                       int      `url:"limit,omitempty"`
+	Cursor                      string   `url:"cursor,omitempty"`
+}
+
+// ListReviewSubmissions finds review submissions, typically filtered to a single app with FilterApp.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_reviewsubmissions
+func (s *SubmissionService) ListReviewSubmissions(ctx context.Context, params *ListReviewSubmissionsQuery) (*ReviewSubmissionsResponse, *Response, error) {
+	return Get[ReviewSubmissionsResponse](ctx, s.client, "reviewSubmissions", params)
+}
+
+// GetReviewSubmission gets a review submission and its state.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_reviewsubmissions_id
+func (s *SubmissionService) GetReviewSubmission(ctx context.Context, id string, params *GetReviewSubmissionQuery) (*ReviewSubmissionResponse, *Response, error) {
+	return Get[ReviewSubmissionResponse](ctx, s.client, fmt.Sprintf("reviewSubmissions/%s", id), params)
+}
+
+// CreateReviewSubmission creates an empty review submission for an app, which items can then be added to.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_reviewsubmissions
+func (s *SubmissionService) CreateReviewSubmission(ctx context.Context, appID string, platform *Platform) (*ReviewSubmissionResponse, *Response, error) {
+	req := reviewSubmissionCreateRequest{
+		Relationships: reviewSubmissionCreateRequestRelationships{
+			App: *newRelationshipDeclaration(&appID, "apps"),
+		},
+		Type: "reviewSubmissions",
+	}
+
+	if platform != nil {
+		req.Attributes = &reviewSubmissionCreateRequestAttributes{
+			Platform: platform,
+		}
+	}
+
+	res := new(ReviewSubmissionResponse)
+	resp, err := s.client.post(ctx, "reviewSubmissions", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// SubmitReviewSubmission sends a review submission and all of its items to App Review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_reviewsubmissions_id
+func (s *SubmissionService) SubmitReviewSubmission(ctx context.Context, id string) (*ReviewSubmissionResponse, *Response, error) {
+	return s.updateReviewSubmission(ctx, id, reviewSubmissionUpdateRequestAttributes{
+		Submitted: Bool(true),
+	})
+}
+
+// CancelReviewSubmission withdraws a review submission from App Review.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/patch_v1_reviewsubmissions_id
+func (s *SubmissionService) CancelReviewSubmission(ctx context.Context, id string) (*ReviewSubmissionResponse, *Response, error) {
+	return s.updateReviewSubmission(ctx, id, reviewSubmissionUpdateRequestAttributes{
+		Canceled: Bool(true),
+	})
+}
+
+func (s *SubmissionService) updateReviewSubmission(ctx context.Context, id string, attributes reviewSubmissionUpdateRequestAttributes) (*ReviewSubmissionResponse, *Response, error) {
+	req := reviewSubmissionUpdateRequest{
+		Attributes: &attributes,
+		ID:         id,
+		Type:       "reviewSubmissions",
+	}
+	url := fmt.Sprintf("reviewSubmissions/%s", id)
+	res := new(ReviewSubmissionResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// ListItemsForReviewSubmission lists the items that make up a review submission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_reviewsubmissions_id_items
+func (s *SubmissionService) ListItemsForReviewSubmission(ctx context.Context, id string, params *ListItemsForReviewSubmissionQuery) (*ReviewSubmissionItemsResponse, *Response, error) {
+	return Get[ReviewSubmissionItemsResponse](ctx, s.client, fmt.Sprintf("reviewSubmissions/%s/items", id), params)
+}
+
+// CreateReviewSubmissionItem adds an App Store version, custom product page version, experiment or in-app event
+// to a review submission.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/post_v1_reviewsubmissionitems
+func (s *SubmissionService) CreateReviewSubmissionItem(ctx context.Context, reviewSubmissionID string, target ReviewSubmissionItemTarget) (*ReviewSubmissionItemResponse, *Response, error) {
+	req := reviewSubmissionItemCreateRequest{
+		Relationships: reviewSubmissionItemCreateRequestRelationships{
+			AppCustomProductPageVersion: newRelationshipDeclaration(target.AppCustomProductPageVersionID, "appCustomProductPageVersions"),
+			AppEvent:                    newRelationshipDeclaration(target.AppEventID, "appEvents"),
+			AppStoreVersion:             newRelationshipDeclaration(target.AppStoreVersionID, "appStoreVersions"),
+			AppStoreVersionExperimentV2: newRelationshipDeclaration(target.AppStoreVersionExperimentID, "appStoreVersionExperiments"),
+			ReviewSubmission:            *newRelationshipDeclaration(&reviewSubmissionID, "reviewSubmissions"),
+		},
+		Type: "reviewSubmissionItems",
+	}
+	res := new(ReviewSubmissionItemResponse)
+	resp, err := s.client.post(ctx, "reviewSubmissionItems", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// DeleteReviewSubmissionItem removes an item from a review submission that hasn't been submitted.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_v1_reviewsubmissionitems_id
+func (s *SubmissionService) DeleteReviewSubmissionItem(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("reviewSubmissionItems/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in ReviewSubmissionResponseIncluded.
+func (i *ReviewSubmissionResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *ReviewSubmissionResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// AppStoreVersion returns the AppStoreVersion stored within, if one is present.
+func (i *ReviewSubmissionResponseIncluded) AppStoreVersion() *AppStoreVersion {
+	return extractIncludedAppStoreVersion(i.inner)
+}
+
+// ReviewSubmissionItem returns the ReviewSubmissionItem stored within, if one is present.
+func (i *ReviewSubmissionResponseIncluded) ReviewSubmissionItem() *ReviewSubmissionItem {
+	return extractIncludedReviewSubmissionItem(i.inner)
+}
@@ -0,0 +1,336 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrAppStoreVersionNotFound happens when SubmitVersionForReview cannot find the requested version of an app.
+var ErrAppStoreVersionNotFound = errors.New("app store version not found")
+
+// ErrMissingExportCompliance happens when the build attached to a version has not answered the export compliance
+// question and SubmitVersionOptions.UsesNonExemptEncryption does not provide an answer.
+var ErrMissingExportCompliance = errors.New("build has no export compliance information")
+
+// ErrSubmissionInReview happens when SubmitVersionForReview finds that the app's review submission for the
+// platform is already waiting for or in review with another version, so the version cannot be added to it.
+type ErrSubmissionInReview struct {
+	SubmissionID string
+	State        ReviewSubmissionState
+}
+
+func (e ErrSubmissionInReview) Error() string {
+	return fmt.Sprintf("review submission %s is already in review for another version (%s)", e.SubmissionID, e.State)
+}
+
+// ErrInvalidVersion happens when SubmitVersionForReview finds violations of App Store constraints in a version.
+type ErrInvalidVersion struct {
+	Violations []VersionViolation
+}
+
+func (e ErrInvalidVersion) Error() string {
+	violations := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		violations[i] = violation.String()
+	}
+
+	return fmt.Sprintf("app store version is not ready for review: %s", strings.Join(violations, "; "))
+}
+
+// SubmitVersionStep identifies a step of SubmitVersionForReview, and is reported to SubmitVersionOptions.Progress.
+type SubmitVersionStep string
+
+const (
+	// SubmitVersionStepFindVersion is the step that looks up the App Store version by its version string.
+	SubmitVersionStepFindVersion SubmitVersionStep = "FIND_VERSION"
+	// SubmitVersionStepSelectBuild is the step that attaches a build to the version.
+	SubmitVersionStepSelectBuild SubmitVersionStep = "SELECT_BUILD"
+	// SubmitVersionStepExportCompliance is the step that ensures the build answered export compliance.
+	SubmitVersionStepExportCompliance SubmitVersionStep = "EXPORT_COMPLIANCE"
+	// SubmitVersionStepValidate is the step that checks the version's metadata with ValidateVersion.
+	SubmitVersionStepValidate SubmitVersionStep = "VALIDATE"
+	// SubmitVersionStepCreateSubmission is the step that finds or creates a review submission.
+	SubmitVersionStepCreateSubmission SubmitVersionStep = "CREATE_SUBMISSION"
+	// SubmitVersionStepAddItem is the step that adds the version to the review submission.
+	SubmitVersionStepAddItem SubmitVersionStep = "ADD_ITEM"
+	// SubmitVersionStepSubmit is the step that submits the review submission to App Review.
+	SubmitVersionStepSubmit SubmitVersionStep = "SUBMIT"
+)
+
+var submitVersionSteps = []SubmitVersionStep{
+	SubmitVersionStepFindVersion,
+	SubmitVersionStepSelectBuild,
+	SubmitVersionStepExportCompliance,
+	SubmitVersionStepValidate,
+	SubmitVersionStepCreateSubmission,
+	SubmitVersionStepAddItem,
+	SubmitVersionStepSubmit,
+}
+
+// IsValid reports whether the submit version step is one this package knows about.
+func (v SubmitVersionStep) IsValid() bool {
+	for _, value := range submitVersionSteps {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseSubmitVersionStep returns s as a SubmitVersionStep, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseSubmitVersionStep(s string) (SubmitVersionStep, error) {
+	if v := SubmitVersionStep(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "SubmitVersionStep", Value: s}
+}
+
+// SubmitVersionOptions are options for SubmitVersionForReview.
+type SubmitVersionOptions struct {
+	// Platform selects the version when the same version string exists on several platforms.
+	Platform *Platform
+	// BuildID is the build to attach. If nil, the attached build is kept, or the latest processed build is selected.
+	BuildID *string
+	// UsesNonExemptEncryption answers export compliance for a build that has not answered it yet.
+	UsesNonExemptEncryption *bool
+	// AppEncryptionDeclarationID is assigned to the build along with UsesNonExemptEncryption.
+	AppEncryptionDeclarationID *string
+	// SkipValidation submits the version even if ValidateVersion reports violations.
+	SkipValidation bool
+	// Progress, if set, is called as each step begins.
+	Progress func(step SubmitVersionStep)
+}
+
+func (o *SubmitVersionOptions) progress(step SubmitVersionStep) {
+	if o.Progress != nil {
+		o.Progress(step)
+	}
+}
+
+// SubmitVersionForReview submits an App Store version to App Review in one call. It attaches a build, answers
+// export compliance, validates the version's metadata, and then adds the version to a review submission and submits
+// it. Steps that are already complete are skipped, so it is safe to call again after a failure: a pending draft
+// submission is reused, and a version that is already waiting for or in review is returned as is. If another
+// version of the app is waiting for or in review, ErrSubmissionInReview is returned.
+func (s *SubmissionService) SubmitVersionForReview(ctx context.Context, appID string, version string, options *SubmitVersionOptions) (*ReviewSubmissionResponse, *Response, error) {
+	if options == nil {
+		options = &SubmitVersionOptions{}
+	}
+
+	options.progress(SubmitVersionStepFindVersion)
+
+	query := &ListAppStoreVersionsQuery{
+		FilterVersionString: []string{version},
+		Include:             []string{"build"},
+	}
+	if options.Platform != nil {
+		query.FilterPlatform = []string{string(*options.Platform)}
+	}
+
+	versions, resp, err := s.client.Apps.ListAppStoreVersionsForApp(ctx, appID, query)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(versions.Data) == 0 {
+		return nil, resp, ErrAppStoreVersionNotFound
+	}
+
+	appStoreVersion := versions.Data[0]
+
+	buildID, resp, err := s.ensureBuildForVersion(ctx, appStoreVersion, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	options.progress(SubmitVersionStepExportCompliance)
+
+	resp, err = s.ensureExportCompliance(ctx, buildID, options)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if !options.SkipValidation {
+		options.progress(SubmitVersionStepValidate)
+
+		violations, resp, err := s.client.Apps.ValidateVersion(ctx, appStoreVersion.ID)
+		if err != nil {
+			return nil, resp, err
+		}
+
+		if len(violations) > 0 {
+			return nil, resp, ErrInvalidVersion{Violations: violations}
+		}
+	}
+
+	var platform *Platform
+	if appStoreVersion.Attributes != nil {
+		platform = appStoreVersion.Attributes.Platform
+	}
+
+	options.progress(SubmitVersionStepCreateSubmission)
+
+	submission, resp, err := s.pendingReviewSubmission(ctx, appID, platform)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	includesVersion := submission != nil && reviewSubmissionIncludesVersion(&submission.Data, appStoreVersion.ID)
+
+	switch {
+	case includesVersion && isReviewSubmissionSubmitted(&submission.Data):
+		return submission, resp, nil
+	case includesVersion:
+		// The pending submission already holds the version, so it only needs to be submitted again.
+	case submission != nil && isReviewSubmissionSubmitted(&submission.Data):
+		return nil, resp, ErrSubmissionInReview{
+			SubmissionID: submission.Data.ID,
+			State:        *submission.Data.Attributes.State,
+		}
+	default:
+		if submission == nil {
+			submission, resp, err = s.CreateReviewSubmission(ctx, appID, platform)
+			if err != nil {
+				return nil, resp, err
+			}
+		}
+
+		options.progress(SubmitVersionStepAddItem)
+
+		_, resp, err = s.CreateReviewSubmissionItem(ctx, submission.Data.ID, ReviewSubmissionItemTarget{
+			AppStoreVersionID: &appStoreVersion.ID,
+		})
+		if err != nil {
+			return nil, resp, err
+		}
+	}
+
+	options.progress(SubmitVersionStepSubmit)
+
+	return s.SubmitReviewSubmission(ctx, submission.Data.ID)
+}
+
+func (s *SubmissionService) ensureBuildForVersion(ctx context.Context, version AppStoreVersion, options *SubmitVersionOptions) (string, *Response, error) {
+	var current string
+	if version.Relationships != nil && version.Relationships.Build != nil && version.Relationships.Build.Data != nil {
+		current = version.Relationships.Build.Data.ID
+	}
+
+	if options.BuildID == nil && current != "" {
+		return current, nil, nil
+	}
+
+	if options.BuildID != nil && *options.BuildID == current {
+		return current, nil, nil
+	}
+
+	options.progress(SubmitVersionStepSelectBuild)
+
+	var (
+		linkage *AppStoreVersionBuildLinkageResponse
+		resp    *Response
+		err     error
+	)
+
+	if options.BuildID != nil {
+		linkage, resp, err = s.client.Apps.SelectBuild(ctx, version.ID, *options.BuildID)
+	} else {
+		linkage, resp, err = s.client.Apps.SelectLatestBuild(ctx, version.ID)
+	}
+
+	if err != nil {
+		return "", resp, err
+	}
+
+	return linkage.Data.ID, resp, nil
+}
+
+func (s *SubmissionService) ensureExportCompliance(ctx context.Context, buildID string, options *SubmitVersionOptions) (*Response, error) {
+	build, resp, err := s.client.Builds.GetBuild(ctx, buildID, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	if build.Data.Attributes != nil && build.Data.Attributes.UsesNonExemptEncryption != nil {
+		return resp, nil
+	}
+
+	if options.UsesNonExemptEncryption == nil {
+		return resp, ErrMissingExportCompliance
+	}
+
+	_, resp, err = s.client.Builds.UpdateBuild(ctx, buildID, nil, options.UsesNonExemptEncryption, options.AppEncryptionDeclarationID)
+
+	return resp, err
+}
+
+// pendingReviewSubmission finds the app's review submission that is still a draft, has unresolved issues, or is
+// waiting for or in review. App Store Connect allows only one such submission per platform.
+func (s *SubmissionService) pendingReviewSubmission(ctx context.Context, appID string, platform *Platform) (*ReviewSubmissionResponse, *Response, error) {
+	query := &ListReviewSubmissionsQuery{
+		FilterApp: []string{appID},
+		FilterState: []string{
+			string(ReviewSubmissionStateReadyForReview),
+			string(ReviewSubmissionStateUnresolvedIssues),
+			string(ReviewSubmissionStateWaitingForReview),
+			string(ReviewSubmissionStateInReview),
+		},
+		Include: []string{"appStoreVersionForReview"},
+		Limit:   1,
+	}
+	if platform != nil {
+		query.FilterPlatform = []string{string(*platform)}
+	}
+
+	submissions, resp, err := s.ListReviewSubmissions(ctx, query)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if len(submissions.Data) == 0 {
+		return nil, resp, nil
+	}
+
+	return &ReviewSubmissionResponse{Data: submissions.Data[0]}, resp, nil
+}
+
+func reviewSubmissionIncludesVersion(submission *ReviewSubmission, versionID string) bool {
+	rels := submission.Relationships
+
+	return rels != nil && rels.AppStoreVersionForReview != nil && rels.AppStoreVersionForReview.Data != nil &&
+		rels.AppStoreVersionForReview.Data.ID == versionID
+}
+
+func isReviewSubmissionSubmitted(submission *ReviewSubmission) bool {
+	if submission.Attributes == nil || submission.Attributes.State == nil {
+		return false
+	}
+
+	state := *submission.Attributes.State
+
+	return state == ReviewSubmissionStateWaitingForReview || state == ReviewSubmissionStateInReview
+}
@@ -0,0 +1,197 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmitVersionForReview(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /apps/1/appStoreVersions":                   `{"data":[{"id":"10","type":"appStoreVersions","attributes":{"platform":"IOS"}}]}`,
+		"GET /appStoreVersions/10":                       `{"data":{"id":"10","type":"appStoreVersions","attributes":{"platform":"IOS"},"relationships":{"app":{"data":{"id":"1","type":"apps"}}}}}`,
+		"GET /builds":                                    `{"data":[{"id":"12","type":"builds","relationships":{"app":{"data":{"id":"1","type":"apps"}}}}]}`,
+		"PATCH /appStoreVersions/10/relationships/build": `{"data":{"id":"12","type":"builds"}}`,
+		"GET /builds/12":                                 `{"data":{"id":"12","type":"builds","attributes":{}}}`,
+		"PATCH /builds/12":                               `{"data":{"id":"12","type":"builds"}}`,
+		"GET /reviewSubmissions":                         `{"data":[]}`,
+		"POST /reviewSubmissions":                        `{"data":{"id":"20","type":"reviewSubmissions"}}`,
+		"POST /reviewSubmissionItems":                    `{"data":{"id":"21","type":"reviewSubmissionItems"}}`,
+		"PATCH /reviewSubmissions/20":                    `{"data":{"id":"20","type":"reviewSubmissions","attributes":{"state":"WAITING_FOR_REVIEW"}}}`,
+	})
+	defer server.Close()
+
+	var steps []SubmitVersionStep
+
+	submission, _, err := client.Submission.SubmitVersionForReview(context.Background(), "1", "1.0", &SubmitVersionOptions{
+		UsesNonExemptEncryption: Bool(false),
+		SkipValidation:          true,
+		Progress: func(step SubmitVersionStep) {
+			steps = append(steps, step)
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "20", submission.Data.ID)
+	assert.Equal(t, []SubmitVersionStep{
+		SubmitVersionStepFindVersion,
+		SubmitVersionStepSelectBuild,
+		SubmitVersionStepExportCompliance,
+		SubmitVersionStepCreateSubmission,
+		SubmitVersionStepAddItem,
+		SubmitVersionStepSubmit,
+	}, steps)
+	assert.Contains(t, *requests, "PATCH /builds/12")
+	assert.Contains(t, *requests, "POST /reviewSubmissions")
+}
+
+func TestSubmitVersionForReviewAlreadySubmitted(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /apps/1/appStoreVersions": `{"data":[{"id":"10","type":"appStoreVersions","attributes":{"platform":"IOS"},"relationships":{"build":{"data":{"id":"12","type":"builds"}}}}]}`,
+		"GET /builds/12":               `{"data":{"id":"12","type":"builds","attributes":{"usesNonExemptEncryption":false}}}`,
+		"GET /reviewSubmissions": `{"data":[{"id":"20","type":"reviewSubmissions","attributes":{"state":"IN_REVIEW"},"relationships":{
+			"appStoreVersionForReview":{"data":{"id":"10","type":"appStoreVersions"}}
+		}}]}`,
+	})
+	defer server.Close()
+
+	submission, _, err := client.Submission.SubmitVersionForReview(context.Background(), "1", "1.0", &SubmitVersionOptions{
+		SkipValidation: true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "20", submission.Data.ID)
+	assert.Equal(t, []string{
+		"GET /apps/1/appStoreVersions",
+		"GET /builds/12",
+		"GET /reviewSubmissions",
+	}, *requests)
+}
+
+func TestSubmitVersionForReviewOtherVersionInReview(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /apps/1/appStoreVersions": `{"data":[{"id":"10","type":"appStoreVersions","attributes":{"platform":"IOS"},"relationships":{"build":{"data":{"id":"12","type":"builds"}}}}]}`,
+		"GET /builds/12":               `{"data":{"id":"12","type":"builds","attributes":{"usesNonExemptEncryption":false}}}`,
+		"GET /reviewSubmissions": `{"data":[{"id":"20","type":"reviewSubmissions","attributes":{"state":"WAITING_FOR_REVIEW"},"relationships":{
+			"appStoreVersionForReview":{"data":{"id":"9","type":"appStoreVersions"}}
+		}}]}`,
+	})
+	defer server.Close()
+
+	submission, _, err := client.Submission.SubmitVersionForReview(context.Background(), "1", "1.0", &SubmitVersionOptions{
+		SkipValidation: true,
+	})
+	assert.Equal(t, ErrSubmissionInReview{SubmissionID: "20", State: ReviewSubmissionStateWaitingForReview}, err)
+	assert.EqualError(t, err, "review submission 20 is already in review for another version (WAITING_FOR_REVIEW)")
+	assert.Nil(t, submission)
+	assert.NotContains(t, *requests, "POST /reviewSubmissionItems")
+	assert.NotContains(t, *requests, "PATCH /reviewSubmissions/20")
+}
+
+func TestSubmitVersionForReviewResubmitsDraft(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /apps/1/appStoreVersions": `{"data":[{"id":"10","type":"appStoreVersions","relationships":{"build":{"data":{"id":"12","type":"builds"}}}}]}`,
+		"GET /builds/12":               `{"data":{"id":"12","type":"builds","attributes":{"usesNonExemptEncryption":false}}}`,
+		"GET /reviewSubmissions": `{"data":[{"id":"20","type":"reviewSubmissions","attributes":{"state":"UNRESOLVED_ISSUES"},"relationships":{
+			"appStoreVersionForReview":{"data":{"id":"10","type":"appStoreVersions"}}
+		}}]}`,
+		"PATCH /reviewSubmissions/20": `{"data":{"id":"20","type":"reviewSubmissions"}}`,
+	})
+	defer server.Close()
+
+	_, _, err := client.Submission.SubmitVersionForReview(context.Background(), "1", "1.0", &SubmitVersionOptions{
+		SkipValidation: true,
+	})
+	assert.NoError(t, err)
+	assert.NotContains(t, *requests, "POST /reviewSubmissionItems")
+	assert.Contains(t, *requests, "PATCH /reviewSubmissions/20")
+}
+
+func TestSubmitVersionForReviewVersionNotFound(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /apps/1/appStoreVersions": `{"data":[]}`,
+	})
+	defer server.Close()
+
+	submission, _, err := client.Submission.SubmitVersionForReview(context.Background(), "1", "1.0", nil)
+	assert.ErrorIs(t, err, ErrAppStoreVersionNotFound)
+	assert.Nil(t, submission)
+}
+
+func TestSubmitVersionForReviewMissingExportCompliance(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /apps/1/appStoreVersions": `{"data":[{"id":"10","type":"appStoreVersions","relationships":{"build":{"data":{"id":"12","type":"builds"}}}}]}`,
+		"GET /builds/12":               `{"data":{"id":"12","type":"builds"}}`,
+	})
+	defer server.Close()
+
+	submission, _, err := client.Submission.SubmitVersionForReview(context.Background(), "1", "1.0", nil)
+	assert.ErrorIs(t, err, ErrMissingExportCompliance)
+	assert.Nil(t, submission)
+}
+
+func TestSubmitVersionForReviewInvalidVersion(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /apps/1/appStoreVersions":                          `{"data":[{"id":"10","type":"appStoreVersions","relationships":{"build":{"data":{"id":"12","type":"builds"}}}}]}`,
+		"GET /builds/12":                                        `{"data":{"id":"12","type":"builds","attributes":{"usesNonExemptEncryption":false}}}`,
+		"GET /appStoreVersions/10":                              `{"data":{"id":"10","type":"appStoreVersions","relationships":{"app":{"data":{"id":"1","type":"apps"}},"build":{"data":{"id":"12","type":"builds"}}}}}`,
+		"GET /apps/1/appInfos":                                  `{"data":[{"id":"2","type":"appInfos"}]}`,
+		"GET /appInfos/2/ageRatingDeclaration":                  `{"data":{"id":"3","type":"ageRatingDeclarations"}}`,
+		"GET /appInfos/2/appInfoLocalizations":                  `{"data":[]}`,
+		"GET /appStoreVersions/10/appStoreVersionLocalizations": `{"data":[]}`,
+	})
+	defer server.Close()
+
+	submission, _, err := client.Submission.SubmitVersionForReview(context.Background(), "1", "1.0", nil)
+	assert.Nil(t, submission)
+
+	var invalid ErrInvalidVersion
+	assert.True(t, errors.As(err, &invalid))
+	assert.Contains(t, invalid.Violations, VersionViolation{Field: "categories.primary", Message: "no primary category is set"})
+	assert.Contains(t, err.Error(), "app store version is not ready for review: ")
+}
+
+func TestParseSubmitVersionStep(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseSubmitVersionStep(string(SubmitVersionStepFindVersion))
+	assert.NoError(t, err)
+	assert.Equal(t, SubmitVersionStepFindVersion, got)
+
+	_, err = ParseSubmitVersionStep("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "SubmitVersionStep", Value: "UNKNOWN"}, err)
+}
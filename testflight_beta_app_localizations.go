@@ -41,7 +41,7 @@ type BetaAppLocalization struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/betaapplocalization/attributes
 type BetaAppLocalizationAttributes struct {
 	Description       *string `json:"description,omitempty"`
-	FeedbackEmail     *string `json:"feedbackEmail,omitempty"`
+	FeedbackEmail     *Email  `json:"feedbackEmail,omitempty"`
 	Locale            *string `json:"locale,omitempty"`
 	MarketingURL      *string `json:"marketingUrl,omitempty"`
 	PrivacyPolicyURL  *string `json:"privacyPolicyUrl,omitempty"`
@@ -69,7 +69,7 @@ type betaAppLocalizationCreateRequest struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/betaapplocalizationcreaterequest/data/attributes
 type BetaAppLocalizationCreateRequestAttributes struct {
 	Description       *string `json:"description,omitempty"`
-	FeedbackEmail     *string `json:"feedbackEmail,omitempty"`
+	FeedbackEmail     *Email  `json:"feedbackEmail,omitempty"`
 	Locale            string  `json:"locale"`
 	MarketingURL      *string `json:"marketingUrl,omitempty"`
 	PrivacyPolicyURL  *string `json:"privacyPolicyUrl,omitempty"`
@@ -106,7 +106,7 @@ type betaAppLocalizationUpdateRequest struct {
 // https://developer.apple.com/documentation/appstoreconnectapi/betaapplocalizationupdaterequest/data/attributes
 type BetaAppLocalizationUpdateRequestAttributes struct {
 	Description       *string `json:"description,omitempty"`
-	FeedbackEmail     *string `json:"feedbackEmail,omitempty"`
+	FeedbackEmail     *Email  `json:"feedbackEmail,omitempty"`
 	MarketingURL      *string `json:"marketingUrl,omitempty"`
 	PrivacyPolicyURL  *string `json:"privacyPolicyUrl,omitempty"`
 	TVOSPrivacyPolicy *string `json:"tvOsPrivacyPolicy,omitempty"`
@@ -164,43 +164,28 @@ type ListBetaAppLocalizationsForAppQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_app_localizations
 func (s *TestflightService) ListBetaAppLocalizations(ctx context.Context, params *ListBetaAppLocalizationsQuery) (*BetaAppLocalizationsResponse, *Response, error) {
-	res := new(BetaAppLocalizationsResponse)
-	resp, err := s.client.get(ctx, "betaAppLocalizations", params, res)
-
-	return res, resp, err
+	return Get[BetaAppLocalizationsResponse](ctx, s.client, "betaAppLocalizations", params)
 }
 
 // GetBetaAppLocalization gets localized beta app information for a specific app and locale.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_app_localization_information
 func (s *TestflightService) GetBetaAppLocalization(ctx context.Context, id string, params *GetBetaAppLocalizationQuery) (*BetaAppLocalizationResponse, *Response, error) {
-	url := fmt.Sprintf("betaAppLocalizations/%s", id)
-	res := new(BetaAppLocalizationResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaAppLocalizationResponse](ctx, s.client, fmt.Sprintf("betaAppLocalizations/%s", id), params)
 }
 
 // GetAppForBetaAppLocalization gets the app information associated with a specific beta app localization.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_beta_app_localization
 func (s *TestflightService) GetAppForBetaAppLocalization(ctx context.Context, id string, params *GetAppForBetaAppLocalizationQuery) (*AppResponse, *Response, error) {
-	url := fmt.Sprintf("betaAppLocalizations/%s/app", id)
-	res := new(AppResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppResponse](ctx, s.client, fmt.Sprintf("betaAppLocalizations/%s/app", id), params)
 }
 
 // ListBetaAppLocalizationsForApp gets a list of localized beta test information for a specific app.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_beta_app_localizations_of_an_app
 func (s *TestflightService) ListBetaAppLocalizationsForApp(ctx context.Context, id string, params *ListBetaAppLocalizationsForAppQuery) (*BetaAppLocalizationsResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/betaAppLocalizations", id)
-	res := new(BetaAppLocalizationsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaAppLocalizationsResponse](ctx, s.client, fmt.Sprintf("apps/%s/betaAppLocalizations", id), params)
 }
 
 // CreateBetaAppLocalization creates localized descriptive information for an app.
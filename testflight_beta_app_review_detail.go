@@ -40,7 +40,7 @@ type BetaAppReviewDetail struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/betaappreviewdetail/attributes
 type BetaAppReviewDetailAttributes struct {
-	ContactEmail        *string `json:"contactEmail,omitempty"`
+	ContactEmail        *Email  `json:"contactEmail,omitempty"`
 	ContactFirstName    *string `json:"contactFirstName,omitempty"`
 	ContactLastName     *string `json:"contactLastName,omitempty"`
 	ContactPhone        *string `json:"contactPhone,omitempty"`
@@ -70,7 +70,7 @@ type betaAppReviewDetailUpdateRequest struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/betaappreviewdetailupdaterequest/data/attributes
 type BetaAppReviewDetailUpdateRequestAttributes struct {
-	ContactEmail        *string `json:"contactEmail,omitempty"`
+	ContactEmail        *Email  `json:"contactEmail,omitempty"`
 	ContactFirstName    *string `json:"contactFirstName,omitempty"`
 	ContactLastName     *string `json:"contactLastName,omitempty"`
 	ContactPhone        *string `json:"contactPhone,omitempty"`
@@ -138,43 +138,28 @@ type GetBetaAppReviewDetailsForAppQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_app_review_details
 func (s *TestflightService) ListBetaAppReviewDetails(ctx context.Context, params *ListBetaAppReviewDetailsQuery) (*BetaAppReviewDetailsResponse, *Response, error) {
-	res := new(BetaAppReviewDetailsResponse)
-	resp, err := s.client.get(ctx, "betaAppReviewDetails", params, res)
-
-	return res, resp, err
+	return Get[BetaAppReviewDetailsResponse](ctx, s.client, "betaAppReviewDetails", params)
 }
 
 // GetBetaAppReviewDetail gets beta app review details for a specific app.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_app_review_detail_information
 func (s *TestflightService) GetBetaAppReviewDetail(ctx context.Context, id string, params *GetBetaAppReviewDetailQuery) (*BetaAppReviewDetailResponse, *Response, error) {
-	url := fmt.Sprintf("betaAppReviewDetails/%s", id)
-	res := new(BetaAppReviewDetailResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaAppReviewDetailResponse](ctx, s.client, fmt.Sprintf("betaAppReviewDetails/%s", id), params)
 }
 
 // GetAppForBetaAppReviewDetail gets the app information for a specific beta app review details resource.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_beta_app_review_detail
 func (s *TestflightService) GetAppForBetaAppReviewDetail(ctx context.Context, id string, params *GetAppForBetaAppReviewDetailQuery) (*AppResponse, *Response, error) {
-	url := fmt.Sprintf("betaAppReviewDetails/%s/app", id)
-	res := new(AppResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppResponse](ctx, s.client, fmt.Sprintf("betaAppReviewDetails/%s/app", id), params)
 }
 
 // GetBetaAppReviewDetailsForApp gets the beta app review details for a specific app.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_beta_app_review_details_resource_of_an_app
 func (s *TestflightService) GetBetaAppReviewDetailsForApp(ctx context.Context, id string, params *GetBetaAppReviewDetailsForAppQuery) (*BetaAppReviewDetailResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/betaAppReviewDetail", id)
-	res := new(BetaAppReviewDetailResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaAppReviewDetailResponse](ctx, s.client, fmt.Sprintf("apps/%s/betaAppReviewDetail", id), params)
 }
 
 // UpdateBetaAppReviewDetail updates the details for a specific app's beta app review.
@@ -41,6 +41,33 @@ const (
 	BetaReviewStateWaitingForReview BetaReviewState = "WAITING_FOR_REVIEW"
 )
 
+var betaReviewStates = []BetaReviewState{
+	BetaReviewStateApproved,
+	BetaReviewStateInReview,
+	BetaReviewStateRejected,
+	BetaReviewStateWaitingForReview,
+}
+
+// IsValid reports whether the beta review state is one this package knows about.
+func (v BetaReviewState) IsValid() bool {
+	for _, value := range betaReviewStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseBetaReviewState returns s as a BetaReviewState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseBetaReviewState(s string) (BetaReviewState, error) {
+	if v := BetaReviewState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "BetaReviewState", Value: s}
+}
+
 // BetaAppReviewSubmission defines model for BetaAppReviewSubmission.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/betaappreviewsubmission
@@ -161,41 +188,26 @@ func (s *TestflightService) CreateBetaAppReviewSubmission(ctx context.Context, b
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_app_review_submissions
 func (s *TestflightService) ListBetaAppReviewSubmissions(ctx context.Context, params *ListBetaAppReviewSubmissionsQuery) (*BetaAppReviewSubmissionsResponse, *Response, error) {
-	res := new(BetaAppReviewSubmissionsResponse)
-	resp, err := s.client.get(ctx, "betaAppReviewSubmissions", params, res)
-
-	return res, resp, err
+	return Get[BetaAppReviewSubmissionsResponse](ctx, s.client, "betaAppReviewSubmissions", params)
 }
 
 // GetBetaAppReviewSubmission gets a specific beta app review submission.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_app_review_submission_information
 func (s *TestflightService) GetBetaAppReviewSubmission(ctx context.Context, id string, params *GetBetaAppReviewSubmissionQuery) (*BetaAppReviewSubmissionResponse, *Response, error) {
-	url := fmt.Sprintf("betaAppReviewSubmissions/%s", id)
-	res := new(BetaAppReviewSubmissionResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaAppReviewSubmissionResponse](ctx, s.client, fmt.Sprintf("betaAppReviewSubmissions/%s", id), params)
 }
 
 // GetBuildForBetaAppReviewSubmission gets the build information for a specific beta app review submission.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_build_information_of_a_beta_app_review_submission
 func (s *TestflightService) GetBuildForBetaAppReviewSubmission(ctx context.Context, id string, params *GetBuildForBetaAppReviewSubmissionQuery) (*BuildResponse, *Response, error) {
-	url := fmt.Sprintf("betaAppReviewSubmissions/%s/build", id)
-	res := new(BuildResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildResponse](ctx, s.client, fmt.Sprintf("betaAppReviewSubmissions/%s/build", id), params)
 }
 
 // GetBetaAppReviewSubmissionForBuild gets the beta app review submission status for a specific build.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_beta_app_review_submission_of_a_build
 func (s *TestflightService) GetBetaAppReviewSubmissionForBuild(ctx context.Context, id string, params *GetBetaAppReviewSubmissionForBuildQuery) (*BetaAppReviewSubmissionResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s/betaAppReviewSubmission", id)
-	res := new(BetaAppReviewSubmissionResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaAppReviewSubmissionResponse](ctx, s.client, fmt.Sprintf("builds/%s/betaAppReviewSubmission", id), params)
 }
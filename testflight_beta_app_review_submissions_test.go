@@ -23,6 +23,8 @@ package asc
 import (
 	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCreateBetaAppReviewSubmission(t *testing.T) {
@@ -64,3 +66,14 @@ func TestGetBetaAppReviewSubmissionForBuild(t *testing.T) {
 		return client.TestFlight.GetBetaAppReviewSubmissionForBuild(ctx, "10", &GetBetaAppReviewSubmissionForBuildQuery{})
 	})
 }
+
+func TestParseBetaReviewState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseBetaReviewState(string(BetaReviewStateApproved))
+	assert.NoError(t, err)
+	assert.Equal(t, BetaReviewStateApproved, got)
+
+	_, err = ParseBetaReviewState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "BetaReviewState", Value: "UNKNOWN"}, err)
+}
@@ -150,43 +150,28 @@ type ListBetaBuildLocalizationsForBuildQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_build_localizations
 func (s *TestflightService) ListBetaBuildLocalizations(ctx context.Context, params *ListBetaBuildLocalizationsQuery) (*BetaBuildLocalizationsResponse, *Response, error) {
-	res := new(BetaBuildLocalizationsResponse)
-	resp, err := s.client.get(ctx, "betaBuildLocalizations", params, res)
-
-	return res, resp, err
+	return Get[BetaBuildLocalizationsResponse](ctx, s.client, "betaBuildLocalizations", params)
 }
 
 // GetBetaBuildLocalization gets localized beta build information for a specific build and locale.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_build_localization_information
 func (s *TestflightService) GetBetaBuildLocalization(ctx context.Context, id string, params *GetBetaBuildLocalizationQuery) (*BetaBuildLocalizationResponse, *Response, error) {
-	url := fmt.Sprintf("betaBuildLocalizations/%s", id)
-	res := new(BetaBuildLocalizationResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaBuildLocalizationResponse](ctx, s.client, fmt.Sprintf("betaBuildLocalizations/%s", id), params)
 }
 
 // GetBuildForBetaBuildLocalization gets the build information associated with a specific beta build localization.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_build_information_of_a_beta_build_localization
 func (s *TestflightService) GetBuildForBetaBuildLocalization(ctx context.Context, id string, params *GetBuildForBetaBuildLocalizationQuery) (*BuildResponse, *Response, error) {
-	url := fmt.Sprintf("betaBuildLocalizations/%s/build", id)
-	res := new(BuildResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildResponse](ctx, s.client, fmt.Sprintf("betaBuildLocalizations/%s/build", id), params)
 }
 
 // ListBetaBuildLocalizationsForBuild gets a list of localized beta test information for a specific build.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_beta_build_localizations_of_a_build
 func (s *TestflightService) ListBetaBuildLocalizationsForBuild(ctx context.Context, id string, params *ListBetaBuildLocalizationsForBuildQuery) (*BetaBuildLocalizationsResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s/betaBuildLocalizations", id)
-	res := new(BetaBuildLocalizationsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaBuildLocalizationsResponse](ctx, s.client, fmt.Sprintf("builds/%s/betaBuildLocalizations", id), params)
 }
 
 // CreateBetaBuildLocalization creates localized descriptive information for an build.
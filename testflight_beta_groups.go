@@ -122,6 +122,41 @@ type BetaGroupUpdateRequestAttributes struct {
 	PublicLinkLimitEnabled *bool   `json:"publicLinkLimitEnabled,omitempty"`
 }
 
+// WithFeedbackEnabled sets the feedback enabled to update and returns the attributes for chaining.
+func (a *BetaGroupUpdateRequestAttributes) WithFeedbackEnabled(v bool) *BetaGroupUpdateRequestAttributes {
+	a.FeedbackEnabled = &v
+
+	return a
+}
+
+// WithName sets the name to update and returns the attributes for chaining.
+func (a *BetaGroupUpdateRequestAttributes) WithName(v string) *BetaGroupUpdateRequestAttributes {
+	a.Name = &v
+
+	return a
+}
+
+// WithPublicLinkEnabled sets the public link enabled to update and returns the attributes for chaining.
+func (a *BetaGroupUpdateRequestAttributes) WithPublicLinkEnabled(v bool) *BetaGroupUpdateRequestAttributes {
+	a.PublicLinkEnabled = &v
+
+	return a
+}
+
+// WithPublicLinkLimit sets the public link limit to update and returns the attributes for chaining.
+func (a *BetaGroupUpdateRequestAttributes) WithPublicLinkLimit(v int) *BetaGroupUpdateRequestAttributes {
+	a.PublicLinkLimit = &v
+
+	return a
+}
+
+// WithPublicLinkLimitEnabled sets the public link limit enabled to update and returns the attributes for chaining.
+func (a *BetaGroupUpdateRequestAttributes) WithPublicLinkLimitEnabled(v bool) *BetaGroupUpdateRequestAttributes {
+	a.PublicLinkLimitEnabled = &v
+
+	return a
+}
+
 // BetaGroupBetaTestersLinkagesResponse defines model for BetaGroupBetaTestersLinkagesResponse.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/betagroupbetatesterslinkagesresponse
@@ -294,43 +329,28 @@ func (s *TestflightService) DeleteBetaGroup(ctx context.Context, id string) (*Re
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_groups
 func (s *TestflightService) ListBetaGroups(ctx context.Context, params *ListBetaGroupsQuery) (*BetaGroupsResponse, *Response, error) {
-	res := new(BetaGroupsResponse)
-	resp, err := s.client.get(ctx, "betaGroups", params, res)
-
-	return res, resp, err
+	return Get[BetaGroupsResponse](ctx, s.client, "betaGroups", params)
 }
 
 // GetBetaGroup gets a specific beta group.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_group_information
 func (s *TestflightService) GetBetaGroup(ctx context.Context, id string, params *GetBetaGroupQuery) (*BetaGroupResponse, *Response, error) {
-	url := fmt.Sprintf("betaGroups/%s", id)
-	res := new(BetaGroupResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaGroupResponse](ctx, s.client, fmt.Sprintf("betaGroups/%s", id), params)
 }
 
 // GetAppForBetaGroup gets the app information for a specific beta group.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_beta_group
 func (s *TestflightService) GetAppForBetaGroup(ctx context.Context, id string, params *GetAppForBetaGroupQuery) (*AppResponse, *Response, error) {
-	url := fmt.Sprintf("betaGroups/%s/app", id)
-	res := new(AppResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppResponse](ctx, s.client, fmt.Sprintf("betaGroups/%s/app", id), params)
 }
 
 // ListBetaGroupsForApp gets a list of beta groups associated with a specific app.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_beta_groups_for_an_app
 func (s *TestflightService) ListBetaGroupsForApp(ctx context.Context, id string, params *ListBetaGroupsForAppQuery) (*BetaGroupsResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/betaGroups", id)
-	res := new(BetaGroupsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaGroupsResponse](ctx, s.client, fmt.Sprintf("apps/%s/betaGroups", id), params)
 }
 
 // AddBetaTestersToBetaGroup adds a specific beta tester to one or more beta groups for beta testing.
@@ -377,44 +397,28 @@ func (s *TestflightService) RemoveBuildsFromBetaGroup(ctx context.Context, id st
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_builds_for_a_betagroup
 func (s *TestflightService) ListBuildsForBetaGroup(ctx context.Context, id string, params *ListBuildsForBetaGroupQuery) (*BuildsResponse, *Response, error) {
-	url := fmt.Sprintf("betaGroups/%s/builds", id)
-	res := new(BuildsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildsResponse](ctx, s.client, fmt.Sprintf("betaGroups/%s/builds", id), params)
 }
 
 // ListBuildIDsForBetaGroup gets a list of build resource IDs in a specific beta group.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_build_ids_in_a_beta_group
 func (s *TestflightService) ListBuildIDsForBetaGroup(ctx context.Context, id string, params *ListBuildIDsForBetaGroupQuery) (*BetaGroupBuildsLinkagesResponse, *Response, error) {
-	url := fmt.Sprintf("betaGroups/%s/relationships/builds", id)
-	res := new(BetaGroupBuildsLinkagesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaGroupBuildsLinkagesResponse](ctx, s.client, fmt.Sprintf("betaGroups/%s/relationships/builds", id), params)
 }
 
 // ListBetaTestersForBetaGroup gets a list of beta testers contained in a specific beta group.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_beta_testers_in_a_betagroup
 func (s *TestflightService) ListBetaTestersForBetaGroup(ctx context.Context, id string, params *ListBetaTestersForBetaGroupQuery) (*BetaTestersResponse, *Response, error) {
-	url := fmt.Sprintf("betaGroups/%s/betaTesters", id)
-	res := new(BetaTestersResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaTestersResponse](ctx, s.client, fmt.Sprintf("betaGroups/%s/betaTesters", id), params)
 }
 
 // ListBetaTesterIDsForBetaGroup gets a list of the beta tester resource IDs in a specific beta group.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_beta_tester_ids_in_a_beta_group
 func (s *TestflightService) ListBetaTesterIDsForBetaGroup(ctx context.Context, id string, params *ListBetaTesterIDsForBetaGroupQuery) (*BetaGroupBetaTestersLinkagesResponse, *Response, error) {
-	url := fmt.Sprintf("betaGroups/%s/relationships/betaTesters", id)
-	res := new(BetaGroupBetaTestersLinkagesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaGroupBetaTestersLinkagesResponse](ctx, s.client, fmt.Sprintf("betaGroups/%s/relationships/betaTesters", id), params)
 }
 
 // UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in BetaGroupResponseIncluded.
@@ -163,3 +163,22 @@ func TestListBetaTesterIDsForBetaGroup(t *testing.T) {
 		return client.TestFlight.ListBetaTesterIDsForBetaGroup(ctx, "10", &ListBetaTesterIDsForBetaGroupQuery{})
 	})
 }
+
+func TestBetaGroupUpdateRequestAttributesBuilder(t *testing.T) {
+	t.Parallel()
+
+	got := new(BetaGroupUpdateRequestAttributes).
+		WithFeedbackEnabled(true).
+		WithName("TEST").
+		WithPublicLinkEnabled(true).
+		WithPublicLinkLimit(10).
+		WithPublicLinkLimitEnabled(true)
+
+	assert.Equal(t, &BetaGroupUpdateRequestAttributes{
+		FeedbackEnabled:        Bool(true),
+		Name:                   String("TEST"),
+		PublicLinkEnabled:      Bool(true),
+		PublicLinkLimit:        Int(10),
+		PublicLinkLimitEnabled: Bool(true),
+	}, got)
+}
@@ -124,43 +124,28 @@ type GetBetaLicenseAgreementForAppQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_license_agreements
 func (s *TestflightService) ListBetaLicenseAgreements(ctx context.Context, params *ListBetaLicenseAgreementsQuery) (*BetaLicenseAgreementsResponse, *Response, error) {
-	res := new(BetaLicenseAgreementsResponse)
-	resp, err := s.client.get(ctx, "betaLicenseAgreements", params, res)
-
-	return res, resp, err
+	return Get[BetaLicenseAgreementsResponse](ctx, s.client, "betaLicenseAgreements", params)
 }
 
 // GetBetaLicenseAgreement gets a specific beta license agreement.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_license_agreement_information
 func (s *TestflightService) GetBetaLicenseAgreement(ctx context.Context, id string, params *GetBetaLicenseAgreementQuery) (*BetaLicenseAgreementResponse, *Response, error) {
-	url := fmt.Sprintf("betaLicenseAgreements/%s", id)
-	res := new(BetaLicenseAgreementResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaLicenseAgreementResponse](ctx, s.client, fmt.Sprintf("betaLicenseAgreements/%s", id), params)
 }
 
 // GetAppForBetaLicenseAgreement gets the app information for a specific beta license agreement.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_beta_license_agreement
 func (s *TestflightService) GetAppForBetaLicenseAgreement(ctx context.Context, id string, params *GetAppForBetaLicenseAgreementQuery) (*AppResponse, *Response, error) {
-	url := fmt.Sprintf("betaLicenseAgreements/%s/app", id)
-	res := new(AppResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppResponse](ctx, s.client, fmt.Sprintf("betaLicenseAgreements/%s/app", id), params)
 }
 
 // GetBetaLicenseAgreementForApp gets the beta license agreement for a specific app.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_beta_license_agreement_of_an_app
 func (s *TestflightService) GetBetaLicenseAgreementForApp(ctx context.Context, id string, params *GetBetaLicenseAgreementForAppQuery) (*BetaLicenseAgreementResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/betaLicenseAgreement", id)
-	res := new(BetaLicenseAgreementResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaLicenseAgreementResponse](ctx, s.client, fmt.Sprintf("apps/%s/betaLicenseAgreement", id), params)
 }
 
 // UpdateBetaLicenseAgreement updates the text for your beta license agreement.
@@ -37,6 +37,31 @@ const (
 	BetaInviteTypePublicLink BetaInviteType = "PUBLIC_LINK"
 )
 
+var betaInviteTypes = []BetaInviteType{
+	BetaInviteTypeEmail,
+	BetaInviteTypePublicLink,
+}
+
+// IsValid reports whether the beta invite type is one this package knows about.
+func (v BetaInviteType) IsValid() bool {
+	for _, value := range betaInviteTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseBetaInviteType returns s as a BetaInviteType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseBetaInviteType(s string) (BetaInviteType, error) {
+	if v := BetaInviteType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "BetaInviteType", Value: s}
+}
+
 // BetaTester defines model for BetaTester.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/betatester
@@ -286,21 +311,14 @@ func (s *TestflightService) DeleteBetaTester(ctx context.Context, id string) (*R
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_beta_testers
 func (s *TestflightService) ListBetaTesters(ctx context.Context, params *ListBetaTestersQuery) (*BetaTestersResponse, *Response, error) {
-	res := new(BetaTestersResponse)
-	resp, err := s.client.get(ctx, "betaTesters", params, res)
-
-	return res, resp, err
+	return Get[BetaTestersResponse](ctx, s.client, "betaTesters", params)
 }
 
 // GetBetaTester gets a specific beta tester.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_beta_tester_information
 func (s *TestflightService) GetBetaTester(ctx context.Context, id string, params *GetBetaTesterQuery) (*BetaTesterResponse, *Response, error) {
-	url := fmt.Sprintf("betaTesters/%s", id)
-	res := new(BetaTesterResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaTesterResponse](ctx, s.client, fmt.Sprintf("betaTesters/%s", id), params)
 }
 
 // AddBetaTesterToBetaGroups adds one or more beta testers to a specific beta group.
@@ -357,77 +375,49 @@ func (s *TestflightService) RemoveSingleBetaTesterAccessApps(ctx context.Context
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_apps_for_a_beta_tester
 func (s *TestflightService) ListAppsForBetaTester(ctx context.Context, id string, params *ListAppsForBetaTesterQuery) (*AppsResponse, *Response, error) {
-	url := fmt.Sprintf("betaTesters/%s/apps", id)
-	res := new(AppsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppsResponse](ctx, s.client, fmt.Sprintf("betaTesters/%s/apps", id), params)
 }
 
 // ListAppIDsForBetaTester gets a list of app resource IDs associated with a beta tester.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_app_resource_ids_for_a_beta_tester
 func (s *TestflightService) ListAppIDsForBetaTester(ctx context.Context, id string, params *ListAppIDsForBetaTesterQuery) (*BetaTesterAppsLinkagesResponse, *Response, error) {
-	url := fmt.Sprintf("betaTesters/%s/relationships/apps", id)
-	res := new(BetaTesterAppsLinkagesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaTesterAppsLinkagesResponse](ctx, s.client, fmt.Sprintf("betaTesters/%s/relationships/apps", id), params)
 }
 
 // ListBuildsIndividuallyAssignedToBetaTester gets a list of builds individually assigned to a specific beta tester.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_builds_individually_assigned_to_a_beta_tester
 func (s *TestflightService) ListBuildsIndividuallyAssignedToBetaTester(ctx context.Context, id string, params *ListBuildsIndividuallyAssignedToBetaTesterQuery) (*BuildsResponse, *Response, error) {
-	url := fmt.Sprintf("betaTesters/%s/builds", id)
-	res := new(BuildsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildsResponse](ctx, s.client, fmt.Sprintf("betaTesters/%s/builds", id), params)
 }
 
 // ListBuildIDsIndividuallyAssignedToBetaTester gets a list of build resource IDs individually assigned to a specific beta tester.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_ids_of_builds_individually_assigned_to_a_beta_tester
 func (s *TestflightService) ListBuildIDsIndividuallyAssignedToBetaTester(ctx context.Context, id string, params *ListBuildIDsIndividuallyAssignedToBetaTesterQuery) (*BetaTesterBuildsLinkagesResponse, *Response, error) {
-	url := fmt.Sprintf("betaTesters/%s/relationships/builds", id)
-	res := new(BetaTesterBuildsLinkagesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaTesterBuildsLinkagesResponse](ctx, s.client, fmt.Sprintf("betaTesters/%s/relationships/builds", id), params)
 }
 
 // ListIndividualTestersForBuild gets a list of beta testers individually assigned to a build.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_individual_testers_for_a_build
 func (s *TestflightService) ListIndividualTestersForBuild(ctx context.Context, id string, params *ListIndividualTestersForBuildQuery) (*BetaTestersResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s/individualTesters", id)
-	res := new(BetaTestersResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaTestersResponse](ctx, s.client, fmt.Sprintf("builds/%s/individualTesters", id), params)
 }
 
 // ListBetaGroupsForBetaTester gets a list of beta groups that contain a specific beta tester.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_beta_groups_to_which_a_beta_tester_belongs
 func (s *TestflightService) ListBetaGroupsForBetaTester(ctx context.Context, id string, params *ListBetaGroupsForBetaTesterQuery) (*BetaGroupsResponse, *Response, error) {
-	url := fmt.Sprintf("betaTesters/%s/betaGroups", id)
-	res := new(BetaGroupsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaGroupsResponse](ctx, s.client, fmt.Sprintf("betaTesters/%s/betaGroups", id), params)
 }
 
 // ListBetaGroupIDsForBetaTester gets a list of group resource IDs associated with a beta tester.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_beta_group_ids_of_a_beta_tester_s_groups
 func (s *TestflightService) ListBetaGroupIDsForBetaTester(ctx context.Context, id string, params *ListBetaGroupIDsForBetaTesterQuery) (*BetaTesterBetaGroupsLinkagesResponse, *Response, error) {
-	url := fmt.Sprintf("betaTesters/%s/relationships/betaGroups", id)
-	res := new(BetaTesterBetaGroupsLinkagesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BetaTesterBetaGroupsLinkagesResponse](ctx, s.client, fmt.Sprintf("betaTesters/%s/relationships/betaGroups", id), params)
 }
 
 // UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in BetaTesterResponseIncluded.
@@ -179,3 +179,14 @@ func TestListBetaGroupIDsForBetaTester(t *testing.T) {
 		return client.TestFlight.ListBetaGroupIDsForBetaTester(ctx, "10", &ListBetaGroupIDsForBetaTesterQuery{})
 	})
 }
+
+func TestParseBetaInviteType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseBetaInviteType(string(BetaInviteTypeEmail))
+	assert.NoError(t, err)
+	assert.Equal(t, BetaInviteTypeEmail, got)
+
+	_, err = ParseBetaInviteType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "BetaInviteType", Value: "UNKNOWN"}, err)
+}
@@ -57,6 +57,41 @@ const (
 	ExternalBetaStateWaitingForBetaReview ExternalBetaState = "WAITING_FOR_BETA_REVIEW"
 )
 
+var externalBetaStates = []ExternalBetaState{
+	ExternalBetaStateApproved,
+	ExternalBetaStateRejected,
+	ExternalBetaStateExpired,
+	ExternalBetaStateInReview,
+	ExternalBetaStateInTesting,
+	ExternalBetaStateInExportComplianceReview,
+	ExternalBetaStateMissingExportCompliance,
+	ExternalBetaStateProcessing,
+	ExternalBetaStateProcessingException,
+	ExternalBetaStateReadyForBetaSubmission,
+	ExternalBetaStateReadyForBetaTesting,
+	ExternalBetaStateWaitingForBetaReview,
+}
+
+// IsValid reports whether the external beta state is one this package knows about.
+func (v ExternalBetaState) IsValid() bool {
+	for _, value := range externalBetaStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseExternalBetaState returns s as an ExternalBetaState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseExternalBetaState(s string) (ExternalBetaState, error) {
+	if v := ExternalBetaState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "ExternalBetaState", Value: s}
+}
+
 // InternalBetaState defines model for InternalBetaState.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/internalbetastate
@@ -79,6 +114,36 @@ const (
 	InternalBetaStateReadyForBetaTesting InternalBetaState = "READY_FOR_BETA_TESTING"
 )
 
+var internalBetaStates = []InternalBetaState{
+	InternalBetaStateExpired,
+	InternalBetaStateInTesting,
+	InternalBetaStateInExportComplianceReview,
+	InternalBetaStateMissingExportCompliance,
+	InternalBetaStateProcessing,
+	InternalBetaStateProcessingException,
+	InternalBetaStateReadyForBetaTesting,
+}
+
+// IsValid reports whether the internal beta state is one this package knows about.
+func (v InternalBetaState) IsValid() bool {
+	for _, value := range internalBetaStates {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseInternalBetaState returns s as an InternalBetaState, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseInternalBetaState(s string) (InternalBetaState, error) {
+	if v := InternalBetaState(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "InternalBetaState", Value: s}
+}
+
 // BuildBetaDetail defines model for BuildBetaDetail.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/buildbetadetail
@@ -181,43 +246,28 @@ type GetBuildBetaDetailForBuildQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_build_beta_details
 func (s *TestflightService) ListBuildBetaDetails(ctx context.Context, params *ListBuildBetaDetailsQuery) (*BuildBetaDetailsResponse, *Response, error) {
-	res := new(BuildBetaDetailsResponse)
-	resp, err := s.client.get(ctx, "buildBetaDetails", params, res)
-
-	return res, resp, err
+	return Get[BuildBetaDetailsResponse](ctx, s.client, "buildBetaDetails", params)
 }
 
 // GetBuildBetaDetail gets a specific build beta details resource.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_build_beta_detail_information
 func (s *TestflightService) GetBuildBetaDetail(ctx context.Context, id string, params *GetBuildBetaDetailsQuery) (*BuildBetaDetailResponse, *Response, error) {
-	url := fmt.Sprintf("buildBetaDetails/%s", id)
-	res := new(BuildBetaDetailResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildBetaDetailResponse](ctx, s.client, fmt.Sprintf("buildBetaDetails/%s", id), params)
 }
 
 // GetBuildForBuildBetaDetail gets the build information for a specific build beta details resource.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_build_information_of_a_build_beta_detail
 func (s *TestflightService) GetBuildForBuildBetaDetail(ctx context.Context, id string, params *GetBuildForBuildBetaDetailQuery) (*BuildResponse, *Response, error) {
-	url := fmt.Sprintf("buildBetaDetails/%s/build", id)
-	res := new(BuildResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildResponse](ctx, s.client, fmt.Sprintf("buildBetaDetails/%s/build", id), params)
 }
 
 // GetBuildBetaDetailForBuild gets the beta test details for a specific build.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_build_beta_details_information_of_a_build
 func (s *TestflightService) GetBuildBetaDetailForBuild(ctx context.Context, id string, params *GetBuildBetaDetailForBuildQuery) (*BuildBetaDetailResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s/buildBetaDetail", id)
-	res := new(BuildBetaDetailResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildBetaDetailResponse](ctx, s.client, fmt.Sprintf("builds/%s/buildBetaDetail", id), params)
 }
 
 // UpdateBuildBetaDetail updates beta test details for a specific build.
@@ -23,6 +23,8 @@ package asc
 import (
 	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestListBuildBetaDetails(t *testing.T) {
@@ -64,3 +66,25 @@ func TestUpdateBuildBetaDetail(t *testing.T) {
 		return client.TestFlight.UpdateBuildBetaDetail(ctx, "10", Bool(false))
 	})
 }
+
+func TestParseExternalBetaState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseExternalBetaState(string(ExternalBetaStateApproved))
+	assert.NoError(t, err)
+	assert.Equal(t, ExternalBetaStateApproved, got)
+
+	_, err = ParseExternalBetaState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "ExternalBetaState", Value: "UNKNOWN"}, err)
+}
+
+func TestParseInternalBetaState(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseInternalBetaState(string(InternalBetaStateExpired))
+	assert.NoError(t, err)
+	assert.Equal(t, InternalBetaStateExpired, got)
+
+	_, err = ParseInternalBetaState("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "InternalBetaState", Value: "UNKNOWN"}, err)
+}
@@ -142,65 +142,42 @@ type GetPrereleaseVersionForBuildQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_prerelease_versions
 func (s *TestflightService) ListPrereleaseVersions(ctx context.Context, params *ListPrereleaseVersionsQuery) (*PrereleaseVersionsResponse, *Response, error) {
-	res := new(PrereleaseVersionsResponse)
-	resp, err := s.client.get(ctx, "preReleaseVersions", params, res)
-
-	return res, resp, err
+	return Get[PrereleaseVersionsResponse](ctx, s.client, "preReleaseVersions", params)
 }
 
 // GetPrereleaseVersion gets information about a specific prerelease version.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_prerelease_version_information
 func (s *TestflightService) GetPrereleaseVersion(ctx context.Context, id string, params *GetPrereleaseVersionQuery) (*PrereleaseVersionResponse, *Response, error) {
-	url := fmt.Sprintf("preReleaseVersions/%s", id)
-	res := new(PrereleaseVersionResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[PrereleaseVersionResponse](ctx, s.client, fmt.Sprintf("preReleaseVersions/%s", id), params)
 }
 
 // GetAppForPrereleaseVersion gets the app information for a specific prerelease version.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_a_prerelease_version
 func (s *TestflightService) GetAppForPrereleaseVersion(ctx context.Context, id string, params *GetAppForPrereleaseVersionQuery) (*AppResponse, *Response, error) {
-	url := fmt.Sprintf("preReleaseVersions/%s/app", id)
-	res := new(AppResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppResponse](ctx, s.client, fmt.Sprintf("preReleaseVersions/%s/app", id), params)
 }
 
 // ListPrereleaseVersionsForApp gets a list of prerelease versions associated with a specific app.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_prerelease_versions_for_an_app
 func (s *TestflightService) ListPrereleaseVersionsForApp(ctx context.Context, id string, params *ListPrereleaseVersionsForAppQuery) (*PrereleaseVersionsResponse, *Response, error) {
-	url := fmt.Sprintf("apps/%s/preReleaseVersions", id)
-	res := new(PrereleaseVersionsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[PrereleaseVersionsResponse](ctx, s.client, fmt.Sprintf("apps/%s/preReleaseVersions", id), params)
 }
 
 // ListBuildsForPrereleaseVersion gets a list of builds of a specific prerelease version.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_builds_of_a_prerelease_version
 func (s *TestflightService) ListBuildsForPrereleaseVersion(ctx context.Context, id string, params *ListBuildsForPrereleaseVersionQuery) (*BuildsResponse, *Response, error) {
-	url := fmt.Sprintf("preReleaseVersions/%s/builds", id)
-	res := new(BuildsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[BuildsResponse](ctx, s.client, fmt.Sprintf("preReleaseVersions/%s/builds", id), params)
 }
 
 // GetPrereleaseVersionForBuild gets the prerelease version for a specific build.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_the_prerelease_version_of_a_build
 func (s *TestflightService) GetPrereleaseVersionForBuild(ctx context.Context, id string, params *GetPrereleaseVersionForBuildQuery) (*PrereleaseVersionResponse, *Response, error) {
-	url := fmt.Sprintf("builds/%s/preReleaseVersion", id)
-	res := new(PrereleaseVersionResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[PrereleaseVersionResponse](ctx, s.client, fmt.Sprintf("builds/%s/preReleaseVersion", id), params)
 }
 
 // UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in PrereleaseVersionResponseIncluded.
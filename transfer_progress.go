@@ -0,0 +1,124 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"io"
+	"sync"
+)
+
+// TransferProgress describes how much of an asset upload or a report or artifact download has been transferred.
+type TransferProgress struct {
+	// Bytes is the number of bytes transferred so far.
+	Bytes int64
+	// Total is the number of bytes to transfer, or -1 if it isn't known, as for downloads sent without a
+	// Content-Length.
+	Total int64
+	// Chunk is the index, in the upload operations, of the part that was just sent. It is zero for downloads.
+	Chunk int
+	// Chunks is the number of parts an upload is split into, or zero for downloads.
+	Chunks int
+}
+
+type progressKey struct{}
+
+// WithProgress returns a copy of ctx that makes uploads and downloads performed with it call fn as bytes are
+// transferred. That covers Client.Upload, UploadExecutor and the helpers built on them, like UploadAppPreview,
+// as well as downloads of reports, artifacts and other files, like DownloadSalesAndTrendsReports. fn may be
+// called concurrently while the parts of an upload are sent in parallel, so it shouldn't block.
+func WithProgress(ctx context.Context, fn func(progress TransferProgress)) context.Context {
+	return context.WithValue(ctx, progressKey{}, fn)
+}
+
+// progressTracker accumulates the bytes of a transfer, which may be split into parts sent concurrently, and
+// reports them to the function set with WithProgress. A nil tracker reports nothing.
+type progressTracker struct {
+	mu     sync.Mutex
+	fn     func(progress TransferProgress)
+	bytes  int64
+	total  int64
+	chunks int
+}
+
+// newProgressTracker returns a tracker for a transfer of total bytes in the given number of chunks, or nil if
+// ctx has no progress function.
+func newProgressTracker(ctx context.Context, total int64, chunks int) *progressTracker {
+	fn, _ := ctx.Value(progressKey{}).(func(progress TransferProgress))
+	if fn == nil {
+		return nil
+	}
+
+	return &progressTracker{fn: fn, total: total, chunks: chunks}
+}
+
+// newUploadProgressTracker returns a tracker for uploading the parts described by ops.
+func newUploadProgressTracker(ctx context.Context, ops []UploadOperation) *progressTracker {
+	var total int64
+
+	for _, op := range ops {
+		if op.Length != nil {
+			total += int64(*op.Length)
+		}
+	}
+
+	return newProgressTracker(ctx, total, len(ops))
+}
+
+func (t *progressTracker) add(n int64, chunk int) {
+	if t == nil || n == 0 {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.bytes += n
+	t.fn(TransferProgress{Bytes: t.bytes, Total: t.total, Chunk: chunk, Chunks: t.chunks})
+}
+
+// reader returns r wrapped to report the bytes read from it as part of chunk.
+func (t *progressTracker) reader(r io.Reader, chunk int) *progressReader {
+	return &progressReader{Reader: r, tracker: t, chunk: chunk}
+}
+
+// progressReader reports the bytes read through it to its tracker.
+type progressReader struct {
+	io.Reader
+
+	tracker *progressTracker
+	chunk   int
+	read    int64
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.read += int64(n)
+	r.tracker.add(int64(n), r.chunk)
+
+	return n, err
+}
+
+// rewind takes back the bytes reported so far, such as before a failed part is sent again.
+func (r *progressReader) rewind() {
+	r.tracker.add(-r.read, r.chunk)
+	r.read = 0
+}
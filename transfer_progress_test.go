@@ -0,0 +1,135 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type progressRecorder struct {
+	mu      sync.Mutex
+	reports []TransferProgress
+}
+
+func (r *progressRecorder) record(progress TransferProgress) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reports = append(r.reports, progress)
+}
+
+func (r *progressRecorder) last() TransferProgress {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.reports[len(r.reports)-1]
+}
+
+func TestUploadProgress(t *testing.T) {
+	t.Parallel()
+
+	server := newPartServer(nil)
+	defer server.Close()
+
+	var recorder progressRecorder
+
+	ctx := WithProgress(context.Background(), recorder.record)
+	contents := bytes.Repeat([]byte("0123456789"), 10)
+	ops := server.operations(len(contents), 30)
+
+	err := NewClient(server.Client()).Upload(ctx, ops, bytes.NewReader(contents))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), recorder.last().Bytes)
+
+	chunks := map[int]bool{}
+
+	for _, progress := range recorder.reports {
+		assert.Equal(t, int64(100), progress.Total)
+		assert.Equal(t, 4, progress.Chunks)
+
+		chunks[progress.Chunk] = true
+	}
+
+	assert.Len(t, chunks, 4)
+}
+
+func TestUploadExecutorProgress(t *testing.T) {
+	t.Parallel()
+
+	server := newPartServer(func(attempt int) int {
+		if attempt == 1 {
+			return http.StatusInternalServerError
+		}
+
+		return 0
+	})
+	defer server.Close()
+
+	var recorder progressRecorder
+
+	ctx := WithProgress(context.Background(), recorder.record)
+	contents := bytes.Repeat([]byte("0123456789"), 4)
+	executor := newTestUploadExecutor(NewClient(server.Client()))
+	executor.Concurrency = 1
+	executor.Progress = &UploadProgress{Completed: []int{0}}
+
+	err := executor.Upload(ctx, server.operations(len(contents), 10), bytes.NewReader(contents))
+	assert.NoError(t, err)
+	assert.Equal(t, TransferProgress{Bytes: 10, Total: 40, Chunk: 0, Chunks: 4}, recorder.reports[0])
+	assert.Equal(t, int64(40), recorder.last().Bytes)
+	assert.Equal(t, 3, recorder.last().Chunk)
+}
+
+func TestDownloadProgress(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer("report contents", http.StatusOK, false)
+	defer server.Close()
+
+	var recorder progressRecorder
+
+	ctx := WithProgress(context.Background(), recorder.record)
+	buffer := new(bytes.Buffer)
+
+	_, err := client.get(ctx, "salesReports", nil, buffer)
+	assert.NoError(t, err)
+	assert.Equal(t, TransferProgress{Bytes: int64(buffer.Len()), Total: int64(buffer.Len())}, recorder.last())
+}
+
+func TestProgressTrackerNotSet(t *testing.T) {
+	t.Parallel()
+
+	tracker := newProgressTracker(context.Background(), 10, 1)
+	assert.Nil(t, tracker)
+
+	reader := tracker.reader(bytes.NewReader([]byte("data")), 0)
+	buffer := new(bytes.Buffer)
+	_, err := buffer.ReadFrom(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "data", buffer.String())
+	reader.rewind()
+}
@@ -0,0 +1,283 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/cenkalti/backoff/v4"
+)
+
+const (
+	defaultUploadConcurrency = 4
+	defaultUploadMaxRetries  = 3
+)
+
+// UploadProgress records which upload operations of an asset reservation have completed, so that an
+// interrupted upload can be resumed by an UploadExecutor without sending those parts again.
+type UploadProgress struct {
+	// ID is the ID of the reserved asset, such as an app preview, that the operations belong to.
+	ID string `json:"id,omitempty"`
+	// Completed are the offsets of the operations that have been uploaded.
+	Completed []int `json:"completed"`
+}
+
+// ReadUploadProgress reads progress previously written by WriteUploadProgress. If there is no file at path, as
+// before an upload is first attempted, a nil progress is returned.
+func ReadUploadProgress(path string) (*UploadProgress, error) {
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	progress := new(UploadProgress)
+	if err := json.Unmarshal(b, progress); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return progress, nil
+}
+
+// WriteUploadProgress writes progress to a JSON file at path.
+func WriteUploadProgress(path string, progress *UploadProgress) error {
+	b, err := json.MarshalIndent(progress, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(b, '\n'), 0o644)
+}
+
+func (p *UploadProgress) contains(op UploadOperation) bool {
+	if p == nil || op.Offset == nil {
+		return false
+	}
+
+	for _, offset := range p.Completed {
+		if offset == *op.Offset {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p *UploadProgress) add(op UploadOperation) {
+	p.Completed = append(p.Completed, *op.Offset)
+	sort.Ints(p.Completed)
+}
+
+// UploadExecutor uploads the parts of an asset in parallel with bounded concurrency, retrying parts that fail.
+// Unlike Client.Upload, which starts every part at once, it holds only as many parts in memory as it uploads
+// at a time, which suits large assets like app previews that are split into dozens of operations.
+type UploadExecutor struct {
+	// Concurrency is the number of parts uploaded at once. It defaults to 4.
+	Concurrency int
+	// MaxRetries is the number of times a failed part is retried before the upload fails. It defaults to 3.
+	MaxRetries int
+	// Progress, if not nil, lists the parts already uploaded, which are skipped, and is updated as parts
+	// complete. Pass the progress of an interrupted upload, along with the upload operations of the same
+	// reservation, to resume it.
+	Progress *UploadProgress
+	// Checkpoint, if not nil, is called with Progress each time a part completes, such as to persist it with
+	// WriteUploadProgress. An error from Checkpoint stops the upload.
+	Checkpoint func(progress *UploadProgress) error
+
+	client  *Client
+	backOff func() backoff.BackOff
+}
+
+// NewUploadExecutor returns an UploadExecutor with the default concurrency and retries.
+func (c *Client) NewUploadExecutor() *UploadExecutor {
+	return &UploadExecutor{
+		Concurrency: defaultUploadConcurrency,
+		MaxRetries:  defaultUploadMaxRetries,
+		client:      c,
+		backOff: func() backoff.BackOff {
+			return backoff.NewExponentialBackOff()
+		},
+	}
+}
+
+// Upload uploads the parts of file described by ops that aren't already in Progress. If a part still fails
+// after its retries, the parts being uploaded are canceled and an UploadOperationError is returned for it.
+// An operation without an offset or length fails the upload with ErrMissingChunkBounds before any part is sent.
+func (e *UploadExecutor) Upload(ctx context.Context, ops []UploadOperation, file io.ReadSeeker) error {
+	for _, op := range ops {
+		if op.Offset == nil || op.Length == nil {
+			return UploadOperationError{Operation: op, Err: ErrMissingChunkBounds}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex // guards file, Progress and firstErr
+		firstErr error
+	)
+
+	fail := func(err error) {
+		if firstErr == nil {
+			firstErr = err
+
+			cancel()
+		}
+	}
+
+	concurrency := e.Concurrency
+	if concurrency < 1 {
+		concurrency = defaultUploadConcurrency
+	}
+
+	// Parts already uploaded are picked out before any goroutine can update Progress, and count as
+	// transferred.
+	tracker := newUploadProgressTracker(ctx, ops)
+	pending := make([]int, 0, len(ops))
+
+	for i, op := range ops {
+		if e.Progress.contains(op) {
+			tracker.add(int64(*op.Length), i)
+		} else {
+			pending = append(pending, i)
+		}
+	}
+
+	slots := make(chan struct{}, concurrency)
+
+	for _, i := range pending {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		if ctx.Err() != nil {
+			break
+		}
+
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			op := ops[i]
+			err := e.uploadChunk(ctx, op, i, file, &mu, tracker)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if err != nil {
+				fail(UploadOperationError{Operation: op, Err: err})
+
+				return
+			}
+
+			if e.Progress == nil {
+				return
+			}
+
+			e.Progress.add(op)
+
+			if e.Checkpoint != nil {
+				if err := e.Checkpoint(e.Progress); err != nil {
+					fail(err)
+				}
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	return ctx.Err()
+}
+
+// uploadChunk reads and uploads one part, retrying transient failures. The file is shared between parts, so
+// it is only read while holding mu.
+func (e *UploadExecutor) uploadChunk(ctx context.Context, op UploadOperation, index int, file io.ReadSeeker, mu *sync.Mutex, tracker *progressTracker) error {
+	send := func() error {
+		mu.Lock()
+		chunk, err := op.chunk(file)
+		mu.Unlock()
+
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		body := tracker.reader(chunk, index)
+
+		req, err := op.request(ctx, body)
+		if err != nil {
+			return backoff.Permanent(err)
+		}
+
+		_, err = e.client.do(ctx, req, nil)
+		if err != nil {
+			body.rewind()
+		}
+
+		if err != nil && (ctx.Err() != nil || !isRetryableUploadError(err)) {
+			return backoff.Permanent(err)
+		}
+
+		return err
+	}
+
+	retries := e.MaxRetries
+	if retries < 0 {
+		retries = 0
+	}
+
+	policy := backoff.WithContext(backoff.WithMaxRetries(e.backOff(), uint64(retries)), ctx)
+
+	return backoff.Retry(send, policy)
+}
+
+// isRetryableUploadError reports whether a part that failed with err may succeed if sent again. Client errors
+// other than timeouts and rate limiting won't.
+func isRetryableUploadError(err error) bool {
+	var errResponse *ErrorResponse
+	if !errors.As(err, &errResponse) || errResponse.Response == nil {
+		return true
+	}
+
+	switch code := errResponse.Response.StatusCode; {
+	case code == http.StatusRequestTimeout, code == http.StatusTooManyRequests:
+		return true
+	default:
+		return code >= http.StatusInternalServerError
+	}
+}
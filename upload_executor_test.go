@@ -0,0 +1,285 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+type partServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	parts    map[string][]byte
+	attempts int
+	active   int
+	peak     int
+	// fail returns the status to respond with for the given attempt, or zero to accept the part.
+	fail func(attempt int) int
+}
+
+func newPartServer(fail func(attempt int) int) *partServer {
+	s := &partServer{parts: map[string][]byte{}, fail: fail}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		s.mu.Lock()
+		s.attempts++
+		attempt := s.attempts
+		s.active++
+		if s.active > s.peak {
+			s.peak = s.active
+		}
+		s.mu.Unlock()
+
+		defer func() {
+			s.mu.Lock()
+			s.active--
+			s.mu.Unlock()
+		}()
+
+		if s.fail != nil {
+			if status := s.fail(attempt); status != 0 {
+				w.WriteHeader(status)
+				fmt.Fprintln(w, `{"errors":[]}`)
+
+				return
+			}
+		}
+
+		s.mu.Lock()
+		s.parts[r.URL.Query().Get("offset")] = body
+		s.mu.Unlock()
+	}))
+
+	return s
+}
+
+func (s *partServer) operations(size, length int) []UploadOperation {
+	ops := make([]UploadOperation, 0)
+
+	for offset := 0; offset < size; offset += length {
+		n := length
+		if offset+n > size {
+			n = size - offset
+		}
+
+		ops = append(ops, UploadOperation{
+			URL:    String(fmt.Sprintf("%s/part?offset=%d", s.URL, offset)),
+			Offset: Int(offset),
+			Length: Int(n),
+			Method: String("PUT"),
+		})
+	}
+
+	return ops
+}
+
+func newTestUploadExecutor(client *Client) *UploadExecutor {
+	executor := client.NewUploadExecutor()
+	executor.backOff = func() backoff.BackOff {
+		return &backoff.ZeroBackOff{}
+	}
+
+	return executor
+}
+
+func TestUploadExecutor(t *testing.T) {
+	t.Parallel()
+
+	server := newPartServer(nil)
+	defer server.Close()
+
+	contents := bytes.Repeat([]byte("0123456789"), 10)
+	executor := newTestUploadExecutor(NewClient(server.Client()))
+	executor.Concurrency = 2
+
+	err := executor.Upload(context.Background(), server.operations(len(contents), 7), bytes.NewReader(contents))
+	assert.NoError(t, err)
+	assert.Len(t, server.parts, 15)
+	assert.Equal(t, contents[14:21], server.parts["14"])
+	assert.LessOrEqual(t, server.peak, 2)
+}
+
+func TestUploadExecutorRetries(t *testing.T) {
+	t.Parallel()
+
+	server := newPartServer(func(attempt int) int {
+		if attempt <= 2 {
+			return http.StatusServiceUnavailable
+		}
+
+		return 0
+	})
+	defer server.Close()
+
+	contents := []byte("preview")
+	executor := newTestUploadExecutor(NewClient(server.Client()))
+
+	err := executor.Upload(context.Background(), server.operations(len(contents), len(contents)), bytes.NewReader(contents))
+	assert.NoError(t, err)
+	assert.Equal(t, 3, server.attempts)
+	assert.Equal(t, contents, server.parts["0"])
+}
+
+func TestUploadExecutorGivesUp(t *testing.T) {
+	t.Parallel()
+
+	server := newPartServer(func(attempt int) int {
+		return http.StatusForbidden
+	})
+	defer server.Close()
+
+	contents := []byte("preview")
+	executor := newTestUploadExecutor(NewClient(server.Client()))
+
+	err := executor.Upload(context.Background(), server.operations(len(contents), len(contents)), bytes.NewReader(contents))
+
+	var opErr UploadOperationError
+
+	assert.True(t, errors.As(err, &opErr))
+	assert.Equal(t, 0, *opErr.Operation.Offset)
+	assert.Equal(t, 1, server.attempts)
+
+	server.fail = func(attempt int) int {
+		return http.StatusInternalServerError
+	}
+	server.attempts = 0
+	executor.MaxRetries = 1
+
+	err = executor.Upload(context.Background(), server.operations(len(contents), len(contents)), bytes.NewReader(contents))
+	assert.Error(t, err)
+	assert.Equal(t, 2, server.attempts)
+}
+
+func TestUploadExecutorResume(t *testing.T) {
+	t.Parallel()
+
+	server := newPartServer(nil)
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "progress.json")
+
+	progress, err := ReadUploadProgress(path)
+	assert.NoError(t, err)
+	assert.Nil(t, progress)
+
+	assert.NoError(t, WriteUploadProgress(path, &UploadProgress{ID: "10", Completed: []int{0, 10}}))
+
+	progress, err = ReadUploadProgress(path)
+	assert.NoError(t, err)
+
+	contents := bytes.Repeat([]byte("0123456789"), 4)
+	executor := newTestUploadExecutor(NewClient(server.Client()))
+	executor.Concurrency = 1
+	executor.Progress = progress
+	executor.Checkpoint = func(progress *UploadProgress) error {
+		return WriteUploadProgress(path, progress)
+	}
+
+	err = executor.Upload(context.Background(), server.operations(len(contents), 10), bytes.NewReader(contents))
+	assert.NoError(t, err)
+	assert.Len(t, server.parts, 2)
+	assert.Contains(t, server.parts, "20")
+	assert.Contains(t, server.parts, "30")
+
+	saved, err := ReadUploadProgress(path)
+	assert.NoError(t, err)
+	assert.Equal(t, &UploadProgress{ID: "10", Completed: []int{0, 10, 20, 30}}, saved)
+}
+
+func TestUploadExecutorCheckpointError(t *testing.T) {
+	t.Parallel()
+
+	server := newPartServer(nil)
+	defer server.Close()
+
+	errCheckpoint := errors.New("disk full")
+	contents := bytes.Repeat([]byte("0123456789"), 4)
+	executor := newTestUploadExecutor(NewClient(server.Client()))
+	executor.Concurrency = 1
+	executor.Progress = &UploadProgress{}
+	executor.Checkpoint = func(progress *UploadProgress) error {
+		return errCheckpoint
+	}
+
+	err := executor.Upload(context.Background(), server.operations(len(contents), 10), bytes.NewReader(contents))
+	assert.Equal(t, errCheckpoint, err)
+	assert.Len(t, server.parts, 1)
+}
+
+func TestUploadExecutorInvalidOperation(t *testing.T) {
+	t.Parallel()
+
+	executor := newTestUploadExecutor(NewClient(nil))
+
+	err := executor.Upload(context.Background(), []UploadOperation{{Offset: Int(0), Length: Int(1)}}, bytes.NewReader([]byte("a")))
+	assert.ErrorIs(t, err, ErrMissingUploadDestination)
+}
+
+func TestUploadExecutorMissingChunkBounds(t *testing.T) {
+	t.Parallel()
+
+	server := newPartServer(nil)
+	defer server.Close()
+
+	contents := bytes.Repeat([]byte("0123456789"), 2)
+	ops := server.operations(len(contents), 10)
+	ops[1].Length = nil
+
+	executor := newTestUploadExecutor(NewClient(server.Client()))
+	executor.Progress = &UploadProgress{Completed: []int{10}}
+
+	err := executor.Upload(context.Background(), ops, bytes.NewReader(contents))
+
+	var opErr UploadOperationError
+
+	assert.True(t, errors.As(err, &opErr))
+	assert.ErrorIs(t, err, ErrMissingChunkBounds)
+	assert.Equal(t, 10, *opErr.Operation.Offset)
+	assert.Empty(t, server.parts)
+}
+
+func TestReadUploadProgressInvalid(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "progress.json")
+	assert.NoError(t, WriteUploadProgress(path, nil))
+
+	progress, err := ReadUploadProgress(path)
+	assert.NoError(t, err)
+	assert.Equal(t, &UploadProgress{}, progress)
+
+	_, err = ReadUploadProgress(t.TempDir())
+	assert.Error(t, err)
+}
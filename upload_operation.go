@@ -23,9 +23,14 @@ package asc
 import (
 	"bytes"
 	"context"
+	"crypto/md5" // nolint: gosec
+	"encoding/hex"
 	"errors"
+	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"strings"
 	"sync"
 )
 
@@ -67,20 +72,24 @@ func (e UploadOperationError) Error() string {
 	return e.Err.Error()
 }
 
+func (e UploadOperationError) Unwrap() error {
+	return e.Err
+}
+
 // chunk returns the bytes in the file from the given offset and with the given length.
-func (op *UploadOperation) chunk(f io.ReadSeeker) (io.Reader, error) {
+func (op *UploadOperation) chunk(f io.ReadSeeker) (*bytes.Buffer, error) {
 	if op.Offset == nil || op.Length == nil {
 		return nil, ErrMissingChunkBounds
 	}
 
-	_, err := f.Seek(int64(*op.Offset), 0)
+	_, err := f.Seek(int64(*op.Offset), io.SeekStart)
 	if err != nil {
 		return nil, err
 	}
 
 	data := make([]byte, *op.Length)
 
-	_, err = f.Read(data)
+	_, err = io.ReadFull(f, data)
 	if err != nil {
 		return nil, err
 	}
@@ -99,6 +108,11 @@ func (op *UploadOperation) request(ctx context.Context, data io.Reader) (*http.R
 		return nil, err
 	}
 
+	// The length can't be inferred when data reports progress, and the part must not be sent chunked.
+	if op.Length != nil {
+		req.ContentLength = int64(*op.Length)
+	}
+
 	if op.RequestHeaders != nil {
 		for _, h := range op.RequestHeaders {
 			if h.Name == nil || h.Value == nil {
@@ -114,11 +128,63 @@ func (op *UploadOperation) request(ctx context.Context, data io.Reader) (*http.R
 
 // Upload takes a file path and concurrently uploads each part of the file to App Store Connect.
 func (c *Client) Upload(ctx context.Context, ops []UploadOperation, file io.ReadSeeker) error {
-	var wg sync.WaitGroup
+	_, err := c.upload(ctx, ops, file, nil)
+
+	return err
+}
+
+// UploadWithChecksum uploads each part of the file like Upload, and returns the hex-encoded MD5 checksum of the
+// file that App Store Connect expects when the asset is committed. The checksum is computed from the parts as
+// they are read for upload, so the file is only read again if the operations don't cover all of it.
+func (c *Client) UploadWithChecksum(ctx context.Context, ops []UploadOperation, file io.ReadSeeker) (string, error) {
+	hash := md5.New() // nolint: gosec
 
-	errs := make(chan UploadOperationError)
+	covered, err := c.upload(ctx, ops, file, hash)
+	if err != nil {
+		return "", err
+	}
+
+	size, err := sizeOfFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	if covered != size {
+		_, checksum, err := fileChecksum(file)
+
+		return checksum, err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// upload reads the parts of the file in order of their offset and uploads them concurrently. If hash is not
+// nil, the parts are written to it for as long as they are contiguous from the start of the file, and the
+// number of bytes written is returned.
+func (c *Client) upload(ctx context.Context, ops []UploadOperation, file io.ReadSeeker, hash io.Writer) (int64, error) {
+	var (
+		wg     sync.WaitGroup
+		hashed int64
+	)
+
+	// Parts are read in order of their offset, but reported by their index in ops.
+	order := make([]int, len(ops))
+	for i := range order {
+		order[i] = i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := ops[order[i]].Offset, ops[order[j]].Offset
+
+		return a != nil && (b == nil || *a < *b)
+	})
+
+	tracker := newUploadProgressTracker(ctx, ops)
+	errs := make(chan UploadOperationError, len(ops))
+
+	for _, i := range order {
+		operation := ops[i]
 
-	for i, operation := range ops {
 		chunk, err := operation.chunk(file)
 		if err != nil {
 			errs <- UploadOperationError{
@@ -129,21 +195,24 @@ func (c *Client) Upload(ctx context.Context, ops []UploadOperation, file io.Read
 			continue
 		}
 
+		if hash != nil && int64(*operation.Offset) == hashed {
+			n, _ := hash.Write(chunk.Bytes())
+			hashed += int64(n)
+		}
+
 		wg.Add(1)
 
-		go c.uploadChunk(ctx, ops[i], chunk, errs, &wg)
+		go c.uploadChunk(ctx, operation, tracker.reader(chunk, i), errs, &wg)
 	}
 
-	go func() {
-		wg.Wait()
-		close(errs)
-	}()
+	wg.Wait()
+	close(errs)
 
 	for err := range errs {
-		return err
+		return hashed, err
 	}
 
-	return nil
+	return hashed, nil
 }
 
 func (c *Client) uploadChunk(ctx context.Context, op UploadOperation, chunk io.Reader, errs chan<- UploadOperationError, wg *sync.WaitGroup) {
@@ -167,3 +236,64 @@ func (c *Client) uploadChunk(ctx context.Context, op UploadOperation, chunk io.R
 		}
 	}
 }
+
+// sizeOfFile returns the size of the file by seeking to its end.
+func sizeOfFile(file io.Seeker) (int64, error) {
+	return file.Seek(0, io.SeekEnd)
+}
+
+// fileChecksum returns the size and hex-encoded MD5 checksum of the file, as expected by App Store Connect
+// when committing an uploaded asset. The file is rewound before reading.
+func fileChecksum(file io.ReadSeeker) (int64, string, error) {
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return 0, "", err
+	}
+
+	hash := md5.New() // nolint: gosec
+
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return 0, "", err
+	}
+
+	return size, hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// ErrAssetChecksumMismatch happens when App Store Connect reports that a committed asset doesn't match the
+// checksum computed while uploading it. The asset should be deleted and uploaded again.
+type ErrAssetChecksumMismatch struct {
+	Expected string
+	Reported string
+}
+
+func (e ErrAssetChecksumMismatch) Error() string {
+	return fmt.Sprintf("asset checksum mismatch: uploaded %s, App Store Connect reported %s", e.Expected, e.Reported)
+}
+
+// VerifyAssetChecksum compares the checksum computed while uploading an asset with the checksum and delivery
+// state App Store Connect reports for it once committed. It returns ErrAssetChecksumMismatch if the reported
+// checksum differs, or if the delivery state has an error about the checksum.
+func VerifyAssetChecksum(checksum string, reported *string, state *AppMediaAssetState) error {
+	if reported != nil && *reported != "" && !strings.EqualFold(*reported, checksum) {
+		return ErrAssetChecksumMismatch{Expected: checksum, Reported: *reported}
+	}
+
+	if state == nil {
+		return nil
+	}
+
+	for _, stateErr := range state.Errors {
+		if stateErr.Code == nil || !strings.Contains(strings.ToUpper(*stateErr.Code), "CHECKSUM") {
+			continue
+		}
+
+		mismatch := ErrAssetChecksumMismatch{Expected: checksum, Reported: *stateErr.Code}
+		if stateErr.Description != nil {
+			mismatch.Reported += ": " + *stateErr.Description
+		}
+
+		return mismatch
+	}
+
+	return nil
+}
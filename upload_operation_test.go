@@ -193,6 +193,65 @@ func TestUploadOperationUploadError_InvalidOperation(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestUploadWithChecksum(t *testing.T) {
+	t.Parallel()
+
+	contents := []byte("the quick brown fox jumps over the lazy dog")
+	_, want, err := fileChecksum(bytes.NewReader(contents))
+	assert.NoError(t, err)
+
+	client, server := newServer("", http.StatusOK, false)
+	defer server.Close()
+
+	op := func(offset, length int) UploadOperation {
+		return UploadOperation{
+			URL:    String(client.baseURL.String()),
+			Offset: Int(offset),
+			Length: Int(length),
+			Method: String("PUT"),
+		}
+	}
+
+	// Out of order, as App Store Connect doesn't promise to sort them.
+	got, err := client.UploadWithChecksum(context.Background(), []UploadOperation{op(20, 23), op(0, 10), op(10, 10)}, bytes.NewReader(contents))
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	// A gap in the operations falls back to reading the whole file.
+	got, err = client.UploadWithChecksum(context.Background(), []UploadOperation{op(0, 10), op(20, 23)}, bytes.NewReader(contents))
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	got, err = client.UploadWithChecksum(context.Background(), nil, bytes.NewReader(contents))
+	assert.NoError(t, err)
+	assert.Equal(t, want, got)
+
+	_, err = client.UploadWithChecksum(context.Background(), []UploadOperation{{}, op(0, 43)}, bytes.NewReader(contents))
+	assert.ErrorIs(t, err, ErrMissingChunkBounds)
+}
+
+func TestVerifyAssetChecksum(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, VerifyAssetChecksum("abc", nil, nil))
+	assert.NoError(t, VerifyAssetChecksum("abc", String("ABC"), &AppMediaAssetState{State: String("COMPLETE")}))
+	assert.Equal(t, ErrAssetChecksumMismatch{Expected: "abc", Reported: "def"}, VerifyAssetChecksum("abc", String("def"), nil))
+
+	state := &AppMediaAssetState{
+		Errors: []AppMediaStateError{
+			{Code: String("IMAGE_INCORRECT_DIMENSIONS")},
+			{Code: String("ASSET_CHECKSUM_MISMATCH"), Description: String("The checksum does not match.")},
+		},
+		State: String("FAILED"),
+	}
+	err := VerifyAssetChecksum("abc", nil, state)
+	assert.Equal(t, ErrAssetChecksumMismatch{Expected: "abc", Reported: "ASSET_CHECKSUM_MISMATCH: The checksum does not match."}, err)
+	assert.NotEmpty(t, err.Error())
+
+	state.Errors = state.Errors[:1]
+	assert.NoError(t, VerifyAssetChecksum("abc", nil, state))
+}
+
 // rmFile closes an open descriptor.
 func rmFile(f *os.File) {
 	if err := os.Remove(f.Name()); err != nil {
@@ -67,6 +67,43 @@ const (
 	UserRoleTechnical UserRole = "TECHNICAL"
 )
 
+var userRoles = []UserRole{
+	UserRoleAccessToReports,
+	UserRoleAccountHolder,
+	UserRoleAdmin,
+	UserRoleAppManager,
+	UserRoleCloudManagedAppDistribution,
+	UserRoleCloudManagedDeveloperID,
+	UserRoleCreateApps,
+	UserRoleCustomerSupport,
+	UserRoleDeveloper,
+	UserRoleFinance,
+	UserRoleMarketing,
+	UserRoleReadOnly,
+	UserRoleSales,
+	UserRoleTechnical,
+}
+
+// IsValid reports whether the user role is one this package knows about.
+func (v UserRole) IsValid() bool {
+	for _, value := range userRoles {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseUserRole returns s as a UserRole, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseUserRole(s string) (UserRole, error) {
+	if v := UserRole(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "UserRole", Value: s}
+}
+
 // User defines model for User.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/user
@@ -199,21 +236,14 @@ type ListVisibleAppsByResourceIDQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_users
 func (s *UsersService) ListUsers(ctx context.Context, params *ListUsersQuery) (*UsersResponse, *Response, error) {
-	res := new(UsersResponse)
-	resp, err := s.client.get(ctx, "users", params, res)
-
-	return res, resp, err
+	return Get[UsersResponse](ctx, s.client, "users", params)
 }
 
 // GetUser gets information about a user on your team, such as name, roles, and app visibility.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_user_information
 func (s *UsersService) GetUser(ctx context.Context, id string, params *GetUserQuery) (*UserResponse, *Response, error) {
-	url := fmt.Sprintf("users/%s", id)
-	res := new(UserResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[UserResponse](ctx, s.client, fmt.Sprintf("users/%s", id), params)
 }
 
 // UpdateUser changes a user's role, app visibility information, or other account details.
@@ -253,22 +283,14 @@ func (s *UsersService) RemoveUser(ctx context.Context, id string) (*Response, er
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_apps_visible_to_a_user
 func (s *UsersService) ListVisibleAppsForUser(ctx context.Context, id string, params *ListVisibleAppsQuery) (*AppsResponse, *Response, error) {
-	url := fmt.Sprintf("users/%s/visibleApps", id)
-	res := new(AppsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppsResponse](ctx, s.client, fmt.Sprintf("users/%s/visibleApps", id), params)
 }
 
 // ListVisibleAppsByResourceIDForUser gets a list of app resource IDs to which a user on your team has access.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/get_all_visible_app_resource_ids_for_a_user
 func (s *UsersService) ListVisibleAppsByResourceIDForUser(ctx context.Context, id string, params *ListVisibleAppsByResourceIDQuery) (*UserVisibleAppsLinkagesResponse, *Response, error) {
-	url := fmt.Sprintf("users/%s/relationships/visibleApps", id)
-	res := new(UserVisibleAppsLinkagesResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[UserVisibleAppsLinkagesResponse](ctx, s.client, fmt.Sprintf("users/%s/relationships/visibleApps", id), params)
 }
 
 // AddVisibleAppsForUser gives a user on your team access to one or more apps.
@@ -276,8 +298,9 @@ func (s *UsersService) ListVisibleAppsByResourceIDForUser(ctx context.Context, i
 // https://developer.apple.com/documentation/appstoreconnectapi/add_visible_apps_to_a_user
 func (s *UsersService) AddVisibleAppsForUser(ctx context.Context, id string, appIDs []string) (*Response, error) {
 	linkages := newPagedRelationshipDeclaration(appIDs, "apps")
+	url := fmt.Sprintf("users/%s/relationships/visibleApps", id)
 
-	return s.client.post(ctx, "appStoreReviewDetails", newRequestBody(linkages.Data), nil)
+	return s.client.post(ctx, url, newRequestBody(linkages.Data), nil)
 }
 
 // UpdateVisibleAppsForUser replaces the list of apps a user on your team can see.
@@ -0,0 +1,360 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+)
+
+// AccessAuditStatus describes whether a team member in an access audit has joined the team.
+type AccessAuditStatus string
+
+const (
+	// AccessAuditStatusActive is a user who has joined the team.
+	AccessAuditStatusActive AccessAuditStatus = "ACTIVE"
+	// AccessAuditStatusInvited is a user with a pending invitation to join the team.
+	AccessAuditStatusInvited AccessAuditStatus = "INVITED"
+)
+
+var accessAuditStatuses = []AccessAuditStatus{
+	AccessAuditStatusActive,
+	AccessAuditStatusInvited,
+}
+
+// IsValid reports whether the access audit status is one this package knows about.
+func (v AccessAuditStatus) IsValid() bool {
+	for _, value := range accessAuditStatuses {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseAccessAuditStatus returns s as an AccessAuditStatus, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseAccessAuditStatus(s string) (AccessAuditStatus, error) {
+	if v := AccessAuditStatus(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "AccessAuditStatus", Value: s}
+}
+
+// AccessAuditApp is an app that appears in an access audit.
+type AccessAuditApp struct {
+	ID       string `json:"id"`
+	BundleID string `json:"bundleId,omitempty"`
+	Name     string `json:"name,omitempty"`
+}
+
+// AccessAuditMember is a user or pending invitation and the apps they can see.
+type AccessAuditMember struct {
+	Status              AccessAuditStatus `json:"status"`
+	ID                  string            `json:"id"`
+	Username            string            `json:"username"`
+	FirstName           string            `json:"firstName,omitempty"`
+	LastName            string            `json:"lastName,omitempty"`
+	Roles               []UserRole        `json:"roles"`
+	AllAppsVisible      bool              `json:"allAppsVisible"`
+	ProvisioningAllowed bool              `json:"provisioningAllowed"`
+	ExpirationDate      *DateTime         `json:"expirationDate,omitempty"`
+	Apps                []AccessAuditApp  `json:"apps"`
+}
+
+// HasRole reports whether the member has been assigned the given role.
+func (m *AccessAuditMember) HasRole(role UserRole) bool {
+	for _, r := range m.Roles {
+		if r == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AccessAuditRow is a single grant of access to an app, flattened for export. Its fields are tagged like
+// report rows, so it can be written by any ReportWriter.
+type AccessAuditRow struct {
+	Status              AccessAuditStatus `tsv:"Status"`
+	MemberID            string            `tsv:"Member ID"`
+	Username            string            `tsv:"Username"`
+	FirstName           string            `tsv:"First Name"`
+	LastName            string            `tsv:"Last Name"`
+	Roles               string            `tsv:"Roles"`
+	AllAppsVisible      bool              `tsv:"All Apps Visible"`
+	ProvisioningAllowed bool              `tsv:"Provisioning Allowed"`
+	AppID               string            `tsv:"App ID"`
+	BundleID            string            `tsv:"Bundle ID"`
+	AppName             string            `tsv:"App Name"`
+}
+
+// AccessAuditReport joins the users, pending invitations, roles and visible apps of a team, answering who
+// can see which app with which role.
+type AccessAuditReport struct {
+	GeneratedAt time.Time           `json:"generatedAt"`
+	Apps        []AccessAuditApp    `json:"apps"`
+	Members     []AccessAuditMember `json:"members"`
+}
+
+// OutputKind implements Output.
+func (r AccessAuditReport) OutputKind() string {
+	return "accessAudit"
+}
+
+// Rows flattens the report into one row per member and app they can see, sorted by username and bundle ID.
+func (r *AccessAuditReport) Rows() []AccessAuditRow {
+	rows := make([]AccessAuditRow, 0)
+
+	for _, member := range r.Members {
+		roles := make([]string, len(member.Roles))
+		for i, role := range member.Roles {
+			roles[i] = string(role)
+		}
+
+		for _, app := range member.Apps {
+			rows = append(rows, AccessAuditRow{
+				Status:              member.Status,
+				MemberID:            member.ID,
+				Username:            member.Username,
+				FirstName:           member.FirstName,
+				LastName:            member.LastName,
+				Roles:               strings.Join(roles, ","),
+				AllAppsVisible:      member.AllAppsVisible,
+				ProvisioningAllowed: member.ProvisioningAllowed,
+				AppID:               app.ID,
+				BundleID:            app.BundleID,
+				AppName:             app.Name,
+			})
+		}
+	}
+
+	return rows
+}
+
+// MembersForApp returns the members that can see the app with the given ID.
+func (r *AccessAuditReport) MembersForApp(appID string) []AccessAuditMember {
+	members := make([]AccessAuditMember, 0)
+
+	for _, member := range r.Members {
+		for _, app := range member.Apps {
+			if app.ID == appID {
+				members = append(members, member)
+
+				break
+			}
+		}
+	}
+
+	return members
+}
+
+// AuditAccess builds an AccessAuditReport of every user and pending invitation on your team. Members that can
+// see all apps are listed against every app on the team.
+func (s *UsersService) AuditAccess(ctx context.Context) (*AccessAuditReport, error) {
+	apps, err := s.listAccessAuditApps(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &AccessAuditReport{
+		GeneratedAt: time.Now().UTC(),
+		Apps:        apps,
+		Members:     make([]AccessAuditMember, 0),
+	}
+
+	users, err := s.listAccessAuditUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range users {
+		member := newUserAccessAuditMember(user)
+		if err := s.resolveAccessAuditApps(ctx, &member, apps, s.ListAllVisibleAppIDsForUser); err != nil {
+			return nil, err
+		}
+
+		report.Members = append(report.Members, member)
+	}
+
+	invitations, err := s.listAccessAuditInvitations(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, invitation := range invitations {
+		member := newInvitationAccessAuditMember(invitation)
+		if err := s.resolveAccessAuditApps(ctx, &member, apps, s.ListAllVisibleAppIDsForInvitation); err != nil {
+			return nil, err
+		}
+
+		report.Members = append(report.Members, member)
+	}
+
+	sort.SliceStable(report.Members, func(i, j int) bool {
+		return report.Members[i].Username < report.Members[j].Username
+	})
+
+	return report, nil
+}
+
+func (s *UsersService) listAccessAuditApps(ctx context.Context) ([]AccessAuditApp, error) {
+	params := &ListAppsQuery{
+		FieldsApps: []string{"bundleId", "name"},
+		Limit:      MaxPageLimit,
+	}
+
+	data, _, err := List[App](ctx, s.client, "apps", params)
+	if err != nil {
+		return nil, err
+	}
+
+	apps := make([]AccessAuditApp, 0, len(data))
+	for _, app := range data {
+		apps = append(apps, newAccessAuditApp(app))
+	}
+
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].BundleID < apps[j].BundleID
+	})
+
+	return apps, nil
+}
+
+func (s *UsersService) listAccessAuditUsers(ctx context.Context) ([]User, error) {
+	users, _, err := List[User](ctx, s.client, "users", &ListUsersQuery{Limit: MaxPageLimit})
+
+	return users, err
+}
+
+func (s *UsersService) listAccessAuditInvitations(ctx context.Context) ([]UserInvitation, error) {
+	invitations, _, err := List[UserInvitation](ctx, s.client, "userInvitations", &ListInvitationsQuery{Limit: MaxPageLimit})
+
+	return invitations, err
+}
+
+// resolveAccessAuditApps fills in the apps a member can see, either every app on the team or the apps
+// listed by their visible apps relationship.
+func (s *UsersService) resolveAccessAuditApps(ctx context.Context, member *AccessAuditMember, apps []AccessAuditApp, list func(context.Context, string) ([]string, error)) error {
+	if member.AllAppsVisible {
+		member.Apps = append([]AccessAuditApp{}, apps...)
+
+		return nil
+	}
+
+	appIDs, err := list(ctx, member.ID)
+	if err != nil {
+		return err
+	}
+
+	byID := make(map[string]AccessAuditApp, len(apps))
+	for _, app := range apps {
+		byID[app.ID] = app
+	}
+
+	member.Apps = make([]AccessAuditApp, 0, len(appIDs))
+
+	for _, appID := range appIDs {
+		app, ok := byID[appID]
+		if !ok {
+			app = AccessAuditApp{ID: appID}
+		}
+
+		member.Apps = append(member.Apps, app)
+	}
+
+	sort.Slice(member.Apps, func(i, j int) bool {
+		return member.Apps[i].BundleID < member.Apps[j].BundleID
+	})
+
+	return nil
+}
+
+func newAccessAuditApp(app App) AccessAuditApp {
+	auditApp := AccessAuditApp{ID: app.ID}
+
+	if app.Attributes != nil {
+		if app.Attributes.BundleID != nil {
+			auditApp.BundleID = *app.Attributes.BundleID
+		}
+
+		if app.Attributes.Name != nil {
+			auditApp.Name = *app.Attributes.Name
+		}
+	}
+
+	return auditApp
+}
+
+func newUserAccessAuditMember(user User) AccessAuditMember {
+	member := AccessAuditMember{
+		Status: AccessAuditStatusActive,
+		ID:     user.ID,
+		Roles:  []UserRole{},
+	}
+
+	if attributes := user.Attributes; attributes != nil {
+		member.Username = stringValue(attributes.Username)
+		member.FirstName = stringValue(attributes.FirstName)
+		member.LastName = stringValue(attributes.LastName)
+		member.AllAppsVisible = boolValue(attributes.AllAppsVisible)
+		member.ProvisioningAllowed = boolValue(attributes.ProvisioningAllowed)
+
+		if attributes.Roles != nil {
+			member.Roles = attributes.Roles
+		}
+	}
+
+	return member
+}
+
+func newInvitationAccessAuditMember(invitation UserInvitation) AccessAuditMember {
+	member := AccessAuditMember{
+		Status: AccessAuditStatusInvited,
+		ID:     invitation.ID,
+		Roles:  []UserRole{},
+	}
+
+	if attributes := invitation.Attributes; attributes != nil {
+		if attributes.Email != nil {
+			member.Username = string(*attributes.Email)
+		}
+
+		member.FirstName = stringValue(attributes.FirstName)
+		member.LastName = stringValue(attributes.LastName)
+		member.AllAppsVisible = boolValue(attributes.AllAppsVisible)
+		member.ProvisioningAllowed = boolValue(attributes.ProvisioningAllowed)
+		member.ExpirationDate = attributes.ExpirationDate
+
+		if attributes.Roles != nil {
+			member.Roles = attributes.Roles
+		}
+	}
+
+	return member
+}
+
+func boolValue(b *bool) bool {
+	return b != nil && *b
+}
@@ -0,0 +1,119 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditAccess(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /apps": `{"data":[
+			{"id":"2","type":"apps","attributes":{"bundleId":"com.example.b","name":"B"}},
+			{"id":"1","type":"apps","attributes":{"bundleId":"com.example.a","name":"A"}}
+		],"links":{}}`,
+		"GET /users": `{"data":[
+			{"id":"u1","type":"users","attributes":{"username":"zed@example.com","roles":["ADMIN"],"allAppsVisible":true}},
+			{"id":"u2","type":"users","attributes":{"username":"amy@example.com","firstName":"Amy","roles":["DEVELOPER","MARKETING"]}}
+		],"links":{}}`,
+		"GET /users/u2/relationships/visibleApps": `{"data":[{"id":"2","type":"apps"},{"id":"9","type":"apps"}],"links":{}}`,
+		"GET /userInvitations": `{"data":[
+			{"id":"i1","type":"userInvitations","attributes":{"email":"new@example.com","roles":["SALES"],"expirationDate":"2026-10-20T00:00:00Z"}}
+		],"links":{}}`,
+		"GET /userInvitations/i1/relationships/visibleApps": `{"data":[{"id":"1","type":"apps"}],"links":{}}`,
+	})
+	defer server.Close()
+
+	report, err := client.Users.AuditAccess(context.Background())
+	assert.NoError(t, err)
+	assert.NotContains(t, *requests, "GET /users/u1/relationships/visibleApps")
+
+	assert.Equal(t, []AccessAuditApp{
+		{ID: "1", BundleID: "com.example.a", Name: "A"},
+		{ID: "2", BundleID: "com.example.b", Name: "B"},
+	}, report.Apps)
+	assert.Len(t, report.Members, 3)
+
+	amy := report.Members[0]
+	assert.Equal(t, AccessAuditStatusActive, amy.Status)
+	assert.Equal(t, "amy@example.com", amy.Username)
+	assert.True(t, amy.HasRole(UserRoleMarketing))
+	assert.False(t, amy.HasRole(UserRoleAdmin))
+	assert.Equal(t, []AccessAuditApp{{ID: "9"}, {ID: "2", BundleID: "com.example.b", Name: "B"}}, amy.Apps)
+
+	invited := report.Members[1]
+	assert.Equal(t, AccessAuditStatusInvited, invited.Status)
+	assert.Equal(t, "new@example.com", invited.Username)
+	assert.NotNil(t, invited.ExpirationDate)
+	assert.Equal(t, []AccessAuditApp{{ID: "1", BundleID: "com.example.a", Name: "A"}}, invited.Apps)
+
+	admin := report.Members[2]
+	assert.True(t, admin.AllAppsVisible)
+	assert.Equal(t, report.Apps, admin.Apps)
+
+	assert.Len(t, report.MembersForApp("1"), 2)
+	assert.Len(t, report.MembersForApp("9"), 1)
+	assert.Empty(t, report.MembersForApp("404"))
+
+	rows := report.Rows()
+	assert.Len(t, rows, 5)
+	assert.Equal(t, "DEVELOPER,MARKETING", rows[0].Roles)
+
+	var buf bytes.Buffer
+
+	w := NewCSVReportWriter(&buf)
+	for _, row := range rows {
+		assert.NoError(t, w.Write(row))
+	}
+
+	assert.NoError(t, w.Flush())
+	assert.True(t, strings.HasPrefix(buf.String(), "status,member_id,username,first_name,last_name,roles,all_apps_visible,provisioning_allowed,app_id,bundle_id,app_name\n"))
+}
+
+func TestAuditAccessError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"status":"403"}]}`, http.StatusForbidden, false)
+	defer server.Close()
+
+	report, err := client.Users.AuditAccess(context.Background())
+	assert.Error(t, err)
+	assert.Nil(t, report)
+}
+
+func TestParseAccessAuditStatus(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseAccessAuditStatus(string(AccessAuditStatusActive))
+	assert.NoError(t, err)
+	assert.Equal(t, AccessAuditStatusActive, got)
+
+	_, err = ParseAccessAuditStatus("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "AccessAuditStatus", Value: "UNKNOWN"}, err)
+}
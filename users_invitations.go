@@ -133,21 +133,14 @@ type GetInvitationQuery struct {
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_invited_users
 func (s *UsersService) ListInvitations(ctx context.Context, params *ListInvitationsQuery) (*UserInvitationsResponse, *Response, error) {
-	res := new(UserInvitationsResponse)
-	resp, err := s.client.get(ctx, "userInvitations", params, res)
-
-	return res, resp, err
+	return Get[UserInvitationsResponse](ctx, s.client, "userInvitations", params)
 }
 
 // GetInvitation gets information about a pending invitation to join your team.
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/read_user_invitation_information
 func (s *UsersService) GetInvitation(ctx context.Context, id string, params *GetInvitationQuery) (*UserInvitationResponse, *Response, error) {
-	url := fmt.Sprintf("userInvitations/%s", id)
-	res := new(UserInvitationResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[UserInvitationResponse](ctx, s.client, fmt.Sprintf("userInvitations/%s", id), params)
 }
 
 // CreateInvitation invites a user with assigned user roles to join your team.
@@ -185,9 +178,5 @@ func (s *UsersService) CancelInvitation(ctx context.Context, id string) (*Respon
 //
 // https://developer.apple.com/documentation/appstoreconnectapi/list_all_apps_visible_to_an_invited_user
 func (s *UsersService) ListVisibleAppsForInvitation(ctx context.Context, id string, params *ListVisibleAppsQuery) (*AppsResponse, *Response, error) {
-	url := fmt.Sprintf("userInvitations/%s/visibleApps", id)
-	res := new(AppsResponse)
-	resp, err := s.client.get(ctx, url, params, res)
-
-	return res, resp, err
+	return Get[AppsResponse](ctx, s.client, fmt.Sprintf("userInvitations/%s/visibleApps", id), params)
 }
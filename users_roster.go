@@ -0,0 +1,509 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownRosterApp happens when a roster member lists an app that is neither the ID nor the bundle ID of an
+// app on the team.
+var ErrUnknownRosterApp = errors.New("roster lists an unknown app")
+
+// ErrEmptyRoster happens when removing the users who are not in a roster is requested for an empty roster, which
+// would remove everyone but the account holder, as an empty roster is more likely a mistake than intended.
+var ErrEmptyRoster = errors.New("roster is empty")
+
+// RosterMember is the access a team member should have.
+type RosterMember struct {
+	// Email is the email address the member signs in with.
+	Email     string     `json:"email"`
+	FirstName string     `json:"firstName,omitempty"`
+	LastName  string     `json:"lastName,omitempty"`
+	Roles     []UserRole `json:"roles"`
+	// AllAppsVisible gives the member access to every app, in which case Apps is ignored.
+	AllAppsVisible      bool `json:"allAppsVisible"`
+	ProvisioningAllowed bool `json:"provisioningAllowed"`
+	// Apps are the IDs or bundle IDs of the apps the member can see.
+	Apps []string `json:"apps,omitempty"`
+}
+
+// RosterPlanOptions configures PlanRoster.
+type RosterPlanOptions struct {
+	// RemoveUnlisted removes users and cancels invitations that are not in the roster. Otherwise they are left
+	// alone.
+	RemoveUnlisted bool
+}
+
+// RosterActionType is the kind of change a RosterAction makes.
+type RosterActionType string
+
+const (
+	// RosterActionInvite invites a member who is neither on the team nor invited.
+	RosterActionInvite RosterActionType = "INVITE"
+	// RosterActionReinvite cancels a pending invitation and invites the member again with different access,
+	// as invitations cannot be modified.
+	RosterActionReinvite RosterActionType = "REINVITE"
+	// RosterActionUpdateUser changes the roles or permissions of a user on the team.
+	RosterActionUpdateUser RosterActionType = "UPDATE_USER"
+	// RosterActionUpdateVisibleApps adds and removes apps a user on the team can see.
+	RosterActionUpdateVisibleApps RosterActionType = "UPDATE_VISIBLE_APPS"
+	// RosterActionRemoveUser removes a user who is not in the roster from the team.
+	RosterActionRemoveUser RosterActionType = "REMOVE_USER"
+	// RosterActionCancelInvitation cancels a pending invitation for someone who is not in the roster.
+	RosterActionCancelInvitation RosterActionType = "CANCEL_INVITATION"
+)
+
+var rosterActionTypes = []RosterActionType{
+	RosterActionInvite,
+	RosterActionReinvite,
+	RosterActionUpdateUser,
+	RosterActionUpdateVisibleApps,
+	RosterActionRemoveUser,
+	RosterActionCancelInvitation,
+}
+
+// IsValid reports whether the roster action type is one this package knows about.
+func (v RosterActionType) IsValid() bool {
+	for _, value := range rosterActionTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseRosterActionType returns s as a RosterActionType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseRosterActionType(s string) (RosterActionType, error) {
+	if v := RosterActionType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "RosterActionType", Value: s}
+}
+
+// RosterAction is a single change a RosterPlan makes to the team.
+type RosterAction struct {
+	Type  RosterActionType `json:"type"`
+	Email string           `json:"email"`
+	// MemberID is the ID of the existing user or invitation the action applies to.
+	MemberID string `json:"memberId,omitempty"`
+	// Member is the desired access of the member, for actions other than removals.
+	Member *RosterMember `json:"member,omitempty"`
+	// CurrentRoles are the roles the member has before the action.
+	CurrentRoles []UserRole `json:"currentRoles,omitempty"`
+	// Current is the existing user or invitation, for actions other than invitations of new members.
+	Current *AccessAuditMember `json:"current,omitempty"`
+	// VisibleApps are the app IDs added and removed by the action.
+	VisibleApps *VisibleAppsChange `json:"visibleApps,omitempty"`
+}
+
+func (a RosterAction) String() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%s %s", a.Type, a.Email)
+
+	switch a.Type {
+	case RosterActionInvite, RosterActionReinvite, RosterActionUpdateUser:
+		fmt.Fprintf(&b, " roles %s -> %s", formatRosterRoles(a.CurrentRoles), formatRosterRoles(a.Member.Roles))
+
+		if a.Member.AllAppsVisible {
+			b.WriteString(", all apps")
+		}
+	}
+
+	if a.VisibleApps != nil {
+		if len(a.VisibleApps.Added) > 0 {
+			fmt.Fprintf(&b, ", +apps %s", strings.Join(a.VisibleApps.Added, ","))
+		}
+
+		if len(a.VisibleApps.Removed) > 0 {
+			fmt.Fprintf(&b, ", -apps %s", strings.Join(a.VisibleApps.Removed, ","))
+		}
+	}
+
+	return b.String()
+}
+
+// RosterPlan is the set of changes needed to bring the team to a roster. Review it with String, which serves
+// as a dry run, before passing it to ApplyRosterPlan.
+type RosterPlan struct {
+	Actions []RosterAction `json:"actions"`
+}
+
+// OutputKind implements Output.
+func (p RosterPlan) OutputKind() string {
+	return "rosterPlan"
+}
+
+// MarshalJSON encodes a plan without actions with an empty list of actions rather than null.
+func (p RosterPlan) MarshalJSON() ([]byte, error) {
+	type plan RosterPlan
+
+	if p.Actions == nil {
+		p.Actions = []RosterAction{}
+	}
+
+	return json.Marshal(plan(p))
+}
+
+func (p *RosterPlan) String() string {
+	if len(p.Actions) == 0 {
+		return "team matches the roster\n"
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%d roster changes\n", len(p.Actions))
+
+	for _, action := range p.Actions {
+		fmt.Fprintf(&b, "  %s\n", action)
+	}
+
+	return b.String()
+}
+
+// Diff returns the plan as a structured diff of the users and invitations it creates, updates and deletes.
+func (p *RosterPlan) Diff() *Plan {
+	plan := &Plan{Changes: make([]ConfigChange, 0, len(p.Actions))}
+
+	for _, action := range p.Actions {
+		change := ConfigChange{Resource: "users", Name: action.Email, ID: action.MemberID}
+
+		switch action.Type {
+		case RosterActionInvite:
+			change.Action = ConfigActionCreate
+			change.Resource = "userInvitations"
+		case RosterActionReinvite:
+			change.Action = ConfigActionUpdate
+			change.Resource = "userInvitations"
+		case RosterActionUpdateUser, RosterActionUpdateVisibleApps:
+			change.Action = ConfigActionUpdate
+		case RosterActionRemoveUser:
+			change.Action = ConfigActionDelete
+		case RosterActionCancelInvitation:
+			change.Action = ConfigActionDelete
+			change.Resource = "userInvitations"
+		}
+
+		if action.Type != RosterActionUpdateVisibleApps {
+			change.Fields = diffRosterAccess(action.Current, action.Member)
+		}
+
+		if action.VisibleApps != nil {
+			if len(action.VisibleApps.Added) > 0 {
+				change.Fields = append(change.Fields, ConfigFieldChange{Field: "visibleApps.added", Desired: strings.Join(action.VisibleApps.Added, ",")})
+			}
+
+			if len(action.VisibleApps.Removed) > 0 {
+				change.Fields = append(change.Fields, ConfigFieldChange{Field: "visibleApps.removed", Desired: strings.Join(action.VisibleApps.Removed, ",")})
+			}
+		}
+
+		plan.Changes = append(plan.Changes, change)
+	}
+
+	return plan
+}
+
+// diffRosterAccess lists the roles and permissions that differ between a member before and after an action.
+// Either is nil for invitations of new members and removals.
+func diffRosterAccess(current *AccessAuditMember, desired *RosterMember) []ConfigFieldChange {
+	var before, after [3]string
+
+	if current != nil {
+		before = [3]string{formatRosterRoles(current.Roles), strconv.FormatBool(current.AllAppsVisible), strconv.FormatBool(current.ProvisioningAllowed)}
+	}
+
+	if desired != nil {
+		after = [3]string{formatRosterRoles(desired.Roles), strconv.FormatBool(desired.AllAppsVisible), strconv.FormatBool(desired.ProvisioningAllowed)}
+	}
+
+	var changes []ConfigFieldChange
+
+	for i, field := range []string{"roles", "allAppsVisible", "provisioningAllowed"} {
+		if before[i] != after[i] {
+			changes = append(changes, ConfigFieldChange{Field: field, Current: before[i], Desired: after[i]})
+		}
+	}
+
+	return changes
+}
+
+// PlanRoster compares the users and pending invitations on your team with a roster and returns the changes
+// needed to converge on it without applying them. Members are matched by email, case-insensitively. Members who
+// are not in the roster are only removed with the RemoveUnlisted option, which an empty roster is refused with,
+// and the account holder is never removed.
+func (s *UsersService) PlanRoster(ctx context.Context, roster []RosterMember, opts *RosterPlanOptions) (*RosterPlan, error) {
+	if opts == nil {
+		opts = &RosterPlanOptions{}
+	}
+
+	if opts.RemoveUnlisted && len(roster) == 0 {
+		return nil, ErrEmptyRoster
+	}
+
+	report, err := s.AuditAccess(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	appIDs := make(map[string]string, 2*len(report.Apps))
+
+	for _, app := range report.Apps {
+		appIDs[app.ID] = app.ID
+		if app.BundleID != "" {
+			appIDs[app.BundleID] = app.ID
+		}
+	}
+
+	existing := make(map[string]AccessAuditMember, len(report.Members))
+	for _, member := range report.Members {
+		existing[strings.ToLower(member.Username)] = member
+	}
+
+	plan := &RosterPlan{Actions: make([]RosterAction, 0)}
+	listed := make(map[string]bool, len(roster))
+
+	for i := range roster {
+		desired := roster[i]
+		email := strings.ToLower(desired.Email)
+		listed[email] = true
+
+		desired.Apps, err = resolveRosterApps(desired, appIDs)
+		if err != nil {
+			return nil, err
+		}
+
+		current, ok := existing[email]
+		if !ok {
+			action := RosterAction{
+				Type:   RosterActionInvite,
+				Email:  desired.Email,
+				Member: &desired,
+			}
+			if len(desired.Apps) > 0 {
+				action.VisibleApps = diffVisibleApps(nil, desired.Apps)
+			}
+
+			plan.Actions = append(plan.Actions, action)
+
+			continue
+		}
+
+		plan.Actions = append(plan.Actions, planRosterMember(current, &desired)...)
+	}
+
+	if opts.RemoveUnlisted {
+		for _, member := range report.Members {
+			if listed[strings.ToLower(member.Username)] || member.HasRole(UserRoleAccountHolder) {
+				continue
+			}
+
+			member := member
+			action := RosterAction{
+				Type:         RosterActionRemoveUser,
+				Email:        member.Username,
+				MemberID:     member.ID,
+				CurrentRoles: member.Roles,
+				Current:      &member,
+			}
+			if member.Status == AccessAuditStatusInvited {
+				action.Type = RosterActionCancelInvitation
+			}
+
+			plan.Actions = append(plan.Actions, action)
+		}
+	}
+
+	return plan, nil
+}
+
+// ApplyRosterPlan makes every change in a plan. It stops at the first failure; as the roster is declarative,
+// planning again after the cause is fixed picks up the remaining changes.
+func (s *UsersService) ApplyRosterPlan(ctx context.Context, plan *RosterPlan) error {
+	for _, action := range plan.Actions {
+		if err := s.applyRosterAction(ctx, action); err != nil {
+			return fmt.Errorf("%s %s: %w", action.Type, action.Email, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *UsersService) applyRosterAction(ctx context.Context, action RosterAction) error {
+	var err error
+
+	switch action.Type {
+	case RosterActionInvite:
+		err = s.inviteRosterMember(ctx, action.Member)
+	case RosterActionReinvite:
+		if _, err = s.CancelInvitation(ctx, action.MemberID); err == nil {
+			err = s.inviteRosterMember(ctx, action.Member)
+		}
+	case RosterActionUpdateUser:
+		_, _, err = s.UpdateUser(ctx, action.MemberID, &UserUpdateRequestAttributes{
+			AllAppsVisible:      Bool(action.Member.AllAppsVisible),
+			ProvisioningAllowed: Bool(action.Member.ProvisioningAllowed),
+			Roles:               action.Member.Roles,
+		}, nil)
+	case RosterActionUpdateVisibleApps:
+		if err = s.BulkAddVisibleAppsForUser(ctx, action.MemberID, action.VisibleApps.Added); err == nil {
+			err = s.BulkRemoveVisibleAppsFromUser(ctx, action.MemberID, action.VisibleApps.Removed)
+		}
+	case RosterActionRemoveUser:
+		_, err = s.RemoveUser(ctx, action.MemberID)
+	case RosterActionCancelInvitation:
+		_, err = s.CancelInvitation(ctx, action.MemberID)
+	}
+
+	return err
+}
+
+func (s *UsersService) inviteRosterMember(ctx context.Context, member *RosterMember) error {
+	attributes := UserInvitationCreateRequestAttributes{
+		AllAppsVisible:      Bool(member.AllAppsVisible),
+		Email:               Email(member.Email),
+		FirstName:           member.FirstName,
+		LastName:            member.LastName,
+		ProvisioningAllowed: Bool(member.ProvisioningAllowed),
+		Roles:               member.Roles,
+	}
+
+	var appIDs []string
+	if !member.AllAppsVisible {
+		appIDs = member.Apps
+	}
+
+	_, _, err := s.CreateInvitation(ctx, attributes, appIDs)
+
+	return err
+}
+
+// planRosterMember returns the actions that bring an existing user or invitation to the desired access.
+func planRosterMember(current AccessAuditMember, desired *RosterMember) []RosterAction {
+	settingsChanged := !sameUserRoles(current.Roles, desired.Roles) ||
+		current.AllAppsVisible != desired.AllAppsVisible ||
+		current.ProvisioningAllowed != desired.ProvisioningAllowed
+
+	var apps *VisibleAppsChange
+
+	if !desired.AllAppsVisible {
+		currentApps := make([]string, 0, len(current.Apps))
+
+		if !current.AllAppsVisible {
+			for _, app := range current.Apps {
+				currentApps = append(currentApps, app.ID)
+			}
+		}
+
+		apps = diffVisibleApps(currentApps, desired.Apps)
+		if apps.IsEmpty() {
+			apps = nil
+		}
+	}
+
+	action := RosterAction{
+		Email:        desired.Email,
+		MemberID:     current.ID,
+		Member:       desired,
+		CurrentRoles: current.Roles,
+		Current:      &current,
+	}
+
+	if current.Status == AccessAuditStatusInvited {
+		if !settingsChanged && apps == nil {
+			return nil
+		}
+
+		action.Type = RosterActionReinvite
+		action.VisibleApps = apps
+
+		return []RosterAction{action}
+	}
+
+	actions := make([]RosterAction, 0, 2)
+
+	if settingsChanged {
+		action.Type = RosterActionUpdateUser
+		actions = append(actions, action)
+	}
+
+	if apps != nil {
+		action.Type = RosterActionUpdateVisibleApps
+		action.VisibleApps = apps
+		actions = append(actions, action)
+	}
+
+	return actions
+}
+
+func resolveRosterApps(member RosterMember, appIDs map[string]string) ([]string, error) {
+	if member.AllAppsVisible {
+		return nil, nil
+	}
+
+	resolved := make([]string, 0, len(member.Apps))
+
+	for _, app := range member.Apps {
+		id, ok := appIDs[app]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s for %s", ErrUnknownRosterApp, app, member.Email)
+		}
+
+		resolved = append(resolved, id)
+	}
+
+	return uniqueAppIDs(resolved), nil
+}
+
+func sameUserRoles(a []UserRole, b []UserRole) bool {
+	return formatRosterRoles(a) == formatRosterRoles(b)
+}
+
+// formatRosterRoles returns the distinct roles sorted and comma-separated, or "none".
+func formatRosterRoles(roles []UserRole) string {
+	names := make([]string, 0, len(roles))
+	seen := make(map[UserRole]bool, len(roles))
+
+	for _, role := range roles {
+		if !seen[role] {
+			seen[role] = true
+
+			names = append(names, string(role))
+		}
+	}
+
+	if len(names) == 0 {
+		return "none"
+	}
+
+	sort.Strings(names)
+
+	return strings.Join(names, ",")
+}
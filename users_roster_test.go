@@ -0,0 +1,225 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testRosterRoutes = map[string]string{
+	"GET /apps": `{"data":[
+		{"id":"1","type":"apps","attributes":{"bundleId":"com.example.a"}},
+		{"id":"2","type":"apps","attributes":{"bundleId":"com.example.b"}}
+	],"links":{}}`,
+	"GET /users": `{"data":[
+		{"id":"owner","type":"users","attributes":{"username":"owner@example.com","roles":["ACCOUNT_HOLDER","ADMIN"],"allAppsVisible":true}},
+		{"id":"dev","type":"users","attributes":{"username":"dev@example.com","roles":["DEVELOPER"]}},
+		{"id":"gone","type":"users","attributes":{"username":"gone@example.com","roles":["SALES"],"allAppsVisible":true}}
+	],"links":{}}`,
+	"GET /users/dev/relationships/visibleApps": `{"data":[{"id":"1","type":"apps"}],"links":{}}`,
+	"GET /userInvitations": `{"data":[
+		{"id":"inv","type":"userInvitations","attributes":{"email":"pending@example.com","roles":["MARKETING"]}},
+		{"id":"old","type":"userInvitations","attributes":{"email":"old@example.com","roles":["SALES"],"allAppsVisible":true}}
+	],"links":{}}`,
+	"GET /userInvitations/inv/relationships/visibleApps": `{"data":[{"id":"2","type":"apps"}],"links":{}}`,
+	"POST /userInvitations":                              `{"data":{"id":"new","type":"userInvitations"}}`,
+	"PATCH /users/dev":                                   `{"data":{"id":"dev","type":"users"}}`,
+}
+
+var testRoster = []RosterMember{
+	{Email: "Dev@example.com", Roles: []UserRole{UserRoleDeveloper, UserRoleAppManager}, Apps: []string{"com.example.b", "2"}},
+	{Email: "pending@example.com", Roles: []UserRole{UserRoleMarketing}, Apps: []string{"com.example.b"}},
+	{Email: "new@example.com", FirstName: "New", LastName: "Person", Roles: []UserRole{UserRoleFinance}, AllAppsVisible: true},
+}
+
+func TestPlanRoster(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newVisibleAppsServer(t, testRosterRoutes)
+	defer server.Close()
+
+	plan, err := client.Users.PlanRoster(context.Background(), testRoster, &RosterPlanOptions{RemoveUnlisted: true})
+	assert.NoError(t, err)
+
+	types := make([]RosterActionType, 0, len(plan.Actions))
+	for _, action := range plan.Actions {
+		types = append(types, action.Type)
+	}
+
+	assert.Equal(t, []RosterActionType{
+		RosterActionUpdateUser,
+		RosterActionUpdateVisibleApps,
+		RosterActionInvite,
+		RosterActionRemoveUser,
+		RosterActionCancelInvitation,
+	}, types)
+	assert.Equal(t, &VisibleAppsChange{Added: []string{"2"}, Removed: []string{"1"}}, plan.Actions[1].VisibleApps)
+	assert.Equal(t, "gone", plan.Actions[3].MemberID)
+	assert.Equal(t, "old", plan.Actions[4].MemberID)
+	assert.Equal(t, `5 roster changes
+  UPDATE_USER Dev@example.com roles DEVELOPER -> APP_MANAGER,DEVELOPER
+  UPDATE_VISIBLE_APPS Dev@example.com, +apps 2, -apps 1
+  INVITE new@example.com roles none -> FINANCE, all apps
+  REMOVE_USER gone@example.com
+  CANCEL_INVITATION old@example.com
+`, plan.String())
+
+	plan, err = client.Users.PlanRoster(context.Background(), testRoster, nil)
+	assert.NoError(t, err)
+	assert.Len(t, plan.Actions, 3, "unlisted members are kept by default")
+}
+
+func TestPlanRosterEmpty(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newVisibleAppsServer(t, testRosterRoutes)
+	defer server.Close()
+
+	plan, err := client.Users.PlanRoster(context.Background(), nil, &RosterPlanOptions{RemoveUnlisted: true})
+	assert.ErrorIs(t, err, ErrEmptyRoster)
+	assert.Nil(t, plan)
+
+	plan, err = client.Users.PlanRoster(context.Background(), []RosterMember{}, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, plan.Actions)
+}
+
+func TestPlanRosterReinvite(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newVisibleAppsServer(t, testRosterRoutes)
+	defer server.Close()
+
+	roster := []RosterMember{{Email: "pending@example.com", Roles: []UserRole{UserRoleMarketing}, Apps: []string{"1"}}}
+	plan, err := client.Users.PlanRoster(context.Background(), roster, nil)
+	assert.NoError(t, err)
+	assert.Len(t, plan.Actions, 1)
+	assert.Equal(t, RosterActionReinvite, plan.Actions[0].Type)
+	assert.Equal(t, "inv", plan.Actions[0].MemberID)
+}
+
+func TestPlanRosterUnknownApp(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newVisibleAppsServer(t, testRosterRoutes)
+	defer server.Close()
+
+	roster := []RosterMember{{Email: "dev@example.com", Roles: []UserRole{UserRoleDeveloper}, Apps: []string{"com.example.missing"}}}
+	plan, err := client.Users.PlanRoster(context.Background(), roster, nil)
+	assert.ErrorIs(t, err, ErrUnknownRosterApp)
+	assert.Nil(t, plan)
+}
+
+func TestPlanRosterUnchanged(t *testing.T) {
+	t.Parallel()
+
+	plan := &RosterPlan{}
+	assert.Equal(t, "team matches the roster\n", plan.String())
+	assert.Empty(t, planRosterMember(AccessAuditMember{
+		Status: AccessAuditStatusActive,
+		Roles:  []UserRole{UserRoleDeveloper},
+		Apps:   []AccessAuditApp{{ID: "1"}},
+	}, &RosterMember{Roles: []UserRole{UserRoleDeveloper, UserRoleDeveloper}, Apps: []string{"1"}}))
+}
+
+func TestApplyRosterPlan(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newVisibleAppsServer(t, testRosterRoutes)
+	defer server.Close()
+
+	plan, err := client.Users.PlanRoster(context.Background(), testRoster, &RosterPlanOptions{RemoveUnlisted: true})
+	assert.NoError(t, err)
+
+	planned := len(*calls)
+
+	err = client.Users.ApplyRosterPlan(context.Background(), plan)
+	assert.NoError(t, err)
+	assert.Equal(t, []visibleAppsCall{
+		{Method: "PATCH", Path: "/users/dev"},
+		{Method: "POST", Path: "/users/dev/relationships/visibleApps", IDs: []string{"2"}},
+		{Method: "DELETE", Path: "/users/dev/relationships/visibleApps", IDs: []string{"1"}},
+		{Method: "POST", Path: "/userInvitations"},
+		{Method: "DELETE", Path: "/users/gone"},
+		{Method: "DELETE", Path: "/userInvitations/old"},
+	}, (*calls)[planned:])
+}
+
+func TestApplyRosterPlanError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"status":"409"}]}`, http.StatusConflict, false)
+	defer server.Close()
+
+	plan := &RosterPlan{Actions: []RosterAction{{Type: RosterActionRemoveUser, Email: "gone@example.com", MemberID: "gone"}}}
+	err := client.Users.ApplyRosterPlan(context.Background(), plan)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "REMOVE_USER gone@example.com")
+}
+
+func TestRosterPlanDiff(t *testing.T) {
+	t.Parallel()
+
+	current := &AccessAuditMember{ID: "u1", Roles: []UserRole{UserRoleDeveloper}}
+	plan := &RosterPlan{Actions: []RosterAction{
+		{Type: RosterActionInvite, Email: "new@example.com", Member: &RosterMember{Email: "new@example.com", Roles: []UserRole{UserRoleMarketing}}},
+		{Type: RosterActionUpdateUser, Email: "dev@example.com", MemberID: "u1", Current: current, Member: &RosterMember{Email: "dev@example.com", Roles: []UserRole{UserRoleDeveloper}, ProvisioningAllowed: true}},
+		{Type: RosterActionUpdateVisibleApps, Email: "dev@example.com", MemberID: "u1", Current: current, VisibleApps: &VisibleAppsChange{Added: []string{"A2"}, Removed: []string{"A1"}}},
+		{Type: RosterActionCancelInvitation, Email: "old@example.com", MemberID: "i1", Current: &AccessAuditMember{ID: "i1"}},
+	}}
+
+	diff := plan.Diff()
+	assert.Equal(t, []ConfigChange{
+		{Action: ConfigActionCreate, Resource: "userInvitations", Name: "new@example.com", Fields: []ConfigFieldChange{
+			{Field: "roles", Current: "", Desired: "MARKETING"},
+			{Field: "allAppsVisible", Current: "", Desired: "false"},
+			{Field: "provisioningAllowed", Current: "", Desired: "false"},
+		}},
+		{Action: ConfigActionUpdate, Resource: "users", Name: "dev@example.com", ID: "u1", Fields: []ConfigFieldChange{
+			{Field: "provisioningAllowed", Current: "false", Desired: "true"},
+		}},
+		{Action: ConfigActionUpdate, Resource: "users", Name: "dev@example.com", ID: "u1", Fields: []ConfigFieldChange{
+			{Field: "visibleApps.added", Desired: "A2"},
+			{Field: "visibleApps.removed", Desired: "A1"},
+		}},
+		{Action: ConfigActionDelete, Resource: "userInvitations", Name: "old@example.com", ID: "i1", Fields: []ConfigFieldChange{
+			{Field: "roles", Current: "none", Desired: ""},
+			{Field: "allAppsVisible", Current: "false", Desired: ""},
+			{Field: "provisioningAllowed", Current: "false", Desired: ""},
+		}},
+	}, diff.Changes)
+	assert.Equal(t, "1 to create, 2 to update, 1 to delete", diff.Summary())
+}
+
+func TestParseRosterActionType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseRosterActionType(string(RosterActionInvite))
+	assert.NoError(t, err)
+	assert.Equal(t, RosterActionInvite, got)
+
+	_, err = ParseRosterActionType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "RosterActionType", Value: "UNKNOWN"}, err)
+}
@@ -23,6 +23,8 @@ package asc
 import (
 	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestListUsers(t *testing.T) {
@@ -96,3 +98,14 @@ func TestRemoveVisibleAppsFromUser(t *testing.T) {
 		return client.Users.RemoveVisibleAppsFromUser(ctx, "10", []string{"10"})
 	})
 }
+
+func TestParseUserRole(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseUserRole(string(UserRoleAccessToReports))
+	assert.NoError(t, err)
+	assert.Equal(t, UserRoleAccessToReports, got)
+
+	_, err = ParseUserRole("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "UserRole", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,315 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// visibleAppsChunkSize is the number of app linkages sent in a single relationship request.
+const visibleAppsChunkSize = 50
+
+// ErrMissingInvitationEmail happens when an invitation cannot be reissued because its email
+// address could not be read.
+var ErrMissingInvitationEmail = errors.New("invitation has no email address")
+
+// ErrInvitationNotReissued happens when ReplaceVisibleAppsForInvitation canceled a pending invitation
+// but could not create its replacement. Attributes and AppIDs hold what is needed to create the
+// invitation again with CreateInvitation.
+type ErrInvitationNotReissued struct {
+	InvitationID string
+	Attributes   UserInvitationCreateRequestAttributes
+	AppIDs       []string
+	Err          error
+}
+
+func (e ErrInvitationNotReissued) Error() string {
+	return fmt.Sprintf("invitation %s for %s was canceled but could not be created again with apps %v: %v", e.InvitationID, e.Attributes.Email, e.AppIDs, e.Err)
+}
+
+func (e ErrInvitationNotReissued) Unwrap() error {
+	return e.Err
+}
+
+// VisibleAppsChange describes the apps added to and removed from a user's or invitation's
+// visible apps by a bulk operation.
+type VisibleAppsChange struct {
+	Added   []string `json:"added,omitempty"`
+	Removed []string `json:"removed,omitempty"`
+}
+
+// IsEmpty reports whether the change neither added nor removed any apps.
+func (c VisibleAppsChange) IsEmpty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0
+}
+
+// ListVisibleAppsByResourceIDForInvitation gets a list of app resource IDs that will be visible to a user with a pending invitation.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/get_v1_userinvitations_id_relationships_visibleapps
+func (s *UsersService) ListVisibleAppsByResourceIDForInvitation(ctx context.Context, id string, params *ListVisibleAppsByResourceIDQuery) (*UserVisibleAppsLinkagesResponse, *Response, error) {
+	return Get[UserVisibleAppsLinkagesResponse](ctx, s.client, fmt.Sprintf("userInvitations/%s/relationships/visibleApps", id), params)
+}
+
+// ListAllVisibleAppIDsForUser pages through every app resource ID a user on your team has access to.
+func (s *UsersService) ListAllVisibleAppIDsForUser(ctx context.Context, id string) ([]string, error) {
+	return s.listAllVisibleAppIDs(ctx, fmt.Sprintf("users/%s/relationships/visibleApps", id))
+}
+
+// ListAllVisibleAppIDsForInvitation pages through every app resource ID that will be visible to a user
+// with a pending invitation.
+func (s *UsersService) ListAllVisibleAppIDsForInvitation(ctx context.Context, id string) ([]string, error) {
+	return s.listAllVisibleAppIDs(ctx, fmt.Sprintf("userInvitations/%s/relationships/visibleApps", id))
+}
+
+// BulkAddVisibleAppsForUser gives a user on your team access to any number of apps, splitting the
+// linkages across as many requests as needed.
+func (s *UsersService) BulkAddVisibleAppsForUser(ctx context.Context, id string, appIDs []string) error {
+	for _, chunk := range chunkAppIDs(uniqueAppIDs(appIDs), visibleAppsChunkSize) {
+		if _, err := s.AddVisibleAppsForUser(ctx, id, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BulkRemoveVisibleAppsFromUser removes a user on your team's access to any number of apps, splitting
+// the linkages across as many requests as needed.
+func (s *UsersService) BulkRemoveVisibleAppsFromUser(ctx context.Context, id string, appIDs []string) error {
+	for _, chunk := range chunkAppIDs(uniqueAppIDs(appIDs), visibleAppsChunkSize) {
+		if _, err := s.RemoveVisibleAppsFromUser(ctx, id, chunk); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// BulkReplaceVisibleAppsForUser makes the given apps the only apps a user on your team can see. Rather
+// than sending the whole list in one request, it compares the list against the user's current visible
+// apps and only adds and removes the difference, in chunks.
+func (s *UsersService) BulkReplaceVisibleAppsForUser(ctx context.Context, id string, appIDs []string) (*VisibleAppsChange, error) {
+	current, err := s.ListAllVisibleAppIDsForUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	change := diffVisibleApps(current, appIDs)
+
+	if err := s.BulkAddVisibleAppsForUser(ctx, id, change.Added); err != nil {
+		return nil, err
+	}
+
+	if err := s.BulkRemoveVisibleAppsFromUser(ctx, id, change.Removed); err != nil {
+		return nil, err
+	}
+
+	return change, nil
+}
+
+// BulkAddVisibleAppsForInvitation adds apps to the apps that will be visible to a user with a pending invitation.
+//
+// The API does not allow modifying an invitation, so see ReplaceVisibleAppsForInvitation for how the change is applied.
+func (s *UsersService) BulkAddVisibleAppsForInvitation(ctx context.Context, id string, appIDs []string) (*UserInvitationResponse, *VisibleAppsChange, error) {
+	current, err := s.ListAllVisibleAppIDsForInvitation(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.ReplaceVisibleAppsForInvitation(ctx, id, append(current, appIDs...))
+}
+
+// BulkRemoveVisibleAppsFromInvitation removes apps from the apps that will be visible to a user with a pending invitation.
+//
+// The API does not allow modifying an invitation, so see ReplaceVisibleAppsForInvitation for how the change is applied.
+func (s *UsersService) BulkRemoveVisibleAppsFromInvitation(ctx context.Context, id string, appIDs []string) (*UserInvitationResponse, *VisibleAppsChange, error) {
+	current, err := s.ListAllVisibleAppIDsForInvitation(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	removed := make(map[string]bool, len(appIDs))
+	for _, appID := range appIDs {
+		removed[appID] = true
+	}
+
+	kept := make([]string, 0, len(current))
+
+	for _, appID := range current {
+		if !removed[appID] {
+			kept = append(kept, appID)
+		}
+	}
+
+	return s.ReplaceVisibleAppsForInvitation(ctx, id, kept)
+}
+
+// ReplaceVisibleAppsForInvitation makes the given apps the only apps that will be visible to a user
+// with a pending invitation.
+//
+// Invitations cannot be modified through the API, so when the visible apps differ the pending
+// invitation is canceled and reissued with the same name, email, roles and permissions. The reissued
+// invitation has a new ID and the invitee receives a new email. When nothing changes, the existing
+// invitation is returned untouched. If the invitation is canceled but cannot be reissued,
+// ErrInvitationNotReissued is returned.
+func (s *UsersService) ReplaceVisibleAppsForInvitation(ctx context.Context, id string, appIDs []string) (*UserInvitationResponse, *VisibleAppsChange, error) {
+	invitation, _, err := s.GetInvitation(ctx, id, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := s.ListAllVisibleAppIDsForInvitation(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	change := diffVisibleApps(current, appIDs)
+	if change.IsEmpty() {
+		return invitation, change, nil
+	}
+
+	attributes, err := reissuedInvitationAttributes(invitation.Data.Attributes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, err := s.CancelInvitation(ctx, id); err != nil {
+		return nil, nil, err
+	}
+
+	visibleAppIDs := uniqueAppIDs(appIDs)
+
+	res, _, err := s.CreateInvitation(ctx, attributes, visibleAppIDs)
+	if err != nil {
+		return nil, nil, ErrInvitationNotReissued{
+			InvitationID: id,
+			Attributes:   attributes,
+			AppIDs:       visibleAppIDs,
+			Err:          err,
+		}
+	}
+
+	return res, change, nil
+}
+
+func (s *UsersService) listAllVisibleAppIDs(ctx context.Context, path string) ([]string, error) {
+	data, _, err := List[RelationshipData](ctx, s.client, path, &ListVisibleAppsByResourceIDQuery{Limit: MaxPageLimit})
+	if err != nil {
+		return nil, err
+	}
+
+	appIDs := make([]string, 0, len(data))
+	for _, linkage := range data {
+		appIDs = append(appIDs, linkage.ID)
+	}
+
+	return appIDs, nil
+}
+
+func reissuedInvitationAttributes(attributes *UserInvitationAttributes) (UserInvitationCreateRequestAttributes, error) {
+	if attributes == nil || attributes.Email == nil {
+		return UserInvitationCreateRequestAttributes{}, ErrMissingInvitationEmail
+	}
+
+	reissued := UserInvitationCreateRequestAttributes{
+		AllAppsVisible:      attributes.AllAppsVisible,
+		Email:               *attributes.Email,
+		ProvisioningAllowed: attributes.ProvisioningAllowed,
+		Roles:               attributes.Roles,
+	}
+
+	if attributes.FirstName != nil {
+		reissued.FirstName = *attributes.FirstName
+	}
+
+	if attributes.LastName != nil {
+		reissued.LastName = *attributes.LastName
+	}
+
+	return reissued, nil
+}
+
+// diffVisibleApps returns the apps in want that are missing from current, and the apps in current
+// that are missing from want, each sorted.
+func diffVisibleApps(current []string, want []string) *VisibleAppsChange {
+	have := make(map[string]bool, len(current))
+	for _, appID := range current {
+		have[appID] = true
+	}
+
+	wanted := make(map[string]bool, len(want))
+	for _, appID := range want {
+		wanted[appID] = true
+	}
+
+	change := &VisibleAppsChange{}
+
+	for appID := range wanted {
+		if !have[appID] {
+			change.Added = append(change.Added, appID)
+		}
+	}
+
+	for appID := range have {
+		if !wanted[appID] {
+			change.Removed = append(change.Removed, appID)
+		}
+	}
+
+	sort.Strings(change.Added)
+	sort.Strings(change.Removed)
+
+	return change
+}
+
+func uniqueAppIDs(appIDs []string) []string {
+	seen := make(map[string]bool, len(appIDs))
+	unique := make([]string, 0, len(appIDs))
+
+	for _, appID := range appIDs {
+		if seen[appID] {
+			continue
+		}
+
+		seen[appID] = true
+		unique = append(unique, appID)
+	}
+
+	return unique
+}
+
+func chunkAppIDs(appIDs []string, size int) [][]string {
+	chunks := make([][]string, 0, (len(appIDs)+size-1)/size)
+
+	for len(appIDs) > size {
+		chunks = append(chunks, appIDs[:size])
+		appIDs = appIDs[size:]
+	}
+
+	if len(appIDs) > 0 {
+		chunks = append(chunks, appIDs)
+	}
+
+	return chunks
+}
@@ -0,0 +1,273 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type visibleAppsCall struct {
+	Method string
+	Path   string
+	IDs    []string
+}
+
+func newVisibleAppsServer(t *testing.T, routes map[string]string) (*Client, *httptest.Server, *[]visibleAppsCall) {
+	t.Helper()
+
+	var (
+		mu    sync.Mutex
+		calls []visibleAppsCall
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := visibleAppsCall{Method: r.Method, Path: r.URL.Path}
+
+		var body struct {
+			Data []RelationshipData `json:"data"`
+		}
+		if r.Method != http.MethodGet && json.NewDecoder(r.Body).Decode(&body) == nil {
+			for _, data := range body.Data {
+				call.IDs = append(call.IDs, data.ID)
+			}
+		}
+
+		mu.Lock()
+		calls = append(calls, call)
+		mu.Unlock()
+
+		key := r.Method + " " + r.URL.Path
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			key += "?cursor=" + cursor
+		}
+
+		fmt.Fprintln(w, routes[key])
+	}))
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client, server, &calls
+}
+
+func appIDRange(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = fmt.Sprintf("app-%03d", i)
+	}
+
+	return ids
+}
+
+func TestListVisibleAppsByResourceIDForInvitation(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &UserVisibleAppsLinkagesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.Users.ListVisibleAppsByResourceIDForInvitation(ctx, "10", &ListVisibleAppsByResourceIDQuery{})
+	})
+}
+
+func TestListAllVisibleAppIDsForUser(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newVisibleAppsServer(t, map[string]string{
+		"GET /users/1/relationships/visibleApps":          `{"data":[{"id":"a","type":"apps"}],"links":{"next":"https://api.appstoreconnect.apple.com/v1/users/1/relationships/visibleApps?cursor=2"}}`,
+		"GET /users/1/relationships/visibleApps?cursor=2": `{"data":[{"id":"b","type":"apps"}],"links":{}}`,
+	})
+	defer server.Close()
+
+	ids, err := client.Users.ListAllVisibleAppIDsForUser(context.Background(), "1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, ids)
+	assert.Len(t, *calls, 2)
+}
+
+func TestBulkAddVisibleAppsForUserChunks(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newVisibleAppsServer(t, map[string]string{})
+	defer server.Close()
+
+	ids := appIDRange(120)
+	err := client.Users.BulkAddVisibleAppsForUser(context.Background(), "1", append(ids, ids[0]))
+	assert.NoError(t, err)
+	assert.Len(t, *calls, 3)
+
+	sent := []string{}
+
+	for _, call := range *calls {
+		assert.Equal(t, "POST /users/1/relationships/visibleApps", call.Method+" "+call.Path)
+		assert.LessOrEqual(t, len(call.IDs), visibleAppsChunkSize)
+		sent = append(sent, call.IDs...)
+	}
+
+	assert.Equal(t, ids, sent)
+}
+
+func TestBulkRemoveVisibleAppsFromUserChunks(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newVisibleAppsServer(t, map[string]string{})
+	defer server.Close()
+
+	err := client.Users.BulkRemoveVisibleAppsFromUser(context.Background(), "1", appIDRange(51))
+	assert.NoError(t, err)
+	assert.Len(t, *calls, 2)
+	assert.Equal(t, http.MethodDelete, (*calls)[1].Method)
+	assert.Equal(t, []string{"app-050"}, (*calls)[1].IDs)
+}
+
+func TestBulkAddVisibleAppsForUserError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"errors":[{"status":"409"}]}`, http.StatusConflict, false)
+	defer server.Close()
+
+	err := client.Users.BulkAddVisibleAppsForUser(context.Background(), "1", []string{"a"})
+	assert.Error(t, err)
+}
+
+func TestBulkReplaceVisibleAppsForUser(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newVisibleAppsServer(t, map[string]string{
+		"GET /users/1/relationships/visibleApps": `{"data":[{"id":"a","type":"apps"},{"id":"b","type":"apps"}],"links":{}}`,
+	})
+	defer server.Close()
+
+	change, err := client.Users.BulkReplaceVisibleAppsForUser(context.Background(), "1", []string{"b", "c"})
+	assert.NoError(t, err)
+	assert.Equal(t, &VisibleAppsChange{Added: []string{"c"}, Removed: []string{"a"}}, change)
+	assert.Equal(t, []visibleAppsCall{
+		{Method: "GET", Path: "/users/1/relationships/visibleApps"},
+		{Method: "POST", Path: "/users/1/relationships/visibleApps", IDs: []string{"c"}},
+		{Method: "DELETE", Path: "/users/1/relationships/visibleApps", IDs: []string{"a"}},
+	}, *calls)
+}
+
+func TestReplaceVisibleAppsForInvitation(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newVisibleAppsServer(t, map[string]string{
+		"GET /userInvitations/1":                           `{"data":{"id":"1","type":"userInvitations","attributes":{"email":"a@example.com","firstName":"A","lastName":"B","roles":["DEVELOPER"]}}}`,
+		"GET /userInvitations/1/relationships/visibleApps": `{"data":[{"id":"a","type":"apps"}],"links":{}}`,
+		"POST /userInvitations":                            `{"data":{"id":"2","type":"userInvitations"}}`,
+	})
+	defer server.Close()
+
+	res, change, err := client.Users.BulkAddVisibleAppsForInvitation(context.Background(), "1", []string{"b"})
+	assert.NoError(t, err)
+	assert.Equal(t, "2", res.Data.ID)
+	assert.Equal(t, []string{"b"}, change.Added)
+	assert.Empty(t, change.Removed)
+
+	paths := make([]string, 0, len(*calls))
+	for _, call := range *calls {
+		paths = append(paths, call.Method+" "+call.Path)
+	}
+
+	assert.Equal(t, []string{
+		"GET /userInvitations/1/relationships/visibleApps",
+		"GET /userInvitations/1",
+		"GET /userInvitations/1/relationships/visibleApps",
+		"DELETE /userInvitations/1",
+		"POST /userInvitations",
+	}, paths)
+}
+
+func TestReplaceVisibleAppsForInvitationUnchanged(t *testing.T) {
+	t.Parallel()
+
+	client, server, calls := newVisibleAppsServer(t, map[string]string{
+		"GET /userInvitations/1":                           `{"data":{"id":"1","type":"userInvitations"}}`,
+		"GET /userInvitations/1/relationships/visibleApps": `{"data":[{"id":"a","type":"apps"}],"links":{}}`,
+	})
+	defer server.Close()
+
+	res, change, err := client.Users.BulkRemoveVisibleAppsFromInvitation(context.Background(), "1", []string{"z"})
+	assert.NoError(t, err)
+	assert.Equal(t, "1", res.Data.ID)
+	assert.True(t, change.IsEmpty())
+
+	for _, call := range *calls {
+		assert.Equal(t, http.MethodGet, call.Method)
+	}
+}
+
+func TestReplaceVisibleAppsForInvitationMissingEmail(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newVisibleAppsServer(t, map[string]string{
+		"GET /userInvitations/1":                           `{"data":{"id":"1","type":"userInvitations"}}`,
+		"GET /userInvitations/1/relationships/visibleApps": `{"data":[],"links":{}}`,
+	})
+	defer server.Close()
+
+	_, _, err := client.Users.ReplaceVisibleAppsForInvitation(context.Background(), "1", []string{"a"})
+	assert.ErrorIs(t, err, ErrMissingInvitationEmail)
+}
+
+func TestReplaceVisibleAppsForInvitationCreateFails(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /userInvitations/1":                           `{"data":{"id":"1","type":"userInvitations","attributes":{"email":"a@example.com","firstName":"A","lastName":"B","roles":["DEVELOPER"]}}}`,
+		"GET /userInvitations/1/relationships/visibleApps": `{"data":[{"id":"a","type":"apps"}],"links":{}}`,
+		"DELETE /userInvitations/1":                        ``,
+	})
+	defer server.Close()
+
+	res, change, err := client.Users.ReplaceVisibleAppsForInvitation(context.Background(), "1", []string{"b", "b"})
+	assert.Nil(t, res)
+	assert.Nil(t, change)
+	assert.Contains(t, *requests, "DELETE /userInvitations/1")
+	assert.Contains(t, *requests, "POST /userInvitations")
+
+	var errReissue ErrInvitationNotReissued
+
+	assert.ErrorAs(t, err, &errReissue)
+	assert.Equal(t, "1", errReissue.InvitationID)
+	assert.Equal(t, Email("a@example.com"), errReissue.Attributes.Email)
+	assert.Equal(t, []UserRole{UserRoleDeveloper}, errReissue.Attributes.Roles)
+	assert.Equal(t, []string{"b"}, errReissue.AppIDs)
+	assert.Contains(t, err.Error(), "invitation 1 for a@example.com was canceled but could not be created again with apps [b]")
+
+	var errResponse *ErrorResponse
+
+	assert.ErrorAs(t, err, &errResponse)
+}
+
+func TestChunkAppIDs(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, chunkAppIDs(nil, 2))
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, chunkAppIDs([]string{"a", "b", "c"}, 2))
+	assert.Equal(t, [][]string{{"a", "b"}}, chunkAppIDs([]string{"a", "b"}, 2))
+}
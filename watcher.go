@@ -0,0 +1,314 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"time"
+)
+
+// DefaultWatchInterval is how often a Watcher polls by default.
+const DefaultWatchInterval = time.Minute
+
+// defaultWatchLimit is how many resources of each kind a Watcher polls per app by default.
+const defaultWatchLimit = 20
+
+// WatchEventType is the kind of resource whose state a WatchEvent reports.
+type WatchEventType string
+
+const (
+	// WatchEventBuildState reports the processing state of a build, such as "PROCESSING" or "VALID".
+	WatchEventBuildState WatchEventType = "BUILD_STATE"
+	// WatchEventVersionState reports the App Store state of an App Store version.
+	WatchEventVersionState WatchEventType = "VERSION_STATE"
+	// WatchEventReviewSubmissionState reports the state of a review submission.
+	WatchEventReviewSubmissionState WatchEventType = "REVIEW_SUBMISSION_STATE"
+)
+
+var watchEventTypes = []WatchEventType{
+	WatchEventBuildState,
+	WatchEventVersionState,
+	WatchEventReviewSubmissionState,
+}
+
+// IsValid reports whether the watch event type is one this package knows about.
+func (v WatchEventType) IsValid() bool {
+	for _, value := range watchEventTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseWatchEventType returns s as a WatchEventType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseWatchEventType(s string) (WatchEventType, error) {
+	if v := WatchEventType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "WatchEventType", Value: s}
+}
+
+// WatchEvent is a change of state observed by a Watcher. From is empty for a resource seen for the first time.
+// When a poll fails, Err is set, ID and the states are empty, and Type and AppID tell which poll failed.
+type WatchEvent struct {
+	Type  WatchEventType
+	AppID string
+	// ID is the ID of the build, App Store version or review submission.
+	ID   string
+	From string
+	To   string
+	Time time.Time
+	// Build is set for WatchEventBuildState events.
+	Build *Build
+	// Version is set for WatchEventVersionState events.
+	Version *AppStoreVersion
+	// ReviewSubmission is set for WatchEventReviewSubmissionState events.
+	ReviewSubmission *ReviewSubmission
+	Err              error
+}
+
+// WatcherOptions configures NewWatcher.
+type WatcherOptions struct {
+	// AppIDs are the apps whose resources are polled.
+	AppIDs []string
+	// Builds, Versions and ReviewSubmissions select the resources to poll. All of them are polled if none is set.
+	Builds            bool
+	Versions          bool
+	ReviewSubmissions bool
+	// Interval is how often to poll. It defaults to DefaultWatchInterval.
+	Interval time.Duration
+	// Limit is how many resources of each kind are polled per app. It defaults to 20. Builds are the most
+	// recently uploaded ones, while App Store versions and review submissions can't be sorted, so they are the
+	// first ones in the order App Store Connect lists them, which is not guaranteed to be the most recent.
+	Limit int
+	// EmitInitial sends the state of every resource seen by the first poll. By default the first poll only
+	// records the states, and later polls send the changes.
+	EmitInitial bool
+}
+
+// Watcher polls the builds, App Store versions and review submissions of apps, and sends an event each time
+// one of them changes state. It remembers the last state it sent for each resource returned by the latest poll,
+// so a state is only sent once, and repeated identical poll failures are only sent once. It serves as a fallback
+// until webhooks are configured; see package webhook.
+type Watcher struct {
+	client *Client
+	opts   WatcherOptions
+	// states are the states of the resources returned by the latest successful poll of each kind of resource
+	// of each app, by resource ID. A poll is seeded once it has an entry.
+	states map[string]map[string]string
+	errs   map[string]string
+}
+
+// NewWatcher returns a watcher of the resources selected by opts.
+func (c *Client) NewWatcher(opts WatcherOptions) *Watcher {
+	if !opts.Builds && !opts.Versions && !opts.ReviewSubmissions {
+		opts.Builds, opts.Versions, opts.ReviewSubmissions = true, true, true
+	}
+
+	if opts.Interval <= 0 {
+		opts.Interval = DefaultWatchInterval
+	}
+
+	if opts.Limit <= 0 {
+		opts.Limit = defaultWatchLimit
+	}
+
+	return &Watcher{
+		client: c,
+		opts:   opts,
+		states: make(map[string]map[string]string),
+		errs:   make(map[string]string),
+	}
+}
+
+// Watch polls every interval, starting immediately, and sends the changes it observes. The channel is closed
+// when ctx is done. The watcher keeps the states it has seen, so calling Watch again after the channel is
+// closed resumes without sending them again. Watch must not be called while a previous channel is open.
+func (w *Watcher) Watch(ctx context.Context) <-chan WatchEvent {
+	events := make(chan WatchEvent)
+
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(w.opts.Interval)
+		defer ticker.Stop()
+
+		for {
+			for _, event := range w.poll(ctx) {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+
+			select {
+			case <-ticker.C:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events
+}
+
+// poll polls every selected resource of every app once and returns the changes.
+func (w *Watcher) poll(ctx context.Context) []WatchEvent {
+	var events []WatchEvent
+
+	for _, appID := range w.opts.AppIDs {
+		if w.opts.Builds {
+			observed, err := w.pollBuilds(ctx, appID)
+			events = append(events, w.observe(ctx, WatchEventBuildState, appID, observed, err)...)
+		}
+
+		if w.opts.Versions {
+			observed, err := w.pollVersions(ctx, appID)
+			events = append(events, w.observe(ctx, WatchEventVersionState, appID, observed, err)...)
+		}
+
+		if w.opts.ReviewSubmissions {
+			observed, err := w.pollReviewSubmissions(ctx, appID)
+			events = append(events, w.observe(ctx, WatchEventReviewSubmissionState, appID, observed, err)...)
+		}
+	}
+
+	return events
+}
+
+// observe compares the states of a poll with the last ones sent, and returns the events of those that changed.
+func (w *Watcher) observe(ctx context.Context, typ WatchEventType, appID string, observed []WatchEvent, err error) []WatchEvent {
+	now := time.Now()
+	poll := string(typ) + "/" + appID
+
+	if err != nil {
+		if ctx.Err() != nil || w.errs[poll] == err.Error() {
+			return nil
+		}
+
+		w.errs[poll] = err.Error()
+
+		return []WatchEvent{{Type: typ, AppID: appID, Time: now, Err: err}}
+	}
+
+	delete(w.errs, poll)
+
+	previous, seeded := w.states[poll]
+	emit := seeded || w.opts.EmitInitial
+
+	// Only the resources of this poll are kept, so that those that dropped out of the limit are forgotten.
+	states := make(map[string]string, len(observed))
+	w.states[poll] = states
+
+	var events []WatchEvent
+
+	for _, event := range observed {
+		from, seen := previous[event.ID]
+		states[event.ID] = event.To
+
+		if seen && from == event.To {
+			continue
+		}
+
+		if emit {
+			event.Type = typ
+			event.AppID = appID
+			event.From = from
+			event.Time = now
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+func (w *Watcher) pollBuilds(ctx context.Context, appID string) ([]WatchEvent, error) {
+	res, _, err := w.client.Builds.ListBuilds(ctx, &ListBuildsQuery{
+		FilterApp: []string{appID},
+		Sort:      []string{"-uploadedDate"},
+		Limit:     w.opts.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	observed := make([]WatchEvent, len(res.Data))
+
+	for i := range res.Data {
+		build := res.Data[i]
+		observed[i] = WatchEvent{ID: build.ID, Build: &build}
+
+		if build.Attributes != nil && build.Attributes.ProcessingState != nil {
+			observed[i].To = *build.Attributes.ProcessingState
+		}
+	}
+
+	return observed, nil
+}
+
+func (w *Watcher) pollVersions(ctx context.Context, appID string) ([]WatchEvent, error) {
+	res, _, err := w.client.Apps.ListAppStoreVersionsForApp(ctx, appID, &ListAppStoreVersionsQuery{
+		Limit: w.opts.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	observed := make([]WatchEvent, len(res.Data))
+
+	for i := range res.Data {
+		version := res.Data[i]
+		observed[i] = WatchEvent{ID: version.ID, Version: &version}
+
+		if version.Attributes != nil && version.Attributes.AppStoreState != nil {
+			observed[i].To = string(*version.Attributes.AppStoreState)
+		}
+	}
+
+	return observed, nil
+}
+
+func (w *Watcher) pollReviewSubmissions(ctx context.Context, appID string) ([]WatchEvent, error) {
+	res, _, err := w.client.Submission.ListReviewSubmissions(ctx, &ListReviewSubmissionsQuery{
+		FilterApp: []string{appID},
+		Limit:     w.opts.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	observed := make([]WatchEvent, len(res.Data))
+
+	for i := range res.Data {
+		submission := res.Data[i]
+		observed[i] = WatchEvent{ID: submission.ID, ReviewSubmission: &submission}
+
+		if submission.Attributes != nil && submission.Attributes.State != nil {
+			observed[i].To = string(*submission.Attributes.State)
+		}
+	}
+
+	return observed, nil
+}
@@ -0,0 +1,260 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// watchedServer serves the builds, versions and review submissions of app APP with states that tests change.
+type watchedServer struct {
+	mu          sync.Mutex
+	builds      map[string]string
+	versions    map[string]string
+	submissions map[string]string
+	fail        bool
+}
+
+func (s *watchedServer) set(states map[string]string, id string, state string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	states[id] = state
+}
+
+func (s *watchedServer) remove(states map[string]string, id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(states, id)
+}
+
+func (s *watchedServer) failing(fail bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.fail = fail
+}
+
+func (s *watchedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		fmt.Fprintln(w, `{"errors":[{"status":"503"}]}`)
+
+		return
+	}
+
+	var (
+		typ, attribute string
+		states         map[string]string
+	)
+
+	switch r.URL.Path {
+	case "/builds":
+		typ, attribute, states = "builds", "processingState", s.builds
+	case "/apps/APP/appStoreVersions":
+		typ, attribute, states = "appStoreVersions", "appStoreState", s.versions
+	case "/reviewSubmissions":
+		typ, attribute, states = "reviewSubmissions", "state", s.submissions
+	default:
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	data := ""
+
+	for _, id := range []string{"1", "2", "3"} {
+		if state, ok := states[id]; ok {
+			if data != "" {
+				data += ","
+			}
+
+			data += fmt.Sprintf(`{"type":%q,"id":%q,"attributes":{%q:%q}}`, typ, id, attribute, state)
+		}
+	}
+
+	fmt.Fprintf(w, `{"data":[%s]}`, data)
+}
+
+func newWatchedServer(t *testing.T) (*Client, *watchedServer) {
+	t.Helper()
+
+	watched := &watchedServer{
+		builds:      map[string]string{"1": "PROCESSING"},
+		versions:    map[string]string{"1": "PREPARE_FOR_SUBMISSION"},
+		submissions: map[string]string{},
+	}
+
+	server := httptest.NewServer(watched)
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client, watched
+}
+
+func TestWatcherPoll(t *testing.T) {
+	t.Parallel()
+
+	client, server := newWatchedServer(t)
+	watcher := client.NewWatcher(WatcherOptions{AppIDs: []string{"APP"}})
+	ctx := context.Background()
+
+	assert.Empty(t, watcher.poll(ctx))
+	assert.Empty(t, watcher.poll(ctx))
+
+	server.set(server.builds, "1", "VALID")
+	server.set(server.builds, "2", "PROCESSING")
+	server.set(server.versions, "1", "WAITING_FOR_REVIEW")
+	server.set(server.submissions, "1", "WAITING_FOR_REVIEW")
+
+	events := watcher.poll(ctx)
+	assert.Len(t, events, 4)
+	assert.Equal(t, WatchEventBuildState, events[0].Type)
+	assert.Equal(t, "APP", events[0].AppID)
+	assert.Equal(t, "1", events[0].ID)
+	assert.Equal(t, "PROCESSING", events[0].From)
+	assert.Equal(t, "VALID", events[0].To)
+	assert.Equal(t, "1", events[0].Build.ID)
+	assert.Equal(t, "2", events[1].ID)
+	assert.Equal(t, "", events[1].From)
+	assert.Equal(t, WatchEventVersionState, events[2].Type)
+	assert.Equal(t, "WAITING_FOR_REVIEW", events[2].To)
+	assert.Equal(t, "1", events[2].Version.ID)
+	assert.Equal(t, WatchEventReviewSubmissionState, events[3].Type)
+	assert.Equal(t, "1", events[3].ReviewSubmission.ID)
+
+	assert.Empty(t, watcher.poll(ctx))
+
+	server.failing(true)
+
+	events = watcher.poll(ctx)
+	assert.Len(t, events, 3)
+
+	var errResponse *ErrorResponse
+	assert.True(t, errors.As(events[0].Err, &errResponse))
+	assert.Equal(t, WatchEventBuildState, events[0].Type)
+	assert.Empty(t, events[0].ID)
+	assert.Empty(t, watcher.poll(ctx), "repeated failures are sent once")
+
+	server.failing(false)
+	server.set(server.submissions, "1", "COMPLETE")
+
+	events = watcher.poll(ctx)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "WAITING_FOR_REVIEW", events[0].From)
+	assert.Equal(t, "COMPLETE", events[0].To)
+}
+
+func TestWatcherForgetsResourcesOutOfPoll(t *testing.T) {
+	t.Parallel()
+
+	client, server := newWatchedServer(t)
+	watcher := client.NewWatcher(WatcherOptions{AppIDs: []string{"APP"}, Builds: true})
+	ctx := context.Background()
+
+	server.set(server.builds, "2", "PROCESSING")
+	assert.Empty(t, watcher.poll(ctx))
+	assert.Equal(t, map[string]string{"1": "PROCESSING", "2": "PROCESSING"}, watcher.states["BUILD_STATE/APP"])
+
+	server.remove(server.builds, "1")
+	server.set(server.builds, "3", "PROCESSING")
+
+	events := watcher.poll(ctx)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "3", events[0].ID)
+	assert.Equal(t, map[string]string{"2": "PROCESSING", "3": "PROCESSING"}, watcher.states["BUILD_STATE/APP"])
+}
+
+func TestWatcherEmitInitial(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newWatchedServer(t)
+	watcher := client.NewWatcher(WatcherOptions{AppIDs: []string{"APP"}, Versions: true, EmitInitial: true})
+
+	events := watcher.poll(context.Background())
+	assert.Len(t, events, 1)
+	assert.Equal(t, WatchEventVersionState, events[0].Type)
+	assert.Equal(t, "", events[0].From)
+	assert.Equal(t, "PREPARE_FOR_SUBMISSION", events[0].To)
+}
+
+func TestWatcherWatch(t *testing.T) {
+	t.Parallel()
+
+	client, server := newWatchedServer(t)
+	watcher := client.NewWatcher(WatcherOptions{AppIDs: []string{"APP"}, Builds: true, Interval: 5 * time.Millisecond, EmitInitial: true})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events := watcher.Watch(ctx)
+
+	event := <-events
+	assert.Equal(t, "PROCESSING", event.To)
+
+	server.set(server.builds, "1", "VALID")
+
+	event = <-events
+	assert.Equal(t, "1", event.ID)
+	assert.Equal(t, "PROCESSING", event.From)
+	assert.Equal(t, "VALID", event.To)
+
+	cancel()
+
+	for range events {
+	}
+}
+
+func TestNewWatcherDefaults(t *testing.T) {
+	t.Parallel()
+
+	watcher := NewClient(nil).NewWatcher(WatcherOptions{})
+	assert.True(t, watcher.opts.Builds)
+	assert.True(t, watcher.opts.Versions)
+	assert.True(t, watcher.opts.ReviewSubmissions)
+	assert.Equal(t, DefaultWatchInterval, watcher.opts.Interval)
+	assert.Equal(t, defaultWatchLimit, watcher.opts.Limit)
+}
+
+func TestParseWatchEventType(t *testing.T) {
+	t.Parallel()
+
+	typ, err := ParseWatchEventType("BUILD_STATE")
+	assert.NoError(t, err)
+	assert.Equal(t, WatchEventBuildState, typ)
+
+	_, err = ParseWatchEventType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "WatchEventType", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,287 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package webhook receives the webhook notifications App Store Connect sends to your server when the state of
+// an app version, a build or another resource changes.
+//
+// Each notification is signed with the secret configured on the webhook. Handler verifies the signature,
+// decodes the event and passes it to your callback, where the typed payload can be switched on:
+//
+//	http.Handle("/asc", webhook.NewHandler(secret, func(ctx context.Context, event *webhook.Event) error {
+//		switch payload := event.Payload.(type) {
+//		case *webhook.BuildUploadStateUpdated:
+//			log.Printf("build upload %s is now %s", payload.BuildUploadID, payload.NewState)
+//		case *webhook.AppStoreVersionStateUpdated:
+//			log.Printf("version %s is now %s", payload.AppStoreVersionID, payload.NewValue)
+//		}
+//
+//		return nil
+//	}))
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhook-notifications
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lingjiawen/asc"
+)
+
+// SignatureHeader is the header App Store Connect puts the signature of a notification in.
+const SignatureHeader = "X-Apple-SIGNATURE"
+
+// signaturePrefix names the algorithm used to compute the signature.
+const signaturePrefix = "hmacsha256="
+
+// maxBodySize bounds the size of a notification Handler reads.
+const maxBodySize = 1 << 20
+
+// ErrInvalidSignature happens when the signature of a notification is missing or doesn't match its body.
+var ErrInvalidSignature = errors.New("webhook: invalid signature")
+
+// EventType defines model for the type of a webhook notification.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/webhookeventtype
+type EventType string
+
+const (
+	// EventTypeAppStoreVersionStateUpdated is sent when the state of an app version changes.
+	EventTypeAppStoreVersionStateUpdated EventType = "appStoreVersionAppVersionStateUpdated"
+	// EventTypeBuildBetaStateUpdated is sent when the external TestFlight state of a build changes.
+	EventTypeBuildBetaStateUpdated EventType = "buildBetaDetailExternalBuildStateUpdated"
+	// EventTypeBuildUploadStateUpdated is sent when the processing state of an uploaded build changes.
+	EventTypeBuildUploadStateUpdated EventType = "buildUploadStateUpdated"
+	// EventTypePing is sent when a test notification is requested for the webhook.
+	EventTypePing EventType = "webhookPingCreated"
+)
+
+// Event is a decoded webhook notification.
+//
+// Payload holds a *AppStoreVersionStateUpdated, *BuildBetaStateUpdated, *BuildUploadStateUpdated or *Ping
+// depending on Type, or nil for event types this package doesn't know about, in which case the undecoded
+// attributes are available in Attributes.
+type Event struct {
+	ID         string
+	Type       EventType
+	Version    int
+	Timestamp  time.Time
+	Instance   *asc.RelationshipData
+	Attributes json.RawMessage
+	Payload    interface{}
+}
+
+// AppStoreVersionStateUpdated is the payload of an EventTypeAppStoreVersionStateUpdated event.
+type AppStoreVersionStateUpdated struct {
+	AppStoreVersionID string
+	OldValue          string
+	NewValue          string
+}
+
+// BuildBetaStateUpdated is the payload of an EventTypeBuildBetaStateUpdated event.
+type BuildBetaStateUpdated struct {
+	BuildBetaDetailID string
+	OldState          asc.ExternalBetaState
+	NewState          asc.ExternalBetaState
+}
+
+// BuildUploadStateUpdated is the payload of an EventTypeBuildUploadStateUpdated event.
+type BuildUploadStateUpdated struct {
+	BuildUploadID string
+	OldState      string
+	NewState      string
+}
+
+// Ping is the payload of an EventTypePing event.
+type Ping struct{}
+
+// notification is the body of a webhook notification.
+type notification struct {
+	Data struct {
+		Attributes    json.RawMessage `json:"attributes"`
+		ID            string          `json:"id"`
+		Relationships struct {
+			Instance *asc.Relationship `json:"instance"`
+		} `json:"relationships"`
+		Type    EventType `json:"type"`
+		Version int       `json:"version"`
+	} `json:"data"`
+}
+
+// stateAttributes are the attributes shared by the state change events.
+type stateAttributes struct {
+	NewState  string    `json:"newState"`
+	NewValue  string    `json:"newValue"`
+	OldState  string    `json:"oldState"`
+	OldValue  string    `json:"oldValue"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// Sign computes the value of the SignatureHeader App Store Connect sends with body, for a webhook configured
+// with secret.
+func Sign(body []byte, secret []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	return signaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks that signature, the value of the SignatureHeader of a notification, was computed from body with
+// secret. It returns ErrInvalidSignature if it wasn't.
+func Verify(body []byte, signature string, secret []byte) error {
+	if !strings.HasPrefix(strings.ToLower(signature), signaturePrefix) {
+		return ErrInvalidSignature
+	}
+
+	got, err := hex.DecodeString(signature[len(signaturePrefix):])
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(body)
+
+	if !hmac.Equal(got, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+// Parse decodes the body of a notification into an Event. It doesn't verify the signature of the notification.
+func Parse(body []byte) (*Event, error) {
+	var n notification
+	if err := json.Unmarshal(body, &n); err != nil {
+		return nil, fmt.Errorf("webhook: failed to decode notification: %w", err)
+	}
+
+	event := &Event{
+		ID:         n.Data.ID,
+		Type:       n.Data.Type,
+		Version:    n.Data.Version,
+		Attributes: n.Data.Attributes,
+	}
+
+	if n.Data.Relationships.Instance != nil {
+		event.Instance = n.Data.Relationships.Instance.Data
+	}
+
+	var attributes stateAttributes
+	if len(n.Data.Attributes) > 0 {
+		if err := json.Unmarshal(n.Data.Attributes, &attributes); err != nil {
+			return nil, fmt.Errorf("webhook: failed to decode %s attributes: %w", event.Type, err)
+		}
+	}
+
+	event.Timestamp = attributes.Timestamp
+	instanceID := ""
+
+	if event.Instance != nil {
+		instanceID = event.Instance.ID
+	}
+
+	switch event.Type {
+	case EventTypeAppStoreVersionStateUpdated:
+		event.Payload = &AppStoreVersionStateUpdated{
+			AppStoreVersionID: instanceID,
+			OldValue:          attributes.OldValue,
+			NewValue:          attributes.NewValue,
+		}
+	case EventTypeBuildBetaStateUpdated:
+		event.Payload = &BuildBetaStateUpdated{
+			BuildBetaDetailID: instanceID,
+			OldState:          asc.ExternalBetaState(attributes.OldState),
+			NewState:          asc.ExternalBetaState(attributes.NewState),
+		}
+	case EventTypeBuildUploadStateUpdated:
+		event.Payload = &BuildUploadStateUpdated{
+			BuildUploadID: instanceID,
+			OldState:      attributes.OldState,
+			NewState:      attributes.NewState,
+		}
+	case EventTypePing:
+		event.Payload = &Ping{}
+	}
+
+	return event, nil
+}
+
+// Handler is an http.Handler that receives webhook notifications.
+type Handler struct {
+	secret  []byte
+	onEvent func(ctx context.Context, event *Event) error
+}
+
+// NewHandler returns a Handler that verifies notifications with secret and passes their events to onEvent.
+//
+// The handler responds 401 to notifications with an invalid signature, 400 to notifications it can't decode,
+// and 500 when onEvent returns an error, so that App Store Connect records the delivery as failed.
+func NewHandler(secret []byte, onEvent func(ctx context.Context, event *Event) error) *Handler {
+	return &Handler{
+		secret:  secret,
+		onEvent: onEvent,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize))
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+
+		return
+	}
+
+	if err := Verify(body, r.Header.Get(SignatureHeader), h.secret); err != nil {
+		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+
+		return
+	}
+
+	event, err := Parse(body)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+
+		return
+	}
+
+	if err := h.onEvent(r.Context(), event); err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,166 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lingjiawen/asc"
+	"github.com/stretchr/testify/assert"
+)
+
+var testSecret = []byte("TEST")
+
+func newNotification(eventType EventType, instanceType string, attributes string) string {
+	return `{"data":{"type":"` + string(eventType) + `","id":"EVENT","version":1,"attributes":` + attributes +
+		`,"relationships":{"instance":{"data":{"type":"` + instanceType + `","id":"10"}}}}}`
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`{"data":{}}`)
+	signature := Sign(body, testSecret)
+
+	assert.True(t, strings.HasPrefix(signature, "hmacsha256="))
+	assert.NoError(t, Verify(body, signature, testSecret))
+	assert.NoError(t, Verify(body, strings.ToUpper(signature[:10])+signature[10:], testSecret))
+	assert.ErrorIs(t, Verify(body, signature, []byte("OTHER")), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify([]byte(`{}`), signature, testSecret), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify(body, "", testSecret), ErrInvalidSignature)
+	assert.ErrorIs(t, Verify(body, "hmacsha256=zz", testSecret), ErrInvalidSignature)
+}
+
+func TestParseAppStoreVersionStateUpdated(t *testing.T) {
+	t.Parallel()
+
+	event, err := Parse([]byte(newNotification(EventTypeAppStoreVersionStateUpdated, "appStoreVersions",
+		`{"oldValue":"WAITING_FOR_REVIEW","newValue":"IN_REVIEW","timestamp":"2024-01-02T03:04:05Z"}`)))
+	assert.NoError(t, err)
+	assert.Equal(t, "EVENT", event.ID)
+	assert.Equal(t, 1, event.Version)
+	assert.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), event.Timestamp)
+	assert.Equal(t, &asc.RelationshipData{ID: "10", Type: "appStoreVersions"}, event.Instance)
+	assert.Equal(t, &AppStoreVersionStateUpdated{
+		AppStoreVersionID: "10",
+		OldValue:          "WAITING_FOR_REVIEW",
+		NewValue:          "IN_REVIEW",
+	}, event.Payload)
+}
+
+func TestParseBuildBetaStateUpdated(t *testing.T) {
+	t.Parallel()
+
+	event, err := Parse([]byte(newNotification(EventTypeBuildBetaStateUpdated, "buildBetaDetails",
+		`{"oldState":"IN_BETA_REVIEW","newState":"BETA_APPROVED"}`)))
+	assert.NoError(t, err)
+	assert.Equal(t, &BuildBetaStateUpdated{
+		BuildBetaDetailID: "10",
+		OldState:          asc.ExternalBetaStateInReview,
+		NewState:          asc.ExternalBetaStateApproved,
+	}, event.Payload)
+}
+
+func TestParseBuildUploadStateUpdated(t *testing.T) {
+	t.Parallel()
+
+	event, err := Parse([]byte(newNotification(EventTypeBuildUploadStateUpdated, "buildUploads",
+		`{"oldState":"PROCESSING","newState":"COMPLETE"}`)))
+	assert.NoError(t, err)
+	assert.Equal(t, &BuildUploadStateUpdated{
+		BuildUploadID: "10",
+		OldState:      "PROCESSING",
+		NewState:      "COMPLETE",
+	}, event.Payload)
+}
+
+func TestParsePing(t *testing.T) {
+	t.Parallel()
+
+	event, err := Parse([]byte(`{"data":{"type":"webhookPingCreated","id":"EVENT","version":1,"attributes":{"timestamp":"2024-01-02T03:04:05Z"}}}`))
+	assert.NoError(t, err)
+	assert.Nil(t, event.Instance)
+	assert.Equal(t, &Ping{}, event.Payload)
+}
+
+func TestParseUnknownEvent(t *testing.T) {
+	t.Parallel()
+
+	event, err := Parse([]byte(newNotification("somethingNew", "things", `{"field":"value"}`)))
+	assert.NoError(t, err)
+	assert.Equal(t, EventType("somethingNew"), event.Type)
+	assert.Nil(t, event.Payload)
+	assert.JSONEq(t, `{"field":"value"}`, string(event.Attributes))
+}
+
+func TestParseError(t *testing.T) {
+	t.Parallel()
+
+	_, err := Parse([]byte(`{`))
+	assert.Error(t, err)
+
+	_, err = Parse([]byte(`{"data":{"type":"buildUploadStateUpdated","attributes":{"timestamp":1}}}`))
+	assert.Error(t, err)
+}
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	body := newNotification(EventTypeBuildUploadStateUpdated, "buildUploads", `{"oldState":"PROCESSING","newState":"COMPLETE"}`)
+
+	var got *Event
+
+	handler := NewHandler(testSecret, func(ctx context.Context, event *Event) error {
+		got = event
+
+		if event.Type == EventTypePing {
+			return errors.New("")
+		}
+
+		return nil
+	})
+
+	serve := func(method string, body string, signature string) int {
+		r := httptest.NewRequest(method, "/", strings.NewReader(body))
+		r.Header.Set(SignatureHeader, signature)
+
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+
+		return w.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve(http.MethodPost, body, Sign([]byte(body), testSecret)))
+	assert.IsType(t, &BuildUploadStateUpdated{}, got.Payload)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, serve(http.MethodGet, body, Sign([]byte(body), testSecret)))
+	assert.Equal(t, http.StatusUnauthorized, serve(http.MethodPost, body, Sign([]byte(body), []byte("OTHER"))))
+	assert.Equal(t, http.StatusBadRequest, serve(http.MethodPost, "{", Sign([]byte("{"), testSecret)))
+
+	ping := `{"data":{"type":"webhookPingCreated","id":"EVENT","version":1}}`
+	assert.Equal(t, http.StatusInternalServerError, serve(http.MethodPost, ping, Sign([]byte(ping), testSecret)))
+}
@@ -0,0 +1,588 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+)
+
+// ErrMissingCiArtifactDownloadURL happens when an Xcode Cloud artifact has no download URL, such as when it has
+// expired.
+var ErrMissingCiArtifactDownloadURL = errors.New("artifact has no download url")
+
+// CiArtifactFileType defines model for CiArtifact.Attributes.FileType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciartifact/attributes
+type CiArtifactFileType string
+
+const (
+	// CiArtifactFileTypeArchive is an Xcode archive.
+	CiArtifactFileTypeArchive CiArtifactFileType = "ARCHIVE"
+	// CiArtifactFileTypeArchiveExport is an app exported from an Xcode archive.
+	CiArtifactFileTypeArchiveExport CiArtifactFileType = "ARCHIVE_EXPORT"
+	// CiArtifactFileTypeLogBundle is a bundle of the logs of an action.
+	CiArtifactFileTypeLogBundle CiArtifactFileType = "LOG_BUNDLE"
+	// CiArtifactFileTypeResultBundle is an Xcode result bundle.
+	CiArtifactFileTypeResultBundle CiArtifactFileType = "RESULT_BUNDLE"
+	// CiArtifactFileTypeStapledNotarizedArchive is a notarized archive with its ticket stapled.
+	CiArtifactFileTypeStapledNotarizedArchive CiArtifactFileType = "STAPLED_NOTARIZED_ARCHIVE"
+	// CiArtifactFileTypeTestProducts is the products built for testing.
+	CiArtifactFileTypeTestProducts CiArtifactFileType = "TEST_PRODUCTS"
+	// CiArtifactFileTypeXcodebuildProducts is the products built by xcodebuild.
+	CiArtifactFileTypeXcodebuildProducts CiArtifactFileType = "XCODEBUILD_PRODUCTS"
+)
+
+var ciArtifactFileTypes = []CiArtifactFileType{
+	CiArtifactFileTypeArchive,
+	CiArtifactFileTypeArchiveExport,
+	CiArtifactFileTypeLogBundle,
+	CiArtifactFileTypeResultBundle,
+	CiArtifactFileTypeStapledNotarizedArchive,
+	CiArtifactFileTypeTestProducts,
+	CiArtifactFileTypeXcodebuildProducts,
+}
+
+// IsValid reports whether the ci artifact file type is one this package knows about.
+func (v CiArtifactFileType) IsValid() bool {
+	for _, value := range ciArtifactFileTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiArtifactFileType returns s as a CiArtifactFileType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiArtifactFileType(s string) (CiArtifactFileType, error) {
+	if v := CiArtifactFileType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiArtifactFileType", Value: s}
+}
+
+// CiIssueType defines model for CiIssue.Attributes.IssueType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissue/attributes
+type CiIssueType string
+
+const (
+	// CiIssueTypeAnalyzerWarning is a warning reported by the static analyzer.
+	CiIssueTypeAnalyzerWarning CiIssueType = "ANALYZER_WARNING"
+	// CiIssueTypeError is a build error.
+	CiIssueTypeError CiIssueType = "ERROR"
+	// CiIssueTypeTestFailure is a failed test.
+	CiIssueTypeTestFailure CiIssueType = "TEST_FAILURE"
+	// CiIssueTypeWarning is a build warning.
+	CiIssueTypeWarning CiIssueType = "WARNING"
+)
+
+var ciIssueTypes = []CiIssueType{
+	CiIssueTypeAnalyzerWarning,
+	CiIssueTypeError,
+	CiIssueTypeTestFailure,
+	CiIssueTypeWarning,
+}
+
+// IsValid reports whether the ci issue type is one this package knows about.
+func (v CiIssueType) IsValid() bool {
+	for _, value := range ciIssueTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiIssueType returns s as a CiIssueType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiIssueType(s string) (CiIssueType, error) {
+	if v := CiIssueType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiIssueType", Value: s}
+}
+
+// CiTestStatus defines model for CiTestStatus.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citeststatus
+type CiTestStatus string
+
+const (
+	// CiTestStatusExpectedFailure is a test that failed as expected.
+	CiTestStatusExpectedFailure CiTestStatus = "EXPECTED_FAILURE"
+	// CiTestStatusFailure is a test that failed.
+	CiTestStatusFailure CiTestStatus = "FAILURE"
+	// CiTestStatusMixed is a test that passed on some destinations and failed on others.
+	CiTestStatusMixed CiTestStatus = "MIXED"
+	// CiTestStatusSkipped is a test that was skipped.
+	CiTestStatusSkipped CiTestStatus = "SKIPPED"
+	// CiTestStatusSuccess is a test that passed.
+	CiTestStatusSuccess CiTestStatus = "SUCCESS"
+)
+
+var ciTestStatuses = []CiTestStatus{
+	CiTestStatusExpectedFailure,
+	CiTestStatusFailure,
+	CiTestStatusMixed,
+	CiTestStatusSkipped,
+	CiTestStatusSuccess,
+}
+
+// IsValid reports whether the ci test status is one this package knows about.
+func (v CiTestStatus) IsValid() bool {
+	for _, value := range ciTestStatuses {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiTestStatus returns s as a CiTestStatus, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiTestStatus(s string) (CiTestStatus, error) {
+	if v := CiTestStatus(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiTestStatus", Value: s}
+}
+
+// FileLocation defines model for FileLocation.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/filelocation
+type FileLocation struct {
+	LineNumber *int    `json:"lineNumber,omitempty"`
+	Path       *string `json:"path,omitempty"`
+}
+
+// CiBuildAction defines model for CiBuildAction.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildaction
+type CiBuildAction struct {
+	Attributes    *CiBuildActionAttributes    `json:"attributes,omitempty"`
+	ID            string                      `json:"id"`
+	Links         ResourceLinks               `json:"links"`
+	Relationships *CiBuildActionRelationships `json:"relationships,omitempty"`
+	Type          string                      `json:"type"`
+}
+
+// CiBuildActionAttributes defines model for CiBuildAction.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildaction/attributes
+type CiBuildActionAttributes struct {
+	ActionType        *CiActionType        `json:"actionType,omitempty"`
+	CompletionStatus  *CiCompletionStatus  `json:"completionStatus,omitempty"`
+	ExecutionProgress *CiExecutionProgress `json:"executionProgress,omitempty"`
+	FinishedDate      *DateTime            `json:"finishedDate,omitempty"`
+	IsRequiredToPass  *bool                `json:"isRequiredToPass,omitempty"`
+	IssueCounts       *CiIssueCounts       `json:"issueCounts,omitempty"`
+	Name              *string              `json:"name,omitempty"`
+	StartedDate       *DateTime            `json:"startedDate,omitempty"`
+}
+
+// CiBuildActionRelationships defines model for CiBuildAction.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildaction/relationships
+type CiBuildActionRelationships struct {
+	Artifacts   *PagedRelationship `json:"artifacts,omitempty"`
+	BuildRun    *Relationship      `json:"buildRun,omitempty"`
+	Issues      *PagedRelationship `json:"issues,omitempty"`
+	TestResults *PagedRelationship `json:"testResults,omitempty"`
+}
+
+// CiBuildActionResponse defines model for CiBuildActionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildactionresponse
+type CiBuildActionResponse struct {
+	Data     CiBuildAction                   `json:"data"`
+	Included []CiBuildActionResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                   `json:"links"`
+}
+
+// CiBuildActionsResponse defines model for CiBuildActionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildactionsresponse
+type CiBuildActionsResponse struct {
+	Data     []CiBuildAction                 `json:"data"`
+	Included []CiBuildActionResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks              `json:"links"`
+	Meta     *PagingInformation              `json:"meta,omitempty"`
+}
+
+// CiBuildActionResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a CiBuildActionResponse or CiBuildActionsResponse.
+type CiBuildActionResponseIncluded included
+
+// CiArtifact defines model for CiArtifact.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciartifact
+type CiArtifact struct {
+	Attributes *CiArtifactAttributes `json:"attributes,omitempty"`
+	ID         string                `json:"id"`
+	Links      ResourceLinks         `json:"links"`
+	Type       string                `json:"type"`
+}
+
+// CiArtifactAttributes defines model for CiArtifact.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciartifact/attributes
+type CiArtifactAttributes struct {
+	DownloadURL *string             `json:"downloadUrl,omitempty"`
+	FileName    *string             `json:"fileName,omitempty"`
+	FileSize    *int64              `json:"fileSize,omitempty"`
+	FileType    *CiArtifactFileType `json:"fileType,omitempty"`
+}
+
+// CiArtifactResponse defines model for CiArtifactResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciartifactresponse
+type CiArtifactResponse struct {
+	Data  CiArtifact    `json:"data"`
+	Links DocumentLinks `json:"links"`
+}
+
+// CiArtifactsResponse defines model for CiArtifactsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciartifactsresponse
+type CiArtifactsResponse struct {
+	Data  []CiArtifact       `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// CiIssue defines model for CiIssue.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissue
+type CiIssue struct {
+	Attributes *CiIssueAttributes `json:"attributes,omitempty"`
+	ID         string             `json:"id"`
+	Links      ResourceLinks      `json:"links"`
+	Type       string             `json:"type"`
+}
+
+// CiIssueAttributes defines model for CiIssue.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissue/attributes
+type CiIssueAttributes struct {
+	Category   *string       `json:"category,omitempty"`
+	FileSource *FileLocation `json:"fileSource,omitempty"`
+	IssueType  *CiIssueType  `json:"issueType,omitempty"`
+	Message    *string       `json:"message,omitempty"`
+}
+
+// CiIssueResponse defines model for CiIssueResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissueresponse
+type CiIssueResponse struct {
+	Data  CiIssue       `json:"data"`
+	Links DocumentLinks `json:"links"`
+}
+
+// CiIssuesResponse defines model for CiIssuesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissuesresponse
+type CiIssuesResponse struct {
+	Data  []CiIssue          `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// CiTestResult defines model for CiTestResult.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestresult
+type CiTestResult struct {
+	Attributes *CiTestResultAttributes `json:"attributes,omitempty"`
+	ID         string                  `json:"id"`
+	Links      ResourceLinks           `json:"links"`
+	Type       string                  `json:"type"`
+}
+
+// CiTestResultAttributes defines model for CiTestResult.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestresult/attributes
+type CiTestResultAttributes struct {
+	ClassName              *string                   `json:"className,omitempty"`
+	DestinationTestResults []CiDestinationTestResult `json:"destinationTestResults,omitempty"`
+	FileSource             *FileLocation             `json:"fileSource,omitempty"`
+	Message                *string                   `json:"message,omitempty"`
+	Name                   *string                   `json:"name,omitempty"`
+	Status                 *CiTestStatus             `json:"status,omitempty"`
+}
+
+// CiDestinationTestResult defines model for CiTestResult.Attributes.DestinationTestResults.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestresult/attributes/destinationtestresults
+type CiDestinationTestResult struct {
+	DeviceName *string       `json:"deviceName,omitempty"`
+	Duration   *float64      `json:"duration,omitempty"`
+	OSVersion  *string       `json:"osVersion,omitempty"`
+	Status     *CiTestStatus `json:"status,omitempty"`
+	UUID       *string       `json:"uuid,omitempty"`
+}
+
+// CiTestResultResponse defines model for CiTestResultResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestresultresponse
+type CiTestResultResponse struct {
+	Data  CiTestResult  `json:"data"`
+	Links DocumentLinks `json:"links"`
+}
+
+// CiTestResultsResponse defines model for CiTestResultsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestresultsresponse
+type CiTestResultsResponse struct {
+	Data  []CiTestResult     `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// ListCiBuildActionsForCiBuildRunQuery are query options for ListCiBuildActionsForCiBuildRun
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_actions_for_a_build_run
+type ListCiBuildActionsForCiBuildRunQuery struct {
+	FieldsCiBuildActions []string `url:"fields[ciBuildActions],omitempty"`
+	FieldsCiBuildRuns    []string `url:"fields[ciBuildRuns],omitempty"`
+	Include              []string `url:"include,omitempty"`
+	Limit                int      `url:"limit,omitempty"`
+	Cursor               string   `url:"cursor,omitempty"`
+}
+
+// GetCiBuildActionQuery are query options for GetCiBuildAction
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_build_action_information
+type GetCiBuildActionQuery struct {
+	FieldsCiBuildActions []string `url:"fields[ciBuildActions],omitempty"`
+	FieldsCiBuildRuns    []string `url:"fields[ciBuildRuns],omitempty"`
+	Include              []string `url:"include,omitempty"`
+}
+
+// ListCiArtifactsForCiBuildActionQuery are query options for ListCiArtifactsForCiBuildAction
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_artifacts_for_a_build_action
+type ListCiArtifactsForCiBuildActionQuery struct {
+	FieldsCiArtifacts []string `url:"fields[ciArtifacts],omitempty"`
+	Limit             int      `url:"limit,omitempty"`
+	Cursor            string   `url:"cursor,omitempty"`
+}
+
+// GetCiArtifactQuery are query options for GetCiArtifact
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_artifact_information
+type GetCiArtifactQuery struct {
+	FieldsCiArtifacts []string `url:"fields[ciArtifacts],omitempty"`
+}
+
+// ListCiIssuesForCiBuildActionQuery are query options for ListCiIssuesForCiBuildAction
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_issues_for_a_build_action
+type ListCiIssuesForCiBuildActionQuery struct {
+	FieldsCiIssues []string `url:"fields[ciIssues],omitempty"`
+	Limit          int      `url:"limit,omitempty"`
+	Cursor         string   `url:"cursor,omitempty"`
+}
+
+// GetCiIssueQuery are query options for GetCiIssue
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_issue_information
+type GetCiIssueQuery struct {
+	FieldsCiIssues []string `url:"fields[ciIssues],omitempty"`
+}
+
+// ListCiTestResultsForCiBuildActionQuery are query options for ListCiTestResultsForCiBuildAction
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_test_results_for_a_build_action
+type ListCiTestResultsForCiBuildActionQuery struct {
+	FieldsCiTestResults []string `url:"fields[ciTestResults],omitempty"`
+	Limit               int      `url:"limit,omitempty"`
+	Cursor              string   `url:"cursor,omitempty"`
+}
+
+// GetCiTestResultQuery are query options for GetCiTestResult
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_cloud_test_result_information
+type GetCiTestResultQuery struct {
+	FieldsCiTestResults []string `url:"fields[ciTestResults],omitempty"`
+}
+
+// ListCiBuildActionsForCiBuildRun lists the actions an Xcode Cloud build run performed.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_actions_for_a_build_run
+func (s *XcodeCloudService) ListCiBuildActionsForCiBuildRun(ctx context.Context, id string, params *ListCiBuildActionsForCiBuildRunQuery) (*CiBuildActionsResponse, *Response, error) {
+	return Get[CiBuildActionsResponse](ctx, s.client, fmt.Sprintf("ciBuildRuns/%s/actions", id), params)
+}
+
+// GetCiBuildAction gets information about an action of an Xcode Cloud build run.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_build_action_information
+func (s *XcodeCloudService) GetCiBuildAction(ctx context.Context, id string, params *GetCiBuildActionQuery) (*CiBuildActionResponse, *Response, error) {
+	return Get[CiBuildActionResponse](ctx, s.client, fmt.Sprintf("ciBuildActions/%s", id), params)
+}
+
+// ListCiArtifactsForCiBuildAction lists the artifacts, such as archives and logs, that an Xcode Cloud build action produced.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_artifacts_for_a_build_action
+func (s *XcodeCloudService) ListCiArtifactsForCiBuildAction(ctx context.Context, id string, params *ListCiArtifactsForCiBuildActionQuery) (*CiArtifactsResponse, *Response, error) {
+	return Get[CiArtifactsResponse](ctx, s.client, fmt.Sprintf("ciBuildActions/%s/artifacts", id), params)
+}
+
+// GetCiArtifact gets information about an Xcode Cloud artifact, including the URL it can be downloaded from.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_artifact_information
+func (s *XcodeCloudService) GetCiArtifact(ctx context.Context, id string, params *GetCiArtifactQuery) (*CiArtifactResponse, *Response, error) {
+	return Get[CiArtifactResponse](ctx, s.client, fmt.Sprintf("ciArtifacts/%s", id), params)
+}
+
+// ListCiIssuesForCiBuildAction lists the errors, warnings and test failures of an Xcode Cloud build action.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_issues_for_a_build_action
+func (s *XcodeCloudService) ListCiIssuesForCiBuildAction(ctx context.Context, id string, params *ListCiIssuesForCiBuildActionQuery) (*CiIssuesResponse, *Response, error) {
+	return Get[CiIssuesResponse](ctx, s.client, fmt.Sprintf("ciBuildActions/%s/issues", id), params)
+}
+
+// GetCiIssue gets information about an issue of an Xcode Cloud build action.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_issue_information
+func (s *XcodeCloudService) GetCiIssue(ctx context.Context, id string, params *GetCiIssueQuery) (*CiIssueResponse, *Response, error) {
+	return Get[CiIssueResponse](ctx, s.client, fmt.Sprintf("ciIssues/%s", id), params)
+}
+
+// ListCiTestResultsForCiBuildAction lists the results of the tests an Xcode Cloud build action ran.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_test_results_for_a_build_action
+func (s *XcodeCloudService) ListCiTestResultsForCiBuildAction(ctx context.Context, id string, params *ListCiTestResultsForCiBuildActionQuery) (*CiTestResultsResponse, *Response, error) {
+	return Get[CiTestResultsResponse](ctx, s.client, fmt.Sprintf("ciBuildActions/%s/testResults", id), params)
+}
+
+// GetCiTestResult gets information about the result of a test an Xcode Cloud build action ran.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_cloud_test_result_information
+func (s *XcodeCloudService) GetCiTestResult(ctx context.Context, id string, params *GetCiTestResultQuery) (*CiTestResultResponse, *Response, error) {
+	return Get[CiTestResultResponse](ctx, s.client, fmt.Sprintf("ciTestResults/%s", id), params)
+}
+
+// DownloadCiArtifact resolves the download URL of an Xcode Cloud artifact and streams its contents to w.
+func (s *XcodeCloudService) DownloadCiArtifact(ctx context.Context, id string, w io.Writer) (*Response, error) {
+	artifact, resp, err := s.GetCiArtifact(ctx, id, nil)
+	if err != nil {
+		return resp, err
+	}
+
+	url, err := ciArtifactDownloadURL(&artifact.Data)
+	if err != nil {
+		return resp, err
+	}
+
+	return s.client.get(ctx, url, nil, w)
+}
+
+// DownloadCiArtifactsForCiBuildRun downloads every artifact of every action of an Xcode Cloud build run into dir,
+// in a subdirectory per action, and returns the paths of the files written. Artifacts without a download URL
+// are skipped.
+func (s *XcodeCloudService) DownloadCiArtifactsForCiBuildRun(ctx context.Context, buildRunID string, dir string) ([]string, error) {
+	actions, err := s.listAllCiBuildActions(ctx, buildRunID)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0)
+
+	for _, action := range actions {
+		params := &ListCiArtifactsForCiBuildActionQuery{Limit: MaxPageLimit}
+
+		err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+			params.Cursor = cursor
+
+			artifacts, _, err := s.ListCiArtifactsForCiBuildAction(ctx, action.ID, params)
+			if err != nil {
+				return nil, err
+			}
+
+			for i := range artifacts.Data {
+				artifact := &artifacts.Data[i]
+
+				url, err := ciArtifactDownloadURL(artifact)
+				if errors.Is(err, ErrMissingCiArtifactDownloadURL) {
+					continue
+				}
+
+				path := filepath.Join(dir, action.ID, ciArtifactFileName(artifact))
+				if _, err := s.client.downloadFile(ctx, url, path); err != nil {
+					return nil, err
+				}
+
+				paths = append(paths, path)
+			}
+
+			return &artifacts.Links, nil
+		})
+		if err != nil {
+			return paths, err
+		}
+	}
+
+	return paths, nil
+}
+
+func (s *XcodeCloudService) listAllCiBuildActions(ctx context.Context, buildRunID string) ([]CiBuildAction, error) {
+	url := fmt.Sprintf("ciBuildRuns/%s/actions", buildRunID)
+	actions, _, err := List[CiBuildAction](ctx, s.client, url, &ListCiBuildActionsForCiBuildRunQuery{Limit: MaxPageLimit})
+
+	return actions, err
+}
+
+func ciArtifactDownloadURL(artifact *CiArtifact) (string, error) {
+	if artifact.Attributes == nil || artifact.Attributes.DownloadURL == nil || *artifact.Attributes.DownloadURL == "" {
+		return "", fmt.Errorf("%w: %s", ErrMissingCiArtifactDownloadURL, artifact.ID)
+	}
+
+	return *artifact.Attributes.DownloadURL, nil
+}
+
+func ciArtifactFileName(artifact *CiArtifact) string {
+	if artifact.Attributes != nil && artifact.Attributes.FileName != nil && *artifact.Attributes.FileName != "" {
+		return filepath.Base(*artifact.Attributes.FileName)
+	}
+
+	return artifact.ID
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in CiBuildActionResponseIncluded.
+func (i *CiBuildActionResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// CiBuildRun returns the CiBuildRun stored within, if one is present.
+func (i *CiBuildActionResponseIncluded) CiBuildRun() *CiBuildRun {
+	return extractIncludedCiBuildRun(i.inner)
+}
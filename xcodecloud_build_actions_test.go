@@ -0,0 +1,215 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCiBuildActionsForCiBuildRun(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiBuildActionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiBuildActionsForCiBuildRun(ctx, "10", &ListCiBuildActionsForCiBuildRunQuery{})
+	})
+}
+
+func TestGetCiBuildAction(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiBuildActionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetCiBuildAction(ctx, "10", &GetCiBuildActionQuery{})
+	})
+}
+
+func TestGetCiBuildActionIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"ciBuildRuns"}]}`, func(ctx context.Context, client *Client) {
+		action, _, err := client.XcodeCloud.GetCiBuildAction(ctx, "10", &GetCiBuildActionQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, action.Included)
+
+		assert.NotNil(t, action.Included[0].CiBuildRun())
+	})
+}
+
+func TestListCiArtifactsForCiBuildAction(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiArtifactsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiArtifactsForCiBuildAction(ctx, "10", &ListCiArtifactsForCiBuildActionQuery{})
+	})
+}
+
+func TestGetCiArtifact(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiArtifactResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetCiArtifact(ctx, "10", &GetCiArtifactQuery{})
+	})
+}
+
+func TestListCiIssuesForCiBuildAction(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiIssuesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiIssuesForCiBuildAction(ctx, "10", &ListCiIssuesForCiBuildActionQuery{})
+	})
+}
+
+func TestGetCiIssue(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiIssueResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetCiIssue(ctx, "10", &GetCiIssueQuery{})
+	})
+}
+
+func TestListCiTestResultsForCiBuildAction(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiTestResultsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiTestResultsForCiBuildAction(ctx, "10", &ListCiTestResultsForCiBuildActionQuery{})
+	})
+}
+
+func TestGetCiTestResult(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiTestResultResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetCiTestResult(ctx, "10", &GetCiTestResultQuery{})
+	})
+}
+
+func newCiArtifactServer(t *testing.T) (*Client, *httptest.Server) {
+	t.Helper()
+
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ciArtifacts/1":
+			fmt.Fprintf(w, `{"data":{"id":"1","type":"ciArtifacts","attributes":{"fileName":"logs.zip","downloadUrl":"%s/files/1"}}}`, server.URL)
+		case "/ciArtifacts/2":
+			fmt.Fprintln(w, `{"data":{"id":"2","type":"ciArtifacts","attributes":{"fileName":"expired.zip"}}}`)
+		case "/ciBuildRuns/10/actions":
+			fmt.Fprintln(w, `{"data":[{"id":"a1","type":"ciBuildActions"}],"links":{}}`)
+		case "/ciBuildActions/a1/artifacts":
+			fmt.Fprintf(w, `{"data":[
+				{"id":"1","type":"ciArtifacts","attributes":{"fileName":"logs.zip","downloadUrl":"%s/files/1"}},
+				{"id":"2","type":"ciArtifacts","attributes":{"fileName":"expired.zip"}}
+			],"links":{}}`, server.URL)
+		case "/files/1":
+			fmt.Fprint(w, "ARTIFACT")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client, server
+}
+
+func TestDownloadCiArtifact(t *testing.T) {
+	t.Parallel()
+
+	client, server := newCiArtifactServer(t)
+	defer server.Close()
+
+	var buf bytes.Buffer
+
+	_, err := client.XcodeCloud.DownloadCiArtifact(context.Background(), "1", &buf)
+	assert.NoError(t, err)
+	assert.Equal(t, "ARTIFACT", buf.String())
+
+	_, err = client.XcodeCloud.DownloadCiArtifact(context.Background(), "2", &buf)
+	assert.ErrorIs(t, err, ErrMissingCiArtifactDownloadURL)
+}
+
+func TestDownloadCiArtifactsForCiBuildRun(t *testing.T) {
+	t.Parallel()
+
+	client, server := newCiArtifactServer(t)
+	defer server.Close()
+
+	dir := t.TempDir()
+
+	paths, err := client.XcodeCloud.DownloadCiArtifactsForCiBuildRun(context.Background(), "10", dir)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "a1", "logs.zip")}, paths)
+
+	b, err := os.ReadFile(paths[0])
+	assert.NoError(t, err)
+	assert.Equal(t, "ARTIFACT", string(b))
+}
+
+func TestCiArtifactFileName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "10", ciArtifactFileName(&CiArtifact{ID: "10"}))
+	assert.Equal(t, "b.zip", ciArtifactFileName(&CiArtifact{ID: "10", Attributes: &CiArtifactAttributes{FileName: String("../a/b.zip")}}))
+}
+
+func TestParseCiArtifactFileType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiArtifactFileType(string(CiArtifactFileTypeArchive))
+	assert.NoError(t, err)
+	assert.Equal(t, CiArtifactFileTypeArchive, got)
+
+	_, err = ParseCiArtifactFileType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiArtifactFileType", Value: "UNKNOWN"}, err)
+}
+
+func TestParseCiIssueType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiIssueType(string(CiIssueTypeAnalyzerWarning))
+	assert.NoError(t, err)
+	assert.Equal(t, CiIssueTypeAnalyzerWarning, got)
+
+	_, err = ParseCiIssueType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiIssueType", Value: "UNKNOWN"}, err)
+}
+
+func TestParseCiTestStatus(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiTestStatus(string(CiTestStatusExpectedFailure))
+	assert.NoError(t, err)
+	assert.Equal(t, CiTestStatusExpectedFailure, got)
+
+	_, err = ParseCiTestStatus("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiTestStatus", Value: "UNKNOWN"}, err)
+}
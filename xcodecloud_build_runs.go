@@ -0,0 +1,523 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrConflictingCiBuildRunSource happens when a build run is started for both a git reference and a pull request.
+var ErrConflictingCiBuildRunSource = errors.New("a build run can start for a git reference or a pull request, not both")
+
+// CiExecutionProgress defines model for CiExecutionProgress.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciexecutionprogress
+type CiExecutionProgress string
+
+const (
+	// CiExecutionProgressComplete is a build run or action that has finished.
+	CiExecutionProgressComplete CiExecutionProgress = "COMPLETE"
+	// CiExecutionProgressPending is a build run or action that is waiting to start.
+	CiExecutionProgressPending CiExecutionProgress = "PENDING"
+	// CiExecutionProgressRunning is a build run or action that is in progress.
+	CiExecutionProgressRunning CiExecutionProgress = "RUNNING"
+)
+
+var ciExecutionProgresses = []CiExecutionProgress{
+	CiExecutionProgressComplete,
+	CiExecutionProgressPending,
+	CiExecutionProgressRunning,
+}
+
+// IsValid reports whether the ci execution progress is one this package knows about.
+func (v CiExecutionProgress) IsValid() bool {
+	for _, value := range ciExecutionProgresses {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiExecutionProgress returns s as a CiExecutionProgress, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiExecutionProgress(s string) (CiExecutionProgress, error) {
+	if v := CiExecutionProgress(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiExecutionProgress", Value: s}
+}
+
+// CiCompletionStatus defines model for CiCompletionStatus.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cicompletionstatus
+type CiCompletionStatus string
+
+const (
+	// CiCompletionStatusCanceled is a build run or action that was canceled.
+	CiCompletionStatusCanceled CiCompletionStatus = "CANCELED"
+	// CiCompletionStatusErrored is a build run or action that could not finish because of an error in Xcode Cloud.
+	CiCompletionStatusErrored CiCompletionStatus = "ERRORED"
+	// CiCompletionStatusFailed is a build run or action that failed.
+	CiCompletionStatusFailed CiCompletionStatus = "FAILED"
+	// CiCompletionStatusSkipped is a build run or action that was skipped.
+	CiCompletionStatusSkipped CiCompletionStatus = "SKIPPED"
+	// CiCompletionStatusSucceeded is a build run or action that succeeded.
+	CiCompletionStatusSucceeded CiCompletionStatus = "SUCCEEDED"
+)
+
+var ciCompletionStatuses = []CiCompletionStatus{
+	CiCompletionStatusCanceled,
+	CiCompletionStatusErrored,
+	CiCompletionStatusFailed,
+	CiCompletionStatusSkipped,
+	CiCompletionStatusSucceeded,
+}
+
+// IsValid reports whether the ci completion status is one this package knows about.
+func (v CiCompletionStatus) IsValid() bool {
+	for _, value := range ciCompletionStatuses {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiCompletionStatus returns s as a CiCompletionStatus, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiCompletionStatus(s string) (CiCompletionStatus, error) {
+	if v := CiCompletionStatus(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiCompletionStatus", Value: s}
+}
+
+// CiBuildRunStartReason defines model for CiBuildRun.Attributes.StartReason.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun/attributes
+type CiBuildRunStartReason string
+
+const (
+	// CiBuildRunStartReasonGitRefChange is a build run started by a change to a branch or tag.
+	CiBuildRunStartReasonGitRefChange CiBuildRunStartReason = "GIT_REF_CHANGE"
+	// CiBuildRunStartReasonManual is a build run started manually.
+	CiBuildRunStartReasonManual CiBuildRunStartReason = "MANUAL"
+	// CiBuildRunStartReasonManualRebuild is a build run started manually as a rebuild of another build run.
+	CiBuildRunStartReasonManualRebuild CiBuildRunStartReason = "MANUAL_REBUILD"
+	// CiBuildRunStartReasonPullRequestOpen is a build run started by opening a pull request.
+	CiBuildRunStartReasonPullRequestOpen CiBuildRunStartReason = "PULL_REQUEST_OPEN"
+	// CiBuildRunStartReasonPullRequestUpdate is a build run started by a change to a pull request.
+	CiBuildRunStartReasonPullRequestUpdate CiBuildRunStartReason = "PULL_REQUEST_UPDATE"
+	// CiBuildRunStartReasonSchedule is a build run started by a schedule.
+	CiBuildRunStartReasonSchedule CiBuildRunStartReason = "SCHEDULE"
+)
+
+var ciBuildRunStartReasons = []CiBuildRunStartReason{
+	CiBuildRunStartReasonGitRefChange,
+	CiBuildRunStartReasonManual,
+	CiBuildRunStartReasonManualRebuild,
+	CiBuildRunStartReasonPullRequestOpen,
+	CiBuildRunStartReasonPullRequestUpdate,
+	CiBuildRunStartReasonSchedule,
+}
+
+// IsValid reports whether the ci build run start reason is one this package knows about.
+func (v CiBuildRunStartReason) IsValid() bool {
+	for _, value := range ciBuildRunStartReasons {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiBuildRunStartReason returns s as a CiBuildRunStartReason, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiBuildRunStartReason(s string) (CiBuildRunStartReason, error) {
+	if v := CiBuildRunStartReason(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiBuildRunStartReason", Value: s}
+}
+
+// CiBuildRunCancelReason defines model for CiBuildRun.Attributes.CancelReason.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun/attributes
+type CiBuildRunCancelReason string
+
+const (
+	// CiBuildRunCancelReasonAutomaticallyByNewerBuild is a build run canceled because a newer build run started.
+	CiBuildRunCancelReasonAutomaticallyByNewerBuild CiBuildRunCancelReason = "AUTOMATICALLY_BY_NEWER_BUILD"
+	// CiBuildRunCancelReasonManuallyByUser is a build run canceled by a user.
+	CiBuildRunCancelReasonManuallyByUser CiBuildRunCancelReason = "MANUALLY_BY_USER"
+)
+
+var ciBuildRunCancelReasons = []CiBuildRunCancelReason{
+	CiBuildRunCancelReasonAutomaticallyByNewerBuild,
+	CiBuildRunCancelReasonManuallyByUser,
+}
+
+// IsValid reports whether the ci build run cancel reason is one this package knows about.
+func (v CiBuildRunCancelReason) IsValid() bool {
+	for _, value := range ciBuildRunCancelReasons {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiBuildRunCancelReason returns s as a CiBuildRunCancelReason, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiBuildRunCancelReason(s string) (CiBuildRunCancelReason, error) {
+	if v := CiBuildRunCancelReason(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiBuildRunCancelReason", Value: s}
+}
+
+// CiGitUser defines model for CiGitUser.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cigituser
+type CiGitUser struct {
+	AvatarURL   *string `json:"avatarUrl,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+// CiBuildRunCommit defines model for CiBuildRun.Attributes.SourceCommit and DestinationCommit.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun/attributes/sourcecommit
+type CiBuildRunCommit struct {
+	Author    *CiGitUser `json:"author,omitempty"`
+	CommitSha *string    `json:"commitSha,omitempty"`
+	Committer *CiGitUser `json:"committer,omitempty"`
+	Message   *string    `json:"message,omitempty"`
+	WebURL    *string    `json:"webUrl,omitempty"`
+}
+
+// CiIssueCounts defines model for CiIssueCounts.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciissuecounts
+type CiIssueCounts struct {
+	AnalyzerWarnings *int `json:"analyzerWarnings,omitempty"`
+	Errors           *int `json:"errors,omitempty"`
+	TestFailures     *int `json:"testFailures,omitempty"`
+	Warnings         *int `json:"warnings,omitempty"`
+}
+
+// CiBuildRun defines model for CiBuildRun.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun
+type CiBuildRun struct {
+	Attributes    *CiBuildRunAttributes    `json:"attributes,omitempty"`
+	ID            string                   `json:"id"`
+	Links         ResourceLinks            `json:"links"`
+	Relationships *CiBuildRunRelationships `json:"relationships,omitempty"`
+	Type          string                   `json:"type"`
+}
+
+// CiBuildRunAttributes defines model for CiBuildRun.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun/attributes
+type CiBuildRunAttributes struct {
+	CancelReason       *CiBuildRunCancelReason `json:"cancelReason,omitempty"`
+	CompletionStatus   *CiCompletionStatus     `json:"completionStatus,omitempty"`
+	CreatedDate        *DateTime               `json:"createdDate,omitempty"`
+	DestinationCommit  *CiBuildRunCommit       `json:"destinationCommit,omitempty"`
+	ExecutionProgress  *CiExecutionProgress    `json:"executionProgress,omitempty"`
+	FinishedDate       *DateTime               `json:"finishedDate,omitempty"`
+	IsPullRequestBuild *bool                   `json:"isPullRequestBuild,omitempty"`
+	IssueCounts        *CiIssueCounts          `json:"issueCounts,omitempty"`
+	Number             *int                    `json:"number,omitempty"`
+	SourceCommit       *CiBuildRunCommit       `json:"sourceCommit,omitempty"`
+	StartReason        *CiBuildRunStartReason  `json:"startReason,omitempty"`
+	StartedDate        *DateTime               `json:"startedDate,omitempty"`
+}
+
+// CiBuildRunRelationships defines model for CiBuildRun.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrun/relationships
+type CiBuildRunRelationships struct {
+	Actions           *PagedRelationship `json:"actions,omitempty"`
+	Builds            *PagedRelationship `json:"builds,omitempty"`
+	DestinationBranch *Relationship      `json:"destinationBranch,omitempty"`
+	Product           *Relationship      `json:"product,omitempty"`
+	PullRequest       *Relationship      `json:"pullRequest,omitempty"`
+	SourceBranchOrTag *Relationship      `json:"sourceBranchOrTag,omitempty"`
+	Workflow          *Relationship      `json:"workflow,omitempty"`
+}
+
+// ciBuildRunCreateRequest defines model for CiBuildRunCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildruncreaterequest/data
+type ciBuildRunCreateRequest struct {
+	Attributes    *ciBuildRunCreateRequestAttributes   `json:"attributes,omitempty"`
+	Relationships ciBuildRunCreateRequestRelationships `json:"relationships"`
+	Type          string                               `json:"type"`
+}
+
+// ciBuildRunCreateRequestAttributes are attributes for CiBuildRunCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildruncreaterequest/data/attributes
+type ciBuildRunCreateRequestAttributes struct {
+	Clean *bool `json:"clean,omitempty"`
+}
+
+// ciBuildRunCreateRequestRelationships are relationships for CiBuildRunCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildruncreaterequest/data/relationships
+type ciBuildRunCreateRequestRelationships struct {
+	BuildRun          *relationshipDeclaration `json:"buildRun,omitempty"`
+	PullRequest       *relationshipDeclaration `json:"pullRequest,omitempty"`
+	SourceBranchOrTag *relationshipDeclaration `json:"sourceBranchOrTag,omitempty"`
+	Workflow          *relationshipDeclaration `json:"workflow,omitempty"`
+}
+
+// CiBuildRunResponse defines model for CiBuildRunResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrunresponse
+type CiBuildRunResponse struct {
+	Data     CiBuildRun                   `json:"data"`
+	Included []CiBuildRunResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                `json:"links"`
+}
+
+// CiBuildRunsResponse defines model for CiBuildRunsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuildrunsresponse
+type CiBuildRunsResponse struct {
+	Data     []CiBuildRun                 `json:"data"`
+	Included []CiBuildRunResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks           `json:"links"`
+	Meta     *PagingInformation           `json:"meta,omitempty"`
+}
+
+// CiBuildRunResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a CiBuildRunResponse or CiBuildRunsResponse.
+type CiBuildRunResponseIncluded included
+
+// CiBuildRunStartOptions configures StartCiBuildRun. A build run starts for the workflow's default branch when
+// neither a git reference nor a pull request is set.
+type CiBuildRunStartOptions struct {
+	// GitReferenceID is the ID of the branch or tag to build.
+	GitReferenceID *string
+	// PullRequestID is the ID of the pull request to build.
+	PullRequestID *string
+	// Clean builds without the cached derived data of earlier builds.
+	Clean *bool
+}
+
+// ListCiBuildRunsQuery are query options for ListCiBuildRunsForCiWorkflow and ListCiBuildRunsForCiProduct
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_build_runs_for_a_workflow
+type ListCiBuildRunsQuery struct {
+	FieldsBuilds      []string `url:"fields[builds],omitempty"`
+	FieldsCiBuildRuns []string `url:"fields[ciBuildRuns],omitempty"`
+	FilterBuilds      []string `url:"filter[builds],omitempty"`
+	Include           []string `url:"include,omitempty"`
+	Limit             int      `url:"limit,omitempty"`
+	LimitBuilds       int      `url:"limit[builds],omitempty"`
+	Sort              []string `url:"sort,omitempty"`
+	Cursor            string   `url:"cursor,omitempty"`
+}
+
+// GetCiBuildRunQuery are query options for GetCiBuildRun
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_build_run_information
+type GetCiBuildRunQuery struct {
+	FieldsBuilds      []string `url:"fields[builds],omitempty"`
+	FieldsCiBuildRuns []string `url:"fields[ciBuildRuns],omitempty"`
+	Include           []string `url:"include,omitempty"`
+	LimitBuilds       int      `url:"limit[builds],omitempty"`
+}
+
+// ListBuildsForCiBuildRunQuery are query options for ListBuildsForCiBuildRun
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_builds_for_a_build_run
+type ListBuildsForCiBuildRunQuery struct {
+	FieldsBuilds []string `url:"fields[builds],omitempty"`
+	FilterID     []string `url:"filter[id],omitempty"`
+	Include      []string `url:"include,omitempty"`
+	Limit        int      `url:"limit,omitempty"`
+	Sort         []string `url:"sort,omitempty"`
+	Cursor       string   `url:"cursor,omitempty"`
+}
+
+// IsComplete reports whether the build run has finished, whatever its completion status.
+func (r *CiBuildRun) IsComplete() bool {
+	return r.Attributes != nil && r.Attributes.ExecutionProgress != nil && *r.Attributes.ExecutionProgress == CiExecutionProgressComplete
+}
+
+// IsSucceeded reports whether the build run has finished and succeeded.
+func (r *CiBuildRun) IsSucceeded() bool {
+	return r.IsComplete() && r.Attributes.CompletionStatus != nil && *r.Attributes.CompletionStatus == CiCompletionStatusSucceeded
+}
+
+// StartCiBuildRun starts a build of an Xcode Cloud workflow, for a branch, tag or pull request if one is given.
+//
+// The App Store Connect API does not provide a way to cancel a build run once it has started; that can
+// only be done in Xcode or App Store Connect.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/start_a_build
+func (s *XcodeCloudService) StartCiBuildRun(ctx context.Context, workflowID string, opts *CiBuildRunStartOptions) (*CiBuildRunResponse, *Response, error) {
+	if opts == nil {
+		opts = &CiBuildRunStartOptions{}
+	}
+
+	if opts.GitReferenceID != nil && opts.PullRequestID != nil {
+		return nil, nil, ErrConflictingCiBuildRunSource
+	}
+
+	req := ciBuildRunCreateRequest{
+		Relationships: ciBuildRunCreateRequestRelationships{
+			PullRequest:       newRelationshipDeclaration(opts.PullRequestID, "scmPullRequests"),
+			SourceBranchOrTag: newRelationshipDeclaration(opts.GitReferenceID, "scmGitReferences"),
+			Workflow:          newRelationshipDeclaration(&workflowID, "ciWorkflows"),
+		},
+		Type: "ciBuildRuns",
+	}
+
+	if opts.Clean != nil {
+		req.Attributes = &ciBuildRunCreateRequestAttributes{Clean: opts.Clean}
+	}
+
+	res := new(CiBuildRunResponse)
+	resp, err := s.client.post(ctx, "ciBuildRuns", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// RebuildCiBuildRun starts a new build run from the same commit and workflow configuration as an earlier one.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/start_a_build
+func (s *XcodeCloudService) RebuildCiBuildRun(ctx context.Context, buildRunID string, clean *bool) (*CiBuildRunResponse, *Response, error) {
+	req := ciBuildRunCreateRequest{
+		Relationships: ciBuildRunCreateRequestRelationships{
+			BuildRun: newRelationshipDeclaration(&buildRunID, "ciBuildRuns"),
+		},
+		Type: "ciBuildRuns",
+	}
+
+	if clean != nil {
+		req.Attributes = &ciBuildRunCreateRequestAttributes{Clean: clean}
+	}
+
+	res := new(CiBuildRunResponse)
+	resp, err := s.client.post(ctx, "ciBuildRuns", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// GetCiBuildRun gets information about an Xcode Cloud build run, including its progress and completion status.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_build_run_information
+func (s *XcodeCloudService) GetCiBuildRun(ctx context.Context, id string, params *GetCiBuildRunQuery) (*CiBuildRunResponse, *Response, error) {
+	return Get[CiBuildRunResponse](ctx, s.client, fmt.Sprintf("ciBuildRuns/%s", id), params)
+}
+
+// ListCiBuildRunsForCiWorkflow lists the build runs of an Xcode Cloud workflow.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_build_runs_for_a_workflow
+func (s *XcodeCloudService) ListCiBuildRunsForCiWorkflow(ctx context.Context, id string, params *ListCiBuildRunsQuery) (*CiBuildRunsResponse, *Response, error) {
+	return Get[CiBuildRunsResponse](ctx, s.client, fmt.Sprintf("ciWorkflows/%s/buildRuns", id), params)
+}
+
+// ListCiBuildRunsForCiProduct lists the build runs of every workflow of an Xcode Cloud product.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_build_runs_for_an_xcode_cloud_product
+func (s *XcodeCloudService) ListCiBuildRunsForCiProduct(ctx context.Context, id string, params *ListCiBuildRunsQuery) (*CiBuildRunsResponse, *Response, error) {
+	return Get[CiBuildRunsResponse](ctx, s.client, fmt.Sprintf("ciProducts/%s/buildRuns", id), params)
+}
+
+// ListBuildsForCiBuildRun lists the builds an Xcode Cloud build run produced.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_builds_for_a_build_run
+func (s *XcodeCloudService) ListBuildsForCiBuildRun(ctx context.Context, id string, params *ListBuildsForCiBuildRunQuery) (*BuildsResponse, *Response, error) {
+	return Get[BuildsResponse](ctx, s.client, fmt.Sprintf("ciBuildRuns/%s/builds", id), params)
+}
+
+// WaitForBuildRun polls an Xcode Cloud build run every interval until it completes, and returns it. If
+// progress is not nil, it is called with the build run after every poll, so callers can report its progress.
+// The completion status of the returned build run tells whether it succeeded; see CiBuildRun.IsSucceeded.
+func (s *XcodeCloudService) WaitForBuildRun(ctx context.Context, id string, interval time.Duration, progress func(*CiBuildRun)) (*CiBuildRun, error) {
+	for {
+		res, _, err := s.GetCiBuildRun(ctx, id, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		run := res.Data
+
+		if progress != nil {
+			progress(&run)
+		}
+
+		if run.IsComplete() {
+			return &run, nil
+		}
+
+		if err := sleepContext(ctx, interval); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in CiBuildRunResponseIncluded.
+func (i *CiBuildRunResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// Build returns the Build stored within, if one is present.
+func (i *CiBuildRunResponseIncluded) Build() *Build {
+	return extractIncludedBuild(i.inner)
+}
+
+// CiProduct returns the CiProduct stored within, if one is present.
+func (i *CiBuildRunResponseIncluded) CiProduct() *CiProduct {
+	return extractIncludedCiProduct(i.inner)
+}
+
+// CiWorkflow returns the CiWorkflow stored within, if one is present.
+func (i *CiBuildRunResponseIncluded) CiWorkflow() *CiWorkflow {
+	return extractIncludedCiWorkflow(i.inner)
+}
+
+// ScmGitReference returns the ScmGitReference stored within, if one is present.
+func (i *CiBuildRunResponseIncluded) ScmGitReference() *ScmGitReference {
+	return extractIncludedScmGitReference(i.inner)
+}
+
+// ScmPullRequest returns the ScmPullRequest stored within, if one is present.
+func (i *CiBuildRunResponseIncluded) ScmPullRequest() *ScmPullRequest {
+	return extractIncludedScmPullRequest(i.inner)
+}
@@ -0,0 +1,243 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartCiBuildRun(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiBuildRunResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.StartCiBuildRun(ctx, "10", &CiBuildRunStartOptions{GitReferenceID: String("20"), Clean: Bool(true)})
+	})
+}
+
+func TestStartCiBuildRunConflictingSource(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior("{}", func(ctx context.Context, client *Client) {
+		_, _, err := client.XcodeCloud.StartCiBuildRun(ctx, "10", &CiBuildRunStartOptions{GitReferenceID: String("20"), PullRequestID: String("30")})
+		assert.ErrorIs(t, err, ErrConflictingCiBuildRunSource)
+	})
+}
+
+func TestStartCiBuildRunRequest(t *testing.T) {
+	t.Parallel()
+
+	var body string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Data json.RawMessage `json:"data"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&payload)
+		body = string(payload.Data)
+
+		fmt.Fprintln(w, `{"data":{"id":"1","type":"ciBuildRuns"}}`)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	_, _, err := client.XcodeCloud.StartCiBuildRun(context.Background(), "10", &CiBuildRunStartOptions{PullRequestID: String("30")})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"relationships": {
+			"pullRequest": {"data": {"id": "30", "type": "scmPullRequests"}},
+			"workflow": {"data": {"id": "10", "type": "ciWorkflows"}}
+		},
+		"type": "ciBuildRuns"
+	}`, body)
+}
+
+func TestRebuildCiBuildRun(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiBuildRunResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.RebuildCiBuildRun(ctx, "10", Bool(false))
+	})
+}
+
+func TestGetCiBuildRun(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiBuildRunResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetCiBuildRun(ctx, "10", &GetCiBuildRunQuery{})
+	})
+}
+
+func TestGetCiBuildRunIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"builds"},{"type":"ciProducts"},{"type":"ciWorkflows"},{"type":"scmGitReferences"},{"type":"scmPullRequests"}]}`, func(ctx context.Context, client *Client) {
+		run, _, err := client.XcodeCloud.GetCiBuildRun(ctx, "10", &GetCiBuildRunQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, run.Included, 5)
+
+		assert.NotNil(t, run.Included[0].Build())
+		assert.NotNil(t, run.Included[1].CiProduct())
+		assert.NotNil(t, run.Included[2].CiWorkflow())
+		assert.NotNil(t, run.Included[3].ScmGitReference())
+		assert.NotNil(t, run.Included[4].ScmPullRequest())
+
+		assert.Nil(t, run.Included[0].CiWorkflow())
+		assert.Nil(t, run.Included[1].Build())
+		assert.Nil(t, run.Included[2].CiProduct())
+	})
+}
+
+func TestListCiBuildRunsForCiWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiBuildRunsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiBuildRunsForCiWorkflow(ctx, "10", &ListCiBuildRunsQuery{Sort: []string{"-number"}})
+	})
+}
+
+func TestListCiBuildRunsForCiProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiBuildRunsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiBuildRunsForCiProduct(ctx, "10", &ListCiBuildRunsQuery{})
+	})
+}
+
+func TestListBuildsForCiBuildRun(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &BuildsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListBuildsForCiBuildRun(ctx, "10", &ListBuildsForCiBuildRunQuery{})
+	})
+}
+
+func TestWaitForBuildRun(t *testing.T) {
+	t.Parallel()
+
+	var polls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		progress := "RUNNING"
+		if atomic.AddInt32(&polls, 1) == 3 {
+			progress = "COMPLETE"
+		}
+
+		fmt.Fprintf(w, `{"data":{"id":"10","type":"ciBuildRuns","attributes":{"executionProgress":%q,"completionStatus":"SUCCEEDED"}}}`, progress)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	var observed []CiExecutionProgress
+
+	run, err := client.XcodeCloud.WaitForBuildRun(context.Background(), "10", time.Millisecond, func(run *CiBuildRun) {
+		observed = append(observed, *run.Attributes.ExecutionProgress)
+	})
+	assert.NoError(t, err)
+	assert.True(t, run.IsSucceeded())
+	assert.Equal(t, []CiExecutionProgress{CiExecutionProgressRunning, CiExecutionProgressRunning, CiExecutionProgressComplete}, observed)
+}
+
+func TestWaitForBuildRunCanceled(t *testing.T) {
+	t.Parallel()
+
+	client, server := newServer(`{"data":{"id":"10","type":"ciBuildRuns","attributes":{"executionProgress":"PENDING"}}}`, http.StatusOK, false)
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	run, err := client.XcodeCloud.WaitForBuildRun(ctx, "10", time.Millisecond, nil)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Nil(t, run)
+}
+
+func TestCiBuildRunStatus(t *testing.T) {
+	t.Parallel()
+
+	complete := CiExecutionProgressComplete
+	failed := CiCompletionStatusFailed
+
+	run := CiBuildRun{}
+	assert.False(t, run.IsComplete())
+	assert.False(t, run.IsSucceeded())
+
+	run.Attributes = &CiBuildRunAttributes{ExecutionProgress: &complete, CompletionStatus: &failed}
+	assert.True(t, run.IsComplete())
+	assert.False(t, run.IsSucceeded())
+}
+
+func TestParseCiExecutionProgress(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiExecutionProgress(string(CiExecutionProgressComplete))
+	assert.NoError(t, err)
+	assert.Equal(t, CiExecutionProgressComplete, got)
+
+	_, err = ParseCiExecutionProgress("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiExecutionProgress", Value: "UNKNOWN"}, err)
+}
+
+func TestParseCiCompletionStatus(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiCompletionStatus(string(CiCompletionStatusCanceled))
+	assert.NoError(t, err)
+	assert.Equal(t, CiCompletionStatusCanceled, got)
+
+	_, err = ParseCiCompletionStatus("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiCompletionStatus", Value: "UNKNOWN"}, err)
+}
+
+func TestParseCiBuildRunStartReason(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiBuildRunStartReason(string(CiBuildRunStartReasonGitRefChange))
+	assert.NoError(t, err)
+	assert.Equal(t, CiBuildRunStartReasonGitRefChange, got)
+
+	_, err = ParseCiBuildRunStartReason("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiBuildRunStartReason", Value: "UNKNOWN"}, err)
+}
+
+func TestParseCiBuildRunCancelReason(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiBuildRunCancelReason(string(CiBuildRunCancelReasonAutomaticallyByNewerBuild))
+	assert.NoError(t, err)
+	assert.Equal(t, CiBuildRunCancelReasonAutomaticallyByNewerBuild, got)
+
+	_, err = ParseCiBuildRunCancelReason("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiBuildRunCancelReason", Value: "UNKNOWN"}, err)
+}
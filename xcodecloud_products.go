@@ -0,0 +1,248 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CiProductType defines model for CiProductType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproduct/attributes
+type CiProductType string
+
+const (
+	// CiProductTypeApp is an Xcode Cloud product that builds an app.
+	CiProductTypeApp CiProductType = "APP"
+	// CiProductTypeFramework is an Xcode Cloud product that builds a framework.
+	CiProductTypeFramework CiProductType = "FRAMEWORK"
+)
+
+var ciProductTypes = []CiProductType{
+	CiProductTypeApp,
+	CiProductTypeFramework,
+}
+
+// IsValid reports whether the ci product type is one this package knows about.
+func (v CiProductType) IsValid() bool {
+	for _, value := range ciProductTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiProductType returns s as a CiProductType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiProductType(s string) (CiProductType, error) {
+	if v := CiProductType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiProductType", Value: s}
+}
+
+// CiProduct defines model for CiProduct.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproduct
+type CiProduct struct {
+	Attributes    *CiProductAttributes    `json:"attributes,omitempty"`
+	ID            string                  `json:"id"`
+	Links         ResourceLinks           `json:"links"`
+	Relationships *CiProductRelationships `json:"relationships,omitempty"`
+	Type          string                  `json:"type"`
+}
+
+// CiProductAttributes defines model for CiProduct.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproduct/attributes
+type CiProductAttributes struct {
+	CreatedDate *DateTime      `json:"createdDate,omitempty"`
+	Name        *string        `json:"name,omitempty"`
+	ProductType *CiProductType `json:"productType,omitempty"`
+}
+
+// CiProductRelationships defines model for CiProduct.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproduct/relationships
+type CiProductRelationships struct {
+	AdditionalRepositories *PagedRelationship `json:"additionalRepositories,omitempty"`
+	App                    *Relationship      `json:"app,omitempty"`
+	BuildRuns              *PagedRelationship `json:"buildRuns,omitempty"`
+	BundleID               *Relationship      `json:"bundleId,omitempty"`
+	PrimaryRepositories    *PagedRelationship `json:"primaryRepositories,omitempty"`
+	Workflows              *PagedRelationship `json:"workflows,omitempty"`
+}
+
+// CiProductResponse defines model for CiProductResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproductresponse
+type CiProductResponse struct {
+	Data     CiProduct                   `json:"data"`
+	Included []CiProductResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks               `json:"links"`
+}
+
+// CiProductsResponse defines model for CiProductsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciproductsresponse
+type CiProductsResponse struct {
+	Data     []CiProduct                 `json:"data"`
+	Included []CiProductResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks          `json:"links"`
+	Meta     *PagingInformation          `json:"meta,omitempty"`
+}
+
+// CiProductResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a CiProductResponse or CiProductsResponse.
+type CiProductResponseIncluded included
+
+// ListCiProductsQuery are query options for ListCiProducts
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_xcode_cloud_products
+type ListCiProductsQuery struct {
+	FieldsApps               []string `url:"fields[apps],omitempty"`
+	FieldsBundleIDs          []string `url:"fields[bundleIds],omitempty"`
+	FieldsCiProducts         []string `url:"fields[ciProducts],omitempty"`
+	FieldsScmRepositories    []string `url:"fields[scmRepositories],omitempty"`
+	FilterApp                []string `url:"filter[app],omitempty"`
+	FilterProductType        []string `url:"filter[productType],omitempty"`
+	Include                  []string `url:"include,omitempty"`
+	Limit                    int      `url:"limit,omitempty"`
+	LimitPrimaryRepositories int      `url:"limit[primaryRepositories],omitempty"`
+	Cursor                   string   `url:"cursor,omitempty"`
+}
+
+// GetCiProductQuery are query options for GetCiProduct
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_cloud_product_information
+type GetCiProductQuery struct {
+	FieldsApps               []string `url:"fields[apps],omitempty"`
+	FieldsBundleIDs          []string `url:"fields[bundleIds],omitempty"`
+	FieldsCiProducts         []string `url:"fields[ciProducts],omitempty"`
+	FieldsScmRepositories    []string `url:"fields[scmRepositories],omitempty"`
+	Include                  []string `url:"include,omitempty"`
+	LimitPrimaryRepositories int      `url:"limit[primaryRepositories],omitempty"`
+}
+
+// GetCiProductForAppQuery are query options for GetCiProductForApp
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_xcode_cloud_product_for_an_app
+type GetCiProductForAppQuery struct {
+	FieldsCiProducts []string `url:"fields[ciProducts],omitempty"`
+}
+
+// GetAppForCiProductQuery are query options for GetAppForCiProduct
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_an_xcode_cloud_product
+type GetAppForCiProductQuery struct {
+	FieldsApps []string `url:"fields[apps],omitempty"`
+}
+
+// ListRepositoriesForCiProductQuery are query options for ListPrimaryRepositoriesForCiProduct and
+// ListAdditionalRepositoriesForCiProduct
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_primary_repositories_for_an_xcode_cloud_product
+type ListRepositoriesForCiProductQuery struct {
+	FieldsScmRepositories []string `url:"fields[scmRepositories],omitempty"`
+	FilterID              []string `url:"filter[id],omitempty"`
+	Limit                 int      `url:"limit,omitempty"`
+	Cursor                string   `url:"cursor,omitempty"`
+}
+
+// ListCiProducts lists all Xcode Cloud products that exist for your team.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_xcode_cloud_products
+func (s *XcodeCloudService) ListCiProducts(ctx context.Context, params *ListCiProductsQuery) (*CiProductsResponse, *Response, error) {
+	return Get[CiProductsResponse](ctx, s.client, "ciProducts", params)
+}
+
+// GetCiProduct gets information about a specific Xcode Cloud product.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_cloud_product_information
+func (s *XcodeCloudService) GetCiProduct(ctx context.Context, id string, params *GetCiProductQuery) (*CiProductResponse, *Response, error) {
+	return Get[CiProductResponse](ctx, s.client, fmt.Sprintf("ciProducts/%s", id), params)
+}
+
+// GetCiProductForApp gets the Xcode Cloud product for an app.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_xcode_cloud_product_for_an_app
+func (s *XcodeCloudService) GetCiProductForApp(ctx context.Context, id string, params *GetCiProductForAppQuery) (*CiProductResponse, *Response, error) {
+	return Get[CiProductResponse](ctx, s.client, fmt.Sprintf("apps/%s/ciProduct", id), params)
+}
+
+// GetAppForCiProduct gets the app information for an Xcode Cloud product.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_app_information_of_an_xcode_cloud_product
+func (s *XcodeCloudService) GetAppForCiProduct(ctx context.Context, id string, params *GetAppForCiProductQuery) (*AppResponse, *Response, error) {
+	return Get[AppResponse](ctx, s.client, fmt.Sprintf("ciProducts/%s/app", id), params)
+}
+
+// ListPrimaryRepositoriesForCiProduct lists the source code repositories that contain the Xcode project or
+// workspace of an Xcode Cloud product.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_primary_repositories_for_an_xcode_cloud_product
+func (s *XcodeCloudService) ListPrimaryRepositoriesForCiProduct(ctx context.Context, id string, params *ListRepositoriesForCiProductQuery) (*ScmRepositoriesResponse, *Response, error) {
+	return Get[ScmRepositoriesResponse](ctx, s.client, fmt.Sprintf("ciProducts/%s/primaryRepositories", id), params)
+}
+
+// ListAdditionalRepositoriesForCiProduct lists the additional source code repositories, such as those of
+// dependencies, that an Xcode Cloud product uses.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_additional_repositories_for_an_xcode_cloud_product
+func (s *XcodeCloudService) ListAdditionalRepositoriesForCiProduct(ctx context.Context, id string, params *ListRepositoriesForCiProductQuery) (*ScmRepositoriesResponse, *Response, error) {
+	return Get[ScmRepositoriesResponse](ctx, s.client, fmt.Sprintf("ciProducts/%s/additionalRepositories", id), params)
+}
+
+// DeleteCiProduct deletes an Xcode Cloud product and all of its workflows and builds.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_an_xcode_cloud_product
+func (s *XcodeCloudService) DeleteCiProduct(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("ciProducts/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in CiProductResponseIncluded.
+func (i *CiProductResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// App returns the App stored within, if one is present.
+func (i *CiProductResponseIncluded) App() *App {
+	return extractIncludedApp(i.inner)
+}
+
+// BundleID returns the BundleID stored within, if one is present.
+func (i *CiProductResponseIncluded) BundleID() *BundleID {
+	return extractIncludedBundleID(i.inner)
+}
+
+// ScmRepository returns the ScmRepository stored within, if one is present.
+func (i *CiProductResponseIncluded) ScmRepository() *ScmRepository {
+	return extractIncludedScmRepository(i.inner)
+}
@@ -0,0 +1,115 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCiProducts(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiProductsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiProducts(ctx, &ListCiProductsQuery{})
+	})
+}
+
+func TestListCiProductsIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"apps"},{"type":"bundleIds"},{"type":"scmRepositories"}]}`, func(ctx context.Context, client *Client) {
+		products, _, err := client.XcodeCloud.ListCiProducts(ctx, &ListCiProductsQuery{
+			Include: []string{"app", "bundleId", "primaryRepositories"},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, products.Included, 3)
+
+		assert.NotNil(t, products.Included[0].App())
+		assert.NotNil(t, products.Included[1].BundleID())
+		assert.NotNil(t, products.Included[2].ScmRepository())
+
+		assert.Nil(t, products.Included[0].ScmRepository())
+		assert.Nil(t, products.Included[1].App())
+		assert.Nil(t, products.Included[2].BundleID())
+	})
+}
+
+func TestGetCiProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiProductResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetCiProduct(ctx, "10", &GetCiProductQuery{})
+	})
+}
+
+func TestGetCiProductForApp(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiProductResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetCiProductForApp(ctx, "10", &GetCiProductForAppQuery{})
+	})
+}
+
+func TestGetAppForCiProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &AppResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetAppForCiProduct(ctx, "10", &GetAppForCiProductQuery{})
+	})
+}
+
+func TestListPrimaryRepositoriesForCiProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmRepositoriesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListPrimaryRepositoriesForCiProduct(ctx, "10", &ListRepositoriesForCiProductQuery{})
+	})
+}
+
+func TestListAdditionalRepositoriesForCiProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmRepositoriesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListAdditionalRepositoriesForCiProduct(ctx, "10", &ListRepositoriesForCiProductQuery{})
+	})
+}
+
+func TestDeleteCiProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.XcodeCloud.DeleteCiProduct(ctx, "10")
+	})
+}
+
+func TestParseCiProductType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiProductType(string(CiProductTypeApp))
+	assert.NoError(t, err)
+	assert.Equal(t, CiProductTypeApp, got)
+
+	_, err = ParseCiProductType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiProductType", Value: "UNKNOWN"}, err)
+}
@@ -0,0 +1,166 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// ScmProviderKind defines model for ScmProviderType.Kind.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmprovidertype
+type ScmProviderKind string
+
+const (
+	// ScmProviderKindBitbucketCloud is Bitbucket Cloud.
+	ScmProviderKindBitbucketCloud ScmProviderKind = "BITBUCKET_CLOUD"
+	// ScmProviderKindBitbucketServer is a Bitbucket Server instance.
+	ScmProviderKindBitbucketServer ScmProviderKind = "BITBUCKET_SERVER"
+	// ScmProviderKindGitHubCloud is GitHub.
+	ScmProviderKindGitHubCloud ScmProviderKind = "GITHUB_CLOUD"
+	// ScmProviderKindGitHubEnterprise is a GitHub Enterprise instance.
+	ScmProviderKindGitHubEnterprise ScmProviderKind = "GITHUB_ENTERPRISE"
+	// ScmProviderKindGitLabCloud is GitLab.
+	ScmProviderKindGitLabCloud ScmProviderKind = "GITLAB_CLOUD"
+	// ScmProviderKindGitLabSelfManaged is a self-managed GitLab instance.
+	ScmProviderKindGitLabSelfManaged ScmProviderKind = "GITLAB_SELF_MANAGED"
+)
+
+var scmProviderKinds = []ScmProviderKind{
+	ScmProviderKindBitbucketCloud,
+	ScmProviderKindBitbucketServer,
+	ScmProviderKindGitHubCloud,
+	ScmProviderKindGitHubEnterprise,
+	ScmProviderKindGitLabCloud,
+	ScmProviderKindGitLabSelfManaged,
+}
+
+// IsValid reports whether the scm provider kind is one this package knows about.
+func (v ScmProviderKind) IsValid() bool {
+	for _, value := range scmProviderKinds {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseScmProviderKind returns s as a ScmProviderKind, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseScmProviderKind(s string) (ScmProviderKind, error) {
+	if v := ScmProviderKind(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "ScmProviderKind", Value: s}
+}
+
+// ScmProviderType defines model for ScmProviderType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmprovidertype
+type ScmProviderType struct {
+	DisplayName *string          `json:"displayName,omitempty"`
+	IsOnPremise *bool            `json:"isOnPremise,omitempty"`
+	Kind        *ScmProviderKind `json:"kind,omitempty"`
+}
+
+// ScmProvider defines model for ScmProvider.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmprovider
+type ScmProvider struct {
+	Attributes *ScmProviderAttributes `json:"attributes,omitempty"`
+	ID         string                 `json:"id"`
+	Links      ResourceLinks          `json:"links"`
+	Type       string                 `json:"type"`
+}
+
+// ScmProviderAttributes defines model for ScmProvider.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmprovider/attributes
+type ScmProviderAttributes struct {
+	ScmProviderType *ScmProviderType `json:"scmProviderType,omitempty"`
+	URL             *string          `json:"url,omitempty"`
+}
+
+// ScmProviderResponse defines model for ScmProviderResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmproviderresponse
+type ScmProviderResponse struct {
+	Data  ScmProvider   `json:"data"`
+	Links DocumentLinks `json:"links"`
+}
+
+// ScmProvidersResponse defines model for ScmProvidersResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmprovidersresponse
+type ScmProvidersResponse struct {
+	Data  []ScmProvider      `json:"data"`
+	Links PagedDocumentLinks `json:"links"`
+	Meta  *PagingInformation `json:"meta,omitempty"`
+}
+
+// ListScmProvidersQuery are query options for ListScmProviders
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_source_code_management_providers
+type ListScmProvidersQuery struct {
+	FieldsScmProviders []string `url:"fields[scmProviders],omitempty"`
+	Limit              int      `url:"limit,omitempty"`
+	Cursor             string   `url:"cursor,omitempty"`
+}
+
+// GetScmProviderQuery are query options for GetScmProvider
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_source_code_management_provider_information
+type GetScmProviderQuery struct {
+	FieldsScmProviders []string `url:"fields[scmProviders],omitempty"`
+}
+
+// ListScmRepositoriesForScmProviderQuery are query options for ListScmRepositoriesForScmProvider
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_repositories_for_a_source_code_management_provider
+type ListScmRepositoriesForScmProviderQuery struct {
+	FieldsScmRepositories []string `url:"fields[scmRepositories],omitempty"`
+	FilterID              []string `url:"filter[id],omitempty"`
+	Limit                 int      `url:"limit,omitempty"`
+	Cursor                string   `url:"cursor,omitempty"`
+}
+
+// ListScmProviders lists the source code management providers connected to Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_source_code_management_providers
+func (s *XcodeCloudService) ListScmProviders(ctx context.Context, params *ListScmProvidersQuery) (*ScmProvidersResponse, *Response, error) {
+	return Get[ScmProvidersResponse](ctx, s.client, "scmProviders", params)
+}
+
+// GetScmProvider gets information about a source code management provider.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_source_code_management_provider_information
+func (s *XcodeCloudService) GetScmProvider(ctx context.Context, id string, params *GetScmProviderQuery) (*ScmProviderResponse, *Response, error) {
+	return Get[ScmProviderResponse](ctx, s.client, fmt.Sprintf("scmProviders/%s", id), params)
+}
+
+// ListScmRepositoriesForScmProvider lists the repositories of a source code management provider.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_repositories_for_a_source_code_management_provider
+func (s *XcodeCloudService) ListScmRepositoriesForScmProvider(ctx context.Context, id string, params *ListScmRepositoriesForScmProviderQuery) (*ScmRepositoriesResponse, *Response, error) {
+	return Get[ScmRepositoriesResponse](ctx, s.client, fmt.Sprintf("scmProviders/%s/repositories", id), params)
+}
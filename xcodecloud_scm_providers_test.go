@@ -0,0 +1,63 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListScmProviders(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmProvidersResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListScmProviders(ctx, &ListScmProvidersQuery{})
+	})
+}
+
+func TestGetScmProvider(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmProviderResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetScmProvider(ctx, "10", &GetScmProviderQuery{})
+	})
+}
+
+func TestListScmRepositoriesForScmProvider(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmRepositoriesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListScmRepositoriesForScmProvider(ctx, "10", &ListScmRepositoriesForScmProviderQuery{})
+	})
+}
+
+func TestParseScmProviderKind(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseScmProviderKind(string(ScmProviderKindBitbucketCloud))
+	assert.NoError(t, err)
+	assert.Equal(t, ScmProviderKindBitbucketCloud, got)
+
+	_, err = ParseScmProviderKind("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "ScmProviderKind", Value: "UNKNOWN"}, err)
+}
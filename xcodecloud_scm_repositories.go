@@ -0,0 +1,432 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrScmGitReferenceNotFound happens when a repository has no branch or tag with the name searched for.
+var ErrScmGitReferenceNotFound = errors.New("git reference not found")
+
+// ErrScmPullRequestNotFound happens when a repository has no pull request with the number searched for.
+var ErrScmPullRequestNotFound = errors.New("pull request not found")
+
+// ScmGitReferenceKind defines model for CiGitRefKind.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cigitrefkind
+type ScmGitReferenceKind string
+
+const (
+	// ScmGitReferenceKindBranch is a branch.
+	ScmGitReferenceKindBranch ScmGitReferenceKind = "BRANCH"
+	// ScmGitReferenceKindTag is a tag.
+	ScmGitReferenceKindTag ScmGitReferenceKind = "TAG"
+)
+
+var scmGitReferenceKinds = []ScmGitReferenceKind{
+	ScmGitReferenceKindBranch,
+	ScmGitReferenceKindTag,
+}
+
+// IsValid reports whether the scm git reference kind is one this package knows about.
+func (v ScmGitReferenceKind) IsValid() bool {
+	for _, value := range scmGitReferenceKinds {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseScmGitReferenceKind returns s as a ScmGitReferenceKind, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseScmGitReferenceKind(s string) (ScmGitReferenceKind, error) {
+	if v := ScmGitReferenceKind(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "ScmGitReferenceKind", Value: s}
+}
+
+// ScmRepository defines model for ScmRepository.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmrepository
+type ScmRepository struct {
+	Attributes    *ScmRepositoryAttributes    `json:"attributes,omitempty"`
+	ID            string                      `json:"id"`
+	Links         ResourceLinks               `json:"links"`
+	Relationships *ScmRepositoryRelationships `json:"relationships,omitempty"`
+	Type          string                      `json:"type"`
+}
+
+// ScmRepositoryAttributes defines model for ScmRepository.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmrepository/attributes
+type ScmRepositoryAttributes struct {
+	HTTPCloneURL     *string   `json:"httpCloneUrl,omitempty"`
+	LastAccessedDate *DateTime `json:"lastAccessedDate,omitempty"`
+	OwnerName        *string   `json:"ownerName,omitempty"`
+	RepositoryName   *string   `json:"repositoryName,omitempty"`
+	SSHCloneURL      *string   `json:"sshCloneUrl,omitempty"`
+}
+
+// ScmRepositoryRelationships defines model for ScmRepository.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmrepository/relationships
+type ScmRepositoryRelationships struct {
+	DefaultBranch *Relationship      `json:"defaultBranch,omitempty"`
+	GitReferences *PagedRelationship `json:"gitReferences,omitempty"`
+	PullRequests  *PagedRelationship `json:"pullRequests,omitempty"`
+	ScmProvider   *Relationship      `json:"scmProvider,omitempty"`
+}
+
+// ScmRepositoryResponse defines model for ScmRepositoryResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmrepositoryresponse
+type ScmRepositoryResponse struct {
+	Data     ScmRepository                   `json:"data"`
+	Included []ScmRepositoryResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                   `json:"links"`
+}
+
+// ScmRepositoriesResponse defines model for ScmRepositoriesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmrepositoriesresponse
+type ScmRepositoriesResponse struct {
+	Data     []ScmRepository                 `json:"data"`
+	Included []ScmRepositoryResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks              `json:"links"`
+	Meta     *PagingInformation              `json:"meta,omitempty"`
+}
+
+// ScmRepositoryResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a ScmRepositoryResponse or ScmRepositoriesResponse.
+type ScmRepositoryResponseIncluded included
+
+// ScmGitReference defines model for ScmGitReference.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmgitreference
+type ScmGitReference struct {
+	Attributes    *ScmGitReferenceAttributes    `json:"attributes,omitempty"`
+	ID            string                        `json:"id"`
+	Links         ResourceLinks                 `json:"links"`
+	Relationships *ScmGitReferenceRelationships `json:"relationships,omitempty"`
+	Type          string                        `json:"type"`
+}
+
+// ScmGitReferenceAttributes defines model for ScmGitReference.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmgitreference/attributes
+type ScmGitReferenceAttributes struct {
+	CanonicalName *string              `json:"canonicalName,omitempty"`
+	IsDeleted     *bool                `json:"isDeleted,omitempty"`
+	Kind          *ScmGitReferenceKind `json:"kind,omitempty"`
+	Name          *string              `json:"name,omitempty"`
+}
+
+// ScmGitReferenceRelationships defines model for ScmGitReference.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmgitreference/relationships
+type ScmGitReferenceRelationships struct {
+	Repository *Relationship `json:"repository,omitempty"`
+}
+
+// ScmGitReferenceResponse defines model for ScmGitReferenceResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmgitreferenceresponse
+type ScmGitReferenceResponse struct {
+	Data     ScmGitReference `json:"data"`
+	Included []ScmRepository `json:"included,omitempty"`
+	Links    DocumentLinks   `json:"links"`
+}
+
+// ScmGitReferencesResponse defines model for ScmGitReferencesResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmgitreferencesresponse
+type ScmGitReferencesResponse struct {
+	Data     []ScmGitReference  `json:"data"`
+	Included []ScmRepository    `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// ScmPullRequest defines model for ScmPullRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmpullrequest
+type ScmPullRequest struct {
+	Attributes    *ScmPullRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                       `json:"id"`
+	Links         ResourceLinks                `json:"links"`
+	Relationships *ScmPullRequestRelationships `json:"relationships,omitempty"`
+	Type          string                       `json:"type"`
+}
+
+// ScmPullRequestAttributes defines model for ScmPullRequest.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmpullrequest/attributes
+type ScmPullRequestAttributes struct {
+	DestinationBranchName      *string `json:"destinationBranchName,omitempty"`
+	DestinationRepositoryName  *string `json:"destinationRepositoryName,omitempty"`
+	DestinationRepositoryOwner *string `json:"destinationRepositoryOwner,omitempty"`
+	IsClosed                   *bool   `json:"isClosed,omitempty"`
+	IsCrossRepository          *bool   `json:"isCrossRepository,omitempty"`
+	Number                     *int    `json:"number,omitempty"`
+	SourceBranchName           *string `json:"sourceBranchName,omitempty"`
+	SourceRepositoryName       *string `json:"sourceRepositoryName,omitempty"`
+	SourceRepositoryOwner      *string `json:"sourceRepositoryOwner,omitempty"`
+	Title                      *string `json:"title,omitempty"`
+	WebURL                     *string `json:"webUrl,omitempty"`
+}
+
+// ScmPullRequestRelationships defines model for ScmPullRequest.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmpullrequest/relationships
+type ScmPullRequestRelationships struct {
+	Repository *Relationship `json:"repository,omitempty"`
+}
+
+// ScmPullRequestResponse defines model for ScmPullRequestResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmpullrequestresponse
+type ScmPullRequestResponse struct {
+	Data     ScmPullRequest  `json:"data"`
+	Included []ScmRepository `json:"included,omitempty"`
+	Links    DocumentLinks   `json:"links"`
+}
+
+// ScmPullRequestsResponse defines model for ScmPullRequestsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/scmpullrequestsresponse
+type ScmPullRequestsResponse struct {
+	Data     []ScmPullRequest   `json:"data"`
+	Included []ScmRepository    `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// ListScmRepositoriesQuery are query options for ListScmRepositories
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_repositories
+type ListScmRepositoriesQuery struct {
+	FieldsScmGitReferences []string `url:"fields[scmGitReferences],omitempty"`
+	FieldsScmProviders     []string `url:"fields[scmProviders],omitempty"`
+	FieldsScmRepositories  []string `url:"fields[scmRepositories],omitempty"`
+	FilterID               []string `url:"filter[id],omitempty"`
+	Include                []string `url:"include,omitempty"`
+	Limit                  int      `url:"limit,omitempty"`
+	Cursor                 string   `url:"cursor,omitempty"`
+}
+
+// GetScmRepositoryQuery are query options for GetScmRepository
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_repository_information
+type GetScmRepositoryQuery struct {
+	FieldsScmGitReferences []string `url:"fields[scmGitReferences],omitempty"`
+	FieldsScmProviders     []string `url:"fields[scmProviders],omitempty"`
+	FieldsScmRepositories  []string `url:"fields[scmRepositories],omitempty"`
+	Include                []string `url:"include,omitempty"`
+}
+
+// ListScmGitReferencesForScmRepositoryQuery are query options for ListScmGitReferencesForScmRepository
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_git_references_for_a_repository
+type ListScmGitReferencesForScmRepositoryQuery struct {
+	FieldsScmGitReferences []string `url:"fields[scmGitReferences],omitempty"`
+	Limit                  int      `url:"limit,omitempty"`
+	Cursor                 string   `url:"cursor,omitempty"`
+}
+
+// GetScmGitReferenceQuery are query options for GetScmGitReference
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_git_reference_information
+type GetScmGitReferenceQuery struct {
+	FieldsScmGitReferences []string `url:"fields[scmGitReferences],omitempty"`
+	Include                []string `url:"include,omitempty"`
+}
+
+// ListScmPullRequestsForScmRepositoryQuery are query options for ListScmPullRequestsForScmRepository
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_pull_requests_for_a_repository
+type ListScmPullRequestsForScmRepositoryQuery struct {
+	FieldsScmPullRequests []string `url:"fields[scmPullRequests],omitempty"`
+	Limit                 int      `url:"limit,omitempty"`
+	Cursor                string   `url:"cursor,omitempty"`
+}
+
+// GetScmPullRequestQuery are query options for GetScmPullRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_pull_request_information
+type GetScmPullRequestQuery struct {
+	FieldsScmPullRequests []string `url:"fields[scmPullRequests],omitempty"`
+	Include               []string `url:"include,omitempty"`
+}
+
+// ListScmRepositories lists the source code repositories Xcode Cloud has access to.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_repositories
+func (s *XcodeCloudService) ListScmRepositories(ctx context.Context, params *ListScmRepositoriesQuery) (*ScmRepositoriesResponse, *Response, error) {
+	return Get[ScmRepositoriesResponse](ctx, s.client, "scmRepositories", params)
+}
+
+// GetScmRepository gets information about a source code repository.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_repository_information
+func (s *XcodeCloudService) GetScmRepository(ctx context.Context, id string, params *GetScmRepositoryQuery) (*ScmRepositoryResponse, *Response, error) {
+	return Get[ScmRepositoryResponse](ctx, s.client, fmt.Sprintf("scmRepositories/%s", id), params)
+}
+
+// ListScmGitReferencesForScmRepository lists the branches and tags of a source code repository.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_git_references_for_a_repository
+func (s *XcodeCloudService) ListScmGitReferencesForScmRepository(ctx context.Context, id string, params *ListScmGitReferencesForScmRepositoryQuery) (*ScmGitReferencesResponse, *Response, error) {
+	return Get[ScmGitReferencesResponse](ctx, s.client, fmt.Sprintf("scmRepositories/%s/gitReferences", id), params)
+}
+
+// GetScmGitReference gets information about a branch or tag of a source code repository.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_git_reference_information
+func (s *XcodeCloudService) GetScmGitReference(ctx context.Context, id string, params *GetScmGitReferenceQuery) (*ScmGitReferenceResponse, *Response, error) {
+	return Get[ScmGitReferenceResponse](ctx, s.client, fmt.Sprintf("scmGitReferences/%s", id), params)
+}
+
+// ListScmPullRequestsForScmRepository lists the pull requests of a source code repository.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_pull_requests_for_a_repository
+func (s *XcodeCloudService) ListScmPullRequestsForScmRepository(ctx context.Context, id string, params *ListScmPullRequestsForScmRepositoryQuery) (*ScmPullRequestsResponse, *Response, error) {
+	return Get[ScmPullRequestsResponse](ctx, s.client, fmt.Sprintf("scmRepositories/%s/pullRequests", id), params)
+}
+
+// GetScmPullRequest gets information about a pull request of a source code repository.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_pull_request_information
+func (s *XcodeCloudService) GetScmPullRequest(ctx context.Context, id string, params *GetScmPullRequestQuery) (*ScmPullRequestResponse, *Response, error) {
+	return Get[ScmPullRequestResponse](ctx, s.client, fmt.Sprintf("scmPullRequests/%s", id), params)
+}
+
+// FindScmGitReference pages through the branches and tags of a repository for the one with the given name,
+// such as "main" or "refs/tags/1.0", matching either its short or canonical name. Deleted references are
+// ignored. The ID of the reference can be passed to StartCiBuildRun.
+func (s *XcodeCloudService) FindScmGitReference(ctx context.Context, repositoryID string, kind ScmGitReferenceKind, name string) (*ScmGitReference, error) {
+	params := &ListScmGitReferencesForScmRepositoryQuery{Limit: MaxPageLimit}
+
+	var found *ScmGitReference
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		res, _, err := s.ListScmGitReferencesForScmRepository(ctx, repositoryID, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range res.Data {
+			if ref := &res.Data[i]; ref.matches(kind, name) {
+				found = ref
+
+				return nil, nil
+			}
+		}
+
+		return &res.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("%w: %s %s", ErrScmGitReferenceNotFound, kind, name)
+	}
+
+	return found, nil
+}
+
+// FindScmPullRequest pages through the pull requests of a repository for the one with the given number. The ID
+// of the pull request can be passed to StartCiBuildRun.
+func (s *XcodeCloudService) FindScmPullRequest(ctx context.Context, repositoryID string, number int) (*ScmPullRequest, error) {
+	params := &ListScmPullRequestsForScmRepositoryQuery{Limit: MaxPageLimit}
+
+	var found *ScmPullRequest
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		res, _, err := s.ListScmPullRequestsForScmRepository(ctx, repositoryID, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range res.Data {
+			pr := &res.Data[i]
+			if pr.Attributes != nil && pr.Attributes.Number != nil && *pr.Attributes.Number == number {
+				found = pr
+
+				return nil, nil
+			}
+		}
+
+		return &res.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("%w: #%d", ErrScmPullRequestNotFound, number)
+	}
+
+	return found, nil
+}
+
+func (r *ScmGitReference) matches(kind ScmGitReferenceKind, name string) bool {
+	attributes := r.Attributes
+	if attributes == nil || attributes.Kind == nil || *attributes.Kind != kind {
+		return false
+	}
+
+	if attributes.IsDeleted != nil && *attributes.IsDeleted {
+		return false
+	}
+
+	return (attributes.Name != nil && *attributes.Name == name) ||
+		(attributes.CanonicalName != nil && *attributes.CanonicalName == name)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in ScmRepositoryResponseIncluded.
+func (i *ScmRepositoryResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// ScmGitReference returns the ScmGitReference stored within, if one is present.
+func (i *ScmRepositoryResponseIncluded) ScmGitReference() *ScmGitReference {
+	return extractIncludedScmGitReference(i.inner)
+}
+
+// ScmProvider returns the ScmProvider stored within, if one is present.
+func (i *ScmRepositoryResponseIncluded) ScmProvider() *ScmProvider {
+	return extractIncludedScmProvider(i.inner)
+}
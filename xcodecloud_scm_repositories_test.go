@@ -0,0 +1,188 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListScmRepositories(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmRepositoriesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListScmRepositories(ctx, &ListScmRepositoriesQuery{})
+	})
+}
+
+func TestGetScmRepository(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmRepositoryResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetScmRepository(ctx, "10", &GetScmRepositoryQuery{})
+	})
+}
+
+func TestGetScmRepositoryIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"scmProviders"},{"type":"scmGitReferences"}]}`, func(ctx context.Context, client *Client) {
+		repo, _, err := client.XcodeCloud.GetScmRepository(ctx, "10", &GetScmRepositoryQuery{})
+		assert.NoError(t, err)
+		assert.Len(t, repo.Included, 2)
+
+		assert.NotNil(t, repo.Included[0].ScmProvider())
+		assert.NotNil(t, repo.Included[1].ScmGitReference())
+
+		assert.Nil(t, repo.Included[0].ScmGitReference())
+		assert.Nil(t, repo.Included[1].ScmProvider())
+	})
+}
+
+func TestListScmGitReferencesForScmRepository(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmGitReferencesResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListScmGitReferencesForScmRepository(ctx, "10", &ListScmGitReferencesForScmRepositoryQuery{})
+	})
+}
+
+func TestGetScmGitReference(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmGitReferenceResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetScmGitReference(ctx, "10", &GetScmGitReferenceQuery{})
+	})
+}
+
+func TestListScmPullRequestsForScmRepository(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmPullRequestsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListScmPullRequestsForScmRepository(ctx, "10", &ListScmPullRequestsForScmRepositoryQuery{})
+	})
+}
+
+func TestGetScmPullRequest(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmPullRequestResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetScmPullRequest(ctx, "10", &GetScmPullRequestQuery{})
+	})
+}
+
+func newScmPagedServer(path string, pages ...string) (*Client, *httptest.Server) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != path {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"errors":[{"status":"404"}]}`)
+
+			return
+		}
+
+		page := 0
+		if r.URL.Query().Get("cursor") == "next" {
+			page = 1
+		}
+
+		next := ""
+		if page+1 < len(pages) {
+			next = fmt.Sprintf(`,"next":"http://%s%s?cursor=next"`, r.Host, path)
+		}
+
+		fmt.Fprintf(w, `{"data":[%s],"links":{"self":""%s}}`, pages[page], next)
+	}))
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	return client, server
+}
+
+func TestFindScmGitReference(t *testing.T) {
+	t.Parallel()
+
+	client, server := newScmPagedServer("/scmRepositories/10/gitReferences",
+		`{"id":"1","type":"scmGitReferences","attributes":{"name":"main","kind":"TAG"}},
+		 {"id":"2","type":"scmGitReferences","attributes":{"name":"main","kind":"BRANCH","isDeleted":true}}`,
+		`{"id":"3","type":"scmGitReferences","attributes":{"name":"main","canonicalName":"refs/heads/main","kind":"BRANCH"}}`,
+	)
+	defer server.Close()
+
+	ref, err := client.XcodeCloud.FindScmGitReference(context.Background(), "10", ScmGitReferenceKindBranch, "main")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", ref.ID)
+
+	ref, err = client.XcodeCloud.FindScmGitReference(context.Background(), "10", ScmGitReferenceKindBranch, "refs/heads/main")
+	assert.NoError(t, err)
+	assert.Equal(t, "3", ref.ID)
+
+	ref, err = client.XcodeCloud.FindScmGitReference(context.Background(), "10", ScmGitReferenceKindTag, "1.0")
+	assert.ErrorIs(t, err, ErrScmGitReferenceNotFound)
+	assert.Nil(t, ref)
+}
+
+func TestFindScmGitReferenceError(t *testing.T) {
+	t.Parallel()
+
+	client, server := newScmPagedServer("/nowhere")
+	defer server.Close()
+
+	ref, err := client.XcodeCloud.FindScmGitReference(context.Background(), "10", ScmGitReferenceKindBranch, "main")
+	assert.Error(t, err)
+	assert.NotErrorIs(t, err, ErrScmGitReferenceNotFound)
+	assert.Nil(t, ref)
+}
+
+func TestFindScmPullRequest(t *testing.T) {
+	t.Parallel()
+
+	client, server := newScmPagedServer("/scmRepositories/10/pullRequests",
+		`{"id":"1","type":"scmPullRequests","attributes":{"number":41}}`,
+		`{"id":"2","type":"scmPullRequests","attributes":{"number":42}}`,
+	)
+	defer server.Close()
+
+	pr, err := client.XcodeCloud.FindScmPullRequest(context.Background(), "10", 42)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", pr.ID)
+
+	pr, err = client.XcodeCloud.FindScmPullRequest(context.Background(), "10", 7)
+	assert.ErrorIs(t, err, ErrScmPullRequestNotFound)
+	assert.Nil(t, pr)
+}
+
+func TestParseScmGitReferenceKind(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseScmGitReferenceKind(string(ScmGitReferenceKindBranch))
+	assert.NoError(t, err)
+	assert.Equal(t, ScmGitReferenceKindBranch, got)
+
+	_, err = ParseScmGitReferenceKind("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "ScmGitReferenceKind", Value: "UNKNOWN"}, err)
+}
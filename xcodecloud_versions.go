@@ -0,0 +1,414 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrNoCompatibleCiXcodeVersion happens when no pinnable Xcode version supports a macOS version.
+var ErrNoCompatibleCiXcodeVersion = errors.New("no compatible Xcode version")
+
+// ErrMissingCiMacOsVersion happens when a workflow does not report the macOS version it runs on.
+var ErrMissingCiMacOsVersion = errors.New("workflow has no macOS version")
+
+// CiXcodeVersion defines model for CiXcodeVersion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversion
+type CiXcodeVersion struct {
+	Attributes    *CiXcodeVersionAttributes    `json:"attributes,omitempty"`
+	ID            string                       `json:"id"`
+	Links         ResourceLinks                `json:"links"`
+	Relationships *CiXcodeVersionRelationships `json:"relationships,omitempty"`
+	Type          string                       `json:"type"`
+}
+
+// CiXcodeVersionAttributes defines model for CiXcodeVersion.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversion/attributes
+type CiXcodeVersionAttributes struct {
+	Name             *string                         `json:"name,omitempty"`
+	TestDestinations []CiXcodeVersionTestDestination `json:"testDestinations,omitempty"`
+	Version          *string                         `json:"version,omitempty"`
+}
+
+// CiXcodeVersionTestDestination defines model for CiXcodeVersion.Attributes.TestDestinations
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversion/attributes
+type CiXcodeVersionTestDestination struct {
+	AvailableRuntimes    []CiXcodeVersionTestRuntime `json:"availableRuntimes,omitempty"`
+	DeviceTypeIdentifier *string                     `json:"deviceTypeIdentifier,omitempty"`
+	DeviceTypeName       *string                     `json:"deviceTypeName,omitempty"`
+	Kind                 *CiTestDestinationKind      `json:"kind,omitempty"`
+}
+
+// CiXcodeVersionTestRuntime defines model for CiXcodeVersion.Attributes.TestDestinations.AvailableRuntimes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversion/attributes
+type CiXcodeVersionTestRuntime struct {
+	RuntimeIdentifier *string `json:"runtimeIdentifier,omitempty"`
+	RuntimeName       *string `json:"runtimeName,omitempty"`
+}
+
+// CiXcodeVersionRelationships defines model for CiXcodeVersion.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversion/relationships
+type CiXcodeVersionRelationships struct {
+	MacOsVersions *PagedRelationship `json:"macOsVersions,omitempty"`
+}
+
+// CiXcodeVersionResponse defines model for CiXcodeVersionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversionresponse
+type CiXcodeVersionResponse struct {
+	Data     CiXcodeVersion   `json:"data"`
+	Included []CiMacOsVersion `json:"included,omitempty"`
+	Links    DocumentLinks    `json:"links"`
+}
+
+// CiXcodeVersionsResponse defines model for CiXcodeVersionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cixcodeversionsresponse
+type CiXcodeVersionsResponse struct {
+	Data     []CiXcodeVersion   `json:"data"`
+	Included []CiMacOsVersion   `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// CiMacOsVersion defines model for CiMacOsVersion.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimacosversion
+type CiMacOsVersion struct {
+	Attributes    *CiMacOsVersionAttributes    `json:"attributes,omitempty"`
+	ID            string                       `json:"id"`
+	Links         ResourceLinks                `json:"links"`
+	Relationships *CiMacOsVersionRelationships `json:"relationships,omitempty"`
+	Type          string                       `json:"type"`
+}
+
+// CiMacOsVersionAttributes defines model for CiMacOsVersion.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimacosversion/attributes
+type CiMacOsVersionAttributes struct {
+	Name    *string `json:"name,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
+
+// CiMacOsVersionRelationships defines model for CiMacOsVersion.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimacosversion/relationships
+type CiMacOsVersionRelationships struct {
+	XcodeVersions *PagedRelationship `json:"xcodeVersions,omitempty"`
+}
+
+// CiMacOsVersionResponse defines model for CiMacOsVersionResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimacosversionresponse
+type CiMacOsVersionResponse struct {
+	Data     CiMacOsVersion   `json:"data"`
+	Included []CiXcodeVersion `json:"included,omitempty"`
+	Links    DocumentLinks    `json:"links"`
+}
+
+// CiMacOsVersionsResponse defines model for CiMacOsVersionsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimacosversionsresponse
+type CiMacOsVersionsResponse struct {
+	Data     []CiMacOsVersion   `json:"data"`
+	Included []CiXcodeVersion   `json:"included,omitempty"`
+	Links    PagedDocumentLinks `json:"links"`
+	Meta     *PagingInformation `json:"meta,omitempty"`
+}
+
+// ListCiXcodeVersionsQuery are query options for ListCiXcodeVersions
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_xcode_versions_available_in_xcode_cloud
+type ListCiXcodeVersionsQuery struct {
+	FieldsCiMacOsVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	FieldsCiXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	Include               []string `url:"include,omitempty"`
+	Limit                 int      `url:"limit,omitempty"`
+	LimitMacOsVersions    int      `url:"limit[macOsVersions],omitempty"`
+	Cursor                string   `url:"cursor,omitempty"`
+}
+
+// GetCiXcodeVersionQuery are query options for GetCiXcodeVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_version_information
+type GetCiXcodeVersionQuery struct {
+	FieldsCiMacOsVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	FieldsCiXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	Include               []string `url:"include,omitempty"`
+	LimitMacOsVersions    int      `url:"limit[macOsVersions],omitempty"`
+}
+
+// ListCiMacOsVersionsForCiXcodeVersionQuery are query options for ListCiMacOsVersionsForCiXcodeVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_macos_versions_available_for_an_xcode_version
+type ListCiMacOsVersionsForCiXcodeVersionQuery struct {
+	FieldsCiMacOsVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	Limit                 int      `url:"limit,omitempty"`
+	Cursor                string   `url:"cursor,omitempty"`
+}
+
+// ListCiMacOsVersionsQuery are query options for ListCiMacOsVersions
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_macos_versions_available_in_xcode_cloud
+type ListCiMacOsVersionsQuery struct {
+	FieldsCiMacOsVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	FieldsCiXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	Include               []string `url:"include,omitempty"`
+	Limit                 int      `url:"limit,omitempty"`
+	LimitXcodeVersions    int      `url:"limit[xcodeVersions],omitempty"`
+	Cursor                string   `url:"cursor,omitempty"`
+}
+
+// GetCiMacOsVersionQuery are query options for GetCiMacOsVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_macos_version_information
+type GetCiMacOsVersionQuery struct {
+	FieldsCiMacOsVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	FieldsCiXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	Include               []string `url:"include,omitempty"`
+	LimitXcodeVersions    int      `url:"limit[xcodeVersions],omitempty"`
+}
+
+// ListCiXcodeVersionsForCiMacOsVersionQuery are query options for ListCiXcodeVersionsForCiMacOsVersion
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_xcode_versions_for_a_macos_version
+type ListCiXcodeVersionsForCiMacOsVersionQuery struct {
+	FieldsCiXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	Limit                 int      `url:"limit,omitempty"`
+	Cursor                string   `url:"cursor,omitempty"`
+}
+
+// ListCiXcodeVersions lists the Xcode versions available in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_xcode_versions_available_in_xcode_cloud
+func (s *XcodeCloudService) ListCiXcodeVersions(ctx context.Context, params *ListCiXcodeVersionsQuery) (*CiXcodeVersionsResponse, *Response, error) {
+	return Get[CiXcodeVersionsResponse](ctx, s.client, "ciXcodeVersions", params)
+}
+
+// GetCiXcodeVersion gets information about an Xcode version available in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_xcode_version_information
+func (s *XcodeCloudService) GetCiXcodeVersion(ctx context.Context, id string, params *GetCiXcodeVersionQuery) (*CiXcodeVersionResponse, *Response, error) {
+	return Get[CiXcodeVersionResponse](ctx, s.client, fmt.Sprintf("ciXcodeVersions/%s", id), params)
+}
+
+// ListCiMacOsVersionsForCiXcodeVersion lists the macOS versions an Xcode version can run on in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_macos_versions_available_for_an_xcode_version
+func (s *XcodeCloudService) ListCiMacOsVersionsForCiXcodeVersion(ctx context.Context, id string, params *ListCiMacOsVersionsForCiXcodeVersionQuery) (*CiMacOsVersionsResponse, *Response, error) {
+	return Get[CiMacOsVersionsResponse](ctx, s.client, fmt.Sprintf("ciXcodeVersions/%s/macOsVersions", id), params)
+}
+
+// ListCiMacOsVersions lists the macOS versions available in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_macos_versions_available_in_xcode_cloud
+func (s *XcodeCloudService) ListCiMacOsVersions(ctx context.Context, params *ListCiMacOsVersionsQuery) (*CiMacOsVersionsResponse, *Response, error) {
+	return Get[CiMacOsVersionsResponse](ctx, s.client, "ciMacOsVersions", params)
+}
+
+// GetCiMacOsVersion gets information about a macOS version available in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_macos_version_information
+func (s *XcodeCloudService) GetCiMacOsVersion(ctx context.Context, id string, params *GetCiMacOsVersionQuery) (*CiMacOsVersionResponse, *Response, error) {
+	return Get[CiMacOsVersionResponse](ctx, s.client, fmt.Sprintf("ciMacOsVersions/%s", id), params)
+}
+
+// ListCiXcodeVersionsForCiMacOsVersion lists the Xcode versions that can run on a macOS version in Xcode Cloud.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_xcode_versions_for_a_macos_version
+func (s *XcodeCloudService) ListCiXcodeVersionsForCiMacOsVersion(ctx context.Context, id string, params *ListCiXcodeVersionsForCiMacOsVersionQuery) (*CiXcodeVersionsResponse, *Response, error) {
+	return Get[CiXcodeVersionsResponse](ctx, s.client, fmt.Sprintf("ciMacOsVersions/%s/xcodeVersions", id), params)
+}
+
+// NewestCompatibleCiXcodeVersion finds the newest Xcode version that can run on a macOS version. Floating versions
+// such as "Latest Release" are never returned, and betas and release candidates are only considered when
+// includePrereleases is true.
+func (s *XcodeCloudService) NewestCompatibleCiXcodeVersion(ctx context.Context, macOsVersionID string, includePrereleases bool) (*CiXcodeVersion, error) {
+	params := &ListCiXcodeVersionsForCiMacOsVersionQuery{Limit: MaxPageLimit}
+
+	var newest *CiXcodeVersion
+
+	err := ForEachPage(func(cursor string) (*PagedDocumentLinks, error) {
+		params.Cursor = cursor
+
+		res, _, err := s.ListCiXcodeVersionsForCiMacOsVersion(ctx, macOsVersionID, params)
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range res.Data {
+			version := &res.Data[i]
+			if version.IsFloating() || (version.IsPrerelease() && !includePrereleases) {
+				continue
+			}
+
+			if newest == nil || compareCiXcodeVersions(version, newest) > 0 {
+				newest = version
+			}
+		}
+
+		return &res.Links, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if newest == nil {
+		return nil, fmt.Errorf("%w: macOS version %s", ErrNoCompatibleCiXcodeVersion, macOsVersionID)
+	}
+
+	return newest, nil
+}
+
+// PinNewestCiXcodeVersion updates a workflow to build with the newest Xcode version compatible with the macOS
+// version it already runs on. It returns the pinned Xcode version, and whether the workflow had to be changed.
+func (s *XcodeCloudService) PinNewestCiXcodeVersion(ctx context.Context, workflowID string, includePrereleases bool) (*CiXcodeVersion, bool, error) {
+	workflow, _, err := s.GetCiWorkflow(ctx, workflowID, &GetCiWorkflowQuery{
+		Include: []string{"macOsVersion", "xcodeVersion"},
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	relationships := workflow.Data.Relationships
+	if relationships == nil || relationships.MacOsVersion == nil || relationships.MacOsVersion.Data == nil {
+		return nil, false, fmt.Errorf("%w: %s", ErrMissingCiMacOsVersion, workflowID)
+	}
+
+	newest, err := s.NewestCompatibleCiXcodeVersion(ctx, relationships.MacOsVersion.Data.ID, includePrereleases)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if relationships.XcodeVersion != nil && relationships.XcodeVersion.Data != nil && relationships.XcodeVersion.Data.ID == newest.ID {
+		return newest, false, nil
+	}
+
+	if _, _, err := s.UpdateCiWorkflow(ctx, workflowID, nil, &newest.ID, nil); err != nil {
+		return nil, false, fmt.Errorf("failed to pin workflow %s to Xcode version %s: %w", workflowID, newest.ID, err)
+	}
+
+	return newest, true, nil
+}
+
+// IsFloating returns true for versions such as "Latest Release" that move to a new Xcode as Apple releases one.
+func (v *CiXcodeVersion) IsFloating() bool {
+	if strings.HasPrefix(strings.ToLower(v.ID), "latest") {
+		return true
+	}
+
+	return v.Attributes != nil && v.Attributes.Name != nil && strings.HasPrefix(strings.ToLower(*v.Attributes.Name), "latest")
+}
+
+// IsPrerelease returns true for beta and release candidate versions of Xcode.
+func (v *CiXcodeVersion) IsPrerelease() bool {
+	return ciXcodeVersionStage(v) < ciXcodeStageRelease
+}
+
+const (
+	ciXcodeStageBeta = iota
+	ciXcodeStageReleaseCandidate
+	ciXcodeStageRelease
+)
+
+var ciXcodeVersionNumber = regexp.MustCompile(`\d+(\.\d+)*`)
+
+func ciXcodeVersionName(v *CiXcodeVersion) string {
+	if v.Attributes == nil || v.Attributes.Name == nil {
+		return ""
+	}
+
+	return *v.Attributes.Name
+}
+
+func ciXcodeVersionStage(v *CiXcodeVersion) int {
+	name := strings.ToLower(ciXcodeVersionName(v))
+
+	switch {
+	case strings.Contains(name, "beta"):
+		return ciXcodeStageBeta
+	case strings.Contains(name, "release candidate"), strings.Contains(name, " rc"):
+		return ciXcodeStageReleaseCandidate
+	default:
+		return ciXcodeStageRelease
+	}
+}
+
+// compareCiXcodeVersions orders Xcode versions by the version number in their name, such as "Xcode 15.1 beta 2",
+// then by release stage, then by prerelease number.
+func compareCiXcodeVersions(a, b *CiXcodeVersion) int {
+	numbersA := ciXcodeVersionNumber.FindAllString(ciXcodeVersionName(a), -1)
+	numbersB := ciXcodeVersionNumber.FindAllString(ciXcodeVersionName(b), -1)
+
+	if c := compareDottedNumbers(firstOrEmpty(numbersA), firstOrEmpty(numbersB)); c != 0 {
+		return c
+	}
+
+	if c := ciXcodeVersionStage(a) - ciXcodeVersionStage(b); c != 0 {
+		return c
+	}
+
+	if len(numbersA) > 1 && len(numbersB) > 1 {
+		return compareDottedNumbers(numbersA[1], numbersB[1])
+	}
+
+	return len(numbersA) - len(numbersB)
+}
+
+func compareDottedNumbers(a, b string) int {
+	partsA := strings.Split(a, ".")
+	partsB := strings.Split(b, ".")
+
+	for i := 0; i < len(partsA) || i < len(partsB); i++ {
+		var x, y int
+		if i < len(partsA) {
+			x, _ = strconv.Atoi(partsA[i])
+		}
+
+		if i < len(partsB) {
+			y, _ = strconv.Atoi(partsB[i])
+		}
+
+		if x != y {
+			return x - y
+		}
+	}
+
+	return 0
+}
+
+func firstOrEmpty(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,176 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCiXcodeVersions(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiXcodeVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiXcodeVersions(ctx, &ListCiXcodeVersionsQuery{})
+	})
+}
+
+func TestGetCiXcodeVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiXcodeVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetCiXcodeVersion(ctx, "10", &GetCiXcodeVersionQuery{})
+	})
+}
+
+func TestListCiMacOsVersionsForCiXcodeVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiMacOsVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiMacOsVersionsForCiXcodeVersion(ctx, "10", &ListCiMacOsVersionsForCiXcodeVersionQuery{})
+	})
+}
+
+func TestListCiMacOsVersions(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiMacOsVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiMacOsVersions(ctx, &ListCiMacOsVersionsQuery{})
+	})
+}
+
+func TestGetCiMacOsVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiMacOsVersionResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetCiMacOsVersion(ctx, "10", &GetCiMacOsVersionQuery{})
+	})
+}
+
+func TestListCiXcodeVersionsForCiMacOsVersion(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiXcodeVersionsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiXcodeVersionsForCiMacOsVersion(ctx, "10", &ListCiXcodeVersionsForCiMacOsVersionQuery{})
+	})
+}
+
+const testCiXcodeVersions = `{"data":[
+	{"id":"latest:stable","type":"ciXcodeVersions","attributes":{"name":"Latest Release"}},
+	{"id":"14","type":"ciXcodeVersions","attributes":{"name":"Xcode 14.3.1"}},
+	{"id":"15b","type":"ciXcodeVersions","attributes":{"name":"Xcode 15.1 beta 2"}},
+	{"id":"15","type":"ciXcodeVersions","attributes":{"name":"Xcode 15.0"}},
+	{"id":"15rc","type":"ciXcodeVersions","attributes":{"name":"Xcode 15.1 Release Candidate"}}
+],"links":{"self":""}}`
+
+func TestNewestCompatibleCiXcodeVersion(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /ciMacOsVersions/20/xcodeVersions": testCiXcodeVersions,
+	})
+	defer server.Close()
+
+	version, err := client.XcodeCloud.NewestCompatibleCiXcodeVersion(context.Background(), "20", false)
+	assert.NoError(t, err)
+	assert.Equal(t, "15", version.ID)
+
+	version, err = client.XcodeCloud.NewestCompatibleCiXcodeVersion(context.Background(), "20", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "15rc", version.ID)
+}
+
+func TestNewestCompatibleCiXcodeVersionNoneFound(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /ciMacOsVersions/20/xcodeVersions": `{"data":[{"id":"latest:stable","type":"ciXcodeVersions"}],"links":{"self":""}}`,
+	})
+	defer server.Close()
+
+	version, err := client.XcodeCloud.NewestCompatibleCiXcodeVersion(context.Background(), "20", true)
+	assert.ErrorIs(t, err, ErrNoCompatibleCiXcodeVersion)
+	assert.Nil(t, version)
+}
+
+func TestPinNewestCiXcodeVersion(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /ciWorkflows/10":                   `{"data":{"id":"10","type":"ciWorkflows","relationships":{"macOsVersion":{"data":{"id":"20","type":"ciMacOsVersions"}},"xcodeVersion":{"data":{"id":"14","type":"ciXcodeVersions"}}}}}`,
+		"GET /ciMacOsVersions/20/xcodeVersions": testCiXcodeVersions,
+		"PATCH /ciWorkflows/10":                 `{"data":{"id":"10","type":"ciWorkflows"}}`,
+	})
+	defer server.Close()
+
+	version, changed, err := client.XcodeCloud.PinNewestCiXcodeVersion(context.Background(), "10", false)
+	assert.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "15", version.ID)
+	assert.Equal(t, []string{"GET /ciWorkflows/10", "GET /ciMacOsVersions/20/xcodeVersions", "PATCH /ciWorkflows/10"}, *requests)
+}
+
+func TestPinNewestCiXcodeVersionUnchanged(t *testing.T) {
+	t.Parallel()
+
+	client, server, requests := newRoutedServer(map[string]string{
+		"GET /ciWorkflows/10":                   `{"data":{"id":"10","type":"ciWorkflows","relationships":{"macOsVersion":{"data":{"id":"20","type":"ciMacOsVersions"}},"xcodeVersion":{"data":{"id":"15","type":"ciXcodeVersions"}}}}}`,
+		"GET /ciMacOsVersions/20/xcodeVersions": testCiXcodeVersions,
+	})
+	defer server.Close()
+
+	version, changed, err := client.XcodeCloud.PinNewestCiXcodeVersion(context.Background(), "10", false)
+	assert.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, "15", version.ID)
+	assert.Len(t, *requests, 2)
+}
+
+func TestPinNewestCiXcodeVersionMissingMacOsVersion(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /ciWorkflows/10": `{"data":{"id":"10","type":"ciWorkflows"}}`,
+	})
+	defer server.Close()
+
+	_, _, err := client.XcodeCloud.PinNewestCiXcodeVersion(context.Background(), "10", false)
+	assert.ErrorIs(t, err, ErrMissingCiMacOsVersion)
+}
+
+func TestCompareCiXcodeVersions(t *testing.T) {
+	t.Parallel()
+
+	named := func(name string) *CiXcodeVersion {
+		return &CiXcodeVersion{Attributes: &CiXcodeVersionAttributes{Name: &name}}
+	}
+
+	assert.Greater(t, compareCiXcodeVersions(named("Xcode 15.0.1"), named("Xcode 15.0")), 0)
+	assert.Greater(t, compareCiXcodeVersions(named("Xcode 15.10"), named("Xcode 15.9")), 0)
+	assert.Greater(t, compareCiXcodeVersions(named("Xcode 15.1"), named("Xcode 15.1 beta 3")), 0)
+	assert.Greater(t, compareCiXcodeVersions(named("Xcode 15.1 beta 3"), named("Xcode 15.1 beta 2")), 0)
+	assert.Less(t, compareCiXcodeVersions(named("Xcode 15.1 beta 3"), named("Xcode 15.1 Release Candidate")), 0)
+	assert.Equal(t, 0, compareCiXcodeVersions(named("Xcode 15.0"), named("Xcode 15")))
+	assert.True(t, named("Latest Release").IsFloating())
+	assert.False(t, named("Xcode 15.0").IsPrerelease())
+}
@@ -0,0 +1,387 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ErrIncompleteCiWorkflowDocument happens when a workflow document lacks the Xcode or macOS version needed to
+// create a workflow from it.
+var ErrIncompleteCiWorkflowDocument = errors.New("workflow document has no Xcode or macOS version")
+
+// CiWorkflowDocument is the portable configuration of an Xcode Cloud workflow: its actions, start conditions and
+// the environment it builds in. It marshals to and from YAML using the attribute names of the API, so it can be
+// stored and reviewed alongside the code it builds.
+type CiWorkflowDocument struct {
+	Name                            string                             `json:"name"`
+	Description                     string                             `json:"description"`
+	IsEnabled                       bool                               `json:"isEnabled"`
+	IsLockedForEditing              bool                               `json:"isLockedForEditing"`
+	Clean                           bool                               `json:"clean"`
+	ContainerFilePath               string                             `json:"containerFilePath"`
+	Environment                     CiWorkflowEnvironment              `json:"environment"`
+	Actions                         []CiAction                         `json:"actions"`
+	BranchStartCondition            *CiBranchStartCondition            `json:"branchStartCondition,omitempty"`
+	TagStartCondition               *CiTagStartCondition               `json:"tagStartCondition,omitempty"`
+	PullRequestStartCondition       *CiPullRequestStartCondition       `json:"pullRequestStartCondition,omitempty"`
+	ScheduledStartCondition         *CiScheduledStartCondition         `json:"scheduledStartCondition,omitempty"`
+	ManualBranchStartCondition      *CiManualBranchStartCondition      `json:"manualBranchStartCondition,omitempty"`
+	ManualTagStartCondition         *CiManualTagStartCondition         `json:"manualTagStartCondition,omitempty"`
+	ManualPullRequestStartCondition *CiManualPullRequestStartCondition `json:"manualPullRequestStartCondition,omitempty"`
+}
+
+// CiWorkflowEnvironment is the Xcode and macOS version a workflow builds with, by their IDs.
+type CiWorkflowEnvironment struct {
+	XcodeVersion string `json:"xcodeVersion,omitempty"`
+	MacOsVersion string `json:"macOsVersion,omitempty"`
+}
+
+// CiWorkflowChange is a single difference between the configuration of a workflow and a document.
+type CiWorkflowChange struct {
+	// Path locates the changed value, such as "actions[0].scheme".
+	Path    string `json:"path"`
+	Current string `json:"current"`
+	Desired string `json:"desired"`
+}
+
+func (c CiWorkflowChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Path, formatCiWorkflowValue(c.Current), formatCiWorkflowValue(c.Desired))
+}
+
+// CiWorkflowPlan is the set of changes needed to bring a workflow to a document. Review it with String, which
+// serves as a dry run, before passing it to ApplyCiWorkflowPlan.
+type CiWorkflowPlan struct {
+	WorkflowID string              `json:"workflowId"`
+	Document   *CiWorkflowDocument `json:"document"`
+	Changes    []CiWorkflowChange  `json:"changes"`
+}
+
+// OutputKind implements Output.
+func (p CiWorkflowPlan) OutputKind() string {
+	return "ciWorkflowPlan"
+}
+
+// MarshalJSON encodes a plan without changes with an empty list of changes rather than null.
+func (p CiWorkflowPlan) MarshalJSON() ([]byte, error) {
+	type plan CiWorkflowPlan
+
+	if p.Changes == nil {
+		p.Changes = []CiWorkflowChange{}
+	}
+
+	return json.Marshal(plan(p))
+}
+
+func (p *CiWorkflowPlan) String() string {
+	if len(p.Changes) == 0 {
+		return fmt.Sprintf("workflow %s matches the document\n", p.WorkflowID)
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "%d changes to workflow %s\n", len(p.Changes), p.WorkflowID)
+
+	for _, change := range p.Changes {
+		fmt.Fprintf(&b, "  %s\n", change)
+	}
+
+	return b.String()
+}
+
+// NewCiWorkflowDocument captures the configuration of a workflow. The workflow must have been fetched with its
+// macOsVersion and xcodeVersion relationships included for the environment to be filled in.
+func NewCiWorkflowDocument(workflow *CiWorkflow) *CiWorkflowDocument {
+	doc := &CiWorkflowDocument{}
+
+	if attributes := workflow.Attributes; attributes != nil {
+		doc.Name = stringValue(attributes.Name)
+		doc.Description = stringValue(attributes.Description)
+		doc.IsEnabled = boolValue(attributes.IsEnabled)
+		doc.IsLockedForEditing = boolValue(attributes.IsLockedForEditing)
+		doc.Clean = boolValue(attributes.Clean)
+		doc.ContainerFilePath = stringValue(attributes.ContainerFilePath)
+		doc.Actions = attributes.Actions
+		doc.BranchStartCondition = attributes.BranchStartCondition
+		doc.TagStartCondition = attributes.TagStartCondition
+		doc.PullRequestStartCondition = attributes.PullRequestStartCondition
+		doc.ScheduledStartCondition = attributes.ScheduledStartCondition
+		doc.ManualBranchStartCondition = attributes.ManualBranchStartCondition
+		doc.ManualTagStartCondition = attributes.ManualTagStartCondition
+		doc.ManualPullRequestStartCondition = attributes.ManualPullRequestStartCondition
+	}
+
+	if relationships := workflow.Relationships; relationships != nil {
+		if relationships.XcodeVersion != nil && relationships.XcodeVersion.Data != nil {
+			doc.Environment.XcodeVersion = relationships.XcodeVersion.Data.ID
+		}
+
+		if relationships.MacOsVersion != nil && relationships.MacOsVersion.Data != nil {
+			doc.Environment.MacOsVersion = relationships.MacOsVersion.Data.ID
+		}
+	}
+
+	return doc
+}
+
+// MarshalYAML implements yaml.Marshaler, writing the document with the attribute names of the API.
+func (d CiWorkflowDocument) MarshalYAML() (interface{}, error) {
+	return ciWorkflowDocumentValues(&d)
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler, reading a document written by MarshalYAML.
+func (d *CiWorkflowDocument) UnmarshalYAML(node *yaml.Node) error {
+	var values interface{}
+	if err := node.Decode(&values); err != nil {
+		return err
+	}
+
+	b, err := json.Marshal(values)
+	if err != nil {
+		return err
+	}
+
+	type document CiWorkflowDocument
+
+	return json.Unmarshal(b, (*document)(d))
+}
+
+// ExportCiWorkflow captures the configuration of a workflow as a document, ready to be marshaled to YAML.
+func (s *XcodeCloudService) ExportCiWorkflow(ctx context.Context, workflowID string) (*CiWorkflowDocument, error) {
+	res, _, err := s.GetCiWorkflow(ctx, workflowID, &GetCiWorkflowQuery{
+		Include: []string{"macOsVersion", "xcodeVersion"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCiWorkflowDocument(&res.Data), nil
+}
+
+// PlanCiWorkflowImport compares the configuration of a workflow with a document and returns the changes needed
+// to converge on it without applying them.
+func (s *XcodeCloudService) PlanCiWorkflowImport(ctx context.Context, workflowID string, doc *CiWorkflowDocument) (*CiWorkflowPlan, error) {
+	current, err := s.ExportCiWorkflow(ctx, workflowID)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := DiffCiWorkflowDocuments(current, doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CiWorkflowPlan{
+		WorkflowID: workflowID,
+		Document:   doc,
+		Changes:    changes,
+	}, nil
+}
+
+// ApplyCiWorkflowPlan updates the workflow with the attributes and environment that changed in a plan returned
+// by PlanCiWorkflowImport. Start conditions missing from the document are removed from the workflow. An empty
+// environment leaves the Xcode and macOS versions of the workflow as they are.
+func (s *XcodeCloudService) ApplyCiWorkflowPlan(ctx context.Context, plan *CiWorkflowPlan) error {
+	if len(plan.Changes) == 0 {
+		return nil
+	}
+
+	desired, err := ciWorkflowDocumentValues(plan.Document)
+	if err != nil {
+		return err
+	}
+
+	req := ciWorkflowImportRequest{
+		Attributes: map[string]interface{}{},
+		ID:         plan.WorkflowID,
+		Type:       "ciWorkflows",
+	}
+
+	for _, change := range plan.Changes {
+		key := ciWorkflowChangeKey(change.Path)
+		if key == "environment" {
+			continue
+		}
+
+		req.Attributes[key] = desired[key]
+	}
+
+	environment := plan.Document.Environment
+	if environment.XcodeVersion != "" || environment.MacOsVersion != "" {
+		req.Relationships = &ciWorkflowUpdateRequestRelationships{
+			MacOsVersion: newRelationshipDeclaration(optionalString(environment.MacOsVersion), "ciMacOsVersions"),
+			XcodeVersion: newRelationshipDeclaration(optionalString(environment.XcodeVersion), "ciXcodeVersions"),
+		}
+	}
+
+	url := fmt.Sprintf("ciWorkflows/%s", plan.WorkflowID)
+
+	if _, err := s.client.patch(ctx, url, newRequestBody(req), nil); err != nil {
+		return fmt.Errorf("failed to update workflow %s: %w", plan.WorkflowID, err)
+	}
+
+	return nil
+}
+
+// CreateCiWorkflowFromDocument creates a workflow for a product and repository from a document, such as one
+// exported from a workflow of another product.
+func (s *XcodeCloudService) CreateCiWorkflowFromDocument(ctx context.Context, doc *CiWorkflowDocument, productID string, repositoryID string) (*CiWorkflowResponse, *Response, error) {
+	if doc.Environment.XcodeVersion == "" || doc.Environment.MacOsVersion == "" {
+		return nil, nil, ErrIncompleteCiWorkflowDocument
+	}
+
+	attributes := CiWorkflowCreateRequestAttributes{
+		Actions:                         doc.Actions,
+		BranchStartCondition:            doc.BranchStartCondition,
+		Clean:                           doc.Clean,
+		ContainerFilePath:               doc.ContainerFilePath,
+		Description:                     doc.Description,
+		IsEnabled:                       doc.IsEnabled,
+		IsLockedForEditing:              Bool(doc.IsLockedForEditing),
+		ManualBranchStartCondition:      doc.ManualBranchStartCondition,
+		ManualPullRequestStartCondition: doc.ManualPullRequestStartCondition,
+		ManualTagStartCondition:         doc.ManualTagStartCondition,
+		Name:                            doc.Name,
+		PullRequestStartCondition:       doc.PullRequestStartCondition,
+		ScheduledStartCondition:         doc.ScheduledStartCondition,
+		TagStartCondition:               doc.TagStartCondition,
+	}
+
+	return s.CreateCiWorkflow(ctx, attributes, productID, repositoryID, doc.Environment.XcodeVersion, doc.Environment.MacOsVersion)
+}
+
+// DiffCiWorkflowDocuments lists every value that differs between two workflow documents, ordered by path.
+func DiffCiWorkflowDocuments(current, desired *CiWorkflowDocument) ([]CiWorkflowChange, error) {
+	currentValues, err := ciWorkflowDocumentValues(current)
+	if err != nil {
+		return nil, err
+	}
+
+	desiredValues, err := ciWorkflowDocumentValues(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	before := make(map[string]string)
+	after := make(map[string]string)
+
+	flattenCiWorkflowValue("", currentValues, before)
+	flattenCiWorkflowValue("", desiredValues, after)
+
+	paths := make([]string, 0, len(before)+len(after))
+
+	for path := range before {
+		paths = append(paths, path)
+	}
+
+	for path := range after {
+		if _, ok := before[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Strings(paths)
+
+	changes := make([]CiWorkflowChange, 0)
+
+	for _, path := range paths {
+		if before[path] != after[path] {
+			changes = append(changes, CiWorkflowChange{Path: path, Current: before[path], Desired: after[path]})
+		}
+	}
+
+	return changes, nil
+}
+
+// ciWorkflowImportRequest is a CiWorkflowUpdateRequest whose attributes can be explicitly null, which is how
+// start conditions are removed from a workflow.
+type ciWorkflowImportRequest struct {
+	Attributes    map[string]interface{}                `json:"attributes,omitempty"`
+	ID            string                                `json:"id"`
+	Relationships *ciWorkflowUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                `json:"type"`
+}
+
+func ciWorkflowDocumentValues(doc *CiWorkflowDocument) (map[string]interface{}, error) {
+	type document CiWorkflowDocument
+
+	b, err := json.Marshal((*document)(doc))
+	if err != nil {
+		return nil, err
+	}
+
+	var values map[string]interface{}
+	err = json.Unmarshal(b, &values)
+
+	return values, err
+}
+
+func flattenCiWorkflowValue(path string, value interface{}, out map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, inner := range v {
+			if path == "" {
+				flattenCiWorkflowValue(key, inner, out)
+			} else {
+				flattenCiWorkflowValue(path+"."+key, inner, out)
+			}
+		}
+	case []interface{}:
+		for i, inner := range v {
+			flattenCiWorkflowValue(fmt.Sprintf("%s[%d]", path, i), inner, out)
+		}
+	case nil:
+	default:
+		b, _ := json.Marshal(v)
+		out[path] = string(b)
+	}
+}
+
+func ciWorkflowChangeKey(path string) string {
+	if i := strings.IndexAny(path, ".["); i >= 0 {
+		return path[:i]
+	}
+
+	return path
+}
+
+func formatCiWorkflowValue(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+
+	return value
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+
+	return &value
+}
@@ -0,0 +1,201 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+const testCiWorkflow = `{"data":{"id":"10","type":"ciWorkflows",
+	"attributes":{
+		"name":"Release","description":"","isEnabled":true,"clean":false,"containerFilePath":"App.xcodeproj",
+		"actions":[{"actionType":"ARCHIVE","name":"Archive","platform":"IOS","scheme":"App"}],
+		"branchStartCondition":{"source":{"patterns":[{"pattern":"main","isPrefix":false}]}},
+		"tagStartCondition":{"source":{"isAllMatch":true}}
+	},
+	"relationships":{
+		"macOsVersion":{"data":{"id":"20","type":"ciMacOsVersions"}},
+		"xcodeVersion":{"data":{"id":"14","type":"ciXcodeVersions"}}
+	}
+}}`
+
+func TestCiWorkflowDocumentYAMLRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	var res CiWorkflowResponse
+
+	assert.NoError(t, json.Unmarshal([]byte(testCiWorkflow), &res))
+
+	doc := NewCiWorkflowDocument(&res.Data)
+	assert.Equal(t, CiWorkflowEnvironment{XcodeVersion: "14", MacOsVersion: "20"}, doc.Environment)
+
+	b, err := yaml.Marshal(doc)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), "containerFilePath: App.xcodeproj")
+	assert.Contains(t, string(b), "actionType: ARCHIVE")
+	assert.NotContains(t, string(b), "pullRequestStartCondition")
+
+	var decoded CiWorkflowDocument
+
+	assert.NoError(t, yaml.Unmarshal(b, &decoded))
+	assert.Equal(t, doc, &decoded)
+
+	changes, err := DiffCiWorkflowDocuments(doc, &decoded)
+	assert.NoError(t, err)
+	assert.Empty(t, changes)
+}
+
+func TestDiffCiWorkflowDocuments(t *testing.T) {
+	t.Parallel()
+
+	current := &CiWorkflowDocument{
+		Name:    "Release",
+		Actions: []CiAction{{Scheme: String("App")}},
+		TagStartCondition: &CiTagStartCondition{
+			AutoCancel: Bool(true),
+		},
+		Environment: CiWorkflowEnvironment{XcodeVersion: "14"},
+	}
+	desired := &CiWorkflowDocument{
+		Name:        "Release",
+		Description: "Ships it",
+		Actions:     []CiAction{{Scheme: String("App Store")}},
+		Environment: CiWorkflowEnvironment{XcodeVersion: "15"},
+	}
+
+	changes, err := DiffCiWorkflowDocuments(current, desired)
+	assert.NoError(t, err)
+	assert.Equal(t, []CiWorkflowChange{
+		{Path: "actions[0].scheme", Current: `"App"`, Desired: `"App Store"`},
+		{Path: "description", Current: `""`, Desired: `"Ships it"`},
+		{Path: "environment.xcodeVersion", Current: `"14"`, Desired: `"15"`},
+		{Path: "tagStartCondition.autoCancel", Current: "true", Desired: ""},
+	}, changes)
+	assert.Equal(t, "tagStartCondition.autoCancel: true -> (none)", changes[3].String())
+}
+
+func TestExportCiWorkflow(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{
+		"GET /ciWorkflows/10": testCiWorkflow,
+	})
+	defer server.Close()
+
+	doc, err := client.XcodeCloud.ExportCiWorkflow(context.Background(), "10")
+	assert.NoError(t, err)
+	assert.Equal(t, "Release", doc.Name)
+	assert.Len(t, doc.Actions, 1)
+}
+
+func TestPlanAndApplyCiWorkflowImport(t *testing.T) {
+	t.Parallel()
+
+	var body map[string]json.RawMessage
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			var payload struct {
+				Data map[string]json.RawMessage `json:"data"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			body = payload.Data
+		}
+
+		fmt.Fprintln(w, testCiWorkflow)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.Client())
+	client.baseURL, _ = url.Parse(server.URL)
+
+	doc, err := client.XcodeCloud.ExportCiWorkflow(context.Background(), "10")
+	assert.NoError(t, err)
+
+	plan, err := client.XcodeCloud.PlanCiWorkflowImport(context.Background(), "10", doc)
+	assert.NoError(t, err)
+	assert.Empty(t, plan.Changes)
+	assert.Equal(t, "workflow 10 matches the document\n", plan.String())
+	assert.NoError(t, client.XcodeCloud.ApplyCiWorkflowPlan(context.Background(), plan))
+	assert.Nil(t, body)
+
+	doc.Clean = true
+	doc.TagStartCondition = nil
+	doc.Environment.XcodeVersion = "15"
+
+	plan, err = client.XcodeCloud.PlanCiWorkflowImport(context.Background(), "10", doc)
+	assert.NoError(t, err)
+	assert.Len(t, plan.Changes, 3)
+	assert.Contains(t, plan.String(), "3 changes to workflow 10")
+
+	assert.NoError(t, client.XcodeCloud.ApplyCiWorkflowPlan(context.Background(), plan))
+	assert.JSONEq(t, `{"clean":true,"tagStartCondition":null}`, string(body["attributes"]))
+	assert.JSONEq(t, `{
+		"macOsVersion":{"data":{"id":"20","type":"ciMacOsVersions"}},
+		"xcodeVersion":{"data":{"id":"15","type":"ciXcodeVersions"}}
+	}`, string(body["relationships"]))
+}
+
+func TestApplyCiWorkflowPlanError(t *testing.T) {
+	t.Parallel()
+
+	client, server, _ := newRoutedServer(map[string]string{})
+	defer server.Close()
+
+	plan := &CiWorkflowPlan{
+		WorkflowID: "10",
+		Document:   &CiWorkflowDocument{Name: "Release"},
+		Changes:    []CiWorkflowChange{{Path: "name", Current: `"Debug"`, Desired: `"Release"`}},
+	}
+
+	err := client.XcodeCloud.ApplyCiWorkflowPlan(context.Background(), plan)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "workflow 10")
+}
+
+func TestCreateCiWorkflowFromDocument(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiWorkflowResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.CreateCiWorkflowFromDocument(ctx, &CiWorkflowDocument{
+			Name:        "Release",
+			Environment: CiWorkflowEnvironment{XcodeVersion: "15", MacOsVersion: "20"},
+		}, "30", "40")
+	})
+}
+
+func TestCreateCiWorkflowFromIncompleteDocument(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior("{}", func(ctx context.Context, client *Client) {
+		_, _, err := client.XcodeCloud.CreateCiWorkflowFromDocument(ctx, &CiWorkflowDocument{Name: "Release"}, "30", "40")
+		assert.ErrorIs(t, err, ErrIncompleteCiWorkflowDocument)
+	})
+}
@@ -0,0 +1,733 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"fmt"
+)
+
+// CiActionType defines model for CiActionType.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciactiontype
+type CiActionType string
+
+const (
+	// CiActionTypeAnalyze is an action that analyzes the scheme.
+	CiActionTypeAnalyze CiActionType = "ANALYZE"
+	// CiActionTypeArchive is an action that archives the scheme, for distribution when it is eligible.
+	CiActionTypeArchive CiActionType = "ARCHIVE"
+	// CiActionTypeBuild is an action that builds the scheme.
+	CiActionTypeBuild CiActionType = "BUILD"
+	// CiActionTypeTest is an action that tests the scheme.
+	CiActionTypeTest CiActionType = "TEST"
+)
+
+var ciActionTypes = []CiActionType{
+	CiActionTypeAnalyze,
+	CiActionTypeArchive,
+	CiActionTypeBuild,
+	CiActionTypeTest,
+}
+
+// IsValid reports whether the ci action type is one this package knows about.
+func (v CiActionType) IsValid() bool {
+	for _, value := range ciActionTypes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiActionType returns s as a CiActionType, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiActionType(s string) (CiActionType, error) {
+	if v := CiActionType(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiActionType", Value: s}
+}
+
+// CiActionPlatform defines model for CiAction.Platform.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciaction
+type CiActionPlatform string
+
+const (
+	// CiActionPlatformIOS is an action that runs for iOS.
+	CiActionPlatformIOS CiActionPlatform = "IOS"
+	// CiActionPlatformMacOS is an action that runs for macOS.
+	CiActionPlatformMacOS CiActionPlatform = "MACOS"
+	// CiActionPlatformTVOS is an action that runs for tvOS.
+	CiActionPlatformTVOS CiActionPlatform = "TVOS"
+	// CiActionPlatformVisionOS is an action that runs for visionOS.
+	CiActionPlatformVisionOS CiActionPlatform = "VISIONOS"
+	// CiActionPlatformWatchOS is an action that runs for watchOS.
+	CiActionPlatformWatchOS CiActionPlatform = "WATCHOS"
+)
+
+var ciActionPlatforms = []CiActionPlatform{
+	CiActionPlatformIOS,
+	CiActionPlatformMacOS,
+	CiActionPlatformTVOS,
+	CiActionPlatformVisionOS,
+	CiActionPlatformWatchOS,
+}
+
+// IsValid reports whether the ci action platform is one this package knows about.
+func (v CiActionPlatform) IsValid() bool {
+	for _, value := range ciActionPlatforms {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiActionPlatform returns s as a CiActionPlatform, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiActionPlatform(s string) (CiActionPlatform, error) {
+	if v := CiActionPlatform(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiActionPlatform", Value: s}
+}
+
+// CiBuildDistributionAudience defines model for CiBuildDistributionAudience.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibuilddistributionaudience
+type CiBuildDistributionAudience string
+
+const (
+	// CiBuildDistributionAudienceAppStoreEligible archives builds that can be distributed on the App Store.
+	CiBuildDistributionAudienceAppStoreEligible CiBuildDistributionAudience = "APP_STORE_ELIGIBLE"
+	// CiBuildDistributionAudienceInternalOnly archives builds that can only be distributed to internal testers.
+	CiBuildDistributionAudienceInternalOnly CiBuildDistributionAudience = "INTERNAL_ONLY"
+)
+
+var ciBuildDistributionAudiences = []CiBuildDistributionAudience{
+	CiBuildDistributionAudienceAppStoreEligible,
+	CiBuildDistributionAudienceInternalOnly,
+}
+
+// IsValid reports whether the ci build distribution audience is one this package knows about.
+func (v CiBuildDistributionAudience) IsValid() bool {
+	for _, value := range ciBuildDistributionAudiences {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiBuildDistributionAudience returns s as a CiBuildDistributionAudience, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiBuildDistributionAudience(s string) (CiBuildDistributionAudience, error) {
+	if v := CiBuildDistributionAudience(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiBuildDistributionAudience", Value: s}
+}
+
+// CiTestConfigurationKind defines model for CiTestConfiguration.Kind.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestconfiguration
+type CiTestConfigurationKind string
+
+const (
+	// CiTestConfigurationKindSpecificTestPlans runs the test plan named by the configuration.
+	CiTestConfigurationKindSpecificTestPlans CiTestConfigurationKind = "SPECIFIC_TEST_PLANS"
+	// CiTestConfigurationKindUseSchemeSettings runs the tests configured in the scheme.
+	CiTestConfigurationKindUseSchemeSettings CiTestConfigurationKind = "USE_SCHEME_SETTINGS"
+)
+
+var ciTestConfigurationKinds = []CiTestConfigurationKind{
+	CiTestConfigurationKindSpecificTestPlans,
+	CiTestConfigurationKindUseSchemeSettings,
+}
+
+// IsValid reports whether the ci test configuration kind is one this package knows about.
+func (v CiTestConfigurationKind) IsValid() bool {
+	for _, value := range ciTestConfigurationKinds {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiTestConfigurationKind returns s as a CiTestConfigurationKind, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiTestConfigurationKind(s string) (CiTestConfigurationKind, error) {
+	if v := CiTestConfigurationKind(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiTestConfigurationKind", Value: s}
+}
+
+// CiTestDestinationKind defines model for CiTestDestinationKind.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestdestinationkind
+type CiTestDestinationKind string
+
+const (
+	// CiTestDestinationKindMac runs tests on a Mac.
+	CiTestDestinationKindMac CiTestDestinationKind = "MAC"
+	// CiTestDestinationKindSimulator runs tests on a simulator.
+	CiTestDestinationKindSimulator CiTestDestinationKind = "SIMULATOR"
+)
+
+var ciTestDestinationKinds = []CiTestDestinationKind{
+	CiTestDestinationKindMac,
+	CiTestDestinationKindSimulator,
+}
+
+// IsValid reports whether the ci test destination kind is one this package knows about.
+func (v CiTestDestinationKind) IsValid() bool {
+	for _, value := range ciTestDestinationKinds {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiTestDestinationKind returns s as a CiTestDestinationKind, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiTestDestinationKind(s string) (CiTestDestinationKind, error) {
+	if v := CiTestDestinationKind(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiTestDestinationKind", Value: s}
+}
+
+// CiFilesAndFoldersRuleMode defines model for CiFilesAndFoldersRule.Mode.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cifilesandfoldersrule
+type CiFilesAndFoldersRuleMode string
+
+const (
+	// CiFilesAndFoldersRuleModeDoNotStartIfAllFilesMatch skips builds when every changed file matches the rule.
+	CiFilesAndFoldersRuleModeDoNotStartIfAllFilesMatch CiFilesAndFoldersRuleMode = "DO_NOT_START_IF_ALL_FILES_MATCH"
+	// CiFilesAndFoldersRuleModeStartIfAnyFileMatches starts builds only when a changed file matches the rule.
+	CiFilesAndFoldersRuleModeStartIfAnyFileMatches CiFilesAndFoldersRuleMode = "START_IF_ANY_FILE_MATCHES"
+)
+
+var ciFilesAndFoldersRuleModes = []CiFilesAndFoldersRuleMode{
+	CiFilesAndFoldersRuleModeDoNotStartIfAllFilesMatch,
+	CiFilesAndFoldersRuleModeStartIfAnyFileMatches,
+}
+
+// IsValid reports whether the ci files and folders rule mode is one this package knows about.
+func (v CiFilesAndFoldersRuleMode) IsValid() bool {
+	for _, value := range ciFilesAndFoldersRuleModes {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiFilesAndFoldersRuleMode returns s as a CiFilesAndFoldersRuleMode, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiFilesAndFoldersRuleMode(s string) (CiFilesAndFoldersRuleMode, error) {
+	if v := CiFilesAndFoldersRuleMode(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiFilesAndFoldersRuleMode", Value: s}
+}
+
+// CiScheduleFrequency defines model for CiScheduledStartCondition.Schedule.Frequency.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cischeduledstartcondition/schedule
+type CiScheduleFrequency string
+
+const (
+	// CiScheduleFrequencyDaily starts a build every day.
+	CiScheduleFrequencyDaily CiScheduleFrequency = "DAILY"
+	// CiScheduleFrequencyHourly starts a build every hour.
+	CiScheduleFrequencyHourly CiScheduleFrequency = "HOURLY"
+	// CiScheduleFrequencyWeekly starts a build on the days of the week in the schedule.
+	CiScheduleFrequencyWeekly CiScheduleFrequency = "WEEKLY"
+)
+
+var ciScheduleFrequencies = []CiScheduleFrequency{
+	CiScheduleFrequencyDaily,
+	CiScheduleFrequencyHourly,
+	CiScheduleFrequencyWeekly,
+}
+
+// IsValid reports whether the ci schedule frequency is one this package knows about.
+func (v CiScheduleFrequency) IsValid() bool {
+	for _, value := range ciScheduleFrequencies {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ParseCiScheduleFrequency returns s as a CiScheduleFrequency, or ErrUnknownEnumValue if it isn't one this package knows about.
+func ParseCiScheduleFrequency(s string) (CiScheduleFrequency, error) {
+	if v := CiScheduleFrequency(s); v.IsValid() {
+		return v, nil
+	}
+
+	return "", ErrUnknownEnumValue{Type: "CiScheduleFrequency", Value: s}
+}
+
+// CiAction defines model for CiAction.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciaction
+type CiAction struct {
+	ActionType                *CiActionType                `json:"actionType,omitempty"`
+	BuildDistributionAudience *CiBuildDistributionAudience `json:"buildDistributionAudience,omitempty"`
+	Destination               *string                      `json:"destination,omitempty"`
+	IsRequiredToPass          *bool                        `json:"isRequiredToPass,omitempty"`
+	Name                      *string                      `json:"name,omitempty"`
+	Platform                  *CiActionPlatform            `json:"platform,omitempty"`
+	Scheme                    *string                      `json:"scheme,omitempty"`
+	TestConfiguration         *CiTestConfiguration         `json:"testConfiguration,omitempty"`
+}
+
+// CiTestConfiguration defines model for CiAction.TestConfiguration.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciaction/testconfiguration
+type CiTestConfiguration struct {
+	Kind             *CiTestConfigurationKind `json:"kind,omitempty"`
+	TestDestinations []CiTestDestination      `json:"testDestinations,omitempty"`
+	TestPlanName     *string                  `json:"testPlanName,omitempty"`
+}
+
+// CiTestDestination defines model for CiTestDestination.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citestdestination
+type CiTestDestination struct {
+	DeviceTypeIdentifier *string                `json:"deviceTypeIdentifier,omitempty"`
+	DeviceTypeName       *string                `json:"deviceTypeName,omitempty"`
+	Kind                 *CiTestDestinationKind `json:"kind,omitempty"`
+	RuntimeIdentifier    *string                `json:"runtimeIdentifier,omitempty"`
+	RuntimeName          *string                `json:"runtimeName,omitempty"`
+}
+
+// CiStartConditionPattern defines model for a pattern in CiBranchPatterns and CiTagPatterns.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibranchpatterns/patterns
+type CiStartConditionPattern struct {
+	IsPrefix *bool   `json:"isPrefix,omitempty"`
+	Pattern  *string `json:"pattern,omitempty"`
+}
+
+// CiBranchPatterns defines model for CiBranchPatterns.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibranchpatterns
+type CiBranchPatterns struct {
+	IsAllMatch *bool                     `json:"isAllMatch,omitempty"`
+	Patterns   []CiStartConditionPattern `json:"patterns,omitempty"`
+}
+
+// CiTagPatterns defines model for CiTagPatterns.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citagpatterns
+type CiTagPatterns struct {
+	IsAllMatch *bool                     `json:"isAllMatch,omitempty"`
+	Patterns   []CiStartConditionPattern `json:"patterns,omitempty"`
+}
+
+// CiFilesAndFoldersRule defines model for CiFilesAndFoldersRule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cifilesandfoldersrule
+type CiFilesAndFoldersRule struct {
+	Matchers []CiFilesAndFoldersMatcher `json:"matchers,omitempty"`
+	Mode     *CiFilesAndFoldersRuleMode `json:"mode,omitempty"`
+}
+
+// CiFilesAndFoldersMatcher defines model for CiFilesAndFoldersRule.Matchers.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cifilesandfoldersrule/matchers
+type CiFilesAndFoldersMatcher struct {
+	Directory     *string `json:"directory,omitempty"`
+	FileExtension *string `json:"fileExtension,omitempty"`
+	FileName      *string `json:"fileName,omitempty"`
+}
+
+// CiBranchStartCondition defines model for CiBranchStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cibranchstartcondition
+type CiBranchStartCondition struct {
+	AutoCancel          *bool                  `json:"autoCancel,omitempty"`
+	FilesAndFoldersRule *CiFilesAndFoldersRule `json:"filesAndFoldersRule,omitempty"`
+	Source              *CiBranchPatterns      `json:"source,omitempty"`
+}
+
+// CiTagStartCondition defines model for CiTagStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/citagstartcondition
+type CiTagStartCondition struct {
+	AutoCancel          *bool                  `json:"autoCancel,omitempty"`
+	FilesAndFoldersRule *CiFilesAndFoldersRule `json:"filesAndFoldersRule,omitempty"`
+	Source              *CiTagPatterns         `json:"source,omitempty"`
+}
+
+// CiPullRequestStartCondition defines model for CiPullRequestStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cipullrequeststartcondition
+type CiPullRequestStartCondition struct {
+	AutoCancel          *bool                  `json:"autoCancel,omitempty"`
+	Destination         *CiBranchPatterns      `json:"destination,omitempty"`
+	FilesAndFoldersRule *CiFilesAndFoldersRule `json:"filesAndFoldersRule,omitempty"`
+	Source              *CiBranchPatterns      `json:"source,omitempty"`
+}
+
+// CiScheduledStartCondition defines model for CiScheduledStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cischeduledstartcondition
+type CiScheduledStartCondition struct {
+	Schedule *CiSchedule       `json:"schedule,omitempty"`
+	Source   *CiBranchPatterns `json:"source,omitempty"`
+}
+
+// CiSchedule defines model for CiScheduledStartCondition.Schedule.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cischeduledstartcondition/schedule
+type CiSchedule struct {
+	Days      []string             `json:"days,omitempty"`
+	Frequency *CiScheduleFrequency `json:"frequency,omitempty"`
+	Hour      *int                 `json:"hour,omitempty"`
+	Minute    *int                 `json:"minute,omitempty"`
+	Timezone  *string              `json:"timezone,omitempty"`
+}
+
+// CiManualBranchStartCondition defines model for CiManualBranchStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimanualbranchstartcondition
+type CiManualBranchStartCondition struct {
+	Source *CiBranchPatterns `json:"source,omitempty"`
+}
+
+// CiManualTagStartCondition defines model for CiManualTagStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimanualtagstartcondition
+type CiManualTagStartCondition struct {
+	Source *CiTagPatterns `json:"source,omitempty"`
+}
+
+// CiManualPullRequestStartCondition defines model for CiManualPullRequestStartCondition.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/cimanualpullrequeststartcondition
+type CiManualPullRequestStartCondition struct {
+	Destination *CiBranchPatterns `json:"destination,omitempty"`
+	Source      *CiBranchPatterns `json:"source,omitempty"`
+}
+
+// CiWorkflow defines model for CiWorkflow.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflow
+type CiWorkflow struct {
+	Attributes    *CiWorkflowAttributes    `json:"attributes,omitempty"`
+	ID            string                   `json:"id"`
+	Links         ResourceLinks            `json:"links"`
+	Relationships *CiWorkflowRelationships `json:"relationships,omitempty"`
+	Type          string                   `json:"type"`
+}
+
+// CiWorkflowAttributes defines model for CiWorkflow.Attributes
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflow/attributes
+type CiWorkflowAttributes struct {
+	Actions                         []CiAction                         `json:"actions,omitempty"`
+	BranchStartCondition            *CiBranchStartCondition            `json:"branchStartCondition,omitempty"`
+	Clean                           *bool                              `json:"clean,omitempty"`
+	ContainerFilePath               *string                            `json:"containerFilePath,omitempty"`
+	Description                     *string                            `json:"description,omitempty"`
+	IsEnabled                       *bool                              `json:"isEnabled,omitempty"`
+	IsLockedForEditing              *bool                              `json:"isLockedForEditing,omitempty"`
+	LastModifiedDate                *DateTime                          `json:"lastModifiedDate,omitempty"`
+	ManualBranchStartCondition      *CiManualBranchStartCondition      `json:"manualBranchStartCondition,omitempty"`
+	ManualPullRequestStartCondition *CiManualPullRequestStartCondition `json:"manualPullRequestStartCondition,omitempty"`
+	ManualTagStartCondition         *CiManualTagStartCondition         `json:"manualTagStartCondition,omitempty"`
+	Name                            *string                            `json:"name,omitempty"`
+	PullRequestStartCondition       *CiPullRequestStartCondition       `json:"pullRequestStartCondition,omitempty"`
+	ScheduledStartCondition         *CiScheduledStartCondition         `json:"scheduledStartCondition,omitempty"`
+	TagStartCondition               *CiTagStartCondition               `json:"tagStartCondition,omitempty"`
+}
+
+// CiWorkflowRelationships defines model for CiWorkflow.Relationships
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflow/relationships
+type CiWorkflowRelationships struct {
+	BuildRuns    *PagedRelationship `json:"buildRuns,omitempty"`
+	MacOsVersion *Relationship      `json:"macOsVersion,omitempty"`
+	Product      *Relationship      `json:"product,omitempty"`
+	Repository   *Relationship      `json:"repository,omitempty"`
+	XcodeVersion *Relationship      `json:"xcodeVersion,omitempty"`
+}
+
+// ciWorkflowCreateRequest defines model for CiWorkflowCreateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowcreaterequest/data
+type ciWorkflowCreateRequest struct {
+	Attributes    CiWorkflowCreateRequestAttributes    `json:"attributes"`
+	Relationships ciWorkflowCreateRequestRelationships `json:"relationships"`
+	Type          string                               `json:"type"`
+}
+
+// CiWorkflowCreateRequestAttributes are attributes for CiWorkflowCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowcreaterequest/data/attributes
+type CiWorkflowCreateRequestAttributes struct {
+	Actions                         []CiAction                         `json:"actions"`
+	BranchStartCondition            *CiBranchStartCondition            `json:"branchStartCondition,omitempty"`
+	Clean                           bool                               `json:"clean"`
+	ContainerFilePath               string                             `json:"containerFilePath"`
+	Description                     string                             `json:"description"`
+	IsEnabled                       bool                               `json:"isEnabled"`
+	IsLockedForEditing              *bool                              `json:"isLockedForEditing,omitempty"`
+	ManualBranchStartCondition      *CiManualBranchStartCondition      `json:"manualBranchStartCondition,omitempty"`
+	ManualPullRequestStartCondition *CiManualPullRequestStartCondition `json:"manualPullRequestStartCondition,omitempty"`
+	ManualTagStartCondition         *CiManualTagStartCondition         `json:"manualTagStartCondition,omitempty"`
+	Name                            string                             `json:"name"`
+	PullRequestStartCondition       *CiPullRequestStartCondition       `json:"pullRequestStartCondition,omitempty"`
+	ScheduledStartCondition         *CiScheduledStartCondition         `json:"scheduledStartCondition,omitempty"`
+	TagStartCondition               *CiTagStartCondition               `json:"tagStartCondition,omitempty"`
+}
+
+// ciWorkflowCreateRequestRelationships are relationships for CiWorkflowCreateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowcreaterequest/data/relationships
+type ciWorkflowCreateRequestRelationships struct {
+	MacOsVersion relationshipDeclaration `json:"macOsVersion"`
+	Product      relationshipDeclaration `json:"product"`
+	Repository   relationshipDeclaration `json:"repository"`
+	XcodeVersion relationshipDeclaration `json:"xcodeVersion"`
+}
+
+// ciWorkflowUpdateRequest defines model for CiWorkflowUpdateRequest.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowupdaterequest/data
+type ciWorkflowUpdateRequest struct {
+	Attributes    *CiWorkflowUpdateRequestAttributes    `json:"attributes,omitempty"`
+	ID            string                                `json:"id"`
+	Relationships *ciWorkflowUpdateRequestRelationships `json:"relationships,omitempty"`
+	Type          string                                `json:"type"`
+}
+
+// CiWorkflowUpdateRequestAttributes are attributes for CiWorkflowUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowupdaterequest/data/attributes
+type CiWorkflowUpdateRequestAttributes struct {
+	Actions                         []CiAction                         `json:"actions,omitempty"`
+	BranchStartCondition            *CiBranchStartCondition            `json:"branchStartCondition,omitempty"`
+	Clean                           *bool                              `json:"clean,omitempty"`
+	ContainerFilePath               *string                            `json:"containerFilePath,omitempty"`
+	Description                     *string                            `json:"description,omitempty"`
+	IsEnabled                       *bool                              `json:"isEnabled,omitempty"`
+	IsLockedForEditing              *bool                              `json:"isLockedForEditing,omitempty"`
+	ManualBranchStartCondition      *CiManualBranchStartCondition      `json:"manualBranchStartCondition,omitempty"`
+	ManualPullRequestStartCondition *CiManualPullRequestStartCondition `json:"manualPullRequestStartCondition,omitempty"`
+	ManualTagStartCondition         *CiManualTagStartCondition         `json:"manualTagStartCondition,omitempty"`
+	Name                            *string                            `json:"name,omitempty"`
+	PullRequestStartCondition       *CiPullRequestStartCondition       `json:"pullRequestStartCondition,omitempty"`
+	ScheduledStartCondition         *CiScheduledStartCondition         `json:"scheduledStartCondition,omitempty"`
+	TagStartCondition               *CiTagStartCondition               `json:"tagStartCondition,omitempty"`
+}
+
+// ciWorkflowUpdateRequestRelationships are relationships for CiWorkflowUpdateRequest
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowupdaterequest/data/relationships
+type ciWorkflowUpdateRequestRelationships struct {
+	MacOsVersion *relationshipDeclaration `json:"macOsVersion,omitempty"`
+	XcodeVersion *relationshipDeclaration `json:"xcodeVersion,omitempty"`
+}
+
+// CiWorkflowResponse defines model for CiWorkflowResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowresponse
+type CiWorkflowResponse struct {
+	Data     CiWorkflow                   `json:"data"`
+	Included []CiWorkflowResponseIncluded `json:"included,omitempty"`
+	Links    DocumentLinks                `json:"links"`
+}
+
+// CiWorkflowsResponse defines model for CiWorkflowsResponse.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/ciworkflowsresponse
+type CiWorkflowsResponse struct {
+	Data     []CiWorkflow                 `json:"data"`
+	Included []CiWorkflowResponseIncluded `json:"included,omitempty"`
+	Links    PagedDocumentLinks           `json:"links"`
+	Meta     *PagingInformation           `json:"meta,omitempty"`
+}
+
+// CiWorkflowResponseIncluded is a heterogenous wrapper for the possible types that can be returned
+// in a CiWorkflowResponse or CiWorkflowsResponse.
+type CiWorkflowResponseIncluded included
+
+// ListCiWorkflowsForCiProductQuery are query options for ListCiWorkflowsForCiProduct
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_workflows_for_an_xcode_cloud_product
+type ListCiWorkflowsForCiProductQuery struct {
+	FieldsCiWorkflows []string `url:"fields[ciWorkflows],omitempty"`
+	Include           []string `url:"include,omitempty"`
+	Limit             int      `url:"limit,omitempty"`
+	Cursor            string   `url:"cursor,omitempty"`
+}
+
+// GetCiWorkflowQuery are query options for GetCiWorkflow
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_workflow_information
+type GetCiWorkflowQuery struct {
+	FieldsCiMacOsVersions []string `url:"fields[ciMacOsVersions],omitempty"`
+	FieldsCiWorkflows     []string `url:"fields[ciWorkflows],omitempty"`
+	FieldsCiXcodeVersions []string `url:"fields[ciXcodeVersions],omitempty"`
+	FieldsScmRepositories []string `url:"fields[scmRepositories],omitempty"`
+	Include               []string `url:"include,omitempty"`
+}
+
+// GetRepositoryForCiWorkflowQuery are query options for GetRepositoryForCiWorkflow
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_repository_information_of_a_workflow
+type GetRepositoryForCiWorkflowQuery struct {
+	FieldsScmRepositories []string `url:"fields[scmRepositories],omitempty"`
+}
+
+// ListCiWorkflowsForCiProduct lists the workflows of an Xcode Cloud product.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/list_all_workflows_for_an_xcode_cloud_product
+func (s *XcodeCloudService) ListCiWorkflowsForCiProduct(ctx context.Context, id string, params *ListCiWorkflowsForCiProductQuery) (*CiWorkflowsResponse, *Response, error) {
+	return Get[CiWorkflowsResponse](ctx, s.client, fmt.Sprintf("ciProducts/%s/workflows", id), params)
+}
+
+// GetCiWorkflow gets information about an Xcode Cloud workflow.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_workflow_information
+func (s *XcodeCloudService) GetCiWorkflow(ctx context.Context, id string, params *GetCiWorkflowQuery) (*CiWorkflowResponse, *Response, error) {
+	return Get[CiWorkflowResponse](ctx, s.client, fmt.Sprintf("ciWorkflows/%s", id), params)
+}
+
+// GetRepositoryForCiWorkflow gets the repository an Xcode Cloud workflow builds.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/read_the_repository_information_of_a_workflow
+func (s *XcodeCloudService) GetRepositoryForCiWorkflow(ctx context.Context, id string, params *GetRepositoryForCiWorkflowQuery) (*ScmRepositoryResponse, *Response, error) {
+	return Get[ScmRepositoryResponse](ctx, s.client, fmt.Sprintf("ciWorkflows/%s/repository", id), params)
+}
+
+// CreateCiWorkflow creates an Xcode Cloud workflow for a product, building a repository with the given Xcode and
+// macOS versions.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/create_a_workflow
+func (s *XcodeCloudService) CreateCiWorkflow(ctx context.Context, attributes CiWorkflowCreateRequestAttributes, productID string, repositoryID string, xcodeVersionID string, macOsVersionID string) (*CiWorkflowResponse, *Response, error) {
+	req := ciWorkflowCreateRequest{
+		Attributes: attributes,
+		Relationships: ciWorkflowCreateRequestRelationships{
+			MacOsVersion: *newRelationshipDeclaration(&macOsVersionID, "ciMacOsVersions"),
+			Product:      *newRelationshipDeclaration(&productID, "ciProducts"),
+			Repository:   *newRelationshipDeclaration(&repositoryID, "scmRepositories"),
+			XcodeVersion: *newRelationshipDeclaration(&xcodeVersionID, "ciXcodeVersions"),
+		},
+		Type: "ciWorkflows",
+	}
+	res := new(CiWorkflowResponse)
+	resp, err := s.client.post(ctx, "ciWorkflows", newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// UpdateCiWorkflow updates the actions, start conditions or environment of an Xcode Cloud workflow. The Xcode and
+// macOS versions are left unchanged when their IDs are nil.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/modify_a_workflow
+func (s *XcodeCloudService) UpdateCiWorkflow(ctx context.Context, id string, attributes *CiWorkflowUpdateRequestAttributes, xcodeVersionID *string, macOsVersionID *string) (*CiWorkflowResponse, *Response, error) {
+	req := ciWorkflowUpdateRequest{
+		Attributes: attributes,
+		ID:         id,
+		Type:       "ciWorkflows",
+	}
+
+	if xcodeVersionID != nil || macOsVersionID != nil {
+		req.Relationships = &ciWorkflowUpdateRequestRelationships{
+			MacOsVersion: newRelationshipDeclaration(macOsVersionID, "ciMacOsVersions"),
+			XcodeVersion: newRelationshipDeclaration(xcodeVersionID, "ciXcodeVersions"),
+		}
+	}
+
+	url := fmt.Sprintf("ciWorkflows/%s", id)
+	res := new(CiWorkflowResponse)
+	resp, err := s.client.patch(ctx, url, newRequestBody(req), res)
+
+	return res, resp, err
+}
+
+// EnableCiWorkflow enables an Xcode Cloud workflow, so that its start conditions start builds again.
+func (s *XcodeCloudService) EnableCiWorkflow(ctx context.Context, id string) (*CiWorkflowResponse, *Response, error) {
+	return s.UpdateCiWorkflow(ctx, id, &CiWorkflowUpdateRequestAttributes{IsEnabled: Bool(true)}, nil, nil)
+}
+
+// DisableCiWorkflow disables an Xcode Cloud workflow, so that no builds start for it.
+func (s *XcodeCloudService) DisableCiWorkflow(ctx context.Context, id string) (*CiWorkflowResponse, *Response, error) {
+	return s.UpdateCiWorkflow(ctx, id, &CiWorkflowUpdateRequestAttributes{IsEnabled: Bool(false)}, nil, nil)
+}
+
+// DeleteCiWorkflow deletes an Xcode Cloud workflow.
+//
+// https://developer.apple.com/documentation/appstoreconnectapi/delete_a_workflow
+func (s *XcodeCloudService) DeleteCiWorkflow(ctx context.Context, id string) (*Response, error) {
+	url := fmt.Sprintf("ciWorkflows/%s", id)
+
+	return s.client.delete(ctx, url, nil)
+}
+
+// UnmarshalJSON is a custom unmarshaller for the heterogenous data stored in CiWorkflowResponseIncluded.
+func (i *CiWorkflowResponseIncluded) UnmarshalJSON(b []byte) error {
+	typeName, inner, err := unmarshalInclude(b)
+	i.Type = typeName
+	i.inner = inner
+
+	return err
+}
+
+// CiMacOsVersion returns the CiMacOsVersion stored within, if one is present.
+func (i *CiWorkflowResponseIncluded) CiMacOsVersion() *CiMacOsVersion {
+	return extractIncludedCiMacOsVersion(i.inner)
+}
+
+// CiProduct returns the CiProduct stored within, if one is present.
+func (i *CiWorkflowResponseIncluded) CiProduct() *CiProduct {
+	return extractIncludedCiProduct(i.inner)
+}
+
+// ScmRepository returns the ScmRepository stored within, if one is present.
+func (i *CiWorkflowResponseIncluded) ScmRepository() *ScmRepository {
+	return extractIncludedScmRepository(i.inner)
+}
+
+// CiXcodeVersion returns the CiXcodeVersion stored within, if one is present.
+func (i *CiWorkflowResponseIncluded) CiXcodeVersion() *CiXcodeVersion {
+	return extractIncludedCiXcodeVersion(i.inner)
+}
@@ -0,0 +1,210 @@
+/**
+Copyright (C) 2020 Aaron Sky.
+
+This file is part of asc-go, a package for working with Apple's
+App Store Connect API.
+
+asc-go is free software: you can redistribute it and/or modify
+it under the terms of the GNU General Public License as published by
+the Free Software Foundation, either version 3 of the License, or
+(at your option) any later version.
+
+asc-go is distributed in the hope that it will be useful,
+but WITHOUT ANY WARRANTY; without even the implied warranty of
+MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+GNU General Public License for more details.
+
+You should have received a copy of the GNU General Public License
+along with asc-go.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package asc
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListCiWorkflowsForCiProduct(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiWorkflowsResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.ListCiWorkflowsForCiProduct(ctx, "10", &ListCiWorkflowsForCiProductQuery{})
+	})
+}
+
+func TestGetCiWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiWorkflowResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetCiWorkflow(ctx, "10", &GetCiWorkflowQuery{})
+	})
+}
+
+func TestGetCiWorkflowIncludeds(t *testing.T) {
+	t.Parallel()
+
+	testEndpointCustomBehavior(`{"included":[{"type":"ciProducts"},{"type":"scmRepositories"},{"type":"ciMacOsVersions"},{"type":"ciXcodeVersions"}]}`, func(ctx context.Context, client *Client) {
+		workflow, _, err := client.XcodeCloud.GetCiWorkflow(ctx, "10", &GetCiWorkflowQuery{})
+		assert.NoError(t, err)
+		assert.NotEmpty(t, workflow.Included)
+
+		assert.NotNil(t, workflow.Included[0].CiProduct())
+		assert.NotNil(t, workflow.Included[1].ScmRepository())
+		assert.NotNil(t, workflow.Included[2].CiMacOsVersion())
+		assert.NotNil(t, workflow.Included[3].CiXcodeVersion())
+
+		assert.Nil(t, workflow.Included[0].ScmRepository())
+		assert.Nil(t, workflow.Included[1].CiProduct())
+	})
+}
+
+func TestGetRepositoryForCiWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &ScmRepositoryResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.GetRepositoryForCiWorkflow(ctx, "10", &GetRepositoryForCiWorkflowQuery{})
+	})
+}
+
+func TestCreateCiWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiWorkflowResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.CreateCiWorkflow(ctx, CiWorkflowCreateRequestAttributes{}, "10", "20", "30", "40")
+	})
+}
+
+func TestUpdateCiWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiWorkflowResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.UpdateCiWorkflow(ctx, "10", &CiWorkflowUpdateRequestAttributes{}, String("30"), nil)
+	})
+}
+
+func TestEnableCiWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiWorkflowResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.EnableCiWorkflow(ctx, "10")
+	})
+}
+
+func TestDisableCiWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithResponse(t, "{}", &CiWorkflowResponse{}, func(ctx context.Context, client *Client) (interface{}, *Response, error) {
+		return client.XcodeCloud.DisableCiWorkflow(ctx, "10")
+	})
+}
+
+func TestDeleteCiWorkflow(t *testing.T) {
+	t.Parallel()
+
+	testEndpointWithNoContent(t, func(ctx context.Context, client *Client) (*Response, error) {
+		return client.XcodeCloud.DeleteCiWorkflow(ctx, "10")
+	})
+}
+
+func TestCiWorkflowUpdateRequestOmitsUnchangedVersions(t *testing.T) {
+	t.Parallel()
+
+	req := ciWorkflowUpdateRequest{
+		Attributes: &CiWorkflowUpdateRequestAttributes{IsEnabled: Bool(false)},
+		ID:         "10",
+		Relationships: &ciWorkflowUpdateRequestRelationships{
+			XcodeVersion: newRelationshipDeclaration(String("30"), "ciXcodeVersions"),
+		},
+		Type: "ciWorkflows",
+	}
+
+	b, err := json.Marshal(req)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"attributes": {"isEnabled": false},
+		"id": "10",
+		"relationships": {"xcodeVersion": {"data": {"id": "30", "type": "ciXcodeVersions"}}},
+		"type": "ciWorkflows"
+	}`, string(b))
+}
+
+func TestParseCiActionType(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiActionType(string(CiActionTypeAnalyze))
+	assert.NoError(t, err)
+	assert.Equal(t, CiActionTypeAnalyze, got)
+
+	_, err = ParseCiActionType("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiActionType", Value: "UNKNOWN"}, err)
+}
+
+func TestParseCiActionPlatform(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiActionPlatform(string(CiActionPlatformIOS))
+	assert.NoError(t, err)
+	assert.Equal(t, CiActionPlatformIOS, got)
+
+	_, err = ParseCiActionPlatform("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiActionPlatform", Value: "UNKNOWN"}, err)
+}
+
+func TestParseCiBuildDistributionAudience(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiBuildDistributionAudience(string(CiBuildDistributionAudienceAppStoreEligible))
+	assert.NoError(t, err)
+	assert.Equal(t, CiBuildDistributionAudienceAppStoreEligible, got)
+
+	_, err = ParseCiBuildDistributionAudience("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiBuildDistributionAudience", Value: "UNKNOWN"}, err)
+}
+
+func TestParseCiTestConfigurationKind(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiTestConfigurationKind(string(CiTestConfigurationKindSpecificTestPlans))
+	assert.NoError(t, err)
+	assert.Equal(t, CiTestConfigurationKindSpecificTestPlans, got)
+
+	_, err = ParseCiTestConfigurationKind("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiTestConfigurationKind", Value: "UNKNOWN"}, err)
+}
+
+func TestParseCiTestDestinationKind(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiTestDestinationKind(string(CiTestDestinationKindMac))
+	assert.NoError(t, err)
+	assert.Equal(t, CiTestDestinationKindMac, got)
+
+	_, err = ParseCiTestDestinationKind("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiTestDestinationKind", Value: "UNKNOWN"}, err)
+}
+
+func TestParseCiFilesAndFoldersRuleMode(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiFilesAndFoldersRuleMode(string(CiFilesAndFoldersRuleModeDoNotStartIfAllFilesMatch))
+	assert.NoError(t, err)
+	assert.Equal(t, CiFilesAndFoldersRuleModeDoNotStartIfAllFilesMatch, got)
+
+	_, err = ParseCiFilesAndFoldersRuleMode("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiFilesAndFoldersRuleMode", Value: "UNKNOWN"}, err)
+}
+
+func TestParseCiScheduleFrequency(t *testing.T) {
+	t.Parallel()
+
+	got, err := ParseCiScheduleFrequency(string(CiScheduleFrequencyDaily))
+	assert.NoError(t, err)
+	assert.Equal(t, CiScheduleFrequencyDaily, got)
+
+	_, err = ParseCiScheduleFrequency("UNKNOWN")
+	assert.Equal(t, ErrUnknownEnumValue{Type: "CiScheduleFrequency", Value: "UNKNOWN"}, err)
+}